@@ -0,0 +1,164 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/secretflow/kuscia/pkg/backup"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
+)
+
+type backupOptions struct {
+	kubeconfig     string
+	passphraseFile string
+	filename       string
+	overwrite      bool
+}
+
+func NewBackupCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Backup and restore the Kuscia resources of the cluster",
+		Long: `Backup and restore the Domains, DomainRoutes, DomainDataSources, DomainData, DomainDataGrants, AppImages,
+KusciaJobs and ConfManager configs of the cluster. The backup archive is encrypted with the passphrase.
+The ConfManager configs stay encrypted with the domain keys, restore them into a cluster running with the same domain keys.`,
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(newCreateCommand(ctx))
+	cmd.AddCommand(newRestoreCommand(ctx))
+	return cmd
+}
+
+func newCreateCommand(ctx context.Context) *cobra.Command {
+	opts := &backupOptions{}
+	cmd := &cobra.Command{
+		Use:          "create -o FILENAME --passphrase-file FILENAME",
+		Short:        "Create an encrypted backup archive of the cluster",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreate(ctx, opts, cmd.OutOrStdout())
+		},
+	}
+	addFlags(cmd, opts)
+	cmd.Flags().StringVarP(&opts.filename, "output", "o", "", "Path of the backup archive to write")
+	_ = cmd.MarkFlagRequired("output")
+	return cmd
+}
+
+func newRestoreCommand(ctx context.Context) *cobra.Command {
+	opts := &backupOptions{}
+	cmd := &cobra.Command{
+		Use:          "restore -f FILENAME --passphrase-file FILENAME",
+		Short:        "Restore an encrypted backup archive into the cluster",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRestore(ctx, opts, cmd.OutOrStdout())
+		},
+	}
+	addFlags(cmd, opts)
+	cmd.Flags().StringVarP(&opts.filename, "filename", "f", "", "Path of the backup archive to restore")
+	cmd.Flags().BoolVar(&opts.overwrite, "overwrite", false, "Overwrite the existing resources, they are skipped otherwise")
+	_ = cmd.MarkFlagRequired("filename")
+	return cmd
+}
+
+func addFlags(cmd *cobra.Command, opts *backupOptions) {
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", filepath.Join(common.DefaultKusciaHomePath(), "etc/kubeconfig"), "Path of the kubeconfig file")
+	cmd.Flags().StringVar(&opts.passphraseFile, "passphrase-file", "", "Path of the file holding the passphrase of the backup archive")
+	_ = cmd.MarkFlagRequired("passphrase-file")
+}
+
+func runCreate(ctx context.Context, opts *backupOptions, stdout io.Writer) error {
+	passphrase, err := readPassphrase(opts.passphraseFile)
+	if err != nil {
+		return err
+	}
+	clients, err := kubeconfig.CreateClientSetsFromKubeconfig(opts.kubeconfig, "")
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := backup.Create(ctx, clients.KusciaClient, clients.KubeClient)
+	if err != nil {
+		return err
+	}
+	archive, err := backup.Seal(snapshot, passphrase)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(opts.filename, archive, 0600); err != nil {
+		return err
+	}
+	writeStats(stdout, snapshot.Stats(), false)
+	return nil
+}
+
+func runRestore(ctx context.Context, opts *backupOptions, stdout io.Writer) error {
+	passphrase, err := readPassphrase(opts.passphraseFile)
+	if err != nil {
+		return err
+	}
+	archive, err := os.ReadFile(opts.filename)
+	if err != nil {
+		return err
+	}
+	snapshot, err := backup.Open(archive, passphrase)
+	if err != nil {
+		return err
+	}
+	clients, err := kubeconfig.CreateClientSetsFromKubeconfig(opts.kubeconfig, "")
+	if err != nil {
+		return err
+	}
+
+	stats, err := backup.Restore(ctx, clients.KusciaClient, clients.KubeClient, snapshot, backup.RestoreOptions{Overwrite: opts.overwrite})
+	writeStats(stdout, stats, true)
+	return err
+}
+
+// readPassphrase reads the passphrase from the file rather than the flag, so that it's not left in the shell history.
+func readPassphrase(filename string) ([]byte, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	passphrase := strings.TrimRight(string(data), "\r\n")
+	if passphrase == "" {
+		return nil, errors.New("passphrase file is empty")
+	}
+	return []byte(passphrase), nil
+}
+
+func writeStats(w io.Writer, stats []backup.Stat, restored bool) {
+	for _, stat := range stats {
+		if restored {
+			fmt.Fprintf(w, "%-20s total: %d, created: %d, updated: %d, skipped: %d\n", stat.Kind, stat.Total, stat.Created, stat.Updated, stat.Skipped)
+		} else {
+			fmt.Fprintf(w, "%-20s total: %d\n", stat.Kind, stat.Total)
+		}
+	}
+}
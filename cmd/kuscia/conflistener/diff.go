@@ -0,0 +1,125 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conflistener
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// reloadableFields are the fields of kuscia.yaml applied without restarting kuscia. [*] matches any
+// list index, and a trailing .* matches any sub field.
+var reloadableFields = []string{
+	"logLevel",
+	"metricUpdatePeriod",
+	"kusciaAPI.rateLimit.*",
+	"image.registries[*].username",
+	"image.registries[*].password",
+}
+
+var reloadablePatterns = compileFieldPatterns(reloadableFields)
+
+// configChange is the fields changed between two versions of kuscia.yaml.
+type configChange struct {
+	Reloadable      []string
+	RestartRequired []string
+}
+
+func compileFieldPatterns(fields []string) []*regexp.Regexp {
+	patterns := make([]*regexp.Regexp, 0, len(fields))
+	for _, f := range fields {
+		p := regexp.QuoteMeta(f)
+		p = strings.ReplaceAll(p, `\[\*\]`, `\[\d+\]`)
+		p = strings.TrimSuffix(p, `\.\*`)
+		if strings.HasSuffix(f, ".*") {
+			p += `\..+`
+		}
+		patterns = append(patterns, regexp.MustCompile("^"+p+"$"))
+	}
+	return patterns
+}
+
+func isReloadable(field string) bool {
+	for _, p := range reloadablePatterns {
+		if p.MatchString(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// diffConfig returns the changed fields of kuscia.yaml, classified by whether they can be reloaded.
+func diffConfig(oldContent, newContent []byte) (*configChange, error) {
+	var oldConf, newConf interface{}
+	if err := yaml.Unmarshal(oldContent, &oldConf); err != nil {
+		return nil, fmt.Errorf("parse old config failed, %v", err)
+	}
+	if err := yaml.Unmarshal(newContent, &newConf); err != nil {
+		return nil, fmt.Errorf("parse new config failed, %v", err)
+	}
+	var fields []string
+	diffValue("", oldConf, newConf, &fields)
+	sort.Strings(fields)
+
+	change := &configChange{}
+	for _, f := range fields {
+		if isReloadable(f) {
+			change.Reloadable = append(change.Reloadable, f)
+		} else {
+			change.RestartRequired = append(change.RestartRequired, f)
+		}
+	}
+	return change, nil
+}
+
+func diffValue(path string, oldValue, newValue interface{}, fields *[]string) {
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		keys := map[string]bool{}
+		for k := range oldMap {
+			keys[k] = true
+		}
+		for k := range newMap {
+			keys[k] = true
+		}
+		for k := range keys {
+			child := k
+			if path != "" {
+				child = path + "." + k
+			}
+			diffValue(child, oldMap[k], newMap[k], fields)
+		}
+		return
+	}
+
+	oldList, oldIsList := oldValue.([]interface{})
+	newList, newIsList := newValue.([]interface{})
+	if oldIsList && newIsList && len(oldList) == len(newList) {
+		for i := range oldList {
+			diffValue(fmt.Sprintf("%s[%d]", path, i), oldList[i], newList[i], fields)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		*fields = append(*fields, path)
+	}
+}
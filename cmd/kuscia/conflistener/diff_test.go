@@ -0,0 +1,110 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conflistener
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffConfig(t *testing.T) {
+	oldContent := `
+mode: lite
+domainID: alice
+logLevel: INFO
+image:
+  defaultRegistry: private
+  registries:
+  - name: private
+    endpoint: registry.example.com/secretflow
+    username: alice
+    password: old
+kusciaAPI:
+  rateLimit:
+    default:
+      qps: 10
+      burst: 20
+`
+	newContent := `
+mode: lite
+domainID: alice
+logLevel: DEBUG
+metricUpdatePeriod: 10
+image:
+  defaultRegistry: private
+  registries:
+  - name: private
+    endpoint: registry.example.com/kuscia
+    username: alice
+    password: new
+kusciaAPI:
+  HTTPPort: 8092
+  rateLimit:
+    default:
+      qps: 100
+      burst: 200
+    maxInFlight: 64
+`
+	change, err := diffConfig([]byte(oldContent), []byte(newContent))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"image.registries[0].password",
+		"kusciaAPI.rateLimit.default.burst",
+		"kusciaAPI.rateLimit.default.qps",
+		"kusciaAPI.rateLimit.maxInFlight",
+		"logLevel",
+		"metricUpdatePeriod",
+	}, change.Reloadable)
+	assert.Equal(t, []string{"image.registries[0].endpoint", "kusciaAPI.HTTPPort"}, change.RestartRequired)
+}
+
+func TestDiffConfig_RestartRequired(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldContent string
+		newContent string
+		expected   []string
+	}{
+		{
+			name:       "enable rate limit",
+			oldContent: "kusciaAPI:\n  HTTPPort: 8082\n",
+			newContent: "kusciaAPI:\n  HTTPPort: 8082\n  rateLimit:\n    maxInFlight: 10\n",
+			expected:   []string{"kusciaAPI.rateLimit"},
+		},
+		{
+			name:       "add registry",
+			oldContent: "image:\n  registries:\n  - name: a\n",
+			newContent: "image:\n  registries:\n  - name: a\n  - name: b\n",
+			expected:   []string{"image.registries"},
+		},
+		{
+			name:       "unchanged",
+			oldContent: "logLevel: INFO\nlogrotate:\n  maxFiles: 3\n",
+			newContent: "logrotate:\n  maxFiles: 3\nlogLevel: INFO\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change, err := diffConfig([]byte(tt.oldContent), []byte(tt.newContent))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, change.RestartRequired)
+			assert.Empty(t, change.Reloadable)
+		})
+	}
+
+	_, err := diffConfig([]byte("a: b"), []byte("a: [b"))
+	assert.Error(t, err)
+}
@@ -29,11 +29,24 @@ import (
 
 var (
 	updateMutex sync.Mutex
+	// startContent is the config the running kuscia started with, it's used to report the fields requiring restart.
+	startContent []byte
+	// lastContent is the config reloaded last time.
+	lastContent []byte
 )
 
-func LogLevelHotReloadListener(ctx context.Context, cf string) {
+// ConfigHotReloadListener watches the config file and reloads the fields in reloadableFields when it
+// changes. The changes of the other fields are reported, they take effect after restarting kuscia.
+func ConfigHotReloadListener(ctx context.Context, cf string) {
 
 	nlog.Infof("Kuscia config file watcher start, config file: %s.", cf)
+	content, err := os.ReadFile(cf)
+	if err != nil {
+		nlog.Warnf("Read config file %s failed: %v", cf, err)
+	}
+	updateMutex.Lock()
+	startContent, lastContent = content, content
+	updateMutex.Unlock()
 	configFileCheck(ctx, cf)
 }
 
@@ -90,7 +103,7 @@ func eventWatcher(ctx context.Context, watcher *fsnotify.Watcher, initWG *sync.W
 					(currentConfigFile != "" && currentConfigFile != realCf) {
 					realCf = currentConfigFile
 					if checkFile(realCf) {
-						updateLogLevel(ctx, realCf)
+						reloadConfig(ctx, realCf)
 					} else {
 						nlog.Error("Kuscia config file watcher update failed. ignore...")
 					}
@@ -131,17 +144,34 @@ func checkFile(cf string) bool {
 	return false
 }
 
-func updateLogLevel(ctx context.Context, cf string) {
+func reloadConfig(ctx context.Context, cf string) {
 
 	updateMutex.Lock()
 	defer updateMutex.Unlock()
 
-	// Check if the file exists and is readable
-	if _, err := os.Stat(cf); os.IsNotExist(err) {
-		nlog.Infof("Config file %s does not exist, skip update", cf)
+	nlog.Debugf("Kuscia config file watcher path: %s", cf)
+	content, err := os.ReadFile(cf)
+	if err != nil {
+		nlog.Errorf("Read config file %s failed: %v", cf, err)
+		return
+	}
+
+	if restart, err := diffConfig(startContent, content); err != nil {
+		nlog.Errorf("Kuscia config file watcher diff config failed: %v", err)
+		return
+	} else if len(restart.RestartRequired) > 0 {
+		nlog.Warnf("Kuscia config fields %v are changed, they take effect after restarting kuscia", restart.RestartRequired)
+	}
+
+	change, err := diffConfig(lastContent, content)
+	if err != nil {
+		nlog.Errorf("Kuscia config file watcher diff config failed: %v", err)
+		return
+	}
+	if len(change.Reloadable) == 0 {
+		return
 	}
 
-	nlog.Debugf("Kuscia config file watcher path: %s", cf)
 	config, err := confloader.ReadConfig(cf)
 	if err != nil {
 		nlog.Errorf("Kuscia config file watcher read config failed: %v", err)
@@ -149,4 +179,6 @@ func updateLogLevel(ctx context.Context, cf string) {
 	}
 
 	modules.UpdateCommonConfigs(ctx, config)
+	lastContent = content
+	nlog.Infof("Kuscia config fields %v are reloaded", change.Reloadable)
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/secretflow/kuscia/pkg/common"
 	cmconf "github.com/secretflow/kuscia/pkg/confmanager/config"
 	dmconfig "github.com/secretflow/kuscia/pkg/datamesh/config"
+	gwconfig "github.com/secretflow/kuscia/pkg/gateway/config"
 	kaconfig "github.com/secretflow/kuscia/pkg/kusciaapi/config"
 	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 	"github.com/secretflow/kuscia/pkg/utils/network"
@@ -56,17 +57,29 @@ type KusciaConfig struct {
 
 	Image ImageConfig `yaml:"image"`
 
-	Agent                 config.AgentConfig        `yaml:"agent,omitempty"`
-	Master                kusciaconfig.MasterConfig `yaml:"master,omitempty"`
-	ConfManager           *cmconf.ConfManagerConfig `yaml:"confManager,omitempty"`
-	KusciaAPI             *kaconfig.KusciaAPIConfig `yaml:"kusciaAPI,omitempty"`
-	DataMesh              *dmconfig.DataMeshConfig  `yaml:"dataMesh,omitempty"`
-	DomainRoute           DomainRouteConfig         `yaml:"domainRoute,omitempty"`
-	Protocol              common.Protocol           `yaml:"protocol"`
-	EnvoyIP               string                    `yaml:"-"`
-	CoreDNSBackUpConf     string                    `yaml:"-"`
-	RunMode               common.RunModeType        `yaml:"-"`
-	EnableWorkloadApprove bool                      `yaml:"enableWorkloadApprove,omitempty"`
+	Agent                 config.AgentConfig                    `yaml:"agent,omitempty"`
+	Master                kusciaconfig.MasterConfig             `yaml:"master,omitempty"`
+	ConfManager           *cmconf.ConfManagerConfig             `yaml:"confManager,omitempty"`
+	KusciaAPI             *kaconfig.KusciaAPIConfig             `yaml:"kusciaAPI,omitempty"`
+	DataMesh              *dmconfig.DataMeshConfig              `yaml:"dataMesh,omitempty"`
+	DomainRoute           DomainRouteConfig                     `yaml:"domainRoute,omitempty"`
+	Protocol              common.Protocol                       `yaml:"protocol"`
+	IPFamily              common.IPFamily                       `yaml:"ipFamily,omitempty"`
+	EnvoyIP               string                                `yaml:"-"`
+	CoreDNSBackUpConf     string                                `yaml:"-"`
+	RunMode               common.RunModeType                    `yaml:"-"`
+	EnableWorkloadApprove bool                                  `yaml:"enableWorkloadApprove,omitempty"`
+	GrantWebhook          *kusciaconfig.WebhookConfig           `yaml:"grantWebhook,omitempty"`
+	CertRenewal           *kusciaconfig.CertRenewalConfig       `yaml:"certRenewal,omitempty"`
+	CertIssuer            *kusciaconfig.CertIssuerConfig        `yaml:"certIssuer,omitempty"`
+	AppImageSync          *kusciaconfig.AppImageSyncConfig      `yaml:"appImageSync,omitempty"`
+	GitOps                *kusciaconfig.GitOpsConfig            `yaml:"gitOps,omitempty"`
+	SecretBackend         *kusciaconfig.SecretBackendConfig     `yaml:"secretBackend,omitempty"`
+	DNS                   *kusciaconfig.DNSConfig               `yaml:"dns,omitempty"`
+	MetricRemoteWrite     *kusciaconfig.MetricRemoteWriteConfig `yaml:"metricRemoteWrite,omitempty"`
+	Alerting              *kusciaconfig.AlertingConfig          `yaml:"alerting,omitempty"`
+	JobArchive            *kusciaconfig.JobArchiveConfig        `yaml:"jobArchive,omitempty"`
+	TaskGC                *kusciaconfig.TaskGCConfig            `yaml:"taskGC,omitempty"`
 }
 
 type CMConfig struct {
@@ -75,8 +88,11 @@ type CMConfig struct {
 }
 
 type DomainRouteConfig struct {
-	ExternalTLS   *kusciaconfig.TLSConfig `yaml:"externalTLS,omitempty"`
-	DomainCsrData string                  `yaml:"-"`
+	ExternalTLS   *kusciaconfig.TLSConfig      `yaml:"externalTLS,omitempty"`
+	TrafficClass  *gwconfig.TrafficClassConfig `yaml:"trafficClass,omitempty"`
+	HTTP3         *gwconfig.HTTP3Config        `yaml:"http3,omitempty"`
+	Proxy         *gwconfig.ProxyConfig        `yaml:"proxy,omitempty"`
+	DomainCsrData string                       `yaml:"-"`
 }
 
 func defaultMaster(rootDir string) KusciaConfig {
@@ -16,6 +16,7 @@ package confloader
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
@@ -34,18 +35,24 @@ import (
 	"github.com/secretflow/kuscia/pkg/agent/config"
 	"github.com/secretflow/kuscia/pkg/common"
 	cmconfig "github.com/secretflow/kuscia/pkg/confmanager/config"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	dmconfig "github.com/secretflow/kuscia/pkg/datamesh/config"
 	kaconfig "github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/datastore"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
 )
 
+const unsealDomainKeyTimeout = 30 * time.Second
+
 type LiteKusciaConfig struct {
 	CommonConfig      `yaml:",inline"`
 	LiteDeployToken   string                      `yaml:"liteDeployToken"`
 	MasterEndpoint    string                      `yaml:"masterEndpoint"`
 	Runtime           string                      `yaml:"runtime"`
 	Runk              RunkConfig                  `yaml:"runk"`
+	Containerd        ContainerdConfig            `yaml:"containerd"`
 	Capacity          config.CapacityCfg          `yaml:"capacity"`
 	ReservedResources config.ReservedResourcesCfg `yaml:"reservedResources"`
 	Image             ImageConfig                 `yaml:"image"`
@@ -54,8 +61,9 @@ type LiteKusciaConfig struct {
 
 type MasterKusciaConfig struct {
 	CommonConfig      `yaml:",inline"`
-	DatastoreEndpoint string `yaml:"datastoreEndpoint"`
-	ClusterToken      string `yaml:"clusterToken,omitempty"`
+	DatastoreEndpoint string               `yaml:"datastoreEndpoint"`
+	DatastoreTLS      *datastore.TLSConfig `yaml:"datastoreTLS,omitempty"`
+	ClusterToken      string               `yaml:"clusterToken,omitempty"`
 	AdvancedConfig    `yaml:",inline"`
 }
 
@@ -63,10 +71,12 @@ type AutonomyKusciaConfig struct {
 	CommonConfig      `yaml:",inline"`
 	Runtime           string                      `yaml:"runtime"`
 	Runk              RunkConfig                  `yaml:"runk"`
+	Containerd        ContainerdConfig            `yaml:"containerd"`
 	Capacity          config.CapacityCfg          `yaml:"capacity"`
 	ReservedResources config.ReservedResourcesCfg `yaml:"reservedResources"`
 	Image             ImageConfig                 `yaml:"image"`
 	DatastoreEndpoint string                      `yaml:"datastoreEndpoint"`
+	DatastoreTLS      *datastore.TLSConfig        `yaml:"datastoreTLS,omitempty"`
 	AdvancedConfig    `yaml:",inline"`
 }
 
@@ -78,6 +88,8 @@ type RunkConfig struct {
 	LogDirectory   string   `yaml:"logDirectory"`
 	LogMaxFiles    int      `yaml:"logMaxFiles"`
 	LogMaxSize     string   `yaml:"logMaxSize"`
+	// SandboxRuntimeClasses overrides the RuntimeClass of the backend k8s for each sandbox type of the app images.
+	SandboxRuntimeClasses map[string]string `yaml:"sandboxRuntimeClasses,omitempty"`
 }
 
 func (runk RunkConfig) overwriteK8sProviderCfg(k8sCfg config.K8sProviderCfg) config.K8sProviderCfg {
@@ -88,9 +100,37 @@ func (runk RunkConfig) overwriteK8sProviderCfg(k8sCfg config.K8sProviderCfg) con
 	k8sCfg.LogDirectory = runk.LogDirectory
 	k8sCfg.LogMaxFiles = runk.LogMaxFiles
 	k8sCfg.LogMaxSize = runk.LogMaxSize
+	if len(runk.SandboxRuntimeClasses) > 0 {
+		classes := map[string]string{}
+		for sandbox, class := range k8sCfg.SandboxRuntimeClasses {
+			classes[sandbox] = class
+		}
+		for sandbox, class := range runk.SandboxRuntimeClasses {
+			classes[sandbox] = class
+		}
+		k8sCfg.SandboxRuntimeClasses = classes
+	}
 	return k8sCfg
 }
 
+// ContainerdConfig is the config of the containerd on the host used by runtime containerd.
+type ContainerdConfig struct {
+	// Endpoint is the CRI endpoint of the containerd, default is unix:///run/containerd/containerd.sock.
+	Endpoint string `yaml:"endpoint"`
+}
+
+func (c ContainerdConfig) overwriteCRIProviderCfg(runtime string, criCfg *config.CRIProviderCfg) {
+	if runtime != config.ContainerdRuntime {
+		return
+	}
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = config.DefaultHostContainerdEndpoint
+	}
+	criCfg.RemoteRuntimeEndpoint = endpoint
+	criCfg.RemoteImageEndpoint = endpoint
+}
+
 type ImageConfig struct {
 	PullPolicy      string          `yaml:"pullPolicy"`
 	DefaultRegistry string          `yaml:"defaultRegistry"`
@@ -111,6 +151,7 @@ type CommonConfig struct {
 	DomainKeyData string          `yaml:"domainKeyData"`
 	LogLevel      string          `yaml:"logLevel"`
 	Protocol      common.Protocol `yaml:"protocol,omitempty"`
+	IPFamily      common.IPFamily `yaml:"ipFamily,omitempty"`
 }
 
 type LogrotateConfig struct {
@@ -129,6 +170,31 @@ type AdvancedConfig struct {
 	DebugPort             int                         `yaml:"debugPort,omitempty"`
 	EnableWorkloadApprove bool                        `yaml:"enableWorkloadApprove,omitempty"`
 	Logrotate             LogrotateConfig             `yaml:"logrotate,omitempty"`
+	// MetricUpdatePeriod is the period in seconds the ss metrics are exported, default 5.
+	MetricUpdatePeriod uint `yaml:"metricUpdatePeriod,omitempty"`
+	// GrantWebhook is notified when a domain data grant to this domain becomes ready.
+	GrantWebhook *kusciaconfig.WebhookConfig `yaml:"grantWebhook,omitempty"`
+	// CertRenewal renews the certs of the domain, routes and gateway listeners ahead of expiration.
+	CertRenewal *kusciaconfig.CertRenewalConfig `yaml:"certRenewal,omitempty"`
+	// CertIssuer is the CA issuing the domain cert and the gateway external listener cert, default self-signed.
+	CertIssuer *kusciaconfig.CertIssuerConfig `yaml:"certIssuer,omitempty"`
+	// AppImageSync syncs the app images from a central registry, only master and autonomy support it.
+	AppImageSync *kusciaconfig.AppImageSyncConfig `yaml:"appImageSync,omitempty"`
+	// GitOps reconciles the domains, routes, app images and data sources from a git repository or an oci artifact,
+	// only master and autonomy support it.
+	GitOps *kusciaconfig.GitOpsConfig `yaml:"gitOps,omitempty"`
+	// SecretBackend is the secret manager sealing the domain key and the confs of ConfManager, default local.
+	SecretBackend *kusciaconfig.SecretBackendConfig `yaml:"secretBackend,omitempty"`
+	// DNS customizes the upstreams, stub domains, host overrides and search domains resolved by the engines.
+	DNS *kusciaconfig.DNSConfig `yaml:"dns,omitempty"`
+	// MetricRemoteWrite pushes the metrics of the domain to a central TSDB, for the domains that can't be scraped.
+	MetricRemoteWrite *kusciaconfig.MetricRemoteWriteConfig `yaml:"metricRemoteWrite,omitempty"`
+	// Alerting evaluates the alert rules and sends the alerts to the notifiers, only master and autonomy support it.
+	Alerting *kusciaconfig.AlertingConfig `yaml:"alerting,omitempty"`
+	// JobArchive archives the finished KusciaJobs to an external store, only master and autonomy support it.
+	JobArchive *kusciaconfig.JobArchiveConfig `yaml:"jobArchive,omitempty"`
+	// TaskGC deletes the resources of the finished KusciaTasks by the ttls, only master and autonomy support it.
+	TaskGC *kusciaconfig.TaskGCConfig `yaml:"taskGC,omitempty"`
 }
 
 func LoadCommonConfig(configFile string) (*CommonConfig, error) {
@@ -160,6 +226,7 @@ func LoadAutonomyConfig(configFile string) (*AutonomyKusciaConfig, error) {
 }
 
 func (lite *LiteKusciaConfig) OverwriteKusciaConfig(kusciaConfig *KusciaConfig) {
+	lite.DomainKeyData = unsealDomainKeyData(lite.SecretBackend, lite.DomainKeyData)
 	kusciaConfig.LogLevel = lite.LogLevel
 	kusciaConfig.DomainID = lite.DomainID
 	kusciaConfig.CAKeyData = lite.DomainKeyData
@@ -168,11 +235,13 @@ func (lite *LiteKusciaConfig) OverwriteKusciaConfig(kusciaConfig *KusciaConfig)
 		kusciaConfig.KusciaAPI = lite.KusciaAPI
 	}
 	kusciaConfig.Protocol = lite.Protocol
+	kusciaConfig.IPFamily = lite.IPFamily
 	kusciaConfig.ConfManager = lite.ConfManager
 	kusciaConfig.DataMesh = lite.DataMesh
 	kusciaConfig.Agent.AllowPrivileged = lite.Agent.AllowPrivileged
 	kusciaConfig.Agent.Provider.Runtime = lite.Runtime
 	kusciaConfig.Agent.Provider.K8s = lite.Runk.overwriteK8sProviderCfg(lite.Agent.Provider.K8s)
+	lite.Containerd.overwriteCRIProviderCfg(lite.Runtime, &kusciaConfig.Agent.Provider.CRI)
 	// overwrite runk log rotate config
 	if kusciaConfig.Agent.Provider.K8s.LogMaxFiles <= 1 {
 		if lite.AdvancedConfig.Logrotate.MaxFiles > 1 {
@@ -211,17 +280,35 @@ func (lite *LiteKusciaConfig) OverwriteKusciaConfig(kusciaConfig *KusciaConfig)
 
 	kusciaConfig.Master.Endpoint = lite.MasterEndpoint
 	kusciaConfig.DomainRoute.DomainCsrData = GenerateCsrData(lite.DomainID, lite.DomainKeyData, lite.LiteDeployToken)
+	if lite.DomainRoute.TrafficClass != nil {
+		kusciaConfig.DomainRoute.TrafficClass = lite.DomainRoute.TrafficClass
+	}
+	if lite.DomainRoute.HTTP3 != nil {
+		kusciaConfig.DomainRoute.HTTP3 = lite.DomainRoute.HTTP3
+	}
+	if lite.DomainRoute.Proxy != nil {
+		kusciaConfig.DomainRoute.Proxy = lite.DomainRoute.Proxy
+	}
 	kusciaConfig.Debug = lite.Debug
 	kusciaConfig.DebugPort = lite.DebugPort
+	kusciaConfig.CertRenewal = lite.AdvancedConfig.CertRenewal
+	kusciaConfig.CertIssuer = lite.AdvancedConfig.CertIssuer
+	kusciaConfig.SecretBackend = lite.AdvancedConfig.SecretBackend
+	kusciaConfig.DNS = lite.AdvancedConfig.DNS
+	kusciaConfig.MetricRemoteWrite = lite.AdvancedConfig.MetricRemoteWrite
 	kusciaConfig.Image = lite.Image
 	kusciaConfig.Image.HTTPProxy = lite.Image.HTTPProxy
 
 	overwriteKusciaConfigLogrotate(&kusciaConfig.Logrotate, &lite.AdvancedConfig.Logrotate)
+	if lite.MetricUpdatePeriod > 0 {
+		kusciaConfig.MetricUpdatePeriod = lite.MetricUpdatePeriod
+	}
 	kusciaConfig.Agent.StdoutGCDuration = time.Duration(kusciaConfig.Logrotate.MaxAgeDays) * 24 * time.Hour
 	overwriteKusciaConfigAgentLogrotate(&kusciaConfig.Agent.Provider.CRI, &lite.Agent.Provider.CRI, &kusciaConfig.Logrotate)
 }
 
 func (master *MasterKusciaConfig) OverwriteKusciaConfig(kusciaConfig *KusciaConfig) {
+	master.DomainKeyData = unsealDomainKeyData(master.SecretBackend, master.DomainKeyData)
 	kusciaConfig.DomainID = master.DomainID
 	kusciaConfig.LogLevel = master.LogLevel
 	kusciaConfig.CAKeyData = master.DomainKeyData
@@ -230,19 +317,45 @@ func (master *MasterKusciaConfig) OverwriteKusciaConfig(kusciaConfig *KusciaConf
 		kusciaConfig.KusciaAPI = master.KusciaAPI
 	}
 	kusciaConfig.Protocol = master.Protocol
+	kusciaConfig.IPFamily = master.IPFamily
 	if master.DomainRoute.ExternalTLS != nil {
 		kusciaConfig.DomainRoute.ExternalTLS = master.DomainRoute.ExternalTLS
 	}
+	if master.DomainRoute.TrafficClass != nil {
+		kusciaConfig.DomainRoute.TrafficClass = master.DomainRoute.TrafficClass
+	}
+	if master.DomainRoute.HTTP3 != nil {
+		kusciaConfig.DomainRoute.HTTP3 = master.DomainRoute.HTTP3
+	}
+	if master.DomainRoute.Proxy != nil {
+		kusciaConfig.DomainRoute.Proxy = master.DomainRoute.Proxy
+	}
 	kusciaConfig.Master.DatastoreEndpoint = master.DatastoreEndpoint
+	kusciaConfig.Master.DatastoreTLS = master.DatastoreTLS
 	kusciaConfig.Master.ClusterToken = master.ClusterToken
 	kusciaConfig.Debug = master.Debug
 	kusciaConfig.DebugPort = master.DebugPort
 	kusciaConfig.EnableWorkloadApprove = master.AdvancedConfig.EnableWorkloadApprove
+	kusciaConfig.GrantWebhook = master.AdvancedConfig.GrantWebhook
+	kusciaConfig.CertRenewal = master.AdvancedConfig.CertRenewal
+	kusciaConfig.CertIssuer = master.AdvancedConfig.CertIssuer
+	kusciaConfig.SecretBackend = master.AdvancedConfig.SecretBackend
+	kusciaConfig.DNS = master.AdvancedConfig.DNS
+	kusciaConfig.MetricRemoteWrite = master.AdvancedConfig.MetricRemoteWrite
+	kusciaConfig.AppImageSync = master.AdvancedConfig.AppImageSync
+	kusciaConfig.GitOps = master.AdvancedConfig.GitOps
+	kusciaConfig.Alerting = master.AdvancedConfig.Alerting
+	kusciaConfig.JobArchive = master.AdvancedConfig.JobArchive
+	kusciaConfig.TaskGC = master.AdvancedConfig.TaskGC
 
 	overwriteKusciaConfigLogrotate(&kusciaConfig.Logrotate, &master.AdvancedConfig.Logrotate)
+	if master.MetricUpdatePeriod > 0 {
+		kusciaConfig.MetricUpdatePeriod = master.MetricUpdatePeriod
+	}
 }
 
 func (autonomy *AutonomyKusciaConfig) OverwriteKusciaConfig(kusciaConfig *KusciaConfig) {
+	autonomy.DomainKeyData = unsealDomainKeyData(autonomy.SecretBackend, autonomy.DomainKeyData)
 	kusciaConfig.LogLevel = autonomy.LogLevel
 	kusciaConfig.DomainID = autonomy.DomainID
 	kusciaConfig.CAKeyData = autonomy.DomainKeyData
@@ -250,6 +363,7 @@ func (autonomy *AutonomyKusciaConfig) OverwriteKusciaConfig(kusciaConfig *Kuscia
 	kusciaConfig.Agent.AllowPrivileged = autonomy.Agent.AllowPrivileged
 	kusciaConfig.Agent.Provider.Runtime = autonomy.Runtime
 	kusciaConfig.Agent.Provider.K8s = autonomy.Runk.overwriteK8sProviderCfg(autonomy.Agent.Provider.K8s)
+	autonomy.Containerd.overwriteCRIProviderCfg(autonomy.Runtime, &kusciaConfig.Agent.Provider.CRI)
 	// overwrite runk log rotate config
 	// maxFile must > 1, maxFileSizeMB must can be parsed
 	if kusciaConfig.Agent.Provider.K8s.LogMaxFiles <= 1 {
@@ -292,19 +406,44 @@ func (autonomy *AutonomyKusciaConfig) OverwriteKusciaConfig(kusciaConfig *Kuscia
 		kusciaConfig.KusciaAPI = autonomy.KusciaAPI
 	}
 	kusciaConfig.Protocol = autonomy.Protocol
+	kusciaConfig.IPFamily = autonomy.IPFamily
 	kusciaConfig.ConfManager = autonomy.ConfManager
 	kusciaConfig.DataMesh = autonomy.DataMesh
 	if autonomy.DomainRoute.ExternalTLS != nil {
 		kusciaConfig.DomainRoute.ExternalTLS = autonomy.DomainRoute.ExternalTLS
 	}
+	if autonomy.DomainRoute.TrafficClass != nil {
+		kusciaConfig.DomainRoute.TrafficClass = autonomy.DomainRoute.TrafficClass
+	}
+	if autonomy.DomainRoute.HTTP3 != nil {
+		kusciaConfig.DomainRoute.HTTP3 = autonomy.DomainRoute.HTTP3
+	}
+	if autonomy.DomainRoute.Proxy != nil {
+		kusciaConfig.DomainRoute.Proxy = autonomy.DomainRoute.Proxy
+	}
 	kusciaConfig.Master.DatastoreEndpoint = autonomy.DatastoreEndpoint
+	kusciaConfig.Master.DatastoreTLS = autonomy.DatastoreTLS
 	kusciaConfig.Debug = autonomy.Debug
 	kusciaConfig.DebugPort = autonomy.DebugPort
 	kusciaConfig.EnableWorkloadApprove = autonomy.AdvancedConfig.EnableWorkloadApprove
+	kusciaConfig.GrantWebhook = autonomy.AdvancedConfig.GrantWebhook
+	kusciaConfig.CertRenewal = autonomy.AdvancedConfig.CertRenewal
+	kusciaConfig.CertIssuer = autonomy.AdvancedConfig.CertIssuer
+	kusciaConfig.SecretBackend = autonomy.AdvancedConfig.SecretBackend
+	kusciaConfig.DNS = autonomy.AdvancedConfig.DNS
+	kusciaConfig.MetricRemoteWrite = autonomy.AdvancedConfig.MetricRemoteWrite
+	kusciaConfig.AppImageSync = autonomy.AdvancedConfig.AppImageSync
+	kusciaConfig.GitOps = autonomy.AdvancedConfig.GitOps
+	kusciaConfig.Alerting = autonomy.AdvancedConfig.Alerting
+	kusciaConfig.JobArchive = autonomy.AdvancedConfig.JobArchive
+	kusciaConfig.TaskGC = autonomy.AdvancedConfig.TaskGC
 	kusciaConfig.Image = autonomy.Image
 	kusciaConfig.Image.HTTPProxy = autonomy.Image.HTTPProxy
 
 	overwriteKusciaConfigLogrotate(&kusciaConfig.Logrotate, &autonomy.AdvancedConfig.Logrotate)
+	if autonomy.MetricUpdatePeriod > 0 {
+		kusciaConfig.MetricUpdatePeriod = autonomy.MetricUpdatePeriod
+	}
 	kusciaConfig.Agent.StdoutGCDuration = time.Duration(kusciaConfig.Logrotate.MaxAgeDays) * 24 * time.Hour
 	overwriteKusciaConfigAgentLogrotate(&kusciaConfig.Agent.Provider.CRI, &autonomy.Agent.Provider.CRI, &kusciaConfig.Logrotate)
 }
@@ -355,6 +494,25 @@ func loadConfig(configFile string, conf interface{}) error {
 	return nil
 }
 
+// unsealDomainKeyData unseals domainKeyData of kuscia.yaml by the secret backend if it's sealed. The sealed
+// plaintext is the PEM encoded domain key, and the unsealed key is base64 encoded as domainKeyData.
+func unsealDomainKeyData(conf *kusciaconfig.SecretBackendConfig, domainKeyData string) string {
+	if conf == nil || !conf.SealedDomainKey || domainKeyData == "" {
+		return domainKeyData
+	}
+	backend, err := secretbackend.NewBackend(conf)
+	if err != nil {
+		nlog.Fatalf("Create secret backend %s error: %v", conf.TypeName(), err.Error())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), unsealDomainKeyTimeout)
+	defer cancel()
+	keyData, err := backend.Unseal(ctx, domainKeyData)
+	if err != nil {
+		nlog.Fatalf("Unseal domain key data by secret backend %s error: %v", conf.TypeName(), err.Error())
+	}
+	return base64.StdEncoding.EncodeToString(keyData)
+}
+
 func GenerateCsrData(domainID, domainKeyData, deployToken string) string {
 	domainKeyDataDecoded, err := base64.StdEncoding.DecodeString(domainKeyData)
 	if err != nil {
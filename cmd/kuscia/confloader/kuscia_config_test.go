@@ -334,3 +334,17 @@ func TestMasterOverwriteKusciaConfig(t *testing.T) {
 	assert.Equal(t, data1, data2)
 
 }
+
+func TestContainerdOverwriteCRIProviderCfg(t *testing.T) {
+	criCfg := config.CRIProviderCfg{RemoteRuntimeEndpoint: "unix:///home/kuscia/containerd/run/containerd.sock"}
+	ContainerdConfig{Endpoint: "unix:///tmp/containerd.sock"}.overwriteCRIProviderCfg(config.ContainerRuntime, &criCfg)
+	assert.Equal(t, "unix:///home/kuscia/containerd/run/containerd.sock", criCfg.RemoteRuntimeEndpoint)
+
+	ContainerdConfig{}.overwriteCRIProviderCfg(config.ContainerdRuntime, &criCfg)
+	assert.Equal(t, config.DefaultHostContainerdEndpoint, criCfg.RemoteRuntimeEndpoint)
+	assert.Equal(t, config.DefaultHostContainerdEndpoint, criCfg.RemoteImageEndpoint)
+
+	ContainerdConfig{Endpoint: "unix:///tmp/containerd.sock"}.overwriteCRIProviderCfg(config.ContainerdRuntime, &criCfg)
+	assert.Equal(t, "unix:///tmp/containerd.sock", criCfg.RemoteRuntimeEndpoint)
+	assert.Equal(t, "unix:///tmp/containerd.sock", criCfg.RemoteImageEndpoint)
+}
@@ -37,7 +37,7 @@ func NewContainerCommand(ctx context.Context) *cobra.Command {
 	}
 
 	command.PersistentFlags().StringVar(&storageDir, "store", config.DefaultImageStoreDir(), "kuscia image storage directory")
-	command.PersistentFlags().StringVar(&runtimeType, "runtime", "", "kuscia runtime type: runp/runc")
+	command.PersistentFlags().StringVar(&runtimeType, "runtime", "", "kuscia runtime type: runp/runc/containerd")
 
 	command.AddCommand(runCommand(imageCtx))
 
@@ -16,19 +16,27 @@ package diagnose
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	"github.com/secretflow/kuscia/pkg/diagnose/app/client"
 	"github.com/secretflow/kuscia/pkg/diagnose/app/netstat"
 	"github.com/secretflow/kuscia/pkg/diagnose/mods"
 	util "github.com/secretflow/kuscia/pkg/diagnose/utils"
+	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 )
 
 const (
 	SubCMDClusterDomainRoute = "cdr"
 	SubCMDNetwork            = "network"
+	SubCMDJob                = "job"
 )
 
 func NewDiagnoseCommand(ctx context.Context) *cobra.Command {
@@ -39,6 +47,7 @@ func NewDiagnoseCommand(ctx context.Context) *cobra.Command {
 	}
 	cmd.AddCommand(NewCDRCommand(ctx))
 	cmd.AddCommand(NewNeworkCommand(ctx))
+	cmd.AddCommand(NewJobCommand(ctx))
 	return cmd
 }
 
@@ -66,6 +75,10 @@ func NewCDRCommand(ctx context.Context) *cobra.Command {
 	cmd.Flags().IntVar(&param.RTTTres, "rtt-threshold", netstat.DefaultRTTThreshold, "RTT threshold, unit ms")
 	cmd.Flags().IntVar(&param.ProxyTimeoutThres, "proxy-timeout-threshold", netstat.DefaultProxyTimeoutThreshold, "Proxy timeout threshold, unit ms")
 	cmd.Flags().IntVar(&param.SizeThres, "request-size-threshold", netstat.DefaultRequestBodySizeThreshold, "Request size threshold, unit MB")
+	cmd.Flags().BoolVar(&param.RTTPercentile, "rtt-percentile", true, "Enable latency percentile test")
+	cmd.Flags().IntVar(&param.P99RTTThres, "p99-rtt-threshold", netstat.DefaultP99RTTThreshold, "P99 RTT threshold, unit ms")
+	cmd.Flags().BoolVar(&param.PathMTU, "mtu", true, "Enable path MTU test")
+	cmd.Flags().BoolVar(&param.TLSRenegotiation, "tls-renegotiation", true, "Enable TLS renegotiation test")
 
 	return cmd
 }
@@ -95,11 +108,54 @@ func NewNeworkCommand(ctx context.Context) *cobra.Command {
 	cmd.Flags().IntVar(&param.RTTTres, "rtt-threshold", netstat.DefaultRTTThreshold, "RTT threshold, unit ms")
 	cmd.Flags().IntVar(&param.ProxyTimeoutThres, "proxy-timeout-threshold", netstat.DefaultProxyTimeoutThreshold, "Proxy timeout threshold, unit ms")
 	cmd.Flags().IntVar(&param.SizeThres, "request-size-threshold", netstat.DefaultRequestBodySizeThreshold, "Request size threshold, unit MB")
+	cmd.Flags().BoolVar(&param.RTTPercentile, "rtt-percentile", true, "Enable latency percentile test")
+	cmd.Flags().IntVar(&param.P99RTTThres, "p99-rtt-threshold", netstat.DefaultP99RTTThreshold, "P99 RTT threshold, unit ms")
+	cmd.Flags().BoolVar(&param.PathMTU, "mtu", true, "Enable path MTU test")
+	cmd.Flags().BoolVar(&param.TLSRenegotiation, "tls-renegotiation", true, "Enable TLS renegotiation test")
 
 	cmd.Flags().BoolVarP(&param.Bidirection, "bidirection", "b", true, "Execute bidirection test")
 	return cmd
 }
 
+func NewJobCommand(ctx context.Context) *cobra.Command {
+	var jobFile, kubeconfigFile string
+	cmd := &cobra.Command{
+		Use:          SubCMDJob,
+		Short:        "Dry-run a KusciaJob to check whether it could run on all parties before it is submitted",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunJobCommand(ctx, jobFile, kubeconfigFile)
+		},
+	}
+	cmd.Flags().StringVarP(&jobFile, "file", "f", "", "Path of the KusciaJob yaml file")
+	cmd.Flags().StringVar(&kubeconfigFile, "kubeconfig", filepath.Join(common.DefaultKusciaHomePath(), "etc/kubeconfig"), "Path of the kubeconfig file")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func RunJobCommand(ctx context.Context, jobFile, kubeconfigFile string) error {
+	content, err := os.ReadFile(jobFile)
+	if err != nil {
+		return err
+	}
+	job := &v1alpha1.KusciaJob{}
+	if err = yaml.Unmarshal(content, job); err != nil {
+		return fmt.Errorf("parse job file %s failed, %v", jobFile, err)
+	}
+	clients, err := kubeconfig.CreateClientSetsFromKubeconfig(kubeconfigFile, "")
+	if err != nil {
+		return err
+	}
+
+	reporter := util.NewReporter("")
+	defer func() {
+		reporter.Render()
+		reporter.Close()
+	}()
+	return mods.NewJobMod(reporter, clients.KubeClient, clients.KusciaClient, job).Run(ctx)
+}
+
 func RunToolCommand(ctx context.Context, config *mods.DiagnoseConfig) error {
 	nlog.Infof("Diagnose Config:\n%v", config)
 	// mod init
@@ -0,0 +1,119 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
+	"github.com/secretflow/kuscia/pkg/utils/resourcediff"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+
+	// the exit codes follow kubectl diff
+	exitCodeDiff  = 1
+	exitCodeError = 2
+)
+
+type diffOptions struct {
+	filenames  []string
+	kubeconfig string
+	output     string
+}
+
+func NewDiffCommand(ctx context.Context) *cobra.Command {
+	opts := &diffOptions{}
+	cmd := &cobra.Command{
+		Use:   "diff -f FILENAME",
+		Short: "Diff the AppImage, KusciaJob, DomainRoute and ClusterDomainRoute manifests against the live objects",
+		Long: `Diff the AppImage, KusciaJob, DomainRoute and ClusterDomainRoute manifests against the live objects.
+The defaults of the crds and the fields filled by the controllers are ignored unless the manifests set them.
+Exit status: 0 no differences were found, 1 differences were found, 2 diff failed.`,
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			hasDiff, err := runDiff(ctx, opts, cmd.InOrStdin(), cmd.OutOrStdout())
+			if err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), err)
+				os.Exit(exitCodeError)
+			}
+			if hasDiff {
+				os.Exit(exitCodeDiff)
+			}
+		},
+	}
+	cmd.Flags().StringSliceVarP(&opts.filenames, "filename", "f", nil, "Manifest files to diff, - means stdin")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", filepath.Join(common.DefaultKusciaHomePath(), "etc/kubeconfig"), "Path of the kubeconfig file")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", outputText, "Output format: text/json")
+	_ = cmd.MarkFlagRequired("filename")
+	return cmd
+}
+
+func runDiff(ctx context.Context, opts *diffOptions, stdin io.Reader, stdout io.Writer) (bool, error) {
+	if opts.output != outputText && opts.output != outputJSON {
+		return false, fmt.Errorf("unsupported output format %q, must be %s or %s", opts.output, outputText, outputJSON)
+	}
+
+	clients, err := kubeconfig.CreateClientSetsFromKubeconfig(opts.kubeconfig, "")
+	if err != nil {
+		return false, err
+	}
+	differ := resourcediff.NewDiffer(clients.KusciaClient)
+
+	var results []*resourcediff.Result
+	hasDiff := false
+	for _, filename := range opts.filenames {
+		data, err := readManifest(filename, stdin)
+		if err != nil {
+			return false, err
+		}
+		objs, err := resourcediff.ParseManifests(data)
+		if err != nil {
+			return false, fmt.Errorf("parse %s failed, %v", filename, err)
+		}
+		for _, obj := range objs {
+			result, err := differ.Diff(ctx, obj)
+			if err != nil {
+				return false, err
+			}
+			hasDiff = hasDiff || result.HasDiff()
+			results = append(results, result)
+		}
+	}
+
+	if opts.output == outputJSON {
+		err = resourcediff.WriteJSON(stdout, results)
+	} else {
+		err = resourcediff.WriteText(stdout, results)
+	}
+	return hasDiff, err
+}
+
+func readManifest(filename string, stdin io.Reader) ([]byte, error) {
+	if filename == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(filename)
+}
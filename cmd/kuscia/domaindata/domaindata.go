@@ -0,0 +1,258 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domaindata
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/secretflow/kuscia/pkg/datactl"
+	"github.com/secretflow/kuscia/pkg/diagnose/app/client"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func NewDomainDataCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "domaindata",
+		Aliases:      []string{"dd"},
+		Short:        "Manage DomainData through KusciaAPI",
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(newCreateDomainDataCommand(ctx))
+	cmd.AddCommand(newListDomainDataCommand(ctx))
+	cmd.AddCommand(newDescribeDomainDataCommand(ctx))
+	cmd.AddCommand(newGrantCommand(ctx))
+	return cmd
+}
+
+func NewDomainDataGrantCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "domaindatagrant",
+		Aliases:      []string{"ddg"},
+		Short:        "Manage DomainDataGrant through KusciaAPI",
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(newCreateGrantCommand(ctx))
+	cmd.AddCommand(newListGrantCommand(ctx))
+	cmd.AddCommand(newDescribeGrantCommand(ctx))
+	return cmd
+}
+
+// runWithClient validates the output format, connects KusciaAPI and runs fn.
+func runWithClient(format string, fn func(c *datactl.Client) error) error {
+	if err := datactl.ValidateFormat(format); err != nil {
+		return err
+	}
+	conn, err := client.NewKusciaAPIConn()
+	if err != nil {
+		return fmt.Errorf("init kuscia api conn failed, %v", err)
+	}
+	defer conn.Close()
+	return fn(datactl.NewClient(conn))
+}
+
+func addDomainFlag(cmd *cobra.Command, domainID *string) {
+	cmd.Flags().StringVarP(domainID, "domain", "d", "", "Domain id the resources belong to")
+	_ = cmd.MarkFlagRequired("domain")
+}
+
+func addOutputFlag(cmd *cobra.Command, format *string) {
+	cmd.Flags().StringVarP(format, "output", "o", datactl.FormatTable, "Output format, one of table, json, yaml")
+}
+
+func newCreateDomainDataCommand(ctx context.Context) *cobra.Command {
+	var file, domainID string
+	cmd := &cobra.Command{
+		Use:          "create",
+		Short:        "Create a DomainData from a YAML or JSON file",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := &kusciaapi.CreateDomainDataRequest{}
+			if err := datactl.LoadRequest(file, request); err != nil {
+				return err
+			}
+			if domainID != "" {
+				request.DomainId = domainID
+			}
+			return runWithClient(datactl.FormatTable, func(c *datactl.Client) error {
+				id, err := c.CreateDomainData(ctx, request)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("domaindata %s/%s created\n", request.DomainId, id)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "CreateDomainData request in YAML or JSON")
+	cmd.Flags().StringVarP(&domainID, "domain", "d", "", "Override the domain id in the file")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func newListDomainDataCommand(ctx context.Context) *cobra.Command {
+	var domainID, dataType, vendor, format string
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List the DomainData of a domain",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithClient(format, func(c *datactl.Client) error {
+				list, err := c.ListDomainData(ctx, domainID, dataType, vendor)
+				if err != nil {
+					return err
+				}
+				return datactl.PrintDomainDataList(os.Stdout, format, list)
+			})
+		},
+	}
+	addDomainFlag(cmd, &domainID)
+	addOutputFlag(cmd, &format)
+	cmd.Flags().StringVar(&dataType, "type", "", "Filter by type, e.g. table, model")
+	cmd.Flags().StringVar(&vendor, "vendor", "", "Filter by vendor, e.g. manual, secretflow")
+	return cmd
+}
+
+func newDescribeDomainDataCommand(ctx context.Context) *cobra.Command {
+	var domainID, format string
+	cmd := &cobra.Command{
+		Use:          "describe DOMAINDATA_ID",
+		Short:        "Show the details of a DomainData",
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithClient(format, func(c *datactl.Client) error {
+				data, err := c.QueryDomainData(ctx, domainID, args[0])
+				if err != nil {
+					return err
+				}
+				return datactl.PrintDomainData(os.Stdout, format, data)
+			})
+		},
+	}
+	addDomainFlag(cmd, &domainID)
+	addOutputFlag(cmd, &format)
+	return cmd
+}
+
+func newGrantCommand(ctx context.Context) *cobra.Command {
+	opts := &datactl.GrantOptions{}
+	cmd := &cobra.Command{
+		Use:          "grant DOMAINDATA_ID",
+		Short:        "Grant a DomainData to a partner domain",
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.DomainDataID = args[0]
+			return runWithClient(datactl.FormatTable, func(c *datactl.Client) error {
+				id, err := c.Grant(ctx, opts)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("domaindatagrant %s/%s created, %s is granted to %s\n", opts.DomainID, id, opts.DomainDataID, opts.GrantDomain)
+				return nil
+			})
+		},
+	}
+	addDomainFlag(cmd, &opts.DomainID)
+	cmd.Flags().StringVar(&opts.GrantDomain, "to", "", "Partner domain the DomainData is granted to")
+	cmd.Flags().StringVar(&opts.GrantID, "grant-id", "", "Id of the DomainDataGrant, generated by KusciaAPI if empty")
+	cmd.Flags().DurationVar(&opts.Expire, "expire", 0, "Duration the grant is valid for, e.g. 24h, 0 means never expire")
+	cmd.Flags().Int32Var(&opts.UseCount, "use-count", 0, "Max times the partner can use the DomainData, 0 means unlimited")
+	cmd.Flags().StringSliceVar(&opts.Components, "components", nil, "Components allowed to use the DomainData")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+func newCreateGrantCommand(ctx context.Context) *cobra.Command {
+	var file, domainID string
+	cmd := &cobra.Command{
+		Use:          "create",
+		Short:        "Create a DomainDataGrant from a YAML or JSON file",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := &kusciaapi.CreateDomainDataGrantRequest{}
+			if err := datactl.LoadRequest(file, request); err != nil {
+				return err
+			}
+			if domainID != "" {
+				request.DomainId = domainID
+			}
+			return runWithClient(datactl.FormatTable, func(c *datactl.Client) error {
+				id, err := c.CreateGrant(ctx, request)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("domaindatagrant %s/%s created\n", request.DomainId, id)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "CreateDomainDataGrant request in YAML or JSON")
+	cmd.Flags().StringVarP(&domainID, "domain", "d", "", "Override the domain id in the file")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func newListGrantCommand(ctx context.Context) *cobra.Command {
+	var domainID, grantDomain, format string
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List the DomainDataGrants of a domain",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithClient(format, func(c *datactl.Client) error {
+				list, err := c.ListGrants(ctx, domainID, grantDomain)
+				if err != nil {
+					return err
+				}
+				return datactl.PrintGrantList(os.Stdout, format, list)
+			})
+		},
+	}
+	addDomainFlag(cmd, &domainID)
+	addOutputFlag(cmd, &format)
+	cmd.Flags().StringVar(&grantDomain, "grant-domain", "", "Filter by the partner domain")
+	return cmd
+}
+
+func newDescribeGrantCommand(ctx context.Context) *cobra.Command {
+	var domainID, format string
+	cmd := &cobra.Command{
+		Use:          "describe GRANT_ID",
+		Short:        "Show the details and use records of a DomainDataGrant",
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithClient(format, func(c *datactl.Client) error {
+				grant, err := c.QueryGrant(ctx, domainID, args[0])
+				if err != nil {
+					return err
+				}
+				return datactl.PrintGrant(os.Stdout, format, grant)
+			})
+		},
+	}
+	addDomainFlag(cmd, &domainID)
+	addOutputFlag(cmd, &format)
+	return cmd
+}
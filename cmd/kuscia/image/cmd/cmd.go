@@ -28,4 +28,5 @@ func InstallCommands(rootCmd *cobra.Command, cmdCtx *utils.ImageContext) {
 	rootCmd.AddCommand(tagCommand(cmdCtx))
 	rootCmd.AddCommand(mountCommand(cmdCtx))
 	rootCmd.AddCommand(rmCommand(cmdCtx))
+	rootCmd.AddCommand(scaffoldCommand(cmdCtx))
 }
@@ -0,0 +1,163 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	oci "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/secretflow/kuscia/cmd/kuscia/utils"
+	"github.com/secretflow/kuscia/pkg/agent/local/store/kii"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/appimage"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+// scaffoldCommand generates an AppImage from a dockerfile or an image
+func scaffoldCommand(cmdCtx *utils.ImageContext) *cobra.Command {
+	var (
+		creds        string
+		dockerfile   string
+		templateFile string
+		output       string
+		portNames    []string
+		skipBase     bool
+	)
+	opts := &appimage.Options{}
+	var portProtocol, portScope string
+
+	scaffoldCmd := &cobra.Command{
+		Use:                   "scaffold image [OPTIONS]",
+		Short:                 "Generate an AppImage from a dockerfile or an image",
+		Args:                  cobra.ExactArgs(1),
+		DisableFlagsInUseLine: true,
+		Example: `
+# generate an AppImage from an image, the image is pulled if it doesn't exist locally
+kuscia image scaffold secretflow/my-engine:0.1.0
+
+# generate an AppImage from the dockerfile that builds the image, the base image is pulled for the config it passes on
+kuscia image scaffold --dockerfile ./Dockerfile secretflow/my-engine:0.1.0
+
+# generate an AppImage from the dockerfile alone, e.g. outside a kuscia node
+kuscia image scaffold --dockerfile ./Dockerfile --skip-base secretflow/my-engine:0.1.0
+
+# name the exposed ports and render with a custom template
+kuscia image scaffold --port-name 8080=http --port-name 9090=grpc --template ./appimage.tmpl -o my-engine.yaml secretflow/my-engine:0.1.0
+
+`,
+		// overrides the image command's hook, so that a dockerfile can be used outside a kuscia node
+		PersistentPreRun: func(c *cobra.Command, args []string) {
+			if dockerfile == "" || !skipBase {
+				runtimeType, _ := c.Flags().GetString("runtime")
+				storageDir, _ := c.Flags().GetString("store")
+				cmdCtx.ImageService = utils.NewImageService(runtimeType, storageDir, args, c)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			image, err := kii.NewImageName(args[0])
+			if err != nil {
+				nlog.Fatal(err.Error())
+			}
+
+			var imageID string
+			var config *oci.ImageConfig
+			if dockerfile != "" {
+				df, err := appimage.ParseDockerfileFile(dockerfile)
+				if err != nil {
+					nlog.Fatalf("Parse dockerfile %s failed: %s", dockerfile, err.Error())
+				}
+				config = &df.Config
+				switch {
+				case df.BaseImage == "scratch":
+				case skipBase:
+					nlog.Infof("Config inherited from the base image %s is ignored, please check the generated AppImage", df.BaseImage)
+				default:
+					_, baseConfig, err := cmdCtx.ImageService.ImageConfig(df.BaseImage, creds)
+					if err != nil {
+						nlog.Fatalf("Read config of the base image %s failed: %s", df.BaseImage, err.Error())
+					}
+					if config, err = df.ImageConfig(baseConfig); err != nil {
+						nlog.Fatal(err.Error())
+					}
+				}
+			} else {
+				if imageID, config, err = cmdCtx.ImageService.ImageConfig(args[0], creds); err != nil {
+					nlog.Fatal(err.Error())
+				}
+			}
+
+			if templateFile != "" {
+				content, err := os.ReadFile(templateFile)
+				if err != nil {
+					nlog.Fatal(err.Error())
+				}
+				opts.Template = string(content)
+			}
+			opts.PortProtocol = v1alpha1.PortProtocol(strings.ToUpper(portProtocol))
+			opts.PortScope = v1alpha1.PortScope(portScope)
+			if opts.PortNames, err = parsePortNames(portNames); err != nil {
+				nlog.Fatal(err.Error())
+			}
+
+			appImage, err := appimage.Scaffold(image, imageID, config, opts)
+			if err != nil {
+				nlog.Fatal(err.Error())
+			}
+			content, err := yaml.Marshal(appImage)
+			if err != nil {
+				nlog.Fatal(err.Error())
+			}
+			if output == "" {
+				fmt.Print(string(content))
+				return
+			}
+			if err := os.WriteFile(output, content, 0644); err != nil {
+				nlog.Fatal(err.Error())
+			}
+		},
+	}
+
+	scaffoldCmd.Flags().StringVarP(&creds, "creds", "c", "", "credentials of the registry")
+	scaffoldCmd.Flags().StringVarP(&dockerfile, "dockerfile", "f", "", "read the image config from the dockerfile instead of the image")
+	scaffoldCmd.Flags().BoolVar(&skipBase, "skip-base", false, "don't read the config of the base image of the dockerfile")
+	scaffoldCmd.Flags().StringVarP(&templateFile, "template", "t", "", "go template file used to render the AppImage")
+	scaffoldCmd.Flags().StringVarP(&output, "output", "o", "", "output file, default is stdout")
+	scaffoldCmd.Flags().StringVar(&opts.Name, "name", "", "name of the AppImage, default is derived from the image")
+	scaffoldCmd.Flags().StringVar(&opts.Role, "role", "", "role of the deploy template")
+	scaffoldCmd.Flags().Int32Var(&opts.Replicas, "replicas", 1, "replicas of the deploy template")
+	scaffoldCmd.Flags().StringVar(&portProtocol, "port-protocol", string(v1alpha1.ProtocolHTTP), "protocol of the exposed ports: HTTP/GRPC")
+	scaffoldCmd.Flags().StringVar(&portScope, "port-scope", string(v1alpha1.ScopeCluster), "scope of the exposed ports: Cluster/Domain/Local")
+	scaffoldCmd.Flags().StringArrayVar(&portNames, "port-name", nil, "name of an exposed port, in port=name format")
+	return scaffoldCmd
+}
+
+func parsePortNames(values []string) (map[int32]string, error) {
+	names := map[int32]string{}
+	for _, value := range values {
+		port, name, ok := strings.Cut(value, "=")
+		n, err := strconv.ParseInt(port, 10, 32)
+		if !ok || err != nil || name == "" {
+			return nil, fmt.Errorf("port name %q must be port=name format", value)
+		}
+		names[int32(n)] = name
+	}
+	return names, nil
+}
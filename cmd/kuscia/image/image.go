@@ -39,7 +39,7 @@ func NewImageCommand(ctx context.Context) *cobra.Command {
 	}
 
 	command.PersistentFlags().StringVar(&storageDir, "store", config.DefaultImageStoreDir(), "kuscia image storage directory")
-	command.PersistentFlags().StringVar(&runtimeType, "runtime", "", "kuscia runtime type: runp/runc")
+	command.PersistentFlags().StringVar(&runtimeType, "runtime", "", "kuscia runtime type: runp/runc/containerd")
 
 	cmd.InstallCommands(command, imageCtx)
 
@@ -0,0 +1,67 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	interconnkuscia "github.com/secretflow/kuscia/pkg/interconn/kuscia"
+	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
+)
+
+type interopOptions struct {
+	kubeconfig string
+}
+
+func NewInteropCommand(ctx context.Context) *cobra.Command {
+	opts := &interopOptions{}
+	cmd := &cobra.Command{
+		Use:          "interop",
+		Short:        "Manage the interop configs of the interconnection",
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().StringVar(&opts.kubeconfig, "kubeconfig", filepath.Join(common.DefaultKusciaHomePath(), "etc/kubeconfig"), "Path of the kubeconfig file")
+	cmd.AddCommand(newForceSyncCommand(ctx, opts))
+	return cmd
+}
+
+func newForceSyncCommand(ctx context.Context, opts *interopOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "force-sync NAME",
+		Short: "Apply the current spec of an interop config even if it conflicts",
+		Long: `Apply the current spec of an interop config even if it conflicts.
+The interconn controller holds the spec of an interop config which reverts to a version applied recently, as the
+writers of it may be fighting over it. Resolve the conflict between the writers, then force sync the spec to apply.`,
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clients, err := kubeconfig.CreateClientSetsFromKubeconfig(opts.kubeconfig, "")
+			if err != nil {
+				return err
+			}
+			token, err := interconnkuscia.ForceSyncInteropConfig(ctx, clients.KusciaClient, args[0])
+			if err != nil {
+				return fmt.Errorf("force sync interop config %s failed, %v", args[0], err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Interop config %s is force synced, token %s\n", args[0], token)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/secretflow/kuscia/pkg/diagnose/app/client"
+	"github.com/secretflow/kuscia/pkg/jobctl"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func NewJobCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "job",
+		Short:        "Manage jobs through KusciaAPI",
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(newSubmitCommand(ctx))
+	cmd.AddCommand(newWatchCommand(ctx))
+	cmd.AddCommand(newApproveCommand(ctx, true))
+	cmd.AddCommand(newApproveCommand(ctx, false))
+	cmd.AddCommand(newCancelCommand(ctx))
+	cmd.AddCommand(newLogsCommand(ctx))
+	return cmd
+}
+
+func newClient() (*jobctl.Client, func(), error) {
+	conn, err := client.NewKusciaAPIConn()
+	if err != nil {
+		return nil, nil, fmt.Errorf("init kuscia api conn failed, %v", err)
+	}
+	return jobctl.NewClient(conn), func() { _ = conn.Close() }, nil
+}
+
+func newSubmitCommand(ctx context.Context) *cobra.Command {
+	var file, jobID string
+	var watch bool
+	var interval time.Duration
+	cmd := &cobra.Command{
+		Use:          "submit",
+		Short:        "Submit a job from a YAML or JSON DAG file",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request, err := jobctl.LoadCreateJobRequest(file)
+			if err != nil {
+				return err
+			}
+			if jobID != "" {
+				request.JobId = jobID
+			}
+			c, closeFn, err := newClient()
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			id, err := c.Submit(ctx, request)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("job %s submitted\n", id)
+			if !watch {
+				return nil
+			}
+			return c.Watch(ctx, id, interval, os.Stdout)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Job DAG file in YAML or JSON")
+	cmd.Flags().StringVar(&jobID, "job-id", "", "Override the job id in the file")
+	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "Watch the job until it finishes after submitting")
+	cmd.Flags().DurationVar(&interval, "interval", jobctl.DefaultWatchInterval, "Interval between two status refreshes")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func newWatchCommand(ctx context.Context) *cobra.Command {
+	var interval time.Duration
+	cmd := &cobra.Command{
+		Use:          "watch JOB_ID",
+		Short:        "Watch the per-task status of a job until it finishes",
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, closeFn, err := newClient()
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			return c.Watch(ctx, args[0], interval, os.Stdout)
+		},
+	}
+	cmd.Flags().DurationVar(&interval, "interval", jobctl.DefaultWatchInterval, "Interval between two status refreshes")
+	return cmd
+}
+
+func newApproveCommand(ctx context.Context, accept bool) *cobra.Command {
+	var reason string
+	use, short := "approve JOB_ID", "Approve a job awaiting the approval of the local domain"
+	if !accept {
+		use, short = "reject JOB_ID", "Reject a job awaiting the approval of the local domain"
+	}
+	cmd := &cobra.Command{
+		Use:          use,
+		Short:        short,
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, closeFn, err := newClient()
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			if err := c.Approve(ctx, args[0], accept, reason); err != nil {
+				return err
+			}
+			if accept {
+				fmt.Printf("job %s approved\n", args[0])
+			} else {
+				fmt.Printf("job %s rejected\n", args[0])
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason of the approval result")
+	return cmd
+}
+
+func newCancelCommand(ctx context.Context) *cobra.Command {
+	var reason string
+	cmd := &cobra.Command{
+		Use:          "cancel JOB_ID",
+		Short:        "Cancel a job, a cancelled job can't be restarted",
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, closeFn, err := newClient()
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			if err := c.Cancel(ctx, args[0], reason); err != nil {
+				return err
+			}
+			fmt.Printf("job %s cancelled\n", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason of the cancellation")
+	return cmd
+}
+
+func newLogsCommand(ctx context.Context) *cobra.Command {
+	request := &kusciaapi.TailTaskLogRequest{}
+	cmd := &cobra.Command{
+		Use:          "logs TASK_ID",
+		Short:        "Print the log of a task",
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request.TaskId = args[0]
+			c, closeFn, err := newClient()
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			return c.Logs(ctx, request, os.Stdout)
+		},
+	}
+	cmd.Flags().Int32Var(&request.ReplicaIdx, "replica", 0, "Replica index of the task pod")
+	cmd.Flags().StringVarP(&request.Container, "container", "c", "", "Container name, defaults to the only container of the pod")
+	cmd.Flags().Int64Var(&request.TailLines, "tail", 0, "Number of lines from the end of the log to show, 0 means all")
+	cmd.Flags().BoolVarP(&request.Follow, "follow", "f", false, "Keep streaming the log until the container exits")
+	cmd.Flags().BoolVar(&request.Local, "local", false, "Read the log on the local node without locating the pod")
+	return cmd
+}
@@ -53,7 +53,7 @@ func NewInitCommand(ctx context.Context) *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&config.Mode, "mode", "", "", "Deploy Domain mode (Master, Lite, Autonomy), case insensitive")
 	cmd.Flags().StringVarP(&config.DomainID, "domain", "d", "", "Domain ID, must follow DNS subdomain rules")
-	cmd.Flags().StringVarP(&config.Runtime, "runtime", "r", "runc", "Domain runtime (runc, runk, runp), default runc")
+	cmd.Flags().StringVarP(&config.Runtime, "runtime", "r", "runc", "Domain runtime (runc, runk, runp, containerd), default runc")
 	cmd.Flags().StringVarP(&config.DomainKeyFile, "domain-key-file", "f", "", "Load domain RSA private key file, none generate domain RSA key data")
 	cmd.Flags().StringVarP(&config.LogLevel, "log-level", "l", "INFO", "Logging level (INFO, DEBUG, WARN) default INFO")
 	cmd.Flags().StringVarP(&config.LiteDeployToken, "lite-deploy-token", "t", "", "The deploy token used by the lite connecting to the master")
@@ -193,7 +193,7 @@ func loadDomainKeyData(domainKeyFile string) (string, error) {
 
 func isSupportedRuntime(runtime string) bool {
 	switch runtime {
-	case config.ContainerRuntime, config.K8sRuntime, config.ProcessRuntime:
+	case config.ContainerRuntime, config.K8sRuntime, config.ProcessRuntime, config.ContainerdRuntime:
 		return true
 	default:
 		return false
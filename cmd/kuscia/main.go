@@ -59,10 +59,17 @@ import (
 	"github.com/spf13/pflag"
 	kubectlcmd "k8s.io/kubectl/pkg/cmd"
 
+	"github.com/secretflow/kuscia/cmd/kuscia/backup"
 	"github.com/secretflow/kuscia/cmd/kuscia/container"
+	"github.com/secretflow/kuscia/cmd/kuscia/datastore"
 	"github.com/secretflow/kuscia/cmd/kuscia/diagnose"
+	"github.com/secretflow/kuscia/cmd/kuscia/diff"
+	"github.com/secretflow/kuscia/cmd/kuscia/domaindata"
 	"github.com/secretflow/kuscia/cmd/kuscia/image"
+	"github.com/secretflow/kuscia/cmd/kuscia/interop"
+	"github.com/secretflow/kuscia/cmd/kuscia/job"
 	"github.com/secretflow/kuscia/cmd/kuscia/kusciainit"
+	"github.com/secretflow/kuscia/cmd/kuscia/migrate"
 	"github.com/secretflow/kuscia/cmd/kuscia/start"
 	_ "github.com/secretflow/kuscia/pkg/agent/middleware/plugins"
 	"github.com/secretflow/kuscia/pkg/utils/meta"
@@ -86,6 +93,14 @@ func main() {
 	rootCmd.AddCommand(container.NewContainerCommand(ctx))
 	rootCmd.AddCommand(start.NewStartCommand(ctx))
 	rootCmd.AddCommand(diagnose.NewDiagnoseCommand(ctx))
+	rootCmd.AddCommand(job.NewJobCommand(ctx))
+	rootCmd.AddCommand(domaindata.NewDomainDataCommand(ctx))
+	rootCmd.AddCommand(domaindata.NewDomainDataGrantCommand(ctx))
+	rootCmd.AddCommand(diff.NewDiffCommand(ctx))
+	rootCmd.AddCommand(backup.NewBackupCommand(ctx))
+	rootCmd.AddCommand(datastore.NewDatastoreCommand(ctx))
+	rootCmd.AddCommand(migrate.NewMigrateCommand(ctx))
+	rootCmd.AddCommand(interop.NewInteropCommand(ctx))
 	rootCmd.AddCommand(kusciainit.NewInitCommand(ctx))
 	rootCmd.AddCommand(kubectlcmd.NewDefaultKubectlCommand())
 	rootCmd.AddCommand(NewKernelCheckCommand(ctx))
@@ -0,0 +1,111 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/migration"
+	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+type migrateOptions struct {
+	kubeconfig string
+	dryRun     bool
+	output     string
+}
+
+func NewMigrateCommand(ctx context.Context) *cobra.Command {
+	opts := &migrateOptions{}
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the Kuscia custom resources into the schema of the current version",
+		Long: `Migrate the Kuscia custom resources into the schema of the current version.
+The fields renamed or defaulted by the new version are migrated, and the objects stored in an old version of the crds
+are rewritten in the storage version. The controller manager runs the migration once after an upgrade as well,
+run it manually to preview the changes with --dry-run or to retry the failed objects.`,
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrate(ctx, opts, cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", filepath.Join(common.DefaultKusciaHomePath(), "etc/kubeconfig"), "Path of the kubeconfig file")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Only report the objects to migrate without updating them")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", outputText, "Output format: text/json")
+	return cmd
+}
+
+func runMigrate(ctx context.Context, opts *migrateOptions, stdout io.Writer) error {
+	if opts.output != outputText && opts.output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, must be %s or %s", opts.output, outputText, outputJSON)
+	}
+	clients, err := kubeconfig.CreateClientSetsFromKubeconfig(opts.kubeconfig, "")
+	if err != nil {
+		return err
+	}
+
+	summary, err := migration.NewMigrator(clients.DynamicClient, clients.ExtensionsClient, migration.Options{DryRun: opts.dryRun}).Run(ctx)
+	if err != nil {
+		return err
+	}
+	if opts.output == outputJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err = encoder.Encode(summary); err != nil {
+			return err
+		}
+	} else {
+		writeSummary(stdout, summary)
+	}
+	if !summary.Succeeded() {
+		return errors.New("some resources failed to migrate")
+	}
+	return nil
+}
+
+func writeSummary(w io.Writer, summary *migration.Summary) {
+	if summary.DryRun {
+		fmt.Fprintln(w, "Dry run, the objects are not updated")
+	}
+	for _, r := range summary.Resources {
+		fmt.Fprintf(w, "%-28s total: %d, migrated: %d, rewritten: %d, failed: %d\n", r.Resource, r.Total, r.Migrated, r.Rewritten, r.Failed)
+		names := make([]string, 0, len(r.Migrations))
+		for name := range r.Migrations {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(w, "  %s: %d\n", name, r.Migrations[name])
+		}
+		for _, msg := range r.Errors {
+			fmt.Fprintf(w, "  error: %s\n", msg)
+		}
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"golang.org/x/sys/unix"
 
+	"github.com/secretflow/kuscia/cmd/kuscia/confloader"
 	"github.com/secretflow/kuscia/pkg/agent/commands"
 	"github.com/secretflow/kuscia/pkg/agent/config"
 	"github.com/secretflow/kuscia/pkg/embedstrings"
@@ -47,15 +48,20 @@ func NewAgent(i *ModuleRuntimeConfigs) (Module, error) {
 	conf := &i.Agent
 	conf.RootDir = i.RootDir
 	conf.Namespace = i.DomainID
-	if conf.Provider.Runtime == config.ContainerRuntime {
+	if config.IsCRIRuntime(conf.Provider.Runtime) {
 		conf.Node.KeepNodeOnExit = true
 	}
 	if conf.Provider.Runtime == config.ProcessRuntime {
 		precheckKernelVersion()
 	}
+	if i.DNS != nil {
+		conf.Provider.CRI.Searches = append(conf.Provider.CRI.Searches, i.DNS.Searches...)
+		conf.Provider.K8s.DNS.Searches = append(conf.Provider.K8s.DNS.Searches, i.DNS.Searches...)
+	}
 	conf.APIVersion = k8sVersion
 	conf.AgentVersion = fmt.Sprintf("%v", meta.AgentVersionString())
 	conf.DomainKey = i.DomainKey
+	conf.SecretBackend = i.ExternalSecretBackend
 	conf.DomainCACert = i.CACert
 	conf.DomainCAKey = i.CAKey
 	conf.DomainCACertFile = i.CACertFile
@@ -63,31 +69,33 @@ func NewAgent(i *ModuleRuntimeConfigs) (Module, error) {
 		conf.DomainCACertFile = filepath.Join(i.RootDir, i.CACertFile)
 	}
 	conf.AllowPrivileged = i.Agent.AllowPrivileged
-	conf.Provider.CRI.RemoteImageEndpoint = fmt.Sprintf("unix://%s", i.ContainerdSock)
-	conf.Provider.CRI.RemoteRuntimeEndpoint = fmt.Sprintf("unix://%s", i.ContainerdSock)
+	// the endpoints of the containerd on the host are loaded from kuscia.yaml
+	if conf.Provider.Runtime != config.ContainerdRuntime {
+		conf.Provider.CRI.RemoteImageEndpoint = fmt.Sprintf("unix://%s", i.ContainerdSock)
+		conf.Provider.CRI.RemoteRuntimeEndpoint = fmt.Sprintf("unix://%s", i.ContainerdSock)
+	}
 
 	if i.Image != nil && len(i.Image.Registries) > 0 {
-		defaultRegIdx := 0 // use first registry as default registry
-		for idx, reg := range i.Image.Registries {
+		conf.Registry = registryCfgFromImage(i.Image)
+		for _, reg := range i.Image.Registries {
 			nlog.Infof("registry(%s), endpoint(%s)", reg.Name, reg.Endpoint)
-			conf.Registry.Allows = append(conf.Registry.Allows, config.RegistryAuth{
-				Repository: reg.Endpoint,
-				Username:   reg.UserName,
-				Password:   reg.Password,
-			})
-
-			if i.Image.DefaultRegistry == reg.Name {
-				defaultRegIdx = idx
-			}
 		}
-
-		conf.Registry.Default = conf.Registry.Allows[defaultRegIdx]
+		RegisterConfigReloader("agent", func(kusciaConf *confloader.KusciaConfig) {
+			if len(kusciaConf.Image.Registries) == 0 {
+				return
+			}
+			newCfg := registryCfgFromImage(&kusciaConf.Image)
+			if updated := conf.Registry.UpdateCredentials(newCfg.Allows); len(updated) > 0 {
+				nlog.Infof("Registry credentials of %v are reloaded", updated)
+			}
+		})
 	} else { // deprecated. remove it 1year later
 		conf.Registry.Default.Repository = os.Getenv("REGISTRY_ENDPOINT")
 		conf.Registry.Default.Username = os.Getenv("REGISTRY_USERNAME")
 		conf.Registry.Default.Password = os.Getenv("REGISTRY_PASSWORD")
 	}
 	conf.Plugins = i.Agent.Plugins
+	conf.Stats.Port = i.AgentExportPort
 
 	conf.KusciaAPIProtocol = i.KusciaAPI.Protocol
 	// Todo: temporary solution for scql
@@ -108,6 +116,26 @@ func NewAgent(i *ModuleRuntimeConfigs) (Module, error) {
 	}, nil
 }
 
+// registryCfgFromImage builds the registry config of the agent, the default registry is the first one if not set.
+func registryCfgFromImage(image *confloader.ImageConfig) config.RegistryCfg {
+	cfg := config.RegistryCfg{}
+	defaultRegIdx := 0
+	for idx, reg := range image.Registries {
+		cfg.Allows = append(cfg.Allows, config.RegistryAuth{
+			Repository: reg.Endpoint,
+			Username:   reg.UserName,
+			Password:   reg.Password,
+		})
+		if image.DefaultRegistry == reg.Name {
+			defaultRegIdx = idx
+		}
+	}
+	if len(cfg.Allows) > 0 {
+		cfg.Default = cfg.Allows[defaultRegIdx]
+	}
+	return cfg
+}
+
 func precheckKernelVersion() {
 	var uts unix.Utsname
 	if err := unix.Uname(&uts); err != nil {
@@ -138,7 +166,7 @@ func (agent *agentModule) Run(ctx context.Context) error {
 		}
 	}
 
-	return commands.RunRootCommand(ctx, agent.conf, agent.clients.KubeClient)
+	return commands.RunRootCommand(ctx, agent.conf, agent.clients.KubeClient, agent.clients.KusciaClient)
 }
 
 func (agent *agentModule) WaitReady(ctx context.Context) error {
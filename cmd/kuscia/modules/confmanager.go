@@ -68,6 +68,7 @@ func NewConfManager(d *ModuleRuntimeConfigs) (Module, error) {
 	}
 	conf.DomainID = d.DomainID
 	conf.DomainKey = d.DomainKey
+	conf.SecretBackend = d.ExternalSecretBackend
 	conf.TLS.RootCA = d.CACert
 	conf.TLS.RootCAKey = d.CAKey
 	conf.KubeClient = d.Clients.KubeClient
@@ -77,6 +78,11 @@ func NewConfManager(d *ModuleRuntimeConfigs) (Module, error) {
 	case common.RunModeAutonomy:
 		conf.DomainCertValue = &atomic.Value{}
 		conf.DomainCertValue.Store(d.DomainCert)
+		// the domain cert of lite is issued by the master, so it's renewed only in autonomy
+		conf.CertRenewal = d.CertRenewal
+		conf.CertIssuer = d.CertIssuer
+		conf.DomainCertFile = d.DomainCertFile
+		conf.KusciaClient = d.Clients.KusciaClient
 	}
 	nlog.Infof("Conf manager config is %+v", conf)
 
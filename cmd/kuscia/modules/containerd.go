@@ -15,8 +15,11 @@
 package modules
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
 	"os"
 	"os/exec"
 	"path"
@@ -45,8 +48,15 @@ type containerdModule struct {
 	Snapshotter string
 	LogConfig   nlog.LogConfig
 	HTTPProxy   string
+	IPFamily    pkgcom.IPFamily
 }
 
+const (
+	cniConflistFile   = "etc/cni/net.d/10-containerd-net.conflist"
+	cniIPv6PodSubnet  = "fd00:10:88::/64"
+	cniIPv6DefaultDst = "::/0"
+)
+
 func NewContainerd(i *ModuleRuntimeConfigs) (Module, error) {
 	return &containerdModule{
 		moduleRuntimeBase: moduleRuntimeBase{
@@ -61,6 +71,7 @@ func NewContainerd(i *ModuleRuntimeConfigs) (Module, error) {
 		Snapshotter: autoDetectSnapshotter(i.RootDir),
 		LogConfig:   *i.LogConfig,
 		HTTPProxy:   i.Image.HTTPProxy,
+		IPFamily:    i.IPFamily,
 	}, nil
 }
 
@@ -71,6 +82,10 @@ func (s *containerdModule) Run(ctx context.Context) error {
 		return err
 	}
 
+	if err := configureCNIIPFamily(filepath.Join(s.Root, cniConflistFile), s.IPFamily); err != nil {
+		return err
+	}
+
 	// check if the file /etc/crictl.yaml exists
 	crictlFile := "/etc/crictl.yaml"
 	if _, err := os.Stat(crictlFile); err != nil {
@@ -117,6 +132,77 @@ func (s *containerdModule) execPreCmds(ctx context.Context) error {
 	return cmd.Run()
 }
 
+// configureCNIIPFamily adds the subnet and default route of IPv6 to the ipam of the bridge network, so that pods get
+// the addresses of IPv6. The addresses of IPv4 are dropped if the node is IPv6 only. The other fields of the conflist,
+// which may be customized by users, are kept.
+func configureCNIIPFamily(conflistPath string, family pkgcom.IPFamily) error {
+	if family != pkgcom.IPFamilyIPv6 && family != pkgcom.IPFamilyDualStack {
+		return nil
+	}
+	content, err := os.ReadFile(conflistPath)
+	if err != nil {
+		return err
+	}
+	conflist := map[string]interface{}{}
+	if err := json.Unmarshal(content, &conflist); err != nil {
+		return fmt.Errorf("parse cni conflist %s failed, %v", conflistPath, err)
+	}
+	plugins, _ := conflist["plugins"].([]interface{})
+	for _, p := range plugins {
+		plugin, _ := p.(map[string]interface{})
+		ipam, ok := plugin["ipam"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ranges, _ := ipam["ranges"].([]interface{})
+		ipam["ranges"] = withIPv6Entry(ranges, family, func(entry interface{}) string {
+			rangeSet, _ := entry.([]interface{})
+			if len(rangeSet) == 0 {
+				return ""
+			}
+			r, _ := rangeSet[0].(map[string]interface{})
+			subnet, _ := r["subnet"].(string)
+			return subnet
+		}, []interface{}{map[string]interface{}{"subnet": cniIPv6PodSubnet}})
+		routes, _ := ipam["routes"].([]interface{})
+		ipam["routes"] = withIPv6Entry(routes, family, func(entry interface{}) string {
+			route, _ := entry.(map[string]interface{})
+			dst, _ := route["dst"].(string)
+			return dst
+		}, map[string]interface{}{"dst": cniIPv6DefaultDst})
+	}
+	updated, err := json.MarshalIndent(conflist, "", "  ")
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(bytes.TrimSpace(content), updated) {
+		return nil
+	}
+	nlog.Infof("Configure cni conflist %s for ip family %s", conflistPath, family)
+	return os.WriteFile(conflistPath, updated, 0644)
+}
+
+// withIPv6Entry appends the entry of IPv6 if the entries have none, and drops the entries of IPv4 if the node is
+// IPv6 only. cidrOf returns the cidr of the entry.
+func withIPv6Entry(entries []interface{}, family pkgcom.IPFamily, cidrOf func(interface{}) string,
+	ipv6Entry interface{}) []interface{} {
+	result := make([]interface{}, 0, len(entries)+1)
+	hasIPv6 := false
+	for _, entry := range entries {
+		ip, _, err := net.ParseCIDR(cidrOf(entry))
+		isIPv6 := err == nil && ip.To4() == nil
+		hasIPv6 = hasIPv6 || isIPv6
+		if family == pkgcom.IPFamilyIPv6 && err == nil && !isIPv6 {
+			continue
+		}
+		result = append(result, entry)
+	}
+	if !hasIPv6 {
+		result = append(result, ipv6Entry)
+	}
+	return result
+}
+
 func autoDetectSnapshotter(root string) string {
 	path := path.Join(root, "containerd")
 	if !paths.CheckDirExist(path) {
@@ -0,0 +1,90 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pkgcom "github.com/secretflow/kuscia/pkg/common"
+)
+
+const testConflist = `{
+  "cniVersion": "1.0.0",
+  "name": "containerd-net",
+  "plugins": [
+    {
+      "type": "bridge",
+      "mtu": 1400,
+      "ipam": {
+        "type": "host-local",
+        "ranges": [[{"subnet": "10.88.0.0/16"}]],
+        "routes": [{"dst": "0.0.0.0/0"}]
+      }
+    },
+    {
+      "type": "portmap",
+      "capabilities": {"portMappings": true}
+    }
+  ]
+}`
+
+func TestConfigureCNIIPFamily(t *testing.T) {
+	tests := []struct {
+		family  pkgcom.IPFamily
+		subnets string
+		routes  string
+	}{
+		{pkgcom.IPFamilyIPv4, `[[{"subnet": "10.88.0.0/16"}]]`, `[{"dst": "0.0.0.0/0"}]`},
+		{pkgcom.IPFamilyDualStack, `[[{"subnet": "10.88.0.0/16"}],[{"subnet": "fd00:10:88::/64"}]]`, `[{"dst": "0.0.0.0/0"},{"dst": "::/0"}]`},
+		{pkgcom.IPFamilyIPv6, `[[{"subnet": "fd00:10:88::/64"}]]`, `[{"dst": "::/0"}]`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.family), func(t *testing.T) {
+			conflistPath := filepath.Join(t.TempDir(), "10-containerd-net.conflist")
+			assert.NoError(t, os.WriteFile(conflistPath, []byte(testConflist), 0644))
+
+			// configuring twice gets the same conflist
+			assert.NoError(t, configureCNIIPFamily(conflistPath, tt.family))
+			assert.NoError(t, configureCNIIPFamily(conflistPath, tt.family))
+
+			content, err := os.ReadFile(conflistPath)
+			assert.NoError(t, err)
+			want := `{
+  "cniVersion": "1.0.0",
+  "name": "containerd-net",
+  "plugins": [
+    {
+      "type": "bridge",
+      "mtu": 1400,
+      "ipam": {
+        "type": "host-local",
+        "ranges": ` + tt.subnets + `,
+        "routes": ` + tt.routes + `
+      }
+    },
+    {
+      "type": "portmap",
+      "capabilities": {"portMappings": true}
+    }
+  ]
+}`
+			assert.JSONEq(t, want, string(content))
+		})
+	}
+}
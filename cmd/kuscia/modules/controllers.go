@@ -17,19 +17,42 @@ package modules
 
 import (
 	"github.com/secretflow/kuscia/pkg/controllers"
+	"github.com/secretflow/kuscia/pkg/controllers/alerting"
+	"github.com/secretflow/kuscia/pkg/controllers/appimagesync"
 	"github.com/secretflow/kuscia/pkg/controllers/clusterdomainroute"
+	"github.com/secretflow/kuscia/pkg/controllers/crdmigration"
 	"github.com/secretflow/kuscia/pkg/controllers/domain"
 	"github.com/secretflow/kuscia/pkg/controllers/domaindata"
 	"github.com/secretflow/kuscia/pkg/controllers/domainroute"
 	"github.com/secretflow/kuscia/pkg/controllers/garbagecollection"
+	"github.com/secretflow/kuscia/pkg/controllers/gitops"
+	"github.com/secretflow/kuscia/pkg/controllers/imageprewarm"
+	"github.com/secretflow/kuscia/pkg/controllers/jobarchive"
 	"github.com/secretflow/kuscia/pkg/controllers/kusciadeployment"
 	"github.com/secretflow/kuscia/pkg/controllers/kusciajob"
 	"github.com/secretflow/kuscia/pkg/controllers/kusciatask"
 	"github.com/secretflow/kuscia/pkg/controllers/portflake"
 	"github.com/secretflow/kuscia/pkg/controllers/taskresourcegroup"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 )
 
 func NewControllersModule(i *ModuleRuntimeConfigs) (Module, error) {
+	if err := kusciaconfig.CheckAppImageSyncConfig(i.AppImageSync); err != nil {
+		return nil, err
+	}
+	if err := kusciaconfig.CheckGitOpsConfig(i.GitOps); err != nil {
+		return nil, err
+	}
+	if err := kusciaconfig.CheckAlertingConfig(i.Alerting); err != nil {
+		return nil, err
+	}
+	if err := kusciaconfig.CheckJobArchiveConfig(i.JobArchive); err != nil {
+		return nil, err
+	}
+	if err := kusciaconfig.CheckTaskGCConfig(i.TaskGC); err != nil {
+		return nil, err
+	}
+
 	opt := &controllers.Options{
 		ControllerName:        "kuscia-controller-manager",
 		HealthCheckPort:       8090,
@@ -38,6 +61,12 @@ func NewControllersModule(i *ModuleRuntimeConfigs) (Module, error) {
 		Namespace:             i.DomainID,
 		RootDir:               i.RootDir,
 		EnableWorkloadApprove: i.EnableWorkloadApprove,
+		GrantWebhook:          i.GrantWebhook,
+		AppImageSync:          i.AppImageSync,
+		GitOps:                i.GitOps,
+		Alerting:              i.Alerting,
+		JobArchive:            i.JobArchive,
+		TaskGC:                i.TaskGC,
 	}
 
 	return controllers.NewServer(
@@ -78,10 +107,33 @@ func NewControllersModule(i *ModuleRuntimeConfigs) (Module, error) {
 			{
 				NewControler: portflake.NewController,
 			},
+			{
+				NewControler: appimagesync.NewController,
+				CRDNames:     []string{controllers.CRDAppImagesName},
+			},
+			{
+				NewControler: gitops.NewController,
+				CRDNames:     []string{controllers.CRDDomainsName, controllers.CRDDomainRoutesName, controllers.CRDAppImagesName, controllers.CRDDomainDataSourcesName},
+			},
+			{
+				NewControler: imageprewarm.NewController,
+				CRDNames:     []string{controllers.CRDAppImagesName, controllers.CRDImagePrewarmsName},
+			},
+			{
+				NewControler: alerting.NewController,
+				CRDNames:     []string{controllers.CRDKusciaJobsName, controllers.CRDClusterDomainRoutesName, controllers.CRDDomainsName},
+			},
+			{
+				NewControler: jobarchive.NewController,
+				CRDNames:     []string{controllers.CRDKusciaJobsName, controllers.CRDKusciaTasksName},
+			},
 
 			{
 				NewControler: garbagecollection.NewKusciaJobGCController,
 			},
+			{
+				NewControler: crdmigration.NewController,
+			},
 		},
 	), nil
 }
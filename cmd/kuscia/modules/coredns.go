@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +33,8 @@ import (
 
 	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/coredns"
+	utilscommon "github.com/secretflow/kuscia/pkg/utils/common"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 	"github.com/secretflow/kuscia/pkg/utils/lock"
 	"github.com/secretflow/kuscia/pkg/utils/network"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
@@ -86,16 +89,38 @@ type CorednsModule struct {
 	rootDir         string
 	namespace       string
 	envoyIP         string
+	dns             *kusciaconfig.DNSConfig
 	readyChan       chan struct{}
 	coreDNSInstance *coredns.KusciaCoreDNS
 }
 
+// corefileConfig renders the corefile template, the lists are joined by spaces as the arguments of the plugins.
+type corefileConfig struct {
+	Upstreams   string
+	StubDomains []corefileStubDomain
+	Hosts       []corefileHost
+}
+
+type corefileStubDomain struct {
+	Zone        string
+	Nameservers string
+}
+
+type corefileHost struct {
+	IP        string
+	Hostnames string
+}
+
 func NewCoreDNS(conf *ModuleRuntimeConfigs) (Module, error) {
+	if err := kusciaconfig.CheckDNSConfig(conf.DNS); err != nil {
+		return nil, err
+	}
 	return &CorednsModule{
 
 		rootDir:   conf.RootDir,
 		namespace: conf.DomainID,
 		envoyIP:   conf.EnvoyIP,
+		dns:       conf.DNS,
 		readyChan: make(chan struct{}),
 	}, nil
 }
@@ -127,7 +152,11 @@ func (s *CorednsModule) Run(ctx context.Context) error {
 	)
 	dnsserver.Directives = directives
 
-	contents, err := os.ReadFile(filepath.Join(s.rootDir, common.ConfPrefix, "corefile"))
+	corefile := filepath.Join(s.rootDir, common.ConfPrefix, "corefile")
+	if err := utilscommon.RenderConfig(corefile+".tmpl", corefile, newCorefileConfig(s.rootDir, s.dns)); err != nil {
+		return err
+	}
+	contents, err := os.ReadFile(corefile)
 	if err != nil {
 		return err
 	}
@@ -171,6 +200,30 @@ func (s *CorednsModule) StartControllers(ctx context.Context, kubeclient kuberne
 	_ = s.coreDNSInstance.StartControllers(ctx, kubeclient)
 }
 
+// newCorefileConfig forwards the names out of the domain to the upstreams of the dns config, or the nameservers
+// of the host backed up by prepareResolvConf.
+func newCorefileConfig(rootDir string, dns *kusciaconfig.DNSConfig) *corefileConfig {
+	conf := &corefileConfig{
+		Upstreams: filepath.Join(rootDir, common.TmpPrefix, "resolv.conf"),
+	}
+	if dns == nil {
+		return conf
+	}
+	if len(dns.Upstreams) > 0 {
+		conf.Upstreams = strings.Join(dns.Upstreams, " ")
+	}
+	for zone, nameservers := range dns.StubDomains {
+		conf.StubDomains = append(conf.StubDomains, corefileStubDomain{Zone: zone, Nameservers: strings.Join(nameservers, " ")})
+	}
+	sort.Slice(conf.StubDomains, func(i, j int) bool {
+		return conf.StubDomains[i].Zone < conf.StubDomains[j].Zone
+	})
+	for _, host := range dns.Hosts {
+		conf.Hosts = append(conf.Hosts, corefileHost{IP: host.IP, Hostnames: strings.Join(host.Hostnames, " ")})
+	}
+	return conf
+}
+
 func prepareResolvConf(rootDir string) error {
 	nlog.Infof("Start preparing coredns resolv.conf, root dir %v", rootDir)
 	hostIP, err := network.GetHostIP()
@@ -0,0 +1,71 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	utilscommon "github.com/secretflow/kuscia/pkg/utils/common"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+func TestRenderCorefile(t *testing.T) {
+	corefileTmpl := filepath.Join("..", "..", "..", "etc", "conf", "corefile.tmpl")
+	corefile := filepath.Join(t.TempDir(), "corefile")
+
+	assert.NoError(t, utilscommon.RenderConfig(corefileTmpl, corefile, newCorefileConfig("/home/kuscia", nil)))
+	content, err := os.ReadFile(corefile)
+	assert.NoError(t, err)
+	assert.Equal(t, `.:53 {
+    kuscia {
+        fallthrough
+    }
+    forward . /home/kuscia/var/tmp/resolv.conf
+}
+`, string(content))
+
+	dns := &kusciaconfig.DNSConfig{
+		Upstreams: []string{"10.0.0.1", "10.0.0.2:5353"},
+		StubDomains: map[string][]string{
+			"partner.example.com": {"192.168.1.53"},
+			"corp.example.com":    {"10.1.0.53", "10.1.0.54"},
+		},
+		Hosts: []kusciaconfig.DNSHostEntry{{IP: "10.2.0.1", Hostnames: []string{"db.corp", "db"}}},
+	}
+	assert.NoError(t, utilscommon.RenderConfig(corefileTmpl, corefile, newCorefileConfig("/home/kuscia", dns)))
+	content, err = os.ReadFile(corefile)
+	assert.NoError(t, err)
+	assert.Equal(t, `corp.example.com:53 {
+    forward . 10.1.0.53 10.1.0.54
+}
+partner.example.com:53 {
+    forward . 192.168.1.53
+}
+.:53 {
+    hosts {
+        10.2.0.1 db.corp db
+        fallthrough
+    }
+    kuscia {
+        fallthrough
+    }
+    forward . 10.0.0.1 10.0.0.2:5353
+}
+`, string(content))
+}
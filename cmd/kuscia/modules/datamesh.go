@@ -35,6 +35,7 @@ func NewDataMesh(d *ModuleRuntimeConfigs) (Module, error) {
 	conf := config.NewDefaultDataMeshConfig()
 	conf.RootDir = d.RootDir
 	conf.DomainKey = d.DomainKey
+	conf.SecretBackend = d.ExternalSecretBackend
 	conf.KubeClient = d.Clients.KubeClient
 	// override data proxy config
 	if d.DataMesh != nil {
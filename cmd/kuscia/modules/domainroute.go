@@ -23,9 +23,12 @@ import (
 	"time"
 
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/certmanager"
 	"github.com/secretflow/kuscia/pkg/gateway/commands"
 	"github.com/secretflow/kuscia/pkg/gateway/config"
 	"github.com/secretflow/kuscia/pkg/gateway/controller"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+	"github.com/secretflow/kuscia/pkg/utils/certinventory"
 	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
 	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
@@ -49,6 +52,14 @@ func NewDomainRoute(i *ModuleRuntimeConfigs) (Module, error) {
 	conf.CsrData = i.DomainRoute.DomainCsrData
 	conf.CACert = i.CACert
 	conf.CAKey = i.CAKey
+	conf.CertRenewal = i.CertRenewal
+	if i.DomainRoute.TrafficClass != nil {
+		conf.TrafficClass = i.DomainRoute.TrafficClass
+	}
+	conf.HTTP3 = i.DomainRoute.HTTP3
+	conf.Proxy = i.DomainRoute.Proxy
+	conf.IPFamily = i.IPFamily
+	conf.SecretBackend = i.ExternalSecretBackend
 
 	externalTLS := conf.ExternalTLS
 	if i.DomainRoute.ExternalTLS != nil {
@@ -68,7 +79,13 @@ func NewDomainRoute(i *ModuleRuntimeConfigs) (Module, error) {
 	if externalTLS != nil && externalTLS.EnableTLS {
 		if externalTLS.KeyData == "" && externalTLS.CertData == "" {
 			var err error
-			externalTLS.KeyData, externalTLS.CertData, err = tlsutils.GenerateKeyCertPairData(i.CAKey, i.CACert, fmt.Sprintf("%s_ENVOY_EXTERNAL", conf.DomainID))
+			commonName := fmt.Sprintf("%s_ENVOY_EXTERNAL", conf.DomainID)
+			if i.ExternalIssuer != nil {
+				externalTLS.KeyData, externalTLS.CertData, err = certmanager.IssueKeyCertPairData(context.Background(), i.ExternalIssuer, tlsutils.ServerCertTemplate(commonName))
+				conf.ExternalCertIssuer = i.ExternalIssuer
+			} else {
+				externalTLS.KeyData, externalTLS.CertData, err = tlsutils.GenerateKeyCertPairData(i.CAKey, i.CACert, commonName)
+			}
 			if err != nil {
 				nlog.Fatalf("Generate external keyCert pair error:%v", err.Error())
 			}
@@ -76,6 +93,13 @@ func NewDomainRoute(i *ModuleRuntimeConfigs) (Module, error) {
 	}
 	conf.ExternalTLS = externalTLS
 
+	for _, listener := range []string{xds.ExternalListener, xds.InternalListener} {
+		certinventory.Default.Register(listener, envoyListenerCertSource(listener))
+	}
+	if i.Clients != nil {
+		certinventory.Default.Register("domainroute-token", certinventory.DomainRouteTokenSource(i.Clients.KusciaClient, i.DomainID))
+	}
+
 	if i.TransportPort > 0 {
 		conf.TransportConfig = &kusciaconfig.ServiceConfig{
 			Endpoint: fmt.Sprintf("http://127.0.0.1:%d", i.TransportPort),
@@ -128,6 +152,22 @@ func (d *domainRouteModule) Name() string {
 	return "domainroute"
 }
 
+// envoyListenerCertSource lists the downstream cert of the envoy listener, which may be rotated at runtime.
+func envoyListenerCertSource(listener string) certinventory.Source {
+	return func(ctx context.Context) ([]certinventory.Certificate, error) {
+		cert, err := xds.QueryListenerCert(listener)
+		if err != nil || cert == nil || cert.CertData == "" {
+			// xds isn't initialized yet, or the listener doesn't serve tls
+			return nil, nil
+		}
+		x509Cert, err := tlsutils.ParseCertData([]byte(cert.CertData))
+		if err != nil {
+			return nil, fmt.Errorf("parse cert of listener %s failed, %v", listener, err)
+		}
+		return []certinventory.Certificate{certinventory.FromX509(certinventory.KindEnvoyListenerCert, listener, x509Cert)}, nil
+	}
+}
+
 func getPubkeyForToken(pubkey string, runmode common.RunModeType) (*rsa.PublicKey, error) {
 	if pubkey == "" && runmode == common.RunModeLite {
 		// for lite, but pubkey is empty, we can only use UID
@@ -53,6 +53,11 @@ import (
 	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
 )
 
+const (
+	k3sIPv6ClusterCIDR = "fd00:10:42::/56"
+	k3sIPv6ServiceCIDR = "fd00:10:43::/112"
+)
+
 type k3sModule struct {
 	rootDir           string
 	kubeconfigFile    string
@@ -60,8 +65,10 @@ type k3sModule struct {
 	listenPort        string
 	dataDir           string
 	datastoreEndpoint string
+	datastoreTLS      *datastore.TLSConfig
 	clusterToken      string
 	hostIP            string
+	ipFamily          pkgcom.IPFamily
 	enableAudit       bool
 	LogConfig         nlog.LogConfig
 	conf              *ModuleRuntimeConfigs
@@ -91,15 +98,21 @@ func NewK3s(i *ModuleRuntimeConfigs) (Module, error) {
 	if err != nil {
 		nlog.Fatal(err)
 	}
+	bindAddress := "0.0.0.0"
+	if i.IPFamily == pkgcom.IPFamilyIPv6 || i.IPFamily == pkgcom.IPFamilyDualStack {
+		bindAddress = "::"
+	}
 	return &k3sModule{
 		rootDir:           i.RootDir,
 		kubeconfigFile:    i.KubeconfigFile,
-		bindAddress:       "0.0.0.0",
+		bindAddress:       bindAddress,
 		listenPort:        "6443",
 		hostIP:            hostIP,
+		ipFamily:          i.IPFamily,
 		dataDir:           filepath.Join(i.RootDir, k3sDataDirPrefix),
 		enableAudit:       false,
 		datastoreEndpoint: i.Master.DatastoreEndpoint,
+		datastoreTLS:      i.Master.DatastoreTLS,
 		clusterToken:      clusterToken,
 		LogConfig:         *i.LogConfig,
 		conf:              i,
@@ -109,11 +122,12 @@ func NewK3s(i *ModuleRuntimeConfigs) (Module, error) {
 
 func (s *k3sModule) Run(ctx context.Context) error {
 	// check DatastoreEndpoint
-	err := datastore.CheckDatastoreEndpoint(s.datastoreEndpoint)
+	err := datastore.CheckDatastore(s.datastoreEndpoint, s.datastoreTLS)
 	if err != nil {
 		nlog.Errorf("k3s check datastore endpoint failed with: %s", err.Error())
 		return err
 	}
+	s.checkSqliteLeftover()
 
 	args := []string{
 		"server",
@@ -138,8 +152,13 @@ func (s *k3sModule) Run(ctx context.Context) error {
 	if !pkgcom.IsRootUser() {
 		args = append(args, "--rootless")
 	}
+	if s.ipFamily == pkgcom.IPFamilyIPv6 {
+		// k3s allocates the cidrs of IPv4 by default, which can't be used on the node of IPv6 only
+		args = append(args, "--cluster-cidr="+k3sIPv6ClusterCIDR, "--service-cidr="+k3sIPv6ServiceCIDR)
+	}
 	if s.datastoreEndpoint != "" {
 		args = append(args, "--datastore-endpoint="+s.datastoreEndpoint)
+		args = append(args, s.datastoreTLS.K3sArgs()...)
 	}
 	if s.clusterToken != "" {
 		args = append(args, "--token="+s.clusterToken)
@@ -187,6 +206,19 @@ func (s *k3sModule) Run(ctx context.Context) error {
 	return err
 }
 
+// checkSqliteLeftover warns if the external datastore is configured while the sqlite datastore is left,
+// k3s doesn't migrate the data of sqlite into the external datastore by itself.
+func (s *k3sModule) checkSqliteLeftover() {
+	if s.datastoreEndpoint == "" {
+		return
+	}
+	sqliteFile := filepath.Join(s.dataDir, k3sSqliteFile)
+	if _, err := os.Stat(sqliteFile); err == nil {
+		nlog.Warnf("Found the sqlite datastore %s while 'datastoreEndpoint' is configured, it's not used by k3s. "+
+			"Migrate it with 'kuscia datastore migrate' if it's not migrated yet, otherwise remove it", sqliteFile)
+	}
+}
+
 func (s *k3sModule) WaitReady(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
@@ -211,7 +243,7 @@ func (s *k3sModule) startCheckReady(ctx context.Context) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-tickerReady.C:
-			if nil == s.readyz("https://127.0.0.1:"+s.listenPort) {
+			if nil == s.readyz(s.readyzURL()) {
 				return nil
 			}
 		case <-ticker.C:
@@ -259,6 +291,13 @@ func (s *k3sModule) initKusciaEnvAfterReady(ctx context.Context) error {
 
 }
 
+func (s *k3sModule) readyzURL() string {
+	if s.ipFamily == pkgcom.IPFamilyIPv6 {
+		return "https://[::1]:" + s.listenPort
+	}
+	return "https://127.0.0.1:" + s.listenPort
+}
+
 func (s *k3sModule) readyz(host string) error {
 	// check k3s process
 	if !process.CheckExists("k3s") {
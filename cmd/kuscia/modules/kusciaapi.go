@@ -24,6 +24,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
 	"google.golang.org/grpc"
@@ -33,9 +34,12 @@ import (
 	"github.com/secretflow/kuscia/cmd/kuscia/confloader"
 	"github.com/secretflow/kuscia/pkg/common"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/jobarchive"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/commands"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/grantpolicy"
 	apiutils "github.com/secretflow/kuscia/pkg/kusciaapi/utils"
+	"github.com/secretflow/kuscia/pkg/utils/certinventory"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/nlog/zlogwriter"
 	"github.com/secretflow/kuscia/pkg/utils/paths"
@@ -44,6 +48,7 @@ import (
 	"github.com/secretflow/kuscia/pkg/web/constants"
 	webconfig "github.com/secretflow/kuscia/pkg/web/framework/config"
 	"github.com/secretflow/kuscia/pkg/web/interceptor"
+	"github.com/secretflow/kuscia/pkg/web/ratelimit"
 	"github.com/secretflow/kuscia/pkg/web/utils"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
@@ -52,6 +57,7 @@ const (
 	kusciaAPISanDNSName            = "kusciaapi"
 	kusciaAPIInterceptorLoggerPath = "var/logs/kusciaapi.log"
 	dateMeshInterceptorLoggerPath  = "var/logs/datamesh.log"
+	kusciaAPIExecAuditLoggerPath   = "var/logs/kusciaapi_exec_audit.log"
 )
 
 type kusciaAPIModule struct {
@@ -69,6 +75,7 @@ func NewKusciaAPI(d *ModuleRuntimeConfigs) (Module, error) {
 	kusciaAPIConfig.RootCAKey = d.CAKey
 	kusciaAPIConfig.RootCA = d.CACert
 	kusciaAPIConfig.DomainKey = d.DomainKey
+	kusciaAPIConfig.SecretBackend = d.ExternalSecretBackend
 	kusciaAPIConfig.TLS.RootCA = d.CACert
 	kusciaAPIConfig.TLS.RootCAKey = d.CAKey
 	kusciaAPIConfig.TLS.CommonName = "KusciaAPI"
@@ -78,6 +85,31 @@ func NewKusciaAPI(d *ModuleRuntimeConfigs) (Module, error) {
 	kusciaAPIConfig.Protocol = d.Protocol
 	kusciaAPIConfig.StdoutPath = d.Agent.StdoutPath
 	kusciaAPIConfig.NodeName = d.Agent.Node.NodeName
+	kusciaAPIConfig.AgentExecSocket = filepath.Join(d.RootDir, common.AgentExecSocketPath)
+	if kusciaAPIConfig.RateLimit != nil {
+		rateLimiters := &ratelimit.Group{}
+		kusciaAPIConfig.RateLimiters = rateLimiters
+		RegisterConfigReloader("kusciaapi", func(kusciaConf *confloader.KusciaConfig) {
+			// enabling or disabling the rate limit requires a restart
+			if kusciaConf.KusciaAPI != nil && kusciaConf.KusciaAPI.RateLimit != nil {
+				rateLimiters.Reload(kusciaConf.KusciaAPI.RateLimit)
+			}
+		})
+	}
+
+	grantPolicies, err := grantpolicy.New(kusciaAPIConfig.GrantPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("init grant policy failed, %s", err.Error())
+	}
+	kusciaAPIConfig.GrantPolicies = grantPolicies
+
+	if d.RunMode != common.RunModeLite && d.JobArchive != nil && d.JobArchive.Enable {
+		store, err := jobarchive.NewStore(&d.JobArchive.Store)
+		if err != nil {
+			return nil, fmt.Errorf("init job archive store failed, %s", err.Error())
+		}
+		kusciaAPIConfig.JobArchive = store
+	}
 
 	protocol := kusciaAPIConfig.Protocol
 	if protocol == "" {
@@ -92,6 +124,8 @@ func NewKusciaAPI(d *ModuleRuntimeConfigs) (Module, error) {
 		if err := kusciaAPIConfig.TLS.LoadFromDataOrFile(nil, []string{kusciaAPISanDNSName}); err != nil {
 			return nil, err
 		}
+		certinventory.Default.Register("kusciaapi-server", certinventory.FileSource(certinventory.KindKusciaAPIServerCert,
+			"kusciaapi-server", kusciaAPIConfig.TLS.ServerCertFile))
 	}
 
 	if kusciaAPIConfig.Token != nil {
@@ -115,6 +149,14 @@ func NewKusciaAPI(d *ModuleRuntimeConfigs) (Module, error) {
 	}
 
 	kusciaAPIConfig.InterceptorLog = interceptorLogger
+	if kusciaAPIConfig.Exec != nil && len(kusciaAPIConfig.Exec.Roles) > 0 {
+		logWriter, err := zlogwriter.New(initLoggerConfig(d.KusciaConfig, kusciaAPIExecAuditLoggerPath))
+		if err != nil {
+			nlog.Errorf("Init Kuscia API exec audit logger failed: %v", err)
+			return nil, err
+		}
+		kusciaAPIConfig.ExecAuditLog = nlog.NewNLog(nlog.SetWriter(logWriter))
+	}
 	nlog.Debugf("Kuscia api config is %+v", kusciaAPIConfig)
 
 	return &kusciaAPIModule{
@@ -17,12 +17,23 @@ package modules
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
 	"github.com/secretflow/kuscia/pkg/agent/pod"
 	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/metricexporter"
 	"github.com/secretflow/kuscia/pkg/metricexporter/envoyexporter"
+	"github.com/secretflow/kuscia/pkg/metricexporter/remotewrite"
+	"github.com/secretflow/kuscia/pkg/metricexporter/trafficexporter"
+	"github.com/secretflow/kuscia/pkg/utils/certinventory"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/readyz"
 )
@@ -35,9 +46,16 @@ type metricExporterModule struct {
 	ssExportPort     int
 	metricExportPort int
 	podManager       pod.Manager
+	domainID         string
+	remoteWrite      *kusciaconfig.MetricRemoteWriteConfig
+	traffic          *trafficexporter.Exporter
+	certMonitor      *certinventory.Monitor
 }
 
 func NewMetricExporter(i *ModuleRuntimeConfigs) (Module, error) {
+	if err := kusciaconfig.CheckMetricRemoteWriteConfig(i.MetricRemoteWrite); err != nil {
+		return nil, err
+	}
 
 	var podManager pod.Manager
 	if i.RunMode != common.RunModeMaster {
@@ -51,6 +69,13 @@ func NewMetricExporter(i *ModuleRuntimeConfigs) (Module, error) {
 		nlog.Info("Running in master mode, skipping podManager creation")
 	}
 
+	var recorder record.EventRecorder
+	if i.Clients != nil && i.Clients.KubeClient != nil {
+		eventBroadcaster := record.NewBroadcaster()
+		eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: i.Clients.KubeClient.CoreV1().Events("")})
+		recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "cert-monitor"})
+	}
+
 	readyURI := fmt.Sprintf("http://127.0.0.1:%d", i.MetricExportPort)
 	exporter := &metricExporterModule{
 		moduleRuntimeBase: moduleRuntimeBase{
@@ -65,16 +90,43 @@ func NewMetricExporter(i *ModuleRuntimeConfigs) (Module, error) {
 		ssExportPort:     i.SsExportPort,
 		metricExportPort: i.MetricExportPort,
 		podManager:       podManager,
+		domainID:         i.DomainID,
+		remoteWrite:      i.MetricRemoteWrite,
+		traffic:          trafficexporter.NewExporter(i.RootDir, i.DomainID),
+		certMonitor:      certinventory.NewMonitor(certinventory.Default, i.DomainID, recorder),
 		metricURLs: map[string]string{
 			"node-exporter": fmt.Sprintf("http://localhost:%d/metrics", i.NodeExportPort),
 			"envoy":         envoyexporter.GetEnvoyMetricURL(),
 			"ss":            fmt.Sprintf("http://localhost:%d/ssmetrics", i.SsExportPort),
+			"traffic":       fmt.Sprintf("http://localhost:%d%s", i.MetricExportPort, trafficexporter.MetricPath),
+			"cert":          fmt.Sprintf("http://localhost:%d%s", i.MetricExportPort, certinventory.MetricPath),
 		},
 	}
+	// the agent serves the container metrics for runc and runp only
+	runtime := i.Agent.Provider.Runtime
+	if i.RunMode != common.RunModeMaster && i.Agent.Stats.Enable && (runtime == config.ContainerRuntime || runtime == config.ProcessRuntime) {
+		exporter.metricURLs["agent"] = fmt.Sprintf("http://localhost:%d/metrics", i.AgentExportPort)
+	}
 	return exporter, nil
 }
 
 func (exporter *metricExporterModule) Run(ctx context.Context) error {
-	metricexporter.MetricExporter(ctx, exporter.metricURLs, exporter.metricExportPort)
+	// the domains behind NAT push the metrics since they can't be scraped
+	if exporter.remoteWrite != nil && exporter.remoteWrite.Enable {
+		writer, err := remotewrite.NewWriter(exporter.remoteWrite, exporter.domainID, func() map[string][]byte {
+			return metricexporter.GatherMetrics(exporter.metricURLs)
+		})
+		if err != nil {
+			return err
+		}
+		go writer.Run(ctx)
+	}
+	// the traffic of the gateway is broken down by the domain pairs and the jobs
+	go exporter.traffic.Run(ctx)
+	go exporter.certMonitor.Run(ctx, certinventory.DefaultCheckInterval)
+	metricexporter.MetricExporter(ctx, exporter.metricURLs, exporter.metricExportPort, map[string]http.Handler{
+		trafficexporter.MetricPath: exporter.traffic.Handler(),
+		certinventory.MetricPath:   exporter.certMonitor.Handler(),
+	})
 	return nil
 }
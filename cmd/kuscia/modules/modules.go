@@ -32,6 +32,7 @@ import (
 
 var (
 	k3sDataDirPrefix              = "var/k3s/"
+	k3sSqliteFile                 = "server/db/state.db"
 	kusciaLogPath                 = "var/logs/kuscia.log"
 	defaultInterConnSchedulerPort = 8084
 	defaultEndpointForLite        = "http://apiserver.master.svc"
@@ -47,6 +47,7 @@ func NewReporter(d *ModuleRuntimeConfigs) (Module, error) {
 	reporterConfig.RunMode = d.RunMode
 	reporterConfig.DomainID = d.DomainID
 	reporterConfig.DomainKey = d.DomainKey
+	reporterConfig.SecretBackend = d.ExternalSecretBackend
 	reporterConfig.KubeClient = d.Clients.KubeClient
 	reporterConfig.KusciaClient = d.Clients.KusciaClient
 
@@ -22,6 +22,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"sync/atomic"
 
 	"k8s.io/klog/v2"
@@ -29,10 +30,14 @@ import (
 	"github.com/secretflow/kuscia/cmd/kuscia/confloader"
 	"github.com/secretflow/kuscia/pkg/agent/config"
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/certmanager"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	trconfig "github.com/secretflow/kuscia/pkg/transport/config"
+	"github.com/secretflow/kuscia/pkg/utils/certinventory"
 	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/nlog/zlogwriter"
+	"github.com/secretflow/kuscia/pkg/utils/paths"
 	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
 	"github.com/secretflow/kuscia/pkg/web/logs"
 )
@@ -53,12 +58,17 @@ type ModuleRuntimeConfigs struct {
 	SsExportPort            int
 	NodeExportPort          int
 	MetricExportPort        int
+	AgentExportPort         int
 	KusciaKubeConfig        string
 	EnableContainerd        bool
 	Image                   *confloader.ImageConfig
 	DomainCertByMasterValue atomic.Value // the value is <*x509.Certificate>
 	LogConfig               *nlog.LogConfig
 	Logrorate               confloader.LogrotateConfig
+	// ExternalIssuer issues the domain cert and the gateway external listener cert, nil if they're self-signed.
+	ExternalIssuer certmanager.Issuer
+	// ExternalSecretBackend seals the confs of ConfManager, nil if they're sealed by the domain key.
+	ExternalSecretBackend secretbackend.Backend
 }
 
 func (d *ModuleRuntimeConfigs) Close() {
@@ -93,6 +103,21 @@ func (d *ModuleRuntimeConfigs) LoadCaDomainKeyAndCert() error {
 		return err
 	}
 
+	if d.ExternalSecretBackend, err = secretbackend.NewBackend(config.SecretBackend); err != nil {
+		nlog.Errorf("create secret backend %s failed: %v", config.SecretBackend.TypeName(), err)
+		return err
+	}
+
+	if config.CertIssuer.IsExternal() {
+		if d.ExternalIssuer, err = certmanager.NewIssuer(&certmanager.IssuerConfig{Issuer: config.CertIssuer}); err != nil {
+			nlog.Errorf("create cert issuer %s failed: %v", config.CertIssuer.TypeName(), err)
+			return err
+		}
+		if !paths.CheckFileExist(config.DomainCertFile) {
+			return d.issueDomainCert()
+		}
+	}
+
 	if d.DomainCert, err = tlsutils.ParseCertWithGenerated(d.DomainKey, d.DomainID, nil, config.DomainCertFile); err != nil {
 		nlog.Errorf("load cert failed: file: %s", d.DomainCertFile)
 		return err
@@ -101,6 +126,35 @@ func (d *ModuleRuntimeConfigs) LoadCaDomainKeyAndCert() error {
 	return nil
 }
 
+// issueDomainCert issues the domain cert by the external CA instead of generating a self-signed one.
+func (d *ModuleRuntimeConfigs) issueDomainCert() error {
+	nlog.Infof("Issue domain cert by cert issuer %s, subject[%s]", d.CertIssuer.TypeName(), d.DomainID)
+	cert, err := d.ExternalIssuer.Issue(context.Background(), tlsutils.DomainCertTemplate(d.DomainID), d.DomainKey)
+	if err != nil {
+		nlog.Errorf("issue domain cert failed: %v", err)
+		return err
+	}
+	if err = tlsutils.WriteX509CertToFile(cert, d.DomainCertFile); err != nil {
+		return err
+	}
+	d.DomainCert = cert
+	return nil
+}
+
+// registerCertSources registers the ca and the domain cert to the cert inventory. The lite domain talks to the
+// others with the cert issued by the master, so it's preferred once received.
+func (d *ModuleRuntimeConfigs) registerCertSources() {
+	certinventory.Default.Register("ca", certinventory.X509Source(certinventory.KindCACert, "ca", func() *x509.Certificate {
+		return d.CACert
+	}))
+	certinventory.Default.Register("domain", certinventory.X509Source(certinventory.KindDomainCert, d.DomainID, func() *x509.Certificate {
+		if cert, ok := d.DomainCertByMasterValue.Load().(*x509.Certificate); ok && cert != nil {
+			return cert
+		}
+		return d.DomainCert
+	}))
+}
+
 func (d *ModuleRuntimeConfigs) EnsureDir() error {
 	if err := os.MkdirAll(filepath.Join(d.RootDir, common.CertPrefix), 0755); err != nil {
 		return err
@@ -194,11 +248,13 @@ func NewModuleRuntimeConfigs(_ context.Context, kusciaConf confloader.KusciaConf
 	if err = dependencies.LoadCaDomainKeyAndCert(); err != nil {
 		nlog.Fatal(err)
 	}
+	dependencies.registerCertSources()
 
 	// init exporter ports
 	dependencies.SsExportPort = 9092
 	dependencies.NodeExportPort = 9100
 	dependencies.MetricExportPort = 9091
+	dependencies.AgentExportPort = 9093
 
 	if strings.ToLower(dependencies.RunMode) == common.RunModeLite {
 		clients, err := kubeconfig.CreateClientSetsFromKubeconfig(dependencies.KubeconfigFile, dependencies.ApiserverEndpoint)
@@ -219,8 +275,23 @@ func GetTransportPort(configPath string) (int, error) {
 	return transConfig.HTTPConfig.Port, nil
 }
 
-// UpdateCommonConfigs update common configs.
-// Currently, only reloading of log levels is supported.
+// ConfigReloader applies the hot-reloadable sections of the new kuscia config to a running module.
+type ConfigReloader func(kusciaConf *confloader.KusciaConfig)
+
+var (
+	configReloadersMu sync.Mutex
+	configReloaders   = map[string]ConfigReloader{}
+)
+
+// RegisterConfigReloader registers the reloader of the module, it's called when kuscia.yaml changes.
+func RegisterConfigReloader(name string, reloader ConfigReloader) {
+	configReloadersMu.Lock()
+	defer configReloadersMu.Unlock()
+	configReloaders[name] = reloader
+}
+
+// UpdateCommonConfigs applies the hot-reloadable configs: the log level, and the sections reloaded by
+// the registered module reloaders.
 func UpdateCommonConfigs(_ context.Context, kusciaConf confloader.KusciaConfig) {
 
 	nlog.Infof("Update common configs, log level: %s", kusciaConf.LogLevel)
@@ -228,4 +299,11 @@ func UpdateCommonConfigs(_ context.Context, kusciaConf confloader.KusciaConfig)
 
 		nlog.Infof("Change log level to %s, failed with err: %s", kusciaConf.LogLevel, err.Error())
 	}
+
+	configReloadersMu.Lock()
+	defer configReloadersMu.Unlock()
+	for name, reloader := range configReloaders {
+		nlog.Infof("Reload configs of module %s", name)
+		reloader(&kusciaConf)
+	}
 }
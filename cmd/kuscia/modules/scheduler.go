@@ -35,6 +35,7 @@ import (
 
 	pkgcom "github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/scheduler/kusciascheduling"
+	"github.com/secretflow/kuscia/pkg/scheduler/placement"
 	"github.com/secretflow/kuscia/pkg/scheduler/queuesort"
 	"github.com/secretflow/kuscia/pkg/utils/common"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
@@ -104,7 +105,8 @@ func (s *schedulerModule) Run(ctx context.Context) error {
 	for {
 		cc, sched, err := app.Setup(ctx, s.opts,
 			app.WithPlugin(kusciascheduling.Name, kusciascheduling.New),
-			app.WithPlugin(queuesort.Name, queuesort.New))
+			app.WithPlugin(queuesort.Name, queuesort.New),
+			app.WithPlugin(placement.Name, placement.New))
 		if err != nil {
 			nlog.Error(err)
 			return err
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/secretflow/kuscia/cmd/kuscia/confloader"
 	pkgcom "github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/ssexporter"
 	"github.com/secretflow/kuscia/pkg/utils/readyz"
@@ -35,6 +36,9 @@ type ssExporterModule struct {
 
 func NewSsExporter(i *ModuleRuntimeConfigs) (Module, error) {
 	readyURI := fmt.Sprintf("http://127.0.0.1:%d", i.SsExportPort)
+	RegisterConfigReloader("ssexporter", func(kusciaConf *confloader.KusciaConfig) {
+		ssexporter.SetExportPeriod(kusciaConf.MetricUpdatePeriod)
+	})
 	return &ssExporterModule{
 		moduleRuntimeBase: moduleRuntimeBase{
 			name:         "ssexporter",
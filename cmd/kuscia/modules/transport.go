@@ -19,7 +19,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/secretflow/kuscia/pkg/transport/server/http"
+	"github.com/secretflow/kuscia/pkg/transport/server"
 	"github.com/secretflow/kuscia/pkg/utils/readyz"
 )
 
@@ -45,5 +45,5 @@ func NewTransport(i *ModuleRuntimeConfigs) (Module, error) {
 }
 
 func (t *transportModule) Run(ctx context.Context) error {
-	return http.Run(ctx, t.configPath)
+	return server.Run(ctx, t.configPath)
 }
@@ -0,0 +1,59 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/secretflow/kuscia/pkg/utils/readyz"
+	"github.com/secretflow/kuscia/pkg/webhook"
+)
+
+type webhookModule struct {
+	moduleRuntimeBase
+	server *webhook.Server
+}
+
+func NewWebhook(i *ModuleRuntimeConfigs) (Module, error) {
+	server, err := webhook.NewServer(&webhook.Config{
+		Port:       webhook.DefaultPort,
+		CACert:     i.CACert,
+		CAKey:      i.CAKey,
+		KubeClient: i.Clients.KubeClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookModule{
+		moduleRuntimeBase: moduleRuntimeBase{
+			name:         "webhook",
+			readyTimeout: 60 * time.Second,
+			rdz: readyz.NewFuncReadyZ(func(ctx context.Context) error {
+				if !server.Ready() {
+					return errors.New("admission webhook is not ready now")
+				}
+				return nil
+			}),
+		},
+		server: server,
+	}, nil
+}
+
+func (m *webhookModule) Run(ctx context.Context) error {
+	return m.server.Run(ctx)
+}
@@ -49,7 +49,7 @@ func NewStartCommand(ctx context.Context) *cobra.Command {
 
 			if startConfigs.logLevelHotReload {
 				// Kuscia config file listener
-				conflistener.LogLevelHotReloadListener(ctx, startConfigs.configFile)
+				conflistener.ConfigHotReloadListener(ctx, startConfigs.configFile)
 				nlog.Infof("Kuscia hot reload is enabled, config file path: %s", startConfigs.configFile)
 			}
 
@@ -59,7 +59,7 @@ func NewStartCommand(ctx context.Context) *cobra.Command {
 	cmd.Flags().StringVarP(&startConfigs.configFile, "config", "c", "etc/config/kuscia.yaml", "load config from file.")
 
 	// Must be enabled with -l=false or --loglevel-hot-reload=true. See: https://github.com/spf13/cobra/issues/1657
-	cmd.Flags().BoolVarP(&startConfigs.logLevelHotReload, "loglevel-hot-reload", "l", true, "enable kuscia configuration update, eg '-d=false'")
+	cmd.Flags().BoolVarP(&startConfigs.logLevelHotReload, "loglevel-hot-reload", "l", true, "enable hot reload of kuscia configuration, eg '-l=false'")
 
 	return cmd
 }
@@ -117,21 +117,23 @@ func Start(ctx context.Context, configFile string) error {
 	mm.Regist("transport", modules.NewTransport, autonomy, lite)
 	mm.Regist("reporter", modules.NewReporter, autonomy, master)
 	mm.Regist("diagnose", modules.NewDiagnose, autonomy, lite, master)
+	mm.Regist("webhook", modules.NewWebhook, autonomy, master)
 
 	mm.SetDependencies("agent", "envoy", "k3s", "kusciaapi")
 	mm.SetDependencies("envoy", "k3s")
-	mm.SetDependencies("controllers", "k3s")
+	mm.SetDependencies("controllers", "k3s", "webhook")
 	mm.SetDependencies("config", "k3s", "envoy", "domainroute", "controllers")
 	mm.SetDependencies("datamesh", "k3s", "config", "envoy", "domainroute")
 	mm.SetDependencies("domainroute", "k3s")
-	mm.SetDependencies("interconn", "k3s")
-	mm.SetDependencies("kusciaapi", "k3s", "config", "domainroute")
+	mm.SetDependencies("interconn", "k3s", "webhook")
+	mm.SetDependencies("kusciaapi", "k3s", "config", "domainroute", "webhook")
 	mm.SetDependencies("scheduler", "k3s")
 	mm.SetDependencies("ssexporter", "envoy")
 	mm.SetDependencies("metricexporter", "agent", "envoy", "ssexporter", "nodeexporter")
 	mm.SetDependencies("transport", "envoy")
 	mm.SetDependencies("k3s", "coredns")
 	mm.SetDependencies("reporter", "k3s", "kusciaapi")
+	mm.SetDependencies("webhook", "k3s")
 
 	mm.AddReadyHook(func(ctx context.Context, mdls map[string]modules.Module) error {
 		nlog.Info("Start... coredns controllers")
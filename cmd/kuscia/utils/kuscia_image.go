@@ -15,6 +15,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/olekukonko/tablewriter"
+	oci "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
 	"gopkg.in/yaml.v3"
@@ -61,57 +63,74 @@ type ImageService interface {
 	BuiltinImage(manifestFile string) error
 
 	ImageRun(name string) error
+
+	// ImageConfig returns the id and config of the image, the image is pulled if it doesn't exist locally.
+	ImageConfig(image, creds string) (string, *oci.ImageConfig, error)
 }
 
 type runtimeConfig struct {
-	Runtime  string `yaml:"runtime"`
-	LogLevel string `yaml:"logLevel"`
+	Runtime    string `yaml:"runtime"`
+	LogLevel   string `yaml:"logLevel"`
+	Containerd struct {
+		Endpoint string `yaml:"endpoint"`
+	} `yaml:"containerd"`
+}
+
+// criEndpoint returns the CRI endpoint of the containerd that runs the containers.
+func (c *runtimeConfig) criEndpoint(runtimeType string) string {
+	if runtimeType != config.ContainerdRuntime {
+		return common.DefaultCRIRemoteEndpoint()
+	}
+	if c.Containerd.Endpoint != "" {
+		return c.Containerd.Endpoint
+	}
+	return config.DefaultHostContainerdEndpoint
 }
 
-func initRuntimeAndLogLevel(runtimeType string) (string, error) {
+func initRuntimeAndLogLevel(runtimeType string) (string, *runtimeConfig, error) {
 
 	confFile := path.Join(common.DefaultKusciaHomePath(), "etc/conf/kuscia.yaml")
 	data, err := os.ReadFile(confFile)
 	if err != nil {
-		return "", fmt.Errorf("failed to read config file: %v", err)
+		return "", nil, fmt.Errorf("failed to read config file: %v", err)
 	}
 
 	localConfig := &runtimeConfig{}
 	if err = yaml.Unmarshal(data, &localConfig); err != nil {
-		return "", fmt.Errorf("parse config file failed %s", err.Error())
+		return "", nil, fmt.Errorf("parse config file failed %s", err.Error())
 	}
 
 	if localConfig.Runtime = strings.ToLower(strings.Trim(localConfig.Runtime, " ")); localConfig.Runtime == "" {
-		return "", fmt.Errorf("runtime in config file(%s) is empty", confFile)
+		return "", nil, fmt.Errorf("runtime in config file(%s) is empty", confFile)
 	}
 	if localConfig.LogLevel != "" {
 		err = nlog.ChangeLogLevel(localConfig.LogLevel)
 		if err != nil {
-			return "", fmt.Errorf("change log level failed: %s", err.Error())
+			return "", nil, fmt.Errorf("change log level failed: %s", err.Error())
 		}
 	}
-	if runtimeType == config.ProcessRuntime || runtimeType == config.ContainerRuntime {
-		return runtimeType, nil
+	if runtimeType == config.ProcessRuntime || runtimeType == config.ContainerRuntime || runtimeType == config.ContainerdRuntime {
+		return runtimeType, localConfig, nil
 	}
 
 	if runtimeType == "" { // get from config file
 		runtimeType = localConfig.Runtime
-		return runtimeType, nil
+		return runtimeType, localConfig, nil
 	}
-	return "", fmt.Errorf("invalidate runtime type: %s", runtimeType)
+	return "", nil, fmt.Errorf("invalidate runtime type: %s", runtimeType)
 
 }
 
 func NewImageService(runtimeType, storageDir string, args []string, cmd *cobra.Command) ImageService {
-	var err error
-	runtimeType, err = initRuntimeAndLogLevel(runtimeType)
+	runtimeType, localConfig, err := initRuntimeAndLogLevel(runtimeType)
 	if err != nil {
 		nlog.Fatal(err)
 	}
-	if runtimeType == config.ContainerRuntime {
+	if config.IsCRIRuntime(runtimeType) {
 		return &ContainerImage{
-			args: args,
-			cmd:  cmd,
+			endpoint: localConfig.criEndpoint(runtimeType),
+			args:     args,
+			cmd:      cmd,
 		}
 	} else {
 		ociStore, err := store.NewOCIStore(storageDir, mounter.Plain)
@@ -151,20 +170,27 @@ func (o *OciImage) LoadImage(tarFile string) error {
 }
 
 func (o *OciImage) PullImage(creds string) error {
-	var auth *runtimeapi.AuthConfig
-	if creds != "" {
-		up := strings.SplitN(creds, ":", 2)
-		if len(up) != 2 {
-			return fmt.Errorf("credentials must be username:password format")
-		}
-		auth = &runtimeapi.AuthConfig{
-			Username: up[0],
-			Password: up[1],
-		}
+	auth, err := parseCreds(creds)
+	if err != nil {
+		return err
 	}
 	return o.Store.PullImage(o.args[0], auth)
 }
 
+func parseCreds(creds string) (*runtimeapi.AuthConfig, error) {
+	if creds == "" {
+		return nil, nil
+	}
+	up := strings.SplitN(creds, ":", 2)
+	if len(up) != 2 {
+		return nil, fmt.Errorf("credentials must be username:password format")
+	}
+	return &runtimeapi.AuthConfig{
+		Username: up[0],
+		Password: up[1],
+	}, nil
+}
+
 func (o *OciImage) ListImage() error {
 	images, err := o.Store.ListImage()
 	if err != nil {
@@ -231,6 +257,30 @@ func (o *OciImage) ImageRun(name string) error {
 
 }
 
+func (o *OciImage) ImageConfig(image, creds string) (string, *oci.ImageConfig, error) {
+	imageName, err := kii.NewImageName(image)
+	if err != nil {
+		return "", nil, err
+	}
+	if !o.Store.CheckImageExist(imageName) {
+		auth, err := parseCreds(creds)
+		if err != nil {
+			return "", nil, err
+		}
+		if err := o.Store.PullImage(image, auth); err != nil {
+			return "", nil, fmt.Errorf("pull image %s failed: %v", image, err)
+		}
+	}
+	manifest, err := o.Store.GetImageManifest(imageName, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	if manifest == nil {
+		return "", nil, fmt.Errorf("image %s not found", image)
+	}
+	return manifest.ID, &manifest.Config, nil
+}
+
 func runpStartContainer(cname string, args []string) error {
 	sandboxBundle, imageStore, logDirectory, err := initContainerEnv(".")
 	if err != nil {
@@ -295,12 +345,19 @@ func initContainerEnv(rootDir string) (*layout.Bundle, store.Store, string, erro
 }
 
 type ContainerImage struct {
-	args []string
-	cmd  *cobra.Command
+	// endpoint is the CRI endpoint of the containerd, e.g. unix:///run/containerd/containerd.sock.
+	endpoint string
+	args     []string
+	cmd      *cobra.Command
+}
+
+// address returns the address of the containerd for ctr.
+func (c *ContainerImage) address() string {
+	return strings.TrimPrefix(c.endpoint, "unix://")
 }
 
 func (c *ContainerImage) RemoveImage() error {
-	cmdArgs := []string{"--runtime-endpoint", common.DefaultCRIRemoteEndpoint(), "rmi"}
+	cmdArgs := []string{"--runtime-endpoint", c.endpoint, "rmi"}
 	if len(c.args) > 0 {
 		cmdArgs = append(cmdArgs, c.args...)
 	}
@@ -309,20 +366,29 @@ func (c *ContainerImage) RemoveImage() error {
 }
 
 func (c *ContainerImage) LoadImage(tarFile string) error {
-	return runContainerdCmd(c.cmd.Context(), "ctr", "--address", common.ContainerdSocket(), "--namespace", common.KusciaDefaultNamespaceOfContainerd, "images", "import", "--no-unpack", tarFile)
+	return runContainerdCmd(c.cmd.Context(), "ctr", "--address", c.address(), "--namespace", common.KusciaDefaultNamespaceOfContainerd, "images", "import", "--no-unpack", tarFile)
 }
 
 func (c *ContainerImage) PullImage(creds string) error {
-	return runContainerdCmd(c.cmd.Context(), "crictl", "--runtime-endpoint", common.DefaultCRIRemoteEndpoint(), "pull", "--creds", creds, c.args[0])
+	return runContainerdCmd(c.cmd.Context(), "crictl", c.pullArgs(c.args[0], creds)...)
+}
+
+// pullArgs returns the crictl arguments to pull the image, --creds is only passed if the credentials are set.
+func (c *ContainerImage) pullArgs(image, creds string) []string {
+	args := []string{"--runtime-endpoint", c.endpoint, "pull"}
+	if creds != "" {
+		args = append(args, "--creds", creds)
+	}
+	return append(args, image)
 }
 
 func (c *ContainerImage) ListImage() error {
-	return runContainerdCmd(c.cmd.Context(), "crictl", "--runtime-endpoint", common.DefaultCRIRemoteEndpoint(), "images", "ls")
+	return runContainerdCmd(c.cmd.Context(), "crictl", "--runtime-endpoint", c.endpoint, "images", "ls")
 }
 
 func (c *ContainerImage) TagImage() error {
 	targetImage := store.CheckTagCompliance(c.args[1])
-	return runContainerdCmd(c.cmd.Context(), "ctr", "--address", common.ContainerdSocket(), "--namespace", common.KusciaDefaultNamespaceOfContainerd, "image", "tag", c.args[0], targetImage)
+	return runContainerdCmd(c.cmd.Context(), "ctr", "--address", c.address(), "--namespace", common.KusciaDefaultNamespaceOfContainerd, "image", "tag", c.args[0], targetImage)
 }
 
 func (c *ContainerImage) MountImage() error {
@@ -336,11 +402,44 @@ func (c *ContainerImage) BuiltinImage(manifestFile string) error {
 }
 
 func (c *ContainerImage) ImageRun(name string) error {
-	cmdArgs := []string{fmt.Sprintf("-a=%s", common.ContainerdSocket()), fmt.Sprintf("-n=%s", common.KusciaDefaultNamespaceOfContainerd), "run"}
+	cmdArgs := []string{fmt.Sprintf("-a=%s", c.address()), fmt.Sprintf("-n=%s", common.KusciaDefaultNamespaceOfContainerd), "run"}
 	cmdArgs = append(cmdArgs, c.args...)
 	return runContainerdCmd(c.cmd.Context(), "ctr", cmdArgs...)
 }
 
+// crictlImageInfo is the part of `crictl inspecti -o json` output we need.
+type crictlImageInfo struct {
+	Status struct {
+		ID string `json:"id"`
+	} `json:"status"`
+	Info struct {
+		ImageSpec struct {
+			Config oci.ImageConfig `json:"config"`
+		} `json:"imageSpec"`
+	} `json:"info"`
+}
+
+func (c *ContainerImage) ImageConfig(image, creds string) (string, *oci.ImageConfig, error) {
+	inspect := func() ([]byte, error) {
+		return exec.CommandContext(c.cmd.Context(), "crictl", "--runtime-endpoint", c.endpoint, "inspecti", "-o", "json", image).Output()
+	}
+	output, err := inspect()
+	if err != nil {
+		nlog.Infof("Image %s is not found locally, pull it", image)
+		if err := runContainerdCmd(c.cmd.Context(), "crictl", c.pullArgs(image, creds)...); err != nil {
+			return "", nil, fmt.Errorf("pull image %s failed: %v", image, err)
+		}
+		if output, err = inspect(); err != nil {
+			return "", nil, fmt.Errorf("inspect image %s failed: %v", image, err)
+		}
+	}
+	info := &crictlImageInfo{}
+	if err := json.Unmarshal(output, info); err != nil {
+		return "", nil, fmt.Errorf("parse image %s info failed: %v", image, err)
+	}
+	return info.Status.ID, &info.Info.ImageSpec.Config, nil
+}
+
 func MountImage(store store.Store, storageDir string, mountID, image, targetPath string) error {
 	nlog.Infof("mountID=%s, image=%s, targetPath=%s", mountID, image, targetPath)
 	imageName, err := kii.NewImageName(image)
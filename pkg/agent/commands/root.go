@@ -17,6 +17,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -30,12 +31,21 @@ import (
 	kubetypes "k8s.io/kubernetes/pkg/kubelet/types"
 
 	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/agent/eviction"
 	"github.com/secretflow/kuscia/pkg/agent/framework"
 	gc "github.com/secretflow/kuscia/pkg/agent/garbagecollection"
+	"github.com/secretflow/kuscia/pkg/agent/kri"
+	"github.com/secretflow/kuscia/pkg/agent/logforward"
 	"github.com/secretflow/kuscia/pkg/agent/middleware/plugin"
+	"github.com/secretflow/kuscia/pkg/agent/netisolation"
+	"github.com/secretflow/kuscia/pkg/agent/podexec"
 	"github.com/secretflow/kuscia/pkg/agent/provider"
 	"github.com/secretflow/kuscia/pkg/agent/resource"
 	"github.com/secretflow/kuscia/pkg/agent/source"
+	"github.com/secretflow/kuscia/pkg/agent/stats"
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/proxy"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/runtime"
 )
@@ -44,7 +54,7 @@ var (
 	ReadyChan = make(chan struct{})
 )
 
-func RunRootCommand(ctx context.Context, agentConfig *config.AgentConfig, kubeClient kubernetes.Interface) error {
+func RunRootCommand(ctx context.Context, agentConfig *config.AgentConfig, kubeClient kubernetes.Interface, kusciaClient kusciaclientset.Interface) error {
 	nlog.Infof("Run root command, Namespace=%v", agentConfig.Namespace)
 	if agentConfig.Namespace == "" {
 		return fmt.Errorf("agent can not start with an empty domain id, you must restart agent with flag --namespace=DOMAIN_ID")
@@ -199,6 +209,126 @@ func RunRootCommand(ctx context.Context, agentConfig *config.AgentConfig, kubeCl
 	}()
 	<-podsController.Ready()
 
+	// init imagePrewarmController, the runtime of the node may not support pre-pulling images
+	imagePuller, _ := podProvider.(kri.ImagePuller)
+	imagePrewarmController := framework.NewImagePrewarmController(&framework.ImagePrewarmControllerConfig{
+		Namespace:    agentConfig.Namespace,
+		NodeName:     node.Name,
+		KusciaClient: kusciaClient,
+		RegistryCfg:  &agentConfig.Registry,
+		Puller:       imagePuller,
+	})
+	go func() {
+		if err := imagePrewarmController.Run(ctx); err != nil {
+			nlog.Errorf("Failed to run image prewarm controller: %v", err)
+		}
+	}()
+
+	// init stats collector, the usage of the containers is collected from their cgroups
+	if agentConfig.Stats.Enable {
+		statsCollector, err := stats.NewCollector(&stats.CollectorConfig{
+			Namespace:      agentConfig.Namespace,
+			Runtime:        agentConfig.Provider.Runtime,
+			KubeClient:     kubeClient,
+			PodLister:      podsController.GetPodManager(),
+			StatusProvider: podsController.GetStatusManager(),
+			StatsCfg:       &agentConfig.Stats,
+		})
+		if err != nil {
+			nlog.Warnf("Skip collecting container stats, %v", err)
+		} else {
+			go func() {
+				if err := statsCollector.Run(ctx); err != nil {
+					nlog.Errorf("Failed to run stats collector: %v", err)
+				}
+			}()
+		}
+	}
+
+	// init eviction manager, the pod provider must be able to measure the local disk used by the pods
+	if agentConfig.Eviction.Enable {
+		if storageProvider, ok := podProvider.(kri.PodStorageProvider); ok {
+			evictionManager := eviction.NewManager(&eviction.ManagerConfig{
+				DiskPaths:       []string{agentConfig.RootDir, agentConfig.DiskPressurePath},
+				PodLister:       podsController.GetPodManager(),
+				StatusProvider:  podsController.GetStatusManager(),
+				StorageProvider: storageProvider,
+				PodKiller:       podsController,
+				EvictionCfg:     &agentConfig.Eviction,
+			})
+			go func() {
+				if err := evictionManager.Run(ctx); err != nil {
+					nlog.Errorf("Failed to run eviction manager: %v", err)
+				}
+			}()
+		} else {
+			nlog.Infof("Skip evicting pods by disk usage, not supported by runtime %q", agentConfig.Provider.Runtime)
+		}
+	}
+
+	// init network isolation, the pods of runc are isolated by the iptables of the node, and the ones of runk by the
+	// NetworkPolicies created by the pod provider
+	if agentConfig.NetworkIsolation.Enable {
+		switch agentConfig.Provider.Runtime {
+		case config.ContainerRuntime:
+			isolationManager, err := netisolation.NewManager(&netisolation.ManagerConfig{
+				PodLister:           podsController.GetPodManager(),
+				StatusProvider:      podsController.GetStatusManager(),
+				NetworkIsolationCfg: &agentConfig.NetworkIsolation,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to build network isolation manager, detail-> %v", err)
+			}
+			go func() {
+				if err := isolationManager.Run(ctx); err != nil {
+					nlog.Errorf("Failed to run network isolation manager: %v", err)
+				}
+			}()
+		case config.K8sRuntime:
+			// the pods are isolated by the NetworkPolicies created along with them
+		default:
+			nlog.Warnf("Skip isolating the network of jobs, not supported by runtime %q", agentConfig.Provider.Runtime)
+		}
+	}
+
+	// init exec server for the debug api of the kusciaapi
+	if execProvider, ok := podProvider.(kri.PodExecProvider); ok {
+		execServer := podexec.NewServer(&podexec.ServerConfig{
+			Namespace:    agentConfig.Namespace,
+			SocketPath:   filepath.Join(agentConfig.RootDir, common.AgentExecSocketPath),
+			PodGetter:    podsController.GetPodManager(),
+			ExecProvider: execProvider,
+		})
+		go func() {
+			if err := execServer.Run(ctx); err != nil {
+				nlog.Errorf("Failed to run exec server: %v", err)
+			}
+		}()
+	}
+
+	// init log forwarder, the logs of the task pods are shipped to the master before they are garbage collected
+	if agentConfig.LogForward.Enable {
+		forwarder, err := logforward.NewForwarder(&logforward.ForwarderConfig{
+			Namespace:      agentConfig.Namespace,
+			NodeName:       node.Name,
+			PodLogDir:      filepath.Join(agentConfig.StdoutPath, "pods"),
+			BufferDir:      filepath.Join(agentConfig.RootDir, common.LogForwardPrefix),
+			PodLister:      podsController.GetPodManager(),
+			StatusProvider: podsController.GetStatusManager(),
+			Pusher:         proxy.NewKusciaAPIClient(agentConfig.LogForward.Endpoint),
+			LogForwardCfg:  &agentConfig.LogForward,
+		})
+		if err != nil {
+			nlog.Warnf("Skip forwarding pod logs, %v", err)
+		} else {
+			go func() {
+				if err := forwarder.Run(ctx); err != nil {
+					nlog.Errorf("Failed to run log forwarder: %v", err)
+				}
+			}()
+		}
+	}
+
 	// init gc
 	logFileGCConfig := gc.DefaultLogFileGCConfig()
 	logFileGCConfig.Namespace = agentConfig.Namespace
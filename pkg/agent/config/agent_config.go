@@ -21,11 +21,13 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	"github.com/secretflow/kuscia/pkg/utils/network"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/paths"
@@ -37,6 +39,7 @@ const (
 	defaultStdoutPath          = "var/stdout"
 	defaultLocalImagePath      = "var/images"
 	defaultLocalSandboxRootDir = "sandbox"
+	defaultSeccompProfileRoot  = "etc/seccomp"
 
 	defaultK8sClientMaxQPS = 250
 	defaultPodsCapacity    = "500"
@@ -47,6 +50,9 @@ const (
 	defaultCRIRemoteEndpoint = "unix:///home/kuscia/containerd/run/containerd.sock"
 	defaultResolvConfig      = "/etc/resolv.conf"
 
+	// DefaultHostContainerdEndpoint is the default endpoint of the containerd running on the host.
+	DefaultHostContainerdEndpoint = "unix:///run/containerd/containerd.sock"
+
 	DefaultLogRotateMaxFiles   = 5
 	DefaultLogRotateMaxSize    = 512
 	DefaultLogRotateMaxSizeStr = "512Mi"
@@ -57,8 +63,24 @@ const (
 	ContainerRuntime = "runc"
 	K8sRuntime       = "runk"
 	ProcessRuntime   = "runp"
+	// ContainerdRuntime runs the containers by the containerd already running on the host through CRI, instead of
+	// the embedded containerd of runc.
+	ContainerdRuntime = "containerd"
+)
+
+// The policies of the containers running as root.
+const (
+	RootPolicyAllow         = "Allow"
+	RootPolicyDeny          = "Deny"
+	RootPolicyUserNamespace = "UserNamespace"
 )
 
+// IsCRIRuntime returns whether the containers are run by a containerd through CRI, either the embedded one or the one
+// on the host.
+func IsCRIRuntime(runtime string) bool {
+	return runtime == ContainerRuntime || runtime == ContainerdRuntime
+}
+
 type AgentLogCfg struct {
 	// Defaults to INFO.
 	LogLevel string `yaml:"level,omitempty"`
@@ -142,11 +164,42 @@ type CRIProviderCfg struct {
 	// agent will configure all containers to use this for DNS resolution
 	// instead of the host's DNS servers.
 	ClusterDNS []string `yaml:"clusterDNS,omitempty"`
+	// Searches are the DNS search domains appended to the ones of all containers.
+	Searches []string `yaml:"searches,omitempty"`
 	// ResolverConfig is the resolver configuration file used as the basis
 	// for the container DNS resolution configuration.
 	ResolverConfig string `yaml:"resolverConfig,omitempty"`
 
 	LocalRuntime LocalRuntimeCfg `yaml:"localRuntime"`
+
+	// ContainerSecurity is the default security profile of the containers, the containers of the app images may
+	// override it by their security contexts.
+	ContainerSecurity ContainerSecurityCfg `yaml:"containerSecurity,omitempty"`
+}
+
+// ContainerSecurityCfg restricts the syscalls and capabilities of the containers started by runc.
+type ContainerSecurityCfg struct {
+	// SeccompProfile is one of RuntimeDefault, Unconfined and Localhost. Defaults to RuntimeDefault.
+	SeccompProfile string `yaml:"seccompProfile,omitempty"`
+	// SeccompLocalhostProfile is the path of the seccomp profile relative to SeccompProfileRoot if SeccompProfile is
+	// Localhost.
+	SeccompLocalhostProfile string `yaml:"seccompLocalhostProfile,omitempty"`
+	// SeccompProfileRoot is the directory of the localhost seccomp profiles, relative to the root dir of kuscia if it's
+	// not absolute.
+	SeccompProfileRoot string `yaml:"seccompProfileRoot,omitempty"`
+	// DropCapabilities are dropped from the default capabilities of the runtime unless the container adds them.
+	DropCapabilities []string `yaml:"dropCapabilities,omitempty"`
+	// RootPolicy is one of Allow, Deny and UserNamespace. Defaults to Allow. The containers running as root are
+	// refused to start by Deny, and remapped into a user namespace by UserNamespace, unless the app image is exempted.
+	RootPolicy string `yaml:"rootPolicy,omitempty"`
+	// UserNamespace is the id mapping of the user namespace if RootPolicy is UserNamespace.
+	UserNamespace UserNamespaceCfg `yaml:"userNamespace,omitempty"`
+}
+
+// UserNamespaceCfg maps the uids and gids [0, Size) of the containers to [HostID, HostID+Size) of the host.
+type UserNamespaceCfg struct {
+	HostID uint32 `yaml:"hostID,omitempty"`
+	Size   uint32 `yaml:"size,omitempty"`
 }
 
 type LocalRuntimeCfg struct {
@@ -185,6 +238,9 @@ type K8sProviderCfg struct {
 	LogDirectory     string                 `yaml:"logDirectory,omitempty"`
 	LogMaxSize       string                 `yaml:"logMaxSize,omitempty"`
 	LogMaxFiles      int                    `yaml:"logMaxFiles,omitempty"`
+
+	// SandboxRuntimeClasses is the RuntimeClass of the backend k8s for each sandbox type of the app images.
+	SandboxRuntimeClasses map[string]string `yaml:"sandboxRuntimeClasses,omitempty"`
 }
 
 type ProviderCfg struct {
@@ -222,11 +278,108 @@ type RegistryCfg struct {
 	Allows  []RegistryAuth `yaml:"allows,omitempty"`
 }
 
+// registryMu guards the credentials of RegistryCfg, which are reloaded while the agent is running.
+var registryMu sync.RWMutex
+
+// DefaultAuth returns a copy of the default registry auth.
+func (c *RegistryCfg) DefaultAuth() RegistryAuth {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return c.Default
+}
+
+// UpdateCredentials updates the username and password of the registries with the same repository as
+// the given auths, and returns the repositories updated. The other fields are kept unchanged.
+func (c *RegistryCfg) UpdateCredentials(auths []RegistryAuth) []string {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	var updated []string
+	update := func(target *RegistryAuth) {
+		for _, auth := range auths {
+			if auth.Repository != target.Repository {
+				continue
+			}
+			if auth.Username != target.Username || auth.Password != target.Password {
+				target.Username, target.Password = auth.Username, auth.Password
+				if len(updated) == 0 || updated[len(updated)-1] != target.Repository {
+					updated = append(updated, target.Repository)
+				}
+			}
+			return
+		}
+	}
+	update(&c.Default)
+	for i := range c.Allows {
+		update(&c.Allows[i])
+	}
+	return updated
+}
+
 type CertCfg struct {
 	SigningCertFile string `yaml:"signingCertFile,omitempty"`
 	SigningKeyFile  string `yaml:"signingKeyFile,omitempty"`
 }
 
+type StatsCfg struct {
+	// Enable collecting the resource usage of the containers from their cgroups, only runc and runp are supported.
+	Enable bool `yaml:"enable"`
+	// CollectPeriod is the period to sample the containers.
+	CollectPeriod time.Duration `yaml:"collectPeriod,omitempty"`
+	// ReportPeriod is the period to write the resource usage of the running pods to their annotations, the final
+	// usage is written once the pod finishes.
+	ReportPeriod time.Duration `yaml:"reportPeriod,omitempty"`
+	// Port to serve the prometheus metrics of the containers, 0 means not to serve.
+	Port int `yaml:"port,omitempty"`
+}
+
+type EvictionCfg struct {
+	// Enable killing the pods exceeding their ephemeral storage limits, and the pods using the most local disk when
+	// the disk of the agent is under pressure.
+	Enable bool `yaml:"enable"`
+	// MonitorPeriod is the period to check the local disk usage of the node and the pods.
+	MonitorPeriod time.Duration `yaml:"monitorPeriod,omitempty"`
+}
+
+type NetworkIsolationCfg struct {
+	// Enable restricting the task pods to talk only to the pods of the same job, the allowed ports of the node and
+	// the allowed cidrs, which blocks the lateral movement between the concurrent jobs of different partners. The
+	// rules are iptables rules for runc and NetworkPolicies for runk, runp isn't supported.
+	Enable bool `yaml:"enable"`
+	// AllowedPorts are the ports of the node the task pods can connect, e.g. the gateway, DNS and DataMesh. The
+	// transport is connected through the gateway.
+	AllowedPorts []int `yaml:"allowedPorts,omitempty"`
+	// AllowedCIDRs are the destinations out of the node the task pods can connect, e.g. the storage of the data.
+	AllowedCIDRs []string `yaml:"allowedCIDRs,omitempty"`
+	// SyncPeriod is the period to reconcile the iptables rules with the pods of the node.
+	SyncPeriod time.Duration `yaml:"syncPeriod,omitempty"`
+}
+
+type GPUCfg struct {
+	// Enable discovering the nvidia gpus of the host and allocating them to the containers requesting nvidia.com/gpu.
+	// It only works for runc and runp.
+	Enable bool `yaml:"enable"`
+	// DeviceDir is the directory holding the nvidia device files.
+	DeviceDir string `yaml:"deviceDir,omitempty"`
+	// LibraryDirs are the directories of the cuda driver libraries, which are mounted into the containers.
+	LibraryDirs []string `yaml:"libraryDirs,omitempty"`
+}
+
+type LogForwardCfg struct {
+	// Enable shipping the stdout logs of the task pods to the master, where they are kept after the pods are garbage
+	// collected.
+	Enable bool `yaml:"enable"`
+	// Endpoint receives the logs instead of the master if it's not empty, it serves the PushArchivedLog api of
+	// KusciaAPI, e.g. http://log-archive.example.com:8080.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// FlushPeriod is the period to collect the new logs and ship them.
+	FlushPeriod time.Duration `yaml:"flushPeriod,omitempty"`
+	// BatchBytes is the max bytes of the logs shipped in one request.
+	BatchBytes int64 `yaml:"batchBytes,omitempty"`
+	// BufferBytes limits the logs buffered on the local disk while the receiver is unavailable, the collecting pauses
+	// until the buffer is drained.
+	BufferBytes int64 `yaml:"bufferBytes,omitempty"`
+}
+
 type PluginCfg struct {
 	Name   string    `yaml:"name,omitempty"`
 	Config yaml.Node `yaml:"config,omitempty"`
@@ -256,6 +409,7 @@ type AgentConfig struct {
 	KusciaAPIToken string
 	DomainKeyData  string
 	DomainKey      *rsa.PrivateKey
+	SecretBackend  secretbackend.Backend
 
 	// CA configuration.
 	DomainCACertFile string
@@ -274,6 +428,11 @@ type AgentConfig struct {
 	Node              NodeCfg              `yaml:"node,omitempty"`
 	Registry          RegistryCfg          `yaml:"registry,omitempty"`
 	Cert              CertCfg              `yaml:"cert,omitempty"`
+	Stats             StatsCfg             `yaml:"stats,omitempty"`
+	Eviction          EvictionCfg          `yaml:"eviction,omitempty"`
+	GPU               GPUCfg               `yaml:"gpu,omitempty"`
+	NetworkIsolation  NetworkIsolationCfg  `yaml:"networkIsolation,omitempty"`
+	LogForward        LogForwardCfg        `yaml:"logForward,omitempty"`
 	Plugins           []PluginCfg          `yaml:"plugins,omitempty"`
 }
 
@@ -331,18 +490,65 @@ func DefaultStaticAgentConfig() *AgentConfig {
 					SandboxRootDir: defaultLocalSandboxRootDir,
 					ImageRootDir:   path.Join(common.DefaultKusciaHomePath(), defaultLocalImagePath),
 				},
+				ContainerSecurity: ContainerSecurityCfg{
+					SeccompProfile:     "RuntimeDefault",
+					SeccompProfileRoot: defaultSeccompProfileRoot,
+					DropCapabilities:   []string{"NET_RAW", "SYS_ADMIN", "SYS_PTRACE", "SYS_MODULE"},
+					RootPolicy:         RootPolicyAllow,
+					UserNamespace: UserNamespaceCfg{
+						HostID: 100000,
+						Size:   65536,
+					},
+				},
 			},
+			K8s: K8sProviderCfg{
+				SandboxRuntimeClasses: map[string]string{
+					"gvisor": "gvisor",
+					"kata":   "kata",
+				},
+			},
+		},
+		Stats: StatsCfg{
+			Enable:        true,
+			CollectPeriod: 15 * time.Second,
+			ReportPeriod:  time.Minute,
+		},
+		Eviction: EvictionCfg{
+			Enable:        true,
+			MonitorPeriod: 10 * time.Second,
+		},
+		NetworkIsolation: NetworkIsolationCfg{
+			Enable:       false,
+			AllowedPorts: []int{53, 80},
+			SyncPeriod:   3 * time.Second,
+		},
+		GPU: GPUCfg{
+			Enable:      true,
+			DeviceDir:   "/dev",
+			LibraryDirs: []string{"/usr/lib/x86_64-linux-gnu", "/usr/lib/aarch64-linux-gnu", "/usr/lib64"},
+		},
+		LogForward: LogForwardCfg{
+			Enable:      false,
+			FlushPeriod: 10 * time.Second,
+			BatchBytes:  1 << 20,
+			BufferBytes: 256 << 20,
 		},
 		Plugins: []PluginCfg{
 			{
 				Name: common.PluginNameImageSecurity,
 			},
+			{
+				Name: common.PluginNameImageSignature,
+			},
 			{
 				Name: common.PluginNameEnvImport,
 			},
 			{
 				Name: common.PluginNameCertIssuance,
 			},
+			{
+				Name: common.PluginNameSecretInject,
+			},
 			{
 				Name: common.PluginNameConfigRender,
 			},
@@ -40,3 +40,22 @@ func TestLoadConfig(t *testing.T) {
 
 	assert.Equal(t, cfg.LogsPath, filepath.Join(rootDir, "kuscia", "var/logs"))
 }
+
+func TestRegistryCfgUpdateCredentials(t *testing.T) {
+	cfg := &RegistryCfg{
+		Default: RegistryAuth{Repository: "registry.a.com/secretflow", Username: "alice", Password: "old"},
+		Allows: []RegistryAuth{
+			{Repository: "registry.a.com/secretflow", Username: "alice", Password: "old"},
+			{Repository: "registry.b.com/secretflow", Username: "bob", Password: "bob"},
+		},
+	}
+	updated := cfg.UpdateCredentials([]RegistryAuth{
+		{Repository: "registry.a.com/secretflow", Username: "alice", Password: "new"},
+		{Repository: "registry.c.com/secretflow", Username: "carol", Password: "carol"},
+	})
+	assert.Equal(t, []string{"registry.a.com/secretflow"}, updated)
+	assert.Equal(t, "new", cfg.DefaultAuth().Password)
+	assert.Equal(t, "new", cfg.Allows[0].Password)
+	assert.Equal(t, "bob", cfg.Allows[1].Password)
+	assert.Len(t, cfg.Allows, 2)
+}
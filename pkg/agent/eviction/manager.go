@@ -0,0 +1,230 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eviction
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/disk"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	resourcehelper "k8s.io/kubernetes/pkg/api/v1/resource"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/agent/kri"
+	"github.com/secretflow/kuscia/pkg/agent/provider/node"
+	"github.com/secretflow/kuscia/pkg/agent/utils/format"
+	"github.com/secretflow/kuscia/pkg/agent/utils/podutils"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/math"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+// PodLister lists the pods bound to the node, e.g. pod.Manager.
+type PodLister interface {
+	GetPods() []*v1.Pod
+}
+
+// PodStatusProvider provides the latest status of the pods, e.g. status.Manager.
+type PodStatusProvider interface {
+	GetPodStatus(uid types.UID) (v1.PodStatus, bool)
+}
+
+// PodKiller kills the pod and fails it with the reason, e.g. framework.PodsController.
+type PodKiller interface {
+	EvictPod(pod *v1.Pod, reason, message string) error
+}
+
+type ManagerConfig struct {
+	// DiskPaths are the paths whose disks are checked for pressure.
+	DiskPaths       []string
+	PodLister       PodLister
+	StatusProvider  PodStatusProvider
+	StorageProvider kri.PodStorageProvider
+	PodKiller       PodKiller
+	EvictionCfg     *config.EvictionCfg
+}
+
+// Manager evicts the pods exceeding their ephemeral storage limits. When the disk of the node is under pressure, the
+// pod using the most local disk beyond its request is evicted, one pod each period until the pressure is relieved.
+type Manager struct {
+	diskPaths       []string
+	podLister       PodLister
+	statusProvider  PodStatusProvider
+	storageProvider kri.PodStorageProvider
+	podKiller       PodKiller
+	monitorPeriod   time.Duration
+
+	diskUsage func(path string) (*disk.UsageStat, error)
+}
+
+func NewManager(cfg *ManagerConfig) *Manager {
+	return &Manager{
+		diskPaths:       cfg.DiskPaths,
+		podLister:       cfg.PodLister,
+		statusProvider:  cfg.StatusProvider,
+		storageProvider: cfg.StorageProvider,
+		podKiller:       cfg.PodKiller,
+		monitorPeriod:   cfg.EvictionCfg.MonitorPeriod,
+		diskUsage:       disk.Usage,
+	}
+}
+
+// Run checks the disk usage periodically until the context is done.
+func (m *Manager) Run(ctx context.Context) error {
+	nlog.Infof("Starting eviction manager, monitor period=%v, disk paths=%v", m.monitorPeriod, m.diskPaths)
+	ticker := time.NewTicker(m.monitorPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.synchronize(ctx)
+		}
+	}
+}
+
+type podStorage struct {
+	pod     *v1.Pod
+	usage   uint64
+	request uint64
+}
+
+// exceeded returns the bytes used beyond the request.
+func (ps *podStorage) exceeded() int64 {
+	return int64(ps.usage) - int64(ps.request)
+}
+
+// synchronize evicts the pods and returns them.
+func (m *Manager) synchronize(ctx context.Context) []*v1.Pod {
+	var (
+		storages []*podStorage
+		evicted  []*v1.Pod
+	)
+	for _, pod := range m.activePods() {
+		usage, err := m.storageProvider.GetPodEphemeralStorageUsage(ctx, pod)
+		if err != nil {
+			nlog.Warnf("Failed to get ephemeral storage usage of pod %q, %v", format.Pod(pod), err)
+			continue
+		}
+
+		opts := resourcehelper.PodResourcesOptions{}
+		if limit, ok := resourcehelper.PodLimits(pod, opts)[v1.ResourceEphemeralStorage]; ok && usage > uint64(limit.Value()) {
+			message := fmt.Sprintf("Pod ephemeral local storage usage %s exceeds the total limit of containers %s.",
+				math.ByteCountBinary(int64(usage)), limit.String())
+			if m.evictPod(pod, message) {
+				evicted = append(evicted, pod)
+			}
+			continue
+		}
+
+		if message := m.hostDirectoryExceeded(ctx, pod); message != "" {
+			if m.evictPod(pod, message) {
+				evicted = append(evicted, pod)
+			}
+			continue
+		}
+
+		ps := &podStorage{pod: pod, usage: usage}
+		if request, ok := resourcehelper.PodRequests(pod, opts)[v1.ResourceEphemeralStorage]; ok {
+			ps.request = uint64(request.Value())
+		}
+		storages = append(storages, ps)
+	}
+	// the disk may be relieved by the evicted pods
+	if len(evicted) > 0 || len(storages) == 0 {
+		return evicted
+	}
+
+	pressure, pressureMsg := m.diskPressure()
+	if !pressure {
+		return evicted
+	}
+	sort.SliceStable(storages, func(i, j int) bool {
+		if storages[i].exceeded() != storages[j].exceeded() {
+			return storages[i].exceeded() > storages[j].exceeded()
+		}
+		return storages[i].usage > storages[j].usage
+	})
+	worst := storages[0]
+	message := fmt.Sprintf("The node was low on disk %s, the pod was using %s which exceeds its request of %s.",
+		pressureMsg, math.ByteCountBinary(int64(worst.usage)), math.ByteCountBinary(int64(worst.request)))
+	if m.evictPod(worst.pod, message) {
+		evicted = append(evicted, worst.pod)
+	}
+	return evicted
+}
+
+// hostDirectoryExceeded returns the message if a host directory volume of the pod exceeds its size limit.
+func (m *Manager) hostDirectoryExceeded(ctx context.Context, pod *v1.Pod) string {
+	hostDirs := podutils.HostDirectoryVolumes(pod)
+	for _, volume := range pod.Spec.Volumes {
+		if !hostDirs.Has(volume.Name) || volume.EmptyDir == nil || volume.EmptyDir.SizeLimit == nil {
+			continue
+		}
+		usage, err := m.storageProvider.GetHostDirectoryUsage(ctx, pod, volume.Name)
+		if err != nil {
+			nlog.Warnf("Failed to get usage of host directory %q of pod %q, %v", volume.Name, format.Pod(pod), err)
+			continue
+		}
+		if limit := volume.EmptyDir.SizeLimit; usage > uint64(limit.Value()) {
+			return fmt.Sprintf("Host directory volume %q usage %s exceeds its size limit %s.",
+				volume.Name, math.ByteCountBinary(int64(usage)), limit.String())
+		}
+	}
+	return ""
+}
+
+func (m *Manager) evictPod(pod *v1.Pod, message string) bool {
+	if err := m.podKiller.EvictPod(pod, common.PodReasonDiskPressure, message); err != nil {
+		nlog.Warnf("Failed to evict pod %q, %v", format.Pod(pod), err)
+		return false
+	}
+	return true
+}
+
+// activePods returns the pods which are not terminated.
+func (m *Manager) activePods() []*v1.Pod {
+	var pods []*v1.Pod
+	for _, pod := range m.podLister.GetPods() {
+		status := pod.Status
+		if s, ok := m.statusProvider.GetPodStatus(pod.UID); ok {
+			status = s
+		}
+		if status.Phase == v1.PodSucceeded || status.Phase == v1.PodFailed || pod.DeletionTimestamp != nil {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+	return pods
+}
+
+func (m *Manager) diskPressure() (bool, string) {
+	for _, path := range m.diskPaths {
+		du, err := m.diskUsage(path)
+		if err != nil {
+			nlog.Warnf("Failed to get disk usage of %s, %v", path, err)
+			continue
+		}
+		if node.HasDiskPressure(du) {
+			return true, fmt.Sprintf("%s(free=%s)", path, math.ByteCountBinary(int64(du.Free)))
+		}
+	}
+	return false, ""
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eviction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/common"
+)
+
+type fakePods struct {
+	pods          []*v1.Pod
+	usages        map[types.UID]uint64
+	hostDirUsages map[string]uint64
+	statuses      map[types.UID]v1.PodStatus
+	evicted       map[types.UID]string
+}
+
+func (f *fakePods) GetPods() []*v1.Pod {
+	return f.pods
+}
+
+func (f *fakePods) GetPodStatus(uid types.UID) (v1.PodStatus, bool) {
+	status, ok := f.statuses[uid]
+	return status, ok
+}
+
+func (f *fakePods) GetPodEphemeralStorageUsage(ctx context.Context, pod *v1.Pod) (uint64, error) {
+	return f.usages[pod.UID], nil
+}
+
+func (f *fakePods) GetHostDirectoryUsage(ctx context.Context, pod *v1.Pod, volumeName string) (uint64, error) {
+	return f.hostDirUsages[pod.Name+"/"+volumeName], nil
+}
+
+func (f *fakePods) EvictPod(pod *v1.Pod, reason, message string) error {
+	f.evicted[pod.UID] = reason
+	f.statuses[pod.UID] = v1.PodStatus{Phase: v1.PodFailed, Reason: reason, Message: message}
+	return nil
+}
+
+func newPod(name, request, limit string) *v1.Pod {
+	container := v1.Container{Name: "main"}
+	if request != "" {
+		container.Resources.Requests = v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse(request)}
+	}
+	if limit != "" {
+		container.Resources.Limits = v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse(limit)}
+	}
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "alice", UID: types.UID(name)},
+		Spec:       v1.PodSpec{Containers: []v1.Container{container}},
+	}
+}
+
+func newTestManager(f *fakePods, du *disk.UsageStat) *Manager {
+	m := NewManager(&ManagerConfig{
+		DiskPaths:       []string{"/home/kuscia"},
+		PodLister:       f,
+		StatusProvider:  f,
+		StorageProvider: f,
+		PodKiller:       f,
+		EvictionCfg:     &config.EvictionCfg{Enable: true, MonitorPeriod: time.Second},
+	})
+	m.diskUsage = func(path string) (*disk.UsageStat, error) {
+		return du, nil
+	}
+	return m
+}
+
+func podNames(pods []*v1.Pod) []string {
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestSynchronizeLimits(t *testing.T) {
+	f := &fakePods{
+		pods: []*v1.Pod{newPod("a", "", "1Gi"), newPod("b", "", ""), newPod("c", "", "1Gi")},
+		usages: map[types.UID]uint64{
+			"a": 2 << 30,
+			"b": 10 << 30,
+			"c": 512 << 20,
+		},
+		statuses: map[types.UID]v1.PodStatus{},
+		evicted:  map[types.UID]string{},
+	}
+	m := newTestManager(f, &disk.UsageStat{UsedPercent: 50, Free: 100 << 30})
+
+	assert.Equal(t, []string{"a"}, podNames(m.synchronize(context.Background())))
+	assert.Equal(t, common.PodReasonDiskPressure, f.evicted["a"])
+	assert.Contains(t, f.statuses["a"].Message, "exceeds the total limit of containers 1Gi")
+
+	// the failed pod is skipped
+	assert.Empty(t, m.synchronize(context.Background()))
+}
+
+func TestSynchronizeDiskPressure(t *testing.T) {
+	f := &fakePods{
+		pods: []*v1.Pod{newPod("a", "8Gi", ""), newPod("b", "", ""), newPod("c", "1Gi", "")},
+		usages: map[types.UID]uint64{
+			"a": 10 << 30,
+			"b": 1 << 30,
+			"c": 5 << 30,
+		},
+		statuses: map[types.UID]v1.PodStatus{},
+		evicted:  map[types.UID]string{},
+	}
+	du := &disk.UsageStat{UsedPercent: 99, Free: 1 << 30}
+	m := newTestManager(f, du)
+
+	// c exceeds its request most
+	assert.Equal(t, []string{"c"}, podNames(m.synchronize(context.Background())))
+	assert.Contains(t, f.statuses["c"].Message, "The node was low on disk /home/kuscia")
+	assert.Equal(t, []string{"a"}, podNames(m.synchronize(context.Background())))
+
+	du.UsedPercent = 50
+	du.Free = 100 << 30
+	assert.Empty(t, m.synchronize(context.Background()))
+	assert.Len(t, f.evicted, 2)
+}
+
+func TestSynchronizeHostDirectoryLimits(t *testing.T) {
+	withHostDir := func(pod *v1.Pod, sizeLimit string) *v1.Pod {
+		limit := resource.MustParse(sizeLimit)
+		pod.Annotations = map[string]string{common.HostDirectoryVolumesAnnotationKey: "ckpt"}
+		pod.Spec.Volumes = []v1.Volume{
+			{Name: "ckpt", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{SizeLimit: &limit}}},
+			{Name: "cache", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{SizeLimit: &limit}}},
+		}
+		return pod
+	}
+	f := &fakePods{
+		pods: []*v1.Pod{withHostDir(newPod("a", "", ""), "1Gi"), withHostDir(newPod("b", "", ""), "1Gi")},
+		hostDirUsages: map[string]uint64{
+			"a/ckpt":  2 << 30,
+			"b/ckpt":  512 << 20,
+			"b/cache": 2 << 30,
+		},
+		statuses: map[types.UID]v1.PodStatus{},
+		evicted:  map[types.UID]string{},
+	}
+	m := newTestManager(f, &disk.UsageStat{UsedPercent: 50, Free: 100 << 30})
+
+	// the cache volume of b is not a host directory
+	assert.Equal(t, []string{"a"}, podNames(m.synchronize(context.Background())))
+	assert.Contains(t, f.statuses["a"].Message, `Host directory volume "ckpt" usage 2.0GB exceeds its size limit 1Gi`)
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/agent/kri"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	kuscialistersv1alpha1 "github.com/secretflow/kuscia/pkg/crd/listers/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/queue"
+)
+
+const (
+	imagePrewarmControllerName = "image-prewarm-controller"
+	imagePrewarmMaxRetries     = 5
+)
+
+type ImagePrewarmControllerConfig struct {
+	Namespace    string
+	NodeName     string
+	KusciaClient kusciaclientset.Interface
+	RegistryCfg  *config.RegistryCfg
+	// Puller pulls the images, nil means the runtime of the node doesn't support pre-pulling images.
+	Puller kri.ImagePuller
+}
+
+// ImagePrewarmController pulls the images of the image prewarms pending on the node, and reports the progress in
+// the node status of the image prewarms.
+type ImagePrewarmController struct {
+	namespace       string
+	nodeName        string
+	kusciaClient    kusciaclientset.Interface
+	registryCfg     *config.RegistryCfg
+	puller          kri.ImagePuller
+	informerFactory kusciainformers.SharedInformerFactory
+	lister          kuscialistersv1alpha1.ImagePrewarmNamespaceLister
+	synced          cache.InformerSynced
+	workqueue       workqueue.RateLimitingInterface
+}
+
+func NewImagePrewarmController(cfg *ImagePrewarmControllerConfig) *ImagePrewarmController {
+	informerFactory := kusciainformers.NewSharedInformerFactoryWithOptions(cfg.KusciaClient, 0, kusciainformers.WithNamespace(cfg.Namespace))
+	informer := informerFactory.Kuscia().V1alpha1().ImagePrewarms()
+	c := &ImagePrewarmController{
+		namespace:       cfg.Namespace,
+		nodeName:        cfg.NodeName,
+		kusciaClient:    cfg.KusciaClient,
+		registryCfg:     cfg.RegistryCfg,
+		puller:          cfg.Puller,
+		informerFactory: informerFactory,
+		lister:          informer.Lister().ImagePrewarms(cfg.Namespace),
+		synced:          informer.Informer().HasSynced,
+		workqueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "imageprewarm"),
+	}
+
+	_, _ = informer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			ip, ok := obj.(*kusciaapisv1alpha1.ImagePrewarm)
+			if !ok {
+				return false
+			}
+			nodeStatus := c.getNodeStatus(ip)
+			return nodeStatus != nil && nodeStatus.Phase == kusciaapisv1alpha1.ImagePrewarmPending
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				queue.EnqueueObjectWithKey(obj, c.workqueue)
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				queue.EnqueueObjectWithKey(newObj, c.workqueue)
+			},
+		},
+	})
+	return c
+}
+
+// Run pulls the images one by one until the context is done.
+func (c *ImagePrewarmController) Run(ctx context.Context) error {
+	defer c.workqueue.ShutDown()
+
+	c.informerFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.synced) {
+		return fmt.Errorf("failed to wait for image prewarm caches to sync")
+	}
+
+	nlog.Infof("Starting %s", imagePrewarmControllerName)
+	go wait.Until(func() {
+		for queue.HandleQueueItem(ctx, imagePrewarmControllerName, c.workqueue, c.syncHandler, imagePrewarmMaxRetries) {
+		}
+	}, time.Second, ctx.Done())
+
+	<-ctx.Done()
+	return nil
+}
+
+func (c *ImagePrewarmController) syncHandler(ctx context.Context, key string) error {
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		nlog.Errorf("Failed to split image prewarm key %v, %v, skip processing it", key, err)
+		return nil
+	}
+	ip, err := c.lister.Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	nodeStatus := c.getNodeStatus(ip)
+	if nodeStatus == nil || nodeStatus.Phase != kusciaapisv1alpha1.ImagePrewarmPending {
+		return nil
+	}
+
+	if c.puller == nil {
+		return c.updateNodeStatus(ctx, name, kusciaapisv1alpha1.ImagePrewarmFailed, "the runtime of the node doesn't support pre-pulling images", "")
+	}
+	if err := c.updateNodeStatus(ctx, name, kusciaapisv1alpha1.ImagePrewarmPulling, "", ""); err != nil {
+		return err
+	}
+
+	image := ConstructImage(c.registryCfg.DefaultAuth().Repository, ip.Status.Image)
+	nlog.Infof("Pre-pulling image %q of image prewarm %s", image, key)
+	imageRef, err := c.puller.PrewarmImage(ctx, image, ip.Status.RegistryCredentialKey)
+	if err != nil {
+		nlog.Warnf("Failed to pre-pull image %q of image prewarm %s, %v", image, key, err)
+		return c.updateNodeStatus(ctx, name, kusciaapisv1alpha1.ImagePrewarmFailed, err.Error(), "")
+	}
+	nlog.Infof("Pre-pulled image %q of image prewarm %s", image, key)
+	return c.updateNodeStatus(ctx, name, kusciaapisv1alpha1.ImagePrewarmSucceeded, "", imageRef)
+}
+
+func (c *ImagePrewarmController) getNodeStatus(ip *kusciaapisv1alpha1.ImagePrewarm) *kusciaapisv1alpha1.ImagePrewarmNodeStatus {
+	for i := range ip.Status.NodeStatuses {
+		if ip.Status.NodeStatuses[i].NodeName == c.nodeName {
+			return &ip.Status.NodeStatuses[i]
+		}
+	}
+	return nil
+}
+
+// updateNodeStatus updates the status of the node only, the agents of the other nodes update the same image prewarm
+// concurrently, so the latest one is fetched on conflicts.
+func (c *ImagePrewarmController) updateNodeStatus(ctx context.Context, name string, phase kusciaapisv1alpha1.ImagePrewarmPhase, message, imageRef string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		ip, err := c.kusciaClient.KusciaV1alpha1().ImagePrewarms(c.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		nodeStatus := c.getNodeStatus(ip)
+		if nodeStatus == nil {
+			return nil
+		}
+		now := metav1.Now()
+		nodeStatus.Phase = phase
+		nodeStatus.Message = message
+		nodeStatus.ImageRef = imageRef
+		nodeStatus.LastTransitionTime = &now
+		_, err = c.kusciaClient.KusciaV1alpha1().ImagePrewarms(c.namespace).UpdateStatus(ctx, ip, metav1.UpdateOptions{})
+		return err
+	})
+}
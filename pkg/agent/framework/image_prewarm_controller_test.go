@@ -0,0 +1,97 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+)
+
+type fakeImagePuller struct {
+	pulled        []string
+	credentialKey string
+	err           error
+}
+
+func (p *fakeImagePuller) PrewarmImage(ctx context.Context, image, registryCredentialKey string) (string, error) {
+	if p.err != nil {
+		return "", p.err
+	}
+	p.pulled = append(p.pulled, image)
+	p.credentialKey = registryCredentialKey
+	return "sha256:" + image, nil
+}
+
+func runImagePrewarm(t *testing.T, puller *fakeImagePuller) *kusciaapisv1alpha1.ImagePrewarm {
+	ip := &kusciaapisv1alpha1.ImagePrewarm{
+		ObjectMeta: metav1.ObjectMeta{Name: "prewarm", Namespace: "alice"},
+		Spec:       kusciaapisv1alpha1.ImagePrewarmSpec{AppImage: "secretflow"},
+		Status: kusciaapisv1alpha1.ImagePrewarmStatus{
+			Phase:                 kusciaapisv1alpha1.ImagePrewarmPulling,
+			Image:                 "secretflow/secretflow:1.0.0",
+			RegistryCredentialKey: "harbor-robot",
+			NodeStatuses: []kusciaapisv1alpha1.ImagePrewarmNodeStatus{
+				{NodeName: "alice-1", Phase: kusciaapisv1alpha1.ImagePrewarmPending},
+				{NodeName: "alice-2", Phase: kusciaapisv1alpha1.ImagePrewarmPending},
+			},
+		},
+	}
+	client := kusciafake.NewSimpleClientset(ip)
+	cfg := &ImagePrewarmControllerConfig{
+		Namespace:    "alice",
+		NodeName:     "alice-1",
+		KusciaClient: client,
+		RegistryCfg:  &config.RegistryCfg{Default: config.RegistryAuth{Repository: "registry.example.com/secretflow"}},
+	}
+	if puller != nil {
+		cfg.Puller = puller
+	}
+	c := NewImagePrewarmController(cfg)
+	assert.NoError(t, c.informerFactory.Kuscia().V1alpha1().ImagePrewarms().Informer().GetStore().Add(ip))
+
+	assert.NoError(t, c.syncHandler(context.Background(), "alice/prewarm"))
+	got, err := client.KusciaV1alpha1().ImagePrewarms("alice").Get(context.Background(), "prewarm", metav1.GetOptions{})
+	assert.NoError(t, err)
+	// the node status of the other node is left to its agent
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmPending, got.Status.NodeStatuses[1].Phase)
+	return got
+}
+
+func TestImagePrewarmSucceeded(t *testing.T) {
+	puller := &fakeImagePuller{}
+	got := runImagePrewarm(t, puller)
+	assert.Equal(t, []string{"registry.example.com/secretflow/secretflow:1.0.0"}, puller.pulled)
+	assert.Equal(t, "harbor-robot", puller.credentialKey)
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmSucceeded, got.Status.NodeStatuses[0].Phase)
+	assert.Equal(t, "sha256:registry.example.com/secretflow/secretflow:1.0.0", got.Status.NodeStatuses[0].ImageRef)
+}
+
+func TestImagePrewarmFailed(t *testing.T) {
+	got := runImagePrewarm(t, &fakeImagePuller{err: fmt.Errorf("image not found")})
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmFailed, got.Status.NodeStatuses[0].Phase)
+	assert.Equal(t, "image not found", got.Status.NodeStatuses[0].Message)
+
+	got = runImagePrewarm(t, nil)
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmFailed, got.Status.NodeStatuses[0].Phase)
+	assert.Contains(t, got.Status.NodeStatuses[0].Message, "doesn't support")
+}
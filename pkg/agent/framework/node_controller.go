@@ -256,10 +256,12 @@ func (nc *NodeController) reuseNode(ctx context.Context) (bool, error) {
 	)
 
 	// step 1. update node spec.
+	cordoned := isCordoned(reusedNode)
 	reusedNode.Labels = localNode.Labels
 	reusedNode.Annotations = localNode.Annotations
 	reusedNode.Spec.Taints = localNode.Spec.Taints
 	reusedNode.Spec.Unschedulable = localNode.Spec.Unschedulable
+	keepCordon(reusedNode, cordoned)
 
 	reusedNode.Status.NodeInfo = localNode.Status.NodeInfo
 	reusedNode.Status.Capacity = localNode.Status.Capacity
@@ -349,6 +351,25 @@ func (nc *NodeController) exit() error {
 	return nil
 }
 
+func isCordoned(node *corev1.Node) bool {
+	return node.Labels[common.LabelNodeCordoned] == common.True
+}
+
+// keepCordon keeps the node cordoned by KusciaAPI unschedulable, since the labels and the spec of the node are
+// replaced by the local ones when the agent starts.
+func keepCordon(node *corev1.Node, cordoned bool) {
+	if !cordoned {
+		return
+	}
+	labels := make(map[string]string, len(node.Labels)+1)
+	for k, v := range node.Labels {
+		labels[k] = v
+	}
+	labels[common.LabelNodeCordoned] = common.True
+	node.Labels = labels
+	node.Spec.Unschedulable = true
+}
+
 func retriable(err error) bool {
 	return k8serrors.IsInternalError(err) || k8serrors.IsServiceUnavailable(err) ||
 		net.IsConnectionRefused(err) || k8serrors.IsConflict(err)
@@ -400,10 +421,12 @@ func (nc *NodeController) ensureNodeOnStartup(ctx context.Context) error {
 
 		// step 1. update node spec.
 		// node updates may only change labels, taints, or capacity
+		cordoned := isCordoned(nodeFromMaster)
 		nodeFromMaster.Labels = nc.nmt.Labels
 		nodeFromMaster.Annotations = nc.nmt.Annotations
 		nodeFromMaster.Spec.Taints = nc.nmt.Spec.Taints
 		nodeFromMaster.Spec.Unschedulable = nc.nmt.Spec.Unschedulable
+		keepCordon(nodeFromMaster, cordoned)
 		finalStatus := nc.nmt.Status.DeepCopy()
 		newNodeSpec, updateErr := nc.nodeStub.Update(ctx, nodeFromMaster, metav1.UpdateOptions{})
 		if updateErr != nil {
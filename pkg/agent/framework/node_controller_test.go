@@ -33,6 +33,7 @@ import (
 	"github.com/secretflow/kuscia/pkg/agent/config"
 	"github.com/secretflow/kuscia/pkg/agent/kri"
 	"github.com/secretflow/kuscia/pkg/agent/utils/nodeutils"
+	"github.com/secretflow/kuscia/pkg/common"
 )
 
 // mockNodeProvider is a basic node provider that only uses the passed in context
@@ -498,3 +499,21 @@ func waitForEvent(ctx context.Context, chEvent <-chan watch.Event, check func(wa
 
 	return chErr
 }
+
+func TestKeepCordon(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{common.LabelNodeCordoned: common.True}}}
+	cordoned := isCordoned(node)
+	assert.True(t, cordoned)
+
+	localLabels := map[string]string{common.LabelNodeNamespace: "alice"}
+	node.Labels = localLabels
+	node.Spec.Unschedulable = false
+	keepCordon(node, cordoned)
+	assert.True(t, node.Spec.Unschedulable)
+	assert.Equal(t, common.True, node.Labels[common.LabelNodeCordoned])
+	assert.Len(t, localLabels, 1)
+
+	node = &corev1.Node{}
+	keepCordon(node, isCordoned(node))
+	assert.False(t, node.Spec.Unschedulable)
+}
@@ -32,9 +32,11 @@ import (
 	utilnet "k8s.io/utils/net"
 
 	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
+	"github.com/secretflow/kuscia/pkg/agent/kri"
 
 	"github.com/secretflow/kuscia/pkg/agent/status"
 	"github.com/secretflow/kuscia/pkg/agent/utils/format"
+	"github.com/secretflow/kuscia/pkg/agent/utils/podutils"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 )
 
@@ -525,6 +527,11 @@ func (pc *PodsController) generateAPIPodStatus(pod *corev1.Pod, podStatus *pkgco
 		Type:   corev1.PodScheduled,
 		Status: corev1.ConditionTrue,
 	})
+	if conditionProvider, ok := pc.provider.(kri.PodConditionProvider); ok {
+		for _, c := range conditionProvider.GetPodConditions(pod) {
+			podutils.UpdateCondition(s, c.Type, c)
+		}
+	}
 
 	// set HostIP and initialize PodIP/PodIPs for host network pods
 	hostIPs := pc.nodeIPs
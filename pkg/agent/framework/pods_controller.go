@@ -46,6 +46,7 @@ import (
 	pkgpod "github.com/secretflow/kuscia/pkg/agent/pod"
 	"github.com/secretflow/kuscia/pkg/agent/status"
 	"github.com/secretflow/kuscia/pkg/agent/utils/format"
+	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 )
 
@@ -240,6 +241,10 @@ func (pc *PodsController) GetStatusManager() status.Manager {
 	return pc.statusManager
 }
 
+func (pc *PodsController) GetPodManager() pkgpod.Manager {
+	return pc.podManager
+}
+
 func (pc *PodsController) RegisterProvider(provider kri.PodProvider) {
 	pc.provider = provider
 }
@@ -353,6 +358,18 @@ func (pc *PodsController) deletePod(pod *corev1.Pod) error {
 	return nil
 }
 
+// EvictPod kills the pod and fails it with the reason and message, an event is recorded for the pod. It blocks until
+// the pod is killed or the grace period of the pod is exceeded.
+func (pc *PodsController) EvictPod(pod *corev1.Pod, reason, message string) error {
+	nlog.Warnf("Evicting pod %q, reason=%s, message=%s", format.Pod(pod), reason, message)
+	pc.recorder.Eventf(pod, corev1.EventTypeWarning, reason, message)
+	return killPodNow(pc.podWorkers, pc.recorder)(pod, true, nil, func(status *corev1.PodStatus) {
+		status.Phase = corev1.PodFailed
+		status.Reason = reason
+		status.Message = message
+	})
+}
+
 // HandlePodAdditions is the callback in SyncHandler for pods being added from
 // a config source.
 func (pc *PodsController) HandlePodAdditions(pods []*corev1.Pod) {
@@ -371,6 +388,11 @@ func (pc *PodsController) HandlePodAdditions(pods []*corev1.Pod) {
 		}
 
 		if !pc.podWorkers.IsPodTerminationRequested(pod.UID) {
+			if message := unsupportedSidecarsMessage(pc.provider, pod); message != "" {
+				pc.rejectPod(pod, "UnsupportedSidecars", message)
+				nlog.Warnf("Reject pod %q: %s", format.Pod(pod), message)
+				continue
+			}
 			if err := hook.Execute(&hook.PodAdditionContext{
 				Pod:         pod,
 				PodProvider: pc.provider,
@@ -474,6 +496,19 @@ func (pc *PodsController) dispatchWork(pod *corev1.Pod, syncType kubetypes.SyncP
 	})
 }
 
+// unsupportedSidecarsMessage returns why the sidecars of the pod can't run on the provider. The sidecars run as
+// ordinary containers if the provider doesn't support sidecars, which never exit and keep the pod from completing,
+// so only the pods restarted always are allowed.
+func unsupportedSidecarsMessage(provider kri.PodProvider, pod *corev1.Pod) string {
+	if pod.Annotations[common.SidecarContainersAnnotationKey] == "" || pod.Spec.RestartPolicy == corev1.RestartPolicyAlways {
+		return ""
+	}
+	if sp, ok := provider.(kri.SidecarProvider); ok && sp.SupportsSidecars() {
+		return ""
+	}
+	return fmt.Sprintf("sidecar containers %s are only supported by runk", pod.Annotations[common.SidecarContainersAnnotationKey])
+}
+
 // rejectPod records an event about the pod with the given reason and message,
 // and updates the pod to the failed phase in the status manage.
 func (pc *PodsController) rejectPod(pod *corev1.Pod, reason, message string) {
@@ -486,28 +521,34 @@ func (pc *PodsController) rejectPod(pod *corev1.Pod, reason, message string) {
 
 // constructPodImage construct the image with the configured repository if image does not contain repository information.
 func (pc *PodsController) constructPodImage(pod *corev1.Pod) {
-	if pc.registryCfg.Default.Repository == "" {
-		return
-	}
-
-	trimRepo := strings.TrimRight(pc.registryCfg.Default.Repository, "/")
 	for i := range pod.Spec.Containers {
 		container := &pod.Spec.Containers[i]
-		switch strings.Count(container.Image, "/") {
-		case 1:
-			oldImage := container.Image
-			imageSlice := strings.Split(strings.Trim(container.Image, "/"), "/")
-			container.Image = fmt.Sprintf("%v/%v", trimRepo, imageSlice[len(imageSlice)-1])
-			nlog.Debugf("Replace image %q with %q, container=%v, pod=%v", oldImage, container.Image, container.Name, format.Pod(pod))
-		case 0:
-			oldImage := container.Image
-			container.Image = fmt.Sprintf("%v/%v", trimRepo, container.Image)
+		oldImage := container.Image
+		container.Image = ConstructImage(pc.registryCfg.DefaultAuth().Repository, container.Image)
+		if container.Image != oldImage {
 			nlog.Debugf("Replace image %q with %q, container=%v, pod=%v", oldImage, container.Image, container.Name, format.Pod(pod))
-		default:
 		}
 	}
 }
 
+// ConstructImage prefixes the image with the repository if the image does not contain repository information.
+func ConstructImage(repository, image string) string {
+	if repository == "" {
+		return image
+	}
+
+	trimRepo := strings.TrimRight(repository, "/")
+	switch strings.Count(image, "/") {
+	case 1:
+		imageSlice := strings.Split(strings.Trim(image, "/"), "/")
+		return fmt.Sprintf("%v/%v", trimRepo, imageSlice[len(imageSlice)-1])
+	case 0:
+		return fmt.Sprintf("%v/%v", trimRepo, image)
+	default:
+		return image
+	}
+}
+
 // syncPod is the transaction script for the sync of a single pod (setting up)
 // a pod. This method is reentrant and expected to converge a pod towards the
 // desired state of the spec.
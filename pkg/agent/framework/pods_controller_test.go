@@ -0,0 +1,51 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/agent/kri"
+	"github.com/secretflow/kuscia/pkg/common"
+)
+
+type fakeSidecarProvider struct {
+	kri.PodProvider
+}
+
+func (p *fakeSidecarProvider) SupportsSidecars() bool {
+	return true
+}
+
+func TestUnsupportedSidecarsMessage(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-1"},
+		Spec:       v1.PodSpec{RestartPolicy: v1.RestartPolicyNever},
+	}
+	var criProvider kri.PodProvider
+	assert.Empty(t, unsupportedSidecarsMessage(criProvider, pod))
+
+	pod.Annotations = map[string]string{common.SidecarContainersAnnotationKey: "log"}
+	assert.NotEmpty(t, unsupportedSidecarsMessage(criProvider, pod))
+	assert.Empty(t, unsupportedSidecarsMessage(&fakeSidecarProvider{}, pod))
+
+	// the sidecars of the pods restarted always run as ordinary containers
+	pod.Spec.RestartPolicy = v1.RestartPolicyAlways
+	assert.Empty(t, unsupportedSidecarsMessage(criProvider, pod))
+}
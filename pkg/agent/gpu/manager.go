@@ -0,0 +1,320 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpu
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
+	"github.com/secretflow/kuscia/pkg/agent/utils/format"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/paths"
+)
+
+const (
+	// ResourceName is the extended resource of the nvidia gpus.
+	ResourceName v1.ResourceName = "nvidia.com/gpu"
+
+	// containerLibraryDir and containerBinaryDir are in the library and binary paths of the cuda images.
+	containerLibraryDir = "/usr/local/nvidia/lib64"
+	containerBinaryDir  = "/usr/local/nvidia/bin"
+
+	envNvidiaVisibleDevices = "NVIDIA_VISIBLE_DEVICES"
+	envCudaVisibleDevices   = "CUDA_VISIBLE_DEVICES"
+	envCudaDeviceOrder      = "CUDA_DEVICE_ORDER"
+)
+
+var (
+	gpuDevicePattern = regexp.MustCompile(`^nvidia([0-9]+)$`)
+
+	// controlDevices are required by the driver besides the gpu devices.
+	controlDevices = []string{"nvidiactl", "nvidia-uvm", "nvidia-uvm-tools", "nvidia-modeset"}
+
+	libraryPatterns = []string{"libcuda.so*", "libnvidia-ml.so*", "libnvidia-ptxjitcompiler.so*", "libnvidia-nvvm.so*"}
+
+	binaries = []string{"nvidia-smi"}
+)
+
+type ManagerConfig struct {
+	// Runtime is the runtime of the agent, only runc and runp are supported.
+	Runtime string
+	// CheckpointPath stores the allocations, so that they are recovered after the agent restarts.
+	CheckpointPath string
+	GPUCfg         *config.GPUCfg
+}
+
+// checkpoint records the gpu indexes allocated to the containers, pod uid => container name => gpu indexes.
+type checkpoint map[types.UID]map[string][]int
+
+// Manager discovers the nvidia gpus of the host and allocates them to the containers exclusively. The allocated
+// devices are mapped into the containers by runc, while the processes of runp see all the devices of the host and
+// CUDA_VISIBLE_DEVICES restricts them.
+type Manager struct {
+	runtime        string
+	checkpointPath string
+
+	// devices are the gpu device files indexed by the minor number.
+	devices        map[int]string
+	controlDevices []string
+	// libraries are the host files mounted into the containers, host path => container path.
+	libraries map[string]string
+
+	mu          sync.Mutex
+	allocations checkpoint
+}
+
+func NewManager(cfg *ManagerConfig) (*Manager, error) {
+	m := &Manager{
+		runtime:        cfg.Runtime,
+		checkpointPath: cfg.CheckpointPath,
+		devices:        map[int]string{},
+		libraries:      map[string]string{},
+		allocations:    checkpoint{},
+	}
+	if err := m.discoverDevices(cfg.GPUCfg.DeviceDir); err != nil {
+		return nil, err
+	}
+	if len(m.devices) == 0 {
+		return m, nil
+	}
+	m.discoverLibraries(cfg.GPUCfg.LibraryDirs)
+	if err := m.loadCheckpoint(); err != nil {
+		return nil, err
+	}
+	nlog.Infof("Discovered %d gpus, control devices=%v, libraries=%d", len(m.devices), m.controlDevices, len(m.libraries))
+	return m, nil
+}
+
+// Capacity returns the number of the gpus.
+func (m *Manager) Capacity() int64 {
+	return int64(len(m.devices))
+}
+
+func (m *Manager) discoverDevices(deviceDir string) error {
+	entries, err := os.ReadDir(deviceDir)
+	if err != nil {
+		return fmt.Errorf("failed to read device dir %q, detail-> %v", deviceDir, err)
+	}
+	for _, entry := range entries {
+		match := gpuDevicePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		index, _ := strconv.Atoi(match[1])
+		m.devices[index] = filepath.Join(deviceDir, entry.Name())
+	}
+	for _, name := range controlDevices {
+		path := filepath.Join(deviceDir, name)
+		if paths.CheckFileOrDirExist(path) {
+			m.controlDevices = append(m.controlDevices, path)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) discoverLibraries(libraryDirs []string) {
+	for _, dir := range libraryDirs {
+		for _, pattern := range libraryPatterns {
+			files, _ := filepath.Glob(filepath.Join(dir, pattern))
+			for _, file := range files {
+				m.libraries[file] = filepath.Join(containerLibraryDir, filepath.Base(file))
+			}
+		}
+	}
+	for _, dir := range []string{"/usr/bin", "/usr/local/bin"} {
+		for _, name := range binaries {
+			file := filepath.Join(dir, name)
+			if paths.CheckFileExist(file) {
+				m.libraries[file] = filepath.Join(containerBinaryDir, name)
+			}
+		}
+	}
+}
+
+// requestedGPUs returns the gpus of the container, the limit takes precedence as the extended resources must be
+// equal in requests and limits.
+func requestedGPUs(container *v1.Container) int {
+	if q, ok := container.Resources.Limits[ResourceName]; ok {
+		return int(q.Value())
+	}
+	if q, ok := container.Resources.Requests[ResourceName]; ok {
+		return int(q.Value())
+	}
+	return 0
+}
+
+// Allocate assigns the gpus requested by the container and adds the devices, the driver libraries and the
+// environments to the run options. The allocation of the restarted container is reused.
+func (m *Manager) Allocate(pod *v1.Pod, container *v1.Container, opts *pkgcontainer.RunContainerOptions) error {
+	count := requestedGPUs(container)
+	if count == 0 {
+		return nil
+	}
+
+	indexes, err := m.allocate(pod.UID, container.Name, count)
+	if err != nil {
+		return err
+	}
+	nlog.Infof("Allocated gpus %v to container %q of pod %q", indexes, container.Name, format.Pod(pod))
+
+	m.applyOptions(indexes, opts)
+	return nil
+}
+
+func (m *Manager) allocate(uid types.UID, containerName string, count int) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if indexes, ok := m.allocations[uid][containerName]; ok && len(indexes) == count {
+		return indexes, nil
+	}
+
+	used := map[int]bool{}
+	for podUID, containers := range m.allocations {
+		for name, indexes := range containers {
+			if podUID == uid && name == containerName {
+				continue
+			}
+			for _, index := range indexes {
+				used[index] = true
+			}
+		}
+	}
+	var free []int
+	for index := range m.devices {
+		if !used[index] {
+			free = append(free, index)
+		}
+	}
+	if len(free) < count {
+		return nil, fmt.Errorf("insufficient gpus, requested %d, available %d", count, len(free))
+	}
+	sort.Ints(free)
+	indexes := free[:count]
+
+	if m.allocations[uid] == nil {
+		m.allocations[uid] = map[string][]int{}
+	}
+	m.allocations[uid][containerName] = indexes
+	if err := m.saveCheckpoint(); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
+
+func (m *Manager) applyOptions(indexes []int, opts *pkgcontainer.RunContainerOptions) {
+	visible := make([]string, len(indexes))
+	for i, index := range indexes {
+		visible[i] = strconv.Itoa(index)
+	}
+	opts.Envs = append(opts.Envs, pkgcontainer.EnvVar{Name: envNvidiaVisibleDevices, Value: strings.Join(visible, ",")})
+
+	if m.runtime == config.ProcessRuntime {
+		// the processes share the devices of the host, the order of cuda is consistent with the device minor number
+		opts.Envs = append(opts.Envs,
+			pkgcontainer.EnvVar{Name: envCudaDeviceOrder, Value: "PCI_BUS_ID"},
+			pkgcontainer.EnvVar{Name: envCudaVisibleDevices, Value: strings.Join(visible, ",")})
+	} else {
+		devices := append([]string{}, m.controlDevices...)
+		for _, index := range indexes {
+			devices = append(devices, m.devices[index])
+		}
+		for _, device := range devices {
+			opts.Devices = append(opts.Devices, pkgcontainer.DeviceInfo{
+				PathOnHost:      device,
+				PathInContainer: device,
+				Permissions:     "rwm",
+			})
+		}
+	}
+
+	hostPaths := make([]string, 0, len(m.libraries))
+	for hostPath := range m.libraries {
+		hostPaths = append(hostPaths, hostPath)
+	}
+	sort.Strings(hostPaths)
+	for _, hostPath := range hostPaths {
+		opts.Mounts = append(opts.Mounts, pkgcontainer.Mount{
+			Name:          "nvidia-" + filepath.Base(hostPath),
+			ContainerPath: m.libraries[hostPath],
+			HostPath:      hostPath,
+			ReadOnly:      true,
+		})
+	}
+}
+
+// Release frees the gpus of the pod.
+func (m *Manager) Release(uid types.UID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.allocations[uid]; !ok {
+		return
+	}
+	delete(m.allocations, uid)
+	if err := m.saveCheckpoint(); err != nil {
+		nlog.Warnf("Failed to save gpu checkpoint, %v", err)
+	}
+	nlog.Infof("Released gpus of pod %q", uid)
+}
+
+// RemoveStalePods frees the gpus of the pods which are not active, e.g. the pods deleted while the agent is down.
+func (m *Manager) RemoveStalePods(isActive func(uid types.UID) bool) {
+	m.mu.Lock()
+	var stale []types.UID
+	for uid := range m.allocations {
+		if !isActive(uid) {
+			stale = append(stale, uid)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, uid := range stale {
+		m.Release(uid)
+	}
+}
+
+func (m *Manager) loadCheckpoint() error {
+	if !paths.CheckFileExist(m.checkpointPath) {
+		return nil
+	}
+	if err := paths.ReadJSON(m.checkpointPath, &m.allocations); err != nil {
+		var syntaxErr *json.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			return fmt.Errorf("failed to read gpu checkpoint %q, detail-> %v", m.checkpointPath, err)
+		}
+		// the allocations are rebuilt when the containers restart
+		nlog.Warnf("Failed to parse gpu checkpoint %q, discard it, %v", m.checkpointPath, err)
+		m.allocations = checkpoint{}
+	}
+	return nil
+}
+
+func (m *Manager) saveCheckpoint() error {
+	return paths.WriteJSON(m.checkpointPath, m.allocations)
+}
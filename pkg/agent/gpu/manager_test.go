@@ -0,0 +1,123 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gpu
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
+)
+
+func newTestManager(t *testing.T, runtime string) (*Manager, *ManagerConfig) {
+	rootDir := t.TempDir()
+	deviceDir := filepath.Join(rootDir, "dev")
+	libraryDir := filepath.Join(rootDir, "lib")
+	for _, file := range []string{
+		filepath.Join(deviceDir, "nvidia0"),
+		filepath.Join(deviceDir, "nvidia1"),
+		filepath.Join(deviceDir, "nvidiactl"),
+		filepath.Join(deviceDir, "null"),
+		filepath.Join(libraryDir, "libcuda.so.1"),
+		filepath.Join(libraryDir, "libc.so.6"),
+	} {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+		assert.NoError(t, os.WriteFile(file, nil, 0644))
+	}
+
+	cfg := &ManagerConfig{
+		Runtime:        runtime,
+		CheckpointPath: filepath.Join(rootDir, "var", "gpu_checkpoint"),
+		GPUCfg: &config.GPUCfg{
+			Enable:      true,
+			DeviceDir:   deviceDir,
+			LibraryDirs: []string{libraryDir},
+		},
+	}
+	m, err := NewManager(cfg)
+	assert.NoError(t, err)
+	return m, cfg
+}
+
+func newGPUPod(uid string, gpus int64) (*v1.Pod, *v1.Container) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: uid, Namespace: "alice", UID: types.UID(uid)},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "main",
+				Resources: v1.ResourceRequirements{
+					Limits: v1.ResourceList{ResourceName: *resource.NewQuantity(gpus, resource.DecimalSI)},
+				},
+			}},
+		},
+	}
+	return pod, &pod.Spec.Containers[0]
+}
+
+func TestAllocate(t *testing.T) {
+	m, cfg := newTestManager(t, config.ContainerRuntime)
+	assert.Equal(t, int64(2), m.Capacity())
+
+	podA, containerA := newGPUPod("a", 1)
+	opts := &pkgcontainer.RunContainerOptions{}
+	assert.NoError(t, m.Allocate(podA, containerA, opts))
+	assert.Contains(t, opts.Envs, pkgcontainer.EnvVar{Name: envNvidiaVisibleDevices, Value: "0"})
+	assert.Equal(t, []string{filepath.Join(cfg.GPUCfg.DeviceDir, "nvidiactl"), filepath.Join(cfg.GPUCfg.DeviceDir, "nvidia0")},
+		[]string{opts.Devices[0].PathOnHost, opts.Devices[1].PathOnHost})
+	assert.Len(t, opts.Mounts, 1)
+	assert.Equal(t, "/usr/local/nvidia/lib64/libcuda.so.1", opts.Mounts[0].ContainerPath)
+
+	// the restarted container reuses the gpu
+	opts = &pkgcontainer.RunContainerOptions{}
+	assert.NoError(t, m.Allocate(podA, containerA, opts))
+	assert.Contains(t, opts.Envs, pkgcontainer.EnvVar{Name: envNvidiaVisibleDevices, Value: "0"})
+
+	podB, containerB := newGPUPod("b", 2)
+	assert.Error(t, m.Allocate(podB, containerB, &pkgcontainer.RunContainerOptions{}))
+
+	// the allocations are recovered from the checkpoint
+	recovered, err := NewManager(cfg)
+	assert.NoError(t, err)
+	recovered.Release(podA.UID)
+	opts = &pkgcontainer.RunContainerOptions{}
+	assert.NoError(t, recovered.Allocate(podB, containerB, opts))
+	assert.Contains(t, opts.Envs, pkgcontainer.EnvVar{Name: envNvidiaVisibleDevices, Value: "0,1"})
+
+	recovered.RemoveStalePods(func(uid types.UID) bool { return false })
+	assert.Empty(t, recovered.allocations)
+}
+
+func TestAllocateProcessRuntime(t *testing.T) {
+	m, _ := newTestManager(t, config.ProcessRuntime)
+
+	pod, container := newGPUPod("a", 2)
+	opts := &pkgcontainer.RunContainerOptions{}
+	assert.NoError(t, m.Allocate(pod, container, opts))
+	assert.Empty(t, opts.Devices)
+	assert.Contains(t, opts.Envs, pkgcontainer.EnvVar{Name: envCudaVisibleDevices, Value: "0,1"})
+
+	pod, container = newGPUPod("b", 0)
+	opts = &pkgcontainer.RunContainerOptions{}
+	assert.NoError(t, m.Allocate(pod, container, opts))
+	assert.Empty(t, opts.Envs)
+}
@@ -20,6 +20,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/remotecommand"
 
 	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
 )
@@ -81,3 +82,43 @@ type PodLifecycleHandler interface {
 type PodProvider interface {
 	PodLifecycleHandler
 }
+
+// ImagePuller is implemented by the pod providers managing the images of the node, the images can be pre-pulled
+// before the pods using them are scheduled.
+type ImagePuller interface {
+	// PrewarmImage pulls the image if it's not present, and returns the reference of the local image. The registry
+	// credential of the key in the confmanager is used if not empty.
+	PrewarmImage(ctx context.Context, image, registryCredentialKey string) (string, error)
+}
+
+// SidecarProvider is implemented by the pod providers which run the containers listed in the sidecar annotation as
+// native sidecars, which are stopped after the other containers exit.
+type SidecarProvider interface {
+	SupportsSidecars() bool
+}
+
+// PodStorageProvider is implemented by the pod providers which can measure the local disk used by the pods, it is
+// used to enforce the ephemeral storage limits of the pods and to evict pods under node disk pressure.
+type PodStorageProvider interface {
+	// GetPodEphemeralStorageUsage returns the bytes of the local disk used by the pod, including the writable layers
+	// of the containers, the pod directory holding the emptyDir volumes and the logs.
+	GetPodEphemeralStorageUsage(ctx context.Context, pod *v1.Pod) (uint64, error)
+	// GetHostDirectoryUsage returns the bytes used by the host directory volume of the pod, it is checked against the
+	// size limit of the volume.
+	GetHostDirectoryUsage(ctx context.Context, pod *v1.Pod, volumeName string) (uint64, error)
+}
+
+// PodConditionProvider is implemented by the pod providers which report extra conditions of the pods, e.g. the
+// checkpoint written by the engine.
+type PodConditionProvider interface {
+	// GetPodConditions returns the extra conditions of the pod, they are merged into the pod status by type.
+	GetPodConditions(pod *v1.Pod) []v1.PodCondition
+}
+
+// PodExecProvider is implemented by the pod providers which can run commands in the running containers, it is used
+// by the debug api to diagnose the engines.
+type PodExecProvider interface {
+	// ExecInContainer runs the command in the container of the pod with the streams, it returns a
+	// k8s.io/client-go/util/exec.ExitError if the command exits with a non-zero code.
+	ExecInContainer(ctx context.Context, pod *v1.Pod, container string, cmd []string, streams remotecommand.StreamOptions) error
+}
@@ -28,9 +28,11 @@ import (
 	"path/filepath"
 	"regexp"
 	goruntime "runtime"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/armon/circbuf"
@@ -53,6 +55,7 @@ import (
 
 	"github.com/secretflow/kuscia/pkg/agent/config"
 	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
+	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/paths"
 
@@ -129,6 +132,22 @@ func (m *kubeGenericRuntimeManager) recordContainerEvent(pod *v1.Pod, container
 	m.recorder.Event(ref, eventType, reason, eventMessage)
 }
 
+// makeDevices generates container devices for kubelet runtime v1.
+func makeDevices(opts *pkgcontainer.RunContainerOptions) []*runtimeapi.Device {
+	devices := make([]*runtimeapi.Device, len(opts.Devices))
+
+	for idx := range opts.Devices {
+		device := opts.Devices[idx]
+		devices[idx] = &runtimeapi.Device{
+			HostPath:      device.PathOnHost,
+			ContainerPath: device.PathInContainer,
+			Permissions:   device.Permissions,
+		}
+	}
+
+	return devices
+}
+
 // makeMounts generates container volume mounts for kubelet runtime v1.
 func (m *kubeGenericRuntimeManager) makeMounts(opts *pkgcontainer.RunContainerOptions, container *v1.Container) []*runtimeapi.Mount {
 	var volumeMounts []*runtimeapi.Mount
@@ -150,7 +169,7 @@ func (m *kubeGenericRuntimeManager) makeMounts(opts *pkgcontainer.RunContainerOp
 	// The reason we create and mount the log file in here (not in kubelet) is because
 	// the file's location depends on the ID of the container, and we need to create and
 	// mount the file before actually starting the container.
-	if m.agentRuntime == config.ContainerRuntime && opts.PodContainerDir != "" && len(container.TerminationMessagePath) != 0 {
+	if config.IsCRIRuntime(m.agentRuntime) && opts.PodContainerDir != "" && len(container.TerminationMessagePath) != 0 {
 		// Because the PodContainerDir contains pod uid and container name which is unique enough,
 		// here we just add a random id to make the path unique for different instances
 		// of the same container.
@@ -185,17 +204,103 @@ func (m *kubeGenericRuntimeManager) makeMounts(opts *pkgcontainer.RunContainerOp
 	return volumeMounts
 }
 
+// calculateContainerSecurityContext applies the default seccomp profile and capabilities to the unprivileged
+// containers of the CRI runtimes, the security context of the container overrides the defaults.
 func (m *kubeGenericRuntimeManager) calculateContainerSecurityContext(context *v1.SecurityContext) *runtimeapi.LinuxContainerSecurityContext {
-	if context == nil {
-		return nil
+	if context != nil {
+		if privileged := m.calculatePrivileged(context.Privileged); privileged != nil {
+			return &runtimeapi.LinuxContainerSecurityContext{
+				Privileged: *privileged,
+			}
+		}
 	}
-	privileged := m.calculatePrivileged(context.Privileged)
-	if privileged == nil {
+	if !config.IsCRIRuntime(m.agentRuntime) {
 		return nil
 	}
-	return &runtimeapi.LinuxContainerSecurityContext{
-		Privileged: *privileged,
+
+	sc := &runtimeapi.LinuxContainerSecurityContext{
+		Seccomp:      m.calculateSeccompProfile(context),
+		Capabilities: m.calculateCapabilities(context),
+	}
+	if context != nil {
+		if context.AllowPrivilegeEscalation != nil && !*context.AllowPrivilegeEscalation {
+			sc.NoNewPrivs = true
+		}
+		if context.RunAsUser != nil {
+			sc.RunAsUser = &runtimeapi.Int64Value{Value: *context.RunAsUser}
+		}
+		if context.RunAsGroup != nil {
+			sc.RunAsGroup = &runtimeapi.Int64Value{Value: *context.RunAsGroup}
+		}
 	}
+	return sc
+}
+
+func (m *kubeGenericRuntimeManager) calculateSeccompProfile(context *v1.SecurityContext) *runtimeapi.SecurityProfile {
+	profileType := v1.SeccompProfileType(m.containerSecurity.SeccompProfile)
+	localhostProfile := m.containerSecurity.SeccompLocalhostProfile
+	if context != nil && context.SeccompProfile != nil {
+		profileType = context.SeccompProfile.Type
+		localhostProfile = ""
+		if context.SeccompProfile.LocalhostProfile != nil {
+			localhostProfile = *context.SeccompProfile.LocalhostProfile
+		}
+	}
+
+	switch profileType {
+	case v1.SeccompProfileTypeUnconfined:
+		return &runtimeapi.SecurityProfile{ProfileType: runtimeapi.SecurityProfile_Unconfined}
+	case v1.SeccompProfileTypeLocalhost:
+		// the profile can't escape from the profile root
+		return &runtimeapi.SecurityProfile{
+			ProfileType:  runtimeapi.SecurityProfile_Localhost,
+			LocalhostRef: filepath.Join(m.containerSecurity.SeccompProfileRoot, filepath.Clean("/"+localhostProfile)),
+		}
+	default:
+		return &runtimeapi.SecurityProfile{ProfileType: runtimeapi.SecurityProfile_RuntimeDefault}
+	}
+}
+
+func (m *kubeGenericRuntimeManager) calculateCapabilities(context *v1.SecurityContext) *runtimeapi.Capability {
+	capabilities := &runtimeapi.Capability{}
+	if context != nil && context.Capabilities != nil {
+		for _, c := range context.Capabilities.Add {
+			capabilities.AddCapabilities = append(capabilities.AddCapabilities, string(c))
+		}
+		for _, c := range context.Capabilities.Drop {
+			capabilities.DropCapabilities = append(capabilities.DropCapabilities, string(c))
+		}
+	}
+	for _, c := range m.containerSecurity.DropCapabilities {
+		if !slices.Contains(capabilities.AddCapabilities, c) && !slices.Contains(capabilities.DropCapabilities, c) {
+			capabilities.DropCapabilities = append(capabilities.DropCapabilities, c)
+		}
+	}
+	return capabilities
+}
+
+// appArmorEnabled reports whether the kernel enables apparmor, the runtime fails to create the containers asking for
+// an apparmor profile otherwise.
+var appArmorEnabled = sync.OnceValue(func() bool {
+	enabled, err := os.ReadFile("/sys/module/apparmor/parameters/enabled")
+	return err == nil && strings.HasPrefix(string(enabled), "Y")
+})
+
+// restrictedContainerSecurityContext confines the container of the sandboxed pod with the default seccomp and
+// apparmor profiles of the runtime, and drops all the capabilities except binding the privileged ports.
+func restrictedContainerSecurityContext() *runtimeapi.LinuxContainerSecurityContext {
+	sc := &runtimeapi.LinuxContainerSecurityContext{
+		Capabilities: &runtimeapi.Capability{
+			DropCapabilities: []string{"ALL"},
+			AddCapabilities:  []string{"NET_BIND_SERVICE"},
+		},
+		Seccomp:    &runtimeapi.SecurityProfile{ProfileType: runtimeapi.SecurityProfile_RuntimeDefault},
+		NoNewPrivs: true,
+	}
+	if appArmorEnabled() {
+		sc.Apparmor = &runtimeapi.SecurityProfile{ProfileType: runtimeapi.SecurityProfile_RuntimeDefault}
+	}
+	return sc
 }
 
 // generateLinuxContainerConfig generates linux container config for kubelet runtime v1.
@@ -207,7 +312,14 @@ func (m *kubeGenericRuntimeManager) generateLinuxContainerConfig(container *v1.C
 	// set linux container resources
 	lc.Resources = m.calculateLinuxResources(container.Resources.Requests.Cpu(), container.Resources.Limits.Cpu(), container.Resources.Limits.Memory())
 	// set security context
-	lc.SecurityContext = m.calculateContainerSecurityContext(container.SecurityContext)
+	if pod.Annotations[common.SandboxAnnotationKey] != "" {
+		lc.SecurityContext = restrictedContainerSecurityContext()
+	} else {
+		lc.SecurityContext = m.calculateContainerSecurityContext(container.SecurityContext)
+	}
+	if userns := m.userNamespaceOptions(pod); userns != nil && lc.SecurityContext != nil {
+		lc.SecurityContext.NamespaceOptions = &runtimeapi.NamespaceOption{UsernsOptions: userns}
+	}
 
 	// TODO calculate OomScoreAdj ...
 
@@ -221,6 +333,13 @@ func (m *kubeGenericRuntimeManager) generateContainerConfig(container *v1.Contai
 		return nil, nil, err
 	}
 
+	if sandbox := pod.Annotations[common.SandboxAnnotationKey]; sandbox != "" && !config.IsCRIRuntime(m.agentRuntime) {
+		return nil, cleanupAction, fmt.Errorf("sandbox %s is not supported by runtime %s", sandbox, m.agentRuntime)
+	}
+	if err := m.verifyRunAsNonRoot(context.Background(), pod, container, imageRef); err != nil {
+		return nil, cleanupAction, err
+	}
+
 	logDir := BuildContainerLogsDirectory(m.podStdoutRootDirectory, pod.Namespace, pod.Name, pod.UID, container.Name)
 	err = m.osInterface.MkdirAll(logDir, 0755)
 	if err != nil {
@@ -239,6 +358,7 @@ func (m *kubeGenericRuntimeManager) generateContainerConfig(container *v1.Contai
 		WorkingDir:  container.WorkingDir,
 		Labels:      newContainerLabels(container, pod),
 		Annotations: newContainerAnnotations(container, pod, restartCount, opts),
+		Devices:     makeDevices(opts),
 		Mounts:      m.makeMounts(opts, container),
 		LogPath:     containerLogsPath,
 		Stdin:       container.Stdin,
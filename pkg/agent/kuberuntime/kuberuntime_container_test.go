@@ -35,8 +35,10 @@ import (
 	v1 "k8s.io/api/core/v1"
 	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
 
+	"github.com/secretflow/kuscia/pkg/agent/config"
 	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
 	containertest "github.com/secretflow/kuscia/pkg/agent/container/testing"
+	"github.com/secretflow/kuscia/pkg/common"
 )
 
 // TestRemoveContainer tests removing the container and its corresponding container logs.
@@ -282,7 +284,7 @@ func makeExpectedConfig(m *kubeGenericRuntimeManager, pod *v1.Pod, containerInde
 		WorkingDir:  container.WorkingDir,
 		Labels:      newContainerLabels(container, pod),
 		Annotations: newContainerAnnotations(container, pod, restartCount, opts),
-		Devices:     []*runtimeapi.Device(nil),
+		Devices:     makeDevices(opts),
 		Mounts:      m.makeMounts(opts, container),
 		LogPath:     containerLogsPath,
 		Stdin:       container.Stdin,
@@ -323,6 +325,86 @@ func TestGenerateContainerConfig(t *testing.T) {
 	assert.Equal(t, expectedConfig, containerConfig, "generate container config for kubelet runtime v1.")
 }
 
+func TestGenerateContainerConfigInSandbox(t *testing.T) {
+	_, _, m, err := createTestRuntimeManager()
+	assert.NoError(t, err)
+
+	privileged := true
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:         "12345678",
+			Name:        "bar",
+			Namespace:   "new",
+			Annotations: map[string]string{common.SandboxAnnotationKey: "gvisor"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:            "foo",
+					Image:           "busybox",
+					SecurityContext: &v1.SecurityContext{Privileged: &privileged},
+				},
+			},
+		},
+	}
+
+	m.agentRuntime = config.ContainerRuntime
+	containerConfig, _, err := m.generateContainerConfig(&pod.Spec.Containers[0], pod, 0, "", pod.Spec.Containers[0].Image, []string{})
+	assert.NoError(t, err)
+	sc := containerConfig.Linux.SecurityContext
+	assert.False(t, sc.Privileged)
+	assert.True(t, sc.NoNewPrivs)
+	assert.Equal(t, []string{"ALL"}, sc.Capabilities.DropCapabilities)
+	assert.Equal(t, runtimeapi.SecurityProfile_RuntimeDefault, sc.Seccomp.ProfileType)
+
+	m.agentRuntime = config.ProcessRuntime
+	_, _, err = m.generateContainerConfig(&pod.Spec.Containers[0], pod, 0, "", pod.Spec.Containers[0].Image, []string{})
+	assert.Error(t, err)
+}
+
+func TestCalculateContainerSecurityContext(t *testing.T) {
+	_, _, m, err := createTestRuntimeManager()
+	assert.NoError(t, err)
+	m.containerSecurity = config.ContainerSecurityCfg{
+		SeccompProfile:     "RuntimeDefault",
+		SeccompProfileRoot: "/home/kuscia/etc/seccomp",
+		DropCapabilities:   []string{"NET_RAW", "SYS_ADMIN"},
+	}
+
+	// the process runtime ignores the security profiles
+	assert.Nil(t, m.calculateContainerSecurityContext(nil))
+
+	m.agentRuntime = config.ContainerRuntime
+	sc := m.calculateContainerSecurityContext(nil)
+	assert.Equal(t, runtimeapi.SecurityProfile_RuntimeDefault, sc.Seccomp.ProfileType)
+	assert.Equal(t, []string{"NET_RAW", "SYS_ADMIN"}, sc.Capabilities.DropCapabilities)
+	assert.False(t, sc.NoNewPrivs)
+
+	// the security context of the container overrides the defaults
+	localhostProfile := "../engine.json"
+	allowPrivilegeEscalation := false
+	sc = m.calculateContainerSecurityContext(&v1.SecurityContext{
+		SeccompProfile: &v1.SeccompProfile{Type: v1.SeccompProfileTypeLocalhost, LocalhostProfile: &localhostProfile},
+		Capabilities: &v1.Capabilities{
+			Add:  []v1.Capability{"NET_RAW"},
+			Drop: []v1.Capability{"CHOWN"},
+		},
+		AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+	})
+	assert.Equal(t, runtimeapi.SecurityProfile_Localhost, sc.Seccomp.ProfileType)
+	assert.Equal(t, "/home/kuscia/etc/seccomp/engine.json", sc.Seccomp.LocalhostRef)
+	assert.Equal(t, []string{"NET_RAW"}, sc.Capabilities.AddCapabilities)
+	assert.Equal(t, []string{"CHOWN", "SYS_ADMIN"}, sc.Capabilities.DropCapabilities)
+	assert.True(t, sc.NoNewPrivs)
+
+	// the privileged container isn't restricted
+	privileged := true
+	m.allowPrivileged = true
+	sc = m.calculateContainerSecurityContext(&v1.SecurityContext{Privileged: &privileged})
+	assert.True(t, sc.Privileged)
+	assert.Nil(t, sc.Seccomp)
+}
+
 func TestGenerateLinuxContainerConfigResources(t *testing.T) {
 	_, _, m, err := createTestRuntimeManager()
 	m.cpuCFSQuota = true
@@ -46,6 +46,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/logs"
 	"k8s.io/kubernetes/pkg/kubelet/metrics"
 
+	"github.com/secretflow/kuscia/pkg/agent/config"
 	"github.com/secretflow/kuscia/pkg/agent/images"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 
@@ -134,6 +135,9 @@ type kubeGenericRuntimeManager struct {
 
 	// allowPrivileged if true, securityContext.Privileged will work for container.
 	allowPrivileged bool
+
+	// containerSecurity is the default seccomp profile and capabilities of the unprivileged containers.
+	containerSecurity config.ContainerSecurityCfg
 }
 
 func NewManager(recorder record.EventRecorder,
@@ -153,6 +157,7 @@ func NewManager(recorder record.EventRecorder,
 	cpuCFSQuota bool,
 	podStdoutRootDirectory string,
 	allowPrivileged bool,
+	containerSecurity config.ContainerSecurityCfg,
 	agentRuntime string) (pkgcontainer.Runtime, error) {
 	ctx := context.Background()
 	m := &kubeGenericRuntimeManager{
@@ -170,6 +175,7 @@ func NewManager(recorder record.EventRecorder,
 		logReduction:           logreduction.NewLogReduction(identicalErrorDelay),
 		podStdoutRootDirectory: podStdoutRootDirectory,
 		allowPrivileged:        allowPrivileged,
+		containerSecurity:      containerSecurity,
 		agentRuntime:           agentRuntime,
 	}
 
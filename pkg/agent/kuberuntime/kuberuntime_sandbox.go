@@ -73,6 +73,9 @@ func (m *kubeGenericRuntimeManager) generatePodSandboxLinuxConfig(pod *v1.Pod) (
 			}
 		}
 	}
+	if userns := m.userNamespaceOptions(pod); userns != nil {
+		lc.SecurityContext.NamespaceOptions = &runtimeapi.NamespaceOption{UsernsOptions: userns}
+	}
 
 	return lc, nil
 }
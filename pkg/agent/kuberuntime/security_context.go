@@ -0,0 +1,90 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kuberuntime
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
+	"github.com/secretflow/kuscia/pkg/common"
+)
+
+// rootPolicy returns the policy of the pod's containers running as root, the pods exempted by the annotation and the
+// pods of the process runtime are always allowed.
+func (m *kubeGenericRuntimeManager) rootPolicy(pod *v1.Pod) string {
+	if !config.IsCRIRuntime(m.agentRuntime) || pod.Annotations[common.AllowRootAnnotationKey] == "true" {
+		return config.RootPolicyAllow
+	}
+	return m.containerSecurity.RootPolicy
+}
+
+// verifyRunAsNonRoot refuses to start the container running as root if the root policy is Deny or the container asks
+// to run as non-root. The user of the container is its runAsUser, or the user of the image otherwise.
+func (m *kubeGenericRuntimeManager) verifyRunAsNonRoot(ctx context.Context, pod *v1.Pod, container *v1.Container, imageRef string) error {
+	sc := container.SecurityContext
+	runAsNonRoot := sc != nil && sc.RunAsNonRoot != nil && *sc.RunAsNonRoot
+	if !runAsNonRoot && m.rootPolicy(pod) != config.RootPolicyDeny {
+		return nil
+	}
+
+	if sc != nil && sc.RunAsUser != nil {
+		if *sc.RunAsUser == 0 {
+			return fmt.Errorf("container %q is set to run as root by its security context, which is not allowed, "+
+				"set securityContext.runAsUser to a non-root user", container.Name)
+		}
+		return nil
+	}
+
+	uid, username, err := m.getImageUser(ctx, imageRef)
+	if err != nil {
+		return fmt.Errorf("failed to get the user of image %q, %v", container.Image, err)
+	}
+	if (uid != nil && *uid == 0) || username == "root" {
+		return fmt.Errorf("image %q of container %q runs as root, which is not allowed, set securityContext.runAsUser "+
+			"to a non-root user, or annotate the AppImage with %s=true to exempt it", container.Image, container.Name,
+			common.AllowRootAnnotationKey)
+	}
+	return nil
+}
+
+// userNamespaceOptions returns the user namespace of the pod if the root policy is UserNamespace, so that root of the
+// containers is an unprivileged user of the host. The pods with privileged containers share the user namespace of
+// the host.
+func (m *kubeGenericRuntimeManager) userNamespaceOptions(pod *v1.Pod) *runtimeapi.UserNamespace {
+	if m.rootPolicy(pod) != config.RootPolicyUserNamespace || pkgcontainer.IsHostNetworkPod(pod) {
+		return nil
+	}
+	for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if c.SecurityContext != nil && m.calculatePrivileged(c.SecurityContext.Privileged) != nil {
+			return nil
+		}
+	}
+
+	mapping := []*runtimeapi.IDMapping{{
+		HostId:      m.containerSecurity.UserNamespace.HostID,
+		ContainerId: 0,
+		Length:      m.containerSecurity.UserNamespace.Size,
+	}}
+	return &runtimeapi.UserNamespace{
+		Mode: runtimeapi.NamespaceMode_POD,
+		Uids: mapping,
+		Gids: mapping,
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kuberuntime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/common"
+)
+
+func TestVerifyRunAsNonRoot(t *testing.T) {
+	_, i, m, err := createTestRuntimeManager()
+	assert.NoError(t, err)
+	i.SetFakeImages([]string{"root-image", "user-image"})
+	i.Images["user-image"].Uid = &runtimeapi.Int64Value{Value: 1000}
+
+	ctx := context.Background()
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "new"}}
+	container := &v1.Container{Name: "foo", Image: "root-image"}
+
+	m.agentRuntime = config.ContainerRuntime
+	m.containerSecurity.RootPolicy = config.RootPolicyAllow
+	assert.NoError(t, m.verifyRunAsNonRoot(ctx, pod, container, "root-image"))
+
+	m.containerSecurity.RootPolicy = config.RootPolicyDeny
+	assert.ErrorContains(t, m.verifyRunAsNonRoot(ctx, pod, container, "root-image"), "runs as root")
+	assert.NoError(t, m.verifyRunAsNonRoot(ctx, pod, container, "user-image"))
+
+	// the security context of the container takes precedence over the image
+	uid := int64(1000)
+	container.SecurityContext = &v1.SecurityContext{RunAsUser: &uid}
+	assert.NoError(t, m.verifyRunAsNonRoot(ctx, pod, container, "root-image"))
+	uid = 0
+	assert.Error(t, m.verifyRunAsNonRoot(ctx, pod, container, "user-image"))
+
+	// the pod is exempted by the annotation
+	pod.Annotations = map[string]string{common.AllowRootAnnotationKey: "true"}
+	assert.NoError(t, m.verifyRunAsNonRoot(ctx, pod, container, "user-image"))
+}
+
+func TestUserNamespaceOptions(t *testing.T) {
+	_, _, m, err := createTestRuntimeManager()
+	assert.NoError(t, err)
+	m.agentRuntime = config.ContainerRuntime
+	m.containerSecurity.RootPolicy = config.RootPolicyUserNamespace
+	m.containerSecurity.UserNamespace = config.UserNamespaceCfg{HostID: 100000, Size: 65536}
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "new"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "foo"}}},
+	}
+	userns := m.userNamespaceOptions(pod)
+	assert.Equal(t, runtimeapi.NamespaceMode_POD, userns.Mode)
+	assert.Equal(t, []*runtimeapi.IDMapping{{HostId: 100000, ContainerId: 0, Length: 65536}}, userns.Uids)
+
+	lc := m.generateLinuxContainerConfig(&pod.Spec.Containers[0], pod)
+	assert.Equal(t, userns, lc.SecurityContext.NamespaceOptions.UsernsOptions)
+
+	// the privileged pods share the user namespace of the host
+	privileged := true
+	m.allowPrivileged = true
+	pod.Spec.Containers[0].SecurityContext = &v1.SecurityContext{Privileged: &privileged}
+	assert.Nil(t, m.userNamespaceOptions(pod))
+
+	m.containerSecurity.RootPolicy = config.RootPolicyDeny
+	pod.Spec.Containers[0].SecurityContext = nil
+	assert.Nil(t, m.userNamespaceOptions(pod))
+}
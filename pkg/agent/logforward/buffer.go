@@ -0,0 +1,148 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logforward
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const batchFileSuffix = ".batch"
+
+// diskBuffer keeps the batches to ship in files named by their sequence, so that they survive the restart of the
+// agent and are shipped in order.
+type diskBuffer struct {
+	dir string
+
+	mu      sync.Mutex
+	size    int64
+	lastSeq uint64
+	// notify is signaled when a batch is put.
+	notify chan struct{}
+}
+
+func newDiskBuffer(dir string) (*diskBuffer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	b := &diskBuffer{
+		dir:    dir,
+		notify: make(chan struct{}, 1),
+	}
+	seqs, err := b.list()
+	if err != nil {
+		return nil, err
+	}
+	for _, seq := range seqs {
+		info, err := os.Stat(b.path(seq))
+		if err != nil {
+			return nil, err
+		}
+		b.size += info.Size()
+		b.lastSeq = seq
+	}
+	return b, nil
+}
+
+func (b *diskBuffer) path(seq uint64) string {
+	return filepath.Join(b.dir, fmt.Sprintf("%020d%s", seq, batchFileSuffix))
+}
+
+// list returns the sequences of the buffered batches in order.
+func (b *diskBuffer) list() ([]uint64, error) {
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return nil, err
+	}
+	var seqs []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, batchFileSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, batchFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs, nil
+}
+
+// put writes the batch atomically, a partially written batch is never read.
+func (b *diskBuffer) put(data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	seq := b.lastSeq + 1
+	tmpPath := filepath.Join(b.dir, fmt.Sprintf(".%d.tmp", seq))
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, b.path(seq)); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	b.lastSeq = seq
+	b.size += int64(len(data))
+
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// oldest returns the first batch, ok is false if the buffer is empty.
+func (b *diskBuffer) oldest() (seq uint64, data []byte, ok bool, err error) {
+	seqs, err := b.list()
+	if err != nil || len(seqs) == 0 {
+		return 0, nil, false, err
+	}
+	data, err = os.ReadFile(b.path(seqs[0]))
+	if err != nil {
+		return 0, nil, false, err
+	}
+	return seqs[0], data, true, nil
+}
+
+func (b *diskBuffer) remove(seq uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	path := b.path(seq)
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+	b.size -= info.Size()
+	return nil
+}
+
+// bytes returns the total size of the buffered batches.
+func (b *diskBuffer) bytes() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.size
+}
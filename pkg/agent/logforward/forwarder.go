@@ -0,0 +1,458 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logforward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/paths"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const (
+	checkpointFile = "checkpoint"
+	batchDir       = "batches"
+
+	maxRetryInterval = time.Minute
+)
+
+// PodLister lists the pods bound to the node, e.g. pod.Manager.
+type PodLister interface {
+	GetPods() []*v1.Pod
+}
+
+// PodStatusProvider provides the latest status of the pods, e.g. status.Manager.
+type PodStatusProvider interface {
+	GetPodStatus(uid types.UID) (v1.PodStatus, bool)
+}
+
+// Pusher ships the logs to the receiver, e.g. the KusciaAPI client of the master.
+type Pusher interface {
+	PushArchivedLog(ctx context.Context, request *kusciaapi.PushArchivedLogRequest) (*kusciaapi.PushArchivedLogResponse, error)
+}
+
+type ForwarderConfig struct {
+	Namespace string
+	NodeName  string
+	// PodLogDir holds the logs of the pods, {namespace}_{pod}_{uid}/{container}/{restart}.log.
+	PodLogDir string
+	// BufferDir keeps the batches not shipped yet and the offsets of the log files.
+	BufferDir      string
+	PodLister      PodLister
+	StatusProvider PodStatusProvider
+	Pusher         Pusher
+	LogForwardCfg  *config.LogForwardCfg
+}
+
+// fileOffset is the position shipped of the log file. Base accumulates the sizes of the file before it's rotated, so
+// that the offsets in the archive keep increasing.
+type fileOffset struct {
+	Base   int64 `json:"base"`
+	Offset int64 `json:"offset"`
+}
+
+// podMeta is recorded once the pod is found, since the pod may be deleted before its logs are shipped.
+type podMeta struct {
+	JobID  string `json:"jobID"`
+	TaskID string `json:"taskID"`
+}
+
+type checkpoint struct {
+	// Files are indexed by the path relative to the pod log dir.
+	Files map[string]*fileOffset `json:"files"`
+	// Pods are indexed by the pod log directory name.
+	Pods map[string]*podMeta `json:"pods"`
+}
+
+// Forwarder ships the stdout logs of the task pods to the master. The new logs are collected periodically into the
+// batches buffered on the disk, which are shipped in order and retried until the receiver accepts them. Collecting
+// pauses while the buffer is full, so a receiver that is unavailable for long doesn't exhaust the disk.
+type Forwarder struct {
+	namespace      string
+	nodeName       string
+	podLogDir      string
+	checkpointPath string
+	podLister      PodLister
+	statusProvider PodStatusProvider
+	pusher         Pusher
+
+	flushPeriod time.Duration
+	batchBytes  int64
+	bufferBytes int64
+
+	buffer     *diskBuffer
+	checkpoint checkpoint
+}
+
+func NewForwarder(cfg *ForwarderConfig) (*Forwarder, error) {
+	buffer, err := newDiskBuffer(filepath.Join(cfg.BufferDir, batchDir))
+	if err != nil {
+		return nil, err
+	}
+	f := &Forwarder{
+		namespace:      cfg.Namespace,
+		nodeName:       cfg.NodeName,
+		podLogDir:      cfg.PodLogDir,
+		checkpointPath: filepath.Join(cfg.BufferDir, checkpointFile),
+		podLister:      cfg.PodLister,
+		statusProvider: cfg.StatusProvider,
+		pusher:         cfg.Pusher,
+		flushPeriod:    cfg.LogForwardCfg.FlushPeriod,
+		batchBytes:     cfg.LogForwardCfg.BatchBytes,
+		bufferBytes:    cfg.LogForwardCfg.BufferBytes,
+		buffer:         buffer,
+		checkpoint: checkpoint{
+			Files: map[string]*fileOffset{},
+			Pods:  map[string]*podMeta{},
+		},
+	}
+	if paths.CheckFileExist(f.checkpointPath) {
+		if err := paths.ReadJSON(f.checkpointPath, &f.checkpoint); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// Run collects and ships the logs until the context is done.
+func (f *Forwarder) Run(ctx context.Context) error {
+	nlog.Infof("Starting log forwarder, flush period=%v, pod log dir=%s", f.flushPeriod, f.podLogDir)
+	go f.ship(ctx)
+
+	ticker := time.NewTicker(f.flushPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := f.collect(); err != nil {
+				nlog.Warnf("Collect pod logs failed, %v", err)
+			}
+		}
+	}
+}
+
+// collect reads the new logs of the pods into the buffer.
+func (f *Forwarder) collect() error {
+	if f.buffer.bytes() >= f.bufferBytes {
+		nlog.Warnf("Log forward buffer is full (%d bytes), pause collecting until it's shipped", f.buffer.bytes())
+		return nil
+	}
+
+	entries, err := os.ReadDir(f.podLogDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	pods := map[types.UID]*v1.Pod{}
+	for _, pod := range f.podLister.GetPods() {
+		pods[pod.UID] = pod
+	}
+
+	existing := map[string]bool{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			existing[entry.Name()] = true
+		}
+	}
+	// forget the pods whose logs are garbage collected
+	for dir := range f.checkpoint.Pods {
+		if !existing[dir] {
+			delete(f.checkpoint.Pods, dir)
+		}
+	}
+	for file := range f.checkpoint.Files {
+		if !existing[strings.SplitN(file, string(filepath.Separator), 2)[0]] {
+			delete(f.checkpoint.Files, file)
+		}
+	}
+
+	batch := &batchBuilder{forwarder: f, updates: map[string]*fileOffset{}}
+	for dir := range existing {
+		parts := strings.SplitN(dir, "_", 3)
+		if len(parts) != 3 || parts[0] != f.namespace {
+			continue
+		}
+		pod := pods[types.UID(parts[2])]
+		meta := f.podMeta(dir, pod)
+		if meta == nil {
+			continue
+		}
+		if err := batch.addPod(dir, parts[1], meta, f.isRunning(pod)); err != nil {
+			return err
+		}
+		if batch.full {
+			break
+		}
+	}
+	if err := batch.flush(); err != nil {
+		return err
+	}
+	return f.saveCheckpoint()
+}
+
+// podMeta returns the job and task of the pod, nil if the pod doesn't belong to a task.
+func (f *Forwarder) podMeta(dir string, pod *v1.Pod) *podMeta {
+	if meta, ok := f.checkpoint.Pods[dir]; ok {
+		if meta.TaskID == "" {
+			return nil
+		}
+		return meta
+	}
+	if pod == nil {
+		// the pod is deleted before it's seen, e.g. the pods before the forwarding is enabled
+		return nil
+	}
+	meta := &podMeta{
+		JobID:  pod.Annotations[common.JobIDAnnotationKey],
+		TaskID: pod.Annotations[common.TaskIDAnnotationKey],
+	}
+	f.checkpoint.Pods[dir] = meta
+	if meta.TaskID == "" {
+		return nil
+	}
+	if meta.JobID == "" {
+		// the task created without a job
+		meta.JobID = meta.TaskID
+	}
+	return meta
+}
+
+func (f *Forwarder) isRunning(pod *v1.Pod) bool {
+	if pod == nil {
+		return false
+	}
+	phase := pod.Status.Phase
+	if f.statusProvider != nil {
+		if status, ok := f.statusProvider.GetPodStatus(pod.UID); ok {
+			phase = status.Phase
+		}
+	}
+	return phase != v1.PodSucceeded && phase != v1.PodFailed
+}
+
+func (f *Forwarder) saveCheckpoint() error {
+	return paths.WriteJSON(f.checkpointPath, f.checkpoint)
+}
+
+// batchBuilder collects the chunks of the log files into the batches. The offsets of the files are advanced after
+// their batch is buffered.
+type batchBuilder struct {
+	forwarder *Forwarder
+	chunks    []*kusciaapi.ArchivedLogChunk
+	bytes     int64
+	updates   map[string]*fileOffset
+	// full is set once the buffer is full, the rest of the logs are collected next time.
+	full bool
+}
+
+func (b *batchBuilder) addPod(dir, podName string, meta *podMeta, running bool) error {
+	f := b.forwarder
+	containers, err := os.ReadDir(filepath.Join(f.podLogDir, dir))
+	if err != nil {
+		return nil
+	}
+	for _, container := range containers {
+		if !container.IsDir() {
+			continue
+		}
+		files, err := os.ReadDir(filepath.Join(f.podLogDir, dir, container.Name()))
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			restartCount, err := strconv.Atoi(strings.TrimSuffix(file.Name(), ".log"))
+			if err != nil || !strings.HasSuffix(file.Name(), ".log") {
+				continue
+			}
+			chunk := &kusciaapi.ArchivedLogChunk{
+				DomainId:     f.namespace,
+				JobId:        meta.JobID,
+				TaskId:       meta.TaskID,
+				PodName:      podName,
+				Container:    container.Name(),
+				RestartCount: int32(restartCount),
+			}
+			if err := b.addFile(filepath.Join(dir, container.Name(), file.Name()), chunk, running); err != nil {
+				return err
+			}
+			if b.full {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// addFile reads the new logs of the file. Only the complete lines of the running pods are read, the partial line is
+// read after it's completed.
+func (b *batchBuilder) addFile(relPath string, chunk *kusciaapi.ArchivedLogChunk, running bool) error {
+	f := b.forwarder
+	offset := &fileOffset{}
+	if last, ok := f.checkpoint.Files[relPath]; ok {
+		*offset = *last
+	}
+
+	for !b.full {
+		file, err := os.Open(filepath.Join(f.podLogDir, relPath))
+		if err != nil {
+			return nil
+		}
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return nil
+		}
+		if info.Size() < offset.Offset {
+			// the file is rotated
+			offset.Base += offset.Offset
+			offset.Offset = 0
+		}
+		remaining := info.Size() - offset.Offset
+		data := make([]byte, min(remaining, f.batchBytes-b.bytes))
+		n, err := file.ReadAt(data, offset.Offset)
+		file.Close()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		data = data[:n]
+		if running {
+			end := bytes.LastIndexByte(data, '\n')
+			if end < 0 && int64(len(data)) < f.batchBytes {
+				data = nil
+			} else if end >= 0 {
+				data = data[:end+1]
+			}
+		}
+		if len(data) > 0 {
+			c := proto.Clone(chunk).(*kusciaapi.ArchivedLogChunk)
+			c.Offset = offset.Base + offset.Offset
+			c.Data = data
+			b.chunks = append(b.chunks, c)
+			b.bytes += int64(len(data))
+			offset.Offset += int64(len(data))
+			next := *offset
+			b.updates[relPath] = &next
+		}
+		if int64(len(data)) == remaining || (len(data) == 0 && b.bytes == 0) {
+			return nil
+		}
+		// the rest of the file doesn't fit in the batch
+		if err := b.flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flush buffers the chunks as a batch and advances the offsets of their files.
+func (b *batchBuilder) flush() error {
+	f := b.forwarder
+	if len(b.chunks) > 0 {
+		data, err := proto.Marshal(&kusciaapi.PushArchivedLogRequest{
+			NodeName: f.nodeName,
+			Chunks:   b.chunks,
+		})
+		if err != nil {
+			return err
+		}
+		if err := f.buffer.put(data); err != nil {
+			return err
+		}
+		for path, offset := range b.updates {
+			f.checkpoint.Files[path] = offset
+		}
+		b.chunks, b.bytes, b.updates = nil, 0, map[string]*fileOffset{}
+		if err := f.saveCheckpoint(); err != nil {
+			return err
+		}
+	}
+	b.full = f.buffer.bytes() >= f.bufferBytes
+	return nil
+}
+
+// ship pushes the buffered batches in order, a batch is retried until it's accepted or rejected by the receiver.
+func (f *Forwarder) ship(ctx context.Context) {
+	retryInterval := time.Second
+	for {
+		seq, data, ok, err := f.buffer.oldest()
+		if err != nil {
+			nlog.Warnf("Read log forward buffer failed, %v", err)
+		}
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return
+			case <-f.buffer.notify:
+			case <-time.After(f.flushPeriod):
+			}
+			continue
+		}
+
+		if err := f.push(ctx, data); err != nil {
+			nlog.Warnf("Ship pod logs failed, retry after %v, %v", retryInterval, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+			retryInterval = min(retryInterval*2, maxRetryInterval)
+			continue
+		}
+		retryInterval = time.Second
+		if err := f.buffer.remove(seq); err != nil {
+			nlog.Warnf("Remove shipped logs from buffer failed, %v", err)
+		}
+	}
+}
+
+// push returns an error if the batch should be retried.
+func (f *Forwarder) push(ctx context.Context, data []byte) error {
+	request := &kusciaapi.PushArchivedLogRequest{}
+	if err := proto.Unmarshal(data, request); err != nil {
+		nlog.Warnf("Discard the corrupted log batch, %v", err)
+		return nil
+	}
+	pushCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	resp, err := f.pusher.PushArchivedLog(pushCtx, request)
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus().GetCode() != int32(errorcode.ErrorCode_SUCCESS) {
+		// the receiver won't accept it on retry either
+		nlog.Warnf("Log batch of %d chunks is rejected, code=%d, message=%s", len(request.Chunks), resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+	return nil
+}
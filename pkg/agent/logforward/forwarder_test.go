@@ -0,0 +1,194 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logforward
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type fakePods struct {
+	pods []*v1.Pod
+}
+
+func (f *fakePods) GetPods() []*v1.Pod {
+	return f.pods
+}
+
+type fakePusher struct {
+	err    error
+	chunks []*kusciaapi.ArchivedLogChunk
+}
+
+func (f *fakePusher) PushArchivedLog(ctx context.Context, request *kusciaapi.PushArchivedLogRequest) (*kusciaapi.PushArchivedLogResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.chunks = append(f.chunks, request.Chunks...)
+	return &kusciaapi.PushArchivedLogResponse{Status: &v1alpha1.Status{}}, nil
+}
+
+func newTestForwarder(t *testing.T, pods *fakePods, pusher *fakePusher, cfg *config.LogForwardCfg) (*Forwarder, string) {
+	rootDir := t.TempDir()
+	podLogDir := filepath.Join(rootDir, "pods")
+	f, err := NewForwarder(&ForwarderConfig{
+		Namespace:     "alice",
+		NodeName:      "node",
+		PodLogDir:     podLogDir,
+		BufferDir:     filepath.Join(rootDir, "buffer"),
+		PodLister:     pods,
+		Pusher:        pusher,
+		LogForwardCfg: cfg,
+	})
+	assert.NoError(t, err)
+	return f, podLogDir
+}
+
+func newTaskPod(name, uid string, phase v1.PodPhase) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "alice",
+			UID:       types.UID(uid),
+			Annotations: map[string]string{
+				common.JobIDAnnotationKey:  "job-1",
+				common.TaskIDAnnotationKey: "task-1",
+			},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
+
+func writeLog(t *testing.T, podLogDir, dir, content string) {
+	path := filepath.Join(podLogDir, dir, "main", "0.log")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	assert.NoError(t, err)
+	defer f.Close()
+	_, err = f.WriteString(content)
+	assert.NoError(t, err)
+}
+
+func shipAll(t *testing.T, f *Forwarder) {
+	for {
+		seq, data, ok, err := f.buffer.oldest()
+		assert.NoError(t, err)
+		if !ok {
+			return
+		}
+		if err := f.push(context.Background(), data); err != nil {
+			return
+		}
+		assert.NoError(t, f.buffer.remove(seq))
+	}
+}
+
+func TestCollect(t *testing.T) {
+	pods := &fakePods{pods: []*v1.Pod{newTaskPod("task-1-0", "uid-1", v1.PodRunning)}}
+	pusher := &fakePusher{}
+	f, podLogDir := newTestForwarder(t, pods, pusher, &config.LogForwardCfg{FlushPeriod: time.Second, BatchBytes: 1024, BufferBytes: 4096})
+	dir := "alice_task-1-0_uid-1"
+
+	// the partial line of the running pod is collected after it's completed
+	writeLog(t, podLogDir, dir, "line1\nline")
+	assert.NoError(t, f.collect())
+	shipAll(t, f)
+	assert.Len(t, pusher.chunks, 1)
+	chunk := pusher.chunks[0]
+	assert.Equal(t, "alice", chunk.DomainId)
+	assert.Equal(t, "job-1", chunk.JobId)
+	assert.Equal(t, "task-1", chunk.TaskId)
+	assert.Equal(t, "task-1-0", chunk.PodName)
+	assert.Equal(t, "main", chunk.Container)
+	assert.Equal(t, int64(0), chunk.Offset)
+	assert.Equal(t, "line1\n", string(chunk.Data))
+
+	// the rest is collected once the pod finishes, even if it's deleted
+	writeLog(t, podLogDir, dir, "2")
+	pods.pods = nil
+	assert.NoError(t, f.collect())
+	shipAll(t, f)
+	assert.Len(t, pusher.chunks, 2)
+	assert.Equal(t, int64(6), pusher.chunks[1].Offset)
+	assert.Equal(t, "line2", string(pusher.chunks[1].Data))
+
+	// the offsets survive the restart
+	f, err := NewForwarder(&ForwarderConfig{
+		Namespace:     "alice",
+		PodLogDir:     podLogDir,
+		BufferDir:     filepath.Dir(f.checkpointPath),
+		PodLister:     pods,
+		Pusher:        pusher,
+		LogForwardCfg: &config.LogForwardCfg{FlushPeriod: time.Second, BatchBytes: 1024, BufferBytes: 4096},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, f.collect())
+	shipAll(t, f)
+	assert.Len(t, pusher.chunks, 2)
+}
+
+func TestCollectSkipsNonTaskPods(t *testing.T) {
+	pod := newTaskPod("other-0", "uid-2", v1.PodRunning)
+	pod.Annotations = nil
+	pusher := &fakePusher{}
+	f, podLogDir := newTestForwarder(t, &fakePods{pods: []*v1.Pod{pod}}, pusher, &config.LogForwardCfg{FlushPeriod: time.Second, BatchBytes: 1024, BufferBytes: 4096})
+
+	writeLog(t, podLogDir, "alice_other-0_uid-2", "hello\n")
+	writeLog(t, podLogDir, "bob_task-1-0_uid-3", "hello\n")
+	assert.NoError(t, f.collect())
+	assert.Equal(t, int64(0), f.buffer.bytes())
+}
+
+func TestCollectBackpressure(t *testing.T) {
+	pods := &fakePods{pods: []*v1.Pod{newTaskPod("task-1-0", "uid-1", v1.PodRunning)}}
+	pusher := &fakePusher{err: errors.New("master unavailable")}
+	f, podLogDir := newTestForwarder(t, pods, pusher, &config.LogForwardCfg{FlushPeriod: time.Second, BatchBytes: 8, BufferBytes: 20})
+
+	writeLog(t, podLogDir, "alice_task-1-0_uid-1", "line1\nline2\nline3\nline4\nline5\nline6\n")
+	assert.NoError(t, f.collect())
+	buffered := f.buffer.bytes()
+	assert.GreaterOrEqual(t, buffered, int64(20))
+	// the buffer is full, nothing is collected until it's shipped
+	assert.NoError(t, f.collect())
+	assert.Equal(t, buffered, f.buffer.bytes())
+
+	shipAll(t, f)
+	assert.Empty(t, pusher.chunks)
+
+	pusher.err = nil
+	for f.buffer.bytes() > 0 {
+		shipAll(t, f)
+		assert.NoError(t, f.collect())
+	}
+	var data string
+	for _, chunk := range pusher.chunks {
+		data += string(chunk.Data)
+	}
+	assert.Equal(t, "line1\nline2\nline3\nline4\nline5\nline6\n", data)
+}
@@ -31,6 +31,7 @@ const (
 	PointGenerateContainerOptions
 	PointK8sProviderSyncPod
 	PointPodAddition
+	PointMakeEnvs
 )
 
 const (
@@ -84,6 +85,20 @@ func (c *GenerateContainerOptionContext) Point() Point {
 	return PointGenerateContainerOptions
 }
 
+// MakeEnvsContext is executed after the environment variables of the container are populated, so that the
+// handlers can rewrite their values.
+type MakeEnvsContext struct {
+	Pod          *v1.Pod
+	Container    *v1.Container
+	Opts         *pkgcontainer.RunContainerOptions
+	PodDir       string
+	ContainerDir string
+}
+
+func (c *MakeEnvsContext) Point() Point {
+	return PointMakeEnvs
+}
+
 type PodAdditionContext struct {
 	Pod         *v1.Pod
 	PodProvider kri.PodProvider
@@ -95,7 +110,9 @@ func (c *PodAdditionContext) Point() Point {
 
 type Result struct {
 	Terminated bool
-	Msg        string
+	// Reason of the termination, default is Reject.
+	Reason string
+	Msg    string
 }
 
 type Handler interface {
@@ -142,8 +159,12 @@ func Execute(ctx Context) error {
 
 		nlog.Debugf("Execute plugin hook.%v succeed, result=%+v", name, result)
 		if result.Terminated {
+			reason := result.Reason
+			if reason == "" {
+				reason = defaultTerminateReason
+			}
 			return &TerminateError{
-				Reason:  defaultTerminateReason,
+				Reason:  reason,
 				Message: fmt.Sprintf("terminate operation by plugin hook.%v, detail-> %v", name, result.Msg),
 			}
 		}
@@ -17,6 +17,7 @@ package configrender
 import (
 	"bytes"
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -62,6 +63,7 @@ type configRenderConfig struct {
 	// Todo: temporary solution for scql
 	kusciaAPIToken string
 	domainKeyData  string
+	domainKey      *rsa.PrivateKey
 }
 
 type configRender struct {
@@ -85,13 +87,15 @@ func (cr *configRender) Init(ctx context.Context, dependencies *plugin.Dependenc
 	cr.config.kusciaAPIProtocol = dependencies.AgentConfig.KusciaAPIProtocol
 	cr.config.kusciaAPIToken = dependencies.AgentConfig.KusciaAPIToken
 	cr.config.domainKeyData = dependencies.AgentConfig.DomainKeyData
+	cr.config.domainKey = dependencies.AgentConfig.DomainKey
 
 	// init cm service
 	configService, err := cmservice.NewConfigService(ctx, &cmservice.ConfigServiceConfig{
-		DomainID:   dependencies.AgentConfig.Namespace,
-		DomainKey:  dependencies.AgentConfig.DomainKey,
-		Driver:     driver.CRDDriverType,
-		KubeClient: dependencies.KubeClient,
+		DomainID:      dependencies.AgentConfig.Namespace,
+		DomainKey:     dependencies.AgentConfig.DomainKey,
+		SecretBackend: dependencies.AgentConfig.SecretBackend,
+		Driver:        driver.CRDDriverType,
+		KubeClient:    dependencies.KubeClient,
 	})
 	if err != nil {
 		return fmt.Errorf("init cm config service for agent failed, %s", err.Error())
@@ -204,7 +208,7 @@ func (cr *configRender) handleSyncPodContext(ctx *hook.K8sProviderSyncPodContext
 	}
 
 	// render taskInputConfig,allocatePorts,ClusterDefine from configMap
-	if err = fillTemplateValueFromConfigMap(ctx.Pod, ctx.ResourceManager, data); err != nil {
+	if err = fillTemplateValueFromConfigMap(ctx.Pod, ctx.ResourceManager, cr.config.domainKey, data); err != nil {
 		nlog.Errorf("fillTemplateValueFromConfigMap pod: %s config failed, error: %s.", ctx.Pod.Name, err.Error())
 		return err
 	}
@@ -253,7 +257,7 @@ func (cr *configRender) handleMakeMountsContext(ctx *hook.MakeMountsContext) err
 	}
 
 	// render taskInputConfig,allocatePorts,ClusterDefine from configMap
-	if err = fillTemplateValueFromConfigMap(ctx.Pod, ctx.ResourceManager, data); err != nil {
+	if err = fillTemplateValueFromConfigMap(ctx.Pod, ctx.ResourceManager, cr.config.domainKey, data); err != nil {
 		nlog.Errorf("fillTemplateValueFromConfigMap pod: %s config failed, error: %s.", ctx.Pod.Name, err.Error())
 		return err
 	}
@@ -284,7 +288,8 @@ func (cr *configRender) handleMakeMountsContext(ctx *hook.MakeMountsContext) err
 	return nil
 }
 
-func fillTemplateValueFromConfigMap(pod *v1.Pod, resourceManager *resource.KubeResourceManager, templateValues map[string]string) error {
+func fillTemplateValueFromConfigMap(pod *v1.Pod, resourceManager *resource.KubeResourceManager, domainKey *rsa.PrivateKey,
+	templateValues map[string]string) error {
 	cmName, ok := pod.Annotations[common.ConfigTemplateValueAnnotationKey]
 	if !ok {
 		return nil
@@ -316,6 +321,15 @@ func fillTemplateValueFromConfigMap(pod *v1.Pod, resourceManager *resource.KubeR
 			}
 		}
 	}
+	// merge the sensitive input config, the encrypted value isn't rendered into the configs
+	if encrypted, ok := templateValues[common.EnvTaskSensitiveInputConfig]; ok {
+		delete(templateValues, common.EnvTaskSensitiveInputConfig)
+		inputConfig, err := decryptSensitiveInputConfig(domainKey, templateValues[common.EnvTaskInputConfig], encrypted)
+		if err != nil {
+			return fmt.Errorf("merge sensitive input config of configMap %s failed, %v", cm.Name, err)
+		}
+		templateValues[common.EnvTaskInputConfig] = inputConfig
+	}
 	return nil
 }
 
@@ -0,0 +1,70 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configrender
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+// decryptSensitiveInputConfig decrypts the sensitive input config with the domain key, and merges it into the
+// task input config.
+func decryptSensitiveInputConfig(domainKey *rsa.PrivateKey, inputConfig, encrypted string) (string, error) {
+	if domainKey == nil {
+		return "", errors.New("domain key is not loaded")
+	}
+	sensitive, err := tlsutils.DecryptOAEP(domainKey, encrypted)
+	if err != nil {
+		return "", fmt.Errorf("decrypt failed, %v", err)
+	}
+	return mergeSensitiveInputConfig(inputConfig, sensitive)
+}
+
+// mergeSensitiveInputConfig merges the sensitive json object into the json object of the task input config. The
+// nested objects are merged recursively, and the other values in the sensitive config take precedence.
+func mergeSensitiveInputConfig(inputConfig string, sensitive []byte) (string, error) {
+	config := map[string]interface{}{}
+	if inputConfig != "" {
+		if err := json.Unmarshal([]byte(inputConfig), &config); err != nil {
+			return "", fmt.Errorf("task input config is not a json object, %v", err)
+		}
+	}
+	sensitiveConfig := map[string]interface{}{}
+	if err := json.Unmarshal(sensitive, &sensitiveConfig); err != nil {
+		return "", fmt.Errorf("sensitive input config is not a json object, %v", err)
+	}
+	mergeJSONObject(config, sensitiveConfig)
+	merged, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(merged), nil
+}
+
+func mergeJSONObject(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcObject, srcIsObject := value.(map[string]interface{})
+		dstObject, dstIsObject := dst[key].(map[string]interface{})
+		if srcIsObject && dstIsObject {
+			mergeJSONObject(dstObject, srcObject)
+			continue
+		}
+		dst[key] = value
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configrender
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+func TestDecryptSensitiveInputConfig(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	encrypted, err := tlsutils.EncryptOAEP(&key.PublicKey, []byte(`{"sf_storage_config":{"password":"secret"}}`))
+	assert.NoError(t, err)
+
+	inputConfig := `{"sf_storage_config":{"type":"s3","endpoint":"oss"},"sf_node_eval_param":{"domain":"data_prep"}}`
+	merged, err := decryptSensitiveInputConfig(key, inputConfig, encrypted)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"sf_storage_config":{"type":"s3","endpoint":"oss","password":"secret"},"sf_node_eval_param":{"domain":"data_prep"}}`, merged)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	_, err = decryptSensitiveInputConfig(otherKey, inputConfig, encrypted)
+	assert.Error(t, err)
+
+	_, err = decryptSensitiveInputConfig(nil, inputConfig, encrypted)
+	assert.Error(t, err)
+}
+
+func TestMergeSensitiveInputConfig(t *testing.T) {
+	merged, err := mergeSensitiveInputConfig("", []byte(`{"token":"secret"}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"token":"secret"}`, merged)
+
+	merged, err = mergeSensitiveInputConfig(`{"token":"placeholder","args":["a"]}`, []byte(`{"token":"secret","args":{"b":1}}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"token":"secret","args":{"b":1}}`, merged)
+
+	_, err = mergeSensitiveInputConfig("meta://task-input-config", []byte(`{"token":"secret"}`))
+	assert.Error(t, err)
+	_, err = mergeSensitiveInputConfig(`{}`, []byte(`["secret"]`))
+	assert.Error(t, err)
+}
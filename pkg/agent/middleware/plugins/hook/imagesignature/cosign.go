@@ -0,0 +1,341 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagesignature
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+const (
+	// cosignSignatureAnnotation is the layer annotation holding the base64 signature of the payload.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// cosignCertificateAnnotation is the layer annotation holding the signing certificate of keyless signing.
+	cosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	// cosignChainAnnotation is the layer annotation holding the intermediate certificates of keyless signing.
+	cosignChainAnnotation = "dev.sigstore.cosign/chain"
+
+	cosignSignatureType = "cosign container image signature"
+
+	maxPayloadBytes = 1 << 20
+)
+
+var (
+	// oidIssuerV1 and oidIssuerV2 are the extensions of the fulcio certificates holding the OIDC issuer.
+	oidIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// simpleSigningPayload is the payload signed by cosign.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+type trustedIssuer struct {
+	issuer  string
+	subject string
+	roots   *x509.CertPool
+}
+
+// verifier verifies the cosign signatures of the images stored in the registries.
+type verifier struct {
+	keys                []crypto.PublicKey
+	issuers             []*trustedIssuer
+	signatureRepository name.Repository
+	options             []remote.Option
+}
+
+func newVerifier(cfg *imageSignatureConfig, auth authn.Authenticator) (*verifier, error) {
+	v := &verifier{}
+	for _, file := range cfg.Keys {
+		key, err := loadPublicKey(file)
+		if err != nil {
+			return nil, err
+		}
+		v.keys = append(v.keys, key)
+	}
+	for _, ic := range cfg.Issuers {
+		if ic.Issuer == "" {
+			return nil, fmt.Errorf("issuer of the trusted identity is empty")
+		}
+		roots, err := loadCertPool(ic.RootCAFile)
+		if err != nil {
+			return nil, err
+		}
+		v.issuers = append(v.issuers, &trustedIssuer{issuer: ic.Issuer, subject: ic.Subject, roots: roots})
+	}
+	if len(v.keys) == 0 && len(v.issuers) == 0 {
+		return nil, fmt.Errorf("no trusted key or issuer is configured")
+	}
+	if cfg.SignatureRepository != "" {
+		repo, err := name.NewRepository(cfg.SignatureRepository)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature repository %q, %v", cfg.SignatureRepository, err)
+		}
+		v.signatureRepository = repo
+	}
+
+	v.options = []remote.Option{remote.WithUserAgent("kuscia")}
+	if auth != nil {
+		v.options = append(v.options, remote.WithAuth(auth))
+	}
+	return v, nil
+}
+
+// Resolve returns the digest of the image in the registry.
+func (v *verifier) Resolve(ctx context.Context, image string) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", fmt.Errorf("invalid image %q, %v", image, err)
+	}
+	if digest, ok := ref.(name.Digest); ok {
+		return digest.DigestStr(), nil
+	}
+	desc, err := remote.Head(ref, append(v.options, remote.WithContext(ctx))...)
+	if err != nil {
+		return "", fmt.Errorf("resolve digest of image %q failed, %v", image, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+// Verify checks that the digest of the image is signed by one of the trusted keys or issuers.
+func (v *verifier) Verify(ctx context.Context, image, digest string) error {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return fmt.Errorf("invalid image %q, %v", image, err)
+	}
+	repo := ref.Context()
+	if v.signatureRepository.RepositoryStr() != "" {
+		repo = v.signatureRepository
+	}
+	sigRef := repo.Tag(strings.Replace(digest, ":", "-", 1) + ".sig")
+
+	opts := append(v.options, remote.WithContext(ctx))
+	desc, err := remote.Get(sigRef, opts...)
+	if err != nil {
+		return fmt.Errorf("image %q is not signed, get signatures %s failed, %v", image, sigRef, err)
+	}
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return fmt.Errorf("parse signatures %s failed, %v", sigRef, err)
+	}
+
+	var errs []string
+	for _, l := range manifest.Layers {
+		err := v.verifyLayer(repo, l, digest, opts)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err.Error())
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("image %q is not signed, no signature in %s", image, sigRef)
+	}
+	return fmt.Errorf("no trusted signature of image %q, %s", image, strings.Join(errs, "; "))
+}
+
+func (v *verifier) verifyLayer(repo name.Repository, desc v1.Descriptor, digest string, opts []remote.Option) error {
+	signature, err := base64.StdEncoding.DecodeString(desc.Annotations[cosignSignatureAnnotation])
+	if err != nil || len(signature) == 0 {
+		return fmt.Errorf("signature %s is invalid", desc.Digest)
+	}
+
+	layer, err := remote.Layer(repo.Digest(desc.Digest.String()), opts...)
+	if err != nil {
+		return fmt.Errorf("get payload %s failed, %v", desc.Digest, err)
+	}
+	rc, err := layer.Compressed()
+	if err != nil {
+		return fmt.Errorf("get payload %s failed, %v", desc.Digest, err)
+	}
+	defer rc.Close()
+	payload, err := io.ReadAll(io.LimitReader(rc, maxPayloadBytes))
+	if err != nil {
+		return fmt.Errorf("read payload %s failed, %v", desc.Digest, err)
+	}
+
+	if err := v.verifySignature(desc.Annotations, payload, signature); err != nil {
+		return fmt.Errorf("signature %s is not trusted, %v", desc.Digest, err)
+	}
+	return checkPayload(payload, digest)
+}
+
+// verifySignature verifies the signature with the trusted keys, or with the certificate issued to a trusted identity.
+func (v *verifier) verifySignature(annotations map[string]string, payload, signature []byte) error {
+	for _, key := range v.keys {
+		if verifyWithKey(key, payload, signature) == nil {
+			return nil
+		}
+	}
+
+	certPEM := annotations[cosignCertificateAnnotation]
+	if certPEM == "" || len(v.issuers) == 0 {
+		return fmt.Errorf("not signed by the trusted keys")
+	}
+	cert, err := parseCertificate([]byte(certPEM))
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	if chain := annotations[cosignChainAnnotation]; chain != "" {
+		intermediates.AppendCertsFromPEM([]byte(chain))
+	}
+	for _, ti := range v.issuers {
+		if err := ti.verify(cert, intermediates); err != nil {
+			continue
+		}
+		return verifyWithKey(cert.PublicKey, payload, signature)
+	}
+	return fmt.Errorf("certificate is not issued to the trusted identities")
+}
+
+func (ti *trustedIssuer) verify(cert *x509.Certificate, intermediates *x509.CertPool) error {
+	// the certificates of keyless signing are short-lived, they are verified at the time they are issued since the
+	// signing time recorded in the transparency log is not available.
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         ti.roots,
+		Intermediates: intermediates,
+		CurrentTime:   cert.NotBefore,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return err
+	}
+	if issuer := certificateIssuer(cert); issuer != ti.issuer {
+		return fmt.Errorf("issuer %q is not trusted", issuer)
+	}
+	if ti.subject == "" {
+		return nil
+	}
+	for _, email := range cert.EmailAddresses {
+		if email == ti.subject {
+			return nil
+		}
+	}
+	for _, uri := range cert.URIs {
+		if uri.String() == ti.subject {
+			return nil
+		}
+	}
+	return fmt.Errorf("subject is not trusted")
+}
+
+func certificateIssuer(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var issuer string
+			if _, err := asn1.Unmarshal(ext.Value, &issuer); err == nil {
+				return issuer
+			}
+		case ext.Id.Equal(oidIssuerV1):
+			return string(ext.Value)
+		}
+	}
+	return ""
+}
+
+func checkPayload(payload []byte, digest string) error {
+	p := &simpleSigningPayload{}
+	if err := json.Unmarshal(payload, p); err != nil {
+		return fmt.Errorf("invalid payload, %v", err)
+	}
+	if p.Critical.Type != cosignSignatureType {
+		return fmt.Errorf("invalid payload type %q", p.Critical.Type)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signed digest %q mismatches the image digest %q", p.Critical.Image.DockerManifestDigest, digest)
+	}
+	return nil
+}
+
+func verifyWithKey(key crypto.PublicKey, payload, signature []byte) error {
+	hashed := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, hashed[:], signature) {
+			return fmt.Errorf("invalid ecdsa signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hashed[:], signature)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, signature) {
+			return fmt.Errorf("invalid ed25519 signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+func loadPublicKey(file string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read public key file %q failed, %v", file, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("public key file %q is not in pem format", file)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key file %q failed, %v", file, err)
+	}
+	return key, nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read root ca file %q failed, %v", file, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificate in root ca file %q", file)
+	}
+	return pool, nil
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("signing certificate is not in pem format")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagesignature
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	internalapi "k8s.io/cri-api/pkg/apis"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/agent/framework"
+	"github.com/secretflow/kuscia/pkg/agent/middleware/hook"
+	"github.com/secretflow/kuscia/pkg/agent/middleware/plugin"
+	"github.com/secretflow/kuscia/pkg/agent/utils/format"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	ModeDisabled = "disabled"
+	ModeWarn     = "warn"
+	ModeEnforce  = "enforce"
+
+	// RejectReason is the reason of the pods rejected for the untrusted images.
+	RejectReason = "ImageSignatureRejected"
+
+	defaultTimeoutSeconds = 30
+)
+
+func Register() {
+	plugin.Register(common.PluginNameImageSignature, &imageSignature{})
+}
+
+type imageSignatureConfig struct {
+	// Mode is one of disabled, warn and enforce, default is disabled. The pods with untrusted images are
+	// rejected in enforce mode, and only logged in warn mode.
+	Mode string `yaml:"mode,omitempty"`
+	// Images to verify, matched by prefix, empty means all the images.
+	Images []string `yaml:"images,omitempty"`
+	// Keys are the files of the trusted public keys in pem format.
+	Keys []string `yaml:"keys,omitempty"`
+	// Issuers are the trusted identities of keyless signing.
+	Issuers []issuerConfig `yaml:"issuers,omitempty"`
+	// SignatureRepository stores the signatures instead of the repository of the image.
+	SignatureRepository string `yaml:"signatureRepository,omitempty"`
+	TimeoutSeconds      int    `yaml:"timeoutSeconds,omitempty"`
+}
+
+type issuerConfig struct {
+	// Issuer is the oidc issuer of the signing certificates, e.g. https://token.actions.githubusercontent.com.
+	Issuer string `yaml:"issuer"`
+	// Subject is the email or uri of the signer, empty means any signer of the issuer.
+	Subject string `yaml:"subject,omitempty"`
+	// RootCAFile is the root ca of the signing certificates, e.g. the root of fulcio.
+	RootCAFile string `yaml:"rootCAFile"`
+}
+
+// imageSignature verifies the cosign signatures of the images before the pods are started.
+type imageSignature struct {
+	config     imageSignatureConfig
+	repository string
+	verifier   *verifier
+
+	// verified caches the digests of the trusted images.
+	verified sync.Map
+}
+
+// Type implements the plugin.Plugin interface.
+func (is *imageSignature) Type() string {
+	return hook.PluginType
+}
+
+// Init implements the plugin.Plugin interface.
+func (is *imageSignature) Init(ctx context.Context, dependencies *plugin.Dependencies, cfg *config.PluginCfg) error {
+	if err := cfg.Config.Decode(&is.config); err != nil {
+		return err
+	}
+
+	switch is.config.Mode {
+	case "", ModeDisabled:
+		return nil
+	case ModeWarn, ModeEnforce:
+	default:
+		return fmt.Errorf("invalid image signature mode %q", is.config.Mode)
+	}
+	if is.config.TimeoutSeconds <= 0 {
+		is.config.TimeoutSeconds = defaultTimeoutSeconds
+	}
+
+	var auth authn.Authenticator
+	registry := dependencies.AgentConfig.Registry.Default
+	if registry.Username != "" {
+		auth = &authn.Basic{Username: registry.Username, Password: registry.Password}
+	}
+	v, err := newVerifier(&is.config, auth)
+	if err != nil {
+		return fmt.Errorf("init image signature verifier failed, %v", err)
+	}
+	is.verifier = v
+	is.repository = registry.Repository
+
+	hook.Register(common.PluginNameImageSignature, is)
+	return nil
+}
+
+// CanExec implements the hook.Handler interface.
+// It returns true if point is equal to PointPodAddition and the pod provider manages the images of the node.
+func (is *imageSignature) CanExec(ctx hook.Context) bool {
+	if ctx.Point() != hook.PointPodAddition {
+		return false
+	}
+	paCtx, ok := ctx.(*hook.PodAdditionContext)
+	if !ok {
+		return false
+	}
+	_, ok = paCtx.PodProvider.(internalapi.ImageManagerService)
+	return ok
+}
+
+// ExecHook implements the hook.Handler interface.
+// It verifies the images of the pod, and rejects the pod if any image is untrusted in enforce mode.
+func (is *imageSignature) ExecHook(ctx hook.Context) (*hook.Result, error) {
+	paCtx, ok := ctx.(*hook.PodAdditionContext)
+	if !ok {
+		return nil, fmt.Errorf("failed to convert ctx to PodAdditionContext")
+	}
+	imageService, _ := paCtx.PodProvider.(internalapi.ImageManagerService)
+
+	result := &hook.Result{}
+	for _, c := range append(paCtx.Pod.Spec.InitContainers, paCtx.Pod.Spec.Containers...) {
+		image := framework.ConstructImage(is.repository, c.Image)
+		if !is.needVerify(image) {
+			continue
+		}
+		if err := is.verify(image, imageService); err != nil {
+			if is.config.Mode != ModeEnforce {
+				nlog.Warnf("Image %q of pod %q is untrusted, %v", image, format.Pod(paCtx.Pod), err)
+				continue
+			}
+			return &hook.Result{
+				Terminated: true,
+				Reason:     RejectReason,
+				Msg:        err.Error(),
+			}, nil
+		}
+	}
+	return result, nil
+}
+
+func (is *imageSignature) needVerify(image string) bool {
+	if len(is.config.Images) == 0 {
+		return true
+	}
+	for _, prefix := range is.config.Images {
+		if strings.HasPrefix(image, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (is *imageSignature) verify(image string, imageService internalapi.ImageManagerService) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(is.config.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	digest := localDigest(ctx, image, imageService)
+	if digest == "" {
+		var err error
+		if digest, err = is.verifier.Resolve(ctx, image); err != nil {
+			return err
+		}
+	}
+	key := image + "@" + digest
+	if _, ok := is.verified.Load(key); ok {
+		return nil
+	}
+	if err := is.verifier.Verify(ctx, image, digest); err != nil {
+		return err
+	}
+	nlog.Infof("Verified the signature of image %q, digest=%s", image, digest)
+	is.verified.Store(key, struct{}{})
+	return nil
+}
+
+// localDigest returns the digest of the image present on the node, so that the image actually used is verified.
+func localDigest(ctx context.Context, image string, imageService internalapi.ImageManagerService) string {
+	ref, err := name.ParseReference(image)
+	if err != nil || imageService == nil {
+		return ""
+	}
+	resp, err := imageService.ImageStatus(ctx, &runtimeapi.ImageSpec{Image: image}, false)
+	if err != nil || resp == nil || resp.Image == nil {
+		return ""
+	}
+	for _, repoDigest := range resp.Image.RepoDigests {
+		d, err := name.NewDigest(repoDigest)
+		if err == nil && d.Context().Name() == ref.Context().Name() {
+			return d.DigestStr()
+		}
+	}
+	return ""
+}
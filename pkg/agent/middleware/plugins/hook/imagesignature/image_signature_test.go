@@ -0,0 +1,264 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagesignature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/agent/middleware/hook"
+	"github.com/secretflow/kuscia/pkg/agent/middleware/plugin"
+	"github.com/secretflow/kuscia/pkg/agent/provider/pod"
+)
+
+// pushImage pushes a random image and returns its reference and digest.
+func pushImage(t *testing.T, registryHost, repo string) (string, string) {
+	img, err := random.Image(64, 1)
+	assert.NoError(t, err)
+	ref, err := name.ParseReference(fmt.Sprintf("%s/%s:1.0.0", registryHost, repo))
+	assert.NoError(t, err)
+	assert.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	assert.NoError(t, err)
+	return ref.String(), digest.String()
+}
+
+// pushSignature pushes a cosign signature of the signed digest for the image of the digest.
+func pushSignature(t *testing.T, image, digest, signedDigest string, signer crypto.Signer, annotations map[string]string) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`,
+		strings.Split(image, ":1.0.0")[0], signedDigest))
+	hashed := sha256.Sum256(payload)
+	signature, err := signer.Sign(rand.Reader, hashed[:], crypto.SHA256)
+	assert.NoError(t, err)
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[cosignSignatureAnnotation] = base64.StdEncoding.EncodeToString(signature)
+	sigImage, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       static.NewLayer(payload, types.MediaType("application/vnd.dev.cosign.simplesigning.v1+json")),
+		Annotations: annotations,
+	})
+	assert.NoError(t, err)
+
+	ref, err := name.ParseReference(image)
+	assert.NoError(t, err)
+	sigRef := ref.Context().Tag(strings.Replace(digest, ":", "-", 1) + ".sig")
+	assert.NoError(t, remote.Write(sigRef, sigImage))
+}
+
+func writePEM(t *testing.T, blockType string, data []byte) string {
+	file := filepath.Join(t.TempDir(), "cert.pem")
+	assert.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), 0644))
+	return file
+}
+
+func newTestImageSignature(t *testing.T, configYaml string) *imageSignature {
+	cfg := &config.PluginCfg{}
+	assert.NoError(t, yaml.Unmarshal([]byte(configYaml), cfg))
+	dep := &plugin.Dependencies{AgentConfig: config.DefaultStaticAgentConfig()}
+
+	is := &imageSignature{}
+	assert.Equal(t, hook.PluginType, is.Type())
+	assert.NoError(t, is.Init(context.Background(), dep, cfg))
+	return is
+}
+
+func execHook(t *testing.T, is *imageSignature, image string) *hook.Result {
+	ctx := &hook.PodAdditionContext{
+		Pod:         &v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Image: image}}}},
+		PodProvider: &pod.CRIProvider{ImageManagerService: &fakeImageManagerService{}},
+	}
+	assert.True(t, is.CanExec(ctx))
+	result, err := is.ExecHook(ctx)
+	assert.NoError(t, err)
+	return result
+}
+
+func TestImageSignature_Key(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	pubBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	keyFile := writePEM(t, "PUBLIC KEY", pubBytes)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	signed, signedDigest := pushImage(t, host, "secretflow/signed")
+	pushSignature(t, signed, signedDigest, signedDigest, key, nil)
+	unsigned, _ := pushImage(t, host, "secretflow/unsigned")
+	untrusted, untrustedDigest := pushImage(t, host, "secretflow/untrusted")
+	pushSignature(t, untrusted, untrustedDigest, untrustedDigest, otherKey, nil)
+	// the signature of another image is copied to the tampered image
+	tampered, tamperedDigest := pushImage(t, host, "secretflow/tampered")
+	pushSignature(t, tampered, tamperedDigest, signedDigest, key, nil)
+
+	is := newTestImageSignature(t, fmt.Sprintf(`
+name: image-signature
+config:
+  mode: enforce
+  keys:
+  - %s
+`, keyFile))
+
+	assert.False(t, execHook(t, is, signed).Terminated)
+	for _, image := range []string{unsigned, untrusted, tampered} {
+		result := execHook(t, is, image)
+		assert.True(t, result.Terminated, image)
+		assert.Equal(t, RejectReason, result.Reason)
+	}
+
+	// untrusted images are allowed in warn mode
+	is.config.Mode = ModeWarn
+	assert.False(t, execHook(t, is, unsigned).Terminated)
+	// images not matched are not verified
+	is.config.Mode = ModeEnforce
+	is.config.Images = []string{host + "/secretflow/signed"}
+	assert.False(t, execHook(t, is, unsigned).Terminated)
+}
+
+func TestImageSignature_Issuer(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "sigstore"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	assert.NoError(t, err)
+	caFile := writePEM(t, "CERTIFICATE", caDER)
+
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	issuer, err := asn1.Marshal("https://token.actions.githubusercontent.com")
+	assert.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       time.Now().Add(-time.Minute),
+		NotAfter:        time.Now().Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses:  []string{"ci@example.com"},
+		ExtraExtensions: []pkix.Extension{{Id: oidIssuerV2, Value: issuer}},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, caTemplate, &signingKey.PublicKey, caKey)
+	assert.NoError(t, err)
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}))
+
+	image, digest := pushImage(t, host, "secretflow/keyless")
+	pushSignature(t, image, digest, digest, signingKey, map[string]string{cosignCertificateAnnotation: certPEM})
+
+	configYaml := `
+name: image-signature
+config:
+  mode: enforce
+  issuers:
+  - issuer: https://token.actions.githubusercontent.com
+    subject: %s
+    rootCAFile: %s
+`
+	is := newTestImageSignature(t, fmt.Sprintf(configYaml, "ci@example.com", caFile))
+	assert.False(t, execHook(t, is, image).Terminated)
+
+	is = newTestImageSignature(t, fmt.Sprintf(configYaml, "dev@example.com", caFile))
+	result := execHook(t, is, image)
+	assert.True(t, result.Terminated)
+	assert.Contains(t, result.Msg, "not issued to the trusted identities")
+}
+
+func TestImageSignature_Init(t *testing.T) {
+	is := newTestImageSignature(t, `
+name: image-signature
+`)
+	assert.Nil(t, is.verifier)
+
+	cfg := &config.PluginCfg{}
+	assert.NoError(t, yaml.Unmarshal([]byte(`
+name: image-signature
+config:
+  mode: enforce
+`), cfg))
+	err := (&imageSignature{}).Init(context.Background(), &plugin.Dependencies{AgentConfig: config.DefaultStaticAgentConfig()}, cfg)
+	assert.ErrorContains(t, err, "no trusted key or issuer")
+}
+
+type fakeImageManagerService struct {
+}
+
+func (f *fakeImageManagerService) ListImages(ctx context.Context, filter *runtimeapi.ImageFilter) ([]*runtimeapi.Image, error) {
+	return nil, nil
+}
+
+func (f *fakeImageManagerService) ImageStatus(ctx context.Context, image *runtimeapi.ImageSpec, verbose bool) (*runtimeapi.ImageStatusResponse, error) {
+	return &runtimeapi.ImageStatusResponse{}, nil
+}
+
+func (f *fakeImageManagerService) PullImage(ctx context.Context, image *runtimeapi.ImageSpec, auth *runtimeapi.AuthConfig, podSandboxConfig *runtimeapi.PodSandboxConfig) (string, error) {
+	return "", nil
+}
+
+func (f *fakeImageManagerService) RemoveImage(ctx context.Context, image *runtimeapi.ImageSpec) error {
+	return nil
+}
+
+func (f *fakeImageManagerService) ImageFsInfo(ctx context.Context) ([]*runtimeapi.FilesystemUsage, error) {
+	return nil, nil
+}
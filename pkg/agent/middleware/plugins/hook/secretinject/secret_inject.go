@@ -0,0 +1,404 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretinject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/agent/container"
+	"github.com/secretflow/kuscia/pkg/agent/middleware/hook"
+	"github.com/secretflow/kuscia/pkg/agent/middleware/plugin"
+	"github.com/secretflow/kuscia/pkg/agent/utils/format"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/driver"
+	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/paths"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/confmanager"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+)
+
+const (
+	// defaultSecretDir is on the tmpfs of /dev/shm, so that the secret files are never written to the disk.
+	defaultSecretDir            = "/dev/shm/kuscia/secrets"
+	defaultContainerSecretsPath = "./kuscia/secrets"
+
+	secretsVolumeName = "kuscia-secrets"
+	// podDirMarkerFile records the pod directory of the secrets of a pod, they're removed after the pod directory
+	// is cleaned up.
+	podDirMarkerFile = ".pod-dir"
+	gcInterval       = time.Minute
+
+	placeholderSecret     = "secret"
+	placeholderSecretFile = "secretFile"
+)
+
+// placeholderReg matches {{secret:<key>}} and {{secretFile:<key>}}, the key is a confmanager config key.
+var placeholderReg = regexp.MustCompile(`\{\{(secret|secretFile):([-._a-zA-Z0-9]+)\}\}`)
+
+func Register() {
+	plugin.Register(common.PluginNameSecretInject, &secretInject{})
+}
+
+type secretInjectConfig struct {
+	// SecretDir is the directory on a tmpfs storing the secret files, default /dev/shm/kuscia/secrets.
+	SecretDir string `yaml:"secretDir,omitempty"`
+	// AllowedKeys are the patterns of the config keys that can be referenced, empty means all the keys.
+	AllowedKeys []string `yaml:"allowedKeys,omitempty"`
+}
+
+// secretInject resolves the placeholders of confmanager secrets in the environment variables of the containers
+// when they're started, so that the secrets never appear in the task input configs or the CRDs.
+type secretInject struct {
+	ctx             context.Context
+	config          secretInjectConfig
+	cmConfigService cmservice.IConfigService
+}
+
+// Type implements the plugin.Plugin interface.
+func (si *secretInject) Type() string {
+	return hook.PluginType
+}
+
+// Init implements the plugin.Plugin interface.
+func (si *secretInject) Init(ctx context.Context, dependencies *plugin.Dependencies, cfg *config.PluginCfg) error {
+	if err := cfg.Config.Decode(&si.config); err != nil {
+		return err
+	}
+	if si.config.SecretDir == "" {
+		si.config.SecretDir = defaultSecretDir
+	}
+	for _, pattern := range si.config.AllowedKeys {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid allowed key pattern %q, %v", pattern, err)
+		}
+	}
+	if err := paths.EnsureDirectoryPerm(si.config.SecretDir, true, 0700); err != nil {
+		return fmt.Errorf("failed to ensure secret directory %q, detail-> %v", si.config.SecretDir, err)
+	}
+
+	configService, err := cmservice.NewConfigService(ctx, &cmservice.ConfigServiceConfig{
+		DomainID:      dependencies.AgentConfig.Namespace,
+		DomainKey:     dependencies.AgentConfig.DomainKey,
+		SecretBackend: dependencies.AgentConfig.SecretBackend,
+		Driver:        driver.CRDDriverType,
+		KubeClient:    dependencies.KubeClient,
+	})
+	if err != nil {
+		return fmt.Errorf("init cm config service for agent failed, %s", err.Error())
+	}
+	si.ctx = ctx
+	si.cmConfigService = configService
+
+	go wait.Until(si.gc, gcInterval, ctx.Done())
+
+	hook.Register(common.PluginNameSecretInject, si)
+	return nil
+}
+
+// CanExec implements the hook.Handler interface.
+// It returns true if the environment variables of the containers reference secrets.
+func (si *secretInject) CanExec(ctx hook.Context) bool {
+	switch ctx.Point() {
+	case hook.PointMakeEnvs:
+		mCtx, ok := ctx.(*hook.MakeEnvsContext)
+		if !ok {
+			return false
+		}
+		for _, env := range mCtx.Opts.Envs {
+			if placeholderReg.MatchString(env.Value) {
+				return true
+			}
+		}
+		return false
+	case hook.PointK8sProviderSyncPod:
+		syncPodCtx, ok := ctx.(*hook.K8sProviderSyncPodContext)
+		if !ok {
+			return false
+		}
+		for _, containers := range [][]corev1.Container{syncPodCtx.BkPod.Spec.InitContainers, syncPodCtx.BkPod.Spec.Containers} {
+			for _, c := range containers {
+				for _, env := range c.Env {
+					if placeholderReg.MatchString(env.Value) {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// ExecHook implements the hook.Handler interface.
+// {{secret:<key>}} is replaced by the value of the key, and {{secretFile:<key>}} is replaced by the path of a file
+// containing the value, which is mounted into the container.
+func (si *secretInject) ExecHook(ctx hook.Context) (*hook.Result, error) {
+	switch ctx.Point() {
+	case hook.PointMakeEnvs:
+		mCtx, ok := ctx.(*hook.MakeEnvsContext)
+		if !ok {
+			return nil, fmt.Errorf("invalid context type %T", ctx)
+		}
+		if err := si.handleMakeEnvsContext(mCtx); err != nil {
+			return nil, err
+		}
+	case hook.PointK8sProviderSyncPod:
+		syncPodCtx, ok := ctx.(*hook.K8sProviderSyncPodContext)
+		if !ok {
+			return nil, fmt.Errorf("invalid context type %T", ctx)
+		}
+		if err := si.handleSyncPodContext(syncPodCtx); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid point %v", ctx.Point())
+	}
+	return &hook.Result{}, nil
+}
+
+func (si *secretInject) handleMakeEnvsContext(ctx *hook.MakeEnvsContext) error {
+	var values []string
+	for _, env := range ctx.Opts.Envs {
+		values = append(values, env.Value)
+	}
+	secrets, err := si.resolveSecrets(values)
+	if err != nil {
+		return err
+	}
+
+	podSecretDir := filepath.Join(si.config.SecretDir, string(ctx.Pod.UID))
+	hostDir := filepath.Join(podSecretDir, ctx.Container.Name)
+	mounted := false
+	for i, env := range ctx.Opts.Envs {
+		var writeErr error
+		ctx.Opts.Envs[i].Value = placeholderReg.ReplaceAllStringFunc(env.Value, func(match string) string {
+			subMatch := placeholderReg.FindStringSubmatch(match)
+			key := subMatch[2]
+			if subMatch[1] == placeholderSecret {
+				return secrets[key]
+			}
+			if !mounted {
+				if writeErr = preparePodSecretDir(podSecretDir, hostDir, ctx.PodDir); writeErr != nil {
+					return match
+				}
+				mounted = true
+			}
+			if err := os.WriteFile(filepath.Join(hostDir, key), []byte(secrets[key]), 0644); err != nil {
+				writeErr = err
+				return match
+			}
+			return filepath.Join(defaultContainerSecretsPath, key)
+		})
+		if writeErr != nil {
+			return fmt.Errorf("failed to write secret file of env %q, detail-> %v", env.Name, writeErr)
+		}
+	}
+
+	if mounted {
+		ctx.Opts.Mounts = append(ctx.Opts.Mounts, container.Mount{
+			Name:          secretsVolumeName,
+			ContainerPath: filepath.Join(ctx.Container.WorkingDir, defaultContainerSecretsPath),
+			HostPath:      hostDir,
+			ReadOnly:      true,
+		})
+	}
+
+	nlog.Infof("Injected %d secrets into container %q in pod %q", len(secrets), ctx.Container.Name, format.Pod(ctx.Pod))
+	return nil
+}
+
+// preparePodSecretDir creates the secret directory of the container, the pod directory is recorded first so that
+// the secrets can always be cleaned up by gc.
+func preparePodSecretDir(podSecretDir, hostDir, podDir string) error {
+	if err := paths.EnsureDirectoryPerm(podSecretDir, true, 0700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(podSecretDir, podDirMarkerFile), []byte(podDir), 0600); err != nil {
+		return err
+	}
+	return paths.EnsureDirectoryPerm(hostDir, true, 0755)
+}
+
+// handleSyncPodContext stores the secrets in a kubernetes secret of the backend pod for runk. The environment
+// variables reference the secret by secretKeyRef, so {{secret:<key>}} must be the whole value of them.
+func (si *secretInject) handleSyncPodContext(ctx *hook.K8sProviderSyncPodContext) error {
+	pod := ctx.BkPod
+	var values []string
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, c := range containers {
+			for _, env := range c.Env {
+				values = append(values, env.Value)
+			}
+		}
+	}
+	secrets, err := si.resolveSecrets(values)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretsVolumeName,
+			Namespace: ctx.Pod.Namespace,
+		},
+		StringData: secrets,
+	}
+
+	mountSecret := false
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for i := range containers {
+			c := &containers[i]
+			mountContainer := false
+			for j, env := range c.Env {
+				if matches := placeholderReg.FindAllStringSubmatchIndex(env.Value, -1); len(matches) == 1 &&
+					matches[0][0] == 0 && matches[0][1] == len(env.Value) &&
+					env.Value[matches[0][2]:matches[0][3]] == placeholderSecret {
+					c.Env[j].Value = ""
+					c.Env[j].ValueFrom = &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+						Key:                  env.Value[matches[0][4]:matches[0][5]],
+					}}
+					continue
+				}
+				var replaceErr error
+				c.Env[j].Value = placeholderReg.ReplaceAllStringFunc(env.Value, func(match string) string {
+					subMatch := placeholderReg.FindStringSubmatch(match)
+					if subMatch[1] == placeholderSecret {
+						replaceErr = fmt.Errorf("%s must be the whole value of env %q in runk", match, env.Name)
+						return match
+					}
+					mountContainer = true
+					return filepath.Join(defaultContainerSecretsPath, subMatch[2])
+				})
+				if replaceErr != nil {
+					return replaceErr
+				}
+			}
+			if mountContainer {
+				mountSecret = true
+				c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+					Name:      secretsVolumeName,
+					MountPath: filepath.Join(c.WorkingDir, defaultContainerSecretsPath),
+					ReadOnly:  true,
+				})
+			}
+		}
+	}
+	if mountSecret {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name:         secretsVolumeName,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secret.Name}},
+		})
+	}
+	ctx.Secrets = append(ctx.Secrets, secret)
+
+	nlog.Infof("Injected %d secrets into pod %q", len(secrets), format.Pod(ctx.Pod))
+	return nil
+}
+
+// resolveSecrets queries the values of the secrets referenced by the placeholders from confmanager.
+func (si *secretInject) resolveSecrets(values []string) (map[string]string, error) {
+	keySet := map[string]struct{}{}
+	var keys []string
+	for _, value := range values {
+		for _, subMatch := range placeholderReg.FindAllStringSubmatch(value, -1) {
+			key := subMatch[2]
+			if _, ok := keySet[key]; ok {
+				continue
+			}
+			if !si.allowed(key) {
+				return nil, fmt.Errorf("secret %q is not allowed to be referenced", key)
+			}
+			keySet[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return map[string]string{}, nil
+	}
+
+	resp := si.cmConfigService.BatchQueryConfig(si.ctx, &confmanager.BatchQueryConfigRequest{Keys: keys})
+	if resp.Status.Code != int32(errorcode.ErrorCode_SUCCESS) {
+		return nil, fmt.Errorf("failed to get secrets %v from cm, %v", keys, resp.Status.Message)
+	}
+	secrets := map[string]string{}
+	for _, d := range resp.Data {
+		secrets[d.Key] = d.Value
+	}
+	var missing []string
+	for _, key := range keys {
+		if secrets[key] == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("secrets %v are not found in cm", strings.Join(missing, ","))
+	}
+	return secrets, nil
+}
+
+func (si *secretInject) allowed(key string) bool {
+	if len(si.config.AllowedKeys) == 0 {
+		return true
+	}
+	for _, pattern := range si.config.AllowedKeys {
+		if ok, _ := path.Match(pattern, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// gc removes the secret files of the pods whose pod directories are cleaned up.
+func (si *secretInject) gc() {
+	entries, err := os.ReadDir(si.config.SecretDir)
+	if err != nil {
+		nlog.Warnf("Failed to read secret directory %q, %v", si.config.SecretDir, err)
+		return
+	}
+	for _, entry := range entries {
+		podSecretDir := filepath.Join(si.config.SecretDir, entry.Name())
+		podDir, err := os.ReadFile(filepath.Join(podSecretDir, podDirMarkerFile))
+		if err == nil {
+			if _, err = os.Stat(string(podDir)); err == nil {
+				continue
+			}
+		}
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			nlog.Warnf("Failed to check pod directory of secrets %q, %v", podSecretDir, err)
+			continue
+		}
+		if err := os.RemoveAll(podSecretDir); err != nil {
+			nlog.Warnf("Failed to remove secrets %q, %v", podSecretDir, err)
+			continue
+		}
+		nlog.Infof("Removed the secrets of pod %s", entry.Name())
+	}
+}
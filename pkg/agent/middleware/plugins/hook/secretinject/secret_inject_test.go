@@ -0,0 +1,138 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretinject
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/agent/container"
+	"github.com/secretflow/kuscia/pkg/agent/middleware/hook"
+	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
+	v1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/confmanager"
+)
+
+type fakeConfigService struct {
+	cmservice.IConfigService
+	data map[string]string
+}
+
+func (f *fakeConfigService) BatchQueryConfig(ctx context.Context, request *confmanager.BatchQueryConfigRequest) *confmanager.BatchQueryConfigResponse {
+	resp := &confmanager.BatchQueryConfigResponse{Status: &v1alpha1.Status{}}
+	for _, key := range request.Keys {
+		resp.Data = append(resp.Data, &confmanager.ConfigData{Key: key, Value: f.data[key]})
+	}
+	return resp
+}
+
+func newTestSecretInject(t *testing.T) *secretInject {
+	return &secretInject{
+		ctx:             context.Background(),
+		config:          secretInjectConfig{SecretDir: t.TempDir()},
+		cmConfigService: &fakeConfigService{data: map[string]string{"mysql-pass": "p@ss", "oss-key": "ak"}},
+	}
+}
+
+func TestHandleMakeEnvsContext(t *testing.T) {
+	si := newTestSecretInject(t)
+	podDir := t.TempDir()
+	ctx := &hook.MakeEnvsContext{
+		Pod:       &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "alice", UID: "uid-1"}},
+		Container: &corev1.Container{Name: "engine", WorkingDir: "/work"},
+		Opts: &container.RunContainerOptions{Envs: []container.EnvVar{
+			{Name: "MYSQL_PASS", Value: "{{secret:mysql-pass}}"},
+			{Name: "MYSQL_DSN", Value: "root:{{secret:mysql-pass}}@tcp(mysql:3306)"},
+			{Name: "OSS_KEY_FILE", Value: "{{secretFile:oss-key}}"},
+			{Name: "PLAIN", Value: "{{.PLAIN}}"},
+		}},
+		PodDir: podDir,
+	}
+	assert.True(t, si.CanExec(ctx))
+	_, err := si.ExecHook(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "p@ss", ctx.Opts.Envs[0].Value)
+	assert.Equal(t, "root:p@ss@tcp(mysql:3306)", ctx.Opts.Envs[1].Value)
+	assert.Equal(t, "kuscia/secrets/oss-key", ctx.Opts.Envs[2].Value)
+	assert.Equal(t, "{{.PLAIN}}", ctx.Opts.Envs[3].Value)
+
+	hostDir := filepath.Join(si.config.SecretDir, "uid-1", "engine")
+	data, err := os.ReadFile(filepath.Join(hostDir, "oss-key"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ak", string(data))
+	assert.Equal(t, []container.Mount{{Name: secretsVolumeName, ContainerPath: "/work/kuscia/secrets", HostPath: hostDir, ReadOnly: true}},
+		ctx.Opts.Mounts)
+
+	// the secrets are kept until the pod directory is cleaned up
+	si.gc()
+	assert.FileExists(t, filepath.Join(hostDir, "oss-key"))
+	assert.NoError(t, os.RemoveAll(podDir))
+	si.gc()
+	assert.NoDirExists(t, filepath.Join(si.config.SecretDir, "uid-1"))
+}
+
+func TestResolveSecrets(t *testing.T) {
+	si := newTestSecretInject(t)
+	_, err := si.resolveSecrets([]string{"{{secret:not-exist}}"})
+	assert.Error(t, err)
+
+	si.config.AllowedKeys = []string{"mysql-*"}
+	secrets, err := si.resolveSecrets([]string{"{{secret:mysql-pass}}", "{{secretFile:mysql-pass}}"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"mysql-pass": "p@ss"}, secrets)
+	_, err = si.resolveSecrets([]string{"{{secret:oss-key}}"})
+	assert.Error(t, err)
+}
+
+func TestHandleSyncPodContext(t *testing.T) {
+	si := newTestSecretInject(t)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "alice"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:       "engine",
+			WorkingDir: "/work",
+			Env: []corev1.EnvVar{
+				{Name: "MYSQL_PASS", Value: "{{secret:mysql-pass}}"},
+				{Name: "OSS_KEY_FILE", Value: "{{secretFile:oss-key}}"},
+			},
+		}}},
+	}
+	ctx := &hook.K8sProviderSyncPodContext{Pod: pod, BkPod: pod.DeepCopy()}
+	assert.True(t, si.CanExec(ctx))
+	_, err := si.ExecHook(ctx)
+	assert.NoError(t, err)
+
+	assert.Len(t, ctx.Secrets, 1)
+	assert.Equal(t, map[string]string{"mysql-pass": "p@ss", "oss-key": "ak"}, ctx.Secrets[0].StringData)
+	c := ctx.BkPod.Spec.Containers[0]
+	assert.Equal(t, "", c.Env[0].Value)
+	assert.Equal(t, "mysql-pass", c.Env[0].ValueFrom.SecretKeyRef.Key)
+	assert.Equal(t, "kuscia/secrets/oss-key", c.Env[1].Value)
+	assert.Equal(t, "/work/kuscia/secrets", c.VolumeMounts[0].MountPath)
+	assert.Equal(t, secretsVolumeName, ctx.BkPod.Spec.Volumes[0].Secret.SecretName)
+
+	// the secret can't be embedded in the value in runk
+	pod.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "MYSQL_DSN", Value: "root:{{secret:mysql-pass}}@mysql"}}
+	ctx = &hook.K8sProviderSyncPodContext{Pod: pod, BkPod: pod.DeepCopy()}
+	_, err = si.ExecHook(ctx)
+	assert.Error(t, err)
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/secretflow/kuscia/pkg/agent/middleware/plugins/hook/configrender"
 	"github.com/secretflow/kuscia/pkg/agent/middleware/plugins/hook/envimport"
 	"github.com/secretflow/kuscia/pkg/agent/middleware/plugins/hook/imagesecurity"
+	"github.com/secretflow/kuscia/pkg/agent/middleware/plugins/hook/imagesignature"
+	"github.com/secretflow/kuscia/pkg/agent/middleware/plugins/hook/secretinject"
 )
 
 func init() {
@@ -26,4 +28,6 @@ func init() {
 	certissuance.Register()
 	envimport.Register()
 	imagesecurity.Register()
+	imagesignature.Register()
+	secretinject.Register()
 }
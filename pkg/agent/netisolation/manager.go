@@ -0,0 +1,312 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netisolation restricts the task pods of the node to talk only to the pods of the same job, the allowed
+// ports of the node and the allowed cidrs, which blocks the lateral movement between the concurrent jobs of
+// different partners on the same node.
+package netisolation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utiliptables "k8s.io/kubernetes/pkg/util/iptables"
+	utilexec "k8s.io/utils/exec"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/agent/utils/format"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	// forwardChain filters the traffic between the pods, and from the pods to the outside of the node.
+	forwardChain utiliptables.Chain = "KUSCIA-ISOLATION-FWD"
+	// inputChain filters the traffic from the pods to the node, e.g. the gateway and DataMesh.
+	inputChain utiliptables.Chain = "KUSCIA-ISOLATION-IN"
+)
+
+// bridgeNFSysctls make the traffic between the pods on the same bridge go through iptables.
+var bridgeNFSysctls = []string{
+	"/proc/sys/net/bridge/bridge-nf-call-iptables",
+	"/proc/sys/net/bridge/bridge-nf-call-ip6tables",
+}
+
+// PodLister lists the pods bound to the node, e.g. pod.Manager.
+type PodLister interface {
+	GetPods() []*v1.Pod
+}
+
+// PodStatusProvider provides the latest status of the pods, e.g. status.Manager.
+type PodStatusProvider interface {
+	GetPodStatus(uid types.UID) (v1.PodStatus, bool)
+}
+
+type ManagerConfig struct {
+	PodLister           PodLister
+	StatusProvider      PodStatusProvider
+	NetworkIsolationCfg *config.NetworkIsolationCfg
+}
+
+// Manager reconciles the iptables rules isolating the jobs with the pods of the node periodically.
+type Manager struct {
+	podLister      PodLister
+	statusProvider PodStatusProvider
+	allowedPorts   []int
+	allowedCIDRs   []*net.IPNet
+	syncPeriod     time.Duration
+
+	iptables map[utiliptables.Protocol]utiliptables.Interface
+	// applied is the last rules restored of each protocol, the rules are restored only if they are changed.
+	applied map[utiliptables.Protocol]string
+}
+
+func NewManager(cfg *ManagerConfig) (*Manager, error) {
+	m := &Manager{
+		podLister:      cfg.PodLister,
+		statusProvider: cfg.StatusProvider,
+		allowedPorts:   cfg.NetworkIsolationCfg.AllowedPorts,
+		syncPeriod:     cfg.NetworkIsolationCfg.SyncPeriod,
+		applied:        map[utiliptables.Protocol]string{},
+	}
+	for _, cidr := range cfg.NetworkIsolationCfg.AllowedCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed cidr %q of network isolation, %v", cidr, err)
+		}
+		m.allowedCIDRs = append(m.allowedCIDRs, ipNet)
+	}
+	execer := utilexec.New()
+	m.iptables = map[utiliptables.Protocol]utiliptables.Interface{
+		utiliptables.ProtocolIPv4: utiliptables.New(execer, utiliptables.ProtocolIPv4),
+		utiliptables.ProtocolIPv6: utiliptables.New(execer, utiliptables.ProtocolIPv6),
+	}
+	return m, nil
+}
+
+// Run reconciles the rules periodically until the context is done.
+func (m *Manager) Run(ctx context.Context) error {
+	nlog.Infof("Starting network isolation manager, sync period=%v, allowed ports=%v", m.syncPeriod, m.allowedPorts)
+	enableBridgeNetfilter()
+	ticker := time.NewTicker(m.syncPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.synchronize()
+		}
+	}
+}
+
+// enableBridgeNetfilter makes the traffic between the pods on the same bridge filtered, or only the traffic to the
+// node and the outside is isolated.
+func enableBridgeNetfilter() {
+	for _, sysctl := range bridgeNFSysctls {
+		if value, err := os.ReadFile(sysctl); err == nil && strings.TrimSpace(string(value)) == "1" {
+			continue
+		}
+		if err := os.WriteFile(sysctl, []byte("1"), 0644); err != nil {
+			nlog.Warnf("Failed to enable %s, the traffic between the pods on the same bridge isn't isolated, "+
+				"please load the br_netfilter module and enable it on the host, %v", sysctl, err)
+		}
+	}
+}
+
+// isolatedPod is a pod whose traffic is restricted to its group.
+type isolatedPod struct {
+	name  string
+	group string
+	ips   []net.IP
+}
+
+// IsolationGroup returns the group the pod can talk to, which is the job of the task pod, or the task if the pod
+// isn't labeled with its job. The pods not created by tasks, e.g. the serving pods, aren't isolated.
+func IsolationGroup(pod *v1.Pod) (string, string, bool) {
+	if pod.Labels[common.LabelController] != common.ControllerKusciaTask || pod.Spec.HostNetwork {
+		return "", "", false
+	}
+	if jobUID := pod.Labels[common.LabelJobUID]; jobUID != "" {
+		return common.LabelJobUID, jobUID, true
+	}
+	if taskUID := pod.Labels[common.LabelTaskUID]; taskUID != "" {
+		return common.LabelTaskUID, taskUID, true
+	}
+	return "", "", false
+}
+
+func (m *Manager) isolatedPods() []*isolatedPod {
+	var pods []*isolatedPod
+	for _, pod := range m.podLister.GetPods() {
+		_, group, ok := IsolationGroup(pod)
+		if !ok {
+			continue
+		}
+		status := pod.Status
+		if latest, found := m.statusProvider.GetPodStatus(pod.UID); found {
+			status = latest
+		}
+		// the addresses of the finished pods may be reused by the new pods
+		if status.Phase == v1.PodSucceeded || status.Phase == v1.PodFailed {
+			continue
+		}
+		isolated := &isolatedPod{name: format.Pod(pod), group: group}
+		for _, podIP := range status.PodIPs {
+			if parsed := net.ParseIP(podIP.IP); parsed != nil {
+				isolated.ips = append(isolated.ips, parsed)
+			}
+		}
+		if len(isolated.ips) == 0 {
+			if parsed := net.ParseIP(status.PodIP); parsed != nil {
+				isolated.ips = append(isolated.ips, parsed)
+			}
+		}
+		if len(isolated.ips) > 0 {
+			pods = append(pods, isolated)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].name < pods[j].name
+	})
+	return pods
+}
+
+func (m *Manager) synchronize() {
+	pods := m.isolatedPods()
+	for protocol, ipt := range m.iptables {
+		rules := m.buildRules(protocol, pods)
+		if err := m.apply(ipt, rules); err != nil {
+			// ip6tables may be absent on the nodes without IPv6, which matters only if the pods have IPv6 addresses
+			if protocol == utiliptables.ProtocolIPv6 && !hasProtocol(pods, protocol) {
+				continue
+			}
+			nlog.Warnf("Failed to sync %s network isolation rules, %v", protocol, err)
+		}
+	}
+}
+
+func (m *Manager) apply(ipt utiliptables.Interface, rules string) error {
+	protocol := ipt.Protocol()
+	reset := false
+	for _, hook := range []struct {
+		chain  utiliptables.Chain
+		parent utiliptables.Chain
+	}{{forwardChain, utiliptables.ChainForward}, {inputChain, utiliptables.ChainInput}} {
+		existed, err := ipt.EnsureChain(utiliptables.TableFilter, hook.chain)
+		if err != nil {
+			return err
+		}
+		// the rules are lost if the chain is removed, e.g. the iptables of the node are flushed
+		reset = reset || !existed
+		if _, err := ipt.EnsureRule(utiliptables.Prepend, utiliptables.TableFilter, hook.parent, "-j", string(hook.chain)); err != nil {
+			return err
+		}
+	}
+	if !reset && m.applied[protocol] == rules {
+		return nil
+	}
+	if err := ipt.RestoreAll([]byte(rules), utiliptables.NoFlushTables, utiliptables.NoRestoreCounters); err != nil {
+		return err
+	}
+	m.applied[protocol] = rules
+	nlog.Infof("Synced %s network isolation rules", protocol)
+	return nil
+}
+
+// buildRules builds the rules of the pods of the protocol in the format of iptables-restore. The chains declared
+// are flushed, so the rules of the deleted pods are removed.
+func (m *Manager) buildRules(protocol utiliptables.Protocol, pods []*isolatedPod) string {
+	isProtocol := func(ip net.IP) bool {
+		return isIPOfProtocol(ip, protocol)
+	}
+	groups := map[string][]net.IP{}
+	for _, pod := range pods {
+		for _, ip := range pod.ips {
+			if isProtocol(ip) {
+				groups[pod.group] = append(groups[pod.group], ip)
+			}
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	writeLine := func(words ...string) {
+		buf.WriteString(strings.Join(words, " "))
+		buf.WriteByte('\n')
+	}
+	writeLine("*filter")
+	writeLine(":"+string(forwardChain), "-", "[0:0]")
+	writeLine(":"+string(inputChain), "-", "[0:0]")
+	for _, chain := range []utiliptables.Chain{forwardChain, inputChain} {
+		writeLine("-A", string(chain), "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT")
+	}
+	var isolatedIPs []string
+	for _, pod := range pods {
+		for _, ip := range pod.ips {
+			if !isProtocol(ip) {
+				continue
+			}
+			src := ip.String()
+			isolatedIPs = append(isolatedIPs, src)
+			for _, peer := range groups[pod.group] {
+				if !peer.Equal(ip) {
+					writeLine("-A", string(forwardChain), "-s", src, "-d", peer.String(), "-j", "ACCEPT")
+				}
+			}
+			for _, cidr := range m.allowedCIDRs {
+				if isProtocol(cidr.IP) {
+					writeLine("-A", string(forwardChain), "-s", src, "-d", cidr.String(), "-j", "ACCEPT")
+				}
+			}
+			writeLine("-A", string(forwardChain), "-s", src, "-j", "DROP")
+
+			for _, port := range m.allowedPorts {
+				for _, proto := range []string{"tcp", "udp"} {
+					writeLine("-A", string(inputChain), "-s", src, "-p", proto, "-m", proto, "--dport", strconv.Itoa(port), "-j", "ACCEPT")
+				}
+			}
+			writeLine("-A", string(inputChain), "-s", src, "-j", "DROP")
+		}
+	}
+	// the traffic to the pods from the others out of their groups, the traffic from the peers is accepted above
+	for _, ip := range isolatedIPs {
+		writeLine("-A", string(forwardChain), "-d", ip, "-j", "DROP")
+	}
+	writeLine("COMMIT")
+	return buf.String()
+}
+
+func hasProtocol(pods []*isolatedPod, protocol utiliptables.Protocol) bool {
+	for _, pod := range pods {
+		for _, ip := range pod.ips {
+			if isIPOfProtocol(ip, protocol) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isIPOfProtocol(ip net.IP, protocol utiliptables.Protocol) bool {
+	return (ip.To4() == nil) == (protocol == utiliptables.ProtocolIPv6)
+}
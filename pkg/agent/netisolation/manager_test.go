@@ -0,0 +1,134 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netisolation
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utiliptables "k8s.io/kubernetes/pkg/util/iptables"
+	iptablestest "k8s.io/kubernetes/pkg/util/iptables/testing"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/common"
+)
+
+type fakePods struct {
+	pods     []*v1.Pod
+	statuses map[types.UID]v1.PodStatus
+}
+
+func (f *fakePods) GetPods() []*v1.Pod {
+	return f.pods
+}
+
+func (f *fakePods) GetPodStatus(uid types.UID) (v1.PodStatus, bool) {
+	status, ok := f.statuses[uid]
+	return status, ok
+}
+
+func newTaskPod(name, jobUID, ip string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "alice",
+			UID:       types.UID(name),
+			Labels: map[string]string{
+				common.LabelController: common.ControllerKusciaTask,
+				common.LabelJobUID:     jobUID,
+				common.LabelTaskUID:    "task-" + jobUID,
+			},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning, PodIP: ip, PodIPs: []v1.PodIP{{IP: ip}}},
+	}
+}
+
+func newTestManager(t *testing.T, f *fakePods) (*Manager, *iptablestest.FakeIPTables) {
+	m, err := NewManager(&ManagerConfig{
+		PodLister:      f,
+		StatusProvider: f,
+		NetworkIsolationCfg: &config.NetworkIsolationCfg{
+			Enable:       true,
+			AllowedPorts: []int{53},
+			AllowedCIDRs: []string{"192.168.0.0/16"},
+			SyncPeriod:   time.Second,
+		},
+	})
+	assert.NoError(t, err)
+	ipt := iptablestest.NewFake()
+	m.iptables = map[utiliptables.Protocol]utiliptables.Interface{utiliptables.ProtocolIPv4: ipt}
+	return m, ipt
+}
+
+func TestIsolationGroup(t *testing.T) {
+	pod := newTaskPod("job-a-1", "job-a", "10.88.0.2")
+	label, group, ok := IsolationGroup(pod)
+	assert.True(t, ok)
+	assert.Equal(t, common.LabelJobUID, label)
+	assert.Equal(t, "job-a", group)
+
+	delete(pod.Labels, common.LabelJobUID)
+	label, group, ok = IsolationGroup(pod)
+	assert.True(t, ok)
+	assert.Equal(t, common.LabelTaskUID, label)
+	assert.Equal(t, "task-job-a", group)
+
+	pod.Labels[common.LabelController] = "kusciadeployment"
+	_, _, ok = IsolationGroup(pod)
+	assert.False(t, ok)
+}
+
+func TestSynchronize(t *testing.T) {
+	finished := newTaskPod("job-c-1", "job-c", "10.88.0.5")
+	f := &fakePods{
+		pods: []*v1.Pod{
+			newTaskPod("job-a-1", "job-a", "10.88.0.2"),
+			newTaskPod("job-a-2", "job-a", "10.88.0.3"),
+			newTaskPod("job-b-1", "job-b", "10.88.0.4"),
+			finished,
+		},
+		statuses: map[types.UID]v1.PodStatus{finished.UID: {Phase: v1.PodSucceeded, PodIP: "10.88.0.5"}},
+	}
+	m, ipt := newTestManager(t, f)
+	m.synchronize()
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, ipt.SaveInto(utiliptables.TableFilter, buf))
+	dump := buf.String()
+	assert.Contains(t, dump, "-A FORWARD -j KUSCIA-ISOLATION-FWD")
+	assert.Contains(t, dump, "-A INPUT -j KUSCIA-ISOLATION-IN")
+	assert.Contains(t, dump, "-A KUSCIA-ISOLATION-FWD -s 10.88.0.2 -d 10.88.0.3 -j ACCEPT")
+	assert.Contains(t, dump, "-A KUSCIA-ISOLATION-FWD -s 10.88.0.3 -d 10.88.0.2 -j ACCEPT")
+	assert.NotContains(t, dump, "-s 10.88.0.2 -d 10.88.0.4")
+	assert.Contains(t, dump, "-A KUSCIA-ISOLATION-FWD -s 10.88.0.4 -d 192.168.0.0/16 -j ACCEPT")
+	assert.Contains(t, dump, "-A KUSCIA-ISOLATION-FWD -s 10.88.0.4 -j DROP")
+	assert.Contains(t, dump, "-A KUSCIA-ISOLATION-FWD -d 10.88.0.4 -j DROP")
+	assert.Contains(t, dump, "-A KUSCIA-ISOLATION-IN -s 10.88.0.4 -p udp -m udp --dport 53 -j ACCEPT")
+	assert.Contains(t, dump, "-A KUSCIA-ISOLATION-IN -s 10.88.0.4 -j DROP")
+	assert.NotContains(t, dump, "10.88.0.5")
+
+	// the rules of the deleted pods are removed
+	f.pods = f.pods[:1]
+	m.synchronize()
+	buf.Reset()
+	assert.NoError(t, ipt.SaveInto(utiliptables.TableFilter, buf))
+	assert.NotContains(t, buf.String(), "10.88.0.3")
+	assert.Contains(t, buf.String(), "-A KUSCIA-ISOLATION-FWD -s 10.88.0.2 -j DROP")
+}
@@ -0,0 +1,278 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podexec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/secretflow/kuscia/pkg/agent/kri"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// ExecPath is the path of the exec sessions served on the socket.
+const ExecPath = "/exec"
+
+// PodGetter returns the pod managed by the agent.
+type PodGetter interface {
+	GetPodByName(namespace, name string) (*v1.Pod, bool)
+}
+
+type ServerConfig struct {
+	Namespace    string
+	SocketPath   string
+	PodGetter    PodGetter
+	ExecProvider kri.PodExecProvider
+}
+
+// Server serves the exec sessions for the kusciaapi on a unix socket, which is only accessible on the node. A session
+// is a websocket connection carrying the json encoded ExecContainerRequest and ExecContainerResponse, the first request
+// starts the session.
+type Server struct {
+	namespace  string
+	socketPath string
+	podGetter  PodGetter
+	provider   kri.PodExecProvider
+}
+
+func NewServer(cfg *ServerConfig) *Server {
+	return &Server{
+		namespace:  cfg.Namespace,
+		socketPath: cfg.SocketPath,
+		podGetter:  cfg.PodGetter,
+		provider:   cfg.ExecProvider,
+	}
+}
+
+func (s *Server) Run(ctx context.Context) error {
+	if err := os.MkdirAll(filepath.Dir(s.socketPath), 0755); err != nil {
+		return err
+	}
+	if err := os.Remove(s.socketPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	listener, err := net.Listen("unix", s.socketPath)
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(s.socketPath, 0600); err != nil {
+		listener.Close()
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(ExecPath, websocket.Handler(s.serveExec))
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	nlog.Infof("Serving container exec on %s", s.socketPath)
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) serveExec(ws *websocket.Conn) {
+	defer ws.Close()
+	session := &execSession{ws: ws}
+
+	request := &kusciaapi.ExecContainerRequest{}
+	if err := websocket.JSON.Receive(ws, request); err != nil {
+		nlog.Warnf("Failed to receive the start of exec session, %v", err)
+		return
+	}
+	pod, container, err := s.findContainer(request.Start)
+	if err != nil {
+		session.sendError(err)
+		return
+	}
+	start := request.Start
+	nlog.Infof("Exec %q in container %s of pod %s/%s", start.Command, container, pod.Namespace, pod.Name)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	streams := remotecommand.StreamOptions{
+		Stdout: &execWriter{session: session},
+		Tty:    start.Tty,
+	}
+	if !start.Tty {
+		streams.Stderr = &execWriter{session: session, stderr: true}
+	}
+	var stdinWriter *io.PipeWriter
+	if start.Stdin {
+		streams.Stdin, stdinWriter = io.Pipe()
+	}
+	sizeQueue := &terminalSizeQueue{ch: make(chan remotecommand.TerminalSize, 1), done: ctx.Done()}
+	if start.Tty {
+		streams.TerminalSizeQueue = sizeQueue
+	}
+	go session.receive(cancel, stdinWriter, sizeQueue)
+
+	err = s.provider.ExecInContainer(ctx, pod, container, start.Command, streams)
+	if stdinWriter != nil {
+		stdinWriter.Close()
+	}
+	var exitErr utilexec.ExitError
+	switch {
+	case err == nil:
+		session.sendExit(0)
+	case errors.As(err, &exitErr):
+		session.sendExit(int32(exitErr.ExitStatus()))
+	default:
+		session.sendError(err)
+	}
+}
+
+// findContainer returns the pod of the task replica and its container, the only container is used if it's not specified.
+func (s *Server) findContainer(start *kusciaapi.ExecContainerStart) (*v1.Pod, string, error) {
+	if start == nil || len(start.Command) == 0 {
+		return nil, "", fmt.Errorf("the first request of exec session must start it with the command")
+	}
+	podName := fmt.Sprintf("%s-%d", start.TaskId, start.ReplicaIdx)
+	pod, ok := s.podGetter.GetPodByName(s.namespace, podName)
+	if !ok {
+		return nil, "", fmt.Errorf("pod %s/%s not found", s.namespace, podName)
+	}
+	container := start.Container
+	if container == "" {
+		if len(pod.Spec.Containers) != 1 {
+			return nil, "", fmt.Errorf("pod %s/%s has %d containers, please specify the container", s.namespace, podName, len(pod.Spec.Containers))
+		}
+		container = pod.Spec.Containers[0].Name
+	}
+	return pod, container, nil
+}
+
+type execSession struct {
+	ws *websocket.Conn
+	mu sync.Mutex
+}
+
+func (s *execSession) send(response *kusciaapi.ExecContainerResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return websocket.JSON.Send(s.ws, response)
+}
+
+func (s *execSession) sendError(err error) {
+	_ = s.send(&kusciaapi.ExecContainerResponse{
+		Status: &v1alpha1.Status{Code: int32(errorcode.ErrorCode_KusciaAPIErrExecContainer), Message: err.Error()},
+	})
+}
+
+func (s *execSession) sendExit(code int32) {
+	_ = s.send(&kusciaapi.ExecContainerResponse{
+		Status:   &v1alpha1.Status{Code: int32(errorcode.ErrorCode_SUCCESS), Message: "success"},
+		Exited:   true,
+		ExitCode: code,
+	})
+}
+
+// receive forwards the stdin and the terminal size of the session until it's closed by the peer, which also stops the
+// command. The stdin is dropped if the command doesn't read it.
+func (s *execSession) receive(cancel context.CancelFunc, stdin *io.PipeWriter, sizeQueue *terminalSizeQueue) {
+	defer cancel()
+	for {
+		request := &kusciaapi.ExecContainerRequest{}
+		if err := websocket.JSON.Receive(s.ws, request); err != nil {
+			if stdin != nil {
+				stdin.CloseWithError(err)
+			}
+			return
+		}
+		if stdin != nil && len(request.Stdin) > 0 {
+			_, _ = stdin.Write(request.Stdin)
+		}
+		if stdin != nil && request.CloseStdin {
+			stdin.Close()
+		}
+		if request.Resize != nil {
+			sizeQueue.push(remotecommand.TerminalSize{Width: uint16(request.Resize.Width), Height: uint16(request.Resize.Height)})
+		}
+	}
+}
+
+type execWriter struct {
+	session *execSession
+	stderr  bool
+}
+
+func (w *execWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+	response := &kusciaapi.ExecContainerResponse{
+		Status: &v1alpha1.Status{Code: int32(errorcode.ErrorCode_SUCCESS), Message: "success"},
+	}
+	if w.stderr {
+		response.Stderr = data
+	} else {
+		response.Stdout = data
+	}
+	if err := w.session.send(response); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// terminalSizeQueue keeps the latest size only, the stale ones are useless for the terminal.
+type terminalSizeQueue struct {
+	ch   chan remotecommand.TerminalSize
+	done <-chan struct{}
+}
+
+func (q *terminalSizeQueue) push(size remotecommand.TerminalSize) {
+	for {
+		select {
+		case q.ch <- size:
+			return
+		default:
+		}
+		select {
+		case <-q.ch:
+		default:
+		}
+	}
+}
+
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	select {
+	case size := <-q.ch:
+		return &size
+	case <-q.done:
+		return nil
+	}
+}
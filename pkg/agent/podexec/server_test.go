@@ -0,0 +1,129 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podexec
+
+import (
+	"context"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type fakePods map[string]*v1.Pod
+
+func (f fakePods) GetPodByName(namespace, name string) (*v1.Pod, bool) {
+	pod, ok := f[namespace+"/"+name]
+	return pod, ok
+}
+
+// echoProvider copies the stdin to the stdout, and exits with the length of the input.
+type echoProvider struct {
+	container string
+}
+
+func (p *echoProvider) ExecInContainer(ctx context.Context, pod *v1.Pod, container string, cmd []string, streams remotecommand.StreamOptions) error {
+	p.container = container
+	n, err := io.Copy(streams.Stdout, streams.Stdin)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	return utilexec.CodeExitError{Err: io.EOF, Code: int(n)}
+}
+
+func startTestServer(t *testing.T, provider *echoProvider) string {
+	socketPath := filepath.Join(t.TempDir(), "exec.sock")
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "task-1-0", Namespace: "alice"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "main"}}},
+	}
+	server := NewServer(&ServerConfig{
+		Namespace:    "alice",
+		SocketPath:   socketPath,
+		PodGetter:    fakePods{"alice/task-1-0": pod},
+		ExecProvider: provider,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go server.Run(ctx)
+	assert.Eventually(t, func() bool {
+		conn, err := net.Dial("unix", socketPath)
+		if err == nil {
+			conn.Close()
+		}
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return socketPath
+}
+
+func dialTestServer(t *testing.T, socketPath string) *websocket.Conn {
+	conn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+	wsConfig, err := websocket.NewConfig("ws://agent"+ExecPath, "http://localhost")
+	assert.NoError(t, err)
+	ws, err := websocket.NewClient(wsConfig, conn)
+	assert.NoError(t, err)
+	return ws
+}
+
+func TestServeExec(t *testing.T) {
+	provider := &echoProvider{}
+	ws := dialTestServer(t, startTestServer(t, provider))
+	defer ws.Close()
+
+	start := &kusciaapi.ExecContainerStart{TaskId: "task-1", Command: []string{"cat"}, Stdin: true}
+	assert.NoError(t, websocket.JSON.Send(ws, &kusciaapi.ExecContainerRequest{Start: start}))
+	assert.NoError(t, websocket.JSON.Send(ws, &kusciaapi.ExecContainerRequest{Stdin: []byte("hello")}))
+	assert.NoError(t, websocket.JSON.Send(ws, &kusciaapi.ExecContainerRequest{CloseStdin: true}))
+
+	var stdout string
+	for {
+		response := &kusciaapi.ExecContainerResponse{}
+		assert.NoError(t, websocket.JSON.Receive(ws, response))
+		assert.Equal(t, int32(0), response.Status.Code)
+		stdout += string(response.Stdout)
+		if response.Exited {
+			assert.Equal(t, int32(5), response.ExitCode)
+			break
+		}
+	}
+	assert.Equal(t, "hello", stdout)
+	assert.Equal(t, "main", provider.container)
+}
+
+func TestServeExecPodNotFound(t *testing.T) {
+	ws := dialTestServer(t, startTestServer(t, &echoProvider{}))
+	defer ws.Close()
+
+	start := &kusciaapi.ExecContainerStart{TaskId: "task-2", Command: []string{"sh"}}
+	assert.NoError(t, websocket.JSON.Send(ws, &kusciaapi.ExecContainerRequest{Start: start}))
+	response := &kusciaapi.ExecContainerResponse{}
+	assert.NoError(t, websocket.JSON.Receive(ws, response))
+	assert.NotEqual(t, int32(0), response.Status.Code)
+	assert.False(t, response.Exited)
+}
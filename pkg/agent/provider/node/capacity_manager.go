@@ -49,6 +49,9 @@ type CapacityManager struct {
 	podTotal     resource.Quantity
 	podAvailable resource.Quantity
 
+	// devices are the extended resources of the devices, e.g. nvidia.com/gpu.
+	devices v1.ResourceList
+
 	cgroupCPUQuota    *int64
 	cgroupCPUPeriod   *uint64
 	cgroupMemoryLimit *int64
@@ -238,6 +241,9 @@ func (pa *CapacityManager) Capacity() v1.ResourceList {
 	if pa.ephemeralStorageTotal != nil {
 		rl[v1.ResourceEphemeralStorage] = *pa.ephemeralStorageTotal
 	}
+	for name, quantity := range pa.devices {
+		rl[name] = quantity.DeepCopy()
+	}
 	return rl
 }
 
@@ -251,9 +257,21 @@ func (pa *CapacityManager) Allocatable() v1.ResourceList {
 	if pa.ephemeralStorageAvailable != nil {
 		rl[v1.ResourceEphemeralStorage] = *pa.ephemeralStorageAvailable
 	}
+	for name, quantity := range pa.devices {
+		rl[name] = quantity.DeepCopy()
+	}
 	return rl
 }
 
+// SetDeviceCapacity exposes the devices of the node as the extended resource, so that the scheduler places the pods
+// requesting them onto the node.
+func (pa *CapacityManager) SetDeviceCapacity(name v1.ResourceName, count int64) {
+	if pa.devices == nil {
+		pa.devices = v1.ResourceList{}
+	}
+	pa.devices[name] = *resource.NewQuantity(count, resource.DecimalSI)
+}
+
 func (pa *CapacityManager) GetCgroupCPUQuota() *int64 {
 	return pa.cgroupCPUQuota
 }
@@ -166,3 +166,13 @@ func TestGetCgroupMemoryLimit(t *testing.T) {
 	got := pa.GetCgroupMemoryLimit()
 	assert.Equal(t, limit, *got)
 }
+
+func TestSetDeviceCapacity(t *testing.T) {
+	pa := &CapacityManager{}
+	pa.SetDeviceCapacity("nvidia.com/gpu", 2)
+
+	capacity := pa.Capacity()
+	allocatable := pa.Allocatable()
+	assert.Equal(t, "2", capacity.Name("nvidia.com/gpu", resource.DecimalSI).String())
+	assert.Equal(t, "2", allocatable.Name("nvidia.com/gpu", resource.DecimalSI).String())
+}
@@ -82,6 +82,12 @@ func (gnp *GenericNodeProvider) ConfigureNode(ctx context.Context, name string)
 	return node
 }
 
+// HasDiskPressure returns true if the free space is less than 5% and 3GB, or the free inodes are less than 5%.
+func HasDiskPressure(du *disk.UsageStat) bool {
+	return (du.InodesUsedPercent >= DiskPressureThreshold) ||
+		(du.UsedPercent >= DiskPressureThreshold && du.Free <= DiskPressureMinFreeSize)
+}
+
 // refreshDiskCondition checks whether the disk capacity is under pressure.
 // return:
 //  1. does disk has pressure? [bool]
@@ -96,9 +102,7 @@ func (gnp *GenericNodeProvider) refreshDiskCondition(name, path string) (bool, b
 		return false, false, msg, msg
 	}
 
-	// disk free size <= 5GB and free < 5%, inode <= 5%
-	diskPressure := (du.InodesUsedPercent >= DiskPressureThreshold) ||
-		(du.UsedPercent >= DiskPressureThreshold && du.Free <= DiskPressureMinFreeSize)
+	diskPressure := HasDiskPressure(du)
 
 	outOfDisk := du.Free <= DiskOutMinFreeSize || du.InodesFree <= DiskOutMinFreeInode
 	// FSType has bug, so we not use it in message
@@ -16,21 +16,27 @@ package pod
 
 import (
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
+	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/client-go/util/flowcontrol"
 	internalapi "k8s.io/cri-api/pkg/apis"
 	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
@@ -38,6 +44,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/cri/remote"
 	"k8s.io/kubernetes/pkg/kubelet/logs"
 	"k8s.io/kubernetes/pkg/kubelet/network/dns"
+	kubetypes "k8s.io/kubernetes/pkg/kubelet/types"
 	"k8s.io/kubernetes/pkg/kubelet/util"
 	"k8s.io/kubernetes/pkg/volume/validation"
 	"k8s.io/utils/clock"
@@ -47,6 +54,7 @@ import (
 	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
 	"github.com/secretflow/kuscia/pkg/agent/framework"
 	"github.com/secretflow/kuscia/pkg/agent/framework/net"
+	"github.com/secretflow/kuscia/pkg/agent/gpu"
 	"github.com/secretflow/kuscia/pkg/agent/images"
 	"github.com/secretflow/kuscia/pkg/agent/kri"
 	"github.com/secretflow/kuscia/pkg/agent/kuberuntime"
@@ -58,8 +66,12 @@ import (
 	"github.com/secretflow/kuscia/pkg/agent/resource"
 	"github.com/secretflow/kuscia/pkg/agent/status"
 	"github.com/secretflow/kuscia/pkg/agent/utils/format"
+	"github.com/secretflow/kuscia/pkg/agent/utils/podutils"
+	"github.com/secretflow/kuscia/pkg/common"
+	cmconfig "github.com/secretflow/kuscia/pkg/confmanager/config"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/paths"
+	"github.com/secretflow/kuscia/pkg/utils/tls"
 )
 
 const (
@@ -83,6 +95,8 @@ const (
 	defaultVolumesDirName    = "volumes"
 	defaultContainersDirName = "containers"
 	defaultStorageDirName    = "storage"
+
+	defaultHostDirectoriesDirName = "host-directories"
 )
 
 // ProviderConfig is the config passed to initialize a registered provider.
@@ -104,6 +118,10 @@ type CRIProviderDependence struct {
 	Runtime        string
 	CRIProviderCfg *config.CRIProviderCfg
 	RegistryCfg    *config.RegistryCfg
+	// DomainKey decrypts the registry credentials stored in the confmanager.
+	DomainKey *rsa.PrivateKey
+	// GPUManager allocates the gpus to the containers, nil if gpu is disabled.
+	GPUManager *gpu.Manager
 }
 
 // CRIProvider implements the kubelet interface and stores pods in memory.
@@ -115,6 +133,7 @@ type CRIProvider struct {
 	ns             string
 	rootDirectory  string
 	registryConfig *config.RegistryCfg
+	domainKey      *rsa.PrivateKey
 
 	// k8s resource
 	eventRecorder   record.EventRecorder
@@ -123,6 +142,10 @@ type CRIProvider struct {
 	podCache pkgcontainer.Cache
 
 	containerRuntime pkgcontainer.Runtime
+	runtimeService   internalapi.RuntimeService
+
+	// podsStdoutDirectory holds the stdout logs of the pods.
+	podsStdoutDirectory string
 
 	backOff *flowcontrol.Backoff
 
@@ -132,6 +155,8 @@ type CRIProvider struct {
 
 	// dnsConfigurer is used for setting up DNS resolver configuration when launching pods.
 	dnsConfigurer *dns.Configurer
+	// dnsSearches are appended to the search domains of the pods.
+	dnsSearches []string
 
 	volumeManager *resource.VolumeManager
 	// Handles container probing.
@@ -149,6 +174,8 @@ type CRIProvider struct {
 
 	podSyncHandler framework.SyncHandler
 
+	gpuManager *gpu.Manager
+
 	chStopping chan struct{}
 	chStopped  chan struct{}
 }
@@ -158,9 +185,10 @@ func NewCRIProvider(dep *CRIProviderDependence) (kri.PodProvider, error) {
 	realOS := pkgcontainer.RealOS{}
 
 	cp := &CRIProvider{
-		nodeIPs:        []net.IP{net.IP(dep.NodeIP)},
+		nodeIPs:        []net.IP{net.ParseIP(dep.NodeIP)},
 		ns:             dep.Namespace,
 		registryConfig: dep.RegistryCfg,
+		domainKey:      dep.DomainKey,
 		rootDirectory:  dep.RootDirectory,
 
 		eventRecorder:   dep.EventRecorder,
@@ -169,6 +197,7 @@ func NewCRIProvider(dep *CRIProviderDependence) (kri.PodProvider, error) {
 		podStateProvider: dep.PodStateProvider,
 		podSyncHandler:   dep.PodSyncHandler,
 		statusManager:    dep.StatusManager,
+		gpuManager:       dep.GPUManager,
 
 		chStopping: make(chan struct{}),
 		chStopped:  make(chan struct{}),
@@ -188,7 +217,7 @@ func NewCRIProvider(dep *CRIProviderDependence) (kri.PodProvider, error) {
 	)
 
 	switch dep.Runtime {
-	case config.ContainerRuntime:
+	case config.ContainerRuntime, config.ContainerdRuntime:
 		remoteRuntimeService, err = remote.NewRemoteRuntimeService(dep.CRIProviderCfg.RemoteRuntimeEndpoint, dep.CRIProviderCfg.RuntimeRequestTimeout, nil)
 		if err != nil {
 			return nil, err
@@ -221,6 +250,7 @@ func NewCRIProvider(dep *CRIProviderDependence) (kri.PodProvider, error) {
 	}
 
 	cp.ImageManagerService = remoteImageService
+	cp.runtimeService = remoteRuntimeService
 
 	// setup containerLogManager for CRI container runtime
 	containerLogManager, err := logs.NewContainerLogManager(
@@ -239,7 +269,20 @@ func NewCRIProvider(dep *CRIProviderDependence) (kri.PodProvider, error) {
 	cp.startupManager = proberesults.NewManager()
 	cp.probeManager = prober.NewManager(cp.statusManager, cp.livenessManager, cp.readinessManager, cp.startupManager, cp.eventRecorder)
 
+	containerSecurity := dep.CRIProviderCfg.ContainerSecurity
+	switch containerSecurity.RootPolicy {
+	case "":
+		containerSecurity.RootPolicy = config.RootPolicyAllow
+	case config.RootPolicyAllow, config.RootPolicyDeny, config.RootPolicyUserNamespace:
+	default:
+		return nil, fmt.Errorf("unknown root policy %q", containerSecurity.RootPolicy)
+	}
+	if !filepath.IsAbs(containerSecurity.SeccompProfileRoot) {
+		containerSecurity.SeccompProfileRoot = path.Join(dep.RootDirectory, containerSecurity.SeccompProfileRoot)
+	}
+
 	podsStdoutDirectory := filepath.Join(dep.StdoutDirectory, defaultPodsDirName)
+	cp.podsStdoutDirectory = podsStdoutDirectory
 	cp.containerRuntime, err = kuberuntime.NewManager(
 		dep.EventRecorder,
 		cp.livenessManager,
@@ -258,6 +301,7 @@ func NewCRIProvider(dep *CRIProviderDependence) (kri.PodProvider, error) {
 		true,
 		podsStdoutDirectory,
 		dep.AllowPrivileged,
+		containerSecurity,
 		dep.Runtime,
 	)
 	if err != nil {
@@ -292,6 +336,7 @@ func NewCRIProvider(dep *CRIProviderDependence) (kri.PodProvider, error) {
 		clusterDNS,
 		dep.CRIProviderCfg.ClusterDomain,
 		dep.CRIProviderCfg.ResolverConfig)
+	cp.dnsSearches = dep.CRIProviderCfg.Searches
 
 	cp.runtimeCache, err = pkgcontainer.NewRuntimeCache(cp.containerRuntime)
 	if err != nil {
@@ -417,6 +462,12 @@ func (cp *CRIProvider) GetStorageDir() string {
 	return filepath.Join(cp.getRootDir(), defaultVariableDirName, defaultStorageDirName)
 }
 
+// GetHostDirectoriesDir returns the full path to the directory under which the host directory volumes are created,
+// they are kept across the restarts of the pods.
+func (cp *CRIProvider) GetHostDirectoriesDir() string {
+	return filepath.Join(cp.getRootDir(), defaultVariableDirName, defaultHostDirectoriesDirName)
+}
+
 // makePodDataDirs creates the dirs for the pod datas.
 func (cp *CRIProvider) makePodDataDirs(pod *v1.Pod) error {
 	uid := pod.UID
@@ -555,6 +606,16 @@ func (cp *CRIProvider) GenerateRunContainerOptions(pod *v1.Pod, container *v1.Co
 	}
 	opts.Envs = append(opts.Envs, envs...)
 
+	if err := hook.Execute(&hook.MakeEnvsContext{
+		Pod:          pod,
+		Container:    container,
+		Opts:         opts,
+		PodDir:       cp.getPodDir(pod.UID),
+		ContainerDir: cp.getPodContainerDir(pod.UID, container.Name),
+	}); err != nil {
+		return nil, nil, err
+	}
+
 	volumes := cp.volumeManager.GetMountedVolumesForPod(pod.UID)
 
 	mounts, err := cp.makeMounts(pod, container, volumes, opts.Envs)
@@ -569,6 +630,12 @@ func (cp *CRIProvider) GenerateRunContainerOptions(pod *v1.Pod, container *v1.Co
 		HostPath:      filepath.Join(cp.GetStorageDir()),
 	})
 
+	if cp.gpuManager != nil {
+		if err := cp.gpuManager.Allocate(pod, container, opts); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// adding TerminationMessagePath on Windows is only allowed if ContainerD is used. Individual files cannot
 	// be mounted as volumes using Docker for Windows.
 	if len(container.TerminationMessagePath) != 0 {
@@ -589,7 +656,22 @@ func (cp *CRIProvider) GenerateRunContainerOptions(pod *v1.Pod, container *v1.Co
 // This function is defined in pkgcontainer.RuntimeHelper interface so we
 // have to implement it.
 func (cp *CRIProvider) GetPodDNS(pod *v1.Pod) (dnsConfig *runtimeapi.DNSConfig, err error) {
-	return cp.dnsConfigurer.GetPodDNS(pod)
+	dnsConfig, err = cp.dnsConfigurer.GetPodDNS(pod)
+	if err != nil || dnsConfig == nil {
+		return dnsConfig, err
+	}
+	dnsConfig.Searches = appendDNSSearches(dnsConfig.Searches, cp.dnsSearches)
+	return dnsConfig, nil
+}
+
+// appendDNSSearches appends the search domains which aren't in the searches yet.
+func appendDNSSearches(searches, toAppend []string) []string {
+	for _, search := range toAppend {
+		if !slices.Contains(searches, search) {
+			searches = append(searches, search)
+		}
+	}
+	return searches
 }
 
 // GetPodCgroupParent gets pod cgroup parent from container manager.
@@ -603,6 +685,111 @@ func (cp *CRIProvider) GetPodDir(podUID types.UID) string {
 	return cp.getPodDir(podUID)
 }
 
+// GetPodEphemeralStorageUsage sums the pod directory, the stdout logs and the writable layers of the containers of
+// the pod. The writable layers are reported by the runtime, runp doesn't report them and they are skipped.
+func (cp *CRIProvider) GetPodEphemeralStorageUsage(ctx context.Context, pod *v1.Pod) (uint64, error) {
+	podDirSize, err := paths.DirSize(cp.getPodDir(pod.UID))
+	if err != nil {
+		return 0, err
+	}
+	logsSize, err := paths.DirSize(kuberuntime.BuildPodLogsDirectory(cp.podsStdoutDirectory, pod.Namespace, pod.Name, pod.UID))
+	if err != nil {
+		return 0, err
+	}
+	usage := podDirSize + logsSize
+
+	stats, err := cp.runtimeService.ListContainerStats(ctx, &runtimeapi.ContainerStatsFilter{
+		LabelSelector: map[string]string{kubetypes.KubernetesPodUIDLabel: string(pod.UID)},
+	})
+	if err != nil {
+		nlog.Debugf("Failed to list container stats of pod %q, %v", format.Pod(pod), err)
+		return usage, nil
+	}
+	for _, s := range stats {
+		if s.GetWritableLayer().GetUsedBytes() != nil {
+			usage += s.GetWritableLayer().GetUsedBytes().GetValue()
+		}
+	}
+	return usage, nil
+}
+
+// GetHostDirectoryUsage returns the bytes used by the host directory volume of the pod.
+func (cp *CRIProvider) GetHostDirectoryUsage(ctx context.Context, pod *v1.Pod, volumeName string) (uint64, error) {
+	return paths.DirSize(cp.volumeManager.GetHostDirectoryPath(pod, volumeName))
+}
+
+// GetPodConditions reports the latest checkpoint written by the engine to the host directory checkpoint volume, the
+// report time is kept until the engine writes a new checkpoint.
+func (cp *CRIProvider) GetPodConditions(pod *v1.Pod) []v1.PodCondition {
+	volumeName := pod.Annotations[common.CheckpointVolumeAnnotationKey]
+	if volumeName == "" || !podutils.HostDirectoryVolumes(pod).Has(volumeName) {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Join(cp.volumeManager.GetHostDirectoryPath(pod, volumeName), common.CheckpointLatestFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Warnf("Failed to read the latest checkpoint of pod %q, %v", format.Pod(pod), err)
+		}
+		return nil
+	}
+	checkpointID := strings.TrimSpace(string(data))
+	if checkpointID == "" {
+		return nil
+	}
+
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == common.PodConditionCheckpointAvailable && cond.Message == checkpointID {
+			return []v1.PodCondition{cond}
+		}
+	}
+	now := metav1.Now()
+	return []v1.PodCondition{{
+		Type:               common.PodConditionCheckpointAvailable,
+		Status:             v1.ConditionTrue,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             "CheckpointWritten",
+		Message:            checkpointID,
+	}}
+}
+
+// ExecInContainer asks the runtime for the streaming url of the exec and connects to it, the process runtime doesn't
+// serve the streaming.
+func (cp *CRIProvider) ExecInContainer(ctx context.Context, pod *v1.Pod, container string, cmd []string, streams remotecommand.StreamOptions) error {
+	podStatus, err := cp.GetPodStatus(ctx, pod)
+	if err != nil {
+		return err
+	}
+	containerStatus := podStatus.FindContainerStatusByName(container)
+	if containerStatus == nil || containerStatus.State != pkgcontainer.ContainerStateRunning {
+		return fmt.Errorf("container %q of pod %q is not running", container, format.Pod(pod))
+	}
+
+	resp, err := cp.runtimeService.Exec(ctx, &runtimeapi.ExecRequest{
+		ContainerId: containerStatus.ID.ID,
+		Cmd:         cmd,
+		Tty:         streams.Tty,
+		Stdin:       streams.Stdin != nil,
+		Stdout:      streams.Stdout != nil,
+		Stderr:      streams.Stderr != nil && !streams.Tty,
+	})
+	if err != nil {
+		return err
+	}
+	if resp == nil || resp.Url == "" {
+		return fmt.Errorf("exec in container is not supported by the runtime")
+	}
+	streamURL, err := url.Parse(resp.Url)
+	if err != nil {
+		return err
+	}
+	executor, err := remotecommand.NewSPDYExecutor(&restclient.Config{}, "POST", streamURL)
+	if err != nil {
+		return err
+	}
+	return executor.StreamWithContext(ctx, streams)
+}
+
 // truncatePodHostnameIfNeeded truncates the pod hostname if it's longer than 63 chars.
 func truncatePodHostnameIfNeeded(podName, hostname string) (string, error) {
 	// Cap hostname at 63 chars (specification is 64bytes which is 63 chars and the null terminating char).
@@ -716,8 +903,9 @@ func (cp *CRIProvider) getAuthFromSecret(repository, secretName string) (*creden
 
 // getRegistryAuth gets authorization information for connecting to a registry.
 func (cp *CRIProvider) getRegistryAuth() *credentialprovider.AuthConfig {
-	if cp.registryConfig.Default.SecretName != "" {
-		auth, err := cp.getAuthFromSecret(cp.registryConfig.Default.Repository, cp.registryConfig.Default.SecretName)
+	defaultAuth := cp.registryConfig.DefaultAuth()
+	if defaultAuth.SecretName != "" {
+		auth, err := cp.getAuthFromSecret(defaultAuth.Repository, defaultAuth.SecretName)
 		if err == nil {
 			return auth
 		}
@@ -726,14 +914,95 @@ func (cp *CRIProvider) getRegistryAuth() *credentialprovider.AuthConfig {
 	}
 
 	return &credentialprovider.AuthConfig{
-		Username:      cp.registryConfig.Default.Username,
-		Password:      cp.registryConfig.Default.Password,
-		Auth:          cp.registryConfig.Default.Auth,
-		ServerAddress: cp.registryConfig.Default.ServerAddress,
-		IdentityToken: cp.registryConfig.Default.IdentityToken,
-		RegistryToken: cp.registryConfig.Default.RegistryToken,
+		Username:      defaultAuth.Username,
+		Password:      defaultAuth.Password,
+		Auth:          defaultAuth.Auth,
+		ServerAddress: defaultAuth.ServerAddress,
+		IdentityToken: defaultAuth.IdentityToken,
+		RegistryToken: defaultAuth.RegistryToken,
+	}
+
+}
+
+// registryCredential is the registry credential stored in the confmanager as json. The name and secret of the
+// exported harbor robot accounts are accepted as the username and password.
+type registryCredential struct {
+	Server        string `json:"server,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	Auth          string `json:"auth,omitempty"`
+	IdentityToken string `json:"identityToken,omitempty"`
+	RegistryToken string `json:"registryToken,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Secret        string `json:"secret,omitempty"`
+}
+
+func parseRegistryCredential(value string) (*credentialprovider.AuthConfig, error) {
+	credential := &registryCredential{}
+	if err := json.Unmarshal([]byte(value), credential); err != nil {
+		return nil, fmt.Errorf("invalid registry credential, detail-> %v", err)
+	}
+	if credential.Username == "" {
+		credential.Username = credential.Name
+	}
+	if credential.Password == "" {
+		credential.Password = credential.Secret
+	}
+	if credential.Username == "" && credential.Auth == "" && credential.IdentityToken == "" && credential.RegistryToken == "" {
+		return nil, fmt.Errorf("invalid registry credential, no username, auth or token")
+	}
+
+	return &credentialprovider.AuthConfig{
+		Username:      credential.Username,
+		Password:      credential.Password,
+		Auth:          credential.Auth,
+		ServerAddress: credential.Server,
+		IdentityToken: credential.IdentityToken,
+		RegistryToken: credential.RegistryToken,
+	}, nil
+}
+
+// getAuthFromConfManager gets the registry credential of the key from the domain config of the confmanager.
+func (cp *CRIProvider) getAuthFromConfManager(key string) (*credentialprovider.AuthConfig, error) {
+	if cp.domainKey == nil {
+		return nil, fmt.Errorf("domain key is not configured")
+	}
+	cm, err := cp.resourceManager.GetConfigMap(cmconfig.DomainConfigName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap %q, detail-> %v", cmconfig.DomainConfigName, err)
+	}
+	encValue, exists := cm.Data[key]
+	if !exists {
+		return nil, fmt.Errorf("registry credential %q is not found in confmanager", key)
+	}
+	value, err := tls.DecryptOAEP(cp.domainKey, encValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt registry credential %q, detail-> %v", key, err)
+	}
+	return parseRegistryCredential(string(value))
+}
+
+// getRegistryAuthByKey gets the registry credential of the key, the default one is used if the key is empty.
+func (cp *CRIProvider) getRegistryAuthByKey(key string) *credentialprovider.AuthConfig {
+	if key != "" {
+		auth, err := cp.getAuthFromConfManager(key)
+		if err == nil {
+			return auth
+		}
+
+		nlog.Errorf("Unable to obtain registry credential %q from confmanager, ignoring and using default authorization, err-> %v", key, err)
 	}
+	return cp.getRegistryAuth()
+}
 
+// PrewarmImage pulls the image with the registry authorization if it's not present. It must not be named PullImage,
+// which would shadow the method of the embedded ImageManagerService that the hook plugins rely on.
+func (cp *CRIProvider) PrewarmImage(ctx context.Context, image, registryCredentialKey string) (string, error) {
+	spec := pkgcontainer.ImageSpec{Image: image}
+	if imageRef, err := cp.containerRuntime.GetImageRef(ctx, spec); err == nil && imageRef != "" {
+		return imageRef, nil
+	}
+	return cp.containerRuntime.PullImage(ctx, spec, cp.getRegistryAuthByKey(registryCredentialKey), nil)
 }
 
 // SyncPod is the transaction script for the sync of a single pod (setting up)
@@ -777,7 +1046,7 @@ func (cp *CRIProvider) SyncPod(ctx context.Context, pod *v1.Pod, podStatus *pkgc
 	}
 
 	// Fetch the authorization information for the pod
-	auth := cp.getRegistryAuth()
+	auth := cp.getRegistryAuthByKey(pod.Annotations[common.ImageRegistryCredentialKeyAnnotationKey])
 
 	// Ensure the pod is being probed
 	cp.probeManager.AddPod(pod)
@@ -822,6 +1091,10 @@ func (cp *CRIProvider) DeletePod(ctx context.Context, pod *v1.Pod) error {
 
 	cp.volumeManager.UnmountVolumesForPod(pod.UID)
 
+	if cp.gpuManager != nil {
+		cp.gpuManager.Release(pod.UID)
+	}
+
 	return nil
 }
 
@@ -840,6 +1113,12 @@ func (cp *CRIProvider) CleanupPods(ctx context.Context, pods []*v1.Pod, runningP
 		allPods.Insert(string(pod.ID))
 	}
 
+	if cp.gpuManager != nil {
+		cp.gpuManager.RemoveStalePods(func(uid types.UID) bool {
+			return allPods.Has(string(uid))
+		})
+	}
+
 	found, err := cp.listPodsFromDisk()
 	if err != nil {
 		return err
@@ -866,6 +1145,10 @@ func (cp *CRIProvider) CleanupPods(ctx context.Context, pods []*v1.Pod, runningP
 			}
 		}
 	}
+	if err := cp.volumeManager.CleanupHostDirectories(pods); err != nil {
+		orphanRemovalErrors = append(orphanRemovalErrors, err)
+	}
+
 	logSpew(orphanRemovalErrors)
 	return utilerrors.NewAggregate(orphanRemovalErrors)
 
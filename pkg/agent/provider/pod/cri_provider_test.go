@@ -15,9 +15,14 @@
 package pod
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"fmt"
+	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -26,6 +31,9 @@ import (
 	"github.com/secretflow/kuscia/pkg/agent/config"
 	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
 	resourcetest "github.com/secretflow/kuscia/pkg/agent/resource/testing"
+	"github.com/secretflow/kuscia/pkg/common"
+	cmconfig "github.com/secretflow/kuscia/pkg/confmanager/config"
+	"github.com/secretflow/kuscia/pkg/utils/tls"
 )
 
 func createTestCRIProvider(t *testing.T) *CRIProvider {
@@ -150,3 +158,107 @@ func createTestPods() []v1.Pod {
 	}
 
 }
+
+func TestCRIProvider_getRegistryAuthByKey(t *testing.T) {
+	domainKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	encrypt := func(value string) string {
+		encValue, err := tls.EncryptOAEP(&domainKey.PublicKey, []byte(value))
+		assert.NoError(t, err)
+		return encValue
+	}
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: cmconfig.DomainConfigName, Namespace: "default"},
+		Data: map[string]string{
+			"harbor-robot": encrypt(`{"server":"harbor.example.com","name":"robot$kuscia","secret":"xyz"}`),
+			"invalid":      encrypt(`{"server":"harbor.example.com"}`),
+		},
+	}
+
+	cp := createTestCRIProvider(t)
+	cp.resourceManager = resourcetest.FakeResourceManager("default", cm)
+	cp.domainKey = domainKey
+	cp.registryConfig = &config.RegistryCfg{Default: config.RegistryAuth{Username: "default"}}
+
+	auth := cp.getRegistryAuthByKey("harbor-robot")
+	assert.Equal(t, "harbor.example.com", auth.ServerAddress)
+	assert.Equal(t, "robot$kuscia", auth.Username)
+	assert.Equal(t, "xyz", auth.Password)
+
+	// fall back to the default authorization
+	assert.Equal(t, "default", cp.getRegistryAuthByKey("").Username)
+	assert.Equal(t, "default", cp.getRegistryAuthByKey("invalid").Username)
+	assert.Equal(t, "default", cp.getRegistryAuthByKey("not-exist").Username)
+}
+
+func TestCRIProvider_HostDirectory(t *testing.T) {
+	cp := createTestCRIProvider(t)
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "task-a-alice-0",
+			Namespace:   "alice",
+			UID:         "uid-1",
+			Annotations: map[string]string{common.HostDirectoryVolumesAnnotationKey: "ckpt"},
+		},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{Name: "ckpt", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+			},
+		},
+	}
+	assert.NoError(t, cp.volumeManager.MountVolumesForPod(pod))
+	hostPath := path.Join(cp.GetHostDirectoriesDir(), "alice", "task-a-alice-0", "ckpt")
+	assert.Equal(t, hostPath, cp.volumeManager.GetMountedVolumesForPod(pod.UID)["ckpt"].HostPath)
+	assert.NoError(t, os.WriteFile(path.Join(hostPath, "model.ckpt"), []byte("checkpoint"), 0644))
+
+	usage, err := cp.GetHostDirectoryUsage(context.Background(), pod, "ckpt")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), usage)
+
+	// the directory is kept for the restarted pod until the retention expires
+	podDir := path.Dir(hostPath)
+	assert.NoError(t, cp.volumeManager.CleanupHostDirectories(nil))
+	assert.DirExists(t, podDir)
+	expired := time.Now().Add(-25 * time.Hour)
+	assert.NoError(t, os.Chtimes(podDir, expired, expired))
+	assert.NoError(t, cp.volumeManager.CleanupHostDirectories([]*v1.Pod{pod}))
+	assert.DirExists(t, podDir)
+	assert.NoError(t, os.Chtimes(podDir, expired, expired))
+	assert.NoError(t, cp.volumeManager.CleanupHostDirectories(nil))
+	assert.NoDirExists(t, podDir)
+}
+
+func TestCRIProvider_GetPodConditions(t *testing.T) {
+	cp := createTestCRIProvider(t)
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "task-a-alice-0",
+			Namespace: "alice",
+			Annotations: map[string]string{
+				common.HostDirectoryVolumesAnnotationKey: "ckpt",
+				common.CheckpointVolumeAnnotationKey:     "ckpt",
+			},
+		},
+	}
+	assert.Empty(t, cp.GetPodConditions(pod))
+
+	hostPath := cp.volumeManager.GetHostDirectoryPath(pod, "ckpt")
+	assert.NoError(t, os.MkdirAll(hostPath, 0755))
+	assert.NoError(t, os.WriteFile(path.Join(hostPath, common.CheckpointLatestFile), []byte("epoch-3\n"), 0644))
+	conditions := cp.GetPodConditions(pod)
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, "epoch-3", conditions[0].Message)
+
+	// the report time is kept for the same checkpoint
+	conditions[0].LastProbeTime = metav1.NewTime(time.Unix(1000, 0))
+	pod.Status.Conditions = conditions
+	assert.Equal(t, conditions, cp.GetPodConditions(pod))
+	assert.NoError(t, os.WriteFile(path.Join(hostPath, common.CheckpointLatestFile), []byte("epoch-4"), 0644))
+	assert.Equal(t, "epoch-4", cp.GetPodConditions(pod)[0].Message)
+	assert.NotEqual(t, int64(1000), cp.GetPodConditions(pod)[0].LastProbeTime.Unix())
+}
+
+func TestAppendDNSSearches(t *testing.T) {
+	assert.Equal(t, []string{"alice.svc", "corp.example.com"}, appendDNSSearches([]string{"alice.svc"}, []string{"corp.example.com", "alice.svc"}))
+	assert.Equal(t, []string{"alice.svc"}, appendDNSSearches([]string{"alice.svc"}, nil))
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/secretflow/kuscia/pkg/agent/netisolation"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const dnsPort = 53
+
+// ensureNetworkPolicy creates the NetworkPolicy isolating the job of the pod in the backend k8s before the pod is
+// created, and returns its name. The pods of the job can talk to each other, to the allowed ports of the kuscia pod
+// and to the allowed cidrs. It returns empty if the pod isn't isolated.
+func (kp *K8sProvider) ensureNetworkPolicy(ctx context.Context, pod *v1.Pod) (string, error) {
+	if kp.networkIsolation == nil || !kp.networkIsolation.Enable {
+		return "", nil
+	}
+	label, group, ok := netisolation.IsolationGroup(pod)
+	if !ok {
+		return "", nil
+	}
+	policy := kp.buildNetworkPolicy(label, group)
+	policies := kp.bkClient.NetworkingV1().NetworkPolicies(kp.bkNamespace)
+	if _, err := policies.Get(ctx, policy.Name, metav1.GetOptions{}); err == nil {
+		return policy.Name, nil
+	} else if !k8serrors.IsNotFound(err) {
+		return "", err
+	}
+	if _, err := policies.Create(ctx, policy, metav1.CreateOptions{}); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return "", err
+	}
+	nlog.Infof("Create network policy %s/%s for pods with %s=%s", kp.bkNamespace, policy.Name, label, group)
+	return policy.Name, nil
+}
+
+// ownNetworkPolicy makes the backend pod an owner of the NetworkPolicy, so that the NetworkPolicy is garbage
+// collected by the backend k8s once all the pods of the job are deleted.
+func (kp *K8sProvider) ownNetworkPolicy(ctx context.Context, name string, bkPod *v1.Pod) error {
+	policies := kp.bkClient.NetworkingV1().NetworkPolicies(kp.bkNamespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		policy, err := policies.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, ref := range policy.OwnerReferences {
+			if ref.UID == bkPod.UID {
+				return nil
+			}
+		}
+		policy.OwnerReferences = append(policy.OwnerReferences, metav1.OwnerReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Name:       bkPod.Name,
+			UID:        bkPod.UID,
+		})
+		_, err = policies.Update(ctx, policy, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (kp *K8sProvider) buildNetworkPolicy(label, group string) *networkingv1.NetworkPolicy {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{
+		common.LabelNodeNamespace: kp.namespace,
+		label:                     group,
+	}}
+	peers := []networkingv1.NetworkPolicyPeer{{PodSelector: selector}}
+
+	// the kuscia pod is the gateway of the pods to their partners and the local services
+	var kusciaPeers []networkingv1.NetworkPolicyPeer
+	if block := hostIPBlock(kp.nodeIP); block != nil {
+		kusciaPeers = append(kusciaPeers, networkingv1.NetworkPolicyPeer{IPBlock: block})
+	}
+	var allowedPorts []networkingv1.NetworkPolicyPort
+	for _, port := range kp.networkIsolation.AllowedPorts {
+		allowedPorts = append(allowedPorts, policyPorts(port)...)
+	}
+
+	egress := []networkingv1.NetworkPolicyEgressRule{{To: peers}}
+	if len(kusciaPeers) > 0 {
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{To: kusciaPeers, Ports: allowedPorts})
+	}
+	if kp.podDNSConfig != nil {
+		var dnsPeers []networkingv1.NetworkPolicyPeer
+		for _, server := range kp.podDNSConfig.Nameservers {
+			if server == kp.nodeIP {
+				continue
+			}
+			if block := hostIPBlock(server); block != nil {
+				dnsPeers = append(dnsPeers, networkingv1.NetworkPolicyPeer{IPBlock: block})
+			}
+		}
+		if len(dnsPeers) > 0 {
+			egress = append(egress, networkingv1.NetworkPolicyEgressRule{To: dnsPeers, Ports: policyPorts(dnsPort)})
+		}
+	}
+	for _, cidr := range kp.networkIsolation.AllowedCIDRs {
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: cidr}}},
+		})
+	}
+
+	ingress := []networkingv1.NetworkPolicyIngressRule{{From: peers}}
+	if len(kusciaPeers) > 0 {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{From: kusciaPeers})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-isolation-%s", kp.namespace, group),
+			Namespace: kp.bkNamespace,
+			Labels: map[string]string{
+				common.LabelNodeNamespace: kp.namespace,
+				common.LabelNodeName:      kp.nodeName,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: *selector,
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Ingress:     ingress,
+			Egress:      egress,
+		},
+	}
+}
+
+func hostIPBlock(host string) *networkingv1.IPBlock {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil
+	}
+	if ip.To4() != nil {
+		return &networkingv1.IPBlock{CIDR: ip.String() + "/32"}
+	}
+	return &networkingv1.IPBlock{CIDR: ip.String() + "/128"}
+}
+
+func policyPorts(port int) []networkingv1.NetworkPolicyPort {
+	var ports []networkingv1.NetworkPolicyPort
+	for _, protocol := range []v1.Protocol{v1.ProtocolTCP, v1.ProtocolUDP} {
+		protocol := protocol
+		portValue := intstr.FromInt(port)
+		ports = append(ports, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &portValue})
+	}
+	return ports
+}
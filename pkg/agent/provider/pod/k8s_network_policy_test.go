@@ -0,0 +1,95 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	resourcetest "github.com/secretflow/kuscia/pkg/agent/resource/testing"
+	"github.com/secretflow/kuscia/pkg/common"
+)
+
+func TestK8sProvider_NetworkPolicy(t *testing.T) {
+	ctx := context.Background()
+	rm := resourcetest.FakeResourceManager("test-namespace")
+	kp := createTestK8sProvider(t, &config.K8sProviderCfg{Namespace: "bk-namespace"}, rm)
+	kp.nodeIP = "172.18.0.2"
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "task-0",
+			Namespace: "test-namespace",
+			Labels: map[string]string{
+				common.LabelController: common.ControllerKusciaTask,
+				common.LabelJobUID:     "job-uid",
+				common.LabelTaskUID:    "task-uid",
+			},
+		},
+	}
+
+	// isolation is disabled
+	name, err := kp.ensureNetworkPolicy(ctx, pod)
+	assert.NoError(t, err)
+	assert.Empty(t, name)
+
+	kp.networkIsolation = &config.NetworkIsolationCfg{
+		Enable:       true,
+		AllowedPorts: []int{53, 80},
+		AllowedCIDRs: []string{"10.0.0.0/8"},
+	}
+	name, err = kp.ensureNetworkPolicy(ctx, pod)
+	assert.NoError(t, err)
+	assert.Equal(t, "test-namespace-isolation-job-uid", name)
+
+	policies := kp.bkClient.NetworkingV1().NetworkPolicies("bk-namespace")
+	policy, err := policies.Get(ctx, name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		common.LabelNodeNamespace: "test-namespace",
+		common.LabelJobUID:        "job-uid",
+	}, policy.Spec.PodSelector.MatchLabels)
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, policy.Spec.PolicyTypes)
+	assert.Len(t, policy.Spec.Ingress, 2)
+	assert.Len(t, policy.Spec.Egress, 3)
+	assert.Equal(t, "172.18.0.2/32", policy.Spec.Egress[1].To[0].IPBlock.CIDR)
+	assert.Len(t, policy.Spec.Egress[1].Ports, 4)
+	assert.Equal(t, "10.0.0.0/8", policy.Spec.Egress[2].To[0].IPBlock.CIDR)
+
+	// the policy is shared by the pods of the same job
+	name, err = kp.ensureNetworkPolicy(ctx, pod)
+	assert.NoError(t, err)
+	assert.Equal(t, "test-namespace-isolation-job-uid", name)
+
+	bkPod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "task-0", Namespace: "bk-namespace", UID: "bk-uid"}}
+	assert.NoError(t, kp.ownNetworkPolicy(ctx, name, bkPod))
+	assert.NoError(t, kp.ownNetworkPolicy(ctx, name, bkPod))
+	policy, err = policies.Get(ctx, name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, policy.OwnerReferences, 1)
+	assert.Equal(t, "bk-uid", string(policy.OwnerReferences[0].UID))
+
+	// the pods not created by kuscia task aren't isolated
+	pod.Labels[common.LabelController] = "kusciadeployment"
+	name, err = kp.ensureNetworkPolicy(ctx, pod)
+	assert.NoError(t, err)
+	assert.Empty(t, name)
+}
@@ -66,6 +66,9 @@ const (
 var (
 	resourceMinLifeCycle = 30 * time.Second
 	resourceNameLimit    = 253
+	// volumeClaimRetention is how long the volume claims are kept after their pods are gone, so that the restarted
+	// pods can pick up the data.
+	volumeClaimRetention = 24 * time.Hour
 )
 
 type K8sProviderDependence struct {
@@ -79,6 +82,8 @@ type K8sProviderDependence struct {
 	ResourceManager *resource.KubeResourceManager
 	K8sProviderCfg  *config.K8sProviderCfg
 	Recorder        record.EventRecorder
+	// NetworkIsolationCfg isolates the network of the jobs by NetworkPolicies if it's enabled.
+	NetworkIsolationCfg *config.NetworkIsolationCfg
 }
 
 type K8sProvider struct {
@@ -87,12 +92,14 @@ type K8sProvider struct {
 	namespace         string
 	bkNamespace       string
 	nodeName          string
+	nodeIP            string
 	podDNSConfig      *v1.PodDNSConfig
 	podDNSPolicy      string
 	resolveConfigData string
 	podSyncHandler    framework.SyncHandler
 	resourceManager   *resource.KubeResourceManager
 	backendPlugin     kubebackend.BackendPlugin
+	networkIsolation  *config.NetworkIsolationCfg
 
 	kubeInformerFactory kubeinformers.SharedInformerFactory
 	podLister           corelisters.PodNamespaceLister
@@ -106,12 +113,16 @@ type K8sProvider struct {
 	annotationsToAdd map[string]string
 	affinitiesToAdd  *v1.Affinity
 	runtimeClassName string
+	// sandboxRuntimeClasses is the RuntimeClass for each sandbox type
+	sandboxRuntimeClasses map[string]string
 
 	leaderElector election.Elector
 	recorder      record.EventRecorder
 	logManager    *K8sLogManager
 	// the pods that failed to apply to backend k8s
 	podsApplyFailed sync.Map
+	// the volume claims whose pods are gone, claim name -> the time the pod was found gone, accessed by the leader only
+	orphanClaims map[string]time.Time
 }
 
 func NewK8sProvider(dep *K8sProviderDependence) (*K8sProvider, error) {
@@ -121,6 +132,8 @@ func NewK8sProvider(dep *K8sProviderDependence) (*K8sProvider, error) {
 		namespace:        dep.Namespace,
 		bkNamespace:      dep.K8sProviderCfg.Namespace,
 		nodeName:         dep.NodeName,
+		nodeIP:           dep.NodeIP,
+		networkIsolation: dep.NetworkIsolationCfg,
 		podDNSPolicy:     dep.K8sProviderCfg.DNS.Policy,
 		podSyncHandler:   dep.PodSyncHandler,
 		resourceManager:  dep.ResourceManager,
@@ -130,6 +143,9 @@ func NewK8sProvider(dep *K8sProviderDependence) (*K8sProvider, error) {
 		runtimeClassName: dep.K8sProviderCfg.RuntimeClassName,
 		recorder:         dep.Recorder,
 		podsApplyFailed:  sync.Map{}, // pod.Name -> v1.podStatus
+		orphanClaims:     map[string]time.Time{},
+
+		sandboxRuntimeClasses: dep.K8sProviderCfg.SandboxRuntimeClasses,
 	}
 
 	if kp.podDNSPolicy == "" {
@@ -146,6 +162,7 @@ func NewK8sProvider(dep *K8sProviderDependence) (*K8sProvider, error) {
 	if len(dep.K8sProviderCfg.DNS.Servers) == 0 {
 		kp.podDNSConfig = &v1.PodDNSConfig{
 			Nameservers: []string{dep.NodeIP},
+			Searches:    dep.K8sProviderCfg.DNS.Searches,
 		}
 	} else {
 		kp.podDNSConfig = &v1.PodDNSConfig{
@@ -293,10 +310,18 @@ func (kp *K8sProvider) SyncPod(ctx context.Context, pod *v1.Pod, podStatus *pkgc
 	newPod.Spec.NodeSelector = nil
 	newPod.Spec.SchedulerName = ""
 
-	if newPod.Spec.RuntimeClassName == nil && kp.runtimeClassName != "" {
+	if sandbox := pod.Annotations[common.SandboxAnnotationKey]; sandbox != "" {
+		runtimeClassName, ok := kp.sandboxRuntimeClasses[sandbox]
+		if !ok || runtimeClassName == "" {
+			return fmt.Errorf("no runtime class is configured for sandbox %q of pod %v", sandbox, format.Pod(pod))
+		}
+		newPod.Spec.RuntimeClassName = &runtimeClassName
+	} else if newPod.Spec.RuntimeClassName == nil && kp.runtimeClassName != "" {
 		newPod.Spec.RuntimeClassName = &kp.runtimeClassName
 	}
 
+	projectSidecars(newPod)
+
 	configMaps := map[string]*v1.ConfigMap{}
 	secrets := map[string]*v1.Secret{}
 	for _, v := range newPod.Spec.Volumes {
@@ -369,6 +394,11 @@ func (kp *K8sProvider) SyncPod(ctx context.Context, pod *v1.Pod, podStatus *pkgc
 				newPod.Spec.Volumes[i].Secret.SecretName = newSecret.Name
 			}
 		}
+		renameEnvSecretRefs(newPod, secret.Name, newSecret.Name)
+	}
+
+	if err := kp.createVolumeClaims(ctx, pod, newPod); err != nil {
+		return err
 	}
 
 	for k, v := range kp.labelsToAdd {
@@ -383,14 +413,82 @@ func (kp *K8sProvider) SyncPod(ctx context.Context, pod *v1.Pod, podStatus *pkgc
 	// allow backend plugin to customize setting
 	kp.backendPlugin.PreSyncPod(newPod)
 
-	_, err := kp.applyPod(ctx, newPod)
+	policyName, err := kp.ensureNetworkPolicy(ctx, newPod)
+	if err != nil {
+		return fmt.Errorf("failed to create network policy of pod %v, detail-> %v", format.Pod(newPod), err)
+	}
+
+	bkPod, err := kp.applyPod(ctx, newPod)
 	if err != nil {
 		return fmt.Errorf("failed to apply pod %v, detail-> %v", format.Pod(newPod), err)
 	}
 
+	if policyName != "" {
+		if err := kp.ownNetworkPolicy(ctx, policyName, bkPod); err != nil {
+			return fmt.Errorf("failed to own network policy %v by pod %v, detail-> %v", policyName, format.Pod(newPod), err)
+		}
+	}
+
 	return nil
 }
 
+// createVolumeClaims creates the persistent volume claims templated by the pod in the backend k8s. The claims are named
+// after the pod and outlive it, so the restarted pod mounts the same volumes.
+func (kp *K8sProvider) createVolumeClaims(ctx context.Context, pod, bkPod *v1.Pod) error {
+	value := pod.Annotations[common.VolumeClaimTemplatesAnnotationKey]
+	if value == "" {
+		return nil
+	}
+	templates := map[string]v1.PersistentVolumeClaimSpec{}
+	if err := json.Unmarshal([]byte(value), &templates); err != nil {
+		return fmt.Errorf("failed to parse volume claim templates of pod %v, detail-> %v", format.Pod(pod), err)
+	}
+
+	claimStub := kp.bkClient.CoreV1().PersistentVolumeClaims(kp.bkNamespace)
+	for _, v := range bkPod.Spec.Volumes {
+		if v.PersistentVolumeClaim == nil {
+			continue
+		}
+		spec, ok := templates[v.PersistentVolumeClaim.ClaimName]
+		if !ok {
+			continue
+		}
+
+		claim := &v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        v.PersistentVolumeClaim.ClaimName,
+				Namespace:   kp.bkNamespace,
+				Labels:      map[string]string{common.LabelNodeNamespace: kp.namespace},
+				Annotations: map[string]string{labelOwnerPodName: pod.Name},
+			},
+			Spec: spec,
+		}
+		_, err := claimStub.Create(ctx, claim, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			nlog.Infof("Volume claim %v already exists, reuse it", claim.Name)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to create volume claim %v, detail-> %v", claim.Name, err)
+		}
+		nlog.Infof("Create volume claim %v successfully", claim.Name)
+	}
+	return nil
+}
+
+// renameEnvSecretRefs points the environment variables referencing the secret to the one in the backend cluster.
+func renameEnvSecretRefs(pod *v1.Pod, name, newName string) {
+	for _, containers := range [][]v1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for i := range containers {
+			for j := range containers[i].Env {
+				ref := containers[i].Env[j].ValueFrom
+				if ref != nil && ref.SecretKeyRef != nil && ref.SecretKeyRef.Name == name {
+					ref.SecretKeyRef.Name = newName
+				}
+			}
+		}
+	}
+}
+
 func (kp *K8sProvider) syncErrAction(retErr error, newPod *v1.Pod, kusciaPodUID types.UID) {
 	// for now, only apply failed error(admission failed) need to be handled
 	if strings.Contains(retErr.Error(), DeniedRequest) {
@@ -399,32 +497,38 @@ func (kp *K8sProvider) syncErrAction(retErr error, newPod *v1.Pod, kusciaPodUID
 			Reason:  "Pod was denied by provider",
 			Message: fmt.Sprintf("Failed to apply pod. Request was denied. Checking host k8s validating admission webhook rules might be helpful, err detail: %v", retErr),
 		}
-		if len(newPod.Status.ContainerStatuses) == 0 {
-			// we create container from newPod.Container
-			newPod.Status.ContainerStatuses = make([]v1.ContainerStatus, len(newPod.Spec.Containers))
-			for i := range newPod.Status.ContainerStatuses {
-				newPod.Status.ContainerStatuses[i].Name = newPod.Spec.Containers[i].Name
-				newPod.Status.ContainerStatuses[i].Image = newPod.Spec.Containers[i].Image
-			}
-		}
-		for i := range newPod.Status.ContainerStatuses {
-			if newPod.Status.ContainerStatuses[i].State.Terminated != nil {
-				continue
-			}
-			newPod.Status.ContainerStatuses[i].State = v1.ContainerState{
-				Terminated: &v1.ContainerStateTerminated{
-					ExitCode: 125,
-					Reason:   "Error",
-					Message:  "Pod was not created, so container was not created",
-				},
-			}
-		}
+		newPod.Status.InitContainerStatuses = notCreatedContainerStatuses(newPod.Status.InitContainerStatuses, newPod.Spec.InitContainers)
+		newPod.Status.ContainerStatuses = notCreatedContainerStatuses(newPod.Status.ContainerStatuses, newPod.Spec.Containers)
 		kp.podsApplyFailed.Store(newPod.Name, newPod)
 		// sync backend pod status to pod worker
 		kp.podSyncHandler.HandlePodSyncByUID(kusciaPodUID)
 	}
 }
 
+func notCreatedContainerStatuses(statuses []v1.ContainerStatus, containers []v1.Container) []v1.ContainerStatus {
+	if len(statuses) == 0 {
+		// we create container from newPod.Container
+		statuses = make([]v1.ContainerStatus, len(containers))
+		for i := range statuses {
+			statuses[i].Name = containers[i].Name
+			statuses[i].Image = containers[i].Image
+		}
+	}
+	for i := range statuses {
+		if statuses[i].State.Terminated != nil {
+			continue
+		}
+		statuses[i].State = v1.ContainerState{
+			Terminated: &v1.ContainerStateTerminated{
+				ExitCode: 125,
+				Reason:   "Error",
+				Message:  "Pod was not created, so container was not created",
+			},
+		}
+	}
+	return statuses
+}
+
 func (kp *K8sProvider) normalizeMeta(sourcePodUID types.UID, meta *metav1.ObjectMeta) *metav1.ObjectMeta {
 	newMeta := &metav1.ObjectMeta{
 		Labels:      map[string]string{},
@@ -485,18 +589,87 @@ func (kp *K8sProvider) mountResolveConfig(bkPod *v1.Pod) *v1.ConfigMap {
 		},
 	})
 
+	mount := v1.VolumeMount{
+		Name:      "resolv-config",
+		MountPath: "/etc/resolv.conf",
+		SubPath:   "resolv.conf",
+	}
+	for i := range bkPod.Spec.InitContainers {
+		c := &bkPod.Spec.InitContainers[i]
+		c.VolumeMounts = append(c.VolumeMounts, mount)
+	}
 	for i := range bkPod.Spec.Containers {
 		c := &bkPod.Spec.Containers[i]
-		c.VolumeMounts = append(c.VolumeMounts, v1.VolumeMount{
-			Name:      "resolv-config",
-			MountPath: "/etc/resolv.conf",
-			SubPath:   "resolv.conf",
-		})
+		c.VolumeMounts = append(c.VolumeMounts, mount)
 	}
 
 	return cm
 }
 
+// sidecarNames returns the names of the sidecar containers of the pod.
+func sidecarNames(pod *v1.Pod) sets.Set[string] {
+	names := sets.New[string]()
+	if value := pod.Annotations[common.SidecarContainersAnnotationKey]; value != "" {
+		names.Insert(strings.Split(value, ",")...)
+	}
+	return names
+}
+
+// SupportsSidecars implements kri.SidecarProvider, the sidecars are projected into the native sidecar containers of
+// the backend pod.
+func (kp *K8sProvider) SupportsSidecars() bool {
+	return true
+}
+
+// projectSidecars moves the sidecars from the containers to the init containers of the backend pod, and makes them
+// native sidecar containers by restartPolicy Always, so that they keep running along with the containers and are
+// stopped after the containers exit.
+func projectSidecars(bkPod *v1.Pod) {
+	sidecars := sidecarNames(bkPod)
+	if sidecars.Len() == 0 {
+		return
+	}
+
+	restartPolicy := v1.ContainerRestartPolicyAlways
+	containers := make([]v1.Container, 0, len(bkPod.Spec.Containers))
+	for _, c := range bkPod.Spec.Containers {
+		if !sidecars.Has(c.Name) {
+			containers = append(containers, c)
+			continue
+		}
+		c.RestartPolicy = &restartPolicy
+		bkPod.Spec.InitContainers = append(bkPod.Spec.InitContainers, c)
+	}
+	bkPod.Spec.Containers = containers
+}
+
+// sidecarStatus returns the status of the sidecar in the kuscia pod. The sidecars are stopped by signals after the
+// containers exit, so they are regarded as completed once the backend pod finishes.
+func sidecarStatus(bkPod *v1.Pod, cs *v1.ContainerStatus) *v1.ContainerStatus {
+	cs = cs.DeepCopy()
+	if bkPod.Status.Phase != v1.PodSucceeded && bkPod.Status.Phase != v1.PodFailed {
+		return cs
+	}
+	if cs.State.Terminated != nil && cs.State.Terminated.ExitCode == 0 {
+		return cs
+	}
+
+	terminated := &v1.ContainerStateTerminated{
+		ExitCode: 0,
+		Reason:   "Completed",
+	}
+	if cs.State.Running != nil {
+		terminated.StartedAt = cs.State.Running.StartedAt
+	} else if cs.State.Terminated != nil {
+		terminated.StartedAt = cs.State.Terminated.StartedAt
+		terminated.FinishedAt = cs.State.Terminated.FinishedAt
+		terminated.ContainerID = cs.State.Terminated.ContainerID
+	}
+	cs.State = v1.ContainerState{Terminated: terminated}
+	cs.Ready = false
+	return cs
+}
+
 func (kp *K8sProvider) applyPod(ctx context.Context, pod *v1.Pod) (*v1.Pod, error) {
 	newPod, err := kp.podLister.Get(pod.Name)
 	if err == nil {
@@ -578,13 +751,20 @@ func (kp *K8sProvider) RefreshPodStatus(pod *v1.Pod, podStatus *v1.PodStatus) {
 		podStatus.Message = bkPod.Status.Message
 	}
 
-	podStatus.ContainerStatuses = make([]v1.ContainerStatus, len(bkPod.Status.ContainerStatuses))
-	podStatus.InitContainerStatuses = make([]v1.ContainerStatus, len(bkPod.Status.InitContainerStatuses))
+	// the sidecars are init containers of the backend pod but containers of the kuscia pod
+	sidecars := sidecarNames(pod)
+	podStatus.ContainerStatuses = make([]v1.ContainerStatus, 0, len(bkPod.Status.ContainerStatuses))
+	podStatus.InitContainerStatuses = make([]v1.ContainerStatus, 0, len(bkPod.Status.InitContainerStatuses))
 	for i := range bkPod.Status.ContainerStatuses {
-		podStatus.ContainerStatuses[i] = *bkPod.Status.ContainerStatuses[i].DeepCopy()
+		podStatus.ContainerStatuses = append(podStatus.ContainerStatuses, *bkPod.Status.ContainerStatuses[i].DeepCopy())
 	}
 	for i := range bkPod.Status.InitContainerStatuses {
-		podStatus.InitContainerStatuses[i] = *bkPod.Status.InitContainerStatuses[i].DeepCopy()
+		cs := &bkPod.Status.InitContainerStatuses[i]
+		if sidecars.Has(cs.Name) {
+			podStatus.ContainerStatuses = append(podStatus.ContainerStatuses, *sidecarStatus(bkPod, cs))
+			continue
+		}
+		podStatus.InitContainerStatuses = append(podStatus.InitContainerStatuses, *cs.DeepCopy())
 	}
 	copyPodCondition(&bkPod.Status, podStatus, v1.PodReady)
 	copyPodCondition(&bkPod.Status, podStatus, v1.ContainersReady)
@@ -626,7 +806,20 @@ func (kp *K8sProvider) GetPodStatus(ctx context.Context, pod *v1.Pod) (*pkgconta
 		podStatus.IPs = append(podStatus.IPs, ip.IP)
 	}
 
-	for _, bkcs := range bkPodStatus.ContainerStatuses {
+	sidecars := sidecarNames(pod)
+	bkContainerStatuses := make([]*v1.ContainerStatus, 0, len(bkPodStatus.InitContainerStatuses)+len(bkPodStatus.ContainerStatuses))
+	for i := range bkPodStatus.InitContainerStatuses {
+		bkcs := &bkPodStatus.InitContainerStatuses[i]
+		if sidecars.Has(bkcs.Name) {
+			bkcs = sidecarStatus(bkPod, bkcs)
+		}
+		bkContainerStatuses = append(bkContainerStatuses, bkcs)
+	}
+	for i := range bkPodStatus.ContainerStatuses {
+		bkContainerStatuses = append(bkContainerStatuses, &bkPodStatus.ContainerStatuses[i])
+	}
+
+	for _, bkcs := range bkContainerStatuses {
 		cs := &pkgcontainer.Status{
 			ID:           pkgcontainer.CtrID{ID: bkcs.ContainerID},
 			Name:         bkcs.Name,
@@ -673,7 +866,7 @@ func (kp *K8sProvider) GetPods(ctx context.Context, all bool) ([]*pkgcontainer.P
 			runningPod.ID = types.UID(p.Labels[common.LabelPodUID])
 		}
 
-		for _, c := range p.Spec.Containers {
+		for _, c := range append(append([]v1.Container{}, p.Spec.InitContainers...), p.Spec.Containers...) {
 			container := &pkgcontainer.Container{
 				Name:  c.Name,
 				Image: c.Image,
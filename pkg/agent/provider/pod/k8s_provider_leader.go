@@ -21,6 +21,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/secretflow/kuscia/pkg/agent/resource"
@@ -179,6 +180,47 @@ func (kp *K8sProvider) cleanupSubResources(ctx context.Context) error {
 	return nil
 }
 
+// cleanupVolumeClaims deletes the volume claims whose owner pods have been gone for longer than the retention.
+func (kp *K8sProvider) cleanupVolumeClaims(ctx context.Context) error {
+	claims, err := kp.bkClient.CoreV1().PersistentVolumeClaims(kp.bkNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{common.LabelNodeNamespace: kp.namespace}).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list volume claim, detail-> %v", err)
+	}
+
+	for _, claim := range claims.Items {
+		ownerPodName, ok := claim.Annotations[labelOwnerPodName]
+		if !ok {
+			continue
+		}
+		_, err := kp.resourceManager.GetPod(ownerPodName)
+		if err == nil {
+			delete(kp.orphanClaims, claim.Name)
+			continue
+		} else if !k8serrors.IsNotFound(err) {
+			nlog.Warnf("Failed to get owner pod %s of volume claim %s: %v", ownerPodName, claim.Name, err)
+			continue
+		}
+
+		goneSince, ok := kp.orphanClaims[claim.Name]
+		if !ok {
+			kp.orphanClaims[claim.Name] = time.Now()
+			continue
+		}
+		if time.Since(goneSince) < volumeClaimRetention {
+			continue
+		}
+		if err := kp.bkClient.CoreV1().PersistentVolumeClaims(kp.bkNamespace).Delete(ctx, claim.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			nlog.Warnf("Failed to cleanup volume claim %q: %v", claim.Name, err)
+			continue
+		}
+		delete(kp.orphanClaims, claim.Name)
+		nlog.Infof("Cleanup volume claim %v successfully", claim.Name)
+	}
+	return nil
+}
+
 // onNewLeader is executed when leader is changed.
 func (kp *K8sProvider) onNewLeader(identity string) {
 	nlog.Infof("New leader has been elected: %s", identity)
@@ -203,6 +245,10 @@ func (kp *K8sProvider) onStartedLeading(ctx context.Context) {
 			if err := kp.cleanupSubResources(context.Background()); err != nil {
 				nlog.Errorf("Failed to cleanup sub resource: %v", err)
 			}
+
+			if err := kp.cleanupVolumeClaims(context.Background()); err != nil {
+				nlog.Errorf("Failed to cleanup volume claims: %v", err)
+			}
 		}
 	}
 }
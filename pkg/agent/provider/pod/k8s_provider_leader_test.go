@@ -20,9 +20,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
@@ -125,6 +127,59 @@ func TestK8sProvider_cleanupZombieResources(t *testing.T) {
 	cancel()
 }
 
+func TestK8sProvider_cleanupVolumeClaims(t *testing.T) {
+	newClaimPod := func(uid types.UID, name string) *v1.Pod {
+		claims := fmt.Sprintf(`{"%s-ckpt":{"accessModes":["ReadWriteOnce"],"resources":{"requests":{"storage":"1Gi"}}}}`, name)
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				UID:         uid,
+				Name:        name,
+				Namespace:   "default",
+				Annotations: map[string]string{common.VolumeClaimTemplatesAnnotationKey: claims},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "ctr01", Image: "aa/bb:001"}},
+				Volumes: []v1.Volume{{
+					Name: "ckpt",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: name + "-ckpt"},
+					},
+				}},
+			},
+		}
+	}
+	pod1 := newClaimPod("001", "pod1")
+	pod2 := newClaimPod("002", "pod2")
+
+	cfg := &config.K8sProviderCfg{Namespace: "bk-namespace"}
+	kp := createTestK8sProvider(t, cfg, resourcetest.FakeResourceManager("default", pod1))
+	kp.namespace = "default"
+	ctx := context.Background()
+	assert.NoError(t, kp.SyncPod(ctx, pod1, nil, nil))
+	assert.NoError(t, kp.SyncPod(ctx, pod2, nil, nil))
+	// the restarted pod reuses the claim
+	assert.NoError(t, kp.createVolumeClaims(ctx, pod2, pod2))
+
+	claim, err := kp.bkClient.CoreV1().PersistentVolumeClaims("bk-namespace").Get(ctx, "pod2-ckpt", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "pod2", claim.Annotations[labelOwnerPodName])
+	assert.Equal(t, "1Gi", claim.Spec.Resources.Requests.Storage().String())
+
+	// pod2 is gone, its claim is kept until the retention expires
+	assert.NoError(t, kp.cleanupVolumeClaims(ctx))
+	assert.Contains(t, kp.orphanClaims, "pod2-ckpt")
+	_, err = kp.bkClient.CoreV1().PersistentVolumeClaims("bk-namespace").Get(ctx, "pod2-ckpt", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	kp.orphanClaims["pod2-ckpt"] = time.Now().Add(-volumeClaimRetention)
+	assert.NoError(t, kp.cleanupVolumeClaims(ctx))
+	_, err = kp.bkClient.CoreV1().PersistentVolumeClaims("bk-namespace").Get(ctx, "pod2-ckpt", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	_, err = kp.bkClient.CoreV1().PersistentVolumeClaims("bk-namespace").Get(ctx, "pod1-ckpt", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, kp.orphanClaims)
+}
+
 func createTestPod(uid types.UID, namespace, name, secretName string) *v1.Pod {
 	pod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -208,6 +208,36 @@ func TestK8sProvider_SyncAndKillPod(t *testing.T) {
 	cancel()
 }
 
+func TestK8sProvider_SyncSandboxPod(t *testing.T) {
+	rm := resourcetest.FakeResourceManager("test-namespace")
+	kp := createTestK8sProvider(t, &config.K8sProviderCfg{
+		Namespace:             "bk-namespace",
+		RuntimeClassName:      "runc",
+		SandboxRuntimeClasses: map[string]string{"gvisor": "runsc"},
+	}, rm)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:         "abc",
+			Name:        "pod01",
+			Namespace:   "test-namespace",
+			Annotations: map[string]string{common.SandboxAnnotationKey: "gvisor"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "ctr01", Image: "aa/bb:001"}},
+		},
+	}
+	assert.NoError(t, kp.SyncPod(context.Background(), pod, nil, nil))
+	newPod, err := kp.bkClient.CoreV1().Pods(kp.bkNamespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "runsc", *newPod.Spec.RuntimeClassName)
+
+	// the runtime class of the sandbox isn't configured
+	pod.Name = "pod02"
+	pod.Annotations[common.SandboxAnnotationKey] = "kata"
+	assert.Error(t, kp.SyncPod(context.Background(), pod, nil, nil))
+}
+
 func TestNormalizeSubResourceMeta(t *testing.T) {
 	resourceNameLimit = 10
 	tests := []struct {
@@ -238,3 +268,69 @@ func TestNormalizeSubResourceMeta(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectSidecars(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{common.SidecarContainersAnnotationKey: "log-shipper"},
+		},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "prefetch"}},
+			Containers:     []v1.Container{{Name: "ctr01"}, {Name: "log-shipper"}},
+		},
+	}
+
+	projectSidecars(pod)
+	assert.Equal(t, []v1.Container{{Name: "ctr01"}}, pod.Spec.Containers)
+	assert.Equal(t, 2, len(pod.Spec.InitContainers))
+	assert.Equal(t, "prefetch", pod.Spec.InitContainers[0].Name)
+	assert.Nil(t, pod.Spec.InitContainers[0].RestartPolicy)
+	assert.Equal(t, "log-shipper", pod.Spec.InitContainers[1].Name)
+	assert.Equal(t, v1.ContainerRestartPolicyAlways, *pod.Spec.InitContainers[1].RestartPolicy)
+}
+
+func TestK8sProvider_SidecarStatus(t *testing.T) {
+	kp := createTestK8sProvider(t, &config.K8sProviderCfg{Namespace: "bk-namespace"}, resourcetest.FakeResourceManager("test-namespace"))
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pod01",
+			Namespace:   "test-namespace",
+			Annotations: map[string]string{common.SidecarContainersAnnotationKey: "log-shipper"},
+		},
+	}
+	bkPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pod01",
+			Namespace:   "bk-namespace",
+			Annotations: pod.Annotations,
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodSucceeded,
+			InitContainerStatuses: []v1.ContainerStatus{
+				{Name: "prefetch", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0}}},
+				{Name: "log-shipper", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 143}}},
+			},
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "ctr01", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0}}},
+			},
+		},
+	}
+	assert.NoError(t, kp.kubeInformerFactory.Core().V1().Pods().Informer().GetStore().Add(bkPod))
+
+	status, err := kp.GetPodStatus(context.Background(), pod)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(status.ContainerStatuses))
+	for _, cs := range status.ContainerStatuses {
+		assert.Equal(t, pkgcontainer.ContainerStateExited, cs.State)
+		assert.Equal(t, 0, cs.ExitCode, cs.Name)
+	}
+
+	podStatus := &v1.PodStatus{}
+	kp.RefreshPodStatus(pod, podStatus)
+	assert.Equal(t, 1, len(podStatus.InitContainerStatuses))
+	assert.Equal(t, "prefetch", podStatus.InitContainerStatuses[0].Name)
+	assert.Equal(t, 2, len(podStatus.ContainerStatuses))
+	assert.Equal(t, "log-shipper", podStatus.ContainerStatuses[1].Name)
+	assert.Equal(t, int32(0), podStatus.ContainerStatuses[1].State.Terminated.ExitCode)
+}
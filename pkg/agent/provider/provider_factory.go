@@ -17,6 +17,7 @@ package provider
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"k8s.io/client-go/kubernetes"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/secretflow/kuscia/pkg/agent/config"
 	"github.com/secretflow/kuscia/pkg/agent/framework"
+	"github.com/secretflow/kuscia/pkg/agent/gpu"
 	"github.com/secretflow/kuscia/pkg/agent/kri"
 	"github.com/secretflow/kuscia/pkg/agent/provider/node"
 	"github.com/secretflow/kuscia/pkg/agent/provider/pod"
@@ -41,9 +43,7 @@ type Factory interface {
 
 func NewFactory(agentConfig *config.AgentConfig, kubeClient kubernetes.Interface) (Factory, error) {
 	switch agentConfig.Provider.Runtime {
-	case config.ProcessRuntime:
-		fallthrough
-	case config.ContainerRuntime:
+	case config.ProcessRuntime, config.ContainerRuntime, config.ContainerdRuntime:
 		return &containerRuntimeFactory{agentConfig: agentConfig}, nil
 	case config.K8sRuntime:
 		bkCfg := &agentConfig.Provider.K8s
@@ -79,6 +79,26 @@ func NewFactory(agentConfig *config.AgentConfig, kubeClient kubernetes.Interface
 
 type containerRuntimeFactory struct {
 	agentConfig *config.AgentConfig
+	// gpuManager is shared by the node provider and the pod provider, nil if gpu is disabled.
+	gpuManager *gpu.Manager
+}
+
+func (f *containerRuntimeFactory) getGPUManager() (*gpu.Manager, error) {
+	if !f.agentConfig.GPU.Enable || f.agentConfig.Provider.Runtime == config.ContainerdRuntime {
+		return nil, nil
+	}
+	if f.gpuManager == nil {
+		m, err := gpu.NewManager(&gpu.ManagerConfig{
+			Runtime:        f.agentConfig.Provider.Runtime,
+			CheckpointPath: filepath.Join(f.agentConfig.RootDir, "var", "gpu_checkpoint"),
+			GPUCfg:         &f.agentConfig.GPU,
+		})
+		if err != nil {
+			return nil, err
+		}
+		f.gpuManager = m
+	}
+	return f.gpuManager, nil
 }
 
 func (f *containerRuntimeFactory) BuildNodeProvider() (kri.NodeProvider, error) {
@@ -95,6 +115,14 @@ func (f *containerRuntimeFactory) BuildNodeProvider() (kri.NodeProvider, error)
 
 	initCgroup(cm, f.agentConfig.Provider.Runtime)
 
+	gpuManager, err := f.getGPUManager()
+	if err != nil {
+		return nil, err
+	}
+	if gpuManager != nil && gpuManager.Capacity() > 0 {
+		cm.SetDeviceCapacity(gpu.ResourceName, gpuManager.Capacity())
+	}
+
 	nodeDep := &node.GenericNodeDependence{
 		BaseNodeDependence: node.BaseNodeDependence{
 			Runtime:         providerCfg.Runtime,
@@ -178,6 +206,11 @@ func newCgroupManager(cm *node.CapacityManager, group string) (cgroup.Manager, e
 }
 
 func (f *containerRuntimeFactory) BuildPodProvider(nodeName string, eventRecorder record.EventRecorder, resourceManager *resource.KubeResourceManager, podsController *framework.PodsController) (kri.PodProvider, error) {
+	gpuManager, err := f.getGPUManager()
+	if err != nil {
+		return nil, err
+	}
+
 	podProviderDep := &pod.CRIProviderDependence{
 		Namespace:        f.agentConfig.Namespace,
 		NodeIP:           f.agentConfig.NodeIP,
@@ -193,6 +226,8 @@ func (f *containerRuntimeFactory) BuildPodProvider(nodeName string, eventRecorde
 		Runtime:          f.agentConfig.Provider.Runtime,
 		CRIProviderCfg:   &f.agentConfig.Provider.CRI,
 		RegistryCfg:      &f.agentConfig.Registry,
+		DomainKey:        f.agentConfig.DomainKey,
+		GPUManager:       gpuManager,
 	}
 
 	return pod.NewCRIProvider(podProviderDep)
@@ -246,6 +281,8 @@ func (f *k8sRuntimeFactory) BuildPodProvider(nodeName string, eventRecorder reco
 		ResourceManager: resourceManager,
 		K8sProviderCfg:  bkCfg,
 		Recorder:        eventRecorder,
+
+		NetworkIsolationCfg: &f.agentConfig.NetworkIsolation,
 	}
 
 	return pod.NewK8sProvider(podProviderDep)
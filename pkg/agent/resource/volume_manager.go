@@ -19,11 +19,13 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilstrings "k8s.io/utils/strings"
 
+	"github.com/secretflow/kuscia/pkg/agent/utils/podutils"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/paths"
 )
@@ -34,8 +36,14 @@ const (
 	secretPluginName    = "kubernetes.io/secret"
 )
 
+// hostDirectoryRetention is how long the host directories are kept after their pods are gone, so that the restarted
+// pods can pick up the data.
+var hostDirectoryRetention = 24 * time.Hour
+
 type VolumeHelper interface {
 	GetPodVolumesDir(podUID types.UID) string
+	// GetHostDirectoriesDir returns the directory holding the host directory volumes, which outlive the pods.
+	GetHostDirectoriesDir() string
 }
 
 type VolumeInfo struct {
@@ -79,10 +87,13 @@ func (vm *VolumeManager) getPath(podUID types.UID, pluginName string, volumeName
 func (vm *VolumeManager) MountVolumesForPod(pod *v1.Pod) error {
 	volumeMap := make(VolumeMap)
 
+	hostDirs := podutils.HostDirectoryVolumes(pod)
 	for _, volume := range pod.Spec.Volumes {
 		var err error
 		var volumeInfo *VolumeInfo
 		switch {
+		case volume.EmptyDir != nil && hostDirs.Has(volume.Name):
+			volumeInfo, err = vm.mountHostDirectory(pod, volume.Name)
 		case volume.HostPath != nil:
 			volumeInfo, err = vm.mountHostPath(volume.HostPath)
 		case volume.ConfigMap != nil:
@@ -122,6 +133,60 @@ func (vm *VolumeManager) GetMountedVolumesForPod(podUID types.UID) VolumeMap {
 	return vm.podVolumeMap[podUID]
 }
 
+// GetHostDirectoryPath returns the host directory of the volume, it is named after the pod instead of the pod uid, so
+// the data survives the restarts of the pod.
+func (vm *VolumeManager) GetHostDirectoryPath(pod *v1.Pod, volumeName string) string {
+	return filepath.Join(vm.volumeHelper.GetHostDirectoriesDir(), pod.Namespace, pod.Name, volumeName)
+}
+
+func (vm *VolumeManager) mountHostDirectory(pod *v1.Pod, volumeName string) (*VolumeInfo, error) {
+	hostPath := vm.GetHostDirectoryPath(pod, volumeName)
+	if err := paths.EnsureDirectory(hostPath, true); err != nil {
+		return nil, fmt.Errorf("error mount host directory %s, detail-> %v", hostPath, err)
+	}
+
+	return &VolumeInfo{
+		HostPath:       hostPath,
+		ReadOnly:       false,
+		Managed:        true,
+		SELinuxRelabel: false,
+	}, nil
+}
+
+// CleanupHostDirectories removes the host directories of the pods which have been gone for longer than the retention.
+// The directories of the active pods are touched, so the modification time tracks when the pods were last seen.
+func (vm *VolumeManager) CleanupHostDirectories(activePods []*v1.Pod) error {
+	rootDir := vm.volumeHelper.GetHostDirectoriesDir()
+	now := time.Now()
+	active := map[string]bool{}
+	for _, pod := range activePods {
+		podDir := filepath.Join(rootDir, pod.Namespace, pod.Name)
+		active[podDir] = true
+		if err := os.Chtimes(podDir, now, now); err != nil && !os.IsNotExist(err) {
+			nlog.Warnf("Failed to touch host directory %s, %v", podDir, err)
+		}
+	}
+
+	podDirs, err := filepath.Glob(filepath.Join(rootDir, "*", "*"))
+	if err != nil {
+		return err
+	}
+	for _, podDir := range podDirs {
+		if active[podDir] {
+			continue
+		}
+		info, err := os.Stat(podDir)
+		if err != nil || now.Sub(info.ModTime()) < hostDirectoryRetention {
+			continue
+		}
+		if err := os.RemoveAll(podDir); err != nil {
+			return fmt.Errorf("failed to remove host directory %s, %v", podDir, err)
+		}
+		nlog.Infof("Removed host directory %s of the gone pod", podDir)
+	}
+	return nil
+}
+
 func (vm *VolumeManager) mountHostPath(hostPath *v1.HostPathVolumeSource) (*VolumeInfo, error) {
 	hostPathType := v1.HostPathUnset
 	if hostPath.Type != nil {
@@ -0,0 +1,333 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	pkgcontainer "github.com/secretflow/kuscia/pkg/agent/container"
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/cgroup"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+// PodLister lists the pods bound to the node, e.g. pod.Manager.
+type PodLister interface {
+	GetPods() []*v1.Pod
+}
+
+// PodStatusProvider provides the latest status of the pods, e.g. status.Manager.
+type PodStatusProvider interface {
+	GetPodStatus(uid types.UID) (v1.PodStatus, bool)
+}
+
+type CollectorConfig struct {
+	Namespace      string
+	Runtime        string
+	KubeClient     kubernetes.Interface
+	PodLister      PodLister
+	StatusProvider PodStatusProvider
+	StatsCfg       *config.StatsCfg
+}
+
+// containerUsage is the latest sample of a container, kept after the container exits so that the usage of
+// restarted containers is still counted.
+type containerUsage struct {
+	name    string
+	running bool
+	stats   cgroup.Stats
+}
+
+type podUsage struct {
+	namespace string
+	name      string
+	taskID    string
+	// containers are indexed by container id.
+	containers      map[string]*containerUsage
+	network         networkStats
+	memoryPeakBytes uint64
+	// memoryByteSeconds is the working set memory integrated over the collect periods.
+	memoryByteSeconds float64
+	// serving is the autoscaling metrics of the pod, only set for the pods of the autoscaled deployments.
+	serving *servingUsage
+
+	reported       *kusciaapisv1alpha1.ResourceUsage
+	lastReportTime time.Time
+	finished       bool
+}
+
+// Collector samples the cgroups of the containers of the pods on the node, exports the samples as prometheus
+// metrics and summarizes the resource usage of each pod in its annotation. The autoscaling metrics of the serving
+// pods are reported in their annotations as well.
+type Collector struct {
+	namespace      string
+	runtime        string
+	kubeClient     kubernetes.Interface
+	podLister      PodLister
+	statusProvider PodStatusProvider
+	collectPeriod  time.Duration
+	reportPeriod   time.Duration
+	port           int
+
+	getStats   func(group string) (*cgroup.Stats, error)
+	getNetwork func(group string) (*networkStats, bool)
+
+	mu   sync.Mutex
+	pods map[types.UID]*podUsage
+}
+
+func NewCollector(cfg *CollectorConfig) (*Collector, error) {
+	if cfg.Runtime != config.ContainerRuntime && cfg.Runtime != config.ProcessRuntime {
+		return nil, fmt.Errorf("collecting container stats is not supported by runtime %q", cfg.Runtime)
+	}
+	return &Collector{
+		namespace:      cfg.Namespace,
+		runtime:        cfg.Runtime,
+		kubeClient:     cfg.KubeClient,
+		podLister:      cfg.PodLister,
+		statusProvider: cfg.StatusProvider,
+		collectPeriod:  cfg.StatsCfg.CollectPeriod,
+		reportPeriod:   cfg.StatsCfg.ReportPeriod,
+		port:           cfg.StatsCfg.Port,
+		getStats:       cgroup.GetStats,
+		getNetwork:     getNetworkStats,
+		pods:           map[types.UID]*podUsage{},
+	}, nil
+}
+
+// Run samples the containers periodically until the context is done.
+func (c *Collector) Run(ctx context.Context) error {
+	if c.port > 0 {
+		go c.serve(ctx)
+	}
+
+	nlog.Infof("Starting container stats collector, collect period=%v, report period=%v", c.collectPeriod, c.reportPeriod)
+	ticker := time.NewTicker(c.collectPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			c.collect()
+			c.collectServing(ctx)
+			c.report(ctx)
+			c.reportServing(ctx)
+		}
+	}
+}
+
+// cgroupGroup returns the cgroup of the container, runp puts the processes of a container in
+// /kuscia.apps/{containerID} and containerd creates /k8s.io/{containerID} for runc.
+func (c *Collector) cgroupGroup(containerID string) string {
+	if c.runtime == config.ProcessRuntime {
+		return path.Join(cgroup.KusciaAppsGroup, containerID)
+	}
+	return path.Join(cgroup.K8sIOGroup, containerID)
+}
+
+func (c *Collector) podStatus(pod *v1.Pod) v1.PodStatus {
+	if c.statusProvider != nil {
+		if status, ok := c.statusProvider.GetPodStatus(pod.UID); ok {
+			return status
+		}
+	}
+	return pod.Status
+}
+
+func (c *Collector) collect() {
+	pods := c.podLister.GetPods()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	exists := map[types.UID]bool{}
+	for _, pod := range pods {
+		exists[pod.UID] = true
+		usage, ok := c.pods[pod.UID]
+		if !ok {
+			usage = &podUsage{
+				namespace:  pod.Namespace,
+				name:       pod.Name,
+				taskID:     pod.Annotations[common.TaskIDAnnotationKey],
+				containers: map[string]*containerUsage{},
+			}
+			c.pods[pod.UID] = usage
+		}
+		if usage.finished {
+			continue
+		}
+
+		status := c.podStatus(pod)
+		containerStatuses := make([]v1.ContainerStatus, 0, len(status.InitContainerStatuses)+len(status.ContainerStatuses))
+		containerStatuses = append(containerStatuses, status.InitContainerStatuses...)
+		containerStatuses = append(containerStatuses, status.ContainerStatuses...)
+		c.collectPod(usage, containerStatuses)
+		if status.Phase == v1.PodSucceeded || status.Phase == v1.PodFailed {
+			usage.finished = true
+		}
+	}
+
+	for uid := range c.pods {
+		if !exists[uid] {
+			delete(c.pods, uid)
+		}
+	}
+}
+
+func (c *Collector) collectPod(usage *podUsage, containerStatuses []v1.ContainerStatus) {
+	var memoryBytes uint64
+	networkCollected := false
+	for _, cs := range containerStatuses {
+		if cs.ContainerID == "" {
+			continue
+		}
+		containerID := pkgcontainer.ParseContainerID(cs.ContainerID).ID
+		cu, ok := usage.containers[containerID]
+		if !ok {
+			cu = &containerUsage{name: cs.Name}
+			usage.containers[containerID] = cu
+		}
+		cu.running = cs.State.Running != nil
+		if !cu.running {
+			continue
+		}
+
+		group := c.cgroupGroup(containerID)
+		stats, err := c.getStats(group)
+		if err != nil {
+			nlog.Debugf("Failed to get stats of container %s/%s/%s, %v", usage.namespace, usage.name, cs.Name, err)
+			continue
+		}
+		cu.stats = *stats
+		memoryBytes += stats.MemoryWorkingSetBytes
+
+		// the containers of a pod share the network namespace
+		if !networkCollected {
+			if network, ok := c.getNetwork(group); ok {
+				networkCollected = true
+				if network.ReceiveBytes >= usage.network.ReceiveBytes && network.TransmitBytes >= usage.network.TransmitBytes {
+					usage.network = *network
+				}
+			}
+		}
+	}
+	if memoryBytes > usage.memoryPeakBytes {
+		usage.memoryPeakBytes = memoryBytes
+	}
+	// each sample stands for the memory of a collect period
+	usage.memoryByteSeconds += float64(memoryBytes) * c.collectPeriod.Seconds()
+}
+
+func (u *podUsage) resourceUsage() *kusciaapisv1alpha1.ResourceUsage {
+	ru := &kusciaapisv1alpha1.ResourceUsage{
+		MemoryPeakBytes:      int64(u.memoryPeakBytes),
+		MemoryMiBSeconds:     int64(u.memoryByteSeconds / (1 << 20)),
+		NetworkReceiveBytes:  int64(u.network.ReceiveBytes),
+		NetworkTransmitBytes: int64(u.network.TransmitBytes),
+	}
+	var cpuNanoSeconds uint64
+	for _, cu := range u.containers {
+		cpuNanoSeconds += cu.stats.CPUUsageNanoSeconds
+		ru.IOReadBytes += int64(cu.stats.IOReadBytes)
+		ru.IOWriteBytes += int64(cu.stats.IOWriteBytes)
+	}
+	ru.CPUMilliSeconds = int64(cpuNanoSeconds / uint64(time.Millisecond))
+	return ru
+}
+
+type reportItem struct {
+	uid       types.UID
+	namespace string
+	name      string
+	usage     *kusciaapisv1alpha1.ResourceUsage
+}
+
+// report writes the resource usage of the pods to their annotations, the running pods are reported every report
+// period and the finished pods are reported once.
+func (c *Collector) report(ctx context.Context) {
+	now := time.Now()
+	var items []reportItem
+
+	c.mu.Lock()
+	for uid, usage := range c.pods {
+		if len(usage.containers) == 0 {
+			continue
+		}
+		if !usage.finished && now.Sub(usage.lastReportTime) < c.reportPeriod {
+			continue
+		}
+		ru := usage.resourceUsage()
+		if usage.reported != nil && *usage.reported == *ru {
+			continue
+		}
+		items = append(items, reportItem{uid: uid, namespace: usage.namespace, name: usage.name, usage: ru})
+	}
+	c.mu.Unlock()
+
+	for _, item := range items {
+		data, err := json.Marshal(item.usage)
+		if err != nil {
+			continue
+		}
+		if err := c.updateAnnotation(ctx, item.namespace, item.name, common.ResourceUsageAnnotationKey, string(data)); err != nil {
+			nlog.Warnf("Failed to report resource usage of pod %s/%s, %v", item.namespace, item.name, err)
+			continue
+		}
+
+		c.mu.Lock()
+		if usage, ok := c.pods[item.uid]; ok {
+			usage.reported = item.usage
+			usage.lastReportTime = now
+		}
+		c.mu.Unlock()
+	}
+}
+
+func (c *Collector) updateAnnotation(ctx context.Context, namespace, name, key, value string) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pod, err := c.kubeClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if pod.Annotations[key] == value {
+			return nil
+		}
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[key] = value
+		_, err = c.kubeClient.CoreV1().Pods(namespace).Update(ctx, pod, metav1.UpdateOptions{})
+		return err
+	})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/cgroup"
+)
+
+type fakePodLister struct {
+	pods []*v1.Pod
+}
+
+func (l *fakePodLister) GetPods() []*v1.Pod {
+	return l.pods
+}
+
+func runningContainer(name, id string) v1.ContainerStatus {
+	return v1.ContainerStatus{
+		Name:        name,
+		ContainerID: "containerd://" + id,
+		State:       v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+	}
+}
+
+func newTestCollector(t *testing.T, runtime string, pod *v1.Pod, groups map[string]*cgroup.Stats) *Collector {
+	c, err := NewCollector(&CollectorConfig{
+		Namespace:  "alice",
+		Runtime:    runtime,
+		KubeClient: fake.NewSimpleClientset(pod),
+		PodLister:  &fakePodLister{pods: []*v1.Pod{pod}},
+		StatsCfg:   &config.StatsCfg{Enable: true, CollectPeriod: time.Second, ReportPeriod: time.Minute},
+	})
+	assert.NoError(t, err)
+	c.getStats = func(group string) (*cgroup.Stats, error) {
+		if stats, ok := groups[group]; ok {
+			return stats, nil
+		}
+		return nil, fmt.Errorf("cgroup %s not found", group)
+	}
+	c.getNetwork = func(group string) (*networkStats, bool) {
+		return &networkStats{ReceiveBytes: 100, TransmitBytes: 200}, true
+	}
+	return c
+}
+
+func TestNewCollector(t *testing.T) {
+	_, err := NewCollector(&CollectorConfig{Runtime: config.K8sRuntime, StatsCfg: &config.StatsCfg{}})
+	assert.Error(t, err)
+}
+
+func TestCollectAndReport(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "task-0",
+			Namespace:   "alice",
+			UID:         types.UID("uid-0"),
+			Annotations: map[string]string{common.TaskIDAnnotationKey: "task"},
+		},
+		Status: v1.PodStatus{
+			Phase:             v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{runningContainer("secretflow", "c1"), runningContainer("sidecar", "c2")},
+		},
+	}
+	groups := map[string]*cgroup.Stats{
+		"/k8s.io/c1": {CPUUsageNanoSeconds: 2 * uint64(time.Second), MemoryWorkingSetBytes: 1000, IOReadBytes: 10, IOWriteBytes: 20},
+		"/k8s.io/c2": {CPUUsageNanoSeconds: uint64(time.Second), MemoryWorkingSetBytes: 500},
+	}
+	c := newTestCollector(t, config.ContainerRuntime, pod, groups)
+
+	c.collect()
+	assert.Equal(t, 8+2, testutil.CollectAndCount(c))
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP kuscia_agent_container_cpu_usage_seconds_total Cumulative cpu time consumed by the container in seconds.
+# TYPE kuscia_agent_container_cpu_usage_seconds_total counter
+kuscia_agent_container_cpu_usage_seconds_total{container="secretflow",namespace="alice",pod="task-0",task_id="task"} 2
+kuscia_agent_container_cpu_usage_seconds_total{container="sidecar",namespace="alice",pod="task-0",task_id="task"} 1
+`), "kuscia_agent_container_cpu_usage_seconds_total"))
+
+	// the container restarts, the usage of the exited one is still counted
+	groups["/k8s.io/c1"].MemoryWorkingSetBytes = 200
+	groups["/k8s.io/c3"] = &cgroup.Stats{CPUUsageNanoSeconds: uint64(time.Second), MemoryWorkingSetBytes: 100}
+	pod.Status.ContainerStatuses[1] = runningContainer("sidecar", "c3")
+	c.collect()
+
+	pod.Status.Phase = v1.PodSucceeded
+	pod.Status.ContainerStatuses = nil
+	c.collect()
+	assert.Equal(t, float64(1500+300), c.pods["uid-0"].memoryByteSeconds)
+	c.report(context.Background())
+
+	got, err := c.kubeClient.CoreV1().Pods("alice").Get(context.Background(), "task-0", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"cpuMilliSeconds":4000,"memoryPeakBytes":1500,"ioReadBytes":10,"ioWriteBytes":20,"networkReceiveBytes":100,"networkTransmitBytes":200}`,
+		got.Annotations[common.ResourceUsageAnnotationKey])
+
+	// the pod is gone
+	c.podLister.(*fakePodLister).pods = nil
+	c.collect()
+	assert.Empty(t, c.pods)
+}
+
+func TestCgroupGroup(t *testing.T) {
+	c := &Collector{runtime: config.ProcessRuntime}
+	assert.Equal(t, "/kuscia.apps/c1", c.cgroupGroup("c1"))
+	c.runtime = config.ContainerRuntime
+	assert.Equal(t, "/k8s.io/c1", c.cgroupGroup("c1"))
+}
+
+func TestParseNetDev(t *testing.T) {
+	content := `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:    1000      10    0    0    0     0          0         0     1000      10    0    0    0     0       0          0
+  eth0:    2048      20    0    0    0     0          0         0     4096      30    0    0    0     0       0          0
+`
+	stats, err := parseNetDev(strings.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, &networkStats{ReceiveBytes: 2048, TransmitBytes: 4096}, stats)
+
+	_, err = parseNetDev(strings.NewReader("eth0: 1 2 3"))
+	assert.Error(t, err)
+}
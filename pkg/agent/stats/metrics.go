@@ -0,0 +1,110 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+var (
+	containerLabels = []string{"namespace", "pod", "container", "task_id"}
+	podLabels       = []string{"namespace", "pod", "task_id"}
+
+	containerCPUUsageDesc = prometheus.NewDesc("kuscia_agent_container_cpu_usage_seconds_total",
+		"Cumulative cpu time consumed by the container in seconds.", containerLabels, nil)
+	containerMemoryWorkingSetDesc = prometheus.NewDesc("kuscia_agent_container_memory_working_set_bytes",
+		"Current working set memory of the container in bytes.", containerLabels, nil)
+	containerIOReadDesc = prometheus.NewDesc("kuscia_agent_container_io_read_bytes_total",
+		"Cumulative bytes read from the block devices by the container.", containerLabels, nil)
+	containerIOWriteDesc = prometheus.NewDesc("kuscia_agent_container_io_write_bytes_total",
+		"Cumulative bytes written to the block devices by the container.", containerLabels, nil)
+	podNetworkReceiveDesc = prometheus.NewDesc("kuscia_agent_pod_network_receive_bytes_total",
+		"Cumulative bytes received by the pod.", podLabels, nil)
+	podNetworkTransmitDesc = prometheus.NewDesc("kuscia_agent_pod_network_transmit_bytes_total",
+		"Cumulative bytes transmitted by the pod.", podLabels, nil)
+)
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- containerCPUUsageDesc
+	ch <- containerMemoryWorkingSetDesc
+	ch <- containerIOReadDesc
+	ch <- containerIOWriteDesc
+	ch <- podNetworkReceiveDesc
+	ch <- podNetworkTransmitDesc
+}
+
+// Collect implements prometheus.Collector, it exports the latest samples of the running containers.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, usage := range c.pods {
+		running := false
+		for _, cu := range usage.containers {
+			if !cu.running {
+				continue
+			}
+			running = true
+			labels := []string{usage.namespace, usage.name, cu.name, usage.taskID}
+			ch <- prometheus.MustNewConstMetric(containerCPUUsageDesc, prometheus.CounterValue,
+				float64(cu.stats.CPUUsageNanoSeconds)/float64(time.Second), labels...)
+			ch <- prometheus.MustNewConstMetric(containerMemoryWorkingSetDesc, prometheus.GaugeValue,
+				float64(cu.stats.MemoryWorkingSetBytes), labels...)
+			ch <- prometheus.MustNewConstMetric(containerIOReadDesc, prometheus.CounterValue,
+				float64(cu.stats.IOReadBytes), labels...)
+			ch <- prometheus.MustNewConstMetric(containerIOWriteDesc, prometheus.CounterValue,
+				float64(cu.stats.IOWriteBytes), labels...)
+		}
+		if !running || (usage.network.ReceiveBytes == 0 && usage.network.TransmitBytes == 0) {
+			continue
+		}
+		labels := []string{usage.namespace, usage.name, usage.taskID}
+		ch <- prometheus.MustNewConstMetric(podNetworkReceiveDesc, prometheus.CounterValue,
+			float64(usage.network.ReceiveBytes), labels...)
+		ch <- prometheus.MustNewConstMetric(podNetworkTransmitDesc, prometheus.CounterValue,
+			float64(usage.network.TransmitBytes), labels...)
+	}
+}
+
+func (c *Collector) serve(ctx context.Context) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(c)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf("0.0.0.0:%d", c.port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	nlog.Infof("Serving container metrics on port %d", c.port)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		nlog.Errorf("Failed to serve container metrics, %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/secretflow/kuscia/pkg/utils/cgroup"
+)
+
+var procRoot = "/proc"
+
+type networkStats struct {
+	ReceiveBytes  uint64
+	TransmitBytes uint64
+}
+
+// getNetworkStats reads the network traffic of the network namespace of the processes in the group. The processes
+// of runp share the network namespace of the agent, their traffic can't be told apart, so false is returned.
+func getNetworkStats(group string) (*networkStats, bool) {
+	pids, err := cgroup.GetPids(group)
+	if err != nil || len(pids) == 0 {
+		return nil, false
+	}
+	pid := strconv.Itoa(pids[0])
+
+	netns, err := os.Readlink(filepath.Join(procRoot, pid, "ns/net"))
+	if err != nil {
+		return nil, false
+	}
+	selfNetns, err := os.Readlink(filepath.Join(procRoot, "self/ns/net"))
+	if err != nil || netns == selfNetns {
+		return nil, false
+	}
+
+	f, err := os.Open(filepath.Join(procRoot, pid, "net/dev"))
+	if err != nil {
+		return nil, false
+	}
+	defer f.Close()
+	stats, err := parseNetDev(f)
+	if err != nil {
+		return nil, false
+	}
+	return stats, true
+}
+
+// parseNetDev sums the traffic of the interfaces except loopback in /proc/{pid}/net/dev.
+func parseNetDev(r io.Reader) (*networkStats, error) {
+	stats := &networkStats{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		iface, data, found := strings.Cut(scanner.Text(), ":")
+		if !found || strings.TrimSpace(iface) == "lo" {
+			continue
+		}
+		// receive: bytes packets errs drop fifo frame compressed multicast, transmit: bytes ...
+		fields := strings.Fields(data)
+		if len(fields) < 16 {
+			return nil, fmt.Errorf("invalid net dev line: %s", scanner.Text())
+		}
+		rx, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := strconv.ParseUint(fields[8], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		stats.ReceiveBytes += rx
+		stats.TransmitBytes += tx
+	}
+	return stats, scanner.Err()
+}
@@ -0,0 +1,275 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const scrapeTimeout = 3 * time.Second
+
+// servingSample is the cumulative values of the autoscaling metrics of a pod at a time.
+type servingSample struct {
+	time         time.Time
+	cpuNanos     uint64
+	requests     float64
+	latencySum   float64
+	latencyCount float64
+}
+
+type servingUsage struct {
+	metricNames map[kusciaapisv1alpha1.AutoscalingMetricType]string
+	last        *servingSample
+	current     map[kusciaapisv1alpha1.AutoscalingMetricType]int64
+	reported    string
+}
+
+type scrapeTarget struct {
+	uid         types.UID
+	url         string
+	metricNames map[kusciaapisv1alpha1.AutoscalingMetricType]string
+}
+
+// parseAutoscalingMetrics returns the autoscaling metrics of the pod, nil if the pod doesn't need to be sampled.
+func parseAutoscalingMetrics(pod *v1.Pod) map[kusciaapisv1alpha1.AutoscalingMetricType]string {
+	value := pod.Annotations[common.AutoscalingMetricsAnnotationKey]
+	if value == "" {
+		return nil
+	}
+	metricNames := map[kusciaapisv1alpha1.AutoscalingMetricType]string{}
+	if err := json.Unmarshal([]byte(value), &metricNames); err != nil {
+		nlog.Warnf("Failed to parse autoscaling metrics of pod %s/%s, %v", pod.Namespace, pod.Name, err)
+		return nil
+	}
+	return metricNames
+}
+
+// metricURL returns the url of the metric probe of the pod.
+func metricURL(pod *v1.Pod) string {
+	path := pod.Annotations[common.MetricPathAnnotationKey]
+	port := pod.Annotations[common.MetricPortAnnotationKey]
+	if path == "" || port == "" || pod.Status.PodIP == "" {
+		return ""
+	}
+	return fmt.Sprintf("http://%s:%s/%s", pod.Status.PodIP, port, strings.TrimPrefix(path, "/"))
+}
+
+// collectServing samples the autoscaling metrics of the running serving pods. The cpu usage comes from the cgroups
+// collected by collect, and the request metrics are scraped from the metric probe of the pod.
+func (c *Collector) collectServing(ctx context.Context) {
+	now := time.Now()
+	var targets []scrapeTarget
+
+	c.mu.Lock()
+	for _, pod := range c.podLister.GetPods() {
+		usage, ok := c.pods[pod.UID]
+		if !ok || usage.finished {
+			continue
+		}
+		if usage.serving == nil {
+			metricNames := parseAutoscalingMetrics(pod)
+			if metricNames == nil {
+				continue
+			}
+			usage.serving = &servingUsage{metricNames: metricNames}
+		}
+		targets = append(targets, scrapeTarget{uid: pod.UID, url: metricURL(c.podWithStatus(pod)), metricNames: usage.serving.metricNames})
+	}
+	c.mu.Unlock()
+
+	for _, target := range targets {
+		sample := &servingSample{time: now}
+		if needScrape(target.metricNames) {
+			if target.url == "" {
+				continue
+			}
+			families, err := scrape(ctx, target.url)
+			if err != nil {
+				nlog.Debugf("Failed to scrape serving metrics from %s, %v", target.url, err)
+				continue
+			}
+			sample.requests = sumCounter(families[target.metricNames[kusciaapisv1alpha1.AutoscalingMetricQPS]])
+			sample.latencySum, sample.latencyCount = sumLatency(families[target.metricNames[kusciaapisv1alpha1.AutoscalingMetricLatency]])
+		}
+
+		c.mu.Lock()
+		if usage, ok := c.pods[target.uid]; ok && usage.serving != nil {
+			for _, cu := range usage.containers {
+				if cu.running {
+					sample.cpuNanos += cu.stats.CPUUsageNanoSeconds
+				}
+			}
+			usage.serving.update(sample)
+		}
+		c.mu.Unlock()
+	}
+}
+
+func (c *Collector) podWithStatus(pod *v1.Pod) *v1.Pod {
+	if pod.Status.PodIP != "" {
+		return pod
+	}
+	podCopy := pod.DeepCopy()
+	podCopy.Status = c.podStatus(pod)
+	return podCopy
+}
+
+func needScrape(metricNames map[kusciaapisv1alpha1.AutoscalingMetricType]string) bool {
+	return metricNames[kusciaapisv1alpha1.AutoscalingMetricQPS] != "" || metricNames[kusciaapisv1alpha1.AutoscalingMetricLatency] != ""
+}
+
+// update calculates the current values of the metrics from the previous sample, counter resets are skipped.
+func (u *servingUsage) update(sample *servingSample) {
+	last := u.last
+	u.last = sample
+	if last == nil {
+		return
+	}
+	elapsed := sample.time.Sub(last.time).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	current := map[kusciaapisv1alpha1.AutoscalingMetricType]int64{}
+	for metricType := range u.metricNames {
+		switch metricType {
+		case kusciaapisv1alpha1.AutoscalingMetricCPU:
+			if sample.cpuNanos >= last.cpuNanos {
+				current[metricType] = int64(float64(sample.cpuNanos-last.cpuNanos)/float64(time.Millisecond)/elapsed + 0.5)
+			}
+		case kusciaapisv1alpha1.AutoscalingMetricQPS:
+			if sample.requests >= last.requests {
+				current[metricType] = int64((sample.requests-last.requests)/elapsed + 0.5)
+			}
+		case kusciaapisv1alpha1.AutoscalingMetricLatency:
+			count := sample.latencyCount - last.latencyCount
+			sum := sample.latencySum - last.latencySum
+			if count > 0 && sum >= 0 {
+				// prometheus durations are in seconds
+				current[metricType] = int64(sum / count * 1000)
+			} else if count == 0 {
+				current[metricType] = 0
+			}
+		}
+	}
+	u.current = current
+}
+
+func scrape(ctx context.Context, url string) (map[string]*dto.MetricFamily, error) {
+	ctx, cancel := context.WithTimeout(ctx, scrapeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(resp.Body)
+}
+
+// sumCounter sums the values of all series of the counter.
+func sumCounter(family *dto.MetricFamily) float64 {
+	if family == nil {
+		return 0
+	}
+	var sum float64
+	for _, m := range family.Metric {
+		switch {
+		case m.Counter != nil:
+			sum += m.Counter.GetValue()
+		case m.Untyped != nil:
+			sum += m.Untyped.GetValue()
+		}
+	}
+	return sum
+}
+
+// sumLatency sums the sample sum and sample count of all series of the histogram or summary.
+func sumLatency(family *dto.MetricFamily) (float64, float64) {
+	if family == nil {
+		return 0, 0
+	}
+	var sum, count float64
+	for _, m := range family.Metric {
+		switch {
+		case m.Histogram != nil:
+			sum += m.Histogram.GetSampleSum()
+			count += float64(m.Histogram.GetSampleCount())
+		case m.Summary != nil:
+			sum += m.Summary.GetSampleSum()
+			count += float64(m.Summary.GetSampleCount())
+		}
+	}
+	return sum, count
+}
+
+// reportServing writes the current values of the autoscaling metrics to the annotations of the pods.
+func (c *Collector) reportServing(ctx context.Context) {
+	var items []reportItem
+	values := map[types.UID]string{}
+
+	c.mu.Lock()
+	for uid, usage := range c.pods {
+		if usage.serving == nil || usage.serving.current == nil || usage.finished {
+			continue
+		}
+		data, err := json.Marshal(usage.serving.current)
+		if err != nil {
+			continue
+		}
+		if string(data) == usage.serving.reported {
+			continue
+		}
+		values[uid] = string(data)
+		items = append(items, reportItem{uid: uid, namespace: usage.namespace, name: usage.name})
+	}
+	c.mu.Unlock()
+
+	for _, item := range items {
+		if err := c.updateAnnotation(ctx, item.namespace, item.name, common.ServingMetricsAnnotationKey, values[item.uid]); err != nil {
+			nlog.Warnf("Failed to report serving metrics of pod %s/%s, %v", item.namespace, item.name, err)
+			continue
+		}
+
+		c.mu.Lock()
+		if usage, ok := c.pods[item.uid]; ok && usage.serving != nil {
+			usage.serving.reported = values[item.uid]
+		}
+		c.mu.Unlock()
+	}
+}
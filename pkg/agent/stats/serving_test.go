@@ -0,0 +1,115 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/cgroup"
+)
+
+func TestServingUsageUpdate(t *testing.T) {
+	u := &servingUsage{metricNames: map[kusciaapisv1alpha1.AutoscalingMetricType]string{
+		kusciaapisv1alpha1.AutoscalingMetricQPS:     "requests_total",
+		kusciaapisv1alpha1.AutoscalingMetricLatency: "request_duration_seconds",
+		kusciaapisv1alpha1.AutoscalingMetricCPU:     "",
+	}}
+	now := time.Now()
+	u.update(&servingSample{time: now, cpuNanos: 0, requests: 100, latencySum: 1, latencyCount: 100})
+	assert.Nil(t, u.current)
+
+	u.update(&servingSample{time: now.Add(10 * time.Second), cpuNanos: uint64(5 * time.Second), requests: 1100, latencySum: 51, latencyCount: 1100})
+	assert.Equal(t, map[kusciaapisv1alpha1.AutoscalingMetricType]int64{
+		kusciaapisv1alpha1.AutoscalingMetricQPS:     100,
+		kusciaapisv1alpha1.AutoscalingMetricLatency: 50,
+		kusciaapisv1alpha1.AutoscalingMetricCPU:     500,
+	}, u.current)
+
+	// the counters are reset after the container restarts
+	u.update(&servingSample{time: now.Add(20 * time.Second), requests: 10, latencyCount: 10})
+	assert.NotContains(t, u.current, kusciaapisv1alpha1.AutoscalingMetricQPS)
+}
+
+func TestCollectAndReportServing(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests += 600
+		fmt.Fprintf(w, "# TYPE requests_total counter\nrequests_total{method=\"a\"} %d\nrequests_total{method=\"b\"} %d\n", requests, requests)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "serving-0",
+			Namespace: "alice",
+			UID:       types.UID("uid-0"),
+			Annotations: map[string]string{
+				common.AutoscalingMetricsAnnotationKey: `{"QPS":"requests_total","CPU":""}`,
+				common.MetricPathAnnotationKey:         "metrics",
+				common.MetricPortAnnotationKey:         serverURL.Port(),
+			},
+		},
+		Status: v1.PodStatus{
+			Phase:             v1.PodRunning,
+			PodIP:             serverURL.Hostname(),
+			ContainerStatuses: []v1.ContainerStatus{runningContainer("serving", "c1")},
+		},
+	}
+	groups := map[string]*cgroup.Stats{"/k8s.io/c1": {CPUUsageNanoSeconds: uint64(time.Second)}}
+	c := newTestCollector(t, config.ContainerRuntime, pod, groups)
+
+	c.collect()
+	c.collectServing(context.Background())
+	last := c.pods[pod.UID].serving.last
+	assert.NotNil(t, last)
+	assert.Equal(t, float64(1200), last.requests)
+
+	// pretend the previous sample was taken one minute ago
+	last.time = last.time.Add(-time.Minute)
+	groups["/k8s.io/c1"].CPUUsageNanoSeconds += uint64(30 * time.Second)
+	c.collect()
+	c.collectServing(context.Background())
+	c.reportServing(context.Background())
+
+	got, err := c.kubeClient.CoreV1().Pods("alice").Get(context.Background(), "serving-0", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"QPS":20,"CPU":500}`, got.Annotations[common.ServingMetricsAnnotationKey])
+}
+
+func TestParseAutoscalingMetrics(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+	assert.Nil(t, parseAutoscalingMetrics(pod))
+	pod.Annotations[common.AutoscalingMetricsAnnotationKey] = "invalid"
+	assert.Nil(t, parseAutoscalingMetrics(pod))
+	pod.Annotations[common.AutoscalingMetricsAnnotationKey] = `{"Latency":"latency_seconds"}`
+	assert.True(t, strings.Contains(parseAutoscalingMetrics(pod)[kusciaapisv1alpha1.AutoscalingMetricLatency], "latency"))
+}
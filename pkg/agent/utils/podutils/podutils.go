@@ -15,7 +15,12 @@
 package podutils
 
 import (
+	"strings"
+
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/secretflow/kuscia/pkg/common"
 )
 
 func UpdateCondition(status *v1.PodStatus, conditionType v1.PodConditionType, condition v1.PodCondition) {
@@ -32,3 +37,12 @@ func UpdateCondition(status *v1.PodStatus, conditionType v1.PodConditionType, co
 		status.Conditions = append(status.Conditions, condition)
 	}
 }
+
+// HostDirectoryVolumes returns the names of the volumes of the pod backed by the host directories managed by the agent.
+func HostDirectoryVolumes(pod *v1.Pod) sets.Set[string] {
+	names := sets.New[string]()
+	if value := pod.Annotations[common.HostDirectoryVolumesAnnotationKey]; value != "" {
+		names.Insert(strings.Split(value, ",")...)
+	}
+	return names
+}
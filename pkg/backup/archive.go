@@ -0,0 +1,138 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	archiveMagic = "KUSCIA-BACKUP-1\n"
+	saltSize     = 16
+	keySize      = 32
+
+	// scrypt parameters recommended for interactive logins in 2017.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// maxSnapshotBytes bounds the decompressed snapshot, so that a crafted archive can't exhaust the memory.
+var maxSnapshotBytes int64 = 1 << 30
+
+// Seal encodes the snapshot into an archive encrypted with the passphrase. The snapshot is compressed and then
+// encrypted with AES-256-GCM, using a key derived from the passphrase with scrypt.
+func Seal(snapshot *Snapshot, passphrase []byte) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("passphrase can not be empty")
+	}
+
+	var plaintext bytes.Buffer
+	zw := gzip.NewWriter(&plaintext)
+	if err := json.NewEncoder(zw).Encode(snapshot); err != nil {
+		return nil, fmt.Errorf("encode snapshot failed, %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("compress snapshot failed, %v", err)
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	archive := make([]byte, 0, len(archiveMagic)+len(salt)+len(nonce)+plaintext.Len()+aead.Overhead())
+	archive = append(archive, archiveMagic...)
+	archive = append(archive, salt...)
+	archive = append(archive, nonce...)
+	return aead.Seal(archive, nonce, plaintext.Bytes(), []byte(archiveMagic)), nil
+}
+
+// Open decrypts the archive with the passphrase and decodes the snapshot in it.
+func Open(archive []byte, passphrase []byte) (*Snapshot, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("passphrase can not be empty")
+	}
+	if !bytes.HasPrefix(archive, []byte(archiveMagic)) {
+		return nil, errors.New("not a kuscia backup archive")
+	}
+	archive = archive[len(archiveMagic):]
+	if len(archive) < saltSize {
+		return nil, errors.New("backup archive is truncated")
+	}
+	salt, archive := archive[:saltSize], archive[saltSize:]
+
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(archive) < aead.NonceSize()+aead.Overhead() {
+		return nil, errors.New("backup archive is truncated")
+	}
+	nonce, ciphertext := archive[:aead.NonceSize()], archive[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(archiveMagic))
+	if err != nil {
+		return nil, errors.New("decrypt backup archive failed, the passphrase is wrong or the archive is corrupted")
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(plaintext))
+	if err != nil {
+		return nil, fmt.Errorf("decompress snapshot failed, %v", err)
+	}
+	defer zr.Close()
+	data, err := io.ReadAll(io.LimitReader(zr, maxSnapshotBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("decompress snapshot failed, %v", err)
+	}
+	if int64(len(data)) > maxSnapshotBytes {
+		return nil, fmt.Errorf("decompressed snapshot exceeds %d bytes", maxSnapshotBytes)
+	}
+
+	snapshot := &Snapshot{}
+	if err = json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("decode snapshot failed, %v", err)
+	}
+	return snapshot, nil
+}
+
+func newAEAD(passphrase, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("derive key from passphrase failed, %v", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
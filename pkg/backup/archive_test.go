@@ -0,0 +1,64 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func TestSealAndOpen(t *testing.T) {
+	snapshot := &Snapshot{
+		Version: SnapshotVersion,
+		Domains: []v1alpha1.Domain{{ObjectMeta: metav1.ObjectMeta{Name: "alice"}}},
+	}
+	archive, err := Seal(snapshot, []byte("passphrase"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(archive), "alice")
+
+	opened, err := Open(archive, []byte("passphrase"))
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", opened.Domains[0].Name)
+
+	_, err = Open(archive, []byte("wrong"))
+	assert.Error(t, err)
+
+	archive[len(archive)-1] ^= 0xff
+	_, err = Open(archive, []byte("passphrase"))
+	assert.Error(t, err)
+}
+
+func TestOpenTooLarge(t *testing.T) {
+	archive, err := Seal(&Snapshot{Version: SnapshotVersion}, []byte("passphrase"))
+	assert.NoError(t, err)
+
+	defer func(max int64) { maxSnapshotBytes = max }(maxSnapshotBytes)
+	maxSnapshotBytes = 8
+	_, err = Open(archive, []byte("passphrase"))
+	assert.ErrorContains(t, err, "decompressed snapshot exceeds 8 bytes")
+}
+
+func TestSealAndOpenInvalid(t *testing.T) {
+	_, err := Seal(&Snapshot{}, nil)
+	assert.Error(t, err)
+	_, err = Open([]byte("not an archive"), []byte("passphrase"))
+	assert.Error(t, err)
+	_, err = Open([]byte(archiveMagic+"salt"), []byte("passphrase"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,423 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup takes snapshots of the Kuscia resources in a cluster and restores them into another one.
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	// SnapshotVersion is the version of the snapshot format.
+	SnapshotVersion = "v1"
+
+	// confManagerConfigName is the configmap holding the encrypted confmanager configs of a domain.
+	confManagerConfigName = "domain-config"
+)
+
+// Kinds of the resources in a snapshot.
+const (
+	KindDomain             = "Domain"
+	KindClusterDomainRoute = "ClusterDomainRoute"
+	KindDomainRoute        = "DomainRoute"
+	KindAppImage           = "AppImage"
+	KindDomainDataSource   = "DomainDataSource"
+	KindDomainData         = "DomainData"
+	KindDomainDataGrant    = "DomainDataGrant"
+	KindConfManagerConfig  = "ConfManagerConfig"
+	KindKusciaJob          = "KusciaJob"
+)
+
+// Snapshot holds the Kuscia resources of a cluster. The confmanager configs are kept encrypted by the domain keys,
+// so they can only be read by a cluster running with the same domain keys.
+type Snapshot struct {
+	Version             string                        `json:"version"`
+	CreationTime        metav1.Time                   `json:"creationTime"`
+	Domains             []v1alpha1.Domain             `json:"domains,omitempty"`
+	ClusterDomainRoutes []v1alpha1.ClusterDomainRoute `json:"clusterDomainRoutes,omitempty"`
+	DomainRoutes        []v1alpha1.DomainRoute        `json:"domainRoutes,omitempty"`
+	AppImages           []v1alpha1.AppImage           `json:"appImages,omitempty"`
+	DomainDataSources   []v1alpha1.DomainDataSource   `json:"domainDataSources,omitempty"`
+	DomainData          []v1alpha1.DomainData         `json:"domainData,omitempty"`
+	DomainDataGrants    []v1alpha1.DomainDataGrant    `json:"domainDataGrants,omitempty"`
+	ConfManagerConfigs  []corev1.ConfigMap            `json:"confManagerConfigs,omitempty"`
+	KusciaJobs          []v1alpha1.KusciaJob          `json:"kusciaJobs,omitempty"`
+}
+
+// Stat counts the resources of a kind in a snapshot or a restore.
+type Stat struct {
+	Kind    string
+	Total   int
+	Created int
+	Updated int
+	Skipped int
+}
+
+// RestoreOptions controls how a snapshot is restored.
+type RestoreOptions struct {
+	// Overwrite updates the existing resources with the ones in the snapshot, they are skipped otherwise.
+	// The confmanager configs are always merged, the existing keys are kept unless Overwrite is set.
+	Overwrite bool
+}
+
+// Create takes a snapshot of the Kuscia resources in the cluster. The domain routes derived from the cluster
+// domain routes are left out, they are regenerated after the cluster domain routes are restored.
+func Create(ctx context.Context, kusciaClient kusciaclientset.Interface, kubeClient kubernetes.Interface) (*Snapshot, error) {
+	client := kusciaClient.KusciaV1alpha1()
+	snapshot := &Snapshot{
+		Version:      SnapshotVersion,
+		CreationTime: metav1.Now(),
+	}
+
+	domains, err := client.Domains().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list domains failed, %v", err)
+	}
+	snapshot.Domains = sanitizeAll(domains.Items)
+
+	clusterDomainRoutes, err := client.ClusterDomainRoutes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list cluster domain routes failed, %v", err)
+	}
+	snapshot.ClusterDomainRoutes = sanitizeAll(clusterDomainRoutes.Items)
+
+	domainRoutes, err := client.DomainRoutes(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list domain routes failed, %v", err)
+	}
+	for i := range domainRoutes.Items {
+		if len(domainRoutes.Items[i].OwnerReferences) == 0 {
+			snapshot.DomainRoutes = append(snapshot.DomainRoutes, domainRoutes.Items[i])
+		}
+	}
+	snapshot.DomainRoutes = sanitizeAll(snapshot.DomainRoutes)
+
+	appImages, err := client.AppImages().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list app images failed, %v", err)
+	}
+	snapshot.AppImages = sanitizeAll(appImages.Items)
+
+	domainDataSources, err := client.DomainDataSources(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list domain data sources failed, %v", err)
+	}
+	snapshot.DomainDataSources = sanitizeAll(domainDataSources.Items)
+
+	domainData, err := client.DomainDatas(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list domain data failed, %v", err)
+	}
+	snapshot.DomainData = sanitizeAll(domainData.Items)
+
+	domainDataGrants, err := client.DomainDataGrants(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list domain data grants failed, %v", err)
+	}
+	snapshot.DomainDataGrants = sanitizeAll(domainDataGrants.Items)
+
+	for i := range snapshot.Domains {
+		cm, err := kubeClient.CoreV1().ConfigMaps(snapshot.Domains[i].Name).Get(ctx, confManagerConfigName, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get confmanager configs of domain %s failed, %v", snapshot.Domains[i].Name, err)
+		}
+		snapshot.ConfManagerConfigs = append(snapshot.ConfManagerConfigs, *cm)
+	}
+	snapshot.ConfManagerConfigs = sanitizeAll(snapshot.ConfManagerConfigs)
+
+	jobs, err := client.KusciaJobs(common.KusciaCrossDomain).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list kuscia jobs failed, %v", err)
+	}
+	snapshot.KusciaJobs = sanitizeAll(jobs.Items)
+
+	return snapshot, nil
+}
+
+// Stats counts the resources of each kind in the snapshot.
+func (s *Snapshot) Stats() []Stat {
+	return []Stat{
+		{Kind: KindDomain, Total: len(s.Domains)},
+		{Kind: KindClusterDomainRoute, Total: len(s.ClusterDomainRoutes)},
+		{Kind: KindDomainRoute, Total: len(s.DomainRoutes)},
+		{Kind: KindAppImage, Total: len(s.AppImages)},
+		{Kind: KindDomainDataSource, Total: len(s.DomainDataSources)},
+		{Kind: KindDomainData, Total: len(s.DomainData)},
+		{Kind: KindDomainDataGrant, Total: len(s.DomainDataGrants)},
+		{Kind: KindConfManagerConfig, Total: len(s.ConfManagerConfigs)},
+		{Kind: KindKusciaJob, Total: len(s.KusciaJobs)},
+	}
+}
+
+// Restore creates the resources of the snapshot in the cluster, along with their status. The domains are restored
+// first and the jobs last, the namespaces of the namespaced resources are created if they don't exist.
+// It goes on restoring the rest when a resource fails, and returns the errors aggregated.
+func Restore(ctx context.Context, kusciaClient kusciaclientset.Interface, kubeClient kubernetes.Interface, snapshot *Snapshot,
+	opts RestoreOptions) ([]Stat, error) {
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %q, must be %s", snapshot.Version, SnapshotVersion)
+	}
+	client := kusciaClient.KusciaV1alpha1()
+	stats := make([]Stat, 0, len(snapshot.Stats()))
+	var errs []error
+	record := func(stat Stat, err error) {
+		stats = append(stats, stat)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	record(restoreObjects(ctx, KindDomain, snapshot.Domains, func(string) typedClient[v1alpha1.Domain] {
+		return client.Domains()
+	}, opts))
+
+	namespaces := map[string]bool{}
+	if err := ensureNamespaces(ctx, kubeClient, namespaces, snapshot.DomainRoutes); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ensureNamespaces(ctx, kubeClient, namespaces, snapshot.DomainDataSources); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ensureNamespaces(ctx, kubeClient, namespaces, snapshot.DomainData); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ensureNamespaces(ctx, kubeClient, namespaces, snapshot.DomainDataGrants); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ensureNamespaces(ctx, kubeClient, namespaces, snapshot.ConfManagerConfigs); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ensureNamespaces(ctx, kubeClient, namespaces, snapshot.KusciaJobs); err != nil {
+		errs = append(errs, err)
+	}
+
+	record(restoreObjects(ctx, KindClusterDomainRoute, snapshot.ClusterDomainRoutes, func(string) typedClient[v1alpha1.ClusterDomainRoute] {
+		return client.ClusterDomainRoutes()
+	}, opts))
+	record(restoreObjects(ctx, KindDomainRoute, snapshot.DomainRoutes, func(namespace string) typedClient[v1alpha1.DomainRoute] {
+		return client.DomainRoutes(namespace)
+	}, opts))
+	record(restoreObjects(ctx, KindAppImage, snapshot.AppImages, func(string) typedClient[v1alpha1.AppImage] {
+		return client.AppImages()
+	}, opts))
+	record(restoreObjects(ctx, KindDomainDataSource, snapshot.DomainDataSources, func(namespace string) typedClient[v1alpha1.DomainDataSource] {
+		return client.DomainDataSources(namespace)
+	}, opts))
+	record(restoreObjects(ctx, KindDomainData, snapshot.DomainData, func(namespace string) typedClient[v1alpha1.DomainData] {
+		return client.DomainDatas(namespace)
+	}, opts))
+	record(restoreObjects(ctx, KindDomainDataGrant, snapshot.DomainDataGrants, func(namespace string) typedClient[v1alpha1.DomainDataGrant] {
+		return client.DomainDataGrants(namespace)
+	}, opts))
+	record(restoreConfManagerConfigs(ctx, kubeClient, snapshot.ConfManagerConfigs, opts))
+	record(restoreObjects(ctx, KindKusciaJob, snapshot.KusciaJobs, func(namespace string) typedClient[v1alpha1.KusciaJob] {
+		return client.KusciaJobs(namespace)
+	}, opts))
+
+	return stats, utilerrors.NewAggregate(errs)
+}
+
+type object[T any] interface {
+	*T
+	metav1.Object
+}
+
+type typedClient[T any] interface {
+	Create(ctx context.Context, obj *T, opts metav1.CreateOptions) (*T, error)
+	Update(ctx context.Context, obj *T, opts metav1.UpdateOptions) (*T, error)
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*T, error)
+}
+
+type statusClient[T any] interface {
+	UpdateStatus(ctx context.Context, obj *T, opts metav1.UpdateOptions) (*T, error)
+}
+
+func restoreObjects[T any, PT object[T]](ctx context.Context, kind string, items []T, clientFor func(namespace string) typedClient[T],
+	opts RestoreOptions) (Stat, error) {
+	stat := Stat{Kind: kind, Total: len(items)}
+	var errs []error
+	for i := range items {
+		item := PT(&items[i])
+		client := clientFor(item.GetNamespace())
+		key := objectKey(item)
+
+		restored, err := client.Create(ctx, &items[i], metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			if !opts.Overwrite {
+				stat.Skipped++
+				continue
+			}
+			restored, err = updateObject(ctx, client, item)
+			if err == nil {
+				stat.Updated++
+			}
+		} else if err == nil {
+			stat.Created++
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("restore %s %s failed, %v", kind, key, err))
+			continue
+		}
+
+		if sc, ok := client.(statusClient[T]); ok {
+			if err = restoreStatus(ctx, client, sc, item, PT(restored).GetResourceVersion()); err != nil {
+				errs = append(errs, fmt.Errorf("restore status of %s %s failed, %v", kind, key, err))
+			}
+		}
+		nlog.Debugf("Restored %s %s", kind, key)
+	}
+	return stat, utilerrors.NewAggregate(errs)
+}
+
+func updateObject[T any, PT object[T]](ctx context.Context, client typedClient[T], obj PT) (*T, error) {
+	var updated *T
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		obj.SetResourceVersion(PT(existing).GetResourceVersion())
+		updated, err = client.Update(ctx, (*T)(obj), metav1.UpdateOptions{})
+		return err
+	})
+	return updated, err
+}
+
+// restoreStatus writes the status in the snapshot to the restored object, it wins over the status the controllers
+// set in the meantime.
+func restoreStatus[T any, PT object[T]](ctx context.Context, client typedClient[T], sc statusClient[T], obj PT, resourceVersion string) error {
+	obj.SetResourceVersion(resourceVersion)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := sc.UpdateStatus(ctx, (*T)(obj), metav1.UpdateOptions{})
+		if !k8serrors.IsConflict(err) {
+			return err
+		}
+		existing, getErr := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		obj.SetResourceVersion(PT(existing).GetResourceVersion())
+		return err
+	})
+}
+
+// restoreConfManagerConfigs merges the confmanager configs into the existing configmaps, since the domain controller
+// creates them as soon as the domains are restored.
+func restoreConfManagerConfigs(ctx context.Context, kubeClient kubernetes.Interface, configs []corev1.ConfigMap, opts RestoreOptions) (Stat, error) {
+	stat := Stat{Kind: KindConfManagerConfig, Total: len(configs)}
+	var errs []error
+	for i := range configs {
+		cm := &configs[i]
+		_, err := kubeClient.CoreV1().ConfigMaps(cm.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+		if err == nil {
+			stat.Created++
+			continue
+		}
+		if !k8serrors.IsAlreadyExists(err) {
+			errs = append(errs, fmt.Errorf("restore %s %s failed, %v", KindConfManagerConfig, objectKey(cm), err))
+			continue
+		}
+
+		updated := false
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			existing, err := kubeClient.CoreV1().ConfigMaps(cm.Namespace).Get(ctx, cm.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			existing = existing.DeepCopy()
+			if existing.Data == nil {
+				existing.Data = make(map[string]string)
+			}
+			updated = false
+			for k, v := range cm.Data {
+				if old, ok := existing.Data[k]; (!ok || opts.Overwrite) && old != v {
+					existing.Data[k] = v
+					updated = true
+				}
+			}
+			if !updated {
+				return nil
+			}
+			_, err = kubeClient.CoreV1().ConfigMaps(cm.Namespace).Update(ctx, existing, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("restore %s %s failed, %v", KindConfManagerConfig, objectKey(cm), err))
+			continue
+		}
+		if updated {
+			stat.Updated++
+		} else {
+			stat.Skipped++
+		}
+	}
+	return stat, utilerrors.NewAggregate(errs)
+}
+
+func ensureNamespaces[T any, PT object[T]](ctx context.Context, kubeClient kubernetes.Interface, created map[string]bool, items []T) error {
+	for i := range items {
+		namespace := PT(&items[i]).GetNamespace()
+		if created[namespace] {
+			continue
+		}
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+		if _, err := kubeClient.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{}); err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("create namespace %s failed, %v", namespace, err)
+		}
+		created[namespace] = true
+	}
+	return nil
+}
+
+// sanitizeAll clears the fields set by the api server, so that the objects can be created in another cluster.
+// The owner references and finalizers are cleared as well, they refer to the objects of the old cluster.
+func sanitizeAll[T any, PT object[T]](items []T) []T {
+	for i := range items {
+		obj := PT(&items[i])
+		obj.SetUID("")
+		obj.SetResourceVersion("")
+		obj.SetGeneration(0)
+		obj.SetCreationTimestamp(metav1.Time{})
+		obj.SetDeletionTimestamp(nil)
+		obj.SetDeletionGracePeriodSeconds(nil)
+		obj.SetManagedFields(nil)
+		obj.SetOwnerReferences(nil)
+		obj.SetFinalizers(nil)
+	}
+	return items
+}
+
+func objectKey(obj metav1.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
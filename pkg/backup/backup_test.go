@@ -0,0 +1,125 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+)
+
+func TestCreateAndRestore(t *testing.T) {
+	ctx := context.Background()
+	srcKuscia := kusciafake.NewSimpleClientset(
+		&v1alpha1.Domain{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice", UID: types.UID("uid-alice"), ResourceVersion: "10"},
+			Status:     &v1alpha1.DomainStatus{NodeStatuses: []v1alpha1.NodeStatus{{Name: "node-1", Status: "Ready"}}},
+		},
+		&v1alpha1.ClusterDomainRoute{ObjectMeta: metav1.ObjectMeta{Name: "alice-bob"}},
+		&v1alpha1.DomainRoute{ObjectMeta: metav1.ObjectMeta{Name: "alice-bob", Namespace: "alice",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ClusterDomainRoute", Name: "alice-bob"}}}},
+		&v1alpha1.DomainRoute{ObjectMeta: metav1.ObjectMeta{Name: "alice-carol", Namespace: "alice"}},
+		&v1alpha1.AppImage{ObjectMeta: metav1.ObjectMeta{Name: "secretflow-image"}},
+		&v1alpha1.DomainDataSource{ObjectMeta: metav1.ObjectMeta{Name: "default-data-source", Namespace: "alice"}},
+		&v1alpha1.DomainData{ObjectMeta: metav1.ObjectMeta{Name: "data-1", Namespace: "alice"}},
+		&v1alpha1.DomainDataGrant{ObjectMeta: metav1.ObjectMeta{Name: "grant-1", Namespace: "alice",
+			Finalizers: []string{"kuscia.secretflow/grant"}}},
+		&v1alpha1.KusciaJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-1", Namespace: common.KusciaCrossDomain},
+			Status:     v1alpha1.KusciaJobStatus{Phase: v1alpha1.KusciaJobSucceeded},
+		},
+	)
+	srcKube := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: confManagerConfigName, Namespace: "alice"},
+		Data:       map[string]string{"key-1": "encrypted-1"},
+	})
+
+	snapshot, err := Create(ctx, srcKuscia, srcKube)
+	assert.NoError(t, err)
+	assert.Equal(t, SnapshotVersion, snapshot.Version)
+	assert.Len(t, snapshot.Domains, 1)
+	assert.Empty(t, snapshot.Domains[0].UID)
+	assert.Empty(t, snapshot.Domains[0].ResourceVersion)
+	assert.Len(t, snapshot.DomainRoutes, 1)
+	assert.Equal(t, "alice-carol", snapshot.DomainRoutes[0].Name)
+	assert.Empty(t, snapshot.DomainDataGrants[0].Finalizers)
+	assert.Len(t, snapshot.ConfManagerConfigs, 1)
+	assert.Len(t, snapshot.KusciaJobs, 1)
+
+	dstKuscia := kusciafake.NewSimpleClientset()
+	dstKube := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: confManagerConfigName, Namespace: "alice"},
+		Data:       map[string]string{"key-2": "encrypted-2"},
+	})
+	stats, err := Restore(ctx, dstKuscia, dstKube, snapshot, RestoreOptions{})
+	assert.NoError(t, err)
+	for _, stat := range stats {
+		if stat.Kind == KindConfManagerConfig {
+			assert.Equal(t, 1, stat.Updated)
+			continue
+		}
+		assert.Equal(t, stat.Total, stat.Created, stat.Kind)
+	}
+
+	domain, err := dstKuscia.KusciaV1alpha1().Domains().Get(ctx, "alice", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Ready", domain.Status.NodeStatuses[0].Status)
+	job, err := dstKuscia.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, "job-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.KusciaJobSucceeded, job.Status.Phase)
+	_, err = dstKube.CoreV1().Namespaces().Get(ctx, common.KusciaCrossDomain, metav1.GetOptions{})
+	assert.NoError(t, err)
+	cm, err := dstKube.CoreV1().ConfigMaps("alice").Get(ctx, confManagerConfigName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"key-1": "encrypted-1", "key-2": "encrypted-2"}, cm.Data)
+}
+
+func TestRestoreExisting(t *testing.T) {
+	ctx := context.Background()
+	snapshot := &Snapshot{
+		Version:   SnapshotVersion,
+		AppImages: []v1alpha1.AppImage{{ObjectMeta: metav1.ObjectMeta{Name: "image"}, Spec: v1alpha1.AppImageSpec{Image: v1alpha1.AppImageInfo{Tag: "1.0"}}}},
+	}
+	existing := &v1alpha1.AppImage{ObjectMeta: metav1.ObjectMeta{Name: "image"}, Spec: v1alpha1.AppImageSpec{Image: v1alpha1.AppImageInfo{Tag: "0.9"}}}
+
+	kusciaClient := kusciafake.NewSimpleClientset(existing.DeepCopy())
+	stats, err := Restore(ctx, kusciaClient, kubefake.NewSimpleClientset(), snapshot, RestoreOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stats[3].Skipped)
+	image, _ := kusciaClient.KusciaV1alpha1().AppImages().Get(ctx, "image", metav1.GetOptions{})
+	assert.Equal(t, "0.9", image.Spec.Image.Tag)
+
+	kusciaClient = kusciafake.NewSimpleClientset(existing.DeepCopy())
+	stats, err = Restore(ctx, kusciaClient, kubefake.NewSimpleClientset(), snapshot, RestoreOptions{Overwrite: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stats[3].Updated)
+	image, _ = kusciaClient.KusciaV1alpha1().AppImages().Get(ctx, "image", metav1.GetOptions{})
+	assert.Equal(t, "1.0", image.Spec.Image.Tag)
+}
+
+func TestRestoreUnsupportedVersion(t *testing.T) {
+	_, err := Restore(context.Background(), kusciafake.NewSimpleClientset(), kubefake.NewSimpleClientset(),
+		&Snapshot{Version: "v0"}, RestoreOptions{})
+	assert.Error(t, err)
+}
@@ -53,6 +53,7 @@ const (
 	LabelInterConnProtocolType = "kuscia.secretflow/interconn-protocol-type"
 	LabelJobUID                = "kuscia.secretflow/job-uid"
 	LabelTaskUID               = "kuscia.secretflow/task-uid"
+	LabelTaskID                = "kuscia.secretflow/task-id"
 	LabelTaskResourceGroupUID  = "kuscia.secretflow/task-resource-group-uid"
 
 	LabelJobAutoApproval = "kuscia.secretflow/job-auto-approval"
@@ -71,15 +72,24 @@ const (
 	LabelNodeName        = "kuscia.secretflow/node"
 	LabelPodUID          = "kuscia.secretflow/pod-uid"
 	LabelOwnerReferences = "kuscia.secretflow/owner-references"
+	// LabelNodeCordoned marks the nodes cordoned by KusciaAPI, the agent keeps them unschedulable after restarting.
+	LabelNodeCordoned = "kuscia.secretflow/node-cordoned"
+
+	// LabelAppImageSynced marks the app images synced from the central registry by the appimage sync controller.
+	LabelAppImageSynced = "kuscia.secretflow/appimage-synced"
+	// LabelGitOpsManaged marks the resources reconciled from the git repository by the gitops controller.
+	LabelGitOpsManaged = "kuscia.secretflow/gitops-managed"
 
 	LabelDomainRoutePartner = "kuscia.secertflow/domainroute-partner"
 )
 
 const (
-	PluginNameCertIssuance  = "cert-issuance"
-	PluginNameConfigRender  = "config-render"
-	PluginNameImageSecurity = "image-security"
-	PluginNameEnvImport     = "env-import"
+	PluginNameCertIssuance   = "cert-issuance"
+	PluginNameConfigRender   = "config-render"
+	PluginNameImageSecurity  = "image-security"
+	PluginNameImageSignature = "image-signature"
+	PluginNameEnvImport      = "env-import"
+	PluginNameSecretInject   = "secret-inject"
 )
 
 type LoadBalancerType string
@@ -116,6 +126,11 @@ const (
 	// the value is a series of domain id join with '_', such as alice_bob_carol .
 	InterConnBFIAPartyAnnotationKey = "kuscia.secretflow/interconn-bfia-parties"
 
+	// InterConnBFIACallbackURLAnnotationKey is a annotation of the partner domain interconnected with bfia protocol,
+	// the job status is pushed to the url once it changes. A path such as /v1/interconn/schedule/job/callback is
+	// sent to the interconn scheduler of the partner.
+	InterConnBFIACallbackURLAnnotationKey = "kuscia.secretflow/interconn-bfia-callback-url"
+
 	InitiatorMasterDomainAnnotationKey   = "kuscia.secretflow/initiator-master-domain"
 	InterConnSelfPartyAnnotationKey      = "kuscia.secretflow/interconn-self-parties"
 	KusciaPartyMasterDomainAnnotationKey = "kuscia.secretflow/party-master-domain"
@@ -136,6 +151,27 @@ const (
 	ProtocolAnnotationKey     = "kuscia.secretflow/protocol"
 	ReadyTimeAnnotationKey    = "kuscia.secretflow/ready-time"
 
+	GrantNotifiedTimeAnnotationKey = "kuscia.secretflow/grant-notified-time"
+	// GrantNotificationAnnotationKey is the notification message in json sent to the grantee of another party by
+	// interconn, along with the copy of the grant.
+	GrantNotificationAnnotationKey = "kuscia.secretflow/grant-notification"
+
+	// DomainOnboardingAnnotationKey records the time a domain was registered by RegisterDomain.
+	DomainOnboardingAnnotationKey = "kuscia.secretflow/onboarding-time"
+
+	// DomainOffboardingFinalizer holds a deleted domain until the resources referring to it are cleaned up.
+	DomainOffboardingFinalizer = "kuscia.secretflow/domain-offboarding"
+
+	// AppImageSyncSourceAnnotationKey records the registry source an app image is synced from.
+	AppImageSyncSourceAnnotationKey = "kuscia.secretflow/appimage-sync-source"
+	// GitOpsSourceAnnotationKey records the git repository or oci artifact a resource is reconciled from.
+	GitOpsSourceAnnotationKey = "kuscia.secretflow/gitops-source"
+	// GitOpsRevisionAnnotationKey records the revision of the source a resource is last applied from.
+	GitOpsRevisionAnnotationKey = "kuscia.secretflow/gitops-revision"
+	// InteropConfigForceSyncAnnotationKey forces the interconn controller to apply the spec of an interop config even
+	// if it conflicts, every new value triggers a force sync.
+	InteropConfigForceSyncAnnotationKey = "kuscia.secretflow/interop-config-force-sync"
+
 	ConfigTemplateVolumesAnnotationKey         = "kuscia.secretflow/config-template-volumes"
 	ConfigTemplateValueAnnotationKey           = "kuscia.secretflow/config-template-value-cm-name"
 	ConfigValueCompressFieldsNameAnnotationKey = "kuscia.secretflow/config-value-compress-fields-name"
@@ -145,8 +181,50 @@ const (
 	ComponentSpecAnnotationKey  = "kuscia.secretflow/component-spec"
 	AllocatedPortsAnnotationKey = "kuscia.secretflow/allocated-ports"
 	ImageIDAnnotationKey        = "kuscia.secretflow/image-id"
+	// ImageRegistryCredentialKeyAnnotationKey is the key of the registry credential in the confmanager to pull the images of the pod.
+	ImageRegistryCredentialKeyAnnotationKey = "kuscia.secretflow/image-registry-credential-key"
+	// ResourceUsageAnnotationKey is the resource usage of the pod in json, reported by the agent.
+	ResourceUsageAnnotationKey = "kuscia.secretflow/resource-usage"
+	// AutoscalingMetricsAnnotationKey is the autoscaling metrics of the serving pod in json, keyed by the metric type
+	// with the name of the prometheus metric as value. The agent samples them and reports the result in
+	// ServingMetricsAnnotationKey.
+	AutoscalingMetricsAnnotationKey = "kuscia.secretflow/autoscaling-metrics"
+	// ServingMetricsAnnotationKey is the current value of the autoscaling metrics of the pod in json, reported by the agent.
+	ServingMetricsAnnotationKey = "kuscia.secretflow/serving-metrics"
+	// TrafficSplitOfAnnotationKey is the name of the service whose traffic is partly routed to the annotated service
+	// by the gateway, the percentage of the traffic is in TrafficWeightAnnotationKey.
+	TrafficSplitOfAnnotationKey = "kuscia.secretflow/traffic-split-of"
+	TrafficWeightAnnotationKey  = "kuscia.secretflow/traffic-weight"
+	// SidecarContainersAnnotationKey is the comma-separated names of the containers that run as sidecars.
+	SidecarContainersAnnotationKey = "kuscia.secretflow/sidecar-containers"
+	// PlacementStrategyAnnotationKey is the strategy the scheduler uses to place the pod on the nodes, binpack or spread.
+	PlacementStrategyAnnotationKey = "kuscia.secretflow/placement-strategy"
+	// VolumeClaimTemplatesAnnotationKey is the json of the persistent volume claim specs keyed by the claim names.
+	VolumeClaimTemplatesAnnotationKey = "kuscia.secretflow/volume-claim-templates"
+	// HostDirectoryVolumesAnnotationKey is the comma-separated names of the volumes backed by the host directories.
+	HostDirectoryVolumesAnnotationKey = "kuscia.secretflow/host-directory-volumes"
+	// CheckpointVolumeAnnotationKey is the name of the volume holding the checkpoints of the engine.
+	CheckpointVolumeAnnotationKey = "kuscia.secretflow/checkpoint-volume"
+	// SandboxAnnotationKey is the sandbox the pod runs in, gvisor or kata.
+	SandboxAnnotationKey = "kuscia.secretflow/sandbox"
+	// AllowRootAnnotationKey exempts the containers of the app image or pod from the root policy of the agent if it's
+	// "true", so that they may run as root of the host.
+	AllowRootAnnotationKey = "kuscia.secretflow/allow-root"
+	// GrantPolicyDecisionAnnotationKey is the decision of the grant policy on the domain data grant, in json.
+	GrantPolicyDecisionAnnotationKey = "kuscia.secretflow/grant-policy-decision"
+)
+
+// Checkpoint contract between kuscia and the engines, the engine writes the checkpoints to the checkpoint volume and
+// the id of the latest one to the LATEST file, the agent reports it by the pod condition.
+const (
+	CheckpointMountPath             = "/home/kuscia/checkpoint"
+	CheckpointLatestFile            = "LATEST"
+	PodConditionCheckpointAvailable = "CheckpointAvailable"
 )
 
+// PodReasonDiskPressure is the status reason of the pods evicted by the agent for their local disk usage.
+const PodReasonDiskPressure = "DiskPressure"
+
 // Environment variables issued to the pod.
 const (
 	EnvTaskID              = "TASK_ID"
@@ -166,6 +244,15 @@ const (
 	EnvKusciaAPIProtocol   = "KUSCIA_API_PROTOCOL"
 	EnvKusciaAPIToken      = "KUSCIA_API_TOKEN"
 	EnvKusciaDomainKeyData = "KUSCIA_DOMAIN_KEY_DATA"
+
+	// EnvTaskSensitiveInputConfig is the encrypted sensitive input config of the party, it's merged into
+	// EnvTaskInputConfig by the agent instead of being issued to the pod.
+	EnvTaskSensitiveInputConfig = "TASK_SENSITIVE_INPUT_CONFIG"
+
+	// EnvCheckpointDir is the directory the engine writes the checkpoints to.
+	EnvCheckpointDir = "KUSCIA_CHECKPOINT_DIR"
+	// EnvResumeFromCheckpoint is the id of the checkpoint the restarted task resumes from.
+	EnvResumeFromCheckpoint = "KUSCIA_RESUME_FROM_CHECKPOINT"
 )
 
 const (
@@ -233,16 +320,30 @@ const (
 	MTLS  Protocol = "MTLS"
 )
 
+// IPFamily is the IP family of the network the gateway listens on and the pods run in.
+type IPFamily string
+
+const (
+	IPFamilyIPv4      IPFamily = "IPv4"
+	IPFamilyIPv6      IPFamily = "IPv6"
+	IPFamilyDualStack IPFamily = "DualStack"
+)
+
 const DomainCsrExtensionID = "1.2.3.4"
 
 const (
-	CertPrefix   = "var/certs/"
-	LogPrefix    = "var/logs/"
-	StdoutPrefix = "var/stdout/"
-	TmpPrefix    = "var/tmp/"
-	ConfPrefix   = "etc/conf/"
+	CertPrefix       = "var/certs/"
+	LogPrefix        = "var/logs/"
+	StdoutPrefix     = "var/stdout/"
+	LogArchivePrefix = "var/log_archive/"
+	LogForwardPrefix = "var/log_forward/"
+	TmpPrefix        = "var/tmp/"
+	ConfPrefix       = "etc/conf/"
 )
 
+// AgentExecSocketPath is the unix socket of the agent serving the exec sessions of the debug api, relative to the root dir.
+const AgentExecSocketPath = "var/run/agent_exec.sock"
+
 const (
 	GatewayLiveTimeout         = 3 * time.Minute
 	GatewayHealthCheckDuration = 15 * time.Second
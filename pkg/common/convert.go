@@ -92,6 +92,63 @@ func Convert2KubeColumn(cols []*pbv1alpha1.DataColumn) []k8sv1alpha1.DataColumn
 	}
 	return colsV1
 }
+
+func Convert2PbMaskingPolicies(policies []k8sv1alpha1.DataMaskingPolicy) []*pbv1alpha1.DataMaskingPolicy {
+	if len(policies) == 0 {
+		return nil
+	}
+	pbPolicies := make([]*pbv1alpha1.DataMaskingPolicy, len(policies))
+	for i, v := range policies {
+		pbPolicies[i] = &pbv1alpha1.DataMaskingPolicy{
+			Column:  v.Column,
+			Method:  string(v.Method),
+			Length:  int32(v.Length),
+			Domains: v.Domains,
+		}
+	}
+	return pbPolicies
+}
+
+func Convert2KubeMaskingPolicies(policies []*pbv1alpha1.DataMaskingPolicy) []k8sv1alpha1.DataMaskingPolicy {
+	if len(policies) == 0 {
+		return nil
+	}
+	kubePolicies := make([]k8sv1alpha1.DataMaskingPolicy, len(policies))
+	for i, v := range policies {
+		kubePolicies[i] = k8sv1alpha1.DataMaskingPolicy{
+			Column:  v.Column,
+			Method:  k8sv1alpha1.DataMaskingMethod(v.Method),
+			Length:  int(v.Length),
+			Domains: v.Domains,
+		}
+	}
+	return kubePolicies
+}
+
+func Convert2PbGrantCompliance(compliance *k8sv1alpha1.GrantCompliance) *pbv1alpha1.GrantCompliance {
+	if compliance == nil {
+		return nil
+	}
+	return &pbv1alpha1.GrantCompliance{
+		Purpose:        compliance.Purpose,
+		LegalBasis:     compliance.LegalBasis,
+		DataCategories: compliance.DataCategories,
+		RetentionDays:  compliance.RetentionDays,
+	}
+}
+
+func Convert2KubeGrantCompliance(compliance *pbv1alpha1.GrantCompliance) *k8sv1alpha1.GrantCompliance {
+	if compliance == nil {
+		return nil
+	}
+	return &k8sv1alpha1.GrantCompliance{
+		Purpose:        compliance.Purpose,
+		LegalBasis:     compliance.LegalBasis,
+		DataCategories: compliance.DataCategories,
+		RetentionDays:  compliance.RetentionDays,
+	}
+}
+
 func Convert2KubeFileFormat(format pbv1alpha1.FileFormat) string {
 	switch format {
 	case pbv1alpha1.FileFormat_CSV:
@@ -244,3 +301,20 @@ func (protocol *Protocol) UnmarshalYAML(unmarshal func(interface{}) error) error
 	}
 	return nil
 }
+
+// yaml profile conversion for IPFamily
+func (family *IPFamily) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var val string
+	err := unmarshal(&val)
+	if err != nil {
+		return err
+	}
+
+	for _, supported := range []IPFamily{IPFamilyIPv4, IPFamilyIPv6, IPFamilyDualStack} {
+		if strings.EqualFold(val, string(supported)) {
+			*family = supported
+			return nil
+		}
+	}
+	return fmt.Errorf("Kuscia configuration: ipFamily: %s is unsupported, supported: IPv4/IPv6/DualStack", val)
+}
@@ -0,0 +1,238 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certmanager
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// Issuer is the CA plugin signing the domain cert and the gateway listener certs. The external CAs are
+// registered by RegisterIssuer and selected by the type of the certIssuer config.
+type Issuer interface {
+	// Issue signs the cert of template for key. The key is always local, so that the issuers requesting by a CSR
+	// can sign it.
+	Issue(ctx context.Context, template *x509.Certificate, key *rsa.PrivateKey) (*x509.Certificate, error)
+}
+
+type IssuerConfig struct {
+	DomainKey       *rsa.PrivateKey
+	DomainCertValue *atomic.Value
+	Issuer          *kusciaconfig.CertIssuerConfig
+}
+
+type IssuerFactory func(conf *IssuerConfig) (Issuer, error)
+
+var (
+	issuerLock      sync.RWMutex
+	issuerFactories = map[string]IssuerFactory{
+		kusciaconfig.CertIssuerSelfSigned:  newSelfSignedIssuer,
+		kusciaconfig.CertIssuerWebhook:     newWebhookIssuer,
+		kusciaconfig.CertIssuerCertManager: newCSRIssuer,
+		kusciaconfig.CertIssuerEST:         newESTIssuer,
+	}
+)
+
+// RegisterIssuer registers the issuer of an external CA.
+func RegisterIssuer(name string, factory IssuerFactory) {
+	issuerLock.Lock()
+	defer issuerLock.Unlock()
+	issuerFactories[name] = factory
+}
+
+func NewIssuer(conf *IssuerConfig) (Issuer, error) {
+	if err := kusciaconfig.CheckCertIssuerConfig(conf.Issuer); err != nil {
+		return nil, err
+	}
+	name := conf.Issuer.TypeName()
+	issuerLock.RLock()
+	factory, ok := issuerFactories[name]
+	issuerLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown cert issuer %q", name)
+	}
+	return factory(conf)
+}
+
+// IssueKeyCertPairData generates a key and issues the cert of template for it, both are PEM encoded.
+func IssueKeyCertPairData(ctx context.Context, issuer Issuer, template *x509.Certificate) (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", "", err
+	}
+	cert, err := issuer.Issue(ctx, template, key)
+	if err != nil {
+		return "", "", err
+	}
+	keyData, err := tlsutils.EncodeRsaKeyToPKCS1(key)
+	if err != nil {
+		return "", "", err
+	}
+	certData, err := tlsutils.EncodeCert(cert)
+	if err != nil {
+		return "", "", err
+	}
+	return keyData, certData, nil
+}
+
+// selfSignedIssuer signs the domain cert with itself, and the other certs with the domain cert.
+type selfSignedIssuer struct {
+	domainKey       *rsa.PrivateKey
+	domainCertValue *atomic.Value
+}
+
+func newSelfSignedIssuer(conf *IssuerConfig) (Issuer, error) {
+	if conf.DomainKey == nil {
+		return nil, fmt.Errorf("domain key is required by the self-signed issuer")
+	}
+	return &selfSignedIssuer{domainKey: conf.DomainKey, domainCertValue: conf.DomainCertValue}, nil
+}
+
+func (i *selfSignedIssuer) Issue(ctx context.Context, template *x509.Certificate, key *rsa.PrivateKey) (*x509.Certificate, error) {
+	if i.domainKey.Equal(key) {
+		return signCert(template, template, &key.PublicKey, i.domainKey)
+	}
+	return signWithDomainCert(i.domainCertValue, i.domainKey, template, &key.PublicKey)
+}
+
+// signWithDomainCert signs the cert with the domain cert as the parent, e.g. the mTLS client certs of routes
+// whose private keys are held by the peers.
+func signWithDomainCert(domainCertValue *atomic.Value, domainKey *rsa.PrivateKey, template *x509.Certificate,
+	publicKey *rsa.PublicKey) (*x509.Certificate, error) {
+	var parent *x509.Certificate
+	if domainCertValue != nil {
+		parent, _ = domainCertValue.Load().(*x509.Certificate)
+	}
+	if parent == nil {
+		return nil, fmt.Errorf("can not find domain cert to sign the cert")
+	}
+	return signCert(template, parent, publicKey, domainKey)
+}
+
+func signCert(template, parent *x509.Certificate, publicKey *rsa.PublicKey, signKey *rsa.PrivateKey) (*x509.Certificate, error) {
+	certRaw, err := x509.CreateCertificate(rand.Reader, template, parent, publicKey, signKey)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(certRaw)
+}
+
+// WebhookIssueRequest is posted to the external CA to sign a cert.
+type WebhookIssueRequest struct {
+	// PublicKey is the PEM encoded PKIX public key of the cert.
+	PublicKey       string   `json:"publicKey"`
+	CommonName      string   `json:"commonName"`
+	Organization    []string `json:"organization,omitempty"`
+	DNSNames        []string `json:"dnsNames,omitempty"`
+	IPAddresses     []string `json:"ipAddresses,omitempty"`
+	IsCA            bool     `json:"isCA,omitempty"`
+	ValiditySeconds int64    `json:"validitySeconds"`
+}
+
+// WebhookIssueResponse is responded by the external CA.
+type WebhookIssueResponse struct {
+	// Cert is the PEM encoded cert.
+	Cert string `json:"cert"`
+}
+
+// webhookIssuer asks an external CA to sign the certs.
+type webhookIssuer struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+func newWebhookIssuer(conf *IssuerConfig) (Issuer, error) {
+	webhook := conf.Issuer.Webhook
+	timeout := defaultWebhookTimeout
+	if webhook.TimeoutSeconds > 0 {
+		timeout = time.Duration(webhook.TimeoutSeconds) * time.Second
+	}
+	return &webhookIssuer{
+		endpoint: webhook.Endpoint,
+		token:    webhook.Token,
+		client:   &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (i *webhookIssuer) Issue(ctx context.Context, template *x509.Certificate, key *rsa.PrivateKey) (*x509.Certificate, error) {
+	publicKeyDer, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	issueReq := &WebhookIssueRequest{
+		PublicKey:       string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDer})),
+		CommonName:      template.Subject.CommonName,
+		Organization:    template.Subject.Organization,
+		DNSNames:        template.DNSNames,
+		IsCA:            template.IsCA,
+		ValiditySeconds: int64(template.NotAfter.Sub(template.NotBefore).Seconds()),
+	}
+	for _, ip := range template.IPAddresses {
+		issueReq.IPAddresses = append(issueReq.IPAddresses, ip.String())
+	}
+	body, err := json.Marshal(issueReq)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if i.token != "" {
+		req.Header.Set("Authorization", "Bearer "+i.token)
+	}
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("cert webhook returns unexpected status code %d, body: %s", resp.StatusCode, respBody)
+	}
+	issueResp := &WebhookIssueResponse{}
+	if err := json.Unmarshal(respBody, issueResp); err != nil {
+		return nil, fmt.Errorf("decode cert webhook response failed, %v", err)
+	}
+	cert, err := tlsutils.ParseCertData([]byte(issueResp.Cert))
+	if err != nil {
+		return nil, fmt.Errorf("parse cert from webhook failed, %v", err)
+	}
+	if !key.PublicKey.Equal(cert.PublicKey) {
+		return nil, fmt.Errorf("public key of the cert from webhook mismatches")
+	}
+	return cert, nil
+}
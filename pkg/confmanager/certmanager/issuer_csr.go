@@ -0,0 +1,135 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certmanager
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/certificate/csr"
+
+	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+const (
+	defaultCSRTimeout = 5 * time.Minute
+	// certManagerRequestIsCA asks cert-manager to issue a CA cert, since the usages of the kubernetes CSR can't.
+	certManagerRequestIsCA = "experimental.cert-manager.io/request-is-ca"
+)
+
+// csrIssuer requests the certs by the kubernetes CertificateSigningRequest API. The requests are signed by the
+// cert-manager issuer of the signer name once they are approved, e.g. by the approver-policy of cert-manager.
+type csrIssuer struct {
+	client     kubernetes.Interface
+	signerName string
+	timeout    time.Duration
+}
+
+func newCSRIssuer(conf *IssuerConfig) (Issuer, error) {
+	certManager := conf.Issuer.CertManager
+	restConfig, err := kubeconfig.BuildClientConfigFromKubeconfig(certManager.KubeconfigFile, "")
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	timeout := defaultCSRTimeout
+	if certManager.TimeoutSeconds > 0 {
+		timeout = time.Duration(certManager.TimeoutSeconds) * time.Second
+	}
+	return &csrIssuer{client: client, signerName: certManager.SignerName, timeout: timeout}, nil
+}
+
+func (i *csrIssuer) Issue(ctx context.Context, template *x509.Certificate, key *rsa.PrivateKey) (*x509.Certificate, error) {
+	csrDer, err := createCertificateRequest(template, key)
+	if err != nil {
+		return nil, err
+	}
+	req := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: "kuscia-"},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:           pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDer}),
+			SignerName:        i.signerName,
+			ExpirationSeconds: csr.DurationToExpirationSeconds(template.NotAfter.Sub(template.NotBefore)),
+			Usages:            csrKeyUsages(template),
+		},
+	}
+	if template.IsCA {
+		req.Annotations = map[string]string{certManagerRequestIsCA: "true"}
+	}
+	req, err = i.client.CertificatesV1().CertificateSigningRequests().Create(ctx, req, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("create certificate signing request failed, %v", err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+	certData, err := csr.WaitForCertificate(waitCtx, i.client, req.Name, req.UID)
+	if err != nil {
+		return nil, fmt.Errorf("wait for certificate signing request %s failed, %v", req.Name, err)
+	}
+	cert, err := tlsutils.ParseCertData(certData)
+	if err != nil {
+		return nil, err
+	}
+	if !key.PublicKey.Equal(cert.PublicKey) {
+		return nil, fmt.Errorf("public key of the cert of certificate signing request %s mismatches", req.Name)
+	}
+	return cert, nil
+}
+
+func csrKeyUsages(template *x509.Certificate) []certificatesv1.KeyUsage {
+	var usages []certificatesv1.KeyUsage
+	if template.KeyUsage&x509.KeyUsageDigitalSignature != 0 {
+		usages = append(usages, certificatesv1.UsageDigitalSignature)
+	}
+	if template.KeyUsage&x509.KeyUsageKeyEncipherment != 0 {
+		usages = append(usages, certificatesv1.UsageKeyEncipherment)
+	}
+	if template.KeyUsage&x509.KeyUsageCertSign != 0 {
+		usages = append(usages, certificatesv1.UsageCertSign)
+	}
+	for _, usage := range template.ExtKeyUsage {
+		switch usage {
+		case x509.ExtKeyUsageServerAuth:
+			usages = append(usages, certificatesv1.UsageServerAuth)
+		case x509.ExtKeyUsageClientAuth:
+			usages = append(usages, certificatesv1.UsageClientAuth)
+		}
+	}
+	return usages
+}
+
+// createCertificateRequest creates the PKCS#10 request of template signed by key.
+func createCertificateRequest(template *x509.Certificate, key *rsa.PrivateKey) ([]byte, error) {
+	return x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:        template.Subject,
+		DNSNames:       template.DNSNames,
+		IPAddresses:    template.IPAddresses,
+		EmailAddresses: template.EmailAddresses,
+		URIs:           template.URIs,
+	}, key)
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certmanager
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const defaultESTTimeout = 30 * time.Second
+
+var oidSignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+
+// estIssuer enrolls the certs by the simpleenroll operation of EST(RFC 7030). The ACME CAs can be used through
+// an EST proxy, since the challenges of ACME can't be solved by kuscia.
+type estIssuer struct {
+	endpoint string
+	username string
+	password string
+	client   *http.Client
+}
+
+func newESTIssuer(conf *IssuerConfig) (Issuer, error) {
+	est := conf.Issuer.EST
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if est.CAFile != "" {
+		caData, err := os.ReadFile(est.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("invalid est ca file %s", est.CAFile)
+		}
+	}
+	timeout := defaultESTTimeout
+	if est.TimeoutSeconds > 0 {
+		timeout = time.Duration(est.TimeoutSeconds) * time.Second
+	}
+	return &estIssuer{
+		endpoint: strings.TrimSuffix(est.Endpoint, "/"),
+		username: est.Username,
+		password: est.Password,
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+func (i *estIssuer) Issue(ctx context.Context, template *x509.Certificate, key *rsa.PrivateKey) (*x509.Certificate, error) {
+	csrDer, err := createCertificateRequest(template, key)
+	if err != nil {
+		return nil, err
+	}
+	body := base64.StdEncoding.EncodeToString(csrDer)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.endpoint+"/simpleenroll", strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/pkcs10")
+	req.Header.Set("Content-Transfer-Encoding", "base64")
+	if i.username != "" {
+		req.SetBasicAuth(i.username, i.password)
+	}
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusAccepted:
+		return nil, fmt.Errorf("est enrollment is pending for manual approval, retry after %q", resp.Header.Get("Retry-After"))
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("est server returns unexpected status code %d, body: %s", resp.StatusCode, respBody)
+	}
+
+	p7Der, err := base64.StdEncoding.DecodeString(string(bytes.Join(bytes.Fields(respBody), nil)))
+	if err != nil {
+		return nil, fmt.Errorf("decode est response failed, %v", err)
+	}
+	certs, err := parseCertsOnlyPKCS7(p7Der)
+	if err != nil {
+		return nil, fmt.Errorf("parse est response failed, %v", err)
+	}
+	// the issued cert is the one of the key, the others are the chain
+	for _, cert := range certs {
+		if key.PublicKey.Equal(cert.PublicKey) {
+			return cert, nil
+		}
+	}
+	return nil, fmt.Errorf("can not find the cert of the key in est response")
+}
+
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	ContentInfo      pkcs7ContentInfo
+	Certificates     asn1.RawValue   `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue   `asn1:"optional,tag:1"`
+	SignerInfos      []asn1.RawValue `asn1:"set"`
+}
+
+// parseCertsOnlyPKCS7 parses the certs of the degenerate certs-only PKCS#7 responded by EST servers.
+func parseCertsOnlyPKCS7(data []byte) ([]*x509.Certificate, error) {
+	contentInfo := &pkcs7ContentInfo{}
+	if _, err := asn1.Unmarshal(data, contentInfo); err != nil {
+		return nil, err
+	}
+	if !contentInfo.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("unexpected pkcs7 content type %s", contentInfo.ContentType)
+	}
+	signedData := &pkcs7SignedData{}
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, signedData); err != nil {
+		return nil, err
+	}
+	if len(signedData.Certificates.Bytes) == 0 {
+		return nil, fmt.Errorf("no cert in pkcs7")
+	}
+	return x509.ParseCertificates(signedData.Certificates.Bytes)
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certmanager
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+type testCA struct {
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	return &testCA{key: key, cert: createCert(t, "external-ca", true, &key.PublicKey, nil, key, time.Now(), time.Hour)}
+}
+
+func (ca *testCA) signRequest(t *testing.T, csrDer []byte, validity time.Duration) *x509.Certificate {
+	req, err := x509.ParseCertificateRequest(csrDer)
+	assert.NoError(t, err)
+	assert.NoError(t, req.CheckSignature())
+	return createCert(t, req.Subject.CommonName, false, req.PublicKey.(*rsa.PublicKey), ca.cert, ca.key, time.Now(), validity)
+}
+
+func TestSelfSignedIssuer(t *testing.T) {
+	domainKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	issuer, err := NewIssuer(&IssuerConfig{DomainKey: domainKey})
+	assert.NoError(t, err)
+
+	domainCert, err := issuer.Issue(context.Background(), tlsutils.DomainCertTemplate("alice"), domainKey)
+	assert.NoError(t, err)
+	assert.NoError(t, domainCert.CheckSignatureFrom(domainCert))
+
+	// the other certs are signed by the domain cert
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	_, err = issuer.Issue(context.Background(), tlsutils.ServerCertTemplate("alice_ENVOY_EXTERNAL"), key)
+	assert.ErrorContains(t, err, "can not find domain cert")
+}
+
+func TestWebhookIssuer(t *testing.T) {
+	ca := newTestCA(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		req := &WebhookIssueRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		block, _ := pem.Decode([]byte(req.PublicKey))
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		assert.NoError(t, err)
+		cert := createCert(t, req.CommonName, req.IsCA, publicKey.(*rsa.PublicKey), ca.cert, ca.key, time.Now(),
+			time.Duration(req.ValiditySeconds)*time.Second)
+		certEncoded, _ := tlsutils.EncodeCert(cert)
+		json.NewEncoder(w).Encode(&WebhookIssueResponse{Cert: certEncoded})
+	}))
+	defer server.Close()
+
+	issuer, err := NewIssuer(&IssuerConfig{Issuer: &kusciaconfig.CertIssuerConfig{
+		Type:    kusciaconfig.CertIssuerWebhook,
+		Webhook: &kusciaconfig.WebhookConfig{Endpoint: server.URL, Token: "secret"},
+	}})
+	assert.NoError(t, err)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	now := time.Now()
+	cert, err := issuer.Issue(context.Background(), &x509.Certificate{
+		Subject:   pkix.Name{CommonName: "alice"},
+		NotBefore: now,
+		NotAfter:  now.Add(time.Hour),
+	}, key)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", cert.Subject.CommonName)
+	assert.NoError(t, cert.CheckSignatureFrom(ca.cert))
+
+	_, err = NewIssuer(&IssuerConfig{Issuer: &kusciaconfig.CertIssuerConfig{Type: "vault"}})
+	assert.ErrorContains(t, err, "unknown cert issuer")
+}
+
+func TestCSRIssuer(t *testing.T) {
+	ca := newTestCA(t)
+	client := kubefake.NewSimpleClientset()
+	// the fake clientset doesn't generate names
+	client.PrependReactor("create", "certificatesigningrequests", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		req := action.(clienttesting.CreateAction).GetObject().(*certificatesv1.CertificateSigningRequest)
+		req.Name = req.GenerateName + "test"
+		return false, nil, nil
+	})
+	// approve and sign the request like cert-manager
+	go wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 10*time.Second, true,
+		func(ctx context.Context) (bool, error) {
+			reqs, err := client.CertificatesV1().CertificateSigningRequests().List(ctx, metav1.ListOptions{})
+			if err != nil || len(reqs.Items) == 0 {
+				return false, nil
+			}
+			req := reqs.Items[0].DeepCopy()
+			assert.Equal(t, "issuers.cert-manager.io/kuscia.ca", req.Spec.SignerName)
+			assert.Equal(t, "true", req.Annotations[certManagerRequestIsCA])
+			block, _ := pem.Decode(req.Spec.Request)
+			cert := ca.signRequest(t, block.Bytes, time.Duration(*req.Spec.ExpirationSeconds)*time.Second)
+			certEncoded, _ := tlsutils.EncodeCert(cert)
+			req.Status.Certificate = []byte(certEncoded)
+			req.Status.Conditions = []certificatesv1.CertificateSigningRequestCondition{{
+				Type:   certificatesv1.CertificateApproved,
+				Status: corev1.ConditionTrue,
+			}}
+			_, err = client.CertificatesV1().CertificateSigningRequests().UpdateStatus(ctx, req, metav1.UpdateOptions{})
+			return err == nil, nil
+		})
+
+	issuer := &csrIssuer{client: client, signerName: "issuers.cert-manager.io/kuscia.ca", timeout: 10 * time.Second}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	cert, err := issuer.Issue(context.Background(), tlsutils.DomainCertTemplate("alice"), key)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", cert.Subject.CommonName)
+	assert.NoError(t, cert.CheckSignatureFrom(ca.cert))
+}
+
+func TestESTIssuer(t *testing.T) {
+	ca := newTestCA(t)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/.well-known/est/simpleenroll", r.URL.Path)
+		assert.Equal(t, "application/pkcs10", r.Header.Get("Content-Type"))
+		username, password, _ := r.BasicAuth()
+		assert.Equal(t, "alice", username)
+		assert.Equal(t, "secret", password)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		csrDer, err := base64.StdEncoding.DecodeString(string(body))
+		assert.NoError(t, err)
+		cert := ca.signRequest(t, csrDer, time.Hour)
+
+		signedData, err := asn1.Marshal(pkcs7SignedData{
+			Version:          1,
+			DigestAlgorithms: []pkix.AlgorithmIdentifier{},
+			ContentInfo:      pkcs7ContentInfo{ContentType: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}},
+			Certificates: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true,
+				Bytes: append(ca.cert.Raw, cert.Raw...)},
+			SignerInfos: []asn1.RawValue{},
+		})
+		assert.NoError(t, err)
+		p7, err := asn1.Marshal(pkcs7ContentInfo{
+			ContentType: oidSignedData,
+			Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedData},
+		})
+		assert.NoError(t, err)
+		w.Header().Set("Content-Type", "application/pkcs7-mime; smime-type=certs-only")
+		w.Write([]byte(base64.StdEncoding.EncodeToString(p7)))
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "est-ca.crt")
+	assert.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0644))
+	issuer, err := NewIssuer(&IssuerConfig{Issuer: &kusciaconfig.CertIssuerConfig{
+		Type: kusciaconfig.CertIssuerEST,
+		EST: &kusciaconfig.ESTIssuerConfig{
+			Endpoint: server.URL + "/.well-known/est/",
+			Username: "alice",
+			Password: "secret",
+			CAFile:   caFile,
+		},
+	}})
+	assert.NoError(t, err)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	cert, err := issuer.Issue(context.Background(), tlsutils.ServerCertTemplate("alice_ENVOY_EXTERNAL"), key)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice_ENVOY_EXTERNAL", cert.Subject.CommonName)
+	assert.NoError(t, cert.CheckSignatureFrom(ca.cert))
+}
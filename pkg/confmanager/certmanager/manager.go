@@ -0,0 +1,215 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certmanager
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+type ManagerConfig struct {
+	DomainID        string
+	DomainKey       *rsa.PrivateKey
+	DomainCertValue *atomic.Value
+	// DomainCertFile is rewritten with the renewed domain cert. It's empty if the domain cert isn't renewed
+	// locally, e.g. the domain cert of lite is issued by the master when it registers.
+	DomainCertFile string
+	// RenewRouteCerts renews the mTLS client certs of the ClusterDomainRoutes whose destination is this
+	// domain, since they are issued by the destination.
+	RenewRouteCerts bool
+	KusciaClient    kusciaclientset.Interface
+	Renewal         *kusciaconfig.CertRenewalConfig
+	Issuer          *kusciaconfig.CertIssuerConfig
+}
+
+// Manager tracks the expiration of the domain cert and the mTLS certs of the routes, and renews them ahead
+// of expiration. The gateways reload the renewed route certs through xds when the routes are updated.
+type Manager struct {
+	conf   *ManagerConfig
+	issuer Issuer
+	now    func() time.Time
+}
+
+func NewManager(conf *ManagerConfig) (*Manager, error) {
+	if err := kusciaconfig.CheckCertRenewalConfig(conf.Renewal); err != nil {
+		return nil, err
+	}
+	issuer, err := NewIssuer(&IssuerConfig{
+		DomainKey:       conf.DomainKey,
+		DomainCertValue: conf.DomainCertValue,
+		Issuer:          conf.Issuer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{conf: conf, issuer: issuer, now: time.Now}, nil
+}
+
+func (m *Manager) Run(ctx context.Context) {
+	nlog.Infof("Cert manager started, issuer: %s, check interval: %s, renew before: %s", m.conf.Issuer.TypeName(),
+		m.conf.Renewal.CheckInterval(), m.conf.Renewal.RenewBefore())
+	ticker := time.NewTicker(m.conf.Renewal.CheckInterval())
+	defer ticker.Stop()
+	for {
+		m.check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Manager) check(ctx context.Context) {
+	if err := m.renewDomainCert(ctx); err != nil {
+		nlog.Warnf("Renew domain cert of %s failed, %v", m.conf.DomainID, err)
+	}
+	if m.conf.RenewRouteCerts {
+		m.renewRouteCerts(ctx)
+	}
+}
+
+func (m *Manager) renewDomainCert(ctx context.Context) error {
+	if m.conf.DomainCertFile == "" || m.conf.DomainCertValue == nil {
+		return nil
+	}
+	cert, ok := m.conf.DomainCertValue.Load().(*x509.Certificate)
+	if !ok || cert == nil || !tlsutils.NeedRenew(cert, m.conf.Renewal.RenewBefore(), m.now()) {
+		return nil
+	}
+
+	newCert, err := m.issuer.Issue(ctx, tlsutils.RenewalTemplate(cert, m.now()), m.conf.DomainKey)
+	if err != nil {
+		return err
+	}
+	if err := tlsutils.WriteX509CertToFile(newCert, m.conf.DomainCertFile); err != nil {
+		return err
+	}
+	m.conf.DomainCertValue.Store(newCert)
+	nlog.Infof("Renew domain cert of %s, it expires at %s", m.conf.DomainID, newCert.NotAfter.Format(time.RFC3339))
+
+	// the domain cert is published in the domain, so that the peers encrypt with the new cert
+	certEncoded, err := tlsutils.EncodeCert(newCert)
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		domain, err := m.conf.KusciaClient.KusciaV1alpha1().Domains().Get(ctx, m.conf.DomainID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !m.isDomainCert(domain.Spec.Cert) {
+			return nil
+		}
+		domain = domain.DeepCopy()
+		domain.Spec.Cert = base64.StdEncoding.EncodeToString([]byte(certEncoded))
+		_, err = m.conf.KusciaClient.KusciaV1alpha1().Domains().Update(ctx, domain, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// isDomainCert reports whether the cert of the domain resource is issued for the domain key.
+func (m *Manager) isDomainCert(encodedCert string) bool {
+	cert, err := decodeCert(encodedCert)
+	if err != nil {
+		return false
+	}
+	return m.conf.DomainKey.PublicKey.Equal(cert.PublicKey)
+}
+
+func (m *Manager) renewRouteCerts(ctx context.Context) {
+	cdrs, err := m.conf.KusciaClient.KusciaV1alpha1().ClusterDomainRoutes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		nlog.Warnf("List cluster domain routes failed, %v", err)
+		return
+	}
+	for i := range cdrs.Items {
+		cdr := &cdrs.Items[i]
+		if cdr.Spec.Destination != m.conf.DomainID || cdr.Spec.AuthenticationType != kusciaapisv1alpha1.DomainAuthenticationMTLS ||
+			cdr.Spec.MTLSConfig == nil || cdr.Spec.MTLSConfig.SourceClientCert == "" {
+			continue
+		}
+		if err := m.renewRouteCert(ctx, cdr); err != nil {
+			nlog.Warnf("Renew mTLS cert of cdr %s failed, %v", cdr.Name, err)
+		}
+	}
+}
+
+func (m *Manager) renewRouteCert(ctx context.Context, cdr *kusciaapisv1alpha1.ClusterDomainRoute) error {
+	oldCert := cdr.Spec.MTLSConfig.SourceClientCert
+	cert, err := decodeCert(oldCert)
+	if err != nil {
+		return err
+	}
+	if !tlsutils.NeedRenew(cert, m.conf.Renewal.RenewBefore(), m.now()) {
+		return nil
+	}
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("public key of the source client cert must be RSA")
+	}
+
+	// the route certs are always signed by the domain cert, since the gateway of destination verifies them with it
+	newCert, err := signWithDomainCert(m.conf.DomainCertValue, m.conf.DomainKey, tlsutils.RenewalTemplate(cert, m.now()), publicKey)
+	if err != nil {
+		return err
+	}
+	certEncoded, err := tlsutils.EncodeCert(newCert)
+	if err != nil {
+		return err
+	}
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := m.conf.KusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(ctx, cdr.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		// the cert has been replaced by others
+		if latest.Spec.MTLSConfig == nil || latest.Spec.MTLSConfig.SourceClientCert != oldCert {
+			return nil
+		}
+		latest = latest.DeepCopy()
+		latest.Spec.MTLSConfig.SourceClientCert = base64.StdEncoding.EncodeToString([]byte(certEncoded))
+		_, err = m.conf.KusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Update(ctx, latest, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	nlog.Infof("Renew mTLS cert of cdr %s, it expires at %s", cdr.Name, newCert.NotAfter.Format(time.RFC3339))
+	return nil
+}
+
+// decodeCert decodes the base64 encoded PEM cert of the resources.
+func decodeCert(encodedCert string) (*x509.Certificate, error) {
+	certData, err := base64.StdEncoding.DecodeString(encodedCert)
+	if err != nil {
+		return nil, err
+	}
+	return tlsutils.ParseCertData(certData)
+}
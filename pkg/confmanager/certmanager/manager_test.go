@@ -0,0 +1,146 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certmanager
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+func createCert(t *testing.T, commonName string, isCA bool, publicKey *rsa.PublicKey, parent *x509.Certificate,
+	signKey *rsa.PrivateKey, notBefore time.Time, validity time.Duration) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent = template
+	}
+	certRaw, err := x509.CreateCertificate(rand.Reader, template, parent, publicKey, signKey)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(certRaw)
+	assert.NoError(t, err)
+	return cert
+}
+
+func encodeCert(t *testing.T, cert *x509.Certificate) string {
+	certEncoded, err := tlsutils.EncodeCert(cert)
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString([]byte(certEncoded))
+}
+
+func TestManagerRenew(t *testing.T) {
+	now := time.Now()
+	domainKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	sourceKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	domainCert := createCert(t, "alice", true, &domainKey.PublicKey, nil, domainKey, now.Add(-71*time.Hour), 72*time.Hour)
+	routeCert := createCert(t, "bob", false, &sourceKey.PublicKey, domainCert, domainKey, now.Add(-71*time.Hour), 72*time.Hour)
+	// the cert of other destination isn't renewed
+	otherRouteCert := encodeCert(t, routeCert)
+
+	kusciaClient := kusciafake.NewSimpleClientset(
+		&kusciaapisv1alpha1.Domain{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+			Spec:       kusciaapisv1alpha1.DomainSpec{Cert: encodeCert(t, domainCert)},
+		},
+		&kusciaapisv1alpha1.ClusterDomainRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "bob-alice"},
+			Spec: kusciaapisv1alpha1.ClusterDomainRouteSpec{DomainRouteSpec: kusciaapisv1alpha1.DomainRouteSpec{
+				Source:             "bob",
+				Destination:        "alice",
+				AuthenticationType: kusciaapisv1alpha1.DomainAuthenticationMTLS,
+				MTLSConfig:         &kusciaapisv1alpha1.DomainRouteMTLSConfig{SourceClientCert: encodeCert(t, routeCert)},
+			}},
+		},
+		&kusciaapisv1alpha1.ClusterDomainRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice-bob"},
+			Spec: kusciaapisv1alpha1.ClusterDomainRouteSpec{DomainRouteSpec: kusciaapisv1alpha1.DomainRouteSpec{
+				Source:             "alice",
+				Destination:        "bob",
+				AuthenticationType: kusciaapisv1alpha1.DomainAuthenticationMTLS,
+				MTLSConfig:         &kusciaapisv1alpha1.DomainRouteMTLSConfig{SourceClientCert: otherRouteCert},
+			}},
+		},
+	)
+
+	certValue := &atomic.Value{}
+	certValue.Store(domainCert)
+	certFile := filepath.Join(t.TempDir(), "domain.crt")
+	m, err := NewManager(&ManagerConfig{
+		DomainID:        "alice",
+		DomainKey:       domainKey,
+		DomainCertValue: certValue,
+		DomainCertFile:  certFile,
+		RenewRouteCerts: true,
+		KusciaClient:    kusciaClient,
+		Renewal:         &kusciaconfig.CertRenewalConfig{Enable: true},
+	})
+	assert.NoError(t, err)
+	m.check(context.Background())
+
+	// the domain cert is renewed with the same key and validity period
+	newDomainCert := certValue.Load().(*x509.Certificate)
+	assert.True(t, newDomainCert.NotAfter.After(domainCert.NotAfter))
+	assert.Equal(t, 72*time.Hour, newDomainCert.NotAfter.Sub(newDomainCert.NotBefore))
+	assert.NoError(t, newDomainCert.CheckSignatureFrom(newDomainCert))
+	fileCert, err := tlsutils.ParseCertFromFile(certFile)
+	assert.NoError(t, err)
+	assert.Equal(t, newDomainCert.Raw, fileCert.Raw)
+	domain, err := kusciaClient.KusciaV1alpha1().Domains().Get(context.Background(), "alice", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, encodeCert(t, newDomainCert), domain.Spec.Cert)
+
+	// the route cert is renewed for the public key of source
+	cdr, err := kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(context.Background(), "bob-alice", metav1.GetOptions{})
+	assert.NoError(t, err)
+	newRouteCert, err := decodeCert(cdr.Spec.MTLSConfig.SourceClientCert)
+	assert.NoError(t, err)
+	assert.True(t, sourceKey.PublicKey.Equal(newRouteCert.PublicKey))
+	assert.True(t, newRouteCert.NotAfter.After(routeCert.NotAfter))
+	assert.NoError(t, newRouteCert.CheckSignatureFrom(newDomainCert))
+
+	cdr, err = kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(context.Background(), "alice-bob", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, otherRouteCert, cdr.Spec.MTLSConfig.SourceClientCert)
+
+	// nothing is renewed until the renewed certs expire
+	m.check(context.Background())
+	assert.Equal(t, newDomainCert, certValue.Load())
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/secretflow/kuscia/pkg/confmanager/bean"
+	"github.com/secretflow/kuscia/pkg/confmanager/certmanager"
 	"github.com/secretflow/kuscia/pkg/confmanager/config"
 	"github.com/secretflow/kuscia/pkg/confmanager/service"
 	"github.com/secretflow/kuscia/pkg/utils/meta"
@@ -36,6 +37,22 @@ func Run(ctx context.Context, conf *config.ConfManagerConfig) error {
 	if err := injectBean(ctx, conf, appEngine); err != nil {
 		return err
 	}
+	if conf.CertRenewal != nil && conf.CertRenewal.Enable && conf.DomainCertFile != "" {
+		certManager, err := certmanager.NewManager(&certmanager.ManagerConfig{
+			DomainID:        conf.DomainID,
+			DomainKey:       conf.DomainKey,
+			DomainCertValue: conf.DomainCertValue,
+			DomainCertFile:  conf.DomainCertFile,
+			RenewRouteCerts: true,
+			KusciaClient:    conf.KusciaClient,
+			Renewal:         conf.CertRenewal,
+			Issuer:          conf.CertIssuer,
+		})
+		if err != nil {
+			return fmt.Errorf("create cert manager failed, %v", err)
+		}
+		go certManager.Run(ctx)
+	}
 	return appEngine.Run(ctx)
 }
 
@@ -45,10 +62,11 @@ func injectBean(ctx context.Context, conf *config.ConfManagerConfig, appEngine *
 		DomainKey:       conf.DomainKey,
 	})
 	configService, err := service.NewConfigService(ctx, &service.ConfigServiceConfig{
-		DomainID:   conf.DomainID,
-		DomainKey:  conf.DomainKey,
-		Driver:     conf.Driver,
-		KubeClient: conf.KubeClient,
+		DomainID:      conf.DomainID,
+		DomainKey:     conf.DomainKey,
+		SecretBackend: conf.SecretBackend,
+		Driver:        conf.Driver,
+		KubeClient:    conf.KubeClient,
 	})
 	if err != nil {
 		return err
@@ -22,6 +22,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/secretflow/kuscia/pkg/confmanager/driver"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 	"github.com/secretflow/kuscia/pkg/web/errorcode"
 	"github.com/secretflow/kuscia/pkg/web/framework/config"
 )
@@ -38,10 +41,17 @@ type ConfManagerConfig struct {
 
 	DomainID        string                 `yaml:"-"`
 	DomainKey       *rsa.PrivateKey        `yaml:"-"`
+	SecretBackend   secretbackend.Backend  `yaml:"-"`
 	TLS             config.TLSServerConfig `yaml:"-"`
 	DomainCertValue *atomic.Value          `yaml:"-"`
 	IsMaster        bool                   `yaml:"-"`
 	KubeClient      kubernetes.Interface   `yaml:"-"`
+
+	// CertRenewal renews the domain cert and the mTLS certs of routes, only in autonomy mode.
+	CertRenewal    *kusciaconfig.CertRenewalConfig `yaml:"-"`
+	CertIssuer     *kusciaconfig.CertIssuerConfig  `yaml:"-"`
+	DomainCertFile string                          `yaml:"-"`
+	KusciaClient   kusciaclientset.Interface       `yaml:"-"`
 }
 
 type SAN struct {
@@ -25,9 +25,9 @@ import (
 	"k8s.io/client-go/informers"
 	listers "k8s.io/client-go/listers/core/v1"
 
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/resources"
-	"github.com/secretflow/kuscia/pkg/utils/tls"
 )
 
 const (
@@ -43,6 +43,7 @@ type CRDDriver struct {
 	Ctx context.Context
 	*Config
 	ConfigMapLister listers.ConfigMapLister
+	sealer          *secretbackend.Sealer
 }
 
 func NewCRDDriver(ctx context.Context, conf *Config) (Driver, error) {
@@ -59,6 +60,7 @@ func newCRDDriver(ctx context.Context, conf *Config) (*CRDDriver, error) {
 		Ctx:    ctx,
 		Config: conf,
 	}
+	driver.sealer = secretbackend.NewSealer(conf.DomainKey, conf.SecretBackend)
 
 	if conf.KubeClient == nil {
 		return nil, fmt.Errorf("kubeclient can't be empty for cm crd driver")
@@ -95,12 +97,12 @@ func (d *CRDDriver) GetConfig(ctx context.Context, key string) (string, bool, er
 		return "", true, nil
 	}
 
-	value, err := tls.DecryptOAEP(d.DomainKey, encValue)
+	value, err := d.decrypt(ctx, encValue)
 	if err != nil {
 		return "", true, err
 	}
 
-	return string(value), true, nil
+	return value, true, nil
 }
 
 func (d *CRDDriver) SetConfig(ctx context.Context, data map[string]string) error {
@@ -126,7 +128,7 @@ func (d *CRDDriver) SetConfig(ctx context.Context, data map[string]string) error
 		if len(key) > maxKeyLen {
 			return fmt.Errorf("key[%v] length is %v bytes and exceed the max length %v bytes", key, len(key), maxKeyLen)
 		}
-		encValue, err := tls.EncryptOAEP(&d.DomainKey.PublicKey, []byte(value))
+		encValue, err := d.encrypt(ctx, value)
 		if err != nil {
 			return err
 		}
@@ -154,11 +156,11 @@ func (d *CRDDriver) ListConfig(ctx context.Context, keys []string) (map[string]s
 	for _, key := range keys {
 		encValue := cm.Data[key]
 		if encValue != "" {
-			value, err := tls.DecryptOAEP(d.DomainKey, encValue)
+			value, err := d.decrypt(ctx, encValue)
 			if err != nil {
 				return nil, err
 			}
-			result[key] = string(value)
+			result[key] = value
 		} else {
 			result[key] = encValue
 		}
@@ -195,6 +197,15 @@ func (d *CRDDriver) getConfigMap(ctx context.Context) (*v1.ConfigMap, error) {
 	return d.KubeClient.CoreV1().ConfigMaps(d.DomainID).Get(ctx, d.ConfigName, metav1.GetOptions{})
 }
 
+func (d *CRDDriver) encrypt(ctx context.Context, value string) (string, error) {
+	return d.sealer.Encrypt(ctx, []byte(value))
+}
+
+func (d *CRDDriver) decrypt(ctx context.Context, encValue string) (string, error) {
+	value, err := d.sealer.Decrypt(ctx, encValue)
+	return string(value), err
+}
+
 func checkDataSize(data map[string]string) error {
 	v, err := json.Marshal(data)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/base64"
 	"strings"
 	"testing"
 
@@ -26,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	"github.com/secretflow/kuscia/pkg/utils/tls"
 )
 
@@ -154,3 +156,37 @@ func TestCheckDataSize(t *testing.T) {
 	got := checkDataSize(data)
 	assert.NoError(t, got)
 }
+
+type fakeSecretBackend struct{}
+
+func (f *fakeSecretBackend) Seal(ctx context.Context, plaintext []byte) (string, error) {
+	return "fake:" + base64.StdEncoding.EncodeToString(plaintext), nil
+}
+
+func (f *fakeSecretBackend) Unseal(ctx context.Context, ciphertext string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.TrimPrefix(ciphertext, "fake:"))
+}
+
+func TestSecretBackend(t *testing.T) {
+	d, err := makeNewCRDDriver(true)
+	assert.NoError(t, err)
+	d.sealer = secretbackend.NewSealer(d.DomainKey, &fakeSecretBackend{})
+
+	assert.NoError(t, d.SetConfig(d.Ctx, map[string]string{testKey1: "value"}))
+	cm, err := d.getConfigMap(d.Ctx)
+	assert.NoError(t, err)
+	assert.True(t, secretbackend.IsSealed(cm.Data[testKey1]))
+
+	value, exist, err := d.GetConfig(d.Ctx, testKey1)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, "value", value)
+	// the value encrypted by the domain key before the secret backend is enabled
+	value, _, err = d.GetConfig(d.Ctx, testKey)
+	assert.NoError(t, err)
+	assert.Equal(t, testValue, value)
+
+	d.sealer = secretbackend.NewSealer(d.DomainKey, nil)
+	_, _, err = d.GetConfig(d.Ctx, testKey1)
+	assert.Error(t, err)
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 
 	"k8s.io/client-go/kubernetes"
+
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 )
 
 type Driver interface {
@@ -43,6 +45,8 @@ type Config struct {
 	DisableCache bool
 	KubeClient   kubernetes.Interface
 	DomainKey    *rsa.PrivateKey
+	// SecretBackend seals the configs by an external secret manager instead of the domain key if it's not nil.
+	SecretBackend secretbackend.Backend
 }
 
 func NewDriver(ctx context.Context, conf *Config) (Driver, error) {
@@ -0,0 +1,132 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbackend
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Backend is the plugin of an enterprise secret manager. The master key never leaves the secret manager, the
+// secrets are sealed and unsealed by it. The backends are registered by RegisterBackend and selected by the type
+// of the secretBackend config.
+type Backend interface {
+	// Seal encrypts the plaintext by the master key of the secret manager.
+	Seal(ctx context.Context, plaintext []byte) (string, error)
+	// Unseal decrypts the ciphertext returned by Seal.
+	Unseal(ctx context.Context, ciphertext string) ([]byte, error)
+}
+
+type BackendFactory func(conf *kusciaconfig.SecretBackendConfig) (Backend, error)
+
+var (
+	backendLock      sync.RWMutex
+	backendFactories = map[string]BackendFactory{
+		kusciaconfig.SecretBackendVault: newVaultBackend,
+		kusciaconfig.SecretBackendKMS:   newKMSBackend,
+	}
+)
+
+// RegisterBackend registers the backend of a secret manager.
+func RegisterBackend(name string, factory BackendFactory) {
+	backendLock.Lock()
+	defer backendLock.Unlock()
+	backendFactories[name] = factory
+}
+
+// NewBackend returns the backend selected by conf, nil if the secrets are sealed by the local domain key.
+func NewBackend(conf *kusciaconfig.SecretBackendConfig) (Backend, error) {
+	if err := kusciaconfig.CheckSecretBackendConfig(conf); err != nil {
+		return nil, err
+	}
+	if !conf.IsExternal() {
+		return nil, nil
+	}
+	name := conf.TypeName()
+	backendLock.RLock()
+	factory, ok := backendFactories[name]
+	backendLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown secret backend %q", name)
+	}
+	return factory(conf)
+}
+
+func newHTTPClient(caFile string, timeoutSeconds int) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		caData, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("invalid secret backend ca file %s", caFile)
+		}
+	}
+	timeout := defaultTimeout
+	if timeoutSeconds > 0 {
+		timeout = time.Duration(timeoutSeconds) * time.Second
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment},
+	}, nil
+}
+
+// postJSON posts reqBody to url and decodes the json response into respBody.
+func postJSON(ctx context.Context, client *http.Client, url string, header http.Header, reqBody, respBody any) error {
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s returns unexpected status code %d, body: %s", url, resp.StatusCode, data)
+	}
+	if err := json.Unmarshal(data, respBody); err != nil {
+		return fmt.Errorf("decode response of %s failed, %v", url, err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbackend
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+func TestNewBackend(t *testing.T) {
+	backend, err := NewBackend(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, backend)
+
+	_, err = NewBackend(&kusciaconfig.SecretBackendConfig{Type: kusciaconfig.SecretBackendVault})
+	assert.Error(t, err)
+	_, err = NewBackend(&kusciaconfig.SecretBackendConfig{Type: kusciaconfig.SecretBackendKMS,
+		KMS: &kusciaconfig.KMSBackendConfig{Endpoint: "kms.example.com", KeyID: "key"}})
+	assert.Error(t, err)
+	_, err = NewBackend(&kusciaconfig.SecretBackendConfig{Type: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestVaultBackend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" || r.Header.Get("X-Vault-Namespace") != "kuscia" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		req := &vaultTransitRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		resp := &vaultTransitResponse{}
+		switch r.URL.Path {
+		case "/v1/transit/encrypt/alice":
+			resp.Data.Ciphertext = "vault:v1:" + req.Plaintext
+		case "/v1/transit/decrypt/alice":
+			resp.Data.Plaintext = strings.TrimPrefix(req.Ciphertext, "vault:v1:")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer server.Close()
+
+	conf := &kusciaconfig.SecretBackendConfig{
+		Type:  kusciaconfig.SecretBackendVault,
+		Vault: &kusciaconfig.VaultBackendConfig{Address: server.URL, Token: "root", Namespace: "kuscia", KeyName: "alice"},
+	}
+	backend, err := NewBackend(conf)
+	assert.NoError(t, err)
+	sealed, err := backend.Seal(context.Background(), []byte("secret"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, "vault:v1:"))
+	plaintext, err := backend.Unseal(context.Background(), sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", string(plaintext))
+
+	conf.Vault.Token = "invalid"
+	backend, err = NewBackend(conf)
+	assert.NoError(t, err)
+	_, err = backend.Seal(context.Background(), []byte("secret"))
+	assert.Error(t, err)
+}
+
+// fakeKMS seals the secrets by encoding them, and counts the calls.
+type fakeKMS struct {
+	seals   int
+	unseals int
+}
+
+func (f *fakeKMS) Seal(ctx context.Context, plaintext []byte) (string, error) {
+	f.seals++
+	return "kms:" + base64.StdEncoding.EncodeToString(plaintext), nil
+}
+
+func (f *fakeKMS) Unseal(ctx context.Context, ciphertext string) ([]byte, error) {
+	f.unseals++
+	return base64.StdEncoding.DecodeString(strings.TrimPrefix(ciphertext, "kms:"))
+}
+
+func TestKMSBackend(t *testing.T) {
+	kms := &fakeKMS{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/encrypt":
+			req := &KMSEncryptRequest{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			assert.Equal(t, "key-1", req.KeyID)
+			ciphertext, _ := kms.Seal(r.Context(), []byte(req.Plaintext))
+			assert.NoError(t, json.NewEncoder(w).Encode(&KMSEncryptResponse{Ciphertext: ciphertext}))
+		case "/decrypt":
+			req := &KMSDecryptRequest{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+			plaintext, _ := kms.Unseal(r.Context(), req.Ciphertext)
+			assert.NoError(t, json.NewEncoder(w).Encode(&KMSDecryptResponse{Plaintext: string(plaintext)}))
+		}
+	}))
+	defer server.Close()
+
+	backend, err := NewBackend(&kusciaconfig.SecretBackendConfig{
+		Type: kusciaconfig.SecretBackendKMS,
+		KMS:  &kusciaconfig.KMSBackendConfig{Endpoint: server.URL + "/", KeyID: "key-1", Token: "token"},
+	})
+	assert.NoError(t, err)
+	sealed, err := backend.Seal(context.Background(), []byte("secret"))
+	assert.NoError(t, err)
+	plaintext, err := backend.Unseal(context.Background(), sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", string(plaintext))
+	assert.Equal(t, 1, kms.seals)
+	assert.Equal(t, 1, kms.unseals)
+}
+
+func TestEnvelope(t *testing.T) {
+	kms := &fakeKMS{}
+	envelope := NewEnvelope(kms)
+	value1, err := envelope.Encrypt(context.Background(), []byte("value-1"))
+	assert.NoError(t, err)
+	value2, err := envelope.Encrypt(context.Background(), []byte("value-2"))
+	assert.NoError(t, err)
+	assert.True(t, IsSealed(value1))
+	assert.False(t, IsSealed("value-1"))
+	// the data key is sealed once
+	assert.Equal(t, 1, kms.seals)
+
+	// another process unseals the data key once for all values
+	envelope = NewEnvelope(kms)
+	plaintext, err := envelope.Decrypt(context.Background(), value1)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-1", string(plaintext))
+	plaintext, err = envelope.Decrypt(context.Background(), value2)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-2", string(plaintext))
+	assert.Equal(t, 1, kms.unseals)
+
+	_, err = envelope.Decrypt(context.Background(), value1[:len(value1)-8])
+	assert.Error(t, err)
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbackend
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+const (
+	sealedValuePrefix = "sealed:v1:"
+	dataKeySize       = 32
+)
+
+type sealedValue struct {
+	// Key is the data key sealed by the backend.
+	Key string `json:"key"`
+	// Data is the AES-GCM nonce followed by the ciphertext.
+	Data []byte `json:"data"`
+}
+
+// Envelope encrypts the values by a data key with AES-GCM and seals the data key by the backend, so that the
+// secret manager is called once for a data key instead of for every value.
+type Envelope struct {
+	backend Backend
+
+	mu            sync.Mutex
+	dataKey       []byte
+	sealedDataKey string
+	// dataKeys caches the unsealed data keys by the sealed ones.
+	dataKeys map[string][]byte
+}
+
+func NewEnvelope(backend Backend) *Envelope {
+	return &Envelope{backend: backend, dataKeys: map[string][]byte{}}
+}
+
+// IsSealed reports whether the value is encrypted by an Envelope.
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedValuePrefix)
+}
+
+func (e *Envelope) Encrypt(ctx context.Context, plaintext []byte) (string, error) {
+	dataKey, sealedDataKey, err := e.currentDataKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(&sealedValue{Key: sealedDataKey, Data: aead.Seal(nonce, nonce, plaintext, nil)})
+	if err != nil {
+		return "", err
+	}
+	return sealedValuePrefix + base64.StdEncoding.EncodeToString(data), nil
+}
+
+func (e *Envelope) Decrypt(ctx context.Context, value string) ([]byte, error) {
+	if !IsSealed(value) {
+		return nil, fmt.Errorf("value is not sealed by the secret backend")
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, sealedValuePrefix))
+	if err != nil {
+		return nil, err
+	}
+	sv := &sealedValue{}
+	if err := json.Unmarshal(data, sv); err != nil {
+		return nil, err
+	}
+	dataKey, err := e.unsealDataKey(ctx, sv.Key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(sv.Data) < aead.NonceSize() {
+		return nil, fmt.Errorf("sealed value is too short")
+	}
+	nonce, ciphertext := sv.Data[:aead.NonceSize()], sv.Data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// currentDataKey generates the data key and seals it by the backend on first use.
+func (e *Envelope) currentDataKey(ctx context.Context) ([]byte, string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.dataKey != nil {
+		return e.dataKey, e.sealedDataKey, nil
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, "", err
+	}
+	sealedDataKey, err := e.backend.Seal(ctx, dataKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("seal data key failed, %v", err)
+	}
+	e.dataKey, e.sealedDataKey = dataKey, sealedDataKey
+	e.dataKeys[sealedDataKey] = dataKey
+	return dataKey, sealedDataKey, nil
+}
+
+func (e *Envelope) unsealDataKey(ctx context.Context, sealedDataKey string) ([]byte, error) {
+	e.mu.Lock()
+	dataKey, ok := e.dataKeys[sealedDataKey]
+	e.mu.Unlock()
+	if ok {
+		return dataKey, nil
+	}
+	dataKey, err := e.backend.Unseal(ctx, sealedDataKey)
+	if err != nil {
+		return nil, fmt.Errorf("unseal data key failed, %v", err)
+	}
+	if len(dataKey) != dataKeySize {
+		return nil, fmt.Errorf("unsealed data key size %d is invalid", len(dataKey))
+	}
+	e.mu.Lock()
+	e.dataKeys[sealedDataKey] = dataKey
+	e.mu.Unlock()
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbackend
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+// KMSEncryptRequest is posted to {endpoint}/encrypt of the KMS to seal a secret.
+type KMSEncryptRequest struct {
+	KeyID string `json:"keyID"`
+	// Plaintext is base64 encoded.
+	Plaintext string `json:"plaintext"`
+}
+
+type KMSEncryptResponse struct {
+	Ciphertext string `json:"ciphertext"`
+}
+
+// KMSDecryptRequest is posted to {endpoint}/decrypt of the KMS to unseal a secret.
+type KMSDecryptRequest struct {
+	KeyID      string `json:"keyID"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+type KMSDecryptResponse struct {
+	// Plaintext is base64 encoded.
+	Plaintext string `json:"plaintext"`
+}
+
+// kmsBackend seals the secrets by the master key of a cloud KMS, the cloud specific APIs are adapted to the
+// encrypt/decrypt json API by a gateway of the KMS.
+type kmsBackend struct {
+	endpoint string
+	keyID    string
+	header   http.Header
+	client   *http.Client
+}
+
+func newKMSBackend(conf *kusciaconfig.SecretBackendConfig) (Backend, error) {
+	kms := conf.KMS
+	client, err := newHTTPClient(kms.CAFile, kms.TimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+	header := http.Header{}
+	if kms.Token != "" {
+		header.Set("Authorization", "Bearer "+kms.Token)
+	}
+	return &kmsBackend{
+		endpoint: strings.TrimSuffix(kms.Endpoint, "/"),
+		keyID:    kms.KeyID,
+		header:   header,
+		client:   client,
+	}, nil
+}
+
+func (b *kmsBackend) Seal(ctx context.Context, plaintext []byte) (string, error) {
+	resp := &KMSEncryptResponse{}
+	req := &KMSEncryptRequest{KeyID: b.keyID, Plaintext: base64.StdEncoding.EncodeToString(plaintext)}
+	if err := postJSON(ctx, b.client, b.endpoint+"/encrypt", b.header, req, resp); err != nil {
+		return "", err
+	}
+	if resp.Ciphertext == "" {
+		return "", fmt.Errorf("kms returns empty ciphertext")
+	}
+	return resp.Ciphertext, nil
+}
+
+func (b *kmsBackend) Unseal(ctx context.Context, ciphertext string) ([]byte, error) {
+	resp := &KMSDecryptResponse{}
+	req := &KMSDecryptRequest{KeyID: b.keyID, Ciphertext: ciphertext}
+	if err := postJSON(ctx, b.client, b.endpoint+"/decrypt", b.header, req, resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
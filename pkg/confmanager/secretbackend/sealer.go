@@ -0,0 +1,63 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbackend
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+// Sealer encrypts the values by the secret backend if it's configured, otherwise by the domain key.
+type Sealer struct {
+	domainKey *rsa.PrivateKey
+	// envelope is nil if the secret backend isn't configured.
+	envelope *Envelope
+}
+
+func NewSealer(domainKey *rsa.PrivateKey, backend Backend) *Sealer {
+	s := &Sealer{domainKey: domainKey}
+	if backend != nil {
+		s.envelope = NewEnvelope(backend)
+	}
+	return s
+}
+
+func (s *Sealer) Encrypt(ctx context.Context, plaintext []byte) (string, error) {
+	if s.envelope != nil {
+		return s.envelope.Encrypt(ctx, plaintext)
+	}
+	if s.domainKey == nil {
+		return "", fmt.Errorf("neither the secret backend nor the domain key is configured")
+	}
+	return tls.EncryptOAEP(&s.domainKey.PublicKey, plaintext)
+}
+
+// Decrypt decrypts the value sealed by the secret backend or encrypted by the domain key, so that the values
+// stored before the secret backend is enabled are still readable.
+func (s *Sealer) Decrypt(ctx context.Context, value string) ([]byte, error) {
+	if IsSealed(value) {
+		if s.envelope == nil {
+			return nil, fmt.Errorf("value is sealed by the secret backend, but the secret backend isn't configured")
+		}
+		return s.envelope.Decrypt(ctx, value)
+	}
+	if s.domainKey == nil {
+		return nil, fmt.Errorf("domain key isn't configured")
+	}
+	return tls.DecryptOAEP(s.domainKey, value)
+}
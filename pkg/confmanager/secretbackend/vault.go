@@ -0,0 +1,100 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretbackend
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+const defaultVaultMount = "transit"
+
+// vaultBackend seals the secrets by the transit secrets engine of HashiCorp Vault.
+type vaultBackend struct {
+	encryptURL string
+	decryptURL string
+	header     http.Header
+	client     *http.Client
+}
+
+type vaultTransitRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultTransitResponse struct {
+	Data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"data"`
+}
+
+func newVaultBackend(conf *kusciaconfig.SecretBackendConfig) (Backend, error) {
+	vault := conf.Vault
+	token := vault.Token
+	if token == "" {
+		data, err := os.ReadFile(vault.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("read vault token file failed, %v", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	client, err := newHTTPClient(vault.CAFile, vault.TimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+	mount := strings.Trim(vault.Mount, "/")
+	if mount == "" {
+		mount = defaultVaultMount
+	}
+	header := http.Header{}
+	header.Set("X-Vault-Token", token)
+	if vault.Namespace != "" {
+		header.Set("X-Vault-Namespace", vault.Namespace)
+	}
+	address := strings.TrimSuffix(vault.Address, "/")
+	return &vaultBackend{
+		encryptURL: fmt.Sprintf("%s/v1/%s/encrypt/%s", address, mount, vault.KeyName),
+		decryptURL: fmt.Sprintf("%s/v1/%s/decrypt/%s", address, mount, vault.KeyName),
+		header:     header,
+		client:     client,
+	}, nil
+}
+
+func (b *vaultBackend) Seal(ctx context.Context, plaintext []byte) (string, error) {
+	resp := &vaultTransitResponse{}
+	req := &vaultTransitRequest{Plaintext: base64.StdEncoding.EncodeToString(plaintext)}
+	if err := postJSON(ctx, b.client, b.encryptURL, b.header, req, resp); err != nil {
+		return "", err
+	}
+	if resp.Data.Ciphertext == "" {
+		return "", fmt.Errorf("vault returns empty ciphertext")
+	}
+	return resp.Data.Ciphertext, nil
+}
+
+func (b *vaultBackend) Unseal(ctx context.Context, ciphertext string) ([]byte, error) {
+	resp := &vaultTransitResponse{}
+	if err := postJSON(ctx, b.client, b.decryptURL, b.header, &vaultTransitRequest{Ciphertext: ciphertext}, resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+}
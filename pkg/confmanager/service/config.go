@@ -24,6 +24,7 @@ import (
 
 	"github.com/secretflow/kuscia/pkg/confmanager/config"
 	"github.com/secretflow/kuscia/pkg/confmanager/driver"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/tls"
 	"github.com/secretflow/kuscia/pkg/web/utils"
@@ -50,16 +51,19 @@ type ConfigServiceConfig struct {
 	Driver       string
 	DisableCache bool
 	KubeClient   kubernetes.Interface
+	// SecretBackend seals the configs by an external secret manager, nil means the domain key.
+	SecretBackend secretbackend.Backend
 }
 
 func NewConfigService(ctx context.Context, conf *ConfigServiceConfig) (IConfigService, error) {
 	cmDriver, err := driver.NewDriver(ctx, &driver.Config{
-		DomainID:     conf.DomainID,
-		DomainKey:    conf.DomainKey,
-		Driver:       conf.Driver,
-		DisableCache: conf.DisableCache,
-		ConfigName:   config.DomainConfigName,
-		KubeClient:   conf.KubeClient,
+		DomainID:      conf.DomainID,
+		DomainKey:     conf.DomainKey,
+		Driver:        conf.Driver,
+		DisableCache:  conf.DisableCache,
+		ConfigName:    config.DomainConfigName,
+		KubeClient:    conf.KubeClient,
+		SecretBackend: conf.SecretBackend,
 	})
 	if err != nil {
 		return nil, err
@@ -111,6 +115,13 @@ func (s *configService) CreateConfig(ctx context.Context, request *confmanager.C
 				Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_ConfManagerErrRequestInvalidate, fmt.Sprintf("request data[%v].key can't be empty", i)),
 			}
 		}
+		if isGatewayFilterKey(d.Key) {
+			if err := validateGatewayFilter(d.Key, d.Value); err != nil {
+				return &confmanager.CreateConfigResponse{
+					Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_ConfManagerErrRequestInvalidate, err.Error()),
+				}
+			}
+		}
 		_, exist, err := s.driver.GetConfig(ctx, d.Key)
 		if err != nil {
 			return &confmanager.CreateConfigResponse{
@@ -171,6 +182,12 @@ func (s *configService) UpdateConfig(ctx context.Context, request *confmanager.U
 				Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_ConfManagerErrRequestInvalidate, fmt.Sprintf("request data[%d].key can't be empty", i)),
 			}
 		}
+		// the gateway filters are versioned, a changed filter is created as a new version
+		if isGatewayFilterKey(d.Key) {
+			return &confmanager.UpdateConfigResponse{
+				Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_ConfManagerErrRequestInvalidate, fmt.Sprintf("gateway filter key[%v] is immutable, create a new version instead", d.Key)),
+			}
+		}
 		data[d.Key] = d.Value
 	}
 
@@ -0,0 +1,105 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// GatewayFilterKeyPrefix prefixes the keys of the gateway filters, a filter is stored as
+	// gateway-filter.<name>.<version> and referenced by the domain routes by its name and version.
+	GatewayFilterKeyPrefix = "gateway-filter."
+
+	GatewayFilterTypeLua  = "Lua"
+	GatewayFilterTypeWasm = "Wasm"
+)
+
+var (
+	gatewayFilterNameRegexp    = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	gatewayFilterVersionRegexp = regexp.MustCompile(`^[A-Za-z0-9]([-._A-Za-z0-9]*[A-Za-z0-9])?$`)
+	luaEntryRegexp             = regexp.MustCompile(`function\s+envoy_on_(request|response)\s*\(`)
+	wasmHeader                 = []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}
+)
+
+// GatewayFilter is the value of a gateway filter, the Code is the Lua source or the base64 encoded Wasm module.
+type GatewayFilter struct {
+	Type string `json:"type"`
+	Code string `json:"code"`
+}
+
+// GatewayFilterConfigKey returns the key of the version of the gateway filter.
+func GatewayFilterConfigKey(name, version string) string {
+	return GatewayFilterKeyPrefix + name + "." + version
+}
+
+func isGatewayFilterKey(key string) bool {
+	return strings.HasPrefix(key, GatewayFilterKeyPrefix)
+}
+
+func validateGatewayFilterKey(key string) error {
+	// the names have no dots, so the version follows the first one
+	name, version, found := strings.Cut(strings.TrimPrefix(key, GatewayFilterKeyPrefix), ".")
+	if !found {
+		return fmt.Errorf("gateway filter key %q must be %s<name>.<version>", key, GatewayFilterKeyPrefix)
+	}
+	if len(name) > 63 || !gatewayFilterNameRegexp.MatchString(name) {
+		return fmt.Errorf("gateway filter name %q must be a DNS label", name)
+	}
+	if len(version) > 63 || !gatewayFilterVersionRegexp.MatchString(version) {
+		return fmt.Errorf("gateway filter version %q must consist of alphanumerics, '-', '_' or '.'", version)
+	}
+	return nil
+}
+
+// ParseGatewayFilter parses and validates a gateway filter, it returns the filter type and the Lua source or the
+// Wasm module. Only the entry points of the Lua scripts are checked, their syntax errors are reported by envoy.
+func ParseGatewayFilter(value string) (string, []byte, error) {
+	filter := &GatewayFilter{}
+	if err := json.Unmarshal([]byte(value), filter); err != nil {
+		return "", nil, fmt.Errorf("gateway filter must be a json of type and code, %v", err)
+	}
+	switch filter.Type {
+	case GatewayFilterTypeLua:
+		if !luaEntryRegexp.MatchString(filter.Code) {
+			return "", nil, fmt.Errorf("lua gateway filter must define envoy_on_request or envoy_on_response")
+		}
+		return filter.Type, []byte(filter.Code), nil
+	case GatewayFilterTypeWasm:
+		module, err := base64.StdEncoding.DecodeString(filter.Code)
+		if err != nil {
+			return "", nil, fmt.Errorf("wasm gateway filter code must be base64 encoded, %v", err)
+		}
+		if !bytes.HasPrefix(module, wasmHeader) {
+			return "", nil, fmt.Errorf("wasm gateway filter code isn't a wasm binary module")
+		}
+		return filter.Type, module, nil
+	default:
+		return "", nil, fmt.Errorf("gateway filter type must be %s or %s, got %q", GatewayFilterTypeLua, GatewayFilterTypeWasm, filter.Type)
+	}
+}
+
+func validateGatewayFilter(key, value string) error {
+	if err := validateGatewayFilterKey(key); err != nil {
+		return err
+	}
+	_, _, err := ParseGatewayFilter(value)
+	return err
+}
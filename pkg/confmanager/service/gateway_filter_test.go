@@ -0,0 +1,96 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/confmanager"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+)
+
+const testLuaFilter = `
+function envoy_on_request(request_handle)
+  request_handle:headers():add("x-partner-stamp", "alice")
+end
+`
+
+func gatewayFilterValue(t *testing.T, filterType, code string) string {
+	value, err := json.Marshal(&GatewayFilter{Type: filterType, Code: code})
+	assert.NoError(t, err)
+	return string(value)
+}
+
+func TestParseGatewayFilter(t *testing.T) {
+	filterType, code, err := ParseGatewayFilter(gatewayFilterValue(t, GatewayFilterTypeLua, testLuaFilter))
+	assert.NoError(t, err)
+	assert.Equal(t, GatewayFilterTypeLua, filterType)
+	assert.Equal(t, testLuaFilter, string(code))
+
+	module := append([]byte{}, wasmHeader...)
+	filterType, code, err = ParseGatewayFilter(gatewayFilterValue(t, GatewayFilterTypeWasm, base64.StdEncoding.EncodeToString(module)))
+	assert.NoError(t, err)
+	assert.Equal(t, GatewayFilterTypeWasm, filterType)
+	assert.Equal(t, module, code)
+
+	invalid := []string{
+		"not a json",
+		gatewayFilterValue(t, "Js", testLuaFilter),
+		gatewayFilterValue(t, GatewayFilterTypeLua, `print("no entry point")`),
+		gatewayFilterValue(t, GatewayFilterTypeWasm, "not base64"),
+		gatewayFilterValue(t, GatewayFilterTypeWasm, base64.StdEncoding.EncodeToString([]byte("not wasm"))),
+	}
+	for _, value := range invalid {
+		_, _, err = ParseGatewayFilter(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestValidateGatewayFilterKey(t *testing.T) {
+	assert.NoError(t, validateGatewayFilterKey(GatewayFilterConfigKey("sign-request", "v1")))
+	assert.NoError(t, validateGatewayFilterKey(GatewayFilterConfigKey("sign-request", "1.0.2")))
+	assert.Error(t, validateGatewayFilterKey("gateway-filter.sign-request"))
+	assert.Error(t, validateGatewayFilterKey(GatewayFilterConfigKey("Sign_Request", "v1")))
+	assert.Error(t, validateGatewayFilterKey(GatewayFilterConfigKey("sign-request", "v1.")))
+}
+
+func Test_ConfigService_GatewayFilter(t *testing.T) {
+	s, err := makeConfigService()
+	assert.Nil(t, err)
+	ctx := context.Background()
+	key := GatewayFilterConfigKey("stamp", "v1")
+
+	got := s.CreateConfig(ctx, &confmanager.CreateConfigRequest{
+		Data: []*confmanager.ConfigData{{Key: key, Value: gatewayFilterValue(t, GatewayFilterTypeLua, "return")}},
+	})
+	assert.Equal(t, int32(errorcode.ErrorCode_ConfManagerErrRequestInvalidate), got.Status.Code)
+
+	value := gatewayFilterValue(t, GatewayFilterTypeLua, testLuaFilter)
+	got = s.CreateConfig(ctx, &confmanager.CreateConfigRequest{
+		Data: []*confmanager.ConfigData{{Key: key, Value: value}},
+	})
+	assert.Equal(t, int32(errorcode.ErrorCode_SUCCESS), got.Status.Code)
+	assert.Equal(t, value, s.QueryConfig(ctx, &confmanager.QueryConfigRequest{Key: key}).Value)
+
+	updated := s.UpdateConfig(ctx, &confmanager.UpdateConfigRequest{
+		Data: []*confmanager.ConfigData{{Key: key, Value: value}},
+	})
+	assert.Equal(t, int32(errorcode.ErrorCode_ConfManagerErrRequestInvalidate), updated.Status.Code)
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package alerting evaluates the alert rules in the master and sends the alerts to the notifiers.
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeinformers "k8s.io/client-go/informers"
+	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/secretflow/kuscia/pkg/controllers"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	kuscialistersv1alpha1 "github.com/secretflow/kuscia/pkg/crd/listers/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/certinventory"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const controllerName = "alerting-controller"
+
+type AlertStatus string
+
+const (
+	AlertFiring   AlertStatus = "firing"
+	AlertResolved AlertStatus = "resolved"
+)
+
+// Alert is sent to the notifiers when it fires, repeats or is resolved.
+type Alert struct {
+	Rule     string      `json:"rule"`
+	Type     string      `json:"type"`
+	Severity string      `json:"severity"`
+	Subject  string      `json:"subject"`
+	Message  string      `json:"message"`
+	Status   AlertStatus `json:"status"`
+	StartsAt time.Time   `json:"startsAt"`
+	EndsAt   *time.Time  `json:"endsAt,omitempty"`
+}
+
+type alertKey struct {
+	rule    string
+	subject string
+}
+
+// alertState tracks a subject meeting the condition of a rule, the alert fires after the condition lasts for the
+// duration of the rule.
+type alertState struct {
+	alert        Alert
+	activeSince  time.Time
+	firing       bool
+	lastNotified time.Time
+}
+
+// Controller evaluates the rules over the informer caches periodically. The alerts are kept in memory, so the
+// firing alerts are notified again after the controller restarts.
+type Controller struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	conf   *kusciaconfig.AlertingConfig
+
+	kusciaInformerFactory    kusciainformers.SharedInformerFactory
+	kubeInformerFactory      kubeinformers.SharedInformerFactory
+	kusciaJobLister          kuscialistersv1alpha1.KusciaJobLister
+	clusterDomainRouteLister kuscialistersv1alpha1.ClusterDomainRouteLister
+	domainLister             kuscialistersv1alpha1.DomainLister
+	nodeLister               listers.NodeLister
+	cacheSyncs               []cache.InformerSynced
+	certs                    *certinventory.Inventory
+
+	notifiers map[string]notifier
+	states    map[alertKey]*alertState
+}
+
+func NewController(ctx context.Context, config controllers.ControllerConfig) controllers.IController {
+	kusciaInformerFactory := kusciainformers.NewSharedInformerFactory(config.KusciaClient, 10*time.Minute)
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(config.KubeClient, 10*time.Minute)
+	kusciaJobInformer := kusciaInformerFactory.Kuscia().V1alpha1().KusciaJobs()
+	clusterDomainRouteInformer := kusciaInformerFactory.Kuscia().V1alpha1().ClusterDomainRoutes()
+	domainInformer := kusciaInformerFactory.Kuscia().V1alpha1().Domains()
+	nodeInformer := kubeInformerFactory.Core().V1().Nodes()
+
+	c := &Controller{
+		conf:                     config.Alerting,
+		kusciaInformerFactory:    kusciaInformerFactory,
+		kubeInformerFactory:      kubeInformerFactory,
+		kusciaJobLister:          kusciaJobInformer.Lister(),
+		clusterDomainRouteLister: clusterDomainRouteInformer.Lister(),
+		domainLister:             domainInformer.Lister(),
+		nodeLister:               nodeInformer.Lister(),
+		cacheSyncs: []cache.InformerSynced{
+			kusciaJobInformer.Informer().HasSynced,
+			clusterDomainRouteInformer.Informer().HasSynced,
+			domainInformer.Informer().HasSynced,
+			nodeInformer.Informer().HasSynced,
+		},
+		certs:     certinventory.Default,
+		notifiers: map[string]notifier{},
+		states:    map[alertKey]*alertState{},
+	}
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	return c
+}
+
+func (c *Controller) Run(int) error {
+	if c.conf == nil || !c.conf.Enable {
+		nlog.Infof("Alerting is disabled, %s exits", c.Name())
+		return nil
+	}
+	for i := range c.conf.Notifiers {
+		conf := &c.conf.Notifiers[i]
+		n, err := newNotifier(conf)
+		if err != nil {
+			return err
+		}
+		c.notifiers[conf.Name] = n
+	}
+
+	c.kusciaInformerFactory.Start(c.ctx.Done())
+	c.kubeInformerFactory.Start(c.ctx.Done())
+	nlog.Infof("Waiting for informer cache to sync for %s", c.Name())
+	if !cache.WaitForCacheSync(c.ctx.Done(), c.cacheSyncs...) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	nlog.Infof("Start evaluating %d alert rules every %v", len(c.conf.Rules), c.conf.Interval())
+	wait.UntilWithContext(c.ctx, func(ctx context.Context) {
+		c.evaluate(ctx, time.Now())
+	}, c.conf.Interval())
+	return nil
+}
+
+func (c *Controller) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+}
+
+func (c *Controller) Name() string {
+	return controllerName
+}
+
+// evaluate evaluates the rules and notifies the alerts which fire, repeat or are resolved. A rule failing to
+// evaluate keeps its alerts as they are.
+func (c *Controller) evaluate(ctx context.Context, now time.Time) {
+	for i := range c.conf.Rules {
+		rule := &c.conf.Rules[i]
+		conds, err := c.evaluateRule(rule, now)
+		if err != nil {
+			nlog.Warnf("Evaluate alert rule %s failed, %v", rule.Name, err)
+			continue
+		}
+		if alerts := c.transit(rule, conds, now); len(alerts) > 0 {
+			c.notify(ctx, rule, alerts)
+		}
+	}
+}
+
+// transit updates the states of the rule by the subjects meeting its condition, and returns the alerts to notify.
+func (c *Controller) transit(rule *kusciaconfig.AlertRuleConfig, conds conditions, now time.Time) []*Alert {
+	var alerts []*Alert
+	subjects := make([]string, 0, len(conds))
+	for subject := range conds {
+		subjects = append(subjects, subject)
+	}
+	sort.Strings(subjects)
+	for _, subject := range subjects {
+		key := alertKey{rule: rule.Name, subject: subject}
+		state, ok := c.states[key]
+		if !ok {
+			state = &alertState{
+				alert: Alert{
+					Rule:     rule.Name,
+					Type:     rule.Type,
+					Severity: rule.GetSeverity(),
+					Subject:  subject,
+					Status:   AlertFiring,
+				},
+				activeSince: now,
+			}
+			c.states[key] = state
+		}
+		state.alert.Message = conds[subject]
+
+		switch {
+		case !state.firing && now.Sub(state.activeSince) >= rule.For():
+			state.firing = true
+			state.alert.StartsAt = now
+		case state.firing && now.Sub(state.lastNotified) >= c.conf.RepeatInterval():
+		default:
+			continue
+		}
+		state.lastNotified = now
+		alert := state.alert
+		alerts = append(alerts, &alert)
+	}
+
+	var resolved []*Alert
+	for key, state := range c.states {
+		if key.rule != rule.Name {
+			continue
+		}
+		if _, ok := conds[key.subject]; ok {
+			continue
+		}
+		delete(c.states, key)
+		if !state.firing {
+			continue
+		}
+		alert := state.alert
+		alert.Status = AlertResolved
+		endsAt := now
+		alert.EndsAt = &endsAt
+		resolved = append(resolved, &alert)
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].Subject < resolved[j].Subject
+	})
+	return append(alerts, resolved...)
+}
+
+func (c *Controller) notify(ctx context.Context, rule *kusciaconfig.AlertRuleConfig, alerts []*Alert) {
+	names := rule.Notifiers
+	if len(names) == 0 {
+		for name := range c.notifiers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	for _, name := range names {
+		if err := c.notifiers[name].Notify(ctx, alerts); err != nil {
+			nlog.Warnf("Notify %d alerts of rule %s by %s failed, %v", len(alerts), rule.Name, name, err)
+			continue
+		}
+		nlog.Infof("Notify %d alerts of rule %s by %s", len(alerts), rule.Name, name)
+	}
+}
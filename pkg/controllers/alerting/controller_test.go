@@ -0,0 +1,236 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerting
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/controllers"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/utils/certinventory"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+type fakeNotifier struct {
+	alerts []*Alert
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, alerts []*Alert) error {
+	n.alerts = append(n.alerts, alerts...)
+	return nil
+}
+
+func newTestController(t *testing.T, conf *kusciaconfig.AlertingConfig) (*Controller, *fakeNotifier) {
+	c := NewController(context.Background(), controllers.ControllerConfig{
+		KubeClient:   kubefake.NewSimpleClientset(),
+		KusciaClient: kusciafake.NewSimpleClientset(),
+		Alerting:     conf,
+	}).(*Controller)
+	n := &fakeNotifier{}
+	c.notifiers["fake"] = n
+	return c, n
+}
+
+func makeJob(name string, phase kusciaapisv1alpha1.KusciaJobPhase, completion time.Time) *kusciaapisv1alpha1.KusciaJob {
+	completionTime := metav1.NewTime(completion)
+	return &kusciaapisv1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "cross-domain"},
+		Status:     kusciaapisv1alpha1.KusciaJobStatus{Phase: phase, CompletionTime: &completionTime},
+	}
+}
+
+func makeCert(t *testing.T, notAfter time.Time) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "alice"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestEvaluateJobFailureRate(t *testing.T) {
+	now := time.Now()
+	rule := &kusciaconfig.AlertRuleConfig{Name: "job-failure", Type: kusciaconfig.AlertRuleJobFailureRate, Threshold: 0.5, MinJobs: 2}
+	c, _ := newTestController(t, &kusciaconfig.AlertingConfig{Enable: true, Rules: []kusciaconfig.AlertRuleConfig{*rule}})
+	store := c.kusciaInformerFactory.Kuscia().V1alpha1().KusciaJobs().Informer().GetStore()
+
+	assert.NoError(t, store.Add(makeJob("job-1", kusciaapisv1alpha1.KusciaJobFailed, now.Add(-time.Minute))))
+	conds, err := c.evaluateRule(rule, now)
+	assert.NoError(t, err)
+	assert.Empty(t, conds, "fewer jobs than minJobs")
+
+	assert.NoError(t, store.Add(makeJob("job-2", kusciaapisv1alpha1.KusciaJobSucceeded, now.Add(-time.Minute))))
+	// out of the window
+	assert.NoError(t, store.Add(makeJob("job-3", kusciaapisv1alpha1.KusciaJobSucceeded, now.Add(-2*time.Hour))))
+	conds, err = c.evaluateRule(rule, now)
+	assert.NoError(t, err)
+	assert.Contains(t, conds[jobFailureRateSubject], "1 of the 2 jobs")
+	assert.Contains(t, conds[jobFailureRateSubject], "job-1")
+
+	assert.NoError(t, store.Add(makeJob("job-4", kusciaapisv1alpha1.KusciaJobSucceeded, now.Add(-time.Minute))))
+	conds, err = c.evaluateRule(rule, now)
+	assert.NoError(t, err)
+	assert.Empty(t, conds)
+}
+
+func TestEvaluateRouteDown(t *testing.T) {
+	rule := &kusciaconfig.AlertRuleConfig{Name: "route-down", Type: kusciaconfig.AlertRuleRouteDown}
+	c, _ := newTestController(t, &kusciaconfig.AlertingConfig{Enable: true})
+	store := c.kusciaInformerFactory.Kuscia().V1alpha1().ClusterDomainRoutes().Informer().GetStore()
+	makeRoute := func(name string, ready corev1.ConditionStatus, probe *kusciaapisv1alpha1.DomainRouteProbeStatus) *kusciaapisv1alpha1.ClusterDomainRoute {
+		return &kusciaapisv1alpha1.ClusterDomainRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: kusciaapisv1alpha1.ClusterDomainRouteSpec{DomainRouteSpec: kusciaapisv1alpha1.DomainRouteSpec{
+				Source: "alice", Destination: "bob",
+			}},
+			Status: kusciaapisv1alpha1.ClusterDomainRouteStatus{
+				Conditions: []kusciaapisv1alpha1.ClusterDomainRouteCondition{{
+					Type: kusciaapisv1alpha1.ClusterDomainRouteReady, Status: ready, Reason: "DestinationUnreachable",
+				}},
+				Probe: probe,
+			},
+		}
+	}
+	assert.NoError(t, store.Add(makeRoute("alice-bob", corev1.ConditionFalse, nil)))
+	assert.NoError(t, store.Add(makeRoute("alice-carol", corev1.ConditionTrue,
+		&kusciaapisv1alpha1.DomainRouteProbeStatus{Result: kusciaapisv1alpha1.DomainRouteProbeUnauthorized})))
+	assert.NoError(t, store.Add(makeRoute("alice-dave", corev1.ConditionTrue,
+		&kusciaapisv1alpha1.DomainRouteProbeStatus{Result: kusciaapisv1alpha1.DomainRouteProbeReachable})))
+
+	conds, err := c.evaluateRule(rule, time.Now())
+	assert.NoError(t, err)
+	assert.Len(t, conds, 2)
+	assert.Contains(t, conds["alice-bob"], "DestinationUnreachable")
+	assert.Contains(t, conds["alice-carol"], "Unauthorized")
+}
+
+func TestEvaluateCertExpiring(t *testing.T) {
+	now := time.Now()
+	rule := &kusciaconfig.AlertRuleConfig{Name: "cert", Type: kusciaconfig.AlertRuleCertExpiring, Threshold: 30}
+	c, _ := newTestController(t, &kusciaconfig.AlertingConfig{Enable: true})
+	store := c.kusciaInformerFactory.Kuscia().V1alpha1().Domains().Informer().GetStore()
+	makeDomain := func(name, cert string) *kusciaapisv1alpha1.Domain {
+		return &kusciaapisv1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: kusciaapisv1alpha1.DomainSpec{Cert: cert}}
+	}
+	assert.NoError(t, store.Add(makeDomain("alice", makeCert(t, now.Add(10*24*time.Hour)))))
+	assert.NoError(t, store.Add(makeDomain("bob", makeCert(t, now.Add(100*24*time.Hour)))))
+	assert.NoError(t, store.Add(makeDomain("carol", makeCert(t, now.Add(-time.Hour)))))
+	assert.NoError(t, store.Add(makeDomain("dave", "")))
+
+	c.certs = certinventory.NewInventory()
+	c.certs.Register("test", func(ctx context.Context) ([]certinventory.Certificate, error) {
+		return []certinventory.Certificate{
+			{Kind: certinventory.KindDomainCert, Name: "alice", NotAfter: now.Add(10 * 24 * time.Hour)},
+			{Kind: certinventory.KindKusciaAPIServerCert, Name: "kusciaapi-server", NotAfter: now.Add(5 * 24 * time.Hour)},
+			{Kind: certinventory.KindEnvoyListenerCert, Name: "external-listener", NotAfter: now.Add(100 * 24 * time.Hour)},
+			{Kind: certinventory.KindDomainRouteToken, Name: "alice-bob", NotAfter: now.Add(time.Hour)},
+			{Kind: certinventory.KindDomainRouteToken, Name: "alice-carol", NotAfter: now.Add(-time.Hour)},
+		}, nil
+	})
+
+	conds, err := c.evaluateRule(rule, now)
+	assert.NoError(t, err)
+	assert.Len(t, conds, 4)
+	assert.Contains(t, conds["alice"], "in 10 days")
+	assert.Contains(t, conds["carol"], "expired")
+	assert.Contains(t, conds["KusciaAPIServerCert/kusciaapi-server"], "in 5 days")
+	assert.Contains(t, conds["DomainRouteToken/alice-carol"], "expired")
+}
+
+func TestEvaluateDiskPressure(t *testing.T) {
+	rule := &kusciaconfig.AlertRuleConfig{Name: "disk", Type: kusciaconfig.AlertRuleDiskPressure}
+	c, _ := newTestController(t, &kusciaconfig.AlertingConfig{Enable: true})
+	store := c.kubeInformerFactory.Core().V1().Nodes().Informer().GetStore()
+	makeNode := func(name string, status corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+				Type: corev1.NodeDiskPressure, Status: status, Message: "disk usage 95%",
+			}}},
+		}
+	}
+	assert.NoError(t, store.Add(makeNode("alice-1", corev1.ConditionTrue)))
+	assert.NoError(t, store.Add(makeNode("alice-2", corev1.ConditionFalse)))
+
+	conds, err := c.evaluateRule(rule, time.Now())
+	assert.NoError(t, err)
+	assert.Len(t, conds, 1)
+	assert.Contains(t, conds["alice-1"], "disk usage 95%")
+}
+
+func TestEvaluateTransitions(t *testing.T) {
+	rule := kusciaconfig.AlertRuleConfig{Name: "disk", Type: kusciaconfig.AlertRuleDiskPressure, ForSeconds: 60}
+	c, n := newTestController(t, &kusciaconfig.AlertingConfig{
+		Enable:                true,
+		RepeatIntervalSeconds: 3600,
+		Rules:                 []kusciaconfig.AlertRuleConfig{rule},
+	})
+	store := c.kubeInformerFactory.Core().V1().Nodes().Informer().GetStore()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice-1"},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+			Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue,
+		}}},
+	}
+	assert.NoError(t, store.Add(node))
+	ctx := context.Background()
+	now := time.Now()
+
+	// pending for forSeconds
+	c.evaluate(ctx, now)
+	assert.Empty(t, n.alerts)
+
+	c.evaluate(ctx, now.Add(time.Minute))
+	assert.Len(t, n.alerts, 1)
+	assert.Equal(t, AlertFiring, n.alerts[0].Status)
+	assert.Equal(t, "alice-1", n.alerts[0].Subject)
+	assert.Equal(t, kusciaconfig.AlertSeverityWarning, n.alerts[0].Severity)
+
+	// not repeated within the repeat interval
+	c.evaluate(ctx, now.Add(2*time.Minute))
+	assert.Len(t, n.alerts, 1)
+
+	c.evaluate(ctx, now.Add(time.Minute+time.Hour))
+	assert.Len(t, n.alerts, 2)
+	assert.Equal(t, AlertFiring, n.alerts[1].Status)
+
+	node.Status.Conditions[0].Status = corev1.ConditionFalse
+	assert.NoError(t, store.Update(node))
+	c.evaluate(ctx, now.Add(2*time.Hour))
+	assert.Len(t, n.alerts, 3)
+	assert.Equal(t, AlertResolved, n.alerts[2].Status)
+	assert.NotNil(t, n.alerts[2].EndsAt)
+	assert.Empty(t, c.states)
+}
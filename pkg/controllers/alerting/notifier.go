@@ -0,0 +1,262 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+const defaultSMTPPort = 25
+
+type notifier interface {
+	Notify(ctx context.Context, alerts []*Alert) error
+}
+
+func newNotifier(conf *kusciaconfig.AlertNotifierConfig) (notifier, error) {
+	client := &http.Client{Timeout: conf.Timeout()}
+	switch conf.Type {
+	case kusciaconfig.AlertNotifierWebhook:
+		return &webhookNotifier{url: conf.URL, token: conf.Token, client: client}, nil
+	case kusciaconfig.AlertNotifierEmail:
+		return &emailNotifier{conf: conf.SMTP, timeout: conf.Timeout()}, nil
+	case kusciaconfig.AlertNotifierDingTalk:
+		return &dingTalkNotifier{url: conf.URL, secret: conf.Secret, client: client}, nil
+	case kusciaconfig.AlertNotifierWeCom:
+		return &weComNotifier{url: conf.URL, client: client}, nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type %q", conf.Type)
+	}
+}
+
+// webhookNotifier posts the alerts as json, e.g. {"alerts": [{"rule": "route-down", "status": "firing", ...}]}.
+type webhookNotifier struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, alerts []*Alert) error {
+	_, err := postJSON(ctx, n.client, n.url, n.token, map[string]interface{}{"alerts": alerts})
+	return err
+}
+
+// emailNotifier sends the alerts by smtp, STARTTLS is used if the server supports it.
+type emailNotifier struct {
+	conf    *kusciaconfig.AlertSMTPConfig
+	timeout time.Duration
+}
+
+func (n *emailNotifier) Notify(ctx context.Context, alerts []*Alert) error {
+	port := n.conf.Port
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+	dialer := &net.Dialer{Timeout: n.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(n.conf.Host, strconv.Itoa(port)))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(n.timeout)); err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, n.conf.Host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.conf.Host}); err != nil {
+			return err
+		}
+	}
+	if n.conf.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.conf.Username, n.conf.Password, n.conf.Host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(n.conf.From); err != nil {
+		return err
+	}
+	for _, to := range n.conf.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(buildMail(n.conf.From, n.conf.To, alerts)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func buildMail(from string, to []string, alerts []*Alert) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", alertsTitle(alerts))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	buf.WriteString(strings.ReplaceAll(alertsMarkdown(alerts), "\n", "\r\n"))
+	return buf.Bytes()
+}
+
+// dingTalkNotifier sends the alerts to the dingtalk robot in markdown, the requests are signed if the secret is set.
+type dingTalkNotifier struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func (n *dingTalkNotifier) Notify(ctx context.Context, alerts []*Alert) error {
+	target := n.url
+	if n.secret != "" {
+		target = signDingTalkURL(n.url, n.secret, time.Now())
+	}
+	body := map[string]interface{}{
+		"msgtype": "markdown",
+		"markdown": map[string]string{
+			"title": alertsTitle(alerts),
+			"text":  alertsMarkdown(alerts),
+		},
+	}
+	return postRobot(ctx, n.client, target, body)
+}
+
+// signDingTalkURL appends the timestamp and the signature, see https://open.dingtalk.com/document/robots/customize-robot-security-settings.
+func signDingTalkURL(rawURL, secret string, now time.Time) string {
+	timestamp := strconv.FormatInt(now.UnixMilli(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + secret))
+	sign := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	separator := "?"
+	if strings.Contains(rawURL, "?") {
+		separator = "&"
+	}
+	return rawURL + separator + "timestamp=" + timestamp + "&sign=" + url.QueryEscape(sign)
+}
+
+// weComNotifier sends the alerts to the wecom group robot in markdown.
+type weComNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func (n *weComNotifier) Notify(ctx context.Context, alerts []*Alert) error {
+	body := map[string]interface{}{
+		"msgtype": "markdown",
+		"markdown": map[string]string{
+			"content": alertsMarkdown(alerts),
+		},
+	}
+	return postRobot(ctx, n.client, n.url, body)
+}
+
+// postRobot posts to the robots of dingtalk and wecom, which respond errcode 0 on success.
+func postRobot(ctx context.Context, client *http.Client, target string, body interface{}) error {
+	respBody, err := postJSON(ctx, client, target, "", body)
+	if err != nil {
+		return err
+	}
+	result := struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}{}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("robot responds invalid body %q, %v", respBody, err)
+	}
+	if result.ErrCode != 0 {
+		return fmt.Errorf("robot responds errcode %d, errmsg: %s", result.ErrCode, result.ErrMsg)
+	}
+	return nil
+}
+
+func postJSON(ctx context.Context, client *http.Client, target, token string, body interface{}) ([]byte, error) {
+	content, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("notifier responds unexpected status code %d, body: %s", resp.StatusCode, respBody)
+	}
+	return respBody, nil
+}
+
+func alertsTitle(alerts []*Alert) string {
+	firing := 0
+	for _, alert := range alerts {
+		if alert.Status == AlertFiring {
+			firing++
+		}
+	}
+	return fmt.Sprintf("[Kuscia] %d alerts firing, %d resolved", firing, len(alerts)-firing)
+}
+
+func alertsMarkdown(alerts []*Alert) string {
+	var b strings.Builder
+	for i, alert := range alerts {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "### [%s] %s\n", strings.ToUpper(string(alert.Status)), alert.Rule)
+		fmt.Fprintf(&b, "- severity: %s\n", alert.Severity)
+		fmt.Fprintf(&b, "- subject: %s\n", alert.Subject)
+		fmt.Fprintf(&b, "- message: %s\n", alert.Message)
+		fmt.Fprintf(&b, "- startsAt: %s\n", alert.StartsAt.Format(time.RFC3339))
+		if alert.EndsAt != nil {
+			fmt.Fprintf(&b, "- endsAt: %s\n", alert.EndsAt.Format(time.RFC3339))
+		}
+	}
+	return b.String()
+}
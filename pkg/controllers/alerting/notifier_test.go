@@ -0,0 +1,116 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerting
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+var testAlerts = []*Alert{{
+	Rule:     "route-down",
+	Type:     kusciaconfig.AlertRuleRouteDown,
+	Severity: kusciaconfig.AlertSeverityCritical,
+	Subject:  "alice-bob",
+	Message:  "route alice -> bob is Unreachable",
+	Status:   AlertFiring,
+	StartsAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+}}
+
+func TestWebhookNotifier(t *testing.T) {
+	var got map[string][]*Alert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer server.Close()
+
+	n, err := newNotifier(&kusciaconfig.AlertNotifierConfig{Name: "hook", Type: kusciaconfig.AlertNotifierWebhook, URL: server.URL, Token: "secret"})
+	assert.NoError(t, err)
+	assert.NoError(t, n.Notify(context.Background(), testAlerts))
+	assert.Len(t, got["alerts"], 1)
+	assert.Equal(t, "alice-bob", got["alerts"][0].Subject)
+}
+
+func TestDingTalkNotifier(t *testing.T) {
+	var got struct {
+		MsgType  string            `json:"msgtype"`
+		Markdown map[string]string `json:"markdown"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timestamp := r.URL.Query().Get("timestamp")
+		mac := hmac.New(sha256.New, []byte("SEC123"))
+		mac.Write([]byte(timestamp + "\n" + "SEC123"))
+		assert.Equal(t, base64.StdEncoding.EncodeToString(mac.Sum(nil)), r.URL.Query().Get("sign"))
+		assert.Equal(t, "robot", r.URL.Query().Get("access_token"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	n, err := newNotifier(&kusciaconfig.AlertNotifierConfig{
+		Name: "ding", Type: kusciaconfig.AlertNotifierDingTalk, URL: server.URL + "?access_token=robot", Secret: "SEC123",
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, n.Notify(context.Background(), testAlerts))
+	assert.Equal(t, "markdown", got.MsgType)
+	assert.Equal(t, "[Kuscia] 1 alerts firing, 0 resolved", got.Markdown["title"])
+	assert.Contains(t, got.Markdown["text"], "### [FIRING] route-down")
+}
+
+func TestWeComNotifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"msgtype":"markdown"`)
+		_, _ = w.Write([]byte(`{"errcode":93000,"errmsg":"invalid webhook url"}`))
+	}))
+	defer server.Close()
+
+	n, err := newNotifier(&kusciaconfig.AlertNotifierConfig{Name: "wecom", Type: kusciaconfig.AlertNotifierWeCom, URL: server.URL})
+	assert.NoError(t, err)
+	err = n.Notify(context.Background(), testAlerts)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "93000")
+}
+
+func TestSignDingTalkURL(t *testing.T) {
+	signed := signDingTalkURL("https://oapi.dingtalk.com/robot/send?access_token=abc", "secret", time.UnixMilli(1700000000000))
+	u, err := url.Parse(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", u.Query().Get("access_token"))
+	assert.Equal(t, "1700000000000", u.Query().Get("timestamp"))
+	assert.NotEmpty(t, u.Query().Get("sign"))
+}
+
+func TestBuildMail(t *testing.T) {
+	mail := string(buildMail("kuscia@example.com", []string{"ops@example.com", "oncall@example.com"}, testAlerts))
+	assert.True(t, strings.HasPrefix(mail, "From: kuscia@example.com\r\nTo: ops@example.com, oncall@example.com\r\n"))
+	assert.Contains(t, mail, "Subject: [Kuscia] 1 alerts firing, 0 resolved\r\n")
+	assert.Contains(t, mail, "- subject: alice-bob\r\n")
+}
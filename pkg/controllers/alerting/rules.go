@@ -0,0 +1,201 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerting
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/certinventory"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+const (
+	// jobFailureRateSubject is the subject of jobFailureRate, which fires for all the jobs rather than each of them.
+	jobFailureRateSubject = "kuscia-jobs"
+	// maxListedJobs bounds the failed jobs listed in the message of jobFailureRate.
+	maxListedJobs = 5
+)
+
+// conditions are the messages of the subjects meeting the condition of a rule, indexed by the subjects.
+type conditions map[string]string
+
+func (c *Controller) evaluateRule(rule *kusciaconfig.AlertRuleConfig, now time.Time) (conditions, error) {
+	switch rule.Type {
+	case kusciaconfig.AlertRuleJobFailureRate:
+		return c.evaluateJobFailureRate(rule, now)
+	case kusciaconfig.AlertRuleRouteDown:
+		return c.evaluateRouteDown()
+	case kusciaconfig.AlertRuleCertExpiring:
+		return c.evaluateCertExpiring(rule, now)
+	case kusciaconfig.AlertRuleDiskPressure:
+		return c.evaluateDiskPressure()
+	default:
+		return nil, fmt.Errorf("unknown rule type %q", rule.Type)
+	}
+}
+
+// evaluateJobFailureRate counts the jobs finished in the window.
+func (c *Controller) evaluateJobFailureRate(rule *kusciaconfig.AlertRuleConfig, now time.Time) (conditions, error) {
+	jobs, err := c.kusciaJobLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	since := now.Add(-rule.Window())
+	finished := 0
+	var failed []string
+	for _, job := range jobs {
+		if job.Status.CompletionTime == nil || job.Status.CompletionTime.Time.Before(since) {
+			continue
+		}
+		switch job.Status.Phase {
+		case kusciaapisv1alpha1.KusciaJobSucceeded:
+			finished++
+		case kusciaapisv1alpha1.KusciaJobFailed:
+			finished++
+			failed = append(failed, job.Name)
+		}
+	}
+	minJobs := rule.MinJobs
+	if minJobs < 1 {
+		minJobs = 1
+	}
+	if finished < minJobs {
+		return nil, nil
+	}
+	rate := float64(len(failed)) / float64(finished)
+	if rate < rule.Threshold {
+		return nil, nil
+	}
+
+	sort.Strings(failed)
+	listed := failed
+	if len(listed) > maxListedJobs {
+		listed = listed[:maxListedJobs]
+	}
+	message := fmt.Sprintf("%d of the %d jobs finished in the last %v failed (%.1f%%), failed jobs: %s",
+		len(failed), finished, rule.Window(), rate*100, strings.Join(listed, ", "))
+	if len(failed) > len(listed) {
+		message += ", ..."
+	}
+	return conditions{jobFailureRateSubject: message}, nil
+}
+
+// evaluateRouteDown checks the ready condition and the probe of the routes, the routes not reconciled yet are skipped.
+func (c *Controller) evaluateRouteDown() (conditions, error) {
+	routes, err := c.clusterDomainRouteLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	result := conditions{}
+	for _, route := range routes {
+		for _, cond := range route.Status.Conditions {
+			if cond.Type == kusciaapisv1alpha1.ClusterDomainRouteReady && cond.Status != corev1.ConditionTrue {
+				result[route.Name] = fmt.Sprintf("route %s -> %s is not ready, reason: %s", route.Spec.Source, route.Spec.Destination, cond.Reason)
+			}
+		}
+		if _, ok := result[route.Name]; ok {
+			continue
+		}
+		if probe := route.Status.Probe; probe != nil && probe.Result != kusciaapisv1alpha1.DomainRouteProbeReachable {
+			result[route.Name] = fmt.Sprintf("route %s -> %s is %s, %s", route.Spec.Source, route.Spec.Destination, probe.Result, probe.Message)
+		}
+	}
+	return result, nil
+}
+
+// evaluateCertExpiring checks the certs of the domains, the expired certs fire as well.
+func (c *Controller) evaluateCertExpiring(rule *kusciaconfig.AlertRuleConfig, now time.Time) (conditions, error) {
+	domains, err := c.domainLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	deadline := now.Add(time.Duration(rule.Threshold * float64(24*time.Hour)))
+	result := conditions{}
+	checked := map[string]bool{}
+	for _, domain := range domains {
+		if domain.Spec.Cert == "" {
+			continue
+		}
+		checked[domain.Name] = true
+		notAfter, err := certNotAfter(domain.Spec.Cert)
+		if err != nil {
+			result[domain.Name] = fmt.Sprintf("cert of domain %s is invalid, %v", domain.Name, err)
+			continue
+		}
+		if notAfter.After(deadline) {
+			continue
+		}
+		if notAfter.Before(now) {
+			result[domain.Name] = fmt.Sprintf("cert of domain %s expired at %s", domain.Name, notAfter.Format(time.RFC3339))
+		} else {
+			days := int(math.Ceil(notAfter.Sub(now).Hours() / 24))
+			result[domain.Name] = fmt.Sprintf("cert of domain %s expires at %s, in %d days", domain.Name, notAfter.Format(time.RFC3339), days)
+		}
+	}
+	// the certs of the local modules, the tokens are rotated well before the expiration so only the expired fire
+	for _, cert := range c.certs.List(c.ctx) {
+		if cert.Kind == certinventory.KindDomainCert && checked[cert.Name] {
+			continue
+		}
+		if cert.Kind == certinventory.KindDomainRouteToken {
+			if cert.NotAfter.After(now) {
+				continue
+			}
+		} else if cert.NotAfter.After(deadline) {
+			continue
+		}
+		result[fmt.Sprintf("%s/%s", cert.Kind, cert.Name)] = certinventory.ExpirationMessage(&cert, now)
+	}
+	return result, nil
+}
+
+// certNotAfter parses the base64 encoded PEM cert of the domains.
+func certNotAfter(cert string) (time.Time, error) {
+	certPEM, err := base64.StdEncoding.DecodeString(cert)
+	if err != nil {
+		return time.Time{}, err
+	}
+	x509Cert, err := tls.ParseCertData(certPEM)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return x509Cert.NotAfter, nil
+}
+
+func (c *Controller) evaluateDiskPressure() (conditions, error) {
+	nodes, err := c.nodeLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	result := conditions{}
+	for _, node := range nodes {
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == corev1.NodeDiskPressure && cond.Status == corev1.ConditionTrue {
+				result[node.Name] = fmt.Sprintf("node %s is under disk pressure, %s", node.Name, cond.Message)
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,246 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appimagesync
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/controllers"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+const controllerName = "appimage-sync-controller"
+
+// Controller syncs the app images from the central registry on a schedule. The app images it creates are labeled
+// by common.LabelAppImageSynced, the local app images of other names are left untouched.
+type Controller struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	kusciaClient kusciaclientset.Interface
+	conf         *kusciaconfig.AppImageSyncConfig
+	source       bundleSource
+	publicKey    *rsa.PublicKey
+}
+
+func NewController(ctx context.Context, config controllers.ControllerConfig) controllers.IController {
+	c := &Controller{
+		kusciaClient: config.KusciaClient,
+		conf:         config.AppImageSync,
+	}
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	return c
+}
+
+func (c *Controller) Run(int) error {
+	if c.conf == nil || !c.conf.Enable {
+		nlog.Infof("AppImage sync is disabled, %s exits", c.Name())
+		return nil
+	}
+
+	keyData, err := os.ReadFile(c.conf.PublicKeyFile)
+	if err != nil {
+		return fmt.Errorf("read appimage sync public key failed, %v", err)
+	}
+	if c.publicKey, err = tls.ParseRSAPublicKey(keyData); err != nil {
+		return fmt.Errorf("parse appimage sync public key failed, %v", err)
+	}
+	if c.source, err = newBundleSource(c.conf); err != nil {
+		return err
+	}
+
+	nlog.Infof("Start syncing app images from %s every %v", c.conf.Source, c.conf.Interval())
+	wait.UntilWithContext(c.ctx, func(ctx context.Context) {
+		if err := c.sync(ctx); err != nil {
+			nlog.Warnf("Sync app images from %s failed, %v", c.conf.Source, err)
+		}
+	}, c.conf.Interval())
+	return nil
+}
+
+func (c *Controller) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+}
+
+func (c *Controller) Name() string {
+	return controllerName
+}
+
+// sync fetches the bundle and applies it, nothing is applied unless the whole bundle is verified and valid.
+func (c *Controller) sync(ctx context.Context) error {
+	fetchCtx, cancel := context.WithTimeout(ctx, c.conf.Timeout())
+	defer cancel()
+	bundle, signature, err := c.source.Fetch(fetchCtx)
+	if err != nil {
+		return err
+	}
+	if signature == "" {
+		return fmt.Errorf("bundle is not signed")
+	}
+	if err := tls.VerifyWithRSA(c.publicKey, bundle, signature); err != nil {
+		return fmt.Errorf("verify bundle signature failed, %v", err)
+	}
+
+	appImages, err := parseBundle(bundle)
+	if err != nil {
+		return err
+	}
+	return c.apply(ctx, appImages)
+}
+
+// parseBundle parses the yaml documents of the bundle, each of which must be an AppImage of a distinct name.
+func parseBundle(bundle []byte) ([]*kusciaapisv1alpha1.AppImage, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(bundle), 4096)
+	names := map[string]bool{}
+	var appImages []*kusciaapisv1alpha1.AppImage
+	for {
+		appImage := &kusciaapisv1alpha1.AppImage{}
+		if err := decoder.Decode(appImage); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("decode bundle failed, %v", err)
+		}
+		if appImage.Kind == "" && appImage.Name == "" {
+			continue
+		}
+		if appImage.Kind != "AppImage" {
+			return nil, fmt.Errorf("bundle contains %q of kind %q, only AppImage is allowed", appImage.Name, appImage.Kind)
+		}
+		if appImage.Name == "" {
+			return nil, fmt.Errorf("bundle contains an app image without name")
+		}
+		if names[appImage.Name] {
+			return nil, fmt.Errorf("bundle contains duplicated app image %q", appImage.Name)
+		}
+		names[appImage.Name] = true
+		appImages = append(appImages, appImage)
+	}
+	if len(appImages) == 0 {
+		return nil, fmt.Errorf("bundle contains no app image")
+	}
+	return appImages, nil
+}
+
+// apply creates or updates the app images of the bundle, and deletes the app images synced from the same source
+// but removed from the bundle if prune is enabled. The app images created locally of the same names are taken over.
+func (c *Controller) apply(ctx context.Context, appImages []*kusciaapisv1alpha1.AppImage) error {
+	client := c.kusciaClient.KusciaV1alpha1().AppImages()
+	synced := make(map[string]bool, len(appImages))
+	var created, updated, deleted int
+	for _, desired := range appImages {
+		synced[desired.Name] = true
+		existing, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			appImage := &kusciaapisv1alpha1.AppImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        desired.Name,
+					Labels:      desired.Labels,
+					Annotations: desired.Annotations,
+				},
+				Spec: desired.Spec,
+			}
+			c.markSynced(appImage)
+			if _, err := client.Create(ctx, appImage, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("create app image %s failed, %v", desired.Name, err)
+			}
+			created++
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("get app image %s failed, %v", desired.Name, err)
+		}
+
+		appImage := existing.DeepCopy()
+		if appImage.Labels[common.LabelAppImageSynced] != "true" {
+			nlog.Infof("Take over the local app image %s by the synced one", appImage.Name)
+		}
+		appImage.Spec = desired.Spec
+		appImage.Labels = mergeMap(appImage.Labels, desired.Labels)
+		appImage.Annotations = mergeMap(appImage.Annotations, desired.Annotations)
+		c.markSynced(appImage)
+		if reflect.DeepEqual(appImage, existing) {
+			continue
+		}
+		if _, err := client.Update(ctx, appImage, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("update app image %s failed, %v", desired.Name, err)
+		}
+		updated++
+	}
+
+	if c.conf.Prune {
+		list, err := client.List(ctx, metav1.ListOptions{LabelSelector: common.LabelAppImageSynced + "=true"})
+		if err != nil {
+			return fmt.Errorf("list synced app images failed, %v", err)
+		}
+		for _, appImage := range list.Items {
+			if synced[appImage.Name] || appImage.Annotations[common.AppImageSyncSourceAnnotationKey] != c.conf.Source {
+				continue
+			}
+			if err := client.Delete(ctx, appImage.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				return fmt.Errorf("delete app image %s failed, %v", appImage.Name, err)
+			}
+			deleted++
+		}
+	}
+
+	if created+updated+deleted > 0 {
+		nlog.Infof("Synced app images from %s, created %d, updated %d, deleted %d", c.conf.Source, created, updated, deleted)
+	}
+	return nil
+}
+
+func (c *Controller) markSynced(appImage *kusciaapisv1alpha1.AppImage) {
+	if appImage.Labels == nil {
+		appImage.Labels = map[string]string{}
+	}
+	if appImage.Annotations == nil {
+		appImage.Annotations = map[string]string{}
+	}
+	appImage.Labels[common.LabelAppImageSynced] = "true"
+	appImage.Annotations[common.AppImageSyncSourceAnnotationKey] = c.conf.Source
+}
+
+func mergeMap(dst, src map[string]string) map[string]string {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]string, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appimagesync
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+func makeBundle(tags map[string]string) string {
+	var bundle string
+	for _, n := range []string{"psi", "secretflow"} {
+		tag, ok := tags[n]
+		if !ok {
+			continue
+		}
+		bundle += fmt.Sprintf(`---
+apiVersion: kuscia.secretflow/v1alpha1
+kind: AppImage
+metadata:
+  name: %s
+  labels:
+    team: engine
+spec:
+  image:
+    name: secretflow/%s
+    tag: %s
+`, n, n, tag)
+	}
+	return bundle
+}
+
+func newTestController(t *testing.T, conf *kusciaconfig.AppImageSyncConfig, key *rsa.PrivateKey, objects ...*kusciaapisv1alpha1.AppImage) *Controller {
+	client := kusciafake.NewSimpleClientset()
+	for _, o := range objects {
+		_, err := client.KusciaV1alpha1().AppImages().Create(context.Background(), o, metav1.CreateOptions{})
+		assert.NoError(t, err)
+	}
+	source, err := newBundleSource(conf)
+	assert.NoError(t, err)
+	return &Controller{
+		ctx:          context.Background(),
+		kusciaClient: client,
+		conf:         conf,
+		source:       source,
+		publicKey:    &key.PublicKey,
+	}
+}
+
+func getAppImage(t *testing.T, c *Controller, name string) *kusciaapisv1alpha1.AppImage {
+	appImage, err := c.kusciaClient.KusciaV1alpha1().AppImages().Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+	return appImage
+}
+
+func TestParseBundle(t *testing.T) {
+	appImages, err := parseBundle([]byte(makeBundle(map[string]string{"psi": "0.1.0", "secretflow": "1.0.0"}) + "---\n"))
+	assert.NoError(t, err)
+	assert.Len(t, appImages, 2)
+	assert.Equal(t, "secretflow", appImages[1].Name)
+	assert.Equal(t, "1.0.0", appImages[1].Spec.Image.Tag)
+
+	_, err = parseBundle([]byte(makeBundle(map[string]string{"psi": "0.1.0"}) + makeBundle(map[string]string{"psi": "0.2.0"})))
+	assert.ErrorContains(t, err, "duplicated")
+
+	_, err = parseBundle([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: psi\n"))
+	assert.ErrorContains(t, err, "only AppImage is allowed")
+
+	_, err = parseBundle([]byte("---\n"))
+	assert.ErrorContains(t, err, "no app image")
+}
+
+func TestSyncFromHTTP(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	bundle := makeBundle(map[string]string{"secretflow": "1.1.0"})
+	signature, err := tls.SignWithRSA(key, bundle)
+	assert.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/appimages.yaml":
+			w.Write([]byte(bundle))
+		case "/appimages.yaml.sig":
+			w.Write([]byte(signature + "\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	conf := &kusciaconfig.AppImageSyncConfig{Enable: true, Source: server.URL + "/appimages.yaml", Token: "secret", Prune: true}
+	local := &kusciaapisv1alpha1.AppImage{
+		ObjectMeta: metav1.ObjectMeta{Name: "secretflow", Labels: map[string]string{"owner": "alice"}},
+		Spec:       kusciaapisv1alpha1.AppImageSpec{Image: kusciaapisv1alpha1.AppImageInfo{Name: "secretflow/secretflow", Tag: "1.0.0"}},
+	}
+	stale := &kusciaapisv1alpha1.AppImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "psi",
+			Labels:      map[string]string{common.LabelAppImageSynced: "true"},
+			Annotations: map[string]string{common.AppImageSyncSourceAnnotationKey: conf.Source},
+		},
+	}
+	other := &kusciaapisv1alpha1.AppImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "other",
+			Labels:      map[string]string{common.LabelAppImageSynced: "true"},
+			Annotations: map[string]string{common.AppImageSyncSourceAnnotationKey: "https://other/appimages.yaml"},
+		},
+	}
+	c := newTestController(t, conf, key, local, stale, other)
+
+	assert.NoError(t, c.sync(context.Background()))
+	synced := getAppImage(t, c, "secretflow")
+	assert.Equal(t, "1.1.0", synced.Spec.Image.Tag)
+	assert.Equal(t, map[string]string{"owner": "alice", "team": "engine", common.LabelAppImageSynced: "true"}, synced.Labels)
+	assert.Equal(t, conf.Source, synced.Annotations[common.AppImageSyncSourceAnnotationKey])
+	assert.Nil(t, getAppImage(t, c, "psi"))
+	assert.NotNil(t, getAppImage(t, c, "other"))
+
+	// the bundle of a wrong signature is not applied
+	bundle = makeBundle(map[string]string{"secretflow": "1.2.0"})
+	assert.ErrorContains(t, c.sync(context.Background()), "verify bundle signature failed")
+	assert.Equal(t, "1.1.0", getAppImage(t, c, "secretflow").Spec.Image.Tag)
+}
+
+func TestSyncFromOCI(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	bundle := makeBundle(map[string]string{"psi": "0.1.0", "secretflow": "1.1.0"})
+	signature, err := tls.SignWithRSA(key, bundle)
+	assert.NoError(t, err)
+	artifact, err := mutate.AppendLayers(empty.Image, static.NewLayer([]byte(bundle), BundleMediaType))
+	assert.NoError(t, err)
+	artifact = mutate.Annotations(artifact, map[string]string{SignatureAnnotation: signature}).(v1.Image)
+	ref, err := name.ParseReference(u.Host + "/kuscia/appimages:v1")
+	assert.NoError(t, err)
+	assert.NoError(t, remote.Write(ref, artifact))
+
+	conf := &kusciaconfig.AppImageSyncConfig{Enable: true, Source: kusciaconfig.AppImageSyncOCIScheme + ref.String()}
+	c := newTestController(t, conf, key)
+	assert.NoError(t, c.sync(context.Background()))
+	assert.Equal(t, "0.1.0", getAppImage(t, c, "psi").Spec.Image.Tag)
+	assert.Equal(t, "1.1.0", getAppImage(t, c, "secretflow").Spec.Image.Tag)
+
+	// the unsigned artifact is not applied
+	unsigned, err := mutate.AppendLayers(empty.Image, static.NewLayer([]byte(makeBundle(map[string]string{"psi": "0.2.0"})), BundleMediaType))
+	assert.NoError(t, err)
+	assert.NoError(t, remote.Write(ref, unsigned))
+	assert.ErrorContains(t, c.sync(context.Background()), "not signed")
+	assert.Equal(t, "0.1.0", getAppImage(t, c, "psi").Spec.Image.Tag)
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appimagesync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+const (
+	// BundleMediaType is the media type of the layer holding the bundle in an oci artifact.
+	BundleMediaType = "application/vnd.kuscia.appimages.v1+yaml"
+	// SignatureAnnotation is the manifest annotation holding the signature of the bundle in an oci artifact.
+	SignatureAnnotation = "kuscia.secretflow/signature"
+
+	maxBundleBytes = 16 << 20
+)
+
+// bundleSource fetches the bundle and its signature from the registry.
+type bundleSource interface {
+	Fetch(ctx context.Context) (bundle []byte, signature string, err error)
+}
+
+func newBundleSource(conf *kusciaconfig.AppImageSyncConfig) (bundleSource, error) {
+	if conf.IsOCI() {
+		ref, err := name.ParseReference(strings.TrimPrefix(conf.Source, kusciaconfig.AppImageSyncOCIScheme))
+		if err != nil {
+			return nil, fmt.Errorf("parse oci artifact %q failed, %v", conf.Source, err)
+		}
+		return &ociSource{ref: ref, username: conf.Username, password: conf.Password}, nil
+	}
+
+	signatureURL := conf.SignatureURL
+	if signatureURL == "" {
+		signatureURL = conf.Source + ".sig"
+	}
+	return &httpSource{
+		url:          conf.Source,
+		signatureURL: signatureURL,
+		token:        conf.Token,
+		client:       &http.Client{Timeout: conf.Timeout()},
+	}, nil
+}
+
+type httpSource struct {
+	url          string
+	signatureURL string
+	token        string
+	client       *http.Client
+}
+
+func (s *httpSource) Fetch(ctx context.Context) ([]byte, string, error) {
+	bundle, err := s.get(ctx, s.url)
+	if err != nil {
+		return nil, "", err
+	}
+	signature, err := s.get(ctx, s.signatureURL)
+	if err != nil {
+		return nil, "", err
+	}
+	return bundle, strings.TrimSpace(string(signature)), nil
+}
+
+func (s *httpSource) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s failed with status %d", url, resp.StatusCode)
+	}
+	return readLimited(resp.Body)
+}
+
+type ociSource struct {
+	ref      name.Reference
+	username string
+	password string
+}
+
+// Fetch pulls the layer of BundleMediaType, or the first layer if none, from the artifact.
+func (s *ociSource) Fetch(ctx context.Context) ([]byte, string, error) {
+	opts := []remote.Option{remote.WithContext(ctx), remote.WithUserAgent("kuscia")}
+	if s.username != "" {
+		opts = append(opts, remote.WithAuth(&authn.Basic{Username: s.username, Password: s.password}))
+	}
+
+	desc, err := remote.Get(s.ref, opts...)
+	if err != nil {
+		return nil, "", fmt.Errorf("get oci artifact %s failed, %v", s.ref, err)
+	}
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return nil, "", fmt.Errorf("parse manifest of oci artifact %s failed, %v", s.ref, err)
+	}
+	if len(manifest.Layers) == 0 {
+		return nil, "", fmt.Errorf("oci artifact %s has no layer", s.ref)
+	}
+
+	bundleLayer := manifest.Layers[0]
+	for _, l := range manifest.Layers {
+		if l.MediaType == BundleMediaType {
+			bundleLayer = l
+			break
+		}
+	}
+	layer, err := remote.Layer(s.ref.Context().Digest(bundleLayer.Digest.String()), opts...)
+	if err != nil {
+		return nil, "", fmt.Errorf("get layer %s of oci artifact %s failed, %v", bundleLayer.Digest, s.ref, err)
+	}
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, "", fmt.Errorf("pull layer %s of oci artifact %s failed, %v", bundleLayer.Digest, s.ref, err)
+	}
+	defer rc.Close()
+	bundle, err := readLimited(rc)
+	if err != nil {
+		return nil, "", err
+	}
+	return bundle, manifest.Annotations[SignatureAnnotation], nil
+}
+
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxBundleBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxBundleBytes {
+		return nil, fmt.Errorf("bundle exceeds %d bytes", maxBundleBytes)
+	}
+	return data, nil
+}
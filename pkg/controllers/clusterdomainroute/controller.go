@@ -310,6 +310,10 @@ func (c *controller) syncHandler(ctx context.Context, key string) error {
 		return syncErr
 	}
 
+	if hasUpdate, syncErr := c.syncReportsFromDomainroute(cdr, srcdr); syncErr != nil || hasUpdate {
+		return syncErr
+	}
+
 	return c.checkInteropConfig(ctx, cdr, sourceRole, destRole)
 }
 
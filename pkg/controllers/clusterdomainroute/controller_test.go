@@ -387,4 +387,54 @@ func Test_controller_syncDomainRouteStatus(t *testing.T) {
 	cdr, err = c.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(c.ctx, testCdr1.Name, metav1.GetOptions{})
 	assert.NoError(t, err)
 	assert.True(t, IsReady(&cdr.Status))
+
+	srcDr.Status.TokenStatus.Rotations = []kusciaapisv1alpha1.DomainRouteTokenRotation{{Revision: 1, PreviousRevision: 0}}
+	update, err = c.syncStatusFromDomainroute(cdr, srcDr, dstDr)
+	assert.NoError(t, err)
+	assert.True(t, update)
+	cdr, err = c.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(c.ctx, testCdr1.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, srcDr.Status.TokenStatus.Rotations, cdr.Status.TokenStatus.Rotations)
+}
+
+func Test_controller_syncReportsFromDomainroute(t *testing.T) {
+	c := NewTestController()
+	cdr := &kusciaapisv1alpha1.ClusterDomainRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice-bob"},
+	}
+	cdr, err := c.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Create(context.Background(), cdr, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	srcDr := &kusciaapisv1alpha1.DomainRoute{}
+	update, err := c.syncReportsFromDomainroute(cdr, srcDr)
+	assert.NoError(t, err)
+	assert.False(t, update)
+
+	srcDr.Status.Probe = &kusciaapisv1alpha1.DomainRouteProbeStatus{
+		Result:      kusciaapisv1alpha1.DomainRouteProbeUnauthorized,
+		Message:     "token not found in destination",
+		LossPercent: 0,
+	}
+	update, err = c.syncReportsFromDomainroute(cdr, srcDr)
+	assert.NoError(t, err)
+	assert.True(t, update)
+	cdr, err = c.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(c.ctx, cdr.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteProbeUnauthorized, cdr.Status.Probe.Result)
+
+	update, err = c.syncReportsFromDomainroute(cdr, srcDr)
+	assert.NoError(t, err)
+	assert.False(t, update)
+
+	srcDr.Status.Protocol = &kusciaapisv1alpha1.DomainRouteProtocolStatus{
+		Configured:  kusciaapisv1alpha1.DomainRouteTransportNOTLS,
+		Destination: kusciaapisv1alpha1.DomainRouteTransportTLS,
+		Negotiated:  kusciaapisv1alpha1.DomainRouteTransportTLS,
+	}
+	update, err = c.syncReportsFromDomainroute(cdr, srcDr)
+	assert.NoError(t, err)
+	assert.True(t, update)
+	cdr, err = c.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(c.ctx, cdr.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteTransportTLS, cdr.Status.Protocol.Negotiated)
 }
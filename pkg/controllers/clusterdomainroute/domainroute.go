@@ -97,7 +97,8 @@ func (c *controller) syncStatusFromDomainroute(cdr *kusciaapisv1alpha1.ClusterDo
 	needUpdate := false
 	cdr = cdr.DeepCopy()
 
-	isSrcTokenChanged := srcdr != nil && !reflect.DeepEqual(cdr.Status.TokenStatus.SourceTokens, srcdr.Status.TokenStatus.Tokens)
+	isSrcTokenChanged := srcdr != nil && (!reflect.DeepEqual(cdr.Status.TokenStatus.SourceTokens, srcdr.Status.TokenStatus.Tokens) ||
+		!reflect.DeepEqual(cdr.Status.TokenStatus.Rotations, srcdr.Status.TokenStatus.Rotations))
 	isSrcStatusChanged := srcdr != nil && !srcdr.Status.IsDestinationUnreachable != IsReady(&cdr.Status)
 
 	// init new condition
@@ -105,6 +106,7 @@ func (c *controller) syncStatusFromDomainroute(cdr *kusciaapisv1alpha1.ClusterDo
 
 	if isSrcTokenChanged || isSrcStatusChanged {
 		cdr.Status.TokenStatus.SourceTokens = srcdr.Status.TokenStatus.Tokens
+		cdr.Status.TokenStatus.Rotations = srcdr.Status.TokenStatus.Rotations
 		needUpdate = true
 
 		if len(cdr.Status.TokenStatus.SourceTokens) == 0 {
@@ -152,6 +154,22 @@ func (c *controller) syncStatusFromDomainroute(cdr *kusciaapisv1alpha1.ClusterDo
 	return false, nil
 }
 
+// syncReportsFromDomainroute copies the probe and protocol statuses reported by the gateway of source. They're
+// synced apart from the conditions, since the probe status changes much more often.
+func (c *controller) syncReportsFromDomainroute(cdr *kusciaapisv1alpha1.ClusterDomainRoute, srcdr *kusciaapisv1alpha1.DomainRoute) (bool, error) {
+	if srcdr == nil || (reflect.DeepEqual(cdr.Status.Probe, srcdr.Status.Probe) && reflect.DeepEqual(cdr.Status.Protocol, srcdr.Status.Protocol)) {
+		return false, nil
+	}
+	cdr = cdr.DeepCopy()
+	cdr.Status.Probe = srcdr.Status.Probe.DeepCopy()
+	cdr.Status.Protocol = srcdr.Status.Protocol.DeepCopy()
+	_, err := c.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().UpdateStatus(c.ctx, cdr, metav1.UpdateOptions{})
+	if err != nil && !k8serrors.IsConflict(err) {
+		return true, err
+	}
+	return true, nil
+}
+
 func IsTokenHeartBeatTimeout(tokens []kusciaapisv1alpha1.DomainRouteToken) bool {
 	readyTokens := make([]kusciaapisv1alpha1.DomainRouteToken, 0)
 	for _, token := range tokens {
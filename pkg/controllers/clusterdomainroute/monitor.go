@@ -49,6 +49,7 @@ func (c *controller) UpdateStatus(ctx context.Context) error {
 			cdr.Status.EndpointStatuses = map[string]kusciaapisv1alpha1.ClusterDomainRouteEndpointStatus{}
 		}
 		endpointsHealthy := map[string]bool{}
+		throughput := &kusciaapisv1alpha1.DomainRouteThroughput{}
 		for _, gw := range gws {
 			if time.Since(gw.Status.HeartbeatTime.Time) > common.GatewayLiveTimeout {
 				continue
@@ -59,9 +60,14 @@ func (c *controller) UpdateStatus(ctx context.Context) error {
 					if metric.Type != "DomainRoute" {
 						continue
 					}
-					if metric.Name == expectMetricsName && metric.HealthyEndpointsCount > 0 {
+					if metric.Name != expectMetricsName {
+						continue
+					}
+					if metric.HealthyEndpointsCount > 0 {
 						endpointsHealthy[gw.Name+"-"+port.Name] = true
 					}
+					throughput.TransmitKbps += metric.TransmitKbps
+					throughput.ReceiveKbps += metric.ReceiveKbps
 				}
 			}
 		}
@@ -82,6 +88,10 @@ func (c *controller) UpdateStatus(ctx context.Context) error {
 			}
 		}
 
+		if updateThroughput(&cdr.Status, throughput) {
+			update = true
+		}
+
 		if update {
 			_, err := c.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().UpdateStatus(ctx, cdr, metav1.UpdateOptions{})
 			if err != nil && !k8serrors.IsConflict(err) {
@@ -95,3 +105,17 @@ func (c *controller) UpdateStatus(ctx context.Context) error {
 
 	return nil
 }
+
+// updateThroughput sets the throughput of the route, it returns false if the throughput isn't changed.
+func updateThroughput(status *kusciaapisv1alpha1.ClusterDomainRouteStatus, throughput *kusciaapisv1alpha1.DomainRouteThroughput) bool {
+	if status.Throughput != nil && status.Throughput.TransmitKbps == throughput.TransmitKbps &&
+		status.Throughput.ReceiveKbps == throughput.ReceiveKbps {
+		return false
+	}
+	if status.Throughput == nil && throughput.TransmitKbps == 0 && throughput.ReceiveKbps == 0 {
+		return false
+	}
+	throughput.LastUpdateTime = metav1.Now()
+	status.Throughput = throughput
+	return true
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterdomainroute
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func TestUpdateThroughput(t *testing.T) {
+	status := &kusciaapisv1alpha1.ClusterDomainRouteStatus{}
+	// idle routes don't report throughput
+	assert.Equal(t, false, updateThroughput(status, &kusciaapisv1alpha1.DomainRouteThroughput{}))
+	assert.Assert(t, status.Throughput == nil)
+
+	assert.Equal(t, true, updateThroughput(status, &kusciaapisv1alpha1.DomainRouteThroughput{TransmitKbps: 100, ReceiveKbps: 10}))
+	assert.Equal(t, int64(100), status.Throughput.TransmitKbps)
+	assert.Assert(t, !status.Throughput.LastUpdateTime.IsZero())
+
+	assert.Equal(t, false, updateThroughput(status, &kusciaapisv1alpha1.DomainRouteThroughput{TransmitKbps: 100, ReceiveKbps: 10}))
+
+	assert.Equal(t, true, updateThroughput(status, &kusciaapisv1alpha1.DomainRouteThroughput{}))
+	assert.Equal(t, int64(0), status.Throughput.TransmitKbps)
+}
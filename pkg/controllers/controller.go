@@ -19,13 +19,22 @@ import (
 
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 
 	"github.com/secretflow/kuscia/pkg/common"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 )
 
+// IController is run by the leader of the controller manager only. The leader runs the controllers when it starts
+// leading, and stops them when it loses the lease, so that at most one replica reconciles the resources at a time:
+//   - Run starts the informers and workers, and blocks until the controller is stopped or its context is done.
+//   - Stop cancels the context of the controller, the sync in progress runs to the end, so the handlers must be
+//     idempotent as the new leader resyncs all resources from its own informer cache.
+//
+// The controllers are created again with a new context if the replica is elected again.
 type IController interface {
 	Run(int) error
 	Stop()
@@ -56,7 +65,9 @@ const (
 	CRDDomainAppImagesName     = "domainappimages.kuscia.secretflow"
 	CRDDomainRoutesName        = "domainroutes.kuscia.secretflow"
 	CRDDomainsName             = "domains.kuscia.secretflow"
+	CRDDomainDataSourcesName   = "domaindatasources.kuscia.secretflow"
 	CRDGatewaysName            = "gateways.kuscia.secretflow"
+	CRDImagePrewarmsName       = "imageprewarms.kuscia.secretflow"
 	CRDKusciaTasksName         = "kusciatasks.kuscia.secretflow"
 	CRDKusciaDeploymentsName   = "kusciadeployments.kuscia.secretflow"
 	CRDTaskResourcesGroupsName = "taskresourcegroups.kuscia.secretflow"
@@ -70,6 +81,14 @@ type ControllerConfig struct {
 	RootDir               string
 	KubeClient            kubernetes.Interface
 	KusciaClient          kusciaclientset.Interface
+	ExtensionsClient      apiextensionsclientset.Interface
+	DynamicClient         dynamic.Interface
 	EventRecorder         record.EventRecorder
 	EnableWorkloadApprove bool
+	GrantWebhook          *kusciaconfig.WebhookConfig
+	AppImageSync          *kusciaconfig.AppImageSyncConfig
+	GitOps                *kusciaconfig.GitOpsConfig
+	Alerting              *kusciaconfig.AlertingConfig
+	JobArchive            *kusciaconfig.JobArchiveConfig
+	TaskGC                *kusciaconfig.TaskGCConfig
 }
@@ -0,0 +1,154 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crdmigration
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/secretflow/kuscia/pkg/controllers"
+	"github.com/secretflow/kuscia/pkg/migration"
+	"github.com/secretflow/kuscia/pkg/utils/meta"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	controllerName = "crd-migration-controller"
+
+	// StatusConfigMapName is the configmap in kube-system recording the summary of the last migration.
+	StatusConfigMapName = "kuscia-crd-migration"
+	statusVersionKey    = "version"
+	statusSucceededKey  = "succeeded"
+	statusSummaryKey    = "summary"
+
+	defaultRetryInterval = 10 * time.Minute
+)
+
+// Controller migrates the Kuscia custom resources once per kuscia version. It runs on the leader of the controller
+// manager after an upgrade, and retries until all the objects are migrated.
+type Controller struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	kubeClient    kubernetes.Interface
+	migrator      *migration.Migrator
+	retryInterval time.Duration
+}
+
+func NewController(ctx context.Context, config controllers.ControllerConfig) controllers.IController {
+	c := &Controller{
+		kubeClient:    config.KubeClient,
+		migrator:      migration.NewMigrator(config.DynamicClient, config.ExtensionsClient, migration.Options{}),
+		retryInterval: defaultRetryInterval,
+	}
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	return c
+}
+
+func (c *Controller) Run(int) error {
+	nlog.Info("Starting crd migration controller")
+	if c.migrated() {
+		nlog.Infof("Kuscia resources are already migrated for version %s", meta.KusciaVersionString())
+	} else {
+		for !c.migrate() {
+			select {
+			case <-c.ctx.Done():
+				return nil
+			case <-time.After(c.retryInterval):
+			}
+		}
+	}
+	<-c.ctx.Done()
+	return nil
+}
+
+func (c *Controller) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+}
+
+func (c *Controller) Name() string {
+	return controllerName
+}
+
+// migrated reports whether the last migration succeeded for the current version.
+func (c *Controller) migrated() bool {
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(c.ctx, StatusConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			nlog.Warnf("Get configmap %s failed, %v", StatusConfigMapName, err)
+		}
+		return false
+	}
+	return cm.Data[statusVersionKey] == meta.KusciaVersionString() && cm.Data[statusSucceededKey] == "true"
+}
+
+// migrate runs the migration and records the summary, it returns true if all the objects are migrated.
+func (c *Controller) migrate() bool {
+	summary, err := c.migrator.Run(c.ctx)
+	if err != nil {
+		nlog.Errorf("Migrate kuscia resources failed, %v", err)
+		return false
+	}
+	for _, r := range summary.Resources {
+		nlog.Infof("Migrate %s: total %d, migrated %d, rewritten %d, failed %d", r.Resource, r.Total, r.Migrated, r.Rewritten, r.Failed)
+	}
+	if err = c.saveSummary(summary); err != nil {
+		nlog.Warnf("Save crd migration summary failed, %v", err)
+		return false
+	}
+	if !summary.Succeeded() {
+		nlog.Warnf("Some kuscia resources failed to migrate, retry in %v", c.retryInterval)
+		return false
+	}
+	return true
+}
+
+func (c *Controller) saveSummary(summary *migration.Summary) error {
+	content, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	data := map[string]string{
+		statusVersionKey:   summary.Version,
+		statusSucceededKey: strconv.FormatBool(summary.Succeeded()),
+		statusSummaryKey:   string(content),
+	}
+
+	configMaps := c.kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem)
+	cm, err := configMaps.Get(c.ctx, StatusConfigMapName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = configMaps.Create(c.ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: StatusConfigMapName, Namespace: metav1.NamespaceSystem},
+			Data:       data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	cm = cm.DeepCopy()
+	cm.Data = data
+	_, err = configMaps.Update(c.ctx, cm, metav1.UpdateOptions{})
+	return err
+}
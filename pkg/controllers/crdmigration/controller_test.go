@@ -0,0 +1,83 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crdmigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/controllers"
+	"github.com/secretflow/kuscia/pkg/utils/meta"
+)
+
+func TestController(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "kuscia.secretflow", Version: "v1alpha1", Resource: "kusciajobs"}
+	job := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kuscia.secretflow/v1alpha1",
+		"kind":       "KusciaJob",
+		"metadata":   map[string]interface{}{"name": "job-1", "namespace": "cross-domain"},
+		"spec":       map[string]interface{}{},
+	}}
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "kusciajobs.kuscia.secretflow"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group:    "kuscia.secretflow",
+			Names:    apiextensionsv1.CustomResourceDefinitionNames{Plural: "kusciajobs", Kind: "KusciaJob"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1alpha1", Served: true, Storage: true}},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1alpha1"}},
+	}
+	kubeClient := kubefake.NewSimpleClientset()
+	c := NewController(context.Background(), controllers.ControllerConfig{
+		KubeClient:       kubeClient,
+		ExtensionsClient: apiextensionsfake.NewSimpleClientset(crd),
+		DynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{gvr: "KusciaJobList"}, job),
+	}).(*Controller)
+
+	assert.False(t, c.migrated())
+	assert.True(t, c.migrate())
+	assert.True(t, c.migrated())
+
+	cm, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(context.Background(), StatusConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, meta.KusciaVersionString(), cm.Data[statusVersionKey])
+	assert.Equal(t, "true", cm.Data[statusSucceededKey])
+	assert.Contains(t, cm.Data[statusSummaryKey], `"migrated":1`)
+
+	done := make(chan error)
+	go func() {
+		done <- c.Run(1)
+	}()
+	c.Stop()
+	select {
+	case err = <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("controller doesn't stop")
+	}
+}
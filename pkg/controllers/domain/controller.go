@@ -364,6 +364,13 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (err error) {
 		return err
 	}
 
+	if rawDomain.DeletionTimestamp != nil {
+		return c.offboard(ctx, rawDomain.DeepCopy())
+	}
+	if err = c.ensureOffboardingFinalizer(ctx, rawDomain); err != nil {
+		return err
+	}
+
 	domain := rawDomain.DeepCopy()
 	scheme.Scheme.Default(domain)
 
@@ -57,6 +57,7 @@ func (c *Controller) syncDomainStatus(dm *kusciaapisv1alpha1.Domain) error {
 	newStatus := &kusciaapisv1alpha1.DomainStatus{}
 	newStatus.NodeStatuses = c.newDomainNodeStatus(deepCopy)
 	newStatus.DeployTokenStatuses = c.newDomainTokenStatus(deepCopy)
+	newStatus.Conditions = c.newDomainConditions(deepCopy, newStatus)
 	if !c.isDomainStatusEqual(oldStatus, newStatus) {
 		nlog.Infof("Update domain %v status", deepCopy.Name)
 		deepCopy.Status = newStatus
@@ -0,0 +1,215 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	apiv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+// ensureOffboardingFinalizer adds the offboarding finalizer to the domain, so that deleting the domain
+// waits until the resources referring to it are cleaned up.
+func (c *Controller) ensureOffboardingFinalizer(ctx context.Context, domain *kusciaapisv1alpha1.Domain) error {
+	if slices.Contains(domain.Finalizers, common.DomainOffboardingFinalizer) {
+		return nil
+	}
+	domainCopy := domain.DeepCopy()
+	domainCopy.Finalizers = append(domainCopy.Finalizers, common.DomainOffboardingFinalizer)
+	_, err := c.kusciaClient.KusciaV1alpha1().Domains().Update(ctx, domainCopy, apismetav1.UpdateOptions{})
+	return err
+}
+
+// offboard cleans up the resources referring to the deleted domain and then removes the offboarding finalizer.
+// Running jobs involving the domain are cancelled, the grants and routes of the domain are revoked, the domain is
+// removed from the interop configs, and the namespace of the domain is deleted along with the resources in it.
+func (c *Controller) offboard(ctx context.Context, domain *kusciaapisv1alpha1.Domain) error {
+	if !slices.Contains(domain.Finalizers, common.DomainOffboardingFinalizer) {
+		return nil
+	}
+
+	nlog.Infof("Offboard domain %s", domain.Name)
+	cleanups := []struct {
+		name string
+		fn   func(ctx context.Context, domainID string) error
+	}{
+		{name: "cancel jobs", fn: c.cancelDomainJobs},
+		{name: "revoke grants", fn: c.revokeDomainGrants},
+		{name: "delete routes", fn: c.deleteDomainRoutes},
+		{name: "clean up interop configs", fn: c.cleanupInteropConfigs},
+		{name: "delete namespace", fn: c.purgeNamespace},
+	}
+	for _, cleanup := range cleanups {
+		if err := cleanup.fn(ctx, domain.Name); err != nil {
+			nlog.Warnf("Offboard domain %s failed to %s: %v", domain.Name, cleanup.name, err)
+			return fmt.Errorf("failed to %s, %v", cleanup.name, err)
+		}
+	}
+
+	domain.Finalizers = slices.DeleteFunc(domain.Finalizers, func(f string) bool {
+		return f == common.DomainOffboardingFinalizer
+	})
+	if _, err := c.kusciaClient.KusciaV1alpha1().Domains().Update(ctx, domain, apismetav1.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	nlog.Infof("Domain %s is offboarded", domain.Name)
+	return nil
+}
+
+// cancelDomainJobs moves the unfinished jobs that the domain initiates or participates in to the cancel stage.
+func (c *Controller) cancelDomainJobs(ctx context.Context, domainID string) error {
+	jobs, err := c.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).List(ctx, apismetav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if isJobFinished(job) || !isJobInvolvingDomain(job, domainID) {
+			continue
+		}
+		if job.Labels[common.LabelJobStage] == string(kusciaapisv1alpha1.JobCancelStage) {
+			continue
+		}
+
+		if job.Labels == nil {
+			job.Labels = make(map[string]string)
+		}
+		job.Labels[common.LabelJobStage] = string(kusciaapisv1alpha1.JobCancelStage)
+		job.Labels[common.LabelJobStageTrigger] = domainID
+		jobVersion := "1"
+		if v, ok := job.Labels[common.LabelJobStageVersion]; ok {
+			if iV, convErr := strconv.Atoi(v); convErr == nil {
+				jobVersion = strconv.Itoa(iV + 1)
+			}
+		}
+		job.Labels[common.LabelJobStageVersion] = jobVersion
+		if _, err = c.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Update(ctx, job, apismetav1.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		nlog.Infof("Cancel job %s since domain %s is offboarded", job.Name, domainID)
+	}
+	return nil
+}
+
+// revokeDomainGrants deletes the grants to the domain in other domains, the grants of the domain itself are
+// deleted along with its namespace.
+func (c *Controller) revokeDomainGrants(ctx context.Context, domainID string) error {
+	grants, err := c.kusciaClient.KusciaV1alpha1().DomainDataGrants(apiv1.NamespaceAll).List(ctx, apismetav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, grant := range grants.Items {
+		if grant.Spec.GrantDomain != domainID || grant.Namespace == domainID {
+			continue
+		}
+		err = c.kusciaClient.KusciaV1alpha1().DomainDataGrants(grant.Namespace).Delete(ctx, grant.Name, apismetav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		nlog.Infof("Revoke domain data grant %s/%s since domain %s is offboarded", grant.Namespace, grant.Name, domainID)
+	}
+	return nil
+}
+
+// deleteDomainRoutes deletes the cluster domain routes from or to the domain, the domain routes derived from them
+// are garbage collected by their owner references.
+func (c *Controller) deleteDomainRoutes(ctx context.Context, domainID string) error {
+	routes, err := c.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().List(ctx, apismetav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, route := range routes.Items {
+		if route.Spec.Source != domainID && route.Spec.Destination != domainID {
+			continue
+		}
+		err = c.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Delete(ctx, route.Name, apismetav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		nlog.Infof("Delete cluster domain route %s since domain %s is offboarded", route.Name, domainID)
+	}
+	return nil
+}
+
+// cleanupInteropConfigs deletes the interop configs hosted by the domain, and removes the domain from the members
+// of the others. An interop config without members left is deleted.
+func (c *Controller) cleanupInteropConfigs(ctx context.Context, domainID string) error {
+	configs, err := c.kusciaClient.KusciaV1alpha1().InteropConfigs().List(ctx, apismetav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range configs.Items {
+		config := &configs.Items[i]
+		if config.Spec.Host != domainID && !slices.Contains(config.Spec.Members, domainID) {
+			continue
+		}
+
+		config.Spec.Members = slices.DeleteFunc(config.Spec.Members, func(member string) bool {
+			return member == domainID
+		})
+		if config.Spec.Host == domainID || len(config.Spec.Members) == 0 {
+			err = c.kusciaClient.KusciaV1alpha1().InteropConfigs().Delete(ctx, config.Name, apismetav1.DeleteOptions{})
+			nlog.Infof("Delete interop config %s since domain %s is offboarded", config.Name, domainID)
+		} else {
+			_, err = c.kusciaClient.KusciaV1alpha1().InteropConfigs().Update(ctx, config, apismetav1.UpdateOptions{})
+			nlog.Infof("Remove domain %s from interop config %s since it is offboarded", domainID, config.Name)
+		}
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// purgeNamespace deletes the namespace of the domain, the resources in it are garbage collected with it.
+func (c *Controller) purgeNamespace(ctx context.Context, domainID string) error {
+	err := c.kubeClient.CoreV1().Namespaces().Delete(ctx, domainID, apismetav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func isJobFinished(job *kusciaapisv1alpha1.KusciaJob) bool {
+	switch job.Status.Phase {
+	case kusciaapisv1alpha1.KusciaJobSucceeded, kusciaapisv1alpha1.KusciaJobFailed,
+		kusciaapisv1alpha1.KusciaJobCancelled, kusciaapisv1alpha1.KusciaJobApprovalReject:
+		return true
+	}
+	return false
+}
+
+func isJobInvolvingDomain(job *kusciaapisv1alpha1.KusciaJob, domainID string) bool {
+	if job.Spec.Initiator == domainID {
+		return true
+	}
+	for _, task := range job.Spec.Tasks {
+		for _, party := range task.Parties {
+			if party.DomainID == domainID {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apicorev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+)
+
+func makeTestJob(name, initiator string, phase kusciaapisv1alpha1.KusciaJobPhase, parties ...string) *kusciaapisv1alpha1.KusciaJob {
+	job := &kusciaapisv1alpha1.KusciaJob{
+		ObjectMeta: apismetav1.ObjectMeta{Name: name, Namespace: common.KusciaCrossDomain},
+		Spec: kusciaapisv1alpha1.KusciaJobSpec{
+			Initiator: initiator,
+			Tasks:     []kusciaapisv1alpha1.KusciaTaskTemplate{{}},
+		},
+		Status: kusciaapisv1alpha1.KusciaJobStatus{Phase: phase},
+	}
+	for _, p := range parties {
+		job.Spec.Tasks[0].Parties = append(job.Spec.Tasks[0].Parties, kusciaapisv1alpha1.Party{DomainID: p})
+	}
+	return job
+}
+
+func TestOffboard(t *testing.T) {
+	ctx := context.Background()
+	now := apismetav1.Now()
+	domain := makeTestDomain("alice")
+	domain.Finalizers = []string{common.DomainOffboardingFinalizer}
+	domain.DeletionTimestamp = &now
+
+	kusciaClient := kusciafake.NewSimpleClientset(
+		domain,
+		makeTestJob("job-running", "bob", kusciaapisv1alpha1.KusciaJobRunning, "alice", "bob"),
+		makeTestJob("job-succeeded", "alice", kusciaapisv1alpha1.KusciaJobSucceeded, "alice", "bob"),
+		makeTestJob("job-other", "bob", kusciaapisv1alpha1.KusciaJobRunning, "bob", "carol"),
+		&kusciaapisv1alpha1.DomainDataGrant{
+			ObjectMeta: apismetav1.ObjectMeta{Name: "grant-to-alice", Namespace: "bob"},
+			Spec:       kusciaapisv1alpha1.DomainDataGrantSpec{GrantDomain: "alice"},
+		},
+		&kusciaapisv1alpha1.DomainDataGrant{
+			ObjectMeta: apismetav1.ObjectMeta{Name: "grant-to-carol", Namespace: "bob"},
+			Spec:       kusciaapisv1alpha1.DomainDataGrantSpec{GrantDomain: "carol"},
+		},
+		&kusciaapisv1alpha1.ClusterDomainRoute{
+			ObjectMeta: apismetav1.ObjectMeta{Name: "bob-alice"},
+			Spec:       kusciaapisv1alpha1.ClusterDomainRouteSpec{DomainRouteSpec: kusciaapisv1alpha1.DomainRouteSpec{Source: "bob", Destination: "alice"}},
+		},
+		&kusciaapisv1alpha1.ClusterDomainRoute{
+			ObjectMeta: apismetav1.ObjectMeta{Name: "bob-carol"},
+			Spec:       kusciaapisv1alpha1.ClusterDomainRouteSpec{DomainRouteSpec: kusciaapisv1alpha1.DomainRouteSpec{Source: "bob", Destination: "carol"}},
+		},
+		&kusciaapisv1alpha1.InteropConfig{
+			ObjectMeta: apismetav1.ObjectMeta{Name: "alice-interop"},
+			Spec:       kusciaapisv1alpha1.InteropConfigSpec{Host: "alice", Members: []string{"bob"}},
+		},
+		&kusciaapisv1alpha1.InteropConfig{
+			ObjectMeta: apismetav1.ObjectMeta{Name: "bob-interop"},
+			Spec:       kusciaapisv1alpha1.InteropConfigSpec{Host: "bob", Members: []string{"alice", "carol"}},
+		},
+	)
+	kubeClient := kubefake.NewSimpleClientset(&apicorev1.Namespace{ObjectMeta: apismetav1.ObjectMeta{Name: "alice"}})
+	c := &Controller{
+		kubeClient:   kubeClient,
+		kusciaClient: kusciaClient,
+	}
+
+	assert.NoError(t, c.offboard(ctx, domain.DeepCopy()))
+
+	job, err := kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, "job-running", apismetav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, string(kusciaapisv1alpha1.JobCancelStage), job.Labels[common.LabelJobStage])
+	assert.Equal(t, "alice", job.Labels[common.LabelJobStageTrigger])
+	assert.Equal(t, "1", job.Labels[common.LabelJobStageVersion])
+	for _, name := range []string{"job-succeeded", "job-other"} {
+		job, err = kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, name, apismetav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, job.Labels[common.LabelJobStage])
+	}
+
+	_, err = kusciaClient.KusciaV1alpha1().DomainDataGrants("bob").Get(ctx, "grant-to-alice", apismetav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	_, err = kusciaClient.KusciaV1alpha1().DomainDataGrants("bob").Get(ctx, "grant-to-carol", apismetav1.GetOptions{})
+	assert.NoError(t, err)
+
+	_, err = kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(ctx, "bob-alice", apismetav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	_, err = kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(ctx, "bob-carol", apismetav1.GetOptions{})
+	assert.NoError(t, err)
+
+	_, err = kusciaClient.KusciaV1alpha1().InteropConfigs().Get(ctx, "alice-interop", apismetav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	interop, err := kusciaClient.KusciaV1alpha1().InteropConfigs().Get(ctx, "bob-interop", apismetav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"carol"}, interop.Spec.Members)
+
+	_, err = kubeClient.CoreV1().Namespaces().Get(ctx, "alice", apismetav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	got, err := kusciaClient.KusciaV1alpha1().Domains().Get(ctx, "alice", apismetav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, got.Finalizers, common.DomainOffboardingFinalizer)
+}
+
+func TestEnsureOffboardingFinalizer(t *testing.T) {
+	ctx := context.Background()
+	domain := makeTestDomain("alice")
+	kusciaClient := kusciafake.NewSimpleClientset(domain)
+	c := &Controller{kusciaClient: kusciaClient}
+
+	assert.NoError(t, c.ensureOffboardingFinalizer(ctx, domain))
+	got, err := kusciaClient.KusciaV1alpha1().Domains().Get(ctx, "alice", apismetav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{common.DomainOffboardingFinalizer}, got.Finalizers)
+
+	assert.NoError(t, c.ensureOffboardingFinalizer(ctx, got))
+	got, err = kusciaClient.KusciaV1alpha1().Domains().Get(ctx, "alice", apismetav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{common.DomainOffboardingFinalizer}, got.Finalizers)
+}
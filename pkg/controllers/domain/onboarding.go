@@ -0,0 +1,85 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+// newDomainConditions is used to derive the onboarding conditions of a domain registered by RegisterDomain.
+func (c *Controller) newDomainConditions(dm *kusciaapisv1alpha1.Domain, newStatus *kusciaapisv1alpha1.DomainStatus) []kusciaapisv1alpha1.DomainCondition {
+	if _, ok := dm.Annotations[common.DomainOnboardingAnnotationKey]; !ok {
+		return nil
+	}
+
+	var oldConditions []kusciaapisv1alpha1.DomainCondition
+	if dm.Status != nil {
+		oldConditions = dm.Status.Conditions
+	}
+
+	tokenIssued, registered, nodeReady := false, false, false
+	for _, tokenStatus := range newStatus.DeployTokenStatuses {
+		tokenIssued = true
+		if tokenStatus.State == common.DeployTokenUsedState && dm.Spec.Cert != "" {
+			registered = true
+		}
+	}
+	for _, nodeStatus := range newStatus.NodeStatuses {
+		if nodeStatus.Status == nodeStatusReady {
+			nodeReady = true
+		}
+	}
+
+	return []kusciaapisv1alpha1.DomainCondition{
+		newDomainCondition(oldConditions, kusciaapisv1alpha1.DomainDeployTokenIssued, tokenIssued,
+			"TokenGenerated", "deploy token is ready for the domain to register with",
+			"WaitingForToken", "waiting for the deploy token to be generated"),
+		newDomainCondition(oldConditions, kusciaapisv1alpha1.DomainCertRegistered, registered,
+			"Registered", "domain has registered and got its certificate signed",
+			"WaitingForRegister", "waiting for the domain to register with the deploy token"),
+		newDomainCondition(oldConditions, kusciaapisv1alpha1.DomainNodeReady, nodeReady,
+			"NodeReady", "node of the domain has handshaked with master",
+			"WaitingForNode", "waiting for a node of the domain to be ready"),
+	}
+}
+
+// newDomainCondition builds the condition and keeps its last transition time if the status is unchanged.
+func newDomainCondition(oldConditions []kusciaapisv1alpha1.DomainCondition, condType kusciaapisv1alpha1.DomainConditionType,
+	ok bool, trueReason, trueMessage, falseReason, falseMessage string) kusciaapisv1alpha1.DomainCondition {
+	cond := kusciaapisv1alpha1.DomainCondition{
+		Type:    condType,
+		Status:  corev1.ConditionFalse,
+		Reason:  falseReason,
+		Message: falseMessage,
+	}
+	if ok {
+		cond.Status = corev1.ConditionTrue
+		cond.Reason = trueReason
+		cond.Message = trueMessage
+	}
+
+	cond.LastTransitionTime = metav1.Now()
+	for _, oldCond := range oldConditions {
+		if oldCond.Type == condType && oldCond.Status == cond.Status {
+			cond.LastTransitionTime = oldCond.LastTransitionTime
+			break
+		}
+	}
+	return cond
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apicorev1 "k8s.io/api/core/v1"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func TestNewDomainConditions(t *testing.T) {
+	c := &Controller{}
+
+	domain := makeTestDomain("test")
+	assert.Nil(t, c.newDomainConditions(domain, domain.Status))
+
+	domain.Annotations = map[string]string{common.DomainOnboardingAnnotationKey: "2025-03-01T08:00:00Z"}
+	transitionTime := apismetav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	domain.Status.Conditions = []kusciaapisv1alpha1.DomainCondition{
+		{Type: kusciaapisv1alpha1.DomainDeployTokenIssued, Status: apicorev1.ConditionTrue, LastTransitionTime: transitionTime},
+		{Type: kusciaapisv1alpha1.DomainCertRegistered, Status: apicorev1.ConditionFalse, LastTransitionTime: transitionTime},
+	}
+	newStatus := &kusciaapisv1alpha1.DomainStatus{
+		NodeStatuses: []kusciaapisv1alpha1.NodeStatus{{Name: "node-1", Status: nodeStatusNotReady}},
+		DeployTokenStatuses: []kusciaapisv1alpha1.DeployTokenStatus{
+			{Token: "token-1", State: common.DeployTokenUsedState},
+			{Token: "token-2", State: common.DeployTokenUnusedState},
+		},
+	}
+
+	conditions := c.newDomainConditions(domain, newStatus)
+	assert.Len(t, conditions, 3)
+	assert.Equal(t, kusciaapisv1alpha1.DomainDeployTokenIssued, conditions[0].Type)
+	assert.Equal(t, apicorev1.ConditionTrue, conditions[0].Status)
+	assert.Equal(t, transitionTime, conditions[0].LastTransitionTime)
+	assert.Equal(t, kusciaapisv1alpha1.DomainCertRegistered, conditions[1].Type)
+	assert.Equal(t, apicorev1.ConditionTrue, conditions[1].Status)
+	assert.NotEqual(t, transitionTime, conditions[1].LastTransitionTime)
+	assert.Equal(t, kusciaapisv1alpha1.DomainNodeReady, conditions[2].Type)
+	assert.Equal(t, apicorev1.ConditionFalse, conditions[2].Status)
+
+	newStatus.NodeStatuses[0].Status = nodeStatusReady
+	conditions = c.newDomainConditions(domain, newStatus)
+	assert.Equal(t, apicorev1.ConditionTrue, conditions[2].Status)
+}
@@ -35,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
 	"github.com/secretflow/kuscia/pkg/common"
@@ -71,6 +72,8 @@ type Controller struct {
 	domainDataWorkqueue            workqueue.RateLimitingInterface
 	domainDataDeleteWorkqueue      workqueue.RateLimitingInterface
 	cacheSyncs                     []cache.InformerSynced
+	grantNotifiers                 []grantNotifier
+	recorder                       record.EventRecorder
 }
 
 func NewController(ctx context.Context, config controllers.ControllerConfig) controllers.IController {
@@ -100,6 +103,8 @@ func NewController(ctx context.Context, config controllers.ControllerConfig) con
 		domainDataGrantWorkqueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "domaindatagrant"),
 		domainDataGrantDeleteWorkqueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "domaindatagrantdelete"),
 		cacheSyncs:                     cacheSyncs,
+		grantNotifiers:                 newGrantNotifiers(config.GrantWebhook),
+		recorder:                       config.EventRecorder,
 	}
 
 	controller.ctx, controller.cancel = context.WithCancel(ctx)
@@ -261,6 +266,17 @@ func (c *Controller) syncDomainDataGrantHandler(ctx context.Context, key string)
 		return err
 	}
 	if dg.Spec.Author == namespace {
+		phase, msg, approved, err := c.checkApproval(dg)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			// the grant domain loses the grant until it's approved again
+			if err = c.deleteByOwnerDomainDataGrant(namespace, name); err != nil {
+				return fmt.Errorf("delete the copies of unapproved DomainDataGrant(%s/%s) error:%s", namespace, name, err.Error())
+			}
+			return c.updateStatus(dg, phase, msg)
+		}
 		domain, err := c.kusciaClient.KusciaV1alpha1().Domains().Get(c.ctx, dg.Spec.GrantDomain, metav1.GetOptions{})
 		if err != nil {
 			return fmt.Errorf("get domain %v err %v", dg.Spec.GrantDomain, err)
@@ -279,7 +295,8 @@ func (c *Controller) syncDomainDataGrantHandler(ctx context.Context, key string)
 				if err != nil {
 					return fmt.Errorf("ensureDomainData(%s/%s) error:%s", dg.Spec.GrantDomain, dg.Spec.DomainDataID, err.Error())
 				}
-				dgcopy := resources.ExtractDomainDataGrantSpec(dg)
+				// extract from a deep copy, the labels must not be set on the cached grant
+				dgcopy := resources.ExtractDomainDataGrantSpec(dg.DeepCopy())
 				dgcopy.Namespace = destDomain
 				dgcopy.Labels[common.LabelOwnerReferences] = dg.Name
 				dgcopy.Labels[common.LabelDomainDataID] = dg.Spec.DomainDataID
@@ -479,6 +496,30 @@ func (c *Controller) checkLabels(dg *v1alpha1.DomainDataGrant) (bool, error) {
 	return false, nil
 }
 
+// checkApproval checks whether the grant can be replicated to the grant domain. If the author domain approves
+// grants manually, the grant must be approved on its current spec.
+func (c *Controller) checkApproval(dg *v1alpha1.DomainDataGrant) (v1alpha1.GrantPhase, string, bool, error) {
+	domain, err := c.domainLister.Get(dg.Spec.Author)
+	if err != nil {
+		return "", "", false, fmt.Errorf("get domain %s error:%s", dg.Spec.Author, err.Error())
+	}
+	if domain.Spec.DataGrantApproval != v1alpha1.DataGrantApprovalManual {
+		return "", "", true, nil
+	}
+	approval := dg.Status.Approval
+	if approval == nil || approval.Generation != dg.Generation {
+		return v1alpha1.GrantPending, "Waiting for approval", false, nil
+	}
+	if approval.Decision != v1alpha1.GrantApproved {
+		msg := "Rejected"
+		if approval.Comment != "" {
+			msg = fmt.Sprintf("Rejected: %s", approval.Comment)
+		}
+		return v1alpha1.GrantRejected, msg, false, nil
+	}
+	return "", "", true, nil
+}
+
 func (c *Controller) verify(dg *v1alpha1.DomainDataGrant) error {
 	phase := v1alpha1.GrantReady
 	msg := ""
@@ -536,7 +577,8 @@ func (c *Controller) verify(dg *v1alpha1.DomainDataGrant) error {
 
 func (c *Controller) updateStatus(dg *v1alpha1.DomainDataGrant, phase v1alpha1.GrantPhase, msg string) error {
 	if phase == dg.Status.Phase && msg == dg.Status.Message {
-		return nil
+		// the status update requeues the grant, so the notification is sent with the latest object
+		return c.notifyGrantActive(dg)
 	}
 	dg = dg.DeepCopy()
 	dg.Status.Phase = phase
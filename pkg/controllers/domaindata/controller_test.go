@@ -147,6 +147,81 @@ func Test_doValidate(t *testing.T) {
 	c.Run(4)
 }
 
+func Test_grantApproval(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	kusciaClient := kusciafake.NewSimpleClientset()
+	ch := make(chan struct{}, 1)
+	ctx := signals.NewKusciaContextWithStopCh(ch)
+	c := NewController(ctx, controllers.ControllerConfig{
+		KubeClient:   kubeClient,
+		KusciaClient: kusciaClient,
+	})
+
+	waitForPhase := func(phase v1alpha1.GrantPhase) *v1alpha1.DomainDataGrant {
+		for i := 0; i < 20; i++ {
+			dg, err := kusciaClient.KusciaV1alpha1().DomainDataGrants("alice").Get(ctx, "testgrant", v1.GetOptions{})
+			assert.NoError(t, err)
+			if dg.Status.Phase == phase {
+				return dg
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		assert.Fail(t, "grant phase is not "+string(phase))
+		return nil
+	}
+	copyExists := func() bool {
+		_, err := kusciaClient.KusciaV1alpha1().DomainDataGrants("bob").Get(ctx, "testgrant", v1.GetOptions{})
+		return err == nil
+	}
+	decide := func(dg *v1alpha1.DomainDataGrant, decision v1alpha1.GrantApprovalDecision) {
+		dg = dg.DeepCopy()
+		dg.Status.Approval = &v1alpha1.GrantApproval{Decision: decision, Generation: dg.Generation, DecisionTime: v1.Now(), Comment: "test"}
+		_, err := kusciaClient.KusciaV1alpha1().DomainDataGrants(dg.Namespace).UpdateStatus(ctx, dg, v1.UpdateOptions{})
+		assert.NoError(t, err)
+	}
+
+	go func() {
+		defer close(ch)
+		kusciaClient.KusciaV1alpha1().Domains().Create(ctx, &v1alpha1.Domain{
+			ObjectMeta: v1.ObjectMeta{Name: "alice"},
+			Spec:       v1alpha1.DomainSpec{DataGrantApproval: v1alpha1.DataGrantApprovalManual},
+		}, v1.CreateOptions{})
+		kusciaClient.KusciaV1alpha1().Domains().Create(ctx, &v1alpha1.Domain{
+			ObjectMeta: v1.ObjectMeta{Name: "bob"},
+		}, v1.CreateOptions{})
+		kusciaClient.KusciaV1alpha1().DomainDatas("alice").Create(ctx, &v1alpha1.DomainData{
+			ObjectMeta: v1.ObjectMeta{Name: "dataid", Namespace: "alice"},
+			Spec:       v1alpha1.DomainDataSpec{Author: "alice"},
+		}, v1.CreateOptions{})
+		time.Sleep(100 * time.Millisecond)
+		_, err := kusciaClient.KusciaV1alpha1().DomainDataGrants("alice").Create(ctx, &v1alpha1.DomainDataGrant{
+			ObjectMeta: v1.ObjectMeta{Namespace: "alice", Name: "testgrant"},
+			Spec: v1alpha1.DomainDataGrantSpec{
+				Author:       "alice",
+				DomainDataID: "dataid",
+				GrantDomain:  "bob",
+			},
+		}, v1.CreateOptions{})
+		assert.NoError(t, err)
+
+		dg := waitForPhase(v1alpha1.GrantPending)
+		assert.False(t, copyExists())
+
+		decide(dg, v1alpha1.GrantApproved)
+		dg = waitForPhase(v1alpha1.GrantReady)
+		assert.True(t, copyExists())
+
+		decide(dg, v1alpha1.GrantApprovalRejected)
+		dg = waitForPhase(v1alpha1.GrantRejected)
+		assert.Equal(t, "Rejected: test", dg.Status.Message)
+		for i := 0; i < 20 && copyExists(); i++ {
+			time.Sleep(100 * time.Millisecond)
+		}
+		assert.False(t, copyExists())
+	}()
+	c.Run(4)
+}
+
 func signDomainDataGrant(priKey *rsa.PrivateKey, dg *v1alpha1.DomainDataGrantSpec) error {
 	dg.Signature = ""
 	bs, err := json.Marshal(dg)
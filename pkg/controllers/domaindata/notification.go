@@ -0,0 +1,214 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domaindata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	GrantActiveReason = "GrantActive"
+
+	defaultWebhookTimeout = 5 * time.Second
+)
+
+// GrantNotification tells the grantee domain that a domain data is available to it.
+type GrantNotification struct {
+	GrantID      string                `json:"grantId"`
+	Author       string                `json:"author"`
+	GrantDomain  string                `json:"grantDomain"`
+	DomainDataID string                `json:"domainDataId"`
+	Name         string                `json:"name,omitempty"`
+	Type         string                `json:"type,omitempty"`
+	RelativeURI  string                `json:"relativeUri,omitempty"`
+	Columns      []v1alpha1.DataColumn `json:"columns,omitempty"`
+	Limit        *v1alpha1.GrantLimit  `json:"limit,omitempty"`
+	Description  map[string]string     `json:"description,omitempty"`
+	ActivatedAt  metav1.Time           `json:"activatedAt"`
+}
+
+type grantNotifier interface {
+	Notify(ctx context.Context, dg *v1alpha1.DomainDataGrant, n *GrantNotification) error
+}
+
+// webhookNotifier posts the notification as json to the configured endpoint.
+type webhookNotifier struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+func newWebhookNotifier(conf *kusciaconfig.WebhookConfig) *webhookNotifier {
+	timeout := defaultWebhookTimeout
+	if conf.TimeoutSeconds > 0 {
+		timeout = time.Duration(conf.TimeoutSeconds) * time.Second
+	}
+	return &webhookNotifier{
+		endpoint: conf.Endpoint,
+		token:    conf.Token,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, dg *v1alpha1.DomainDataGrant, notification *GrantNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.token != "" {
+		req.Header.Set("Authorization", "Bearer "+n.token)
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("grant webhook returns unexpected status code %d, body: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+func newGrantNotifiers(webhook *kusciaconfig.WebhookConfig) []grantNotifier {
+	var notifiers []grantNotifier
+	if webhook != nil && webhook.Endpoint != "" {
+		notifiers = append(notifiers, newWebhookNotifier(webhook))
+	}
+	return notifiers
+}
+
+// notifyGrantActive notifies the grantee once when its copy of the grant becomes ready. If the grantee is a domain of
+// another party, the notification is sent as an interconn message, i.e. it's attached to the copy synced to the
+// grantee's cluster, and the controller there notifies the grantee. Otherwise, the configured notifiers notify the
+// grantee, and the copies in other namespaces, e.g. the author's or the master domain's, are skipped.
+//
+// The notifiers are retried until they all succeed, while the GrantActive event is only recorded after the
+// grant is marked as notified, so that a retry doesn't record it twice.
+func (c *Controller) notifyGrantActive(dg *v1alpha1.DomainDataGrant) error {
+	if dg.Namespace == dg.Spec.Author || dg.Status.Phase != v1alpha1.GrantReady {
+		return nil
+	}
+	if _, ok := dg.Annotations[common.GrantNotifiedTimeAnnotationKey]; ok {
+		return nil
+	}
+	if domain, err := c.domainLister.Get(dg.Spec.GrantDomain); err == nil && domain.Spec.Role == v1alpha1.Partner {
+		return c.sendGrantNotification(dg)
+	}
+	if (len(c.grantNotifiers) == 0 && c.recorder == nil) || dg.Namespace != dg.Spec.GrantDomain {
+		return nil
+	}
+
+	notification := c.buildGrantNotification(dg)
+	for _, notifier := range c.grantNotifiers {
+		if err := notifier.Notify(c.ctx, dg, notification); err != nil {
+			return fmt.Errorf("notify DomainDataGrant(%s/%s) active failed: %v", dg.Namespace, dg.Name, err)
+		}
+	}
+
+	dgCopy := dg.DeepCopy()
+	if dgCopy.Annotations == nil {
+		dgCopy.Annotations = map[string]string{}
+	}
+	dgCopy.Annotations[common.GrantNotifiedTimeAnnotationKey] = notification.ActivatedAt.Format(time.RFC3339)
+	updated, err := c.kusciaClient.KusciaV1alpha1().DomainDataGrants(dg.Namespace).Update(c.ctx, dgCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	if c.recorder != nil {
+		content, err := json.Marshal(notification)
+		if err != nil {
+			return err
+		}
+		c.recorder.Event(updated, corev1.EventTypeNormal, GrantActiveReason, string(content))
+	}
+	nlog.Infof("Notify DomainDataGrant(%s/%s) active to domain %s", dg.Namespace, dg.Name, dg.Spec.GrantDomain)
+	return nil
+}
+
+// sendGrantNotification attaches the notification message to the copy of the grant for the domain of another party,
+// which interconn syncs to the grantee's cluster together with the grant.
+func (c *Controller) sendGrantNotification(dg *v1alpha1.DomainDataGrant) error {
+	if _, ok := dg.Annotations[common.GrantNotificationAnnotationKey]; ok {
+		return nil
+	}
+	content, err := json.Marshal(c.buildGrantNotification(dg))
+	if err != nil {
+		return err
+	}
+	dgCopy := dg.DeepCopy()
+	if dgCopy.Annotations == nil {
+		dgCopy.Annotations = map[string]string{}
+	}
+	dgCopy.Annotations[common.GrantNotificationAnnotationKey] = string(content)
+	if _, err = c.kusciaClient.KusciaV1alpha1().DomainDataGrants(dg.Namespace).Update(c.ctx, dgCopy, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	nlog.Infof("Send DomainDataGrant(%s/%s) active message to domain %s by interconn", dg.Namespace, dg.Name, dg.Spec.GrantDomain)
+	return nil
+}
+
+// buildGrantNotification returns the notification of the grant, the message received by interconn is preferred, since
+// it's built by the author's cluster which holds the domain data.
+func (c *Controller) buildGrantNotification(dg *v1alpha1.DomainDataGrant) *GrantNotification {
+	if content, ok := dg.Annotations[common.GrantNotificationAnnotationKey]; ok {
+		received := &GrantNotification{}
+		if err := json.Unmarshal([]byte(content), received); err == nil {
+			return received
+		}
+		nlog.Warnf("Decode the notification message of DomainDataGrant(%s/%s) failed, rebuild it", dg.Namespace, dg.Name)
+	}
+	notification := &GrantNotification{
+		GrantID:      dg.Name,
+		Author:       dg.Spec.Author,
+		GrantDomain:  dg.Spec.GrantDomain,
+		DomainDataID: dg.Spec.DomainDataID,
+		Limit:        dg.Spec.Limit,
+		Description:  dg.Spec.Description,
+		ActivatedAt:  metav1.Now(),
+	}
+	dd, err := c.domaindataLister.DomainDatas(dg.Namespace).Get(dg.Spec.DomainDataID)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			nlog.Warnf("Get DomainData(%s/%s) of grant %s failed: %v", dg.Namespace, dg.Spec.DomainDataID, dg.Name, err)
+		}
+		return notification
+	}
+	notification.Name = dd.Spec.Name
+	notification.Type = dd.Spec.Type
+	notification.RelativeURI = dd.Spec.RelativeURI
+	notification.Columns = dd.Spec.Columns
+	return notification
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domaindata
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/controllers"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+func TestNotifyGrantActive(t *testing.T) {
+	var received []*GrantNotification
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		n := &GrantNotification{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(n))
+		received = append(received, n)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	recorder := record.NewFakeRecorder(10)
+	kusciaClient := kusciafake.NewSimpleClientset()
+	c := NewController(ctx, controllers.ControllerConfig{
+		KubeClient:    kubefake.NewSimpleClientset(),
+		KusciaClient:  kusciaClient,
+		EventRecorder: recorder,
+		GrantWebhook:  &kusciaconfig.WebhookConfig{Endpoint: server.URL, Token: "secret"},
+	}).(*Controller)
+
+	dg := &v1alpha1.DomainDataGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant-1", Namespace: "bob"},
+		Spec: v1alpha1.DomainDataGrantSpec{
+			Author:       "alice",
+			GrantDomain:  "bob",
+			DomainDataID: "data-1",
+		},
+		Status: v1alpha1.DomainDataGrantStatus{Phase: v1alpha1.GrantReady},
+	}
+	_, err := kusciaClient.KusciaV1alpha1().DomainDataGrants("bob").Create(ctx, dg, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	// the author's copy is not notified
+	authorCopy := dg.DeepCopy()
+	authorCopy.Namespace = "alice"
+	assert.NoError(t, c.notifyGrantActive(authorCopy))
+	assert.Empty(t, received)
+
+	// webhook failures are returned so that the grant is retried
+	status = http.StatusInternalServerError
+	assert.Error(t, c.notifyGrantActive(dg))
+	// the event is recorded once after the retry succeeds
+	assert.Empty(t, recorder.Events)
+
+	status = http.StatusOK
+	received = nil
+	assert.NoError(t, c.notifyGrantActive(dg))
+	assert.Len(t, received, 1)
+	assert.Equal(t, "grant-1", received[0].GrantID)
+	assert.Equal(t, "data-1", received[0].DomainDataID)
+	assert.Contains(t, <-recorder.Events, GrantActiveReason)
+
+	latest, err := kusciaClient.KusciaV1alpha1().DomainDataGrants("bob").Get(ctx, "grant-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, latest.Annotations, common.GrantNotifiedTimeAnnotationKey)
+
+	// notified grants are skipped
+	assert.NoError(t, c.notifyGrantActive(latest))
+	assert.Len(t, received, 1)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestNotifyGrantActiveByInterconn(t *testing.T) {
+	var received []*GrantNotification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := &GrantNotification{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(n))
+		received = append(received, n)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	kusciaClient := kusciafake.NewSimpleClientset()
+	c := NewController(ctx, controllers.ControllerConfig{
+		KubeClient:   kubefake.NewSimpleClientset(),
+		KusciaClient: kusciaClient,
+		GrantWebhook: &kusciaconfig.WebhookConfig{Endpoint: server.URL},
+	}).(*Controller)
+	domainStore := c.kusciaInformerFactory.Kuscia().V1alpha1().Domains().Informer().GetStore()
+	assert.NoError(t, domainStore.Add(&v1alpha1.Domain{
+		ObjectMeta: metav1.ObjectMeta{Name: "bob"},
+		Spec:       v1alpha1.DomainSpec{Role: v1alpha1.Partner},
+	}))
+
+	dg := &v1alpha1.DomainDataGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant-1", Namespace: "bob"},
+		Spec: v1alpha1.DomainDataGrantSpec{
+			Author:       "alice",
+			GrantDomain:  "bob",
+			DomainDataID: "data-1",
+		},
+		Status: v1alpha1.DomainDataGrantStatus{Phase: v1alpha1.GrantReady},
+	}
+	_, err := kusciaClient.KusciaV1alpha1().DomainDataGrants("bob").Create(ctx, dg, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	// the author's cluster sends the message with the copy instead of calling its own webhook
+	assert.NoError(t, c.notifyGrantActive(dg))
+	assert.Empty(t, received)
+	sent, err := kusciaClient.KusciaV1alpha1().DomainDataGrants("bob").Get(ctx, "grant-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, sent.Annotations, common.GrantNotificationAnnotationKey)
+	assert.NotContains(t, sent.Annotations, common.GrantNotifiedTimeAnnotationKey)
+
+	// the grantee's cluster notifies the grantee with the received message
+	assert.NoError(t, domainStore.Delete(&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "bob"}}))
+	sent.Annotations[common.GrantNotificationAnnotationKey] = `{"grantId":"grant-1","domainDataId":"data-1","name":"alice-table"}`
+	assert.NoError(t, c.notifyGrantActive(sent))
+	assert.Len(t, received, 1)
+	assert.Equal(t, "alice-table", received[0].Name)
+}
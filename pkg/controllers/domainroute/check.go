@@ -19,6 +19,9 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net"
+	"net/url"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/labels"
@@ -114,6 +117,39 @@ func DoValidate(spec *kusciaapisv1alpha1.DomainRouteSpec) error {
 	default:
 		return fmt.Errorf("unsupport type %s", spec.AuthenticationType)
 	}
+	if spec.BandwidthLimit != nil && spec.BandwidthLimit.LimitKbps <= 0 {
+		return fmt.Errorf("field BandwidthLimit.LimitKbps must be positive, got %d", spec.BandwidthLimit.LimitKbps)
+	}
+	if err := validateConnection(spec.Connection); err != nil {
+		return err
+	}
+	if err := validateCompression(spec.Compression); err != nil {
+		return err
+	}
+	if err := validateProxy(spec.Proxy); err != nil {
+		return err
+	}
+	if err := validateEndpointHost("Endpoint.Host", spec.Endpoint.Host); err != nil {
+		return err
+	}
+	if err := validateEndpointAddresses(spec.Endpoint.Addresses); err != nil {
+		return err
+	}
+	if err := validateMirror(spec.Mirror); err != nil {
+		return err
+	}
+	if err := validateFilters(spec.Filters); err != nil {
+		return err
+	}
+	if err := validateRequestSigning(spec); err != nil {
+		return err
+	}
+	if err := validateCircuitBreaker(spec.CircuitBreaker); err != nil {
+		return err
+	}
+	if err := validateProtocolNegotiation(spec); err != nil {
+		return err
+	}
 	if spec.TokenConfig != nil {
 		if spec.TokenConfig.SourcePublicKey != "" {
 			// publickey must be base64 encoded
@@ -126,6 +162,12 @@ func DoValidate(spec *kusciaapisv1alpha1.DomainRouteSpec) error {
 				return fmt.Errorf("destinationPublicKey is format err, must be base64 encoded, err :%v ", err)
 			}
 		}
+		if spec.TokenConfig.RollingUpdatePeriod < 0 {
+			return fmt.Errorf("field TokenConfig.RollingUpdatePeriod can not be negative, got %d", spec.TokenConfig.RollingUpdatePeriod)
+		}
+		if spec.TokenConfig.RollingOverlapPeriod < 0 {
+			return fmt.Errorf("field TokenConfig.RollingOverlapPeriod can not be negative, got %d", spec.TokenConfig.RollingOverlapPeriod)
+		}
 	}
 
 	return nil
@@ -148,3 +190,199 @@ func (c *controller) needRollingToNext(ctx context.Context, dr *kusciaapisv1alph
 	}
 	return false
 }
+
+func validateConnection(conn *kusciaapisv1alpha1.DomainRouteConnection) error {
+	if conn == nil {
+		return nil
+	}
+	seconds := map[string]int32{
+		"TCPKeepaliveTimeSeconds":     conn.TCPKeepaliveTimeSeconds,
+		"TCPKeepaliveIntervalSeconds": conn.TCPKeepaliveIntervalSeconds,
+		"TCPKeepaliveProbes":          conn.TCPKeepaliveProbes,
+		"HTTP2PingIntervalSeconds":    conn.HTTP2PingIntervalSeconds,
+		"HTTP2PingTimeoutSeconds":     conn.HTTP2PingTimeoutSeconds,
+		"IdleTimeoutSeconds":          conn.IdleTimeoutSeconds,
+	}
+	for field, value := range seconds {
+		if value < 0 {
+			return fmt.Errorf("field Connection.%s can not be negative, got %d", field, value)
+		}
+	}
+	if conn.WarmUpConnections < 0 || conn.WarmUpConnections > 2 {
+		return fmt.Errorf("field Connection.WarmUpConnections must be in [0, 2], got %d", conn.WarmUpConnections)
+	}
+	return nil
+}
+
+func validateCompression(compression *kusciaapisv1alpha1.DomainRouteCompression) error {
+	if compression == nil {
+		return nil
+	}
+	switch compression.Algorithm {
+	case kusciaapisv1alpha1.DomainRouteCompressionGzip, kusciaapisv1alpha1.DomainRouteCompressionZstd:
+	default:
+		return fmt.Errorf("field Compression.Algorithm must be gzip or zstd, got %q", compression.Algorithm)
+	}
+	if compression.MinContentLength < 0 {
+		return fmt.Errorf("field Compression.MinContentLength can not be negative, got %d", compression.MinContentLength)
+	}
+	return nil
+}
+
+// validateProxy doesn't print the url in the errors, since it may contain the password of the proxy.
+func validateProxy(proxy *kusciaapisv1alpha1.DomainRouteProxy) error {
+	if proxy == nil {
+		return nil
+	}
+	u, err := url.Parse(proxy.URL)
+	if err != nil {
+		return fmt.Errorf("field Proxy.URL is not a valid url")
+	}
+	if u.Scheme != "http" && u.Scheme != "socks5" {
+		return fmt.Errorf("field Proxy.URL must start with http:// or socks5://")
+	}
+	if u.Hostname() == "" || u.Port() == "" {
+		return fmt.Errorf("field Proxy.URL must contain host and port")
+	}
+	return nil
+}
+
+// validateEndpointHost checks that a host containing colons is an IPv6 literal, which may be enclosed in brackets.
+// A port isn't allowed in the host, it's configured in Endpoint.Ports.
+func validateEndpointHost(field, host string) error {
+	if !strings.Contains(host, ":") {
+		return nil
+	}
+	literal := host
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		literal = host[1 : len(host)-1]
+	}
+	if net.ParseIP(literal) == nil {
+		return fmt.Errorf("field %s %q must be a domain name, an IPv4 or an IPv6 address without port", field, host)
+	}
+	return nil
+}
+
+func validateEndpointAddresses(addresses []kusciaapisv1alpha1.DomainEndpointAddress) error {
+	hosts := map[string]bool{}
+	for i, address := range addresses {
+		if address.Host == "" {
+			return fmt.Errorf("field Endpoint.Addresses[%d].Host is null", i)
+		}
+		if err := validateEndpointHost(fmt.Sprintf("Endpoint.Addresses[%d].Host", i), address.Host); err != nil {
+			return err
+		}
+		host := strings.TrimSuffix(strings.TrimPrefix(address.Host, "["), "]")
+		if hosts[host] {
+			return fmt.Errorf("field Endpoint.Addresses[%d].Host %q is duplicated", i, address.Host)
+		}
+		hosts[host] = true
+		if address.Weight < 0 || address.Weight > 128 {
+			return fmt.Errorf("field Endpoint.Addresses[%d].Weight must be in [1, 128], got %d", i, address.Weight)
+		}
+		if address.Priority < 0 || address.Priority > 7 {
+			return fmt.Errorf("field Endpoint.Addresses[%d].Priority must be in [0, 7], got %d", i, address.Priority)
+		}
+	}
+	return nil
+}
+
+func validateMirror(mirror *kusciaapisv1alpha1.DomainRouteMirror) error {
+	if mirror == nil {
+		return nil
+	}
+	u, err := url.Parse(mirror.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("field Mirror.URL must be a http or https url, got %q", mirror.URL)
+	}
+	if mirror.Percentage < 0 || mirror.Percentage > 100 {
+		return fmt.Errorf("field Mirror.Percentage must be in [1, 100], got %d", mirror.Percentage)
+	}
+	switch mirror.Mode {
+	case "", kusciaapisv1alpha1.DomainRouteMirrorHeaders, kusciaapisv1alpha1.DomainRouteMirrorFull:
+	default:
+		return fmt.Errorf("field Mirror.Mode must be Headers or Full, got %q", mirror.Mode)
+	}
+	if mirror.MaxBodyBytes < 0 {
+		return fmt.Errorf("field Mirror.MaxBodyBytes can not be negative, got %d", mirror.MaxBodyBytes)
+	}
+	return nil
+}
+
+// validateFilters doesn't check whether the filters exist, since they're stored in ConfManager of the source.
+func validateFilters(filters []kusciaapisv1alpha1.DomainRouteFilter) error {
+	names := map[string]bool{}
+	for i, filter := range filters {
+		if filter.Name == "" || filter.Version == "" {
+			return fmt.Errorf("field Filters[%d] must have name and version", i)
+		}
+		if names[filter.Name] {
+			return fmt.Errorf("field Filters has duplicated filter %q", filter.Name)
+		}
+		names[filter.Name] = true
+	}
+	return nil
+}
+
+func validateRequestSigning(spec *kusciaapisv1alpha1.DomainRouteSpec) error {
+	if spec.RequestSigning == nil {
+		return nil
+	}
+	if spec.TokenConfig == nil {
+		return fmt.Errorf("field RequestSigning requires TokenConfig, the requests are signed with the token")
+	}
+	if spec.Transit != nil {
+		return fmt.Errorf("field RequestSigning doesn't support the transit routes")
+	}
+	if spec.RequestSigning.ClockSkewSeconds < 0 || spec.RequestSigning.ClockSkewSeconds > 3600 {
+		return fmt.Errorf("field RequestSigning.ClockSkewSeconds must be in [1, 3600], got %d", spec.RequestSigning.ClockSkewSeconds)
+	}
+	return nil
+}
+
+func validateCircuitBreaker(cb *kusciaapisv1alpha1.DomainRouteCircuitBreaker) error {
+	if cb == nil {
+		return nil
+	}
+	values := map[string]int32{
+		"MaxConnections":      cb.MaxConnections,
+		"MaxPendingRequests":  cb.MaxPendingRequests,
+		"MaxRequests":         cb.MaxRequests,
+		"ConsecutiveFailures": cb.ConsecutiveFailures,
+	}
+	for field, value := range values {
+		if value < 0 {
+			return fmt.Errorf("field CircuitBreaker.%s can not be negative, got %d", field, value)
+		}
+	}
+	if cb.EjectionSeconds < 0 || cb.EjectionSeconds > 3600 {
+		return fmt.Errorf("field CircuitBreaker.EjectionSeconds must be in [1, 3600], got %d", cb.EjectionSeconds)
+	}
+	if budget := cb.RetryBudget; budget != nil {
+		if budget.MaxRetries < 0 || budget.MaxRetries > 5 {
+			return fmt.Errorf("field CircuitBreaker.RetryBudget.MaxRetries must be in [1, 5], got %d", budget.MaxRetries)
+		}
+		if budget.BudgetPercent < 0 || budget.BudgetPercent > 100 {
+			return fmt.Errorf("field CircuitBreaker.RetryBudget.BudgetPercent must be in [1, 100], got %d", budget.BudgetPercent)
+		}
+		if budget.MinRetryConcurrency < 0 {
+			return fmt.Errorf("field CircuitBreaker.RetryBudget.MinRetryConcurrency can not be negative, got %d", budget.MinRetryConcurrency)
+		}
+	}
+	return nil
+}
+
+func validateProtocolNegotiation(spec *kusciaapisv1alpha1.DomainRouteSpec) error {
+	if spec.ProtocolNegotiation == nil {
+		return nil
+	}
+	if spec.Transit != nil {
+		return fmt.Errorf("field ProtocolNegotiation doesn't support the transit routes")
+	}
+	switch spec.ProtocolNegotiation.MinProtocol {
+	case "", kusciaapisv1alpha1.DomainRouteTransportNOTLS, kusciaapisv1alpha1.DomainRouteTransportTLS, kusciaapisv1alpha1.DomainRouteTransportMTLS:
+	default:
+		return fmt.Errorf("field ProtocolNegotiation.MinProtocol must be NOTLS, TLS or MTLS, got %q", spec.ProtocolNegotiation.MinProtocol)
+	}
+	return nil
+}
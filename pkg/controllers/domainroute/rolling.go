@@ -28,6 +28,9 @@ import (
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 )
 
+// tokenRotationHistorySize is the number of token rotations kept in the status.
+const tokenRotationHistorySize = 10
+
 func (c *controller) preRollingSourceDomainRoute(ctx context.Context, dr *kusciaapisv1alpha1.DomainRoute) error {
 	initializer, err := c.selectInitializer(ctx, dr)
 	if err != nil {
@@ -96,6 +99,7 @@ func (c *controller) postRollingSourceDomainRoute(ctx context.Context, dr *kusci
 	n := len(dr.Status.TokenStatus.Tokens)
 	if n == 0 || dr.Status.TokenStatus.Tokens[n-1].Revision != dr.Status.TokenStatus.RevisionToken.Revision {
 		dr = dr.DeepCopy()
+		recordTokenRotation(&dr.Status.TokenStatus, metav1.Now())
 		dr.Status.TokenStatus.Tokens = append(dr.Status.TokenStatus.Tokens, dr.Status.TokenStatus.RevisionToken)
 		if n > 1 {
 			dr.Status.TokenStatus.Tokens = dr.Status.TokenStatus.Tokens[n-1:]
@@ -116,6 +120,7 @@ func (c *controller) postRollingDestinationDomainRoute(ctx context.Context, dr *
 		dr.Status.TokenStatus.RevisionToken.Token != "" &&
 		(n == 0 || dr.Status.TokenStatus.Tokens[n-1].Revision != dr.Status.TokenStatus.RevisionToken.Revision) {
 		dr = dr.DeepCopy()
+		recordTokenRotation(&dr.Status.TokenStatus, metav1.Now())
 		if n > 1 {
 			dr.Status.TokenStatus.Tokens = dr.Status.TokenStatus.Tokens[n-1:]
 		}
@@ -142,3 +147,20 @@ func (c *controller) postRollingDestinationDomainRoute(ctx context.Context, dr *
 	}
 	return nil
 }
+
+// recordTokenRotation records the rotation from the latest token in Tokens to the revision token. The previous
+// token is still accepted until its expiration time, so the requests in flight are not broken by the rotation.
+func recordTokenRotation(status *kusciaapisv1alpha1.DomainRouteTokenStatus, now metav1.Time) {
+	rotation := kusciaapisv1alpha1.DomainRouteTokenRotation{
+		Revision: status.RevisionToken.Revision,
+		Time:     now,
+	}
+	if n := len(status.Tokens); n > 0 {
+		rotation.PreviousRevision = status.Tokens[n-1].Revision
+		rotation.PreviousExpirationTime = status.Tokens[n-1].ExpirationTime
+	}
+	status.Rotations = append(status.Rotations, rotation)
+	if len(status.Rotations) > tokenRotationHistorySize {
+		status.Rotations = status.Rotations[len(status.Rotations)-tokenRotationHistorySize:]
+	}
+}
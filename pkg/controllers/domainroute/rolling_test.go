@@ -0,0 +1,46 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domainroute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func Test_recordTokenRotation(t *testing.T) {
+	expiration := metav1.NewTime(time.Now().Add(time.Hour))
+	status := &kusciaapisv1alpha1.DomainRouteTokenStatus{
+		RevisionToken: kusciaapisv1alpha1.DomainRouteToken{Revision: 1},
+	}
+	recordTokenRotation(status, metav1.Now())
+	assert.Equal(t, int64(1), status.Rotations[0].Revision)
+	assert.Equal(t, int64(0), status.Rotations[0].PreviousRevision)
+
+	status.Tokens = []kusciaapisv1alpha1.DomainRouteToken{{Revision: 1, ExpirationTime: expiration}}
+	for i := 2; i <= tokenRotationHistorySize+2; i++ {
+		status.RevisionToken.Revision = int64(i)
+		recordTokenRotation(status, metav1.Now())
+	}
+	assert.Len(t, status.Rotations, tokenRotationHistorySize)
+	last := status.Rotations[tokenRotationHistorySize-1]
+	assert.Equal(t, int64(tokenRotationHistorySize+2), last.Revision)
+	assert.Equal(t, int64(1), last.PreviousRevision)
+	assert.Equal(t, expiration, last.PreviousExpirationTime)
+}
@@ -49,6 +49,7 @@ type KusciaJobGCController struct {
 	kusciaJobSynced       cache.InformerSynced
 	namespaceSynced       cache.InformerSynced
 	kusciaJobGCDuration   time.Duration
+	jobArchiveEnabled     bool
 }
 
 func NewKusciaJobGCController(ctx context.Context, config controllers.ControllerConfig) controllers.IController {
@@ -70,12 +71,18 @@ func NewKusciaJobGCController(ctx context.Context, config controllers.Controller
 		kusciaJobSynced:       kusciaJobInformer.Informer().HasSynced,
 		namespaceSynced:       namespaceInformer.Informer().HasSynced,
 		kusciaJobGCDuration:   defaultGCDuration,
+		jobArchiveEnabled:     config.JobArchive != nil && config.JobArchive.Enable,
 	}
 	gcController.ctx, gcController.cancel = context.WithCancel(ctx)
 	return gcController
 }
 
 func (kgc *KusciaJobGCController) Run(flag int) error {
+	// the archive controller deletes the finished jobs after they are archived
+	if kgc.jobArchiveEnabled {
+		nlog.Infof("KusciaJob archive is enabled, %s exits", kgc.Name())
+		return nil
+	}
 	nlog.Info("Starting KusciaJobGC controller")
 	kgc.kusciaInformerFactory.Start(kgc.ctx.Done())
 	kgc.kubeInformerFactory.Start(kgc.ctx.Done())
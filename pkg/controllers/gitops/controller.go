@@ -0,0 +1,488 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/controllers"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	controllerName = "gitops-controller"
+
+	// StatusConfigMapName is the configmap in kube-system recording the status of the last reconciliation.
+	StatusConfigMapName   = "kuscia-gitops"
+	statusSourceKey       = "source"
+	statusRevisionKey     = "revision"
+	statusLastSyncTimeKey = "lastSyncTime"
+	statusSucceededKey    = "succeeded"
+	statusMessageKey      = "message"
+	statusSummaryKey      = "summary"
+
+	kusciaAPIVersion = "kuscia.secretflow/v1alpha1"
+)
+
+type resourceKind struct {
+	gvr        schema.GroupVersionResource
+	namespaced bool
+}
+
+// managedKinds are the kinds reconciled from the source, in the order they are applied.
+var managedKinds = map[string]resourceKind{
+	"Domain":           {gvr: gvr("domains"), namespaced: false},
+	"AppImage":         {gvr: gvr("appimages"), namespaced: false},
+	"DomainRoute":      {gvr: gvr("domainroutes"), namespaced: true},
+	"DomainDataSource": {gvr: gvr("domaindatasources"), namespaced: true},
+}
+
+var applyOrder = []string{"Domain", "AppImage", "DomainRoute", "DomainDataSource"}
+
+func gvr(resource string) schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "kuscia.secretflow", Version: "v1alpha1", Resource: resource}
+}
+
+// Summary is the result of a reconciliation. Drifted lists the resources changed out of the source since they were
+// applied, they are overwritten unless the drift policy is report.
+type Summary struct {
+	Created int      `json:"created"`
+	Updated int      `json:"updated"`
+	Deleted int      `json:"deleted"`
+	Drifted []string `json:"drifted,omitempty"`
+}
+
+// Controller reconciles the resources declared in the git repository or the oci artifact on a schedule. The
+// resources it applies are labeled by common.LabelGitOpsManaged, the resources of other names are left untouched.
+type Controller struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	kubeClient    kubernetes.Interface
+	dynamicClient dynamic.Interface
+	conf          *kusciaconfig.GitOpsConfig
+	workDir       string
+	source        manifestSource
+}
+
+func NewController(ctx context.Context, config controllers.ControllerConfig) controllers.IController {
+	c := &Controller{
+		kubeClient:    config.KubeClient,
+		dynamicClient: config.DynamicClient,
+		conf:          config.GitOps,
+		workDir:       filepath.Join(config.RootDir, common.TmpPrefix, "gitops"),
+	}
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	return c
+}
+
+func (c *Controller) Run(int) error {
+	if c.conf == nil || !c.conf.Enable {
+		nlog.Infof("GitOps is disabled, %s exits", c.Name())
+		return nil
+	}
+
+	var err error
+	if c.source, err = newManifestSource(c.conf, c.workDir); err != nil {
+		return err
+	}
+
+	nlog.Infof("Start reconciling resources from %s every %v", c.conf.Source, c.conf.Interval())
+	wait.UntilWithContext(c.ctx, func(ctx context.Context) {
+		if err := c.sync(ctx); err != nil {
+			nlog.Warnf("Reconcile resources from %s failed, %v", c.conf.Source, err)
+		}
+	}, c.conf.Interval())
+	return nil
+}
+
+func (c *Controller) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+}
+
+func (c *Controller) Name() string {
+	return controllerName
+}
+
+// sync fetches the manifests and applies them, nothing is applied unless all the manifests are valid. The result
+// is recorded in the status configmap either way.
+func (c *Controller) sync(ctx context.Context) error {
+	revision, summary, err := c.reconcile(ctx)
+	if statusErr := c.saveStatus(ctx, revision, summary, err); statusErr != nil {
+		nlog.Warnf("Save gitops status failed, %v", statusErr)
+	}
+	return err
+}
+
+func (c *Controller) reconcile(ctx context.Context) (string, *Summary, error) {
+	fetchCtx, cancel := context.WithTimeout(ctx, c.conf.Timeout())
+	defer cancel()
+	revision, files, err := c.source.Fetch(fetchCtx)
+	if err != nil {
+		return "", nil, err
+	}
+	objects, err := parseManifests(files)
+	if err != nil {
+		return revision, nil, err
+	}
+	summary, err := c.apply(ctx, revision, objects)
+	return revision, summary, err
+}
+
+// parseManifests parses the documents of the files, each of which must be a managed kind of a distinct name.
+func parseManifests(files []manifestFile) ([]*unstructured.Unstructured, error) {
+	keys := map[string]string{}
+	var objects []*unstructured.Unstructured
+	for _, file := range files {
+		decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(file.Content), 4096)
+		for {
+			obj := &unstructured.Unstructured{}
+			if err := decoder.Decode(&obj.Object); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, fmt.Errorf("decode %s failed, %v", file.Name, err)
+			}
+			if len(obj.Object) == 0 {
+				continue
+			}
+			if err := validateObject(obj); err != nil {
+				return nil, fmt.Errorf("%s: %v", file.Name, err)
+			}
+			key := objectKey(obj)
+			if previous, ok := keys[key]; ok {
+				return nil, fmt.Errorf("%s: %s is already declared in %s", file.Name, key, previous)
+			}
+			keys[key] = file.Name
+			objects = append(objects, obj)
+		}
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("source contains no resource")
+	}
+	return objects, nil
+}
+
+func validateObject(obj *unstructured.Unstructured) error {
+	kind, ok := managedKinds[obj.GetKind()]
+	if !ok {
+		return fmt.Errorf("kind %q of %q is not allowed, only Domain, DomainRoute, AppImage and DomainDataSource are allowed", obj.GetKind(), obj.GetName())
+	}
+	if obj.GetAPIVersion() != kusciaAPIVersion {
+		return fmt.Errorf("apiVersion of %s %q should be %s", obj.GetKind(), obj.GetName(), kusciaAPIVersion)
+	}
+	if obj.GetName() == "" {
+		return fmt.Errorf("%s without name", obj.GetKind())
+	}
+	if kind.namespaced && obj.GetNamespace() == "" {
+		return fmt.Errorf("%s %q should have a namespace", obj.GetKind(), obj.GetName())
+	}
+	if !kind.namespaced && obj.GetNamespace() != "" {
+		return fmt.Errorf("%s %q is cluster scoped and should have no namespace", obj.GetKind(), obj.GetName())
+	}
+	return nil
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetKind() + "/" + obj.GetName()
+	}
+	return obj.GetKind() + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// apply creates or updates the resources of the source, and deletes the resources reconciled from the same source
+// but removed from it if prune is enabled. The resources created locally of the same names are taken over.
+func (c *Controller) apply(ctx context.Context, revision string, objects []*unstructured.Unstructured) (*Summary, error) {
+	byKind := map[string][]*unstructured.Unstructured{}
+	desired := make(map[string]bool, len(objects))
+	for _, obj := range objects {
+		byKind[obj.GetKind()] = append(byKind[obj.GetKind()], obj)
+		desired[objectKey(obj)] = true
+	}
+
+	summary := &Summary{}
+	for _, kind := range applyOrder {
+		for _, obj := range byKind[kind] {
+			if err := c.applyObject(ctx, revision, obj, summary); err != nil {
+				return summary, err
+			}
+		}
+	}
+
+	if c.conf.Prune {
+		// delete in the reverse order, so the routes and data sources are gone before their domains
+		for i := len(applyOrder) - 1; i >= 0; i-- {
+			if err := c.prune(ctx, applyOrder[i], desired, summary); err != nil {
+				return summary, err
+			}
+		}
+	}
+
+	if summary.Created+summary.Updated+summary.Deleted+len(summary.Drifted) > 0 {
+		nlog.Infof("Reconciled resources from %s at %s, created %d, updated %d, deleted %d, drifted %d",
+			c.conf.Source, revision, summary.Created, summary.Updated, summary.Deleted, len(summary.Drifted))
+	}
+	return summary, nil
+}
+
+func (c *Controller) resourceClient(obj *unstructured.Unstructured) dynamic.ResourceInterface {
+	kind := managedKinds[obj.GetKind()]
+	if kind.namespaced {
+		return c.dynamicClient.Resource(kind.gvr).Namespace(obj.GetNamespace())
+	}
+	return c.dynamicClient.Resource(kind.gvr)
+}
+
+func (c *Controller) applyObject(ctx context.Context, revision string, desired *unstructured.Unstructured, summary *Summary) error {
+	key := objectKey(desired)
+	client := c.resourceClient(desired)
+	existing, err := client.Get(ctx, desired.GetName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		obj := &unstructured.Unstructured{Object: desiredContent(desired)}
+		obj.SetAPIVersion(desired.GetAPIVersion())
+		obj.SetKind(desired.GetKind())
+		obj.SetName(desired.GetName())
+		obj.SetNamespace(desired.GetNamespace())
+		obj.SetLabels(desired.GetLabels())
+		obj.SetAnnotations(desired.GetAnnotations())
+		c.markManaged(obj, revision)
+		if _, err := client.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("create %s failed, %v", key, err)
+		}
+		summary.Created++
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get %s failed, %v", key, err)
+	}
+
+	managed := existing.GetLabels()[common.LabelGitOpsManaged] == "true"
+	inSync := isSubset(desiredContent(desired), existing.Object)
+	if managed && !inSync && existing.GetAnnotations()[common.GitOpsRevisionAnnotationKey] == revision {
+		summary.Drifted = append(summary.Drifted, key)
+		if !c.conf.CorrectDrift() {
+			nlog.Warnf("%s drifted from %s at %s", key, c.conf.Source, revision)
+			return nil
+		}
+		nlog.Warnf("%s drifted from %s at %s, correct it", key, c.conf.Source, revision)
+	}
+	if !managed {
+		nlog.Infof("Take over the local %s by the one of %s", key, c.conf.Source)
+	}
+
+	obj := existing.DeepCopy()
+	for field, value := range desiredContent(desired) {
+		obj.Object[field] = value
+	}
+	obj.SetLabels(mergeMap(obj.GetLabels(), desired.GetLabels()))
+	obj.SetAnnotations(mergeMap(obj.GetAnnotations(), desired.GetAnnotations()))
+	c.markManaged(obj, revision)
+	if inSync && reflect.DeepEqual(obj.GetLabels(), existing.GetLabels()) && reflect.DeepEqual(obj.GetAnnotations(), existing.GetAnnotations()) {
+		return nil
+	}
+	if _, err := client.Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update %s failed, %v", key, err)
+	}
+	summary.Updated++
+	return nil
+}
+
+func (c *Controller) prune(ctx context.Context, kindName string, desired map[string]bool, summary *Summary) error {
+	kind := managedKinds[kindName]
+	list, err := c.dynamicClient.Resource(kind.gvr).List(ctx, metav1.ListOptions{LabelSelector: common.LabelGitOpsManaged + "=true"})
+	if err != nil {
+		return fmt.Errorf("list managed %s failed, %v", kind.gvr.Resource, err)
+	}
+	for i := range list.Items {
+		obj := &list.Items[i]
+		obj.SetKind(kindName)
+		key := objectKey(obj)
+		if desired[key] || obj.GetAnnotations()[common.GitOpsSourceAnnotationKey] != c.conf.Source {
+			continue
+		}
+		if err := c.resourceClient(obj).Delete(ctx, obj.GetName(), metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("delete %s failed, %v", key, err)
+		}
+		nlog.Infof("Pruned %s which is removed from %s", key, c.conf.Source)
+		summary.Deleted++
+	}
+	return nil
+}
+
+func (c *Controller) markManaged(obj *unstructured.Unstructured, revision string) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[common.LabelGitOpsManaged] = "true"
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[common.GitOpsSourceAnnotationKey] = c.conf.Source
+	annotations[common.GitOpsRevisionAnnotationKey] = revision
+	obj.SetAnnotations(annotations)
+}
+
+// desiredContent is the top level fields of the object apart from the type, the metadata and the status, which are
+// owned by the source.
+func desiredContent(obj *unstructured.Unstructured) map[string]interface{} {
+	content := map[string]interface{}{}
+	for field, value := range obj.Object {
+		switch field {
+		case "apiVersion", "kind", "metadata", "status":
+			continue
+		}
+		content[field] = runtime.DeepCopyJSONValue(value)
+	}
+	return content
+}
+
+// isSubset reports whether every field of desired is set to the same value in live, so that the fields defaulted by
+// the api server are not taken as drift. The lists must be of the same length.
+func isSubset(desired, live interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return len(d) == 0 && live == nil
+		}
+		for k, v := range d {
+			if !isSubset(v, l[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			return len(d) == 0 && live == nil
+		}
+		if len(d) != len(l) {
+			return false
+		}
+		for i := range d {
+			if !isSubset(d[i], l[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return live == nil
+	default:
+		return reflect.DeepEqual(normalizeNumber(desired), normalizeNumber(live))
+	}
+}
+
+// normalizeNumber unifies the numbers decoded from the source and returned by the api server.
+func normalizeNumber(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return v.String()
+		}
+		return f
+	}
+	return value
+}
+
+func (c *Controller) saveStatus(ctx context.Context, revision string, summary *Summary, syncErr error) error {
+	if summary == nil {
+		summary = &Summary{}
+	}
+	sort.Strings(summary.Drifted)
+	content, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	message := ""
+	if syncErr != nil {
+		message = syncErr.Error()
+	} else if len(summary.Drifted) > 0 && !c.conf.CorrectDrift() {
+		message = fmt.Sprintf("%d resources drifted from the source", len(summary.Drifted))
+	}
+	data := map[string]string{
+		statusSourceKey:       c.conf.Source,
+		statusRevisionKey:     revision,
+		statusLastSyncTimeKey: time.Now().UTC().Format(time.RFC3339),
+		statusSucceededKey:    strconv.FormatBool(syncErr == nil),
+		statusMessageKey:      message,
+		statusSummaryKey:      string(content),
+	}
+
+	configMaps := c.kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem)
+	cm, err := configMaps.Get(ctx, StatusConfigMapName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = configMaps.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: StatusConfigMapName, Namespace: metav1.NamespaceSystem},
+			Data:       data,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	cm = cm.DeepCopy()
+	cm.Data = data
+	_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+func mergeMap(dst, src map[string]string) map[string]string {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]string, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
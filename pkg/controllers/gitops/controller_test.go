@@ -0,0 +1,252 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/controllers"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+const (
+	testDomain = `
+apiVersion: kuscia.secretflow/v1alpha1
+kind: Domain
+metadata:
+  name: alice
+spec:
+  role: partner
+  cert: abc
+`
+	testRoute = `
+apiVersion: kuscia.secretflow/v1alpha1
+kind: DomainRoute
+metadata:
+  name: alice-bob
+  namespace: alice
+spec:
+  source: alice
+  destination: bob
+  endpoint:
+    host: bob.example.com
+    ports:
+    - name: http
+      port: 1080
+`
+	testManifests = testDomain + "---" + testRoute
+)
+
+type fakeSource struct {
+	revision string
+	files    []manifestFile
+}
+
+func (s *fakeSource) Fetch(context.Context) (string, []manifestFile, error) {
+	return s.revision, s.files, nil
+}
+
+func newTestController(t *testing.T, conf *kusciaconfig.GitOpsConfig, objects ...runtime.Object) *Controller {
+	listKinds := map[schema.GroupVersionResource]string{}
+	for kind, rk := range managedKinds {
+		listKinds[rk.gvr] = kind + "List"
+	}
+	c := NewController(context.Background(), controllers.ControllerConfig{
+		KubeClient:    kubefake.NewSimpleClientset(),
+		DynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...),
+		GitOps:        conf,
+		RootDir:       t.TempDir(),
+	}).(*Controller)
+	return c
+}
+
+func getObject(t *testing.T, c *Controller, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	got, err := c.resourceClient(obj).Get(context.Background(), name, metav1.GetOptions{})
+	require.NoError(t, err)
+	return got
+}
+
+func TestParseManifests(t *testing.T) {
+	objects, err := parseManifests([]manifestFile{{Name: "fleet.yaml", Content: []byte(testManifests)}})
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "Domain/alice", objectKey(objects[0]))
+	assert.Equal(t, "DomainRoute/alice/alice-bob", objectKey(objects[1]))
+
+	tests := []struct {
+		name    string
+		content string
+		errMsg  string
+	}{
+		{"empty", "---\n", "no resource"},
+		{"kind", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n", "not allowed"},
+		{"apiVersion", "apiVersion: v1\nkind: Domain\nmetadata:\n  name: a\n", "apiVersion"},
+		{"namespace", "apiVersion: kuscia.secretflow/v1alpha1\nkind: DomainRoute\nmetadata:\n  name: a\n", "namespace"},
+		{"cluster", "apiVersion: kuscia.secretflow/v1alpha1\nkind: Domain\nmetadata:\n  name: a\n  namespace: a\n", "cluster scoped"},
+		{"duplicated", testManifests + "---\n" + testManifests, "already declared"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseManifests([]manifestFile{{Name: "fleet.yaml", Content: []byte(tt.content)}})
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	live := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"role":  "partner",
+			"port":  int64(1080),
+			"ports": []interface{}{map[string]interface{}{"name": "http", "port": int64(80), "protocol": "HTTP"}},
+		},
+	}
+	assert.True(t, isSubset(map[string]interface{}{"spec": map[string]interface{}{"role": "partner", "port": float64(1080)}}, live))
+	assert.True(t, isSubset(map[string]interface{}{"spec": map[string]interface{}{
+		"ports": []interface{}{map[string]interface{}{"name": "http", "port": int64(80)}},
+	}}, live))
+	assert.False(t, isSubset(map[string]interface{}{"spec": map[string]interface{}{"role": "master"}}, live))
+	assert.False(t, isSubset(map[string]interface{}{"spec": map[string]interface{}{"ports": []interface{}{}}}, live))
+	assert.False(t, isSubset(map[string]interface{}{"spec": map[string]interface{}{"cert": "abc"}}, live))
+}
+
+func TestSync(t *testing.T) {
+	conf := &kusciaconfig.GitOpsConfig{Enable: true, Source: "https://git.example.com/fleet.git", Prune: true}
+	local := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kuscia.secretflow/v1alpha1",
+		"kind":       "Domain",
+		"metadata":   map[string]interface{}{"name": "alice"},
+		"spec":       map[string]interface{}{"role": "partner", "cert": "old"},
+	}}
+	c := newTestController(t, conf, local)
+	source := &fakeSource{revision: "rev1", files: []manifestFile{{Name: "fleet.yaml", Content: []byte(testManifests)}}}
+	c.source = source
+	ctx := context.Background()
+
+	// the local domain is taken over and the route is created
+	require.NoError(t, c.sync(ctx))
+	domain := getObject(t, c, "Domain", "", "alice")
+	assert.Equal(t, "true", domain.GetLabels()[common.LabelGitOpsManaged])
+	assert.Equal(t, "rev1", domain.GetAnnotations()[common.GitOpsRevisionAnnotationKey])
+	cert, _, _ := unstructured.NestedString(domain.Object, "spec", "cert")
+	assert.Equal(t, "abc", cert)
+	route := getObject(t, c, "DomainRoute", "alice", "alice-bob")
+	assert.Equal(t, conf.Source, route.GetAnnotations()[common.GitOpsSourceAnnotationKey])
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, StatusConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "rev1", cm.Data[statusRevisionKey])
+	assert.Equal(t, "true", cm.Data[statusSucceededKey])
+	assert.Contains(t, cm.Data[statusSummaryKey], `"created":1,"updated":1`)
+
+	// the drift is corrected
+	require.NoError(t, unstructured.SetNestedField(domain.Object, "changed", "spec", "cert"))
+	_, err = c.resourceClient(domain).Update(ctx, domain, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, c.sync(ctx))
+	domain = getObject(t, c, "Domain", "", "alice")
+	cert, _, _ = unstructured.NestedString(domain.Object, "spec", "cert")
+	assert.Equal(t, "abc", cert)
+	cm, _ = c.kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, StatusConfigMapName, metav1.GetOptions{})
+	assert.Contains(t, cm.Data[statusSummaryKey], `"drifted":["Domain/alice"]`)
+
+	// the drift is only reported
+	conf.DriftPolicy = kusciaconfig.GitOpsDriftPolicyReport
+	require.NoError(t, unstructured.SetNestedField(domain.Object, "changed", "spec", "cert"))
+	_, err = c.resourceClient(domain).Update(ctx, domain, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, c.sync(ctx))
+	domain = getObject(t, c, "Domain", "", "alice")
+	cert, _, _ = unstructured.NestedString(domain.Object, "spec", "cert")
+	assert.Equal(t, "changed", cert)
+	cm, _ = c.kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, StatusConfigMapName, metav1.GetOptions{})
+	assert.Contains(t, cm.Data[statusMessageKey], "1 resources drifted")
+
+	// a new revision is applied, and the removed route is pruned
+	source.revision = "rev2"
+	source.files = []manifestFile{{Name: "fleet.yaml", Content: []byte(testDomain)}}
+	require.NoError(t, c.sync(ctx))
+	domain = getObject(t, c, "Domain", "", "alice")
+	cert, _, _ = unstructured.NestedString(domain.Object, "spec", "cert")
+	assert.Equal(t, "abc", cert)
+	assert.Equal(t, "rev2", domain.GetAnnotations()[common.GitOpsRevisionAnnotationKey])
+	_, err = c.dynamicClient.Resource(managedKinds["DomainRoute"].gvr).Namespace("alice").Get(ctx, "alice-bob", metav1.GetOptions{})
+	assert.True(t, err != nil)
+	cm, _ = c.kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, StatusConfigMapName, metav1.GetOptions{})
+	assert.Contains(t, cm.Data[statusSummaryKey], `"deleted":1`)
+
+	// nothing is applied if the source is invalid
+	source.files = []manifestFile{{Name: "bad.yaml", Content: []byte("kind: Pod\n")}}
+	assert.Error(t, c.sync(ctx))
+	cm, _ = c.kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, StatusConfigMapName, metav1.GetOptions{})
+	assert.Equal(t, "false", cm.Data[statusSucceededKey])
+	assert.Contains(t, cm.Data[statusMessageKey], "bad.yaml")
+}
+
+func TestGitSource(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	repo := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	run("init", "--quiet")
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, "fleet", ".hidden"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "fleet", "domains.yaml"), []byte(testManifests), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "fleet", "README.md"), []byte("fleet"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "fleet", ".hidden", "x.yaml"), []byte("kind: Pod"), 0644))
+	run("add", "-A")
+	run("commit", "--quiet", "-m", "init")
+
+	source, err := newManifestSource(&kusciaconfig.GitOpsConfig{Source: "file://" + repo, Path: "fleet"}, filepath.Join(t.TempDir(), "work"))
+	require.NoError(t, err)
+	revision, files, err := source.Fetch(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, revision, 40)
+	require.Len(t, files, 1)
+	assert.Equal(t, "domains.yaml", files[0].Name)
+
+	// the working tree is reused
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "fleet", "routes.yml"), []byte(testManifests), 0644))
+	run("add", "-A")
+	run("commit", "--quiet", "-m", "routes")
+	revision2, files, err := source.Fetch(context.Background())
+	require.NoError(t, err)
+	assert.NotEqual(t, revision, revision2)
+	assert.Len(t, files, 2)
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+const (
+	// ManifestMediaType is the media type of the layers holding the resource manifests in an oci artifact.
+	ManifestMediaType = "application/vnd.kuscia.gitops.v1+yaml"
+
+	// titleAnnotation is the standard layer annotation naming the file of the layer.
+	titleAnnotation = "org.opencontainers.image.title"
+
+	maxManifestBytes = 16 << 20
+)
+
+// manifestFile is a yaml or json file of the source, which may hold multiple documents.
+type manifestFile struct {
+	Name    string
+	Content []byte
+}
+
+// manifestSource fetches the resource manifests and the revision they are fetched at.
+type manifestSource interface {
+	Fetch(ctx context.Context) (revision string, files []manifestFile, err error)
+}
+
+func newManifestSource(conf *kusciaconfig.GitOpsConfig, workDir string) (manifestSource, error) {
+	if conf.IsOCI() {
+		ref, err := name.ParseReference(strings.TrimPrefix(conf.Source, kusciaconfig.GitOpsOCIScheme))
+		if err != nil {
+			return nil, fmt.Errorf("parse oci artifact %q failed, %v", conf.Source, err)
+		}
+		return &ociSource{ref: ref, username: conf.Username, password: conf.Password}, nil
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, fmt.Errorf("git is required by the gitops source %s, %v", conf.Source, err)
+	}
+	return &gitSource{conf: conf, dir: workDir}, nil
+}
+
+// gitSource fetches the revision of the git repository into a shallow working tree, which is reused by the
+// following fetches.
+type gitSource struct {
+	conf *kusciaconfig.GitOpsConfig
+	dir  string
+}
+
+func (s *gitSource) Fetch(ctx context.Context) (string, []manifestFile, error) {
+	if _, err := os.Stat(filepath.Join(s.dir, ".git")); err != nil {
+		if err := os.RemoveAll(s.dir); err != nil {
+			return "", nil, err
+		}
+		if err := os.MkdirAll(s.dir, 0700); err != nil {
+			return "", nil, err
+		}
+		if _, err := s.git(ctx, "init", "--quiet"); err != nil {
+			return "", nil, err
+		}
+		if _, err := s.git(ctx, "remote", "add", "origin", s.conf.Source); err != nil {
+			return "", nil, err
+		}
+	}
+
+	revision := s.conf.Revision
+	if revision == "" {
+		revision = "HEAD"
+	}
+	if _, err := s.git(ctx, "fetch", "--quiet", "--depth", "1", "origin", revision); err != nil {
+		return "", nil, err
+	}
+	if _, err := s.git(ctx, "checkout", "--quiet", "--force", "FETCH_HEAD"); err != nil {
+		return "", nil, err
+	}
+	commit, err := s.git(ctx, "rev-parse", "HEAD")
+	if err != nil {
+		return "", nil, err
+	}
+
+	files, err := readManifestDir(filepath.Join(s.dir, filepath.Clean("/"+s.conf.Path)))
+	if err != nil {
+		return "", nil, err
+	}
+	return commit, files, nil
+}
+
+func (s *gitSource) git(ctx context.Context, args ...string) (string, error) {
+	var globalArgs []string
+	if s.conf.Username != "" {
+		auth := base64.StdEncoding.EncodeToString([]byte(s.conf.Username + ":" + s.conf.Password))
+		globalArgs = append(globalArgs, "-c", "http.extraHeader=Authorization: Basic "+auth)
+	}
+	cmd := exec.CommandContext(ctx, "git", append(globalArgs, args...)...)
+	cmd.Dir = s.dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if s.conf.SSHKeyFile != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_SSH_COMMAND=ssh -i %s -o IdentitiesOnly=yes -o StrictHostKeyChecking=accept-new", s.conf.SSHKeyFile))
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s failed, %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// readManifestDir reads the yaml and json files under dir recursively, the hidden files and directories are skipped.
+func readManifestDir(dir string) ([]manifestFile, error) {
+	var files []manifestFile
+	total := 0
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || !isManifestFile(d.Name()) {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if total += len(content); total > maxManifestBytes {
+			return fmt.Errorf("manifests exceed %d bytes", maxManifestBytes)
+		}
+		rel, _ := filepath.Rel(dir, path)
+		files = append(files, manifestFile{Name: rel, Content: content})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read manifests failed, %v", err)
+	}
+	return files, nil
+}
+
+func isManifestFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+type ociSource struct {
+	ref      name.Reference
+	username string
+	password string
+}
+
+// Fetch pulls the layers of ManifestMediaType, or all the layers if none, from the artifact. The revision is the
+// digest of the manifest.
+func (s *ociSource) Fetch(ctx context.Context) (string, []manifestFile, error) {
+	opts := []remote.Option{remote.WithContext(ctx), remote.WithUserAgent("kuscia")}
+	if s.username != "" {
+		opts = append(opts, remote.WithAuth(&authn.Basic{Username: s.username, Password: s.password}))
+	}
+
+	desc, err := remote.Get(s.ref, opts...)
+	if err != nil {
+		return "", nil, fmt.Errorf("get oci artifact %s failed, %v", s.ref, err)
+	}
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return "", nil, fmt.Errorf("parse manifest of oci artifact %s failed, %v", s.ref, err)
+	}
+
+	layers := manifest.Layers
+	var typed []v1.Descriptor
+	for _, l := range layers {
+		if l.MediaType == ManifestMediaType {
+			typed = append(typed, l)
+		}
+	}
+	if len(typed) > 0 {
+		layers = typed
+	}
+	if len(layers) == 0 {
+		return "", nil, fmt.Errorf("oci artifact %s has no layer", s.ref)
+	}
+
+	var files []manifestFile
+	total := 0
+	for _, l := range layers {
+		layer, err := remote.Layer(s.ref.Context().Digest(l.Digest.String()), opts...)
+		if err != nil {
+			return "", nil, fmt.Errorf("get layer %s of oci artifact %s failed, %v", l.Digest, s.ref, err)
+		}
+		content, err := readLayer(layer, maxManifestBytes-total)
+		if err != nil {
+			return "", nil, fmt.Errorf("pull layer %s of oci artifact %s failed, %v", l.Digest, s.ref, err)
+		}
+		total += len(content)
+		fileName := l.Annotations[titleAnnotation]
+		if fileName == "" {
+			fileName = l.Digest.String()
+		}
+		files = append(files, manifestFile{Name: fileName, Content: content})
+	}
+	return desc.Digest.String(), files, nil
+}
+
+func readLayer(layer v1.Layer, limit int) ([]byte, error) {
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > limit {
+		return nil, fmt.Errorf("manifests exceed %d bytes", maxManifestBytes)
+	}
+	return data, nil
+}
@@ -0,0 +1,305 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imageprewarm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeinformers "k8s.io/client-go/informers"
+	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/controllers"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	kuscialistersv1alpha1 "github.com/secretflow/kuscia/pkg/crd/listers/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/queue"
+	utilsres "github.com/secretflow/kuscia/pkg/utils/resources"
+)
+
+const (
+	controllerName = "imageprewarm-controller"
+	maxRetries     = 15
+)
+
+// Controller resolves the image and the nodes of the image prewarms, and aggregates the progress reported by the
+// agents of the nodes into the phase of the image prewarms.
+type Controller struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	kusciaClient kusciaclientset.Interface
+
+	kusciaInformerFactory kusciainformers.SharedInformerFactory
+	kubeInformerFactory   kubeinformers.SharedInformerFactory
+	imagePrewarmLister    kuscialistersv1alpha1.ImagePrewarmLister
+	appImageLister        kuscialistersv1alpha1.AppImageLister
+	domainLister          kuscialistersv1alpha1.DomainLister
+	nodeLister            listers.NodeLister
+	workqueue             workqueue.RateLimitingInterface
+	cacheSyncs            []cache.InformerSynced
+}
+
+func NewController(ctx context.Context, config controllers.ControllerConfig) controllers.IController {
+	kusciaInformerFactory := kusciainformers.NewSharedInformerFactory(config.KusciaClient, 10*time.Minute)
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(config.KubeClient, 10*time.Minute)
+	imagePrewarmInformer := kusciaInformerFactory.Kuscia().V1alpha1().ImagePrewarms()
+	appImageInformer := kusciaInformerFactory.Kuscia().V1alpha1().AppImages()
+	domainInformer := kusciaInformerFactory.Kuscia().V1alpha1().Domains()
+	nodeInformer := kubeInformerFactory.Core().V1().Nodes()
+
+	c := &Controller{
+		kusciaClient:          config.KusciaClient,
+		kusciaInformerFactory: kusciaInformerFactory,
+		kubeInformerFactory:   kubeInformerFactory,
+		imagePrewarmLister:    imagePrewarmInformer.Lister(),
+		appImageLister:        appImageInformer.Lister(),
+		domainLister:          domainInformer.Lister(),
+		nodeLister:            nodeInformer.Lister(),
+		workqueue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "imageprewarm"),
+		cacheSyncs: []cache.InformerSynced{
+			imagePrewarmInformer.Informer().HasSynced,
+			appImageInformer.Informer().HasSynced,
+			domainInformer.Informer().HasSynced,
+			nodeInformer.Informer().HasSynced,
+		},
+	}
+	c.ctx, c.cancel = context.WithCancel(ctx)
+
+	_, _ = imagePrewarmInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			queue.EnqueueObjectWithKey(obj, c.workqueue)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			queue.EnqueueObjectWithKey(newObj, c.workqueue)
+		},
+	})
+	return c
+}
+
+func (c *Controller) Run(workers int) error {
+	defer runtime.HandleCrash()
+	defer c.workqueue.ShutDown()
+
+	nlog.Infof("Starting %s", c.Name())
+	c.kusciaInformerFactory.Start(c.ctx.Done())
+	c.kubeInformerFactory.Start(c.ctx.Done())
+
+	nlog.Infof("Waiting for informer cache to sync for %s", c.Name())
+	if !cache.WaitForCacheSync(c.ctx.Done(), c.cacheSyncs...) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	nlog.Infof("Starting workers for %s", c.Name())
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.runWorker, time.Second, c.ctx.Done())
+	}
+
+	<-c.ctx.Done()
+	return nil
+}
+
+func (c *Controller) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+}
+
+func (c *Controller) Name() string {
+	return controllerName
+}
+
+func (c *Controller) runWorker() {
+	for queue.HandleQueueItem(context.Background(), controllerName, c.workqueue, c.syncHandler, maxRetries) {
+	}
+}
+
+func (c *Controller) syncHandler(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		nlog.Errorf("Failed to split image prewarm key %v, %v, skip processing it", key, err)
+		return nil
+	}
+	ip, err := c.imagePrewarmLister.ImagePrewarms(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			nlog.Infof("Image prewarm %v maybe deleted, skip processing it", key)
+			return nil
+		}
+		return err
+	}
+	if isFinished(ip.Status.Phase) {
+		return nil
+	}
+
+	newIP := ip.DeepCopy()
+	now := metav1.Now()
+	if newIP.Status.Phase == "" {
+		c.initStatus(newIP, now)
+	} else {
+		c.failLostNodes(newIP, now)
+		aggregateStatus(newIP, now)
+	}
+	if reflect.DeepEqual(ip.Status, newIP.Status) {
+		return nil
+	}
+
+	if _, err := c.kusciaClient.KusciaV1alpha1().ImagePrewarms(namespace).UpdateStatus(ctx, newIP, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update image prewarm %v status failed, %v", key, err)
+	}
+	return nil
+}
+
+// initStatus resolves the image of the app image and the nodes to pull the image, the nodes are left to the agents
+// in the pending phase, the nodes not ready are failed directly.
+func (c *Controller) initStatus(ip *kusciaapisv1alpha1.ImagePrewarm, now metav1.Time) {
+	ip.Status.StartTime = &now
+	appImage, err := c.appImageLister.Get(ip.Spec.AppImage)
+	if err != nil {
+		setFailed(ip, now, fmt.Sprintf("get app image %s failed, %v", ip.Spec.AppImage, err))
+		return
+	}
+	ip.Status.Image = fmt.Sprintf("%s:%s", appImage.Spec.Image.Name, appImage.Spec.Image.Tag)
+	domain, err := c.domainLister.Get(ip.Namespace)
+	if err != nil {
+		nlog.Warnf("Failed to get domain %s, skip its image registry credential, %v", ip.Namespace, err)
+	}
+	ip.Status.RegistryCredentialKey = utilsres.GetImageRegistryCredentialKey(appImage, domain)
+
+	nodes, err := c.selectNodes(ip)
+	if err != nil {
+		setFailed(ip, now, err.Error())
+		return
+	}
+	for _, node := range nodes {
+		nodeStatus := kusciaapisv1alpha1.ImagePrewarmNodeStatus{
+			NodeName:           node.Name,
+			Phase:              kusciaapisv1alpha1.ImagePrewarmPending,
+			LastTransitionTime: &now,
+		}
+		if !isNodeReady(node) {
+			nodeStatus.Phase = kusciaapisv1alpha1.ImagePrewarmFailed
+			nodeStatus.Message = "node is not ready"
+		}
+		ip.Status.NodeStatuses = append(ip.Status.NodeStatuses, nodeStatus)
+	}
+	ip.Status.Phase = kusciaapisv1alpha1.ImagePrewarmPulling
+	aggregateStatus(ip, now)
+}
+
+// selectNodes returns the nodes of the domain in the spec, or all the nodes of the domain if not specified.
+func (c *Controller) selectNodes(ip *kusciaapisv1alpha1.ImagePrewarm) ([]*corev1.Node, error) {
+	nodes, err := c.nodeLister.List(labels.SelectorFromSet(labels.Set{common.LabelNodeNamespace: ip.Namespace}))
+	if err != nil {
+		return nil, fmt.Errorf("list nodes of domain %s failed, %v", ip.Namespace, err)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	if len(ip.Spec.Nodes) == 0 {
+		if len(nodes) == 0 {
+			return nil, fmt.Errorf("domain %s has no node", ip.Namespace)
+		}
+		return nodes, nil
+	}
+
+	domainNodes := make(map[string]*corev1.Node, len(nodes))
+	for _, node := range nodes {
+		domainNodes[node.Name] = node
+	}
+	var selected []*corev1.Node
+	for _, name := range ip.Spec.Nodes {
+		node, ok := domainNodes[name]
+		if !ok {
+			return nil, fmt.Errorf("node %s is not found in domain %s", name, ip.Namespace)
+		}
+		selected = append(selected, node)
+	}
+	return selected, nil
+}
+
+// failLostNodes fails the nodes which are deleted or not ready before finishing, since their agents can't report.
+func (c *Controller) failLostNodes(ip *kusciaapisv1alpha1.ImagePrewarm, now metav1.Time) {
+	for i := range ip.Status.NodeStatuses {
+		nodeStatus := &ip.Status.NodeStatuses[i]
+		if isFinished(nodeStatus.Phase) {
+			continue
+		}
+		node, err := c.nodeLister.Get(nodeStatus.NodeName)
+		switch {
+		case k8serrors.IsNotFound(err):
+			nodeStatus.Message = "node is deleted"
+		case err == nil && !isNodeReady(node):
+			nodeStatus.Message = "node is not ready"
+		default:
+			continue
+		}
+		nodeStatus.Phase = kusciaapisv1alpha1.ImagePrewarmFailed
+		nodeStatus.LastTransitionTime = &now
+	}
+}
+
+// aggregateStatus finishes the image prewarm once all the nodes are finished, it fails if any node fails.
+func aggregateStatus(ip *kusciaapisv1alpha1.ImagePrewarm, now metav1.Time) {
+	var failed []string
+	for _, nodeStatus := range ip.Status.NodeStatuses {
+		if !isFinished(nodeStatus.Phase) {
+			return
+		}
+		if nodeStatus.Phase == kusciaapisv1alpha1.ImagePrewarmFailed {
+			failed = append(failed, fmt.Sprintf("%s: %s", nodeStatus.NodeName, nodeStatus.Message))
+		}
+	}
+	if len(failed) > 0 {
+		setFailed(ip, now, fmt.Sprintf("failed to pull image on nodes [%s]", strings.Join(failed, "; ")))
+		return
+	}
+	ip.Status.Phase = kusciaapisv1alpha1.ImagePrewarmSucceeded
+	ip.Status.CompletionTime = &now
+}
+
+func setFailed(ip *kusciaapisv1alpha1.ImagePrewarm, now metav1.Time, message string) {
+	ip.Status.Phase = kusciaapisv1alpha1.ImagePrewarmFailed
+	ip.Status.Message = message
+	ip.Status.CompletionTime = &now
+}
+
+func isFinished(phase kusciaapisv1alpha1.ImagePrewarmPhase) bool {
+	return phase == kusciaapisv1alpha1.ImagePrewarmSucceeded || phase == kusciaapisv1alpha1.ImagePrewarmFailed
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imageprewarm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/controllers"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+)
+
+func makeNode(name, domain string, ready corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{common.LabelNodeNamespace: domain}},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+		},
+	}
+}
+
+func newTestController(t *testing.T, ip *kusciaapisv1alpha1.ImagePrewarm, nodes ...*corev1.Node) *Controller {
+	appImage := &kusciaapisv1alpha1.AppImage{
+		ObjectMeta: metav1.ObjectMeta{Name: "secretflow"},
+		Spec:       kusciaapisv1alpha1.AppImageSpec{Image: kusciaapisv1alpha1.AppImageInfo{Name: "secretflow/secretflow", Tag: "1.0.0"}},
+	}
+	domain := &kusciaapisv1alpha1.Domain{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+		Spec:       kusciaapisv1alpha1.DomainSpec{ImageRegistryCredentialKey: "harbor-robot"},
+	}
+	kusciaClient := kusciafake.NewSimpleClientset(appImage, ip)
+	kubeClient := kubefake.NewSimpleClientset()
+	c := NewController(context.Background(), controllers.ControllerConfig{
+		KubeClient:   kubeClient,
+		KusciaClient: kusciaClient,
+	}).(*Controller)
+
+	assert.NoError(t, c.kusciaInformerFactory.Kuscia().V1alpha1().AppImages().Informer().GetStore().Add(appImage))
+	assert.NoError(t, c.kusciaInformerFactory.Kuscia().V1alpha1().Domains().Informer().GetStore().Add(domain))
+	assert.NoError(t, c.kusciaInformerFactory.Kuscia().V1alpha1().ImagePrewarms().Informer().GetStore().Add(ip))
+	for _, node := range nodes {
+		assert.NoError(t, c.kubeInformerFactory.Core().V1().Nodes().Informer().GetStore().Add(node))
+	}
+	return c
+}
+
+func syncAndGet(t *testing.T, c *Controller, ip *kusciaapisv1alpha1.ImagePrewarm) *kusciaapisv1alpha1.ImagePrewarm {
+	assert.NoError(t, c.syncHandler(context.Background(), ip.Namespace+"/"+ip.Name))
+	got, err := c.kusciaClient.KusciaV1alpha1().ImagePrewarms(ip.Namespace).Get(context.Background(), ip.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	return got
+}
+
+func TestInitStatus(t *testing.T) {
+	ip := &kusciaapisv1alpha1.ImagePrewarm{
+		ObjectMeta: metav1.ObjectMeta{Name: "prewarm", Namespace: "alice"},
+		Spec:       kusciaapisv1alpha1.ImagePrewarmSpec{AppImage: "secretflow"},
+	}
+	c := newTestController(t, ip,
+		makeNode("alice-2", "alice", corev1.ConditionFalse),
+		makeNode("alice-1", "alice", corev1.ConditionTrue),
+		makeNode("bob-1", "bob", corev1.ConditionTrue))
+
+	got := syncAndGet(t, c, ip)
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmPulling, got.Status.Phase)
+	assert.Equal(t, "secretflow/secretflow:1.0.0", got.Status.Image)
+	assert.Equal(t, "harbor-robot", got.Status.RegistryCredentialKey)
+	assert.Len(t, got.Status.NodeStatuses, 2)
+	assert.Equal(t, "alice-1", got.Status.NodeStatuses[0].NodeName)
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmPending, got.Status.NodeStatuses[0].Phase)
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmFailed, got.Status.NodeStatuses[1].Phase)
+	assert.NotNil(t, got.Status.StartTime)
+}
+
+func TestInitStatusFailed(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    kusciaapisv1alpha1.ImagePrewarmSpec
+		message string
+	}{
+		{
+			name:    "app image not found",
+			spec:    kusciaapisv1alpha1.ImagePrewarmSpec{AppImage: "psi"},
+			message: "get app image psi failed",
+		},
+		{
+			name:    "node not in domain",
+			spec:    kusciaapisv1alpha1.ImagePrewarmSpec{AppImage: "secretflow", Nodes: []string{"bob-1"}},
+			message: "node bob-1 is not found in domain alice",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip := &kusciaapisv1alpha1.ImagePrewarm{
+				ObjectMeta: metav1.ObjectMeta{Name: "prewarm", Namespace: "alice"},
+				Spec:       tt.spec,
+			}
+			c := newTestController(t, ip, makeNode("bob-1", "bob", corev1.ConditionTrue))
+			got := syncAndGet(t, c, ip)
+			assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmFailed, got.Status.Phase)
+			assert.Contains(t, got.Status.Message, tt.message)
+			assert.NotNil(t, got.Status.CompletionTime)
+		})
+	}
+}
+
+func TestAggregateStatus(t *testing.T) {
+	ip := &kusciaapisv1alpha1.ImagePrewarm{
+		ObjectMeta: metav1.ObjectMeta{Name: "prewarm", Namespace: "alice"},
+		Spec:       kusciaapisv1alpha1.ImagePrewarmSpec{AppImage: "secretflow"},
+		Status: kusciaapisv1alpha1.ImagePrewarmStatus{
+			Phase: kusciaapisv1alpha1.ImagePrewarmPulling,
+			NodeStatuses: []kusciaapisv1alpha1.ImagePrewarmNodeStatus{
+				{NodeName: "alice-1", Phase: kusciaapisv1alpha1.ImagePrewarmSucceeded},
+				{NodeName: "alice-2", Phase: kusciaapisv1alpha1.ImagePrewarmPulling},
+			},
+		},
+	}
+	c := newTestController(t, ip, makeNode("alice-1", "alice", corev1.ConditionTrue), makeNode("alice-2", "alice", corev1.ConditionTrue))
+	got := syncAndGet(t, c, ip)
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmPulling, got.Status.Phase)
+
+	// the node lost before finishing is failed
+	assert.NoError(t, c.kubeInformerFactory.Core().V1().Nodes().Informer().GetStore().Delete(makeNode("alice-2", "alice", corev1.ConditionTrue)))
+	got = syncAndGet(t, c, ip)
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmFailed, got.Status.Phase)
+	assert.Equal(t, "failed to pull image on nodes [alice-2: node is deleted]", got.Status.Message)
+
+	ip.Status.NodeStatuses[1].Phase = kusciaapisv1alpha1.ImagePrewarmSucceeded
+	aggregateStatus(ip, metav1.Now())
+	assert.Equal(t, kusciaapisv1alpha1.ImagePrewarmSucceeded, ip.Status.Phase)
+}
@@ -0,0 +1,222 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jobarchive archives the finished KusciaJobs to an external store and deletes them from the cluster.
+package jobarchive
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/controllers"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	kuscialistersv1alpha1 "github.com/secretflow/kuscia/pkg/crd/listers/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/jobarchive"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	controllerName = "kuscia-job-archive-controller"
+	batchSize      = 100
+)
+
+// Controller archives the cross domain KusciaJobs finished longer than the retention, along with their tasks and
+// events, then deletes the jobs. The tasks are deleted with the jobs by the owner references. A job is kept in the
+// cluster if it fails to be archived, and is retried in the next round.
+type Controller struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	conf   *kusciaconfig.JobArchiveConfig
+
+	kubeClient            kubernetes.Interface
+	kusciaClient          kusciaclientset.Interface
+	kusciaInformerFactory kusciainformers.SharedInformerFactory
+	kusciaJobLister       kuscialistersv1alpha1.KusciaJobLister
+	kusciaTaskLister      kuscialistersv1alpha1.KusciaTaskLister
+	domainDataGrantLister kuscialistersv1alpha1.DomainDataGrantLister
+	cacheSyncs            []cache.InformerSynced
+
+	store     jobarchive.Store
+	batchWait time.Duration
+}
+
+func NewController(ctx context.Context, config controllers.ControllerConfig) controllers.IController {
+	kusciaInformerFactory := kusciainformers.NewSharedInformerFactory(config.KusciaClient, 10*time.Minute)
+	kusciaJobInformer := kusciaInformerFactory.Kuscia().V1alpha1().KusciaJobs()
+	kusciaTaskInformer := kusciaInformerFactory.Kuscia().V1alpha1().KusciaTasks()
+	domainDataGrantInformer := kusciaInformerFactory.Kuscia().V1alpha1().DomainDataGrants()
+
+	c := &Controller{
+		conf:                  config.JobArchive,
+		kubeClient:            config.KubeClient,
+		kusciaClient:          config.KusciaClient,
+		kusciaInformerFactory: kusciaInformerFactory,
+		kusciaJobLister:       kusciaJobInformer.Lister(),
+		kusciaTaskLister:      kusciaTaskInformer.Lister(),
+		domainDataGrantLister: domainDataGrantInformer.Lister(),
+		cacheSyncs: []cache.InformerSynced{
+			kusciaJobInformer.Informer().HasSynced,
+			kusciaTaskInformer.Informer().HasSynced,
+			domainDataGrantInformer.Informer().HasSynced,
+		},
+		batchWait: 5 * time.Second,
+	}
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	return c
+}
+
+func (c *Controller) Run(int) error {
+	if c.conf == nil || !c.conf.Enable {
+		nlog.Infof("KusciaJob archive is disabled, %s exits", c.Name())
+		return nil
+	}
+	if c.store == nil {
+		store, err := jobarchive.NewStore(&c.conf.Store)
+		if err != nil {
+			return err
+		}
+		c.store = store
+	}
+
+	c.kusciaInformerFactory.Start(c.ctx.Done())
+	nlog.Infof("Waiting for informer cache to sync for %s", c.Name())
+	if !cache.WaitForCacheSync(c.ctx.Done(), c.cacheSyncs...) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	nlog.Infof("Start archiving the KusciaJobs finished longer than %v to %s every %v", c.conf.Retention(),
+		c.conf.Store.Type, c.conf.Interval())
+	wait.UntilWithContext(c.ctx, func(ctx context.Context) {
+		c.archive(ctx, time.Now())
+	}, c.conf.Interval())
+	return nil
+}
+
+func (c *Controller) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+}
+
+func (c *Controller) Name() string {
+	return controllerName
+}
+
+// archive archives the expired jobs in the order of the completion time, and returns the number of archived jobs.
+func (c *Controller) archive(ctx context.Context, now time.Time) int {
+	jobs, err := c.kusciaJobLister.KusciaJobs(common.KusciaCrossDomain).List(labels.Everything())
+	if err != nil {
+		nlog.Warnf("List KusciaJobs failed, %v", err)
+		return 0
+	}
+	var expired []*kusciaapisv1alpha1.KusciaJob
+	for _, job := range jobs {
+		if job.DeletionTimestamp != nil || job.Status.CompletionTime == nil {
+			continue
+		}
+		if now.Sub(job.Status.CompletionTime.Time) >= c.conf.Retention() {
+			expired = append(expired, job)
+		}
+	}
+	if len(expired) == 0 {
+		return 0
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].Status.CompletionTime.Before(expired[j].Status.CompletionTime)
+	})
+
+	// the events are listed once for the round, Build picks the events of each job
+	events, err := c.kubeClient.CoreV1().Events(common.KusciaCrossDomain).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		nlog.Warnf("List events failed, the jobs are archived without events, %v", err)
+		events = nil
+	}
+
+	// the grants are summarized in the archive, so that the usage of the domain data can be reported by the grants
+	grants, err := c.domainDataGrantLister.List(labels.Everything())
+	if err != nil {
+		nlog.Warnf("List DomainDataGrants failed, the jobs are archived without grants, %v", err)
+		grants = nil
+	}
+
+	archived := 0
+	for i, job := range expired {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := c.archiveJob(ctx, job, events, grants, now); err != nil {
+			nlog.Warnf("Archive KusciaJob %s failed, %v", job.Name, err)
+		} else {
+			archived++
+		}
+		if (i+1)%batchSize == 0 && c.batchWait > 0 {
+			nlog.Infof("KusciaJob archive sleeping for %v...", c.batchWait)
+			time.Sleep(c.batchWait)
+		}
+	}
+	nlog.Infof("Archived %d/%d KusciaJobs to %s", archived, len(expired), c.conf.Store.Type)
+	return archived
+}
+
+func (c *Controller) archiveJob(ctx context.Context, job *kusciaapisv1alpha1.KusciaJob, events *corev1.EventList,
+	grants []*kusciaapisv1alpha1.DomainDataGrant, now time.Time) error {
+	var tasks []*kusciaapisv1alpha1.KusciaTask
+	for _, template := range job.Spec.Tasks {
+		if template.TaskID == "" {
+			continue
+		}
+		task, err := c.kusciaTaskLister.KusciaTasks(common.KusciaCrossDomain).Get(template.TaskID)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		tasks = append(tasks, task)
+	}
+	var items []corev1.Event
+	if events != nil {
+		items = events.Items
+	}
+	archived := jobarchive.Build(job, tasks, items, now)
+	jobarchive.SummarizeGrants(archived, grants)
+	if err := c.store.Put(ctx, archived); err != nil {
+		return err
+	}
+
+	// the precondition keeps a job recreated with the same name after the archive
+	uid := job.UID
+	err := c.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Delete(ctx, job.Name,
+		metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &uid}})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("delete archived job failed, %v", err)
+	}
+	nlog.Infof("Archived and deleted KusciaJob %s (completed at %v)", job.Name, job.Status.CompletionTime.Time)
+	return nil
+}
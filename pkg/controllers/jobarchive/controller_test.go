@@ -0,0 +1,169 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobarchive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/controllers"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/jobarchive"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+type fakeStore struct {
+	jobs map[string]*jobarchive.ArchivedJob
+	err  error
+}
+
+func (s *fakeStore) Put(ctx context.Context, job *jobarchive.ArchivedJob) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.jobs[job.JobID] = job
+	return nil
+}
+
+func (s *fakeStore) Get(ctx context.Context, jobID string) (*jobarchive.ArchivedJob, error) {
+	if job, ok := s.jobs[jobID]; ok {
+		return job, nil
+	}
+	return nil, jobarchive.ErrNotFound
+}
+
+func makeJob(name string, completion *time.Time) *kusciaapisv1alpha1.KusciaJob {
+	job := &kusciaapisv1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.KusciaCrossDomain, UID: types.UID("uid-" + name)},
+		Spec: kusciaapisv1alpha1.KusciaJobSpec{
+			Initiator: "alice",
+			Tasks: []kusciaapisv1alpha1.KusciaTaskTemplate{{
+				Alias: "a", TaskID: name + "-a", TaskInputConfig: `{"sf_input_ids":["alice-table"]}`,
+			}},
+		},
+		Status: kusciaapisv1alpha1.KusciaJobStatus{Phase: kusciaapisv1alpha1.KusciaJobSucceeded},
+	}
+	if completion != nil {
+		t := metav1.NewTime(*completion)
+		job.Status.CompletionTime = &t
+	}
+	return job
+}
+
+func newTestController(t *testing.T, store *fakeStore, jobs ...*kusciaapisv1alpha1.KusciaJob) (*Controller,
+	*kusciafake.Clientset) {
+	kusciaClient := kusciafake.NewSimpleClientset()
+	kubeClient := kubefake.NewSimpleClientset(&corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "ev", Namespace: common.KusciaCrossDomain},
+		InvolvedObject: corev1.ObjectReference{Kind: "KusciaJob", Name: "old"},
+		Type:           corev1.EventTypeNormal,
+		Reason:         "Succeeded",
+		Count:          1,
+	})
+	c := NewController(context.Background(), controllers.ControllerConfig{
+		KubeClient:   kubeClient,
+		KusciaClient: kusciaClient,
+		JobArchive:   &kusciaconfig.JobArchiveConfig{Enable: true, Store: kusciaconfig.JobArchiveStoreConfig{Type: "mysql"}},
+	}).(*Controller)
+	c.store = store
+	c.batchWait = 0
+	for _, job := range jobs {
+		_, err := kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Create(context.Background(), job,
+			metav1.CreateOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, c.kusciaInformerFactory.Kuscia().V1alpha1().KusciaJobs().Informer().GetStore().Add(job))
+	}
+	task := &kusciaapisv1alpha1.KusciaTask{
+		ObjectMeta: metav1.ObjectMeta{Name: "old-a", Namespace: common.KusciaCrossDomain},
+		Status: kusciaapisv1alpha1.KusciaTaskStatus{
+			Phase:           kusciaapisv1alpha1.TaskSucceeded,
+			PartyTaskStatus: []kusciaapisv1alpha1.PartyTaskStatus{{DomainID: "alice"}, {DomainID: "bob"}},
+		},
+	}
+	assert.NoError(t, c.kusciaInformerFactory.Kuscia().V1alpha1().KusciaTasks().Informer().GetStore().Add(task))
+	for _, grant := range []*kusciaapisv1alpha1.DomainDataGrant{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "grant-bob", Namespace: "alice"},
+			Spec:       kusciaapisv1alpha1.DomainDataGrantSpec{Author: "alice", DomainDataID: "alice-table", GrantDomain: "bob"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "grant-carol", Namespace: "alice"},
+			Spec:       kusciaapisv1alpha1.DomainDataGrantSpec{Author: "alice", DomainDataID: "alice-table", GrantDomain: "carol"},
+		},
+	} {
+		assert.NoError(t, c.kusciaInformerFactory.Kuscia().V1alpha1().DomainDataGrants().Informer().GetStore().Add(grant))
+	}
+	return c, kusciaClient
+}
+
+func TestArchive(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-8 * 24 * time.Hour)
+	recent := now.Add(-time.Hour)
+	store := &fakeStore{jobs: map[string]*jobarchive.ArchivedJob{}}
+	c, kusciaClient := newTestController(t, store, makeJob("old", &old), makeJob("recent", &recent),
+		makeJob("running", nil))
+
+	assert.Equal(t, 1, c.archive(context.Background(), now))
+	archived, ok := store.jobs["old"]
+	assert.True(t, ok)
+	assert.Equal(t, "alice", archived.Job.Spec.Initiator)
+	assert.Len(t, archived.Tasks, 1)
+	assert.Equal(t, kusciaapisv1alpha1.TaskSucceeded, archived.Tasks[0].Phase)
+	assert.Equal(t, []string{"alice-table"}, archived.Tasks[0].Inputs)
+	assert.Len(t, archived.Events, 1)
+	// carol doesn't take part in the job
+	assert.Len(t, archived.Grants, 1)
+	assert.Equal(t, "grant-bob", archived.Grants[0].GrantID)
+
+	jobs, err := kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).List(context.Background(),
+		metav1.ListOptions{})
+	assert.NoError(t, err)
+	var names []string
+	for _, job := range jobs.Items {
+		names = append(names, job.Name)
+	}
+	assert.ElementsMatch(t, []string{"recent", "running"}, names)
+}
+
+func TestArchive_StoreFailed(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-8 * 24 * time.Hour)
+	store := &fakeStore{jobs: map[string]*jobarchive.ArchivedJob{}, err: errors.New("unavailable")}
+	c, kusciaClient := newTestController(t, store, makeJob("old", &old))
+
+	assert.Equal(t, 0, c.archive(context.Background(), now))
+	_, err := kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(context.Background(), "old",
+		metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestRun_Disabled(t *testing.T) {
+	c := NewController(context.Background(), controllers.ControllerConfig{
+		KubeClient:   kubefake.NewSimpleClientset(),
+		KusciaClient: kusciafake.NewSimpleClientset(),
+	})
+	assert.NoError(t, c.Run(1))
+}
@@ -0,0 +1,419 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciadeployment
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciav1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	// autoscaleInterval is the interval to re-evaluate the autoscaled kuscia deployments.
+	autoscaleInterval = 30 * time.Second
+
+	defaultScaleDownStabilizationWindowSeconds int32 = 300
+)
+
+type timestampedReplicas struct {
+	replicas  int32
+	timestamp time.Time
+}
+
+// recommendationHistory keeps the recent desired replicas of each autoscaled kuscia deployment for the
+// stabilization windows, it's kept in memory like the horizontal pod autoscaler does.
+type recommendationHistory struct {
+	mu      sync.Mutex
+	records map[string][]timestampedReplicas
+}
+
+func newRecommendationHistory() *recommendationHistory {
+	return &recommendationHistory{records: map[string][]timestampedReplicas{}}
+}
+
+// stabilize records the recommendation and returns the replicas to scale to within the stabilization windows.
+func (h *recommendationHistory) stabilize(key string, current, recommendation int32, behavior *kusciav1alpha1.AutoscalingBehavior, now time.Time) int32 {
+	upWindow := time.Duration(scaleUpStabilizationWindowSeconds(behavior)) * time.Second
+	downWindow := time.Duration(scaleDownStabilizationWindowSeconds(behavior)) * time.Second
+	longest := upWindow
+	if downWindow > longest {
+		longest = downWindow
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	records := []timestampedReplicas{{replicas: recommendation, timestamp: now}}
+	for _, r := range h.records[key] {
+		if now.Sub(r.timestamp) <= longest {
+			records = append(records, r)
+		}
+	}
+	h.records[key] = records
+
+	// scale up to the lowest recommendation in the up window, scale down to the highest one in the down window
+	upRecommendation, downRecommendation := recommendation, recommendation
+	for _, r := range records {
+		age := now.Sub(r.timestamp)
+		if age <= upWindow && r.replicas < upRecommendation {
+			upRecommendation = r.replicas
+		}
+		if age <= downWindow && r.replicas > downRecommendation {
+			downRecommendation = r.replicas
+		}
+	}
+
+	desired := current
+	if upRecommendation > desired {
+		desired = upRecommendation
+	}
+	if downRecommendation < desired {
+		desired = downRecommendation
+	}
+	return desired
+}
+
+func (h *recommendationHistory) forget(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.records, key)
+}
+
+func scaleUpStabilizationWindowSeconds(behavior *kusciav1alpha1.AutoscalingBehavior) int32 {
+	if behavior != nil && behavior.ScaleUp != nil && behavior.ScaleUp.StabilizationWindowSeconds != nil {
+		return *behavior.ScaleUp.StabilizationWindowSeconds
+	}
+	return 0
+}
+
+func scaleDownStabilizationWindowSeconds(behavior *kusciav1alpha1.AutoscalingBehavior) int32 {
+	if behavior != nil && behavior.ScaleDown != nil && behavior.ScaleDown.StabilizationWindowSeconds != nil {
+		return *behavior.ScaleDown.StabilizationWindowSeconds
+	}
+	return defaultScaleDownStabilizationWindowSeconds
+}
+
+func minReplicas(autoscaling *kusciav1alpha1.KusciaDeploymentAutoscaling) int32 {
+	if autoscaling.MinReplicas != nil {
+		return *autoscaling.MinReplicas
+	}
+	return 1
+}
+
+func validateAutoscaling(autoscaling *kusciav1alpha1.KusciaDeploymentAutoscaling) error {
+	if autoscaling.MaxReplicas < minReplicas(autoscaling) {
+		return fmt.Errorf("autoscaling maxReplicas %d should not be less than minReplicas %d", autoscaling.MaxReplicas, minReplicas(autoscaling))
+	}
+	if len(autoscaling.Metrics) == 0 {
+		return fmt.Errorf("autoscaling metrics can't be empty")
+	}
+	for _, metric := range autoscaling.Metrics {
+		if metric.Target <= 0 {
+			return fmt.Errorf("autoscaling metric %s target should be greater than 0", metric.Type)
+		}
+		switch metric.Type {
+		case kusciav1alpha1.AutoscalingMetricQPS, kusciav1alpha1.AutoscalingMetricLatency:
+			if metric.MetricName == "" {
+				return fmt.Errorf("autoscaling metric %s requires the metric name", metric.Type)
+			}
+		case kusciav1alpha1.AutoscalingMetricCPU:
+		default:
+			return fmt.Errorf("unsupported autoscaling metric type %q", metric.Type)
+		}
+	}
+	return nil
+}
+
+// buildAutoscalingMetricsAnnotation returns the metrics for the agent to sample in the serving pods.
+func buildAutoscalingMetricsAnnotation(autoscaling *kusciav1alpha1.KusciaDeploymentAutoscaling) (string, error) {
+	metricNames := map[kusciav1alpha1.AutoscalingMetricType]string{}
+	for _, metric := range autoscaling.Metrics {
+		metricNames[metric.Type] = metric.MetricName
+	}
+	data, err := json.Marshal(metricNames)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// fillAutoscalingAnnotations fills the pod annotations for the agent to sample the autoscaling metrics, the request
+// metrics are scraped from the metric probe of the containers.
+func fillAutoscalingAnnotations(annotations map[string]string, partyKitInfo *PartyKitInfo) error {
+	autoscaling := partyKitInfo.kd.Spec.Autoscaling
+	if autoscaling == nil {
+		return nil
+	}
+	value, err := buildAutoscalingMetricsAnnotation(autoscaling)
+	if err != nil {
+		return err
+	}
+	annotations[common.AutoscalingMetricsAnnotationKey] = value
+
+	for _, ctr := range partyKitInfo.deployTemplate.Spec.Containers {
+		if ctr.MetricProbe == nil || ctr.MetricProbe.Path == "" || ctr.MetricProbe.Port == "" {
+			continue
+		}
+		port, ok := partyKitInfo.dkInfo.ports[ctr.MetricProbe.Port]
+		if !ok {
+			return fmt.Errorf("metric port name %s not found for deployment %s", ctr.MetricProbe.Port, partyKitInfo.dkInfo.deploymentName)
+		}
+		annotations[common.MetricPathAnnotationKey] = ctr.MetricProbe.Path
+		annotations[common.MetricPortAnnotationKey] = strconv.Itoa(int(port.Port))
+		break
+	}
+	return nil
+}
+
+// refreshAutoscaling recommends the replicas of the self parties from the metrics of their pods, and the initiator
+// decides the replicas of all parties from the recommendations of the parties, so that all parties scale together.
+// The replicas of the self party deployments are set in their deploy templates. It returns true if the status
+// needs to be updated.
+func (c *Controller) refreshAutoscaling(kd *kusciav1alpha1.KusciaDeployment, partyKitInfos map[string]*PartyKitInfo) (bool, error) {
+	autoscaling := kd.Spec.Autoscaling
+	if autoscaling == nil {
+		if kd.Status.Autoscaling != nil {
+			kd.Status.Autoscaling = nil
+			return true, nil
+		}
+		return false, nil
+	}
+
+	status := kd.Status.Autoscaling.DeepCopy()
+	if status == nil {
+		status = &kusciav1alpha1.KusciaDeploymentAutoscalingStatus{}
+	}
+	if status.PartyRecommendations == nil {
+		status.PartyRecommendations = map[string]kusciav1alpha1.AutoscalingRecommendation{}
+	}
+
+	now := time.Now()
+	for key, partyKitInfo := range partyKitInfos {
+		deployment, err := c.deploymentLister.Deployments(partyKitInfo.domainID).Get(partyKitInfo.dkInfo.deploymentName)
+		if err != nil {
+			// the deployment is not created yet
+			continue
+		}
+		recommendation, err := c.recommendReplicas(autoscaling, deployment)
+		if err != nil {
+			return false, err
+		}
+		if recommendation == nil {
+			continue
+		}
+		pre, ok := status.PartyRecommendations[key]
+		if !ok || pre.Replicas != recommendation.Replicas || !reflect.DeepEqual(pre.CurrentMetrics, recommendation.CurrentMetrics) {
+			updateTime := metav1.NewTime(now)
+			recommendation.UpdateTime = &updateTime
+			status.PartyRecommendations[key] = *recommendation
+		}
+	}
+
+	isInitiator, err := c.isInitiatorController(kd)
+	if err != nil {
+		return false, err
+	}
+	if isInitiator {
+		c.decideReplicas(kd, status, now)
+	}
+
+	desired := status.DesiredReplicas
+	if desired == 0 {
+		desired = minReplicas(autoscaling)
+	}
+	for _, partyKitInfo := range partyKitInfos {
+		replicas := desired
+		partyKitInfo.deployTemplate.Replicas = &replicas
+	}
+
+	if reflect.DeepEqual(kd.Status.Autoscaling, status) {
+		return false, nil
+	}
+	kd.Status.Autoscaling = status
+	return true, nil
+}
+
+// decideReplicas takes the largest recommendation of the parties as the desired replicas of all parties.
+func (c *Controller) decideReplicas(kd *kusciav1alpha1.KusciaDeployment, status *kusciav1alpha1.KusciaDeploymentAutoscalingStatus, now time.Time) {
+	autoscaling := kd.Spec.Autoscaling
+	current := status.DesiredReplicas
+	if current == 0 {
+		current = minReplicas(autoscaling)
+	}
+
+	// the recommendations of the parties which have left the deployment are ignored
+	parties := map[string]bool{}
+	for _, party := range kd.Spec.Parties {
+		parties[party.DomainID+"/"+party.Role] = true
+	}
+	keys := make([]string, 0, len(status.PartyRecommendations))
+	for key := range status.PartyRecommendations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	recommendation := int32(0)
+	for _, key := range keys {
+		if !parties[key] {
+			delete(status.PartyRecommendations, key)
+			continue
+		}
+		if r := status.PartyRecommendations[key]; r.Replicas > recommendation {
+			recommendation = r.Replicas
+		}
+	}
+	if recommendation == 0 {
+		recommendation = current
+	}
+
+	kdKey, _ := cache.MetaNamespaceKeyFunc(kd)
+	desired := c.recommendations.stabilize(kdKey, current, recommendation, autoscaling.Behavior, now)
+	desired = limitStep(current, desired, autoscaling.Behavior)
+	desired = clampReplicas(desired, autoscaling)
+
+	if desired != status.DesiredReplicas {
+		nlog.Infof("KusciaDeployment %s scales from %d to %d replicas, recommendation=%d", kdKey, status.DesiredReplicas, desired, recommendation)
+		status.DesiredReplicas = desired
+		lastScaleTime := metav1.NewTime(now)
+		status.LastScaleTime = &lastScaleTime
+	}
+}
+
+func limitStep(current, desired int32, behavior *kusciav1alpha1.AutoscalingBehavior) int32 {
+	if behavior == nil {
+		return desired
+	}
+	if desired > current && behavior.ScaleUp != nil && behavior.ScaleUp.MaxStep > 0 && desired-current > behavior.ScaleUp.MaxStep {
+		return current + behavior.ScaleUp.MaxStep
+	}
+	if desired < current && behavior.ScaleDown != nil && behavior.ScaleDown.MaxStep > 0 && current-desired > behavior.ScaleDown.MaxStep {
+		return current - behavior.ScaleDown.MaxStep
+	}
+	return desired
+}
+
+func clampReplicas(replicas int32, autoscaling *kusciav1alpha1.KusciaDeploymentAutoscaling) int32 {
+	if replicas < minReplicas(autoscaling) {
+		return minReplicas(autoscaling)
+	}
+	if replicas > autoscaling.MaxReplicas {
+		return autoscaling.MaxReplicas
+	}
+	return replicas
+}
+
+// recommendReplicas calculates the desired replicas of the deployment from the average metrics of its running pods,
+// the largest desired replicas of all metrics is returned. It returns nil if none of the pods reports metrics.
+func (c *Controller) recommendReplicas(autoscaling *kusciav1alpha1.KusciaDeploymentAutoscaling, deployment *appsv1.Deployment) (*kusciav1alpha1.AutoscalingRecommendation, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := c.podLister.Pods(deployment.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	sums, count := sumPodMetrics(pods)
+	if count == 0 {
+		return nil, nil
+	}
+
+	recommendation := &kusciav1alpha1.AutoscalingRecommendation{CurrentMetrics: map[kusciav1alpha1.AutoscalingMetricType]int64{}}
+	for _, metric := range autoscaling.Metrics {
+		sum, ok := sums[metric.Type]
+		if !ok {
+			continue
+		}
+		recommendation.CurrentMetrics[metric.Type] = sum / int64(count)
+		replicas := int32(math.Ceil(float64(sum) / float64(metric.Target)))
+		if replicas > recommendation.Replicas {
+			recommendation.Replicas = replicas
+		}
+	}
+	if len(recommendation.CurrentMetrics) == 0 {
+		return nil, nil
+	}
+	recommendation.Replicas = clampReplicas(recommendation.Replicas, autoscaling)
+	return recommendation, nil
+}
+
+// sumPodMetrics sums the metrics reported by the agent of the running pods, and returns the number of pods counted.
+func sumPodMetrics(pods []*corev1.Pod) (map[kusciav1alpha1.AutoscalingMetricType]int64, int) {
+	sums := map[kusciav1alpha1.AutoscalingMetricType]int64{}
+	count := 0
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		metrics, ok := parseServingMetrics(pod)
+		if !ok {
+			continue
+		}
+		count++
+		for metricType, value := range metrics {
+			sums[metricType] += value
+		}
+	}
+	return sums, count
+}
+
+func parseServingMetrics(pod *corev1.Pod) (map[kusciav1alpha1.AutoscalingMetricType]int64, bool) {
+	value := pod.Annotations[common.ServingMetricsAnnotationKey]
+	if value == "" {
+		return nil, false
+	}
+	metrics := map[kusciav1alpha1.AutoscalingMetricType]int64{}
+	if err := json.Unmarshal([]byte(value), &metrics); err != nil {
+		nlog.Warnf("Failed to parse serving metrics of pod %s/%s, %v", pod.Namespace, pod.Name, err)
+		return nil, false
+	}
+	return metrics, true
+}
+
+// enqueueAutoscaledDeployments enqueues the autoscaled kuscia deployments periodically, since the metrics reported
+// in the pod annotations don't trigger the reconciliation.
+func (c *Controller) enqueueAutoscaledDeployments() {
+	kds, err := c.kdLister.List(labels.Everything())
+	if err != nil {
+		nlog.Warnf("Failed to list kuscia deployments, %v", err)
+		return
+	}
+	for _, kd := range kds {
+		if kd.Spec.Autoscaling == nil || !c.kusciaDeploymentResourceFilter(kd) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(kd)
+		if err != nil {
+			continue
+		}
+		c.kdQueue.Add(key)
+	}
+}
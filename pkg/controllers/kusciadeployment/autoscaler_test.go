@@ -0,0 +1,220 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciadeployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciav1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientsetfake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+)
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func TestValidateAutoscaling(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoscaling *kusciav1alpha1.KusciaDeploymentAutoscaling
+		wantErr     bool
+	}{
+		{
+			name: "valid",
+			autoscaling: &kusciav1alpha1.KusciaDeploymentAutoscaling{MaxReplicas: 3, Metrics: []kusciav1alpha1.AutoscalingMetric{
+				{Type: kusciav1alpha1.AutoscalingMetricQPS, Target: 100, MetricName: "requests_total"},
+				{Type: kusciav1alpha1.AutoscalingMetricCPU, Target: 500},
+			}},
+		},
+		{
+			name: "max replicas less than min replicas",
+			autoscaling: &kusciav1alpha1.KusciaDeploymentAutoscaling{MinReplicas: int32Ptr(3), MaxReplicas: 2, Metrics: []kusciav1alpha1.AutoscalingMetric{
+				{Type: kusciav1alpha1.AutoscalingMetricCPU, Target: 500},
+			}},
+			wantErr: true,
+		},
+		{
+			name:        "empty metrics",
+			autoscaling: &kusciav1alpha1.KusciaDeploymentAutoscaling{MaxReplicas: 3},
+			wantErr:     true,
+		},
+		{
+			name: "latency without metric name",
+			autoscaling: &kusciav1alpha1.KusciaDeploymentAutoscaling{MaxReplicas: 3, Metrics: []kusciav1alpha1.AutoscalingMetric{
+				{Type: kusciav1alpha1.AutoscalingMetricLatency, Target: 50},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAutoscaling(tt.autoscaling)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestRecommendationHistoryStabilize(t *testing.T) {
+	h := newRecommendationHistory()
+	behavior := &kusciav1alpha1.AutoscalingBehavior{
+		ScaleDown: &kusciav1alpha1.AutoscalingRules{StabilizationWindowSeconds: int32Ptr(60)},
+	}
+	now := time.Now()
+
+	// scale up immediately
+	assert.Equal(t, int32(4), h.stabilize("kd", 2, 4, behavior, now))
+	// scale down to the highest recommendation in the window
+	assert.Equal(t, int32(4), h.stabilize("kd", 4, 1, behavior, now.Add(30*time.Second)))
+	assert.Equal(t, int32(1), h.stabilize("kd", 4, 1, behavior, now.Add(100*time.Second)))
+
+	h.forget("kd")
+	assert.Empty(t, h.records)
+}
+
+func TestLimitStepAndClamp(t *testing.T) {
+	behavior := &kusciav1alpha1.AutoscalingBehavior{
+		ScaleUp:   &kusciav1alpha1.AutoscalingRules{MaxStep: 2},
+		ScaleDown: &kusciav1alpha1.AutoscalingRules{MaxStep: 1},
+	}
+	assert.Equal(t, int32(3), limitStep(1, 10, behavior))
+	assert.Equal(t, int32(4), limitStep(5, 1, behavior))
+	assert.Equal(t, int32(10), limitStep(1, 10, nil))
+
+	autoscaling := &kusciav1alpha1.KusciaDeploymentAutoscaling{MinReplicas: int32Ptr(2), MaxReplicas: 5}
+	assert.Equal(t, int32(2), clampReplicas(1, autoscaling))
+	assert.Equal(t, int32(5), clampReplicas(8, autoscaling))
+	assert.Equal(t, int32(3), clampReplicas(3, autoscaling))
+}
+
+func TestRefreshAutoscaling(t *testing.T) {
+	kd := makeTestKusciaDeployment("kd-1", 1, 1, 1)
+	kd.Namespace = common.KusciaCrossDomain
+	kd.Spec.Autoscaling = &kusciav1alpha1.KusciaDeploymentAutoscaling{
+		MaxReplicas: 4,
+		Metrics: []kusciav1alpha1.AutoscalingMetric{
+			{Type: kusciav1alpha1.AutoscalingMetricQPS, Target: 100, MetricName: "requests_total"},
+			{Type: kusciav1alpha1.AutoscalingMetricCPU, Target: 1000},
+		},
+	}
+
+	selectorLabels := map[string]string{common.LabelKubernetesDeploymentName: "kd-1"}
+	makeDeployment := func(namespace string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "kd-1", Namespace: namespace},
+			Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: selectorLabels}},
+		}
+	}
+	makePod := func(namespace, name, metrics string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: selectorLabels,
+				Annotations: map[string]string{common.ServingMetricsAnnotationKey: metrics}},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	kubeInformerFactory := informers.NewSharedInformerFactory(clientsetfake.NewSimpleClientset(), 0)
+	deploymentInformer := kubeInformerFactory.Apps().V1().Deployments()
+	podInformer := kubeInformerFactory.Core().V1().Pods()
+	kusciaInformerFactory := kusciainformers.NewSharedInformerFactory(kusciaclientsetfake.NewSimpleClientset(), 0)
+	domainInformer := kusciaInformerFactory.Kuscia().V1alpha1().Domains()
+
+	assert.NoError(t, domainInformer.Informer().GetStore().Add(&kusciav1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "alice"}}))
+	for _, ns := range []string{"alice", "bob"} {
+		assert.NoError(t, deploymentInformer.Informer().GetStore().Add(makeDeployment(ns)))
+	}
+	// alice serves 250 qps with two pods, bob is idle but busy in cpu
+	assert.NoError(t, podInformer.Informer().GetStore().Add(makePod("alice", "pod-1", `{"QPS":150,"CPU":200}`)))
+	assert.NoError(t, podInformer.Informer().GetStore().Add(makePod("alice", "pod-2", `{"QPS":100,"CPU":200}`)))
+	assert.NoError(t, podInformer.Informer().GetStore().Add(makePod("bob", "pod-1", `{"QPS":0,"CPU":1500}`)))
+
+	c := &Controller{
+		deploymentLister: deploymentInformer.Lister(),
+		podLister:        podInformer.Lister(),
+		domainLister:     domainInformer.Lister(),
+		recommendations:  newRecommendationHistory(),
+	}
+	partyKitInfos := map[string]*PartyKitInfo{}
+	for _, domainID := range []string{"alice", "bob"} {
+		partyKitInfos[domainID+"/"] = &PartyKitInfo{
+			kd:             kd,
+			domainID:       domainID,
+			deployTemplate: &kusciav1alpha1.KusciaDeploymentPartyTemplate{Replicas: int32Ptr(1)},
+			dkInfo:         &DeploymentKitInfo{deploymentName: "kd-1"},
+		}
+	}
+
+	updated, err := c.refreshAutoscaling(kd, partyKitInfos)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, int32(3), kd.Status.Autoscaling.PartyRecommendations["alice/"].Replicas)
+	assert.Equal(t, int64(125), kd.Status.Autoscaling.PartyRecommendations["alice/"].CurrentMetrics[kusciav1alpha1.AutoscalingMetricQPS])
+	assert.Equal(t, int32(2), kd.Status.Autoscaling.PartyRecommendations["bob/"].Replicas)
+	// all parties scale together to the largest recommendation
+	assert.Equal(t, int32(3), kd.Status.Autoscaling.DesiredReplicas)
+	for _, partyKitInfo := range partyKitInfos {
+		assert.Equal(t, int32(3), *partyKitInfo.deployTemplate.Replicas)
+	}
+
+	updated, err = c.refreshAutoscaling(kd, partyKitInfos)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+
+	kd.Spec.Autoscaling = nil
+	updated, err = c.refreshAutoscaling(kd, partyKitInfos)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Nil(t, kd.Status.Autoscaling)
+}
+
+func TestFillAutoscalingAnnotations(t *testing.T) {
+	kd := makeTestKusciaDeployment("kd-1", 1, 1, 1)
+	partyKitInfo := &PartyKitInfo{
+		kd: kd,
+		deployTemplate: &kusciav1alpha1.KusciaDeploymentPartyTemplate{
+			Spec: kusciav1alpha1.PodSpec{Containers: []kusciav1alpha1.Container{
+				{Name: "serving", MetricProbe: &kusciav1alpha1.MetricProbe{Path: "metrics", Port: "metrics"}},
+			}},
+		},
+		dkInfo: &DeploymentKitInfo{
+			deploymentName: "kd-1",
+			ports:          NamedPorts{"metrics": kusciav1alpha1.ContainerPort{Name: "metrics", Port: 9090}},
+		},
+	}
+
+	annotations := map[string]string{}
+	assert.NoError(t, fillAutoscalingAnnotations(annotations, partyKitInfo))
+	assert.Empty(t, annotations)
+
+	kd.Spec.Autoscaling = &kusciav1alpha1.KusciaDeploymentAutoscaling{
+		MaxReplicas: 2,
+		Metrics:     []kusciav1alpha1.AutoscalingMetric{{Type: kusciav1alpha1.AutoscalingMetricQPS, Target: 100, MetricName: "requests_total"}},
+	}
+	assert.NoError(t, fillAutoscalingAnnotations(annotations, partyKitInfo))
+	assert.Equal(t, map[string]string{
+		common.AutoscalingMetricsAnnotationKey: `{"QPS":"requests_total"}`,
+		common.MetricPathAnnotationKey:         "metrics",
+		common.MetricPortAnnotationKey:         "9090",
+	}, annotations)
+}
@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	applisters "k8s.io/client-go/listers/apps/v1"
@@ -73,6 +74,10 @@ type Controller struct {
 	serviceSynced    cache.InformerSynced
 	configMapLister  corelisters.ConfigMapLister
 	configMapSynced  cache.InformerSynced
+	podLister        corelisters.PodLister
+	podSynced        cache.InformerSynced
+	nodeLister       corelisters.NodeLister
+	nodeSynced       cache.InformerSynced
 
 	kdLister       kuscialistersv1alpha1.KusciaDeploymentLister
 	kdSynced       cache.InformerSynced
@@ -80,6 +85,8 @@ type Controller struct {
 	appImageSynced cache.InformerSynced
 	domainLister   kuscialistersv1alpha1.DomainLister
 	domainSynced   cache.InformerSynced
+
+	recommendations *recommendationHistory
 }
 
 // NewController returns a controller instance.
@@ -91,6 +98,8 @@ func NewController(ctx context.Context, config controllers.ControllerConfig) con
 	nsInformer := kubeInformerFactory.Core().V1().Namespaces()
 	serviceInformer := kubeInformerFactory.Core().V1().Services()
 	configMapInformer := kubeInformerFactory.Core().V1().ConfigMaps()
+	podInformer := kubeInformerFactory.Core().V1().Pods()
+	nodeInformer := kubeInformerFactory.Core().V1().Nodes()
 	kdInformer := kusciaInformerFactory.Kuscia().V1alpha1().KusciaDeployments()
 	appImageInformer := kusciaInformerFactory.Kuscia().V1alpha1().AppImages()
 	domainInformer := kusciaInformerFactory.Kuscia().V1alpha1().Domains()
@@ -109,6 +118,10 @@ func NewController(ctx context.Context, config controllers.ControllerConfig) con
 		serviceSynced:         serviceInformer.Informer().HasSynced,
 		configMapLister:       configMapInformer.Lister(),
 		configMapSynced:       configMapInformer.Informer().HasSynced,
+		podLister:             podInformer.Lister(),
+		podSynced:             podInformer.Informer().HasSynced,
+		nodeLister:            nodeInformer.Lister(),
+		nodeSynced:            nodeInformer.Informer().HasSynced,
 		kdLister:              kdInformer.Lister(),
 		kdSynced:              kdInformer.Informer().HasSynced,
 		appImageLister:        appImageInformer.Lister(),
@@ -116,6 +129,7 @@ func NewController(ctx context.Context, config controllers.ControllerConfig) con
 		domainLister:          domainInformer.Lister(),
 		domainSynced:          domainInformer.Informer().HasSynced,
 		kdQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "kusciaDeployment"),
+		recommendations:       newRecommendationHistory(),
 	}
 
 	controller.ctx, controller.cancel = context.WithCancel(ctx)
@@ -487,7 +501,7 @@ func (c *Controller) Run(workers int) error {
 
 	// Wait for the caches to be synced before starting workers
 	nlog.Infof("Waiting for informer cache to sync for %v", c.Name())
-	if !cache.WaitForCacheSync(c.ctx.Done(), c.deploymentSynced, c.namespaceSynced, c.serviceSynced, c.configMapSynced, c.kdSynced, c.appImageSynced, c.domainSynced) {
+	if !cache.WaitForCacheSync(c.ctx.Done(), c.deploymentSynced, c.namespaceSynced, c.serviceSynced, c.configMapSynced, c.podSynced, c.nodeSynced, c.kdSynced, c.appImageSynced, c.domainSynced) {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
 
@@ -495,6 +509,7 @@ func (c *Controller) Run(workers int) error {
 	for i := 0; i < workers; i++ {
 		go c.runWorker(c.ctx)
 	}
+	go wait.Until(c.enqueueAutoscaledDeployments, autoscaleInterval, c.ctx.Done())
 
 	<-c.ctx.Done()
 	nlog.Infof("Shutting down %v workers", c.Name())
@@ -531,6 +546,7 @@ func (c *Controller) syncHandler(ctx context.Context, key string) error {
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			nlog.Infof("KusciaDeployment %s/%s maybe deleted, delete resources", ns, key)
+			c.recommendations.forget(key)
 			if err = c.cleanKusciaDeploymentEvent(ctx, ns, name); err != nil {
 				nlog.Errorf("Clean kd %s/%s resources failed: %s", ns, name, err)
 				return err
@@ -580,6 +596,18 @@ func (c *Controller) validate(kd *kusciav1alpha1.KusciaDeployment) error {
 	if !found {
 		return fmt.Errorf("kusciaDeployment %s initiator %s should be one of the parties", kd.Name, kd.Spec.Initiator)
 	}
+
+	if kd.Spec.Autoscaling != nil {
+		if err := validateAutoscaling(kd.Spec.Autoscaling); err != nil {
+			return fmt.Errorf("kusciaDeployment %s %v", kd.Name, err)
+		}
+	}
+
+	if kd.Spec.Traffic != nil {
+		if err := validateTraffic(kd); err != nil {
+			return fmt.Errorf("kusciaDeployment %s %v", kd.Name, err)
+		}
+	}
 	return nil
 }
 
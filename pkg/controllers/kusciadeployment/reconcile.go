@@ -18,6 +18,7 @@ package kusciadeployment
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -63,11 +64,16 @@ func (c *Controller) ProcessKusciaDeployment(ctx context.Context, kd *kusciav1al
 		return nil
 	}
 
+	autoscalingUpdated, err := c.refreshAutoscaling(kd, partyKitInfos)
+	if err != nil {
+		return fmt.Errorf("failed to refresh autoscaling of kuscia deployment %v, %v", kd.Name, err)
+	}
+
 	if err = c.syncResources(ctx, partyKitInfos); err != nil {
 		return c.handleError(ctx, partyKitInfos, preKdStatus, kd, err)
 	}
 
-	if c.refreshPartyDeploymentStatuses(kd, partyKitInfos) {
+	if c.refreshPartyDeploymentStatuses(kd, partyKitInfos) || autoscalingUpdated {
 		return c.updateKusciaDeploymentStatus(ctx, kd)
 	}
 
@@ -172,7 +178,13 @@ func (c *Controller) refreshPartyDeploymentStatuses(kd *kusciav1alpha1.KusciaDep
 			continue
 		}
 
-		changed := refreshPartyDeploymentStatus(kd.Status.PartyDeploymentStatuses, deployment, partyKitInfo.role)
+		replicaSpread, err := c.buildReplicaSpread(deployment)
+		if err != nil {
+			nlog.Warnf("Failed to build replica spread of party deployment %v/%v for kuscia deployment %v, %v",
+				deployment.Namespace, deployment.Name, kd.Name, err)
+		}
+
+		changed := refreshPartyDeploymentStatus(kd.Status.PartyDeploymentStatuses, deployment, partyKitInfo.role, replicaSpread)
 		if changed {
 			updated = true
 		}
@@ -223,7 +235,8 @@ func (c *Controller) refreshPartyDeploymentStatuses(kd *kusciav1alpha1.KusciaDep
 	return updated
 }
 
-func refreshPartyDeploymentStatus(partyDeploymentStatuses map[string]map[string]*kusciav1alpha1.KusciaDeploymentPartyStatus, deployment *appsv1.Deployment, role string) bool {
+func refreshPartyDeploymentStatus(partyDeploymentStatuses map[string]map[string]*kusciav1alpha1.KusciaDeploymentPartyStatus, deployment *appsv1.Deployment, role string,
+	replicaSpread *kusciav1alpha1.KusciaDeploymentReplicaSpread) bool {
 	curDepStatus := &kusciav1alpha1.KusciaDeploymentPartyStatus{
 		Phase:               kusciav1alpha1.KusciaDeploymentPhaseProgressing,
 		Role:                role,
@@ -233,6 +246,7 @@ func refreshPartyDeploymentStatus(partyDeploymentStatuses map[string]map[string]
 		UnavailableReplicas: deployment.Status.UnavailableReplicas,
 		Conditions:          deployment.Status.Conditions,
 		CreationTimestamp:   &deployment.CreationTimestamp,
+		ReplicaSpread:       replicaSpread,
 	}
 
 	if curDepStatus.AvailableReplicas > 0 {
@@ -265,6 +279,49 @@ func refreshPartyDeploymentStatus(partyDeploymentStatuses map[string]map[string]
 	return false
 }
 
+// buildReplicaSpread counts the scheduled pods of the deployment in each zone and on each node.
+func (c *Controller) buildReplicaSpread(deployment *appsv1.Deployment) (*kusciav1alpha1.KusciaDeploymentReplicaSpread, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := c.podLister.Pods(deployment.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	spread := &kusciav1alpha1.KusciaDeploymentReplicaSpread{}
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil ||
+			pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if spread.Nodes == nil {
+			spread.Nodes = map[string]int32{}
+		}
+		spread.Nodes[pod.Spec.NodeName]++
+
+		node, err := c.nodeLister.Get(pod.Spec.NodeName)
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return nil, err
+			}
+			continue
+		}
+		if zone := node.Labels[corev1.LabelTopologyZone]; zone != "" {
+			if spread.Zones == nil {
+				spread.Zones = map[string]int32{}
+			}
+			spread.Zones[zone]++
+		}
+	}
+
+	if spread.Nodes == nil {
+		return nil, nil
+	}
+	return spread, nil
+}
+
 func (c *Controller) syncResources(ctx context.Context, partyKitInfos map[string]*PartyKitInfo) (err error) {
 	if err = c.syncService(ctx, partyKitInfos); err != nil {
 		return err
@@ -289,14 +346,20 @@ func (c *Controller) syncService(ctx context.Context, partyKitInfos map[string]*
 	}()
 
 	for _, partyKitInfo := range partyKitInfos {
+		stableServices, err := c.stableServices(partyKitInfo)
+		if err != nil {
+			return err
+		}
+
 		for portName, serviceName := range partyKitInfo.dkInfo.portService {
+			trafficAnnotations := buildTrafficAnnotations(partyKitInfo, stableServices[portName])
 			svc, err := c.serviceLister.Services(partyKitInfo.domainID).Get(serviceName)
 			if err != nil && k8serrors.IsNotFound(err) {
 				svc, err = c.kubeClient.CoreV1().Services(partyKitInfo.domainID).Get(ctx, serviceName, metav1.GetOptions{})
 			}
 			if err != nil {
 				if k8serrors.IsNotFound(err) {
-					if err = c.createService(ctx, partyKitInfo, portName, serviceName); err != nil {
+					if err = c.createService(ctx, partyKitInfo, portName, serviceName, trafficAnnotations); err != nil {
 						partyKitInfo.kd.Status.Phase = kusciav1alpha1.KusciaDeploymentPhaseFailed
 						partyKitInfo.kd.Status.Reason = string(createServiceFailed)
 						partyKitInfo.kd.Status.Message = err.Error()
@@ -314,12 +377,16 @@ func (c *Controller) syncService(ctx context.Context, partyKitInfos map[string]*
 				partyKitInfo.kd.Status.Message = err.Error()
 				return err
 			}
+
+			if err = c.updateServiceTraffic(ctx, svc, trafficAnnotations); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
-func (c *Controller) createService(ctx context.Context, partyKitInfo *PartyKitInfo, portName, serviceName string) error {
+func (c *Controller) createService(ctx context.Context, partyKitInfo *PartyKitInfo, portName, serviceName string, trafficAnnotations map[string]string) error {
 	ctrPort, ok := partyKitInfo.dkInfo.ports[portName]
 	if !ok {
 		return fmt.Errorf("container port %q is not found in deployment %q", portName, partyKitInfo.dkInfo.deploymentName)
@@ -329,6 +396,9 @@ func (c *Controller) createService(ctx context.Context, partyKitInfo *PartyKitIn
 	if err != nil {
 		return fmt.Errorf("failed to generate service %v/%v for deployment %v, %v", service.Namespace, service.Name, partyKitInfo.dkInfo.deploymentName, err)
 	}
+	for key, value := range trafficAnnotations {
+		service.Annotations[key] = value
+	}
 
 	if _, err = c.kubeClient.CoreV1().Services(service.Namespace).Create(ctx, service, metav1.CreateOptions{}); err != nil {
 		if k8serrors.IsAlreadyExists(err) {
@@ -471,7 +541,16 @@ func (c *Controller) syncDeployment(ctx context.Context, partyKitInfos map[strin
 		}
 	}()
 
-	for _, partyKitInfo := range partyKitInfos {
+	// the parties are rolled out one by one in a stable order
+	keys := make([]string, 0, len(partyKitInfos))
+	for key := range partyKitInfos {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rolloutStarted := false
+	for _, key := range keys {
+		partyKitInfo := partyKitInfos[key]
 		deployment, err := c.deploymentLister.Deployments(partyKitInfo.domainID).Get(partyKitInfo.dkInfo.deploymentName)
 		if err != nil && k8serrors.IsNotFound(err) {
 			deployment, err = c.kubeClient.AppsV1().Deployments(partyKitInfo.domainID).Get(ctx, partyKitInfo.dkInfo.deploymentName, metav1.GetOptions{})
@@ -497,9 +576,12 @@ func (c *Controller) syncDeployment(ctx context.Context, partyKitInfos map[strin
 			return err
 		}
 
-		if err = c.updateDeployment(ctx, partyKitInfo); err != nil {
+		started, err := c.updateDeployment(ctx, partyKitInfo, rolloutStarted)
+		if err != nil {
 			return err
 		}
+		rolloutStarted = rolloutStarted || started
+
 	}
 	return nil
 }
@@ -551,24 +633,13 @@ func (c *Controller) generateDeployment(partyKitInfo *PartyKitInfo) (*appsv1.Dep
 		}
 	}
 
-	maxSurge := intstr.FromString("25%")
-	maxUnavailable := intstr.FromString("25%")
-	updateStrategy := &appsv1.DeploymentStrategy{
-		Type: appsv1.RollingUpdateDeploymentStrategyType,
-		RollingUpdate: &appsv1.RollingUpdateDeployment{
-			MaxSurge:       &maxSurge,
-			MaxUnavailable: &maxUnavailable,
-		},
-	}
-	if partyKitInfo.deployTemplate.Strategy != nil {
-		updateStrategy = partyKitInfo.deployTemplate.Strategy
-	}
-
 	var affinity *corev1.Affinity
 	if partyKitInfo.deployTemplate.Spec.Affinity != nil {
 		affinity = partyKitInfo.deployTemplate.Spec.Affinity.DeepCopy()
 		buildAffinity(affinity, partyKitInfo.dkInfo.deploymentName)
 	}
+	topologySpreadConstraints := buildTopologySpreadConstraints(partyKitInfo.deployTemplate.Spec.TopologySpreadConstraints,
+		partyKitInfo.deployTemplate.Replicas, partyKitInfo.dkInfo.deploymentName)
 
 	automountServiceAccountToken := false
 	deployment := &appsv1.Deployment{
@@ -583,13 +654,15 @@ func (c *Controller) generateDeployment(partyKitInfo *PartyKitInfo) (*appsv1.Dep
 			Selector: &metav1.LabelSelector{
 				MatchLabels: selectorLabels,
 			},
-			Strategy: *updateStrategy,
+			Strategy:        buildDeploymentStrategy(partyKitInfo),
+			MinReadySeconds: minReadySeconds(partyKitInfo.kd),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: selectorLabels,
 				},
 				Spec: corev1.PodSpec{
-					Affinity: affinity,
+					Affinity:                  affinity,
+					TopologySpreadConstraints: topologySpreadConstraints,
 					Tolerations: []corev1.Toleration{
 						{
 							Key:      common.KusciaTaintTolerationKey,
@@ -612,54 +685,31 @@ func (c *Controller) generateDeployment(partyKitInfo *PartyKitInfo) (*appsv1.Dep
 	if partyKitInfo.dkInfo.imageID != "" {
 		deployment.Spec.Template.Annotations[common.ImageIDAnnotationKey] = partyKitInfo.dkInfo.imageID
 	}
+	if partyKitInfo.dkInfo.registryCredentialKey != "" {
+		deployment.Spec.Template.Annotations[common.ImageRegistryCredentialKeyAnnotationKey] = partyKitInfo.dkInfo.registryCredentialKey
+	}
+	if err = fillAutoscalingAnnotations(deployment.Spec.Template.Annotations, partyKitInfo); err != nil {
+		return nil, fmt.Errorf("failed to generate deployment %v, %v", partyKitInfo.dkInfo.deploymentName, err)
+	}
+
+	protoJSONOptions := protojson.MarshalOptions{EmitUnpopulated: true}
+	clusterDefine, err := protoJSONOptions.Marshal(partyKitInfo.dkInfo.clusterDef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate deployment %v, %v", partyKitInfo.dkInfo.deploymentName, err)
+	}
+	allocatedPorts, err := protoJSONOptions.Marshal(partyKitInfo.dkInfo.allocatedPorts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate deployment %v, %v", partyKitInfo.dkInfo.deploymentName, err)
+	}
+	portNumberEnvs := buildPortNumberEnvs(partyKitInfo.dkInfo.allocatedPorts)
 
 	renderConfigTemplateVolume := false
 	for _, ctr := range partyKitInfo.deployTemplate.Spec.Containers {
-		if ctr.ImagePullPolicy == "" {
-			ctr.ImagePullPolicy = corev1.PullIfNotPresent
-		}
-
-		resCtr := corev1.Container{
-			Name:                     ctr.Name,
-			Image:                    partyKitInfo.dkInfo.image,
-			Command:                  ctr.Command,
-			Args:                     ctr.Args,
-			WorkingDir:               ctr.WorkingDir,
-			Env:                      ctr.Env,
-			EnvFrom:                  ctr.EnvFrom,
-			Resources:                ctr.Resources,
-			LivenessProbe:            ctr.LivenessProbe,
-			ReadinessProbe:           ctr.ReadinessProbe,
-			StartupProbe:             ctr.StartupProbe,
-			ImagePullPolicy:          ctr.ImagePullPolicy,
-			SecurityContext:          ctr.SecurityContext,
-			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
-		}
-
-		for _, port := range ctr.Ports {
-			namedPort, ok := partyKitInfo.dkInfo.ports[port.Name]
-			if !ok {
-				return nil, fmt.Errorf("port %s is not allocated for deployment %s", port.Name, partyKitInfo.dkInfo.deploymentName)
-			}
-			resPort := corev1.ContainerPort{
-				Name:          port.Name,
-				ContainerPort: namedPort.Port,
-				Protocol:      corev1.ProtocolTCP,
-			}
-
-			resCtr.Ports = append(resCtr.Ports, resPort)
-		}
-
-		protoJSONOptions := protojson.MarshalOptions{EmitUnpopulated: true}
-		clusterDefine, err := protoJSONOptions.Marshal(partyKitInfo.dkInfo.clusterDef)
+		resCtr, renderConfig, err := generateContainer(partyKitInfo, &ctr)
 		if err != nil {
-			return nil, fmt.Errorf("failed to generate deployment %v, %v", partyKitInfo.dkInfo.deploymentName, err)
-		}
-
-		allocatedPorts, err := protoJSONOptions.Marshal(partyKitInfo.dkInfo.allocatedPorts)
-		if err != nil {
-			return nil, fmt.Errorf("failed to generate deployment %v, %v", partyKitInfo.dkInfo.deploymentName, err)
+			return nil, err
 		}
+		renderConfigTemplateVolume = renderConfigTemplateVolume || renderConfig
 
 		resCtr.Env = append(resCtr.Env, []corev1.EnvVar{
 			{
@@ -680,7 +730,6 @@ func (c *Controller) generateDeployment(partyKitInfo *PartyKitInfo) (*appsv1.Dep
 			},
 		}...)
 
-		portNumberEnvs := buildPortNumberEnvs(partyKitInfo.dkInfo.allocatedPorts)
 		if len(portNumberEnvs) > 0 {
 			resCtr.Env = append(resCtr.Env, portNumberEnvs...)
 		}
@@ -692,18 +741,45 @@ func (c *Controller) generateDeployment(partyKitInfo *PartyKitInfo) (*appsv1.Dep
 			})
 		}
 
-		if len(ctr.ConfigVolumeMounts) > 0 && partyKitInfo.configTemplatesCMName != "" {
-			renderConfigTemplateVolume = true
-			for _, vm := range ctr.ConfigVolumeMounts {
-				resCtr.VolumeMounts = append(resCtr.VolumeMounts, corev1.VolumeMount{
-					Name:      configTemplateVolumeName,
-					MountPath: vm.MountPath,
-					SubPath:   vm.SubPath,
-				})
-			}
+		deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, resCtr)
+	}
+
+	for _, ctr := range partyKitInfo.deployTemplate.Spec.InitContainers {
+		resCtr, renderConfig, err := generateContainer(partyKitInfo, &ctr)
+		if err != nil {
+			return nil, err
 		}
+		renderConfigTemplateVolume = renderConfigTemplateVolume || renderConfig
+		resCtr.Env = append(resCtr.Env, portNumberEnvs...)
+		deployment.Spec.Template.Spec.InitContainers = append(deployment.Spec.Template.Spec.InitContainers, resCtr)
+	}
 
+	// the sidecars are appended to the containers as the pods of kuscia task, and the runk provider projects them
+	// into the sidecar containers of the backend pod by the annotation.
+	var sidecars []string
+	for _, ctr := range partyKitInfo.deployTemplate.Spec.Sidecars {
+		resCtr, renderConfig, err := generateContainer(partyKitInfo, &ctr)
+		if err != nil {
+			return nil, err
+		}
+		renderConfigTemplateVolume = renderConfigTemplateVolume || renderConfig
+		resCtr.Env = append(resCtr.Env, portNumberEnvs...)
 		deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, resCtr)
+		sidecars = append(sidecars, resCtr.Name)
+	}
+	if len(sidecars) > 0 {
+		deployment.Spec.Template.Annotations[common.SidecarContainersAnnotationKey] = strings.Join(sidecars, ",")
+	}
+
+	for _, volume := range partyKitInfo.deployTemplate.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil || volume.HostDirectory != nil {
+			return nil, fmt.Errorf("failed to generate deployment %v, volume %s: only emptyDir volumes are supported by kuscia deployment",
+				partyKitInfo.dkInfo.deploymentName, volume.Name)
+		}
+		deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name:         volume.Name,
+			VolumeSource: corev1.VolumeSource{EmptyDir: volume.EmptyDir},
+		})
 	}
 
 	if renderConfigTemplateVolume {
@@ -722,6 +798,81 @@ func (c *Controller) generateDeployment(partyKitInfo *PartyKitInfo) (*appsv1.Dep
 	return deployment, nil
 }
 
+// generateContainer converts the container of the deploy template, and reports whether the container mounts the
+// config template volume.
+func generateContainer(partyKitInfo *PartyKitInfo, ctr *kusciav1alpha1.Container) (corev1.Container, bool, error) {
+	imagePullPolicy := ctr.ImagePullPolicy
+	if imagePullPolicy == "" {
+		imagePullPolicy = corev1.PullIfNotPresent
+	}
+
+	resCtr := corev1.Container{
+		Name:                     ctr.Name,
+		Image:                    containerImage(partyKitInfo, ctr),
+		Command:                  ctr.Command,
+		Args:                     ctr.Args,
+		WorkingDir:               ctr.WorkingDir,
+		Env:                      ctr.Env,
+		EnvFrom:                  ctr.EnvFrom,
+		Resources:                ctr.Resources,
+		LivenessProbe:            ctr.LivenessProbe,
+		ReadinessProbe:           ctr.ReadinessProbe,
+		StartupProbe:             ctr.StartupProbe,
+		ImagePullPolicy:          imagePullPolicy,
+		SecurityContext:          ctr.SecurityContext,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+
+	for _, port := range ctr.Ports {
+		namedPort, ok := partyKitInfo.dkInfo.ports[port.Name]
+		if !ok {
+			return resCtr, false, fmt.Errorf("port %s is not allocated for deployment %s", port.Name, partyKitInfo.dkInfo.deploymentName)
+		}
+		resPort := corev1.ContainerPort{
+			Name:          port.Name,
+			ContainerPort: namedPort.Port,
+			Protocol:      corev1.ProtocolTCP,
+		}
+
+		resCtr.Ports = append(resCtr.Ports, resPort)
+	}
+
+	// copy the volume mounts, the deploy template is shared by the parties
+	resCtr.VolumeMounts = append(resCtr.VolumeMounts, ctr.VolumeMounts...)
+	renderConfigTemplateVolume := false
+	if len(ctr.ConfigVolumeMounts) > 0 && partyKitInfo.configTemplatesCMName != "" {
+		renderConfigTemplateVolume = true
+		for _, vm := range ctr.ConfigVolumeMounts {
+			resCtr.VolumeMounts = append(resCtr.VolumeMounts, corev1.VolumeMount{
+				Name:      configTemplateVolumeName,
+				MountPath: vm.MountPath,
+				SubPath:   vm.SubPath,
+			})
+		}
+	}
+	return resCtr, renderConfigTemplateVolume, nil
+}
+
+// containerImage returns the image of the container, default is the image of the app image.
+func containerImage(partyKitInfo *PartyKitInfo, ctr *kusciav1alpha1.Container) string {
+	if ctr.Image != "" {
+		return ctr.Image
+	}
+	return partyKitInfo.dkInfo.image
+}
+
+// templateContainerImages returns the images of all the containers of the deploy template by name.
+func templateContainerImages(partyKitInfo *PartyKitInfo) map[string]string {
+	images := map[string]string{}
+	spec := &partyKitInfo.deployTemplate.Spec
+	for _, ctrs := range [][]kusciav1alpha1.Container{spec.Containers, spec.InitContainers, spec.Sidecars} {
+		for i := range ctrs {
+			images[ctrs[i].Name] = containerImage(partyKitInfo, &ctrs[i])
+		}
+	}
+	return images
+}
+
 func buildPortNumberEnvs(allocatedPorts *appconfig.AllocatedPorts) []corev1.EnvVar {
 	if allocatedPorts == nil {
 		return nil
@@ -741,28 +892,67 @@ func buildPortNumberEnvs(allocatedPorts *appconfig.AllocatedPorts) []corev1.EnvV
 	return portNumberEnvs
 }
 
-func (c *Controller) updateDeployment(ctx context.Context, partyKitInfo *PartyKitInfo) error {
+// updateDeployment updates the party deployment and returns whether a rollout of its pods is started. If the rolling
+// update of the kuscia deployment is set, the pod template is not updated while another party is rolling out,
+// including the one started by the same sync indicated by rolloutStarted.
+func (c *Controller) updateDeployment(ctx context.Context, partyKitInfo *PartyKitInfo, rolloutStarted bool) (bool, error) {
 	deployment, err := c.deploymentLister.Deployments(partyKitInfo.domainID).Get(partyKitInfo.dkInfo.deploymentName)
 	if err != nil {
-		return fmt.Errorf("failed to check if need update deployment %v, %v", partyKitInfo.dkInfo.deploymentName, err)
+		return false, fmt.Errorf("failed to check if need update deployment %v, %v", partyKitInfo.dkInfo.deploymentName, err)
 	}
 
 	deploymentCopy := deployment.DeepCopy()
 	needUpdate := false
 	for _, kdParty := range partyKitInfo.kd.Spec.Parties {
 		if kdParty.DomainID == partyKitInfo.domainID && kdParty.Role == partyKitInfo.role {
-			// check replicas
-			if kdParty.Template.Replicas != nil && deploymentCopy.Spec.Replicas != nil && *kdParty.Template.Replicas != *deploymentCopy.Spec.Replicas {
-				nlog.Debugf("Deployment %v/%v replicas changed from %v to %v", deploymentCopy.Namespace, deploymentCopy.Name, *deploymentCopy.Spec.Replicas, *kdParty.Template.Replicas)
+			// check replicas, the replicas of autoscaled deployment are decided by the autoscaler
+			replicas := kdParty.Template.Replicas
+			if partyKitInfo.kd.Spec.Autoscaling != nil {
+				replicas = partyKitInfo.deployTemplate.Replicas
+			}
+			if replicas != nil && deploymentCopy.Spec.Replicas != nil && *replicas != *deploymentCopy.Spec.Replicas {
+				nlog.Debugf("Deployment %v/%v replicas changed from %v to %v", deploymentCopy.Namespace, deploymentCopy.Name, *deploymentCopy.Spec.Replicas, *replicas)
 				needUpdate = true
-				deploymentCopy.Spec.Replicas = kdParty.Template.Replicas
+				deploymentCopy.Spec.Replicas = replicas
+			}
+
+			// check autoscaling metrics of the pods
+			annotations := map[string]string{}
+			if err = fillAutoscalingAnnotations(annotations, partyKitInfo); err != nil {
+				return false, err
+			}
+			for _, key := range []string{common.AutoscalingMetricsAnnotationKey, common.MetricPathAnnotationKey, common.MetricPortAnnotationKey} {
+				if annotations[key] != deploymentCopy.Spec.Template.Annotations[key] {
+					nlog.Debugf("Deployment %v/%v pod annotation %v changed to %v", deploymentCopy.Namespace, deploymentCopy.Name, key, annotations[key])
+					needUpdate = true
+					if deploymentCopy.Spec.Template.Annotations == nil {
+						deploymentCopy.Spec.Template.Annotations = map[string]string{}
+					}
+					if annotations[key] == "" {
+						delete(deploymentCopy.Spec.Template.Annotations, key)
+					} else {
+						deploymentCopy.Spec.Template.Annotations[key] = annotations[key]
+					}
+				}
 			}
 
 			// check strategy
-			if kdParty.Template.Strategy != nil && !reflect.DeepEqual(*kdParty.Template.Strategy, deploymentCopy.Spec.Strategy) {
-				nlog.Debugf("Deployment %v/%v strategy changed from %v to %v", deploymentCopy.Namespace, deploymentCopy.Name, deploymentCopy.Spec.Strategy, *kdParty.Template.Strategy)
+			// currently, only check when kd template strategy or kd rolling update is not nil
+			// allows manual modification of deployment strategy
+			if kdParty.Template.Strategy != nil || partyKitInfo.kd.Spec.RollingUpdate != nil {
+				strategy := buildDeploymentStrategy(partyKitInfo)
+				if !reflect.DeepEqual(strategy, deploymentCopy.Spec.Strategy) {
+					nlog.Debugf("Deployment %v/%v strategy changed from %v to %v", deploymentCopy.Namespace, deploymentCopy.Name, deploymentCopy.Spec.Strategy, strategy)
+					needUpdate = true
+					deploymentCopy.Spec.Strategy = strategy
+				}
+			}
+
+			// check min ready seconds
+			if minReady := minReadySeconds(partyKitInfo.kd); minReady != deploymentCopy.Spec.MinReadySeconds {
+				nlog.Debugf("Deployment %v/%v min ready seconds changed from %v to %v", deploymentCopy.Namespace, deploymentCopy.Name, deploymentCopy.Spec.MinReadySeconds, minReady)
 				needUpdate = true
-				deploymentCopy.Spec.Strategy = *kdParty.Template.Strategy
+				deploymentCopy.Spec.MinReadySeconds = minReady
 			}
 
 			// check affinity
@@ -778,12 +968,29 @@ func (c *Controller) updateDeployment(ctx context.Context, partyKitInfo *PartyKi
 				}
 			}
 
-			// check container image
-			for i, ctr := range deploymentCopy.Spec.Template.Spec.Containers {
-				if ctr.Image != partyKitInfo.dkInfo.image {
-					nlog.Debugf("Deployment %v/%v pod container %v image changed from %v to %v", deploymentCopy.Namespace, deploymentCopy.Name, ctr.Name, ctr.Image, partyKitInfo.dkInfo.image)
-					needUpdate = true
-					deploymentCopy.Spec.Template.Spec.Containers[i].Image = partyKitInfo.dkInfo.image
+			// check topology spread constraints, the default ones depend on the replicas
+			topologySpreadConstraints := buildTopologySpreadConstraints(partyKitInfo.deployTemplate.Spec.TopologySpreadConstraints,
+				deploymentCopy.Spec.Replicas, deploymentCopy.Name)
+			if !reflect.DeepEqual(topologySpreadConstraints, deploymentCopy.Spec.Template.Spec.TopologySpreadConstraints) {
+				nlog.Debugf("Deployment %v/%v topology spread constraints changed", deploymentCopy.Namespace, deploymentCopy.Name)
+				needUpdate = true
+				deploymentCopy.Spec.Template.Spec.TopologySpreadConstraints = topologySpreadConstraints
+			}
+
+			// check container image, including the init containers and the sidecars
+			images := templateContainerImages(partyKitInfo)
+			podSpec := &deploymentCopy.Spec.Template.Spec
+			for _, ctrs := range [][]corev1.Container{podSpec.Containers, podSpec.InitContainers} {
+				for i, ctr := range ctrs {
+					image, ok := images[ctr.Name]
+					if !ok {
+						image = partyKitInfo.dkInfo.image
+					}
+					if ctr.Image != image {
+						nlog.Debugf("Deployment %v/%v pod container %v image changed from %v to %v", deploymentCopy.Namespace, deploymentCopy.Name, ctr.Name, ctr.Image, image)
+						needUpdate = true
+						ctrs[i].Image = image
+					}
 				}
 			}
 
@@ -827,14 +1034,30 @@ func (c *Controller) updateDeployment(ctx context.Context, partyKitInfo *PartyKi
 		}
 	}
 
+	// the changes of pod template trigger a rollout
+	rollout := !reflect.DeepEqual(deployment.Spec.Template, deploymentCopy.Spec.Template)
+	if rollout && partyKitInfo.kd.Spec.RollingUpdate != nil {
+		blocker, blocked := "the previous party", rolloutStarted
+		if !blocked {
+			blocker, blocked = c.partyRollingOut(partyKitInfo)
+		}
+		if blocked {
+			nlog.Infof("Deployment %v/%v waits for the rollout of %v", deploymentCopy.Namespace, deploymentCopy.Name, blocker)
+			deploymentCopy.Spec.Template = *deployment.Spec.Template.DeepCopy()
+			rollout = false
+			needUpdate = !reflect.DeepEqual(deployment.Spec, deploymentCopy.Spec)
+		}
+	}
+
 	if needUpdate {
 		_, err = c.kubeClient.AppsV1().Deployments(deploymentCopy.Namespace).Update(ctx, deploymentCopy, metav1.UpdateOptions{})
 		if err != nil && !k8serrors.IsConflict(err) {
-			return fmt.Errorf("failed to update deployment %v/%v, %v", deploymentCopy.Namespace, deployment.Name, err)
+			return false, fmt.Errorf("failed to update deployment %v/%v, %v", deploymentCopy.Namespace, deployment.Name, err)
 		}
+		return rollout && err == nil, nil
 	}
 
-	return nil
+	return false, nil
 }
 
 func buildAffinity(affinity *corev1.Affinity, deploymentName string) {
@@ -861,6 +1084,39 @@ func buildAffinity(affinity *corev1.Affinity, deploymentName string) {
 	}
 }
 
+// buildTopologySpreadConstraints returns the topology spread constraints of the deployment pods. The constraints
+// specified by users only count the pods of the deployment. Otherwise, the replicas are spread across zones and nodes
+// on a best-effort basis, so that a zone or node outage doesn't take down all the replicas.
+func buildTopologySpreadConstraints(constraints []corev1.TopologySpreadConstraint, replicas *int32, deploymentName string) []corev1.TopologySpreadConstraint {
+	labelSelector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{common.LabelKubernetesDeploymentName: deploymentName},
+	}
+
+	if len(constraints) > 0 {
+		result := make([]corev1.TopologySpreadConstraint, len(constraints))
+		for i := range constraints {
+			constraints[i].DeepCopyInto(&result[i])
+			result[i].LabelSelector = labelSelector
+		}
+		return result
+	}
+
+	if replicas == nil || *replicas <= 1 {
+		return nil
+	}
+
+	var result []corev1.TopologySpreadConstraint
+	for _, topologyKey := range []string{corev1.LabelTopologyZone, corev1.LabelHostname} {
+		result = append(result, corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       topologyKey,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     labelSelector,
+		})
+	}
+	return result
+}
+
 func (c *Controller) selfParties(kd *kusciav1alpha1.KusciaDeployment) ([]kusciav1alpha1.KusciaDeploymentParty, error) {
 	selfParties := make([]kusciav1alpha1.KusciaDeploymentParty, 0)
 	for _, p := range kd.Spec.Parties {
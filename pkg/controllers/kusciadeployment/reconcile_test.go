@@ -27,6 +27,7 @@ import (
 	"k8s.io/client-go/informers"
 	clientsetfake "k8s.io/client-go/kubernetes/fake"
 
+	"github.com/secretflow/kuscia/pkg/common"
 	kusciav1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 )
 
@@ -162,6 +163,8 @@ func TestRefreshPartyDeploymentStatuses(t *testing.T) {
 	c := &Controller{
 		kubeClient:       kubeFakeClient,
 		deploymentLister: depInformer.Lister(),
+		podLister:        informerFactory.Core().V1().Pods().Lister(),
+		nodeLister:       informerFactory.Core().V1().Nodes().Lister(),
 	}
 
 	// alice: Replicas[2],AvailableReplicas[0]
@@ -328,12 +331,71 @@ func TestRefreshPartyDeploymentStatus(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := refreshPartyDeploymentStatus(tt.partyDeploymentStatuses, tt.deployment, tt.role)
+			got := refreshPartyDeploymentStatus(tt.partyDeploymentStatuses, tt.deployment, tt.role, nil)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
+func TestBuildReplicaSpread(t *testing.T) {
+	selectorLabels := map[string]string{"kuscia.secretflow/deployment-name": "kd-alice-1"}
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "kd-alice-1", Namespace: "alice"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: selectorLabels},
+		},
+	}
+	makePod := func(name, nodeName string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "alice", Labels: selectorLabels},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+	makeNode := func(name, zone string) *corev1.Node {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if zone != "" {
+			node.Labels = map[string]string{corev1.LabelTopologyZone: zone}
+		}
+		return node
+	}
+
+	kubeFakeClient := clientsetfake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(kubeFakeClient, 0)
+	podInformer := informerFactory.Core().V1().Pods()
+	nodeInformer := informerFactory.Core().V1().Nodes()
+	c := &Controller{
+		podLister:  podInformer.Lister(),
+		nodeLister: nodeInformer.Lister(),
+	}
+
+	spread, err := c.buildReplicaSpread(dep)
+	assert.NoError(t, err)
+	assert.Nil(t, spread)
+
+	for _, pod := range []*corev1.Pod{
+		makePod("pod-1", "node-a1", corev1.PodRunning),
+		makePod("pod-2", "node-a2", corev1.PodRunning),
+		makePod("pod-3", "node-b1", corev1.PodRunning),
+		makePod("pod-4", "node-c1", corev1.PodRunning),
+		makePod("pod-5", "", corev1.PodPending),
+		makePod("pod-6", "node-a1", corev1.PodFailed),
+	} {
+		assert.NoError(t, podInformer.Informer().GetStore().Add(pod))
+	}
+	for _, node := range []*corev1.Node{makeNode("node-a1", "az-a"), makeNode("node-a2", "az-a"),
+		makeNode("node-b1", "az-b"), makeNode("node-c1", "")} {
+		assert.NoError(t, nodeInformer.Informer().GetStore().Add(node))
+	}
+
+	spread, err = c.buildReplicaSpread(dep)
+	assert.NoError(t, err)
+	assert.Equal(t, &kusciav1alpha1.KusciaDeploymentReplicaSpread{
+		Zones: map[string]int32{"az-a": 2, "az-b": 1},
+		Nodes: map[string]int32{"node-a1": 1, "node-a2": 1, "node-b1": 1, "node-c1": 1},
+	}, spread)
+}
+
 func TestSyncService(t *testing.T) {
 	kd := makeTestKusciaDeployment("kd", 1, 1, 1)
 	partyKitInfo := &PartyKitInfo{
@@ -411,7 +473,7 @@ func TestCreateService(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := c.createService(context.Background(), tt.kitInfo, tt.portName, tt.serviceName)
+			got := c.createService(context.Background(), tt.kitInfo, tt.portName, tt.serviceName, nil)
 			assert.Equal(t, tt.wantErr, got != nil)
 		})
 	}
@@ -579,6 +641,31 @@ func TestGenerateDeployment(t *testing.T) {
 	deployment, err := c.generateDeployment(partyKitInfo)
 	assert.NoError(t, err)
 	assert.NotNil(t, deployment)
+
+	// the init containers, sidecars and volumes of the template are rendered
+	partyKitInfo.deployTemplate.Spec.InitContainers = []kusciav1alpha1.Container{{Name: "prefetch", Image: "fetcher"}}
+	partyKitInfo.deployTemplate.Spec.Sidecars = []kusciav1alpha1.Container{{
+		Name:         "log",
+		Image:        "shipper",
+		VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/logs"}},
+	}}
+	partyKitInfo.deployTemplate.Spec.Volumes = []kusciav1alpha1.Volume{{Name: "logs", EmptyDir: &corev1.EmptyDirVolumeSource{}}}
+	deployment, err = c.generateDeployment(partyKitInfo)
+	assert.NoError(t, err)
+	podSpec := deployment.Spec.Template.Spec
+	assert.Len(t, podSpec.InitContainers, 1)
+	assert.Equal(t, "fetcher", podSpec.InitContainers[0].Image)
+	assert.Len(t, podSpec.Containers, 2)
+	assert.Equal(t, "sf-2", podSpec.Containers[0].Image)
+	assert.Equal(t, "shipper", podSpec.Containers[1].Image)
+	assert.Equal(t, "/logs", podSpec.Containers[1].VolumeMounts[0].MountPath)
+	assert.Equal(t, "log", deployment.Spec.Template.Annotations[common.SidecarContainersAnnotationKey])
+	assert.Len(t, podSpec.Volumes, 1)
+	assert.Equal(t, "logs", podSpec.Volumes[0].Name)
+
+	partyKitInfo.deployTemplate.Spec.Volumes[0].HostDirectory = &kusciav1alpha1.HostDirectoryVolumeSource{}
+	_, err = c.generateDeployment(partyKitInfo)
+	assert.Error(t, err)
 }
 
 func TestUpdateDeployment(t *testing.T) {
@@ -612,6 +699,51 @@ func TestUpdateDeployment(t *testing.T) {
 		deploymentLister: deployInformer.Lister(),
 	}
 
-	err := c.updateDeployment(context.Background(), partyKitInfo)
+	_, err := c.updateDeployment(context.Background(), partyKitInfo, false)
+	assert.NoError(t, err)
+	updated, err := kubeFakeClient.AppsV1().Deployments("alice").Get(context.Background(), "kd-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, updated.Spec.Template.Spec.TopologySpreadConstraints)
+
+	// the default topology spread constraints are rendered after scaling out
+	replicas := int32(3)
+	kd.Spec.Parties[0].Template.Replicas = &replicas
+	assert.NoError(t, deployInformer.Informer().GetStore().Update(updated))
+	_, err = c.updateDeployment(context.Background(), partyKitInfo, false)
+	assert.NoError(t, err)
+	updated, err = kubeFakeClient.AppsV1().Deployments("alice").Get(context.Background(), "kd-1", metav1.GetOptions{})
 	assert.NoError(t, err)
+	assert.Len(t, updated.Spec.Template.Spec.TopologySpreadConstraints, 2)
+}
+
+func TestBuildTopologySpreadConstraints(t *testing.T) {
+	one, three := int32(1), int32(3)
+	assert.Nil(t, buildTopologySpreadConstraints(nil, nil, "kd-1"))
+	assert.Nil(t, buildTopologySpreadConstraints(nil, &one, "kd-1"))
+
+	got := buildTopologySpreadConstraints(nil, &three, "kd-1")
+	assert.Equal(t, 2, len(got))
+	assert.Equal(t, corev1.LabelTopologyZone, got[0].TopologyKey)
+	assert.Equal(t, corev1.LabelHostname, got[1].TopologyKey)
+	for _, constraint := range got {
+		assert.Equal(t, int32(1), constraint.MaxSkew)
+		assert.Equal(t, corev1.ScheduleAnyway, constraint.WhenUnsatisfiable)
+		assert.Equal(t, "kd-1", constraint.LabelSelector.MatchLabels["kuscia.secretflow/deployment-name"])
+	}
+
+	// the constraints specified by users are kept, even if there is only one replica
+	constraints := []corev1.TopologySpreadConstraint{
+		{
+			MaxSkew:           2,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}},
+		},
+	}
+	got = buildTopologySpreadConstraints(constraints, &one, "kd-1")
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, int32(2), got[0].MaxSkew)
+	assert.Equal(t, corev1.DoNotSchedule, got[0].WhenUnsatisfiable)
+	assert.Equal(t, map[string]string{"kuscia.secretflow/deployment-name": "kd-1"}, got[0].LabelSelector.MatchLabels)
+	assert.Equal(t, map[string]string{"app": "other"}, constraints[0].LabelSelector.MatchLabels)
 }
@@ -0,0 +1,113 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciadeployment
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciav1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+const (
+	// reasons of the progressing condition, see k8s.io/kubernetes/pkg/controller/deployment/util
+	newRSAvailableReason     = "NewReplicaSetAvailable"
+	progressDeadlineExceeded = "ProgressDeadlineExceeded"
+)
+
+// buildDeploymentStrategy returns the strategy of the party deployment, the strategy in the party template takes
+// precedence over the rolling update of the kuscia deployment.
+func buildDeploymentStrategy(partyKitInfo *PartyKitInfo) appsv1.DeploymentStrategy {
+	if partyKitInfo.deployTemplate.Strategy != nil {
+		return *partyKitInfo.deployTemplate.Strategy
+	}
+
+	maxSurge := intstr.FromString("25%")
+	maxUnavailable := intstr.FromString("25%")
+	if rollingUpdate := partyKitInfo.kd.Spec.RollingUpdate; rollingUpdate != nil {
+		if rollingUpdate.MaxSurge != nil {
+			maxSurge = *rollingUpdate.MaxSurge
+		}
+		if rollingUpdate.MaxUnavailable != nil {
+			maxUnavailable = *rollingUpdate.MaxUnavailable
+		}
+	}
+	return appsv1.DeploymentStrategy{
+		Type: appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{
+			MaxSurge:       &maxSurge,
+			MaxUnavailable: &maxUnavailable,
+		},
+	}
+}
+
+func minReadySeconds(kd *kusciav1alpha1.KusciaDeployment) int32 {
+	if kd.Spec.RollingUpdate == nil {
+		return 0
+	}
+	return kd.Spec.RollingUpdate.MinReadySeconds
+}
+
+// partyRollingOut returns the deployment of other parties which is rolling out, the parties whose rollout exceeds
+// the progress deadline are ignored, otherwise a failed party would block the others forever.
+func (c *Controller) partyRollingOut(partyKitInfo *PartyKitInfo) (string, bool) {
+	for namespace, partyStatuses := range partyKitInfo.kd.Status.PartyDeploymentStatuses {
+		for name, partyStatus := range partyStatuses {
+			if namespace == partyKitInfo.domainID && name == partyKitInfo.dkInfo.deploymentName {
+				continue
+			}
+
+			// the status of self parties may be stale, prefer the live deployment
+			deployment, err := c.deploymentLister.Deployments(namespace).Get(name)
+			if err == nil && deployment.Labels[common.LabelKusciaDeploymentName] == partyKitInfo.kd.Name {
+				if deploymentRollingOut(deployment) {
+					return fmt.Sprintf("%s/%s", namespace, name), true
+				}
+				continue
+			}
+
+			if partyStatus != nil && rollingOut(partyStatus.UpdatedReplicas, partyStatus.Replicas, partyStatus.Conditions) {
+				return fmt.Sprintf("%s/%s", namespace, name), true
+			}
+		}
+	}
+	return "", false
+}
+
+func deploymentRollingOut(deployment *appsv1.Deployment) bool {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return true
+	}
+	return rollingOut(deployment.Status.UpdatedReplicas, deployment.Status.Replicas, deployment.Status.Conditions)
+}
+
+func rollingOut(updatedReplicas, replicas int32, conditions []appsv1.DeploymentCondition) bool {
+	for _, cond := range conditions {
+		if cond.Type != appsv1.DeploymentProgressing {
+			continue
+		}
+		if cond.Status == corev1.ConditionFalse || cond.Reason == progressDeadlineExceeded {
+			return false
+		}
+		if cond.Reason != newRSAvailableReason {
+			return true
+		}
+	}
+	return updatedReplicas < replicas
+}
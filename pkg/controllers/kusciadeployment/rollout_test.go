@@ -0,0 +1,154 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciadeployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciav1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func TestBuildDeploymentStrategy(t *testing.T) {
+	kd := makeTestKusciaDeployment("kd", 1, 1, 1)
+	partyKitInfo := &PartyKitInfo{kd: kd, deployTemplate: &kusciav1alpha1.KusciaDeploymentPartyTemplate{}}
+
+	strategy := buildDeploymentStrategy(partyKitInfo)
+	assert.Equal(t, "25%", strategy.RollingUpdate.MaxSurge.String())
+	assert.Equal(t, "25%", strategy.RollingUpdate.MaxUnavailable.String())
+
+	maxUnavailable := intstr.FromInt(0)
+	kd.Spec.RollingUpdate = &kusciav1alpha1.KusciaDeploymentRollingUpdate{MaxUnavailable: &maxUnavailable, MinReadySeconds: 10}
+	strategy = buildDeploymentStrategy(partyKitInfo)
+	assert.Equal(t, "25%", strategy.RollingUpdate.MaxSurge.String())
+	assert.Equal(t, "0", strategy.RollingUpdate.MaxUnavailable.String())
+	assert.Equal(t, int32(10), minReadySeconds(kd))
+
+	// the strategy in the party template takes precedence
+	partyKitInfo.deployTemplate.Strategy = kd.Spec.Parties[0].Template.Strategy
+	assert.Equal(t, *kd.Spec.Parties[0].Template.Strategy, buildDeploymentStrategy(partyKitInfo))
+}
+
+func TestRollingOut(t *testing.T) {
+	tests := []struct {
+		name            string
+		updatedReplicas int32
+		replicas        int32
+		conditions      []appsv1.DeploymentCondition
+		want            bool
+	}{
+		{
+			name:            "complete",
+			updatedReplicas: 2,
+			replicas:        2,
+			conditions:      []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: newRSAvailableReason}},
+		},
+		{
+			name:            "progressing",
+			updatedReplicas: 2,
+			replicas:        2,
+			conditions:      []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "ReplicaSetUpdated"}},
+			want:            true,
+		},
+		{
+			name:            "progress deadline exceeded",
+			updatedReplicas: 1,
+			replicas:        3,
+			conditions:      []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: progressDeadlineExceeded}},
+		},
+		{
+			name:            "old pods without conditions",
+			updatedReplicas: 1,
+			replicas:        3,
+			want:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rollingOut(tt.updatedReplicas, tt.replicas, tt.conditions))
+		})
+	}
+}
+
+func TestUpdateDeploymentWaitsForOtherParty(t *testing.T) {
+	kd := makeTestKusciaDeployment("kd", 2, 1, 1)
+	kd.Spec.RollingUpdate = &kusciav1alpha1.KusciaDeploymentRollingUpdate{MinReadySeconds: 5}
+	kd.Status.PartyDeploymentStatuses = map[string]map[string]*kusciav1alpha1.KusciaDeploymentPartyStatus{
+		"alice": {"kd-1": {}},
+		"bob": {"kd-1": {
+			Replicas:        3,
+			UpdatedReplicas: 1,
+			Conditions:      []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "ReplicaSetUpdated"}},
+		}},
+	}
+
+	d1 := makeTestDeployment("kd-1", "alice", "sf-1", 2, 1, 1)
+	d1.Labels = map[string]string{common.LabelKusciaDeploymentName: "kd"}
+	kubeFakeClient := clientsetfake.NewSimpleClientset(d1)
+	deployInformer := informers.NewSharedInformerFactory(kubeFakeClient, 0).Apps().V1().Deployments()
+	assert.NoError(t, deployInformer.Informer().GetStore().Add(d1))
+
+	partyKitInfo := &PartyKitInfo{
+		kd:       kd,
+		domainID: "alice",
+		deployTemplate: &kusciav1alpha1.KusciaDeploymentPartyTemplate{
+			Strategy: kd.Spec.Parties[0].Template.Strategy,
+		},
+		dkInfo: &DeploymentKitInfo{
+			deploymentName: "kd-1",
+			image:          "sf-2",
+		},
+	}
+	c := &Controller{
+		kubeClient:       kubeFakeClient,
+		deploymentLister: deployInformer.Lister(),
+	}
+
+	// bob is rolling out, only the min ready seconds of alice is updated
+	started, err := c.updateDeployment(context.Background(), partyKitInfo, false)
+	assert.NoError(t, err)
+	assert.False(t, started)
+	updated, err := kubeFakeClient.AppsV1().Deployments("alice").Get(context.Background(), "kd-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), updated.Spec.MinReadySeconds)
+	assert.Equal(t, "sf-1", updated.Spec.Template.Spec.Containers[0].Image)
+
+	// bob finishes the rollout
+	kd.Status.PartyDeploymentStatuses["bob"]["kd-1"] = &kusciav1alpha1.KusciaDeploymentPartyStatus{
+		Replicas:        2,
+		UpdatedReplicas: 2,
+		Conditions:      []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: newRSAvailableReason}},
+	}
+	assert.NoError(t, deployInformer.Informer().GetStore().Update(updated))
+	started, err = c.updateDeployment(context.Background(), partyKitInfo, true)
+	assert.NoError(t, err)
+	assert.False(t, started)
+
+	started, err = c.updateDeployment(context.Background(), partyKitInfo, false)
+	assert.NoError(t, err)
+	assert.True(t, started)
+	updated, err = kubeFakeClient.AppsV1().Deployments("alice").Get(context.Background(), "kd-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "sf-2", updated.Spec.Template.Spec.Containers[0].Image)
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciadeployment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciav1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+func validateTraffic(kd *kusciav1alpha1.KusciaDeployment) error {
+	traffic := kd.Spec.Traffic
+	if traffic.StableDeployment == "" {
+		return fmt.Errorf("traffic stable deployment can't be empty")
+	}
+	if traffic.StableDeployment == kd.Name {
+		return fmt.Errorf("traffic stable deployment can't be itself")
+	}
+	if traffic.Weight < 0 || traffic.Weight > 100 {
+		return fmt.Errorf("traffic weight %d should be between 0 and 100", traffic.Weight)
+	}
+	return nil
+}
+
+// stableServices returns the services of the stable kuscia deployment whose traffic is split to the party, keyed by
+// the port name. It returns nil if the stable kuscia deployment or the same party in it is not found.
+func (c *Controller) stableServices(partyKitInfo *PartyKitInfo) (PortService, error) {
+	traffic := partyKitInfo.kd.Spec.Traffic
+	if traffic == nil {
+		return nil, nil
+	}
+
+	stable, err := c.kdLister.KusciaDeployments(partyKitInfo.kd.Namespace).Get(traffic.StableDeployment)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			nlog.Warnf("Stable deployment %s of kuscia deployment %s is not found, skip splitting traffic", traffic.StableDeployment, partyKitInfo.kd.Name)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, party := range stable.Spec.Parties {
+		if party.DomainID != partyKitInfo.domainID || party.Role != partyKitInfo.role {
+			continue
+		}
+		deploymentName := generateDeploymentName(stable.Name, party.Role)
+		return generatePortServices(deploymentName, party.ServiceNamePrefix, partyKitInfo.servicedPorts), nil
+	}
+	return nil, nil
+}
+
+// buildTrafficAnnotations returns the annotations of the service which takes part of the traffic of the stable service.
+func buildTrafficAnnotations(partyKitInfo *PartyKitInfo, stableService string) map[string]string {
+	if stableService == "" {
+		return nil
+	}
+	return map[string]string{
+		common.TrafficSplitOfAnnotationKey: stableService,
+		common.TrafficWeightAnnotationKey:  strconv.Itoa(int(partyKitInfo.kd.Spec.Traffic.Weight)),
+	}
+}
+
+// updateServiceTraffic updates the traffic splitting annotations of the service, which are read by the gateway.
+func (c *Controller) updateServiceTraffic(ctx context.Context, svc *corev1.Service, annotations map[string]string) error {
+	needUpdate := false
+	for _, key := range []string{common.TrafficSplitOfAnnotationKey, common.TrafficWeightAnnotationKey} {
+		if svc.Annotations[key] != annotations[key] {
+			needUpdate = true
+		}
+	}
+	if !needUpdate {
+		return nil
+	}
+
+	svcCopy := svc.DeepCopy()
+	if svcCopy.Annotations == nil {
+		svcCopy.Annotations = map[string]string{}
+	}
+	for _, key := range []string{common.TrafficSplitOfAnnotationKey, common.TrafficWeightAnnotationKey} {
+		if annotations[key] == "" {
+			delete(svcCopy.Annotations, key)
+		} else {
+			svcCopy.Annotations[key] = annotations[key]
+		}
+	}
+	nlog.Infof("Service %v/%v traffic split changed to %v", svcCopy.Namespace, svcCopy.Name, annotations)
+	if _, err := c.kubeClient.CoreV1().Services(svcCopy.Namespace).Update(ctx, svcCopy, metav1.UpdateOptions{}); err != nil && !k8serrors.IsConflict(err) {
+		return fmt.Errorf("failed to update service %v/%v, %v", svcCopy.Namespace, svcCopy.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciadeployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciav1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientsetfake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+)
+
+func TestValidateTraffic(t *testing.T) {
+	kd := makeTestKusciaDeployment("serving-canary", 1, 1, 1)
+	kd.Spec.Traffic = &kusciav1alpha1.KusciaDeploymentTraffic{StableDeployment: "serving", Weight: 20}
+	assert.NoError(t, validateTraffic(kd))
+
+	kd.Spec.Traffic.Weight = 120
+	assert.Error(t, validateTraffic(kd))
+
+	kd.Spec.Traffic = &kusciav1alpha1.KusciaDeploymentTraffic{StableDeployment: "serving-canary"}
+	assert.Error(t, validateTraffic(kd))
+}
+
+func TestSyncServiceTraffic(t *testing.T) {
+	stable := makeTestKusciaDeployment("serving", 1, 1, 1)
+	stable.Namespace = common.KusciaCrossDomain
+	stable.Spec.Parties[0].ServiceNamePrefix = "alice-serving"
+	canary := makeTestKusciaDeployment("serving-canary", 1, 1, 1)
+	canary.Namespace = common.KusciaCrossDomain
+	canary.Spec.Traffic = &kusciav1alpha1.KusciaDeploymentTraffic{StableDeployment: "serving", Weight: 20}
+
+	kusciaInformerFactory := kusciainformers.NewSharedInformerFactory(kusciaclientsetfake.NewSimpleClientset(), 0)
+	kdInformer := kusciaInformerFactory.Kuscia().V1alpha1().KusciaDeployments()
+	assert.NoError(t, kdInformer.Informer().GetStore().Add(stable))
+
+	kubeClient := clientsetfake.NewSimpleClientset()
+	svcInformer := informers.NewSharedInformerFactory(kubeClient, 0).Core().V1().Services()
+	c := &Controller{
+		kubeClient:    kubeClient,
+		serviceLister: svcInformer.Lister(),
+		kdLister:      kdInformer.Lister(),
+	}
+	partyKitInfo := &PartyKitInfo{
+		kd:            canary,
+		domainID:      "alice",
+		servicedPorts: []string{"http"},
+		dkInfo: &DeploymentKitInfo{
+			deploymentName: "serving-canary",
+			ports:          NamedPorts{"http": kusciav1alpha1.ContainerPort{Name: "http", Port: 8080, Scope: kusciav1alpha1.ScopeDomain}},
+			portService:    PortService{"http": "serving-canary-http"},
+		},
+	}
+
+	assert.NoError(t, c.syncService(context.Background(), map[string]*PartyKitInfo{"alice/": partyKitInfo}))
+	svc, err := kubeClient.CoreV1().Services("alice").Get(context.Background(), "serving-canary-http", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice-serving-http", svc.Annotations[common.TrafficSplitOfAnnotationKey])
+	assert.Equal(t, "20", svc.Annotations[common.TrafficWeightAnnotationKey])
+
+	// the weight is changed
+	canary.Spec.Traffic.Weight = 100
+	assert.NoError(t, c.updateServiceTraffic(context.Background(), svc, buildTrafficAnnotations(partyKitInfo, "alice-serving-http")))
+	svc, err = kubeClient.CoreV1().Services("alice").Get(context.Background(), "serving-canary-http", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "100", svc.Annotations[common.TrafficWeightAnnotationKey])
+
+	// the annotations are removed once the traffic is not split
+	assert.NoError(t, c.updateServiceTraffic(context.Background(), svc, nil))
+	svc, err = kubeClient.CoreV1().Services("alice").Get(context.Background(), "serving-canary-http", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, svc.Annotations, common.TrafficSplitOfAnnotationKey)
+}
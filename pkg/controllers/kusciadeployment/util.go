@@ -22,12 +22,14 @@ import (
 	"reflect"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/secretflow/kuscia/pkg/common"
 	pkgport "github.com/secretflow/kuscia/pkg/controllers/portflake/port"
 	kusciav1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	utilsres "github.com/secretflow/kuscia/pkg/utils/resources"
 	proto "github.com/secretflow/kuscia/proto/api/v1alpha1/appconfig"
 )
@@ -65,13 +67,14 @@ type PortService map[string]string
 
 // DeploymentKitInfo defines kit for deployment.
 type DeploymentKitInfo struct {
-	deploymentName string
-	image          string
-	imageID        string
-	ports          NamedPorts
-	portService    PortService
-	clusterDef     *proto.ClusterDefine
-	allocatedPorts *proto.AllocatedPorts
+	deploymentName        string
+	image                 string
+	imageID               string
+	registryCredentialKey string
+	ports                 NamedPorts
+	portService           PortService
+	clusterDef            *proto.ClusterDefine
+	allocatedPorts        *proto.AllocatedPorts
 }
 
 func (c *Controller) buildPartyKitInfos(kd *kusciav1alpha1.KusciaDeployment) (map[string]*PartyKitInfo, bool, error) {
@@ -158,6 +161,11 @@ func (c *Controller) buildPartyKitInfo(kd *kusciav1alpha1.KusciaDeployment, part
 		ports:          ports,
 		portService:    portService,
 	}
+	domain, err := c.domainLister.Get(party.DomainID)
+	if err != nil {
+		nlog.Warnf("Failed to get domain %q, skip its image registry credential, %v", party.DomainID, err)
+	}
+	dkInfo.registryCredentialKey = utilsres.GetImageRegistryCredentialKey(appImage, domain)
 
 	kit := &PartyKitInfo{
 		kd:                    kd,
@@ -207,6 +215,13 @@ func mergeDeployTemplate(appImageTemplate *kusciav1alpha1.DeployTemplate, partyT
 	if partyTemplate.Spec.Affinity != nil {
 		template.Spec.Affinity = partyTemplate.Spec.Affinity.DeepCopy()
 	}
+
+	if len(partyTemplate.Spec.TopologySpreadConstraints) > 0 {
+		template.Spec.TopologySpreadConstraints = make([]corev1.TopologySpreadConstraint, len(partyTemplate.Spec.TopologySpreadConstraints))
+		for i := range partyTemplate.Spec.TopologySpreadConstraints {
+			partyTemplate.Spec.TopologySpreadConstraints[i].DeepCopyInto(&template.Spec.TopologySpreadConstraints[i])
+		}
+	}
 	return template
 }
 
@@ -0,0 +1,251 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	utilsres "github.com/secretflow/kuscia/pkg/utils/resources"
+)
+
+// The parties of the inter connection jobs in P2P mode run the tasks with their own app images, which may be of
+// different versions. Each party reports the versions of the app images referred by the tasks in the job status,
+// the versions are exchanged through the job summaries like the approval status. Once all the parties are created,
+// every party picks the same versions by negotiateAppImageVersions, and fails the job if there is none.
+
+// needAppImageNegotiation returns whether the job runs in P2P mode with kuscia protocol.
+func needAppImageNegotiation(job *kusciaapisv1alpha1.KusciaJob) bool {
+	if _, ok := job.Annotations[common.InterConnBFIAPartyAnnotationKey]; ok {
+		return false
+	}
+	return isInterConnJob(job)
+}
+
+// jobAppImages returns the app images referred by the tasks of the job in alphabetical order.
+func jobAppImages(job *kusciaapisv1alpha1.KusciaJob) []string {
+	var appImages []string
+	for _, t := range job.Spec.Tasks {
+		appImages = append(appImages, t.AppImage)
+	}
+	sort.Strings(appImages)
+	return slices.Compact(appImages)
+}
+
+// localAppImageVersions returns the versions of the app images referred by the job supported by the self cluster.
+// The versions of an app image are the tags of the local app images with the same image name, the versions of
+// an app image missing locally are empty.
+func (h *JobScheduler) localAppImageVersions(job *kusciaapisv1alpha1.KusciaJob) ([]kusciaapisv1alpha1.PartyAppImageVersions, error) {
+	appImageList, err := h.kusciaClient.KusciaV1alpha1().AppImages().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list app images failed, %v", err)
+	}
+
+	appImages := make(map[string]*kusciaapisv1alpha1.AppImage, len(appImageList.Items))
+	for i := range appImageList.Items {
+		appImages[appImageList.Items[i].Name] = &appImageList.Items[i]
+	}
+
+	var result []kusciaapisv1alpha1.PartyAppImageVersions
+	for _, name := range jobAppImages(job) {
+		versions := kusciaapisv1alpha1.PartyAppImageVersions{AppImage: name}
+		if appImage, ok := appImages[name]; ok {
+			var others []string
+			for _, ai := range appImageList.Items {
+				if ai.Spec.Image.Name == appImage.Spec.Image.Name && ai.Spec.Image.Tag != appImage.Spec.Image.Tag {
+					others = append(others, ai.Spec.Image.Tag)
+				}
+			}
+			sortVersionsDesc(others)
+			versions.Versions = append([]string{appImage.Spec.Image.Tag}, slices.Compact(others)...)
+		}
+		result = append(result, versions)
+	}
+	return result, nil
+}
+
+// setLocalAppImageVersions reports the app image versions of the own parties.
+func (h *JobScheduler) setLocalAppImageVersions(job *kusciaapisv1alpha1.KusciaJob, ownParties map[string]kusciaapisv1alpha1.Party) error {
+	if !needAppImageNegotiation(job) {
+		return nil
+	}
+
+	versions, err := h.localAppImageVersions(job)
+	if err != nil {
+		return err
+	}
+	if job.Status.AppImageVersions == nil {
+		job.Status.AppImageVersions = make(map[string][]kusciaapisv1alpha1.PartyAppImageVersions)
+	}
+	for p := range ownParties {
+		job.Status.AppImageVersions[p] = versions
+	}
+	return nil
+}
+
+// negotiatedAppImageVersions returns the versions the job is pinned to, the key is the app image name. It returns
+// nil if some party doesn't report its versions, e.g. the party runs an old version of kuscia, in which case the
+// parties run the app images referred by the tasks as they are.
+func (h *JobScheduler) negotiatedAppImageVersions(job *kusciaapisv1alpha1.KusciaJob) (map[string]string, error) {
+	if !needAppImageNegotiation(job) {
+		return nil, nil
+	}
+
+	var parties []string
+	for p := range h.getParties(job) {
+		if _, ok := job.Status.AppImageVersions[p]; !ok {
+			return nil, nil
+		}
+		parties = append(parties, p)
+	}
+	sort.Strings(parties)
+	return negotiateAppImageVersions(job.Spec.Initiator, parties, job.Status.AppImageVersions)
+}
+
+// negotiateAppImageVersions picks the first version of each app image in the order of the initiator which is
+// supported by all the parties. So the tag of the initiator's app image is preferred, then the newer versions.
+func negotiateAppImageVersions(initiator string, parties []string, partyVersions map[string][]kusciaapisv1alpha1.PartyAppImageVersions) (map[string]string, error) {
+	supported := func(party, appImage, v string) bool {
+		for _, versions := range partyVersions[party] {
+			if versions.AppImage == appImage {
+				return slices.Contains(versions.Versions, v)
+			}
+		}
+		return false
+	}
+
+	pinned := make(map[string]string)
+	var errs []string
+	for _, versions := range partyVersions[initiator] {
+		for _, v := range versions.Versions {
+			if allSupport(parties, func(party string) bool { return supported(party, versions.AppImage, v) }) {
+				pinned[versions.AppImage] = v
+				break
+			}
+		}
+		if _, ok := pinned[versions.AppImage]; !ok {
+			errs = append(errs, describeAppImageVersions(versions.AppImage, parties, partyVersions))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return pinned, nil
+}
+
+func allSupport(parties []string, supported func(party string) bool) bool {
+	for _, p := range parties {
+		if !supported(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// describeAppImageVersions describes the versions of each party, e.g. "app image secretflow has no version
+// supported by all parties: party alice supports [1.1.0], party bob supports [1.0.0]".
+func describeAppImageVersions(appImage string, parties []string, partyVersions map[string][]kusciaapisv1alpha1.PartyAppImageVersions) string {
+	var details []string
+	for _, p := range parties {
+		var versions []string
+		for _, v := range partyVersions[p] {
+			if v.AppImage == appImage {
+				versions = v.Versions
+			}
+		}
+		if len(versions) == 0 {
+			details = append(details, fmt.Sprintf("party %s has no app image %s", p, appImage))
+		} else {
+			details = append(details, fmt.Sprintf("party %s supports [%s]", p, strings.Join(versions, ", ")))
+		}
+	}
+	return fmt.Sprintf("app image %s has no version supported by all parties: %s", appImage, strings.Join(details, ", "))
+}
+
+// pinnedAppImageRefs returns the local app images of the negotiated versions, the key is the app image referred
+// by the tasks.
+func (h *JobScheduler) pinnedAppImageRefs(job *kusciaapisv1alpha1.KusciaJob) (map[string]string, error) {
+	pinned, err := h.negotiatedAppImageVersions(job)
+	if err != nil || len(pinned) == 0 {
+		return nil, err
+	}
+
+	appImageList, err := h.kusciaClient.KusciaV1alpha1().AppImages().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list app images failed, %v", err)
+	}
+	sort.Slice(appImageList.Items, func(i, j int) bool {
+		return appImageList.Items[i].Name < appImageList.Items[j].Name
+	})
+
+	refs := make(map[string]string, len(pinned))
+	for name, tag := range pinned {
+		idx := slices.IndexFunc(appImageList.Items, func(ai kusciaapisv1alpha1.AppImage) bool { return ai.Name == name })
+		if idx < 0 {
+			return nil, fmt.Errorf("app image %s is not found", name)
+		}
+		base := appImageList.Items[idx]
+		if base.Spec.Image.Tag == tag {
+			refs[name] = name
+			continue
+		}
+		for _, ai := range appImageList.Items {
+			if ai.Spec.Image.Name == base.Spec.Image.Name && ai.Spec.Image.Tag == tag {
+				refs[name] = ai.Name
+				break
+			}
+		}
+		if _, ok := refs[name]; !ok {
+			return nil, fmt.Errorf("app image %s with version %s is not found", base.Spec.Image.Name, tag)
+		}
+	}
+	return refs, nil
+}
+
+// appImageRefOf returns the app image the party runs, the partners run their own app images.
+func (h *JobScheduler) appImageRefOf(p kusciaapisv1alpha1.Party, appImage string, refs map[string]string) string {
+	if ref, ok := refs[appImage]; ok {
+		if isPartner, err := utilsres.IsPartnerDomain(h.namespaceLister, p.DomainID); err == nil && !isPartner {
+			return ref
+		}
+	}
+	return appImage
+}
+
+// sortVersionsDesc sorts the versions from the newest, the versions which aren't semantic are sorted after.
+func sortVersionsDesc(versions []string) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		vi, erri := version.ParseGeneric(versions[i])
+		vj, errj := version.ParseGeneric(versions[j])
+		switch {
+		case erri == nil && errj == nil && (vi.LessThan(vj) || vj.LessThan(vi)):
+			return vj.LessThan(vi)
+		case erri == nil && errj != nil:
+			return true
+		case erri != nil && errj == nil:
+			return false
+		default:
+			return versions[i] > versions[j]
+		}
+	})
+}
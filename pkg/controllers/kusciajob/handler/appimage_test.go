@@ -0,0 +1,161 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+)
+
+func makeAppImage(name, imageName, tag string) *kusciaapisv1alpha1.AppImage {
+	return &kusciaapisv1alpha1.AppImage{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: kusciaapisv1alpha1.AppImageSpec{
+			Image: kusciaapisv1alpha1.AppImageInfo{Name: imageName, Tag: tag},
+		},
+	}
+}
+
+func appImageVersions(appImage string, versions ...string) []kusciaapisv1alpha1.PartyAppImageVersions {
+	return []kusciaapisv1alpha1.PartyAppImageVersions{{AppImage: appImage, Versions: versions}}
+}
+
+func TestNegotiateAppImageVersions(t *testing.T) {
+	tests := []struct {
+		name          string
+		partyVersions map[string][]kusciaapisv1alpha1.PartyAppImageVersions
+		want          map[string]string
+		wantErr       string
+	}{
+		{
+			name: "the version of initiator is preferred",
+			partyVersions: map[string][]kusciaapisv1alpha1.PartyAppImageVersions{
+				"alice": appImageVersions("secretflow", "1.0.0", "1.1.0"),
+				"bob":   appImageVersions("secretflow", "1.1.0", "1.0.0"),
+			},
+			want: map[string]string{"secretflow": "1.0.0"},
+		},
+		{
+			name: "the common version is picked",
+			partyVersions: map[string][]kusciaapisv1alpha1.PartyAppImageVersions{
+				"alice": appImageVersions("secretflow", "1.2.0", "1.1.0", "1.0.0"),
+				"bob":   appImageVersions("secretflow", "1.0.0", "1.1.0"),
+			},
+			want: map[string]string{"secretflow": "1.1.0"},
+		},
+		{
+			name: "no common version",
+			partyVersions: map[string][]kusciaapisv1alpha1.PartyAppImageVersions{
+				"alice": appImageVersions("secretflow", "1.2.0"),
+				"bob":   appImageVersions("secretflow", "1.0.0", "1.1.0"),
+			},
+			wantErr: "app image secretflow has no version supported by all parties: party alice supports [1.2.0], party bob supports [1.0.0, 1.1.0]",
+		},
+		{
+			name: "party has no app image",
+			partyVersions: map[string][]kusciaapisv1alpha1.PartyAppImageVersions{
+				"alice": appImageVersions("secretflow", "1.2.0"),
+				"bob":   appImageVersions("secretflow"),
+			},
+			wantErr: "party bob has no app image secretflow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := negotiateAppImageVersions("alice", []string{"alice", "bob"}, tt.partyVersions)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSortVersionsDesc(t *testing.T) {
+	versions := []string{"latest", "1.0.0", "1.10.0", "1.2.0b0", "1.9.0"}
+	sortVersionsDesc(versions)
+	assert.Equal(t, []string{"1.10.0", "1.9.0", "1.2.0b0", "1.0.0", "latest"}, versions)
+}
+
+func TestAppImageNegotiation(t *testing.T) {
+	kusciaClient := kusciafake.NewSimpleClientset(
+		makeAppImage("secretflow", "secretflow/secretflow-lite", "1.0.0"),
+		makeAppImage("secretflow-110", "secretflow/secretflow-lite", "1.1.0"),
+		makeAppImage("secretflow-090", "secretflow/secretflow-lite", "0.9.0"),
+		makeAppImage("other", "secretflow/other", "1.1.0"),
+	)
+	kubeInformerFactory := informers.NewSharedInformerFactory(kubefake.NewSimpleClientset(), 0)
+	nsInformer := kubeInformerFactory.Core().V1().Namespaces()
+	nsInformer.Informer().GetStore().Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "alice"}})
+	nsInformer.Informer().GetStore().Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "bob",
+		Labels: map[string]string{common.LabelDomainRole: string(kusciaapisv1alpha1.Partner)},
+	}})
+	kusciaInformerFactory := kusciainformers.NewSharedInformerFactory(kusciaClient, 0)
+	domainInformer := kusciaInformerFactory.Kuscia().V1alpha1().Domains()
+	domainInformer.Informer().GetStore().Add(&kusciaapisv1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "alice"}})
+	domainInformer.Informer().GetStore().Add(&kusciaapisv1alpha1.Domain{
+		ObjectMeta: metav1.ObjectMeta{Name: "bob"},
+		Spec:       kusciaapisv1alpha1.DomainSpec{Role: kusciaapisv1alpha1.Partner},
+	})
+	h := &PendingHandler{JobScheduler: NewJobScheduler(&Dependencies{
+		KusciaClient:    kusciaClient,
+		NamespaceLister: nsInformer.Lister(),
+		DomainLister:    domainInformer.Lister(),
+	})}
+
+	job := makeKusciaJob(KusciaJobForShapeIndependent, kusciaapisv1alpha1.KusciaJobScheduleModeBestEffort, 2, nil)
+	for i := range job.Spec.Tasks {
+		job.Spec.Tasks[i].AppImage = "secretflow"
+	}
+	job.Annotations = map[string]string{common.InterConnKusciaPartyAnnotationKey: "bob"}
+
+	// the partner doesn't report its versions yet
+	assert.NoError(t, h.setLocalAppImageVersions(job, map[string]kusciaapisv1alpha1.Party{"alice": {DomainID: "alice"}}))
+	assert.Equal(t, appImageVersions("secretflow", "1.0.0", "1.1.0", "0.9.0"), job.Status.AppImageVersions["alice"])
+	refs, err := h.pinnedAppImageRefs(job)
+	assert.NoError(t, err)
+	assert.Nil(t, refs)
+
+	job.Status.AppImageVersions["bob"] = appImageVersions("secretflow", "1.1.0")
+	refs, err = h.pinnedAppImageRefs(job)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"secretflow": "secretflow-110"}, refs)
+	assert.Equal(t, "secretflow-110", h.appImageRefOf(kusciaapisv1alpha1.Party{DomainID: "alice"}, "secretflow", refs))
+	assert.Equal(t, "secretflow", h.appImageRefOf(kusciaapisv1alpha1.Party{DomainID: "bob"}, "secretflow", refs))
+
+	// fail fast if there is no common version
+	job.Status.AppImageVersions["bob"] = appImageVersions("secretflow", "1.2.0")
+	setJobAllPartyCreateSuccess(job)
+	needUpdate, err := h.HandlePhase(job)
+	assert.NoError(t, err)
+	assert.True(t, needUpdate)
+	assert.Equal(t, kusciaapisv1alpha1.KusciaJobFailed, job.Status.Phase)
+	assert.Equal(t, string(kusciaapisv1alpha1.AppImageNegotiationFailed), job.Status.Reason)
+	assert.Contains(t, job.Status.Message, "party bob supports [1.2.0]")
+}
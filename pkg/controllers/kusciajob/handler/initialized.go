@@ -89,6 +89,10 @@ func (h *JobScheduler) handleInitialized(job *kusciaapisv1alpha1.KusciaJob) (nee
 			job.Status.StageStatus[p] = kusciaapisv1alpha1.JobCreateStageSucceeded
 		}
 	}
+	// report the app image versions of own parties to negotiate with the other parties
+	if err = h.setLocalAppImageVersions(job, ownP); err != nil {
+		return false, err
+	}
 	// inter connection job
 	if isInterConnJob(job) {
 		job.Status.Phase = kusciaapisv1alpha1.KusciaJobAwaitingApproval
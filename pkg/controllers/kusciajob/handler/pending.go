@@ -68,6 +68,12 @@ func (h *PendingHandler) handlePending(job *kusciaapisv1alpha1.KusciaJob) (needU
 	}
 	// normal logic
 	if ok, _ := h.allPartyCreateSuccess(job); ok {
+		// all parties have reported their app image versions once created
+		if _, err := h.negotiatedAppImageVersions(job); err != nil {
+			// set Pending --> Failed
+			setKusciaJobStatus(now, &job.Status, kusciaapisv1alpha1.KusciaJobFailed, string(kusciaapisv1alpha1.AppImageNegotiationFailed), err.Error())
+			return true, nil
+		}
 		// set Pending --> Running
 		job.Status.Phase = kusciaapisv1alpha1.KusciaJobRunning
 		return true, nil
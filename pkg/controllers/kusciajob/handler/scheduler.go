@@ -524,6 +524,13 @@ func (h *JobScheduler) deleteNotSuccessTasks(kusciaJob *kusciaapisv1alpha1.Kusci
 			nlog.Warnf("Get kuscia task %v failed, so skip delete this task, error: %s.", taskID, err.Error())
 			return err
 		}
+		// keep the checkpoints for the restarted task, the older ones are kept if the task reported none
+		if checkpoints := buildPartyTaskCheckpoints(kt); len(checkpoints) > 0 {
+			if kusciaJob.Status.TaskCheckpoints == nil {
+				kusciaJob.Status.TaskCheckpoints = map[string][]kusciaapisv1alpha1.PartyTaskCheckpoint{}
+			}
+			kusciaJob.Status.TaskCheckpoints[taskID] = checkpoints
+		}
 		err = h.kusciaClient.KusciaV1alpha1().KusciaTasks(common.KusciaCrossDomain).Delete(context.Background(), kt.Name, metav1.DeleteOptions{})
 		if err != nil && !k8serrors.IsNotFound(err) {
 			nlog.Warnf("Delete kuscia task %v failed, so skip delete this task, error: %s.", taskID, err.Error())
@@ -536,6 +543,33 @@ func (h *JobScheduler) deleteNotSuccessTasks(kusciaJob *kusciaapisv1alpha1.Kusci
 	return nil
 }
 
+// buildPartyTaskCheckpoints collects the checkpoints reported by the parties of the task.
+func buildPartyTaskCheckpoints(kt *kusciaapisv1alpha1.KusciaTask) []kusciaapisv1alpha1.PartyTaskCheckpoint {
+	var checkpoints []kusciaapisv1alpha1.PartyTaskCheckpoint
+	for _, pts := range kt.Status.PartyTaskStatus {
+		if pts.Checkpoint == nil || pts.Checkpoint.ID == "" {
+			continue
+		}
+		checkpoints = append(checkpoints, kusciaapisv1alpha1.PartyTaskCheckpoint{
+			DomainID: pts.DomainID,
+			Role:     pts.Role,
+			ID:       pts.Checkpoint.ID,
+		})
+	}
+	return checkpoints
+}
+
+// setResumeFromCheckpoint lets the parties of the restarted task resume from the checkpoints kept by the job.
+func setResumeFromCheckpoint(parties []kusciaapisv1alpha1.PartyInfo, checkpoints []kusciaapisv1alpha1.PartyTaskCheckpoint) {
+	for i := range parties {
+		for _, checkpoint := range checkpoints {
+			if checkpoint.DomainID == parties[i].DomainID && checkpoint.Role == parties[i].Role {
+				parties[i].ResumeFromCheckpoint = checkpoint.ID
+			}
+		}
+	}
+}
+
 // kusciaJobValidate check whether kusciaJob is valid.
 func (h *JobScheduler) kusciaJobValidate(kusciaJob *kusciaapisv1alpha1.KusciaJob) error {
 	if _, err := h.namespaceLister.Get(kusciaJob.Spec.Initiator); err != nil {
@@ -549,6 +583,9 @@ func (h *JobScheduler) kusciaJobValidate(kusciaJob *kusciaapisv1alpha1.KusciaJob
 	if err := kusciaJobDependenciesExits(kusciaJob); err != nil {
 		return err
 	}
+	if err := kusciaJobAffinityValid(kusciaJob); err != nil {
+		return err
+	}
 	return kusciaJobHasTaskCycle(kusciaJob)
 }
 
@@ -820,6 +857,63 @@ func kusciaJobDependenciesExits(kusciaJob *kusciaapisv1alpha1.KusciaJob) error {
 	return nil
 }
 
+// kusciaJobAffinityValid checks that the affinity of each task references the other existing tasks of the job,
+// and no task is both colocated with and separated from the task.
+func kusciaJobAffinityValid(kusciaJob *kusciaapisv1alpha1.KusciaJob) error {
+	taskAliasSet := make(map[string]bool, len(kusciaJob.Spec.Tasks))
+	for _, t := range kusciaJob.Spec.Tasks {
+		taskAliasSet[t.Alias] = true
+	}
+
+	for _, t := range kusciaJob.Spec.Tasks {
+		if t.Affinity == nil {
+			continue
+		}
+		colocated := make(map[string]bool, len(t.Affinity.ColocateWith))
+		for _, alias := range t.Affinity.ColocateWith {
+			if alias == t.Alias || !taskAliasSet[alias] {
+				return fmt.Errorf("validate failed: task %s has invalid colocated task %s", t.Alias, alias)
+			}
+			colocated[alias] = true
+		}
+		for _, alias := range t.Affinity.SeparateFrom {
+			if alias == t.Alias || !taskAliasSet[alias] {
+				return fmt.Errorf("validate failed: task %s has invalid separated task %s", t.Alias, alias)
+			}
+			if colocated[alias] {
+				return fmt.Errorf("validate failed: task %s can't be both colocated with and separated from task %s", t.Alias, alias)
+			}
+		}
+	}
+	return nil
+}
+
+// buildTaskAffinity translates the task aliases in the affinity of the task template to the task ids.
+func buildTaskAffinity(kusciaJob *kusciaapisv1alpha1.KusciaJob, affinity *kusciaapisv1alpha1.TaskAffinity) *kusciaapisv1alpha1.TaskAffinity {
+	if affinity == nil {
+		return nil
+	}
+
+	taskIDs := make(map[string]string, len(kusciaJob.Spec.Tasks))
+	for _, t := range kusciaJob.Spec.Tasks {
+		taskIDs[t.Alias] = t.TaskID
+	}
+	toTaskIDs := func(aliases []string) []string {
+		var ids []string
+		for _, alias := range aliases {
+			if id := taskIDs[alias]; id != "" {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+	return &kusciaapisv1alpha1.TaskAffinity{
+		ColocateWith: toTaskIDs(affinity.ColocateWith),
+		SeparateFrom: toTaskIDs(affinity.SeparateFrom),
+		Preferred:    affinity.Preferred,
+	}
+}
+
 // buildJobSubTaskStatus returns current subtask status.
 func buildJobSubTaskStatus(currentSubTasks []*kusciaapisv1alpha1.KusciaTask, job *kusciaapisv1alpha1.KusciaJob) (map[string]kusciaapisv1alpha1.KusciaTaskPhase, map[string]kusciaapisv1alpha1.KusciaTaskPhase) {
 	subTaskStatusWithAlias := make(map[string]kusciaapisv1alpha1.KusciaTaskPhase, 0)
@@ -1014,6 +1108,10 @@ func willStartTasksOf(kusciaJob *kusciaapisv1alpha1.KusciaJob, readyTasks []kusc
 func (h *RunningHandler) buildWillStartKusciaTask(kusciaJob *kusciaapisv1alpha1.KusciaJob, willStartTask []kusciaapisv1alpha1.KusciaTaskTemplate) ([]*kusciaapisv1alpha1.KusciaTask, error) {
 	createdTasks := make([]*kusciaapisv1alpha1.KusciaTask, 0)
 	isIcJob := isInterConnJob(kusciaJob)
+	appImageRefs, err := h.pinnedAppImageRefs(kusciaJob)
+	if err != nil {
+		return nil, err
+	}
 	for i, t := range willStartTask {
 		asParticipant, err := h.selfClusterAsParticipant(&willStartTask[i])
 		if err != nil {
@@ -1036,8 +1134,10 @@ func (h *RunningHandler) buildWillStartKusciaTask(kusciaJob *kusciaapisv1alpha1.
 					common.LabelJobUID:     string(kusciaJob.UID),
 				},
 			},
-			Spec: h.createTaskSpec(kusciaJob.Spec.Initiator, t),
+			Spec: h.createTaskSpec(kusciaJob.Spec.Initiator, t, appImageRefs),
 		}
+		taskObject.Spec.Affinity = buildTaskAffinity(kusciaJob, t.Affinity)
+		setResumeFromCheckpoint(taskObject.Spec.Parties, kusciaJob.Status.TaskCheckpoints[t.TaskID])
 
 		if isIcJob {
 			// todo delete LabelInterConnProtocolType label
@@ -1076,11 +1176,12 @@ func (h *RunningHandler) selfClusterAsParticipant(task *kusciaapisv1alpha1.Kusci
 }
 
 // createTaskSpec will make kuscia task spec for kuscia job.
-func (h *RunningHandler) createTaskSpec(initiator string, t kusciaapisv1alpha1.KusciaTaskTemplate) kusciaapisv1alpha1.KusciaTaskSpec {
+func (h *RunningHandler) createTaskSpec(initiator string, t kusciaapisv1alpha1.KusciaTaskTemplate, appImageRefs map[string]string) kusciaapisv1alpha1.KusciaTaskSpec {
 	result := kusciaapisv1alpha1.KusciaTaskSpec{
-		Initiator:       initiator,
-		TaskInputConfig: t.TaskInputConfig,
-		Parties:         h.buildPartiesFromTaskInputConfig(t),
+		Initiator:             initiator,
+		TaskInputConfig:       t.TaskInputConfig,
+		SensitiveInputConfigs: t.SensitiveInputConfigs,
+		Parties:               h.buildPartiesFromTaskInputConfig(t, appImageRefs),
 	}
 	if t.ScheduleConfig != nil {
 		result.ScheduleConfig = *t.ScheduleConfig
@@ -1088,16 +1189,18 @@ func (h *RunningHandler) createTaskSpec(initiator string, t kusciaapisv1alpha1.K
 	return result
 }
 
-// buildPartiesFromTaskInputConfig will make kuscia task parties for kuscia job.
-func (h *RunningHandler) buildPartiesFromTaskInputConfig(template kusciaapisv1alpha1.KusciaTaskTemplate) []kusciaapisv1alpha1.PartyInfo {
+// buildPartiesFromTaskInputConfig will make kuscia task parties for kuscia job. The own parties run the app images
+// of the negotiated versions in appImageRefs if any.
+func (h *RunningHandler) buildPartiesFromTaskInputConfig(template kusciaapisv1alpha1.KusciaTaskTemplate, appImageRefs map[string]string) []kusciaapisv1alpha1.PartyInfo {
 	taskPartyInfos := make([]kusciaapisv1alpha1.PartyInfo, len(template.Parties))
 	for i, p := range template.Parties {
+		appImage := h.appImageRefOf(p, template.AppImage, appImageRefs)
 		// build container resources of tasks
-		tpl := h.buildPartyTemplate(p, template.AppImage)
+		tpl := h.buildPartyTemplate(p, appImage)
 
 		taskPartyInfos[i] = kusciaapisv1alpha1.PartyInfo{
 			DomainID:       p.DomainID,
-			AppImageRef:    template.AppImage,
+			AppImageRef:    appImage,
 			Role:           p.Role,
 			Template:       tpl,
 			BandwidthLimit: p.BandwidthLimit,
@@ -200,6 +200,82 @@ func Test_kusciaJobValidate(t *testing.T) {
 	}
 }
 
+func Test_kusciaJobAffinityValid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		affinity *kusciaapisv1alpha1.TaskAffinity
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "valid affinity",
+			affinity: &kusciaapisv1alpha1.TaskAffinity{ColocateWith: []string{"b"}, SeparateFrom: []string{"c", "d"}},
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "colocate with unknown task",
+			affinity: &kusciaapisv1alpha1.TaskAffinity{ColocateWith: []string{"e"}},
+			wantErr:  assert.Error,
+		},
+		{
+			name:     "separate from itself",
+			affinity: &kusciaapisv1alpha1.TaskAffinity{SeparateFrom: []string{"a"}},
+			wantErr:  assert.Error,
+		},
+		{
+			name:     "colocate with and separate from the same task",
+			affinity: &kusciaapisv1alpha1.TaskAffinity{ColocateWith: []string{"b"}, SeparateFrom: []string{"b"}},
+			wantErr:  assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kusciaJob := makeKusciaJob(KusciaJobForShapeIndependent, kusciaapisv1alpha1.KusciaJobScheduleModeBestEffort, 2, nil)
+			kusciaJob.Spec.Tasks[0].Affinity = tt.affinity
+			tt.wantErr(t, kusciaJobAffinityValid(kusciaJob))
+		})
+	}
+}
+
+func Test_buildTaskAffinity(t *testing.T) {
+	t.Parallel()
+	kusciaJob := makeKusciaJob(KusciaJobForShapeIndependent, kusciaapisv1alpha1.KusciaJobScheduleModeBestEffort, 2, nil)
+	kusciaJob.Spec.Tasks[1].TaskID = "job-b"
+	kusciaJob.Spec.Tasks[2].TaskID = "job-c"
+
+	assert.Nil(t, buildTaskAffinity(kusciaJob, nil))
+	got := buildTaskAffinity(kusciaJob, &kusciaapisv1alpha1.TaskAffinity{
+		ColocateWith: []string{"b"},
+		SeparateFrom: []string{"c"},
+		Preferred:    true,
+	})
+	assert.Equal(t, &kusciaapisv1alpha1.TaskAffinity{
+		ColocateWith: []string{"job-b"},
+		SeparateFrom: []string{"job-c"},
+		Preferred:    true,
+	}, got)
+}
+
+func Test_resumeFromCheckpoint(t *testing.T) {
+	t.Parallel()
+	kt := &kusciaapisv1alpha1.KusciaTask{
+		Status: kusciaapisv1alpha1.KusciaTaskStatus{
+			PartyTaskStatus: []kusciaapisv1alpha1.PartyTaskStatus{
+				{DomainID: "alice", Role: "host", Checkpoint: &kusciaapisv1alpha1.TaskCheckpoint{ID: "epoch-3"}},
+				{DomainID: "bob", Role: "guest"},
+			},
+		},
+	}
+	checkpoints := buildPartyTaskCheckpoints(kt)
+	assert.Equal(t, []kusciaapisv1alpha1.PartyTaskCheckpoint{{DomainID: "alice", Role: "host", ID: "epoch-3"}}, checkpoints)
+
+	parties := []kusciaapisv1alpha1.PartyInfo{{DomainID: "alice", Role: "host"}, {DomainID: "bob", Role: "guest"}}
+	setResumeFromCheckpoint(parties, checkpoints)
+	assert.Equal(t, "epoch-3", parties[0].ResumeFromCheckpoint)
+	assert.Empty(t, parties[1].ResumeFromCheckpoint)
+}
+
 func Test_readyTasksOf(t *testing.T) {
 	t.Parallel()
 	noDependencies := makeKusciaJob(KusciaJobForShapeIndependent,
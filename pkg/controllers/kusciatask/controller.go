@@ -25,6 +25,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -92,8 +93,12 @@ type Controller struct {
 	kusciaTaskLister kuscialistersv1alpha1.KusciaTaskLister
 	kusciaTaskSynced cache.InformerSynced
 	appImageSynced   cache.InformerSynced
+	domainSynced     cache.InformerSynced
+	domainDataSynced cache.InformerSynced
+	grantSynced      cache.InformerSynced
 	trgSynced        cache.InformerSynced
 	trgLister        kuscialistersv1alpha1.TaskResourceGroupLister
+	garbageCollector *handler.GarbageCollector
 }
 
 // NewController returns a controller instance.
@@ -110,7 +115,10 @@ func NewController(ctx context.Context, config controllers.ControllerConfig) con
 	configMapInformer := kubeInformerFactory.Core().V1().ConfigMaps()
 	kusciaTaskInformer := kusciaInformerFactory.Kuscia().V1alpha1().KusciaTasks()
 	appImageInformer := kusciaInformerFactory.Kuscia().V1alpha1().AppImages()
+	domainInformer := kusciaInformerFactory.Kuscia().V1alpha1().Domains()
 	trgInformer := kusciaInformerFactory.Kuscia().V1alpha1().TaskResourceGroups()
+	domainDataInformer := kusciaInformerFactory.Kuscia().V1alpha1().DomainDatas()
+	grantInformer := kusciaInformerFactory.Kuscia().V1alpha1().DomainDataGrants()
 
 	controller := &Controller{
 		kubeClient:            kubeClient,
@@ -128,6 +136,9 @@ func NewController(ctx context.Context, config controllers.ControllerConfig) con
 		kusciaTaskLister:      kusciaTaskInformer.Lister(),
 		kusciaTaskSynced:      kusciaTaskInformer.Informer().HasSynced,
 		appImageSynced:        appImageInformer.Informer().HasSynced,
+		domainSynced:          domainInformer.Informer().HasSynced,
+		domainDataSynced:      domainDataInformer.Informer().HasSynced,
+		grantSynced:           grantInformer.Informer().HasSynced,
 		trgLister:             trgInformer.Lister(),
 		trgSynced:             trgInformer.Informer().HasSynced,
 		taskQueue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), taskQueue),
@@ -135,17 +146,24 @@ func NewController(ctx context.Context, config controllers.ControllerConfig) con
 		recorder:              eventRecorder,
 	}
 	controller.ctx, controller.cancel = context.WithCancel(ctx)
-	controller.handlerFactory = handler.NewKusciaTaskPhaseHandlerFactory(&handler.Dependencies{
-		KubeClient:       kubeClient,
-		KusciaClient:     kusciaClient,
-		TrgLister:        trgInformer.Lister(),
-		NamespacesLister: namespaceInformer.Lister(),
-		PodsLister:       controller.podsLister,
-		ServicesLister:   serviceInformer.Lister(),
-		ConfigMapLister:  configMapInformer.Lister(),
-		AppImagesLister:  appImageInformer.Lister(),
-		Recorder:         eventRecorder,
-	})
+	deps := &handler.Dependencies{
+		KubeClient:            kubeClient,
+		KusciaClient:          kusciaClient,
+		TrgLister:             trgInformer.Lister(),
+		NamespacesLister:      namespaceInformer.Lister(),
+		PodsLister:            controller.podsLister,
+		ServicesLister:        serviceInformer.Lister(),
+		ConfigMapLister:       configMapInformer.Lister(),
+		AppImagesLister:       appImageInformer.Lister(),
+		DomainLister:          domainInformer.Lister(),
+		DomainDataLister:      domainDataInformer.Lister(),
+		DomainDataGrantLister: grantInformer.Lister(),
+		Recorder:              eventRecorder,
+		KusciaTaskLister:      kusciaTaskInformer.Lister(),
+		TaskGC:                config.TaskGC,
+	}
+	controller.handlerFactory = handler.NewKusciaTaskPhaseHandlerFactory(deps)
+	controller.garbageCollector = handler.NewGarbageCollector(deps)
 
 	// kuscia task event handler
 	_, _ = kusciaTaskInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -237,7 +255,8 @@ func (c *Controller) Run(workers int) error {
 	// Wait for the caches to be synced before starting workers
 	nlog.Infof("Waiting for informer cache to sync for %v", c.Name())
 	if !cache.WaitForCacheSync(c.ctx.Done(), c.namespaceSynced, c.podsSynced, c.servicesSynced, c.configMapSynced,
-		c.kusciaTaskSynced, c.appImageSynced, c.trgSynced) {
+		c.kusciaTaskSynced, c.appImageSynced, c.domainSynced,
+		c.domainDataSynced, c.grantSynced, c.trgSynced) {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
 
@@ -248,6 +267,13 @@ func (c *Controller) Run(workers int) error {
 		go c.runDeletedTaskWorker(c.ctx)
 	}
 
+	if c.garbageCollector.Enabled() {
+		nlog.Infof("Start collecting the expired resources of the finished kusciaTasks every %v", c.garbageCollector.Interval())
+		go wait.UntilWithContext(c.ctx, func(ctx context.Context) {
+			c.garbageCollector.Collect(ctx, time.Now())
+		}, c.garbageCollector.Interval())
+	}
+
 	<-c.ctx.Done()
 	nlog.Info("Shutting down workers")
 
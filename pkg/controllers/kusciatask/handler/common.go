@@ -36,12 +36,18 @@ const (
 	labelKusciaTaskPodRole     = "kuscia.secretflow/pod-role"
 
 	configTemplateVolumeName = "config-template"
+
+	// taskComponentKey is the key of the component in the task input config of SecretFlow.
+	taskComponentKey = "sf_node_eval_param"
 )
 
 const (
 	defaultResourceReservedSeconds = 30
 	defaultLifecycleSeconds        = 300
 	defaultRetryIntervalSeconds    = 30
+	// defaultReservationTimeoutSeconds releases the reservations of the parties if some party can't reserve
+	// resources in time, so that the resources are not held by the parties waiting for it.
+	defaultReservationTimeoutSeconds = 120
 )
 
 func selfClusterAsParticipant(namespacesLister corelisters.NamespaceLister, kusciaTask *kusciaapisv1alpha1.KusciaTask) (bool, error) {
@@ -22,6 +22,7 @@ import (
 	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
 	kuscialistersv1alpha1 "github.com/secretflow/kuscia/pkg/crd/listers/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 )
 
 // Dependencies defines some parameter dependencies of functions.
@@ -34,7 +35,13 @@ type Dependencies struct {
 	ServicesLister   corelisters.ServiceLister
 	ConfigMapLister  corelisters.ConfigMapLister
 	AppImagesLister  kuscialistersv1alpha1.AppImageLister
-	Recorder         record.EventRecorder
+	DomainLister     kuscialistersv1alpha1.DomainLister
+	// DomainDataLister and DomainDataGrantLister are used to check the input domain data granted to the parties.
+	DomainDataLister      kuscialistersv1alpha1.DomainDataLister
+	DomainDataGrantLister kuscialistersv1alpha1.DomainDataGrantLister
+	Recorder              record.EventRecorder
+	KusciaTaskLister      kuscialistersv1alpha1.KusciaTaskLister
+	TaskGC                *kusciaconfig.TaskGCConfig
 }
 
 // KusciaTaskPhaseHandler is an interface to handle kuscia task.
@@ -17,7 +17,9 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -39,6 +41,7 @@ type FinishedHandler struct {
 	kusciaClient    kusciaclientset.Interface
 	podsLister      corelisters.PodLister
 	configMapLister corelisters.ConfigMapLister
+	gc              *GarbageCollector
 }
 
 // NewFinishedHandler returns a FinishedHandler instance.
@@ -48,11 +51,13 @@ func NewFinishedHandler(deps *Dependencies) *FinishedHandler {
 		kusciaClient:    deps.KusciaClient,
 		podsLister:      deps.PodsLister,
 		configMapLister: deps.ConfigMapLister,
+		gc:              NewGarbageCollector(deps),
 	}
 }
 
 // Handle is used to perform the real logic.
 func (h *FinishedHandler) Handle(kusciaTask *kusciaapisv1alpha1.KusciaTask) (bool, error) {
+	h.summarizeResourceUsage(kusciaTask)
 	if err := h.DeleteTaskResources(kusciaTask); err != nil {
 		return false, err
 	}
@@ -62,8 +67,56 @@ func (h *FinishedHandler) Handle(kusciaTask *kusciaapisv1alpha1.KusciaTask) (boo
 	return true, nil
 }
 
+// summarizeResourceUsage sums up the resource usage reported by the agents in the annotations of the pods for each
+// party. The agents report the usage periodically, so the usage of the last period before the task finishes may be
+// missing.
+func (h *FinishedHandler) summarizeResourceUsage(kusciaTask *kusciaapisv1alpha1.KusciaTask) {
+	usages := map[int]*kusciaapisv1alpha1.ResourceUsage{}
+	pods, _ := h.podsLister.List(labels.SelectorFromSet(labels.Set{common.LabelTaskUID: string(kusciaTask.UID)}))
+	for _, pod := range pods {
+		value, ok := pod.Annotations[common.ResourceUsageAnnotationKey]
+		if !ok {
+			continue
+		}
+		podUsage := &kusciaapisv1alpha1.ResourceUsage{}
+		if err := json.Unmarshal([]byte(value), podUsage); err != nil {
+			nlog.Warnf("Invalid resource usage of pod %v/%v, %v", pod.Namespace, pod.Name, err)
+			continue
+		}
+
+		for i, status := range kusciaTask.Status.PartyTaskStatus {
+			if status.DomainID != pod.Namespace || status.Role != pod.Labels[labelKusciaTaskPodRole] {
+				continue
+			}
+			if usages[i] == nil {
+				usages[i] = &kusciaapisv1alpha1.ResourceUsage{}
+			}
+			addResourceUsage(usages[i], podUsage)
+			break
+		}
+	}
+
+	for i, usage := range usages {
+		kusciaTask.Status.PartyTaskStatus[i].ResourceUsage = usage
+	}
+}
+
+func addResourceUsage(sum, usage *kusciaapisv1alpha1.ResourceUsage) {
+	sum.CPUMilliSeconds += usage.CPUMilliSeconds
+	sum.MemoryPeakBytes += usage.MemoryPeakBytes
+	sum.MemoryMiBSeconds += usage.MemoryMiBSeconds
+	sum.IOReadBytes += usage.IOReadBytes
+	sum.IOWriteBytes += usage.IOWriteBytes
+	sum.NetworkReceiveBytes += usage.NetworkReceiveBytes
+	sum.NetworkTransmitBytes += usage.NetworkTransmitBytes
+}
+
 // DeleteTaskResources is used to delete task resources.
 func (h *FinishedHandler) DeleteTaskResources(kusciaTask *kusciaapisv1alpha1.KusciaTask) error {
+	if h.gc.Enabled() {
+		return h.gc.CollectTask(context.Background(), kusciaTask, time.Now())
+	}
+
 	pods, _ := h.podsLister.List(labels.SelectorFromSet(labels.Set{common.LabelTaskUID: string(kusciaTask.UID)}))
 	for _, pod := range pods {
 		if pod.Status.Phase == corev1.PodFailed {
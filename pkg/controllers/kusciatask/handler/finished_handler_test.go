@@ -99,3 +99,50 @@ func TestFinishedHandler_Handle(t *testing.T) {
 	assert.True(t, errors.IsNotFound(err))
 
 }
+
+func TestFinishedHandler_summarizeResourceUsage(t *testing.T) {
+	t.Parallel()
+	testKusciaTask := &kusciaapisv1alpha1.KusciaTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "abc",
+			UID:  "111",
+		},
+		Status: kusciaapisv1alpha1.KusciaTaskStatus{
+			Phase: kusciaapisv1alpha1.TaskSucceeded,
+			PartyTaskStatus: []kusciaapisv1alpha1.PartyTaskStatus{
+				{DomainID: "alice", Role: "server"},
+				{DomainID: "alice", Role: "client"},
+				{DomainID: "bob"},
+			},
+		},
+	}
+
+	newPod := func(namespace, name, role, usage string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        name,
+				Labels:      map[string]string{common.LabelTaskUID: "111", labelKusciaTaskPodRole: role},
+				Annotations: map[string]string{common.ResourceUsageAnnotationKey: usage},
+			},
+		}
+	}
+
+	kubeClient := kubefake.NewSimpleClientset()
+	kubeInformersFactory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	podInformer := kubeInformersFactory.Core().V1().Pods()
+	podInformer.Informer().GetStore().Add(newPod("alice", "pod-01", "server", `{"cpuMilliSeconds":100,"memoryPeakBytes":1024}`))
+	podInformer.Informer().GetStore().Add(newPod("alice", "pod-02", "server", `{"cpuMilliSeconds":200,"memoryPeakBytes":2048,"ioReadBytes":10}`))
+	podInformer.Informer().GetStore().Add(newPod("alice", "pod-03", "client", `invalid`))
+	podInformer.Informer().GetStore().Add(newPod("bob", "pod-01", "", `{"networkTransmitBytes":30}`))
+
+	h := NewFinishedHandler(&Dependencies{PodsLister: podInformer.Lister()})
+	h.summarizeResourceUsage(testKusciaTask)
+	// summarizing again doesn't count the usage twice
+	h.summarizeResourceUsage(testKusciaTask)
+
+	assert.Equal(t, &kusciaapisv1alpha1.ResourceUsage{CPUMilliSeconds: 300, MemoryPeakBytes: 3072, IOReadBytes: 10},
+		testKusciaTask.Status.PartyTaskStatus[0].ResourceUsage)
+	assert.Nil(t, testKusciaTask.Status.PartyTaskStatus[1].ResourceUsage)
+	assert.Equal(t, &kusciaapisv1alpha1.ResourceUsage{NetworkTransmitBytes: 30}, testKusciaTask.Status.PartyTaskStatus[2].ResourceUsage)
+}
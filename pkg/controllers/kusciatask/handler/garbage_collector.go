@@ -0,0 +1,258 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/controllers/kusciatask/metrics"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	kuscialistersv1alpha1 "github.com/secretflow/kuscia/pkg/crd/listers/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	gcKindPod               = "Pod"
+	gcKindService           = "Service"
+	gcKindConfigMap         = "ConfigMap"
+	gcKindTaskResource      = "TaskResource"
+	gcKindTaskResourceGroup = "TaskResourceGroup"
+)
+
+type deleteFunc func(ctx context.Context, name string, opts metav1.DeleteOptions) error
+
+// GarbageCollector deletes the resources of the finished tasks by the ttls of the task gc policies.
+type GarbageCollector struct {
+	conf             *kusciaconfig.TaskGCConfig
+	kubeClient       kubernetes.Interface
+	kusciaClient     kusciaclientset.Interface
+	podsLister       corelisters.PodLister
+	servicesLister   corelisters.ServiceLister
+	configMapLister  corelisters.ConfigMapLister
+	trgLister        kuscialistersv1alpha1.TaskResourceGroupLister
+	kusciaTaskLister kuscialistersv1alpha1.KusciaTaskLister
+}
+
+// NewGarbageCollector returns a GarbageCollector instance.
+func NewGarbageCollector(deps *Dependencies) *GarbageCollector {
+	return &GarbageCollector{
+		conf:             deps.TaskGC,
+		kubeClient:       deps.KubeClient,
+		kusciaClient:     deps.KusciaClient,
+		podsLister:       deps.PodsLister,
+		servicesLister:   deps.ServicesLister,
+		configMapLister:  deps.ConfigMapLister,
+		trgLister:        deps.TrgLister,
+		kusciaTaskLister: deps.KusciaTaskLister,
+	}
+}
+
+// Enabled returns whether the task gc is enabled.
+func (gc *GarbageCollector) Enabled() bool {
+	return gc.conf != nil && gc.conf.Enable
+}
+
+// Interval returns the interval of collecting the expired resources.
+func (gc *GarbageCollector) Interval() time.Duration {
+	return gc.conf.Interval()
+}
+
+// CollectTask deletes the resources of the task which just finished, including the pods still running and the
+// resources whose ttls are zero. The others are deleted by Collect after the ttls.
+func (gc *GarbageCollector) CollectTask(ctx context.Context, kusciaTask *kusciaapisv1alpha1.KusciaTask, now time.Time) error {
+	policy := gc.policy(kusciaTask.Status.Phase)
+	if policy == nil {
+		return nil
+	}
+	completion := now
+	if kusciaTask.Status.CompletionTime != nil {
+		completion = kusciaTask.Status.CompletionTime.Time
+	}
+	phase := kusciaTask.Status.Phase
+	selector := labels.SelectorFromSet(labels.Set{common.LabelTaskUID: string(kusciaTask.UID)})
+
+	pods, _ := gc.podsLister.List(selector)
+	for _, pod := range pods {
+		if podFinished(pod) && !expired(policy.PodTTL(), completion, now) {
+			continue
+		}
+		if err := gc.delete(ctx, gcKindPod, phase, pod.Namespace, pod.Name, gc.kubeClient.CoreV1().Pods(pod.Namespace).Delete); err != nil {
+			return err
+		}
+	}
+
+	if expired(policy.PodTTL(), completion, now) {
+		services, _ := gc.servicesLister.List(selector)
+		for _, service := range services {
+			if err := gc.delete(ctx, gcKindService, phase, service.Namespace, service.Name, gc.kubeClient.CoreV1().Services(service.Namespace).Delete); err != nil {
+				return err
+			}
+		}
+	}
+
+	if expired(policy.ConfigMapTTL(), completion, now) {
+		configMaps, _ := gc.configMapLister.List(selector)
+		for _, configMap := range configMaps {
+			if err := gc.delete(ctx, gcKindConfigMap, phase, configMap.Namespace, configMap.Name, gc.kubeClient.CoreV1().ConfigMaps(configMap.Namespace).Delete); err != nil {
+				return err
+			}
+		}
+	}
+
+	if expired(policy.TaskResourceTTL(), completion, now) {
+		trg, _ := gc.trgLister.Get(kusciaTask.Name)
+		if trg != nil {
+			return gc.deleteTaskResourceGroup(ctx, trg, phase)
+		}
+	}
+	return nil
+}
+
+// Collect deletes the expired resources of all the finished tasks. The resources of the deleted tasks are deleted
+// by the controller when the tasks are deleted.
+func (gc *GarbageCollector) Collect(ctx context.Context, now time.Time) {
+	startTime := time.Now()
+	defer func() {
+		metrics.GCDurations.Observe(time.Since(startTime).Seconds())
+	}()
+
+	requirement, _ := labels.NewRequirement(common.LabelTaskUID, selection.Exists, nil)
+	selector := labels.NewSelector().Add(*requirement)
+	var errs int
+
+	pods, _ := gc.podsLister.List(selector)
+	for _, pod := range pods {
+		if task, policy := gc.finishedTask(pod.Annotations[common.TaskIDAnnotationKey], pod.Labels[common.LabelTaskUID]); task != nil &&
+			expired(policy.PodTTL(), task.Status.CompletionTime.Time, now) {
+			if err := gc.delete(ctx, gcKindPod, task.Status.Phase, pod.Namespace, pod.Name, gc.kubeClient.CoreV1().Pods(pod.Namespace).Delete); err != nil {
+				nlog.Warn(err)
+				errs++
+			}
+		}
+	}
+
+	services, _ := gc.servicesLister.List(selector)
+	for _, service := range services {
+		if task, policy := gc.finishedTask(service.Annotations[common.TaskIDAnnotationKey], service.Labels[common.LabelTaskUID]); task != nil &&
+			expired(policy.PodTTL(), task.Status.CompletionTime.Time, now) {
+			if err := gc.delete(ctx, gcKindService, task.Status.Phase, service.Namespace, service.Name, gc.kubeClient.CoreV1().Services(service.Namespace).Delete); err != nil {
+				nlog.Warn(err)
+				errs++
+			}
+		}
+	}
+
+	configMaps, _ := gc.configMapLister.List(selector)
+	for _, configMap := range configMaps {
+		if task, policy := gc.finishedTask(configMap.Annotations[common.TaskIDAnnotationKey], configMap.Labels[common.LabelTaskUID]); task != nil &&
+			expired(policy.ConfigMapTTL(), task.Status.CompletionTime.Time, now) {
+			if err := gc.delete(ctx, gcKindConfigMap, task.Status.Phase, configMap.Namespace, configMap.Name, gc.kubeClient.CoreV1().ConfigMaps(configMap.Namespace).Delete); err != nil {
+				nlog.Warn(err)
+				errs++
+			}
+		}
+	}
+
+	trgs, _ := gc.trgLister.List(selector)
+	for _, trg := range trgs {
+		if task, policy := gc.finishedTask(trg.Name, trg.Labels[common.LabelTaskUID]); task != nil &&
+			expired(policy.TaskResourceTTL(), task.Status.CompletionTime.Time, now) {
+			if err := gc.deleteTaskResourceGroup(ctx, trg, task.Status.Phase); err != nil {
+				nlog.Warn(err)
+				errs++
+			}
+		}
+	}
+
+	nlog.Infof("Finish collecting the expired resources of the finished kusciaTasks (%v), %d errors", time.Since(startTime), errs)
+}
+
+// finishedTask returns the finished task of the resource and the gc policy of the phase, or nil if the task is
+// running or deleted.
+func (gc *GarbageCollector) finishedTask(taskID, taskUID string) (*kusciaapisv1alpha1.KusciaTask, *kusciaconfig.TaskGCPolicy) {
+	if taskID == "" {
+		return nil, nil
+	}
+	task, err := gc.kusciaTaskLister.KusciaTasks(common.KusciaCrossDomain).Get(taskID)
+	if err != nil || string(task.UID) != taskUID || task.Status.CompletionTime == nil {
+		return nil, nil
+	}
+	policy := gc.policy(task.Status.Phase)
+	if policy == nil {
+		return nil, nil
+	}
+	return task, policy
+}
+
+func (gc *GarbageCollector) policy(phase kusciaapisv1alpha1.KusciaTaskPhase) *kusciaconfig.TaskGCPolicy {
+	switch phase {
+	case kusciaapisv1alpha1.TaskSucceeded:
+		return &gc.conf.Succeeded
+	case kusciaapisv1alpha1.TaskFailed:
+		return &gc.conf.Failed
+	default:
+		return nil
+	}
+}
+
+// deleteTaskResourceGroup deletes the task resources before the task resource group, since the task resources of
+// the parties in other clusters may not be collected by the owner references.
+func (gc *GarbageCollector) deleteTaskResourceGroup(ctx context.Context, trg *kusciaapisv1alpha1.TaskResourceGroup, phase kusciaapisv1alpha1.KusciaTaskPhase) error {
+	for _, party := range trg.Spec.Parties {
+		if party.TaskResourceName == "" {
+			continue
+		}
+		if err := gc.delete(ctx, gcKindTaskResource, phase, party.DomainID, party.TaskResourceName,
+			gc.kusciaClient.KusciaV1alpha1().TaskResources(party.DomainID).Delete); err != nil {
+			return err
+		}
+	}
+	return gc.delete(ctx, gcKindTaskResourceGroup, phase, "", trg.Name, gc.kusciaClient.KusciaV1alpha1().TaskResourceGroups().Delete)
+}
+
+func (gc *GarbageCollector) delete(ctx context.Context, kind string, phase kusciaapisv1alpha1.KusciaTaskPhase, namespace, name string, del deleteFunc) error {
+	if err := del(ctx, name, metav1.DeleteOptions{}); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		metrics.GCErrors.WithLabelValues(kind).Inc()
+		return fmt.Errorf("failed to delete %s %s/%s, %v", kind, namespace, name, err)
+	}
+	metrics.GCDeletedResources.WithLabelValues(kind, string(phase)).Inc()
+	nlog.Debugf("Delete the %s %s/%s of the %s kusciaTask successfully", kind, namespace, name, phase)
+	return nil
+}
+
+// expired returns whether the ttl passed since the task finished, the negative ttl never expires.
+func expired(ttl time.Duration, completion, now time.Time) bool {
+	return ttl >= 0 && !now.Before(completion.Add(ttl))
+}
+
+func podFinished(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed
+}
@@ -0,0 +1,185 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+type gcFixture struct {
+	gc           *GarbageCollector
+	kubeClient   *kubefake.Clientset
+	kusciaClient *kusciafake.Clientset
+}
+
+func newGCFixture(t *testing.T, conf *kusciaconfig.TaskGCConfig, task *kusciaapisv1alpha1.KusciaTask) *gcFixture {
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace:   "alice",
+			Name:        name,
+			Labels:      map[string]string{common.LabelTaskUID: string(task.UID)},
+			Annotations: map[string]string{common.TaskIDAnnotationKey: task.Name},
+		}
+	}
+	runningPod := &v1.Pod{ObjectMeta: meta("pod-01"), Status: v1.PodStatus{Phase: v1.PodRunning}}
+	failedPod := &v1.Pod{ObjectMeta: meta("pod-02"), Status: v1.PodStatus{Phase: v1.PodFailed}}
+	service := &v1.Service{ObjectMeta: meta("svc-01")}
+	configMap := &v1.ConfigMap{ObjectMeta: meta("cm-01")}
+	trg := &kusciaapisv1alpha1.TaskResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: task.Name, Labels: map[string]string{common.LabelTaskUID: string(task.UID)}},
+		Spec: kusciaapisv1alpha1.TaskResourceGroupSpec{
+			Parties: []kusciaapisv1alpha1.TaskResourceGroupParty{{DomainID: "alice", TaskResourceName: "tr-01"}},
+		},
+	}
+	tr := &kusciaapisv1alpha1.TaskResource{ObjectMeta: metav1.ObjectMeta{Namespace: "alice", Name: "tr-01"}}
+
+	kubeClient := kubefake.NewSimpleClientset(runningPod, failedPod, service, configMap)
+	kusciaClient := kusciafake.NewSimpleClientset(task, trg, tr)
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	kusciaInformerFactory := kusciainformers.NewSharedInformerFactory(kusciaClient, 0)
+	podInformer := kubeInformerFactory.Core().V1().Pods()
+	serviceInformer := kubeInformerFactory.Core().V1().Services()
+	configMapInformer := kubeInformerFactory.Core().V1().ConfigMaps()
+	trgInformer := kusciaInformerFactory.Kuscia().V1alpha1().TaskResourceGroups()
+	taskInformer := kusciaInformerFactory.Kuscia().V1alpha1().KusciaTasks()
+	assert.NoError(t, podInformer.Informer().GetStore().Add(runningPod))
+	assert.NoError(t, podInformer.Informer().GetStore().Add(failedPod))
+	assert.NoError(t, serviceInformer.Informer().GetStore().Add(service))
+	assert.NoError(t, configMapInformer.Informer().GetStore().Add(configMap))
+	assert.NoError(t, trgInformer.Informer().GetStore().Add(trg))
+	assert.NoError(t, taskInformer.Informer().GetStore().Add(task))
+
+	return &gcFixture{
+		gc: NewGarbageCollector(&Dependencies{
+			KubeClient:       kubeClient,
+			KusciaClient:     kusciaClient,
+			PodsLister:       podInformer.Lister(),
+			ServicesLister:   serviceInformer.Lister(),
+			ConfigMapLister:  configMapInformer.Lister(),
+			TrgLister:        trgInformer.Lister(),
+			KusciaTaskLister: taskInformer.Lister(),
+			TaskGC:           conf,
+		}),
+		kubeClient:   kubeClient,
+		kusciaClient: kusciaClient,
+	}
+}
+
+func (f *gcFixture) exists(t *testing.T) map[string]bool {
+	ctx := context.Background()
+	check := func(err error) bool {
+		if err != nil {
+			assert.True(t, errors.IsNotFound(err))
+			return false
+		}
+		return true
+	}
+	_, runningPodErr := f.kubeClient.CoreV1().Pods("alice").Get(ctx, "pod-01", metav1.GetOptions{})
+	_, failedPodErr := f.kubeClient.CoreV1().Pods("alice").Get(ctx, "pod-02", metav1.GetOptions{})
+	_, serviceErr := f.kubeClient.CoreV1().Services("alice").Get(ctx, "svc-01", metav1.GetOptions{})
+	_, configMapErr := f.kubeClient.CoreV1().ConfigMaps("alice").Get(ctx, "cm-01", metav1.GetOptions{})
+	_, trErr := f.kusciaClient.KusciaV1alpha1().TaskResources("alice").Get(ctx, "tr-01", metav1.GetOptions{})
+	_, trgErr := f.kusciaClient.KusciaV1alpha1().TaskResourceGroups().Get(ctx, "task-01", metav1.GetOptions{})
+	return map[string]bool{
+		"runningPod":        check(runningPodErr),
+		"failedPod":         check(failedPodErr),
+		"service":           check(serviceErr),
+		"configMap":         check(configMapErr),
+		"taskResource":      check(trErr),
+		"taskResourceGroup": check(trgErr),
+	}
+}
+
+func newGCTask(phase kusciaapisv1alpha1.KusciaTaskPhase, completion *time.Time) *kusciaapisv1alpha1.KusciaTask {
+	task := &kusciaapisv1alpha1.KusciaTask{
+		ObjectMeta: metav1.ObjectMeta{Namespace: common.KusciaCrossDomain, Name: "task-01", UID: "111"},
+		Status:     kusciaapisv1alpha1.KusciaTaskStatus{Phase: phase},
+	}
+	if completion != nil {
+		task.Status.CompletionTime = &metav1.Time{Time: *completion}
+	}
+	return task
+}
+
+func TestGarbageCollector_CollectTask(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	conf := &kusciaconfig.TaskGCConfig{
+		Enable: true,
+		Failed: kusciaconfig.TaskGCPolicy{PodTTLSeconds: 3600, ConfigMapTTLSeconds: 3600, TaskResourceTTLSeconds: -1},
+	}
+
+	// the succeeded resources with zero ttls are deleted when the task finishes
+	f := newGCFixture(t, conf, newGCTask(kusciaapisv1alpha1.TaskSucceeded, nil))
+	assert.NoError(t, f.gc.CollectTask(context.Background(), newGCTask(kusciaapisv1alpha1.TaskSucceeded, nil), now))
+	for name, exists := range f.exists(t) {
+		assert.False(t, exists, name)
+	}
+
+	// only the running pods of the failed task are deleted when the task finishes
+	f = newGCFixture(t, conf, newGCTask(kusciaapisv1alpha1.TaskFailed, nil))
+	assert.NoError(t, f.gc.CollectTask(context.Background(), newGCTask(kusciaapisv1alpha1.TaskFailed, nil), now))
+	assert.Equal(t, map[string]bool{
+		"runningPod":        false,
+		"failedPod":         true,
+		"service":           true,
+		"configMap":         true,
+		"taskResource":      true,
+		"taskResourceGroup": true,
+	}, f.exists(t))
+}
+
+func TestGarbageCollector_Collect(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	completion := now.Add(-2 * time.Hour)
+	conf := &kusciaconfig.TaskGCConfig{
+		Enable: true,
+		Failed: kusciaconfig.TaskGCPolicy{PodTTLSeconds: 3600, ConfigMapTTLSeconds: 3 * 3600, TaskResourceTTLSeconds: -1},
+	}
+
+	f := newGCFixture(t, conf, newGCTask(kusciaapisv1alpha1.TaskFailed, &completion))
+	f.gc.Collect(context.Background(), now)
+	assert.Equal(t, map[string]bool{
+		"runningPod":        false,
+		"failedPod":         false,
+		"service":           false,
+		"configMap":         true,
+		"taskResource":      true,
+		"taskResourceGroup": true,
+	}, f.exists(t))
+
+	// the resources of the running tasks are kept
+	f = newGCFixture(t, conf, newGCTask(kusciaapisv1alpha1.TaskRunning, nil))
+	f.gc.Collect(context.Background(), now)
+	for name, exists := range f.exists(t) {
+		assert.True(t, exists, name)
+	}
+}
@@ -17,6 +17,7 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -26,6 +27,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
@@ -51,6 +53,9 @@ type PendingHandler struct {
 	servicesLister   corelisters.ServiceLister
 	configMapLister  corelisters.ConfigMapLister
 	appImagesLister  kuscialistersv1alpha1.AppImageLister
+	domainLister     kuscialistersv1alpha1.DomainLister
+	domainDataLister kuscialistersv1alpha1.DomainDataLister
+	grantLister      kuscialistersv1alpha1.DomainDataGrantLister
 }
 
 type NamedPorts map[string]kusciaapisv1alpha1.ContainerPort
@@ -72,6 +77,11 @@ type PartyKitInfo struct {
 	role                  string
 	image                 string
 	imageID               string
+	registryCredentialKey string
+	placementStrategy     kusciaapisv1alpha1.PlacementStrategy
+	sandbox               kusciaapisv1alpha1.SandboxType
+	allowRoot             bool
+	resumeFromCheckpoint  string
 	deployTemplate        *kusciaapisv1alpha1.DeployTemplate
 	configTemplatesCMName string
 	configTemplates       map[string]string
@@ -93,6 +103,9 @@ func NewPendingHandler(deps *Dependencies) *PendingHandler {
 		servicesLister:   deps.ServicesLister,
 		configMapLister:  deps.ConfigMapLister,
 		appImagesLister:  deps.AppImagesLister,
+		domainLister:     deps.DomainLister,
+		domainDataLister: deps.DomainDataLister,
+		grantLister:      deps.DomainDataGrantLister,
 	}
 }
 
@@ -344,6 +357,19 @@ func generateMessageBy(trg *kusciaapisv1alpha1.TaskResourceGroup) string {
 	return message
 }
 
+// getImageRegistryCredentialKey returns the registry credential of the app image or the domain, the domain is optional
+// since the credential of the domain is only a default one.
+func (h *PendingHandler) getImageRegistryCredentialKey(appImage *kusciaapisv1alpha1.AppImage, domainID string) string {
+	var domain *kusciaapisv1alpha1.Domain
+	if h.domainLister != nil {
+		var err error
+		if domain, err = h.domainLister.Get(domainID); err != nil {
+			nlog.Warnf("Failed to get domain %q from cache, skip its image registry credential, %v", domainID, err)
+		}
+	}
+	return utilsres.GetImageRegistryCredentialKey(appImage, domain)
+}
+
 func (h *PendingHandler) buildPartyKitInfo(kusciaTask *kusciaapisv1alpha1.KusciaTask, party *kusciaapisv1alpha1.PartyInfo) (*PartyKitInfo, error) {
 	kit := &PartyKitInfo{
 		kusciaTask: kusciaTask,
@@ -370,6 +396,12 @@ func (h *PendingHandler) buildPartyKitInfo(kusciaTask *kusciaapisv1alpha1.Kuscia
 	}
 
 	deployTemplate := mergeDeployTemplate(baseDeployTemplate, &party.Template)
+	if err := validateSandbox(appImage.Spec.Sandbox, deployTemplate); err != nil {
+		return nil, fmt.Errorf("invalid sandbox of appImage %q for party %v/%v, %v", appImage.Name, party.DomainID, party.Role, err)
+	}
+	if err := h.checkGrantedComponent(kusciaTask, party.DomainID); err != nil {
+		return nil, err
+	}
 
 	replicas := 1
 	if deployTemplate.Replicas != nil {
@@ -404,6 +436,14 @@ func (h *PendingHandler) buildPartyKitInfo(kusciaTask *kusciaapisv1alpha1.Kuscia
 
 	kit.image = fmt.Sprintf("%s:%s", appImage.Spec.Image.Name, appImage.Spec.Image.Tag)
 	kit.imageID = appImage.Spec.Image.ID
+	kit.registryCredentialKey = h.getImageRegistryCredentialKey(appImage, party.DomainID)
+	kit.placementStrategy = kusciaTask.Spec.ScheduleConfig.PlacementStrategy
+	if kit.placementStrategy == "" {
+		kit.placementStrategy = appImage.Spec.PlacementStrategy
+	}
+	kit.sandbox = appImage.Spec.Sandbox
+	kit.allowRoot = appImage.Annotations[common.AllowRootAnnotationKey] == "true"
+	kit.resumeFromCheckpoint = party.ResumeFromCheckpoint
 	kit.deployTemplate = deployTemplate
 	kit.configTemplates = appImage.Spec.ConfigTemplates
 	kit.servicedPorts = servicedPorts
@@ -482,6 +522,106 @@ func mergeDeployTemplate(baseTemplate *kusciaapisv1alpha1.DeployTemplate, partyT
 	return template
 }
 
+// validateSandbox checks the sandbox type, and that the containers running in the sandbox don't ask for the privileges
+// which break out of it.
+func validateSandbox(sandbox kusciaapisv1alpha1.SandboxType, template *kusciaapisv1alpha1.DeployTemplate) error {
+	switch sandbox {
+	case "":
+		return nil
+	case kusciaapisv1alpha1.SandboxGVisor, kusciaapisv1alpha1.SandboxKata:
+	default:
+		return fmt.Errorf("unknown sandbox type %q", sandbox)
+	}
+
+	var containers []kusciaapisv1alpha1.Container
+	containers = append(containers, template.Spec.InitContainers...)
+	containers = append(containers, template.Spec.Containers...)
+	containers = append(containers, template.Spec.Sidecars...)
+	for _, ctr := range containers {
+		sc := ctr.SecurityContext
+		if sc == nil {
+			continue
+		}
+		if sc.Privileged != nil && *sc.Privileged {
+			return fmt.Errorf("container %q in sandbox %s can't be privileged", ctr.Name, sandbox)
+		}
+		if sc.AllowPrivilegeEscalation != nil && *sc.AllowPrivilegeEscalation {
+			return fmt.Errorf("container %q in sandbox %s can't allow privilege escalation", ctr.Name, sandbox)
+		}
+		if sc.Capabilities != nil && len(sc.Capabilities.Add) > 0 {
+			return fmt.Errorf("container %q in sandbox %s can't add capabilities", ctr.Name, sandbox)
+		}
+	}
+	return nil
+}
+
+// taskComponent is the SecretFlow component run by the task.
+type taskComponent struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// checkGrantedComponent checks that the input domain data granted to the party allow the component of the task.
+// The input domain data not in the party are skipped, because they belong to the parties of other clusters.
+func (h *PendingHandler) checkGrantedComponent(kusciaTask *kusciaapisv1alpha1.KusciaTask, domainID string) error {
+	dataIDs := utilsres.TaskInputDomainDataIDs(kusciaTask.Spec.TaskInputConfig)
+	if len(dataIDs) == 0 {
+		return nil
+	}
+	inputs := map[string]json.RawMessage{}
+	_ = json.Unmarshal([]byte(kusciaTask.Spec.TaskInputConfig), &inputs)
+	var component taskComponent
+	if raw, ok := inputs[taskComponentKey]; ok {
+		_ = json.Unmarshal(raw, &component)
+	}
+
+	for _, id := range dataIDs {
+		data, err := h.domainDataLister.DomainDatas(domainID).Get(id)
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get domain data %s/%s from cache, %v", domainID, id, err)
+		}
+		if data.Spec.Author == "" || data.Spec.Author == domainID {
+			continue
+		}
+		grants, err := h.grantLister.DomainDataGrants(domainID).List(labels.Everything())
+		if err != nil {
+			return fmt.Errorf("failed to list domain data grants of %s from cache, %v", domainID, err)
+		}
+		for _, grant := range grants {
+			if grant.Spec.DomainDataID != id || grant.Spec.GrantDomain != domainID || grant.Status.Phase != kusciaapisv1alpha1.GrantReady {
+				continue
+			}
+			if limit := grant.Spec.Limit; limit != nil && !componentAllowed(limit.AllowedComponents, component) {
+				return fmt.Errorf("component %q version %q is not allowed by grant %s of domain data %s/%s", component.Name, component.Version, grant.Name, domainID, id)
+			}
+		}
+	}
+	return nil
+}
+
+func componentAllowed(allowed []kusciaapisv1alpha1.AllowedComponent, component taskComponent) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, c := range allowed {
+		if c.Name != component.Name {
+			continue
+		}
+		if len(c.Versions) == 0 {
+			return true
+		}
+		for _, v := range c.Versions {
+			if v == component.Version {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func mergeContainersPorts(containers []kusciaapisv1alpha1.Container) (NamedPorts, error) {
 	ports := NamedPorts{}
 	for _, container := range containers {
@@ -829,9 +969,10 @@ func (h *PendingHandler) createTaskResourceGroup(kusciaTask *kusciaapisv1alpha1.
 // and adjust trg.Spec.MinReservedMembers by minus the amount of out of controlled parties
 func (h *PendingHandler) generateTaskResourceGroup(kusciaTask *kusciaapisv1alpha1.KusciaTask, partyKitInfos map[string]*PartyKitInfo) (*kusciaapisv1alpha1.TaskResourceGroup, error) {
 	var (
-		resourceReservedSeconds = defaultResourceReservedSeconds
-		lifeCycleSeconds        = defaultLifecycleSeconds
-		retryIntervalSeconds    = defaultRetryIntervalSeconds
+		resourceReservedSeconds   = defaultResourceReservedSeconds
+		lifeCycleSeconds          = defaultLifecycleSeconds
+		retryIntervalSeconds      = defaultRetryIntervalSeconds
+		reservationTimeoutSeconds = defaultReservationTimeoutSeconds
 	)
 
 	if kusciaTask.Spec.ScheduleConfig.ResourceReservedSeconds > 0 {
@@ -846,6 +987,10 @@ func (h *PendingHandler) generateTaskResourceGroup(kusciaTask *kusciaapisv1alpha
 		retryIntervalSeconds = kusciaTask.Spec.ScheduleConfig.RetryIntervalSeconds
 	}
 
+	if kusciaTask.Spec.ScheduleConfig.ReservationTimeoutSeconds > 0 {
+		reservationTimeoutSeconds = kusciaTask.Spec.ScheduleConfig.ReservationTimeoutSeconds
+	}
+
 	var trgParties, outOfControlledParties []kusciaapisv1alpha1.TaskResourceGroupParty
 	for _, partyKitInfo := range partyKitInfos {
 		isPartner, err := utilsres.IsPartnerDomain(h.namespacesLister, partyKitInfo.domainID)
@@ -913,13 +1058,15 @@ func (h *PendingHandler) generateTaskResourceGroup(kusciaTask *kusciaapisv1alpha
 			},
 		},
 		Spec: kusciaapisv1alpha1.TaskResourceGroupSpec{
-			MinReservedMembers:      minReservedMembers,
-			ResourceReservedSeconds: resourceReservedSeconds,
-			LifecycleSeconds:        lifeCycleSeconds,
-			RetryIntervalSeconds:    retryIntervalSeconds,
-			Initiator:               kusciaTask.Spec.Initiator,
-			Parties:                 trgParties,
-			OutOfControlledParties:  outOfControlledParties,
+			MinReservedMembers:        minReservedMembers,
+			ResourceReservedSeconds:   resourceReservedSeconds,
+			LifecycleSeconds:          lifeCycleSeconds,
+			RetryIntervalSeconds:      retryIntervalSeconds,
+			ReservationTimeoutSeconds: reservationTimeoutSeconds,
+			MaxReserveAttempts:        kusciaTask.Spec.ScheduleConfig.MaxReserveAttempts,
+			Initiator:                 kusciaTask.Spec.Initiator,
+			Parties:                   trgParties,
+			OutOfControlledParties:    outOfControlledParties,
 		},
 	}
 
@@ -999,6 +1146,14 @@ func generateKusciaConfigMap(partyKit *PartyKitInfo, podKit *PodKitInfo) *v1.Con
 		annotations[common.ConfigValueCompressFieldsNameAnnotationKey] = utilcom.SliceToAnnotationString([]string{common.EnvTaskInputConfig})
 	}
 
+	// the sensitive input config is kept encrypted, and only decrypted by the agent of the party
+	for _, sensitive := range partyKit.kusciaTask.Spec.SensitiveInputConfigs {
+		if sensitive.DomainID == partyKit.domainID {
+			confMap[common.EnvTaskSensitiveInputConfig] = sensitive.EncryptedConfig
+			break
+		}
+	}
+
 	confMapName := fmt.Sprintf(common.KusciaGenerateConfigMapFormat, partyKit.kusciaTask.Name) // kuscia-generate-conf
 	return &v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1034,6 +1189,7 @@ func (h *PendingHandler) generatePod(partyKit *PartyKitInfo, podKit *PodKitInfo)
 		labelKusciaTaskPodIdentity:          podKit.podIdentity,
 		kusciaapisv1alpha1.TaskResourceUID:  "",
 		common.LabelTaskUID:                 string(partyKit.kusciaTask.UID),
+		common.LabelTaskID:                  partyKit.kusciaTask.Name,
 		labelKusciaTaskPodRole:              partyKit.role,
 	}
 
@@ -1053,6 +1209,11 @@ func (h *PendingHandler) generatePod(partyKit *PartyKitInfo, podKit *PodKitInfo)
 		labels[common.LabelInterConnProtocolType] = protocolType
 	}
 
+	// the pods of the same job are put in the same network isolation group by agent
+	if jobUID := partyKit.kusciaTask.Labels[common.LabelJobUID]; jobUID != "" {
+		labels[common.LabelJobUID] = jobUID
+	}
+
 	restartPolicy := v1.RestartPolicyNever
 	if partyKit.deployTemplate.Spec.RestartPolicy != "" {
 		restartPolicy = partyKit.deployTemplate.Spec.RestartPolicy
@@ -1090,6 +1251,7 @@ func (h *PendingHandler) generatePod(partyKit *PartyKitInfo, podKit *PodKitInfo)
 			},
 			SchedulerName:                schedulerName,
 			AutomountServiceAccountToken: &automountServiceAccountToken,
+			Affinity:                     buildPodAffinity(partyKit.kusciaTask.Spec.Affinity),
 		},
 	}
 	if pod.Annotations == nil {
@@ -1098,12 +1260,21 @@ func (h *PendingHandler) generatePod(partyKit *PartyKitInfo, podKit *PodKitInfo)
 	if partyKit.imageID != "" {
 		pod.Annotations[common.ImageIDAnnotationKey] = partyKit.imageID
 	}
+	if partyKit.registryCredentialKey != "" {
+		pod.Annotations[common.ImageRegistryCredentialKeyAnnotationKey] = partyKit.registryCredentialKey
+	}
+	if partyKit.placementStrategy != "" {
+		pod.Annotations[common.PlacementStrategyAnnotationKey] = string(partyKit.placementStrategy)
+	}
+	if partyKit.sandbox != "" {
+		pod.Annotations[common.SandboxAnnotationKey] = string(partyKit.sandbox)
+	}
+	if partyKit.allowRoot {
+		pod.Annotations[common.AllowRootAnnotationKey] = "true"
+	}
 
 	needConfigTemplateVolume := false
 	for _, ctr := range partyKit.deployTemplate.Spec.Containers {
-		if ctr.ImagePullPolicy == "" {
-			ctr.ImagePullPolicy = v1.PullIfNotPresent
-		}
 		if ctr.MetricProbe != nil {
 			metricPath := ctr.MetricProbe.Path
 			metricPortName := ctr.MetricProbe.Port
@@ -1118,55 +1289,52 @@ func (h *PendingHandler) generatePod(partyKit *PartyKitInfo, podKit *PodKitInfo)
 			}
 		}
 
-		resCtr := v1.Container{
-			Name:                     ctr.Name,
-			Image:                    partyKit.image,
-			Command:                  ctr.Command,
-			Args:                     ctr.Args,
-			WorkingDir:               ctr.WorkingDir,
-			Env:                      ctr.Env,
-			Ports:                    []v1.ContainerPort{},
-			EnvFrom:                  ctr.EnvFrom,
-			Resources:                ctr.Resources,
-			LivenessProbe:            ctr.LivenessProbe,
-			ReadinessProbe:           ctr.ReadinessProbe,
-			StartupProbe:             ctr.StartupProbe,
-			ImagePullPolicy:          ctr.ImagePullPolicy,
-			SecurityContext:          ctr.SecurityContext,
-			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
-		}
-
-		for _, port := range ctr.Ports {
-			namedPort, ok := podKit.ports[port.Name]
-			if !ok {
-				return nil, fmt.Errorf("port %s is not allocated for pod %s", port.Name, pod.Name)
-			}
-			resPort := v1.ContainerPort{
-				Name:          port.Name,
-				ContainerPort: namedPort.Port,
-				Protocol:      v1.ProtocolTCP,
-			}
-
-			resCtr.Ports = append(resCtr.Ports, resPort)
+		resCtr, needConfig, err := generateContainer(partyKit, podKit, &ctr)
+		if err != nil {
+			return nil, err
 		}
+		needConfigTemplateVolume = needConfigTemplateVolume || needConfig
+		pod.Spec.Containers = append(pod.Spec.Containers, resCtr)
+	}
 
-		portNumberEnvs := buildPortNumberEnvs(podKit.allocatedPorts) // todo : remove it , now scql use it ,20240829
-		if len(portNumberEnvs) > 0 {
-			resCtr.Env = append(resCtr.Env, portNumberEnvs...)
+	for _, ctr := range partyKit.deployTemplate.Spec.InitContainers {
+		resCtr, needConfig, err := generateContainer(partyKit, podKit, &ctr)
+		if err != nil {
+			return nil, err
 		}
+		needConfigTemplateVolume = needConfigTemplateVolume || needConfig
+		pod.Spec.InitContainers = append(pod.Spec.InitContainers, resCtr)
+	}
 
-		if len(ctr.ConfigVolumeMounts) > 0 && partyKit.configTemplatesCMName != "" {
-			needConfigTemplateVolume = true
-			for _, vm := range ctr.ConfigVolumeMounts {
-				resCtr.VolumeMounts = append(resCtr.VolumeMounts, v1.VolumeMount{
-					Name:      configTemplateVolumeName,
-					MountPath: vm.MountPath,
-					SubPath:   vm.SubPath,
-				})
-			}
+	// k3s doesn't support sidecar containers, so the sidecars are appended to the containers, and the runk
+	// provider projects them into the sidecar containers of the backend pod by the annotation.
+	var sidecars []string
+	for _, ctr := range partyKit.deployTemplate.Spec.Sidecars {
+		resCtr, needConfig, err := generateContainer(partyKit, podKit, &ctr)
+		if err != nil {
+			return nil, err
 		}
-
+		needConfigTemplateVolume = needConfigTemplateVolume || needConfig
 		pod.Spec.Containers = append(pod.Spec.Containers, resCtr)
+		sidecars = append(sidecars, resCtr.Name)
+	}
+	if len(sidecars) > 0 {
+		pod.Annotations[common.SidecarContainersAnnotationKey] = strings.Join(sidecars, ",")
+	}
+
+	volumes, volumeAnnotations, err := generateVolumes(podKit.podName, partyKit.deployTemplate.Spec.Volumes)
+	if err != nil {
+		return nil, err
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, volumes...)
+	for k, v := range volumeAnnotations {
+		pod.Annotations[k] = v
+	}
+	if checkpointVolume := partyKit.deployTemplate.Spec.CheckpointVolume; checkpointVolume != "" {
+		if !hasVolume(volumes, checkpointVolume) {
+			return nil, fmt.Errorf("checkpoint volume %s is not defined in the deploy template of pod %s", checkpointVolume, podKit.podName)
+		}
+		pod.Annotations[common.CheckpointVolumeAnnotationKey] = checkpointVolume
 	}
 
 	if needConfigTemplateVolume {
@@ -1197,6 +1365,200 @@ func (h *PendingHandler) generatePod(partyKit *PartyKitInfo, podKit *PodKitInfo)
 	return pod, nil
 }
 
+// buildPodAffinity translates the task affinity into the pod affinity terms on the node, one term for each task.
+func buildPodAffinity(affinity *kusciaapisv1alpha1.TaskAffinity) *v1.Affinity {
+	if affinity == nil || (len(affinity.ColocateWith) == 0 && len(affinity.SeparateFrom) == 0) {
+		return nil
+	}
+
+	buildTerms := func(taskIDs []string) []v1.PodAffinityTerm {
+		var terms []v1.PodAffinityTerm
+		for _, taskID := range taskIDs {
+			terms = append(terms, v1.PodAffinityTerm{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{common.LabelTaskID: taskID},
+				},
+				TopologyKey: v1.LabelHostname,
+			})
+		}
+		return terms
+	}
+	buildWeightedTerms := func(terms []v1.PodAffinityTerm) []v1.WeightedPodAffinityTerm {
+		var weightedTerms []v1.WeightedPodAffinityTerm
+		for _, term := range terms {
+			weightedTerms = append(weightedTerms, v1.WeightedPodAffinityTerm{Weight: 100, PodAffinityTerm: term})
+		}
+		return weightedTerms
+	}
+
+	result := &v1.Affinity{}
+	colocateTerms := buildTerms(affinity.ColocateWith)
+	separateTerms := buildTerms(affinity.SeparateFrom)
+	if len(colocateTerms) > 0 {
+		result.PodAffinity = &v1.PodAffinity{}
+		if affinity.Preferred {
+			result.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution = buildWeightedTerms(colocateTerms)
+		} else {
+			result.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = colocateTerms
+		}
+	}
+	if len(separateTerms) > 0 {
+		result.PodAntiAffinity = &v1.PodAntiAffinity{}
+		if affinity.Preferred {
+			result.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = buildWeightedTerms(separateTerms)
+		} else {
+			result.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = separateTerms
+		}
+	}
+	return result
+}
+
+// generateVolumes converts the volumes of the deploy template. The persistent volume claims and the host directories
+// are named after the pod, and the agent materializes them from the returned annotations.
+func generateVolumes(podName string, templateVolumes []kusciaapisv1alpha1.Volume) ([]v1.Volume, map[string]string, error) {
+	var (
+		volumes        []v1.Volume
+		hostDirs       []string
+		claimTemplates = map[string]v1.PersistentVolumeClaimSpec{}
+	)
+	for _, volume := range templateVolumes {
+		source := v1.VolumeSource{EmptyDir: volume.EmptyDir}
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claimName := fmt.Sprintf("%s-%s", podName, volume.Name)
+			accessModes := volume.PersistentVolumeClaim.AccessModes
+			if len(accessModes) == 0 {
+				accessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+			}
+			claimTemplates[claimName] = v1.PersistentVolumeClaimSpec{
+				AccessModes:      accessModes,
+				StorageClassName: volume.PersistentVolumeClaim.StorageClassName,
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceStorage: volume.PersistentVolumeClaim.Storage},
+				},
+			}
+			source = v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claimName}}
+		case volume.HostDirectory != nil:
+			hostDirs = append(hostDirs, volume.Name)
+			source = v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{SizeLimit: volume.HostDirectory.SizeLimit}}
+		}
+		volumes = append(volumes, v1.Volume{Name: volume.Name, VolumeSource: source})
+	}
+
+	annotations := map[string]string{}
+	if len(claimTemplates) > 0 {
+		data, err := json.Marshal(claimTemplates)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal volume claim templates of pod %s, %v", podName, err)
+		}
+		annotations[common.VolumeClaimTemplatesAnnotationKey] = string(data)
+	}
+	if len(hostDirs) > 0 {
+		annotations[common.HostDirectoryVolumesAnnotationKey] = strings.Join(hostDirs, ",")
+	}
+	return volumes, annotations, nil
+}
+
+// generateContainer converts the container of the deploy template, and reports whether the container mounts the
+// config template volume.
+func generateContainer(partyKit *PartyKitInfo, podKit *PodKitInfo, ctr *kusciaapisv1alpha1.Container) (v1.Container, bool, error) {
+	image := ctr.Image
+	if image == "" {
+		image = partyKit.image
+	}
+	imagePullPolicy := ctr.ImagePullPolicy
+	if imagePullPolicy == "" {
+		imagePullPolicy = v1.PullIfNotPresent
+	}
+
+	resCtr := v1.Container{
+		Name:                     ctr.Name,
+		Image:                    image,
+		Command:                  ctr.Command,
+		Args:                     ctr.Args,
+		WorkingDir:               ctr.WorkingDir,
+		Env:                      ctr.Env,
+		Ports:                    []v1.ContainerPort{},
+		EnvFrom:                  ctr.EnvFrom,
+		Resources:                ctr.Resources,
+		LivenessProbe:            ctr.LivenessProbe,
+		ReadinessProbe:           ctr.ReadinessProbe,
+		StartupProbe:             ctr.StartupProbe,
+		ImagePullPolicy:          imagePullPolicy,
+		SecurityContext:          ctr.SecurityContext,
+		TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+	}
+
+	for _, port := range ctr.Ports {
+		namedPort, ok := podKit.ports[port.Name]
+		if !ok {
+			return resCtr, false, fmt.Errorf("port %s is not allocated for pod %s", port.Name, podKit.podName)
+		}
+		resPort := v1.ContainerPort{
+			Name:          port.Name,
+			ContainerPort: namedPort.Port,
+			Protocol:      v1.ProtocolTCP,
+		}
+
+		resCtr.Ports = append(resCtr.Ports, resPort)
+	}
+
+	portNumberEnvs := buildPortNumberEnvs(podKit.allocatedPorts) // todo : remove it , now scql use it ,20240829
+	if len(portNumberEnvs) > 0 {
+		resCtr.Env = append(resCtr.Env, portNumberEnvs...)
+	}
+
+	// copy the volume mounts, the deploy template is shared by the pods of the party
+	resCtr.VolumeMounts = append(resCtr.VolumeMounts, ctr.VolumeMounts...)
+	if checkpointVolume := partyKit.deployTemplate.Spec.CheckpointVolume; checkpointVolume != "" {
+		resCtr.VolumeMounts, resCtr.Env = mountCheckpointVolume(resCtr.VolumeMounts, resCtr.Env, checkpointVolume, partyKit.resumeFromCheckpoint)
+	}
+	needConfigTemplateVolume := false
+	if len(ctr.ConfigVolumeMounts) > 0 && partyKit.configTemplatesCMName != "" {
+		needConfigTemplateVolume = true
+		for _, vm := range ctr.ConfigVolumeMounts {
+			resCtr.VolumeMounts = append(resCtr.VolumeMounts, v1.VolumeMount{
+				Name:      configTemplateVolumeName,
+				MountPath: vm.MountPath,
+				SubPath:   vm.SubPath,
+			})
+		}
+	}
+
+	return resCtr, needConfigTemplateVolume, nil
+}
+
+func hasVolume(volumes []v1.Volume, name string) bool {
+	for _, volume := range volumes {
+		if volume.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// mountCheckpointVolume mounts the checkpoint volume at the well-known path unless the container mounts it already,
+// and tells the engine where to write the checkpoints and which checkpoint to resume from.
+func mountCheckpointVolume(mounts []v1.VolumeMount, envs []v1.EnvVar, volumeName, resumeFrom string) ([]v1.VolumeMount, []v1.EnvVar) {
+	mountPath := ""
+	for _, mount := range mounts {
+		if mount.Name == volumeName && mount.SubPath == "" {
+			mountPath = mount.MountPath
+			break
+		}
+	}
+	if mountPath == "" {
+		mountPath = common.CheckpointMountPath
+		mounts = append(mounts, v1.VolumeMount{Name: volumeName, MountPath: mountPath})
+	}
+
+	envs = append(append([]v1.EnvVar{}, envs...), v1.EnvVar{Name: common.EnvCheckpointDir, Value: mountPath})
+	if resumeFrom != "" {
+		envs = append(envs, v1.EnvVar{Name: common.EnvResumeFromCheckpoint, Value: resumeFrom})
+	}
+	return mounts, envs
+}
+
 func buildPortNumberEnvs(allocatedPorts *proto.AllocatedPorts) []v1.EnvVar {
 	if allocatedPorts == nil {
 		return nil
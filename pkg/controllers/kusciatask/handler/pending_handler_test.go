@@ -16,6 +16,7 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -29,11 +30,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeinformers "k8s.io/client-go/informers"
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/yaml"
 
 	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
 	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	kuscialistersv1alpha1 "github.com/secretflow/kuscia/pkg/crd/listers/kuscia/v1alpha1"
 	utilsres "github.com/secretflow/kuscia/pkg/utils/resources"
 	proto "github.com/secretflow/kuscia/proto/api/v1alpha1/appconfig"
 )
@@ -71,6 +74,9 @@ func makeTestPendingHandler() *PendingHandler {
 		ServicesLister:   kubeInformersFactory.Core().V1().Services().Lister(),
 		ConfigMapLister:  kubeInformersFactory.Core().V1().ConfigMaps().Lister(),
 		AppImagesLister:  appImageInformer.Lister(),
+
+		DomainDataLister:      kusciaInformerFactory.Kuscia().V1alpha1().DomainDatas().Lister(),
+		DomainDataGrantLister: kusciaInformerFactory.Kuscia().V1alpha1().DomainDataGrants().Lister(),
 	}
 
 	return NewPendingHandler(dep)
@@ -332,6 +338,7 @@ metadata:
     kuscia.secretflow/pod-identity: ""
     kuscia.secretflow/task-resource-uid: ""
     kuscia.secretflow/task-uid: ""
+    kuscia.secretflow/task-id: kusciatask-001
     kuscia.secretflow/pod-role: server
   annotations:
     kuscia.secretflow/config-template-volumes: config-template
@@ -400,6 +407,211 @@ status: {}
 	assert.Equal(t, wantPod, pod)
 }
 
+func Test_generatePodWithSidecars(t *testing.T) {
+	t.Parallel()
+	deployTemplate := makeTestDeployTemplateCase1()
+	deployTemplate.Spec.InitContainers = []kusciaapisv1alpha1.Container{
+		{
+			Name:         "prefetch",
+			Image:        "prefetch-image:0.0.1",
+			Command:      []string{"prefetch"},
+			VolumeMounts: []v1.VolumeMount{{Name: "data", MountPath: "/data"}},
+		},
+	}
+	deployTemplate.Spec.Sidecars = []kusciaapisv1alpha1.Container{
+		{
+			Name:         "log-shipper",
+			Command:      []string{"ship"},
+			VolumeMounts: []v1.VolumeMount{{Name: "data", MountPath: "/data", ReadOnly: true}},
+		},
+	}
+	deployTemplate.Spec.Volumes = []kusciaapisv1alpha1.Volume{
+		{Name: "data", EmptyDir: &v1.EmptyDirVolumeSource{}},
+	}
+	partyKit := &PartyKitInfo{
+		kusciaTask:        makeTestKusciaTaskCase1(),
+		domainID:          "domain-a",
+		role:              "server",
+		image:             "test-image:0.0.1",
+		placementStrategy: kusciaapisv1alpha1.PlacementStrategyBinpack,
+		deployTemplate:    deployTemplate,
+		pods: []*PodKitInfo{
+			{
+				podName: "kusciatask-001-server-0",
+				ports: NamedPorts{
+					"cluster": kusciaapisv1alpha1.ContainerPort{Name: "cluster", Port: 10000},
+					"domain":  kusciaapisv1alpha1.ContainerPort{Name: "domain", Port: 10001},
+					"local":   kusciaapisv1alpha1.ContainerPort{Name: "local", Port: 10002},
+				},
+			},
+		},
+	}
+
+	h := makeTestPendingHandler()
+	pod, err := h.generatePod(partyKit, partyKit.pods[0])
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(pod.Spec.InitContainers))
+	assert.Equal(t, "prefetch-image:0.0.1", pod.Spec.InitContainers[0].Image)
+	assert.Equal(t, []v1.VolumeMount{{Name: "data", MountPath: "/data"}}, pod.Spec.InitContainers[0].VolumeMounts)
+
+	assert.Equal(t, 2, len(pod.Spec.Containers))
+	assert.Equal(t, "log-shipper", pod.Spec.Containers[1].Name)
+	assert.Equal(t, "test-image:0.0.1", pod.Spec.Containers[1].Image)
+	assert.Equal(t, "log-shipper", pod.Annotations[common.SidecarContainersAnnotationKey])
+	assert.Equal(t, "binpack", pod.Annotations[common.PlacementStrategyAnnotationKey])
+
+	assert.Equal(t, []v1.Volume{{Name: "data", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}, pod.Spec.Volumes)
+}
+
+func Test_buildPodAffinity(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, buildPodAffinity(nil))
+	assert.Nil(t, buildPodAffinity(&kusciaapisv1alpha1.TaskAffinity{Preferred: true}))
+
+	affinity := buildPodAffinity(&kusciaapisv1alpha1.TaskAffinity{
+		ColocateWith: []string{"task-a", "task-b"},
+		SeparateFrom: []string{"task-c"},
+	})
+	assert.Len(t, affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 2)
+	assert.Equal(t, v1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{common.LabelTaskID: "task-b"}},
+		TopologyKey:   v1.LabelHostname,
+	}, affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution[1])
+	assert.Len(t, affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 1)
+	assert.Empty(t, affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+
+	affinity = buildPodAffinity(&kusciaapisv1alpha1.TaskAffinity{
+		SeparateFrom: []string{"task-c"},
+		Preferred:    true,
+	})
+	assert.Nil(t, affinity.PodAffinity)
+	assert.Empty(t, affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+	assert.Equal(t, int32(100), affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].Weight)
+}
+
+func Test_generateVolumes(t *testing.T) {
+	t.Parallel()
+	sizeLimit := resource.MustParse("10Gi")
+	volumes, annotations, err := generateVolumes("task-a-alice-0", []kusciaapisv1alpha1.Volume{
+		{Name: "cache", EmptyDir: &v1.EmptyDirVolumeSource{}},
+		{Name: "ckpt", PersistentVolumeClaim: &kusciaapisv1alpha1.PersistentVolumeClaimTemplate{Storage: resource.MustParse("20Gi")}},
+		{Name: "data", HostDirectory: &kusciaapisv1alpha1.HostDirectoryVolumeSource{SizeLimit: &sizeLimit}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []v1.Volume{
+		{Name: "cache", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+		{Name: "ckpt", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "task-a-alice-0-ckpt"}}},
+		{Name: "data", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{SizeLimit: &sizeLimit}}},
+	}, volumes)
+	assert.Equal(t, "data", annotations[common.HostDirectoryVolumesAnnotationKey])
+
+	claims := map[string]v1.PersistentVolumeClaimSpec{}
+	assert.NoError(t, json.Unmarshal([]byte(annotations[common.VolumeClaimTemplatesAnnotationKey]), &claims))
+	assert.Equal(t, []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}, claims["task-a-alice-0-ckpt"].AccessModes)
+	storage := claims["task-a-alice-0-ckpt"].Resources.Requests[v1.ResourceStorage]
+	assert.Equal(t, "20Gi", storage.String())
+
+	_, annotations, err = generateVolumes("task-a-alice-0", []kusciaapisv1alpha1.Volume{{Name: "cache"}})
+	assert.NoError(t, err)
+	assert.Empty(t, annotations)
+}
+
+func Test_mountCheckpointVolume(t *testing.T) {
+	t.Parallel()
+	mounts, envs := mountCheckpointVolume(nil, []v1.EnvVar{{Name: "ENV_1", Value: "VALUE_1"}}, "ckpt", "")
+	assert.Equal(t, []v1.VolumeMount{{Name: "ckpt", MountPath: common.CheckpointMountPath}}, mounts)
+	assert.Equal(t, []v1.EnvVar{
+		{Name: "ENV_1", Value: "VALUE_1"},
+		{Name: common.EnvCheckpointDir, Value: common.CheckpointMountPath},
+	}, envs)
+
+	// the mount path of the container is kept
+	mounts, envs = mountCheckpointVolume([]v1.VolumeMount{{Name: "ckpt", MountPath: "/data/ckpt"}}, nil, "ckpt", "epoch-3")
+	assert.Len(t, mounts, 1)
+	assert.Equal(t, []v1.EnvVar{
+		{Name: common.EnvCheckpointDir, Value: "/data/ckpt"},
+		{Name: common.EnvResumeFromCheckpoint, Value: "epoch-3"},
+	}, envs)
+}
+
+func Test_validateSandbox(t *testing.T) {
+	t.Parallel()
+	privileged := true
+	template := &kusciaapisv1alpha1.DeployTemplate{
+		Spec: kusciaapisv1alpha1.PodSpec{
+			Containers: []kusciaapisv1alpha1.Container{{Name: "engine"}},
+		},
+	}
+	assert.NoError(t, validateSandbox("", template))
+	assert.NoError(t, validateSandbox(kusciaapisv1alpha1.SandboxGVisor, template))
+	assert.Error(t, validateSandbox("firecracker", template))
+
+	template.Spec.Sidecars = []kusciaapisv1alpha1.Container{{
+		Name:            "log",
+		SecurityContext: &v1.SecurityContext{Privileged: &privileged},
+	}}
+	assert.NoError(t, validateSandbox("", template))
+	assert.Error(t, validateSandbox(kusciaapisv1alpha1.SandboxKata, template))
+
+	template.Spec.Sidecars[0].SecurityContext = &v1.SecurityContext{
+		Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_ADMIN"}},
+	}
+	assert.Error(t, validateSandbox(kusciaapisv1alpha1.SandboxKata, template))
+}
+
+func Test_checkGrantedComponent(t *testing.T) {
+	t.Parallel()
+	dataIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	grantIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	h := &PendingHandler{
+		domainDataLister: kuscialistersv1alpha1.NewDomainDataLister(dataIndexer),
+		grantLister:      kuscialistersv1alpha1.NewDomainDataGrantLister(grantIndexer),
+	}
+	assert.NoError(t, dataIndexer.Add(&kusciaapisv1alpha1.DomainData{
+		ObjectMeta: metav1.ObjectMeta{Name: "own", Namespace: "domain-a"},
+		Spec:       kusciaapisv1alpha1.DomainDataSpec{Author: "domain-a"},
+	}))
+	assert.NoError(t, dataIndexer.Add(&kusciaapisv1alpha1.DomainData{
+		ObjectMeta: metav1.ObjectMeta{Name: "granted", Namespace: "domain-a"},
+		Spec:       kusciaapisv1alpha1.DomainDataSpec{Author: "domain-b"},
+	}))
+	assert.NoError(t, grantIndexer.Add(&kusciaapisv1alpha1.DomainDataGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant-1", Namespace: "domain-a"},
+		Spec: kusciaapisv1alpha1.DomainDataGrantSpec{
+			Author:       "domain-b",
+			DomainDataID: "granted",
+			GrantDomain:  "domain-a",
+			Limit: &kusciaapisv1alpha1.GrantLimit{
+				AllowedComponents: []kusciaapisv1alpha1.AllowedComponent{{Name: "psi", Versions: []string{"1.0.0"}}},
+			},
+		},
+		Status: kusciaapisv1alpha1.DomainDataGrantStatus{Phase: kusciaapisv1alpha1.GrantReady},
+	}))
+
+	makeTask := func(inputConfig string) *kusciaapisv1alpha1.KusciaTask {
+		return &kusciaapisv1alpha1.KusciaTask{Spec: kusciaapisv1alpha1.KusciaTaskSpec{TaskInputConfig: inputConfig}}
+	}
+	tests := []struct {
+		inputConfig string
+		allowed     bool
+	}{
+		{`{"sf_input_ids":["granted"],"sf_node_eval_param":{"name":"psi","version":"1.0.0"}}`, true},
+		{`{"sf_input_ids":["granted"],"sf_node_eval_param":{"name":"psi","version":"0.9.0"}}`, false},
+		{`{"sf_input_ids":["granted"],"sf_node_eval_param":{"name":"train"}}`, false},
+		{`{"sf_input_ids":["granted"]}`, false},
+		// the own domain data and the domain data of other clusters are not limited
+		{`{"sf_input_ids":["own","missing"],"sf_node_eval_param":{"name":"train"}}`, true},
+		{`not json`, true},
+	}
+	for _, tt := range tests {
+		err := h.checkGrantedComponent(makeTask(tt.inputConfig), "domain-a")
+		assert.Equal(t, tt.allowed, err == nil, tt.inputConfig)
+	}
+	// the grants of other domains are ignored
+	assert.NoError(t, h.checkGrantedComponent(makeTask(`{"sf_input_ids":["granted"]}`), "domain-b"))
+}
+
 func makeTestAppImageCase1() *kusciaapisv1alpha1.AppImage {
 	return &kusciaapisv1alpha1.AppImage{
 		ObjectMeta: metav1.ObjectMeta{
@@ -620,3 +832,22 @@ func makeTestPartyTemplateCase1() *kusciaapisv1alpha1.PartyTemplate {
 
 	return dt
 }
+
+func Test_generateKusciaConfigMap(t *testing.T) {
+	t.Parallel()
+	task := makeTestKusciaTaskCase1()
+	task.Spec.SensitiveInputConfigs = []kusciaapisv1alpha1.SensitiveInputConfig{
+		{DomainID: "domain-a", EncryptedConfig: "encrypted-a"},
+		{DomainID: "domain-b", EncryptedConfig: "encrypted-b"},
+	}
+	partyKit := &PartyKitInfo{kusciaTask: task, domainID: "domain-b"}
+	podKit := &PodKitInfo{podName: "kusciatask-001-server-0"}
+
+	cm := generateKusciaConfigMap(partyKit, podKit)
+	assert.Equal(t, "encrypted-b", cm.Data[common.EnvTaskSensitiveInputConfig])
+
+	partyKit.domainID = "domain-c"
+	cm = generateKusciaConfigMap(partyKit, podKit)
+	_, ok := cm.Data[common.EnvTaskSensitiveInputConfig]
+	assert.False(t, ok)
+}
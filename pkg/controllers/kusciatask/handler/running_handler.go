@@ -27,6 +27,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
+	"github.com/secretflow/kuscia/pkg/common"
 	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
 	kuscialistersv1alpha1 "github.com/secretflow/kuscia/pkg/crd/listers/kuscia/v1alpha1"
@@ -192,6 +193,7 @@ func (h *RunningHandler) buildPartyTaskStatus(taskStatus *kusciaapisv1alpha1.Kus
 		switch h.getPartyTaskStatus(taskStatus, party) {
 		case partyFailed:
 			partyTaskStatus.Phase = kusciaapisv1alpha1.TaskFailed
+			partyTaskStatus.Message = h.getPartyFailedMessage(taskStatus, party)
 		case partySucceeded:
 			partyTaskStatus.Phase = kusciaapisv1alpha1.TaskSucceeded
 		case partyRunning:
@@ -199,12 +201,62 @@ func (h *RunningHandler) buildPartyTaskStatus(taskStatus *kusciaapisv1alpha1.Kus
 		default:
 			partyTaskStatus.Phase = kusciaapisv1alpha1.TaskPending
 		}
+		partyTaskStatus.Checkpoint = h.getPartyCheckpoint(taskStatus, party)
 
 		partyTaskStatuses = append(partyTaskStatuses, partyTaskStatus)
 	}
 	return partyTaskStatuses
 }
 
+// getPartyFailedMessage keeps the message of the failed party, and tells the pods evicted by the agent for their
+// local disk usage.
+func (h *RunningHandler) getPartyFailedMessage(taskStatus *kusciaapisv1alpha1.KusciaTaskStatus, party kusciaapisv1alpha1.TaskResourceGroupParty) string {
+	for _, pts := range taskStatus.PartyTaskStatus {
+		if pts.DomainID == party.DomainID && pts.Role == party.Role && pts.Message != "" {
+			return pts.Message
+		}
+	}
+
+	for _, pp := range party.Pods {
+		pod, err := h.podsLister.Pods(party.DomainID).Get(pp.Name)
+		if err != nil {
+			continue
+		}
+		if pod.Status.Reason == common.PodReasonDiskPressure {
+			return fmt.Sprintf("pod %v was evicted for %v, %v", pod.Name, pod.Status.Reason, pod.Status.Message)
+		}
+	}
+	return ""
+}
+
+// getPartyCheckpoint returns the latest checkpoint reported by the pods of the party through the pod condition, or the
+// recorded one if no newer checkpoint is reported.
+func (h *RunningHandler) getPartyCheckpoint(taskStatus *kusciaapisv1alpha1.KusciaTaskStatus, party kusciaapisv1alpha1.TaskResourceGroupParty) *kusciaapisv1alpha1.TaskCheckpoint {
+	var checkpoint *kusciaapisv1alpha1.TaskCheckpoint
+	for _, pts := range taskStatus.PartyTaskStatus {
+		if pts.DomainID == party.DomainID && pts.Role == party.Role {
+			checkpoint = pts.Checkpoint
+		}
+	}
+
+	for _, pp := range party.Pods {
+		pod, err := h.podsLister.Pods(party.DomainID).Get(pp.Name)
+		if err != nil {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type != common.PodConditionCheckpointAvailable || cond.Status != v1.ConditionTrue || cond.Message == "" {
+				continue
+			}
+			if checkpoint == nil || checkpoint.ReportTime == nil || checkpoint.ReportTime.Before(&cond.LastProbeTime) {
+				reportTime := cond.LastProbeTime
+				checkpoint = &kusciaapisv1alpha1.TaskCheckpoint{ID: cond.Message, ReportTime: &reportTime}
+			}
+		}
+	}
+	return checkpoint
+}
+
 func (h *RunningHandler) getPartyTaskStatus(taskStatus *kusciaapisv1alpha1.KusciaTaskStatus, party kusciaapisv1alpha1.TaskResourceGroupParty) partyStatus {
 	for _, pts := range taskStatus.PartyTaskStatus {
 		if pts.DomainID == party.DomainID && pts.Role == party.Role {
@@ -370,6 +422,7 @@ func buildOuterPartyTaskStatus(taskStatus *kusciaapisv1alpha1.KusciaTaskStatus,
 		if s.DomainID == outerPartyTaskStatus.DomainID && s.Role == outerPartyTaskStatus.Role {
 			outerPartyTaskStatus.Phase = s.Phase
 			outerPartyTaskStatus.Message = s.Message
+			outerPartyTaskStatus.Checkpoint = s.Checkpoint
 			break
 		}
 	}
@@ -678,3 +678,58 @@ func TestReconcileTaskStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileTaskStatusWithEvictedPod(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	kubeInformersFactory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	podInformer := kubeInformersFactory.Core().V1().Pods()
+	h := &RunningHandler{
+		kubeClient: kubeClient,
+		podsLister: podInformer.Lister(),
+	}
+
+	pod := makePod("alice", v1.PodFailed)
+	pod.Status.Reason = common.PodReasonDiskPressure
+	pod.Status.Message = "The node was low on disk"
+	podInformer.Informer().GetStore().Add(pod)
+	podInformer.Informer().GetStore().Add(makePod("bob", v1.PodRunning))
+
+	taskStatus := &kusciaapisv1alpha1.KusciaTaskStatus{Phase: kusciaapisv1alpha1.TaskRunning}
+	h.reconcileTaskStatus(taskStatus, makeTaskResourceGroup("trg-1", []string{"alice", "bob"}, nil))
+	assert.Equal(t, kusciaapisv1alpha1.TaskFailed, taskStatus.Phase)
+	assert.Equal(t, kusciaapisv1alpha1.TaskFailed, taskStatus.PartyTaskStatus[0].Phase)
+	assert.Equal(t, "pod alice was evicted for DiskPressure, The node was low on disk", taskStatus.PartyTaskStatus[0].Message)
+	assert.Empty(t, taskStatus.PartyTaskStatus[1].Message)
+}
+
+func TestReconcileTaskStatusWithCheckpoint(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	kubeInformersFactory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	podInformer := kubeInformersFactory.Core().V1().Pods()
+	h := &RunningHandler{
+		kubeClient: kubeClient,
+		podsLister: podInformer.Lister(),
+	}
+
+	reportTime := metav1.NewTime(time.Now().Truncate(time.Second))
+	pod := makePod("alice", v1.PodRunning)
+	pod.Status.Conditions = []v1.PodCondition{{
+		Type:          common.PodConditionCheckpointAvailable,
+		Status:        v1.ConditionTrue,
+		LastProbeTime: reportTime,
+		Message:       "epoch-3",
+	}}
+	podInformer.Informer().GetStore().Add(pod)
+	podInformer.Informer().GetStore().Add(makePod("bob", v1.PodRunning))
+
+	taskStatus := &kusciaapisv1alpha1.KusciaTaskStatus{Phase: kusciaapisv1alpha1.TaskRunning}
+	trg := makeTaskResourceGroup("trg-1", []string{"alice", "bob"}, nil)
+	h.reconcileTaskStatus(taskStatus, trg)
+	assert.Equal(t, &kusciaapisv1alpha1.TaskCheckpoint{ID: "epoch-3", ReportTime: &reportTime}, taskStatus.PartyTaskStatus[0].Checkpoint)
+	assert.Nil(t, taskStatus.PartyTaskStatus[1].Checkpoint)
+
+	// the recorded checkpoint is kept after the pod is gone
+	podInformer.Informer().GetStore().Delete(pod)
+	h.reconcileTaskStatus(taskStatus, trg)
+	assert.Equal(t, "epoch-3", taskStatus.PartyTaskStatus[0].Checkpoint.ID)
+}
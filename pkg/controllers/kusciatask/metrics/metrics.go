@@ -51,6 +51,29 @@ var (
 		},
 		[]string{"result"},
 	)
+
+	GCDeletedResources = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kuscia_task_gc_deleted_resources",
+			Help: "Counts number of resources of the finished kuscia tasks deleted by gc",
+		},
+		[]string{"kind", "phase"},
+	)
+
+	GCErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kuscia_task_gc_errors",
+			Help: "Counts number of resources of the finished kuscia tasks failed to be deleted by gc",
+		},
+		[]string{"kind"},
+	)
+
+	GCDurations = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name: "kuscia_task_gc_durations_seconds",
+			Help: "Latency distributions of collecting the expired resources of the finished kuscia tasks.",
+		},
+	)
 )
 
 func ClearDeadMetrics(key string) {
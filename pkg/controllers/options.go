@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 )
 
 // Options is the main context object for the domain controller.
@@ -42,6 +43,18 @@ type Options struct {
 	ControllerName string
 
 	EnableWorkloadApprove bool
+
+	GrantWebhook *kusciaconfig.WebhookConfig
+
+	AppImageSync *kusciaconfig.AppImageSyncConfig
+
+	GitOps *kusciaconfig.GitOpsConfig
+
+	Alerting *kusciaconfig.AlertingConfig
+
+	JobArchive *kusciaconfig.JobArchiveConfig
+
+	TaskGC *kusciaconfig.TaskGCConfig
 }
 
 // NewOptions creates a new options with a default config.
@@ -63,6 +76,30 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("invalid config health-check-port: %v", o.HealthCheckPort)
 	}
 
+	if err := kusciaconfig.CheckWebhookConfig(o.GrantWebhook, "grant"); err != nil {
+		return err
+	}
+
+	if err := kusciaconfig.CheckAppImageSyncConfig(o.AppImageSync); err != nil {
+		return err
+	}
+
+	if err := kusciaconfig.CheckGitOpsConfig(o.GitOps); err != nil {
+		return err
+	}
+
+	if err := kusciaconfig.CheckAlertingConfig(o.Alerting); err != nil {
+		return err
+	}
+
+	if err := kusciaconfig.CheckJobArchiveConfig(o.JobArchive); err != nil {
+		return err
+	}
+
+	if err := kusciaconfig.CheckTaskGCConfig(o.TaskGC); err != nil {
+		return err
+	}
+
 	return nil
 }
 
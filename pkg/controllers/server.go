@@ -25,6 +25,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apiserver/pkg/server/healthz"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -56,6 +57,7 @@ type Server struct {
 	kubeClient              kubernetes.Interface
 	kusciaClient            kusciaclientset.Interface
 	extensionClient         apiextensionsclientset.Interface
+	dynamicClient           dynamic.Interface
 	leaderElector           election.Elector
 	electionChecker         *leaderelection.HealthzAdaptor
 	controllers             []IController
@@ -77,6 +79,7 @@ func NewServer(opts *Options, clients *kubeconfig.KubeClients, controllerConstru
 		kubeClient:              clients.KubeClient,
 		kusciaClient:            clients.KusciaClient,
 		extensionClient:         clients.ExtensionsClient,
+		dynamicClient:           clients.DynamicClient,
 		electionChecker:         leaderelection.NewLeaderHealthzAdaptor(leaderHealthzAdaptorTimeout),
 		controllerConstructions: controllerConstructions,
 	}
@@ -135,7 +138,7 @@ func (s *Server) onNewLeader(identity string) {
 	nlog.Infof("New leader has been elected: %s", identity)
 }
 
-// onStartedLeading is executed when leader started.
+// onStartedLeading is executed when leader started, the ctx is canceled once the lease is lost.
 func (s *Server) onStartedLeading(ctx context.Context) {
 	nlog.Info("Start leading")
 	if !s.controllersIsEmpty() {
@@ -151,8 +154,16 @@ func (s *Server) onStartedLeading(ctx context.Context) {
 		RootDir:               s.options.RootDir,
 		KubeClient:            s.kubeClient,
 		KusciaClient:          s.kusciaClient,
+		ExtensionsClient:      s.extensionClient,
+		DynamicClient:         s.dynamicClient,
 		EventRecorder:         s.eventRecorder,
 		EnableWorkloadApprove: s.options.EnableWorkloadApprove,
+		GrantWebhook:          s.options.GrantWebhook,
+		AppImageSync:          s.options.AppImageSync,
+		GitOps:                s.options.GitOps,
+		Alerting:              s.options.Alerting,
+		JobArchive:            s.options.JobArchive,
+		TaskGC:                s.options.TaskGC,
 	}
 	for _, cc := range s.controllerConstructions {
 		controller := cc.NewControler(ctx, config)
@@ -168,7 +179,8 @@ func (s *Server) onStartedLeading(ctx context.Context) {
 	}
 }
 
-// onStoppedLeading is executed when leader stopped.
+// onStoppedLeading is executed when leader stopped. The controllers are stopped instead of exiting the process,
+// the elector keeps campaigning and runs new controllers if this replica is elected again.
 func (s *Server) onStoppedLeading() {
 	nlog.Warnf("Server %v Leading stopped, self identity: %v, leader identity: %v", s.options.ControllerName, s.leaderElector.MyIdentity(), s.leaderElector.GetLeader())
 	s.mutex.Lock()
@@ -55,37 +55,41 @@ const (
 
 	defaultTaskResourceGroupLifecycleSeconds     = 300
 	defaultTaskResourceGroupRetryDurationSeconds = 30
+	// maxTaskResourceGroupRetryDurationSeconds caps the exponential backoff between reservation attempts.
+	maxTaskResourceGroupRetryDurationSeconds = 300
 
 	statusUpdateRetries = 3
 )
 
 const (
-	controllerName            = "taskresourcegroup-controller"
-	trgReserveFailedQueueName = "taskresourcegroup-reserve-failed-queue"
-	trgLifecycleQueueName     = "taskresourcegroup-lifecycle-queue"
+	controllerName                 = "taskresourcegroup-controller"
+	trgReserveFailedQueueName      = "taskresourcegroup-reserve-failed-queue"
+	trgReservationTimeoutQueueName = "taskresourcegroup-reservation-timeout-queue"
+	trgLifecycleQueueName          = "taskresourcegroup-lifecycle-queue"
 )
 
 // Controller is the implementation for managing resources.
 type Controller struct {
-	ctx                   context.Context
-	cancel                context.CancelFunc
-	kubeClient            kubernetes.Interface
-	kusciaClient          kusciaclientset.Interface
-	kubeInformerFactory   informers.SharedInformerFactory
-	kusciaInformerFactory kusciainformers.SharedInformerFactory
-	namespaceLister       listers.NamespaceLister
-	namespaceSynced       cache.InformerSynced
-	podLister             listers.PodLister
-	podSynced             cache.InformerSynced
-	trLister              kuscialistersv1alpha1.TaskResourceLister
-	trSynced              cache.InformerSynced
-	trgSynced             cache.InformerSynced
-	trgLister             kuscialistersv1alpha1.TaskResourceGroupLister
-	trgQueue              workqueue.RateLimitingInterface
-	trgReserveFailedQueue workqueue.DelayingInterface
-	trgLifecycleQueue     workqueue.DelayingInterface
-	recorder              record.EventRecorder
-	handlerFactory        *handler.TaskResourceGroupPhaseHandlerFactory
+	ctx                        context.Context
+	cancel                     context.CancelFunc
+	kubeClient                 kubernetes.Interface
+	kusciaClient               kusciaclientset.Interface
+	kubeInformerFactory        informers.SharedInformerFactory
+	kusciaInformerFactory      kusciainformers.SharedInformerFactory
+	namespaceLister            listers.NamespaceLister
+	namespaceSynced            cache.InformerSynced
+	podLister                  listers.PodLister
+	podSynced                  cache.InformerSynced
+	trLister                   kuscialistersv1alpha1.TaskResourceLister
+	trSynced                   cache.InformerSynced
+	trgSynced                  cache.InformerSynced
+	trgLister                  kuscialistersv1alpha1.TaskResourceGroupLister
+	trgQueue                   workqueue.RateLimitingInterface
+	trgReserveFailedQueue      workqueue.DelayingInterface
+	trgReservationTimeoutQueue workqueue.DelayingInterface
+	trgLifecycleQueue          workqueue.DelayingInterface
+	recorder                   record.EventRecorder
+	handlerFactory             *handler.TaskResourceGroupPhaseHandlerFactory
 }
 
 // NewController returns a controller instance.
@@ -101,22 +105,23 @@ func NewController(ctx context.Context, config controllers.ControllerConfig) con
 	trgInformer := kusciaInformerFactory.Kuscia().V1alpha1().TaskResourceGroups()
 	trInformer := kusciaInformerFactory.Kuscia().V1alpha1().TaskResources()
 	controller := &Controller{
-		kubeClient:            kubeClient,
-		kusciaClient:          kusciaClient,
-		kubeInformerFactory:   kubeInformerFactory,
-		kusciaInformerFactory: kusciaInformerFactory,
-		namespaceLister:       nsInformer.Lister(),
-		namespaceSynced:       nsInformer.Informer().HasSynced,
-		podLister:             podInformer.Lister(),
-		podSynced:             podInformer.Informer().HasSynced,
-		trLister:              trInformer.Lister(),
-		trSynced:              trInformer.Informer().HasSynced,
-		trgLister:             trgInformer.Lister(),
-		trgSynced:             trgInformer.Informer().HasSynced,
-		trgQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
-		trgReserveFailedQueue: workqueue.NewNamedDelayingQueue(trgReserveFailedQueueName),
-		trgLifecycleQueue:     workqueue.NewNamedDelayingQueue(trgLifecycleQueueName),
-		recorder:              eventRecorder,
+		kubeClient:                 kubeClient,
+		kusciaClient:               kusciaClient,
+		kubeInformerFactory:        kubeInformerFactory,
+		kusciaInformerFactory:      kusciaInformerFactory,
+		namespaceLister:            nsInformer.Lister(),
+		namespaceSynced:            nsInformer.Informer().HasSynced,
+		podLister:                  podInformer.Lister(),
+		podSynced:                  podInformer.Informer().HasSynced,
+		trLister:                   trInformer.Lister(),
+		trSynced:                   trInformer.Informer().HasSynced,
+		trgLister:                  trgInformer.Lister(),
+		trgSynced:                  trgInformer.Informer().HasSynced,
+		trgQueue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+		trgReserveFailedQueue:      workqueue.NewNamedDelayingQueue(trgReserveFailedQueueName),
+		trgReservationTimeoutQueue: workqueue.NewNamedDelayingQueue(trgReservationTimeoutQueueName),
+		trgLifecycleQueue:          workqueue.NewNamedDelayingQueue(trgLifecycleQueueName),
+		recorder:                   eventRecorder,
 	}
 
 	controller.ctx, controller.cancel = context.WithCancel(ctx)
@@ -327,6 +332,7 @@ func (c *Controller) Run(workers int) error {
 	defer func() {
 		c.trgQueue.ShutDown()
 		c.trgReserveFailedQueue.ShutDown()
+		c.trgReservationTimeoutQueue.ShutDown()
 		c.trgLifecycleQueue.ShutDown()
 	}()
 
@@ -344,6 +350,7 @@ func (c *Controller) Run(workers int) error {
 		go wait.UntilWithContext(c.ctx, c.runWorker, time.Second)
 		go wait.Until(c.handleExpiredTrg, time.Second, c.ctx.Done())
 		go wait.Until(c.handleReserveFailedTrg, time.Second, c.ctx.Done())
+		go wait.Until(c.handleReservationTimeoutTrg, time.Second, c.ctx.Done())
 	}
 
 	<-c.ctx.Done()
@@ -417,9 +424,25 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (err error) {
 		err = c.updateTaskResourceGroupStatus(ctx, rawTrg, trg)
 	}
 
+	if err == nil {
+		c.enqueueReservationTimeout(trg)
+	}
 	return err
 }
 
+// enqueueReservationTimeout is used to recheck the reserving trg when the reservation timeout of the attempt arrives.
+func (c *Controller) enqueueReservationTimeout(trg *kusciaapisv1alpha1.TaskResourceGroup) {
+	if trg.Status.Phase != kusciaapisv1alpha1.TaskResourceGroupPhaseReserving ||
+		trg.Spec.ReservationTimeoutSeconds <= 0 ||
+		trg.Status.LastTransitionTime == nil ||
+		!utilsres.SelfClusterAsInitiator(c.namespaceLister, trg.Spec.Initiator, trg.Annotations) {
+		return
+	}
+
+	timeout := time.Duration(trg.Spec.ReservationTimeoutSeconds) * time.Second
+	c.trgReservationTimeoutQueue.AddAfter(trg.Name, time.Until(trg.Status.LastTransitionTime.Add(timeout)))
+}
+
 func failTaskResourceGroup(trg *kusciaapisv1alpha1.TaskResourceGroup) {
 	now := metav1.Now()
 	trg.Status.Phase = kusciaapisv1alpha1.TaskResourceGroupPhaseFailed
@@ -477,21 +500,34 @@ func (c *Controller) needHandleReserveFailedTrg(trg *kusciaapisv1alpha1.TaskReso
 	}
 
 	now := metav1.Now()
-	offset := time.Duration(trg.Spec.RetryIntervalSeconds) * time.Second
-	retryTime := trg.Status.LastTransitionTime.Add(offset)
+	retryTime := trg.Status.LastTransitionTime.Add(reserveRetryInterval(trg))
 	nlog.Debugf("Task resource group retryTime: %v, currentTime: %v", retryTime, now)
 	if now.After(retryTime) {
 		return true
 	}
 
+	nlog.Infof("Put task resource group %q into reserve failed queue", trg.Name)
+	c.trgReserveFailedQueue.AddAfter(trg.Name, retryTime.Sub(now.Time))
+	return false
+}
+
+// reserveRetryInterval returns the backoff before the next reservation attempt,
+// it doubles the retry interval on each attempt and is capped by maxTaskResourceGroupRetryDurationSeconds.
+func reserveRetryInterval(trg *kusciaapisv1alpha1.TaskResourceGroup) time.Duration {
 	retryIntervalSeconds := trg.Spec.RetryIntervalSeconds
 	if retryIntervalSeconds <= 0 {
 		retryIntervalSeconds = defaultTaskResourceGroupRetryDurationSeconds
 	}
 
-	nlog.Infof("Put task resource group %q into reserve failed queue", trg.Name)
-	c.trgReserveFailedQueue.AddAfter(trg.Name, time.Duration(retryIntervalSeconds)*time.Second)
-	return false
+	interval := time.Duration(retryIntervalSeconds) * time.Second
+	maxInterval := time.Duration(maxTaskResourceGroupRetryDurationSeconds) * time.Second
+	for i := 0; i < trg.Status.RetryCount && interval < maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxInterval && retryIntervalSeconds < maxTaskResourceGroupRetryDurationSeconds {
+		interval = maxInterval
+	}
+	return interval
 }
 
 // updateTaskResourceGroupStatus is used to update task resource group status.
@@ -559,6 +595,19 @@ func (c *Controller) handleReserveFailedTrg() {
 	c.trgReserveFailedQueue.Done(item)
 }
 
+// handleReservationTimeoutTrg is used to handle trg whose reservation may time out.
+func (c *Controller) handleReservationTimeoutTrg() {
+	item, shutdown := c.trgReservationTimeoutQueue.Get()
+	if shutdown {
+		nlog.Info("Task resource group reservation timeout queue is shutdown")
+		return
+	}
+
+	nlog.Infof("Enqueue reservation timeout task resource group %v into trg queue", item)
+	c.handleDelayingTrg(item)
+	c.trgReservationTimeoutQueue.Done(item)
+}
+
 // handleDelayingTrg is used to handle delaying task resource group.
 func (c *Controller) handleDelayingTrg(item interface{}) {
 	trgName, ok := item.(string)
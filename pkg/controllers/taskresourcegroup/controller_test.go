@@ -17,6 +17,7 @@ package taskresourcegroup
 import (
 	"context"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clientsetfake "k8s.io/client-go/kubernetes/fake"
@@ -621,6 +622,53 @@ func TestHandleReserveFailedTrg(t *testing.T) {
 	}
 }
 
+func TestReserveRetryInterval(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                 string
+		retryIntervalSeconds int
+		retryCount           int
+		want                 time.Duration
+	}{
+		{
+			name:       "default retry interval",
+			retryCount: 0,
+			want:       30 * time.Second,
+		},
+		{
+			name:                 "retry interval doubles on each attempt",
+			retryIntervalSeconds: 10,
+			retryCount:           2,
+			want:                 40 * time.Second,
+		},
+		{
+			name:                 "retry interval is capped",
+			retryIntervalSeconds: 30,
+			retryCount:           10,
+			want:                 300 * time.Second,
+		},
+		{
+			name:                 "retry interval is greater than the cap",
+			retryIntervalSeconds: 600,
+			retryCount:           3,
+			want:                 600 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trg := &kusciaapisv1alpha1.TaskResourceGroup{
+				Spec:   kusciaapisv1alpha1.TaskResourceGroupSpec{RetryIntervalSeconds: tt.retryIntervalSeconds},
+				Status: kusciaapisv1alpha1.TaskResourceGroupStatus{RetryCount: tt.retryCount},
+			}
+			got := reserveRetryInterval(trg)
+			if got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestName(t *testing.T) {
 	t.Parallel()
 	kubeFakeClient := clientsetfake.NewSimpleClientset()
@@ -16,6 +16,8 @@ package handler
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -83,6 +85,7 @@ func (h *ReservingHandler) updatePodAnnotations(now metav1.Time, trg *kusciaapis
 func (h *ReservingHandler) summarizeTaskResourcesInfo(now metav1.Time, trg *kusciaapisv1alpha1.TaskResourceGroup) (needUpdate bool, err error) {
 	var trs []*kusciaapisv1alpha1.TaskResource
 	var trsCount, reservedCount, failedCount int
+	var reservations []kusciaapisv1alpha1.TaskResourceGroupPartyReservation
 	partySet := make(map[string]struct{})
 
 	var allParties []kusciaapisv1alpha1.TaskResourceGroupParty
@@ -110,6 +113,7 @@ func (h *ReservingHandler) summarizeTaskResourcesInfo(now metav1.Time, trg *kusc
 				failedCount++
 			}
 		}
+		reservations = append(reservations, buildPartyReservation(party, trs))
 		partySet[party.DomainID] = struct{}{}
 	}
 
@@ -146,25 +150,108 @@ func (h *ReservingHandler) summarizeTaskResourcesInfo(now metav1.Time, trg *kusc
 	}
 
 	if trg.Spec.MinReservedMembers > totalParty-failedCount {
-		cond, _ := utilsres.GetTaskResourceGroupCondition(&trg.Status, kusciaapisv1alpha1.TaskResourcesReserved)
-		// patch all party status phase to failed.
 		trCondReason := "Task resource group state changed to reserve-failed, so set the task resource status to failed"
-		if err = patchTaskResourceStatus(trg, kusciaapisv1alpha1.TaskResourcePhaseFailed, kusciaapisv1alpha1.TaskResourceCondFailed,
-			trCondReason, h.kusciaClient, h.trLister); err != nil {
-			needUpdate = utilsres.SetTaskResourceGroupCondition(&now, cond, v1.ConditionFalse,
-				fmt.Sprintf("Patch task resources status failed, %v", err.Error()))
-			return needUpdate, err
+		reason := fmt.Sprintf("The remaining no-failed parties count %v is less than the schedulable threshold %v",
+			totalParty-failedCount, trg.Spec.MinReservedMembers)
+		return h.failReservation(now, trg, reservations, trCondReason, reason)
+	}
+
+	if reservationTimedOut(now, trg) {
+		cond, _ := utilsres.GetTaskResourceGroupCondition(&trg.Status, kusciaapisv1alpha1.ReservationTimeout)
+		utilsres.SetTaskResourceGroupCondition(&now, cond, v1.ConditionTrue,
+			fmt.Sprintf("Reservation timed out in attempt %v", trg.Status.RetryCount+1))
+		trCondReason := "Task resource group reservation timed out, so release the reserved resources"
+		reason := fmt.Sprintf("The parties did not reserve resources within %v seconds", trg.Spec.ReservationTimeoutSeconds)
+		return h.failReservation(now, trg, reservations, trCondReason, reason)
+	}
+	return needUpdate, nil
+}
+
+// failReservation releases the resources reserved by all the parties, and records the reservation of each party.
+// The task resource group is retried later in phase reserve-failed, or fails if all the attempts are used up.
+func (h *ReservingHandler) failReservation(now metav1.Time, trg *kusciaapisv1alpha1.TaskResourceGroup,
+	reservations []kusciaapisv1alpha1.TaskResourceGroupPartyReservation, trCondReason, reason string) (bool, error) {
+	cond, _ := utilsres.GetTaskResourceGroupCondition(&trg.Status, kusciaapisv1alpha1.TaskResourcesReserved)
+	// patch all party status phase to failed.
+	if err := patchTaskResourceStatus(trg, kusciaapisv1alpha1.TaskResourcePhaseFailed, kusciaapisv1alpha1.TaskResourceCondFailed,
+		trCondReason, h.kusciaClient, h.trLister); err != nil {
+		needUpdate := utilsres.SetTaskResourceGroupCondition(&now, cond, v1.ConditionFalse,
+			fmt.Sprintf("Patch task resources status failed, %v", err.Error()))
+		return needUpdate, err
+	}
+
+	trg.Status.Phase = kusciaapisv1alpha1.TaskResourceGroupPhaseReserveFailed
+	if trg.Labels != nil && trg.Labels[common.LabelInterConnProtocolType] == string(kusciaapisv1alpha1.InterConnBFIA) {
+		trg.Status.Phase = kusciaapisv1alpha1.TaskResourceGroupPhaseFailed
+	}
+	reason = fmt.Sprintf("%s, %s", reason, buildInsufficientPartiesMessage(reservations))
+	if attempts := trg.Status.RetryCount + 1; trg.Spec.MaxReserveAttempts > 0 && attempts >= trg.Spec.MaxReserveAttempts {
+		trg.Status.Phase = kusciaapisv1alpha1.TaskResourceGroupPhaseFailed
+		reason = fmt.Sprintf("Failed to reserve resources after %v attempts, %s", attempts, reason)
+	}
+	trg.Status.LastTransitionTime = &now
+	trg.Status.PartyReservations = reservations
+	utilsres.SetTaskResourceGroupCondition(&now, cond, v1.ConditionFalse, reason)
+	return true, nil
+}
+
+// reservationTimedOut reports whether the parties stay reserving longer than the reservation timeout in the attempt.
+func reservationTimedOut(now metav1.Time, trg *kusciaapisv1alpha1.TaskResourceGroup) bool {
+	if trg.Spec.ReservationTimeoutSeconds <= 0 || trg.Status.LastTransitionTime == nil {
+		return false
+	}
+	timeout := time.Duration(trg.Spec.ReservationTimeoutSeconds) * time.Second
+	return !now.Time.Before(trg.Status.LastTransitionTime.Add(timeout))
+}
+
+// buildPartyReservation summarizes the task resources of the party, the party is reserved only if all of them are.
+func buildPartyReservation(party kusciaapisv1alpha1.TaskResourceGroupParty,
+	trs []*kusciaapisv1alpha1.TaskResource) kusciaapisv1alpha1.TaskResourceGroupPartyReservation {
+	reservation := kusciaapisv1alpha1.TaskResourceGroupPartyReservation{
+		DomainID: party.DomainID,
+		Role:     party.Role,
+		Phase:    kusciaapisv1alpha1.TaskResourcePhasePending,
+		Message:  "Task resource is not created",
+	}
+	for _, tr := range trs {
+		reservation.Phase = tr.Status.Phase
+		switch tr.Status.Phase {
+		case kusciaapisv1alpha1.TaskResourcePhaseReserved, kusciaapisv1alpha1.TaskResourcePhaseSchedulable:
+			reservation.Message = "Resources are reserved"
+		case kusciaapisv1alpha1.TaskResourcePhaseFailed:
+			reservation.Message = "Failed to reserve resources"
+			if cond := findTaskResourceCondition(tr, kusciaapisv1alpha1.TaskResourceCondFailed); cond != nil && cond.Reason != "" {
+				reservation.Message = cond.Reason
+			}
+			return reservation
+		default:
+			reservation.Message = fmt.Sprintf("Insufficient resources for %v pods of task resource %v", tr.Spec.MinReservedPods, tr.Name)
+			return reservation
 		}
+	}
+	return reservation
+}
 
-		trg.Status.Phase = kusciaapisv1alpha1.TaskResourceGroupPhaseReserveFailed
-		if trg.Labels != nil && trg.Labels[common.LabelInterConnProtocolType] == string(kusciaapisv1alpha1.InterConnBFIA) {
-			trg.Status.Phase = kusciaapisv1alpha1.TaskResourceGroupPhaseFailed
+func findTaskResourceCondition(tr *kusciaapisv1alpha1.TaskResource, condType kusciaapisv1alpha1.TaskResourceConditionType) *kusciaapisv1alpha1.TaskResourceCondition {
+	for i := range tr.Status.Conditions {
+		if tr.Status.Conditions[i].Type == condType {
+			return &tr.Status.Conditions[i]
 		}
-		trg.Status.LastTransitionTime = &now
-		needUpdate = utilsres.SetTaskResourceGroupCondition(&now, cond, v1.ConditionFalse,
-			fmt.Sprintf("The remaining no-failed parties count %v is less than the schedulable threshold %v",
-				totalParty-failedCount, trg.Spec.MinReservedMembers))
-		return needUpdate, nil
 	}
-	return needUpdate, nil
+	return nil
+}
+
+// buildInsufficientPartiesMessage lists the parties which did not reserve resources.
+func buildInsufficientPartiesMessage(reservations []kusciaapisv1alpha1.TaskResourceGroupPartyReservation) string {
+	var parts []string
+	for _, r := range reservations {
+		if r.Phase == kusciaapisv1alpha1.TaskResourcePhaseReserved || r.Phase == kusciaapisv1alpha1.TaskResourcePhaseSchedulable {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("party %v: %v", r.DomainID, r.Message))
+	}
+	if len(parts) == 0 {
+		return "no party is insufficient"
+	}
+	return "insufficient parties: " + strings.Join(parts, "; ")
 }
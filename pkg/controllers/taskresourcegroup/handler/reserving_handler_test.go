@@ -16,7 +16,10 @@ package handler
 
 import (
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
@@ -27,6 +30,7 @@ import (
 	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciaclientsetfake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
 	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	utilsres "github.com/secretflow/kuscia/pkg/utils/resources"
 	"github.com/secretflow/kuscia/test/util"
 )
 
@@ -297,3 +301,109 @@ func TestReservingHandlerHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestReservingHandlerReservationTimeout(t *testing.T) {
+	t.Parallel()
+	tr5 := util.MakeTaskResource("ns5", "tr5", 1, nil)
+	tr5.Labels = map[string]string{
+		common.LabelTaskResourceGroupUID: "555",
+	}
+	tr5.Status.Phase = kusciaapisv1alpha1.TaskResourcePhaseReserved
+
+	tr6 := util.MakeTaskResource("ns6", "tr6", 2, nil)
+	tr6.Labels = map[string]string{
+		common.LabelTaskResourceGroupUID: "555",
+	}
+	tr6.Status.Phase = kusciaapisv1alpha1.TaskResourcePhaseReserving
+
+	kubeFakeClient := clientsetfake.NewSimpleClientset()
+	kusciaFakeClient := kusciaclientsetfake.NewSimpleClientset(tr5, tr6)
+	informerFactory := informers.NewSharedInformerFactory(kubeFakeClient, 0)
+	kusciaInformerFactory := kusciainformers.NewSharedInformerFactory(kusciaFakeClient, 0)
+	podInformer := informerFactory.Core().V1().Pods()
+	nsInformer := informerFactory.Core().V1().Namespaces()
+	trInformer := kusciaInformerFactory.Kuscia().V1alpha1().TaskResources()
+	trInformer.Informer().GetStore().Add(tr5)
+	trInformer.Informer().GetStore().Add(tr6)
+
+	h := NewReservingHandler(&Dependencies{
+		KubeClient:      kubeFakeClient,
+		KusciaClient:    kusciaFakeClient,
+		PodLister:       podInformer.Lister(),
+		TrLister:        trInformer.Lister(),
+		NamespaceLister: nsInformer.Lister(),
+	})
+
+	makeTrg := func(elapsed time.Duration, maxAttempts, retryCount int) *kusciaapisv1alpha1.TaskResourceGroup {
+		lastTransitionTime := metav1.NewTime(time.Now().Add(-elapsed))
+		return &kusciaapisv1alpha1.TaskResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "trg5",
+				UID:  types.UID("555"),
+				Annotations: map[string]string{
+					common.SelfClusterAsInitiatorAnnotationKey: "true",
+				},
+			},
+			Spec: kusciaapisv1alpha1.TaskResourceGroupSpec{
+				Initiator:                 "ns5",
+				MinReservedMembers:        2,
+				ReservationTimeoutSeconds: 60,
+				MaxReserveAttempts:        maxAttempts,
+				Parties: []kusciaapisv1alpha1.TaskResourceGroupParty{
+					{DomainID: "ns5", Role: "guest"},
+					{DomainID: "ns6", Role: "host"},
+				},
+			},
+			Status: kusciaapisv1alpha1.TaskResourceGroupStatus{
+				Phase:              kusciaapisv1alpha1.TaskResourceGroupPhaseReserving,
+				RetryCount:         retryCount,
+				LastTransitionTime: &lastTransitionTime,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		trg        *kusciaapisv1alpha1.TaskResourceGroup
+		want       kusciaapisv1alpha1.TaskResourceGroupPhase
+		wantReason string
+	}{
+		{
+			name: "reservation does not time out",
+			trg:  makeTrg(10*time.Second, 0, 0),
+			want: kusciaapisv1alpha1.TaskResourceGroupPhaseReserving,
+		},
+		{
+			name:       "reservation times out and retries",
+			trg:        makeTrg(2*time.Minute, 3, 0),
+			want:       kusciaapisv1alpha1.TaskResourceGroupPhaseReserveFailed,
+			wantReason: "party ns6: Insufficient resources for 2 pods of task resource tr6",
+		},
+		{
+			name:       "reservation times out and uses up all attempts",
+			trg:        makeTrg(2*time.Minute, 3, 2),
+			want:       kusciaapisv1alpha1.TaskResourceGroupPhaseFailed,
+			wantReason: "Failed to reserve resources after 3 attempts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.Handle(tt.trg)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, tt.trg.Status.Phase)
+			if tt.wantReason == "" {
+				assert.Empty(t, tt.trg.Status.PartyReservations)
+				return
+			}
+
+			assert.Len(t, tt.trg.Status.PartyReservations, 2)
+			assert.Equal(t, kusciaapisv1alpha1.TaskResourcePhaseReserved, tt.trg.Status.PartyReservations[0].Phase)
+			assert.Equal(t, "host", tt.trg.Status.PartyReservations[1].Role)
+			assert.Equal(t, kusciaapisv1alpha1.TaskResourcePhaseReserving, tt.trg.Status.PartyReservations[1].Phase)
+			assert.True(t, utilsres.IsExistingTaskResourceGroupCondition(&tt.trg.Status, kusciaapisv1alpha1.ReservationTimeout, v1.ConditionTrue))
+			cond, _ := utilsres.GetTaskResourceGroupCondition(&tt.trg.Status, kusciaapisv1alpha1.TaskResourcesReserved)
+			assert.Contains(t, cond.Reason, tt.wantReason)
+		})
+	}
+}
@@ -136,11 +136,13 @@ func (e *KusciaCoreDNS) Records(ctx context.Context, state request.Request, exac
 	return []msg.Service{{Host: endpoints[counter%uint64(len(endpoints))], TTL: defaultTTL}}, nil
 }
 
+// resolveHost resolves the addresses of host, the addresses of IPv4 are preferred, and the ones of IPv6 are used if
+// host has no address of IPv4, e.g. on the nodes of IPv6 only.
 func (e *KusciaCoreDNS) resolveHost(host string) []string {
 	// lookup in local cache
-	cachedAddrsIPv4, found := e.Cache.Get(host)
+	cachedAddrs, found := e.Cache.Get(host)
 	if found {
-		items, ok := cachedAddrsIPv4.([]string)
+		items, ok := cachedAddrs.([]string)
 		if !ok {
 			return nil
 		}
@@ -154,17 +156,27 @@ func (e *KusciaCoreDNS) resolveHost(host string) []string {
 	}
 
 	addrsIPv4 := make([]string, 0, len(addrs))
+	addrsIPv6 := make([]string, 0, len(addrs))
 	for _, addr := range addrs {
 		ip := net.ParseIP(addr)
+		if ip == nil {
+			continue
+		}
 		if ip.To4() != nil {
 			addrsIPv4 = append(addrsIPv4, addr)
+		} else {
+			addrsIPv6 = append(addrsIPv6, addr)
 		}
 	}
+	resolved := addrsIPv4
+	if len(resolved) == 0 {
+		resolved = addrsIPv6
+	}
 
 	// update local cache
-	if len(addrsIPv4) != 0 {
-		e.Cache.Set(host, addrsIPv4, defaultTTL*time.Second)
+	if len(resolved) != 0 {
+		e.Cache.Set(host, resolved, defaultTTL*time.Second)
 	}
 
-	return addrsIPv4
+	return resolved
 }
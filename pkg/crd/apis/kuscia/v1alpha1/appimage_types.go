@@ -50,8 +50,28 @@ type AppImageSpec struct {
 	// +optional
 	ConfigTemplates map[string]string `json:"configTemplates,omitempty"`
 	DeployTemplates []DeployTemplate  `json:"deployTemplates"`
+	// PlacementStrategy is the default strategy to place the pods of the app on the nodes.
+	// +kubebuilder:validation:Enum=binpack;spread
+	// +optional
+	PlacementStrategy PlacementStrategy `json:"placementStrategy,omitempty"`
+	// Sandbox runs the pods of the app in a sandbox, e.g. for the untrusted engines. In runk mode the pods run with
+	// the RuntimeClass of the sandbox configured by the agent, in runc mode the containers run with the restricted
+	// seccomp and apparmor profiles. Runp doesn't support sandbox.
+	// +kubebuilder:validation:Enum=gvisor;kata
+	// +optional
+	Sandbox SandboxType `json:"sandbox,omitempty"`
 }
 
+// SandboxType defines the sandbox the pods run in.
+type SandboxType string
+
+const (
+	// SandboxGVisor runs the pods in the gVisor user-space kernel.
+	SandboxGVisor SandboxType = "gvisor"
+	// SandboxKata runs the pods in the Kata lightweight virtual machines.
+	SandboxKata SandboxType = "kata"
+)
+
 // AppImageInfo defines the basic app image info.
 type AppImageInfo struct {
 	Name string `json:"name"`
@@ -62,6 +82,10 @@ type AppImageInfo struct {
 	ID string `json:"id,omitempty"`
 	// +optional
 	Sign string `json:"sign,omitempty"`
+	// RegistryCredentialKey is the key of the registry credential in the confmanager of each domain, which is used
+	// to pull the image from an authenticated private registry. It takes precedence over the one of the domain.
+	// +optional
+	RegistryCredentialKey string `json:"registryCredentialKey,omitempty"`
 }
 
 // DeployTemplate defines the app deploy template.
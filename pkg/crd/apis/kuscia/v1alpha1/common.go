@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -87,14 +88,70 @@ type PodSpec struct {
 	RestartPolicy corev1.RestartPolicy `json:"restartPolicy,omitempty"`
 	// +optional
 	Containers []Container `json:"containers,omitempty"`
+	// InitContainers run in order before the containers, e.g. to prefetch data.
+	// +optional
+	InitContainers []Container `json:"initContainers,omitempty"`
+	// Sidecars keep running along with the containers, e.g. to ship logs, and are stopped after the containers exit.
+	// Only runk supports sidecars, which requires native sidecar containers of the backend k8s(1.29+).
+	// +optional
+	Sidecars []Container `json:"sidecars,omitempty"`
+	// Volumes are shared between the containers of the pod.
+	// +optional
+	Volumes []Volume `json:"volumes,omitempty"`
+	// CheckpointVolume names the volume holding the checkpoints of the engine, it is mounted into the containers at
+	// /home/kuscia/checkpoint unless the container mounts it elsewhere. The engine writes the id of the latest
+	// checkpoint to the LATEST file of the volume to report it.
+	// +optional
+	CheckpointVolume string `json:"checkpointVolume,omitempty"`
 	// If specified, the pod's scheduling constraints
 	// +optional
 	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints describes how the replicas spread across the topology domains, e.g. zones and nodes.
+	// If not specified, the replicas of KusciaDeployment are spread across zones and nodes on a best-effort basis.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// Volume defines a volume of the pod, exactly one of the sources should be set.
+type Volume struct {
+	Name string `json:"name"`
+	// +optional
+	EmptyDir *corev1.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+	// PersistentVolumeClaim claims a persistent volume of the backend k8s for the pod, only runk supports it.
+	// The claim is named after the pod, so the data survives the restarts of the pod.
+	// +optional
+	PersistentVolumeClaim *PersistentVolumeClaimTemplate `json:"persistentVolumeClaim,omitempty"`
+	// HostDirectory mounts a directory managed by the agent on the node, only runc and runp support it.
+	// The directory is named after the pod, so the data survives the restarts of the pod.
+	// +optional
+	HostDirectory *HostDirectoryVolumeSource `json:"hostDirectory,omitempty"`
+}
+
+// PersistentVolumeClaimTemplate describes the persistent volume claim created for the pod.
+type PersistentVolumeClaimTemplate struct {
+	// StorageClassName of the claim, the default storage class of the backend k8s is used if not specified.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// AccessModes of the claim, default is ReadWriteOnce.
+	// +optional
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// Storage is the requested capacity of the volume.
+	Storage resource.Quantity `json:"storage"`
+}
+
+// HostDirectoryVolumeSource describes the directory managed by the agent for the pod.
+type HostDirectoryVolumeSource struct {
+	// SizeLimit is the quota of the directory, the pod is evicted once the directory exceeds it.
+	// +optional
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
 }
 
 // Container defines the container info.
 type Container struct {
 	Name string `json:"name"`
+	// Image of the container, default is the image of the app image.
+	// +optional
+	Image string `json:"image,omitempty"`
 	// +optional
 	Command []string `json:"command,omitempty"`
 	// +optional
@@ -102,6 +159,9 @@ type Container struct {
 	WorkingDir string   `json:"workingDir"`
 	// +optional
 	ConfigVolumeMounts []ConfigVolumeMount `json:"configVolumeMounts,omitempty"`
+	// VolumeMounts mounts the volumes of the pod into the container.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
 	// +optional
 	Ports []ContainerPort `json:"ports"`
 	// +optional
@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -78,8 +79,25 @@ type DomainSpec struct {
 	AuthCenter *AuthCenter `json:"authCenter"`
 	// +optional
 	ResourceQuota *DomainResourceQuota `json:"resourceQuota,omitempty"`
+	// ImageRegistryCredentialKey is the key of the registry credential in the confmanager of the domain, which is used
+	// to pull the images of the app images without their own registry credential.
+	// +optional
+	ImageRegistryCredentialKey string `json:"imageRegistryCredentialKey,omitempty"`
+	// DataGrantApproval is how the domain data grants authored by the domain are approved, the grants
+	// are replicated to the grant domains only after they are approved. Default is Auto.
+	// +kubebuilder:validation:Enum=Auto;Manual
+	// +optional
+	DataGrantApproval DataGrantApprovalMode `json:"dataGrantApproval,omitempty"`
 }
 
+// DataGrantApprovalMode is how the domain data grants are approved.
+type DataGrantApprovalMode string
+
+const (
+	DataGrantApprovalAuto   DataGrantApprovalMode = "Auto"
+	DataGrantApprovalManual DataGrantApprovalMode = "Manual"
+)
+
 type AuthCenter struct {
 	// AuthenticationType describes how master authenticates the source's domain nodes request.
 	// +kubebuilder:validation:Enum=Token;MTLS;None
@@ -103,6 +121,9 @@ type DomainStatus struct {
 	NodeStatuses []NodeStatus `json:"nodeStatuses,omitempty"`
 	// +optional
 	DeployTokenStatuses []DeployTokenStatus `json:"deployTokenStatuses"`
+	// Conditions tracks the onboarding progress of a domain registered by RegisterDomain.
+	// +optional
+	Conditions []DomainCondition `json:"conditions,omitempty"`
 }
 
 // NodeStatus defines node status under domain.
@@ -124,3 +145,33 @@ type DeployTokenStatus struct {
 	// +optional
 	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
+
+// DomainConditionType defines condition types for Domain.
+type DomainConditionType string
+
+// These are valid conditions of a Domain during onboarding.
+const (
+	// DomainDeployTokenIssued means an unused deploy token is available for the domain to register with.
+	DomainDeployTokenIssued DomainConditionType = "DeployTokenIssued"
+	// DomainCertRegistered means the domain has registered with a deploy token and got its certificate signed.
+	DomainCertRegistered DomainConditionType = "CertRegistered"
+	// DomainNodeReady means at least one node of the domain has handshaked with master and is ready.
+	DomainNodeReady DomainConditionType = "NodeReady"
+)
+
+// DomainCondition describes the state of a Domain at a certain point.
+type DomainCondition struct {
+	// Type of Domain condition.
+	Type DomainConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// A human-readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -67,6 +67,9 @@ type DomainDataSpec struct {
 	Vendor string `json:"vendor,omitempty"`
 	// +optional
 	FileFormat string `json:"fileFormat,omitempty"`
+	// MaskingPolicies masks the columns of the domain data read by other domains.
+	// +optional
+	MaskingPolicies []DataMaskingPolicy `json:"maskingPolicies,omitempty"`
 }
 
 const (
@@ -76,6 +79,31 @@ const (
 	DomainDataReportType = "report"
 )
 
+// DataMaskingMethod is the method to mask a column.
+// +kubebuilder:validation:Enum=hash;truncate;nullify
+type DataMaskingMethod string
+
+const (
+	// DataMaskingHash replaces the value with its sha256 hex digest.
+	DataMaskingHash DataMaskingMethod = "hash"
+	// DataMaskingTruncate keeps the leading characters of the value.
+	DataMaskingTruncate DataMaskingMethod = "truncate"
+	// DataMaskingNullify replaces the value with null.
+	DataMaskingNullify DataMaskingMethod = "nullify"
+)
+
+// DataMaskingPolicy masks a column of the domain data read by the consumer domains.
+type DataMaskingPolicy struct {
+	Column string            `json:"column"`
+	Method DataMaskingMethod `json:"method"`
+	// Length is the leading characters kept by the truncate method.
+	// +optional
+	Length int `json:"length,omitempty"`
+	// Domains are the consumer domains, empty means all the domains except the author.
+	// +optional
+	Domains []string `json:"domains,omitempty"`
+}
+
 type Partition struct {
 	// enum path, odps
 	Type string `json:"type"`
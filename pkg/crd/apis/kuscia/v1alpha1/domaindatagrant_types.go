@@ -61,6 +61,31 @@ type GrantLimit struct {
 	Initiator string `json:"initiator,omitempty"`
 	// +optional
 	InputConfig string `json:"inputConfig,omitempty"`
+	// MaxBytesRead limits the bytes the grantee can read from the domain data, 0 means unlimited.
+	// +optional
+	MaxBytesRead int64 `json:"maxBytesRead,omitempty"`
+	// AllowedComponents limits the components and their versions that can use the domain data.
+	// +optional
+	AllowedComponents []AllowedComponent `json:"allowedComponents,omitempty"`
+	// MaxRowsRead limits the rows the grantee can read from the table domain data, 0 means unlimited.
+	// +optional
+	MaxRowsRead int64 `json:"maxRowsRead,omitempty"`
+	// SamplePercent is the percent of rows randomly sampled for the grantee, 0 means no sampling.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SamplePercent int32 `json:"samplePercent,omitempty"`
+	// AllowedColumns limits the columns of the table domain data visible to the grantee, empty means all columns.
+	// +optional
+	AllowedColumns []string `json:"allowedColumns,omitempty"`
+}
+
+// AllowedComponent is a component allowed to use the granted domain data.
+type AllowedComponent struct {
+	Name string `json:"name"`
+	// Versions of the component, empty means all versions.
+	// +optional
+	Versions []string `json:"versions,omitempty"`
 }
 
 // DomainDataGrantSpec defines the spec of data grant info.
@@ -74,6 +99,25 @@ type DomainDataGrantSpec struct {
 	Limit *GrantLimit `json:"limit,omitempty"`
 	// +optional
 	Description map[string]string `json:"description,omitempty"`
+	// +optional
+	Compliance *GrantCompliance `json:"compliance,omitempty"`
+}
+
+// GrantCompliance describes the legal and compliance basis of the grant.
+type GrantCompliance struct {
+	// Purpose is what the grant domain uses the data for.
+	// +optional
+	Purpose string `json:"purpose,omitempty"`
+	// LegalBasis is the legal basis of the processing, e.g. consent, contract.
+	// +optional
+	LegalBasis string `json:"legalBasis,omitempty"`
+	// DataCategories are the categories of the data, e.g. personal, financial.
+	// +optional
+	DataCategories []string `json:"dataCategories,omitempty"`
+	// RetentionDays is how long the grant domain can retain the data, 0 means not specified.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RetentionDays int32 `json:"retentionDays,omitempty"`
 }
 
 type DataSchema struct {
@@ -94,18 +138,46 @@ type DomainDataGrantStatus struct {
 	Message string     `json:"message"`
 	// +optional
 	UseRecords []UseRecord `json:"use_records"`
+	// Approval is the decision on the grant if the author domain approves grants manually.
+	// +optional
+	Approval *GrantApproval `json:"approval,omitempty"`
 }
 
 // GrantPhase is phase of data grant at the current time.
-// +kubebuilder:validation:Enum=Ready;Unavailable;Unknown
+// +kubebuilder:validation:Enum=Ready;Unavailable;Unknown;Pending;Rejected
 type GrantPhase string
 
 const (
 	GrantReady       GrantPhase = "Ready"
 	GrantUnavailable GrantPhase = "Unavailable"
 	GrantUnknown     GrantPhase = "Unknown"
+	// GrantPending means the grant is waiting for the approval of the author domain.
+	GrantPending GrantPhase = "Pending"
+	// GrantRejected means the grant is rejected by the author domain.
+	GrantRejected GrantPhase = "Rejected"
 )
 
+// GrantApprovalDecision is the decision on a grant.
+// +kubebuilder:validation:Enum=Approved;Rejected
+type GrantApprovalDecision string
+
+const (
+	GrantApproved         GrantApprovalDecision = "Approved"
+	GrantApprovalRejected GrantApprovalDecision = "Rejected"
+)
+
+// GrantApproval records the decision on the spec of the grant.
+type GrantApproval struct {
+	Decision GrantApprovalDecision `json:"decision"`
+	// Generation is the generation of the grant the decision is made on, the decision is outdated once the spec changes.
+	Generation   int64       `json:"generation"`
+	DecisionTime metav1.Time `json:"decisionTime"`
+	// +optional
+	Operator string `json:"operator,omitempty"`
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
 type UseRecord struct {
 	UseTime     metav1.Time `json:"use_time"`
 	GrantDomain string      `json:"grant_domain"`
@@ -118,3 +190,9 @@ type UseRecord struct {
 // GrantLevel
 // +kubebuilder:validation:Enum=normal;metadata;file
 type GrantType string
+
+const (
+	GrantNormal   GrantType = "normal"
+	GrantMetadata GrantType = "metadata"
+	GrantFile     GrantType = "file"
+)
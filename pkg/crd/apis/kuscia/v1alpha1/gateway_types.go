@@ -52,6 +52,11 @@ type GatewayEndpointStatus struct {
 	Type                  string `json:"type"`
 	TotalEndpointsCount   int    `json:"totalEndpointsCount"`
 	HealthyEndpointsCount int    `json:"healthyEndpointsCount"`
+	// TransmitKbps and ReceiveKbps are the throughput of the DomainRoute type endpoints in KiB/s.
+	// +optional
+	TransmitKbps int64 `json:"transmitKbps,omitempty"`
+	// +optional
+	ReceiveKbps int64 `json:"receiveKbps,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,101 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=ipw
+// +kubebuilder:printcolumn:name="AppImage",type=string,JSONPath=`.spec.appImage`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ImagePrewarm pre-pulls the image of an app image on the nodes of the domain, the namespace of the image prewarm.
+type ImagePrewarm struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ImagePrewarmSpec `json:"spec"`
+	// +optional
+	Status ImagePrewarmStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ImagePrewarmList contains a list of image prewarms.
+type ImagePrewarmList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImagePrewarm `json:"items"`
+}
+
+// ImagePrewarmSpec defines the app image to pre-pull and where.
+type ImagePrewarmSpec struct {
+	AppImage string `json:"appImage"`
+	// Nodes to pre-pull the image, empty means all the nodes of the domain.
+	// +optional
+	Nodes []string `json:"nodes,omitempty"`
+}
+
+// ImagePrewarmPhase is the phase of the image prewarm or of a node.
+// +kubebuilder:validation:Enum=Pending;Pulling;Succeeded;Failed
+type ImagePrewarmPhase string
+
+const (
+	ImagePrewarmPending   ImagePrewarmPhase = "Pending"
+	ImagePrewarmPulling   ImagePrewarmPhase = "Pulling"
+	ImagePrewarmSucceeded ImagePrewarmPhase = "Succeeded"
+	ImagePrewarmFailed    ImagePrewarmPhase = "Failed"
+)
+
+// ImagePrewarmStatus defines the progress of the image prewarm. The controller resolves the image and the nodes,
+// then the agents on the nodes pull the image and report their progress in NodeStatuses.
+type ImagePrewarmStatus struct {
+	// +optional
+	Phase ImagePrewarmPhase `json:"phase,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Image of the app image to pull, e.g. secretflow/secretflow-lite-anolis8:1.0.0.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// RegistryCredentialKey is the key of the registry credential to pull the image, resolved from the app image
+	// and the domain.
+	// +optional
+	RegistryCredentialKey string `json:"registryCredentialKey,omitempty"`
+	// +optional
+	NodeStatuses []ImagePrewarmNodeStatus `json:"nodeStatuses,omitempty"`
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// ImagePrewarmNodeStatus defines the progress of pulling the image on a node.
+type ImagePrewarmNodeStatus struct {
+	NodeName string            `json:"nodeName"`
+	Phase    ImagePrewarmPhase `json:"phase"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// ImageRef is the local reference of the pulled image.
+	// +optional
+	ImageRef string `json:"imageRef,omitempty"`
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+}
@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,13 +26,20 @@ import (
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName=interop
+// +kubebuilder:printcolumn:name="Host",type=string,JSONPath=`.spec.host`
+// +kubebuilder:printcolumn:name="Version",type=integer,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Synced",type=string,JSONPath=`.status.conditions[?(@.type=="Synced")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // InteropConfig is the Schema for the Interop config API.
 type InteropConfig struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
 	Spec              InteropConfigSpec `json:"spec"`
+	// +optional
+	Status InteropConfigStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -49,3 +57,64 @@ type InteropConfigSpec struct {
 	Host    string   `json:"host"`
 	Members []string `json:"members"`
 }
+
+// InteropConfigStatus defines the spec applied by the interconn controller. A spec reverting to a version applied
+// within the conflict window is taken as a conflict of concurrent writers, and is held until it's force synced.
+type InteropConfigStatus struct {
+	// Version is increased every time a new spec is applied.
+	// +optional
+	Version int64 `json:"version,omitempty"`
+	// SpecHash is the hash of the applied spec.
+	// +optional
+	SpecHash string `json:"specHash,omitempty"`
+	// AppliedSpec is the spec in effect, which is kept while the current spec conflicts.
+	// +optional
+	AppliedSpec *InteropConfigSpec `json:"appliedSpec,omitempty"`
+	// ForceSyncToken is the value of the force sync annotation handled last time.
+	// +optional
+	ForceSyncToken string `json:"forceSyncToken,omitempty"`
+	// History is the recently applied versions, the latest last.
+	// +optional
+	History []InteropConfigRevision `json:"history,omitempty"`
+	// Conditions is an array of current observed InteropConfig conditions.
+	// +optional
+	Conditions []InteropConfigCondition `json:"conditions,omitempty"`
+}
+
+// InteropConfigRevision is a version of the spec applied.
+type InteropConfigRevision struct {
+	Version     int64       `json:"version"`
+	SpecHash    string      `json:"specHash"`
+	AppliedTime metav1.Time `json:"appliedTime"`
+}
+
+// InteropConfigConditionType defines condition types for InteropConfig.
+type InteropConfigConditionType string
+
+// These are valid conditions of an InteropConfig.
+const (
+	// InteropConfigSynced means the current spec is applied.
+	InteropConfigSynced InteropConfigConditionType = "Synced"
+	// InteropConfigConflict means the spec is flapping between the versions of concurrent writers.
+	InteropConfigConflict InteropConfigConditionType = "Conflict"
+)
+
+// InteropConfigCondition describes the state of an InteropConfig at a certain point.
+type InteropConfigCondition struct {
+	// Type of InteropConfig condition.
+	Type InteropConfigConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// A human-readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
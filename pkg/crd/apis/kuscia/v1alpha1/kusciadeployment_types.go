@@ -17,6 +17,7 @@ package v1alpha1
 import (
 	v1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -56,6 +57,112 @@ type KusciaDeploymentSpec struct {
 	Initiator   string                  `json:"initiator"`
 	InputConfig string                  `json:"inputConfig"`
 	Parties     []KusciaDeploymentParty `json:"parties"`
+	// Autoscaling scales the replicas of all parties together according to the metrics of the pods.
+	// The replicas in the party templates are ignored once it's set.
+	// +optional
+	Autoscaling *KusciaDeploymentAutoscaling `json:"autoscaling,omitempty"`
+	// RollingUpdate updates the parties one by one, a party starts to update after the others finish their updates,
+	// so that the replicas of all parties are not taken down simultaneously.
+	// +optional
+	RollingUpdate *KusciaDeploymentRollingUpdate `json:"rollingUpdate,omitempty"`
+	// Traffic routes part of the traffic of the services of another kuscia deployment to the services of this one,
+	// it is used by the canary revision of a serving.
+	// +optional
+	Traffic *KusciaDeploymentTraffic `json:"traffic,omitempty"`
+}
+
+// KusciaDeploymentTraffic defines the traffic splitting between the kuscia deployments.
+type KusciaDeploymentTraffic struct {
+	// The name of the kuscia deployment in the same namespace whose traffic is split, the services of the
+	// same party and port are paired.
+	// +kubebuilder:validation:MinLength=1
+	StableDeployment string `json:"stableDeployment"`
+	// The percentage of the traffic routed to this kuscia deployment.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+}
+
+// KusciaDeploymentRollingUpdate defines the rolling update of the parties.
+type KusciaDeploymentRollingUpdate struct {
+	// The maximum number of pods that can be scheduled above the desired replicas of each party during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). Defaults to 25%.
+	// The strategy in the party template takes precedence over it.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// The maximum number of pods of each party that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). Defaults to 25%.
+	// The strategy in the party template takes precedence over it.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// Minimum number of seconds for which a newly created pod should be ready without any of its containers
+	// crashing, for it to be considered available. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+}
+
+// KusciaDeploymentAutoscaling defines how to scale the replicas of the parties automatically.
+type KusciaDeploymentAutoscaling struct {
+	// The lower limit of the replicas of each party. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// The upper limit of the replicas of each party.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// The metrics used to calculate the desired replicas, the largest desired replicas of all metrics is used.
+	// +kubebuilder:validation:MinItems=1
+	Metrics []AutoscalingMetric `json:"metrics"`
+	// The scale up and scale down policies.
+	// +optional
+	Behavior *AutoscalingBehavior `json:"behavior,omitempty"`
+}
+
+// AutoscalingMetricType defines the type of autoscaling metric.
+// +kubebuilder:validation:Enum=QPS;Latency;CPU
+type AutoscalingMetricType string
+
+const (
+	// AutoscalingMetricQPS is the requests per second of each pod, calculated from a counter exported by the pod.
+	AutoscalingMetricQPS AutoscalingMetricType = "QPS"
+	// AutoscalingMetricLatency is the average request latency of each pod in milliseconds, calculated from a
+	// histogram or summary exported by the pod.
+	AutoscalingMetricLatency AutoscalingMetricType = "Latency"
+	// AutoscalingMetricCPU is the cpu usage of each pod in millicores.
+	AutoscalingMetricCPU AutoscalingMetricType = "CPU"
+)
+
+// AutoscalingMetric defines a metric and its target value per pod.
+type AutoscalingMetric struct {
+	Type AutoscalingMetricType `json:"type"`
+	// The target average value per pod, requests per second for QPS, milliseconds for Latency and millicores for CPU.
+	// +kubebuilder:validation:Minimum=1
+	Target int64 `json:"target"`
+	// The name of the prometheus metric exported on the metric probe of the pod, required by QPS and Latency.
+	// +optional
+	MetricName string `json:"metricName,omitempty"`
+}
+
+// AutoscalingBehavior defines the scale up and scale down policies.
+type AutoscalingBehavior struct {
+	// +optional
+	ScaleUp *AutoscalingRules `json:"scaleUp,omitempty"`
+	// +optional
+	ScaleDown *AutoscalingRules `json:"scaleDown,omitempty"`
+}
+
+// AutoscalingRules defines the policy to scale in one direction.
+type AutoscalingRules struct {
+	// The window in which the recommendations are considered to prevent flapping, the highest recommendation is used
+	// to scale down and the lowest recommendation is used to scale up. Defaults to 0 for scale up and 300 for scale down.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	StabilizationWindowSeconds *int32 `json:"stabilizationWindowSeconds,omitempty"`
+	// The maximum number of replicas to add or remove in a scaling, 0 means no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxStep int32 `json:"maxStep,omitempty"`
 }
 
 // KusciaDeploymentParty defines the kuscia deployment party info.
@@ -105,6 +212,19 @@ type KusciaDeploymentPartyStatus struct {
 	Conditions []v1.DeploymentCondition `json:"conditions,omitempty"`
 	// +optional
 	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+	// The spread of the scheduled pods across the failure domains.
+	// +optional
+	ReplicaSpread *KusciaDeploymentReplicaSpread `json:"replicaSpread,omitempty"`
+}
+
+// KusciaDeploymentReplicaSpread defines the number of scheduled pods in each failure domain.
+type KusciaDeploymentReplicaSpread struct {
+	// The number of pods in each zone, keyed by the topology.kubernetes.io/zone label of nodes.
+	// +optional
+	Zones map[string]int32 `json:"zones,omitempty"`
+	// The number of pods on each node.
+	// +optional
+	Nodes map[string]int32 `json:"nodes,omitempty"`
 }
 
 // KusciaDeploymentStatus defines the observed state of kuscia deployment.
@@ -131,6 +251,33 @@ type KusciaDeploymentStatus struct {
 	// It is represented in RFC3339 form and is in UTC.
 	// +optional
 	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+	// The status of the autoscaling, only set when autoscaling is enabled.
+	// +optional
+	Autoscaling *KusciaDeploymentAutoscalingStatus `json:"autoscaling,omitempty"`
+}
+
+// KusciaDeploymentAutoscalingStatus defines the observed state of the autoscaling.
+type KusciaDeploymentAutoscalingStatus struct {
+	// The replicas of all parties decided by the initiator.
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+	// The desired replicas recommended by each party, keyed by the domain id. The initiator takes the largest one
+	// so that all parties scale together.
+	// +optional
+	PartyRecommendations map[string]AutoscalingRecommendation `json:"partyRecommendations,omitempty"`
+	// The last time the replicas were changed.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+}
+
+// AutoscalingRecommendation defines the desired replicas recommended by a party and the metrics it's based on.
+type AutoscalingRecommendation struct {
+	Replicas int32 `json:"replicas"`
+	// The current average value per pod of each metric type.
+	// +optional
+	CurrentMetrics map[AutoscalingMetricType]int64 `json:"currentMetrics,omitempty"`
+	// +optional
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
 }
 
 // KusciaDeploymentPhase defines the phase for deployment.
@@ -116,6 +116,9 @@ type KusciaTaskTemplate struct {
 	AppImage string `json:"appImage"`
 	// TaskInputConfig defines input config for KusciaTask.
 	TaskInputConfig string `json:"taskInputConfig"`
+	// SensitiveInputConfigs defines the sensitive part of TaskInputConfig, encrypted for each party.
+	// +optional
+	SensitiveInputConfigs []SensitiveInputConfig `json:"sensitiveInputConfigs,omitempty"`
 	// ScheduleConfig defines the schedule config for KusciaTask.
 	// +optional
 	ScheduleConfig *ScheduleConfig `json:"scheduleConfig,omitempty"`
@@ -124,6 +127,10 @@ type KusciaTaskTemplate struct {
 	// The larger the value of this field, the higher the priority.
 	// +optional
 	Priority int `json:"priority,omitempty"`
+	// Affinity defines the tasks whose pods the pods of this subtask must or must not be placed with on the same node.
+	// The tasks are referenced by aliases like dependencies.
+	// +optional
+	Affinity *TaskAffinity `json:"affinity,omitempty"`
 	// Parties defines participants and role in this KusciaTask
 	Parties []Party `json:"parties"`
 }
@@ -162,6 +169,11 @@ type KusciaJobStatus struct {
 	// +optional
 	StageStatus map[string]JobStagePhase `json:"stageStatus,omitempty"`
 
+	// AppImageVersions describes the app image versions supported by each party, the key is domain id. The parties
+	// exchange them to pin the inter connection job to the versions supported by all of them.
+	// +optional
+	AppImageVersions map[string][]PartyAppImageVersions `json:"appImageVersions,omitempty"`
+
 	// The latest available observations of an object's current state.
 	// +optional
 	Conditions []KusciaJobCondition `json:"conditions,omitempty"`
@@ -183,6 +195,11 @@ type KusciaJobStatus struct {
 	// +optional
 	TaskStatus map[string]KusciaTaskPhase `json:"taskStatus,omitempty"`
 
+	// TaskCheckpoints keeps the checkpoints of the unfinished tasks deleted on restart, the restarted tasks resume
+	// from them. The key is taskId.
+	// +optional
+	TaskCheckpoints map[string][]PartyTaskCheckpoint `json:"taskCheckpoints,omitempty"`
+
 	// Represents time when the job was acknowledged by the job controller.
 	// It is not guaranteed to be set in happens-before order across separate operations.
 	// It is represented in RFC3339 form and is in UTC.
@@ -202,6 +219,16 @@ type KusciaJobStatus struct {
 	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
 }
 
+// PartyAppImageVersions defines the versions of an app image supported by a party.
+type PartyAppImageVersions struct {
+	// AppImage is the name of the app image referred by the tasks.
+	AppImage string `json:"appImage"`
+	// Versions are the image tags of the local app images sharing the image name with the app image, the tag of
+	// the app image itself comes first.
+	// +optional
+	Versions []string `json:"versions,omitempty"`
+}
+
 // PartyTaskCreateStatus defines party task create status.
 type PartyTaskCreateStatus struct {
 	DomainID string `json:"domainID"`
@@ -280,6 +307,8 @@ type KusciaJobReason string
 const (
 	ValidateFailed   KusciaJobReason = "ValidateFailed"
 	CreateTaskFailed KusciaJobReason = "CreateTaskFailed"
+	// AppImageNegotiationFailed means the parties have no common version of some app image.
+	AppImageNegotiationFailed KusciaJobReason = "AppImageNegotiationFailed"
 )
 
 // KusciaJobPhase defines current status of this kuscia job.
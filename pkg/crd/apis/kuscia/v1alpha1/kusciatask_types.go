@@ -55,9 +55,41 @@ type KusciaTaskList struct {
 type KusciaTaskSpec struct {
 	Initiator       string `json:"initiator"`
 	TaskInputConfig string `json:"taskInputConfig"`
+	// SensitiveInputConfigs defines the sensitive part of TaskInputConfig, encrypted for each party.
+	// +optional
+	SensitiveInputConfigs []SensitiveInputConfig `json:"sensitiveInputConfigs,omitempty"`
 	// +optional
 	ScheduleConfig ScheduleConfig `json:"scheduleConfig,omitempty"`
-	Parties        []PartyInfo    `json:"parties"`
+	// Affinity defines the tasks whose pods the pods of the task must or must not be placed with on the same node.
+	// The tasks are referenced by task ids.
+	// +optional
+	Affinity *TaskAffinity `json:"affinity,omitempty"`
+	Parties  []PartyInfo   `json:"parties"`
+}
+
+// TaskAffinity defines the placement of the pods of a task relative to the pods of the other tasks in the same job.
+// The pods are placed relative to the pods of the same party only.
+type TaskAffinity struct {
+	// ColocateWith is the tasks whose pods the pods of the task must be placed with on the same node,
+	// e.g. to share a local cache volume.
+	// +optional
+	ColocateWith []string `json:"colocateWith,omitempty"`
+	// SeparateFrom is the tasks whose pods the pods of the task must not be placed with on the same node,
+	// e.g. to avoid noisy neighbors.
+	// +optional
+	SeparateFrom []string `json:"separateFrom,omitempty"`
+	// Preferred makes the rules best-effort, the pods are still scheduled if the rules can't be satisfied.
+	// +optional
+	Preferred bool `json:"preferred,omitempty"`
+}
+
+// SensitiveInputConfig defines the sensitive input config of a party. It's encrypted with the public key of the
+// party, so only the agent of the party can decrypt it and merge it into the task input config of the pods.
+type SensitiveInputConfig struct {
+	// DomainID of the party.
+	DomainID string `json:"domainID"`
+	// EncryptedConfig is the json object encrypted by RSA-OAEP, base64 encoded.
+	EncryptedConfig string `json:"encryptedConfig"`
 }
 
 // ScheduleConfig defines the config for scheduling.
@@ -71,8 +103,30 @@ type ScheduleConfig struct {
 	LifecycleSeconds int `json:"lifecycleSeconds,omitempty"`
 	// +optional
 	RetryIntervalSeconds int `json:"retryIntervalSeconds,omitempty"`
+	// ReservationTimeoutSeconds is how long the parties can stay reserving resources in an attempt, the reservations
+	// of all the parties are released when it's exceeded.
+	// +optional
+	ReservationTimeoutSeconds int `json:"reservationTimeoutSeconds,omitempty"`
+	// MaxReserveAttempts is the number of attempts to reserve resources before the task fails.
+	// +optional
+	MaxReserveAttempts int `json:"maxReserveAttempts,omitempty"`
+	// PlacementStrategy is how the pods of the task are placed on the nodes of the domain.
+	// It takes precedence over the one of the app image.
+	// +kubebuilder:validation:Enum=binpack;spread
+	// +optional
+	PlacementStrategy PlacementStrategy `json:"placementStrategy,omitempty"`
 }
 
+// PlacementStrategy defines how the pods are placed on the nodes.
+type PlacementStrategy string
+
+const (
+	// PlacementStrategyBinpack places the pods on the most allocated nodes to reduce fragmentation.
+	PlacementStrategyBinpack PlacementStrategy = "binpack"
+	// PlacementStrategySpread places the pods on the least allocated nodes for reliability.
+	PlacementStrategySpread PlacementStrategy = "spread"
+)
+
 // PartyInfo defines the basic party info.
 type PartyInfo struct {
 	DomainID    string `json:"domainID"`
@@ -85,6 +139,9 @@ type PartyInfo struct {
 	Template PartyTemplate `json:"template,omitempty"`
 	// +optional
 	BandwidthLimit []BandwidthLimit `json:"bandwidthLimits,omitempty"`
+	// ResumeFromCheckpoint is the checkpoint the party resumes from, it is set by kuscia when the task is restarted.
+	// +optional
+	ResumeFromCheckpoint string `json:"resumeFromCheckpoint,omitempty"`
 }
 
 // PartyTemplate defines the specific info for party.
@@ -104,6 +161,50 @@ type PartyTaskStatus struct {
 	Phase KusciaTaskPhase `json:"phase,omitempty"`
 	// +optional
 	Message string `json:"message,omitempty"`
+	// ResourceUsage is the resource usage of the party's pods, summarized when the task finishes.
+	// +optional
+	ResourceUsage *ResourceUsage `json:"resourceUsage,omitempty"`
+	// Checkpoint is the latest checkpoint reported by the engine of the party.
+	// +optional
+	Checkpoint *TaskCheckpoint `json:"checkpoint,omitempty"`
+}
+
+// TaskCheckpoint defines a checkpoint reported by the engine.
+type TaskCheckpoint struct {
+	// ID is the content of the LATEST file in the checkpoint volume.
+	ID string `json:"id"`
+	// +optional
+	ReportTime *metav1.Time `json:"reportTime,omitempty"`
+}
+
+// PartyTaskCheckpoint defines the checkpoint of a party of the task.
+type PartyTaskCheckpoint struct {
+	DomainID string `json:"domainID"`
+	// +optional
+	Role string `json:"role,omitempty"`
+	ID   string `json:"id"`
+}
+
+// ResourceUsage defines the resource usage of pods, sampled by the agents from the cgroups of the containers.
+type ResourceUsage struct {
+	// CPUMilliSeconds is the cpu time consumed.
+	// +optional
+	CPUMilliSeconds int64 `json:"cpuMilliSeconds,omitempty"`
+	// MemoryPeakBytes is the sum of the peak working set memory of the pods.
+	// +optional
+	MemoryPeakBytes int64 `json:"memoryPeakBytes,omitempty"`
+	// MemoryMiBSeconds is the working set memory integrated over the sampling periods.
+	// +optional
+	MemoryMiBSeconds int64 `json:"memoryMiBSeconds,omitempty"`
+	// +optional
+	IOReadBytes int64 `json:"ioReadBytes,omitempty"`
+	// +optional
+	IOWriteBytes int64 `json:"ioWriteBytes,omitempty"`
+	// NetworkReceiveBytes and NetworkTransmitBytes are only collected for pods with their own network namespace.
+	// +optional
+	NetworkReceiveBytes int64 `json:"networkReceiveBytes,omitempty"`
+	// +optional
+	NetworkTransmitBytes int64 `json:"networkTransmitBytes,omitempty"`
 }
 
 // KusciaTaskStatus defines the observed state of kuscia task.
@@ -82,6 +82,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&KusciaTaskSummaryList{},
 		&KusciaDeploymentSummary{},
 		&KusciaDeploymentSummaryList{},
+		&ImagePrewarm{},
+		&ImagePrewarmList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -70,6 +70,15 @@ type TaskResourceGroupSpec struct {
 	// If the task has not been scheduled successfully in the lifecycle, the task resource group is set to failed.
 	// +optional
 	LifecycleSeconds int `json:"lifecycleSeconds,omitempty"`
+	// ReservationTimeoutSeconds represents how long the parties can stay reserving resources in an attempt.
+	// If it's exceeded, the reservations of all the parties are released and reserving is retried with backoff.
+	// Zero means no timeout.
+	// +optional
+	ReservationTimeoutSeconds int `json:"reservationTimeoutSeconds,omitempty"`
+	// MaxReserveAttempts represents the number of attempts to reserve resources.
+	// If all the attempts failed, the task resource group is set to failed. Zero means no limit in the lifecycle.
+	// +optional
+	MaxReserveAttempts int `json:"maxReserveAttempts,omitempty"`
 	// Initiator represents who initiated the task.
 	Initiator string `json:"initiator"`
 	// Parties represents the parties' whose task resource is controlled by self cluster.
@@ -127,6 +136,21 @@ type TaskResourceGroupStatus struct {
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 	// +optional
 	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// PartyReservations represents the reservation of each party in the last failed attempt.
+	// +optional
+	PartyReservations []TaskResourceGroupPartyReservation `json:"partyReservations,omitempty"`
+}
+
+// TaskResourceGroupPartyReservation defines the reservation of a party in an attempt.
+type TaskResourceGroupPartyReservation struct {
+	DomainID string `json:"domainID"`
+	// +optional
+	Role string `json:"role,omitempty"`
+	// Phase is the phase of the party task resource when the attempt failed.
+	// +optional
+	Phase TaskResourcePhase `json:"phase,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // TaskResourceGroupConditionType is a valid value for a task resource group condition type.
@@ -140,6 +164,7 @@ const (
 	TaskResourcesListed        TaskResourceGroupConditionType = "TaskResourcesListed"
 	TaskResourcesReserved      TaskResourceGroupConditionType = "TaskResourcesReserved"
 	TaskResourceGroupExpired   TaskResourceGroupConditionType = "TaskResourceGroupExpired"
+	ReservationTimeout         TaskResourceGroupConditionType = "ReservationTimeout"
 	TaskResourcesScheduled     TaskResourceGroupConditionType = "TaskResourcesScheduled"
 	TaskResourceGroupFailed    TaskResourceGroupConditionType = "TaskResourceGroupFailed"
 	DependentTaskFailed        TaskResourceGroupConditionType = "DependentTaskFailed"
@@ -159,4 +184,7 @@ type SchedulerPluginArgs struct {
 	// ResourceReservedSeconds is the waiting timeout in seconds.
 	// +optional
 	ResourceReservedSeconds int `json:"resourceReservedSeconds,omitempty"`
+	// PlacementStrategy is the strategy to place the pods which don't specify one.
+	// +optional
+	PlacementStrategy PlacementStrategy `json:"placementStrategy,omitempty"`
 }
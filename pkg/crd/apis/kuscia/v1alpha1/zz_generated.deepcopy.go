@@ -23,8 +23,30 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedComponent) DeepCopyInto(out *AllowedComponent) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedComponent.
+func (in *AllowedComponent) DeepCopy() *AllowedComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppImage) DeepCopyInto(out *AppImage) {
 	*out = *in
@@ -148,6 +170,96 @@ func (in *AuthCenter) DeepCopy() *AuthCenter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingBehavior) DeepCopyInto(out *AutoscalingBehavior) {
+	*out = *in
+	if in.ScaleUp != nil {
+		in, out := &in.ScaleUp, &out.ScaleUp
+		*out = new(AutoscalingRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(AutoscalingRules)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingBehavior.
+func (in *AutoscalingBehavior) DeepCopy() *AutoscalingBehavior {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingBehavior)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingMetric) DeepCopyInto(out *AutoscalingMetric) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingMetric.
+func (in *AutoscalingMetric) DeepCopy() *AutoscalingMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingRecommendation) DeepCopyInto(out *AutoscalingRecommendation) {
+	*out = *in
+	if in.CurrentMetrics != nil {
+		in, out := &in.CurrentMetrics, &out.CurrentMetrics
+		*out = make(map[AutoscalingMetricType]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UpdateTime != nil {
+		in, out := &in.UpdateTime, &out.UpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingRecommendation.
+func (in *AutoscalingRecommendation) DeepCopy() *AutoscalingRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingRules) DeepCopyInto(out *AutoscalingRules) {
+	*out = *in
+	if in.StabilizationWindowSeconds != nil {
+		in, out := &in.StabilizationWindowSeconds, &out.StabilizationWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingRules.
+func (in *AutoscalingRules) DeepCopy() *AutoscalingRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
 	*out = *in
@@ -310,6 +422,21 @@ func (in *ClusterDomainRouteStatus) DeepCopyInto(out *ClusterDomainRouteStatus)
 			(*out)[key] = val
 		}
 	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(DomainRouteThroughput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Probe != nil {
+		in, out := &in.Probe, &out.Probe
+		*out = new(DomainRouteProbeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(DomainRouteProtocolStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -341,6 +468,13 @@ func (in *ClusterDomainRouteTokenStatus) DeepCopyInto(out *ClusterDomainRouteTok
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rotations != nil {
+		in, out := &in.Rotations, &out.Rotations
+		*out = make([]DomainRouteTokenRotation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -388,6 +522,13 @@ func (in *Container) DeepCopyInto(out *Container) {
 		*out = make([]ConfigVolumeMount, len(*in))
 		copy(*out, *in)
 	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]ContainerPort, len(*in))
@@ -495,6 +636,27 @@ func (in *DataCondition) DeepCopy() *DataCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMaskingPolicy) DeepCopyInto(out *DataMaskingPolicy) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMaskingPolicy.
+func (in *DataMaskingPolicy) DeepCopy() *DataMaskingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMaskingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataSchema) DeepCopyInto(out *DataSchema) {
 	*out = *in
@@ -724,6 +886,23 @@ func (in *DomainAppImageSpec) DeepCopy() *DomainAppImageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainCondition) DeepCopyInto(out *DomainCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCondition.
+func (in *DomainCondition) DeepCopy() *DomainCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainData) DeepCopyInto(out *DomainData) {
 	*out = *in
@@ -828,6 +1007,11 @@ func (in *DomainDataGrantSpec) DeepCopyInto(out *DomainDataGrantSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Compliance != nil {
+		in, out := &in.Compliance, &out.Compliance
+		*out = new(GrantCompliance)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -851,6 +1035,11 @@ func (in *DomainDataGrantStatus) DeepCopyInto(out *DomainDataGrantStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(GrantApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1001,6 +1190,13 @@ func (in *DomainDataSpec) DeepCopyInto(out *DomainDataSpec) {
 		*out = make([]DataColumn, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaskingPolicies != nil {
+		in, out := &in.MaskingPolicies, &out.MaskingPolicies
+		*out = make([]DataMaskingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1022,6 +1218,11 @@ func (in *DomainEndpoint) DeepCopyInto(out *DomainEndpoint) {
 		*out = make([]DomainPort, len(*in))
 		copy(*out, *in)
 	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]DomainEndpointAddress, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1035,6 +1236,22 @@ func (in *DomainEndpoint) DeepCopy() *DomainEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainEndpointAddress) DeepCopyInto(out *DomainEndpointAddress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainEndpointAddress.
+func (in *DomainEndpointAddress) DeepCopy() *DomainEndpointAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainEndpointAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainList) DeepCopyInto(out *DomainList) {
 	*out = *in
@@ -1134,151 +1351,103 @@ func (in *DomainRoute) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainRouteList) DeepCopyInto(out *DomainRouteList) {
+func (in *DomainRouteBandwidthLimit) DeepCopyInto(out *DomainRouteBandwidthLimit) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DomainRoute, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteList.
-func (in *DomainRouteList) DeepCopy() *DomainRouteList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteBandwidthLimit.
+func (in *DomainRouteBandwidthLimit) DeepCopy() *DomainRouteBandwidthLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainRouteList)
+	out := new(DomainRouteBandwidthLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DomainRouteList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainRouteMTLSConfig) DeepCopyInto(out *DomainRouteMTLSConfig) {
+func (in *DomainRouteCircuitBreaker) DeepCopyInto(out *DomainRouteCircuitBreaker) {
 	*out = *in
+	if in.RetryBudget != nil {
+		in, out := &in.RetryBudget, &out.RetryBudget
+		*out = new(DomainRouteRetryBudget)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteMTLSConfig.
-func (in *DomainRouteMTLSConfig) DeepCopy() *DomainRouteMTLSConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteCircuitBreaker.
+func (in *DomainRouteCircuitBreaker) DeepCopy() *DomainRouteCircuitBreaker {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainRouteMTLSConfig)
+	out := new(DomainRouteCircuitBreaker)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainRouteSpec) DeepCopyInto(out *DomainRouteSpec) {
+func (in *DomainRouteCompression) DeepCopyInto(out *DomainRouteCompression) {
 	*out = *in
-	in.Endpoint.DeepCopyInto(&out.Endpoint)
-	if in.Transit != nil {
-		in, out := &in.Transit, &out.Transit
-		*out = new(Transit)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TokenConfig != nil {
-		in, out := &in.TokenConfig, &out.TokenConfig
-		*out = new(TokenConfig)
-		**out = **in
-	}
-	if in.BodyEncryption != nil {
-		in, out := &in.BodyEncryption, &out.BodyEncryption
-		*out = new(BodyEncryption)
-		**out = **in
-	}
-	if in.MTLSConfig != nil {
-		in, out := &in.MTLSConfig, &out.MTLSConfig
-		*out = new(DomainRouteMTLSConfig)
-		**out = **in
-	}
-	if in.SourceWhiteIPList != nil {
-		in, out := &in.SourceWhiteIPList, &out.SourceWhiteIPList
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.RequestHeadersToAdd != nil {
-		in, out := &in.RequestHeadersToAdd, &out.RequestHeadersToAdd
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteSpec.
-func (in *DomainRouteSpec) DeepCopy() *DomainRouteSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteCompression.
+func (in *DomainRouteCompression) DeepCopy() *DomainRouteCompression {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainRouteSpec)
+	out := new(DomainRouteCompression)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainRouteStatus) DeepCopyInto(out *DomainRouteStatus) {
+func (in *DomainRouteConnection) DeepCopyInto(out *DomainRouteConnection) {
 	*out = *in
-	in.TokenStatus.DeepCopyInto(&out.TokenStatus)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteStatus.
-func (in *DomainRouteStatus) DeepCopy() *DomainRouteStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteConnection.
+func (in *DomainRouteConnection) DeepCopy() *DomainRouteConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainRouteStatus)
+	out := new(DomainRouteConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainRouteToken) DeepCopyInto(out *DomainRouteToken) {
+func (in *DomainRouteFilter) DeepCopyInto(out *DomainRouteFilter) {
 	*out = *in
-	in.RevisionTime.DeepCopyInto(&out.RevisionTime)
-	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
-	in.HeartBeatTime.DeepCopyInto(&out.HeartBeatTime)
-	if in.EffectiveInstances != nil {
-		in, out := &in.EffectiveInstances, &out.EffectiveInstances
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteToken.
-func (in *DomainRouteToken) DeepCopy() *DomainRouteToken {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteFilter.
+func (in *DomainRouteFilter) DeepCopy() *DomainRouteFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainRouteToken)
+	out := new(DomainRouteFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainRouteTokenStatus) DeepCopyInto(out *DomainRouteTokenStatus) {
+func (in *DomainRouteList) DeepCopyInto(out *DomainRouteList) {
 	*out = *in
-	in.RevisionToken.DeepCopyInto(&out.RevisionToken)
-	if in.Tokens != nil {
-		in, out := &in.Tokens, &out.Tokens
-		*out = make([]DomainRouteToken, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DomainRoute, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1286,23 +1455,401 @@ func (in *DomainRouteTokenStatus) DeepCopyInto(out *DomainRouteTokenStatus) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteTokenStatus.
-func (in *DomainRouteTokenStatus) DeepCopy() *DomainRouteTokenStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteList.
+func (in *DomainRouteList) DeepCopy() *DomainRouteList {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainRouteTokenStatus)
+	out := new(DomainRouteList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DomainRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
+func (in *DomainRouteMTLSConfig) DeepCopyInto(out *DomainRouteMTLSConfig) {
 	*out = *in
-	if in.InterConnProtocols != nil {
-		in, out := &in.InterConnProtocols, &out.InterConnProtocols
-		*out = make([]InterConnProtocolType, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteMTLSConfig.
+func (in *DomainRouteMTLSConfig) DeepCopy() *DomainRouteMTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteMTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteMirror) DeepCopyInto(out *DomainRouteMirror) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteMirror.
+func (in *DomainRouteMirror) DeepCopy() *DomainRouteMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteProbeRecord) DeepCopyInto(out *DomainRouteProbeRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteProbeRecord.
+func (in *DomainRouteProbeRecord) DeepCopy() *DomainRouteProbeRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteProbeRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteProbeStatus) DeepCopyInto(out *DomainRouteProbeStatus) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]DomainRouteProbeRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteProbeStatus.
+func (in *DomainRouteProbeStatus) DeepCopy() *DomainRouteProbeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteProbeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteProtocolNegotiation) DeepCopyInto(out *DomainRouteProtocolNegotiation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteProtocolNegotiation.
+func (in *DomainRouteProtocolNegotiation) DeepCopy() *DomainRouteProtocolNegotiation {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteProtocolNegotiation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteProtocolStatus) DeepCopyInto(out *DomainRouteProtocolStatus) {
+	*out = *in
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastNegotiationTime.DeepCopyInto(&out.LastNegotiationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteProtocolStatus.
+func (in *DomainRouteProtocolStatus) DeepCopy() *DomainRouteProtocolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteProtocolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteProxy) DeepCopyInto(out *DomainRouteProxy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteProxy.
+func (in *DomainRouteProxy) DeepCopy() *DomainRouteProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteRequestSigning) DeepCopyInto(out *DomainRouteRequestSigning) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteRequestSigning.
+func (in *DomainRouteRequestSigning) DeepCopy() *DomainRouteRequestSigning {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteRequestSigning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteRetryBudget) DeepCopyInto(out *DomainRouteRetryBudget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteRetryBudget.
+func (in *DomainRouteRetryBudget) DeepCopy() *DomainRouteRetryBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteRetryBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteSpec) DeepCopyInto(out *DomainRouteSpec) {
+	*out = *in
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.Transit != nil {
+		in, out := &in.Transit, &out.Transit
+		*out = new(Transit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenConfig != nil {
+		in, out := &in.TokenConfig, &out.TokenConfig
+		*out = new(TokenConfig)
+		**out = **in
+	}
+	if in.BodyEncryption != nil {
+		in, out := &in.BodyEncryption, &out.BodyEncryption
+		*out = new(BodyEncryption)
+		**out = **in
+	}
+	if in.MTLSConfig != nil {
+		in, out := &in.MTLSConfig, &out.MTLSConfig
+		*out = new(DomainRouteMTLSConfig)
+		**out = **in
+	}
+	if in.SourceWhiteIPList != nil {
+		in, out := &in.SourceWhiteIPList, &out.SourceWhiteIPList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestHeadersToAdd != nil {
+		in, out := &in.RequestHeadersToAdd, &out.RequestHeadersToAdd
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		*out = new(DomainRouteBandwidthLimit)
+		**out = **in
+	}
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(DomainRouteConnection)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(DomainRouteCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(DomainRouteProxy)
+		**out = **in
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(DomainRouteMirror)
+		**out = **in
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]DomainRouteFilter, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestSigning != nil {
+		in, out := &in.RequestSigning, &out.RequestSigning
+		*out = new(DomainRouteRequestSigning)
+		**out = **in
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(DomainRouteCircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtocolNegotiation != nil {
+		in, out := &in.ProtocolNegotiation, &out.ProtocolNegotiation
+		*out = new(DomainRouteProtocolNegotiation)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteSpec.
+func (in *DomainRouteSpec) DeepCopy() *DomainRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteStatus) DeepCopyInto(out *DomainRouteStatus) {
+	*out = *in
+	in.TokenStatus.DeepCopyInto(&out.TokenStatus)
+	if in.Probe != nil {
+		in, out := &in.Probe, &out.Probe
+		*out = new(DomainRouteProbeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(DomainRouteProtocolStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteStatus.
+func (in *DomainRouteStatus) DeepCopy() *DomainRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteThroughput) DeepCopyInto(out *DomainRouteThroughput) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteThroughput.
+func (in *DomainRouteThroughput) DeepCopy() *DomainRouteThroughput {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteThroughput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteToken) DeepCopyInto(out *DomainRouteToken) {
+	*out = *in
+	in.RevisionTime.DeepCopyInto(&out.RevisionTime)
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+	in.HeartBeatTime.DeepCopyInto(&out.HeartBeatTime)
+	if in.EffectiveInstances != nil {
+		in, out := &in.EffectiveInstances, &out.EffectiveInstances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteToken.
+func (in *DomainRouteToken) DeepCopy() *DomainRouteToken {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteTokenRotation) DeepCopyInto(out *DomainRouteTokenRotation) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	in.PreviousExpirationTime.DeepCopyInto(&out.PreviousExpirationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteTokenRotation.
+func (in *DomainRouteTokenRotation) DeepCopy() *DomainRouteTokenRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteTokenRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainRouteTokenStatus) DeepCopyInto(out *DomainRouteTokenStatus) {
+	*out = *in
+	in.RevisionToken.DeepCopyInto(&out.RevisionToken)
+	if in.Tokens != nil {
+		in, out := &in.Tokens, &out.Tokens
+		*out = make([]DomainRouteToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rotations != nil {
+		in, out := &in.Rotations, &out.Rotations
+		*out = make([]DomainRouteTokenRotation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRouteTokenStatus.
+func (in *DomainRouteTokenStatus) DeepCopy() *DomainRouteTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainRouteTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
+	*out = *in
+	if in.InterConnProtocols != nil {
+		in, out := &in.InterConnProtocols, &out.InterConnProtocols
+		*out = make([]InterConnProtocolType, len(*in))
+		copy(*out, *in)
 	}
 	if in.AuthCenter != nil {
 		in, out := &in.AuthCenter, &out.AuthCenter
@@ -1344,6 +1891,13 @@ func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]DomainCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1472,6 +2026,44 @@ func (in *GatewayStatus) DeepCopy() *GatewayStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantApproval) DeepCopyInto(out *GrantApproval) {
+	*out = *in
+	in.DecisionTime.DeepCopyInto(&out.DecisionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantApproval.
+func (in *GrantApproval) DeepCopy() *GrantApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantCompliance) DeepCopyInto(out *GrantCompliance) {
+	*out = *in
+	if in.DataCategories != nil {
+		in, out := &in.DataCategories, &out.DataCategories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantCompliance.
+func (in *GrantCompliance) DeepCopy() *GrantCompliance {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantCompliance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrantLimit) DeepCopyInto(out *GrantLimit) {
 	*out = *in
@@ -1489,6 +2081,18 @@ func (in *GrantLimit) DeepCopyInto(out *GrantLimit) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedComponents != nil {
+		in, out := &in.AllowedComponents, &out.AllowedComponents
+		*out = make([]AllowedComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedColumns != nil {
+		in, out := &in.AllowedColumns, &out.AllowedColumns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1502,6 +2106,160 @@ func (in *GrantLimit) DeepCopy() *GrantLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDirectoryVolumeSource) DeepCopyInto(out *HostDirectoryVolumeSource) {
+	*out = *in
+	if in.SizeLimit != nil {
+		in, out := &in.SizeLimit, &out.SizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDirectoryVolumeSource.
+func (in *HostDirectoryVolumeSource) DeepCopy() *HostDirectoryVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDirectoryVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrewarm) DeepCopyInto(out *ImagePrewarm) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrewarm.
+func (in *ImagePrewarm) DeepCopy() *ImagePrewarm {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrewarm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePrewarm) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrewarmList) DeepCopyInto(out *ImagePrewarmList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImagePrewarm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrewarmList.
+func (in *ImagePrewarmList) DeepCopy() *ImagePrewarmList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrewarmList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePrewarmList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrewarmNodeStatus) DeepCopyInto(out *ImagePrewarmNodeStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrewarmNodeStatus.
+func (in *ImagePrewarmNodeStatus) DeepCopy() *ImagePrewarmNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrewarmNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrewarmSpec) DeepCopyInto(out *ImagePrewarmSpec) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrewarmSpec.
+func (in *ImagePrewarmSpec) DeepCopy() *ImagePrewarmSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrewarmSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrewarmStatus) DeepCopyInto(out *ImagePrewarmStatus) {
+	*out = *in
+	if in.NodeStatuses != nil {
+		in, out := &in.NodeStatuses, &out.NodeStatuses
+		*out = make([]ImagePrewarmNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrewarmStatus.
+func (in *ImagePrewarmStatus) DeepCopy() *ImagePrewarmStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrewarmStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ingress) DeepCopyInto(out *Ingress) {
 	*out = *in
@@ -1567,6 +2325,7 @@ func (in *InteropConfig) DeepCopyInto(out *InteropConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1588,6 +2347,24 @@ func (in *InteropConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InteropConfigCondition) DeepCopyInto(out *InteropConfigCondition) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InteropConfigCondition.
+func (in *InteropConfigCondition) DeepCopy() *InteropConfigCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(InteropConfigCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InteropConfigList) DeepCopyInto(out *InteropConfigList) {
 	*out = *in
@@ -1621,6 +2398,23 @@ func (in *InteropConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InteropConfigRevision) DeepCopyInto(out *InteropConfigRevision) {
+	*out = *in
+	in.AppliedTime.DeepCopyInto(&out.AppliedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InteropConfigRevision.
+func (in *InteropConfigRevision) DeepCopy() *InteropConfigRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(InteropConfigRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InteropConfigSpec) DeepCopyInto(out *InteropConfigSpec) {
 	*out = *in
@@ -1642,6 +2436,41 @@ func (in *InteropConfigSpec) DeepCopy() *InteropConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InteropConfigStatus) DeepCopyInto(out *InteropConfigStatus) {
+	*out = *in
+	if in.AppliedSpec != nil {
+		in, out := &in.AppliedSpec, &out.AppliedSpec
+		*out = new(InteropConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]InteropConfigRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]InteropConfigCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InteropConfigStatus.
+func (in *InteropConfigStatus) DeepCopy() *InteropConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InteropConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KusciaDeployment) DeepCopyInto(out *KusciaDeployment) {
 	*out = *in
@@ -1667,7 +2496,65 @@ func (in *KusciaDeployment) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KusciaDeploymentAutoscaling) DeepCopyInto(out *KusciaDeploymentAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]AutoscalingMetric, len(*in))
+		copy(*out, *in)
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(AutoscalingBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KusciaDeploymentAutoscaling.
+func (in *KusciaDeploymentAutoscaling) DeepCopy() *KusciaDeploymentAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(KusciaDeploymentAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KusciaDeploymentAutoscalingStatus) DeepCopyInto(out *KusciaDeploymentAutoscalingStatus) {
+	*out = *in
+	if in.PartyRecommendations != nil {
+		in, out := &in.PartyRecommendations, &out.PartyRecommendations
+		*out = make(map[string]AutoscalingRecommendation, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KusciaDeploymentAutoscalingStatus.
+func (in *KusciaDeploymentAutoscalingStatus) DeepCopy() *KusciaDeploymentAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KusciaDeploymentAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -1734,6 +2621,11 @@ func (in *KusciaDeploymentPartyStatus) DeepCopyInto(out *KusciaDeploymentPartySt
 		in, out := &in.CreationTimestamp, &out.CreationTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.ReplicaSpread != nil {
+		in, out := &in.ReplicaSpread, &out.ReplicaSpread
+		*out = new(KusciaDeploymentReplicaSpread)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1774,6 +2666,62 @@ func (in *KusciaDeploymentPartyTemplate) DeepCopy() *KusciaDeploymentPartyTempla
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KusciaDeploymentReplicaSpread) DeepCopyInto(out *KusciaDeploymentReplicaSpread) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KusciaDeploymentReplicaSpread.
+func (in *KusciaDeploymentReplicaSpread) DeepCopy() *KusciaDeploymentReplicaSpread {
+	if in == nil {
+		return nil
+	}
+	out := new(KusciaDeploymentReplicaSpread)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KusciaDeploymentRollingUpdate) DeepCopyInto(out *KusciaDeploymentRollingUpdate) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KusciaDeploymentRollingUpdate.
+func (in *KusciaDeploymentRollingUpdate) DeepCopy() *KusciaDeploymentRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(KusciaDeploymentRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KusciaDeploymentSpec) DeepCopyInto(out *KusciaDeploymentSpec) {
 	*out = *in
@@ -1784,6 +2732,21 @@ func (in *KusciaDeploymentSpec) DeepCopyInto(out *KusciaDeploymentSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(KusciaDeploymentAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(KusciaDeploymentRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Traffic != nil {
+		in, out := &in.Traffic, &out.Traffic
+		*out = new(KusciaDeploymentTraffic)
+		**out = **in
+	}
 	return
 }
 
@@ -1829,6 +2792,11 @@ func (in *KusciaDeploymentStatus) DeepCopyInto(out *KusciaDeploymentStatus) {
 		in, out := &in.LastReconcileTime, &out.LastReconcileTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(KusciaDeploymentAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1902,6 +2870,22 @@ func (in *KusciaDeploymentSummaryList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KusciaDeploymentTraffic) DeepCopyInto(out *KusciaDeploymentTraffic) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KusciaDeploymentTraffic.
+func (in *KusciaDeploymentTraffic) DeepCopy() *KusciaDeploymentTraffic {
+	if in == nil {
+		return nil
+	}
+	out := new(KusciaDeploymentTraffic)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KusciaJob) DeepCopyInto(out *KusciaJob) {
 	*out = *in
@@ -2028,6 +3012,23 @@ func (in *KusciaJobStatus) DeepCopyInto(out *KusciaJobStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.AppImageVersions != nil {
+		in, out := &in.AppImageVersions, &out.AppImageVersions
+		*out = make(map[string][]PartyAppImageVersions, len(*in))
+		for key, val := range *in {
+			var outVal []PartyAppImageVersions
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]PartyAppImageVersions, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]KusciaJobCondition, len(*in))
@@ -2057,6 +3058,21 @@ func (in *KusciaJobStatus) DeepCopyInto(out *KusciaJobStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.TaskCheckpoints != nil {
+		in, out := &in.TaskCheckpoints, &out.TaskCheckpoints
+		*out = make(map[string][]PartyTaskCheckpoint, len(*in))
+		for key, val := range *in {
+			var outVal []PartyTaskCheckpoint
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]PartyTaskCheckpoint, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.StartTime != nil {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
@@ -2243,7 +3259,17 @@ func (in *KusciaTaskList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KusciaTaskSpec) DeepCopyInto(out *KusciaTaskSpec) {
 	*out = *in
+	if in.SensitiveInputConfigs != nil {
+		in, out := &in.SensitiveInputConfigs, &out.SensitiveInputConfigs
+		*out = make([]SensitiveInputConfig, len(*in))
+		copy(*out, *in)
+	}
 	out.ScheduleConfig = in.ScheduleConfig
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(TaskAffinity)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Parties != nil {
 		in, out := &in.Parties, &out.Parties
 		*out = make([]PartyInfo, len(*in))
@@ -2270,7 +3296,9 @@ func (in *KusciaTaskStatus) DeepCopyInto(out *KusciaTaskStatus) {
 	if in.PartyTaskStatus != nil {
 		in, out := &in.PartyTaskStatus, &out.PartyTaskStatus
 		*out = make([]PartyTaskStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2469,11 +3497,21 @@ func (in *KusciaTaskTemplate) DeepCopyInto(out *KusciaTaskTemplate) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SensitiveInputConfigs != nil {
+		in, out := &in.SensitiveInputConfigs, &out.SensitiveInputConfigs
+		*out = make([]SensitiveInputConfig, len(*in))
+		copy(*out, *in)
+	}
 	if in.ScheduleConfig != nil {
 		in, out := &in.ScheduleConfig, &out.ScheduleConfig
 		*out = new(ScheduleConfig)
 		**out = **in
 	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(TaskAffinity)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Parties != nil {
 		in, out := &in.Parties, &out.Parties
 		*out = make([]Party, len(*in))
@@ -2621,6 +3659,27 @@ func (in *PartyAllocatedPorts) DeepCopy() *PartyAllocatedPorts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartyAppImageVersions) DeepCopyInto(out *PartyAppImageVersions) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartyAppImageVersions.
+func (in *PartyAppImageVersions) DeepCopy() *PartyAppImageVersions {
+	if in == nil {
+		return nil
+	}
+	out := new(PartyAppImageVersions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PartyInfo) DeepCopyInto(out *PartyInfo) {
 	*out = *in
@@ -2643,6 +3702,22 @@ func (in *PartyInfo) DeepCopy() *PartyInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartyTaskCheckpoint) DeepCopyInto(out *PartyTaskCheckpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartyTaskCheckpoint.
+func (in *PartyTaskCheckpoint) DeepCopy() *PartyTaskCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(PartyTaskCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PartyTaskCreateStatus) DeepCopyInto(out *PartyTaskCreateStatus) {
 	*out = *in
@@ -2662,6 +3737,16 @@ func (in *PartyTaskCreateStatus) DeepCopy() *PartyTaskCreateStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PartyTaskStatus) DeepCopyInto(out *PartyTaskStatus) {
 	*out = *in
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = new(ResourceUsage)
+		**out = **in
+	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(TaskCheckpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2697,6 +3782,33 @@ func (in *PartyTemplate) DeepCopy() *PartyTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimTemplate) DeepCopyInto(out *PersistentVolumeClaimTemplate) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	out.Storage = in.Storage.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimTemplate.
+func (in *PersistentVolumeClaimTemplate) DeepCopy() *PersistentVolumeClaimTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSpec) DeepCopyInto(out *PodSpec) {
 	*out = *in
@@ -2707,11 +3819,39 @@ func (in *PodSpec) DeepCopyInto(out *PodSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
 		*out = new(v1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2757,6 +3897,22 @@ func (in *PodStatus) DeepCopy() *PodStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceUsage) DeepCopyInto(out *ResourceUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceUsage.
+func (in *ResourceUsage) DeepCopy() *ResourceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleConfig) DeepCopyInto(out *ScheduleConfig) {
 	*out = *in
@@ -2789,6 +3945,22 @@ func (in *SchedulerPluginArgs) DeepCopy() *SchedulerPluginArgs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SensitiveInputConfig) DeepCopyInto(out *SensitiveInputConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SensitiveInputConfig.
+func (in *SensitiveInputConfig) DeepCopy() *SensitiveInputConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SensitiveInputConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
 	*out = *in
@@ -2813,6 +3985,52 @@ func (in *ServiceStatus) DeepCopy() *ServiceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskAffinity) DeepCopyInto(out *TaskAffinity) {
+	*out = *in
+	if in.ColocateWith != nil {
+		in, out := &in.ColocateWith, &out.ColocateWith
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SeparateFrom != nil {
+		in, out := &in.SeparateFrom, &out.SeparateFrom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskAffinity.
+func (in *TaskAffinity) DeepCopy() *TaskAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskCheckpoint) DeepCopyInto(out *TaskCheckpoint) {
+	*out = *in
+	if in.ReportTime != nil {
+		in, out := &in.ReportTime, &out.ReportTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskCheckpoint.
+func (in *TaskCheckpoint) DeepCopy() *TaskCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskResource) DeepCopyInto(out *TaskResource) {
 	*out = *in
@@ -2979,6 +4197,22 @@ func (in *TaskResourceGroupPartyPod) DeepCopy() *TaskResourceGroupPartyPod {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskResourceGroupPartyReservation) DeepCopyInto(out *TaskResourceGroupPartyReservation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskResourceGroupPartyReservation.
+func (in *TaskResourceGroupPartyReservation) DeepCopy() *TaskResourceGroupPartyReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskResourceGroupPartyReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskResourceGroupSpec) DeepCopyInto(out *TaskResourceGroupSpec) {
 	*out = *in
@@ -3031,6 +4265,11 @@ func (in *TaskResourceGroupStatus) DeepCopyInto(out *TaskResourceGroupStatus) {
 		in, out := &in.LastTransitionTime, &out.LastTransitionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.PartyReservations != nil {
+		in, out := &in.PartyReservations, &out.PartyReservations
+		*out = make([]TaskResourceGroupPartyReservation, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3222,3 +4461,34 @@ func (in *UseRecord) DeepCopy() *UseRecord {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Volume) DeepCopyInto(out *Volume) {
+	*out = *in
+	if in.EmptyDir != nil {
+		in, out := &in.EmptyDir, &out.EmptyDir
+		*out = new(v1.EmptyDirVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(PersistentVolumeClaimTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostDirectory != nil {
+		in, out := &in.HostDirectory, &out.HostDirectory
+		*out = new(HostDirectoryVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Volume.
+func (in *Volume) DeepCopy() *Volume {
+	if in == nil {
+		return nil
+	}
+	out := new(Volume)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeImagePrewarms implements ImagePrewarmInterface
+type FakeImagePrewarms struct {
+	Fake *FakeKusciaV1alpha1
+	ns   string
+}
+
+var imageprewarmsResource = schema.GroupVersionResource{Group: "kuscia.secretflow", Version: "v1alpha1", Resource: "imageprewarms"}
+
+var imageprewarmsKind = schema.GroupVersionKind{Group: "kuscia.secretflow", Version: "v1alpha1", Kind: "ImagePrewarm"}
+
+// Get takes name of the imagePrewarm, and returns the corresponding imagePrewarm object, and an error if there is any.
+func (c *FakeImagePrewarms) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ImagePrewarm, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(imageprewarmsResource, c.ns, name), &v1alpha1.ImagePrewarm{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ImagePrewarm), err
+}
+
+// List takes label and field selectors, and returns the list of ImagePrewarms that match those selectors.
+func (c *FakeImagePrewarms) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ImagePrewarmList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(imageprewarmsResource, imageprewarmsKind, c.ns, opts), &v1alpha1.ImagePrewarmList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ImagePrewarmList{ListMeta: obj.(*v1alpha1.ImagePrewarmList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ImagePrewarmList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested imagePrewarms.
+func (c *FakeImagePrewarms) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(imageprewarmsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a imagePrewarm and creates it.  Returns the server's representation of the imagePrewarm, and an error, if there is any.
+func (c *FakeImagePrewarms) Create(ctx context.Context, imagePrewarm *v1alpha1.ImagePrewarm, opts v1.CreateOptions) (result *v1alpha1.ImagePrewarm, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(imageprewarmsResource, c.ns, imagePrewarm), &v1alpha1.ImagePrewarm{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ImagePrewarm), err
+}
+
+// Update takes the representation of a imagePrewarm and updates it. Returns the server's representation of the imagePrewarm, and an error, if there is any.
+func (c *FakeImagePrewarms) Update(ctx context.Context, imagePrewarm *v1alpha1.ImagePrewarm, opts v1.UpdateOptions) (result *v1alpha1.ImagePrewarm, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(imageprewarmsResource, c.ns, imagePrewarm), &v1alpha1.ImagePrewarm{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ImagePrewarm), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeImagePrewarms) UpdateStatus(ctx context.Context, imagePrewarm *v1alpha1.ImagePrewarm, opts v1.UpdateOptions) (*v1alpha1.ImagePrewarm, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(imageprewarmsResource, "status", c.ns, imagePrewarm), &v1alpha1.ImagePrewarm{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ImagePrewarm), err
+}
+
+// Delete takes name of the imagePrewarm and deletes it. Returns an error if one occurs.
+func (c *FakeImagePrewarms) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(imageprewarmsResource, c.ns, name, opts), &v1alpha1.ImagePrewarm{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeImagePrewarms) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(imageprewarmsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ImagePrewarmList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched imagePrewarm.
+func (c *FakeImagePrewarms) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ImagePrewarm, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(imageprewarmsResource, c.ns, name, pt, data, subresources...), &v1alpha1.ImagePrewarm{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ImagePrewarm), err
+}
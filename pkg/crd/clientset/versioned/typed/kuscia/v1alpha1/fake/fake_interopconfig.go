@@ -94,6 +94,17 @@ func (c *FakeInteropConfigs) Update(ctx context.Context, interopConfig *v1alpha1
 	return obj.(*v1alpha1.InteropConfig), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeInteropConfigs) UpdateStatus(ctx context.Context, interopConfig *v1alpha1.InteropConfig, opts v1.UpdateOptions) (*v1alpha1.InteropConfig, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(interopconfigsResource, "status", interopConfig), &v1alpha1.InteropConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.InteropConfig), err
+}
+
 // Delete takes name of the interopConfig and deletes it. Returns an error if one occurs.
 func (c *FakeInteropConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
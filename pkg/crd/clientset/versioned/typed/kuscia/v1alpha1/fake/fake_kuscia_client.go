@@ -62,6 +62,10 @@ func (c *FakeKusciaV1alpha1) Gateways(namespace string) v1alpha1.GatewayInterfac
 	return &FakeGateways{c, namespace}
 }
 
+func (c *FakeKusciaV1alpha1) ImagePrewarms(namespace string) v1alpha1.ImagePrewarmInterface {
+	return &FakeImagePrewarms{c, namespace}
+}
+
 func (c *FakeKusciaV1alpha1) InteropConfigs() v1alpha1.InteropConfigInterface {
 	return &FakeInteropConfigs{c}
 }
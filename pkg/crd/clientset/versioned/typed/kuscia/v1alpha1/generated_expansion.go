@@ -34,6 +34,8 @@ type DomainRouteExpansion interface{}
 
 type GatewayExpansion interface{}
 
+type ImagePrewarmExpansion interface{}
+
 type InteropConfigExpansion interface{}
 
 type KusciaDeploymentExpansion interface{}
@@ -0,0 +1,193 @@
+// Copyright 2023 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	scheme "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ImagePrewarmsGetter has a method to return a ImagePrewarmInterface.
+// A group's client should implement this interface.
+type ImagePrewarmsGetter interface {
+	ImagePrewarms(namespace string) ImagePrewarmInterface
+}
+
+// ImagePrewarmInterface has methods to work with ImagePrewarm resources.
+type ImagePrewarmInterface interface {
+	Create(ctx context.Context, imagePrewarm *v1alpha1.ImagePrewarm, opts v1.CreateOptions) (*v1alpha1.ImagePrewarm, error)
+	Update(ctx context.Context, imagePrewarm *v1alpha1.ImagePrewarm, opts v1.UpdateOptions) (*v1alpha1.ImagePrewarm, error)
+	UpdateStatus(ctx context.Context, imagePrewarm *v1alpha1.ImagePrewarm, opts v1.UpdateOptions) (*v1alpha1.ImagePrewarm, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ImagePrewarm, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ImagePrewarmList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ImagePrewarm, err error)
+	ImagePrewarmExpansion
+}
+
+// imagePrewarms implements ImagePrewarmInterface
+type imagePrewarms struct {
+	client rest.Interface
+	ns     string
+}
+
+// newImagePrewarms returns a ImagePrewarms
+func newImagePrewarms(c *KusciaV1alpha1Client, namespace string) *imagePrewarms {
+	return &imagePrewarms{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the imagePrewarm, and returns the corresponding imagePrewarm object, and an error if there is any.
+func (c *imagePrewarms) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ImagePrewarm, err error) {
+	result = &v1alpha1.ImagePrewarm{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("imageprewarms").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ImagePrewarms that match those selectors.
+func (c *imagePrewarms) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ImagePrewarmList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ImagePrewarmList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("imageprewarms").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested imagePrewarms.
+func (c *imagePrewarms) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("imageprewarms").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a imagePrewarm and creates it.  Returns the server's representation of the imagePrewarm, and an error, if there is any.
+func (c *imagePrewarms) Create(ctx context.Context, imagePrewarm *v1alpha1.ImagePrewarm, opts v1.CreateOptions) (result *v1alpha1.ImagePrewarm, err error) {
+	result = &v1alpha1.ImagePrewarm{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("imageprewarms").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(imagePrewarm).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a imagePrewarm and updates it. Returns the server's representation of the imagePrewarm, and an error, if there is any.
+func (c *imagePrewarms) Update(ctx context.Context, imagePrewarm *v1alpha1.ImagePrewarm, opts v1.UpdateOptions) (result *v1alpha1.ImagePrewarm, err error) {
+	result = &v1alpha1.ImagePrewarm{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("imageprewarms").
+		Name(imagePrewarm.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(imagePrewarm).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *imagePrewarms) UpdateStatus(ctx context.Context, imagePrewarm *v1alpha1.ImagePrewarm, opts v1.UpdateOptions) (result *v1alpha1.ImagePrewarm, err error) {
+	result = &v1alpha1.ImagePrewarm{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("imageprewarms").
+		Name(imagePrewarm.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(imagePrewarm).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the imagePrewarm and deletes it. Returns an error if one occurs.
+func (c *imagePrewarms) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("imageprewarms").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *imagePrewarms) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("imageprewarms").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched imagePrewarm.
+func (c *imagePrewarms) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ImagePrewarm, err error) {
+	result = &v1alpha1.ImagePrewarm{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("imageprewarms").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -38,6 +38,7 @@ type InteropConfigsGetter interface {
 type InteropConfigInterface interface {
 	Create(ctx context.Context, interopConfig *v1alpha1.InteropConfig, opts v1.CreateOptions) (*v1alpha1.InteropConfig, error)
 	Update(ctx context.Context, interopConfig *v1alpha1.InteropConfig, opts v1.UpdateOptions) (*v1alpha1.InteropConfig, error)
+	UpdateStatus(ctx context.Context, interopConfig *v1alpha1.InteropConfig, opts v1.UpdateOptions) (*v1alpha1.InteropConfig, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.InteropConfig, error)
@@ -126,6 +127,21 @@ func (c *interopConfigs) Update(ctx context.Context, interopConfig *v1alpha1.Int
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *interopConfigs) UpdateStatus(ctx context.Context, interopConfig *v1alpha1.InteropConfig, opts v1.UpdateOptions) (result *v1alpha1.InteropConfig, err error) {
+	result = &v1alpha1.InteropConfig{}
+	err = c.client.Put().
+		Resource("interopconfigs").
+		Name(interopConfig.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(interopConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the interopConfig and deletes it. Returns an error if one occurs.
 func (c *interopConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -35,6 +35,7 @@ type KusciaV1alpha1Interface interface {
 	DomainDataSourcesGetter
 	DomainRoutesGetter
 	GatewaysGetter
+	ImagePrewarmsGetter
 	InteropConfigsGetter
 	KusciaDeploymentsGetter
 	KusciaDeploymentSummariesGetter
@@ -87,6 +88,10 @@ func (c *KusciaV1alpha1Client) Gateways(namespace string) GatewayInterface {
 	return newGateways(c, namespace)
 }
 
+func (c *KusciaV1alpha1Client) ImagePrewarms(namespace string) ImagePrewarmInterface {
+	return newImagePrewarms(c, namespace)
+}
+
 func (c *KusciaV1alpha1Client) InteropConfigs() InteropConfigInterface {
 	return newInteropConfigs(c)
 }
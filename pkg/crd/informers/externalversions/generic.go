@@ -69,6 +69,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kuscia().V1alpha1().DomainRoutes().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("gateways"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kuscia().V1alpha1().Gateways().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("imageprewarms"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kuscia().V1alpha1().ImagePrewarms().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("interopconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kuscia().V1alpha1().InteropConfigs().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("kusciadeployments"):
@@ -40,6 +40,8 @@ type Interface interface {
 	DomainRoutes() DomainRouteInformer
 	// Gateways returns a GatewayInformer.
 	Gateways() GatewayInformer
+	// ImagePrewarms returns a ImagePrewarmInformer.
+	ImagePrewarms() ImagePrewarmInformer
 	// InteropConfigs returns a InteropConfigInformer.
 	InteropConfigs() InteropConfigInformer
 	// KusciaDeployments returns a KusciaDeploymentInformer.
@@ -116,6 +118,11 @@ func (v *version) Gateways() GatewayInformer {
 	return &gatewayInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ImagePrewarms returns a ImagePrewarmInformer.
+func (v *version) ImagePrewarms() ImagePrewarmInformer {
+	return &imagePrewarmInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // InteropConfigs returns a InteropConfigInformer.
 func (v *version) InteropConfigs() InteropConfigInformer {
 	return &interopConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
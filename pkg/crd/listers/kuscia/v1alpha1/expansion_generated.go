@@ -76,6 +76,14 @@ type GatewayListerExpansion interface{}
 // GatewayNamespaceLister.
 type GatewayNamespaceListerExpansion interface{}
 
+// ImagePrewarmListerExpansion allows custom methods to be added to
+// ImagePrewarmLister.
+type ImagePrewarmListerExpansion interface{}
+
+// ImagePrewarmNamespaceListerExpansion allows custom methods to be added to
+// ImagePrewarmNamespaceLister.
+type ImagePrewarmNamespaceListerExpansion interface{}
+
 // InteropConfigListerExpansion allows custom methods to be added to
 // InteropConfigLister.
 type InteropConfigListerExpansion interface{}
@@ -0,0 +1,97 @@
+// Copyright 2023 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ImagePrewarmLister helps list ImagePrewarms.
+// All objects returned here must be treated as read-only.
+type ImagePrewarmLister interface {
+	// List lists all ImagePrewarms in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ImagePrewarm, err error)
+	// ImagePrewarms returns an object that can list and get ImagePrewarms.
+	ImagePrewarms(namespace string) ImagePrewarmNamespaceLister
+	ImagePrewarmListerExpansion
+}
+
+// imagePrewarmLister implements the ImagePrewarmLister interface.
+type imagePrewarmLister struct {
+	indexer cache.Indexer
+}
+
+// NewImagePrewarmLister returns a new ImagePrewarmLister.
+func NewImagePrewarmLister(indexer cache.Indexer) ImagePrewarmLister {
+	return &imagePrewarmLister{indexer: indexer}
+}
+
+// List lists all ImagePrewarms in the indexer.
+func (s *imagePrewarmLister) List(selector labels.Selector) (ret []*v1alpha1.ImagePrewarm, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ImagePrewarm))
+	})
+	return ret, err
+}
+
+// ImagePrewarms returns an object that can list and get ImagePrewarms.
+func (s *imagePrewarmLister) ImagePrewarms(namespace string) ImagePrewarmNamespaceLister {
+	return imagePrewarmNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ImagePrewarmNamespaceLister helps list and get ImagePrewarms.
+// All objects returned here must be treated as read-only.
+type ImagePrewarmNamespaceLister interface {
+	// List lists all ImagePrewarms in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ImagePrewarm, err error)
+	// Get retrieves the ImagePrewarm from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ImagePrewarm, error)
+	ImagePrewarmNamespaceListerExpansion
+}
+
+// imagePrewarmNamespaceLister implements the ImagePrewarmNamespaceLister
+// interface.
+type imagePrewarmNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ImagePrewarms in the indexer for a given namespace.
+func (s imagePrewarmNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ImagePrewarm, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ImagePrewarm))
+	})
+	return ret, err
+}
+
+// Get retrieves the ImagePrewarm from the indexer for a given namespace and name.
+func (s imagePrewarmNamespaceLister) Get(name string) (*v1alpha1.ImagePrewarm, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("imageprewarm"), name)
+	}
+	return obj.(*v1alpha1.ImagePrewarm), nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datactl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// Client manages DomainData and DomainDataGrant through KusciaAPI.
+type Client struct {
+	dataClient  kusciaapi.DomainDataServiceClient
+	grantClient kusciaapi.DomainDataGrantServiceClient
+}
+
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		dataClient:  kusciaapi.NewDomainDataServiceClient(conn),
+		grantClient: kusciaapi.NewDomainDataGrantServiceClient(conn),
+	}
+}
+
+// GrantOptions describes a grant of a domain data to a partner.
+type GrantOptions struct {
+	DomainID     string
+	DomainDataID string
+	GrantID      string
+	GrantDomain  string
+	// Expire is the duration the grant is valid for, 0 means never expire.
+	Expire     time.Duration
+	UseCount   int32
+	Components []string
+}
+
+// CreateDomainData creates the domain data and returns its id.
+func (c *Client) CreateDomainData(ctx context.Context, request *kusciaapi.CreateDomainDataRequest) (string, error) {
+	resp, err := c.dataClient.CreateDomainData(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return "", fmt.Errorf("create domain data failed, %v", err)
+	}
+	return resp.GetData().GetDomaindataId(), nil
+}
+
+func (c *Client) ListDomainData(ctx context.Context, domainID, dataType, vendor string) ([]*kusciaapi.DomainData, error) {
+	resp, err := c.dataClient.ListDomainData(ctx, &kusciaapi.ListDomainDataRequest{
+		Data: &kusciaapi.ListDomainDataRequestData{
+			DomainId:         domainID,
+			DomaindataType:   dataType,
+			DomaindataVendor: vendor,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return nil, fmt.Errorf("list domain data of %s failed, %v", domainID, err)
+	}
+	return resp.GetData().GetDomaindataList(), nil
+}
+
+func (c *Client) QueryDomainData(ctx context.Context, domainID, domainDataID string) (*kusciaapi.DomainData, error) {
+	resp, err := c.dataClient.QueryDomainData(ctx, &kusciaapi.QueryDomainDataRequest{
+		Data: &kusciaapi.QueryDomainDataRequestData{
+			DomainId:     domainID,
+			DomaindataId: domainDataID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return nil, fmt.Errorf("query domain data %s/%s failed, %v", domainID, domainDataID, err)
+	}
+	return resp.GetData(), nil
+}
+
+// CreateGrant creates the domain data grant and returns its id.
+func (c *Client) CreateGrant(ctx context.Context, request *kusciaapi.CreateDomainDataGrantRequest) (string, error) {
+	resp, err := c.grantClient.CreateDomainDataGrant(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return "", fmt.Errorf("create domain data grant failed, %v", err)
+	}
+	return resp.GetData().GetDomaindatagrantId(), nil
+}
+
+// Grant grants the domain data to a partner, it checks the domain data exists before creating the grant.
+func (c *Client) Grant(ctx context.Context, opts *GrantOptions) (string, error) {
+	if opts.GrantDomain == "" {
+		return "", fmt.Errorf("grant domain is required")
+	}
+	if opts.GrantDomain == opts.DomainID {
+		return "", fmt.Errorf("can't grant domain data to its own domain %s", opts.DomainID)
+	}
+	if _, err := c.QueryDomainData(ctx, opts.DomainID, opts.DomainDataID); err != nil {
+		return "", err
+	}
+	return c.CreateGrant(ctx, BuildGrantRequest(opts, time.Now()))
+}
+
+// BuildGrantRequest converts the options to a CreateDomainDataGrantRequest, the expiration is relative to now.
+func BuildGrantRequest(opts *GrantOptions, now time.Time) *kusciaapi.CreateDomainDataGrantRequest {
+	request := &kusciaapi.CreateDomainDataGrantRequest{
+		DomaindatagrantId: opts.GrantID,
+		DomaindataId:      opts.DomainDataID,
+		GrantDomain:       opts.GrantDomain,
+		DomainId:          opts.DomainID,
+	}
+	if opts.Expire > 0 || opts.UseCount > 0 || len(opts.Components) > 0 {
+		request.Limit = &kusciaapi.GrantLimit{
+			UseCount:   opts.UseCount,
+			Components: opts.Components,
+		}
+		if opts.Expire > 0 {
+			// expiration_time is in nanoseconds
+			request.Limit.ExpirationTime = now.Add(opts.Expire).UnixNano()
+		}
+	}
+	return request
+}
+
+func (c *Client) ListGrants(ctx context.Context, domainID, grantDomain string) ([]*kusciaapi.DomainDataGrant, error) {
+	resp, err := c.grantClient.ListDomainDataGrant(ctx, &kusciaapi.ListDomainDataGrantRequest{
+		Data: &kusciaapi.ListDomainDataGrantRequestData{
+			DomainId:    domainID,
+			GrantDomain: grantDomain,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return nil, fmt.Errorf("list domain data grants of %s failed, %v", domainID, err)
+	}
+	return resp.GetData().GetDomaindatagrantList(), nil
+}
+
+func (c *Client) QueryGrant(ctx context.Context, domainID, grantID string) (*kusciaapi.DomainDataGrant, error) {
+	resp, err := c.grantClient.QueryDomainDataGrant(ctx, &kusciaapi.QueryDomainDataGrantRequest{
+		DomainId:          domainID,
+		DomaindatagrantId: grantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return nil, fmt.Errorf("query domain data grant %s/%s failed, %v", domainID, grantID, err)
+	}
+	return resp.GetData(), nil
+}
+
+func checkStatus(status *v1alpha1.Status) error {
+	if status == nil {
+		return fmt.Errorf("empty response status")
+	}
+	if status.Code != 0 {
+		return fmt.Errorf("code: %d, message: %s", status.Code, status.Message)
+	}
+	return nil
+}
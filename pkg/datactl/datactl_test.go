@@ -0,0 +1,149 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datactl
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"sigs.k8s.io/yaml"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type fakeDataClient struct {
+	kusciaapi.DomainDataServiceClient
+	exists bool
+}
+
+func (f *fakeDataClient) QueryDomainData(ctx context.Context, in *kusciaapi.QueryDomainDataRequest, opts ...grpc.CallOption) (*kusciaapi.QueryDomainDataResponse, error) {
+	if !f.exists {
+		return &kusciaapi.QueryDomainDataResponse{Status: &v1alpha1.Status{Code: 11302, Message: "domaindata not found"}}, nil
+	}
+	return &kusciaapi.QueryDomainDataResponse{
+		Status: &v1alpha1.Status{},
+		Data:   &kusciaapi.DomainData{DomaindataId: in.Data.DomaindataId, DomainId: in.Data.DomainId},
+	}, nil
+}
+
+type fakeGrantClient struct {
+	kusciaapi.DomainDataGrantServiceClient
+	created *kusciaapi.CreateDomainDataGrantRequest
+}
+
+func (f *fakeGrantClient) CreateDomainDataGrant(ctx context.Context, in *kusciaapi.CreateDomainDataGrantRequest, opts ...grpc.CallOption) (*kusciaapi.CreateDomainDataGrantResponse, error) {
+	f.created = in
+	return &kusciaapi.CreateDomainDataGrantResponse{
+		Status: &v1alpha1.Status{},
+		Data:   &kusciaapi.CreateDomainDataGrantResponseData{DomaindatagrantId: "grant-1"},
+	}, nil
+}
+
+func TestGrant(t *testing.T) {
+	grantClient := &fakeGrantClient{}
+	c := &Client{dataClient: &fakeDataClient{exists: true}, grantClient: grantClient}
+	id, err := c.Grant(context.Background(), &GrantOptions{DomainID: "alice", DomainDataID: "table-1", GrantDomain: "bob", Expire: time.Hour})
+	assert.NoError(t, err)
+	assert.Equal(t, "grant-1", id)
+	assert.Equal(t, "bob", grantClient.created.GrantDomain)
+	assert.Greater(t, grantClient.created.Limit.ExpirationTime, time.Now().UnixNano())
+
+	_, err = c.Grant(context.Background(), &GrantOptions{DomainID: "alice", DomainDataID: "table-1", GrantDomain: "alice"})
+	assert.Error(t, err)
+
+	c = &Client{dataClient: &fakeDataClient{}, grantClient: grantClient}
+	_, err = c.Grant(context.Background(), &GrantOptions{DomainID: "alice", DomainDataID: "table-2", GrantDomain: "bob"})
+	assert.ErrorContains(t, err, "domaindata not found")
+}
+
+func TestBuildGrantRequest(t *testing.T) {
+	now := time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC)
+	request := BuildGrantRequest(&GrantOptions{DomainID: "alice", DomainDataID: "table-1", GrantDomain: "bob"}, now)
+	assert.Nil(t, request.Limit)
+
+	request = BuildGrantRequest(&GrantOptions{DomainID: "alice", DomainDataID: "table-1", GrantDomain: "bob",
+		Expire: 24 * time.Hour, UseCount: 3, Components: []string{"psi"}}, now)
+	assert.Equal(t, now.Add(24*time.Hour).UnixNano(), request.Limit.ExpirationTime)
+	assert.Equal(t, int32(3), request.Limit.UseCount)
+	assert.Equal(t, []string{"psi"}, request.Limit.Components)
+}
+
+func TestParseRequest(t *testing.T) {
+	request := &kusciaapi.CreateDomainDataRequest{}
+	err := ParseRequest([]byte(`
+domain_id: alice
+domaindata_id: table-1
+type: table
+relative_uri: alice.csv
+columns:
+- name: id
+  type: str
+`), request)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice.csv", request.RelativeUri)
+	assert.Len(t, request.Columns, 1)
+
+	assert.Error(t, ParseRequest([]byte("grant_domain: bob\nunknown: 1\n"), &kusciaapi.CreateDomainDataGrantRequest{}))
+}
+
+func TestPrintGrantList(t *testing.T) {
+	expiration := time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC)
+	list := []*kusciaapi.DomainDataGrant{{
+		Data: &kusciaapi.DomainDataGrantData{
+			DomaindatagrantId: "grant-1",
+			DomaindataId:      "table-1",
+			GrantDomain:       "bob",
+			Limit:             &kusciaapi.GrantLimit{ExpirationTime: expiration.UnixNano()},
+		},
+		Status: &kusciaapi.DomainDataGrantStatus{Phase: "Ready"},
+	}, {
+		Data: &kusciaapi.DomainDataGrantData{DomaindatagrantId: "grant-2", DomaindataId: "table-2", GrantDomain: "carol"},
+	}}
+
+	out := &bytes.Buffer{}
+	assert.NoError(t, PrintGrantList(out, FormatTable, list))
+	assert.Contains(t, out.String(), expiration.Local().Format(time.RFC3339))
+	assert.Contains(t, out.String(), "never")
+	assert.Contains(t, out.String(), "unlimited")
+
+	out.Reset()
+	assert.NoError(t, PrintGrantList(out, FormatYAML, list))
+	parsed := map[string]interface{}{}
+	assert.NoError(t, yaml.Unmarshal(out.Bytes(), &parsed))
+	assert.Len(t, parsed["domaindatagrant_list"], 2)
+
+	out.Reset()
+	assert.NoError(t, PrintGrantList(out, FormatJSON, list))
+	assert.Contains(t, out.String(), `"domaindatagrant_id": "grant-1"`)
+}
+
+func TestPrintDomainData(t *testing.T) {
+	out := &bytes.Buffer{}
+	data := &kusciaapi.DomainData{
+		DomaindataId: "table-1",
+		Attributes:   map[string]string{"b": "2", "a": "1"},
+		Columns:      []*v1alpha1.DataColumn{{Name: "id", Type: "str"}},
+	}
+	assert.NoError(t, PrintDomainData(out, FormatTable, data))
+	assert.Contains(t, out.String(), "a=1,b=2")
+	assert.Contains(t, out.String(), "COLUMN")
+
+	assert.Error(t, ValidateFormat("xml"))
+}
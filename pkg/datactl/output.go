@@ -0,0 +1,211 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datactl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// Output formats supported by the print functions.
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatYAML  = "yaml"
+)
+
+// ValidateFormat checks the output format is supported.
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatTable, FormatJSON, FormatYAML:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q, must be one of table, json, yaml", format)
+	}
+}
+
+// LoadRequest reads a YAML or JSON file into the KusciaAPI request message.
+func LoadRequest(file string, request proto.Message) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("read file %s failed, %v", file, err)
+	}
+	return ParseRequest(content, request)
+}
+
+// ParseRequest parses YAML or JSON content into the KusciaAPI request message.
+func ParseRequest(content []byte, request proto.Message) error {
+	jsonContent, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return fmt.Errorf("parse file failed, %v", err)
+	}
+	if err := protojson.Unmarshal(jsonContent, request); err != nil {
+		return fmt.Errorf("file doesn't match the %s, %v", request.ProtoReflect().Descriptor().Name(), err)
+	}
+	return nil
+}
+
+func PrintDomainDataList(w io.Writer, format string, list []*kusciaapi.DomainData) error {
+	if format != FormatTable {
+		return printMessage(w, format, &kusciaapi.DomainDataList{DomaindataList: list})
+	}
+	table := newTable(w, []string{"DOMAINDATA ID", "NAME", "TYPE", "VENDOR", "DATASOURCE", "RELATIVE URI", "STATUS", "AUTHOR"})
+	for _, d := range list {
+		table.Append([]string{d.DomaindataId, d.Name, d.Type, d.Vendor, d.DatasourceId, d.RelativeUri, d.Status, d.Author})
+	}
+	table.Render()
+	return nil
+}
+
+func PrintDomainData(w io.Writer, format string, data *kusciaapi.DomainData) error {
+	if format != FormatTable {
+		return printMessage(w, format, data)
+	}
+	table := newTable(w, []string{"FIELD", "VALUE"})
+	table.AppendBulk([][]string{
+		{"DomainData ID", data.DomaindataId},
+		{"Domain ID", data.DomainId},
+		{"Name", data.Name},
+		{"Type", data.Type},
+		{"Vendor", data.Vendor},
+		{"Datasource ID", data.DatasourceId},
+		{"Relative URI", data.RelativeUri},
+		{"File Format", data.FileFormat.String()},
+		{"Status", data.Status},
+		{"Author", data.Author},
+		{"Attributes", formatMap(data.Attributes)},
+	})
+	table.Render()
+	if len(data.Columns) > 0 {
+		columns := newTable(w, []string{"COLUMN", "TYPE", "COMMENT"})
+		for _, c := range data.Columns {
+			columns.Append([]string{c.Name, c.Type, c.Comment})
+		}
+		columns.Render()
+	}
+	return nil
+}
+
+func PrintGrantList(w io.Writer, format string, list []*kusciaapi.DomainDataGrant) error {
+	if format != FormatTable {
+		return printMessage(w, format, &kusciaapi.DomainDataGrantList{DomaindatagrantList: list})
+	}
+	table := newTable(w, []string{"GRANT ID", "DOMAINDATA ID", "GRANT DOMAIN", "AUTHOR", "EXPIRATION", "USE COUNT", "PHASE"})
+	for _, g := range list {
+		data := g.GetData()
+		table.Append([]string{data.GetDomaindatagrantId(), data.GetDomaindataId(), data.GetGrantDomain(), data.GetAuthor(),
+			formatExpiration(data.GetLimit()), formatUseCount(data.GetLimit()), g.GetStatus().GetPhase()})
+	}
+	table.Render()
+	return nil
+}
+
+func PrintGrant(w io.Writer, format string, grant *kusciaapi.DomainDataGrant) error {
+	if format != FormatTable {
+		return printMessage(w, format, grant)
+	}
+	data, limit := grant.GetData(), grant.GetData().GetLimit()
+	table := newTable(w, []string{"FIELD", "VALUE"})
+	table.AppendBulk([][]string{
+		{"Grant ID", data.GetDomaindatagrantId()},
+		{"Domain ID", data.GetDomainId()},
+		{"DomainData ID", data.GetDomaindataId()},
+		{"Grant Domain", data.GetGrantDomain()},
+		{"Author", data.GetAuthor()},
+		{"Expiration", formatExpiration(limit)},
+		{"Use Count", formatUseCount(limit)},
+		{"Components", strings.Join(limit.GetComponents(), ",")},
+		{"Initiator", limit.GetInitiator()},
+		{"Flow ID", limit.GetFlowId()},
+		{"Description", formatMap(data.GetDescription())},
+		{"Phase", grant.GetStatus().GetPhase()},
+		{"Message", grant.GetStatus().GetMessage()},
+	})
+	table.Render()
+	if records := grant.GetStatus().GetRecords(); len(records) > 0 {
+		recordTable := newTable(w, []string{"USE TIME", "GRANT DOMAIN", "COMPONENT", "OUTPUT"})
+		for _, r := range records {
+			recordTable.Append([]string{time.Unix(0, r.UseTime).Format(time.RFC3339), r.GrantDomain, r.Component, r.Output})
+		}
+		recordTable.Render()
+	}
+	return nil
+}
+
+func printMessage(w io.Writer, format string, msg proto.Message) error {
+	content, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if format == FormatYAML {
+		if content, err = yaml.JSONToYAML(content); err != nil {
+			return err
+		}
+		_, err = w.Write(content)
+		return err
+	}
+	// protojson randomizes the whitespaces, re-indent it for a stable output
+	buf := &bytes.Buffer{}
+	if err := json.Indent(buf, content, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+func newTable(w io.Writer, header []string) *tablewriter.Table {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader(header)
+	table.SetAutoWrapText(false)
+	return table
+}
+
+func formatExpiration(limit *kusciaapi.GrantLimit) string {
+	if limit.GetExpirationTime() <= 0 {
+		return "never"
+	}
+	return time.Unix(0, limit.GetExpirationTime()).Format(time.RFC3339)
+}
+
+func formatUseCount(limit *kusciaapi.GrantLimit) string {
+	if limit.GetUseCount() <= 0 {
+		return "unlimited"
+	}
+	return strconv.Itoa(int(limit.GetUseCount()))
+}
+
+func formatMap(m map[string]string) string {
+	pairs := make([]string, 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
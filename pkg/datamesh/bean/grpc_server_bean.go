@@ -96,9 +96,10 @@ func (s *grpcServerBean) Start(ctx context.Context, e framework.ConfBeanRegistry
 	datasourceService := service.NewDomainDataSourceService(s.config, s.cmConfigService)
 	datamesh.RegisterDomainDataServiceServer(server, grpchandler.NewDomainDataHandler(domainDataService))
 	datamesh.RegisterDomainDataSourceServiceServer(server, grpchandler.NewDomainDataSourceHandler(datasourceService))
-	datamesh.RegisterDomainDataGrantServiceServer(server, grpchandler.NewDomainDataGrantHandler(service.NewDomainDataGrantService(s.config)))
+	domainDataGrantService := service.NewDomainDataGrantService(s.config)
+	datamesh.RegisterDomainDataGrantServiceServer(server, grpchandler.NewDomainDataGrantHandler(domainDataGrantService))
 
-	flight.RegisterFlightServiceServer(server, handler.NewDataMeshFlightHandler(domainDataService, datasourceService, s.config.DataProxyList))
+	flight.RegisterFlightServiceServer(server, handler.NewDataMeshFlightHandler(domainDataService, datasourceService, domainDataGrantService, s.config.DataProxyList))
 
 	reflection.Register(server)
 
@@ -51,10 +51,11 @@ func Run(ctx context.Context, conf *config.DataMeshConfig, kusciaClient kusciacl
 func injectBean(ctx context.Context, conf *config.DataMeshConfig, appEngine *engine.Engine) error {
 	// init cm service
 	cmConfigService, err := cmservice.NewConfigService(ctx, &cmservice.ConfigServiceConfig{
-		DomainID:   conf.KubeNamespace,
-		DomainKey:  conf.DomainKey,
-		Driver:     driver.CRDDriverType,
-		KubeClient: conf.KubeClient,
+		DomainID:      conf.KubeNamespace,
+		DomainKey:     conf.DomainKey,
+		SecretBackend: conf.SecretBackend,
+		Driver:        driver.CRDDriverType,
+		KubeClient:    conf.KubeClient,
 	})
 	if err != nil {
 		return fmt.Errorf("init cm config service for datamesh failed, %s", err.Error())
@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/pflag"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
 	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
@@ -46,6 +47,7 @@ type DataMeshConfig struct {
 	Initiator      string
 	FlagSet        *pflag.FlagSet
 	DomainKey      *rsa.PrivateKey
+	SecretBackend  secretbackend.Backend
 	TLS            config.TLSServerConfig
 	KusciaClient   kusciaclientset.Interface
 	KubeClient     kubernetes.Interface
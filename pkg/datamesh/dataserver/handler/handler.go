@@ -44,14 +44,14 @@ type datameshFlightHandler struct {
 	flightService           *svc.FlightIO
 }
 
-func NewDataMeshFlightHandler(dds service.IDomainDataService, dss service.IDomainDataSourceService, configs []config.DataProxyConfig) flight.FlightServer {
+func NewDataMeshFlightHandler(dds service.IDomainDataService, dss service.IDomainDataSourceService, dgs service.IDomainDataGrantService, configs []config.DataProxyConfig) flight.FlightServer {
 	handler := &datameshFlightHandler{
 		customHandles:           map[string]CustomActionHandler{},
 		domainDataService:       dds,
 		domainDataSourceService: dss,
 	}
 	// new dp flight
-	handler.flightService = svc.NewFlightIO(dds, dss, configs)
+	handler.flightService = svc.NewFlightIO(dds, dss, dgs, configs)
 	chs := svc.NewCustomActionService(dds, dss)
 	handler.customHandles["ActionCreateDomainDataRequest"] = chs.DoActionCreateDomainDataRequest
 	handler.customHandles["ActionQueryDomainDataRequest"] = chs.DoActionQueryDomainDataRequest
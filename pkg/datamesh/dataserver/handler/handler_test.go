@@ -198,7 +198,7 @@ func (mps *mockDoPutServer) Recv() (*flight.FlightData, error) {
 
 func TestNewDataMeshFlightHandler(t *testing.T) {
 	t.Parallel()
-	svr := NewDataMeshFlightHandler(nil, nil, []config.DataProxyConfig{
+	svr := NewDataMeshFlightHandler(nil, nil, nil, []config.DataProxyConfig{
 		{
 			Mode:            string(config.ModeDirect),
 			DataSourceTypes: []string{"odps"},
@@ -214,7 +214,7 @@ func TestNewDataMeshFlightHandler(t *testing.T) {
 
 func TestGetFlightInf_FAILED(t *testing.T) {
 	t.Parallel()
-	svr := NewDataMeshFlightHandler(nil, nil, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(nil, nil, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 
 	// anyCmd.UnmarshalNew failed
@@ -249,7 +249,7 @@ func TestGetFlightInf_DataSource_Invalidate(t *testing.T) {
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
 
-	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 
 	// datasource not registed
@@ -301,7 +301,7 @@ func TestGetFlightInf_Success(t *testing.T) {
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
 
-	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 
 	registLocalFileDomainDataSource(t, conf, common.DefaultDataSourceID)
@@ -330,7 +330,7 @@ func TestGetFlightInf_Success(t *testing.T) {
 func TestDoGet_InvalidateTicket(t *testing.T) {
 	t.Parallel()
 
-	svr := NewDataMeshFlightHandler(nil, nil, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(nil, nil, nil, []config.DataProxyConfig{})
 
 	assert.Error(t, svr.DoGet(&flight.Ticket{
 		Ticket: []byte("invalidate-ticket"),
@@ -345,7 +345,7 @@ func TestDoGet_Success(t *testing.T) {
 	datasourceService := service.NewDomainDataSourceService(conf, nil)
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
-	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, nil, []config.DataProxyConfig{})
 	dm := svr.(*datameshFlightHandler)
 	assert.NotNil(t, dm)
 	// Mock datasource and domain data
@@ -384,7 +384,7 @@ func TestDoPut_Failed(t *testing.T) {
 	datasourceService := service.NewDomainDataSourceService(conf, nil)
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
-	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 	// Mock datasource and domain data
 	registLocalFileDomainDataSource(t, conf, common.DefaultDataSourceID)
@@ -431,7 +431,7 @@ func TestDoPut_Success(t *testing.T) {
 	datasourceService := service.NewDomainDataSourceService(conf, nil)
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
-	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 	// Mock datasource and domain data
 	registLocalFileDomainDataSource(t, conf, common.DefaultDataSourceID)
@@ -473,7 +473,7 @@ func TestDoPut_Success(t *testing.T) {
 func TestDoAction_Failed(t *testing.T) {
 	t.Parallel()
 
-	svr := NewDataMeshFlightHandler(nil, nil, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(nil, nil, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 
 	assert.Error(t, svr.DoAction(&flight.Action{
@@ -496,7 +496,7 @@ func TestDoAction_Success(t *testing.T) {
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
 
-	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(domainDataService, datasourceService, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 
 	body, _ := proto.Marshal(&datamesh.CreateDomainDataRequest{
@@ -525,7 +525,7 @@ func TestDoAction_Success(t *testing.T) {
 func TestGetFlightInfo_recover(t *testing.T) {
 	t.Parallel()
 
-	svr := NewDataMeshFlightHandler(nil, nil, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(nil, nil, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 	info, err := svr.GetFlightInfo(context.Background(), nil)
 	assert.Error(t, err)
@@ -535,7 +535,7 @@ func TestGetFlightInfo_recover(t *testing.T) {
 func TestDoGet_recover(t *testing.T) {
 	t.Parallel()
 
-	svr := NewDataMeshFlightHandler(nil, nil, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(nil, nil, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 
 	assert.Error(t, svr.DoGet(nil, nil))
@@ -544,7 +544,7 @@ func TestDoGet_recover(t *testing.T) {
 func TestDoPut_recover(t *testing.T) {
 	t.Parallel()
 
-	svr := NewDataMeshFlightHandler(nil, nil, []config.DataProxyConfig{})
+	svr := NewDataMeshFlightHandler(nil, nil, nil, []config.DataProxyConfig{})
 	assert.NotNil(t, svr)
 
 	assert.Error(t, svr.DoPut(nil))
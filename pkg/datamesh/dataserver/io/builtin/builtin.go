@@ -35,11 +35,13 @@ import (
 type IOServer struct {
 	ioChannels map[string]DataMeshDataIOInterface
 	cmds       *gocache.Cache
+	readBytes  *utils.ReadBytesTracker
 }
 
 func NewIOServer() *IOServer {
 	return &IOServer{
-		cmds: gocache.New(time.Duration(10)*time.Minute, time.Minute),
+		cmds:      gocache.New(time.Duration(10)*time.Minute, time.Minute),
+		readBytes: utils.NewReadBytesTracker(),
 		ioChannels: map[string]DataMeshDataIOInterface{
 			common.DomainDataSourceTypeLocalFS: NewBuiltinLocalFileIOChannel(),
 			common.DomainDataSourceTypeOSS:     NewBuiltinOssIOChannel(),
@@ -108,9 +110,15 @@ func (d *IOServer) DoGet(tkt *flight.Ticket, fs flight.FlightService_DoGetServer
 			Writer:       flightWriter,
 		}
 	}
+	if reqCtx.OutputConstraint != nil {
+		w = utils.NewConstrainedRecordWriter(w, reqCtx.OutputConstraint, d.readBytes)
+	}
 	if ios, ok := d.ioChannels[reqCtx.DataSourceType]; ok {
 		if ioReadErr := ios.Read(fs.Context(), reqCtx, w); ioReadErr != nil {
 			nlog.Errorf("Read domaindata failed with %s", ioReadErr.Error())
+			if status.Code(ioReadErr) == codes.PermissionDenied {
+				return ioReadErr
+			}
 			return status.Error(codes.Internal, fmt.Sprintf("Read domaindata failed with %s", ioReadErr.Error()))
 		}
 		return nil
@@ -24,7 +24,6 @@ import (
 	"github.com/apache/arrow/go/v13/arrow/array"
 	"github.com/apache/arrow/go/v13/arrow/csv"
 	"github.com/apache/arrow/go/v13/arrow/flight"
-	"github.com/apache/arrow/go/v13/arrow/ipc"
 	"github.com/apache/arrow/go/v13/arrow/memory"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -74,10 +73,8 @@ func DataProxyContentToFlightStreamCSV(data *datamesh.DomainData, r io.Reader, w
 	for csvReader.Next() {
 		record := csvReader.Record()
 		if iCount <= 0 && !schema.Equal(record.Schema()) {
-			if csvWriter, ok := w.(*utils.FlightRecordWriter); ok {
-				w = flight.NewRecordWriter(csvWriter.FlightWriter, ipc.WithSchema(record.Schema()))
-				nlog.Debugf("Domaindata(%s) input writer is csv writer schema(%s)", data.GetDomaindataId(), record.Schema().String())
-			}
+			w = utils.ResetRecordWriterSchema(w, record.Schema())
+			nlog.Debugf("Domaindata(%s) input writer is csv writer schema(%s)", data.GetDomaindataId(), record.Schema().String())
 		}
 		record.Retain()
 		if err := w.Write(record); err != nil {
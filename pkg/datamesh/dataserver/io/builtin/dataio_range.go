@@ -0,0 +1,151 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
+)
+
+// ErrRangeNotSatisfiable means the offset is beyond the end of the domain data.
+var ErrRangeNotSatisfiable = errors.New("range not satisfiable")
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// OpenDomainDataRange opens the raw content of the domain data from the offset, the length <= 0 means to the end of
+// the file. It returns the reader and the size of the whole file, only localfs and oss datasources are supported.
+func OpenDomainDataRange(ctx context.Context, data *datamesh.DomainData, ds *datamesh.DomainDataSource, offset, length int64) (io.ReadCloser, int64, error) {
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("offset %d can't be negative", offset)
+	}
+	if ds.Info == nil {
+		return nil, 0, fmt.Errorf("datasource(%s) info is empty", ds.DatasourceId)
+	}
+
+	switch ds.Type {
+	case common.DomainDataSourceTypeLocalFS:
+		if ds.Info.Localfs == nil {
+			return nil, 0, fmt.Errorf("datasource(%s) localfs info is empty", ds.DatasourceId)
+		}
+		return openLocalFileRange(ds.Info.Localfs.Path, data.RelativeUri, offset, length)
+	case common.DomainDataSourceTypeOSS:
+		if ds.Info.Oss == nil {
+			return nil, 0, fmt.Errorf("datasource(%s) oss info is empty", ds.DatasourceId)
+		}
+		return openOssRange(ctx, ds.Info.Oss, data.RelativeUri, offset, length)
+	default:
+		return nil, 0, fmt.Errorf("datasource type %s doesn't support downloading by range", ds.Type)
+	}
+}
+
+// localFilePath joins the path of the localfs datasource and the relative uri of the domain data.
+func localFilePath(root, relativeURI string) (string, error) {
+	root = path.Clean(root)
+	filePath := path.Join(root, relativeURI)
+	// the relative uri is set by the users, make sure it doesn't escape from the datasource
+	if !strings.HasPrefix(filePath, strings.TrimSuffix(root, "/")+"/") {
+		return "", fmt.Errorf("relative uri %q is out of the datasource path", relativeURI)
+	}
+	return filePath, nil
+}
+
+func openLocalFileRange(root, relativeURI string, offset, length int64) (io.ReadCloser, int64, error) {
+	filePath, err := localFilePath(root, relativeURI)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+	if info.IsDir() {
+		file.Close()
+		return nil, 0, fmt.Errorf("file(%s) is a directory", filePath)
+	}
+	size := info.Size()
+	if offset > size {
+		file.Close()
+		return nil, 0, fmt.Errorf("%w, offset %d is beyond the file size %d", ErrRangeNotSatisfiable, offset, size)
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+	remain := size - offset
+	if length > 0 && length < remain {
+		remain = length
+	}
+	return &limitedReadCloser{Reader: io.LimitReader(file, remain), Closer: file}, size, nil
+}
+
+func openOssRange(ctx context.Context, config *datamesh.OssDataSourceInfo, relativeURI string, offset, length int64) (io.ReadCloser, int64, error) {
+	client, err := (&BuiltinOssIO{}).newOssSession(config)
+	if err != nil {
+		return nil, 0, err
+	}
+	key := path.Join(config.Prefix, relativeURI)
+	head, err := client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		nlog.Warnf("Oss client head object(%s) error: %s", key, err.Error())
+		return nil, 0, err
+	}
+	size := aws.Int64Value(head.ContentLength)
+	if offset > size {
+		return nil, 0, fmt.Errorf("%w, offset %d is beyond the object size %d", ErrRangeNotSatisfiable, offset, size)
+	}
+	remain := size - offset
+	if length > 0 && length < remain {
+		remain = length
+	}
+	if remain == 0 {
+		// the oss rejects the empty range
+		return io.NopCloser(strings.NewReader("")), size, nil
+	}
+
+	obj, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(config.Bucket),
+		Key:    aws.String(key),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+remain-1)),
+	})
+	if err != nil {
+		nlog.Warnf("Oss client get object(%s) error: %s", key, err.Error())
+		return nil, 0, err
+	}
+	return &limitedReadCloser{Reader: io.LimitReader(obj.Body, remain), Closer: obj.Body}, size, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
+)
+
+func TestOpenDomainDataRange_LocalFS(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "result.csv"), []byte("0123456789"), 0644))
+	ds := &datamesh.DomainDataSource{
+		DatasourceId: "default",
+		Type:         common.DomainDataSourceTypeLocalFS,
+		Info:         &datamesh.DataSourceInfo{Localfs: &datamesh.LocalDataSourceInfo{Path: root}},
+	}
+
+	tests := []struct {
+		name        string
+		relativeURI string
+		offset      int64
+		length      int64
+		want        string
+		wantErr     bool
+	}{
+		{name: "whole file", relativeURI: "result.csv", want: "0123456789"},
+		{name: "from offset", relativeURI: "result.csv", offset: 4, want: "456789"},
+		{name: "range", relativeURI: "result.csv", offset: 2, length: 3, want: "234"},
+		{name: "length beyond end", relativeURI: "result.csv", offset: 8, length: 10, want: "89"},
+		{name: "at end", relativeURI: "result.csv", offset: 10, want: ""},
+		{name: "offset beyond end", relativeURI: "result.csv", offset: 11, wantErr: true},
+		{name: "negative offset", relativeURI: "result.csv", offset: -1, wantErr: true},
+		{name: "escape datasource", relativeURI: "../result.csv", wantErr: true},
+		{name: "not exist", relativeURI: "missing.csv", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, size, err := OpenDomainDataRange(context.Background(), &datamesh.DomainData{RelativeUri: tt.relativeURI}, ds, tt.offset, tt.length)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			defer reader.Close()
+			content, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(content))
+			assert.Equal(t, int64(10), size)
+		})
+	}
+}
+
+func TestOpenDomainDataRange_Unsupported(t *testing.T) {
+	t.Parallel()
+	ds := &datamesh.DomainDataSource{
+		Type: common.DomainDataSourceTypeMysql,
+		Info: &datamesh.DataSourceInfo{Database: &datamesh.DatabaseDataSourceInfo{}},
+	}
+	_, _, err := OpenDomainDataRange(context.Background(), &datamesh.DomainData{RelativeUri: "t"}, ds, 0, 0)
+	assert.Error(t, err)
+}
+
+func TestOpenDomainDataRange_NotSatisfiable(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "result.csv"), []byte("0123"), 0644))
+	ds := &datamesh.DomainDataSource{
+		Type: common.DomainDataSourceTypeLocalFS,
+		Info: &datamesh.DataSourceInfo{Localfs: &datamesh.LocalDataSourceInfo{Path: root}},
+	}
+	_, _, err := OpenDomainDataRange(context.Background(), &datamesh.DomainData{RelativeUri: "result.csv"}, ds, 5, 0)
+	assert.ErrorIs(t, err, ErrRangeNotSatisfiable)
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/paths"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
+)
+
+// ErrDomainDataFileExists means the file of the domain data exists and it's not allowed to overwrite.
+var ErrDomainDataFileExists = errors.New("domain data file exists")
+
+// DomainDataWriter writes the raw content of the domain data, the content is visible after it's committed.
+type DomainDataWriter interface {
+	Write(p []byte) (int, error)
+	// Commit publishes the written content to the datasource.
+	Commit(ctx context.Context) error
+	// Abort discards the written content, it's a no-op after committed.
+	Abort()
+}
+
+// CreateDomainDataWriter creates the writer of the raw content of the domain data, only localfs and oss datasources
+// are supported. The oss content is buffered in memory until it's committed, so it's only for small files.
+func CreateDomainDataWriter(ctx context.Context, data *datamesh.DomainData, ds *datamesh.DomainDataSource, overwrite bool) (DomainDataWriter, error) {
+	if ds.Info == nil {
+		return nil, fmt.Errorf("datasource(%s) info is empty", ds.DatasourceId)
+	}
+
+	switch ds.Type {
+	case common.DomainDataSourceTypeLocalFS:
+		if ds.Info.Localfs == nil {
+			return nil, fmt.Errorf("datasource(%s) localfs info is empty", ds.DatasourceId)
+		}
+		return createLocalFileWriter(ds.Info.Localfs.Path, data.RelativeUri, overwrite)
+	case common.DomainDataSourceTypeOSS:
+		if ds.Info.Oss == nil {
+			return nil, fmt.Errorf("datasource(%s) oss info is empty", ds.DatasourceId)
+		}
+		return createOssWriter(ctx, ds.Info.Oss, data.RelativeUri, overwrite)
+	default:
+		return nil, fmt.Errorf("datasource type %s doesn't support uploading files", ds.Type)
+	}
+}
+
+// localFileWriter writes to a temporary file in the same directory, which is renamed to the target on commit.
+type localFileWriter struct {
+	*os.File
+	target    string
+	overwrite bool
+	done      bool
+}
+
+func createLocalFileWriter(root, relativeURI string, overwrite bool) (*localFileWriter, error) {
+	filePath, err := localFilePath(root, relativeURI)
+	if err != nil {
+		return nil, err
+	}
+	if !overwrite {
+		if _, err := os.Stat(filePath); err == nil {
+			return nil, fmt.Errorf("%w, file(%s)", ErrDomainDataFileExists, filePath)
+		}
+	}
+	dir, base := path.Split(filePath)
+	if err := paths.EnsurePath(dir, true); err != nil {
+		return nil, err
+	}
+	file, err := os.CreateTemp(dir, "."+base+".uploading-*")
+	if err != nil {
+		return nil, err
+	}
+	return &localFileWriter{File: file, target: filePath, overwrite: overwrite}, nil
+}
+
+func (w *localFileWriter) Commit(ctx context.Context) error {
+	if err := w.File.Close(); err != nil {
+		w.Abort()
+		return err
+	}
+	if !w.overwrite {
+		if _, err := os.Stat(w.target); err == nil {
+			w.Abort()
+			return fmt.Errorf("%w, file(%s)", ErrDomainDataFileExists, w.target)
+		}
+	}
+	if err := os.Chmod(w.File.Name(), 0644); err != nil {
+		w.Abort()
+		return err
+	}
+	if err := os.Rename(w.File.Name(), w.target); err != nil {
+		w.Abort()
+		return err
+	}
+	w.done = true
+	return nil
+}
+
+func (w *localFileWriter) Abort() {
+	if w.done {
+		return
+	}
+	w.done = true
+	w.File.Close()
+	if err := os.Remove(w.File.Name()); err != nil && !os.IsNotExist(err) {
+		nlog.Warnf("Remove uploading file(%s) failed: %v", w.File.Name(), err)
+	}
+}
+
+type ossWriter struct {
+	bytes.Buffer
+	client    *s3.S3
+	bucket    string
+	key       string
+	overwrite bool
+}
+
+func createOssWriter(ctx context.Context, config *datamesh.OssDataSourceInfo, relativeURI string, overwrite bool) (*ossWriter, error) {
+	client, err := (&BuiltinOssIO{}).newOssSession(config)
+	if err != nil {
+		return nil, err
+	}
+	w := &ossWriter{
+		client:    client,
+		bucket:    config.Bucket,
+		key:       path.Join(config.Prefix, relativeURI),
+		overwrite: overwrite,
+	}
+	if err := w.checkExists(ctx); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *ossWriter) checkExists(ctx context.Context) error {
+	if w.overwrite {
+		return nil
+	}
+	_, err := w.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(w.bucket),
+		Key:    aws.String(w.key),
+	})
+	if err == nil {
+		return fmt.Errorf("%w, object(%s)", ErrDomainDataFileExists, path.Join(w.bucket, w.key))
+	}
+	var reqErr awserr.RequestFailure
+	if errors.As(err, &reqErr) && reqErr.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+func (w *ossWriter) Commit(ctx context.Context) error {
+	if err := w.checkExists(ctx); err != nil {
+		return err
+	}
+	_, err := w.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(w.bucket),
+		Key:    aws.String(w.key),
+		Body:   bytes.NewReader(w.Bytes()),
+	})
+	if err != nil {
+		nlog.Warnf("Oss client put object(%s) error: %s", w.key, err.Error())
+	}
+	w.Reset()
+	return err
+}
+
+func (w *ossWriter) Abort() {
+	w.Reset()
+}
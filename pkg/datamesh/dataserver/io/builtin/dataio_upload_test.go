@@ -0,0 +1,68 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
+)
+
+func TestCreateDomainDataWriter_LocalFS(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	ds := &datamesh.DomainDataSource{
+		Type: common.DomainDataSourceTypeLocalFS,
+		Info: &datamesh.DataSourceInfo{Localfs: &datamesh.LocalDataSourceInfo{Path: root}},
+	}
+	data := &datamesh.DomainData{RelativeUri: "upload/input.csv"}
+
+	w, err := CreateDomainDataWriter(context.Background(), data, ds, false)
+	assert.NoError(t, err)
+	_, err = w.Write([]byte("id,age\n1,20\n"))
+	assert.NoError(t, err)
+	// the content is invisible before committed
+	_, err = os.Stat(filepath.Join(root, "upload/input.csv"))
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, w.Commit(context.Background()))
+	content, err := os.ReadFile(filepath.Join(root, "upload/input.csv"))
+	assert.NoError(t, err)
+	assert.Equal(t, "id,age\n1,20\n", string(content))
+
+	// the existing file is kept without overwrite
+	_, err = CreateDomainDataWriter(context.Background(), data, ds, false)
+	assert.ErrorIs(t, err, ErrDomainDataFileExists)
+
+	w, err = CreateDomainDataWriter(context.Background(), data, ds, true)
+	assert.NoError(t, err)
+	_, err = w.Write([]byte("discarded"))
+	assert.NoError(t, err)
+	w.Abort()
+	entries, err := os.ReadDir(filepath.Join(root, "upload"))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	content, err = os.ReadFile(filepath.Join(root, "upload/input.csv"))
+	assert.NoError(t, err)
+	assert.Equal(t, "id,age\n1,20\n", string(content))
+
+	_, err = CreateDomainDataWriter(context.Background(), &datamesh.DomainData{RelativeUri: "../input.csv"}, ds, true)
+	assert.Error(t, err)
+}
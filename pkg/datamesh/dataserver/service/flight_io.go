@@ -28,20 +28,23 @@ import (
 	"github.com/secretflow/kuscia/pkg/datamesh/dataserver/utils"
 	"github.com/secretflow/kuscia/pkg/datamesh/metaserver/service"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
 )
 
 type FlightIO struct {
 	dd    service.IDomainDataService
 	ds    service.IDomainDataSourceService
+	dg    service.IDomainDataGrantService
 	ioMap map[string]io.Server
 	inIO  io.Server
 }
 
-func NewFlightIO(dd service.IDomainDataService, ds service.IDomainDataSourceService, configs []config.DataProxyConfig) *FlightIO {
+func NewFlightIO(dd service.IDomainDataService, ds service.IDomainDataSourceService, dg service.IDomainDataGrantService, configs []config.DataProxyConfig) *FlightIO {
 	inIO := io.NewBuiltinIO()
 	fs := FlightIO{
 		dd: dd,
 		ds: ds,
+		dg: dg,
 		ioMap: map[string]io.Server{
 			common.DomainDataSourceTypeLocalFS: inIO,
 			common.DomainDataSourceTypeOSS:     inIO,
@@ -65,13 +68,56 @@ func (dp *FlightIO) GetFlightInfo(ctx context.Context, msg proto.Message) (fligh
 		nlog.Warnf("GetFlightInfo create context fail: %s", err.Error())
 		return nil, err
 	}
+	if err = dp.initOutputConstraint(ctx, reqCtx); err != nil {
+		nlog.Warnf("GetFlightInfo init output constraint fail: %s", err.Error())
+		return nil, err
+	}
 
 	if dpX, ok := dp.ioMap[reqCtx.DataSourceType]; ok {
+		if reqCtx.OutputConstraint != nil && dpX != dp.inIO {
+			return nil, status.Errorf(codes.PermissionDenied, "the output of the domaindata is limited or masked, which is not supported by the dataproxy of datasource type (%s)", reqCtx.DataSourceType)
+		}
 		return dpX.GetFlightInfo(ctx, reqCtx)
 	}
 	return nil, status.Errorf(codes.InvalidArgument, "datasource type (%s) without data proxy", reqCtx.DataSourceType)
 }
 
+// initOutputConstraint limits the output of the domaindata of other domains according to the grants and the masking policies.
+func (dp *FlightIO) initOutputConstraint(ctx context.Context, reqCtx *utils.DataMeshRequestContext) error {
+	if reqCtx.Query == nil || dp.dd == nil {
+		return nil
+	}
+	data, err := reqCtx.GetDomainData(ctx)
+	if err != nil {
+		return err
+	}
+
+	var constraint *utils.OutputConstraint
+	if dp.dg != nil && data.Vendor == common.DomainDataVendorGrant {
+		grants, err := dp.dg.ListReadyDomainDataGrants(ctx, data.DomaindataId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "list grants of domaindata(%s) failed with %s", data.DomaindataId, err.Error())
+		}
+		constraint = utils.NewOutputConstraint(data.DomaindataId, grants)
+	}
+	if len(data.MaskingPolicies) > 0 {
+		if constraint == nil {
+			constraint = &utils.OutputConstraint{}
+		}
+		constraint.MaskingPolicies = data.MaskingPolicies
+	}
+	if constraint == nil {
+		return nil
+	}
+	if reqCtx.GetTransferContentType() == datamesh.ContentType_RAW && constraint.FiltersContent() {
+		return status.Errorf(codes.PermissionDenied, "the output of domaindata(%s) is limited by the grants or masked, can't be read as raw content", data.DomaindataId)
+	}
+	reqCtx.OutputConstraint = constraint
+	// check the query columns before the data is read
+	_, err = reqCtx.GetDomainData(ctx)
+	return err
+}
+
 func (dp *FlightIO) DoGet(tkt *flight.Ticket, fs flight.FlightService_DoGetServer) (err error) {
 	return dp.inIO.DoGet(tkt, fs)
 }
@@ -31,7 +31,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -177,7 +179,7 @@ func TestNewFlightService(t *testing.T) {
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
 
-	fs := NewFlightIO(domainDataService, datasourceService, []config.DataProxyConfig{{
+	fs := NewFlightIO(domainDataService, datasourceService, nil, []config.DataProxyConfig{{
 		Endpoint:        "127.0.0.1:8080",
 		ClientTLSConfig: nil,
 		DataSourceTypes: []string{"oss"},
@@ -195,7 +197,7 @@ func TestGetFlightInfo(t *testing.T) {
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
 
-	fs := NewFlightIO(domainDataService, datasourceService, []config.DataProxyConfig{{
+	fs := NewFlightIO(domainDataService, datasourceService, nil, []config.DataProxyConfig{{
 		Endpoint:        "127.0.0.1:8080",
 		ClientTLSConfig: nil,
 		DataSourceTypes: []string{"oss"},
@@ -223,7 +225,7 @@ func TestFlightDoGet_Success(t *testing.T) {
 	datasourceService := service.NewDomainDataSourceService(conf, nil)
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
-	fs := NewFlightIO(domainDataService, datasourceService, []config.DataProxyConfig{{
+	fs := NewFlightIO(domainDataService, datasourceService, nil, []config.DataProxyConfig{{
 		Endpoint:        "127.0.0.1:8080",
 		ClientTLSConfig: nil,
 		DataSourceTypes: []string{"oss"},
@@ -258,6 +260,140 @@ func TestFlightDoGet_Success(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+type mockFlightDataReader struct {
+	dataList []*flight.FlightData
+}
+
+func (r *mockFlightDataReader) Recv() (*flight.FlightData, error) {
+	if len(r.dataList) == 0 {
+		return nil, io.EOF
+	}
+	data := r.dataList[0]
+	r.dataList = r.dataList[1:]
+	return data, nil
+}
+
+func registGrantedDomainData(t *testing.T, conf *config.DataMeshConfig, dsID, pathName string, limit *v1alpha1.GrantLimit) string {
+	domainDataID := "data-" + uuid.New().String()
+	_, err := conf.KusciaClient.KusciaV1alpha1().DomainDatas(conf.KubeNamespace).Create(context.Background(), &v1alpha1.DomainData{
+		ObjectMeta: v1.ObjectMeta{
+			Name: domainDataID,
+		},
+		Spec: v1alpha1.DomainDataSpec{
+			RelativeURI: pathName,
+			Name:        domainDataID,
+			Type:        "table",
+			DataSource:  dsID,
+			Author:      "alice",
+			Vendor:      common.DomainDataVendorGrant,
+			Columns: []v1alpha1.DataColumn{
+				{Name: "id", Type: "int64"},
+				{Name: "name", Type: "str"},
+				{Name: "secret", Type: "str"},
+			},
+		},
+	}, v1.CreateOptions{})
+	assert.NoError(t, err)
+
+	_, err = conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(conf.KubeNamespace).Create(context.Background(), &v1alpha1.DomainDataGrant{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   "grant-" + domainDataID,
+			Labels: map[string]string{common.LabelDomainDataID: domainDataID},
+		},
+		Spec: v1alpha1.DomainDataGrantSpec{
+			Author:       "alice",
+			DomainDataID: domainDataID,
+			GrantDomain:  conf.KubeNamespace,
+			Limit:        limit,
+		},
+		Status: v1alpha1.DomainDataGrantStatus{
+			Phase: v1alpha1.GrantReady,
+		},
+	}, v1.CreateOptions{})
+	assert.NoError(t, err)
+	return domainDataID
+}
+
+func TestFlightDoGet_GrantOutputConstraint(t *testing.T) {
+	t.Parallel()
+	conf := initContextTestEnv(t)
+	domainDataService := service.NewDomainDataService(conf)
+	datasourceService := service.NewDomainDataSourceService(conf, nil)
+	fs := NewFlightIO(domainDataService, datasourceService, service.NewDomainDataGrantService(conf), []config.DataProxyConfig{})
+
+	registLocalFileDomainDataSource(t, conf, common.DefaultDataSourceID)
+	fileName := "TestFlightDoGet_GrantOutputConstraint.csv"
+	domainDataID := registGrantedDomainData(t, conf, common.DefaultDataSourceID, fileName, &v1alpha1.GrantLimit{
+		MaxRowsRead:    2,
+		AllowedColumns: []string{"id", "name"},
+	})
+	filepath := path.Join(defaultLocalFSPath, fileName)
+	assert.NoError(t, os.WriteFile(filepath, []byte("id,name,secret\n1,a,x\n2,b,y\n3,c,z\n"), 0644))
+	defer os.Remove(filepath)
+
+	_, err := fs.GetFlightInfo(context.Background(), &datamesh.CommandDomainDataQuery{
+		DomaindataId: domainDataID,
+		ContentType:  datamesh.ContentType_RAW,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = fs.GetFlightInfo(context.Background(), &datamesh.CommandDomainDataQuery{
+		DomaindataId: domainDataID,
+		ContentType:  datamesh.ContentType_Table,
+		Columns:      []string{"id", "secret"},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	fl, err := fs.GetFlightInfo(context.Background(), &datamesh.CommandDomainDataQuery{
+		DomaindataId: domainDataID,
+		ContentType:  datamesh.ContentType_Table,
+	})
+	assert.NoError(t, err)
+	server := &mockDoGetServer{
+		ServerStream: &mockGrpcServerStream{},
+	}
+	assert.NoError(t, fs.DoGet(fl.Endpoint[0].GetTicket(), server))
+
+	reader, err := flight.NewRecordReader(&mockFlightDataReader{dataList: server.dataList})
+	assert.NoError(t, err)
+	defer reader.Release()
+	assert.Equal(t, []string{"id", "name"}, []string{reader.Schema().Field(0).Name, reader.Schema().Field(1).Name})
+	assert.Equal(t, 2, len(reader.Schema().Fields()))
+	var rows int64
+	for reader.Next() {
+		rows += reader.Record().NumRows()
+	}
+	assert.Equal(t, int64(2), rows)
+}
+
+func TestFlightDoGet_GrantMaxBytes(t *testing.T) {
+	t.Parallel()
+	conf := initContextTestEnv(t)
+	domainDataService := service.NewDomainDataService(conf)
+	datasourceService := service.NewDomainDataSourceService(conf, nil)
+	fs := NewFlightIO(domainDataService, datasourceService, service.NewDomainDataGrantService(conf), []config.DataProxyConfig{})
+	registLocalFileDomainDataSource(t, conf, common.DefaultDataSourceID)
+
+	readRaw := func(fileName, content string) error {
+		domainDataID := registGrantedDomainData(t, conf, common.DefaultDataSourceID, fileName, &v1alpha1.GrantLimit{MaxBytesRead: 16})
+		filepath := path.Join(defaultLocalFSPath, fileName)
+		assert.NoError(t, os.WriteFile(filepath, []byte(content), 0644))
+		defer os.Remove(filepath)
+
+		fl, err := fs.GetFlightInfo(context.Background(), &datamesh.CommandDomainDataQuery{
+			DomaindataId: domainDataID,
+			ContentType:  datamesh.ContentType_RAW,
+		})
+		assert.NoError(t, err)
+		return fs.DoGet(fl.Endpoint[0].GetTicket(), &mockDoGetServer{ServerStream: &mockGrpcServerStream{}})
+	}
+
+	// the raw content can be read as long as it doesn't exceed the max bytes
+	assert.NoError(t, readRaw("TestFlightDoGet_GrantMaxBytes_small.csv", "id\n1\n2\n"))
+	err := readRaw("TestFlightDoGet_GrantMaxBytes_large.csv", "id,name,secret\n1,a,x\n2,b,y\n3,c,z\n")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestFlightDoGet_NotExist(t *testing.T) {
 	t.Parallel()
 	conf := initContextTestEnv(t)
@@ -267,7 +403,7 @@ func TestFlightDoGet_NotExist(t *testing.T) {
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
 
-	fs := NewFlightIO(domainDataService, datasourceService, []config.DataProxyConfig{{
+	fs := NewFlightIO(domainDataService, datasourceService, nil, []config.DataProxyConfig{{
 		Endpoint:        "127.0.0.1:8080",
 		ClientTLSConfig: nil,
 		DataSourceTypes: []string{"oss"},
@@ -300,7 +436,7 @@ func TestFlightDoPut_Success(t *testing.T) {
 	datasourceService := service.NewDomainDataSourceService(conf, nil)
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
-	fs := NewFlightIO(domainDataService, datasourceService, []config.DataProxyConfig{{
+	fs := NewFlightIO(domainDataService, datasourceService, nil, []config.DataProxyConfig{{
 		Endpoint:        "127.0.0.1:8080",
 		ClientTLSConfig: nil,
 		DataSourceTypes: []string{"oss"},
@@ -348,7 +484,7 @@ func TestFlightDoPut_NotExist(t *testing.T) {
 	datasourceService := service.NewDomainDataSourceService(conf, nil)
 	assert.NotNil(t, domainDataService)
 	assert.NotNil(t, datasourceService)
-	fs := NewFlightIO(domainDataService, datasourceService, []config.DataProxyConfig{{
+	fs := NewFlightIO(domainDataService, datasourceService, nil, []config.DataProxyConfig{{
 		Endpoint:        "127.0.0.1:8080",
 		ClientTLSConfig: nil,
 		DataSourceTypes: []string{"oss"},
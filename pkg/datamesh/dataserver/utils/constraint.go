@@ -0,0 +1,388 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/ipc"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	k8sv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
+)
+
+// OutputConstraint restricts the view of a granted domaindata exposed to the grantee.
+type OutputConstraint struct {
+	// MaxRows is the max rows to read, 0 means unlimited.
+	MaxRows int64
+	// SamplePercent is the percent of rows sampled, 0 means no sampling.
+	SamplePercent int32
+	// AllowedColumns is the visible columns, nil means all columns.
+	AllowedColumns []string
+	// MaxBytes is the max bytes of the output values, 0 means unlimited.
+	MaxBytes int64
+	// Seed makes the sampled rows stable across reads, so that reading repeatedly doesn't expose more rows.
+	Seed int64
+	// Key identifies the domaindata and the grants the constraint is merged from, the bytes read are counted by it.
+	Key string
+	// MaskingPolicies masks the columns of the domaindata.
+	MaskingPolicies []*v1alpha1.DataMaskingPolicy
+}
+
+// NewOutputConstraint merges the output limits of the grants, the most restrictive limit wins.
+// It returns nil if none of the grants limits the output.
+func NewOutputConstraint(domaindataID string, grants []*datamesh.DomainDataGrantData) *OutputConstraint {
+	c := &OutputConstraint{}
+	limited := false
+	grantIDs := make([]string, 0, len(grants))
+	for _, g := range grants {
+		limit := g.GetLimit()
+		if limit == nil || (limit.MaxRowsRead <= 0 && limit.SamplePercent <= 0 && len(limit.AllowedColumns) == 0 && limit.MaxBytesRead <= 0) {
+			continue
+		}
+		limited = true
+		grantIDs = append(grantIDs, g.DomaindatagrantId)
+		if limit.MaxRowsRead > 0 && (c.MaxRows == 0 || limit.MaxRowsRead < c.MaxRows) {
+			c.MaxRows = limit.MaxRowsRead
+		}
+		if limit.SamplePercent > 0 && (c.SamplePercent == 0 || limit.SamplePercent < c.SamplePercent) {
+			c.SamplePercent = limit.SamplePercent
+		}
+		if len(limit.AllowedColumns) > 0 {
+			c.AllowedColumns = intersectColumns(c.AllowedColumns, limit.AllowedColumns)
+		}
+		if limit.MaxBytesRead > 0 && (c.MaxBytes == 0 || limit.MaxBytesRead < c.MaxBytes) {
+			c.MaxBytes = limit.MaxBytesRead
+		}
+	}
+	if !limited {
+		return nil
+	}
+
+	sort.Strings(grantIDs)
+	c.Key = domaindataID + "/" + strings.Join(grantIDs, "/")
+	h := fnv.New64a()
+	h.Write([]byte(c.Key))
+	c.Seed = int64(h.Sum64())
+	return c
+}
+
+// ReadBytesTracker counts the bytes read of the constraints across the reads, so that the max bytes limits the total
+// output of the grants instead of each read. The counts are kept in memory, they restart with the data server.
+type ReadBytesTracker struct {
+	mu    sync.Mutex
+	bytes map[string]int64
+}
+
+func NewReadBytesTracker() *ReadBytesTracker {
+	return &ReadBytesTracker{bytes: map[string]int64{}}
+}
+
+// Reserve adds n bytes to the key if the total doesn't exceed max, it reports whether the bytes are added.
+func (t *ReadBytesTracker) Reserve(key string, n, max int64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.bytes[key]+n > max {
+		return false
+	}
+	t.bytes[key] += n
+	return true
+}
+
+// FiltersContent returns whether the constraint changes the rows or columns of the output,
+// the raw content of the domaindata can only be read if it doesn't.
+func (c *OutputConstraint) FiltersContent() bool {
+	return c.MaxRows > 0 || c.SamplePercent > 0 || c.AllowedColumns != nil || len(c.MaskingPolicies) > 0
+}
+
+func intersectColumns(current, allowed []string) []string {
+	if current == nil {
+		return append([]string{}, allowed...)
+	}
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, col := range allowed {
+		allowedSet[col] = true
+	}
+	result := []string{}
+	for _, col := range current {
+		if allowedSet[col] {
+			result = append(result, col)
+		}
+	}
+	return result
+}
+
+// ApplyToDomainData returns a copy of the domaindata with only the allowed columns, the query columns must be allowed.
+// The hashed and truncated columns are read as strings, and the nullified columns become nullable.
+func (c *OutputConstraint) ApplyToDomainData(data *datamesh.DomainData, queryColumns []string) (*datamesh.DomainData, error) {
+	if c.AllowedColumns == nil && len(c.MaskingPolicies) == 0 {
+		return data, nil
+	}
+	allowedSet := make(map[string]bool, len(c.AllowedColumns))
+	for _, col := range c.AllowedColumns {
+		allowedSet[col] = true
+	}
+	allowed := func(col string) bool {
+		return c.AllowedColumns == nil || allowedSet[col]
+	}
+	for _, col := range queryColumns {
+		if !allowed(col) {
+			return nil, status.Errorf(codes.PermissionDenied, "column(%s) of domaindata(%s) is not allowed by the grants", col, data.DomaindataId)
+		}
+	}
+
+	filtered := proto.Clone(data).(*datamesh.DomainData)
+	filtered.Columns = make([]*v1alpha1.DataColumn, 0, len(data.Columns))
+	for _, col := range data.Columns {
+		if !allowed(col.Name) {
+			continue
+		}
+		col = proto.Clone(col).(*v1alpha1.DataColumn)
+		if p := c.maskingPolicy(col.Name); p != nil {
+			if k8sv1alpha1.DataMaskingMethod(p.Method) == k8sv1alpha1.DataMaskingNullify {
+				col.NotNullable = false
+			} else {
+				col.Type = "str"
+			}
+		}
+		filtered.Columns = append(filtered.Columns, col)
+	}
+	if len(filtered.Columns) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "no column of domaindata(%s) is allowed by the grants", data.DomaindataId)
+	}
+	return filtered, nil
+}
+
+func (c *OutputConstraint) maskingPolicy(column string) *v1alpha1.DataMaskingPolicy {
+	for _, p := range c.MaskingPolicies {
+		if p.Column == column {
+			return p
+		}
+	}
+	return nil
+}
+
+type constrainedRecordWriter struct {
+	RecordWriter
+	constraint *OutputConstraint
+	readBytes  *ReadBytesTracker
+	random     *rand.Rand
+	rows       int64
+}
+
+// NewConstrainedRecordWriter samples and truncates the rows of records before writing them to w, and fails once the
+// output exceeds the max bytes. The bytes are counted by the tracker across the writers of the same constraint key.
+func NewConstrainedRecordWriter(w RecordWriter, c *OutputConstraint, readBytes *ReadBytesTracker) RecordWriter {
+	return &constrainedRecordWriter{
+		RecordWriter: w,
+		constraint:   c,
+		readBytes:    readBytes,
+		random:       rand.New(rand.NewSource(c.Seed)),
+	}
+}
+
+func (w *constrainedRecordWriter) Write(rec arrow.Record) error {
+	maxRows := w.constraint.MaxRows
+	if maxRows > 0 && w.rows >= maxRows {
+		return nil
+	}
+
+	out := rec
+	if percent := w.constraint.SamplePercent; percent > 0 && percent < 100 {
+		sampled, err := sampleRecord(rec, w.random, percent)
+		if err != nil {
+			return err
+		}
+		defer sampled.Release()
+		out = sampled
+	}
+	if maxRows > 0 && w.rows+out.NumRows() > maxRows {
+		sliced := out.NewSlice(0, maxRows-w.rows)
+		defer sliced.Release()
+		out = sliced
+	}
+	if out.NumRows() == 0 {
+		return nil
+	}
+	if len(w.constraint.MaskingPolicies) > 0 {
+		masked, err := w.constraint.maskRecord(out)
+		if err != nil {
+			return err
+		}
+		defer masked.Release()
+		out = masked
+	}
+	if maxBytes := w.constraint.MaxBytes; maxBytes > 0 && !w.readBytes.Reserve(w.constraint.Key, recordBytes(out), maxBytes) {
+		return status.Errorf(codes.PermissionDenied, "the grants allow to read %d bytes only", maxBytes)
+	}
+	w.rows += out.NumRows()
+	return w.RecordWriter.Write(out)
+}
+
+// recordBytes returns the bytes of the values in the record, the validity bitmaps are not counted.
+func recordBytes(rec arrow.Record) int64 {
+	var n int64
+	for _, col := range rec.Columns() {
+		if col.Len() == 0 {
+			continue
+		}
+		switch a := col.(type) {
+		case *array.Binary:
+			offsets := a.ValueOffsets()
+			n += int64(offsets[len(offsets)-1] - offsets[0])
+		case *array.String:
+			offsets := a.ValueOffsets()
+			n += int64(offsets[len(offsets)-1] - offsets[0])
+		case *array.LargeBinary:
+			offsets := a.ValueOffsets()
+			n += offsets[len(offsets)-1] - offsets[0]
+		case *array.LargeString:
+			offsets := a.ValueOffsets()
+			n += offsets[len(offsets)-1] - offsets[0]
+		default:
+			if fw, ok := col.DataType().(arrow.FixedWidthDataType); ok {
+				n += int64(col.Len()) * int64((fw.BitWidth()+7)/8)
+			}
+		}
+	}
+	return n
+}
+
+// maskRecord replaces the masked columns of the record.
+func (c *OutputConstraint) maskRecord(rec arrow.Record) (arrow.Record, error) {
+	fields := make([]arrow.Field, 0, rec.NumCols())
+	cols := make([]arrow.Array, 0, rec.NumCols())
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+	for i, field := range rec.Schema().Fields() {
+		col := rec.Column(i)
+		p := c.maskingPolicy(field.Name)
+		if p == nil {
+			col.Retain()
+			fields = append(fields, field)
+			cols = append(cols, col)
+			continue
+		}
+
+		switch k8sv1alpha1.DataMaskingMethod(p.Method) {
+		case k8sv1alpha1.DataMaskingNullify:
+			field.Nullable = true
+			cols = append(cols, array.MakeArrayOfNull(memory.DefaultAllocator, field.Type, col.Len()))
+		case k8sv1alpha1.DataMaskingHash, k8sv1alpha1.DataMaskingTruncate:
+			field.Type = arrow.BinaryTypes.String
+			cols = append(cols, maskStringColumn(col, p))
+		default:
+			return nil, fmt.Errorf("unknown masking method %q of column %s", p.Method, field.Name)
+		}
+		fields = append(fields, field)
+	}
+	return array.NewRecord(arrow.NewSchema(fields, nil), cols, rec.NumRows()), nil
+}
+
+func maskStringColumn(col arrow.Array, p *v1alpha1.DataMaskingPolicy) arrow.Array {
+	bldr := array.NewStringBuilder(memory.DefaultAllocator)
+	defer bldr.Release()
+	bldr.Reserve(col.Len())
+	for i := 0; i < col.Len(); i++ {
+		if col.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		value := col.ValueStr(i)
+		if k8sv1alpha1.DataMaskingMethod(p.Method) == k8sv1alpha1.DataMaskingHash {
+			sum := sha256.Sum256([]byte(value))
+			bldr.Append(hex.EncodeToString(sum[:]))
+		} else if runes := []rune(value); len(runes) > int(p.Length) {
+			bldr.Append(string(runes[:p.Length]))
+		} else {
+			bldr.Append(value)
+		}
+	}
+	return bldr.NewArray()
+}
+
+// sampleRecord keeps each row of the record with the probability of percent/100.
+func sampleRecord(rec arrow.Record, random *rand.Rand, percent int32) (arrow.Record, error) {
+	// contiguous ranges [start, end) of the kept rows
+	var ranges [][2]int64
+	for i := int64(0); i < rec.NumRows(); i++ {
+		if random.Int31n(100) >= percent {
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1][1] == i {
+			ranges[n-1][1] = i + 1
+		} else {
+			ranges = append(ranges, [2]int64{i, i + 1})
+		}
+	}
+	if len(ranges) == 0 {
+		return rec.NewSlice(0, 0), nil
+	}
+
+	var rows int64
+	for _, r := range ranges {
+		rows += r[1] - r[0]
+	}
+	cols := make([]arrow.Array, 0, rec.NumCols())
+	defer func() {
+		for _, col := range cols {
+			col.Release()
+		}
+	}()
+	for _, col := range rec.Columns() {
+		slices := make([]arrow.Array, 0, len(ranges))
+		for _, r := range ranges {
+			slices = append(slices, array.NewSlice(col, r[0], r[1]))
+		}
+		sampled, err := array.Concatenate(slices, memory.DefaultAllocator)
+		for _, s := range slices {
+			s.Release()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("sample column failed, %s", err.Error())
+		}
+		cols = append(cols, sampled)
+	}
+	return array.NewRecord(rec.Schema(), cols, rows), nil
+}
+
+// ResetRecordWriterSchema recreates the flight writer under w with the schema, other writers are returned as is.
+func ResetRecordWriterSchema(w RecordWriter, schema *arrow.Schema) RecordWriter {
+	switch rw := w.(type) {
+	case *FlightRecordWriter:
+		return flight.NewRecordWriter(rw.FlightWriter, ipc.WithSchema(schema))
+	case *constrainedRecordWriter:
+		rw.RecordWriter = ResetRecordWriterSchema(rw.RecordWriter, schema)
+	}
+	return w
+}
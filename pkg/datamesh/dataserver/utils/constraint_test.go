@@ -0,0 +1,222 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
+)
+
+type mockRecordWriter struct {
+	rows []int64
+}
+
+func (w *mockRecordWriter) Write(rec arrow.Record) error {
+	ids := rec.Column(0).(*array.Int64)
+	for i := 0; i < ids.Len(); i++ {
+		w.rows = append(w.rows, ids.Value(i))
+	}
+	return nil
+}
+
+func (w *mockRecordWriter) Close() error { return nil }
+
+func newTestRecord(start, rows int64) arrow.Record {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	bldr := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer bldr.Release()
+	for i := start; i < start+rows; i++ {
+		bldr.Field(0).(*array.Int64Builder).Append(i)
+	}
+	return bldr.NewRecord()
+}
+
+func TestNewOutputConstraint(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, NewOutputConstraint("data", nil))
+	assert.Nil(t, NewOutputConstraint("data", []*datamesh.DomainDataGrantData{
+		{DomaindatagrantId: "g1", Limit: &datamesh.GrantLimit{}},
+	}))
+	bytesLimited := NewOutputConstraint("data", []*datamesh.DomainDataGrantData{
+		{DomaindatagrantId: "g1", Limit: &datamesh.GrantLimit{MaxBytesRead: 10}},
+		{DomaindatagrantId: "g2", Limit: &datamesh.GrantLimit{MaxBytesRead: 20}},
+	})
+	assert.Equal(t, int64(10), bytesLimited.MaxBytes)
+	assert.False(t, bytesLimited.FiltersContent())
+
+	c := NewOutputConstraint("data", []*datamesh.DomainDataGrantData{
+		{DomaindatagrantId: "g1", Limit: &datamesh.GrantLimit{MaxRowsRead: 100, AllowedColumns: []string{"id", "age", "name"}}},
+		{DomaindatagrantId: "g2", Limit: &datamesh.GrantLimit{MaxRowsRead: 10, SamplePercent: 50, AllowedColumns: []string{"name", "id"}}},
+		{DomaindatagrantId: "g3"},
+	})
+	assert.NotNil(t, c)
+	assert.Equal(t, int64(10), c.MaxRows)
+	assert.Equal(t, int32(50), c.SamplePercent)
+	assert.Equal(t, []string{"id", "name"}, c.AllowedColumns)
+
+	// the seed is independent of the order of grants
+	reversed := NewOutputConstraint("data", []*datamesh.DomainDataGrantData{
+		{DomaindatagrantId: "g2", Limit: &datamesh.GrantLimit{SamplePercent: 50}},
+		{DomaindatagrantId: "g1", Limit: &datamesh.GrantLimit{MaxRowsRead: 100}},
+	})
+	assert.Equal(t, c.Seed, reversed.Seed)
+	assert.Equal(t, "data/g1/g2", reversed.Key)
+}
+
+func TestOutputConstraintApplyToDomainData(t *testing.T) {
+	t.Parallel()
+	data := &datamesh.DomainData{
+		DomaindataId: "data",
+		Columns: []*v1alpha1.DataColumn{
+			{Name: "id", Type: "int64"},
+			{Name: "age", Type: "int64"},
+			{Name: "name", Type: "str"},
+		},
+	}
+
+	c := &OutputConstraint{}
+	filtered, err := c.ApplyToDomainData(data, []string{"age"})
+	assert.NoError(t, err)
+	assert.Len(t, filtered.Columns, 3)
+
+	c.AllowedColumns = []string{"name", "id"}
+	filtered, err = c.ApplyToDomainData(data, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id", "name"}, GenerateArrowColumnNames(filtered))
+	assert.Len(t, data.Columns, 3)
+
+	_, err = c.ApplyToDomainData(data, []string{"id", "age"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	c.AllowedColumns = []string{}
+	_, err = c.ApplyToDomainData(data, nil)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestConstrainedRecordWriter(t *testing.T) {
+	t.Parallel()
+	writeRecords := func(c *OutputConstraint) []int64 {
+		mw := &mockRecordWriter{}
+		w := NewConstrainedRecordWriter(mw, c, NewReadBytesTracker())
+		for i := int64(0); i < 10; i++ {
+			rec := newTestRecord(i*100, 100)
+			assert.NoError(t, w.Write(rec))
+			rec.Release()
+		}
+		assert.NoError(t, w.Close())
+		return mw.rows
+	}
+
+	rows := writeRecords(&OutputConstraint{MaxRows: 150})
+	assert.Len(t, rows, 150)
+	assert.Equal(t, int64(149), rows[149])
+
+	sampled := writeRecords(&OutputConstraint{SamplePercent: 30, Seed: 1})
+	assert.Greater(t, len(sampled), 200)
+	assert.Less(t, len(sampled), 400)
+	for i := 1; i < len(sampled); i++ {
+		assert.Less(t, sampled[i-1], sampled[i])
+	}
+	// the same seed samples the same rows
+	assert.Equal(t, sampled, writeRecords(&OutputConstraint{SamplePercent: 30, Seed: 1}))
+
+	limited := writeRecords(&OutputConstraint{SamplePercent: 30, MaxRows: 50, Seed: 1})
+	assert.Equal(t, sampled[:50], limited)
+	assert.True(t, (&OutputConstraint{MaxRows: 50}).FiltersContent())
+}
+
+func TestConstrainedRecordWriter_MaxBytes(t *testing.T) {
+	t.Parallel()
+	mw := &mockRecordWriter{}
+	readBytes := NewReadBytesTracker()
+	c := &OutputConstraint{MaxBytes: 1600, Key: "data/g1"}
+	// each record of 100 int64 values has 800 bytes
+	w := NewConstrainedRecordWriter(mw, c, readBytes)
+	rec := newTestRecord(0, 100)
+	assert.NoError(t, w.Write(rec))
+	rec.Release()
+
+	// the bytes read before are counted by the next read of the same grants
+	w = NewConstrainedRecordWriter(mw, c, readBytes)
+	rec = newTestRecord(100, 100)
+	assert.NoError(t, w.Write(rec))
+	rec.Release()
+	rec = newTestRecord(200, 1)
+	defer rec.Release()
+	assert.Equal(t, codes.PermissionDenied, status.Code(w.Write(rec)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(NewConstrainedRecordWriter(mw, c, readBytes).Write(rec)))
+	assert.Len(t, mw.rows, 200)
+
+	// the other grants are counted separately
+	assert.NoError(t, NewConstrainedRecordWriter(mw, &OutputConstraint{MaxBytes: 1600, Key: "data/g2"}, readBytes).Write(rec))
+}
+
+func TestOutputConstraintMasking(t *testing.T) {
+	t.Parallel()
+	data := &datamesh.DomainData{
+		DomaindataId: "data",
+		Columns: []*v1alpha1.DataColumn{
+			{Name: "id", Type: "int64", NotNullable: true},
+			{Name: "name", Type: "str"},
+			{Name: "age", Type: "int64", NotNullable: true},
+		},
+	}
+	c := &OutputConstraint{MaskingPolicies: []*v1alpha1.DataMaskingPolicy{
+		{Column: "id", Method: "hash"},
+		{Column: "name", Method: "truncate", Length: 2},
+		{Column: "age", Method: "nullify"},
+	}}
+	masked, err := c.ApplyToDomainData(data, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "str", masked.Columns[0].Type)
+	assert.Equal(t, "str", masked.Columns[1].Type)
+	assert.Equal(t, "int64", masked.Columns[2].Type)
+	assert.False(t, masked.Columns[2].NotNullable)
+	assert.True(t, data.Columns[2].NotNullable)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "age", Type: arrow.PrimitiveTypes.Int64},
+	}, nil)
+	bldr := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer bldr.Release()
+	bldr.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2}, nil)
+	bldr.Field(1).(*array.StringBuilder).AppendValues([]string{"alice", ""}, []bool{true, false})
+	bldr.Field(2).(*array.Int64Builder).AppendValues([]int64{20, 30}, nil)
+	rec := bldr.NewRecord()
+	defer rec.Release()
+
+	out, err := c.maskRecord(rec)
+	assert.NoError(t, err)
+	defer out.Release()
+	ids := out.Column(0).(*array.String)
+	assert.Equal(t, "6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b", ids.Value(0))
+	assert.NotEqual(t, ids.Value(0), ids.Value(1))
+	names := out.Column(1).(*array.String)
+	assert.Equal(t, "al", names.Value(0))
+	assert.True(t, names.IsNull(1))
+	assert.Equal(t, 2, out.Column(2).NullN())
+	assert.True(t, out.Schema().Field(2).Nullable)
+}
@@ -32,6 +32,8 @@ type DataMeshRequestContext struct {
 	Query          *datamesh.CommandDomainDataQuery
 	Update         *datamesh.CommandDomainDataUpdate
 	SqlQuery       *datamesh.CommandDataSourceSqlQuery
+	// OutputConstraint restricts the view of the granted domaindata, nil means unrestricted.
+	OutputConstraint *OutputConstraint
 
 	domainDataService       service.IDomainDataService
 	domainDataSourceService service.IDomainDataSourceService
@@ -82,6 +84,9 @@ func (rc *DataMeshRequestContext) GetDomainData(ctx context.Context) (*datamesh.
 		}
 		return nil, common.BuildGrpcErrorf(appStatus, codes.Internal, "Query domain data by id(%s) fail", rc.getDomainDataID())
 	}
+	if rc.OutputConstraint != nil {
+		return rc.OutputConstraint.ApplyToDomainData(domainDataResp.Data, rc.Query.GetColumns())
+	}
 	return domainDataResp.Data, nil
 }
 
@@ -140,17 +140,18 @@ func (s domainDataService) QueryDomainData(ctx context.Context, request *datames
 	return &datamesh.QueryDomainDataResponse{
 		Status: utils.BuildSuccessResponseStatus(),
 		Data: &datamesh.DomainData{
-			DomaindataId: kusciaDomainData.Name,
-			Name:         kusciaDomainData.Spec.Name,
-			Type:         kusciaDomainData.Spec.Type,
-			RelativeUri:  kusciaDomainData.Spec.RelativeURI,
-			DatasourceId: kusciaDomainData.Spec.DataSource,
-			Attributes:   kusciaDomainData.Spec.Attributes,
-			Partition:    common.Convert2PbPartition(kusciaDomainData.Spec.Partition),
-			Columns:      common.Convert2PbColumn(kusciaDomainData.Spec.Columns),
-			Vendor:       kusciaDomainData.Spec.Vendor,
-			FileFormat:   common.Convert2PbFileFormat(kusciaDomainData.Spec.FileFormat),
-			Author:       kusciaDomainData.Spec.Author,
+			DomaindataId:    kusciaDomainData.Name,
+			Name:            kusciaDomainData.Spec.Name,
+			Type:            kusciaDomainData.Spec.Type,
+			RelativeUri:     kusciaDomainData.Spec.RelativeURI,
+			DatasourceId:    kusciaDomainData.Spec.DataSource,
+			Attributes:      kusciaDomainData.Spec.Attributes,
+			Partition:       common.Convert2PbPartition(kusciaDomainData.Spec.Partition),
+			Columns:         common.Convert2PbColumn(kusciaDomainData.Spec.Columns),
+			Vendor:          kusciaDomainData.Spec.Vendor,
+			FileFormat:      common.Convert2PbFileFormat(kusciaDomainData.Spec.FileFormat),
+			MaskingPolicies: common.Convert2PbMaskingPolicies(resources.MaskingPoliciesForDomain(&kusciaDomainData.Spec, s.conf.KubeNamespace)),
+			Author:          kusciaDomainData.Spec.Author,
 		},
 	}
 }
@@ -198,16 +199,17 @@ func (s domainDataService) UpdateDomainData(ctx context.Context, request *datame
 			Labels:          labels,
 		},
 		Spec: v1alpha1.DomainDataSpec{
-			RelativeURI: request.RelativeUri,
-			Name:        request.Name,
-			Type:        request.Type,
-			DataSource:  request.DatasourceId,
-			Attributes:  request.Attributes,
-			Partition:   common.Convert2KubePartition(request.Partition),
-			Columns:     common.Convert2KubeColumn(request.Columns),
-			Vendor:      request.Vendor,
-			FileFormat:  common.Convert2KubeFileFormat(request.FileFormat),
-			Author:      s.conf.KubeNamespace,
+			RelativeURI:     request.RelativeUri,
+			Name:            request.Name,
+			Type:            request.Type,
+			DataSource:      request.DatasourceId,
+			Attributes:      request.Attributes,
+			Partition:       common.Convert2KubePartition(request.Partition),
+			Columns:         common.Convert2KubeColumn(request.Columns),
+			Vendor:          request.Vendor,
+			FileFormat:      common.Convert2KubeFileFormat(request.FileFormat),
+			MaskingPolicies: originalDomainData.Spec.MaskingPolicies,
+			Author:          s.conf.KubeNamespace,
 		},
 	}
 	// merge modifiedDomainData to originalDomainData
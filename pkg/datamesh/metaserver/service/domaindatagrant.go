@@ -44,6 +44,8 @@ type IDomainDataGrantService interface {
 	QueryDomainDataGrant(ctx context.Context, request *datamesh.QueryDomainDataGrantRequest) *datamesh.QueryDomainDataGrantResponse
 	UpdateDomainDataGrant(ctx context.Context, request *datamesh.UpdateDomainDataGrantRequest) *datamesh.UpdateDomainDataGrantResponse
 	DeleteDomainDataGrant(ctx context.Context, request *datamesh.DeleteDomainDataGrantRequest) *datamesh.DeleteDomainDataGrantResponse
+	// ListReadyDomainDataGrants lists the ready grants of the domaindata that other domains granted to this domain.
+	ListReadyDomainDataGrants(ctx context.Context, domaindataID string) ([]*datamesh.DomainDataGrantData, error)
 }
 
 type domainDataGrantService struct {
@@ -133,6 +135,11 @@ func (s *domainDataGrantService) QueryDomainDataGrant(ctx context.Context, reque
 
 	data := &datamesh.DomainDataGrantData{}
 	s.convertSpec2Data(dg, data)
+	if dd, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDatas(s.conf.KubeNamespace).Get(ctx, dg.Spec.DomainDataID, metav1.GetOptions{}); err == nil {
+		data.MaskingPolicies = common.Convert2PbMaskingPolicies(resources.MaskingPoliciesForDomain(&dd.Spec, dg.Spec.GrantDomain))
+	} else {
+		nlog.Warnf("Get DomainData %s of DomainDataGrant %s failed, error:%s", dg.Spec.DomainDataID, dg.Name, err.Error())
+	}
 	return &datamesh.QueryDomainDataGrantResponse{
 		Status: utils.BuildSuccessResponseStatus(),
 		Data:   data,
@@ -169,6 +176,11 @@ func (s *domainDataGrantService) UpdateDomainDataGrant(ctx context.Context, requ
 			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_DataMeshErrRequestInvalidate, "grantdomain cant be self"),
 		}
 	}
+	if err := validateGrantLimit(request.Limit); err != nil {
+		return &datamesh.UpdateDomainDataGrantResponse{
+			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_DataMeshErrRequestInvalidate, err.Error()),
+		}
+	}
 	dg, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(s.conf.KubeNamespace).Get(ctx, request.DomaindatagrantId, metav1.GetOptions{})
 	if err != nil {
 		nlog.Errorf("Get DomainDataGrant failed, error:%s", err.Error())
@@ -177,6 +189,8 @@ func (s *domainDataGrantService) UpdateDomainDataGrant(ctx context.Context, requ
 		}
 	}
 
+	// the compliance metadata is only managed by kusciaapi
+	compliance := dg.Spec.Compliance
 	_ = s.convertData2Spec(&datamesh.DomainDataGrantData{
 		DomaindatagrantId: request.DomaindatagrantId,
 		Author:            s.conf.KubeNamespace,
@@ -185,6 +199,7 @@ func (s *domainDataGrantService) UpdateDomainDataGrant(ctx context.Context, requ
 		Limit:             request.Limit,
 		Description:       request.Description,
 	}, dg)
+	dg.Spec.Compliance = compliance
 	_, err = s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(s.conf.KubeNamespace).Update(ctx, dg, metav1.UpdateOptions{})
 	if err != nil {
 		nlog.Errorf("Update DomainDataGrant failed, error:%s", err.Error())
@@ -233,17 +248,49 @@ func (s *domainDataGrantService) signDomainDataGrant(dg *v1alpha1.DomainDataGran
 	return nil
 }
 
+func (s *domainDataGrantService) ListReadyDomainDataGrants(ctx context.Context, domaindataID string) ([]*datamesh.DomainDataGrantData, error) {
+	selector := metav1.LabelSelector{MatchLabels: map[string]string{common.LabelDomainDataID: domaindataID}}
+	dgs, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(s.conf.KubeNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(&selector),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []*datamesh.DomainDataGrantData
+	for i := range dgs.Items {
+		dg := &dgs.Items[i]
+		// the grants authored by this domain are granted to others
+		if dg.Spec.Author == s.conf.KubeNamespace || dg.Spec.DomainDataID != domaindataID || dg.Status.Phase != v1alpha1.GrantReady {
+			continue
+		}
+		data := &datamesh.DomainDataGrantData{}
+		s.convertSpec2Data(dg, data)
+		grants = append(grants, data)
+	}
+	return grants, nil
+}
+
 func (s *domainDataGrantService) convertData2Spec(reqdata *datamesh.DomainDataGrantData, v *v1alpha1.DomainDataGrant) error {
 	var limit *v1alpha1.GrantLimit
 	if reqdata.Limit != nil {
-		grantMode := []v1alpha1.GrantType{"normal"}
 		limit = &v1alpha1.GrantLimit{
-			FlowID:      reqdata.Limit.FlowId,
-			UseCount:    int(reqdata.Limit.UseCount),
-			Initiator:   reqdata.Limit.Initiator,
-			InputConfig: reqdata.Limit.InputConfig,
-			Components:  reqdata.Limit.Components,
-			GrantMode:   grantMode,
+			FlowID:         reqdata.Limit.FlowId,
+			UseCount:       int(reqdata.Limit.UseCount),
+			Initiator:      reqdata.Limit.Initiator,
+			InputConfig:    reqdata.Limit.InputConfig,
+			Components:     reqdata.Limit.Components,
+			GrantMode:      resources.GrantModesFromStrings(reqdata.Limit.GrantMode),
+			MaxBytesRead:   reqdata.Limit.MaxBytesRead,
+			MaxRowsRead:    reqdata.Limit.MaxRowsRead,
+			SamplePercent:  reqdata.Limit.SamplePercent,
+			AllowedColumns: reqdata.Limit.AllowedColumns,
+		}
+		for _, c := range reqdata.Limit.AllowedComponents {
+			limit.AllowedComponents = append(limit.AllowedComponents, v1alpha1.AllowedComponent{
+				Name:     c.Name,
+				Versions: c.Versions,
+			})
 		}
 		if reqdata.Limit.ExpirationTime > 0 {
 			mt := metav1.NewTime(time.Unix(reqdata.Limit.ExpirationTime/int64(time.Second), reqdata.Limit.ExpirationTime%int64(time.Second)))
@@ -274,16 +321,29 @@ func (s *domainDataGrantService) convertSpec2Data(v *v1alpha1.DomainDataGrant, d
 	domaindata.DomaindatagrantId = v.Name
 	domaindata.GrantDomain = v.Spec.GrantDomain
 	domaindata.Signature = v.Spec.Signature
+	domaindata.Description = v.Spec.Description
 	if v.Spec.Limit != nil {
 		domaindata.Limit = &datamesh.GrantLimit{
-			Components:  v.Spec.Limit.Components,
-			FlowId:      v.Spec.Limit.FlowID,
-			UseCount:    int32(v.Spec.Limit.UseCount),
-			InputConfig: v.Spec.Limit.InputConfig,
+			Components:     v.Spec.Limit.Components,
+			FlowId:         v.Spec.Limit.FlowID,
+			UseCount:       int32(v.Spec.Limit.UseCount),
+			Initiator:      v.Spec.Limit.Initiator,
+			InputConfig:    v.Spec.Limit.InputConfig,
+			GrantMode:      resources.GrantModesToStrings(v.Spec.Limit.GrantMode),
+			MaxBytesRead:   v.Spec.Limit.MaxBytesRead,
+			MaxRowsRead:    v.Spec.Limit.MaxRowsRead,
+			SamplePercent:  v.Spec.Limit.SamplePercent,
+			AllowedColumns: v.Spec.Limit.AllowedColumns,
 		}
 		if v.Spec.Limit.ExpirationTime != nil {
 			domaindata.Limit.ExpirationTime = v.Spec.Limit.ExpirationTime.UnixNano()
 		}
+		for _, c := range v.Spec.Limit.AllowedComponents {
+			domaindata.Limit.AllowedComponents = append(domaindata.Limit.AllowedComponents, &datamesh.AllowedComponent{
+				Name:     c.Name,
+				Versions: c.Versions,
+			})
+		}
 	}
 }
 
@@ -302,7 +362,38 @@ func validateCreateDomainDataGrantRequest(request *datamesh.CreateDomainDataGran
 	}
 
 	if request.GetDomaindatagrantId() != "" {
-		return resources.ValidateK8sName(request.GetDomaindatagrantId(), "domaindatagrant_id")
+		if err := resources.ValidateK8sName(request.GetDomaindatagrantId(), "domaindatagrant_id"); err != nil {
+			return err
+		}
+	}
+	return validateGrantLimit(request.Limit)
+}
+
+func validateGrantLimit(limit *datamesh.GrantLimit) error {
+	if limit == nil {
+		return nil
+	}
+	if err := resources.ValidateGrantModes(limit.GrantMode); err != nil {
+		return err
+	}
+	if limit.MaxBytesRead < 0 {
+		return fmt.Errorf("max bytes read cant be negative")
+	}
+	if limit.MaxRowsRead < 0 {
+		return fmt.Errorf("max rows read cant be negative")
+	}
+	if limit.SamplePercent < 0 || limit.SamplePercent > 100 {
+		return fmt.Errorf("sample percent must be in [0, 100]")
+	}
+	for _, c := range limit.AllowedColumns {
+		if c == "" {
+			return fmt.Errorf("allowed column cant be empty")
+		}
+	}
+	for _, c := range limit.AllowedComponents {
+		if c.Name == "" {
+			return fmt.Errorf("allowed component name cant be empty")
+		}
 	}
 	return nil
 }
@@ -21,9 +21,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	kusciav1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
 	"github.com/secretflow/kuscia/pkg/datamesh/config"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
@@ -124,3 +126,43 @@ func TestCreateDomainDataGrant(t *testing.T) {
 	_, err = conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(conf.KubeNamespace).Get(context.Background(), resp.Data.DomaindatagrantId, metav1.GetOptions{})
 	assert.True(t, k8serrors.IsNotFound(err))
 }
+
+func TestDomainDataGrantConvertRoundTrip(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	s := &domainDataGrantService{conf: &config.DataMeshConfig{DomainKey: key}}
+	data := &datamesh.DomainDataGrantData{
+		DomaindatagrantId: "grant-1",
+		Author:            "alice",
+		DomaindataId:      "data-1",
+		GrantDomain:       "bob",
+		Limit: &datamesh.GrantLimit{
+			ExpirationTime: time.Now().Add(time.Hour).UnixNano(),
+			UseCount:       3,
+			FlowId:         "flow-1",
+			Components:     []string{"psi"},
+			Initiator:      "alice",
+			InputConfig:    "{}",
+			GrantMode:      []string{"metadata"},
+			MaxBytesRead:   1 << 20,
+			MaxRowsRead:    1000,
+			SamplePercent:  10,
+			AllowedColumns: []string{"id"},
+			AllowedComponents: []*datamesh.AllowedComponent{
+				{Name: "psi", Versions: []string{"1.0.0"}},
+			},
+		},
+		Description: map[string]string{"usage": "test"},
+	}
+	dg := &kusciav1alpha1.DomainDataGrant{}
+	assert.NoError(t, s.convertData2Spec(data, dg))
+	got := &datamesh.DomainDataGrantData{}
+	s.convertSpec2Data(dg, got)
+	// the signature is generated by the conversion
+	assert.NotEmpty(t, got.Signature)
+	data.Signature = got.Signature
+	assert.True(t, proto.Equal(data, got), "got %v", got)
+
+	assert.Error(t, validateGrantLimit(&datamesh.GrantLimit{GrantMode: []string{"all"}}))
+	assert.Error(t, validateGrantLimit(&datamesh.GrantLimit{SamplePercent: 101}))
+}
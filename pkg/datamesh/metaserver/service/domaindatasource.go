@@ -26,11 +26,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
 	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	"github.com/secretflow/kuscia/pkg/datamesh/config"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
-	"github.com/secretflow/kuscia/pkg/utils/tls"
 	"github.com/secretflow/kuscia/pkg/web/utils"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/confmanager"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
@@ -50,19 +50,21 @@ type IDomainDataSourceService interface {
 type domainDataSourceService struct {
 	conf          *config.DataMeshConfig
 	configService cmservice.IConfigService
+	sealer        *secretbackend.Sealer
 }
 
 func NewDomainDataSourceService(config *config.DataMeshConfig, configService cmservice.IConfigService) IDomainDataSourceService {
 	return &domainDataSourceService{
 		conf:          config,
 		configService: configService,
+		sealer:        secretbackend.NewSealer(config.DomainKey, config.SecretBackend),
 	}
 }
 
 func (s domainDataSourceService) CreateDefaultDomainDataSource(ctx context.Context) error {
 	nlog.Infof("Create default datasource %s.", common.DefaultDataSourceID)
 
-	kusciaDomainDataSource, err := s.generateDefaultDataSource(common.DefaultDataSourceID)
+	kusciaDomainDataSource, err := s.generateDefaultDataSource(ctx, common.DefaultDataSourceID)
 	if err != nil {
 		nlog.Errorf("GenerateDefaultDataSource %s failed, error:%s", common.DefaultDataSourceID, err.Error())
 		return err
@@ -78,7 +80,7 @@ func (s domainDataSourceService) CreateDefaultDomainDataSource(ctx context.Conte
 
 func (s domainDataSourceService) CreateDefaultDataProxyDomainDataSource(ctx context.Context) error {
 	nlog.Infof("Create default datasource: %s.", common.DefaultDataProxyDataSourceID)
-	kusciaDomainDataSource, err := s.generateDefaultDataSource(common.DefaultDataProxyDataSourceID)
+	kusciaDomainDataSource, err := s.generateDefaultDataSource(ctx, common.DefaultDataProxyDataSourceID)
 	if err != nil {
 		nlog.Errorf("GenerateDefaultDataSource %s failed, error:%s", common.DefaultDataProxyDataSourceID, err.Error())
 		return err
@@ -93,7 +95,7 @@ func (s domainDataSourceService) CreateDefaultDataProxyDomainDataSource(ctx cont
 	return nil
 }
 
-func (s domainDataSourceService) generateDefaultDataSource(dsID string) (*v1alpha1.DomainDataSource, error) {
+func (s domainDataSourceService) generateDefaultDataSource(ctx context.Context, dsID string) (*v1alpha1.DomainDataSource, error) {
 	info := &datamesh.DataSourceInfo{
 		Localfs: &datamesh.LocalDataSourceInfo{
 			Path: path.Join(s.conf.RootDir, common.DefaultDomainDataSourceLocalFSPath),
@@ -101,7 +103,7 @@ func (s domainDataSourceService) generateDefaultDataSource(dsID string) (*v1alph
 	}
 
 	// parse DataSource
-	uri, encInfo, err := s.encryptInfo(common.DomainDataSourceTypeLocalFS, info)
+	uri, encInfo, err := s.encryptInfo(ctx, common.DomainDataSourceTypeLocalFS, info)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +158,7 @@ func (s domainDataSourceService) QueryDomainDataSource(ctx context.Context, requ
 				Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_DataMeshErrQueryDomainDataSource, "datasource crd encryptedInfo field is not exist"),
 			}
 		}
-		info, err = s.decryptInfo(encryptedInfo)
+		info, err = s.decryptInfo(ctx, encryptedInfo)
 		if err != nil {
 			return &datamesh.QueryDomainDataSourceResponse{
 				Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_DataMeshErrQueryDomainDataSource, err.Error()),
@@ -237,7 +239,7 @@ func parseDataSourceURI(sourceType string, info *datamesh.DataSourceInfo) (uri s
 }
 
 //nolint:dupl
-func (s domainDataSourceService) encryptInfo(dataSourceType string, info *datamesh.DataSourceInfo) (uri string, encInfo string, err error) {
+func (s domainDataSourceService) encryptInfo(ctx context.Context, dataSourceType string, info *datamesh.DataSourceInfo) (uri string, encInfo string, err error) {
 	uri, err = parseDataSourceURI(dataSourceType, info)
 	if err != nil {
 		return "", "", fmt.Errorf("parse data source failed, %v", err)
@@ -249,8 +251,8 @@ func (s domainDataSourceService) encryptInfo(dataSourceType string, info *datame
 		return "", "", err
 	}
 
-	// encrypt
-	encInfo, err = tls.EncryptOAEP(&s.conf.DomainKey.PublicKey, infoBytes)
+	// encrypt by the secret backend if it's configured, otherwise by the domain key
+	encInfo, err = s.sealer.Encrypt(ctx, infoBytes)
 	if err != nil {
 		return "", "", fmt.Errorf("encrypt plaintext failed, %v", err)
 	}
@@ -259,8 +261,8 @@ func (s domainDataSourceService) encryptInfo(dataSourceType string, info *datame
 }
 
 //nolint:dupl
-func (s domainDataSourceService) decryptInfo(cipherInfo string) (*datamesh.DataSourceInfo, error) {
-	plaintext, err := tls.DecryptOAEP(s.conf.DomainKey, cipherInfo)
+func (s domainDataSourceService) decryptInfo(ctx context.Context, cipherInfo string) (*datamesh.DataSourceInfo, error) {
+	plaintext, err := s.sealer.Decrypt(ctx, cipherInfo)
 	if err != nil {
 		return nil, fmt.Errorf("decrypt data source info failed, %v", err)
 	}
@@ -100,7 +100,7 @@ func (c *Client) invokeProtoRequest(ctx context.Context, method, path string, re
 	if request != nil {
 		reader = bytes.NewReader(request)
 	}
-	req, err := http.NewRequest(method, c.getURL(path), reader)
+	req, err := http.NewRequestWithContext(ctx, method, c.getURL(path), reader)
 	if err != nil {
 		return nil, err
 	}
@@ -162,12 +162,17 @@ func (c *Client) Mock(ctx context.Context, request *diagnose.MockRequest) (respo
 }
 
 func (c *Client) MockChunk(req proto.Message, url string) (*http.Response, error) {
+	return c.MockChunkWithContext(context.Background(), req, url)
+}
+
+// MockChunkWithContext is MockChunk whose response body reading is canceled with the ctx.
+func (c *Client) MockChunkWithContext(ctx context.Context, req proto.Message, url string) (*http.Response, error) {
 	jsonData, err := proto.Marshal(req)
 	if err != nil {
 		nlog.Errorf("Error marshaling proto: %v", err)
 		return nil, err
 	}
-	httpReq, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		nlog.Errorf("Error creating request: %v", err)
 		return nil, err
@@ -200,7 +205,8 @@ func NewGrpcConn(address string, tlsconfig *config.TLSConfig, tokenFile string)
 		if err != nil {
 			return nil, err
 		}
-		dialOpts = append(dialOpts, grpc.WithUnaryInterceptor(interceptor.GrpcClientTokenInterceptor(string(token))))
+		dialOpts = append(dialOpts, grpc.WithUnaryInterceptor(interceptor.GrpcClientTokenInterceptor(string(token))),
+			grpc.WithStreamInterceptor(interceptor.GrpcClientStreamTokenInterceptor(string(token))))
 	}
 	// use tls if ca file exists
 	if err := paths.CheckAllFileExist(tlsconfig.CAPath, tlsconfig.ServerCertPath, tlsconfig.ServerKeyPath); err != nil {
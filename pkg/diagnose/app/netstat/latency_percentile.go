@@ -0,0 +1,101 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstat
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/secretflow/kuscia/pkg/diagnose/app/client"
+	"github.com/secretflow/kuscia/pkg/diagnose/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/diagnose"
+)
+
+const (
+	PercentileIteration    = 200
+	DefaultP99RTTThreshold = 200 // 200ms
+)
+
+// LatencyPercentileTask records the latency percentiles between two nodes.
+// Client sends request for 200 times and records the p50, p90 and p99 of the durations, a high p99 with a normal
+// average latency usually means packet loss and retransmission on the path.
+type LatencyPercentileTask struct {
+	client    *client.Client
+	threshold int
+	output    *TaskOutput
+}
+
+func NewLatencyPercentileTask(client *client.Client, threshold int) Task {
+	if threshold == 0 {
+		threshold = DefaultP99RTTThreshold
+	}
+	task := &LatencyPercentileTask{
+		client:    client,
+		threshold: threshold,
+		output:    new(TaskOutput),
+	}
+	task.output.Name = task.Name()
+	task.output.Threshold = fmt.Sprintf("p99 %v%v", threshold, LatencyUnit)
+	return task
+}
+
+func (t *LatencyPercentileTask) Run(ctx context.Context) {
+	nlog.Infof("Run %v task, threshold: %v", t.Name(), t.output.Threshold)
+	req := &diagnose.MockRequest{
+		Duration: LatencyDuration,
+	}
+
+	var err error
+	durations := make([]float64, 0, PercentileIteration)
+	for i := 0; i < PercentileIteration; i++ {
+		start := time.Now()
+		if _, err = t.client.Mock(ctx, req); err != nil {
+			nlog.Errorf("Mock error: %v", err)
+			continue
+		}
+		durations = append(durations, float64(time.Since(start).Microseconds())/1000)
+	}
+	if len(durations) == 0 {
+		t.output.Result = common.Fail
+		t.output.Information = fmt.Sprintf("mock failed, %v", err)
+		return
+	}
+	sort.Float64s(durations)
+	p50, p90, p99 := Decimal(Percentile(durations, 50)), Decimal(Percentile(durations, 90)), Decimal(Percentile(durations, 99))
+	t.output.DetectedValue = fmt.Sprintf("p50 %v%v, p90 %v%v, p99 %v%v", p50, LatencyUnit, p90, LatencyUnit, p99, LatencyUnit)
+	if failed := PercentileIteration - len(durations); failed > 0 {
+		t.output.Result = common.Warning
+		t.output.Information = fmt.Sprintf("%d of %d requests failed, %v", failed, PercentileIteration, err)
+		return
+	}
+	if p99 <= float64(t.threshold) {
+		t.output.Result = common.Pass
+	} else {
+		t.output.Result = common.Warning
+		t.output.Information = fmt.Sprintf("p99 not satisfy threshold %v%v", t.threshold, LatencyUnit)
+	}
+}
+
+func (t *LatencyPercentileTask) Output() *TaskOutput {
+	return t.output
+}
+
+func (t *LatencyPercentileTask) Name() string {
+	return "RTT_PERCENTILE"
+}
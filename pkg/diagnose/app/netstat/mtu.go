@@ -0,0 +1,109 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstat
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/secretflow/kuscia/pkg/diagnose/app/client"
+	"github.com/secretflow/kuscia/pkg/diagnose/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/diagnose"
+)
+
+const (
+	MTUUnit           = "bytes"
+	DefaultMTU        = 1500
+	directionUpload   = "request"
+	directionDownload = "response"
+)
+
+// mtuProbeTimeout is the timeout of each probe, the stalled requests are regarded as dropped.
+var mtuProbeTimeout = 5 * time.Second
+
+// MTUProbeSizes are the payload sizes probed through the gateways, around the common MTU of the tunnels and ethernet.
+var MTUProbeSizes = []int{512, 1200, 1400, 1472, 1500, 4096, 9000, 65536}
+
+// PathMTUTask detects the path MTU problems between two nodes through the gateways instead of the raw ICMP, which is
+// usually blocked by the partner networks.
+// Client sends the requests and asks the server to return the responses of increasing payload sizes. When a device on
+// the path has a smaller MTU and the ICMP "fragmentation needed" is dropped, the packets larger than the MTU are
+// silently dropped (MTU black hole), so the small payloads succeed while the large ones stall until timeout.
+type PathMTUTask struct {
+	client *client.Client
+	output *TaskOutput
+}
+
+func NewPathMTUTask(client *client.Client) Task {
+	task := &PathMTUTask{
+		client: client,
+		output: new(TaskOutput),
+	}
+	task.output.Name = task.Name()
+	task.output.Threshold = fmt.Sprintf("%v%v", DefaultMTU, MTUUnit)
+	return task
+}
+
+func (t *PathMTUTask) Run(ctx context.Context) {
+	nlog.Infof("Run %v task", t.Name())
+	passed := 0
+	for _, size := range MTUProbeSizes {
+		direction, err := t.Detect(ctx, size)
+		if err == nil {
+			passed = size
+			continue
+		}
+		t.output.Result = common.Fail
+		if passed == 0 {
+			t.output.Information = fmt.Sprintf("mock request failed, %v", err)
+			return
+		}
+		t.output.DetectedValue = fmt.Sprintf("%v~%v%v", passed, size, MTUUnit)
+		t.output.Information = fmt.Sprintf("%s payload of %v%v stalled while %v%v passed, the large packets may be dropped "+
+			"by a device with smaller MTU (MTU black hole), please check the MTU and ICMP settings of the gateways, %v",
+			direction, size, MTUUnit, passed, MTUUnit, err)
+		return
+	}
+	t.output.Result = common.Pass
+	t.output.DetectedValue = fmt.Sprintf(">=%v%v", passed, MTUUnit)
+}
+
+// Detect sends the payload of the size in the request and asks for the same size in the response.
+func (t *PathMTUTask) Detect(ctx context.Context, size int) (string, error) {
+	probe := func(req *diagnose.MockRequest) error {
+		ctx, cancel := context.WithTimeout(ctx, mtuProbeTimeout)
+		defer cancel()
+		_, err := t.client.Mock(ctx, req)
+		return err
+	}
+	if err := probe(&diagnose.MockRequest{Data: make([]byte, size)}); err != nil {
+		return directionUpload, err
+	}
+	if err := probe(&diagnose.MockRequest{RespBodySize: int64(size)}); err != nil {
+		return directionDownload, err
+	}
+	return "", nil
+}
+
+func (t *PathMTUTask) Output() *TaskOutput {
+	return t.output
+}
+
+func (t *PathMTUTask) Name() string {
+	return "PATH_MTU"
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstat
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/secretflow/kuscia/pkg/diagnose/app/client"
+	"github.com/secretflow/kuscia/pkg/diagnose/common"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/diagnose"
+)
+
+// newMockServer serves the mock requests, the payloads larger than maxPayload stall and the chunked stream is cut
+// after streamLimit bytes.
+func newMockServer(t *testing.T, maxPayload int, streamLimit int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		req := &diagnose.MockRequest{}
+		assert.NoError(t, proto.Unmarshal(body, req))
+		if len(req.Data) > maxPayload || req.RespBodySize > int64(maxPayload) && !req.EnableChunked {
+			<-r.Context().Done()
+			return
+		}
+		if req.EnableChunked {
+			chunk := make([]byte, req.ChunkedSize)
+			for sent := int64(0); sent < req.RespBodySize; sent += req.ChunkedSize {
+				if sent >= streamLimit {
+					// drop the connection in the middle of the stream
+					conn, _, _ := w.(http.Hijacker).Hijack()
+					conn.Close()
+					return
+				}
+				_, _ = w.Write(chunk)
+				w.(http.Flusher).Flush()
+			}
+			return
+		}
+		data, _ := proto.Marshal(&diagnose.MockResponse{Data: make([]byte, req.RespBodySize)})
+		_, _ = w.Write(data)
+	}))
+}
+
+func newTestClient(server *httptest.Server) *client.Client {
+	return client.NewDiagnoseClient(strings.TrimPrefix(server.URL, "http://"))
+}
+
+func TestLatencyPercentile(t *testing.T) {
+	server := newMockServer(t, 1<<20, 1<<30)
+	defer server.Close()
+
+	task := NewLatencyPercentileTask(newTestClient(server), 0)
+	task.Run(context.Background())
+	assert.Equal(t, common.Pass, task.Output().Result)
+	assert.Contains(t, task.Output().DetectedValue, "p99")
+	assert.Equal(t, "p99 200ms", task.Output().Threshold)
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assert.Equal(t, float64(5), Percentile(values, 50))
+	assert.Equal(t, float64(9), Percentile(values, 90))
+	assert.Equal(t, float64(10), Percentile(values, 99))
+	assert.Equal(t, float64(0), Percentile(nil, 99))
+}
+
+func TestPathMTU(t *testing.T) {
+	mtuProbeTimeout = 200 * time.Millisecond
+	defer func() { mtuProbeTimeout = 5 * time.Second }()
+
+	server := newMockServer(t, 1<<20, 1<<30)
+	defer server.Close()
+	task := NewPathMTUTask(newTestClient(server))
+	task.Run(context.Background())
+	assert.Equal(t, common.Pass, task.Output().Result)
+	assert.Equal(t, ">=65536bytes", task.Output().DetectedValue)
+
+	blackhole := newMockServer(t, 1400, 1<<30)
+	defer blackhole.Close()
+	task = NewPathMTUTask(newTestClient(blackhole))
+	task.Run(context.Background())
+	assert.Equal(t, common.Fail, task.Output().Result)
+	assert.Equal(t, "1400~1472bytes", task.Output().DetectedValue)
+	assert.Contains(t, task.Output().Information, "MTU black hole")
+}
+
+func TestTLSRenegotiation(t *testing.T) {
+	server := newMockServer(t, 1<<20, RenegotiationStreamSize)
+	defer server.Close()
+	task := NewTLSRenegotiationTask(newTestClient(server))
+	task.Run(context.Background())
+	assert.Equal(t, common.Pass, task.Output().Result)
+
+	interrupted := newMockServer(t, 1<<20, 1<<20)
+	defer interrupted.Close()
+	task = NewTLSRenegotiationTask(newTestClient(interrupted))
+	task.Run(context.Background())
+	assert.Equal(t, common.Fail, task.Output().Result)
+	assert.Contains(t, task.Output().Information, "stream interrupted")
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstat
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/secretflow/kuscia/pkg/diagnose/app/client"
+	"github.com/secretflow/kuscia/pkg/diagnose/common"
+	"github.com/secretflow/kuscia/pkg/utils/math"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/diagnose"
+)
+
+const (
+	RenegotiationStreamSize  = 16 << 20 // 16MB
+	RenegotiationChunkedSize = 64 << 10 // 64KB
+	RenegotiationTimeout     = 120000   // 120s
+)
+
+// TLSRenegotiationTask checks whether the long transfers between two nodes are interrupted.
+// Server returns 16MB chunked data continuously, then client sends another request on the same keep-alive connection.
+// The gateways or middleboxes of the partner networks renegotiating or resetting the TLS connections after some bytes
+// cause the stream to be truncated or the reused connection to be reset, which is a common cause of the jobs failing
+// after running for a while.
+type TLSRenegotiationTask struct {
+	client *client.Client
+	output *TaskOutput
+}
+
+func NewTLSRenegotiationTask(client *client.Client) Task {
+	task := &TLSRenegotiationTask{
+		client: client,
+		output: new(TaskOutput),
+	}
+	task.output.Name = task.Name()
+	return task
+}
+
+func (t *TLSRenegotiationTask) Run(ctx context.Context) {
+	nlog.Infof("Run %v task", t.Name())
+	ctx, cancel := context.WithTimeout(ctx, RenegotiationTimeout*time.Millisecond)
+	defer cancel()
+
+	req := &diagnose.MockRequest{
+		EnableChunked: true,
+		ChunkedSize:   RenegotiationChunkedSize,
+		RespBodySize:  RenegotiationStreamSize,
+	}
+	url := fmt.Sprintf("http://%v/%v/%v", t.client.HostName, common.DiagnoseNetworkGroup, common.DiagnoseMockPath)
+	resp, err := t.client.MockChunkWithContext(ctx, req, url)
+	if err != nil {
+		t.output.Result = common.Fail
+		t.output.Information = err.Error()
+		return
+	}
+	received, err := io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	t.output.DetectedValue = math.ByteCountBinary(received)
+	if (err != nil && !errors.Is(err, io.EOF)) || received < RenegotiationStreamSize {
+		t.output.Result = common.Fail
+		t.output.Information = fmt.Sprintf("stream interrupted after %s of %s, the gateways on the path may renegotiate "+
+			"or reset the tls connection, %v", math.ByteCountBinary(received), math.ByteCountBinary(RenegotiationStreamSize), err)
+		return
+	}
+
+	// the keep-alive connection of the stream is reused
+	if _, err = t.client.Mock(ctx, &diagnose.MockRequest{}); err != nil {
+		t.output.Result = common.Fail
+		t.output.Information = fmt.Sprintf("request on the reused connection failed after the stream, the gateways on the path "+
+			"may renegotiate or reset the tls connection, %v", err)
+		return
+	}
+	t.output.Result = common.Pass
+}
+
+func (t *TLSRenegotiationTask) Output() *TaskOutput {
+	return t.output
+}
+
+func (t *TLSRenegotiationTask) Name() string {
+	return "TLS_RENEGOTIATION"
+}
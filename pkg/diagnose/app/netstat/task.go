@@ -79,6 +79,10 @@ type NetworkParam struct {
 	SizeThres         int  `json:"size_thres"`
 	ProxyBuffer       bool `json:"proxy_buffer"`
 	Bidirection       bool `json:"bi_direction"`
+	RTTPercentile     bool `json:"rtt_percentile"`
+	P99RTTThres       int  `json:"p99_rtt_thres"`
+	PathMTU           bool `json:"path_mtu"`
+	TLSRenegotiation  bool `json:"tls_renegotiation"`
 }
 
 func NewTaskGroup(cli *client.Client, config *NetworkParam) *TaskGroup {
@@ -102,6 +106,15 @@ func NewTaskGroup(cli *client.Client, config *NetworkParam) *TaskGroup {
 	if config.ProxyBuffer {
 		tg.tasks = append(tg.tasks, NewBufferTask(tg.diagnoseClient))
 	}
+	if config.RTTPercentile {
+		tg.tasks = append(tg.tasks, NewLatencyPercentileTask(tg.diagnoseClient, config.P99RTTThres))
+	}
+	if config.PathMTU {
+		tg.tasks = append(tg.tasks, NewPathMTUTask(tg.diagnoseClient))
+	}
+	if config.TLSRenegotiation {
+		tg.tasks = append(tg.tasks, NewTLSRenegotiationTask(tg.diagnoseClient))
+	}
 	return tg
 }
 
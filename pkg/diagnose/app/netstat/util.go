@@ -17,6 +17,7 @@ package netstat
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"sync"
 )
@@ -73,3 +74,18 @@ func ToMbps(value int) float64 {
 func ToMillisecond(value float64) int {
 	return int(value * 1000)
 }
+
+// Percentile returns the nearest-rank percentile of the sorted values, p is in (0, 100].
+func Percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
@@ -128,9 +128,9 @@ func (m *CRDMod) CheckConnection(domainroute *kusciaapi.QueryDomainRouteResponse
 	}
 	var url string
 	if domainroute.Endpoint.Ports[0].IsTLS {
-		url = fmt.Sprintf("https://%s:%d%s", domainroute.Endpoint.Host, domainroute.Endpoint.Ports[0].Port, domainroute.Endpoint.Ports[0].PathPrefix)
+		url = fmt.Sprintf("https://%s:%d%s", utils.BracketIPv6(domainroute.Endpoint.Host), domainroute.Endpoint.Ports[0].Port, domainroute.Endpoint.Ports[0].PathPrefix)
 	} else {
-		url = fmt.Sprintf("http://%s:%d%s", domainroute.Endpoint.Host, domainroute.Endpoint.Ports[0].Port, domainroute.Endpoint.Ports[0].PathPrefix)
+		url = fmt.Sprintf("http://%s:%d%s", utils.BracketIPv6(domainroute.Endpoint.Host), domainroute.Endpoint.Ports[0].Port, domainroute.Endpoint.Ports[0].PathPrefix)
 	}
 	client := &http.Client{
 		Transport: &http.Transport{
@@ -0,0 +1,436 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mods
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	pkgcommon "github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/diagnose/common"
+	util "github.com/secretflow/kuscia/pkg/diagnose/utils"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/resources"
+)
+
+const (
+	JobCheckAppImage  = "APP_IMAGE"
+	JobCheckDomain    = "DOMAIN"
+	JobCheckData      = "DOMAIN_DATA"
+	JobCheckRoute     = "DOMAIN_ROUTE"
+	JobCheckResources = "RESOURCES"
+
+)
+
+// JobCheckResult is the result of a dry-run check of a party.
+type JobCheckResult struct {
+	Party       string
+	Check       string
+	Target      string
+	Result      string
+	Information string
+}
+
+// JobMod checks whether a KusciaJob could run before it is submitted. The domains of the local cluster are fully
+// checked, while only the routes to the partner domains are checked because their resources are in other clusters.
+type JobMod struct {
+	job          *v1alpha1.KusciaJob
+	kubeClient   kubernetes.Interface
+	kusciaClient kusciaclientset.Interface
+	reporter     *util.Reporter
+
+	domains map[string]*v1alpha1.Domain
+	checked map[string]bool
+	results []*JobCheckResult
+}
+
+func NewJobMod(reporter *util.Reporter, kubeClient kubernetes.Interface, kusciaClient kusciaclientset.Interface, job *v1alpha1.KusciaJob) *JobMod {
+	return &JobMod{
+		job:          job,
+		kubeClient:   kubeClient,
+		kusciaClient: kusciaClient,
+		reporter:     reporter,
+		domains:      map[string]*v1alpha1.Domain{},
+		checked:      map[string]bool{},
+	}
+}
+
+func (m *JobMod) Run(ctx context.Context) error {
+	nlog.Infof("diagnose job %s dry-run", m.job.Name)
+	parties := m.parties()
+	for _, party := range parties {
+		m.checkDomain(ctx, party)
+	}
+	for _, task := range m.job.Spec.Tasks {
+		m.checkTask(ctx, task)
+	}
+	for _, src := range parties {
+		for _, dst := range parties {
+			if src != dst {
+				m.checkRoute(ctx, src, dst)
+			}
+		}
+	}
+	return m.render(parties)
+}
+
+// Results returns the results of the checks.
+func (m *JobMod) Results() []*JobCheckResult {
+	return m.results
+}
+
+func (m *JobMod) parties() []string {
+	set := map[string]bool{}
+	if m.job.Spec.Initiator != "" {
+		set[m.job.Spec.Initiator] = true
+	}
+	for _, task := range m.job.Spec.Tasks {
+		for _, p := range task.Parties {
+			set[p.DomainID] = true
+		}
+	}
+	parties := make([]string, 0, len(set))
+	for p := range set {
+		parties = append(parties, p)
+	}
+	sort.Strings(parties)
+	return parties
+}
+
+// isLocal returns whether the party is a domain of the local cluster, whose resources could be checked.
+func (m *JobMod) isLocal(party string) bool {
+	domain, ok := m.domains[party]
+	return ok && domain.Spec.Role != v1alpha1.Partner
+}
+
+func (m *JobMod) checkDomain(ctx context.Context, party string) {
+	domain, err := m.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, party, metav1.GetOptions{})
+	if err != nil {
+		m.onFailure(party, JobCheckDomain, party, fmt.Sprintf("get domain failed, %v", err))
+		return
+	}
+	m.domains[party] = domain
+	if domain.Spec.Role == v1alpha1.Partner {
+		m.onWarning(party, JobCheckDomain, party, "partner domain, the app images, data and resources are checked by its own kuscia")
+		return
+	}
+	m.onSuccess(party, JobCheckDomain, party)
+}
+
+func (m *JobMod) checkTask(ctx context.Context, task v1alpha1.KusciaTaskTemplate) {
+	var appImage *v1alpha1.AppImage
+	appImageErr := fmt.Errorf("app image of task %s is empty", task.Alias)
+	if task.AppImage != "" {
+		appImage, appImageErr = m.kusciaClient.KusciaV1alpha1().AppImages().Get(ctx, task.AppImage, metav1.GetOptions{})
+	}
+
+	for _, p := range task.Parties {
+		if !m.isLocal(p.DomainID) {
+			continue
+		}
+		if appImageErr != nil {
+			m.onceFailure(p.DomainID, JobCheckAppImage, task.AppImage, fmt.Sprintf("get app image failed, %v", appImageErr))
+			continue
+		}
+		template, err := resources.SelectDeployTemplate(appImage.Spec.DeployTemplates, p.Role)
+		if err != nil {
+			m.onceFailure(p.DomainID, JobCheckAppImage, task.AppImage, err.Error())
+			continue
+		}
+		m.once(p.DomainID, JobCheckAppImage, task.AppImage, func() { m.onSuccess(p.DomainID, JobCheckAppImage, task.AppImage) })
+		m.checkResources(ctx, task.Alias, p, template)
+	}
+	m.checkData(ctx, task)
+}
+
+// checkData checks the input domain data of the task are in the local parties and granted to them.
+func (m *JobMod) checkData(ctx context.Context, task v1alpha1.KusciaTaskTemplate) {
+	dataIDs := resources.TaskInputDomainDataIDs(task.TaskInputConfig)
+
+	hasPartner := false
+	for _, id := range dataIDs {
+		found := false
+		for _, p := range task.Parties {
+			if !m.isLocal(p.DomainID) {
+				hasPartner = true
+				continue
+			}
+			data, err := m.kusciaClient.KusciaV1alpha1().DomainDatas(p.DomainID).Get(ctx, id, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			found = true
+			if err != nil {
+				m.onceFailure(p.DomainID, JobCheckData, id, fmt.Sprintf("get domain data failed, %v", err))
+				continue
+			}
+			if data.Spec.Author == "" || data.Spec.Author == p.DomainID {
+				m.once(p.DomainID, JobCheckData, id, func() { m.onSuccess(p.DomainID, JobCheckData, id) })
+				continue
+			}
+			if err = m.checkGrant(ctx, p.DomainID, data); err != nil {
+				m.onceFailure(p.DomainID, JobCheckData, id, err.Error())
+				continue
+			}
+			m.once(p.DomainID, JobCheckData, id, func() { m.onSuccess(p.DomainID, JobCheckData, id) })
+		}
+		if found {
+			continue
+		}
+		if hasPartner {
+			m.once(m.job.Spec.Initiator, JobCheckData, id, func() {
+				m.onWarning(m.job.Spec.Initiator, JobCheckData, id, "domain data not found in the local parties, it may belong to the partners")
+			})
+			continue
+		}
+		m.onceFailure(m.job.Spec.Initiator, JobCheckData, id, fmt.Sprintf("domain data not found in parties of task %s", task.Alias))
+	}
+}
+
+// checkGrant checks the domain data authored by another domain is granted to the party and the grant is not expired.
+func (m *JobMod) checkGrant(ctx context.Context, party string, data *v1alpha1.DomainData) error {
+	grants, err := m.kusciaClient.KusciaV1alpha1().DomainDataGrants(party).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("list domain data grants failed, %v", err)
+	}
+	for _, grant := range grants.Items {
+		if grant.Spec.DomainDataID != data.Name || grant.Spec.GrantDomain != party {
+			continue
+		}
+		if limit := grant.Spec.Limit; limit != nil && limit.ExpirationTime != nil && limit.ExpirationTime.Time.Before(time.Now()) {
+			return fmt.Errorf("grant %s of domain data from %s expired at %s", grant.Name, data.Spec.Author, limit.ExpirationTime.Format(time.RFC3339))
+		}
+		return nil
+	}
+	return fmt.Errorf("domain data is authored by %s but not granted to %s", data.Spec.Author, party)
+}
+
+// checkRoute checks the route from the local source to the destination is ready.
+func (m *JobMod) checkRoute(ctx context.Context, src, dst string) {
+	if !m.isLocal(src) {
+		return
+	}
+	name := fmt.Sprintf("%s-%s", src, dst)
+	dr, err := m.kusciaClient.KusciaV1alpha1().DomainRoutes(src).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		m.onFailure(src, JobCheckRoute, name, fmt.Sprintf("get domain route failed, %v", err))
+		return
+	}
+	if dr.Spec.AuthenticationType == v1alpha1.DomainAuthenticationToken && len(dr.Status.TokenStatus.Tokens) == 0 {
+		m.onFailure(src, JobCheckRoute, name, "token of the domain route is not generated")
+		return
+	}
+	if dr.Status.IsDestinationUnreachable {
+		m.onFailure(src, JobCheckRoute, name, "destination is unreachable")
+		return
+	}
+	m.onSuccess(src, JobCheckRoute, name)
+}
+
+// checkResources checks the ready nodes of the party have enough allocatable resources for the pods of the task.
+func (m *JobMod) checkResources(ctx context.Context, alias string, party v1alpha1.Party, template *v1alpha1.DeployTemplate) {
+	target := fmt.Sprintf("task %s", alias)
+	replicas := 1
+	if template.Replicas != nil && *template.Replicas > 0 {
+		replicas = int(*template.Replicas)
+	}
+	request := podRequest(template, party, replicas)
+	free, err := m.nodeFreeResources(ctx, party.DomainID)
+	if err != nil {
+		m.onFailure(party.DomainID, JobCheckResources, target, err.Error())
+		return
+	}
+	for i := 0; i < replicas; i++ {
+		placed := false
+		for _, nodeFree := range free {
+			if fits(request, nodeFree) {
+				for name, q := range request {
+					left := nodeFree[name]
+					left.Sub(q)
+					nodeFree[name] = left
+				}
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			m.onFailure(party.DomainID, JobCheckResources, target,
+				fmt.Sprintf("no ready node has enough resources for pod %d/%d, request %s", i+1, replicas, formatResources(request)))
+			return
+		}
+	}
+	m.onSuccess(party.DomainID, JobCheckResources, target)
+}
+
+// podRequest returns the resource request of each pod, the resources of the party are split to the pods as the job
+// controller does, otherwise the requests of the containers in the template are used.
+func podRequest(template *v1alpha1.DeployTemplate, party v1alpha1.Party, replicas int) corev1.ResourceList {
+	request := corev1.ResourceList{}
+	if party.Resources != nil && len(party.Resources.Requests) > 0 {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if q, ok := party.Resources.Requests[name]; ok {
+				request[name] = *k8sresource.NewMilliQuantity(q.MilliValue()/int64(replicas), q.Format)
+			}
+		}
+		return request
+	}
+	for _, c := range template.Spec.Containers {
+		for name, q := range c.Resources.Requests {
+			sum := request[name]
+			sum.Add(q)
+			request[name] = sum
+		}
+	}
+	return request
+}
+
+// nodeFreeResources returns the allocatable resources of the ready nodes of the domain minus the requests of the
+// running pods.
+func (m *JobMod) nodeFreeResources(ctx context.Context, domain string) ([]corev1.ResourceList, error) {
+	selector := labels.SelectorFromSet(labels.Set{pkgcommon.LabelNodeNamespace: domain}).String()
+	nodes, err := m.kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("list nodes failed, %v", err)
+	}
+
+	var free []corev1.ResourceList
+	for _, node := range nodes.Items {
+		if !isNodeReady(&node) {
+			continue
+		}
+		pods, err := m.kubeClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node.Name).String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list pods of node %s failed, %v", node.Name, err)
+		}
+		nodeFree := node.Status.Allocatable.DeepCopy()
+		for _, pod := range pods.Items {
+			if pod.Spec.NodeName != node.Name || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			for _, c := range pod.Spec.Containers {
+				for name, q := range c.Resources.Requests {
+					if left, ok := nodeFree[name]; ok {
+						left.Sub(q)
+						nodeFree[name] = left
+					}
+				}
+			}
+		}
+		free = append(free, nodeFree)
+	}
+	if len(free) == 0 {
+		return nil, fmt.Errorf("no ready node of domain %s", domain)
+	}
+	return free, nil
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func fits(request, free corev1.ResourceList) bool {
+	for name, q := range request {
+		left, ok := free[name]
+		if !ok || left.Cmp(q) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func formatResources(list corev1.ResourceList) string {
+	items := make([]string, 0, len(list))
+	for name, q := range list {
+		items = append(items, fmt.Sprintf("%s=%s", name, q.String()))
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+// once runs the check reporting of the party and target only once, since the tasks may share them.
+func (m *JobMod) once(party, check, target string, report func()) {
+	key := strings.Join([]string{party, check, target}, "/")
+	if m.checked[key] {
+		return
+	}
+	m.checked[key] = true
+	report()
+}
+
+func (m *JobMod) onceFailure(party, check, target, message string) {
+	m.once(party, check, target, func() { m.onFailure(party, check, target, message) })
+}
+
+func (m *JobMod) onSuccess(party, check, target string) {
+	m.results = append(m.results, &JobCheckResult{Party: party, Check: check, Target: target, Result: common.Pass})
+}
+
+func (m *JobMod) onWarning(party, check, target, message string) {
+	m.results = append(m.results, &JobCheckResult{Party: party, Check: check, Target: target, Result: common.Warning, Information: message})
+}
+
+func (m *JobMod) onFailure(party, check, target, message string) {
+	m.results = append(m.results, &JobCheckResult{Party: party, Check: check, Target: target, Result: common.Fail, Information: message})
+}
+
+func (m *JobMod) render(parties []string) error {
+	table := m.reporter.NewTableWriter()
+	table.SetTitle(fmt.Sprintf("JOB DRY-RUN CHECK(%s):", m.job.Name))
+	table.AddHeader([]string{"PARTY", "CHECK", "TARGET", "RESULT", "INFORMATION"})
+	partyResults := map[string]string{}
+	for _, r := range m.results {
+		table.AddRow([]string{r.Party, r.Check, r.Target, r.Result, r.Information})
+		if r.Result == common.Fail {
+			partyResults[r.Party] = common.Fail
+		}
+	}
+
+	summary := m.reporter.NewTableWriter()
+	summary.SetTitle(fmt.Sprintf("JOB DRY-RUN RESULT(%s):", m.job.Name))
+	summary.AddHeader([]string{"PARTY", "RESULT"})
+	var failed []string
+	for _, party := range parties {
+		result := common.Pass
+		if partyResults[party] != "" {
+			result = common.Fail
+			failed = append(failed, party)
+		}
+		summary.AddRow([]string{party, result})
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("job %s dry-run failed on parties %s", m.job.Name, strings.Join(failed, ","))
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mods
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	pkgcommon "github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/diagnose/common"
+	"github.com/secretflow/kuscia/pkg/diagnose/utils"
+)
+
+func newTestJob() *v1alpha1.KusciaJob {
+	return &v1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "job-1"},
+		Spec: v1alpha1.KusciaJobSpec{
+			Initiator: "alice",
+			Tasks: []v1alpha1.KusciaTaskTemplate{{
+				Alias:           "psi",
+				AppImage:        "secretflow-image",
+				TaskInputConfig: `{"sf_input_ids":["alice-table","bob-table"]}`,
+				Parties:         []v1alpha1.Party{{DomainID: "alice"}, {DomainID: "bob"}},
+			}},
+		},
+	}
+}
+
+func newTestNode(name, domain, cpu string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{pkgcommon.LabelNodeNamespace: domain}},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{corev1.ResourceCPU: k8sresource.MustParse(cpu)},
+			Conditions:  []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func newTestRoute(src, dst string) *v1alpha1.DomainRoute {
+	return &v1alpha1.DomainRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: src + "-" + dst, Namespace: src},
+		Spec:       v1alpha1.DomainRouteSpec{AuthenticationType: v1alpha1.DomainAuthenticationToken},
+		Status: v1alpha1.DomainRouteStatus{
+			TokenStatus: v1alpha1.DomainRouteTokenStatus{Tokens: []v1alpha1.DomainRouteToken{{Token: "token"}}},
+		},
+	}
+}
+
+func runJobMod(t *testing.T, kusciaClient *kusciafake.Clientset, kubeClient *kubefake.Clientset) (*JobMod, error) {
+	mod := NewJobMod(utils.NewReporter(""), kubeClient, kusciaClient, newTestJob())
+	err := mod.Run(context.Background())
+	return mod, err
+}
+
+func findResult(mod *JobMod, party, check string) *JobCheckResult {
+	for _, r := range mod.Results() {
+		if r.Party == party && r.Check == check {
+			return r
+		}
+	}
+	return nil
+}
+
+func TestJobModSuccess(t *testing.T) {
+	appImage := &v1alpha1.AppImage{
+		ObjectMeta: metav1.ObjectMeta{Name: "secretflow-image"},
+		Spec: v1alpha1.AppImageSpec{
+			DeployTemplates: []v1alpha1.DeployTemplate{{
+				Name: "secretflow",
+				Spec: v1alpha1.PodSpec{Containers: []v1alpha1.Container{{
+					Name: "secretflow",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: k8sresource.MustParse("1")},
+					},
+				}}},
+			}},
+		},
+	}
+	kusciaClient := kusciafake.NewSimpleClientset(
+		&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "alice"}},
+		&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "bob"}},
+		appImage,
+		&v1alpha1.DomainData{ObjectMeta: metav1.ObjectMeta{Name: "alice-table", Namespace: "alice"}, Spec: v1alpha1.DomainDataSpec{Author: "alice"}},
+		&v1alpha1.DomainData{ObjectMeta: metav1.ObjectMeta{Name: "bob-table", Namespace: "bob"}, Spec: v1alpha1.DomainDataSpec{Author: "carol"}},
+		&v1alpha1.DomainDataGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: "grant-1", Namespace: "bob"},
+			Spec:       v1alpha1.DomainDataGrantSpec{Author: "carol", GrantDomain: "bob", DomainDataID: "bob-table"},
+		},
+		newTestRoute("alice", "bob"),
+		newTestRoute("bob", "alice"),
+	)
+	kubeClient := kubefake.NewSimpleClientset(newTestNode("alice-node", "alice", "2"), newTestNode("bob-node", "bob", "1"))
+
+	mod, err := runJobMod(t, kusciaClient, kubeClient)
+	assert.NoError(t, err)
+	for _, r := range mod.Results() {
+		assert.Equal(t, common.Pass, r.Result, "%+v", r)
+	}
+	assert.Len(t, mod.Results(), 10)
+}
+
+func TestJobModFail(t *testing.T) {
+	kusciaClient := kusciafake.NewSimpleClientset(
+		&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "alice"}},
+		&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "bob"}, Spec: v1alpha1.DomainSpec{Role: v1alpha1.Partner}},
+		&v1alpha1.AppImage{
+			ObjectMeta: metav1.ObjectMeta{Name: "secretflow-image"},
+			Spec:       v1alpha1.AppImageSpec{DeployTemplates: []v1alpha1.DeployTemplate{{Name: "secretflow"}}},
+		},
+		&v1alpha1.DomainData{ObjectMeta: metav1.ObjectMeta{Name: "alice-table", Namespace: "alice"}, Spec: v1alpha1.DomainDataSpec{Author: "carol"}},
+	)
+	kubeClient := kubefake.NewSimpleClientset()
+
+	mod, err := runJobMod(t, kusciaClient, kubeClient)
+	assert.Error(t, err)
+	assert.Equal(t, common.Warning, findResult(mod, "bob", JobCheckDomain).Result)
+	assert.Equal(t, common.Pass, findResult(mod, "alice", JobCheckAppImage).Result)
+	assert.Equal(t, common.Fail, findResult(mod, "alice", JobCheckResources).Result)
+	assert.Equal(t, common.Fail, findResult(mod, "alice", JobCheckRoute).Result)
+	// the partner party is not checked except the domain
+	assert.Nil(t, findResult(mod, "bob", JobCheckRoute))
+
+	var dataResults []*JobCheckResult
+	for _, r := range mod.Results() {
+		if r.Check == JobCheckData {
+			dataResults = append(dataResults, r)
+		}
+	}
+	assert.Len(t, dataResults, 2)
+	assert.Equal(t, common.Fail, dataResults[0].Result)
+	assert.Contains(t, dataResults[0].Information, "not granted")
+	assert.Equal(t, common.Warning, dataResults[1].Result)
+}
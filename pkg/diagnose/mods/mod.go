@@ -46,5 +46,9 @@ func (c *DiagnoseConfig) String() string {
 --TestProxyTimeout: %v, Threshold: %v
 --TestProxyBuffer: %v
 --TestRequestBodySize: %v, Threshold: %v
---BidrectionMode: %v`, c.Command, c.Source, c.Destination, c.CRDType, c.ReportFile, c.Speed, c.SpeedThres, c.RTT, c.RTTTres, c.ProxyTimeout, c.ProxyTimeoutThres, c.ProxyBuffer, c.Size, c.SizeThres, c.Bidirection)
+--TestRTTPercentile: %v, P99 Threshold: %v
+--TestPathMTU: %v
+--TestTLSRenegotiation: %v
+--BidrectionMode: %v`, c.Command, c.Source, c.Destination, c.CRDType, c.ReportFile, c.Speed, c.SpeedThres, c.RTT, c.RTTTres, c.ProxyTimeout, c.ProxyTimeoutThres, c.ProxyBuffer, c.Size, c.SizeThres,
+		c.RTTPercentile, c.P99RTTThres, c.PathMTU, c.TLSRenegotiation, c.Bidirection)
 }
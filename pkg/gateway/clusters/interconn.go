@@ -22,13 +22,14 @@ import (
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 
 	"github.com/secretflow/kuscia/pkg/gateway/config"
+	"github.com/secretflow/kuscia/pkg/gateway/utils"
 	"github.com/secretflow/kuscia/pkg/gateway/xds"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 )
 
 const (
 	transportService = "transport"
-	schedulerService = "interconn-scheduler"
+	schedulerService = utils.ServiceInterConnScheduler
 )
 
 func AddInterConnClusters(namespace string, config *config.InterConnClusterConfig) error {
@@ -26,6 +26,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/driver"
+	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
 	informers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
 	"github.com/secretflow/kuscia/pkg/diagnose/app/server"
 	"github.com/secretflow/kuscia/pkg/gateway/clusters"
@@ -147,6 +149,17 @@ func Run(ctx context.Context, gwConfig *config.GatewayConfig, clients *kubeconfi
 
 	// start DomainRoute controller
 	drInformer := kusciaInformerFactory.Kuscia().V1alpha1().DomainRoutes()
+	// the custom filters of the domain routes are loaded from ConfManager, the gateway works without them
+	configService, err := cmservice.NewConfigService(ctx, &cmservice.ConfigServiceConfig{
+		DomainID:      gwConfig.DomainID,
+		DomainKey:     gwConfig.DomainKey,
+		SecretBackend: gwConfig.SecretBackend,
+		Driver:        driver.CRDDriverType,
+		KubeClient:    clients.KubeClient,
+	})
+	if err != nil {
+		nlog.Warnf("Init cm config service for gateway failed, the domain routes with filters won't work, %v", err)
+	}
 	drConfig := &controller.DomainRouteConfig{
 		Namespace:     gwConfig.DomainID,
 		MasterConfig:  masterConfig,
@@ -156,10 +169,18 @@ func Run(ctx context.Context, gwConfig *config.GatewayConfig, clients *kubeconfi
 		Prikey:        prikey,
 		PrikeyData:    priKeyData,
 		HandshakePort: gwConfig.HandshakePort,
+		TrafficClass:  gwConfig.TrafficClass,
+		Proxy:         gwConfig.Proxy,
+		ConfigService: configService,
 	}
 	drc := controller.NewDomainRouteController(drConfig, clients.KubeClient, clients.KusciaClient, drInformer)
 	go drc.Run(ctx, concurrentSyncs*2, ctx.Done())
 
+	if gwConfig.CertRenewal != nil && gwConfig.CertRenewal.Enable && gwConfig.CACert != nil && gwConfig.CAKey != nil {
+		renewer := controller.NewListenerCertRenewer(gwConfig.CACert, gwConfig.CAKey, gwConfig.ExternalCertIssuer, gwConfig.CertRenewal)
+		go renewer.Run(ctx)
+	}
+
 	pm, err := poller.NewPollManager(isMaster, gwConfig.DomainID, gwc.GatewayName(), serviceInformer, drInformer, gatewayInformer)
 	go pm.Run(concurrentSyncs, ctx.Done())
 
@@ -271,6 +292,10 @@ func StartXds(gwConfig *config.GatewayConfig) error {
 		ExternalCert: externalCert,
 		InternalCert: internalCert,
 		Logdir:       filepath.Join(gwConfig.RootDir, "var/logs/envoy/"),
+		IPFamily:     gwConfig.IPFamily,
+	}
+	if gwConfig.HTTP3 != nil && gwConfig.HTTP3.Enable {
+		xdsConfig.ExternalHTTP3 = &xds.HTTP3Config{AdvertisePort: gwConfig.HTTP3.AdvertisePort}
 	}
 
 	xds.InitSnapshot(gwConfig.DomainID, utils.GetHostname(), xdsConfig)
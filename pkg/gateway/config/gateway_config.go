@@ -19,6 +19,10 @@ import (
 	"crypto/x509"
 	"fmt"
 
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/certmanager"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
+	"github.com/secretflow/kuscia/pkg/gateway/egress"
 	"github.com/secretflow/kuscia/pkg/gateway/utils"
 	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
 )
@@ -48,6 +52,61 @@ type GatewayConfig struct {
 
 	TransportConfig          *kusciaconfig.ServiceConfig `yaml:"transport,omitempty"`
 	InterConnSchedulerConfig *kusciaconfig.ServiceConfig `yaml:"interConnScheduler,omitempty"`
+
+	TrafficClass *TrafficClassConfig `yaml:"trafficClass,omitempty"`
+	HTTP3        *HTTP3Config        `yaml:"http3,omitempty"`
+	Proxy        *ProxyConfig        `yaml:"proxy,omitempty"`
+	// IPFamily is the IP family of the listeners and the connections to the partner domains, IPv4 by default.
+	IPFamily common.IPFamily `yaml:"ipFamily,omitempty"`
+
+	CertRenewal *kusciaconfig.CertRenewalConfig `yaml:"-"`
+	// ExternalCertIssuer renews the external listener cert if it's issued by the external CA.
+	ExternalCertIssuer certmanager.Issuer `yaml:"-"`
+	// SecretBackend unseals the custom filters of the domain routes in ConfManager, nil if they're sealed by the
+	// domain key.
+	SecretBackend secretbackend.Backend `yaml:"-"`
+}
+
+// TrafficClassConfig defines the control-plane class of the cross-domain traffic. The requests to the control-plane
+// services are routed with high priority on separate upstream connections, and they are limited by a reserved
+// bandwidth instead of the bandwidth limit of the route, so that they don't queue behind the bulk data transfers.
+type TrafficClassConfig struct {
+	// ControlPlaneServices are the names of the control-plane services, empty means the traffic isn't classified.
+	ControlPlaneServices []string `yaml:"controlPlaneServices,omitempty"`
+	// ReservedKbps is the bandwidth in KiB/s reserved for the control-plane traffic of a route with bandwidth limit,
+	// 0 means the control-plane traffic isn't limited.
+	ReservedKbps int64 `yaml:"reservedKbps,omitempty"`
+}
+
+// HTTP3Config defines the HTTP/3 listener serving the peers on the udp port of the external listener. The peers
+// learn it from the Alt-Svc header of the responses, and use it if their routes enable HTTP/3.
+type HTTP3Config struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// AdvertisePort is the udp port advertised to the peers, e.g. the port mapped by the NAT, default externalPort.
+	AdvertisePort uint32 `yaml:"advertisePort,omitempty"`
+}
+
+// ProxyConfig defines the forward proxy the gateway connects to the partner domains through, unless the domain
+// route sets its own proxy. The connections to the master aren't proxied.
+type ProxyConfig struct {
+	// URL of the proxy, http://[user:password@]host:port or socks5://[user:password@]host:port.
+	URL string `yaml:"url,omitempty"`
+	// NoProxy lists the destination domain ids or endpoint hosts connected directly, and the entries starting
+	// with a dot match the subdomains, e.g. .example.com.
+	NoProxy []string `yaml:"noProxy,omitempty"`
+}
+
+func DefaultTrafficClassConfig() *TrafficClassConfig {
+	return &TrafficClassConfig{
+		ControlPlaneServices: []string{
+			utils.ServiceAPIServer,
+			utils.ServiceHandshake,
+			utils.ServiceKusciaAPI,
+			utils.ServiceReporter,
+			utils.ServiceInterConnScheduler,
+		},
+		ReservedKbps: 1024,
+	}
 }
 
 func DefaultStaticGatewayConfig() *GatewayConfig {
@@ -63,6 +122,7 @@ func DefaultStaticGatewayConfig() *GatewayConfig {
 		IdleTimeout:    60,
 		ResyncPeriod:   600,
 		MasterConfig:   &kusciaconfig.MasterConfig{},
+		TrafficClass:   DefaultTrafficClassConfig(),
 	}
 	return g
 }
@@ -96,6 +156,16 @@ func (config *GatewayConfig) CheckConfig() error {
 		}
 	}
 
+	if config.TrafficClass != nil && config.TrafficClass.ReservedKbps < 0 {
+		return fmt.Errorf("trafficClass.reservedKbps must not be negative")
+	}
+
+	if config.Proxy != nil && config.Proxy.URL != "" {
+		if _, err := egress.ParseProxyURL(config.Proxy.URL); err != nil {
+			return fmt.Errorf("proxy.url is invalid, %v", err)
+		}
+	}
+
 	return kusciaconfig.CheckMasterConfig(config.MasterConfig)
 }
 
@@ -0,0 +1,98 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+const (
+	defaultMaxRetries = 2
+	// only retry the requests that never reached destination, so the non-idempotent requests are safe to retry
+	retryOnBeforeRequest = "connect-failure,refused-stream"
+)
+
+// applyCircuitBreaker sets the thresholds and the outlier detection of the cluster to destination, it must be
+// called after the cluster is decorated, which sets the default outlier detection.
+func applyCircuitBreaker(cb *kusciaapisv1alpha1.DomainRouteCircuitBreaker, cluster *envoycluster.Cluster) {
+	if cb == nil {
+		return
+	}
+
+	thresholds := &envoycluster.CircuitBreakers_Thresholds{
+		Priority: core.RoutingPriority_DEFAULT,
+	}
+	if cb.MaxConnections > 0 {
+		thresholds.MaxConnections = wrapperspb.UInt32(uint32(cb.MaxConnections))
+	}
+	if cb.MaxPendingRequests > 0 {
+		thresholds.MaxPendingRequests = wrapperspb.UInt32(uint32(cb.MaxPendingRequests))
+	}
+	if cb.MaxRequests > 0 {
+		thresholds.MaxRequests = wrapperspb.UInt32(uint32(cb.MaxRequests))
+	}
+	if budget := cb.RetryBudget; budget != nil {
+		// envoy uses 20% and 3 for the unset fields
+		thresholds.RetryBudget = &envoycluster.CircuitBreakers_Thresholds_RetryBudget{}
+		if budget.BudgetPercent > 0 {
+			thresholds.RetryBudget.BudgetPercent = &v3.Percent{Value: float64(budget.BudgetPercent)}
+		}
+		if budget.MinRetryConcurrency > 0 {
+			thresholds.RetryBudget.MinRetryConcurrency = wrapperspb.UInt32(uint32(budget.MinRetryConcurrency))
+		}
+	}
+	cluster.CircuitBreakers = &envoycluster.CircuitBreakers{
+		Thresholds: []*envoycluster.CircuitBreakers_Thresholds{thresholds},
+	}
+
+	if cluster.OutlierDetection == nil {
+		cluster.OutlierDetection = &envoycluster.OutlierDetection{}
+	}
+	outlier := cluster.OutlierDetection
+	if cb.ConsecutiveFailures > 0 {
+		// count the 5xx responses and the local origin failures together, instead of ejecting on the first
+		// connection failure
+		outlier.SplitExternalLocalOriginErrors = false
+		outlier.ConsecutiveLocalOriginFailure = nil
+		outlier.Consecutive_5Xx = wrapperspb.UInt32(uint32(cb.ConsecutiveFailures))
+		outlier.EnforcingConsecutive_5Xx = wrapperspb.UInt32(100)
+	}
+	if cb.EjectionSeconds > 0 {
+		outlier.BaseEjectionTime = secondsDuration(cb.EjectionSeconds)
+		outlier.MaxEjectionTime = secondsDuration(cb.EjectionSeconds)
+	}
+}
+
+// applyRetryPolicy retries the requests of the virtual host that failed before reaching destination, the
+// concurrent retries are limited by the retry budget of the clusters.
+func applyRetryPolicy(cb *kusciaapisv1alpha1.DomainRouteCircuitBreaker, vh *route.VirtualHost) {
+	if cb == nil || cb.RetryBudget == nil {
+		return
+	}
+	retries := uint32(defaultMaxRetries)
+	if cb.RetryBudget.MaxRetries > 0 {
+		retries = uint32(cb.RetryBudget.MaxRetries)
+	}
+	vh.RetryPolicy = &route.RetryPolicy{
+		RetryOn:    retryOnBeforeRequest,
+		NumRetries: wrapperspb.UInt32(retries),
+	}
+}
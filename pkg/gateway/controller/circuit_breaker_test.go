@@ -0,0 +1,73 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+func TestApplyCircuitBreaker(t *testing.T) {
+	cluster := &envoycluster.Cluster{Name: "alice-to-bob-http"}
+	assert.NoError(t, xds.DecorateRemoteUpstreamCluster(cluster, xds.ProtocolHTTP))
+	applyCircuitBreaker(nil, cluster)
+	assert.Nil(t, cluster.CircuitBreakers)
+	assert.True(t, cluster.OutlierDetection.SplitExternalLocalOriginErrors)
+
+	applyCircuitBreaker(&kusciaapisv1alpha1.DomainRouteCircuitBreaker{
+		MaxConnections:      100,
+		MaxPendingRequests:  10,
+		ConsecutiveFailures: 5,
+		EjectionSeconds:     60,
+		RetryBudget:         &kusciaapisv1alpha1.DomainRouteRetryBudget{BudgetPercent: 10},
+	}, cluster)
+	thresholds := cluster.CircuitBreakers.Thresholds[0]
+	assert.Equal(t, uint32(100), thresholds.MaxConnections.Value)
+	assert.Equal(t, uint32(10), thresholds.MaxPendingRequests.Value)
+	assert.Nil(t, thresholds.MaxRequests)
+	assert.Equal(t, float64(10), thresholds.RetryBudget.BudgetPercent.Value)
+	assert.Nil(t, thresholds.RetryBudget.MinRetryConcurrency)
+
+	outlier := cluster.OutlierDetection
+	assert.False(t, outlier.SplitExternalLocalOriginErrors)
+	assert.Equal(t, uint32(5), outlier.Consecutive_5Xx.Value)
+	assert.Equal(t, uint32(100), outlier.EnforcingConsecutive_5Xx.Value)
+	assert.Equal(t, time.Minute, outlier.BaseEjectionTime.AsDuration())
+	assert.Equal(t, time.Minute, outlier.MaxEjectionTime.AsDuration())
+}
+
+func TestApplyRetryPolicy(t *testing.T) {
+	vh := &route.VirtualHost{Name: "alice-to-bob"}
+	applyRetryPolicy(&kusciaapisv1alpha1.DomainRouteCircuitBreaker{MaxRequests: 10}, vh)
+	assert.Nil(t, vh.RetryPolicy)
+
+	applyRetryPolicy(&kusciaapisv1alpha1.DomainRouteCircuitBreaker{
+		RetryBudget: &kusciaapisv1alpha1.DomainRouteRetryBudget{},
+	}, vh)
+	assert.Equal(t, retryOnBeforeRequest, vh.RetryPolicy.RetryOn)
+	assert.Equal(t, uint32(defaultMaxRetries), vh.RetryPolicy.NumRetries.Value)
+
+	applyRetryPolicy(&kusciaapisv1alpha1.DomainRouteCircuitBreaker{
+		RetryBudget: &kusciaapisv1alpha1.DomainRouteRetryBudget{MaxRetries: 4},
+	}, vh)
+	assert.Equal(t, uint32(4), vh.RetryPolicy.NumRetries.Value)
+}
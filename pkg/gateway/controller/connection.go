@@ -0,0 +1,115 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"time"
+
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+const defaultHTTP2PingTimeout = 5 * time.Second
+
+func secondsDuration(s int32) *durationpb.Duration {
+	return durationpb.New(time.Duration(s) * time.Second)
+}
+
+// applyConnectionProtocolOptions sets the idle timeout and the http2 keepalive of the route. The idle timeout
+// is always reset, since the options of a token route are inherited from the previous cluster.
+func applyConnectionProtocolOptions(conn *kusciaapisv1alpha1.DomainRouteConnection, options *envoyhttp.HttpProtocolOptions) {
+	if options.CommonHttpProtocolOptions == nil {
+		xds.SetCommonHTTPProtocolOptions(options)
+	}
+	options.CommonHttpProtocolOptions.IdleTimeout = durationpb.New(xds.DefaultUpstreamIdleTimeout)
+	if conn == nil {
+		return
+	}
+	if conn.IdleTimeoutSeconds > 0 {
+		options.CommonHttpProtocolOptions.IdleTimeout = secondsDuration(conn.IdleTimeoutSeconds)
+	}
+	if conn.HTTP2PingIntervalSeconds <= 0 {
+		return
+	}
+
+	keepalive := &core.KeepaliveSettings{
+		Interval: secondsDuration(conn.HTTP2PingIntervalSeconds),
+		Timeout:  durationpb.New(defaultHTTP2PingTimeout),
+	}
+	if conn.HTTP2PingTimeoutSeconds > 0 {
+		keepalive.Timeout = secondsDuration(conn.HTTP2PingTimeoutSeconds)
+	}
+	switch upstream := options.UpstreamProtocolOptions.(type) {
+	case *envoyhttp.HttpProtocolOptions_ExplicitHttpConfig_:
+		if h2, ok := upstream.ExplicitHttpConfig.ProtocolConfig.(*envoyhttp.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions); ok {
+			if h2.Http2ProtocolOptions == nil {
+				h2.Http2ProtocolOptions = &core.Http2ProtocolOptions{}
+			}
+			h2.Http2ProtocolOptions.ConnectionKeepalive = keepalive
+		}
+	case *envoyhttp.HttpProtocolOptions_AutoConfig:
+		if upstream.AutoConfig.Http2ProtocolOptions == nil {
+			upstream.AutoConfig.Http2ProtocolOptions = &core.Http2ProtocolOptions{}
+		}
+		upstream.AutoConfig.Http2ProtocolOptions.ConnectionKeepalive = keepalive
+	case *envoyhttp.HttpProtocolOptions_UseDownstreamProtocolConfig:
+		if upstream.UseDownstreamProtocolConfig.Http2ProtocolOptions == nil {
+			upstream.UseDownstreamProtocolConfig.Http2ProtocolOptions = &core.Http2ProtocolOptions{}
+		}
+		upstream.UseDownstreamProtocolConfig.Http2ProtocolOptions.ConnectionKeepalive = keepalive
+	}
+}
+
+// useHTTP3 reports whether the cluster of the port tries HTTP/3, which needs the TLS of destination.
+func useHTTP3(conn *kusciaapisv1alpha1.DomainRouteConnection, dp kusciaapisv1alpha1.DomainPort) bool {
+	return conn != nil && conn.HTTP3 && dp.IsTLS
+}
+
+// applyConnectionKeepalive sets the tcp keepalive and the warm-up of the cluster, it must be called after the
+// cluster is decorated, which enables the tcp keepalive with the system defaults.
+func applyConnectionKeepalive(conn *kusciaapisv1alpha1.DomainRouteConnection, cluster *envoycluster.Cluster) {
+	if conn == nil {
+		return
+	}
+	if cluster.UpstreamConnectionOptions == nil {
+		cluster.UpstreamConnectionOptions = &envoycluster.UpstreamConnectionOptions{}
+	}
+	if cluster.UpstreamConnectionOptions.TcpKeepalive == nil {
+		cluster.UpstreamConnectionOptions.TcpKeepalive = &core.TcpKeepalive{}
+	}
+	keepalive := cluster.UpstreamConnectionOptions.TcpKeepalive
+	if conn.TCPKeepaliveTimeSeconds > 0 {
+		keepalive.KeepaliveTime = wrapperspb.UInt32(uint32(conn.TCPKeepaliveTimeSeconds))
+	}
+	if conn.TCPKeepaliveIntervalSeconds > 0 {
+		keepalive.KeepaliveInterval = wrapperspb.UInt32(uint32(conn.TCPKeepaliveIntervalSeconds))
+	}
+	if conn.TCPKeepaliveProbes > 0 {
+		keepalive.KeepaliveProbes = wrapperspb.UInt32(uint32(conn.TCPKeepaliveProbes))
+	}
+
+	// envoy establishes ratio connections for each stream, so the spare ones are ready for the next requests
+	if conn.WarmUpConnections > 0 {
+		cluster.PreconnectPolicy = &envoycluster.Cluster_PreconnectPolicy{
+			PerUpstreamPreconnectRatio: wrapperspb.Double(float64(1 + conn.WarmUpConnections)),
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/stretchr/testify/assert"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+func TestApplyConnectionProtocolOptions(t *testing.T) {
+	options := xds.GenerateSimpleUpstreamHTTPOptions(true)
+	options.CommonHttpProtocolOptions.IdleTimeout = secondsDuration(60)
+	applyConnectionProtocolOptions(nil, options)
+	assert.Equal(t, xds.DefaultUpstreamIdleTimeout, options.CommonHttpProtocolOptions.IdleTimeout.AsDuration())
+	assert.Nil(t, options.GetUseDownstreamProtocolConfig().GetHttp2ProtocolOptions())
+
+	conn := &kusciaapisv1alpha1.DomainRouteConnection{
+		IdleTimeoutSeconds:       240,
+		HTTP2PingIntervalSeconds: 30,
+	}
+	applyConnectionProtocolOptions(conn, options)
+	assert.Equal(t, 240*time.Second, options.CommonHttpProtocolOptions.IdleTimeout.AsDuration())
+	keepalive := options.GetUseDownstreamProtocolConfig().GetHttp2ProtocolOptions().GetConnectionKeepalive()
+	assert.Equal(t, 30*time.Second, keepalive.Interval.AsDuration())
+	assert.Equal(t, defaultHTTP2PingTimeout, keepalive.Timeout.AsDuration())
+
+	options = xds.GenerateHTTP2UpstreamHTTPOptions(true)
+	conn.HTTP2PingTimeoutSeconds = 3
+	applyConnectionProtocolOptions(conn, options)
+	keepalive = options.GetExplicitHttpConfig().GetHttp2ProtocolOptions().GetConnectionKeepalive()
+	assert.Equal(t, 3*time.Second, keepalive.Timeout.AsDuration())
+
+	options = xds.GenerateHTTP3UpstreamHTTPOptions(true)
+	applyConnectionProtocolOptions(conn, options)
+	keepalive = options.GetAutoConfig().GetHttp2ProtocolOptions().GetConnectionKeepalive()
+	assert.Equal(t, 30*time.Second, keepalive.Interval.AsDuration())
+	assert.NotNil(t, options.GetAutoConfig().GetHttp3ProtocolOptions())
+}
+
+func TestUseHTTP3(t *testing.T) {
+	tlsPort := kusciaapisv1alpha1.DomainPort{Name: "https", Protocol: kusciaapisv1alpha1.DomainRouteProtocolHTTP, IsTLS: true, Port: 1080}
+	plainPort := kusciaapisv1alpha1.DomainPort{Name: "http", Protocol: kusciaapisv1alpha1.DomainRouteProtocolHTTP, Port: 1080}
+	assert.False(t, useHTTP3(nil, tlsPort))
+	assert.False(t, useHTTP3(&kusciaapisv1alpha1.DomainRouteConnection{}, tlsPort))
+	assert.True(t, useHTTP3(&kusciaapisv1alpha1.DomainRouteConnection{HTTP3: true}, tlsPort))
+	assert.False(t, useHTTP3(&kusciaapisv1alpha1.DomainRouteConnection{HTTP3: true}, plainPort))
+}
+
+func TestApplyConnectionKeepalive(t *testing.T) {
+	cluster := &envoycluster.Cluster{Name: "alice-to-bob-http"}
+	assert.NoError(t, xds.DecorateRemoteUpstreamCluster(cluster, xds.ProtocolHTTP))
+	applyConnectionKeepalive(nil, cluster)
+	assert.Nil(t, cluster.UpstreamConnectionOptions.TcpKeepalive.KeepaliveTime)
+	assert.Nil(t, cluster.PreconnectPolicy)
+
+	applyConnectionKeepalive(&kusciaapisv1alpha1.DomainRouteConnection{
+		TCPKeepaliveTimeSeconds:     60,
+		TCPKeepaliveIntervalSeconds: 10,
+		TCPKeepaliveProbes:          3,
+		WarmUpConnections:           1,
+	}, cluster)
+	keepalive := cluster.UpstreamConnectionOptions.TcpKeepalive
+	assert.Equal(t, uint32(60), keepalive.KeepaliveTime.Value)
+	assert.Equal(t, uint32(10), keepalive.KeepaliveInterval.Value)
+	assert.Equal(t, uint32(3), keepalive.KeepaliveProbes.Value)
+	assert.Equal(t, float64(2), cluster.PreconnectPolicy.PerUpstreamPreconnectRatio.Value)
+}
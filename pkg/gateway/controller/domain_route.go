@@ -20,7 +20,9 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"fmt"
+	"net"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -51,6 +53,7 @@ import (
 	kusciareceiver "github.com/secretflow/kuscia-envoy/kuscia/api/filters/http/kuscia_receiver/v3"
 	kusciatokenauth "github.com/secretflow/kuscia-envoy/kuscia/api/filters/http/kuscia_token_auth/v3"
 	"github.com/secretflow/kuscia/pkg/common"
+	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
 	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	clientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
 	kusciaextv1alpha1 "github.com/secretflow/kuscia/pkg/crd/informers/externalversions/kuscia/v1alpha1"
@@ -58,6 +61,9 @@ import (
 	"github.com/secretflow/kuscia/pkg/gateway/clusters"
 	"github.com/secretflow/kuscia/pkg/gateway/config"
 	"github.com/secretflow/kuscia/pkg/gateway/controller/interconn"
+	"github.com/secretflow/kuscia/pkg/gateway/egress"
+	"github.com/secretflow/kuscia/pkg/gateway/mirror"
+	"github.com/secretflow/kuscia/pkg/gateway/signing"
 	"github.com/secretflow/kuscia/pkg/gateway/utils"
 	"github.com/secretflow/kuscia/pkg/gateway/xds"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
@@ -82,6 +88,10 @@ type DomainRouteConfig struct {
 	Prikey        *rsa.PrivateKey
 	PrikeyData    []byte
 	HandshakePort uint32
+	TrafficClass  *config.TrafficClassConfig
+	Proxy         *config.ProxyConfig
+	// ConfigService loads the custom filters of the domain routes, nil if ConfManager isn't available.
+	ConfigService cmservice.IConfigService
 }
 
 type DomainRouteController struct {
@@ -103,10 +113,20 @@ type DomainRouteController struct {
 	drCache sync.Map
 
 	handshakeCache  *gocache.Cache
+	protocolCache   *gocache.Cache
 	handshakeServer *http.Server
 	handshakePort   uint32
 
 	drHeartbeat map[string]time.Time
+	drProbes    *probeWindows
+
+	trafficClass *config.TrafficClassConfig
+	proxy        *config.ProxyConfig
+	tunnels      *egress.Tunnels
+	mirrors      *mirror.Sinks
+
+	configService cmservice.IConfigService
+	signing       *signing.Server
 }
 
 // NewDomainRouteController create a new endpoints controller.
@@ -145,7 +165,15 @@ func NewDomainRouteController(
 		handshakePort:           drConfig.HandshakePort,
 		drCache:                 sync.Map{},
 		handshakeCache:          gocache.New(5*time.Minute, 10*time.Minute),
+		protocolCache:           gocache.New(protocolDetectTTL, 2*protocolDetectTTL),
 		drHeartbeat:             make(map[string]time.Time, 0),
+		drProbes:                newProbeWindows(),
+		trafficClass:            drConfig.TrafficClass,
+		proxy:                   drConfig.Proxy,
+		tunnels:                 egress.NewTunnels(),
+		mirrors:                 mirror.NewSinks(),
+		configService:           drConfig.ConfigService,
+		signing:                 signing.NewServer(drConfig.Namespace),
 	}
 
 	_, _ = DomainRouteInformer.Informer().AddEventHandlerWithResyncPeriod(
@@ -394,6 +422,7 @@ func (c *DomainRouteController) deleteDomainRoute(key string) error {
 		return err
 	}
 	delete(c.drHeartbeat, dr.Name)
+	c.drProbes.delete(dr.Name)
 	c.drCache.Delete(key)
 	return nil
 }
@@ -426,9 +455,22 @@ func (c *DomainRouteController) addClusterWithEnvoy(dr *kusciaapisv1alpha1.Domai
 		}
 	}
 
-	for _, dp := range dr.Spec.Endpoint.Ports {
+	proxyURL := c.getProxyURL(dr)
+	var negotiated kusciaapisv1alpha1.DomainRouteTransportProtocol
+	for i, dp := range dr.Spec.Endpoint.Ports {
 		nlog.Infof("add cluster %s-to-%s name:%s protocol:%s port:%d", dr.Spec.Source, dr.Spec.Destination, dp.Name, dp.Protocol, dp.Port)
-		err := addClusterForDstGateway(dr, dp, transportSocket)
+		tunnelAddr, err := c.updateProxyTunnel(dr, dp, proxyURL)
+		if err != nil {
+			return err
+		}
+		// the ports of destination are served by the same gateway, so the protocol is negotiated on the first one
+		if dr.Spec.ProtocolNegotiation != nil && i == 0 {
+			if negotiated, err = c.negotiateRouteProtocol(dr, negotiationAddress(dr, dp, tunnelAddr)); err != nil {
+				return err
+			}
+		}
+		dstPort, dstTransportSocket := applyNegotiatedProtocol(negotiated, dp, transportSocket)
+		err = addClusterForDstGateway(dr, dstPort, dstTransportSocket, tunnelAddr)
 		if err != nil {
 			return err
 		}
@@ -518,8 +560,24 @@ func (c *DomainRouteController) updateEnvoyRule(dr *kusciaapisv1alpha1.DomainRou
 			return nil
 		}
 		// case2: direct route, add virtualhost: source-to-dest-Protocol
-		if err := xds.AddOrUpdateVirtualHost(generateInternalVirtualHost(dr, token.Token, grpcDegrade),
-			xds.InternalRoute); err != nil {
+		vh := generateInternalVirtualHost(dr, token.Token, grpcDegrade)
+		if err := c.updateMirror(dr, vh); err != nil {
+			return err
+		}
+		addControlPlaneRoutes(vh, c.trafficClass, getBandwidthLimitKbps(dr))
+		if err := xds.UpdateRouteBandwidthLimit(vh.Name, getBandwidthLimitKbps(dr)); err != nil {
+			return err
+		}
+		if err := xds.UpdateRouteCompression(vh.Name, getRouteCompression(dr)); err != nil {
+			return err
+		}
+		if err := c.updateRouteFilters(dr, vh.Name); err != nil {
+			return err
+		}
+		if err := c.updateRouteSigning(dr, vh.Name, token.Token); err != nil {
+			return err
+		}
+		if err := xds.AddOrUpdateVirtualHost(vh, xds.InternalRoute); err != nil {
 			return err
 		}
 
@@ -538,6 +596,9 @@ func (c *DomainRouteController) updateEnvoyRule(dr *kusciaapisv1alpha1.DomainRou
 			if err := xds.UpdateSourceTokens(sourceToken, true); err != nil {
 				return err
 			}
+			if err := c.updateSourceSigning(dr, tokenVals); err != nil {
+				return err
+			}
 		}
 
 		if len(dr.Spec.RequestHeadersToAdd) > 0 {
@@ -560,6 +621,26 @@ func (c *DomainRouteController) deleteEnvoyRule(dr *kusciaapisv1alpha1.DomainRou
 		if err := xds.DeleteVirtualHost(name, xds.InternalRoute); err != nil {
 			return fmt.Errorf("delete virtual host %s failed with %v", name, err)
 		}
+		if err := xds.UpdateRouteBandwidthLimit(name, 0); err != nil {
+			return err
+		}
+		if err := xds.UpdateRouteCompression(name, nil); err != nil {
+			return err
+		}
+		if err := xds.UpdateRouteFilters(name, nil); err != nil {
+			return err
+		}
+		c.signing.UpdateSigner(name, "", "", "")
+		if err := xds.UpdateRouteSigning(name, false); err != nil {
+			return err
+		}
+		for _, dp := range dr.Spec.Endpoint.Ports {
+			c.tunnels.Close(common.GenerateClusterName(dr.Spec.Source, dr.Spec.Destination, dp.Name))
+		}
+		c.mirrors.Close(name)
+		if err := xds.DeleteCluster(mirrorClusterName(name)); err != nil {
+			return err
+		}
 		if utils.IsReverseTunnelTransit(dr.Spec.Transit) {
 			rule := kusciareceiver.ReceiverRule{
 				Source:      dr.Spec.Source,
@@ -605,6 +686,10 @@ func (c *DomainRouteController) deleteEnvoyRule(dr *kusciaapisv1alpha1.DomainRou
 			if err := xds.UpdateSourceTokens(sourceToken, false); err != nil {
 				return err
 			}
+			c.signing.UpdateVerifier(dr.Spec.Source, nil, 0)
+			if err := xds.UpdateSourceSigning(dr.Spec.Source, false); err != nil {
+				return err
+			}
 			if utils.IsReverseTunnelTransit(dr.Spec.Transit) {
 				sourceHeader := &kusciapoller.Poller_SourceHeader{
 					Source: dr.Spec.Source,
@@ -881,10 +966,30 @@ func generateInternalVirtualHost(dr *kusciaapisv1alpha1.DomainRoute, token strin
 		Domains: []string{fmt.Sprintf("*.%s.svc", dr.Spec.Destination)},
 		Routes:  routes,
 	}
+	applyRetryPolicy(dr.Spec.CircuitBreaker, vh)
 
 	return vh
 }
 
+func getBandwidthLimitKbps(dr *kusciaapisv1alpha1.DomainRoute) int64 {
+	if dr.Spec.BandwidthLimit == nil {
+		return 0
+	}
+	return dr.Spec.BandwidthLimit.LimitKbps
+}
+
+func getRouteCompression(dr *kusciaapisv1alpha1.DomainRoute) *xds.RouteCompressionConfig {
+	compression := dr.Spec.Compression
+	if compression == nil {
+		return nil
+	}
+	return &xds.RouteCompressionConfig{
+		Algorithm:        string(compression.Algorithm),
+		MinContentLength: uint32(compression.MinContentLength),
+		ContentTypes:     compression.ContentTypes,
+	}
+}
+
 func (c *DomainRouteController) setKeepAliveForDstClusters(dr *kusciaapisv1alpha1.DomainRoute, enable bool) error {
 	clusterNames := c.getClusterNamesByDomainRoute(dr)
 	for _, cn := range clusterNames {
@@ -902,14 +1007,21 @@ func (c *DomainRouteController) setKeepAliveForDstClusters(dr *kusciaapisv1alpha
 	return nil
 }
 
+// addClusterForDstGateway connects to the tunnel instead of the destination if tunnelAddr isn't nil.
 func addClusterForDstGateway(dr *kusciaapisv1alpha1.DomainRoute, dp kusciaapisv1alpha1.DomainPort,
-	transportSocket *core.TransportSocket) error {
+	transportSocket *core.TransportSocket, tunnelAddr *net.TCPAddr) error {
 	var protocolOptions *envoyhttp.HttpProtocolOptions
 	var protocol string
+	http3 := false
 	if dr.Labels[grpcDegradeLabel] == "True" && dp.Protocol == kusciaapisv1alpha1.DomainRouteProtocolGRPC {
 		// use http1.1
 		protocolOptions = xds.GenerateHTTP2UpstreamHTTPOptions(true)
 		protocol = xds.GenerateProtocol(dp.IsTLS, true)
+	} else if useHTTP3(dr.Spec.Connection, dp) && tunnelAddr == nil {
+		// try http3 if destination supports it, otherwise http2, quic can't go through the proxies
+		http3 = true
+		protocolOptions = xds.GenerateHTTP3UpstreamHTTPOptions(true)
+		protocol = xds.GenerateProtocol(dp.IsTLS, dp.Protocol == kusciaapisv1alpha1.DomainRouteProtocolGRPC)
 	} else {
 		// use same protocol with downstream
 		protocolOptions = xds.GenerateSimpleUpstreamHTTPOptions(true)
@@ -932,6 +1044,7 @@ func addClusterForDstGateway(dr *kusciaapisv1alpha1.DomainRoute, dp kusciaapisv1
 			protocolOptions.CommonHttpProtocolOptions = preProtocolOptions.CommonHttpProtocolOptions
 		}
 	}
+	applyConnectionProtocolOptions(dr.Spec.Connection, protocolOptions)
 
 	b, err := proto.Marshal(protocolOptions)
 	if err != nil {
@@ -943,29 +1056,7 @@ func addClusterForDstGateway(dr *kusciaapisv1alpha1.DomainRoute, dp kusciaapisv1
 		Name: clusterName,
 		LoadAssignment: &endpoint.ClusterLoadAssignment{
 			ClusterName: clusterName,
-			Endpoints: []*endpoint.LocalityLbEndpoints{
-				{
-					LbEndpoints: []*endpoint.LbEndpoint{
-						{
-							HostIdentifier: &endpoint.LbEndpoint_Endpoint{
-								Endpoint: &endpoint.Endpoint{
-									Address: &core.Address{
-										Address: &core.Address_SocketAddress{
-											SocketAddress: &core.SocketAddress{
-												Address: dr.Spec.Endpoint.Host,
-												PortSpecifier: &core.SocketAddress_PortValue{
-													PortValue: uint32(dp.Port),
-												},
-											},
-										},
-									},
-									Hostname: dr.Spec.Endpoint.Host,
-								},
-							},
-						},
-					},
-				},
-			},
+			Endpoints:   generateDstLbEndpoints(dr, dp, tunnelAddr),
 		},
 		TypedExtensionProtocolOptions: map[string]*anypb.Any{
 			"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": {
@@ -979,12 +1070,89 @@ func addClusterForDstGateway(dr *kusciaapisv1alpha1.DomainRoute, dp kusciaapisv1
 	if err := xds.DecorateRemoteUpstreamCluster(cluster, protocol); err != nil {
 		return err
 	}
+	if http3 {
+		if err := xds.DecorateQUICUpstreamTransport(cluster, utils.StripIPv6Brackets(dr.Spec.Endpoint.Host)); err != nil {
+			return err
+		}
+	}
+	applyConnectionKeepalive(dr.Spec.Connection, cluster)
+	applyCircuitBreaker(dr.Spec.CircuitBreaker, cluster)
 
 	interconn.Decorator.UpdateDstCluster(dr, cluster)
 
 	return xds.AddOrUpdateCluster(cluster)
 }
 
+// generateDstLbEndpoints groups the addresses of destination by priority, envoy fails over to the next priority
+// once the health checks eject the addresses of the current one. The proxied routes connect to the tunnel only,
+// which fails over among the addresses by itself.
+func generateDstLbEndpoints(dr *kusciaapisv1alpha1.DomainRoute, dp kusciaapisv1alpha1.DomainPort,
+	tunnelAddr *net.TCPAddr) []*endpoint.LocalityLbEndpoints {
+	newLbEndpoint := func(address, hostname string, port, weight uint32) *endpoint.LbEndpoint {
+		return &endpoint.LbEndpoint{
+			HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+				Endpoint: &endpoint.Endpoint{
+					Address: &core.Address{
+						Address: &core.Address_SocketAddress{
+							SocketAddress: &core.SocketAddress{
+								Address: address,
+								PortSpecifier: &core.SocketAddress_PortValue{
+									PortValue: port,
+								},
+							},
+						},
+					},
+					Hostname: hostname,
+				},
+			},
+			LoadBalancingWeight: wrapperspb.UInt32(weight),
+		}
+	}
+
+	host := utils.StripIPv6Brackets(dr.Spec.Endpoint.Host)
+	if tunnelAddr != nil {
+		return []*endpoint.LocalityLbEndpoints{{
+			LbEndpoints: []*endpoint.LbEndpoint{newLbEndpoint(tunnelAddr.IP.String(), host, uint32(tunnelAddr.Port), 1)},
+		}}
+	}
+	if len(dr.Spec.Endpoint.Addresses) == 0 {
+		return []*endpoint.LocalityLbEndpoints{{
+			LbEndpoints: []*endpoint.LbEndpoint{newLbEndpoint(host, host, uint32(dp.Port), 1)},
+		}}
+	}
+
+	// envoy requires the priorities to be contiguous from 0, so they are renumbered in order
+	var localities []*endpoint.LocalityLbEndpoints
+	lastPriority := int32(-1)
+	for _, address := range sortedEndpointAddresses(dr.Spec.Endpoint.Addresses) {
+		weight := uint32(address.Weight)
+		if weight == 0 {
+			weight = 1
+		}
+		addressHost := utils.StripIPv6Brackets(address.Host)
+		hostname := host
+		if hostname == "" {
+			hostname = addressHost
+		}
+		if address.Priority != lastPriority {
+			localities = append(localities, &endpoint.LocalityLbEndpoints{Priority: uint32(len(localities))})
+			lastPriority = address.Priority
+		}
+		locality := localities[len(localities)-1]
+		locality.LbEndpoints = append(locality.LbEndpoints, newLbEndpoint(addressHost, hostname, uint32(dp.Port), weight))
+	}
+	return localities
+}
+
+// sortedEndpointAddresses returns the addresses ordered by priority, the highest first.
+func sortedEndpointAddresses(addresses []kusciaapisv1alpha1.DomainEndpointAddress) []kusciaapisv1alpha1.DomainEndpointAddress {
+	sorted := append([]kusciaapisv1alpha1.DomainEndpointAddress{}, addresses...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority < sorted[j].Priority
+	})
+	return sorted
+}
+
 func generateRequestHeaders(dr *kusciaapisv1alpha1.DomainRoute) *headerDecorator.HeaderDecorator_SourceHeader {
 	sourceHeader := &headerDecorator.HeaderDecorator_SourceHeader{
 		Source:  dr.Spec.Source,
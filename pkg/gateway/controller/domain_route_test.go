@@ -29,6 +29,8 @@ import (
 	"time"
 
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	bandwidth_limitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/bandwidth_limit/v3"
+	compressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
 	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -1035,3 +1037,186 @@ func TestDeleteRoute(t *testing.T) {
 	}
 	assert.Nil(t, dvh)
 }
+
+func TestRouteBandwidthLimit(t *testing.T) {
+	ns := "alicelimit"
+	c := newDomainRouteTestInfo(ns, 1058)
+	stopCh := make(chan struct{})
+	go c.Run(context.Background(), 1, stopCh)
+	time.Sleep(200 * time.Millisecond)
+
+	dr := &kusciaapisv1alpha1.DomainRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "alicelimit-bob",
+			Namespace: ns,
+		},
+		Spec: kusciaapisv1alpha1.DomainRouteSpec{
+			Source:            ns,
+			Destination:       "bob",
+			InterConnProtocol: kusciaapisv1alpha1.InterConnKuscia,
+			Endpoint: kusciaapisv1alpha1.DomainEndpoint{
+				Host: EnvoyServerIP,
+				Ports: []kusciaapisv1alpha1.DomainPort{
+					{
+						Protocol: kusciaapisv1alpha1.DomainRouteProtocolHTTP,
+						Port:     ExternalServerPort,
+					},
+				},
+			},
+			AuthenticationType: kusciaapisv1alpha1.DomainAuthenticationToken,
+			TokenConfig: &kusciaapisv1alpha1.TokenConfig{
+				TokenGenMethod:       kusciaapisv1alpha1.TokenGenMethodRSA,
+				SourcePublicKey:      base64.StdEncoding.EncodeToString(pubPemData),
+				DestinationPublicKey: base64.StdEncoding.EncodeToString(pubPemData),
+			},
+			BandwidthLimit: &kusciaapisv1alpha1.DomainRouteBandwidthLimit{
+				LimitKbps: 1024,
+			},
+		},
+		Status: kusciaapisv1alpha1.DomainRouteStatus{
+			TokenStatus: kusciaapisv1alpha1.DomainRouteTokenStatus{
+				Tokens: []kusciaapisv1alpha1.DomainRouteToken{
+					{
+						Token: fakeRevisionToken,
+					},
+				},
+			},
+		},
+	}
+
+	c.client.KusciaV1alpha1().DomainRoutes(dr.Namespace).Create(context.Background(), dr, metav1.CreateOptions{})
+	time.Sleep(200 * time.Millisecond)
+
+	vhName := fmt.Sprintf("%s-to-%s", dr.Spec.Source, dr.Spec.Destination)
+	vh, err := xds.QueryVirtualHost(vhName, xds.InternalRoute)
+	assert.NoError(t, err)
+	config, ok := vh.TypedPerFilterConfig[xds.BandwidthLimitName]
+	assert.True(t, ok)
+	limit := &bandwidth_limitv3.BandwidthLimit{}
+	assert.NoError(t, config.UnmarshalTo(limit))
+	assert.Equal(t, uint64(1024), limit.LimitKbps.GetValue())
+	_, err = xds.GetHTTPFilterConfig(xds.BandwidthLimitName, xds.InternalListener)
+	assert.NoError(t, err)
+
+	c.client.KusciaV1alpha1().DomainRoutes(dr.Namespace).Delete(context.Background(), dr.Name, metav1.DeleteOptions{})
+	time.Sleep(200 * time.Millisecond)
+
+	_, err = xds.GetHTTPFilterConfig(xds.BandwidthLimitName, xds.InternalListener)
+	assert.Error(t, err)
+	stopCh <- struct{}{}
+}
+
+func TestRouteCompression(t *testing.T) {
+	ns := "alicecompress"
+	c := newDomainRouteTestInfo(ns, 1059)
+	stopCh := make(chan struct{})
+	go c.Run(context.Background(), 1, stopCh)
+	time.Sleep(200 * time.Millisecond)
+
+	dr := &kusciaapisv1alpha1.DomainRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "alicecompress-bob",
+			Namespace: ns,
+		},
+		Spec: kusciaapisv1alpha1.DomainRouteSpec{
+			Source:            ns,
+			Destination:       "bob",
+			InterConnProtocol: kusciaapisv1alpha1.InterConnKuscia,
+			Endpoint: kusciaapisv1alpha1.DomainEndpoint{
+				Host: EnvoyServerIP,
+				Ports: []kusciaapisv1alpha1.DomainPort{
+					{
+						Protocol: kusciaapisv1alpha1.DomainRouteProtocolHTTP,
+						Port:     ExternalServerPort,
+					},
+				},
+			},
+			AuthenticationType: kusciaapisv1alpha1.DomainAuthenticationToken,
+			TokenConfig: &kusciaapisv1alpha1.TokenConfig{
+				TokenGenMethod:       kusciaapisv1alpha1.TokenGenMethodRSA,
+				SourcePublicKey:      base64.StdEncoding.EncodeToString(pubPemData),
+				DestinationPublicKey: base64.StdEncoding.EncodeToString(pubPemData),
+			},
+			Compression: &kusciaapisv1alpha1.DomainRouteCompression{
+				Algorithm:        kusciaapisv1alpha1.DomainRouteCompressionZstd,
+				MinContentLength: 4096,
+				ContentTypes:     []string{"application/grpc"},
+			},
+		},
+		Status: kusciaapisv1alpha1.DomainRouteStatus{
+			TokenStatus: kusciaapisv1alpha1.DomainRouteTokenStatus{
+				Tokens: []kusciaapisv1alpha1.DomainRouteToken{
+					{
+						Token: fakeRevisionToken,
+					},
+				},
+			},
+		},
+	}
+
+	c.client.KusciaV1alpha1().DomainRoutes(dr.Namespace).Create(context.Background(), dr, metav1.CreateOptions{})
+	time.Sleep(200 * time.Millisecond)
+
+	vhName := fmt.Sprintf("%s-to-%s", dr.Spec.Source, dr.Spec.Destination)
+	filterName := xds.CompressorFilterName + "/" + vhName
+	vh, err := xds.QueryVirtualHost(vhName, xds.InternalRoute)
+	assert.NoError(t, err)
+	_, ok := vh.TypedPerFilterConfig[filterName]
+	assert.True(t, ok)
+	config, err := xds.GetHTTPFilterConfig(filterName, xds.InternalListener)
+	assert.NoError(t, err)
+	compressor := &compressorv3.Compressor{}
+	assert.NoError(t, config.UnmarshalTo(compressor))
+	assert.Equal(t, "zstd", compressor.CompressorLibrary.Name)
+	assert.Equal(t, uint32(4096), compressor.RequestDirectionConfig.CommonConfig.MinContentLength.GetValue())
+	assert.Equal(t, []string{"application/grpc"}, compressor.RequestDirectionConfig.CommonConfig.ContentType)
+
+	c.client.KusciaV1alpha1().DomainRoutes(dr.Namespace).Delete(context.Background(), dr.Name, metav1.DeleteOptions{})
+	time.Sleep(200 * time.Millisecond)
+
+	_, err = xds.GetHTTPFilterConfig(filterName, xds.InternalListener)
+	assert.Error(t, err)
+	stopCh <- struct{}{}
+}
+
+func TestTokenExpirationTime(t *testing.T) {
+	revisionTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	conf := &kusciaapisv1alpha1.TokenConfig{}
+	assert.Equal(t, revisionTime.AddDate(100, 0, 0), tokenExpirationTime(revisionTime, conf))
+
+	conf.RollingUpdatePeriod = 600
+	assert.Equal(t, revisionTime.Add(1200*time.Second), tokenExpirationTime(revisionTime, conf))
+
+	conf.RollingOverlapPeriod = 60
+	assert.Equal(t, revisionTime.Add(660*time.Second), tokenExpirationTime(revisionTime, conf))
+}
+
+func TestDstClusterWithBackupAddresses(t *testing.T) {
+	dr := newProxyRoute("multi", "bob.example.com")
+	dp := dr.Spec.Endpoint.Ports[0]
+	dr.Spec.Endpoint.Addresses = []kusciaapisv1alpha1.DomainEndpointAddress{
+		{Host: "3.3.3.3", Priority: 5},
+		{Host: "1.1.1.1", Weight: 3},
+		{Host: "2.2.2.2"},
+	}
+	assert.NoError(t, addClusterForDstGateway(dr, dp, nil, nil))
+
+	cluster, err := xds.QueryCluster("alice-to-multi-http")
+	assert.NoError(t, err)
+	localities := cluster.LoadAssignment.Endpoints
+	assert.Len(t, localities, 2)
+
+	assert.Equal(t, uint32(0), localities[0].Priority)
+	assert.Len(t, localities[0].LbEndpoints, 2)
+	primary := localities[0].LbEndpoints[0]
+	assert.Equal(t, "1.1.1.1", primary.GetEndpoint().Address.GetSocketAddress().Address)
+	assert.Equal(t, uint32(1080), primary.GetEndpoint().Address.GetSocketAddress().GetPortValue())
+	assert.Equal(t, "bob.example.com", primary.GetEndpoint().Hostname)
+	assert.Equal(t, uint32(3), primary.LoadBalancingWeight.GetValue())
+	assert.Equal(t, uint32(1), localities[0].LbEndpoints[1].LoadBalancingWeight.GetValue())
+
+	// the priorities are renumbered contiguously
+	assert.Equal(t, uint32(1), localities[1].Priority)
+	assert.Equal(t, "3.3.3.3", localities[1].LbEndpoints[0].GetEndpoint().Address.GetSocketAddress().Address)
+}
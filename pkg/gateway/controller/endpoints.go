@@ -142,7 +142,7 @@ func (ec *EndpointsController) addServiceEventHandler(serviceInformer corev1info
 		cache.FilteringResourceEventHandler{
 			FilterFunc: func(obj interface{}) bool {
 				svc, ok := obj.(*v1.Service)
-				if ok && svc.Spec.Type == v1.ServiceTypeExternalName {
+				if ok && (svc.Spec.Type == v1.ServiceTypeExternalName || trafficSplitTarget(svc) != "") {
 					return true
 				}
 				return false
@@ -181,6 +181,10 @@ func (ec *EndpointsController) updateService(obj interface{}) {
 
 	nlog.Infof("Updating service %s/%s/%s", svc.Namespace, svc.Name, svc.ResourceVersion)
 	ec.enqueue(svc)
+	// the weights of the service whose traffic is split are changed too
+	if target := trafficSplitTarget(svc); target != "" {
+		ec.queue.Add(target)
+	}
 }
 
 func (ec *EndpointsController) updateEndpoints(obj interface{}) {
@@ -222,6 +226,9 @@ func (ec *EndpointsController) syncHandler(ctx context.Context, key string) erro
 	if service == nil {
 		return ec.deleteService(namespace, name)
 	}
+	if target := trafficSplitTarget(service); target != "" {
+		defer ec.queue.Add(target)
+	}
 
 	portScope := service.Labels[common.LabelPortScope]
 	var accessDomains string
@@ -427,6 +434,10 @@ func (ec *EndpointsController) AddEnvoyCluster(namespace string, name string, pr
 	decorateInternalVirtualHost(internalVh, name)
 	decorateExternalVirtualHost(externalVh, name)
 
+	splits := ec.trafficSplits(namespace, name)
+	applyTrafficSplits(internalVh, name, splits)
+	applyTrafficSplits(externalVh, name, splits)
+
 	cluster, err := generateCluster(name, protocol, hosts, clientCert)
 	if err != nil {
 		return err
@@ -138,15 +138,24 @@ func (c *DomainRouteController) checkConnectionStatus(dr *kusciaapisv1alpha1.Dom
 		KusciaSource: dr.Spec.Source,
 		Transit:      utils.IsTransit(dr.Spec.Transit),
 		Headers:      headers}
+	start := time.Now()
 	err := utils.DoHTTP(nil, out, hp)
+	sample := probeSample{latency: time.Since(start)}
+	// the probe is recorded at last, since the status updates above are based on the dr from lister
 	if err != nil {
+		result := probeResultOfError(err)
+		sample.lost = result == kusciaapisv1alpha1.DomainRouteProbeUnreachable
 		_ = c.markDestUnreachable(context.Background(), dr)
+		c.recordProbe(dr, result, err.Error(), sample)
 		return err
 	}
 
 	c.refreshHeartbeatTime(dr)
 	_ = c.markDestReachable(context.Background(), dr)
-	return c.handleGetResponse(out, dr)
+	err = c.handleGetResponse(out, dr)
+	result, message := probeResultOfState(out.State)
+	c.recordProbe(dr, result, message, sample)
+	return err
 }
 
 func (c *DomainRouteController) handleGetResponse(out *getResponse, dr *kusciaapisv1alpha1.DomainRoute) error {
@@ -604,11 +613,7 @@ func (c *DomainRouteController) DestReplyHandshake(req *handshake.HandShakeReque
 		revision = drCopy.Status.TokenStatus.RevisionToken.Revision
 		drCopy.Status.TokenStatus.RevisionToken.RevisionTime = revisionTime
 		drCopy.Status.TokenStatus.RevisionToken.IsReady = false
-		if drCopy.Spec.TokenConfig.RollingUpdatePeriod == 0 {
-			expirationTime = metav1.NewTime(revisionTime.AddDate(100, 0, 0))
-		} else {
-			expirationTime = metav1.NewTime(revisionTime.Add(2 * time.Duration(drCopy.Spec.TokenConfig.RollingUpdatePeriod) * time.Second))
-		}
+		expirationTime = metav1.NewTime(tokenExpirationTime(revisionTime.Time, drCopy.Spec.TokenConfig))
 		drCopy.Status.TokenStatus.RevisionToken.ExpirationTime = expirationTime
 		_, err = c.kusciaClient.KusciaV1alpha1().DomainRoutes(drCopy.Namespace).UpdateStatus(context.Background(), drCopy, metav1.UpdateOptions{})
 		if err != nil {
@@ -862,3 +867,16 @@ func addMasterClusters(domainID string, pathPrefix string, token []byte) error {
 	}
 	return nil
 }
+
+// tokenExpirationTime returns the time when the token generated at revisionTime is no longer accepted. The token
+// rolls after RollingUpdatePeriod, and is kept for RollingOverlapPeriod more for the requests in flight.
+func tokenExpirationTime(revisionTime time.Time, conf *kusciaapisv1alpha1.TokenConfig) time.Time {
+	if conf.RollingUpdatePeriod == 0 {
+		return revisionTime.AddDate(100, 0, 0)
+	}
+	overlap := conf.RollingOverlapPeriod
+	if overlap == 0 {
+		overlap = conf.RollingUpdatePeriod
+	}
+	return revisionTime.Add(time.Duration(conf.RollingUpdatePeriod+overlap) * time.Second)
+}
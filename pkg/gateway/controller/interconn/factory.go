@@ -15,6 +15,8 @@
 package interconn
 
 import (
+	"sync"
+
 	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -39,9 +41,29 @@ type DomainRouteDecorator interface {
 }
 
 type Factory struct {
+	mu       sync.RWMutex
 	handlers map[kusciaapisv1alpha1.InterConnProtocolType]DomainRouteDecorator
 }
 
+// RegisterHandler registers the decorator of the routes with the interconn protocol, so that the protocols added
+// by the interconn adapters are routed by their own rules. The routes with unknown protocols are routed as kuscia.
+func RegisterHandler(protocol kusciaapisv1alpha1.InterConnProtocolType, handler DomainRouteDecorator) {
+	if f, ok := Decorator.(*Factory); ok {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.handlers[protocol] = handler
+	}
+}
+
+func (f *Factory) handler(dr *kusciaapisv1alpha1.DomainRoute) DomainRouteDecorator {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if handler, ok := f.handlers[getInterConnProtocol(dr)]; ok {
+		return handler
+	}
+	return f.handlers[kusciaapisv1alpha1.InterConnKuscia]
+}
+
 func NewDomainRouteDecorator() DomainRouteDecorator {
 	factory := &Factory{
 		handlers: map[kusciaapisv1alpha1.InterConnProtocolType]DomainRouteDecorator{
@@ -54,14 +76,12 @@ func NewDomainRouteDecorator() DomainRouteDecorator {
 
 func (f *Factory) GenerateInternalRoute(dr *kusciaapisv1alpha1.DomainRoute, dp kusciaapisv1alpha1.DomainPort,
 	token string) []*route.Route {
-	interConnProtocol := getInterConnProtocol(dr)
-	return f.handlers[interConnProtocol].GenerateInternalRoute(dr, dp, token)
+	return f.handler(dr).GenerateInternalRoute(dr, dp, token)
 }
 
 func (f *Factory) UpdateDstCluster(dr *kusciaapisv1alpha1.DomainRoute,
 	cluster *envoycluster.Cluster) {
-	interConnProtocol := getInterConnProtocol(dr)
-	f.handlers[interConnProtocol].UpdateDstCluster(dr, cluster)
+	f.handler(dr).UpdateDstCluster(dr, cluster)
 }
 
 func generateDefaultRouteAction(dr *kusciaapisv1alpha1.DomainRoute,
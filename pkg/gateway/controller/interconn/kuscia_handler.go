@@ -130,7 +130,7 @@ func (handler *KusciaHandler) UpdateDstCluster(dr *kusciaapisv1alpha1.DomainRout
 			HealthyThreshold:   wrapperspb.UInt32(1),
 			HealthChecker: &core.HealthCheck_HttpHealthCheck_{
 				HttpHealthCheck: &core.HealthCheck_HttpHealthCheck{
-					Host: dr.Spec.Endpoint.Host,
+					Host: utils.BracketIPv6(dr.Spec.Endpoint.Host),
 					Path: handshakePath,
 					RequestHeadersToAdd: []*core.HeaderValueOption{
 						{
@@ -0,0 +1,108 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"time"
+
+	"github.com/secretflow/kuscia/pkg/confmanager/certmanager"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+// ListenerCertRenewer renews the downstream certs of the gateway listeners generated from the CA, and reloads
+// them through xds. The certs configured by users aren't signed by the CA, so they are left as they are. If
+// externalIssuer isn't nil, the external listener cert is issued by it and renewed by it too.
+type ListenerCertRenewer struct {
+	caCert         *x509.Certificate
+	caKey          *rsa.PrivateKey
+	externalIssuer certmanager.Issuer
+	renewal        *kusciaconfig.CertRenewalConfig
+	now            func() time.Time
+}
+
+func NewListenerCertRenewer(caCert *x509.Certificate, caKey *rsa.PrivateKey, externalIssuer certmanager.Issuer,
+	renewal *kusciaconfig.CertRenewalConfig) *ListenerCertRenewer {
+	return &ListenerCertRenewer{
+		caCert:         caCert,
+		caKey:          caKey,
+		externalIssuer: externalIssuer,
+		renewal:        renewal,
+		now:            time.Now,
+	}
+}
+
+func (r *ListenerCertRenewer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.renewal.CheckInterval())
+	defer ticker.Stop()
+	for {
+		r.check()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *ListenerCertRenewer) check() {
+	for _, listenerName := range []string{xds.ExternalListener, xds.InternalListener} {
+		if err := r.renewListenerCert(context.Background(), listenerName); err != nil {
+			nlog.Warnf("Renew cert of listener %s failed, %v", listenerName, err)
+		}
+	}
+}
+
+func (r *ListenerCertRenewer) renewListenerCert(ctx context.Context, listenerName string) error {
+	tlsCert, err := xds.QueryListenerCert(listenerName)
+	if err != nil || tlsCert == nil {
+		return err
+	}
+	cert, err := tlsutils.ParseCertData([]byte(tlsCert.CertData))
+	if err != nil {
+		return err
+	}
+	if !tlsutils.NeedRenew(cert, r.renewal.RenewBefore(), r.now()) {
+		return nil
+	}
+
+	template := tlsutils.RenewalTemplate(cert, r.now())
+	newCert := &xds.TLSCert{CAData: tlsCert.CAData}
+	switch {
+	case listenerName == xds.ExternalListener && r.externalIssuer != nil:
+		if newCert.KeyData, newCert.CertData, err = certmanager.IssueKeyCertPairData(ctx, r.externalIssuer, template); err != nil {
+			return err
+		}
+	case cert.CheckSignatureFrom(r.caCert) == nil:
+		var certBuf, keyBuf bytes.Buffer
+		if err := tlsutils.GenerateX509KeyPair(r.caCert, r.caKey, template, &certBuf, &keyBuf); err != nil {
+			return err
+		}
+		newCert.KeyData, newCert.CertData = keyBuf.String(), certBuf.String()
+	default:
+		return nil
+	}
+	if err := xds.UpdateListenerCert(listenerName, newCert); err != nil {
+		return err
+	}
+	nlog.Infof("Renew cert of listener %s, it expires at %s", listenerName, template.NotAfter.Format(time.RFC3339))
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+func TestListenerCertRenewer(t *testing.T) {
+	caKey, caCertData, err := tlsutils.CreateCA("test-ca")
+	assert.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caCertData)
+	assert.NoError(t, err)
+	keyData, certData, err := tlsutils.GenerateKeyCertPairData(caKey, caCert, "default_ENVOY_EXTERNAL")
+	assert.NoError(t, err)
+	assert.NoError(t, xds.UpdateListenerCert(xds.ExternalListener, &xds.TLSCert{CertData: certData, KeyData: keyData}))
+
+	renewer := NewListenerCertRenewer(caCert, caKey, nil, &kusciaconfig.CertRenewalConfig{Enable: true})
+	renewer.check()
+	tlsCert, err := xds.QueryListenerCert(xds.ExternalListener)
+	assert.NoError(t, err)
+	assert.Equal(t, certData, tlsCert.CertData)
+
+	// the listener cert is renewed when it's about to expire
+	renewer.now = func() time.Time { return time.Now().AddDate(10, 0, -1) }
+	renewer.check()
+	tlsCert, err = xds.QueryListenerCert(xds.ExternalListener)
+	assert.NoError(t, err)
+	assert.NotEqual(t, certData, tlsCert.CertData)
+	cert, err := tlsutils.ParseCertData([]byte(tlsCert.CertData))
+	assert.NoError(t, err)
+	assert.NoError(t, cert.CheckSignatureFrom(caCert))
+	assert.Equal(t, "default_ENVOY_EXTERNAL", cert.Subject.CommonName)
+	assert.True(t, cert.NotAfter.After(time.Now().AddDate(19, 0, 0)))
+}
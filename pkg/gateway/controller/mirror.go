@@ -0,0 +1,114 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"net"
+
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/mirror"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+func mirrorClusterName(vhName string) string {
+	return fmt.Sprintf("mirror-%s", vhName)
+}
+
+// updateMirror mirrors the routes of the virtual host to the local sink of the route, which posts the requests to
+// the collector. The sink and its cluster are removed if the route isn't mirrored any more.
+func (c *DomainRouteController) updateMirror(dr *kusciaapisv1alpha1.DomainRoute, vh *route.VirtualHost) error {
+	clusterName := mirrorClusterName(vh.Name)
+	if dr.Spec.Mirror == nil {
+		c.mirrors.Close(vh.Name)
+		return xds.DeleteCluster(clusterName)
+	}
+
+	addr, err := c.mirrors.Ensure(vh.Name, mirror.Config{
+		CollectorURL: dr.Spec.Mirror.URL,
+		Full:         dr.Spec.Mirror.Mode == kusciaapisv1alpha1.DomainRouteMirrorFull,
+		MaxBodyBytes: int64(dr.Spec.Mirror.MaxBodyBytes),
+	})
+	if err != nil {
+		return fmt.Errorf("start mirror sink of %s failed with %s", vh.Name, err.Error())
+	}
+	cluster := newLocalCluster(clusterName, addr)
+	if err := xds.DecorateLocalUpstreamCluster(cluster, xds.ProtocolHTTP); err != nil {
+		return err
+	}
+	if err := xds.AddOrUpdateCluster(cluster); err != nil {
+		return err
+	}
+
+	percentage := dr.Spec.Mirror.Percentage
+	if percentage <= 0 {
+		percentage = 100
+	}
+	for _, r := range vh.Routes {
+		if r.GetRoute() == nil || r.GetMatch().GetConnectMatcher() != nil {
+			continue
+		}
+		r.GetRoute().RequestMirrorPolicies = []*route.RouteAction_RequestMirrorPolicy{
+			{
+				Cluster: clusterName,
+				RuntimeFraction: &core.RuntimeFractionalPercent{
+					DefaultValue: &v3.FractionalPercent{
+						Numerator:   uint32(percentage),
+						Denominator: v3.FractionalPercent_HUNDRED,
+					},
+				},
+			},
+		}
+	}
+	return nil
+}
+
+// newLocalCluster returns the cluster of a local server of the gateway, such as the mirror sinks.
+func newLocalCluster(name string, addr *net.TCPAddr) *envoycluster.Cluster {
+	return &envoycluster.Cluster{
+		Name: name,
+		LoadAssignment: &endpoint.ClusterLoadAssignment{
+			ClusterName: name,
+			Endpoints: []*endpoint.LocalityLbEndpoints{
+				{
+					LbEndpoints: []*endpoint.LbEndpoint{
+						{
+							HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+								Endpoint: &endpoint.Endpoint{
+									Address: &core.Address{
+										Address: &core.Address_SocketAddress{
+											SocketAddress: &core.SocketAddress{
+												Address: addr.IP.String(),
+												PortSpecifier: &core.SocketAddress_PortValue{
+													PortValue: uint32(addr.Port),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
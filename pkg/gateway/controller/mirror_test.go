@@ -0,0 +1,58 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/mirror"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+func TestUpdateMirror(t *testing.T) {
+	c := &DomainRouteController{mirrors: mirror.NewSinks()}
+	dr := newProxyRoute("mirrored", "bob.example.com")
+	dr.Spec.Mirror = &kusciaapisv1alpha1.DomainRouteMirror{
+		URL:        "http://collector.alice.svc:8080/mirror",
+		Percentage: 10,
+		Mode:       kusciaapisv1alpha1.DomainRouteMirrorFull,
+	}
+	vh := generateInternalVirtualHost(dr, "token", false)
+	assert.NoError(t, c.updateMirror(dr, vh))
+
+	_, err := xds.QueryCluster("mirror-alice-to-mirrored")
+	assert.NoError(t, err)
+	for _, r := range vh.Routes {
+		policies := r.GetRoute().RequestMirrorPolicies
+		if r.GetMatch().GetConnectMatcher() != nil {
+			assert.Empty(t, policies)
+			continue
+		}
+		assert.Len(t, policies, 1)
+		assert.Equal(t, "mirror-alice-to-mirrored", policies[0].Cluster)
+		assert.Equal(t, uint32(10), policies[0].RuntimeFraction.DefaultValue.Numerator)
+	}
+
+	// disabling the mirror removes the cluster
+	dr.Spec.Mirror = nil
+	vh = generateInternalVirtualHost(dr, "token", false)
+	assert.NoError(t, c.updateMirror(dr, vh))
+	_, err = xds.QueryCluster("mirror-alice-to-mirrored")
+	assert.Error(t, err)
+	assert.Empty(t, vh.Routes[0].GetRoute().RequestMirrorPolicies)
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/utils"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	// probeWindowSize is the number of probes the statistics are computed from, about 5 minutes of probes.
+	probeWindowSize = 20
+	// probeHistorySize is the number of result changes kept in the status.
+	probeHistorySize = 10
+	// probeStatusUpdatePeriod limits the status updates if the probe result doesn't change.
+	probeStatusUpdatePeriod = time.Minute
+)
+
+type probeSample struct {
+	latency time.Duration
+	lost    bool
+}
+
+// probeWindows keeps the recent probe samples of each domain route.
+type probeWindows struct {
+	mu      sync.Mutex
+	samples map[string][]probeSample
+}
+
+func newProbeWindows() *probeWindows {
+	return &probeWindows{samples: map[string][]probeSample{}}
+}
+
+// add appends the sample of the route and returns the average latency of the successful probes and the loss
+// percentage in the window.
+func (w *probeWindows) add(name string, sample probeSample) (avgLatency time.Duration, lossPercent int32) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	samples := append(w.samples[name], sample)
+	if len(samples) > probeWindowSize {
+		samples = samples[len(samples)-probeWindowSize:]
+	}
+	w.samples[name] = samples
+
+	var total time.Duration
+	lost := 0
+	for _, s := range samples {
+		if s.lost {
+			lost++
+		} else {
+			total += s.latency
+		}
+	}
+	if received := len(samples) - lost; received > 0 {
+		avgLatency = total / time.Duration(received)
+	}
+	return avgLatency, int32(lost * 100 / len(samples))
+}
+
+func (w *probeWindows) delete(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.samples, name)
+}
+
+// probeResultOfError classifies a failed probe. The requests rejected by the gateway of destination mean the
+// network is fine but the route isn't authorized.
+func probeResultOfError(err error) kusciaapisv1alpha1.DomainRouteProbeResult {
+	var statusErr *utils.StatusCodeError
+	if errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusUnauthorized || statusErr.StatusCode == http.StatusForbidden) {
+		return kusciaapisv1alpha1.DomainRouteProbeUnauthorized
+	}
+	return kusciaapisv1alpha1.DomainRouteProbeUnreachable
+}
+
+// probeResultOfState classifies the token state responded by destination.
+func probeResultOfState(state DestinationStatus) (kusciaapisv1alpha1.DomainRouteProbeResult, string) {
+	switch state {
+	case TokenReady, TokenNotReady:
+		return kusciaapisv1alpha1.DomainRouteProbeReachable, ""
+	case TokenNotFound:
+		return kusciaapisv1alpha1.DomainRouteProbeUnauthorized, "token not found in destination"
+	case NoAuthentication:
+		return kusciaapisv1alpha1.DomainRouteProbeUnauthorized, "destination doesn't authenticate the route"
+	case DomainIDInputInvalid, TokenRevisionInputInvalid:
+		return kusciaapisv1alpha1.DomainRouteProbeUnauthorized, "destination rejects the source or token revision"
+	default:
+		return kusciaapisv1alpha1.DomainRouteProbeUnauthorized, fmt.Sprintf("destination returns state %d", state)
+	}
+}
+
+// buildProbeStatus merges the probe into the previous status, and reports whether the status should be updated.
+func buildProbeStatus(prev *kusciaapisv1alpha1.DomainRouteProbeStatus, result kusciaapisv1alpha1.DomainRouteProbeResult,
+	message string, sample probeSample, avgLatency time.Duration, lossPercent int32, now metav1.Time) (*kusciaapisv1alpha1.DomainRouteProbeStatus, bool) {
+	status := &kusciaapisv1alpha1.DomainRouteProbeStatus{}
+	if prev != nil {
+		status = prev.DeepCopy()
+	}
+	// the messages of the network errors vary, so only the change of result is recorded in history
+	changed := prev == nil || prev.Result != result
+	status.Result = result
+	status.Message = message
+	status.LastProbeTime = now
+	if !sample.lost {
+		status.LatencyMillis = sample.latency.Milliseconds()
+	}
+	status.AvgLatencyMillis = avgLatency.Milliseconds()
+	status.LossPercent = lossPercent
+	if changed {
+		status.History = append(status.History, kusciaapisv1alpha1.DomainRouteProbeRecord{
+			Result:  result,
+			Message: message,
+			Time:    now,
+		})
+		if len(status.History) > probeHistorySize {
+			status.History = status.History[len(status.History)-probeHistorySize:]
+		}
+	}
+	return status, changed || now.Sub(prev.LastProbeTime.Time) >= probeStatusUpdatePeriod
+}
+
+// recordProbe adds the result of a probe to the statistics of the route and writes them into its status.
+func (c *DomainRouteController) recordProbe(dr *kusciaapisv1alpha1.DomainRoute, result kusciaapisv1alpha1.DomainRouteProbeResult,
+	message string, sample probeSample) {
+	avgLatency, lossPercent := c.drProbes.add(dr.Name, sample)
+	status, needUpdate := buildProbeStatus(dr.Status.Probe, result, message, sample, avgLatency, lossPercent, metav1.Now())
+	if !needUpdate {
+		return
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := c.kusciaClient.KusciaV1alpha1().DomainRoutes(dr.Namespace).Get(context.Background(), dr.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		latest = latest.DeepCopy()
+		latest.Status.Probe = status
+		_, err = c.kusciaClient.KusciaV1alpha1().DomainRoutes(dr.Namespace).UpdateStatus(context.Background(), latest, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		nlog.Warnf("Update probe status of dr(%s) fail, err: %v", dr.Name, err)
+	}
+}
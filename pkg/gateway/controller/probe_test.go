@@ -0,0 +1,97 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/utils"
+)
+
+func TestProbeWindows(t *testing.T) {
+	w := newProbeWindows()
+	avg, loss := w.add("alice-bob", probeSample{latency: 10 * time.Millisecond})
+	assert.Equal(t, 10*time.Millisecond, avg)
+	assert.Equal(t, int32(0), loss)
+
+	w.add("alice-bob", probeSample{latency: 30 * time.Millisecond})
+	w.add("alice-bob", probeSample{lost: true})
+	avg, loss = w.add("alice-bob", probeSample{lost: true})
+	assert.Equal(t, 20*time.Millisecond, avg)
+	assert.Equal(t, int32(50), loss)
+
+	for i := 0; i < probeWindowSize; i++ {
+		avg, loss = w.add("alice-bob", probeSample{lost: true})
+	}
+	assert.Equal(t, time.Duration(0), avg)
+	assert.Equal(t, int32(100), loss)
+
+	w.delete("alice-bob")
+	_, loss = w.add("alice-bob", probeSample{})
+	assert.Equal(t, int32(0), loss)
+}
+
+func TestProbeResultOfError(t *testing.T) {
+	err := fmt.Errorf("send request error, detail -> connection refused")
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteProbeUnreachable, probeResultOfError(err))
+	err = &utils.StatusCodeError{StatusCode: http.StatusServiceUnavailable}
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteProbeUnreachable, probeResultOfError(err))
+	err = &utils.StatusCodeError{StatusCode: http.StatusUnauthorized}
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteProbeUnauthorized, probeResultOfError(err))
+
+	result, _ := probeResultOfState(TokenNotFound)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteProbeUnauthorized, result)
+}
+
+func TestBuildProbeStatus(t *testing.T) {
+	now := metav1.Now()
+	status, needUpdate := buildProbeStatus(nil, kusciaapisv1alpha1.DomainRouteProbeReachable, "",
+		probeSample{latency: 12 * time.Millisecond}, 12*time.Millisecond, 0, now)
+	assert.True(t, needUpdate)
+	assert.Equal(t, int64(12), status.LatencyMillis)
+	assert.Len(t, status.History, 1)
+
+	// the same result isn't updated until the update period
+	next := metav1.NewTime(now.Add(15 * time.Second))
+	_, needUpdate = buildProbeStatus(status, kusciaapisv1alpha1.DomainRouteProbeReachable, "",
+		probeSample{latency: 20 * time.Millisecond}, 16*time.Millisecond, 0, next)
+	assert.False(t, needUpdate)
+
+	next = metav1.NewTime(now.Add(30 * time.Second))
+	status, needUpdate = buildProbeStatus(status, kusciaapisv1alpha1.DomainRouteProbeUnreachable, "timeout",
+		probeSample{lost: true}, 12*time.Millisecond, 50, next)
+	assert.True(t, needUpdate)
+	assert.Equal(t, int64(12), status.LatencyMillis)
+	assert.Equal(t, int32(50), status.LossPercent)
+	assert.Len(t, status.History, 2)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteProbeUnreachable, status.History[1].Result)
+
+	for i := 0; i < probeHistorySize; i++ {
+		result := kusciaapisv1alpha1.DomainRouteProbeReachable
+		if i%2 == 1 {
+			result = kusciaapisv1alpha1.DomainRouteProbeUnauthorized
+		}
+		status, _ = buildProbeStatus(status, result, "", probeSample{}, 0, 0, next)
+	}
+	assert.Len(t, status.History, probeHistorySize)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteProbeUnauthorized, status.History[probeHistorySize-1].Result)
+}
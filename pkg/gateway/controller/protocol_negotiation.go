@@ -0,0 +1,223 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/utils"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	protocolDetectTimeout = 3 * time.Second
+	// protocolDetectTTL is how long the detected protocol of destination is trusted, so that the changes of the
+	// gateway of destination are picked up without dialing it in every sync.
+	protocolDetectTTL = 10 * time.Minute
+	// protocolDetectFailureTTL limits the dials to an unreachable destination.
+	protocolDetectFailureTTL = time.Minute
+)
+
+var protocolStrength = map[kusciaapisv1alpha1.DomainRouteTransportProtocol]int{
+	kusciaapisv1alpha1.DomainRouteTransportNOTLS: 0,
+	kusciaapisv1alpha1.DomainRouteTransportTLS:   1,
+	kusciaapisv1alpha1.DomainRouteTransportMTLS:  2,
+}
+
+type detectedProtocol struct {
+	protocol kusciaapisv1alpha1.DomainRouteTransportProtocol
+	err      error
+}
+
+// detectDestinationProtocol dials the gateway of destination and infers its protocol from the TLS handshake. The
+// gateways answer a ClientHello with a plaintext error if they don't listen on TLS, and request the certificate of
+// the client if they require MTLS. Only the handshake is done, no request is sent.
+func detectDestinationProtocol(addr, serverName string, timeout time.Duration) (kusciaapisv1alpha1.DomainRouteTransportProtocol, error) {
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+
+	certRequested := false
+	tlsConn := tls.Client(conn, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true, // only the protocol is detected, the certificates are verified by envoy
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certRequested = true
+			return &tls.Certificate{}, nil
+		},
+	})
+	err = tlsConn.Handshake()
+	if certRequested {
+		return kusciaapisv1alpha1.DomainRouteTransportMTLS, nil
+	}
+	var recordErr tls.RecordHeaderError
+	if errors.As(err, &recordErr) {
+		return kusciaapisv1alpha1.DomainRouteTransportNOTLS, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return kusciaapisv1alpha1.DomainRouteTransportTLS, nil
+}
+
+// configuredProtocol returns the protocol the route is configured with. The clusters use TLS with the client
+// certificate if the mTLSConfig is set, regardless of the isTLS of the ports.
+func configuredProtocol(dr *kusciaapisv1alpha1.DomainRoute) kusciaapisv1alpha1.DomainRouteTransportProtocol {
+	if sourceSupportsMTLS(dr) {
+		return kusciaapisv1alpha1.DomainRouteTransportMTLS
+	}
+	for _, dp := range dr.Spec.Endpoint.Ports {
+		if dp.IsTLS {
+			return kusciaapisv1alpha1.DomainRouteTransportTLS
+		}
+	}
+	return kusciaapisv1alpha1.DomainRouteTransportNOTLS
+}
+
+func sourceSupportsMTLS(dr *kusciaapisv1alpha1.DomainRoute) bool {
+	return dr.Spec.MTLSConfig != nil && dr.Spec.MTLSConfig.SourceClientCert != ""
+}
+
+// negotiateProtocol picks the strongest protocol supported by both gateways. The gateway of source supports
+// NOTLS and TLS, and MTLS if the route has the client certificate. The gateway of destination listens on exactly
+// one of them, so it's the only candidate. An empty protocol and the reason are returned if it isn't acceptable.
+func negotiateProtocol(dr *kusciaapisv1alpha1.DomainRoute, destination kusciaapisv1alpha1.DomainRouteTransportProtocol) (
+	negotiated kusciaapisv1alpha1.DomainRouteTransportProtocol, message string, warnings []string) {
+	if destination == kusciaapisv1alpha1.DomainRouteTransportMTLS && !sourceSupportsMTLS(dr) {
+		return "", "destination requires MTLS, but the route has no mTLSConfig with the client certificate", nil
+	}
+	minProtocol := kusciaapisv1alpha1.DomainRouteTransportNOTLS
+	if dr.Spec.ProtocolNegotiation != nil && dr.Spec.ProtocolNegotiation.MinProtocol != "" {
+		minProtocol = dr.Spec.ProtocolNegotiation.MinProtocol
+	}
+	if protocolStrength[destination] < protocolStrength[minProtocol] {
+		return "", fmt.Sprintf("destination only supports %s, which is weaker than the minProtocol %s", destination, minProtocol), nil
+	}
+
+	configured := configuredProtocol(dr)
+	switch {
+	case protocolStrength[destination] < protocolStrength[configured]:
+		warnings = append(warnings, fmt.Sprintf("downgraded from the configured %s to %s, destination doesn't support %s",
+			configured, destination, configured))
+	case protocolStrength[destination] > protocolStrength[configured]:
+		warnings = append(warnings, fmt.Sprintf("upgraded from the configured %s to %s, destination requires %s",
+			configured, destination, destination))
+	}
+	return destination, "", warnings
+}
+
+// negotiationAddress returns the address the protocol of destination is detected on, which is the first address
+// of the first port, or the tunnel of it if the route goes through a proxy.
+func negotiationAddress(dr *kusciaapisv1alpha1.DomainRoute, dp kusciaapisv1alpha1.DomainPort, tunnelAddr *net.TCPAddr) string {
+	if tunnelAddr != nil {
+		return tunnelAddr.String()
+	}
+	host := utils.StripIPv6Brackets(dr.Spec.Endpoint.Host)
+	if addresses := sortedEndpointAddresses(dr.Spec.Endpoint.Addresses); len(addresses) > 0 {
+		host = utils.StripIPv6Brackets(addresses[0].Host)
+	}
+	return net.JoinHostPort(host, strconv.Itoa(dp.Port))
+}
+
+// negotiateRouteProtocol detects the protocol of destination and records the negotiation in the status. It
+// returns an empty protocol if destination isn't reachable, then the configured protocol is kept.
+func (c *DomainRouteController) negotiateRouteProtocol(dr *kusciaapisv1alpha1.DomainRoute, addr string) (
+	kusciaapisv1alpha1.DomainRouteTransportProtocol, error) {
+	key := dr.Name + "/" + addr
+	var detected detectedProtocol
+	if val, ok := c.protocolCache.Get(key); ok {
+		detected = val.(detectedProtocol)
+	} else {
+		detected.protocol, detected.err = detectDestinationProtocol(addr, utils.StripIPv6Brackets(dr.Spec.Endpoint.Host), protocolDetectTimeout)
+		ttl := protocolDetectTTL
+		if detected.err != nil {
+			ttl = protocolDetectFailureTTL
+		}
+		c.protocolCache.Set(key, detected, ttl)
+	}
+
+	status := &kusciaapisv1alpha1.DomainRouteProtocolStatus{
+		Configured:  configuredProtocol(dr),
+		Destination: detected.protocol,
+	}
+	if detected.err != nil {
+		status.Message = fmt.Sprintf("failed to detect the protocol of destination, keep the configured one: %v", detected.err)
+	} else {
+		status.Negotiated, status.Message, status.Warnings = negotiateProtocol(dr, detected.protocol)
+		for _, warning := range status.Warnings {
+			nlog.Warnf("Protocol negotiation of dr(%s): %s", dr.Name, warning)
+		}
+	}
+	c.recordProtocolStatus(dr, status)
+
+	if detected.err == nil && status.Negotiated == "" {
+		return "", fmt.Errorf("protocol negotiation failed, %s", status.Message)
+	}
+	return status.Negotiated, nil
+}
+
+// recordProtocolStatus writes the result of the negotiation into the status if it changed.
+func (c *DomainRouteController) recordProtocolStatus(dr *kusciaapisv1alpha1.DomainRoute, status *kusciaapisv1alpha1.DomainRouteProtocolStatus) {
+	if prev := dr.Status.Protocol; prev != nil {
+		status.LastNegotiationTime = prev.LastNegotiationTime
+		if reflect.DeepEqual(prev, status) {
+			return
+		}
+	}
+	status.LastNegotiationTime = metav1.Now()
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := c.kusciaClient.KusciaV1alpha1().DomainRoutes(dr.Namespace).Get(context.Background(), dr.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		latest = latest.DeepCopy()
+		latest.Status.Protocol = status
+		_, err = c.kusciaClient.KusciaV1alpha1().DomainRoutes(dr.Namespace).UpdateStatus(context.Background(), latest, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		nlog.Warnf("Update protocol status of dr(%s) fail, err: %v", dr.Name, err)
+	}
+}
+
+// applyNegotiatedProtocol returns the port and the transport socket of the clusters connecting with the
+// negotiated protocol. The transport socket of the mTLSConfig is kept for TLS, since it verifies the server.
+func applyNegotiatedProtocol(protocol kusciaapisv1alpha1.DomainRouteTransportProtocol, dp kusciaapisv1alpha1.DomainPort,
+	transportSocket *core.TransportSocket) (kusciaapisv1alpha1.DomainPort, *core.TransportSocket) {
+	switch protocol {
+	case kusciaapisv1alpha1.DomainRouteTransportNOTLS:
+		dp.IsTLS = false
+		transportSocket = nil
+	case kusciaapisv1alpha1.DomainRouteTransportTLS, kusciaapisv1alpha1.DomainRouteTransportMTLS:
+		dp.IsTLS = true
+	}
+	return dp, transportSocket
+}
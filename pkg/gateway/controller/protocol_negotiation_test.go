@@ -0,0 +1,120 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/stretchr/testify/assert"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func TestDetectDestinationProtocol(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	plain := httptest.NewServer(handler)
+	defer plain.Close()
+	protocol, err := detectDestinationProtocol(plain.Listener.Addr().String(), "bob", time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteTransportNOTLS, protocol)
+
+	tlsServer := httptest.NewTLSServer(handler)
+	defer tlsServer.Close()
+	protocol, err = detectDestinationProtocol(tlsServer.Listener.Addr().String(), "bob", time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteTransportTLS, protocol)
+
+	mtlsServer := httptest.NewUnstartedServer(handler)
+	mtlsServer.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	mtlsServer.StartTLS()
+	defer mtlsServer.Close()
+	protocol, err = detectDestinationProtocol(mtlsServer.Listener.Addr().String(), "bob", time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteTransportMTLS, protocol)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	addr := lis.Addr().String()
+	lis.Close()
+	_, err = detectDestinationProtocol(addr, "bob", time.Second)
+	assert.Error(t, err)
+}
+
+func TestNegotiateProtocol(t *testing.T) {
+	dr := &kusciaapisv1alpha1.DomainRoute{}
+	dr.Spec.Endpoint.Ports = []kusciaapisv1alpha1.DomainPort{{Name: "http", Port: 1080, IsTLS: true}}
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteTransportTLS, configuredProtocol(dr))
+
+	negotiated, message, warnings := negotiateProtocol(dr, kusciaapisv1alpha1.DomainRouteTransportTLS)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteTransportTLS, negotiated)
+	assert.Empty(t, message)
+	assert.Empty(t, warnings)
+
+	negotiated, _, warnings = negotiateProtocol(dr, kusciaapisv1alpha1.DomainRouteTransportNOTLS)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteTransportNOTLS, negotiated)
+	assert.Equal(t, []string{"downgraded from the configured TLS to NOTLS, destination doesn't support TLS"}, warnings)
+
+	negotiated, message, _ = negotiateProtocol(dr, kusciaapisv1alpha1.DomainRouteTransportMTLS)
+	assert.Empty(t, negotiated)
+	assert.Equal(t, "destination requires MTLS, but the route has no mTLSConfig with the client certificate", message)
+
+	dr.Spec.ProtocolNegotiation = &kusciaapisv1alpha1.DomainRouteProtocolNegotiation{MinProtocol: kusciaapisv1alpha1.DomainRouteTransportTLS}
+	negotiated, message, _ = negotiateProtocol(dr, kusciaapisv1alpha1.DomainRouteTransportNOTLS)
+	assert.Empty(t, negotiated)
+	assert.Equal(t, "destination only supports NOTLS, which is weaker than the minProtocol TLS", message)
+
+	dr.Spec.MTLSConfig = &kusciaapisv1alpha1.DomainRouteMTLSConfig{SourceClientCert: "cert"}
+	negotiated, _, warnings = negotiateProtocol(dr, kusciaapisv1alpha1.DomainRouteTransportMTLS)
+	assert.Equal(t, kusciaapisv1alpha1.DomainRouteTransportMTLS, negotiated)
+	assert.Empty(t, warnings)
+}
+
+func TestApplyNegotiatedProtocol(t *testing.T) {
+	dp := kusciaapisv1alpha1.DomainPort{Name: "http", Port: 1080}
+	socket := &core.TransportSocket{Name: "envoy.transport_sockets.tls"}
+
+	port, transportSocket := applyNegotiatedProtocol("", dp, socket)
+	assert.False(t, port.IsTLS)
+	assert.Equal(t, socket, transportSocket)
+
+	port, transportSocket = applyNegotiatedProtocol(kusciaapisv1alpha1.DomainRouteTransportTLS, dp, socket)
+	assert.True(t, port.IsTLS)
+	assert.Equal(t, socket, transportSocket)
+
+	dp.IsTLS = true
+	port, transportSocket = applyNegotiatedProtocol(kusciaapisv1alpha1.DomainRouteTransportNOTLS, dp, socket)
+	assert.False(t, port.IsTLS)
+	assert.Nil(t, transportSocket)
+}
+
+func TestNegotiationAddress(t *testing.T) {
+	dr := &kusciaapisv1alpha1.DomainRoute{}
+	dr.Spec.Endpoint.Host = "bob.example.com"
+	dp := kusciaapisv1alpha1.DomainPort{Name: "http", Port: 1080}
+	assert.Equal(t, "bob.example.com:1080", negotiationAddress(dr, dp, nil))
+
+	dr.Spec.Endpoint.Addresses = []kusciaapisv1alpha1.DomainEndpointAddress{{Host: "2.2.2.2", Priority: 1}, {Host: "1.1.1.1"}}
+	assert.Equal(t, "1.1.1.1:1080", negotiationAddress(dr, dp, nil))
+
+	tunnel := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 20000}
+	assert.Equal(t, "127.0.0.1:20000", negotiationAddress(dr, dp, tunnel))
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/config"
+	"github.com/secretflow/kuscia/pkg/gateway/utils"
+)
+
+// getProxyURL returns the forward proxy the route connects to destination through, empty means directly.
+func (c *DomainRouteController) getProxyURL(dr *kusciaapisv1alpha1.DomainRoute) string {
+	if dr.Spec.Proxy != nil {
+		return dr.Spec.Proxy.URL
+	}
+	if c.proxy == nil || c.proxy.URL == "" || bypassProxy(c.proxy, dr) {
+		return ""
+	}
+	return c.proxy.URL
+}
+
+func bypassProxy(proxy *config.ProxyConfig, dr *kusciaapisv1alpha1.DomainRoute) bool {
+	host := strings.ToLower(utils.StripIPv6Brackets(dr.Spec.Endpoint.Host))
+	for _, entry := range proxy.NoProxy {
+		entry = strings.ToLower(entry)
+		if entry == dr.Spec.Destination || entry == host {
+			return true
+		}
+		if strings.HasPrefix(entry, ".") && strings.HasSuffix(host, entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateProxyTunnel starts the tunnel of the port through the proxy, and returns its address. The tunnel is
+// stopped if the route isn't proxied any more.
+func (c *DomainRouteController) updateProxyTunnel(dr *kusciaapisv1alpha1.DomainRoute, dp kusciaapisv1alpha1.DomainPort,
+	proxyURL string) (*net.TCPAddr, error) {
+	clusterName := common.GenerateClusterName(dr.Spec.Source, dr.Spec.Destination, dp.Name)
+	if proxyURL == "" {
+		c.tunnels.Close(clusterName)
+		return nil, nil
+	}
+	targets := []string{net.JoinHostPort(utils.StripIPv6Brackets(dr.Spec.Endpoint.Host), strconv.Itoa(dp.Port))}
+	if len(dr.Spec.Endpoint.Addresses) > 0 {
+		targets = targets[:0]
+		for _, address := range sortedEndpointAddresses(dr.Spec.Endpoint.Addresses) {
+			targets = append(targets, net.JoinHostPort(utils.StripIPv6Brackets(address.Host), strconv.Itoa(dp.Port)))
+		}
+	}
+	addr, err := c.tunnels.Ensure(clusterName, proxyURL, targets...)
+	if err != nil {
+		return nil, fmt.Errorf("start proxy tunnel of %s failed with %s", clusterName, err.Error())
+	}
+	return addr, nil
+}
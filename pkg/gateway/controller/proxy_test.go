@@ -0,0 +1,82 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/config"
+	"github.com/secretflow/kuscia/pkg/gateway/egress"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+func newProxyRoute(destination, host string) *kusciaapisv1alpha1.DomainRoute {
+	return &kusciaapisv1alpha1.DomainRoute{
+		Spec: kusciaapisv1alpha1.DomainRouteSpec{
+			Source:      "alice",
+			Destination: destination,
+			Endpoint: kusciaapisv1alpha1.DomainEndpoint{
+				Host: host,
+				Ports: []kusciaapisv1alpha1.DomainPort{
+					{Name: "http", Protocol: kusciaapisv1alpha1.DomainRouteProtocolHTTP, Port: 1080},
+				},
+			},
+		},
+	}
+}
+
+func TestGetProxyURL(t *testing.T) {
+	c := &DomainRouteController{}
+	assert.Equal(t, "", c.getProxyURL(newProxyRoute("bob", "bob.example.com")))
+
+	c.proxy = &config.ProxyConfig{
+		URL:     "http://proxy.corp:3128",
+		NoProxy: []string{"carol", ".partner.com"},
+	}
+	assert.Equal(t, "http://proxy.corp:3128", c.getProxyURL(newProxyRoute("bob", "bob.example.com")))
+	assert.Equal(t, "", c.getProxyURL(newProxyRoute("carol", "carol.example.com")))
+	assert.Equal(t, "", c.getProxyURL(newProxyRoute("dave", "dave.partner.com")))
+
+	// the proxy of the route overrides the global one
+	dr := newProxyRoute("carol", "carol.example.com")
+	dr.Spec.Proxy = &kusciaapisv1alpha1.DomainRouteProxy{URL: "socks5://proxy.corp:1080"}
+	assert.Equal(t, "socks5://proxy.corp:1080", c.getProxyURL(dr))
+}
+
+func TestAddClusterThroughProxyTunnel(t *testing.T) {
+	c := &DomainRouteController{tunnels: egress.NewTunnels()}
+	dr := newProxyRoute("proxied", "bob.example.com")
+	dp := dr.Spec.Endpoint.Ports[0]
+	dr.Spec.Connection = &kusciaapisv1alpha1.DomainRouteConnection{HTTP3: true}
+
+	tunnelAddr, err := c.updateProxyTunnel(dr, dp, "http://proxy.corp:3128")
+	assert.NoError(t, err)
+	defer c.tunnels.Close("alice-to-proxied-http")
+	assert.NoError(t, addClusterForDstGateway(dr, dp, nil, tunnelAddr))
+
+	cluster, err := xds.QueryCluster("alice-to-proxied-http")
+	assert.NoError(t, err)
+	lbEndpoint := cluster.LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint()
+	assert.Equal(t, "127.0.0.1", lbEndpoint.Address.GetSocketAddress().Address)
+	assert.Equal(t, uint32(tunnelAddr.Port), lbEndpoint.Address.GetSocketAddress().GetPortValue())
+	assert.Equal(t, "bob.example.com", lbEndpoint.Hostname)
+
+	tunnelAddr, err = c.updateProxyTunnel(dr, dp, "")
+	assert.NoError(t, err)
+	assert.Nil(t, tunnelAddr)
+}
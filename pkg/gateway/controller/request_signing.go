@@ -0,0 +1,66 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+// updateRouteSigning signs the requests of the virtual host with the token the route uses now.
+func (c *DomainRouteController) updateRouteSigning(dr *kusciaapisv1alpha1.DomainRoute, vhName, token string) error {
+	if dr.Spec.RequestSigning == nil {
+		c.signing.UpdateSigner(vhName, "", "", "")
+		return xds.UpdateRouteSigning(vhName, false)
+	}
+	if err := c.ensureSigningCluster(); err != nil {
+		return err
+	}
+	c.signing.UpdateSigner(vhName, dr.Spec.Source, dr.Spec.Destination, token)
+	return xds.UpdateRouteSigning(vhName, true)
+}
+
+// updateSourceSigning verifies the requests from the source with all the valid tokens of the route.
+func (c *DomainRouteController) updateSourceSigning(dr *kusciaapisv1alpha1.DomainRoute, tokens []string) error {
+	if dr.Spec.RequestSigning == nil {
+		c.signing.UpdateVerifier(dr.Spec.Source, nil, 0)
+		return xds.UpdateSourceSigning(dr.Spec.Source, false)
+	}
+	if err := c.ensureSigningCluster(); err != nil {
+		return err
+	}
+	clockSkew := time.Duration(dr.Spec.RequestSigning.ClockSkewSeconds) * time.Second
+	c.signing.UpdateVerifier(dr.Spec.Source, tokens, clockSkew)
+	return xds.UpdateSourceSigning(dr.Spec.Source, true)
+}
+
+// ensureSigningCluster starts the signing server, whose address doesn't change once it's started.
+func (c *DomainRouteController) ensureSigningCluster() error {
+	if cluster, _ := xds.QueryCluster(xds.RequestSigningClusterName); cluster != nil {
+		return nil
+	}
+	addr, err := c.signing.Start()
+	if err != nil {
+		return fmt.Errorf("start request signing server failed with %s", err.Error())
+	}
+	cluster := newLocalCluster(xds.RequestSigningClusterName, addr)
+	if err := xds.DecorateLocalUpstreamCluster(cluster, xds.ProtocolGRPC); err != nil {
+		return err
+	}
+	return xds.AddOrUpdateCluster(cluster)
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/confmanager"
+)
+
+// updateRouteFilters loads the custom filters of the domain route from ConfManager. The versions of the filters
+// are immutable, so they're reloaded only when the domain route changes to the other versions.
+func (c *DomainRouteController) updateRouteFilters(dr *kusciaapisv1alpha1.DomainRoute, vhName string) error {
+	if len(dr.Spec.Filters) == 0 {
+		return xds.UpdateRouteFilters(vhName, nil)
+	}
+	if c.configService == nil {
+		return fmt.Errorf("domain route %s has filters, but ConfManager isn't available", dr.Name)
+	}
+
+	var filters []*xds.RouteFilterConfig
+	for _, filter := range dr.Spec.Filters {
+		key := cmservice.GatewayFilterConfigKey(filter.Name, filter.Version)
+		resp := c.configService.QueryConfig(context.Background(), &confmanager.QueryConfigRequest{Key: key})
+		if !utils.IsSuccessCode(resp.Status.Code) {
+			return fmt.Errorf("query filter %s of domain route %s failed, %s", key, dr.Name, resp.Status.Message)
+		}
+		if resp.Value == "" {
+			return fmt.Errorf("filter %s of domain route %s doesn't exist", key, dr.Name)
+		}
+		filterType, code, err := cmservice.ParseGatewayFilter(resp.Value)
+		if err != nil {
+			return fmt.Errorf("filter %s of domain route %s is invalid, %v", key, dr.Name, err)
+		}
+		filters = append(filters, &xds.RouteFilterConfig{
+			Name:    filter.Name,
+			Version: filter.Version,
+			Type:    filterType,
+			Code:    code,
+		})
+	}
+	return xds.UpdateRouteFilters(vhName, filters)
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/confmanager"
+)
+
+type fakeConfigService struct {
+	cmservice.IConfigService
+	configs map[string]string
+}
+
+func (s *fakeConfigService) QueryConfig(_ context.Context, request *confmanager.QueryConfigRequest) *confmanager.QueryConfigResponse {
+	return &confmanager.QueryConfigResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Key:    request.Key,
+		Value:  s.configs[request.Key],
+	}
+}
+
+func TestUpdateRouteFilters(t *testing.T) {
+	dr := newProxyRoute("filtered", "bob.example.com")
+	dr.Spec.Filters = []kusciaapisv1alpha1.DomainRouteFilter{{Name: "stamp", Version: "v1"}}
+	vhName := "alice-to-filtered"
+	filterName := xds.RouteCustomFilterName + "/" + vhName + "/00-stamp"
+
+	c := &DomainRouteController{}
+	assert.Error(t, c.updateRouteFilters(dr, vhName))
+
+	configs := map[string]string{}
+	c.configService = &fakeConfigService{configs: configs}
+	assert.Error(t, c.updateRouteFilters(dr, vhName))
+
+	value, _ := json.Marshal(&cmservice.GatewayFilter{
+		Type: cmservice.GatewayFilterTypeLua,
+		Code: `function envoy_on_request(handle) handle:headers():add("x-stamp", "alice") end`,
+	})
+	configs[cmservice.GatewayFilterConfigKey("stamp", "v1")] = string(value)
+	assert.NoError(t, c.updateRouteFilters(dr, vhName))
+	_, err := xds.GetHTTPFilterConfig(filterName, xds.InternalListener)
+	assert.NoError(t, err)
+
+	// removing the filters from the domain route removes them from the listener
+	dr.Spec.Filters = nil
+	assert.NoError(t, c.updateRouteFilters(dr, vhName))
+	_, err = xds.GetHTTPFilterConfig(filterName, xds.InternalListener)
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/secretflow/kuscia/pkg/gateway/config"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+const controlPlaneRouteSuffix = "-control-plane"
+
+// addControlPlaneRoutes adds a high priority copy of each http route of the virtual host, which only matches the
+// requests to the control-plane services. Envoy keeps separate upstream connections for each priority, so the
+// control-plane requests aren't blocked by the bulk streams multiplexed on the default connections. If the route
+// has a bandwidth limit, the control-plane requests use the reserved bandwidth rather than the limit of the route.
+func addControlPlaneRoutes(vh *route.VirtualHost, tc *config.TrafficClassConfig, limitKbps int64) {
+	if tc == nil || len(tc.ControlPlaneServices) == 0 {
+		return
+	}
+	authority := &route.HeaderMatcher{
+		Name: ":authority",
+		HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+			StringMatch: &matcher.StringMatcher{
+				MatchPattern: &matcher.StringMatcher_SafeRegex{
+					SafeRegex: &matcher.RegexMatcher{
+						Regex: controlPlaneAuthorityRegex(tc.ControlPlaneServices),
+					},
+				},
+			},
+		},
+	}
+
+	var controlRoutes []*route.Route
+	for _, r := range vh.Routes {
+		if r.GetRoute() == nil || r.GetMatch().GetConnectMatcher() != nil {
+			continue
+		}
+		cr := proto.Clone(r).(*route.Route)
+		cr.Name = r.Name + controlPlaneRouteSuffix
+		cr.Match.Headers = append(cr.Match.Headers, authority)
+		cr.GetRoute().Priority = core.RoutingPriority_HIGH
+		if limitKbps > 0 {
+			if cr.TypedPerFilterConfig == nil {
+				cr.TypedPerFilterConfig = map[string]*anypb.Any{}
+			}
+			cr.TypedPerFilterConfig[xds.BandwidthLimitName] = xds.NewBandwidthLimitConfig(tc.ReservedKbps)
+		}
+		controlRoutes = append(controlRoutes, cr)
+	}
+	vh.Routes = append(controlRoutes, vh.Routes...)
+}
+
+// controlPlaneAuthorityRegex matches the hosts like kusciaapi.bob.svc and kusciaapi.bob.svc:8082.
+func controlPlaneAuthorityRegex(services []string) string {
+	quoted := make([]string, 0, len(services))
+	for _, svc := range services {
+		quoted = append(quoted, regexp.QuoteMeta(svc))
+	}
+	return fmt.Sprintf(`(%s)\..*`, strings.Join(quoted, "|"))
+}
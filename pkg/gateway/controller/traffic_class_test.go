@@ -0,0 +1,86 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"regexp"
+	"testing"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	bandwidth_limitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/bandwidth_limit/v3"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/gateway/config"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+func TestAddControlPlaneRoutes(t *testing.T) {
+	dr := &kusciaapisv1alpha1.DomainRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "alice-bob",
+			Namespace: "alice",
+		},
+		Spec: kusciaapisv1alpha1.DomainRouteSpec{
+			Source:            "alice",
+			Destination:       "bob",
+			InterConnProtocol: kusciaapisv1alpha1.InterConnKuscia,
+			Endpoint: kusciaapisv1alpha1.DomainEndpoint{
+				Host: EnvoyServerIP,
+				Ports: []kusciaapisv1alpha1.DomainPort{
+					{
+						Name:     "http",
+						Protocol: kusciaapisv1alpha1.DomainRouteProtocolHTTP,
+						Port:     ExternalServerPort,
+					},
+				},
+			},
+		},
+	}
+
+	vh := generateInternalVirtualHost(dr, fakeRevisionToken, false)
+	addControlPlaneRoutes(vh, nil, 0)
+	assert.Len(t, vh.Routes, 2)
+
+	vh = generateInternalVirtualHost(dr, fakeRevisionToken, false)
+	addControlPlaneRoutes(vh, config.DefaultTrafficClassConfig(), 0)
+	// the connect route isn't copied
+	assert.Len(t, vh.Routes, 3)
+	cr := vh.Routes[0]
+	assert.Equal(t, xds.DefaultRouteName+controlPlaneRouteSuffix, cr.Name)
+	assert.Equal(t, core.RoutingPriority_HIGH, cr.GetRoute().Priority)
+	assert.Equal(t, core.RoutingPriority_DEFAULT, vh.Routes[1].GetRoute().Priority)
+	_, ok := cr.TypedPerFilterConfig[xds.BandwidthLimitName]
+	assert.False(t, ok)
+
+	regex := regexp.MustCompile("^" + cr.Match.Headers[0].GetStringMatch().GetSafeRegex().Regex + "$")
+	assert.True(t, regex.MatchString("kusciaapi.bob.svc"))
+	assert.True(t, regex.MatchString("kuscia-handshake.bob.svc:1054"))
+	assert.False(t, regex.MatchString("datamesh.bob.svc"))
+	assert.False(t, regex.MatchString("secretflow-task-psi-0-fed.bob.svc"))
+
+	vh = generateInternalVirtualHost(dr, fakeRevisionToken, false)
+	addControlPlaneRoutes(vh, config.DefaultTrafficClassConfig(), 10240)
+	limit := &bandwidth_limitv3.BandwidthLimit{}
+	assert.NoError(t, vh.Routes[0].TypedPerFilterConfig[xds.BandwidthLimitName].UnmarshalTo(limit))
+	assert.Equal(t, uint64(1024), limit.LimitKbps.GetValue())
+
+	vh = generateInternalVirtualHost(dr, fakeRevisionToken, false)
+	addControlPlaneRoutes(vh, &config.TrafficClassConfig{ControlPlaneServices: []string{"kusciaapi"}}, 10240)
+	limit = &bandwidth_limitv3.BandwidthLimit{}
+	assert.NoError(t, vh.Routes[0].TypedPerFilterConfig[xds.BandwidthLimitName].UnmarshalTo(limit))
+	assert.Equal(t, bandwidth_limitv3.BandwidthLimit_DISABLED, limit.EnableMode)
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const maxTrafficWeight = 100
+
+// trafficSplit is a service which takes part of the traffic of another service, e.g. the canary revision of a serving.
+type trafficSplit struct {
+	service string
+	weight  uint32
+}
+
+// trafficSplitTarget returns the key of the service whose traffic is split to the service, empty if not split.
+func trafficSplitTarget(service *v1.Service) string {
+	target := service.Annotations[common.TrafficSplitOfAnnotationKey]
+	if target == "" || target == service.Name {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", service.Namespace, target)
+}
+
+// trafficSplits returns the services which split the traffic of the service. A service joins only after its cluster
+// is added, otherwise envoy would reply no healthy upstream for the traffic routed to it.
+func (ec *EndpointsController) trafficSplits(namespace, name string) []trafficSplit {
+	if ec.serviceLister == nil {
+		return nil
+	}
+	services, err := ec.serviceLister.Services(namespace).List(labels.Everything())
+	if err != nil {
+		nlog.Warnf("Failed to list services of namespace %s, %v", namespace, err)
+		return nil
+	}
+
+	var splits []trafficSplit
+	for _, svc := range services {
+		if svc.Name == name || svc.Annotations[common.TrafficSplitOfAnnotationKey] != name {
+			continue
+		}
+		weight, err := strconv.ParseUint(svc.Annotations[common.TrafficWeightAnnotationKey], 10, 32)
+		if err != nil || weight == 0 {
+			continue
+		}
+		if _, err = xds.QueryCluster(fmt.Sprintf("service-%s", svc.Name)); err != nil {
+			continue
+		}
+		splits = append(splits, trafficSplit{service: svc.Name, weight: uint32(weight)})
+	}
+	sort.Slice(splits, func(i, j int) bool {
+		return splits[i].service < splits[j].service
+	})
+	return splits
+}
+
+// applyTrafficSplits routes the traffic of the virtual host of the service to the weighted clusters, the service
+// itself takes the rest of the weights.
+func applyTrafficSplits(vh *route.VirtualHost, name string, splits []trafficSplit) {
+	if len(splits) == 0 {
+		return
+	}
+
+	var clusters []*route.WeightedCluster_ClusterWeight
+	remaining := uint32(maxTrafficWeight)
+	for _, split := range splits {
+		weight := split.weight
+		if weight > remaining {
+			weight = remaining
+		}
+		if weight == 0 {
+			continue
+		}
+		remaining -= weight
+		clusters = append(clusters, &route.WeightedCluster_ClusterWeight{
+			Name:   fmt.Sprintf("service-%s", split.service),
+			Weight: wrapperspb.UInt32(weight),
+		})
+	}
+	if remaining > 0 {
+		clusters = append([]*route.WeightedCluster_ClusterWeight{{
+			Name:   fmt.Sprintf("service-%s", name),
+			Weight: wrapperspb.UInt32(remaining),
+		}}, clusters...)
+	}
+
+	for _, r := range vh.Routes {
+		if action := r.GetRoute(); action != nil {
+			action.ClusterSpecifier = &route.RouteAction_WeightedClusters{
+				WeightedClusters: &route.WeightedCluster{Clusters: clusters},
+			}
+		}
+	}
+}
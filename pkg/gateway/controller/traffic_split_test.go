@@ -0,0 +1,56 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+)
+
+func TestTrafficSplitTarget(t *testing.T) {
+	svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "serving-canary-http", Namespace: "alice"}}
+	assert.Equal(t, "", trafficSplitTarget(svc))
+
+	svc.Annotations = map[string]string{common.TrafficSplitOfAnnotationKey: "serving-http"}
+	assert.Equal(t, "alice/serving-http", trafficSplitTarget(svc))
+}
+
+func TestApplyTrafficSplits(t *testing.T) {
+	ec := &EndpointsController{}
+	vh, err := ec.generateVirtualHost("alice", "serving-http", "", false)
+	assert.NoError(t, err)
+
+	applyTrafficSplits(vh, "serving-http", nil)
+	assert.Equal(t, "service-serving-http", vh.Routes[0].GetRoute().GetCluster())
+
+	applyTrafficSplits(vh, "serving-http", []trafficSplit{{service: "serving-canary-http", weight: 20}})
+	clusters := vh.Routes[0].GetRoute().GetWeightedClusters().GetClusters()
+	assert.Len(t, clusters, 2)
+	assert.Equal(t, "service-serving-http", clusters[0].Name)
+	assert.Equal(t, uint32(80), clusters[0].Weight.GetValue())
+	assert.Equal(t, "service-serving-canary-http", clusters[1].Name)
+	assert.Equal(t, uint32(20), clusters[1].Weight.GetValue())
+
+	// all traffic is switched to the new revision in blue/green release
+	applyTrafficSplits(vh, "serving-http", []trafficSplit{{service: "serving-canary-http", weight: 100}})
+	clusters = vh.Routes[0].GetRoute().GetWeightedClusters().GetClusters()
+	assert.Len(t, clusters, 1)
+	assert.Equal(t, "service-serving-canary-http", clusters[0].Name)
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/proxy"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+// Tunnels forwards the upstream connections of envoy to the partners through the forward proxies. Envoy can't
+// connect through the SOCKS5 proxies nor authenticate to the http ones, so each proxied cluster connects to a
+// local tunnel instead, which dials the partner through the proxy and copies the bytes as they are. The TLS to
+// the partner is still established by envoy.
+type Tunnels struct {
+	mu      sync.Mutex
+	tunnels map[string]*tunnel
+}
+
+func NewTunnels() *Tunnels {
+	return &Tunnels{tunnels: map[string]*tunnel{}}
+}
+
+// Ensure starts the tunnel named name to the target addresses host:port through the proxy, and returns the local
+// address envoy connects to. The targets are dialed in order until one of them connects, so the backup addresses
+// follow the primary one. The tunnel is restarted if the proxy or the targets change.
+func (t *Tunnels) Ensure(name, proxyURL string, targets ...string) (*net.TCPAddr, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if tun, ok := t.tunnels[name]; ok {
+		if tun.proxyURL == proxyURL && slices.Equal(tun.targets, targets) {
+			return tun.addr(), nil
+		}
+		tun.close()
+		delete(t.tunnels, name)
+	}
+
+	u, err := ParseProxyURL(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	dialer, err := NewDialer(u)
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	tun := &tunnel{
+		name:     name,
+		proxyURL: proxyURL,
+		targets:  targets,
+		dialer:   dialer,
+		listener: listener,
+		conns:    map[net.Conn]struct{}{},
+	}
+	tun.ctx, tun.cancel = context.WithCancel(context.Background())
+	go tun.serve()
+	t.tunnels[name] = tun
+	nlog.Infof("Start tunnel %s to %s through proxy %s on %s", name, strings.Join(targets, ","), u.Redacted(), listener.Addr())
+	return tun.addr(), nil
+}
+
+// Close stops the tunnel and closes its connections, it does nothing if the tunnel doesn't exist.
+func (t *Tunnels) Close(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if tun, ok := t.tunnels[name]; ok {
+		tun.close()
+		delete(t.tunnels, name)
+		nlog.Infof("Stop tunnel %s", name)
+	}
+}
+
+type tunnel struct {
+	name     string
+	proxyURL string
+	targets  []string
+	dialer   proxy.ContextDialer
+	listener net.Listener
+	ctx      context.Context
+	cancel   context.CancelFunc
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+func (t *tunnel) addr() *net.TCPAddr {
+	return t.listener.Addr().(*net.TCPAddr)
+}
+
+func (t *tunnel) serve() {
+	for {
+		conn, err := t.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				nlog.Warnf("Tunnel %s stopped accepting, %v", t.name, err)
+			}
+			return
+		}
+		go t.forward(conn)
+	}
+}
+
+func (t *tunnel) forward(downstream net.Conn) {
+	upstream, err := t.dial()
+	if err != nil {
+		downstream.Close()
+		return
+	}
+	if !t.track(downstream, upstream) {
+		downstream.Close()
+		upstream.Close()
+		return
+	}
+	defer t.untrack(downstream, upstream)
+
+	done := make(chan struct{}, 2)
+	copyConn := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go copyConn(upstream, downstream)
+	go copyConn(downstream, upstream)
+	// the http connections don't half close, so either side closing ends the tunnel connection
+	<-done
+	downstream.Close()
+	upstream.Close()
+	<-done
+}
+
+func (t *tunnel) dial() (net.Conn, error) {
+	var lastErr error
+	for _, target := range t.targets {
+		conn, err := t.dialer.DialContext(t.ctx, "tcp", target)
+		if err == nil {
+			return conn, nil
+		}
+		nlog.Warnf("Tunnel %s dial %s failed, %v", t.name, target, err)
+		lastErr = err
+	}
+	if lastErr == nil {
+		lastErr = errors.New("no target to dial")
+	}
+	return nil, lastErr
+}
+
+func (t *tunnel) track(conns ...net.Conn) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.ctx.Err() != nil {
+		return false
+	}
+	for _, conn := range conns {
+		t.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (t *tunnel) untrack(conns ...net.Conn) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, conn := range conns {
+		delete(t.conns, conn)
+	}
+}
+
+func (t *tunnel) close() {
+	t.cancel()
+	t.listener.Close()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for conn := range t.conns {
+		conn.Close()
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTunnels(t *testing.T) {
+	echoServer := startEchoServer(t)
+	defer echoServer.Close()
+	proxyServer := startConnectProxy(t, "")
+	defer proxyServer.Close()
+
+	tunnels := NewTunnels()
+	proxyURL := "http://" + proxyServer.Addr().String()
+	addr, err := tunnels.Ensure("alice-to-bob-http", proxyURL, echoServer.Addr().String())
+	assert.NoError(t, err)
+	conn, err := net.Dial("tcp", addr.String())
+	assert.NoError(t, err)
+	echo(t, conn)
+
+	// the same config reuses the tunnel
+	sameAddr, err := tunnels.Ensure("alice-to-bob-http", proxyURL, echoServer.Addr().String())
+	assert.NoError(t, err)
+	assert.Equal(t, addr, sameAddr)
+
+	_, err = tunnels.Ensure("alice-to-carol-http", "ftp://proxy.example.com:21", echoServer.Addr().String())
+	assert.Error(t, err)
+
+	// closing the tunnel closes its connections
+	tunnels.Close("alice-to-bob-http")
+	_, err = conn.Read(make([]byte, 1))
+	assert.Error(t, err)
+	_, err = net.Dial("tcp", addr.String())
+	assert.Error(t, err)
+}
+
+func TestTunnelsFailover(t *testing.T) {
+	echoServer := startEchoServer(t)
+	defer echoServer.Close()
+	proxyServer := startConnectProxy(t, "")
+	defer proxyServer.Close()
+	down, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	downAddr := down.Addr().String()
+	down.Close()
+
+	tunnels := NewTunnels()
+	defer tunnels.Close("alice-to-bob-http")
+	addr, err := tunnels.Ensure("alice-to-bob-http", "http://"+proxyServer.Addr().String(), downAddr, echoServer.Addr().String())
+	assert.NoError(t, err)
+	conn, err := net.Dial("tcp", addr.String())
+	assert.NoError(t, err)
+	defer conn.Close()
+	echo(t, conn)
+}
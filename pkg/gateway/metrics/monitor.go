@@ -49,6 +49,10 @@ type ClusterMetricsCollector struct {
 	domainRouteLister kuscialistersv1alpha1.DomainRouteLister
 	gc                *controller.GatewayController
 	metricsEndpoint   string
+
+	// the byte counters of the last collection, used to calculate the throughput of domain routes
+	lastMetrics     map[string]int
+	lastCollectTime time.Time
 }
 
 func NewClusterMetricsCollector(sl corelister.ServiceLister, el corelister.EndpointsLister,
@@ -73,6 +77,9 @@ func (c *ClusterMetricsCollector) collect() {
 		nlog.Warnf("get metrics from envoy failed with %v", err)
 		return
 	}
+	now := time.Now()
+	lastMetrics, elapsed := c.lastMetrics, now.Sub(c.lastCollectTime)
+	c.lastMetrics, c.lastCollectTime = metrics, now
 
 	var networkStatus []*kusciaapisv1alpha1.GatewayEndpointStatus
 	if services, err := c.serviceLister.List(labels.Everything()); err == nil {
@@ -134,11 +141,15 @@ func (c *ClusterMetricsCollector) collect() {
 				if !ok {
 					continue
 				}
+				txKey := fmt.Sprintf("cluster.%s.upstream_cx_tx_bytes_total", clusterName)
+				rxKey := fmt.Sprintf("cluster.%s.upstream_cx_rx_bytes_total", clusterName)
 				networkStatus = append(networkStatus, &kusciaapisv1alpha1.GatewayEndpointStatus{
 					Name:                  clusterName,
 					Type:                  "DomainRoute",
 					TotalEndpointsCount:   total,
 					HealthyEndpointsCount: healthy,
+					TransmitKbps:          throughputKbps(lastMetrics, metrics, txKey, elapsed),
+					ReceiveKbps:           throughputKbps(lastMetrics, metrics, rxKey, elapsed),
 				})
 			}
 		}
@@ -149,8 +160,22 @@ func (c *ClusterMetricsCollector) collect() {
 	}
 }
 
+// throughputKbps calculates the throughput in KiB/s from the byte counters of two collections. The counters
+// are reset when envoy restarts or the cluster is recreated, the throughput is unknown then and 0 is returned.
+func throughputKbps(last, current map[string]int, key string, elapsed time.Duration) int64 {
+	lastBytes, ok := last[key]
+	if !ok || elapsed <= 0 {
+		return 0
+	}
+	bytes := current[key] - lastBytes
+	if bytes <= 0 {
+		return 0
+	}
+	return int64(float64(bytes) / 1024 / elapsed.Seconds())
+}
+
 func (c *ClusterMetricsCollector) getMetrics() (map[string]int, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s?filter=membership_total|membership_healthy&format=json",
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s?filter=membership_total|membership_healthy|upstream_cx_tx_bytes_total|upstream_cx_rx_bytes_total&format=json",
 		c.metricsEndpoint),
 		nil)
 	if err != nil {
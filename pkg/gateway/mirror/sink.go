@@ -0,0 +1,204 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mirror receives the requests mirrored by envoy and posts them to the debug collectors.
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	RouteHeader         = "Kuscia-Mirror-Route"
+	MethodHeader        = "Kuscia-Mirror-Method"
+	URIHeader           = "Kuscia-Mirror-Uri"
+	HostHeader          = "Kuscia-Mirror-Host"
+	BodyTruncatedHeader = "Kuscia-Mirror-Body-Truncated"
+
+	DefaultMaxBodyBytes = 64 * 1024
+
+	redacted = "REDACTED"
+	// envoy appends the suffix to the host of the mirrored requests
+	shadowSuffix = "-shadow"
+	// maxPending bounds the requests being posted to the collector, the others are dropped
+	maxPending  = 64
+	postTimeout = 10 * time.Second
+)
+
+// the credentials must not leak to the collectors
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Kuscia-Token"}
+
+// Config defines how the requests of a route are mirrored.
+type Config struct {
+	// CollectorURL receives the mirrored requests by POST.
+	CollectorURL string
+	// Full mirrors the bodies as well as the headers.
+	Full bool
+	// MaxBodyBytes truncates the mirrored bodies, DefaultMaxBodyBytes is used if it's not positive.
+	MaxBodyBytes int64
+}
+
+// Sinks are the local http servers receiving the requests mirrored by envoy, one for each route. Envoy mirrors
+// the requests as they are, so the sinks strip the bodies or truncate them before posting to the collectors.
+type Sinks struct {
+	mu     sync.Mutex
+	sinks  map[string]*sink
+	client *http.Client
+}
+
+func NewSinks() *Sinks {
+	return &Sinks{
+		sinks:  map[string]*sink{},
+		client: &http.Client{Timeout: postTimeout},
+	}
+}
+
+// Ensure starts the sink of the route named name, and returns the local address envoy mirrors the requests to.
+// The sink is restarted if the config changes.
+func (s *Sinks) Ensure(name string, config Config) (*net.TCPAddr, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if config.MaxBodyBytes <= 0 {
+		config.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+	if sk, ok := s.sinks[name]; ok {
+		if sk.config == config {
+			return sk.addr(), nil
+		}
+		sk.close()
+		delete(s.sinks, name)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	sk := &sink{
+		name:     name,
+		config:   config,
+		client:   s.client,
+		listener: listener,
+		pending:  make(chan struct{}, maxPending),
+	}
+	sk.server = &http.Server{Handler: sk, ReadHeaderTimeout: postTimeout}
+	go func() {
+		if err := sk.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			nlog.Warnf("Mirror sink %s stopped serving, %v", name, err)
+		}
+	}()
+	s.sinks[name] = sk
+	nlog.Infof("Start mirror sink %s on %s", name, listener.Addr())
+	return sk.addr(), nil
+}
+
+// Close stops the sink, it does nothing if the sink doesn't exist.
+func (s *Sinks) Close(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sk, ok := s.sinks[name]; ok {
+		sk.close()
+		delete(s.sinks, name)
+		nlog.Infof("Stop mirror sink %s", name)
+	}
+}
+
+type sink struct {
+	name     string
+	config   Config
+	client   *http.Client
+	listener net.Listener
+	server   *http.Server
+	pending  chan struct{}
+}
+
+func (s *sink) addr() *net.TCPAddr {
+	return s.listener.Addr().(*net.TCPAddr)
+}
+
+func (s *sink) close() {
+	_ = s.server.Close()
+}
+
+// ServeHTTP responds to envoy at once, envoy ignores the responses of the mirrored requests anyway.
+func (s *sink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body []byte
+	truncated := false
+	if s.config.Full {
+		body, _ = io.ReadAll(io.LimitReader(r.Body, s.config.MaxBodyBytes+1))
+		if int64(len(body)) > s.config.MaxBodyBytes {
+			body, truncated = body[:s.config.MaxBodyBytes], true
+		}
+	}
+	_, _ = io.Copy(io.Discard, r.Body)
+	w.WriteHeader(http.StatusOK)
+
+	req, err := s.newCollectorRequest(r, body, truncated)
+	if err != nil {
+		nlog.Warnf("Mirror sink %s build request failed, %v", s.name, err)
+		return
+	}
+	select {
+	case s.pending <- struct{}{}:
+		go s.post(req)
+	default:
+		nlog.Debugf("Mirror sink %s drops request %s, too many pending requests", s.name, r.RequestURI)
+	}
+}
+
+func (s *sink) newCollectorRequest(r *http.Request, body []byte, truncated bool) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.config.CollectorURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header = r.Header.Clone()
+	for _, h := range redactedHeaders {
+		if req.Header.Get(h) != "" {
+			req.Header.Set(h, redacted)
+		}
+	}
+	req.Header.Del("Content-Length")
+	req.Header.Set(RouteHeader, s.name)
+	req.Header.Set(MethodHeader, r.Method)
+	req.Header.Set(URIHeader, r.RequestURI)
+	req.Header.Set(HostHeader, strings.Replace(r.Host, shadowSuffix, "", 1))
+	if truncated {
+		req.Header.Set(BodyTruncatedHeader, strconv.FormatBool(truncated))
+	}
+	return req, nil
+}
+
+func (s *sink) post(req *http.Request) {
+	defer func() { <-s.pending }()
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		nlog.Debugf("Mirror sink %s post to collector failed, %v", s.name, err)
+		return
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
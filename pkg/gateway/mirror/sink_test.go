@@ -0,0 +1,107 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type collected struct {
+	header http.Header
+	body   string
+}
+
+func startCollector(t *testing.T) (*httptest.Server, chan collected) {
+	ch := make(chan collected, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		ch <- collected{header: r.Header, body: string(body)}
+	}))
+	return server, ch
+}
+
+func mirrorRequest(t *testing.T, url string) {
+	req, err := http.NewRequest(http.MethodPost, url+"/api/v1/job?id=1", strings.NewReader("hello world"))
+	assert.NoError(t, err)
+	req.Host = "kusciaapi.bob.svc-shadow"
+	req.Header.Set("Kuscia-Token", "secret")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func receive(t *testing.T, ch chan collected) collected {
+	select {
+	case c := <-ch:
+		return c
+	case <-time.After(5 * time.Second):
+		t.Fatal("collector received nothing")
+	}
+	return collected{}
+}
+
+func TestSinkFull(t *testing.T) {
+	collector, ch := startCollector(t)
+	defer collector.Close()
+
+	sinks := NewSinks()
+	defer sinks.Close("alice-to-bob")
+	addr, err := sinks.Ensure("alice-to-bob", Config{CollectorURL: collector.URL, Full: true, MaxBodyBytes: 5})
+	assert.NoError(t, err)
+	mirrorRequest(t, "http://"+addr.String())
+
+	c := receive(t, ch)
+	assert.Equal(t, "hello", c.body)
+	assert.Equal(t, "true", c.header.Get(BodyTruncatedHeader))
+	assert.Equal(t, "alice-to-bob", c.header.Get(RouteHeader))
+	assert.Equal(t, http.MethodPost, c.header.Get(MethodHeader))
+	assert.Equal(t, "/api/v1/job?id=1", c.header.Get(URIHeader))
+	assert.Equal(t, "kusciaapi.bob.svc", c.header.Get(HostHeader))
+	assert.Equal(t, "application/json", c.header.Get("Content-Type"))
+	assert.Equal(t, redacted, c.header.Get("Kuscia-Token"))
+}
+
+func TestSinkHeadersOnly(t *testing.T) {
+	collector, ch := startCollector(t)
+	defer collector.Close()
+
+	sinks := NewSinks()
+	addr, err := sinks.Ensure("alice-to-bob", Config{CollectorURL: collector.URL})
+	assert.NoError(t, err)
+
+	// the same config reuses the sink
+	sameAddr, err := sinks.Ensure("alice-to-bob", Config{CollectorURL: collector.URL, MaxBodyBytes: DefaultMaxBodyBytes})
+	assert.NoError(t, err)
+	assert.Equal(t, addr, sameAddr)
+
+	mirrorRequest(t, "http://"+addr.String())
+	c := receive(t, ch)
+	assert.Equal(t, "", c.body)
+	assert.Equal(t, "", c.header.Get(BodyTruncatedHeader))
+
+	sinks.Close("alice-to-bob")
+	_, err = http.Get("http://" + addr.String())
+	assert.Error(t, err)
+}
@@ -0,0 +1,279 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	auth "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	DefaultClockSkew = 300 * time.Second
+
+	handshakeHostPrefix = "kuscia-handshake."
+
+	reasonMissing   = "missing"
+	reasonExpired   = "expired"
+	reasonSignature = "invalid_signature"
+	reasonReplayed  = "replayed"
+)
+
+var rejectedRequests = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "kuscia_gateway_rejected_requests_total",
+		Help: "Counts number of cross-domain requests rejected as replayed or altered",
+	},
+	[]string{"source", "reason"},
+)
+
+type signer struct {
+	source      string
+	destination string
+	token       string
+}
+
+type verifier struct {
+	tokens    []string
+	clockSkew time.Duration
+}
+
+// Server is the external authorization service of envoy, it signs the requests to the destinations in the
+// gateway of source, and verifies the requests from the sources in the gateway of destination.
+type Server struct {
+	namespace string
+
+	mu        sync.RWMutex
+	signers   map[string]*signer
+	verifiers map[string]*verifier
+	nonces    *gocache.Cache
+	now       func() time.Time
+
+	listener   net.Listener
+	grpcServer *grpc.Server
+}
+
+func NewServer(namespace string) *Server {
+	return &Server{
+		namespace: namespace,
+		signers:   map[string]*signer{},
+		verifiers: map[string]*verifier{},
+		nonces:    gocache.New(DefaultClockSkew*2, time.Minute),
+		now:       time.Now,
+	}
+}
+
+// Start starts the server if it's not started, and returns the local address envoy checks the requests with.
+func (s *Server) Start() (*net.TCPAddr, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener != nil {
+		return s.listener.Addr().(*net.TCPAddr), nil
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s.listener = listener
+	s.grpcServer = grpc.NewServer()
+	auth.RegisterAuthorizationServer(s.grpcServer, s)
+	go func() {
+		if err := s.grpcServer.Serve(listener); err != nil {
+			nlog.Warnf("Request signing server stopped serving, %v", err)
+		}
+	}()
+	nlog.Infof("Start request signing server on %s", listener.Addr())
+	return listener.Addr().(*net.TCPAddr), nil
+}
+
+// UpdateSigner sets the token the requests of the virtual host are signed with, empty token removes it.
+func (s *Server) UpdateSigner(vhName, source, destination, token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if token == "" {
+		delete(s.signers, vhName)
+		return
+	}
+	s.signers[vhName] = &signer{source: source, destination: destination, token: token}
+}
+
+// UpdateVerifier sets the valid tokens of the source, empty tokens remove it, and the requests of the source
+// aren't verified any more.
+func (s *Server) UpdateVerifier(source string, tokens []string, clockSkew time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(tokens) == 0 {
+		delete(s.verifiers, source)
+		return
+	}
+	if clockSkew <= 0 {
+		clockSkew = DefaultClockSkew
+	}
+	s.verifiers[source] = &verifier{tokens: tokens, clockSkew: clockSkew}
+}
+
+// Check implements the envoy external authorization service.
+func (s *Server) Check(_ context.Context, req *auth.CheckRequest) (*auth.CheckResponse, error) {
+	httpReq := req.GetAttributes().GetRequest().GetHttp()
+	// the requests from the virtual hosts of the signed domain routes carry the names of the virtual hosts
+	if vhName := req.GetAttributes().GetContextExtensions()[xds.RequestSigningContextKey]; vhName != "" {
+		return s.sign(vhName, httpReq), nil
+	}
+	return s.verify(httpReq), nil
+}
+
+func (s *Server) sign(vhName string, httpReq *auth.AttributeContext_HttpRequest) *auth.CheckResponse {
+	// the handshake requests are authenticated by the keys of the domains, and they negotiate the tokens
+	if strings.HasPrefix(httpReq.GetHost(), handshakeHostPrefix) {
+		return okResponse(nil, nil)
+	}
+	s.mu.RLock()
+	sg := s.signers[vhName]
+	s.mu.RUnlock()
+	if sg == nil {
+		nlog.Warnf("Request signing of virtual host %s isn't ready", vhName)
+		return deniedResponse(codes.Unavailable, typev3.StatusCode_ServiceUnavailable, "request signing isn't ready")
+	}
+
+	r := &Request{
+		Source:      sg.source,
+		Destination: sg.destination,
+		Method:      httpReq.GetMethod(),
+		Host:        httpReq.GetHost(),
+		Path:        httpReq.GetPath(),
+		Timestamp:   strconv.FormatInt(s.now().Unix(), 10),
+		Nonce:       newNonce(),
+		BodyDigest:  requestBodyDigest(httpReq),
+	}
+	return okResponse(map[string]string{
+		HeaderTimestamp: r.Timestamp,
+		HeaderNonce:     r.Nonce,
+		HeaderSignature: Sign(sg.token, r),
+	}, nil)
+}
+
+func (s *Server) verify(httpReq *auth.AttributeContext_HttpRequest) *auth.CheckResponse {
+	headers := httpReq.GetHeaders()
+	source := headers["kuscia-source"]
+	host := headers["kuscia-host"]
+	if host == "" {
+		host = httpReq.GetHost()
+	}
+	s.mu.RLock()
+	vf := s.verifiers[source]
+	s.mu.RUnlock()
+	if vf == nil || strings.HasPrefix(host, handshakeHostPrefix) {
+		return okResponse(nil, nil)
+	}
+
+	r := &Request{
+		Source:      source,
+		Destination: s.namespace,
+		Method:      httpReq.GetMethod(),
+		Host:        host,
+		Path:        httpReq.GetPath(),
+		Timestamp:   headers[strings.ToLower(HeaderTimestamp)],
+		Nonce:       headers[strings.ToLower(HeaderNonce)],
+		BodyDigest:  requestBodyDigest(httpReq),
+	}
+	signature := headers[strings.ToLower(HeaderSignature)]
+	if r.Timestamp == "" || r.Nonce == "" || signature == "" {
+		return s.reject(source, reasonMissing)
+	}
+	timestamp, err := strconv.ParseInt(r.Timestamp, 10, 64)
+	if err != nil {
+		return s.reject(source, reasonExpired)
+	}
+	if skew := s.now().Sub(time.Unix(timestamp, 0)); skew > vf.clockSkew || skew < -vf.clockSkew {
+		return s.reject(source, reasonExpired)
+	}
+	if !Verify(vf.tokens, r, signature) {
+		return s.reject(source, reasonSignature)
+	}
+	// the nonces are recorded after the signatures are verified, so that the forged requests can't occupy them,
+	// and they're remembered until the timestamps expire on both sides of the clock skew
+	if err := s.nonces.Add(source+"/"+r.Nonce, struct{}{}, 2*vf.clockSkew); err != nil {
+		return s.reject(source, reasonReplayed)
+	}
+	return okResponse(nil, []string{HeaderTimestamp, HeaderNonce, HeaderSignature})
+}
+
+func (s *Server) reject(source, reason string) *auth.CheckResponse {
+	nlog.Warnf("Reject request from %s, reason: %s", source, reason)
+	rejectedRequests.WithLabelValues(source, reason).Inc()
+	return deniedResponse(codes.PermissionDenied, typev3.StatusCode_Unauthorized, "request signing "+reason)
+}
+
+// requestBodyDigest digests the body envoy packs as bytes, the string body is the fallback of the filters which
+// don't pack it.
+func requestBodyDigest(httpReq *auth.AttributeContext_HttpRequest) string {
+	if body := httpReq.GetRawBody(); len(body) > 0 {
+		return BodyDigest(body)
+	}
+	return BodyDigest([]byte(httpReq.GetBody()))
+}
+
+func newNonce() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func okResponse(headers map[string]string, headersToRemove []string) *auth.CheckResponse {
+	resp := &auth.OkHttpResponse{HeadersToRemove: headersToRemove}
+	for key, value := range headers {
+		resp.Headers = append(resp.Headers, &core.HeaderValueOption{
+			Header:       &core.HeaderValue{Key: key, Value: value},
+			AppendAction: core.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		})
+	}
+	return &auth.CheckResponse{
+		Status:       &rpcstatus.Status{Code: int32(codes.OK)},
+		HttpResponse: &auth.CheckResponse_OkResponse{OkResponse: resp},
+	}
+}
+
+func deniedResponse(code codes.Code, httpCode typev3.StatusCode, message string) *auth.CheckResponse {
+	return &auth.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(code), Message: message},
+		HttpResponse: &auth.CheckResponse_DeniedResponse{
+			DeniedResponse: &auth.DeniedHttpResponse{
+				Status: &typev3.HttpStatus{Code: httpCode},
+				Body:   message,
+			},
+		},
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	auth "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/secretflow/kuscia/pkg/gateway/xds"
+)
+
+func newCheckRequest(host, path string, headers map[string]string, extensions map[string]string) *auth.CheckRequest {
+	return newCheckRequestWithBody(host, path, nil, headers, extensions)
+}
+
+func newCheckRequestWithBody(host, path string, body []byte, headers map[string]string, extensions map[string]string) *auth.CheckRequest {
+	return &auth.CheckRequest{
+		Attributes: &auth.AttributeContext{
+			Request: &auth.AttributeContext_Request{
+				Http: &auth.AttributeContext_HttpRequest{
+					Method:  "POST",
+					Host:    host,
+					Path:    path,
+					Headers: headers,
+					RawBody: body,
+				},
+			},
+			ContextExtensions: extensions,
+		},
+	}
+}
+
+// signedHeaders signs a request in alice, and returns the headers bob's gateway receives.
+func signedHeaders(t *testing.T, alice *Server, path string) map[string]string {
+	resp, err := alice.Check(context.Background(), newCheckRequest("svc.bob.svc", path, nil,
+		map[string]string{xds.RequestSigningContextKey: "alice-to-bob"}))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(codes.OK), resp.Status.Code)
+	headers := map[string]string{
+		"kuscia-source": "alice",
+		"kuscia-host":   "svc.bob.svc",
+	}
+	for _, header := range resp.GetOkResponse().GetHeaders() {
+		headers[strings.ToLower(header.Header.Key)] = header.Header.Value
+	}
+	return headers
+}
+
+func TestSignAndVerify(t *testing.T) {
+	alice, bob := NewServer("alice"), NewServer("bob")
+	alice.UpdateSigner("alice-to-bob", "alice", "bob", "token-2")
+	bob.UpdateVerifier("alice", []string{"token-1", "token-2"}, 0)
+
+	headers := signedHeaders(t, alice, "/api/v1/data?id=1")
+	resp, _ := bob.Check(context.Background(), newCheckRequest("bob.example.com", "/api/v1/data?id=1", headers, nil))
+	assert.Equal(t, int32(codes.OK), resp.Status.Code)
+	assert.ElementsMatch(t, []string{HeaderTimestamp, HeaderNonce, HeaderSignature}, resp.GetOkResponse().HeadersToRemove)
+
+	// replayed
+	resp, _ = bob.Check(context.Background(), newCheckRequest("bob.example.com", "/api/v1/data?id=1", headers, nil))
+	assert.Equal(t, int32(codes.PermissionDenied), resp.Status.Code)
+
+	// altered
+	headers = signedHeaders(t, alice, "/api/v1/data?id=1")
+	resp, _ = bob.Check(context.Background(), newCheckRequest("bob.example.com", "/api/v1/data?id=2", headers, nil))
+	assert.Equal(t, int32(codes.PermissionDenied), resp.Status.Code)
+
+	// missing
+	delete(headers, "kuscia-signature")
+	resp, _ = bob.Check(context.Background(), newCheckRequest("bob.example.com", "/api/v1/data?id=1", headers, nil))
+	assert.Equal(t, int32(codes.PermissionDenied), resp.Status.Code)
+
+	// the requests from the sources not verified and the handshake requests pass
+	resp, _ = bob.Check(context.Background(), newCheckRequest("bob.example.com", "/", map[string]string{"kuscia-source": "carol"}, nil))
+	assert.Equal(t, int32(codes.OK), resp.Status.Code)
+	resp, _ = bob.Check(context.Background(), newCheckRequest("bob.example.com", "/handshake",
+		map[string]string{"kuscia-source": "alice", "kuscia-host": "kuscia-handshake.bob.svc"}, nil))
+	assert.Equal(t, int32(codes.OK), resp.Status.Code)
+}
+
+func TestVerifyBody(t *testing.T) {
+	alice, bob := NewServer("alice"), NewServer("bob")
+	alice.UpdateSigner("alice-to-bob", "alice", "bob", "token")
+	bob.UpdateVerifier("alice", []string{"token"}, 0)
+
+	sign := func(body []byte) map[string]string {
+		resp, err := alice.Check(context.Background(), newCheckRequestWithBody("svc.bob.svc", "/api/v1/data", body, nil,
+			map[string]string{xds.RequestSigningContextKey: "alice-to-bob"}))
+		assert.NoError(t, err)
+		headers := map[string]string{"kuscia-source": "alice", "kuscia-host": "svc.bob.svc"}
+		for _, header := range resp.GetOkResponse().GetHeaders() {
+			headers[strings.ToLower(header.Header.Key)] = header.Header.Value
+		}
+		return headers
+	}
+
+	headers := sign([]byte(`{"amount":1}`))
+	resp, _ := bob.Check(context.Background(), newCheckRequestWithBody("bob.example.com", "/api/v1/data", []byte(`{"amount":1}`), headers, nil))
+	assert.Equal(t, int32(codes.OK), resp.Status.Code)
+
+	// tampered body
+	headers = sign([]byte(`{"amount":1}`))
+	resp, _ = bob.Check(context.Background(), newCheckRequestWithBody("bob.example.com", "/api/v1/data", []byte(`{"amount":100}`), headers, nil))
+	assert.Equal(t, int32(codes.PermissionDenied), resp.Status.Code)
+
+	// dropped body
+	headers = sign([]byte(`{"amount":1}`))
+	resp, _ = bob.Check(context.Background(), newCheckRequest("bob.example.com", "/api/v1/data", headers, nil))
+	assert.Equal(t, int32(codes.PermissionDenied), resp.Status.Code)
+}
+
+func TestVerifyClockSkew(t *testing.T) {
+	alice, bob := NewServer("alice"), NewServer("bob")
+	alice.UpdateSigner("alice-to-bob", "alice", "bob", "token")
+	bob.UpdateVerifier("alice", []string{"token"}, time.Minute)
+
+	now := time.Now()
+	alice.now = func() time.Time { return now.Add(-50 * time.Second) }
+	headers := signedHeaders(t, alice, "/")
+	resp, _ := bob.Check(context.Background(), newCheckRequest("bob.example.com", "/", headers, nil))
+	assert.Equal(t, int32(codes.OK), resp.Status.Code)
+
+	alice.now = func() time.Time { return now.Add(70 * time.Second) }
+	headers = signedHeaders(t, alice, "/")
+	resp, _ = bob.Check(context.Background(), newCheckRequest("bob.example.com", "/", headers, nil))
+	assert.Equal(t, int32(codes.PermissionDenied), resp.Status.Code)
+}
+
+func TestSignWithoutSigner(t *testing.T) {
+	alice := NewServer("alice")
+	resp, _ := alice.Check(context.Background(), newCheckRequest("svc.bob.svc", "/", nil,
+		map[string]string{xds.RequestSigningContextKey: "alice-to-bob"}))
+	assert.Equal(t, int32(codes.Unavailable), resp.Status.Code)
+
+	resp, _ = alice.Check(context.Background(), newCheckRequest("kuscia-handshake.bob.svc", "/handshake", nil,
+		map[string]string{xds.RequestSigningContextKey: "alice-to-bob"}))
+	assert.Equal(t, int32(codes.OK), resp.Status.Code)
+	assert.Empty(t, resp.GetOkResponse().Headers)
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	HeaderTimestamp = "Kuscia-Timestamp"
+	HeaderNonce     = "Kuscia-Nonce"
+	HeaderSignature = "Kuscia-Signature"
+
+	signatureAlgorithm = "KUSCIA-HMAC-SHA256"
+)
+
+// Request is the signed part of a cross-domain request. The host is the one requested in the source, which is
+// passed to destination by the Kuscia-Host header, and the path includes the query.
+type Request struct {
+	Source      string
+	Destination string
+	Method      string
+	Host        string
+	Path        string
+	// Timestamp is the unix seconds the request is signed at.
+	Timestamp string
+	Nonce     string
+	// BodyDigest is the hex encoded SHA256 of the body envoy buffers for the check, see BodyDigest.
+	BodyDigest string
+}
+
+// BodyDigest returns the hex encoded SHA256 of the request body. Envoy buffers the body up to the same limit in
+// the gateways of both sides, so the larger bodies are signed by their leading bytes.
+func BodyDigest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *Request) canonical() string {
+	return strings.Join([]string{
+		signatureAlgorithm,
+		r.Source,
+		r.Destination,
+		r.Method,
+		r.Host,
+		r.Path,
+		r.Timestamp,
+		r.Nonce,
+		r.BodyDigest,
+	}, "\n")
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the request.
+func Sign(key string, r *Request) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(r.canonical()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature is signed by any of the keys, the previous tokens are still valid while
+// the token of the route is rolling.
+func Verify(keys []string, r *Request, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	for _, key := range keys {
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write([]byte(r.canonical()))
+		if hmac.Equal(mac.Sum(nil), expected) {
+			return true
+		}
+	}
+	return false
+}
@@ -27,4 +27,6 @@ const (
 	ServiceKusciaAPI     = "kusciaapi"
 	ServiceReporter      = "reporter"
 	EnvoyClusterName     = "envoy-cluster"
+
+	ServiceInterConnScheduler = "interconn-scheduler"
 )
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -40,6 +41,34 @@ type HTTPParam struct {
 	Headers      map[string]string
 }
 
+// StatusCodeError is returned by DoHTTP if the response status isn't 200, it tells the requests rejected by the
+// peer from the failures in the network.
+type StatusCodeError struct {
+	StatusCode int
+	Detail     string
+}
+
+func (e *StatusCodeError) Error() string {
+	return fmt.Sprintf("response status code [%d], detail -> %s", e.StatusCode, e.Detail)
+}
+
+// StripIPv6Brackets returns the host without the brackets around an IPv6 literal, e.g. "[::1]" to "::1".
+func StripIPv6Brackets(host string) string {
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		return host[1 : len(host)-1]
+	}
+	return host
+}
+
+// BracketIPv6 returns the host with brackets if it's an IPv6 literal, as the host part of URLs and Host headers.
+func BracketIPv6(host string) string {
+	host = StripIPv6Brackets(host)
+	if strings.Contains(host, ":") {
+		return "[" + host + "]"
+	}
+	return host
+}
+
 func ParseURL(url string) (string, string, uint32, string, error) {
 	var protocol, hostPort, host, path string
 	var port int
@@ -60,13 +89,13 @@ func ParseURL(url string) (string, string, uint32, string, error) {
 		path = "/" + parts[1]
 	}
 
-	fields := strings.Split(hostPort, ":")
-	host = fields[0]
-	if len(fields) == 2 {
-		if port, err = strconv.Atoi(fields[1]); err != nil {
+	if h, p, splitErr := net.SplitHostPort(hostPort); splitErr == nil {
+		host = h
+		if port, err = strconv.Atoi(p); err != nil {
 			return protocol, host, uint32(port), path, err
 		}
 	} else {
+		host = StripIPv6Brackets(hostPort)
 		if protocol == "http" {
 			port = 80
 		} else {
@@ -148,7 +177,7 @@ func DoHTTP(in interface{}, out interface{}, hp *HTTPParam) error {
 		if len(body) > 200 {
 			body = body[:200]
 		}
-		return fmt.Errorf("response status code [%d], detail -> %s", resp.StatusCode, string(body))
+		return &StatusCodeError{StatusCode: resp.StatusCode, Detail: string(body)}
 	}
 
 	if err := json.Unmarshal(body, out); err != nil {
@@ -99,6 +99,8 @@ func TestParseURL(t *testing.T) {
 		{"case 4", args{"https://user-kuscia-master:1080"}, "https", "user-kuscia-master", 1080, "", false},
 		{"case 5", args{"http://user-kuscia-master:1080"}, "http", "user-kuscia-master", 1080, "", false},
 		{"case 6", args{"https::///test.example.com"}, "", "", 0, "", true},
+		{"case 7", args{"https://[fd00::1]:1080/path"}, "https", "fd00::1", 1080, "/path", false},
+		{"case 8", args{"http://[fd00::1]"}, "http", "fd00::1", 80, "", false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -167,3 +169,12 @@ func TestDoHTTPWithRetry(t *testing.T) {
 		})
 	}
 }
+
+func TestBracketIPv6(t *testing.T) {
+	assert.Equal(t, "fd00::1", StripIPv6Brackets("[fd00::1]"))
+	assert.Equal(t, "fd00::1", StripIPv6Brackets("fd00::1"))
+	assert.Equal(t, "[fd00::1]", BracketIPv6("fd00::1"))
+	assert.Equal(t, "[fd00::1]", BracketIPv6("[fd00::1]"))
+	assert.Equal(t, "127.0.0.1", BracketIPv6("127.0.0.1"))
+	assert.Equal(t, "alice.example.com", BracketIPv6("alice.example.com"))
+}
@@ -39,6 +39,9 @@ const (
 	ProtocolGRPCS = "GRPCS"
 )
 
+// DefaultUpstreamIdleTimeout is the idle timeout of the connections to the remote clusters.
+const DefaultUpstreamIdleTimeout = 900 * time.Second
+
 func GenerateProtocol(isTLS, isGRPC bool) string {
 	if isTLS {
 		if isGRPC {
@@ -60,7 +63,7 @@ func DecorateCluster(cluster *envoycluster.Cluster) {
 	cluster.ClusterDiscoveryType = &envoycluster.Cluster_Type{
 		Type: envoycluster.Cluster_STRICT_DNS,
 	}
-	cluster.DnsLookupFamily = envoycluster.Cluster_V4_ONLY
+	cluster.DnsLookupFamily = clusterDNSLookupFamily(cluster, ipFamily())
 
 	// load balance policy
 	cluster.LbPolicy = envoycluster.Cluster_RING_HASH
@@ -195,7 +198,7 @@ func GenerateHTTP2UpstreamHTTPOptions(isRemoteCluster bool) *envoyhttp.HttpProto
 func SetCommonHTTPProtocolOptions(options *envoyhttp.HttpProtocolOptions) {
 	// set connections idle timeout
 	options.CommonHttpProtocolOptions = &core.HttpProtocolOptions{
-		IdleTimeout: &durationpb.Duration{Seconds: int64(900)},
+		IdleTimeout: durationpb.New(DefaultUpstreamIdleTimeout),
 	}
 }
 
@@ -0,0 +1,147 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	gzipcompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	zstdcompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	compressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// RouteCompressionConfig defines how the request bodies of a domain route are compressed.
+type RouteCompressionConfig struct {
+	Algorithm string
+	// MinContentLength 0 means the envoy default.
+	MinContentLength uint32
+	// ContentTypes empty means the envoy default.
+	ContentTypes []string
+}
+
+// routeCompressorFilterName names the compressor filter of a domain route virtual host. The thresholds can't
+// be overridden per route, so each domain route has its own compressor, which is only enabled on its virtual host.
+func routeCompressorFilterName(vhName string) string {
+	return CompressorFilterName + "/" + vhName
+}
+
+// filterType returns the filter name without the instance suffix, e.g. the compressors of the domain routes
+// and the decompressors of the algorithms are named <filter>/<instance>, they are sorted by the filter type.
+func filterType(name string) string {
+	if i := strings.IndexByte(name, '/'); i > 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// UpdateRouteCompression sets the compression of the domain route virtual host, nil removes it.
+// It takes effect on the next update of the virtual host.
+func UpdateRouteCompression(vhName string, conf *RouteCompressionConfig) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if reflect.DeepEqual(routeCompressions[vhName], conf) {
+		return nil
+	}
+	filterName := routeCompressorFilterName(vhName)
+	if conf == nil {
+		nlog.Infof("Remove compression of virtual host %s", vhName)
+		delete(routeCompressions, vhName)
+		delete(internalFilterMap, filterName)
+	} else {
+		filter, err := newRequestCompressor(conf)
+		if err != nil {
+			return err
+		}
+		nlog.Infof("Update compression of virtual host %s to %s", vhName, conf.Algorithm)
+		routeCompressions[vhName] = conf
+		internalFilterMap[filterName] = filter
+	}
+	return updateHTTPFilters(internalFilterMap, InternalListener)
+}
+
+// newRequestCompressor compresses the request bodies only, the responses are left as they are, since the
+// gateway of the destination doesn't compress them.
+func newRequestCompressor(conf *RouteCompressionConfig) (*compressor.Compressor, error) {
+	var library proto.Message
+	switch conf.Algorithm {
+	case CompressionGzip:
+		library = &gzipcompressor.Gzip{}
+	case CompressionZstd:
+		library = &zstdcompressor.Zstd{}
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %q", conf.Algorithm)
+	}
+	libraryConfig, err := anypb.New(library)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s compressor failed with %s", conf.Algorithm, err.Error())
+	}
+
+	requestConfig := &compressor.Compressor_CommonDirectionConfig{
+		Enabled: &core.RuntimeFeatureFlag{
+			DefaultValue: wrapperspb.Bool(true),
+			RuntimeKey:   "kuscia.compressor.request.enabled",
+		},
+		ContentType: conf.ContentTypes,
+	}
+	if conf.MinContentLength > 0 {
+		requestConfig.MinContentLength = wrapperspb.UInt32(conf.MinContentLength)
+	}
+	return &compressor.Compressor{
+		CompressorLibrary: &core.TypedExtensionConfig{
+			Name:        conf.Algorithm,
+			TypedConfig: libraryConfig,
+		},
+		RequestDirectionConfig: &compressor.Compressor_RequestDirectionConfig{
+			CommonConfig: requestConfig,
+		},
+		ResponseDirectionConfig: &compressor.Compressor_ResponseDirectionConfig{
+			CommonConfig: &compressor.Compressor_CommonDirectionConfig{
+				Enabled: &core.RuntimeFeatureFlag{
+					DefaultValue: wrapperspb.Bool(false),
+					RuntimeKey:   "kuscia.compressor.response.enabled",
+				},
+			},
+		},
+	}, nil
+}
+
+// updateVhCompression enables the compressor of the virtual host, which is disabled on the other ones.
+func updateVhCompression(vh *route.VirtualHost, enabled bool) {
+	filterName := routeCompressorFilterName(vh.Name)
+	if !enabled {
+		delete(vh.TypedPerFilterConfig, filterName)
+		return
+	}
+	if vh.TypedPerFilterConfig == nil {
+		vh.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	filterConfig, _ := anypb.New(&route.FilterConfig{})
+	vh.TypedPerFilterConfig[filterName] = filterConfig
+}
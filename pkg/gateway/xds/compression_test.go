@@ -0,0 +1,84 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequestCompressor(t *testing.T) {
+	compressor, err := newRequestCompressor(&RouteCompressionConfig{Algorithm: CompressionGzip})
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionGzip, compressor.CompressorLibrary.Name)
+	assert.True(t, compressor.RequestDirectionConfig.CommonConfig.Enabled.DefaultValue.GetValue())
+	assert.Nil(t, compressor.RequestDirectionConfig.CommonConfig.MinContentLength)
+	assert.False(t, compressor.ResponseDirectionConfig.CommonConfig.Enabled.DefaultValue.GetValue())
+	assert.NoError(t, compressor.Validate())
+
+	compressor, err = newRequestCompressor(&RouteCompressionConfig{
+		Algorithm:        CompressionZstd,
+		MinContentLength: 1024,
+		ContentTypes:     []string{"application/octet-stream"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1024), compressor.RequestDirectionConfig.CommonConfig.MinContentLength.GetValue())
+	assert.Equal(t, []string{"application/octet-stream"}, compressor.RequestDirectionConfig.CommonConfig.ContentType)
+
+	_, err = newRequestCompressor(&RouteCompressionConfig{Algorithm: "br"})
+	assert.Error(t, err)
+}
+
+func TestSortCompressorFilters(t *testing.T) {
+	filters := sortInternalFilters([]*hcm.HttpFilter{
+		{Name: RouterName},
+		{Name: routeCompressorFilterName("alice-to-carol")},
+		{Name: CryptFilterName},
+		{Name: routeCompressorFilterName("alice-to-bob")},
+		{Name: KusciaGressName},
+	})
+	var names []string
+	for _, filter := range filters {
+		names = append(names, filter.Name)
+	}
+	assert.Equal(t, []string{
+		KusciaGressName,
+		"envoy.filters.http.compressor/alice-to-bob",
+		"envoy.filters.http.compressor/alice-to-carol",
+		CryptFilterName,
+		RouterName,
+	}, names)
+
+	filters = sortExternalFilters([]*hcm.HttpFilter{
+		{Name: RouterName},
+		{Name: DecompressorFilterName + "/gzip"},
+		{Name: CryptFilterName},
+		{Name: TokenAuthFilterName},
+	})
+	assert.Equal(t, CryptFilterName, filters[1].Name)
+	assert.Equal(t, DecompressorFilterName+"/gzip", filters[2].Name)
+}
+
+func TestUpdateVhCompression(t *testing.T) {
+	vh := &route.VirtualHost{Name: "alice-to-bob"}
+	updateVhCompression(vh, true)
+	assert.Contains(t, vh.TypedPerFilterConfig, routeCompressorFilterName(vh.Name))
+
+	updateVhCompression(vh, false)
+	assert.NotContains(t, vh.TypedPerFilterConfig, routeCompressorFilterName(vh.Name))
+}
@@ -41,27 +41,39 @@ const (
 	ReceiverFilterName         = "envoy.filters.http.kuscia_receiver"
 	BandwidthLimitName         = "envoy.filters.http.bandwidth_limit"
 	PollerFilterName           = "envoy.filters.http.kuscia_poller"
+	CompressorFilterName       = "envoy.filters.http.compressor"
+	DecompressorFilterName     = "envoy.filters.http.decompressor"
+	ExtAuthzFilterName         = "envoy.filters.http.ext_authz"
+	// RouteCustomFilterName is the type of the Lua and Wasm filters provided by the users for the domain routes
+	RouteCustomFilterName = "kuscia.filters.http.route_custom"
 )
 
 var (
+	// the custom filters see the original requests, the requests are signed as the destination sees them, and the
+	// request bodies are compressed before the bandwidth limit and the encryption
 	internalFilterPriority = map[string]int{
 		GrpcHTTP1ReverseBridgeName: 0,
 		KusciaGressName:            1,
-		BandwidthLimitName:         2,
-		CryptFilterName:            3,
-		ReceiverFilterName:         4,
-		PollerFilterName:           5,
-		RouterName:                 6,
+		RouteCustomFilterName:      2,
+		ExtAuthzFilterName:         3,
+		CompressorFilterName:       4,
+		BandwidthLimitName:         5,
+		CryptFilterName:            6,
+		ReceiverFilterName:         7,
+		PollerFilterName:           8,
+		RouterName:                 9,
 	}
 
 	externalFilterPriority = map[string]int{
 		GrpcHTTP1BridgeName:       0,
 		KusciaGressName:           1,
 		TokenAuthFilterName:       2,
-		HeaderDecoratorFilterName: 3,
-		CryptFilterName:           4,
-		ReceiverFilterName:        5,
-		RouterName:                6,
+		ExtAuthzFilterName:        3,
+		HeaderDecoratorFilterName: 4,
+		CryptFilterName:           5,
+		DecompressorFilterName:    6,
+		ReceiverFilterName:        7,
+		RouterName:                8,
 	}
 
 	mutableFilters = map[string]bool{
@@ -71,17 +83,30 @@ var (
 		ReceiverFilterName:        true,
 		BandwidthLimitName:        true,
 		PollerFilterName:          true,
+		CompressorFilterName:      true,
+		RouteCustomFilterName:     true,
+		ExtAuthzFilterName:        true,
 	}
 
 	// internal only filters config
 	encryptRules      []*kusciacrypt.CryptRule // for outbound, on port 80
 	pollAppendHeaders []*kusciapoller.Poller_SourceHeader
 	virtualHostLimits map[string]map[string]*RouteLimitConfig
+	// bandwidth limit of the domain routes, keyed by virtual host name
+	routeBandwidthLimits map[string]int64
+	// compression of the domain routes, keyed by virtual host name
+	routeCompressions map[string]*RouteCompressionConfig
+	// custom filters of the domain routes, keyed by virtual host name
+	routeFilters map[string][]*RouteFilterConfig
+	// domain routes whose requests are signed, keyed by virtual host name
+	routeSignings map[string]bool
 
 	// external only filers config
 	decryptRules  []*kusciacrypt.CryptRule // for inbound, on port 1080
 	appendHeaders []*headerdecorator.HeaderDecorator_SourceHeader
 	sourceTokens  []*kusciatoken.TokenAuth_SourceToken
+	// sources whose requests are verified
+	sourceSignings map[string]bool
 
 	// internal and external filters config
 	receiverRules []*kusciareceiver.ReceiverRule
@@ -200,20 +225,45 @@ func UpdateBandwidthLimit(vhName string, taskID string, serviceName string, limi
 
 	if add {
 		updateVirtualHostLimit(vhName, taskID, serviceName, *limitKbps)
-		if _, ok := internalFilterMap[BandwidthLimitName]; !ok {
-			internalFilterMap[BandwidthLimitName] = &bandwidth_limitv3.BandwidthLimit{
-				StatPrefix: "kuscia_bandwidth_limit",
-			}
-		}
 	} else {
 		deleteVirtualHostLimit(vhName, taskID, serviceName)
-		if len(virtualHostLimits) == 0 {
-			delete(internalFilterMap, BandwidthLimitName)
-		}
 	}
+	updateBandwidthLimitFilter()
 	return updateHTTPFilters(internalFilterMap, InternalListener)
 }
 
+// UpdateRouteBandwidthLimit sets the bandwidth limit of the domain route virtual host, limitKbps 0 removes the limit.
+// It takes effect on the next update of the virtual host.
+func UpdateRouteBandwidthLimit(vhName string, limitKbps int64) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if routeBandwidthLimits[vhName] == limitKbps {
+		return nil
+	}
+	nlog.Infof("Update bandwidth limit of virtual host %s to %d kbps", vhName, limitKbps)
+	if limitKbps > 0 {
+		routeBandwidthLimits[vhName] = limitKbps
+	} else {
+		delete(routeBandwidthLimits, vhName)
+	}
+	updateBandwidthLimitFilter()
+	return updateHTTPFilters(internalFilterMap, InternalListener)
+}
+
+// updateBandwidthLimitFilter keeps the bandwidth limit filter only while any limit is configured.
+func updateBandwidthLimitFilter() {
+	if len(virtualHostLimits) == 0 && len(routeBandwidthLimits) == 0 {
+		delete(internalFilterMap, BandwidthLimitName)
+		return
+	}
+	if _, ok := internalFilterMap[BandwidthLimitName]; !ok {
+		internalFilterMap[BandwidthLimitName] = &bandwidth_limitv3.BandwidthLimit{
+			StatPrefix: "kuscia_bandwidth_limit",
+		}
+	}
+}
+
 func updateVirtualHostLimit(vhName string, taskID string, serviceName string, limitKbps int64) {
 	cfgs, ok := virtualHostLimits[vhName]
 	if !ok {
@@ -367,7 +417,12 @@ func (f HTTPFilters) Len() int {
 }
 
 func (f HTTPFilters) Less(i, j int) bool {
-	return f.dic[f.filters[i].Name] < f.dic[f.filters[j].Name]
+	pi, pj := f.dic[filterType(f.filters[i].Name)], f.dic[filterType(f.filters[j].Name)]
+	if pi != pj {
+		return pi < pj
+	}
+	// keep the instances of the same filter in a stable order, so that the listener isn't updated needlessly
+	return f.filters[i].Name < f.filters[j].Name
 }
 
 func (f HTTPFilters) Swap(i, j int) {
@@ -0,0 +1,188 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"fmt"
+
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	quic "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// ExternalQUICListener serves HTTP/3 on the udp port of the external listener.
+	ExternalQUICListener = "external-listener-quic"
+
+	quicTransportSocketName = "envoy.transport_sockets.quic"
+	hcmFilterName           = "envoy.filters.network.http_connection_manager"
+	altSvcMaxAgeSeconds     = 86400
+	// alternateProtocolsCacheName is shared by all the remote clusters, the entries are keyed by the origin.
+	alternateProtocolsCacheName = "kuscia-alternate-protocols"
+)
+
+// HTTP3Config enables the HTTP/3 listener of the external listener.
+type HTTP3Config struct {
+	// AdvertisePort is the udp port advertised to the peers by the Alt-Svc header, 0 means the external port.
+	AdvertisePort uint32
+}
+
+func (c *HTTP3Config) advertisePort(externalPort uint32) uint32 {
+	if c.AdvertisePort > 0 {
+		return c.AdvertisePort
+	}
+	return externalPort
+}
+
+// externalHTTP3Enabled reports whether the external listener serves HTTP/3. QUIC needs the TLS of the external
+// listener, and it's disabled for the mutual TLS, since the QUIC listener doesn't verify the client certs.
+func externalHTTP3Enabled(config *InitConfig) bool {
+	return config.ExternalHTTP3 != nil && config.ExternalCert != nil && config.ExternalCert.CAData == ""
+}
+
+// generateQUICListener copies the external listener to serve HTTP/3 over QUIC on the udp port of the same number.
+func generateQUICListener(lis *listener.Listener, cert *TLSCert) (*listener.Listener, error) {
+	quicLis, ok := proto.Clone(lis).(*listener.Listener)
+	if !ok {
+		return nil, fmt.Errorf("clone %s fail", lis.Name)
+	}
+	quicLis.Name = ExternalQUICListener
+	quicLis.GetAddress().GetSocketAddress().Protocol = core.SocketAddress_UDP
+	// the tcp socket options and listener filters don't work for udp
+	quicLis.SocketOptions = nil
+	quicLis.ListenerFilters = nil
+	quicLis.UdpListenerConfig = &listener.UdpListenerConfig{
+		QuicOptions: &listener.QuicProtocolOptions{},
+		DownstreamSocketConfig: &core.UdpSocketConfig{
+			PreferGro: wrapperspb.Bool(true),
+		},
+	}
+
+	transportSocket, err := generateQUICDownstreamTransport(cert)
+	if err != nil {
+		return nil, err
+	}
+	for _, filterChain := range quicLis.FilterChains {
+		filterChain.TransportSocket = transportSocket
+		for _, filter := range filterChain.Filters {
+			if filter.Name != hcmFilterName {
+				continue
+			}
+			var httpManager hcm.HttpConnectionManager
+			if err := filter.GetTypedConfig().UnmarshalTo(&httpManager); err != nil {
+				return nil, fmt.Errorf("unmarshal hcm failed with %s", err.Error())
+			}
+			httpManager.CodecType = hcm.HttpConnectionManager_HTTP3
+			httpManager.Http3ProtocolOptions = &core.Http3ProtocolOptions{}
+			hcmConfig, err := anypb.New(&httpManager)
+			if err != nil {
+				return nil, fmt.Errorf("marshal http connection manager failed with %s", err.Error())
+			}
+			filter.ConfigType = &listener.Filter_TypedConfig{TypedConfig: hcmConfig}
+		}
+	}
+	return quicLis, nil
+}
+
+func generateQUICDownstreamTransport(cert *TLSCert) (*core.TransportSocket, error) {
+	tlsSocket, err := GenerateDownstreamTLSConfigByCert(cert)
+	if err != nil {
+		return nil, err
+	}
+	tlsContext := &tls.DownstreamTlsContext{}
+	if err := tlsSocket.GetTypedConfig().UnmarshalTo(tlsContext); err != nil {
+		return nil, err
+	}
+	conf, err := anypb.New(&quic.QuicDownstreamTransport{DownstreamTlsContext: tlsContext})
+	if err != nil {
+		return nil, fmt.Errorf("marshal QuicDownstreamTransport failed with %s", err.Error())
+	}
+	return &core.TransportSocket{
+		Name:       quicTransportSocketName,
+		ConfigType: &core.TransportSocket_TypedConfig{TypedConfig: conf},
+	}, nil
+}
+
+// addAltSvcHeader advertises the HTTP/3 listener in the responses of the external route, so that the peers
+// learn to use HTTP/3 on the next connections.
+func addAltSvcHeader(routeConfig *route.RouteConfiguration, port uint32) {
+	routeConfig.ResponseHeadersToAdd = append(routeConfig.ResponseHeadersToAdd, &core.HeaderValueOption{
+		Header: &core.HeaderValue{
+			Key:   "alt-svc",
+			Value: fmt.Sprintf("h3=\":%d\"; ma=%d", port, altSvcMaxAgeSeconds),
+		},
+		AppendAction: core.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+	})
+}
+
+// GenerateHTTP3UpstreamHTTPOptions returns the options trying HTTP/3 once the upstream advertises it by the Alt-Svc
+// header. Envoy races a tcp connection if QUIC doesn't connect in time, and marks HTTP/3 broken for the upstream
+// if QUIC fails, so the requests fall back to HTTP/2 automatically.
+func GenerateHTTP3UpstreamHTTPOptions(isRemoteCluster bool) *envoyhttp.HttpProtocolOptions {
+	protocolOptions := &envoyhttp.HttpProtocolOptions{
+		UpstreamProtocolOptions: &envoyhttp.HttpProtocolOptions_AutoConfig{
+			AutoConfig: &envoyhttp.HttpProtocolOptions_AutoHttpConfig{
+				Http2ProtocolOptions: &core.Http2ProtocolOptions{},
+				Http3ProtocolOptions: &core.Http3ProtocolOptions{},
+				AlternateProtocolsCacheOptions: &core.AlternateProtocolsCacheOptions{
+					Name: alternateProtocolsCacheName,
+				},
+			},
+		},
+	}
+	if isRemoteCluster {
+		SetCommonHTTPProtocolOptions(protocolOptions)
+	}
+	return protocolOptions
+}
+
+// DecorateQUICUpstreamTransport wraps the tls transport socket of the cluster by the QUIC one, which is used by
+// both the QUIC connections and the tcp connections falling back to. The cluster must be decorated before.
+func DecorateQUICUpstreamTransport(cluster *envoycluster.Cluster, sni string) error {
+	if cluster.TransportSocket == nil || cluster.TransportSocket.Name == quicTransportSocketName {
+		return nil
+	}
+	tlsContext := &tls.UpstreamTlsContext{}
+	if err := cluster.TransportSocket.GetTypedConfig().UnmarshalTo(tlsContext); err != nil {
+		return fmt.Errorf("unmarshal UpstreamTlsContext of %s failed with %s", cluster.Name, err.Error())
+	}
+	if tlsContext.Sni == "" {
+		tlsContext.Sni = sni
+	}
+	if tlsContext.CommonTlsContext == nil {
+		tlsContext.CommonTlsContext = &tls.CommonTlsContext{}
+	}
+	// the tcp connections negotiate HTTP/2 by alpn
+	if len(tlsContext.CommonTlsContext.AlpnProtocols) == 0 {
+		tlsContext.CommonTlsContext.AlpnProtocols = []string{"h2", "http/1.1"}
+	}
+	conf, err := anypb.New(&quic.QuicUpstreamTransport{UpstreamTlsContext: tlsContext})
+	if err != nil {
+		return fmt.Errorf("marshal QuicUpstreamTransport failed with %s", err.Error())
+	}
+	cluster.TransportSocket = &core.TransportSocket{
+		Name:       quicTransportSocketName,
+		ConfigType: &core.TransportSocket_TypedConfig{TypedConfig: conf},
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"crypto/x509"
+	"testing"
+
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	quic "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+func newTestTLSCert(t *testing.T) *TLSCert {
+	caKey, caCertData, err := tlsutils.CreateCA("test-ca")
+	assert.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caCertData)
+	assert.NoError(t, err)
+	keyData, certData, err := tlsutils.GenerateKeyCertPairData(caKey, caCert, "alice_ENVOY_EXTERNAL")
+	assert.NoError(t, err)
+	return &TLSCert{CertData: certData, KeyData: keyData}
+}
+
+func TestGenerateQUICListener(t *testing.T) {
+	hcmConfig, err := anypb.New(&hcm.HttpConnectionManager{StatPrefix: "external_http"})
+	assert.NoError(t, err)
+	lis := &listener.Listener{
+		Name: ExternalListener,
+		Address: &core.Address{Address: &core.Address_SocketAddress{SocketAddress: &core.SocketAddress{
+			Address:       "0.0.0.0",
+			PortSpecifier: &core.SocketAddress_PortValue{PortValue: 1080},
+		}}},
+		SocketOptions: []*core.SocketOption{{Level: 1, Name: 9, Value: &core.SocketOption_IntValue{IntValue: 1}}},
+		FilterChains: []*listener.FilterChain{{
+			Filters: []*listener.Filter{{
+				Name:       hcmFilterName,
+				ConfigType: &listener.Filter_TypedConfig{TypedConfig: hcmConfig},
+			}},
+		}},
+	}
+
+	quicLis, err := generateQUICListener(lis, newTestTLSCert(t))
+	assert.NoError(t, err)
+	assert.Equal(t, ExternalQUICListener, quicLis.Name)
+	assert.Equal(t, core.SocketAddress_UDP, quicLis.GetAddress().GetSocketAddress().Protocol)
+	assert.Equal(t, uint32(1080), quicLis.GetAddress().GetSocketAddress().GetPortValue())
+	assert.Nil(t, quicLis.SocketOptions)
+	assert.NotNil(t, quicLis.UdpListenerConfig.QuicOptions)
+
+	transportSocket := quicLis.FilterChains[0].TransportSocket
+	assert.Equal(t, quicTransportSocketName, transportSocket.Name)
+	quicTransport := &quic.QuicDownstreamTransport{}
+	assert.NoError(t, transportSocket.GetTypedConfig().UnmarshalTo(quicTransport))
+	assert.Len(t, quicTransport.DownstreamTlsContext.CommonTlsContext.TlsCertificates, 1)
+
+	httpManager := &hcm.HttpConnectionManager{}
+	assert.NoError(t, quicLis.FilterChains[0].Filters[0].GetTypedConfig().UnmarshalTo(httpManager))
+	assert.Equal(t, hcm.HttpConnectionManager_HTTP3, httpManager.CodecType)
+	assert.Equal(t, "external_http", httpManager.StatPrefix)
+
+	// the external listener is left as it is
+	assert.Equal(t, core.SocketAddress_TCP, lis.GetAddress().GetSocketAddress().Protocol)
+	assert.Nil(t, lis.FilterChains[0].TransportSocket)
+}
+
+func TestExternalHTTP3Enabled(t *testing.T) {
+	cert := newTestTLSCert(t)
+	assert.False(t, externalHTTP3Enabled(&InitConfig{ExternalCert: cert}))
+	assert.False(t, externalHTTP3Enabled(&InitConfig{ExternalHTTP3: &HTTP3Config{}}))
+	assert.True(t, externalHTTP3Enabled(&InitConfig{ExternalHTTP3: &HTTP3Config{}, ExternalCert: cert}))
+	mtlsCert := *cert
+	mtlsCert.CAData = cert.CertData
+	assert.False(t, externalHTTP3Enabled(&InitConfig{ExternalHTTP3: &HTTP3Config{}, ExternalCert: &mtlsCert}))
+}
+
+func TestAddAltSvcHeader(t *testing.T) {
+	routeConfig := &route.RouteConfiguration{Name: ExternalRoute}
+	addAltSvcHeader(routeConfig, (&HTTP3Config{}).advertisePort(1080))
+	assert.Equal(t, "alt-svc", routeConfig.ResponseHeadersToAdd[0].Header.Key)
+	assert.Equal(t, `h3=":1080"; ma=86400`, routeConfig.ResponseHeadersToAdd[0].Header.Value)
+
+	routeConfig = &route.RouteConfiguration{Name: ExternalRoute}
+	addAltSvcHeader(routeConfig, (&HTTP3Config{AdvertisePort: 21080}).advertisePort(1080))
+	assert.Equal(t, `h3=":21080"; ma=86400`, routeConfig.ResponseHeadersToAdd[0].Header.Value)
+}
+
+func TestDecorateQUICUpstreamTransport(t *testing.T) {
+	cluster := &envoycluster.Cluster{Name: "alice-to-bob-https"}
+	assert.NoError(t, DecorateQUICUpstreamTransport(cluster, "bob.example.com"))
+	assert.Nil(t, cluster.TransportSocket)
+
+	assert.NoError(t, DecorateRemoteUpstreamCluster(cluster, ProtocolHTTPS))
+	assert.NoError(t, DecorateQUICUpstreamTransport(cluster, "bob.example.com"))
+	assert.Equal(t, quicTransportSocketName, cluster.TransportSocket.Name)
+	quicTransport := &quic.QuicUpstreamTransport{}
+	assert.NoError(t, cluster.TransportSocket.GetTypedConfig().UnmarshalTo(quicTransport))
+	assert.Equal(t, "bob.example.com", quicTransport.UpstreamTlsContext.Sni)
+	assert.Equal(t, []string{"h2", "http/1.1"}, quicTransport.UpstreamTlsContext.CommonTlsContext.AlpnProtocols)
+
+	// decorating again doesn't wrap twice
+	assert.NoError(t, DecorateQUICUpstreamTransport(cluster, "bob.example.com"))
+	assert.Equal(t, quicTransportSocketName, cluster.TransportSocket.Name)
+}
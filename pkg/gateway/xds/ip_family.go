@@ -0,0 +1,80 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"net"
+
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+
+	"github.com/secretflow/kuscia/pkg/common"
+)
+
+const (
+	anyIPv4Address = "0.0.0.0"
+	anyIPv6Address = "::"
+)
+
+func ipFamily() common.IPFamily {
+	if config == nil || config.IPFamily == "" {
+		return common.IPFamilyIPv4
+	}
+	return config.IPFamily
+}
+
+// applyListenerIPFamily makes the listeners on the IPv4 any address listen on the IPv6 any address in the IPv6
+// network. The dual-stack listeners accept the IPv4 connections as the IPv4-mapped IPv6 addresses.
+func applyListenerIPFamily(lis *listener.Listener, family common.IPFamily) {
+	addr := lis.GetAddress().GetSocketAddress()
+	if addr == nil || addr.Address != anyIPv4Address {
+		return
+	}
+	switch family {
+	case common.IPFamilyIPv6:
+		addr.Address = anyIPv6Address
+	case common.IPFamilyDualStack:
+		addr.Address = anyIPv6Address
+		addr.Ipv4Compat = true
+	}
+}
+
+// clusterDNSLookupFamily returns the address family the hosts of the cluster are resolved to. The IP literals are
+// resolved too, so the IPv4 network falls back to IPv6 if the cluster has IPv6 endpoints, and the IPv6 network
+// falls back to IPv4 for the local IPv4 endpoints, e.g. 127.0.0.1.
+func clusterDNSLookupFamily(cluster *envoycluster.Cluster, family common.IPFamily) envoycluster.Cluster_DnsLookupFamily {
+	switch family {
+	case common.IPFamilyIPv6:
+		return envoycluster.Cluster_AUTO
+	case common.IPFamilyDualStack:
+		return envoycluster.Cluster_V4_PREFERRED
+	}
+	if hasIPv6Endpoint(cluster) {
+		return envoycluster.Cluster_V4_PREFERRED
+	}
+	return envoycluster.Cluster_V4_ONLY
+}
+
+func hasIPv6Endpoint(cluster *envoycluster.Cluster) bool {
+	for _, locality := range cluster.GetLoadAssignment().GetEndpoints() {
+		for _, lbEndpoint := range locality.GetLbEndpoints() {
+			ip := net.ParseIP(lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress().GetAddress())
+			if ip != nil && ip.To4() == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"testing"
+
+	envoycluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/pkg/common"
+)
+
+func newSocketAddress(address string) *core.Address {
+	return &core.Address{
+		Address: &core.Address_SocketAddress{
+			SocketAddress: &core.SocketAddress{
+				Address:       address,
+				PortSpecifier: &core.SocketAddress_PortValue{PortValue: 1080},
+			},
+		},
+	}
+}
+
+func TestApplyListenerIPFamily(t *testing.T) {
+	tests := []struct {
+		address    string
+		family     common.IPFamily
+		want       string
+		ipv4Compat bool
+	}{
+		{"0.0.0.0", common.IPFamilyIPv4, "0.0.0.0", false},
+		{"0.0.0.0", common.IPFamilyIPv6, "::", false},
+		{"0.0.0.0", common.IPFamilyDualStack, "::", true},
+		{"127.0.0.1", common.IPFamilyIPv6, "127.0.0.1", false},
+	}
+	for _, tt := range tests {
+		lis := &listener.Listener{Address: newSocketAddress(tt.address)}
+		applyListenerIPFamily(lis, tt.family)
+		assert.Equal(t, tt.want, lis.Address.GetSocketAddress().Address)
+		assert.Equal(t, tt.ipv4Compat, lis.Address.GetSocketAddress().Ipv4Compat)
+	}
+}
+
+func TestClusterDNSLookupFamily(t *testing.T) {
+	newCluster := func(address string) *envoycluster.Cluster {
+		return &envoycluster.Cluster{
+			LoadAssignment: &endpoint.ClusterLoadAssignment{
+				Endpoints: []*endpoint.LocalityLbEndpoints{{
+					LbEndpoints: []*endpoint.LbEndpoint{{
+						HostIdentifier: &endpoint.LbEndpoint_Endpoint{
+							Endpoint: &endpoint.Endpoint{Address: newSocketAddress(address)},
+						},
+					}},
+				}},
+			},
+		}
+	}
+
+	assert.Equal(t, envoycluster.Cluster_V4_ONLY, clusterDNSLookupFamily(newCluster("alice.example.com"), common.IPFamilyIPv4))
+	assert.Equal(t, envoycluster.Cluster_V4_PREFERRED, clusterDNSLookupFamily(newCluster("fd00::1"), common.IPFamilyIPv4))
+	assert.Equal(t, envoycluster.Cluster_V4_PREFERRED, clusterDNSLookupFamily(newCluster("alice.example.com"), common.IPFamilyDualStack))
+	assert.Equal(t, envoycluster.Cluster_AUTO, clusterDNSLookupFamily(newCluster("127.0.0.1"), common.IPFamilyIPv6))
+}
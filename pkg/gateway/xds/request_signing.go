@@ -0,0 +1,132 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	extauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	// RequestSigningClusterName is the cluster of the local request signing server
+	RequestSigningClusterName = "kuscia-request-signing"
+	// RequestSigningContextKey is the context extension naming the virtual host the request is signed for
+	RequestSigningContextKey = "kuscia-signing-route"
+
+	requestSigningTimeout = time.Second
+	// requestSigningMaxBodyBytes is the size of the body buffered and signed, the larger bodies are signed by
+	// their leading bytes rather than rejected, so the large transfers still pass.
+	requestSigningMaxBodyBytes = 1 << 20
+)
+
+// routeSignerFilterName names the ext_authz filter signing the requests, it's only enabled on the virtual hosts
+// of the signed domain routes, which pass their names to the signing server.
+var routeSignerFilterName = ExtAuthzFilterName + "/sign"
+
+// UpdateRouteSigning sets whether the requests of the domain route virtual host are signed.
+// It takes effect on the next update of the virtual host.
+func UpdateRouteSigning(vhName string, enabled bool) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if routeSignings[vhName] == enabled {
+		return nil
+	}
+	if enabled {
+		nlog.Infof("Enable request signing of virtual host %s", vhName)
+		routeSignings[vhName] = true
+	} else {
+		nlog.Infof("Disable request signing of virtual host %s", vhName)
+		delete(routeSignings, vhName)
+	}
+	if len(routeSignings) == 0 {
+		delete(internalFilterMap, routeSignerFilterName)
+	} else {
+		internalFilterMap[routeSignerFilterName] = newRequestSigningFilter()
+	}
+	return updateHTTPFilters(internalFilterMap, InternalListener)
+}
+
+// UpdateSourceSigning sets whether the requests from the source are verified. The verifying filter checks all
+// the requests while any source is verified, and the signing server passes the requests of the other sources.
+func UpdateSourceSigning(source string, enabled bool) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if sourceSignings[source] == enabled {
+		return nil
+	}
+	if enabled {
+		nlog.Infof("Enable request signing verification of source %s", source)
+		sourceSignings[source] = true
+	} else {
+		nlog.Infof("Disable request signing verification of source %s", source)
+		delete(sourceSignings, source)
+	}
+	if len(sourceSignings) == 0 {
+		delete(externalFilterMap, ExtAuthzFilterName)
+	} else {
+		externalFilterMap[ExtAuthzFilterName] = newRequestSigningFilter()
+	}
+	return updateHTTPFilters(externalFilterMap, ExternalListener)
+}
+
+// newRequestSigningFilter rejects the requests if the signing server is unavailable, since they'd be rejected
+// by the destination without the signatures. The bodies are sent to the server to be signed with the requests.
+func newRequestSigningFilter() *extauthz.ExtAuthz {
+	return &extauthz.ExtAuthz{
+		Services: &extauthz.ExtAuthz_GrpcService{
+			GrpcService: &core.GrpcService{
+				TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &core.GrpcService_EnvoyGrpc{ClusterName: RequestSigningClusterName},
+				},
+				Timeout: durationpb.New(requestSigningTimeout),
+			},
+		},
+		WithRequestBody: &extauthz.BufferSettings{
+			MaxRequestBytes:     requestSigningMaxBodyBytes,
+			AllowPartialMessage: true,
+			PackAsBytes:         true,
+		},
+		TransportApiVersion: core.ApiVersion_V3,
+	}
+}
+
+// updateVhSigning enables the signing filter on the virtual host, which is disabled on the other ones.
+func updateVhSigning(vh *route.VirtualHost, enabled bool) {
+	if !enabled {
+		delete(vh.TypedPerFilterConfig, routeSignerFilterName)
+		return
+	}
+	if vh.TypedPerFilterConfig == nil {
+		vh.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	perRoute, _ := anypb.New(&extauthz.ExtAuthzPerRoute{
+		Override: &extauthz.ExtAuthzPerRoute_CheckSettings{
+			CheckSettings: &extauthz.CheckSettings{
+				ContextExtensions: map[string]string{RequestSigningContextKey: vh.Name},
+			},
+		},
+	})
+	filterConfig, _ := anypb.New(&route.FilterConfig{Config: perRoute})
+	vh.TypedPerFilterConfig[routeSignerFilterName] = filterConfig
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	extauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequestSigningFilter(t *testing.T) {
+	filter := newRequestSigningFilter()
+	assert.NoError(t, filter.Validate())
+	assert.Equal(t, RequestSigningClusterName, filter.GetGrpcService().GetEnvoyGrpc().ClusterName)
+	assert.False(t, filter.FailureModeAllow)
+	assert.True(t, filter.GetWithRequestBody().PackAsBytes)
+}
+
+func TestUpdateVhSigning(t *testing.T) {
+	vh := &route.VirtualHost{Name: "alice-to-bob"}
+	updateVhSigning(vh, true)
+	filterConfig := &route.FilterConfig{}
+	assert.NoError(t, vh.TypedPerFilterConfig[routeSignerFilterName].UnmarshalTo(filterConfig))
+	perRoute := &extauthz.ExtAuthzPerRoute{}
+	assert.NoError(t, filterConfig.Config.UnmarshalTo(perRoute))
+	assert.Equal(t, "alice-to-bob", perRoute.GetCheckSettings().ContextExtensions[RequestSigningContextKey])
+
+	updateVhSigning(vh, false)
+	assert.NotContains(t, vh.TypedPerFilterConfig, routeSignerFilterName)
+}
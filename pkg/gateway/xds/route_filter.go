@@ -0,0 +1,160 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	lua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	wasmfilter "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	wasm "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	RouteFilterTypeLua  = "Lua"
+	RouteFilterTypeWasm = "Wasm"
+
+	wasmRuntime = "envoy.wasm.runtime.v8"
+)
+
+// RouteFilterConfig is a user-provided filter of a domain route.
+type RouteFilterConfig struct {
+	Name    string
+	Version string
+	Type    string
+	// Code is the Lua source or the Wasm module.
+	Code []byte
+}
+
+// routeCustomFilterPrefix prefixes the custom filters of a domain route virtual host. The Lua and Wasm filters
+// share a filter type, and their instances are named by their indexes, so they're sorted in the configured order.
+func routeCustomFilterPrefix(vhName string) string {
+	return RouteCustomFilterName + "/" + vhName + "/"
+}
+
+func routeCustomFilterName(vhName string, index int, name string) string {
+	return fmt.Sprintf("%s%02d-%s", routeCustomFilterPrefix(vhName), index, name)
+}
+
+// UpdateRouteFilters sets the custom filters of the domain route virtual host, empty removes them.
+// It takes effect on the next update of the virtual host.
+func UpdateRouteFilters(vhName string, filters []*RouteFilterConfig) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if len(filters) == 0 {
+		filters = nil
+	}
+	if reflect.DeepEqual(routeFilters[vhName], filters) {
+		return nil
+	}
+	instances := map[string]protoreflect.ProtoMessage{}
+	for i, filter := range filters {
+		instance, err := newRouteCustomFilter(filter)
+		if err != nil {
+			return err
+		}
+		instances[routeCustomFilterName(vhName, i, filter.Name)] = instance
+	}
+
+	prefix := routeCustomFilterPrefix(vhName)
+	for name := range internalFilterMap {
+		if strings.HasPrefix(name, prefix) {
+			delete(internalFilterMap, name)
+		}
+	}
+	for name, instance := range instances {
+		internalFilterMap[name] = instance
+	}
+	if filters == nil {
+		nlog.Infof("Remove custom filters of virtual host %s", vhName)
+		delete(routeFilters, vhName)
+	} else {
+		nlog.Infof("Update custom filters of virtual host %s to %s", vhName, routeFilterVersions(filters))
+		routeFilters[vhName] = filters
+	}
+	return updateHTTPFilters(internalFilterMap, InternalListener)
+}
+
+func routeFilterVersions(filters []*RouteFilterConfig) string {
+	var versions []string
+	for _, filter := range filters {
+		versions = append(versions, filter.Name+"@"+filter.Version)
+	}
+	return strings.Join(versions, ",")
+}
+
+func newRouteCustomFilter(filter *RouteFilterConfig) (protoreflect.ProtoMessage, error) {
+	switch filter.Type {
+	case RouteFilterTypeLua:
+		return &lua.Lua{
+			DefaultSourceCode: &core.DataSource{
+				Specifier: &core.DataSource_InlineString{InlineString: string(filter.Code)},
+			},
+			StatPrefix: filter.Name,
+		}, nil
+	case RouteFilterTypeWasm:
+		// the vm id contains the version, so that the vms of the different versions aren't shared
+		id := filter.Name + "." + filter.Version
+		return &wasmfilter.Wasm{
+			Config: &wasm.PluginConfig{
+				Name: id,
+				Vm: &wasm.PluginConfig_VmConfig{
+					VmConfig: &wasm.VmConfig{
+						VmId:    id,
+						Runtime: wasmRuntime,
+						Code: &core.AsyncDataSource{
+							Specifier: &core.AsyncDataSource_Local{
+								Local: &core.DataSource{
+									Specifier: &core.DataSource_InlineBytes{InlineBytes: filter.Code},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %q of filter %s", filter.Type, filter.Name)
+	}
+}
+
+// updateVhCustomFilters enables the custom filters of the virtual host, which are disabled on the other ones.
+func updateVhCustomFilters(vh *route.VirtualHost, filters []*RouteFilterConfig) {
+	prefix := routeCustomFilterPrefix(vh.Name)
+	for name := range vh.TypedPerFilterConfig {
+		if strings.HasPrefix(name, prefix) {
+			delete(vh.TypedPerFilterConfig, name)
+		}
+	}
+	if len(filters) == 0 {
+		return
+	}
+	if vh.TypedPerFilterConfig == nil {
+		vh.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	for i, filter := range filters {
+		filterConfig, _ := anypb.New(&route.FilterConfig{})
+		vh.TypedPerFilterConfig[routeCustomFilterName(vh.Name, i, filter.Name)] = filterConfig
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRouteCustomFilter(t *testing.T) {
+	filter, err := newRouteCustomFilter(&RouteFilterConfig{
+		Name:    "stamp",
+		Version: "v1",
+		Type:    RouteFilterTypeLua,
+		Code:    []byte("function envoy_on_request(handle) end"),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, filter.(interface{ Validate() error }).Validate())
+
+	filter, err = newRouteCustomFilter(&RouteFilterConfig{
+		Name:    "sign",
+		Version: "1.0.2",
+		Type:    RouteFilterTypeWasm,
+		Code:    []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, filter.(interface{ Validate() error }).Validate())
+
+	_, err = newRouteCustomFilter(&RouteFilterConfig{Name: "js", Type: "Js"})
+	assert.Error(t, err)
+}
+
+func TestSortRouteCustomFilters(t *testing.T) {
+	filters := sortInternalFilters([]*hcm.HttpFilter{
+		{Name: RouterName},
+		{Name: routeCompressorFilterName("alice-to-bob")},
+		{Name: routeCustomFilterName("alice-to-bob", 1, "stamp")},
+		{Name: routeCustomFilterName("alice-to-bob", 0, "sign")},
+		{Name: KusciaGressName},
+	})
+	var names []string
+	for _, filter := range filters {
+		names = append(names, filter.Name)
+	}
+	assert.Equal(t, []string{
+		KusciaGressName,
+		"kuscia.filters.http.route_custom/alice-to-bob/00-sign",
+		"kuscia.filters.http.route_custom/alice-to-bob/01-stamp",
+		"envoy.filters.http.compressor/alice-to-bob",
+		RouterName,
+	}, names)
+}
+
+func TestUpdateVhCustomFilters(t *testing.T) {
+	vh := &route.VirtualHost{Name: "alice-to-bob"}
+	updateVhCompression(vh, true)
+	updateVhCustomFilters(vh, []*RouteFilterConfig{{Name: "sign"}, {Name: "stamp"}})
+	assert.Contains(t, vh.TypedPerFilterConfig, routeCustomFilterName(vh.Name, 0, "sign"))
+	assert.Contains(t, vh.TypedPerFilterConfig, routeCustomFilterName(vh.Name, 1, "stamp"))
+
+	updateVhCustomFilters(vh, []*RouteFilterConfig{{Name: "stamp"}})
+	assert.Len(t, vh.TypedPerFilterConfig, 2)
+	assert.Contains(t, vh.TypedPerFilterConfig, routeCustomFilterName(vh.Name, 0, "stamp"))
+
+	updateVhCustomFilters(vh, nil)
+	assert.Len(t, vh.TypedPerFilterConfig, 1)
+	assert.Contains(t, vh.TypedPerFilterConfig, routeCompressorFilterName(vh.Name))
+}
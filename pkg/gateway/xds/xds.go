@@ -54,7 +54,10 @@ import (
 
 	// envoy build-in plugins for Unmarshal listeners
 	_ "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	_ "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/decompressor/v3"
+	_ "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/decompressor/v3"
 	bandwidth_limitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/bandwidth_limit/v3"
+	_ "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
 	_ "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_http1_bridge/v3"
 	_ "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_http1_reverse_bridge/v3"
 	_ "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
@@ -65,6 +68,7 @@ import (
 	headerdecorator "github.com/secretflow/kuscia-envoy/kuscia/api/filters/http/kuscia_header_decorator/v3"
 	kusciatoken "github.com/secretflow/kuscia-envoy/kuscia/api/filters/http/kuscia_token_auth/v3"
 
+	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/gateway/utils"
 	"github.com/secretflow/kuscia/pkg/utils/meta"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
@@ -106,6 +110,10 @@ type InitConfig struct {
 
 	ExternalCert *TLSCert
 	InternalCert *TLSCert
+	// ExternalHTTP3 serves HTTP/3 besides the external listener if it isn't nil.
+	ExternalHTTP3 *HTTP3Config
+	// IPFamily is the IP family of the listeners and the upstream clusters, IPv4 if empty.
+	IPFamily common.IPFamily
 }
 
 type ConfigTemplate struct {
@@ -126,6 +134,11 @@ func NewXdsServer(port uint32, id string) {
 	snapshotCache = cache.NewSnapshotCache(false, cache.IDHash{}, nil)
 	nodeID = id
 	virtualHostLimits = map[string]map[string]*RouteLimitConfig{}
+	routeBandwidthLimits = map[string]int64{}
+	routeCompressions = map[string]*RouteCompressionConfig{}
+	routeFilters = map[string][]*RouteFilterConfig{}
+	routeSignings = map[string]bool{}
+	sourceSignings = map[string]bool{}
 
 	// Run the xDS server
 	ctx = context.Background()
@@ -190,9 +203,20 @@ func InitSnapshot(ns, instance string, initConfig *InitConfig) {
 		LogPrefix:    config.Logdir,
 		Version:      meta.KusciaVersionString(),
 	}
+	if config.ExternalHTTP3 != nil && !externalHTTP3Enabled(config) {
+		nlog.Warnf("HTTP/3 is disabled, since the external listener doesn't serve TLS or it requires client certs")
+	}
+	routes := generateRoutes(configTemplate, config.Basedir)
+	if externalHTTP3Enabled(config) {
+		for _, r := range routes {
+			if routeConfig := r.(*route.RouteConfiguration); routeConfig.Name == ExternalRoute {
+				addAltSvcHeader(routeConfig, config.ExternalHTTP3.advertisePort(config.ExternalPort))
+			}
+		}
+	}
 	snapshot, err = cache.NewSnapshot("1", map[resource.Type][]types.Resource{
 		resource.ClusterType:  generateClusters(config.Basedir),
-		resource.RouteType:    generateRoutes(configTemplate, config.Basedir),
+		resource.RouteType:    routes,
 		resource.ListenerType: generateListeners(configTemplate, config),
 	})
 	if err != nil {
@@ -251,9 +275,17 @@ func generateListeners(configTemplate ConfigTemplate, config *InitConfig) []type
 		if err := protojson.Unmarshal(data.Bytes(), &lis); err != nil {
 			nlog.Fatal(err)
 		}
+		applyListenerIPFamily(&lis, ipFamily())
 		if lis.Name == ExternalListener && config.ExternalCert != nil {
 			generateTLSListener(&lis, config.ExternalCert)
 		}
+		if lis.Name == ExternalListener && externalHTTP3Enabled(config) {
+			quicLis, err := generateQUICListener(&lis, config.ExternalCert)
+			if err != nil {
+				nlog.Fatalf("generate quic listener fail, detail: %v", err)
+			}
+			listeners = append(listeners, quicLis)
+		}
 		if lis.Name == InternalListener && config.InternalCert != nil {
 			tlsLis, err := copyTLSListener(&lis, config.InternalCert, InternalTLSPort)
 			if err != nil {
@@ -611,13 +643,7 @@ func updateVhLimitRoute(vh *route.VirtualHost, tasks map[string]*RouteLimitConfi
 				HeaderMatchSpecifier: &route.HeaderMatcher_PresentMatch{PresentMatch: false},
 			})
 		}
-		bandwidthConfig, _ := anypb.New(&bandwidth_limitv3.BandwidthLimit{
-			StatPrefix:   "kuscia_bandwidth_limit",
-			FillInterval: &durationpb.Duration{Nanos: 1e8}, // 0.1s
-			EnableMode:   bandwidth_limitv3.BandwidthLimit_REQUEST_AND_RESPONSE,
-			LimitKbps:    &wrapperspb.UInt64Value{Value: uint64(cfg.LimitKbps)},
-		})
-		r.TypedPerFilterConfig["envoy.filters.http.bandwidth_limit"] = bandwidthConfig
+		r.TypedPerFilterConfig[BandwidthLimitName] = NewBandwidthLimitConfig(cfg.LimitKbps)
 		for i, route := range vh.Routes {
 			if route.Name == task {
 				vh.Routes[i] = r
@@ -628,6 +654,34 @@ func updateVhLimitRoute(vh *route.VirtualHost, tasks map[string]*RouteLimitConfi
 	}
 }
 
+// updateVhBandwidthLimit sets the bandwidth limit shared by all the routes of the virtual host. The task limit
+// routes have their own config, which takes precedence over the virtual host one.
+func updateVhBandwidthLimit(vh *route.VirtualHost, limitKbps int64) {
+	if limitKbps <= 0 {
+		delete(vh.TypedPerFilterConfig, BandwidthLimitName)
+		return
+	}
+	if vh.TypedPerFilterConfig == nil {
+		vh.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	vh.TypedPerFilterConfig[BandwidthLimitName] = NewBandwidthLimitConfig(limitKbps)
+}
+
+// NewBandwidthLimitConfig returns the per route config of the bandwidth limit filter, the limit is disabled
+// if limitKbps isn't positive.
+func NewBandwidthLimitConfig(limitKbps int64) *anypb.Any {
+	limit := &bandwidth_limitv3.BandwidthLimit{
+		StatPrefix: "kuscia_bandwidth_limit",
+	}
+	if limitKbps > 0 {
+		limit.FillInterval = &durationpb.Duration{Nanos: 1e8} // 0.1s
+		limit.EnableMode = bandwidth_limitv3.BandwidthLimit_REQUEST_AND_RESPONSE
+		limit.LimitKbps = &wrapperspb.UInt64Value{Value: uint64(limitKbps)}
+	}
+	bandwidthConfig, _ := anypb.New(limit)
+	return bandwidthConfig
+}
+
 func AddOrUpdateVirtualHost(vh *route.VirtualHost, routeName string) error {
 	lock.Lock()
 	defer lock.Unlock()
@@ -655,6 +709,10 @@ func AddOrUpdateVirtualHost(vh *route.VirtualHost, routeName string) error {
 	// internal route only
 	if routeName == InternalRoute {
 		updateVhLimitRoute(vh, virtualHostLimits[vh.Name])
+		updateVhBandwidthLimit(vh, routeBandwidthLimits[vh.Name])
+		updateVhCompression(vh, routeCompressions[vh.Name] != nil)
+		updateVhCustomFilters(vh, routeFilters[vh.Name])
+		updateVhSigning(vh, routeSignings[vh.Name])
 	}
 
 	for i := range routeConfig.VirtualHosts {
@@ -710,6 +768,10 @@ func UpdateVirtualHostByName(vhName string, routeName string) error {
 	// internal route only
 	if routeName == InternalRoute {
 		updateVhLimitRoute(vh, virtualHostLimits[vh.Name])
+		updateVhBandwidthLimit(vh, routeBandwidthLimits[vh.Name])
+		updateVhCompression(vh, routeCompressions[vh.Name] != nil)
+		updateVhCustomFilters(vh, routeFilters[vh.Name])
+		updateVhSigning(vh, routeSignings[vh.Name])
 	}
 
 	for i := range routeConfig.VirtualHosts {
@@ -899,6 +961,82 @@ func GetTokenAuth() (*kusciatoken.TokenAuth, error) {
 	return &tokenAuthFilter, nil
 }
 
+// QueryListenerCert returns the downstream cert of the listener, nil if the listener doesn't serve TLS.
+func QueryListenerCert(listenerName string) (*TLSCert, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	if config == nil {
+		return nil, fmt.Errorf("xds isn't initialized")
+	}
+	switch listenerName {
+	case ExternalListener:
+		return config.ExternalCert, nil
+	case InternalListener:
+		return config.InternalCert, nil
+	default:
+		return nil, fmt.Errorf("invalid listener: %s", listenerName)
+	}
+}
+
+// UpdateListenerCert replaces the downstream cert of the listener, the cert of internal listener is served by
+// its tls copy. Envoy drains the connections of the old listener, so the established connections are kept.
+func UpdateListenerCert(listenerName string, cert *TLSCert) error {
+	lock.Lock()
+	defer lock.Unlock()
+	if config == nil {
+		return fmt.Errorf("xds isn't initialized")
+	}
+
+	tlsListenerName := listenerName
+	switch listenerName {
+	case ExternalListener:
+	case InternalListener:
+		tlsListenerName = fmt.Sprintf("%s-tls", listenerName)
+	default:
+		return fmt.Errorf("invalid listener: %s", listenerName)
+	}
+	transportSocket, err := GenerateDownstreamTLSConfigByCert(cert)
+	if err != nil {
+		return err
+	}
+
+	listeners := snapshot.Resources[types.Listener].Items
+	if _, ok := listeners[tlsListenerName]; !ok {
+		return fmt.Errorf("unknown listener name: %s", tlsListenerName)
+	}
+	var quicTransportSocket *core.TransportSocket
+	if _, ok := listeners[ExternalQUICListener]; ok && listenerName == ExternalListener {
+		if quicTransportSocket, err = generateQUICDownstreamTransport(cert); err != nil {
+			return err
+		}
+	}
+	items := make(map[string]types.ResourceWithTTL)
+	for k, v := range listeners {
+		switch {
+		case k == tlsListenerName:
+			lis := proto.Clone(v.Resource).(*listener.Listener)
+			lis.FilterChains[0].TransportSocket = transportSocket
+			items[k] = types.ResourceWithTTL{Resource: lis}
+		case k == ExternalQUICListener && quicTransportSocket != nil:
+			lis := proto.Clone(v.Resource).(*listener.Listener)
+			lis.FilterChains[0].TransportSocket = quicTransportSocket
+			items[k] = types.ResourceWithTTL{Resource: lis}
+		default:
+			items[k] = v
+		}
+	}
+	if err := resetSnapshot(types.Listener, items); err != nil {
+		return err
+	}
+
+	if listenerName == ExternalListener {
+		config.ExternalCert = cert
+	} else {
+		config.InternalCert = cert
+	}
+	return nil
+}
+
 func updateHTTPFilters(filterMap map[string]protoreflect.ProtoMessage, listenerName string) error {
 	listeners := snapshot.Resources[types.Listener].Items
 
@@ -930,7 +1068,7 @@ func updateHTTPFilters(filterMap map[string]protoreflect.ProtoMessage, listenerN
 
 	var filters []*hcm.HttpFilter
 	for _, filter := range httpManager.HttpFilters {
-		if _, ok := mutableFilters[filter.Name]; !ok {
+		if _, ok := mutableFilters[filterType(filter.Name)]; !ok {
 			filters = append(filters, filter)
 		}
 	}
@@ -939,6 +1077,8 @@ func updateHTTPFilters(filterMap map[string]protoreflect.ProtoMessage, listenerN
 		filters = append(filters, &hcm.HttpFilter{
 			Name:       name,
 			ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: typedConfig},
+			// the instances for the domain routes are enabled by their virtual hosts
+			Disabled: filterType(name) != name,
 		})
 	}
 
@@ -972,6 +1112,14 @@ func updateHTTPFilters(filterMap map[string]protoreflect.ProtoMessage, listenerN
 		listeners[tlsLis.Name] = types.ResourceWithTTL{Resource: tlsLis}
 	}
 
+	if _, ok := items[ExternalQUICListener]; ok && lis.Name == ExternalListener {
+		quicLis, err := generateQUICListener(lis, config.ExternalCert)
+		if err != nil {
+			return err
+		}
+		items[quicLis.Name] = types.ResourceWithTTL{Resource: quicLis}
+	}
+
 	if err = resetSnapshot(types.Listener, items); err != nil {
 		return err
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/secretflow/kuscia/pkg/interconn/bfia/handler"
+	"github.com/secretflow/kuscia/pkg/interconn/bfia/validation"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/web/api"
 	"github.com/secretflow/kuscia/pkg/web/decorator"
@@ -36,8 +37,33 @@ const (
 	defaultListenPort       = 8084
 	defaultReadWriteTimeout = 30
 	defaultIdleTimeout      = 30
+
+	defaultQuarantineCapacity = 1000
 )
 
+// defaultSchemas are the fields the inbound interconn messages must carry, the ones without them used to
+// fail deep in the controllers.
+var defaultSchemas = map[string]validation.RequiredFields{
+	"/v1/interconn/schedule/job/create": {
+		"job_id",
+		"dag",
+		"dag.components",
+		"dag.components.code",
+		"dag.components.name",
+		"dag.components.module_name",
+		"config",
+		"config.role",
+		"config.initiator",
+		"config.initiator.role",
+		"config.initiator.node_id",
+	},
+	"/v1/interconn/schedule/job/stop":   {"job_id"},
+	"/v1/interconn/schedule/job/start":  {"job_id"},
+	"/v1/interconn/schedule/task/start": {"task_id", "job_id", "task_name"},
+	"/v1/interconn/schedule/task/stop":  {"task_id"},
+	"/v1/interconn/schedule/task/poll":  {"task_id", "role"},
+}
+
 // httpServerBean defines the http server bean.
 type httpServerBean struct {
 	*handler.ResourcesManager
@@ -48,16 +74,22 @@ type httpServerBean struct {
 	IdleTimeout  int
 
 	HTTPEngine *gin.Engine
+	Guard      *validation.Guard
 }
 
 // NewHTTPServerBean returns a http server bean.
 func NewHTTPServerBean(rm *handler.ResourcesManager) *httpServerBean { // nolint: golint
+	guard := validation.NewGuard(defaultQuarantineCapacity)
+	for path, schema := range defaultSchemas {
+		guard.Register(path, schema)
+	}
 	return &httpServerBean{
 		ResourcesManager: rm,
 		ListenPort:       defaultListenPort,
 		ReadTimeout:      defaultReadWriteTimeout,
 		WriteTimeout:     defaultReadWriteTimeout,
 		IdleTimeout:      defaultIdleTimeout,
+		Guard:            guard,
 	}
 }
 
@@ -96,6 +128,7 @@ func (b *httpServerBean) Init(e framework.ConfBeanRegistry) error {
 	httpEngine := gin.New()
 	httpEngine.Use(gin.Recovery())
 	b.HTTPEngine = httpEngine
+	b.Guard.SetReplayHandler(httpEngine)
 	for _, groupRouters := range b.buildGroupRouters(eg) {
 		b.registerGroupRoutes(groupRouters)
 	}
@@ -140,22 +173,22 @@ func (b *httpServerBean) buildGroupRouters(engine *engine.Engine) router.GroupsR
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(engine, handler.NewCreateJobHandler(b.ResourcesManager))},
+					Handlers:     b.guardedHandlers(engine, handler.NewCreateJobHandler(b.ResourcesManager)),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "stop",
-					Handlers:     []gin.HandlerFunc{protoDecorator(engine, handler.NewStopJobHandler(b.ResourcesManager))},
+					Handlers:     b.guardedHandlers(engine, handler.NewStopJobHandler(b.ResourcesManager)),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "start",
-					Handlers:     []gin.HandlerFunc{protoDecorator(engine, handler.NewStartJobHandler(b.ResourcesManager))},
+					Handlers:     b.guardedHandlers(engine, handler.NewStartJobHandler(b.ResourcesManager)),
 				},
 				{
 					HTTPMethod:   http.MethodGet,
 					RelativePath: "status_all",
-					Handlers:     []gin.HandlerFunc{protoDecorator(engine, handler.NewQueryJobStatusAllHandler(b.ResourcesManager))},
+					Handlers:     b.guardedHandlers(engine, handler.NewQueryJobStatusAllHandler(b.ResourcesManager)),
 				},
 			},
 		},
@@ -166,23 +199,30 @@ func (b *httpServerBean) buildGroupRouters(engine *engine.Engine) router.GroupsR
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "start",
-					Handlers:     []gin.HandlerFunc{protoDecorator(engine, handler.NewStartTaskHandler(b.ResourcesManager))},
+					Handlers:     b.guardedHandlers(engine, handler.NewStartTaskHandler(b.ResourcesManager)),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "stop",
-					Handlers:     []gin.HandlerFunc{protoDecorator(engine, handler.NewStopTaskHandler(b.ResourcesManager))},
+					Handlers:     b.guardedHandlers(engine, handler.NewStopTaskHandler(b.ResourcesManager)),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "poll",
-					Handlers:     []gin.HandlerFunc{protoDecorator(engine, handler.NewPollTaskStatusHandler(b.ResourcesManager))},
+					Handlers:     b.guardedHandlers(engine, handler.NewPollTaskStatusHandler(b.ResourcesManager)),
 				},
 			},
 		},
+		b.Guard.AdminRouters(),
 	}
 }
 
+// guardedHandlers validates the payload against the schema of the route before handling it.
+func (b *httpServerBean) guardedHandlers(engine *engine.Engine, handler api.ProtoHandler) []gin.HandlerFunc {
+	reqType, _ := handler.GetType()
+	return []gin.HandlerFunc{b.Guard.Middleware(reqType), protoDecorator(engine, handler)}
+}
+
 // protoDecorator is used to decorate handler.
 func protoDecorator(engine *engine.Engine, handler api.ProtoHandler) gin.HandlerFunc {
 	return decorator.InterConnProtoDecoratorMaker(http.StatusBadRequest, http.StatusBadRequest)(engine, handler)
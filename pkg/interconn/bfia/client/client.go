@@ -17,15 +17,21 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/tls"
 	"github.com/secretflow/kuscia/proto/api/v1/interconn"
 )
 
@@ -59,11 +65,18 @@ const (
 // Client defines a client which is used to access to kuscia storage service.
 type Client struct {
 	httpClient *http.Client
+	signKey    *rsa.PrivateKey
 }
 
 // New is used to create a client instance.
 func New() *Client {
+	return NewWithSignKey(nil)
+}
+
+// NewWithSignKey creates a client signing the pushed requests with the key, the requests aren't signed if the key is nil.
+func NewWithSignKey(signKey *rsa.PrivateKey) *Client {
 	return &Client{
+		signKey: signKey,
 		httpClient: &http.Client{
 			Transport: &http.Transport{
 				DialContext: (&net.Dialer{
@@ -171,18 +184,58 @@ func (c *Client) PollTaskStatus(ctx context.Context, requesterID, host, taskID,
 	return c.do(ctx, requesterID, host, http.MethodPost, fmt.Sprintf("%s%s%s", httpPrefix, host, pollTaskStatusAPI), body)
 }
 
+// PushJobStatus pushes the job status to the callback url of other party, the url is relative to the host if it's a
+// path. The request is signed by the sign key of the client.
+func (c *Client) PushJobStatus(ctx context.Context, requesterID, host, callbackURL string, status *interconn.JobStatusCallbackRequest) error {
+	body, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(callbackURL, "/") {
+		callbackURL = fmt.Sprintf("%s%s%s", httpPrefix, host, callbackURL)
+	} else {
+		u, err := url.Parse(callbackURL)
+		if err != nil {
+			return fmt.Errorf("invalid callback url %q, %v", callbackURL, err)
+		}
+		host = u.Host
+	}
+	_, err = c.doWithSign(ctx, requesterID, host, http.MethodPost, callbackURL, body, true)
+	return err
+}
+
+// SignContent returns the content signed for the request, it's the timestamp, nonce and body joined with '\n'.
+func SignContent(timestamp, nonce string, body []byte) string {
+	return timestamp + "\n" + nonce + "\n" + string(body)
+}
+
 func (c *Client) do(ctx context.Context, requesterID, host, method, url string, body []byte) (*interconn.CommonResponse, error) {
+	return c.doWithSign(ctx, requesterID, host, method, url, body, false)
+}
+
+func (c *Client) doWithSign(ctx context.Context, requesterID, host, method, url string, body []byte, sign bool) (*interconn.CommonResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 
 	nlog.Infof("bfia client send request path: %v, body: %v", req.URL.RequestURI(), string(body))
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
 	req.Header.Set(nodeIDHeader, requesterID)
-	req.Header.Set(timestampHeader, strconv.FormatInt(time.Now().Unix(), 10))
+	req.Header.Set(timestampHeader, timestamp)
 	if method == http.MethodPost {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	if sign && c.signKey != nil {
+		nonce := uuid.NewString()
+		signature, err := tls.SignWithRSA(c.signKey, SignContent(timestamp, nonce, body))
+		if err != nil {
+			return nil, fmt.Errorf("sign request failed, %v", err)
+		}
+		req.Header.Set(nonceHeader, nonce)
+		req.Header.Set(authSignHeader, signature)
+	}
 	req.Host = host
 	var resp *http.Response
 	for i := 0; ; i++ {
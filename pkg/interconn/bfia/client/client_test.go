@@ -0,0 +1,64 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/proto/api/v1/interconn"
+)
+
+func TestPushJobStatus(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	var gotHost, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHost, gotPath = r.Host, r.URL.Path
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", r.Header.Get(nodeIDHeader))
+
+		signature, err := base64.StdEncoding.DecodeString(r.Header.Get(authSignHeader))
+		assert.NoError(t, err)
+		digest := sha256.Sum256([]byte(SignContent(r.Header.Get(timestampHeader), r.Header.Get(nonceHeader), body)))
+		assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+		_, _ = w.Write([]byte(`{"code":200}`))
+	}))
+	defer server.Close()
+
+	status := &interconn.JobStatusCallbackRequest{JobId: "job-1", Status: "RUNNING", EventId: "1"}
+	c := NewWithSignKey(key)
+	assert.NoError(t, c.PushJobStatus(context.Background(), "alice", "interconn-scheduler.bob.svc", server.URL+"/callback", status))
+	assert.Equal(t, strings.TrimPrefix(server.URL, "http://"), gotHost)
+	assert.Equal(t, "/callback", gotPath)
+
+	// the path is sent to the host
+	host := strings.TrimPrefix(server.URL, "http://")
+	assert.NoError(t, c.PushJobStatus(context.Background(), "alice", host, "/v1/interconn/schedule/job/callback", status))
+	assert.Equal(t, "/v1/interconn/schedule/job/callback", gotPath)
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	bfiacommon "github.com/secretflow/kuscia/pkg/interconn/bfia/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/queue"
+	utilsres "github.com/secretflow/kuscia/pkg/utils/resources"
+	"github.com/secretflow/kuscia/pkg/web/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1/interconn"
+)
+
+// handleJobStatusChanged enqueues the job to push its status to the partners if the job or task phase changed.
+func (c *Controller) handleJobStatusChanged(oldKj, newKj *kusciaapisv1alpha1.KusciaJob) {
+	if oldKj.Status.Phase == newKj.Status.Phase && reflect.DeepEqual(oldKj.Status.TaskStatus, newKj.Status.TaskStatus) {
+		return
+	}
+
+	if !utilsres.SelfClusterAsInitiator(c.nsLister, newKj.Spec.Initiator, newKj.Annotations) {
+		return
+	}
+
+	queue.EnqueueObjectWithKey(newKj, c.kjCallbackQueue)
+}
+
+// runJobCallbackWorker is a long-running function that will read and process a event on the callback queue.
+func (c *Controller) runJobCallbackWorker(ctx context.Context) {
+	for queue.HandleQueueItem(ctx, kusciaJobCallbackQueueName, c.kjCallbackQueue, c.syncJobCallbackHandler, maxRetries) {
+	}
+}
+
+// syncJobCallbackHandler pushes the job status to the partners having callback urls. The status already pushed to
+// a partner is skipped, so only the failed partners are pushed again when retrying.
+func (c *Controller) syncJobCallbackHandler(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		nlog.Errorf("Failed to split job key %v, %v, skip processing it", key, err)
+		return nil
+	}
+
+	kj, err := c.kjLister.KusciaJobs(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			nlog.Infof("Kuscia job %v maybe deleted, skip pushing its status", key)
+			return nil
+		}
+		return err
+	}
+
+	if len(kj.Spec.Tasks) == 0 {
+		return nil
+	}
+
+	status := buildJobStatusCallback(kj)
+	var errs errorcode.Errs
+	for domainID := range c.getPartiesDomainInfo(kj) {
+		callbackURL := c.getCallbackURL(domainID)
+		if callbackURL == "" {
+			continue
+		}
+
+		cacheKey := pushedStatusCacheKey(key, domainID)
+		if pushed, ok := c.pushedStatusCache.Get(cacheKey); ok && pushed.(string) == status.EventId {
+			continue
+		}
+
+		if err := c.bfiaClient.PushJobStatus(ctx, kj.Spec.Initiator, buildHostFor(domainID), callbackURL, status); err != nil {
+			errs.AppendErr(fmt.Errorf("push job %v status to party %v failed, %v", kj.Name, domainID, err))
+			continue
+		}
+		nlog.Infof("Pushed job %v status %v to party %v", kj.Name, status.Status, domainID)
+		c.pushedStatusCache.SetDefault(cacheKey, status.EventId)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", errs.String())
+	}
+	return nil
+}
+
+// getCallbackURL returns the callback url of the partner, it's empty if the partner doesn't accept the pushed status.
+func (c *Controller) getCallbackURL(domainID string) string {
+	domain, err := c.domainLister.Get(domainID)
+	if err != nil {
+		return ""
+	}
+	return domain.Annotations[common.InterConnBFIACallbackURLAnnotationKey]
+}
+
+// buildJobStatusCallback builds the status pushed to the partners, the event id is the digest of the status so the
+// retries of the same status carry the same id.
+func buildJobStatusCallback(kj *kusciaapisv1alpha1.KusciaJob) *interconn.JobStatusCallbackRequest {
+	req := &interconn.JobStatusCallbackRequest{
+		JobId:      kj.Name,
+		Status:     bfiacommon.KusciaJobPhaseToInterConJobPhase[kj.Status.Phase],
+		TaskStatus: make(map[string]string, len(kj.Status.TaskStatus)),
+	}
+	if req.Status == "" {
+		req.Status = bfiacommon.InterConnPending
+	}
+
+	taskIDs := make([]string, 0, len(kj.Status.TaskStatus))
+	for taskID, phase := range kj.Status.TaskStatus {
+		req.TaskStatus[taskID] = bfiacommon.KusciaTaskPhaseToInterConnTaskPhase[phase]
+		taskIDs = append(taskIDs, taskID)
+	}
+	sort.Strings(taskIDs)
+
+	h := sha256.New()
+	h.Write([]byte(req.JobId + "\n" + req.Status))
+	for _, taskID := range taskIDs {
+		h.Write([]byte("\n" + taskID + "=" + req.TaskStatus[taskID]))
+	}
+	req.EventId = hex.EncodeToString(h.Sum(nil))
+	return req
+}
+
+func pushedStatusCacheKey(jobKey, domainID string) string {
+	return jobKey + "/" + domainID
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gochache "github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	clientsetfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientsetfake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	"github.com/secretflow/kuscia/pkg/interconn/bfia/client"
+	pkgcommon "github.com/secretflow/kuscia/pkg/interconn/bfia/common"
+	"github.com/secretflow/kuscia/proto/api/v1/interconn"
+)
+
+func TestBuildJobStatusCallback(t *testing.T) {
+	kj := makeKusciaJob("job-1", nil, nil)
+	kj.Status.Phase = kusciaapisv1alpha1.KusciaJobRunning
+	kj.Status.TaskStatus = map[string]kusciaapisv1alpha1.KusciaTaskPhase{
+		"task-1": kusciaapisv1alpha1.TaskSucceeded,
+		"task-2": kusciaapisv1alpha1.TaskRunning,
+	}
+
+	got := buildJobStatusCallback(kj)
+	assert.Equal(t, "job-1", got.JobId)
+	assert.Equal(t, pkgcommon.InterConnRunning, got.Status)
+	assert.Equal(t, map[string]string{"task-1": pkgcommon.InterConnSuccess, "task-2": pkgcommon.InterConnRunning}, got.TaskStatus)
+	assert.Equal(t, got.EventId, buildJobStatusCallback(kj.DeepCopy()).EventId)
+
+	kj.Status.TaskStatus["task-2"] = kusciaapisv1alpha1.TaskSucceeded
+	assert.NotEqual(t, got.EventId, buildJobStatusCallback(kj).EventId)
+}
+
+func TestSyncJobCallbackHandler(t *testing.T) {
+	var pushed []*interconn.JobStatusCallbackRequest
+	fail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		req := &interconn.JobStatusCallbackRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		pushed = append(pushed, req)
+		_, _ = w.Write([]byte(`{"code":200}`))
+	}))
+	defer server.Close()
+
+	nsBob := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "bob",
+			Labels: map[string]string{
+				common.LabelDomainRole:         string(kusciaapisv1alpha1.Partner),
+				common.LabelInterConnProtocols: string(kusciaapisv1alpha1.InterConnBFIA)},
+		},
+	}
+	domainBob := &kusciaapisv1alpha1.Domain{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "bob",
+			Annotations: map[string]string{common.InterConnBFIACallbackURLAnnotationKey: server.URL + "/callback"},
+		},
+	}
+	kj := makeKusciaJob("job-1", nil, nil)
+	kj.Namespace = "cross-domain"
+	kj.Status.Phase = kusciaapisv1alpha1.KusciaJobRunning
+
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(clientsetfake.NewSimpleClientset(), 0)
+	nsInformer := kubeInformerFactory.Core().V1().Namespaces()
+	nsInformer.Informer().GetStore().Add(nsBob)
+	kusciaInformerFactory := kusciainformers.NewSharedInformerFactory(kusciaclientsetfake.NewSimpleClientset(), 0)
+	kjInformer := kusciaInformerFactory.Kuscia().V1alpha1().KusciaJobs()
+	kjInformer.Informer().GetStore().Add(kj)
+	domainInformer := kusciaInformerFactory.Kuscia().V1alpha1().Domains()
+	domainInformer.Informer().GetStore().Add(domainBob)
+
+	c := &Controller{
+		nsLister:          nsInformer.Lister(),
+		kjLister:          kjInformer.Lister(),
+		domainLister:      domainInformer.Lister(),
+		bfiaClient:        client.New(),
+		pushedStatusCache: gochache.New(pushedStatusCacheExpiration, pushedStatusCacheExpiration),
+	}
+
+	key := "cross-domain/job-1"
+	assert.Error(t, c.syncJobCallbackHandler(context.Background(), key))
+	assert.Empty(t, pushed)
+
+	fail = false
+	assert.NoError(t, c.syncJobCallbackHandler(context.Background(), key))
+	assert.Equal(t, 1, len(pushed))
+	assert.Equal(t, pkgcommon.InterConnRunning, pushed[0].Status)
+
+	// the same status isn't pushed again
+	assert.NoError(t, c.syncJobCallbackHandler(context.Background(), key))
+	assert.Equal(t, 1, len(pushed))
+
+	finished := kj.DeepCopy()
+	finished.Status.Phase = kusciaapisv1alpha1.KusciaJobSucceeded
+	kjInformer.Informer().GetStore().Update(finished)
+	assert.NoError(t, c.syncJobCallbackHandler(context.Background(), key))
+	assert.Equal(t, 2, len(pushed))
+	assert.Equal(t, pkgcommon.InterConnSuccess, pushed[1].Status)
+	assert.NotEqual(t, pushed[0].EventId, pushed[1].EventId)
+}
@@ -16,6 +16,7 @@ package controller
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
 	"reflect"
 	"time"
@@ -48,6 +49,7 @@ const (
 	taskResourceQueueName         = "interconn-bfia-taskresource-queue"
 	kusciaTaskStatusSyncQueueName = "interconn-bfia-kusciatask-status-sync-queue"
 	kusciaJobStatusSyncQueueName  = "interconn-bfia-kusciajob-status-sync-queue"
+	kusciaJobCallbackQueueName    = "interconn-bfia-kusciajob-callback-queue"
 )
 
 const (
@@ -57,6 +59,7 @@ const (
 	taskStatusSyncInterval                 = 5 * time.Second
 	jobStatusSyncInterval                  = 15 * time.Second
 	statusUpdateRetries                    = 5
+	pushedStatusCacheExpiration            = 24 * time.Hour
 )
 
 const (
@@ -101,19 +104,36 @@ type Controller struct {
 	appImageSynced        cache.InformerSynced
 	trLister              kuscialistersv1alpha1.TaskResourceLister
 	trSynced              cache.InformerSynced
+	domainLister          kuscialistersv1alpha1.DomainLister
+	domainSynced          cache.InformerSynced
 	ktStatusSyncQueue     workqueue.DelayingInterface
 	kjStatusSyncQueue     workqueue.DelayingInterface
 	trQueue               workqueue.RateLimitingInterface
 	kjQueue               workqueue.RateLimitingInterface
 	ktQueue               workqueue.RateLimitingInterface
+	kjCallbackQueue       workqueue.RateLimitingInterface
 	recorder              record.EventRecorder
 
 	bfiaClient           *client.Client
 	inflightRequestCache *gochache.Cache
+	// pushedStatusCache records the event id of the job status pushed to each party.
+	pushedStatusCache *gochache.Cache
 }
 
 // NewController returns a controller instance.
 func NewController(ctx context.Context, kubeClient kubernetes.Interface, kusciaClient kusciaclientset.Interface, eventRecorder record.EventRecorder) iccommon.IController {
+	return newController(ctx, kubeClient, kusciaClient, eventRecorder, nil)
+}
+
+// NewControllerWithSignKey returns the function creating controllers which sign the job status pushed to the
+// partners with the key.
+func NewControllerWithSignKey(signKey *rsa.PrivateKey) iccommon.NewControllerFunc {
+	return func(ctx context.Context, kubeClient kubernetes.Interface, kusciaClient kusciaclientset.Interface, eventRecorder record.EventRecorder) iccommon.IController {
+		return newController(ctx, kubeClient, kusciaClient, eventRecorder, signKey)
+	}
+}
+
+func newController(ctx context.Context, kubeClient kubernetes.Interface, kusciaClient kusciaclientset.Interface, eventRecorder record.EventRecorder, signKey *rsa.PrivateKey) *Controller {
 	kubeInformerFactory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 5*time.Minute)
 	nsInformer := kubeInformerFactory.Core().V1().Namespaces()
 
@@ -122,6 +142,7 @@ func NewController(ctx context.Context, kubeClient kubernetes.Interface, kusciaC
 	ktInformer := kusciaInformerFactory.Kuscia().V1alpha1().KusciaTasks()
 	appImageInformer := kusciaInformerFactory.Kuscia().V1alpha1().AppImages()
 	trInformer := kusciaInformerFactory.Kuscia().V1alpha1().TaskResources()
+	domainInformer := kusciaInformerFactory.Kuscia().V1alpha1().Domains()
 
 	controller := &Controller{
 		kubeClient:            kubeClient,
@@ -138,14 +159,18 @@ func NewController(ctx context.Context, kubeClient kubernetes.Interface, kusciaC
 		appImageSynced:        appImageInformer.Informer().HasSynced,
 		trLister:              trInformer.Lister(),
 		trSynced:              trInformer.Informer().HasSynced,
+		domainLister:          domainInformer.Lister(),
+		domainSynced:          domainInformer.Informer().HasSynced,
 		kjQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), kusciaJobQueueName),
 		ktQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), kusciaTaskQueueName),
 		trQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), taskResourceQueueName),
 		ktStatusSyncQueue:     workqueue.NewNamedDelayingQueue(kusciaTaskStatusSyncQueueName),
 		kjStatusSyncQueue:     workqueue.NewNamedDelayingQueue(kusciaJobStatusSyncQueueName),
+		kjCallbackQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), kusciaJobCallbackQueueName),
 		recorder:              eventRecorder,
-		bfiaClient:            client.New(),
+		bfiaClient:            client.NewWithSignKey(signKey),
 		inflightRequestCache:  gochache.New(inflightRequestCacheExpiration, inflightRequestCacheExpiration),
+		pushedStatusCache:     gochache.New(pushedStatusCacheExpiration, pushedStatusCacheExpiration),
 	}
 
 	controller.ctx, controller.cancel = context.WithCancel(ctx)
@@ -257,6 +282,7 @@ func (c *Controller) Run(workers int) error {
 		c.ktStatusSyncQueue.ShutDown()
 		c.kjStatusSyncQueue.ShutDown()
 		c.trQueue.ShutDown()
+		c.kjCallbackQueue.ShutDown()
 	}()
 
 	nlog.Infof("Starting %v", c.Name())
@@ -264,7 +290,7 @@ func (c *Controller) Run(workers int) error {
 	c.kusciaInformerFactory.Start(c.ctx.Done())
 
 	nlog.Infof("Waiting for informer cache to sync for %v", c.Name())
-	if ok := cache.WaitForCacheSync(c.ctx.Done(), c.nsSynced, c.kjSynced, c.ktSynced, c.appImageSynced, c.trSynced); !ok {
+	if ok := cache.WaitForCacheSync(c.ctx.Done(), c.nsSynced, c.kjSynced, c.ktSynced, c.appImageSynced, c.trSynced, c.domainSynced); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
 
@@ -275,6 +301,7 @@ func (c *Controller) Run(workers int) error {
 		go wait.UntilWithContext(c.ctx, c.runJobStatusSyncWorker, time.Second)
 		go wait.UntilWithContext(c.ctx, c.runTaskStatusSyncWorker, time.Second)
 		go wait.UntilWithContext(c.ctx, c.runTaskWorker, time.Second)
+		go wait.UntilWithContext(c.ctx, c.runJobCallbackWorker, time.Second)
 	}
 
 	<-c.ctx.Done()
@@ -69,6 +69,7 @@ func (c *Controller) handleUpdatedKusciaJob(oldObj, newObj interface{}) {
 	}
 
 	queue.EnqueueObjectWithKey(newKj, c.kjQueue)
+	c.handleJobStatusChanged(oldKj, newKj)
 }
 
 // runJobWorker is a long-running function that will read and process a event on the work queue.
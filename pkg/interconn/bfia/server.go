@@ -16,8 +16,10 @@ package bfia
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
 
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	"github.com/secretflow/kuscia/pkg/interconn/bfia/bean"
 	bfiacommon "github.com/secretflow/kuscia/pkg/interconn/bfia/common"
 	"github.com/secretflow/kuscia/pkg/interconn/bfia/controller"
@@ -28,6 +30,12 @@ import (
 	"github.com/secretflow/kuscia/pkg/web/framework/engine"
 )
 
+func init() {
+	common.RegisterAdapter(kusciaapisv1alpha1.InterConnBFIA, func(ctx context.Context, conf *common.AdapterConfig) (common.Adapter, error) {
+		return NewServer(ctx, conf.Clients, conf.DomainKey)
+	})
+}
+
 // Server implements the inter connection with bfia protocol.
 type Server struct {
 	NewController   common.NewControllerFunc
@@ -36,7 +44,7 @@ type Server struct {
 }
 
 // NewServer returns a server instance.
-func NewServer(ctx context.Context, clients *kubeconfig.KubeClients) (*Server, error) {
+func NewServer(ctx context.Context, clients *kubeconfig.KubeClients, domainKey *rsa.PrivateKey) (*Server, error) {
 	rm, err := handler.NewResourcesManager(ctx, clients.KusciaClient)
 	if err != nil {
 		return nil, err
@@ -52,13 +60,21 @@ func NewServer(ctx context.Context, clients *kubeconfig.KubeClients) (*Server, e
 	}
 
 	s := &Server{
-		NewController:   controller.NewController,
+		NewController:   controller.NewControllerWithSignKey(domainKey),
 		ResourceManager: rm,
 		APPEngine:       appEngine,
 	}
 	return s, nil
 }
 
+func (s *Server) Protocol() kusciaapisv1alpha1.InterConnProtocolType {
+	return kusciaapisv1alpha1.InterConnBFIA
+}
+
+func (s *Server) ControllerConstruction() common.ControllerConstruction {
+	return common.ControllerConstruction{NewControler: s.NewController}
+}
+
 // Run runs the bfia server.
 func (s *Server) Run(ctx context.Context) error {
 	go s.ResourceManager.Run(ctx)
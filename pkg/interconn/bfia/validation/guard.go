@@ -0,0 +1,259 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/decorator"
+	"github.com/secretflow/kuscia/pkg/web/decorator/binder"
+	"github.com/secretflow/kuscia/pkg/web/framework/router"
+	"github.com/secretflow/kuscia/proto/api/v1/interconn"
+)
+
+// Guard validates the payloads of the inbound interconn messages before they reach the handlers. The messages
+// violating the schema are rejected with the violations, and quarantined to be inspected and replayed.
+type Guard struct {
+	mu         sync.RWMutex
+	validators map[string][]Validator
+	quarantine *Quarantine
+	// replayHandler serves the replayed messages, it's the engine serving the messages.
+	replayHandler http.Handler
+}
+
+func NewGuard(quarantineCapacity int) *Guard {
+	return &Guard{
+		validators: map[string][]Validator{},
+		quarantine: NewQuarantine(quarantineCapacity),
+	}
+}
+
+// Register adds the validator of the api path, e.g. /v1/interconn/schedule/job/create.
+func (g *Guard) Register(path string, validator Validator) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.validators[path] = append(g.validators[path], validator)
+}
+
+func (g *Guard) SetReplayHandler(handler http.Handler) {
+	g.replayHandler = handler
+}
+
+func (g *Guard) Quarantine() *Quarantine {
+	return g.quarantine
+}
+
+// Middleware validates the body of the requests with the type reqType. The body is kept in the context,
+// so that the proto decorator binds it again.
+func (g *Guard) Middleware(reqType reflect.Type) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodPost {
+			c.Next()
+			return
+		}
+		body, err := c.GetRawData()
+		if err != nil {
+			c.Next()
+			return
+		}
+		c.Set(gin.BodyBytesKey, body)
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		violations, ok := g.validate(c, reqType, body)
+		if !ok || len(violations) == 0 {
+			c.Next()
+			return
+		}
+
+		path := c.FullPath()
+		id := g.quarantine.Add(&QuarantinedMessage{
+			Path:        path,
+			Source:      c.GetHeader(constants.SourceDomainHeader),
+			ContentType: c.GetHeader("Content-Type"),
+			Payload:     string(body),
+			Violations:  violations,
+			ReceivedAt:  time.Now(),
+		})
+		nlog.Warnf("Reject payload of %s from %q, quarantined as %s, violations: %+v", path, c.GetHeader(constants.SourceDomainHeader), id, violations)
+		renderRejection(c, path, id, violations)
+	}
+}
+
+// validate returns false if the body isn't decoded by the decorator, which decides how to handle it.
+func (g *Guard) validate(c *gin.Context, reqType reflect.Type, body []byte) ([]Violation, bool) {
+	request, ok := reflect.New(reqType).Interface().(proto.Message)
+	if !ok {
+		return nil, false
+	}
+	var err error
+	contentType := c.GetHeader("Content-Type")
+	switch {
+	case contentType == "" || contentType == "text/plain" || strings.HasPrefix(contentType, "application/json"):
+		err = binder.JSONProtoBinder{}.BindBody(body, request)
+	case decorator.IsProtobufMIME(c.ContentType()):
+		err = proto.Unmarshal(body, request)
+	default:
+		return nil, false
+	}
+	if err != nil {
+		return []Violation{{Description: fmt.Sprintf("malformed payload, %v", err)}}, true
+	}
+
+	g.mu.RLock()
+	validators := g.validators[c.FullPath()]
+	g.mu.RUnlock()
+	var violations []Violation
+	for _, validator := range validators {
+		violations = append(violations, validator.Validate(request)...)
+	}
+	return violations, true
+}
+
+func renderRejection(c *gin.Context, path, quarantineID string, violations []Violation) {
+	items := make([]interface{}, 0, len(violations))
+	for _, v := range violations {
+		items = append(items, map[string]interface{}{"field": v.Field, "description": v.Description})
+	}
+	data, err := structpb.NewStruct(map[string]interface{}{
+		"quarantine_id": quarantineID,
+		"violations":    items,
+	})
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	resp, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(&interconn.CommonResponse{
+		Code: http.StatusBadRequest,
+		Msg:  fmt.Sprintf("payload of %s violates the schema", path),
+		Data: data,
+	})
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	// the interconn responses carry the error code in the body
+	c.Data(http.StatusOK, "application/json; charset=utf-8", resp)
+	c.Abort()
+}
+
+// AdminRouters returns the routes to inspect, replay and discard the quarantined messages. They are only
+// served to the local callers, the requests forwarded by the gateway from the partners are forbidden.
+func (g *Guard) AdminRouters() *router.GroupRouters {
+	return &router.GroupRouters{
+		Group:           "/v1/interconn/quarantine",
+		GroupMiddleware: []gin.HandlerFunc{localOnly},
+		Routes: []*router.Router{
+			{
+				HTTPMethod:   http.MethodGet,
+				RelativePath: "",
+				Handlers:     []gin.HandlerFunc{g.list},
+			},
+			{
+				HTTPMethod:   http.MethodGet,
+				RelativePath: ":id",
+				Handlers:     []gin.HandlerFunc{g.get},
+			},
+			{
+				HTTPMethod:   http.MethodDelete,
+				RelativePath: ":id",
+				Handlers:     []gin.HandlerFunc{g.discard},
+			},
+			{
+				HTTPMethod:   http.MethodPost,
+				RelativePath: ":id/replay",
+				Handlers:     []gin.HandlerFunc{g.replay},
+			},
+		},
+	}
+}
+
+func localOnly(c *gin.Context) {
+	if c.GetHeader(constants.SourceDomainHeader) != "" {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": "quarantine api is only served to the local callers"})
+		return
+	}
+	c.Next()
+}
+
+func (g *Guard) list(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"messages": g.quarantine.List()})
+}
+
+func (g *Guard) get(c *gin.Context) {
+	msg, ok := g.quarantine.Get(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"message": fmt.Sprintf("quarantined message %s not found", c.Param("id"))})
+		return
+	}
+	c.JSON(http.StatusOK, msg)
+}
+
+func (g *Guard) discard(c *gin.Context) {
+	if _, ok := g.quarantine.Remove(c.Param("id")); !ok {
+		c.JSON(http.StatusNotFound, gin.H{"message": fmt.Sprintf("quarantined message %s not found", c.Param("id"))})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// replay dispatches the quarantined message again, with the body of the replay request if it isn't empty, e.g.
+// the payload fixed by the partner. The message is quarantined again with a new id if it's still invalid.
+func (g *Guard) replay(c *gin.Context) {
+	if g.replayHandler == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"message": "replay is not supported"})
+		return
+	}
+	msg, ok := g.quarantine.Remove(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"message": fmt.Sprintf("quarantined message %s not found", c.Param("id"))})
+		return
+	}
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+	contentType := c.GetHeader("Content-Type")
+	if len(body) == 0 {
+		body, contentType = []byte(msg.Payload), msg.ContentType
+	}
+
+	req, err := http.NewRequestWithContext(c.Request.Context(), http.MethodPost, msg.Path, bytes.NewReader(body))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(constants.SourceDomainHeader, msg.Source)
+	recorder := httptest.NewRecorder()
+	g.replayHandler.ServeHTTP(recorder, req)
+	nlog.Infof("Replay quarantined message %s of %s, status code %d", msg.ID, msg.Path, recorder.Code)
+	c.Data(recorder.Code, recorder.Header().Get("Content-Type"), recorder.Body.Bytes())
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/proto/api/v1/interconn"
+)
+
+const stopJobPath = "/v1/interconn/schedule/job/stop"
+
+// newTestEngine serves the stop job api, which echoes the body it receives.
+func newTestEngine(g *Guard) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.POST(stopJobPath, g.Middleware(reflect.TypeOf(interconn.StopJobRequest{})), func(c *gin.Context) {
+		body, _ := c.Get(gin.BodyBytesKey)
+		c.Data(http.StatusOK, "application/json", body.([]byte))
+	})
+	admin := g.AdminRouters()
+	group := engine.Group(admin.Group, admin.GroupMiddleware...)
+	for _, route := range admin.Routes {
+		group.Handle(route.HTTPMethod, route.RelativePath, route.Handlers...)
+	}
+	g.SetReplayHandler(engine)
+	return engine
+}
+
+func serve(engine *gin.Engine, method, path, contentType string, body []byte, source string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if source != "" {
+		req.Header.Set(constants.SourceDomainHeader, source)
+	}
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestGuardPassesValidPayload(t *testing.T) {
+	g := NewGuard(10)
+	g.Register(stopJobPath, RequiredFields{"job_id"})
+	engine := newTestEngine(g)
+
+	w := serve(engine, http.MethodPost, stopJobPath, "application/json", []byte(`{"job_id":"job-1"}`), "bob")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"job_id":"job-1"}`, w.Body.String())
+	assert.Empty(t, g.Quarantine().List())
+
+	body, _ := proto.Marshal(&interconn.StopJobRequest{JobId: "job-1"})
+	w = serve(engine, http.MethodPost, stopJobPath, "application/x-protobuf", body, "bob")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, body, w.Body.Bytes())
+	assert.Empty(t, g.Quarantine().List())
+}
+
+func TestGuardRejectsAndReplays(t *testing.T) {
+	g := NewGuard(10)
+	g.Register(stopJobPath, RequiredFields{"job_id"})
+	engine := newTestEngine(g)
+
+	w := serve(engine, http.MethodPost, stopJobPath, "application/json", []byte(`{"job_id":""}`), "bob")
+	assert.Equal(t, http.StatusOK, w.Code)
+	resp := struct {
+		Code int32  `json:"code"`
+		Msg  string `json:"msg"`
+		Data struct {
+			QuarantineID string      `json:"quarantine_id"`
+			Violations   []Violation `json:"violations"`
+		} `json:"data"`
+	}{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, int32(http.StatusBadRequest), resp.Code)
+	assert.Equal(t, []Violation{{Field: "job_id", Description: "is required"}}, resp.Data.Violations)
+
+	msg, ok := g.Quarantine().Get(resp.Data.QuarantineID)
+	assert.True(t, ok)
+	assert.Equal(t, stopJobPath, msg.Path)
+	assert.Equal(t, "bob", msg.Source)
+	assert.Equal(t, `{"job_id":""}`, msg.Payload)
+
+	// the partners can't reach the quarantine api through the gateway
+	w = serve(engine, http.MethodGet, "/v1/interconn/quarantine", "", nil, "bob")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = serve(engine, http.MethodGet, "/v1/interconn/quarantine/"+msg.ID, "", nil, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// replaying the same payload quarantines it again
+	w = serve(engine, http.MethodPost, "/v1/interconn/quarantine/"+msg.ID+"/replay", "", nil, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	messages := g.Quarantine().List()
+	assert.Len(t, messages, 1)
+	assert.NotEqual(t, msg.ID, messages[0].ID)
+
+	// replaying the fixed payload
+	w = serve(engine, http.MethodPost, "/v1/interconn/quarantine/"+messages[0].ID+"/replay", "application/json",
+		[]byte(`{"job_id":"job-1"}`), "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"job_id":"job-1"}`, w.Body.String())
+	assert.Empty(t, g.Quarantine().List())
+
+	w = serve(engine, http.MethodPost, "/v1/interconn/quarantine/"+messages[0].ID+"/replay", "", nil, "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGuardRejectsMalformedPayload(t *testing.T) {
+	g := NewGuard(10)
+	engine := newTestEngine(g)
+
+	w := serve(engine, http.MethodPost, stopJobPath, "application/json", []byte(`{"job_id":`), "bob")
+	body, _ := io.ReadAll(w.Body)
+	assert.Contains(t, string(body), "malformed payload")
+	assert.Len(t, g.Quarantine().List(), 1)
+
+	w = serve(engine, http.MethodDelete, "/v1/interconn/quarantine/"+g.Quarantine().List()[0].ID, "", nil, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, g.Quarantine().List())
+}
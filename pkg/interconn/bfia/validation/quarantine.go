@@ -0,0 +1,92 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuarantinedMessage is an inbound message rejected by the schema validation.
+type QuarantinedMessage struct {
+	ID          string `json:"id"`
+	Path        string `json:"path"`
+	Source      string `json:"source"`
+	ContentType string `json:"content_type"`
+	// Payload is the raw body, it keeps the binary protobuf bodies intact for replaying.
+	Payload    string      `json:"payload"`
+	Violations []Violation `json:"violations"`
+	ReceivedAt time.Time   `json:"received_at"`
+}
+
+// Quarantine keeps the latest rejected messages in memory, the oldest ones are dropped once it's full.
+// The messages are lost on restart, the partners are expected to resend them in that case.
+type Quarantine struct {
+	mu       sync.Mutex
+	capacity int
+	messages []*QuarantinedMessage
+}
+
+func NewQuarantine(capacity int) *Quarantine {
+	return &Quarantine{capacity: capacity}
+}
+
+// Add quarantines the message and returns its id.
+func (q *Quarantine) Add(msg *QuarantinedMessage) string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	msg.ID = uuid.NewString()
+	if len(q.messages) >= q.capacity {
+		q.messages = q.messages[len(q.messages)-q.capacity+1:]
+	}
+	q.messages = append(q.messages, msg)
+	return msg.ID
+}
+
+// List returns the quarantined messages, the oldest first.
+func (q *Quarantine) List() []*QuarantinedMessage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return append([]*QuarantinedMessage{}, q.messages...)
+}
+
+func (q *Quarantine) Get(id string) (*QuarantinedMessage, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, msg := range q.messages {
+		if msg.ID == id {
+			return msg, true
+		}
+	}
+	return nil, false
+}
+
+func (q *Quarantine) Remove(id string) (*QuarantinedMessage, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, msg := range q.messages {
+		if msg.ID == id {
+			q.messages = append(q.messages[:i], q.messages[i+1:]...)
+			return msg, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuarantine(t *testing.T) {
+	q := NewQuarantine(2)
+	first := q.Add(&QuarantinedMessage{Path: "/first"})
+	second := q.Add(&QuarantinedMessage{Path: "/second"})
+	assert.NotEqual(t, first, second)
+
+	msg, ok := q.Get(first)
+	assert.True(t, ok)
+	assert.Equal(t, "/first", msg.Path)
+
+	// the oldest one is dropped once it's full
+	third := q.Add(&QuarantinedMessage{Path: "/third"})
+	_, ok = q.Get(first)
+	assert.False(t, ok)
+	messages := q.List()
+	assert.Len(t, messages, 2)
+	assert.Equal(t, second, messages[0].ID)
+	assert.Equal(t, third, messages[1].ID)
+
+	msg, ok = q.Remove(second)
+	assert.True(t, ok)
+	assert.Equal(t, "/second", msg.Path)
+	_, ok = q.Remove(second)
+	assert.False(t, ok)
+	assert.Len(t, q.List(), 1)
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation describes a field of the payload violating the schema.
+type Violation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// Validator validates the decoded payload of an interconn api.
+type Validator interface {
+	Validate(request proto.Message) []Violation
+}
+
+// ValidatorFunc adapts a function to Validator.
+type ValidatorFunc func(request proto.Message) []Violation
+
+func (f ValidatorFunc) Validate(request proto.Message) []Violation {
+	return f(request)
+}
+
+// RequiredFields is the schema listing the fields that must be set. The fields of the nested messages are
+// joined by dots, and the fields of the repeated messages are checked for each element, e.g. dag.components.name.
+type RequiredFields []string
+
+func (r RequiredFields) Validate(request proto.Message) []Violation {
+	var violations []Violation
+	for _, path := range r {
+		violations = append(violations, checkRequired(request.ProtoReflect(), strings.Split(path, "."), "")...)
+	}
+	return violations
+}
+
+func checkRequired(msg protoreflect.Message, path []string, prefix string) []Violation {
+	field := path[0]
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		return []Violation{{Field: field, Description: fmt.Sprintf("unknown field of %s in schema", msg.Descriptor().FullName())}}
+	}
+	// Has reports false for the empty lists and the zero scalars
+	if !msg.Has(fd) {
+		return []Violation{{Field: field, Description: "is required"}}
+	}
+	if len(path) == 1 {
+		return nil
+	}
+	if fd.Message() == nil || fd.IsMap() {
+		return []Violation{{Field: field, Description: "is not a message in schema"}}
+	}
+	if !fd.IsList() {
+		return checkRequired(msg.Get(fd).Message(), path[1:], field)
+	}
+
+	var violations []Violation
+	list := msg.Get(fd).List()
+	for i := 0; i < list.Len(); i++ {
+		violations = append(violations, checkRequired(list.Get(i).Message(), path[1:], fmt.Sprintf("%s[%d]", field, i))...)
+	}
+	return violations
+}
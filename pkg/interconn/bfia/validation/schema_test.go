@@ -0,0 +1,52 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/proto/api/v1/interconn"
+)
+
+func TestRequiredFields(t *testing.T) {
+	schema := RequiredFields{"job_id", "dag.components.name", "config.initiator.node_id"}
+
+	violations := schema.Validate(&interconn.CreateJobRequest{
+		JobId: "job-1",
+		Dag: &interconn.DAG{Components: []*interconn.Component{
+			{Name: "psi"},
+			{Code: "secretflow"},
+		}},
+		Config: &interconn.Config{Initiator: &interconn.ConfigInitiator{NodeId: "alice"}},
+	})
+	assert.Equal(t, []Violation{{Field: "dag.components[1].name", Description: "is required"}}, violations)
+
+	violations = schema.Validate(&interconn.CreateJobRequest{})
+	assert.Equal(t, []Violation{
+		{Field: "job_id", Description: "is required"},
+		{Field: "dag", Description: "is required"},
+		{Field: "config", Description: "is required"},
+	}, violations)
+}
+
+func TestRequiredFieldsInvalidSchema(t *testing.T) {
+	violations := RequiredFields{"job", "job_id.name"}.Validate(&interconn.StopJobRequest{JobId: "job-1"})
+	assert.Len(t, violations, 2)
+	assert.Equal(t, "job", violations[0].Field)
+	assert.Equal(t, "job_id", violations[1].Field)
+	assert.Equal(t, "is not a message in schema", violations[1].Description)
+}
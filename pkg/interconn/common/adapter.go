@@ -0,0 +1,87 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"sort"
+	"sync"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
+)
+
+// Adapter implements an interconnection protocol. It translates the job and task lifecycle of the protocol to
+// KusciaJob and KusciaTask, the resources it creates are labeled with its protocol by common.LabelInterConnProtocolType.
+type Adapter interface {
+	// Protocol returns the interconnection protocol implemented, e.g. bfia.
+	Protocol() kusciaapisv1alpha1.InterConnProtocolType
+	// ControllerConstruction returns how to construct the controller syncing the resources of the protocol, the
+	// controller only runs in the leader of the interconn servers.
+	ControllerConstruction() ControllerConstruction
+	// Run runs the services of the protocol, e.g. the http server receiving the requests of the partners. It runs
+	// in all the interconn servers.
+	Run(ctx context.Context) error
+}
+
+// AdapterConfig is passed to the adapters when the interconn server starts.
+type AdapterConfig struct {
+	Clients *kubeconfig.KubeClients
+	// DomainKey is the private key of the domain, e.g. to sign the requests to the partners.
+	DomainKey *rsa.PrivateKey
+}
+
+// AdapterFactory creates the adapter when the interconn server starts.
+type AdapterFactory func(ctx context.Context, conf *AdapterConfig) (Adapter, error)
+
+var (
+	adapterLock      sync.RWMutex
+	adapterFactories = map[kusciaapisv1alpha1.InterConnProtocolType]AdapterFactory{}
+)
+
+// RegisterAdapter registers the adapter of the protocol, the adapters usually register themselves in init. The
+// adapter registered later replaces the former one of the same protocol.
+func RegisterAdapter(protocol kusciaapisv1alpha1.InterConnProtocolType, factory AdapterFactory) {
+	adapterLock.Lock()
+	defer adapterLock.Unlock()
+	adapterFactories[protocol] = factory
+}
+
+// RegisteredProtocols returns the protocols having adapters in alphabetical order.
+func RegisteredProtocols() []kusciaapisv1alpha1.InterConnProtocolType {
+	adapterLock.RLock()
+	defer adapterLock.RUnlock()
+
+	protocols := make([]kusciaapisv1alpha1.InterConnProtocolType, 0, len(adapterFactories))
+	for protocol := range adapterFactories {
+		protocols = append(protocols, protocol)
+	}
+	sort.Slice(protocols, func(i, j int) bool {
+		return protocols[i] < protocols[j]
+	})
+	return protocols
+}
+
+func NewAdapter(ctx context.Context, protocol kusciaapisv1alpha1.InterConnProtocolType, conf *AdapterConfig) (Adapter, error) {
+	adapterLock.RLock()
+	factory, ok := adapterFactories[protocol]
+	adapterLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown interconn protocol %q", protocol)
+	}
+	return factory(ctx, conf)
+}
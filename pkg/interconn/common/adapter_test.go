@@ -0,0 +1,59 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
+)
+
+type fakeAdapter struct {
+	protocol kusciaapisv1alpha1.InterConnProtocolType
+}
+
+func (a *fakeAdapter) Protocol() kusciaapisv1alpha1.InterConnProtocolType {
+	return a.protocol
+}
+
+func (a *fakeAdapter) ControllerConstruction() ControllerConstruction {
+	return ControllerConstruction{CRDNames: []string{string(a.protocol)}}
+}
+
+func (a *fakeAdapter) Run(ctx context.Context) error {
+	return nil
+}
+
+func TestRegisterAdapter(t *testing.T) {
+	for _, protocol := range []kusciaapisv1alpha1.InterConnProtocolType{"zeta", "alpha"} {
+		p := protocol
+		RegisterAdapter(p, func(ctx context.Context, conf *AdapterConfig) (Adapter, error) {
+			return &fakeAdapter{protocol: p}, nil
+		})
+	}
+	assert.Equal(t, []kusciaapisv1alpha1.InterConnProtocolType{"alpha", "zeta"}, RegisteredProtocols())
+
+	adapter, err := NewAdapter(context.Background(), "zeta", &AdapterConfig{Clients: &kubeconfig.KubeClients{}})
+	assert.NoError(t, err)
+	assert.Equal(t, kusciaapisv1alpha1.InterConnProtocolType("zeta"), adapter.Protocol())
+	assert.Equal(t, []string{"zeta"}, adapter.ControllerConstruction().CRDNames)
+
+	_, err = NewAdapter(context.Background(), "unknown", &AdapterConfig{Clients: &kubeconfig.KubeClients{}})
+	assert.Error(t, err)
+}
@@ -42,6 +42,8 @@ func CheckCRDExists(ctx context.Context, extensionClient apiextensionsclientset.
 	return nil
 }
 
+// IController is run by the leader of the interconn servers only, see controllers.IController for the failover
+// behavior.
 type IController interface {
 	Run(int) error
 	Stop()
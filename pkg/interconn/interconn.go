@@ -16,6 +16,7 @@ package interconn
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
 	"sync"
 
@@ -27,9 +28,10 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
-	"github.com/secretflow/kuscia/pkg/interconn/bfia"
 	iccommon "github.com/secretflow/kuscia/pkg/interconn/common"
-	"github.com/secretflow/kuscia/pkg/interconn/kuscia"
+	// the built-in adapters register themselves
+	_ "github.com/secretflow/kuscia/pkg/interconn/bfia"
+	_ "github.com/secretflow/kuscia/pkg/interconn/kuscia"
 	"github.com/secretflow/kuscia/pkg/utils/election"
 	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
@@ -47,15 +49,14 @@ type Server struct {
 	kubeClient              kubernetes.Interface
 	kusciaClient            kusciaclientset.Interface
 	extensionClient         apiextensionsclientset.Interface
-	bfiaServer              *bfia.Server
-	kusciaServer            *kuscia.Server
+	adapters                []iccommon.Adapter
 	leaderElector           election.Elector
 	controllers             []iccommon.IController
 	controllerConstructions []iccommon.ControllerConstruction
 }
 
 // NewServer returns a Server instance.
-func NewServer(ctx context.Context, clients *kubeconfig.KubeClients) (*Server, error) {
+func NewServer(ctx context.Context, clients *kubeconfig.KubeClients, domainKey *rsa.PrivateKey) (*Server, error) {
 	s := &Server{
 		ctx:             ctx,
 		kubeClient:      clients.KubeClient,
@@ -63,20 +64,16 @@ func NewServer(ctx context.Context, clients *kubeconfig.KubeClients) (*Server, e
 		extensionClient: clients.ExtensionsClient,
 	}
 
-	bfiaServer, err := bfia.NewServer(ctx, clients)
-	if err != nil {
-		nlog.Fatalf("new bfia Server failed, %v", err.Error())
-		return nil, err
+	for _, protocol := range iccommon.RegisteredProtocols() {
+		adapter, err := iccommon.NewAdapter(ctx, protocol, &iccommon.AdapterConfig{Clients: clients, DomainKey: domainKey})
+		if err != nil {
+			nlog.Fatalf("new %s adapter failed, %v", protocol, err.Error())
+			return nil, err
+		}
+		nlog.Infof("Interconn protocol %s is enabled", protocol)
+		s.adapters = append(s.adapters, adapter)
+		s.controllerConstructions = append(s.controllerConstructions, adapter.ControllerConstruction())
 	}
-	s.bfiaServer = bfiaServer
-	s.kusciaServer = kuscia.NewServer(clients)
-	s.controllerConstructions = append(s.controllerConstructions, iccommon.ControllerConstruction{
-		NewControler: s.bfiaServer.NewController,
-	})
-	s.controllerConstructions = append(s.controllerConstructions, iccommon.ControllerConstruction{
-		NewControler: s.kusciaServer.NewController,
-		CRDNames:     s.kusciaServer.CRDNames,
-	})
 
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(nlog.Infof)
@@ -91,7 +88,7 @@ func NewServer(ctx context.Context, clients *kubeconfig.KubeClients) (*Server, e
 		election.WithOnStoppedLeading(s.onStoppedLeading))
 	if leaderElector == nil {
 		nlog.Fatal("failed to new leader elector")
-		return nil, err
+		return nil, fmt.Errorf("failed to new leader elector")
 	}
 	s.leaderElector = leaderElector
 	return s, nil
@@ -110,7 +107,7 @@ func (s *Server) onNewLeader(identity string) {
 	nlog.Infof("New leader has been elected: %s", identity)
 }
 
-// onStartedLeading is executed when leader started.
+// onStartedLeading is executed when leader started, the ctx is canceled once the lease is lost.
 func (s *Server) onStartedLeading(ctx context.Context) {
 	nlog.Info("Start leading")
 	if !s.controllerIsEmpty() {
@@ -134,7 +131,8 @@ func (s *Server) onStartedLeading(ctx context.Context) {
 	}
 }
 
-// onStoppedLeading is executed when leader stopped.
+// onStoppedLeading is executed when leader stopped. The controllers are stopped instead of exiting the process,
+// the elector keeps campaigning and runs new controllers if this replica is elected again.
 func (s *Server) onStoppedLeading() {
 	nlog.Warnf("Server %v leading stopped", serverName)
 	s.mutex.Lock()
@@ -167,11 +165,13 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	}
 
-	go func() {
-		if err := s.bfiaServer.Run(ctx); err != nil {
-			nlog.Fatalf("Run bfia Server failed, %v", err)
-		}
-	}()
+	for _, adapter := range s.adapters {
+		go func(adapter iccommon.Adapter) {
+			if err := adapter.Run(ctx); err != nil {
+				nlog.Fatalf("Run %s adapter failed, %v", adapter.Protocol(), err)
+			}
+		}(adapter)
+	}
 
 	s.leaderElector.Run(ctx)
 	return nil
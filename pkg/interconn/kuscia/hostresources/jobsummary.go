@@ -139,6 +139,10 @@ func (c *hostResourcesController) updateMemberJobByJobSummary(ctx context.Contex
 		needUpdate = true
 	}
 
+	if updateJobAppImageVersions(job, jobSummary, domainIDMap) {
+		needUpdate = true
+	}
+
 	if updateJobPartyTaskCreateStatus(job, jobSummary, domainIDMap) {
 		needUpdate = true
 	}
@@ -163,7 +167,7 @@ func updateJobStatusPhase(job *kusciaapisv1alpha1.KusciaJob, jobSummary *kusciaa
 		jobSummary.Status.Reason != "" &&
 		jobSummary.Status.Reason != job.Status.Reason {
 		switch jobSummary.Status.Reason {
-		case string(kusciaapisv1alpha1.ValidateFailed), string(kusciaapisv1alpha1.CreateTaskFailed):
+		case string(kusciaapisv1alpha1.ValidateFailed), string(kusciaapisv1alpha1.CreateTaskFailed), string(kusciaapisv1alpha1.AppImageNegotiationFailed):
 			job.Status.Phase = jobSummary.Status.Phase
 			job.Status.Reason = jobSummary.Status.Reason
 			job.Status.Message = jobSummary.Status.Message
@@ -213,6 +217,26 @@ func updateJobStageStatus(job *kusciaapisv1alpha1.KusciaJob, jobSummary *kusciaa
 	return updated
 }
 
+func updateJobAppImageVersions(job *kusciaapisv1alpha1.KusciaJob, jobSummary *kusciaapisv1alpha1.KusciaJobSummary, domainIDMap map[string]struct{}) bool {
+	if len(job.Status.AppImageVersions) == 0 && len(jobSummary.Status.AppImageVersions) > 0 {
+		job.Status.AppImageVersions = jobSummary.Status.AppImageVersions
+		return true
+	}
+
+	updated := false
+	for domainID, versions := range jobSummary.Status.AppImageVersions {
+		if _, exist := domainIDMap[domainID]; exist {
+			continue
+		}
+
+		if !reflect.DeepEqual(versions, job.Status.AppImageVersions[domainID]) {
+			job.Status.AppImageVersions[domainID] = versions
+			updated = true
+		}
+	}
+	return updated
+}
+
 func updateJobPartyTaskCreateStatus(job *kusciaapisv1alpha1.KusciaJob, jobSummary *kusciaapisv1alpha1.KusciaJobSummary, domainIDMap map[string]struct{}) bool {
 	if len(job.Status.PartyTaskCreateStatus) == 0 && len(jobSummary.Status.PartyTaskCreateStatus) > 0 {
 		job.Status.PartyTaskCreateStatus = jobSummary.Status.PartyTaskCreateStatus
@@ -16,15 +16,40 @@ package kuscia
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/secretflow/kuscia/pkg/common"
 	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/queue"
 )
 
+const (
+	// interopConfigConflictWindow is the window in which a spec reverting to a version applied before is taken as a
+	// conflict of concurrent writers rather than an intended rollback.
+	interopConfigConflictWindow = 10 * time.Minute
+	maxInteropConfigHistory     = 10
+
+	interopConfigReasonApplied      = "Applied"
+	interopConfigReasonForceSynced  = "ForceSynced"
+	interopConfigReasonSpecFlapping = "SpecFlapping"
+	interopConfigReasonNoConflict   = "NoConflict"
+)
+
 // runInteropConfigWorker is a long-running function that will continually call the
 // processNextWorkItem function in order to read and process a message on the work queue.
 func (c *Controller) runInteropConfigWorker(ctx context.Context) {
@@ -36,6 +61,11 @@ func (c *Controller) runInteropConfigWorker(ctx context.Context) {
 func (c *Controller) registerInteropConfigs() {
 	interopConfigs, _ := c.interopConfigLister.List(labels.Everything())
 	for _, ic := range interopConfigs {
+		// the spec in effect is restored, the conflicting spec is left to the worker
+		if ic.Status.AppliedSpec != nil {
+			ic = ic.DeepCopy()
+			ic.Spec = *ic.Status.AppliedSpec
+		}
 		if err := c.registerInteropConfig(ic); err != nil {
 			nlog.Warnf("Register interop config %v failed during startup，%v", ic.Name, err.Error())
 		}
@@ -64,6 +94,11 @@ func (c *Controller) handleUpdatedInteropConfig(oldObj, newObj interface{}) {
 	if oldIC.ResourceVersion == newIC.ResourceVersion {
 		return
 	}
+	// the status written by the worker itself is not handled again
+	if !reflect.DeepEqual(oldIC.Status, newIC.Status) && reflect.DeepEqual(oldIC.Spec, newIC.Spec) &&
+		reflect.DeepEqual(oldIC.Annotations, newIC.Annotations) {
+		return
+	}
 	queue.EnqueueObjectWithKeyName(newObj, c.interopConfigQueue)
 }
 
@@ -80,13 +115,156 @@ func (c *Controller) interopConfigHandler(ctx context.Context, key string) error
 		return err
 	}
 
-	if err = c.registerInteropConfig(ic); err != nil {
+	return c.syncInteropConfig(ctx, ic)
+}
+
+// syncInteropConfig applies the spec of the interop config and records it as a new version in the status. A spec
+// reverting to a version applied within the conflict window means the writers of the interop config, e.g. both
+// sides of the interconnection, are fighting over it. Such a spec is not applied, the version in effect is kept and
+// the conflict is surfaced as a condition until the spec is force synced by the annotation
+// common.InteropConfigForceSyncAnnotationKey, or is changed to a new spec.
+func (c *Controller) syncInteropConfig(ctx context.Context, ic *kusciaapisv1alpha1.InteropConfig) error {
+	now := metav1.Now()
+	newIC := ic.DeepCopy()
+	status := &newIC.Status
+	hash := interopConfigSpecHash(&ic.Spec)
+	forceToken := ic.Annotations[common.InteropConfigForceSyncAnnotationKey]
+	forced := forceToken != "" && forceToken != status.ForceSyncToken
+
+	if !forced && hash != status.SpecHash {
+		if rev := conflictingRevision(status, hash, now.Time); rev != nil {
+			if status.AppliedSpec != nil {
+				held := ic.DeepCopy()
+				held.Spec = *status.AppliedSpec
+				if err := c.registerInteropConfig(held); err != nil {
+					return err
+				}
+			}
+			message := fmt.Sprintf("spec %s reverts to version %d applied at %s while version %d is in effect, "+
+				"the writers of the interop config may be fighting over it, set annotation %s to force sync",
+				hash, rev.Version, rev.AppliedTime.UTC().Format(time.RFC3339), status.Version, common.InteropConfigForceSyncAnnotationKey)
+			setInteropConfigCondition(status, kusciaapisv1alpha1.InteropConfigConflict, corev1.ConditionTrue, interopConfigReasonSpecFlapping, message, now)
+			setInteropConfigCondition(status, kusciaapisv1alpha1.InteropConfigSynced, corev1.ConditionFalse, interopConfigReasonSpecFlapping,
+				fmt.Sprintf("version %d is kept for the conflict", status.Version), now)
+			if !reflect.DeepEqual(ic.Status, newIC.Status) {
+				nlog.Warnf("Interop config %s conflicts, %s", ic.Name, message)
+			}
+			return c.updateInteropConfigStatus(ctx, ic, newIC)
+		}
+	}
+
+	if err := c.registerInteropConfig(ic); err != nil {
 		return err
 	}
 
+	reason := interopConfigReasonApplied
+	if forced {
+		reason = interopConfigReasonForceSynced
+		status.ForceSyncToken = forceToken
+		nlog.Infof("Interop config %s is force synced by %s", ic.Name, forceToken)
+	}
+	if hash != status.SpecHash {
+		status.Version++
+		status.SpecHash = hash
+		status.AppliedSpec = ic.Spec.DeepCopy()
+		status.History = append(status.History, kusciaapisv1alpha1.InteropConfigRevision{
+			Version:     status.Version,
+			SpecHash:    hash,
+			AppliedTime: now,
+		})
+		if len(status.History) > maxInteropConfigHistory {
+			status.History = status.History[len(status.History)-maxInteropConfigHistory:]
+		}
+	}
+	setInteropConfigCondition(status, kusciaapisv1alpha1.InteropConfigSynced, corev1.ConditionTrue, reason,
+		fmt.Sprintf("version %d is applied", status.Version), now)
+	setInteropConfigCondition(status, kusciaapisv1alpha1.InteropConfigConflict, corev1.ConditionFalse, interopConfigReasonNoConflict, "", now)
+	return c.updateInteropConfigStatus(ctx, ic, newIC)
+}
+
+// ForceSyncInteropConfig sets a new token to the force sync annotation of the interop config, so that its current
+// spec is applied even if it conflicts. The token is returned.
+func ForceSyncInteropConfig(ctx context.Context, kusciaClient kusciaclientset.Interface, name string) (string, error) {
+	token := time.Now().UTC().Format(time.RFC3339Nano)
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{common.InteropConfigForceSyncAnnotationKey: token},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	if _, err = kusciaClient.KusciaV1alpha1().InteropConfigs().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// conflictingRevision returns the revision applied before of the same spec hash if it's within the conflict window,
+// or if the interop config is already in conflict.
+func conflictingRevision(status *kusciaapisv1alpha1.InteropConfigStatus, hash string, now time.Time) *kusciaapisv1alpha1.InteropConfigRevision {
+	inConflict := false
+	for _, cond := range status.Conditions {
+		if cond.Type == kusciaapisv1alpha1.InteropConfigConflict && cond.Status == corev1.ConditionTrue {
+			inConflict = true
+		}
+	}
+	for i := len(status.History) - 1; i >= 0; i-- {
+		rev := &status.History[i]
+		if rev.SpecHash != hash {
+			continue
+		}
+		if inConflict || now.Sub(rev.AppliedTime.Time) < interopConfigConflictWindow {
+			return rev
+		}
+		return nil
+	}
 	return nil
 }
 
+// interopConfigSpecHash is the hash of the spec regardless of the order of the members.
+func interopConfigSpecHash(spec *kusciaapisv1alpha1.InteropConfigSpec) string {
+	members := append([]string(nil), spec.Members...)
+	sort.Strings(members)
+	sum := sha256.Sum256([]byte(spec.Host + "\n" + strings.Join(members, ",")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// setInteropConfigCondition sets the condition, the times are kept if nothing changes.
+func setInteropConfigCondition(status *kusciaapisv1alpha1.InteropConfigStatus, condType kusciaapisv1alpha1.InteropConfigConditionType,
+	condStatus corev1.ConditionStatus, reason, message string, now metav1.Time) {
+	for i := range status.Conditions {
+		cond := &status.Conditions[i]
+		if cond.Type != condType {
+			continue
+		}
+		if cond.Status == condStatus && cond.Reason == reason && cond.Message == message {
+			return
+		}
+		if cond.Status != condStatus {
+			cond.LastTransitionTime = now
+		}
+		cond.Status, cond.Reason, cond.Message, cond.LastUpdateTime = condStatus, reason, message, now
+		return
+	}
+	status.Conditions = append(status.Conditions, kusciaapisv1alpha1.InteropConfigCondition{
+		Type:               condType,
+		Status:             condStatus,
+		Reason:             reason,
+		Message:            message,
+		LastUpdateTime:     now,
+		LastTransitionTime: now,
+	})
+}
+
+func (c *Controller) updateInteropConfigStatus(ctx context.Context, oldIC, newIC *kusciaapisv1alpha1.InteropConfig) error {
+	if reflect.DeepEqual(oldIC.Status, newIC.Status) {
+		return nil
+	}
+	_, err := c.kusciaClient.KusciaV1alpha1().InteropConfigs().UpdateStatus(ctx, newIC, metav1.UpdateOptions{})
+	return err
+}
+
 // registerInteropConfig is used to register interop config.
 func (c *Controller) registerInteropConfig(ic *kusciaapisv1alpha1.InteropConfig) error {
 	c.mu.Lock()
@@ -18,10 +18,15 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clientsetfake "k8s.io/client-go/kubernetes/fake"
 
+	"github.com/secretflow/kuscia/pkg/common"
 	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciaclientsetfake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
 	"github.com/secretflow/kuscia/pkg/interconn/kuscia/hostresources"
@@ -353,3 +358,117 @@ func TestDeleteInteropConfigInfo(t *testing.T) {
 		})
 	}
 }
+
+func getInteropConfigCondition(ic *kusciaapisv1alpha1.InteropConfig, condType kusciaapisv1alpha1.InteropConfigConditionType) *kusciaapisv1alpha1.InteropConfigCondition {
+	for i := range ic.Status.Conditions {
+		if ic.Status.Conditions[i].Type == condType {
+			return &ic.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func TestSyncInteropConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ic := &kusciaapisv1alpha1.InteropConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "ic"},
+		Spec:       kusciaapisv1alpha1.InteropConfigSpec{Host: "alice"},
+	}
+	kusciaFakeClient := kusciaclientsetfake.NewSimpleClientset(ic)
+	cc := NewController(ctx, nil, kusciaFakeClient, nil).(*Controller)
+
+	sync := func(spec kusciaapisv1alpha1.InteropConfigSpec, annotations map[string]string) *kusciaapisv1alpha1.InteropConfig {
+		current, err := kusciaFakeClient.KusciaV1alpha1().InteropConfigs().Get(ctx, "ic", metav1.GetOptions{})
+		require.NoError(t, err)
+		current.Spec = spec
+		current.Annotations = annotations
+		require.NoError(t, cc.syncInteropConfig(ctx, current))
+		got, err := kusciaFakeClient.KusciaV1alpha1().InteropConfigs().Get(ctx, "ic", metav1.GetOptions{})
+		require.NoError(t, err)
+		got.Spec = spec
+		return got
+	}
+
+	specA := kusciaapisv1alpha1.InteropConfigSpec{Host: "alice"}
+	specB := kusciaapisv1alpha1.InteropConfigSpec{Host: "bob"}
+
+	got := sync(specA, nil)
+	assert.Equal(t, int64(1), got.Status.Version)
+	assert.Equal(t, interopConfigSpecHash(&specA), got.Status.SpecHash)
+	assert.Equal(t, corev1.ConditionTrue, getInteropConfigCondition(got, kusciaapisv1alpha1.InteropConfigSynced).Status)
+	assert.Equal(t, "alice", cc.getInteropConfigInfo("ic").host)
+
+	// the same spec is not a new version
+	got = sync(specA, nil)
+	assert.Equal(t, int64(1), got.Status.Version)
+
+	got = sync(specB, nil)
+	assert.Equal(t, int64(2), got.Status.Version)
+	assert.Equal(t, "bob", cc.getInteropConfigInfo("ic").host)
+
+	// reverting to version 1 conflicts, and version 2 is kept
+	got = sync(specA, nil)
+	assert.Equal(t, int64(2), got.Status.Version)
+	assert.Equal(t, "bob", got.Status.AppliedSpec.Host)
+	assert.Equal(t, "bob", cc.getInteropConfigInfo("ic").host)
+	conflict := getInteropConfigCondition(got, kusciaapisv1alpha1.InteropConfigConflict)
+	assert.Equal(t, corev1.ConditionTrue, conflict.Status)
+	assert.Contains(t, conflict.Message, "version 1")
+	assert.Equal(t, corev1.ConditionFalse, getInteropConfigCondition(got, kusciaapisv1alpha1.InteropConfigSynced).Status)
+
+	// the conflict stays after the window
+	got.Status.History[0].AppliedTime = metav1.NewTime(time.Now().Add(-2 * interopConfigConflictWindow))
+	_, err := kusciaFakeClient.KusciaV1alpha1().InteropConfigs().UpdateStatus(ctx, got, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	got = sync(specA, nil)
+	assert.Equal(t, int64(2), got.Status.Version)
+
+	// force sync applies the spec
+	got = sync(specA, map[string]string{common.InteropConfigForceSyncAnnotationKey: "t1"})
+	assert.Equal(t, int64(3), got.Status.Version)
+	assert.Equal(t, "t1", got.Status.ForceSyncToken)
+	assert.Equal(t, "alice", cc.getInteropConfigInfo("ic").host)
+	assert.Equal(t, corev1.ConditionFalse, getInteropConfigCondition(got, kusciaapisv1alpha1.InteropConfigConflict).Status)
+	assert.Equal(t, interopConfigReasonForceSynced, getInteropConfigCondition(got, kusciaapisv1alpha1.InteropConfigSynced).Reason)
+	assert.Len(t, got.Status.History, 3)
+}
+
+func TestInteropConfigSpecHash(t *testing.T) {
+	t.Parallel()
+	a := &kusciaapisv1alpha1.InteropConfigSpec{Host: "alice", Members: []string{"bob", "carol"}}
+	b := &kusciaapisv1alpha1.InteropConfigSpec{Host: "alice", Members: []string{"carol", "bob"}}
+	c := &kusciaapisv1alpha1.InteropConfigSpec{Host: "alice", Members: []string{"bob"}}
+	assert.Equal(t, interopConfigSpecHash(a), interopConfigSpecHash(b))
+	assert.NotEqual(t, interopConfigSpecHash(a), interopConfigSpecHash(c))
+}
+
+func TestHandleUpdatedInteropConfigStatus(t *testing.T) {
+	t.Parallel()
+	cc := NewController(context.Background(), nil, kusciaclientsetfake.NewSimpleClientset(), nil).(*Controller)
+	ic1 := &kusciaapisv1alpha1.InteropConfig{ObjectMeta: metav1.ObjectMeta{Name: "ic", ResourceVersion: "1"}}
+	ic2 := ic1.DeepCopy()
+	ic2.ResourceVersion = "2"
+	ic2.Status.Version = 1
+	cc.handleUpdatedInteropConfig(ic1, ic2)
+	assert.Equal(t, 0, cc.interopConfigQueue.Len())
+
+	ic3 := ic2.DeepCopy()
+	ic3.ResourceVersion = "3"
+	ic3.Annotations = map[string]string{common.InteropConfigForceSyncAnnotationKey: "t1"}
+	cc.handleUpdatedInteropConfig(ic2, ic3)
+	assert.Equal(t, 1, cc.interopConfigQueue.Len())
+}
+
+func TestForceSyncInteropConfig(t *testing.T) {
+	t.Parallel()
+	kusciaFakeClient := kusciaclientsetfake.NewSimpleClientset(&kusciaapisv1alpha1.InteropConfig{ObjectMeta: metav1.ObjectMeta{Name: "ic"}})
+	token, err := ForceSyncInteropConfig(context.Background(), kusciaFakeClient, "ic")
+	require.NoError(t, err)
+	ic, err := kusciaFakeClient.KusciaV1alpha1().InteropConfigs().Get(context.Background(), "ic", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, token, ic.Annotations[common.InteropConfigForceSyncAnnotationKey])
+
+	_, err = ForceSyncInteropConfig(context.Background(), kusciaFakeClient, "missing")
+	assert.Error(t, err)
+}
@@ -295,6 +295,7 @@ func (c *Controller) createJobSummary(ctx context.Context, job *v1alpha1.KusciaJ
 			Phase:                 job.Status.Phase,
 			ApproveStatus:         job.Status.ApproveStatus,
 			StageStatus:           job.Status.StageStatus,
+			AppImageVersions:      job.Status.AppImageVersions,
 			PartyTaskCreateStatus: job.Status.PartyTaskCreateStatus,
 			TaskStatus:            job.Status.TaskStatus,
 			StartTime:             ikcommon.GetCurrentTime(),
@@ -349,6 +350,10 @@ func (c *Controller) updateJobSummary(ctx context.Context, job *v1alpha1.KusciaJ
 		needUpdate = true
 	}
 
+	if updateJobSummaryAppImageVersions(job, jobSummary, domainIDs, true) {
+		needUpdate = true
+	}
+
 	if updateJobSummaryPartyTaskCreateStatus(job, jobSummary, domainIDs, true) {
 		needUpdate = true
 	}
@@ -474,6 +479,39 @@ func updateJobSummaryStageStatus(job *v1alpha1.KusciaJob, jobSummary *v1alpha1.K
 	return updated
 }
 
+func updateJobSummaryAppImageVersions(job *v1alpha1.KusciaJob, jobSummary *v1alpha1.KusciaJobSummary, domainIDs []string, isHost bool) bool {
+	if len(job.Status.AppImageVersions) == 0 || reflect.DeepEqual(job.Status.AppImageVersions, jobSummary.Status.AppImageVersions) {
+		return false
+	}
+
+	if len(jobSummary.Status.AppImageVersions) == 0 {
+		jobSummary.Status.AppImageVersions = job.Status.AppImageVersions
+		return true
+	}
+
+	updated := false
+	if isHost {
+		for domainID, versions := range job.Status.AppImageVersions {
+			if domainID == jobSummary.Namespace {
+				continue
+			}
+			if !reflect.DeepEqual(jobSummary.Status.AppImageVersions[domainID], versions) {
+				updated = true
+				jobSummary.Status.AppImageVersions[domainID] = versions
+			}
+		}
+	} else {
+		for _, domainID := range domainIDs {
+			versions, ok := job.Status.AppImageVersions[domainID]
+			if ok && !reflect.DeepEqual(versions, jobSummary.Status.AppImageVersions[domainID]) {
+				updated = true
+				jobSummary.Status.AppImageVersions[domainID] = versions
+			}
+		}
+	}
+	return updated
+}
+
 func updateJobSummaryPartyTaskCreateStatus(job *v1alpha1.KusciaJob, jobSummary *v1alpha1.KusciaJobSummary, domainIDs []string, isHost bool) bool {
 	if len(job.Status.PartyTaskCreateStatus) == 0 || reflect.DeepEqual(job.Status.PartyTaskCreateStatus, jobSummary.Status.PartyTaskCreateStatus) {
 		return false
@@ -600,6 +638,10 @@ func (c *Controller) updateHostJobSummary(ctx context.Context,
 		needUpdate = true
 	}
 
+	if updateHostJobSummaryAppImageVersions(job, jobSummary, domainIDs) {
+		needUpdate = true
+	}
+
 	if updateHostJobSummaryPartyTaskCreateStatus(job, jobSummary, domainIDs) {
 		needUpdate = true
 	}
@@ -625,7 +667,7 @@ func updateHostJobSummaryStatusPhase(job *v1alpha1.KusciaJob, jobSummary *v1alph
 		job.Status.Reason != "" &&
 		job.Status.Reason != jobSummary.Status.Reason {
 		switch job.Status.Reason {
-		case string(v1alpha1.ValidateFailed), string(v1alpha1.CreateTaskFailed):
+		case string(v1alpha1.ValidateFailed), string(v1alpha1.CreateTaskFailed), string(v1alpha1.AppImageNegotiationFailed):
 			jobParties := job.Annotations[common.InterConnSelfPartyAnnotationKey]
 			jobSummary.Status.Phase = job.Status.Phase
 			jobSummary.Status.Reason = job.Status.Reason
@@ -666,6 +708,10 @@ func updateHostJobSummaryStageStatus(job *v1alpha1.KusciaJob, jobSummary *v1alph
 	return updateJobSummaryStageStatus(job, jobSummary, domainIDs, false)
 }
 
+func updateHostJobSummaryAppImageVersions(job *v1alpha1.KusciaJob, jobSummary *v1alpha1.KusciaJobSummary, domainIDs []string) bool {
+	return updateJobSummaryAppImageVersions(job, jobSummary, domainIDs, false)
+}
+
 func updateHostJobSummaryPartyTaskCreateStatus(job *v1alpha1.KusciaJob, jobSummary *v1alpha1.KusciaJobSummary, domainIDs []string) bool {
 	return updateJobSummaryPartyTaskCreateStatus(job, jobSummary, domainIDs, false)
 }
@@ -518,6 +518,33 @@ func TestUpdateJobSummaryPartyTaskCreateStatus(t *testing.T) {
 	assert.Equal(t, false, got)
 }
 
+func TestUpdateJobSummaryAppImageVersions(t *testing.T) {
+	t.Parallel()
+	domainIDs := []string{"bob"}
+	aliceVersions := []v1alpha1.PartyAppImageVersions{{AppImage: "secretflow", Versions: []string{"1.1.0", "1.0.0"}}}
+	bobVersions := []v1alpha1.PartyAppImageVersions{{AppImage: "secretflow", Versions: []string{"1.0.0"}}}
+
+	// app image versions in job is empty, should return false
+	kj := makeMockJob("cross-domain", "job-1")
+	kjs := makeMockJobSummary("bob", "job-1")
+	assert.Equal(t, false, updateJobSummaryAppImageVersions(kj, kjs, domainIDs, true))
+
+	// host doesn't overwrite the versions of the member party
+	kj.Status.AppImageVersions = map[string][]v1alpha1.PartyAppImageVersions{"alice": aliceVersions}
+	kjs.Status.AppImageVersions = map[string][]v1alpha1.PartyAppImageVersions{"bob": bobVersions}
+	assert.Equal(t, true, updateJobSummaryAppImageVersions(kj, kjs, domainIDs, true))
+	assert.Equal(t, map[string][]v1alpha1.PartyAppImageVersions{"alice": aliceVersions, "bob": bobVersions}, kjs.Status.AppImageVersions)
+
+	// member only updates the versions of its own parties
+	kj = makeMockJob("cross-domain", "job-1")
+	kj.Status.AppImageVersions = map[string][]v1alpha1.PartyAppImageVersions{"bob": bobVersions}
+	kjs = makeMockJobSummary("bob", "job-1")
+	kjs.Status.AppImageVersions = map[string][]v1alpha1.PartyAppImageVersions{"alice": aliceVersions}
+	assert.Equal(t, true, updateHostJobSummaryAppImageVersions(kj, kjs, domainIDs))
+	assert.Equal(t, map[string][]v1alpha1.PartyAppImageVersions{"alice": aliceVersions, "bob": bobVersions}, kjs.Status.AppImageVersions)
+	assert.Equal(t, false, updateHostJobSummaryAppImageVersions(kj, kjs, domainIDs))
+}
+
 func TestUpdateJobSummaryTaskStatus(t *testing.T) {
 	t.Parallel()
 	// job task status is empty, should return false
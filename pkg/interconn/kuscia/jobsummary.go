@@ -152,7 +152,7 @@ func updateJobStatusPhase(job *v1alpha1.KusciaJob, jobSummary *v1alpha1.KusciaJo
 		jobSummary.Status.Reason != "" &&
 		jobSummary.Status.Reason != job.Status.Reason {
 		switch jobSummary.Status.Reason {
-		case string(v1alpha1.ValidateFailed), string(v1alpha1.CreateTaskFailed):
+		case string(v1alpha1.ValidateFailed), string(v1alpha1.CreateTaskFailed), string(v1alpha1.AppImageNegotiationFailed):
 			job.Status.Phase = jobSummary.Status.Phase
 			job.Status.Reason = jobSummary.Status.Reason
 			job.Status.Message = jobSummary.Status.Message
@@ -179,6 +179,11 @@ func updateJobStatusInfo(job *v1alpha1.KusciaJob, jobSummary *v1alpha1.KusciaJob
 		job.Status.PartyTaskCreateStatus = jobSummary.Status.PartyTaskCreateStatus
 	}
 
+	if job.Status.AppImageVersions == nil && len(jobSummary.Status.AppImageVersions) > 0 {
+		updated = true
+		job.Status.AppImageVersions = jobSummary.Status.AppImageVersions
+	}
+
 	for _, domainID := range domainIDs {
 		if jobSummary.Status.ApproveStatus[domainID] != job.Status.ApproveStatus[domainID] {
 			job.Status.ApproveStatus[domainID] = jobSummary.Status.ApproveStatus[domainID]
@@ -194,6 +199,11 @@ func updateJobStatusInfo(job *v1alpha1.KusciaJob, jobSummary *v1alpha1.KusciaJob
 			job.Status.PartyTaskCreateStatus[domainID] = jobSummary.Status.PartyTaskCreateStatus[domainID]
 			updated = true
 		}
+
+		if versions, ok := jobSummary.Status.AppImageVersions[domainID]; ok && !reflect.DeepEqual(versions, job.Status.AppImageVersions[domainID]) {
+			job.Status.AppImageVersions[domainID] = versions
+			updated = true
+		}
 	}
 
 	return updated
@@ -17,11 +17,18 @@ package kuscia
 import (
 	"context"
 
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	"github.com/secretflow/kuscia/pkg/interconn/common"
 	"github.com/secretflow/kuscia/pkg/utils/kubeconfig"
 )
 
-// Server implements the inter connection with bfia protocol.
+func init() {
+	common.RegisterAdapter(kusciaapisv1alpha1.InterConnKuscia, func(_ context.Context, conf *common.AdapterConfig) (common.Adapter, error) {
+		return NewServer(conf.Clients), nil
+	})
+}
+
+// Server implements the inter connection with kuscia protocol.
 type Server struct {
 	NewController common.NewControllerFunc
 	CRDNames      []string
@@ -36,7 +43,15 @@ func NewServer(clients *kubeconfig.KubeClients) *Server {
 	return s
 }
 
-// Run runs the bfia server.
+func (s *Server) Protocol() kusciaapisv1alpha1.InterConnProtocolType {
+	return kusciaapisv1alpha1.InterConnKuscia
+}
+
+func (s *Server) ControllerConstruction() common.ControllerConstruction {
+	return common.ControllerConstruction{NewControler: s.NewController, CRDNames: s.CRDNames}
+}
+
+// Run runs the kuscia server, the kuscia protocol has no services besides the controller.
 func (s *Server) Run(ctx context.Context) error {
 	return nil
 }
@@ -0,0 +1,240 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobarchive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/resources"
+)
+
+// ErrNotFound means the job isn't archived.
+var ErrNotFound = errors.New("archived job not found")
+
+// ArchivedJob is a finished KusciaJob serialized to the archive, along with the summaries of its tasks and events
+// since the KusciaTasks and the events are deleted with the job.
+type ArchivedJob struct {
+	JobID       string    `json:"jobID"`
+	ArchiveTime time.Time `json:"archiveTime"`
+	// Job is the spec and the status of the KusciaJob, the managed fields are dropped.
+	Job    *v1alpha1.KusciaJob `json:"job"`
+	Tasks  []TaskSummary       `json:"tasks,omitempty"`
+	Events []Event             `json:"events,omitempty"`
+	// Grants are the domain data grants which authorized the parties to use the inputs of the tasks.
+	Grants []GrantSummary `json:"grants,omitempty"`
+}
+
+type TaskSummary struct {
+	TaskID         string                   `json:"taskID"`
+	Alias          string                   `json:"alias,omitempty"`
+	Phase          v1alpha1.KusciaTaskPhase `json:"phase,omitempty"`
+	Message        string                   `json:"message,omitempty"`
+	Progress       float32                  `json:"progress,omitempty"`
+	CreateTime     time.Time                `json:"createTime"`
+	StartTime      *time.Time               `json:"startTime,omitempty"`
+	CompletionTime *time.Time               `json:"completionTime,omitempty"`
+	Parties        []PartySummary           `json:"parties,omitempty"`
+	// Inputs are the ids of the input domain data in the task input config.
+	Inputs []string `json:"inputs,omitempty"`
+}
+
+type PartySummary struct {
+	DomainID string                   `json:"domainID"`
+	Role     string                   `json:"role,omitempty"`
+	Phase    v1alpha1.KusciaTaskPhase `json:"phase,omitempty"`
+	Message  string                   `json:"message,omitempty"`
+}
+
+type GrantSummary struct {
+	GrantID      string                    `json:"grantID"`
+	DomainDataID string                    `json:"domainDataID"`
+	Author       string                    `json:"author"`
+	GrantDomain  string                    `json:"grantDomain"`
+	Phase        v1alpha1.GrantPhase       `json:"phase,omitempty"`
+	UseCount     int                       `json:"useCount,omitempty"`
+	Compliance   *v1alpha1.GrantCompliance `json:"compliance,omitempty"`
+}
+
+// Event is the kubernetes event of the job or its tasks, the duplicate events are merged by the count.
+type Event struct {
+	ObjectKind string    `json:"objectKind"`
+	ObjectName string    `json:"objectName"`
+	Type       string    `json:"type"`
+	Reason     string    `json:"reason,omitempty"`
+	Message    string    `json:"message,omitempty"`
+	Count      int32     `json:"count"`
+	FirstTime  time.Time `json:"firstTime"`
+	LastTime   time.Time `json:"lastTime"`
+	Source     string    `json:"source,omitempty"`
+}
+
+// Store saves the archived jobs in an external store.
+type Store interface {
+	// Put saves the archived job, the job archived before is overwritten.
+	Put(ctx context.Context, job *ArchivedJob) error
+	// Get returns ErrNotFound if the job isn't archived.
+	Get(ctx context.Context, jobID string) (*ArchivedJob, error)
+}
+
+// NewStore creates the store of the config, which should be checked by kusciaconfig.CheckJobArchiveConfig.
+func NewStore(config *kusciaconfig.JobArchiveStoreConfig) (Store, error) {
+	switch config.Type {
+	case kusciaconfig.JobArchiveStoreS3, kusciaconfig.JobArchiveStoreOSS:
+		return newS3Store(config)
+	case kusciaconfig.JobArchiveStoreMySQL:
+		return newMySQLStore(config)
+	default:
+		return nil, fmt.Errorf("unknown job archive store type %q", config.Type)
+	}
+}
+
+// Build archives the job with its tasks and the events of them. The tasks of the job which are deleted already
+// are summarized from the job status.
+func Build(job *v1alpha1.KusciaJob, tasks []*v1alpha1.KusciaTask, events []corev1.Event, now time.Time) *ArchivedJob {
+	archived := &ArchivedJob{
+		JobID:       job.Name,
+		ArchiveTime: now,
+		Job:         job.DeepCopy(),
+	}
+	archived.Job.ManagedFields = nil
+
+	taskByID := map[string]*v1alpha1.KusciaTask{}
+	for _, task := range tasks {
+		taskByID[task.Name] = task
+	}
+	objects := map[string]bool{"KusciaJob/" + job.Name: true}
+	for _, template := range job.Spec.Tasks {
+		if template.TaskID == "" {
+			continue
+		}
+		objects["KusciaTask/"+template.TaskID] = true
+		summary := TaskSummary{
+			TaskID: template.TaskID,
+			Alias:  template.Alias,
+			Phase:  job.Status.TaskStatus[template.TaskID],
+			Inputs: resources.TaskInputDomainDataIDs(template.TaskInputConfig),
+		}
+		task, ok := taskByID[template.TaskID]
+		if !ok {
+			for _, party := range template.Parties {
+				summary.Parties = append(summary.Parties, PartySummary{DomainID: party.DomainID, Role: party.Role})
+			}
+			archived.Tasks = append(archived.Tasks, summary)
+			continue
+		}
+		summary.Phase = task.Status.Phase
+		summary.Message = task.Status.Message
+		summary.Progress = task.Status.Progress
+		summary.CreateTime = task.CreationTimestamp.Time
+		if task.Status.StartTime != nil {
+			summary.StartTime = &task.Status.StartTime.Time
+		}
+		if task.Status.CompletionTime != nil {
+			summary.CompletionTime = &task.Status.CompletionTime.Time
+		}
+		for _, party := range task.Status.PartyTaskStatus {
+			summary.Parties = append(summary.Parties, PartySummary{
+				DomainID: party.DomainID,
+				Role:     party.Role,
+				Phase:    party.Phase,
+				Message:  party.Message,
+			})
+		}
+		archived.Tasks = append(archived.Tasks, summary)
+	}
+
+	merged := map[Event]*Event{}
+	for i := range events {
+		ev := &events[i]
+		if !objects[ev.InvolvedObject.Kind+"/"+ev.InvolvedObject.Name] {
+			continue
+		}
+		firstTime, lastTime, count := resources.EventTimes(ev)
+		key := Event{ObjectKind: ev.InvolvedObject.Kind, ObjectName: ev.InvolvedObject.Name, Type: ev.Type, Reason: ev.Reason, Message: ev.Message}
+		if m, ok := merged[key]; ok {
+			m.Count += count
+			if firstTime.Before(m.FirstTime) {
+				m.FirstTime = firstTime
+			}
+			if lastTime.After(m.LastTime) {
+				m.LastTime = lastTime
+			}
+			continue
+		}
+		e := key
+		e.Count, e.FirstTime, e.LastTime = count, firstTime, lastTime
+		e.Source = ev.Source.Component
+		if e.Source == "" {
+			e.Source = ev.ReportingController
+		}
+		merged[key] = &e
+	}
+	for _, e := range merged {
+		archived.Events = append(archived.Events, *e)
+	}
+	sort.Slice(archived.Events, func(i, j int) bool {
+		a, b := archived.Events[i], archived.Events[j]
+		if !a.LastTime.Equal(b.LastTime) {
+			return a.LastTime.Before(b.LastTime)
+		}
+		if a.ObjectName != b.ObjectName {
+			return a.ObjectName < b.ObjectName
+		}
+		return a.Reason < b.Reason
+	})
+	return archived
+}
+
+// SummarizeGrants records the grants of the task inputs to the parties of the tasks in the archived job. The grants
+// of the other domain data, or to the domains not taking part in the job, are skipped.
+func SummarizeGrants(archived *ArchivedJob, grants []*v1alpha1.DomainDataGrant) {
+	used := map[string]bool{}
+	for _, task := range archived.Tasks {
+		for _, input := range task.Inputs {
+			for _, party := range task.Parties {
+				used[input+"/"+party.DomainID] = true
+			}
+		}
+	}
+	archived.Grants = nil
+	for _, grant := range grants {
+		if !used[grant.Spec.DomainDataID+"/"+grant.Spec.GrantDomain] {
+			continue
+		}
+		summary := GrantSummary{
+			GrantID:      grant.Name,
+			DomainDataID: grant.Spec.DomainDataID,
+			Author:       grant.Spec.Author,
+			GrantDomain:  grant.Spec.GrantDomain,
+			Phase:        grant.Status.Phase,
+			Compliance:   grant.Spec.Compliance.DeepCopy(),
+		}
+		if grant.Spec.Limit != nil {
+			summary.UseCount = grant.Spec.Limit.UseCount
+		}
+		archived.Grants = append(archived.Grants, summary)
+	}
+	sort.Slice(archived.Grants, func(i, j int) bool {
+		return archived.Grants[i].GrantID < archived.Grants[j].GrantID
+	})
+}
@@ -0,0 +1,323 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobarchive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+func makeArchiveJob(now time.Time) (*v1alpha1.KusciaJob, []*v1alpha1.KusciaTask) {
+	completion := metav1.NewTime(now.Add(-time.Hour))
+	job := &v1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "job-1", Namespace: common.KusciaCrossDomain,
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kuscia"}},
+		},
+		Spec: v1alpha1.KusciaJobSpec{Initiator: "alice", Tasks: []v1alpha1.KusciaTaskTemplate{
+			{Alias: "psi", TaskID: "task-1", TaskInputConfig: `{"sf_input_ids":["alice-table","bob-table"]}`,
+				Parties: []v1alpha1.Party{{DomainID: "alice"}, {DomainID: "bob"}}},
+			{Alias: "train", TaskID: "task-2", Parties: []v1alpha1.Party{{DomainID: "alice", Role: "server"}}},
+		}},
+		Status: v1alpha1.KusciaJobStatus{
+			Phase:          v1alpha1.KusciaJobSucceeded,
+			CompletionTime: &completion,
+			TaskStatus:     map[string]v1alpha1.KusciaTaskPhase{"task-1": v1alpha1.TaskSucceeded, "task-2": v1alpha1.TaskSucceeded},
+		},
+	}
+	tasks := []*v1alpha1.KusciaTask{{
+		ObjectMeta: metav1.ObjectMeta{Name: "task-1", Namespace: common.KusciaCrossDomain, CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour))},
+		Status: v1alpha1.KusciaTaskStatus{
+			Phase:          v1alpha1.TaskSucceeded,
+			Progress:       1,
+			CompletionTime: &completion,
+			PartyTaskStatus: []v1alpha1.PartyTaskStatus{
+				{DomainID: "alice", Phase: v1alpha1.TaskSucceeded},
+				{DomainID: "bob", Phase: v1alpha1.TaskSucceeded},
+			},
+		},
+	}}
+	return job, tasks
+}
+
+func TestBuild(t *testing.T) {
+	now := time.Now()
+	job, tasks := makeArchiveJob(now)
+	event := func(kind, name, reason string, count int32, last time.Time) corev1.Event {
+		return corev1.Event{
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name},
+			Type:           corev1.EventTypeNormal,
+			Reason:         reason,
+			Count:          count,
+			FirstTimestamp: metav1.NewTime(last.Add(-time.Minute)),
+			LastTimestamp:  metav1.NewTime(last),
+			Source:         corev1.EventSource{Component: "kuscia-controller-manager"},
+		}
+	}
+	events := []corev1.Event{
+		event("KusciaTask", "task-1", "Succeeded", 1, now.Add(-time.Hour)),
+		event("KusciaJob", "job-1", "Created", 2, now.Add(-3*time.Hour)),
+		event("KusciaJob", "job-1", "Created", 1, now.Add(-2*time.Hour)),
+		event("KusciaJob", "job-2", "Created", 1, now),
+	}
+
+	archived := Build(job, tasks, events, now)
+	assert.Equal(t, "job-1", archived.JobID)
+	assert.Nil(t, archived.Job.ManagedFields)
+	assert.NotNil(t, job.ManagedFields)
+	assert.Len(t, archived.Tasks, 2)
+	assert.Equal(t, v1alpha1.TaskSucceeded, archived.Tasks[0].Phase)
+	assert.Len(t, archived.Tasks[0].Parties, 2)
+	assert.NotNil(t, archived.Tasks[0].CompletionTime)
+	// task-2 was deleted, it's summarized from the job
+	assert.Equal(t, "train", archived.Tasks[1].Alias)
+	assert.Equal(t, v1alpha1.TaskSucceeded, archived.Tasks[1].Phase)
+	assert.Equal(t, []PartySummary{{DomainID: "alice", Role: "server"}}, archived.Tasks[1].Parties)
+	assert.Equal(t, []string{"alice-table", "bob-table"}, archived.Tasks[0].Inputs)
+	assert.Nil(t, archived.Tasks[1].Inputs)
+
+	assert.Len(t, archived.Events, 2)
+	assert.Equal(t, "KusciaJob", archived.Events[0].ObjectKind)
+	assert.Equal(t, int32(3), archived.Events[0].Count)
+	assert.True(t, now.Add(-2*time.Hour).Equal(archived.Events[0].LastTime))
+	assert.Equal(t, "task-1", archived.Events[1].ObjectName)
+	assert.Equal(t, "kuscia-controller-manager", archived.Events[1].Source)
+}
+
+func TestSummarizeGrants(t *testing.T) {
+	now := time.Now()
+	job, tasks := makeArchiveJob(now)
+	archived := Build(job, tasks, nil, now)
+	grant := func(name, dataID, grantDomain string) *v1alpha1.DomainDataGrant {
+		return &v1alpha1.DomainDataGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.DomainDataGrantSpec{Author: "bob", DomainDataID: dataID, GrantDomain: grantDomain,
+				Limit: &v1alpha1.GrantLimit{UseCount: 3}},
+			Status: v1alpha1.DomainDataGrantStatus{Phase: v1alpha1.GrantReady},
+		}
+	}
+	SummarizeGrants(archived, []*v1alpha1.DomainDataGrant{
+		grant("grant-2", "bob-table", "alice"),
+		grant("grant-1", "alice-table", "bob"),
+		// carol doesn't take part in the job and other-table isn't an input
+		grant("grant-3", "bob-table", "carol"),
+		grant("grant-4", "other-table", "alice"),
+	})
+	assert.Equal(t, []GrantSummary{
+		{GrantID: "grant-1", DomainDataID: "alice-table", Author: "bob", GrantDomain: "bob", Phase: v1alpha1.GrantReady, UseCount: 3},
+		{GrantID: "grant-2", DomainDataID: "bob-table", Author: "bob", GrantDomain: "alice", Phase: v1alpha1.GrantReady, UseCount: 3},
+	}, archived.Grants)
+}
+
+// fakeS3 serves the put and get object requests of the path style urls.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = data
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`))
+			return
+		}
+		_, _ = w.Write(data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	store, err := NewStore(&kusciaconfig.JobArchiveStoreConfig{
+		Type: kusciaconfig.JobArchiveStoreS3, Endpoint: server.URL, Bucket: "kuscia", Prefix: "jobs",
+		AccessKeyID: "ak", AccessKeySecret: "sk",
+	})
+	assert.NoError(t, err)
+
+	_, err = store.Get(context.Background(), "job-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	job, tasks := makeArchiveJob(now)
+	assert.NoError(t, store.Put(context.Background(), Build(job, tasks, nil, now)))
+	assert.Contains(t, fake.objects, "/kuscia/jobs/job-1.json")
+
+	archived, err := store.Get(context.Background(), "job-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", archived.Job.Spec.Initiator)
+	assert.True(t, now.Equal(archived.ArchiveTime))
+	assert.Len(t, archived.Tasks, 2)
+
+	// the objects larger than the limit aren't read into memory
+	store, err = NewStore(&kusciaconfig.JobArchiveStoreConfig{
+		Type: kusciaconfig.JobArchiveStoreS3, Endpoint: server.URL, Bucket: "kuscia", Prefix: "jobs",
+		AccessKeyID: "ak", AccessKeySecret: "sk", MaxObjectBytes: 16,
+	})
+	assert.NoError(t, err)
+	_, err = store.Get(context.Background(), "job-1")
+	assert.ErrorContains(t, err, "exceeds the max object size 16 bytes")
+}
+
+func expectCreateTables(mock sqlmock.Sqlmock) {
+	for _, table := range []string{"kuscia_archived_job", "kuscia_archived_job_task", "kuscia_archived_job_party",
+		"kuscia_archived_job_input", "kuscia_archived_job_grant", "kuscia_archived_job_transfer"} {
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS `" + table + "`").WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+}
+
+func TestMySQLStore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	store := &mysqlStore{db: db, table: "kuscia_archived_job"}
+	selectSQL := regexp.QuoteMeta("SELECT `data` FROM `kuscia_archived_job` WHERE `job_id` = ?")
+
+	mock.ExpectQuery(selectSQL).WithArgs("job-1").WillReturnError(&mysql.MySQLError{Number: errNoSuchTable})
+	_, err = store.Get(context.Background(), "job-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	job, tasks := makeArchiveJob(now)
+	expectCreateTables(mock)
+	expectPut := func() {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `kuscia_archived_job`").
+			WithArgs("job-1", "alice", "Succeeded", sqlmock.AnyArg(), now, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("DELETE FROM `kuscia_archived_job_task`").WithArgs("job-1").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("INSERT IGNORE INTO `kuscia_archived_job_task`").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("DELETE FROM `kuscia_archived_job_party`").WithArgs("job-1").WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec("INSERT IGNORE INTO `kuscia_archived_job_party`").
+			WithArgs("job-1", "task-1", "alice", "", "Succeeded", "job-1", "task-1", "bob", "", "Succeeded",
+				"job-1", "task-2", "alice", "server", "").
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec("DELETE FROM `kuscia_archived_job_input`").WithArgs("job-1").WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("INSERT IGNORE INTO `kuscia_archived_job_input`").
+			WithArgs("job-1", "task-1", "alice-table", "job-1", "task-1", "bob-table").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		// no grants are archived with the job, nor the transfers authorized by them
+		mock.ExpectExec("DELETE FROM `kuscia_archived_job_grant`").WithArgs("job-1").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM `kuscia_archived_job_transfer`").WithArgs("job-1").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+	}
+	expectPut()
+	assert.NoError(t, store.Put(context.Background(), Build(job, tasks, nil, now)))
+
+	// the tables are created once
+	expectPut()
+	assert.NoError(t, store.Put(context.Background(), Build(job, tasks, nil, now)))
+
+	// the archived job is rolled back if the rows fail to be put
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `kuscia_archived_job`").WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectExec("DELETE FROM `kuscia_archived_job_task`").WillReturnError(errors.New("lock wait timeout"))
+	mock.ExpectRollback()
+	assert.Error(t, store.Put(context.Background(), Build(job, tasks, nil, now)))
+
+	mock.ExpectQuery(selectSQL).WithArgs("job-1").
+		WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(`{"jobID":"job-1","job":{"spec":{"initiator":"alice"}}}`))
+	archived, err := store.Get(context.Background(), "job-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", archived.Job.Spec.Initiator)
+
+	mock.ExpectQuery(selectSQL).WithArgs("job-2").WillReturnRows(sqlmock.NewRows([]string{"data"}))
+	_, err = store.Get(context.Background(), "job-2")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTransferRows(t *testing.T) {
+	now := time.Now()
+	job, tasks := makeArchiveJob(now)
+	archived := Build(job, tasks, nil, now)
+	archived.Grants = []GrantSummary{
+		{GrantID: "grant-1", DomainDataID: "alice-table", Author: "alice", GrantDomain: "bob"},
+		// the grant to its author itself isn't a transfer
+		{GrantID: "grant-2", DomainDataID: "bob-table", Author: "bob", GrantDomain: "bob"},
+	}
+	assert.Equal(t, [][]any{{"job-1", "task-1", "alice-table", "alice", "bob", "grant-1"}}, transferRows(archived))
+}
+
+func TestMySQLStore_Report(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	store := &mysqlStore{db: db, table: "kuscia_archived_job"}
+	since := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 3, 0)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT p.`domain_id`, i.`domaindata_id`")).
+		WithArgs("Succeeded", "alice", "alice", "bob", "alice-table", since, until).
+		WillReturnRows(sqlmock.NewRows([]string{"domain_id", "domaindata_id", "jobs", "succeeded", "tasks"}).
+			AddRow("bob", "alice-table", 3, 2, 5).
+			AddRow("bob", "carol-table", 1, 1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT p.`domain_id`, i.`domaindata_id`, g.`grant_id`")).
+		WithArgs("alice", "alice", "bob", "alice-table", since, until).
+		WillReturnRows(sqlmock.NewRows([]string{"domain_id", "domaindata_id", "grant_id"}).
+			AddRow("bob", "alice-table", "grant-1").
+			AddRow("bob", "alice-table", "grant-2").
+			AddRow("bob", "alice-table", "grant-3"))
+	report, err := store.Report(context.Background(), &ReportQuery{
+		Domain: "alice", Partner: "bob", DomainDataID: "alice-table", Since: since, Until: until,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []ReportRow{
+		{Partner: "bob", DomainDataID: "alice-table", JobCount: 3, SucceededJobCount: 2, TaskCount: 5,
+			GrantIDs: []string{"grant-1", "grant-2", "grant-3"}},
+		{Partner: "bob", DomainDataID: "carol-table", JobCount: 1, SucceededJobCount: 1, TaskCount: 1},
+	}, report)
+
+	// nothing is archived yet
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT p.`domain_id`, i.`domaindata_id`")).WithArgs("Succeeded").
+		WillReturnError(&mysql.MySQLError{Number: errNoSuchTable})
+	report, err = store.Report(context.Background(), &ReportQuery{})
+	assert.NoError(t, err)
+	assert.Empty(t, report)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNewStore_UnknownType(t *testing.T) {
+	_, err := NewStore(&kusciaconfig.JobArchiveStoreConfig{Type: "ftp"})
+	assert.True(t, err != nil && strings.Contains(err.Error(), "ftp"))
+}
@@ -0,0 +1,364 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobarchive
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+const (
+	// errNoSuchTable is the mysql error of querying a table which doesn't exist.
+	errNoSuchTable = 1146
+)
+
+// mysqlStore saves the archived jobs in a table, the columns besides data and the normalized tables are for querying
+// the archive by sql.
+type mysqlStore struct {
+	db    *sql.DB
+	table string
+
+	mu           sync.Mutex
+	tableCreated bool
+}
+
+func newMySQLStore(config *kusciaconfig.JobArchiveStoreConfig) (Store, error) {
+	db, err := sql.Open("mysql", config.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("open mysql of job archive failed, %v", err)
+	}
+	return &mysqlStore{db: db, table: config.GetTable()}, nil
+}
+
+// tableDDLs returns the ddl of the archived job table and the normalized tables of the tasks, parties, inputs, grants
+// and transfers. The normalized tables are rewritten with the archived job, so that the archive can be queried by sql.
+func (s *mysqlStore) tableDDLs() []string {
+	return []string{
+		"CREATE TABLE IF NOT EXISTS `" + s.table + "` (" +
+			"`job_id` varchar(63) NOT NULL," +
+			"`initiator` varchar(63) NOT NULL DEFAULT ''," +
+			"`phase` varchar(32) NOT NULL DEFAULT ''," +
+			"`completion_time` datetime NULL," +
+			"`archive_time` datetime NOT NULL," +
+			"`data` longblob NOT NULL," +
+			"PRIMARY KEY (`job_id`)," +
+			"KEY `idx_initiator_completion_time` (`initiator`,`completion_time`)" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		"CREATE TABLE IF NOT EXISTS `" + s.table + "_task` (" +
+			"`job_id` varchar(63) NOT NULL," +
+			"`task_id` varchar(63) NOT NULL," +
+			"`alias` varchar(63) NOT NULL DEFAULT ''," +
+			"`phase` varchar(32) NOT NULL DEFAULT ''," +
+			"`start_time` datetime NULL," +
+			"`completion_time` datetime NULL," +
+			"PRIMARY KEY (`job_id`,`task_id`)" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		"CREATE TABLE IF NOT EXISTS `" + s.table + "_party` (" +
+			"`job_id` varchar(63) NOT NULL," +
+			"`task_id` varchar(63) NOT NULL," +
+			"`domain_id` varchar(63) NOT NULL," +
+			"`role` varchar(63) NOT NULL DEFAULT ''," +
+			"`phase` varchar(32) NOT NULL DEFAULT ''," +
+			"PRIMARY KEY (`job_id`,`task_id`,`domain_id`,`role`)," +
+			"KEY `idx_domain_id` (`domain_id`)" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		"CREATE TABLE IF NOT EXISTS `" + s.table + "_input` (" +
+			"`job_id` varchar(63) NOT NULL," +
+			"`task_id` varchar(63) NOT NULL," +
+			"`domaindata_id` varchar(255) NOT NULL," +
+			"PRIMARY KEY (`job_id`,`task_id`,`domaindata_id`)," +
+			"KEY `idx_domaindata_id` (`domaindata_id`)" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		"CREATE TABLE IF NOT EXISTS `" + s.table + "_grant` (" +
+			"`job_id` varchar(63) NOT NULL," +
+			"`grant_id` varchar(255) NOT NULL," +
+			"`domaindata_id` varchar(255) NOT NULL," +
+			"`author` varchar(63) NOT NULL DEFAULT ''," +
+			"`grant_domain` varchar(63) NOT NULL," +
+			"`phase` varchar(32) NOT NULL DEFAULT ''," +
+			"PRIMARY KEY (`job_id`,`grant_id`)," +
+			"KEY `idx_domaindata_id_grant_domain` (`domaindata_id`,`grant_domain`)" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		"CREATE TABLE IF NOT EXISTS `" + s.table + "_transfer` (" +
+			"`job_id` varchar(63) NOT NULL," +
+			"`task_id` varchar(63) NOT NULL," +
+			"`domaindata_id` varchar(255) NOT NULL," +
+			"`source_domain` varchar(63) NOT NULL," +
+			"`destination_domain` varchar(63) NOT NULL," +
+			"`grant_id` varchar(255) NOT NULL," +
+			"PRIMARY KEY (`job_id`,`task_id`,`domaindata_id`,`destination_domain`)," +
+			"KEY `idx_source_domain_destination_domain` (`source_domain`,`destination_domain`)" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+	}
+}
+
+// ensureTable creates the tables on the first put, so the mysql needn't be ready when kuscia starts.
+func (s *mysqlStore) ensureTable(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tableCreated {
+		return nil
+	}
+	for _, ddl := range s.tableDDLs() {
+		if _, err := s.db.ExecContext(ctx, ddl); err != nil {
+			return fmt.Errorf("create table of %s failed, %v", s.table, err)
+		}
+	}
+	s.tableCreated = true
+	return nil
+}
+
+func (s *mysqlStore) Put(ctx context.Context, job *ArchivedJob) error {
+	if err := s.ensureTable(ctx); err != nil {
+		return err
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	var initiator, phase string
+	var completionTime sql.NullTime
+	if job.Job != nil {
+		initiator, phase = job.Job.Spec.Initiator, string(job.Job.Status.Phase)
+		if job.Job.Status.CompletionTime != nil {
+			completionTime = sql.NullTime{Time: job.Job.Status.CompletionTime.UTC(), Valid: true}
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("put archived job %s failed, %v", job.JobID, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	_, err = tx.ExecContext(ctx, "INSERT INTO `"+s.table+"` (`job_id`, `initiator`, `phase`, `completion_time`, `archive_time`, `data`) "+
+		"VALUES (?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE `initiator` = VALUES(`initiator`), `phase` = VALUES(`phase`), "+
+		"`completion_time` = VALUES(`completion_time`), `archive_time` = VALUES(`archive_time`), `data` = VALUES(`data`)",
+		job.JobID, initiator, phase, completionTime, job.ArchiveTime.UTC(), data)
+	if err != nil {
+		return fmt.Errorf("put archived job %s failed, %v", job.JobID, err)
+	}
+	if err = s.putRows(ctx, tx, job); err != nil {
+		return fmt.Errorf("put rows of archived job %s failed, %v", job.JobID, err)
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("put archived job %s failed, %v", job.JobID, err)
+	}
+	return nil
+}
+
+// putRows replaces the rows of the job in the normalized tables.
+func (s *mysqlStore) putRows(ctx context.Context, tx *sql.Tx, job *ArchivedJob) error {
+	var tasks, parties, inputs, grants [][]any
+	for _, task := range job.Tasks {
+		tasks = append(tasks, []any{job.JobID, task.TaskID, task.Alias, string(task.Phase),
+			nullTime(task.StartTime), nullTime(task.CompletionTime)})
+		for _, party := range task.Parties {
+			parties = append(parties, []any{job.JobID, task.TaskID, party.DomainID, party.Role, string(party.Phase)})
+		}
+		for _, input := range task.Inputs {
+			inputs = append(inputs, []any{job.JobID, task.TaskID, input})
+		}
+	}
+	for _, grant := range job.Grants {
+		grants = append(grants, []any{job.JobID, grant.GrantID, grant.DomainDataID, grant.Author, grant.GrantDomain,
+			string(grant.Phase)})
+	}
+	transfers := transferRows(job)
+
+	for _, t := range []struct {
+		suffix  string
+		columns string
+		rows    [][]any
+	}{
+		{"_task", "`job_id`, `task_id`, `alias`, `phase`, `start_time`, `completion_time`", tasks},
+		{"_party", "`job_id`, `task_id`, `domain_id`, `role`, `phase`", parties},
+		{"_input", "`job_id`, `task_id`, `domaindata_id`", inputs},
+		{"_grant", "`job_id`, `grant_id`, `domaindata_id`, `author`, `grant_domain`, `phase`", grants},
+		{"_transfer", "`job_id`, `task_id`, `domaindata_id`, `source_domain`, `destination_domain`, `grant_id`", transfers},
+	} {
+		table := s.table + t.suffix
+		if _, err := tx.ExecContext(ctx, "DELETE FROM `"+table+"` WHERE `job_id` = ?", job.JobID); err != nil {
+			return err
+		}
+		if len(t.rows) == 0 {
+			continue
+		}
+		placeholder := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(t.rows[0])), ", ") + ")"
+		values := make([]string, 0, len(t.rows))
+		var args []any
+		for _, row := range t.rows {
+			values = append(values, placeholder)
+			args = append(args, row...)
+		}
+		// the duplicate rows, e.g. a domain data listed twice in the input config, are ignored
+		query := "INSERT IGNORE INTO `" + table + "` (" + t.columns + ") VALUES " + strings.Join(values, ", ")
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transferRows returns the domain data transferred from their authors to the other parties of the tasks, which are
+// authorized by the archived grants.
+func transferRows(job *ArchivedJob) [][]any {
+	grantByData := map[string]GrantSummary{}
+	for _, grant := range job.Grants {
+		if grant.Author != "" && grant.Author != grant.GrantDomain {
+			grantByData[grant.DomainDataID+"/"+grant.GrantDomain] = grant
+		}
+	}
+	var rows [][]any
+	for _, task := range job.Tasks {
+		for _, input := range task.Inputs {
+			for _, party := range task.Parties {
+				if grant, ok := grantByData[input+"/"+party.DomainID]; ok {
+					rows = append(rows, []any{job.JobID, task.TaskID, input, grant.Author, party.DomainID, grant.GrantID})
+				}
+			}
+		}
+	}
+	return rows
+}
+
+func (s *mysqlStore) Get(ctx context.Context, jobID string) (*ArchivedJob, error) {
+	var data []byte
+	err := s.db.QueryRowContext(ctx, "SELECT `data` FROM `"+s.table+"` WHERE `job_id` = ?", jobID).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) || isNoSuchTable(err) {
+		// the table is created by the first archived job
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("get archived job %s failed, %v", jobID, err)
+	}
+	job := &ArchivedJob{}
+	if err = json.Unmarshal(data, job); err != nil {
+		return nil, fmt.Errorf("decode archived job %s failed, %v", jobID, err)
+	}
+	return job, nil
+}
+
+// Report joins the inputs of the tasks with the parties of the same tasks, so a partner is reported for the domain
+// data it computed on, whichever party owns the domain data.
+func (s *mysqlStore) Report(ctx context.Context, query *ReportQuery) ([]ReportRow, error) {
+	var conds []string
+	var args []any
+	if query.Domain != "" {
+		conds = append(conds, "p.`domain_id` <> ?",
+			"EXISTS (SELECT 1 FROM `"+s.table+"_party` d WHERE d.`job_id` = j.`job_id` AND d.`domain_id` = ?)")
+		args = append(args, query.Domain, query.Domain)
+	}
+	if query.Partner != "" {
+		conds = append(conds, "p.`domain_id` = ?")
+		args = append(args, query.Partner)
+	}
+	if query.DomainDataID != "" {
+		conds = append(conds, "i.`domaindata_id` = ?")
+		args = append(args, query.DomainDataID)
+	}
+	if query.Phase != "" {
+		conds = append(conds, "j.`phase` = ?")
+		args = append(args, query.Phase)
+	}
+	if !query.Since.IsZero() {
+		conds = append(conds, "j.`completion_time` >= ?")
+		args = append(args, query.Since.UTC())
+	}
+	if !query.Until.IsZero() {
+		conds = append(conds, "j.`completion_time` < ?")
+		args = append(args, query.Until.UTC())
+	}
+	from := " FROM `" + s.table + "` j " +
+		"JOIN `" + s.table + "_input` i ON i.`job_id` = j.`job_id` " +
+		"JOIN `" + s.table + "_party` p ON p.`job_id` = i.`job_id` AND p.`task_id` = i.`task_id`"
+	where := ""
+	if len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
+	}
+
+	rows, err := s.db.QueryContext(ctx, "SELECT p.`domain_id`, i.`domaindata_id`, COUNT(DISTINCT j.`job_id`), "+
+		"COUNT(DISTINCT CASE WHEN j.`phase` = ? THEN j.`job_id` END), COUNT(DISTINCT i.`job_id`, i.`task_id`)"+
+		from+where+" GROUP BY p.`domain_id`, i.`domaindata_id` ORDER BY p.`domain_id`, i.`domaindata_id`",
+		append([]any{string(v1alpha1.KusciaJobSucceeded)}, args...)...)
+	if isNoSuchTable(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("report archived jobs failed, %v", err)
+	}
+	defer rows.Close()
+
+	var report []ReportRow
+	index := map[string]int{}
+	for rows.Next() {
+		var row ReportRow
+		if err = rows.Scan(&row.Partner, &row.DomainDataID, &row.JobCount, &row.SucceededJobCount, &row.TaskCount); err != nil {
+			return nil, fmt.Errorf("report archived jobs failed, %v", err)
+		}
+		index[row.Partner+"/"+row.DomainDataID] = len(report)
+		report = append(report, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("report archived jobs failed, %v", err)
+	}
+	if len(report) == 0 {
+		return report, nil
+	}
+
+	// the grants are selected as rows rather than concatenated, which is truncated by group_concat_max_len
+	grantRows, err := s.db.QueryContext(ctx, "SELECT DISTINCT p.`domain_id`, i.`domaindata_id`, g.`grant_id`"+from+
+		" JOIN `"+s.table+"_grant` g ON g.`job_id` = i.`job_id` AND g.`domaindata_id` = i.`domaindata_id` "+
+		"AND g.`grant_domain` = p.`domain_id`"+where+" ORDER BY p.`domain_id`, i.`domaindata_id`, g.`grant_id`", args...)
+	if err != nil {
+		return nil, fmt.Errorf("report grants of archived jobs failed, %v", err)
+	}
+	defer grantRows.Close()
+	for grantRows.Next() {
+		var partner, domainDataID, grantID string
+		if err = grantRows.Scan(&partner, &domainDataID, &grantID); err != nil {
+			return nil, fmt.Errorf("report grants of archived jobs failed, %v", err)
+		}
+		if i, ok := index[partner+"/"+domainDataID]; ok {
+			report[i].GrantIDs = append(report[i].GrantIDs, grantID)
+		}
+	}
+	if err = grantRows.Err(); err != nil {
+		return nil, fmt.Errorf("report grants of archived jobs failed, %v", err)
+	}
+	return report, nil
+}
+
+func isNoSuchTable(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == errNoSuchTable
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
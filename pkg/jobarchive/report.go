@@ -0,0 +1,50 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobarchive
+
+import (
+	"context"
+	"time"
+)
+
+// Reporter is implemented by the stores which can aggregate the archived jobs, e.g. the mysql store keeps the
+// normalized tables of the tasks, parties, inputs and grants besides the archived jobs.
+type Reporter interface {
+	// Report counts the archived jobs by the partner and the input domain data.
+	Report(ctx context.Context, query *ReportQuery) ([]ReportRow, error)
+}
+
+// ReportQuery filters the archived jobs of the report, the empty fields match all.
+type ReportQuery struct {
+	// Domain only reports the jobs which the domain takes part in, and the domain isn't reported as a partner.
+	Domain       string
+	Partner      string
+	DomainDataID string
+	Phase        string
+	// Since and Until are the range [Since, Until) of the job completion time.
+	Since time.Time
+	Until time.Time
+}
+
+// ReportRow is the usage of a domain data by a partner domain in the archived jobs.
+type ReportRow struct {
+	Partner           string
+	DomainDataID      string
+	JobCount          int64
+	SucceededJobCount int64
+	TaskCount         int64
+	// GrantIDs are the grants which authorized the partner to use the domain data.
+	GrantIDs []string
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobarchive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	// defaultS3MaxObjectBytes bounds the archived job read into memory, which is far larger than a job with
+	// hundreds of tasks and events.
+	defaultS3MaxObjectBytes = 64 << 20
+)
+
+// s3Store saves each archived job as a json object {prefix}/{jobID}.json of the bucket.
+type s3Store struct {
+	client         *s3.S3
+	bucket         string
+	prefix         string
+	maxObjectBytes int64
+}
+
+func newS3Store(config *kusciaconfig.JobArchiveStoreConfig) (Store, error) {
+	region := config.Region
+	if region == "" {
+		region = defaultS3Region
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials(config.AccessKeyID, config.AccessKeySecret, ""),
+		Endpoint:         aws.String(config.Endpoint),
+		Region:           aws.String(region),
+		S3ForcePathStyle: aws.Bool(!config.Virtualhost),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create %s session of %s failed, %v", config.Type, config.Endpoint, err)
+	}
+	maxObjectBytes := config.MaxObjectBytes
+	if maxObjectBytes <= 0 {
+		maxObjectBytes = defaultS3MaxObjectBytes
+	}
+	return &s3Store{client: s3.New(sess), bucket: config.Bucket, prefix: config.Prefix, maxObjectBytes: maxObjectBytes}, nil
+}
+
+func (s *s3Store) key(jobID string) string {
+	return path.Join(s.prefix, jobID+".json")
+}
+
+func (s *s3Store) Put(ctx context.Context, job *ArchivedJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(s.key(job.JobID)),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return fmt.Errorf("put archived job %s failed, %v", job.JobID, err)
+	}
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, jobID string) (*ArchivedJob, error) {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(jobID)),
+	})
+	if err != nil {
+		var reqErr awserr.RequestFailure
+		if errors.As(err, &reqErr) && (reqErr.StatusCode() == http.StatusNotFound || reqErr.Code() == s3.ErrCodeNoSuchKey) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get archived job %s failed, %v", jobID, err)
+	}
+	defer out.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(out.Body, s.maxObjectBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read archived job %s failed, %v", jobID, err)
+	}
+	if int64(len(data)) > s.maxObjectBytes {
+		return nil, fmt.Errorf("archived job %s exceeds the max object size %d bytes", jobID, s.maxObjectBytes)
+	}
+	job := &ArchivedJob{}
+	if err = json.Unmarshal(data, job); err != nil {
+		return nil, fmt.Errorf("decode archived job %s failed, %v", jobID, err)
+	}
+	return job, nil
+}
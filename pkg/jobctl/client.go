@@ -0,0 +1,174 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobctl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// DefaultWatchInterval is the interval between two job status queries while watching.
+const DefaultWatchInterval = 2 * time.Second
+
+// Client manages jobs through KusciaAPI.
+type Client struct {
+	jobClient kusciaapi.JobServiceClient
+	logClient kusciaapi.LogServiceClient
+}
+
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		jobClient: kusciaapi.NewJobServiceClient(conn),
+		logClient: kusciaapi.NewLogServiceClient(conn),
+	}
+}
+
+// Submit creates the job and returns its id.
+func (c *Client) Submit(ctx context.Context, request *kusciaapi.CreateJobRequest) (string, error) {
+	resp, err := c.jobClient.CreateJob(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return "", fmt.Errorf("create job %s failed, %v", request.JobId, err)
+	}
+	return resp.GetData().GetJobId(), nil
+}
+
+// Query returns the current status of the job.
+func (c *Client) Query(ctx context.Context, jobID string) (*kusciaapi.JobStatusDetail, error) {
+	resp, err := c.jobClient.QueryJob(ctx, &kusciaapi.QueryJobRequest{JobId: jobID})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return nil, fmt.Errorf("query job %s failed, %v", jobID, err)
+	}
+	return resp.GetData().GetStatus(), nil
+}
+
+// Approve accepts or rejects the job on behalf of the local domain.
+func (c *Client) Approve(ctx context.Context, jobID string, accept bool, reason string) error {
+	result := kusciaapi.ApproveResult_APPROVE_RESULT_ACCEPT
+	if !accept {
+		result = kusciaapi.ApproveResult_APPROVE_RESULT_REJECT
+	}
+	resp, err := c.jobClient.ApproveJob(ctx, &kusciaapi.ApproveJobRequest{JobId: jobID, Result: result, Reason: reason})
+	if err != nil {
+		return err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return fmt.Errorf("approve job %s failed, %v", jobID, err)
+	}
+	return nil
+}
+
+// Cancel cancels the job, a cancelled job can't be restarted.
+func (c *Client) Cancel(ctx context.Context, jobID string, reason string) error {
+	resp, err := c.jobClient.CancelJob(ctx, &kusciaapi.CancelJobRequest{JobId: jobID, Reason: reason})
+	if err != nil {
+		return err
+	}
+	if err := checkStatus(resp.Status); err != nil {
+		return fmt.Errorf("cancel job %s failed, %v", jobID, err)
+	}
+	return nil
+}
+
+// Watch polls the job status and renders the task table to out every time it changes, until the
+// job reaches a final state. It returns an error if the job doesn't end in Succeeded.
+func (c *Client) Watch(ctx context.Context, jobID string, interval time.Duration, out io.Writer) error {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	var last *kusciaapi.JobStatusDetail
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status, err := c.Query(ctx, jobID)
+		if err != nil {
+			return err
+		}
+		if last == nil || !proto.Equal(last, status) {
+			fmt.Fprintf(out, "\n[%s]\n", time.Now().Format(time.DateTime))
+			RenderJobStatus(out, jobID, status)
+			last = status
+		}
+		if finished, err := jobFinished(jobID, status); finished {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Logs writes the log of the task to out. If follow is set, it keeps streaming until the container exits.
+func (c *Client) Logs(ctx context.Context, request *kusciaapi.TailTaskLogRequest, out io.Writer) error {
+	stream, err := c.logClient.TailTaskLog(ctx, request)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := checkStatus(resp.Status); err != nil {
+			return fmt.Errorf("query log of task %s failed, %v", request.TaskId, err)
+		}
+		if _, err := io.WriteString(out, resp.Log); err != nil {
+			return err
+		}
+	}
+}
+
+func jobFinished(jobID string, status *kusciaapi.JobStatusDetail) (bool, error) {
+	if status == nil {
+		return false, nil
+	}
+	switch status.State {
+	case kusciaapi.JobState_Succeeded.String():
+		return true, nil
+	case kusciaapi.JobState_Failed.String(), kusciaapi.JobState_ApprovalReject.String(), kusciaapi.JobState_Cancelled.String():
+		return true, fmt.Errorf("job %s finished with state %s", jobID, status.State)
+	default:
+		return false, nil
+	}
+}
+
+func checkStatus(status *v1alpha1.Status) error {
+	if status == nil {
+		return fmt.Errorf("empty response status")
+	}
+	if status.Code != 0 {
+		return fmt.Errorf("code: %d, message: %s", status.Code, status.Message)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobctl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type fakeJobServiceClient struct {
+	kusciaapi.JobServiceClient
+	states  []string
+	queries int
+	approve *kusciaapi.ApproveJobRequest
+}
+
+func (f *fakeJobServiceClient) QueryJob(ctx context.Context, in *kusciaapi.QueryJobRequest, opts ...grpc.CallOption) (*kusciaapi.QueryJobResponse, error) {
+	state := f.states[f.queries]
+	if f.queries < len(f.states)-1 {
+		f.queries++
+	}
+	return &kusciaapi.QueryJobResponse{
+		Status: &v1alpha1.Status{},
+		Data: &kusciaapi.QueryJobResponseData{
+			JobId: in.JobId,
+			Status: &kusciaapi.JobStatusDetail{
+				State: state,
+				Tasks: []*kusciaapi.TaskStatus{{
+					TaskId:  "task-1",
+					Alias:   "psi",
+					State:   state,
+					Parties: []*kusciaapi.PartyStatus{{DomainId: "bob", State: state}, {DomainId: "alice", State: state}},
+				}},
+			},
+		},
+	}, nil
+}
+
+func (f *fakeJobServiceClient) ApproveJob(ctx context.Context, in *kusciaapi.ApproveJobRequest, opts ...grpc.CallOption) (*kusciaapi.ApproveJobResponse, error) {
+	f.approve = in
+	return &kusciaapi.ApproveJobResponse{Status: &v1alpha1.Status{Code: 11100, Message: "job not found"}}, nil
+}
+
+type fakeLogServiceClient struct {
+	kusciaapi.LogServiceClient
+	logs []*kusciaapi.TailTaskLogResponse
+}
+
+func (f *fakeLogServiceClient) TailTaskLog(ctx context.Context, in *kusciaapi.TailTaskLogRequest, opts ...grpc.CallOption) (kusciaapi.LogService_TailTaskLogClient, error) {
+	return &fakeTailStream{logs: f.logs}, nil
+}
+
+type fakeTailStream struct {
+	grpc.ClientStream
+	logs []*kusciaapi.TailTaskLogResponse
+}
+
+func (s *fakeTailStream) Recv() (*kusciaapi.TailTaskLogResponse, error) {
+	if len(s.logs) == 0 {
+		return nil, io.EOF
+	}
+	resp := s.logs[0]
+	s.logs = s.logs[1:]
+	return resp, nil
+}
+
+func TestWatch(t *testing.T) {
+	jobClient := &fakeJobServiceClient{states: []string{"Pending", "Running", "Running", "Succeeded"}}
+	c := &Client{jobClient: jobClient}
+	out := &bytes.Buffer{}
+	assert.NoError(t, c.Watch(context.Background(), "job-1", time.Millisecond, out))
+	// the unchanged Running status is only rendered once
+	assert.Equal(t, 3, strings.Count(out.String(), "JOB: job-1"))
+	assert.Contains(t, out.String(), "alice:Succeeded bob:Succeeded")
+
+	jobClient = &fakeJobServiceClient{states: []string{"Running", "Failed"}}
+	c = &Client{jobClient: jobClient}
+	assert.ErrorContains(t, c.Watch(context.Background(), "job-1", time.Millisecond, io.Discard), "Failed")
+}
+
+func TestApprove(t *testing.T) {
+	jobClient := &fakeJobServiceClient{}
+	c := &Client{jobClient: jobClient}
+	err := c.Approve(context.Background(), "job-1", false, "bad input")
+	assert.ErrorContains(t, err, "job not found")
+	assert.Equal(t, kusciaapi.ApproveResult_APPROVE_RESULT_REJECT, jobClient.approve.Result)
+	assert.Equal(t, "bad input", jobClient.approve.Reason)
+}
+
+func TestLogs(t *testing.T) {
+	c := &Client{logClient: &fakeLogServiceClient{logs: []*kusciaapi.TailTaskLogResponse{
+		{Status: &v1alpha1.Status{}, Log: "line1\n"},
+		{Status: &v1alpha1.Status{}, Log: "line2\n"},
+	}}}
+	out := &bytes.Buffer{}
+	assert.NoError(t, c.Logs(context.Background(), &kusciaapi.TailTaskLogRequest{TaskId: "task-1"}, out))
+	assert.Equal(t, "line1\nline2\n", out.String())
+
+	c = &Client{logClient: &fakeLogServiceClient{logs: []*kusciaapi.TailTaskLogResponse{
+		{Status: &v1alpha1.Status{Code: 11101, Message: "pod not found"}},
+	}}}
+	assert.ErrorContains(t, c.Logs(context.Background(), &kusciaapi.TailTaskLogRequest{TaskId: "task-1"}, io.Discard), "pod not found")
+}
+
+func TestRenderJobStatus(t *testing.T) {
+	out := &bytes.Buffer{}
+	RenderJobStatus(out, "job-1", &kusciaapi.JobStatusDetail{
+		State:             "Failed",
+		ApproveStatusList: []*kusciaapi.PartyApproveStatus{{DomainId: "bob", State: "JobAccepted"}, {DomainId: "alice", State: "JobAccepted"}},
+		Tasks: []*kusciaapi.TaskStatus{{
+			TaskId:   "task-1",
+			State:    "Failed",
+			Progress: 0.5,
+			Parties:  []*kusciaapi.PartyStatus{{DomainId: "alice", State: "Failed", ErrMsg: "oom"}},
+		}},
+	})
+	assert.Contains(t, out.String(), "APPROVAL: alice=JobAccepted, bob=JobAccepted")
+	assert.Contains(t, out.String(), "50%")
+	assert.Contains(t, out.String(), "alice: oom")
+
+	out.Reset()
+	RenderJobStatus(out, "job-1", nil)
+	assert.Contains(t, out.String(), "not available")
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobctl
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// RenderJobStatus writes the job state and a per-task status table to w.
+func RenderJobStatus(w io.Writer, jobID string, status *kusciaapi.JobStatusDetail) {
+	if status == nil {
+		fmt.Fprintf(w, "JOB %s: status is not available yet\n", jobID)
+		return
+	}
+	fmt.Fprintf(w, "JOB: %s  STATE: %s  CREATE: %s  START: %s  END: %s\n", jobID, status.State,
+		orDash(status.CreateTime), orDash(status.StartTime), orDash(status.EndTime))
+	if status.ErrMsg != "" {
+		fmt.Fprintf(w, "MESSAGE: %s\n", status.ErrMsg)
+	}
+	if len(status.ApproveStatusList) > 0 {
+		approvals := make([]string, 0, len(status.ApproveStatusList))
+		for _, a := range status.ApproveStatusList {
+			approvals = append(approvals, fmt.Sprintf("%s=%s", a.DomainId, a.State))
+		}
+		sort.Strings(approvals)
+		fmt.Fprintf(w, "APPROVAL: %s\n", strings.Join(approvals, ", "))
+	}
+
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"TASK ID", "ALIAS", "STATE", "PROGRESS", "PARTIES", "START", "END", "MESSAGE"})
+	table.SetAutoWrapText(false)
+	for _, task := range status.Tasks {
+		parties := make([]string, 0, len(task.Parties))
+		for _, p := range task.Parties {
+			parties = append(parties, fmt.Sprintf("%s:%s", p.DomainId, p.State))
+		}
+		sort.Strings(parties)
+		table.Append([]string{
+			task.TaskId,
+			orDash(task.Alias),
+			task.State,
+			fmt.Sprintf("%.0f%%", task.Progress*100),
+			strings.Join(parties, " "),
+			orDash(task.StartTime),
+			orDash(task.EndTime),
+			taskMessage(task),
+		})
+	}
+	table.Render()
+}
+
+// taskMessage returns the task error message, falling back to the first failed party's message.
+func taskMessage(task *kusciaapi.TaskStatus) string {
+	if task.ErrMsg != "" {
+		return task.ErrMsg
+	}
+	for _, p := range task.Parties {
+		if p.ErrMsg != "" {
+			return fmt.Sprintf("%s: %s", p.DomainId, p.ErrMsg)
+		}
+	}
+	return ""
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// LoadCreateJobRequest reads a job DAG file in YAML or JSON and converts it to a CreateJobRequest.
+// The file uses the same fields as the KusciaAPI CreateJob request. task_input_config may be
+// written as an object, in which case it's serialized to the string expected by KusciaAPI.
+func LoadCreateJobRequest(file string) (*kusciaapi.CreateJobRequest, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read job file %s failed, %v", file, err)
+	}
+	return ParseCreateJobRequest(content)
+}
+
+// ParseCreateJobRequest converts the YAML or JSON content of a job DAG to a CreateJobRequest.
+func ParseCreateJobRequest(content []byte) (*kusciaapi.CreateJobRequest, error) {
+	jsonContent, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, fmt.Errorf("parse job file failed, %v", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(jsonContent, &raw); err != nil {
+		return nil, fmt.Errorf("job file must be an object, %v", err)
+	}
+	if tasks, ok := raw["tasks"].([]interface{}); ok {
+		for i, t := range tasks {
+			task, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, key := range []string{"task_input_config", "taskInputConfig"} {
+				cfg, exist := task[key]
+				if !exist {
+					continue
+				}
+				if _, isString := cfg.(string); isString {
+					continue
+				}
+				cfgBytes, err := json.Marshal(cfg)
+				if err != nil {
+					return nil, fmt.Errorf("tasks[%d].%s is invalid, %v", i, key, err)
+				}
+				task[key] = string(cfgBytes)
+			}
+		}
+	}
+	if jsonContent, err = json.Marshal(raw); err != nil {
+		return nil, err
+	}
+
+	request := &kusciaapi.CreateJobRequest{}
+	if err := protojson.Unmarshal(jsonContent, request); err != nil {
+		return nil, fmt.Errorf("job file doesn't match the CreateJob request, %v", err)
+	}
+	if len(request.Tasks) == 0 {
+		return nil, fmt.Errorf("job file must contain at least one task")
+	}
+	return request, nil
+}
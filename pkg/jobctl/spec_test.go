@@ -0,0 +1,82 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobctl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCreateJobRequest(t *testing.T) {
+	content := `
+job_id: job-1
+initiator: alice
+max_parallelism: 2
+tasks:
+- alias: psi
+  task_id: job-psi
+  app_image: secretflow-image
+  task_input_config:
+    sf_input_ids: ["alice-table"]
+  parties:
+  - domain_id: alice
+    resources:
+      cpu: "1"
+      memory: 2Gi
+  - domain_id: bob
+- alias: train
+  app_image: secretflow-image
+  dependencies: [psi]
+  task_input_config: '{"sf_input_ids":["psi-out"]}'
+  parties:
+  - domain_id: alice
+`
+	request, err := ParseCreateJobRequest([]byte(content))
+	assert.NoError(t, err)
+	assert.Equal(t, "job-1", request.JobId)
+	assert.Equal(t, "alice", request.Initiator)
+	assert.Equal(t, int32(2), request.MaxParallelism)
+	assert.Len(t, request.Tasks, 2)
+	assert.Equal(t, `{"sf_input_ids":["alice-table"]}`, request.Tasks[0].TaskInputConfig)
+	assert.Equal(t, "2Gi", request.Tasks[0].Parties[0].Resources.Memory)
+	assert.Equal(t, []string{"psi"}, request.Tasks[1].Dependencies)
+	assert.Equal(t, `{"sf_input_ids":["psi-out"]}`, request.Tasks[1].TaskInputConfig)
+}
+
+func TestParseCreateJobRequest_JSON(t *testing.T) {
+	content := `{"jobId":"job-1","initiator":"alice","tasks":[{"alias":"psi","appImage":"img","parties":[{"domainId":"alice"}]}]}`
+	request, err := ParseCreateJobRequest([]byte(content))
+	assert.NoError(t, err)
+	assert.Equal(t, "img", request.Tasks[0].AppImage)
+	assert.Equal(t, "alice", request.Tasks[0].Parties[0].DomainId)
+}
+
+func TestParseCreateJobRequest_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "not an object", content: "- a\n- b\n"},
+		{name: "unknown field", content: "job_id: a\nunknown: b\ntasks:\n- alias: a\n"},
+		{name: "no task", content: "job_id: a\ninitiator: alice\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCreateJobRequest([]byte(tt.content))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -65,7 +65,8 @@ func (s *grpcServerBean) Start(ctx context.Context, e framework.ConfBeanRegistry
 	// init grpc server opts
 	opts := []grpc.ServerOption{
 		grpc.ConnectionTimeout(time.Duration(s.config.ConnectTimeout) * time.Second),
-		grpc.ChainUnaryInterceptor(interceptor.UnaryRecoverInterceptor(pberrorcode.ErrorCode_KusciaAPIErrForUnexpected)),
+		grpc.ChainUnaryInterceptor(interceptor.UnaryRecoverInterceptor(pberrorcode.ErrorCode_KusciaAPIErrForUnexpected),
+			interceptor.GrpcServerLocaleInterceptor()),
 		grpc.StreamInterceptor(interceptor.StreamRecoverInterceptor(pberrorcode.ErrorCode_KusciaAPIErrForUnexpected)),
 		grpc.MaxRecvMsgSize(256 * 1024 * 1024), // 256MB
 	}
@@ -85,9 +86,16 @@ func (s *grpcServerBean) Start(ctx context.Context, e framework.ConfBeanRegistry
 		nlog.Fatalf("failed to listen on addr[%s]: %v", addr, err)
 	}
 	opts = append(opts, grpc.ChainUnaryInterceptor(interceptor.GrpcServerLoggingInterceptor(*s.config.InterceptorLog)))
-	// set token auth interceptor
+	// set token auth interceptor, the tenants of the master are authenticated by their own tokens or certificates
+	tenantAuthenticator, err := utils.NewTenantAuthenticator(s.config)
+	if err != nil {
+		return err
+	}
 	tokenConfig := s.config.Token
-	if s.config.Token != nil {
+	if tenantAuthenticator != nil {
+		opts = append(opts, grpc.ChainUnaryInterceptor(interceptor.GrpcServerTenantInterceptor(tenantAuthenticator)))
+		opts = append(opts, grpc.ChainStreamInterceptor(interceptor.GrpcStreamServerTenantInterceptor(tenantAuthenticator)))
+	} else if s.config.Token != nil {
 		token, err := utils.ReadToken(*tokenConfig)
 		if err != nil {
 			return err
@@ -97,6 +105,17 @@ func (s *grpcServerBean) Start(ctx context.Context, e framework.ConfBeanRegistry
 		tokenStreamInterceptor := grpc.ChainStreamInterceptor(interceptor.GrpcStreamServerTokenInterceptor(token))
 		opts = append(opts, tokenStreamInterceptor)
 	}
+	// set rate limit interceptor
+	if s.config.RateLimit != nil {
+		limiter := s.config.RateLimiters.NewLimiter(s.config.RateLimit)
+		opts = append(opts, grpc.ChainUnaryInterceptor(interceptor.GrpcServerRateLimitInterceptor(limiter)))
+		opts = append(opts, grpc.ChainStreamInterceptor(interceptor.GrpcStreamServerRateLimitInterceptor(limiter)))
+	}
+	// the standby forwards the write requests to the leader after authenticating them
+	if s.config.Forwarder != nil {
+		opts = append(opts, grpc.ChainUnaryInterceptor(s.config.Forwarder.GrpcServerInterceptor()))
+		opts = append(opts, grpc.ChainStreamInterceptor(s.config.Forwarder.GrpcStreamServerInterceptor()))
+	}
 	// set master role interceptor
 	opts = append(opts, grpc.ChainUnaryInterceptor(interceptor.GrpcServerMasterRoleInterceptor()))
 	opts = append(opts, grpc.ChainStreamInterceptor(interceptor.GrpcStreamServerMasterRoleInterceptor()))
@@ -107,16 +126,24 @@ func (s *grpcServerBean) Start(ctx context.Context, e framework.ConfBeanRegistry
 	kusciaapi.RegisterDomainServiceServer(server, grpchandler.NewDomainHandler(service.NewDomainService(s.config)))
 	kusciaapi.RegisterDomainRouteServiceServer(server, grpchandler.NewDomainRouteHandler(service.NewDomainRouteService(s.config)))
 	kusciaapi.RegisterHealthServiceServer(server, grpchandler.NewHealthHandler(service.NewHealthService()))
-	kusciaapi.RegisterDomainDataServiceServer(server, grpchandler.NewDomainDataHandler(service.NewDomainDataService(s.config)))
+	kusciaapi.RegisterDomainDataServiceServer(server, grpchandler.NewDomainDataHandler(service.NewDomainDataService(s.config),
+		service.NewDomainDataDownloadService(s.config, s.cmConfigService), service.NewDomainDataUploadService(s.config, s.cmConfigService)))
 	kusciaapi.RegisterDomainDataSourceServiceServer(server, grpchandler.NewDomainDataSourceHandler(service.NewDomainDataSourceService(s.config, s.cmConfigService)))
 	kusciaapi.RegisterServingServiceServer(server, grpchandler.NewServingHandler(service.NewServingService(s.config)))
 	kusciaapi.RegisterDomainDataGrantServiceServer(server, grpchandler.NewDomainDataGrantHandler(service.NewDomainDataGrantService(s.config)))
-	kusciaapi.RegisterCertificateServiceServer(server, grpchandler.NewCertificateHandler(newCertService(s.config)))
+	kusciaapi.RegisterCertificateServiceServer(server, grpchandler.NewCertificateHandler(newCertService(s.config), service.NewCertInventoryService(s.config)))
 	kusciaapi.RegisterConfigServiceServer(server, grpchandler.NewConfigHandler(service.NewConfigService(s.config, s.cmConfigService)))
 	kusciaapi.RegisterAppImageServiceServer(server, grpchandler.NewAppImageHandler(service.NewAppImageService(s.config)))
 	kusciaapi.RegisterLogServiceServer(server, grpchandler.NewLogHandler(service.NewLogService(s.config)))
-
-	reflection.Register(server)
+	kusciaapi.RegisterNodeServiceServer(server, grpchandler.NewNodeHandler(service.NewNodeService(s.config)))
+	kusciaapi.RegisterDebugServiceServer(server, grpchandler.NewDebugHandler(service.NewDebugService(s.config)))
+	kusciaapi.RegisterBackupServiceServer(server, grpchandler.NewBackupHandler(service.NewBackupService(s.config)))
+	kusciaapi.RegisterSearchServiceServer(server, grpchandler.NewSearchHandler(service.NewSearchService(s.config)))
+
+	// reflection lets tools like grpcurl discover the services, disable it to hide the api schema
+	if !s.config.DisableReflection {
+		reflection.Register(server)
+	}
 	nlog.Infof("grpc server listening on %s", addr)
 
 	// serve grpc
@@ -20,17 +20,23 @@ import (
 	"crypto/rsa"
 	"fmt"
 	"net/http"
+	"path"
+	"reflect"
+	"strings"
 	"sync/atomic"
 
 	"github.com/gin-gonic/gin"
-	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/confmanager/handler/httphandler/certificate"
 	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
 	apiconfig "github.com/secretflow/kuscia/pkg/kusciaapi/config"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/appimage"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/backup"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/certinventory"
 	handlerconfig "github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/config"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/debug"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/domain"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/domaindata"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/domaindatagrant"
@@ -40,9 +46,12 @@ import (
 	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/job"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/log"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/middleware"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/node"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/search"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/handler/httphandler/serving"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/service"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/utils"
+	"github.com/secretflow/kuscia/pkg/utils/meta"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/web/api"
 	"github.com/secretflow/kuscia/pkg/web/constants"
@@ -52,7 +61,9 @@ import (
 	"github.com/secretflow/kuscia/pkg/web/framework/beans"
 	frameworkconfig "github.com/secretflow/kuscia/pkg/web/framework/config"
 	"github.com/secretflow/kuscia/pkg/web/framework/router"
+	"github.com/secretflow/kuscia/pkg/web/i18n"
 	"github.com/secretflow/kuscia/pkg/web/interceptor"
+	"github.com/secretflow/kuscia/pkg/web/openapi"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 )
@@ -115,17 +126,34 @@ func (s *httpServerBean) externalGinInit(e framework.ConfBeanRegistry) error {
 	}
 	// recover middleware
 	s.externalGinBean.Use(gin.Recovery(), interceptor.HTTPServerLoggingInterceptor(*s.config.InterceptorLog))
-	// auth token
+	// the document describes the open source api only, it's registered before the auth interceptors so
+	// that browsers can open the swagger ui
+	doc := s.registerOpenAPIRoutes(s.externalGinBean)
+	// auth token, the tenants of the master are authenticated by their own tokens or certificates
+	tenantAuthenticator, err := utils.NewTenantAuthenticator(s.config)
+	if err != nil {
+		return err
+	}
 	tokenConfig := s.config.Token
-	if tokenConfig != nil {
+	if tenantAuthenticator != nil {
+		s.externalGinBean.Use(interceptor.HTTPTenantInterceptor(tenantAuthenticator))
+	} else if tokenConfig != nil {
 		token, err := utils.ReadToken(*tokenConfig)
 		if err != nil {
 			return err
 		}
 		s.externalGinBean.Use(interceptor.HTTPTokenAuthInterceptor(token))
 	}
+	// rate limit
+	if s.config.RateLimit != nil {
+		s.externalGinBean.Use(interceptor.HTTPRateLimitInterceptor(s.config.RateLimiters.NewLimiter(s.config.RateLimit)))
+	}
+	// the standby forwards the write requests to the leader after authenticating them
+	if s.config.Forwarder != nil {
+		s.externalGinBean.Use(s.config.Forwarder.HTTPInterceptor())
+	}
 	s.externalGinBean.Use(interceptor.HTTPSetMasterRoleInterceptor())
-	s.registerGroupRoutes(e, s.externalGinBean)
+	s.registerGroupRoutes(e, s.externalGinBean, doc)
 	return nil
 }
 
@@ -139,7 +167,7 @@ func (s *httpServerBean) internalGinInit(e framework.ConfBeanRegistry) error {
 	s.internalGinBean.Use(interceptor.HTTPSourceAuthInterceptor())
 	// casbin permission
 	s.internalGinBean.Use(middleware.PermissionMiddleWare)
-	s.registerGroupRoutes(e, s.internalGinBean)
+	s.registerGroupRoutes(e, s.internalGinBean, nil)
 	return nil
 }
 
@@ -163,19 +191,26 @@ func (s *httpServerBean) ServerName() string {
 	return "kusciaAPIHttpServer"
 }
 
-func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean *beans.GinBean) {
+func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean *beans.GinBean, doc *openapi.Builder) {
 	jobService := service.NewJobService(s.config)
 	domainService := service.NewDomainService(s.config)
 	routeService := service.NewDomainRouteService(s.config)
 	domainDataSourceService := service.NewDomainDataSourceService(s.config, s.cmConfigService)
 	domainDataService := service.NewDomainDataService(s.config)
+	domainDataDownloadService := service.NewDomainDataDownloadService(s.config, s.cmConfigService)
+	domainDataUploadService := service.NewDomainDataUploadService(s.config, s.cmConfigService)
 	domainDataGrantService := service.NewDomainDataGrantService(s.config)
 	servingService := service.NewServingService(s.config)
 	appImageService := service.NewAppImageService(s.config)
 	healthService := service.NewHealthService()
 	certService := newCertService(s.config)
+	certInventoryService := service.NewCertInventoryService(s.config)
 	configService := service.NewConfigService(s.config, s.cmConfigService)
 	logService := service.NewLogService(s.config)
+	nodeService := service.NewNodeService(s.config)
+	debugService := service.NewDebugService(s.config)
+	backupService := service.NewBackupService(s.config)
+	searchService := service.NewSearchService(s.config)
 	// define router groups
 	groupsRouters := []*router.GroupRouters{
 		// job group routes
@@ -185,27 +220,27 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, job.NewCreateJobHandler(jobService))},
+					ProtoHandler: job.NewCreateJobHandler(jobService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "delete",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, job.NewDeleteJobHandler(jobService))},
+					ProtoHandler: job.NewDeleteJobHandler(jobService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, job.NewQueryJobHandler(jobService))},
+					ProtoHandler: job.NewQueryJobHandler(jobService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "stop",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, job.NewStopJobHandler(jobService))},
+					ProtoHandler: job.NewStopJobHandler(jobService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "status/batchQuery",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, job.NewBatchQueryJobStatusHandler(jobService))},
+					ProtoHandler: job.NewBatchQueryJobStatusHandler(jobService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
@@ -215,22 +250,62 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "approve",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, job.NewApproveJobHandler(jobService))},
+					ProtoHandler: job.NewApproveJobHandler(jobService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "suspend",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, job.NewSuspendJobHandler(jobService))},
+					ProtoHandler: job.NewSuspendJobHandler(jobService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "restart",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, job.NewRestartJobHandler(jobService))},
+					ProtoHandler: job.NewRestartJobHandler(jobService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "cancel",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, job.NewCancelJobHandler(jobService))},
+					ProtoHandler: job.NewCancelJobHandler(jobService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "batchCancel",
+					ProtoHandler: job.NewBatchCancelJobHandler(jobService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "batchApprove",
+					ProtoHandler: job.NewBatchApproveJobHandler(jobService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "event/list",
+					ProtoHandler: job.NewListEventsHandler(jobService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "usage/query",
+					ProtoHandler: job.NewQueryJobUsageHandler(jobService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "usage/list",
+					ProtoHandler: job.NewListJobUsageHandler(jobService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "archive/query",
+					ProtoHandler: job.NewQueryArchivedJobHandler(jobService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "archive/report",
+					ProtoHandler: job.NewReportArchivedJobHandler(jobService),
+				},
+				{
+					HTTPMethod:   http.MethodGet,
+					RelativePath: "usage/export",
+					Handlers:     []gin.HandlerFunc{job.NewExportJobUsageHandler(jobService).Handle},
 				},
 			},
 		},
@@ -241,27 +316,37 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domain.NewCreateDomainHandler(domainService))},
+					ProtoHandler: domain.NewCreateDomainHandler(domainService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "delete",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domain.NewDeleteDomainHandler(domainService))},
+					ProtoHandler: domain.NewDeleteDomainHandler(domainService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "update",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domain.NewUpdateDomainHandler(domainService))},
+					ProtoHandler: domain.NewUpdateDomainHandler(domainService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domain.NewQueryDomainHandler(domainService))},
+					ProtoHandler: domain.NewQueryDomainHandler(domainService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "batchQuery",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domain.NewBatchQueryDomainHandler(domainService))},
+					ProtoHandler: domain.NewBatchQueryDomainHandler(domainService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "register",
+					ProtoHandler: domain.NewRegisterDomainHandler(domainService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "queryOnboarding",
+					ProtoHandler: domain.NewQueryDomainOnboardingHandler(domainService),
 				},
 			},
 		},
@@ -272,22 +357,27 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domainroute.NewCreateDomainRouteHandler(routeService))},
+					ProtoHandler: domainroute.NewCreateDomainRouteHandler(routeService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "delete",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domainroute.NewDeleteDomainHandler(routeService))},
+					ProtoHandler: domainroute.NewDeleteDomainHandler(routeService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domainroute.NewQueryDomainRouteHandler(routeService))},
+					ProtoHandler: domainroute.NewQueryDomainRouteHandler(routeService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "status/batchQuery",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domainroute.NewBatchQueryDomainRouteStatusHandler(routeService))},
+					ProtoHandler: domainroute.NewBatchQueryDomainRouteStatusHandler(routeService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "status/query",
+					ProtoHandler: domainroute.NewQueryDomainRouteStatusHandler(routeService),
 				},
 			},
 		},
@@ -298,32 +388,47 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindata.NewCreateDomainDataHandler(domainDataService))},
+					ProtoHandler: domaindata.NewCreateDomainDataHandler(domainDataService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "update",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindata.NewUpdateDomainDataHandler(domainDataService))},
+					ProtoHandler: domaindata.NewUpdateDomainDataHandler(domainDataService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "delete",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindata.NewDeleteDomainDataHandler(domainDataService))},
+					ProtoHandler: domaindata.NewDeleteDomainDataHandler(domainDataService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindata.NewQueryDomainDataHandler(domainDataService))},
+					ProtoHandler: domaindata.NewQueryDomainDataHandler(domainDataService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "batchQuery",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindata.NewBatchQueryDomainDataHandler(domainDataService))},
+					ProtoHandler: domaindata.NewBatchQueryDomainDataHandler(domainDataService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "list",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindata.NewListDomainDataHandler(domainDataService))},
+					ProtoHandler: domaindata.NewListDomainDataHandler(domainDataService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "maskingPolicies/set",
+					ProtoHandler: domaindata.NewSetDomainDataMaskingPoliciesHandler(domainDataService),
+				},
+				{
+					HTTPMethod:   http.MethodGet,
+					RelativePath: "download",
+					Handlers:     []gin.HandlerFunc{domaindata.NewDownloadDomainDataHandler(domainDataDownloadService).Handle},
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "upload",
+					Handlers:     []gin.HandlerFunc{domaindata.NewUploadDomainDataHandler(domainDataUploadService).Handle},
 				},
 			},
 		},
@@ -334,32 +439,32 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatasource.NewCreateDomainDataSourceHandler(domainDataSourceService))},
+					ProtoHandler: domaindatasource.NewCreateDomainDataSourceHandler(domainDataSourceService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "update",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatasource.NewUpdateDomainDataSourceHandler(domainDataSourceService))},
+					ProtoHandler: domaindatasource.NewUpdateDomainDataSourceHandler(domainDataSourceService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "delete",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatasource.NewDeleteDomainDataSourceHandler(domainDataSourceService))},
+					ProtoHandler: domaindatasource.NewDeleteDomainDataSourceHandler(domainDataSourceService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatasource.NewQueryDomainDataSourceHandler(domainDataSourceService))},
+					ProtoHandler: domaindatasource.NewQueryDomainDataSourceHandler(domainDataSourceService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "batchQuery",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatasource.NewBatchQueryDomainDataSourceHandler(domainDataSourceService))},
+					ProtoHandler: domaindatasource.NewBatchQueryDomainDataSourceHandler(domainDataSourceService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "list",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatasource.NewListDomainDataSourceHandler(domainDataSourceService))},
+					ProtoHandler: domaindatasource.NewListDomainDataSourceHandler(domainDataSourceService),
 				},
 			},
 		},
@@ -370,27 +475,47 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, serving.NewCreateServingHandler(servingService))},
+					ProtoHandler: serving.NewCreateServingHandler(servingService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "update",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, serving.NewUpdateServingHandler(servingService))},
+					ProtoHandler: serving.NewUpdateServingHandler(servingService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "delete",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, serving.NewDeleteServingHandler(servingService))},
+					ProtoHandler: serving.NewDeleteServingHandler(servingService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, serving.NewQueryServingHandler(servingService))},
+					ProtoHandler: serving.NewQueryServingHandler(servingService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "status/batchQuery",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, serving.NewBatchQueryServingStatusHandler(servingService))},
+					ProtoHandler: serving.NewBatchQueryServingStatusHandler(servingService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "canary/create",
+					ProtoHandler: serving.NewCreateServingCanaryHandler(servingService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "traffic/update",
+					ProtoHandler: serving.NewUpdateServingTrafficHandler(servingService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "promote",
+					ProtoHandler: serving.NewPromoteServingHandler(servingService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "rollback",
+					ProtoHandler: serving.NewRollbackServingHandler(servingService),
 				},
 			},
 		},
@@ -400,32 +525,42 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatagrant.NewCreateDomainDataGrantHandler(domainDataGrantService))},
+					ProtoHandler: domaindatagrant.NewCreateDomainDataGrantHandler(domainDataGrantService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "update",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatagrant.NewUpdateDomainDataGrantHandler(domainDataGrantService))},
+					ProtoHandler: domaindatagrant.NewUpdateDomainDataGrantHandler(domainDataGrantService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "delete",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatagrant.NewDeleteDomainDataGrantHandler(domainDataGrantService))},
+					ProtoHandler: domaindatagrant.NewDeleteDomainDataGrantHandler(domainDataGrantService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatagrant.NewQueryDomainDataGrantHandler(domainDataGrantService))},
+					ProtoHandler: domaindatagrant.NewQueryDomainDataGrantHandler(domainDataGrantService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "batchQuery",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatagrant.NewBatchQueryDomainDataGrantHandler(domainDataGrantService))},
+					ProtoHandler: domaindatagrant.NewBatchQueryDomainDataGrantHandler(domainDataGrantService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "list",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, domaindatagrant.NewListDomainDataGrantHandler(domainDataGrantService))},
+					ProtoHandler: domaindatagrant.NewListDomainDataGrantHandler(domainDataGrantService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "approve",
+					ProtoHandler: domaindatagrant.NewApproveDomainDataGrantHandler(domainDataGrantService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "reject",
+					ProtoHandler: domaindatagrant.NewRejectDomainDataGrantHandler(domainDataGrantService),
 				},
 			},
 		},
@@ -435,7 +570,12 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "generate",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, certificate.NewGenerateKeyCertsHandler(certService))},
+					ProtoHandler: certificate.NewGenerateKeyCertsHandler(certService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "list",
+					ProtoHandler: certinventory.NewListCertificatesHandler(certInventoryService),
 				},
 			},
 		},
@@ -445,27 +585,27 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, handlerconfig.NewCreateConfigHandler(configService))},
+					ProtoHandler: handlerconfig.NewCreateConfigHandler(configService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, handlerconfig.NewQueryConfigHandler(configService))},
+					ProtoHandler: handlerconfig.NewQueryConfigHandler(configService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "update",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, handlerconfig.NewUpdateConfigHandler(configService))},
+					ProtoHandler: handlerconfig.NewUpdateConfigHandler(configService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "delete",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, handlerconfig.NewDeleteConfigHandler(configService))},
+					ProtoHandler: handlerconfig.NewDeleteConfigHandler(configService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "batchQuery",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, handlerconfig.NewBatchQueryConfigHandler(configService))},
+					ProtoHandler: handlerconfig.NewBatchQueryConfigHandler(configService),
 				},
 			},
 		},
@@ -475,27 +615,37 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "create",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, appimage.NewCreateAppImageHandler(appImageService))},
+					ProtoHandler: appimage.NewCreateAppImageHandler(appImageService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "update",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, appimage.NewUpdateAppImageHandler(appImageService))},
+					ProtoHandler: appimage.NewUpdateAppImageHandler(appImageService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "delete",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, appimage.NewDeleteAppImageHandler(appImageService))},
+					ProtoHandler: appimage.NewDeleteAppImageHandler(appImageService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, appimage.NewQueryAppImageHandler(appImageService))},
+					ProtoHandler: appimage.NewQueryAppImageHandler(appImageService),
 				},
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "batchQuery",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, appimage.NewBatchQueryAppImageHandler(appImageService))},
+					ProtoHandler: appimage.NewBatchQueryAppImageHandler(appImageService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "prewarm",
+					ProtoHandler: appimage.NewPrewarmImageHandler(appImageService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "prewarm/query",
+					ProtoHandler: appimage.NewQueryPrewarmImageHandler(appImageService),
 				},
 			},
 		},
@@ -510,7 +660,90 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: "node/query",
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, log.NewQueryPodNodeHandler(logService))},
+					ProtoHandler: log.NewQueryPodNodeHandler(logService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "task/range",
+					ProtoHandler: log.NewQueryTaskLogHandler(logService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "task/tail",
+					Handlers:     []gin.HandlerFunc{log.NewTailTaskLogHandler(logService).Handle},
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "archive/push",
+					ProtoHandler: log.NewPushArchivedLogHandler(logService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "archive/list",
+					ProtoHandler: log.NewListArchivedLogHandler(logService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "archive/download",
+					ProtoHandler: log.NewDownloadArchivedLogHandler(logService),
+				},
+			},
+		},
+		// node group routes
+		{
+			Group: "api/v1/node",
+			Routes: []*router.Router{
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "cordon",
+					ProtoHandler: node.NewCordonNodeHandler(nodeService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "uncordon",
+					ProtoHandler: node.NewUncordonNodeHandler(nodeService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "drain",
+					ProtoHandler: node.NewDrainNodeHandler(nodeService),
+				},
+			},
+		},
+		// resource group routes
+		{
+			Group: "api/v1/resource",
+			Routes: []*router.Router{
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "search",
+					ProtoHandler: search.NewSearchHandler(searchService),
+				},
+			},
+		},
+		// backup group routes
+		{
+			Group: "api/v1/backup",
+			Routes: []*router.Router{
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "create",
+					ProtoHandler: backup.NewCreateBackupHandler(backupService),
+				},
+				{
+					HTTPMethod:   http.MethodPost,
+					RelativePath: "restore",
+					ProtoHandler: backup.NewRestoreBackupHandler(backupService),
+				},
+			},
+		},
+		{
+			Group: "api/v1/debug",
+			Routes: []*router.Router{
+				{
+					HTTPMethod:   http.MethodGet,
+					RelativePath: "exec",
+					Handlers:     []gin.HandlerFunc{debug.NewExecContainerHandler(debugService).Handle},
 				},
 			},
 		},
@@ -521,7 +754,7 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 				{
 					HTTPMethod:   http.MethodPost,
 					RelativePath: constants.HealthAPI,
-					Handlers:     []gin.HandlerFunc{protoDecorator(e, health.NewReadyHandler(healthService))},
+					ProtoHandler: health.NewReadyHandler(healthService),
 				},
 			},
 		},
@@ -531,11 +764,57 @@ func (s *httpServerBean) registerGroupRoutes(e framework.ConfBeanRegistry, bean
 	for _, gr := range groupsRouters {
 		group := bean.Group(gr.Group, gr.GroupMiddleware...)
 		for _, route := range gr.Routes {
-			group.Handle(route.HTTPMethod, route.RelativePath, route.Handlers...)
+			handlers := route.Handlers
+			if route.ProtoHandler != nil {
+				handlers = append(handlers, protoDecorator(e, route.ProtoHandler))
+				if doc != nil {
+					documentRoute(doc, gr.Group, route)
+				}
+			}
+			group.Handle(route.HTTPMethod, route.RelativePath, handlers...)
 		}
 	}
 }
 
+// registerOpenAPIRoutes serves the document of the routes registered later, the document is only
+// marshaled on the first request.
+func (s *httpServerBean) registerOpenAPIRoutes(bean *beans.GinBean) *openapi.Builder {
+	conf := s.config.OpenAPI
+	if conf != nil && conf.Disabled {
+		return nil
+	}
+	doc := openapi.NewBuilder(openapi.Info{
+		Title:       "Kuscia API",
+		Description: "All the apis respond with a status, whose code is one of the ErrorCode schema and 0 means success.",
+		Version:     meta.KusciaVersionString(),
+	}, true)
+	if s.config.Token != nil {
+		doc.AddHeaderAuth("token", constants.TokenHeader)
+	}
+	doc.AddIntEnum("ErrorCode", "Error codes of the status in responses.", pberrorcode.ErrorCode(0).Descriptor())
+	bean.Handle(http.MethodGet, openapi.SpecPath, openapi.SpecHandler(doc))
+	if conf != nil && conf.SwaggerUI {
+		bean.Handle(http.MethodGet, openapi.SwaggerUIPath, openapi.SwaggerUIHandler("Kuscia API", "/"+openapi.SpecPath, conf.SwaggerUIAssetsURL))
+	}
+	return doc
+}
+
+func documentRoute(doc *openapi.Builder, group string, route *router.Router) {
+	reqType, respType := route.ProtoHandler.GetType()
+	req, _ := reflect.New(reqType).Interface().(proto.Message)
+	resp, _ := reflect.New(respType).Interface().(proto.Message)
+	if req == nil || resp == nil {
+		return
+	}
+	tag := strings.TrimPrefix(group, "api/v1/")
+	if tag == "" {
+		tag = strings.Trim(route.RelativePath, "/")
+	}
+	if err := doc.AddOperation(route.HTTPMethod, "/"+path.Join(group, route.RelativePath), tag, req, resp); err != nil {
+		nlog.Warnf("Document route %s failed: %v", route.RelativePath, err)
+	}
+}
+
 func newCertService(config *apiconfig.KusciaAPIConfig) cmservice.ICertificateService {
 	var certValue = &atomic.Value{}
 	var privateKey *rsa.PrivateKey
@@ -559,24 +838,32 @@ func newCertService(config *apiconfig.KusciaAPIConfig) cmservice.ICertificateSer
 
 // protoDecorator is used to wrap handler.
 func protoDecorator(e framework.ConfBeanRegistry, handler api.ProtoHandler) gin.HandlerFunc {
-	return decorator.CustomProtoDecoratorMaker(setKusciaAPIErrorResp(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), setKusciaAPIErrorResp(pberrorcode.ErrorCode_KusciaAPIErrForUnexpected))(e, handler)
+	return decorator.ProtoDecorator(e, handler, &decorator.ProtoDecoratorOptions{
+		ValidateFailedHandler:   setKusciaAPIErrorResp(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate),
+		UnexpectedErrorHandler:  setKusciaAPIErrorResp(pberrorcode.ErrorCode_KusciaAPIErrForUnexpected),
+		PostProcessHandler:      localizeResponse,
+		RenderJSONUseProtoNames: true,
+	})
+}
+
+// localizeResponse localizes the status message of the response by the Accept-Language header.
+func localizeResponse(response api.ProtoResponse, bizContext *api.BizContext) {
+	locale := i18n.ParseAcceptLanguage(bizContext.Context.GetHeader(i18n.AcceptLanguageHeader))
+	if i18n.LocalizeResponse(response, locale) {
+		bizContext.Context.Header(i18n.ContentLanguageHeader, locale)
+	}
 }
 
 func setKusciaAPIErrorResp(errCode pberrorcode.ErrorCode) func(flow *decorator.BizFlow, errs *errorcode.Errs) (response api.ProtoResponse) {
 	return func(flow *decorator.BizFlow, errs *errorcode.Errs) (response api.ProtoResponse) {
-
+		// return the message itself rather than the json, so it can be rendered as protobuf as well
 		wrappedErr := fmt.Errorf("%s", errs)
-		resp := v1alpha1.ErrorResponse{
+		return &v1alpha1.ErrorResponse{
 			Status: &v1alpha1.Status{
 				Code:    int32(errCode),
 				Message: wrappedErr.Error(),
 			},
 		}
-		bytes, _ := protojson.Marshal(&resp)
-		response = &api.AnyStringProto{
-			Content: string(bytes),
-		}
-		return response
 	}
 }
 
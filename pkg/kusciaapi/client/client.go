@@ -0,0 +1,211 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client is the go sdk of KusciaAPI, it talks to either the grpc or the http server with the
+// same typed methods.
+package client
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/secretflow/kuscia/pkg/utils/network"
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/interceptor"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// Client calls all the services of KusciaAPI. A response whose status isn't success is returned as
+// a *StatusError, and transient errors are retried according to the RetryConfig.
+type Client struct {
+	kusciaapi.AppImageServiceClient
+	kusciaapi.CertificateServiceClient
+	kusciaapi.ConfigServiceClient
+	kusciaapi.DomainServiceClient
+	kusciaapi.DomainRouteServiceClient
+	kusciaapi.DomainDataServiceClient
+	kusciaapi.DomainDataGrantServiceClient
+	kusciaapi.DomainDataSourceServiceClient
+	kusciaapi.HealthServiceClient
+	kusciaapi.JobServiceClient
+	kusciaapi.LogServiceClient
+	kusciaapi.NodeServiceClient
+	kusciaapi.SearchServiceClient
+	kusciaapi.ServingServiceClient
+
+	grpcConn *grpc.ClientConn
+}
+
+// New creates a client from conf, the http server is used if the endpoint is an url, otherwise the grpc server.
+func New(conf *Config) (*Client, error) {
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+	tlsConfig, err := conf.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	token, err := conf.token()
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.isHTTP() {
+		conn := &httpConn{
+			baseURL: strings.TrimSuffix(conf.Endpoint, "/"),
+			token:   token,
+			client:  utils.BuildHTTPClient(tlsConfig),
+		}
+		return newClient(conn, conf, nil), nil
+	}
+
+	dialOpts := network.BuildGrpcOptions(tlsConfig)
+	if token != "" {
+		dialOpts = append(dialOpts,
+			grpc.WithUnaryInterceptor(interceptor.GrpcClientTokenInterceptor(token)),
+			grpc.WithStreamInterceptor(streamTokenInterceptor(token)))
+	}
+	conn, err := grpc.Dial(conf.Endpoint, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(conn, conf, conn), nil
+}
+
+// NewWithConn creates a client over an established connection with the default timeout and retries.
+func NewWithConn(cc grpc.ClientConnInterface) *Client {
+	return newClient(cc, &Config{}, nil)
+}
+
+func newClient(cc grpc.ClientConnInterface, conf *Config, grpcConn *grpc.ClientConn) *Client {
+	cc = &callConn{
+		ClientConnInterface: cc,
+		timeout:             conf.timeout(),
+		retry:               conf.Retry,
+	}
+	return &Client{
+		AppImageServiceClient:         kusciaapi.NewAppImageServiceClient(cc),
+		CertificateServiceClient:      kusciaapi.NewCertificateServiceClient(cc),
+		ConfigServiceClient:           kusciaapi.NewConfigServiceClient(cc),
+		DomainServiceClient:           kusciaapi.NewDomainServiceClient(cc),
+		DomainRouteServiceClient:      kusciaapi.NewDomainRouteServiceClient(cc),
+		DomainDataServiceClient:       kusciaapi.NewDomainDataServiceClient(cc),
+		DomainDataGrantServiceClient:  kusciaapi.NewDomainDataGrantServiceClient(cc),
+		DomainDataSourceServiceClient: kusciaapi.NewDomainDataSourceServiceClient(cc),
+		HealthServiceClient:           kusciaapi.NewHealthServiceClient(cc),
+		JobServiceClient:              kusciaapi.NewJobServiceClient(cc),
+		LogServiceClient:              kusciaapi.NewLogServiceClient(cc),
+		NodeServiceClient:             kusciaapi.NewNodeServiceClient(cc),
+		SearchServiceClient:           kusciaapi.NewSearchServiceClient(cc),
+		ServingServiceClient:          kusciaapi.NewServingServiceClient(cc),
+		grpcConn:                      grpcConn,
+	}
+}
+
+// Close releases the grpc connection created by New.
+func (c *Client) Close() error {
+	if c.grpcConn == nil {
+		return nil
+	}
+	return c.grpcConn.Close()
+}
+
+func streamTokenInterceptor(token string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx = metadata.AppendToOutgoingContext(ctx, strings.ToLower(constants.TokenHeader), token)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// callConn applies the timeout, retries and status conversion to the unary calls of both servers.
+type callConn struct {
+	grpc.ClientConnInterface
+	timeout time.Duration
+	retry   *RetryConfig
+}
+
+func (c *callConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	backoff := c.retry.initialBackoff()
+	for attempt := 1; ; attempt++ {
+		err := c.invoke(ctx, method, args, reply, opts...)
+		if err == nil || attempt >= c.retry.maxAttempts() {
+			return err
+		}
+		delay, ok := retryDelay(method, err, backoff)
+		if !ok {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		if msg, ok := reply.(proto.Message); ok {
+			proto.Reset(msg)
+		}
+		backoff = min(backoff*2, c.retry.maxBackoff())
+	}
+}
+
+func (c *callConn) invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	if err := c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...); err != nil {
+		return err
+	}
+	if r, ok := reply.(interface{ GetStatus() *v1alpha1.Status }); ok {
+		if st := r.GetStatus(); st != nil && st.Code != int32(pberrorcode.ErrorCode_SUCCESS) {
+			return &StatusError{Status: st}
+		}
+	}
+	return nil
+}
+
+// retryDelay reports whether the call should be retried. Errors carrying a RetryInfo are retried with
+// the suggested delay, while unavailable servers are only retried for the read methods, since a write
+// may have been applied before the connection broke.
+func retryDelay(method string, err error, backoff time.Duration) (time.Duration, bool) {
+	if delay, ok := RetryDelay(err); ok {
+		return max(delay, backoff), true
+	}
+	if status.Code(err) == codes.Unavailable && isReadMethod(method) {
+		return backoff, true
+	}
+	return 0, false
+}
+
+func isReadMethod(method string) bool {
+	name := path.Base(method)
+	for _, prefix := range []string{"Query", "BatchQuery", "List", "HealthZ"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
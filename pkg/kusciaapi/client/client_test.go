@@ -0,0 +1,207 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type fakeJobServer struct {
+	kusciaapi.UnimplementedJobServiceServer
+	queryCalls  int
+	createCalls int
+}
+
+func (s *fakeJobServer) QueryJob(ctx context.Context, req *kusciaapi.QueryJobRequest) (*kusciaapi.QueryJobResponse, error) {
+	s.queryCalls++
+	if s.queryCalls == 1 {
+		return nil, status.Error(codes.Unavailable, "connection reset")
+	}
+	return &kusciaapi.QueryJobResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   &kusciaapi.QueryJobResponseData{JobId: req.JobId},
+	}, nil
+}
+
+func (s *fakeJobServer) CreateJob(ctx context.Context, req *kusciaapi.CreateJobRequest) (*kusciaapi.CreateJobResponse, error) {
+	s.createCalls++
+	if s.createCalls == 1 {
+		return nil, status.Error(codes.Unavailable, "connection reset")
+	}
+	return &kusciaapi.CreateJobResponse{
+		Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+			utils.NewFieldViolation("job_id", "job id is invalid")),
+	}, nil
+}
+
+func (s *fakeJobServer) BatchQueryJobStatus(ctx context.Context, req *kusciaapi.BatchQueryJobStatusRequest) (*kusciaapi.BatchQueryJobStatusResponse, error) {
+	data := &kusciaapi.BatchQueryJobStatusResponseData{}
+	for _, id := range req.JobIds {
+		data.Jobs = append(data.Jobs, &kusciaapi.JobStatus{JobId: id})
+	}
+	return &kusciaapi.BatchQueryJobStatusResponse{Status: utils.BuildSuccessResponseStatus(), Data: data}, nil
+}
+
+func newBufconnClient(t *testing.T, server *fakeJobServer) *Client {
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	kusciaapi.RegisterJobServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	c := NewWithConn(conn)
+	c.JobServiceClient = kusciaapi.NewJobServiceClient(&callConn{
+		ClientConnInterface: conn,
+		timeout:             defaultTimeout,
+		retry:               &RetryConfig{MaxAttempts: 2},
+	})
+	return c
+}
+
+func TestClientRetry(t *testing.T) {
+	server := &fakeJobServer{}
+	c := newBufconnClient(t, server)
+
+	resp, err := c.QueryJob(context.Background(), &kusciaapi.QueryJobRequest{JobId: "job-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "job-1", resp.Data.JobId)
+	assert.Equal(t, 2, server.queryCalls)
+
+	// writes aren't retried when the server is unavailable
+	_, err = c.CreateJob(context.Background(), &kusciaapi.CreateJobRequest{JobId: "job-1"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, server.createCalls)
+}
+
+func TestClientStatusError(t *testing.T) {
+	server := &fakeJobServer{createCalls: 1}
+	c := newBufconnClient(t, server)
+
+	_, err := c.CreateJob(context.Background(), &kusciaapi.CreateJobRequest{JobId: "job-1"})
+	assert.Equal(t, pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, Code(err))
+	violations := FieldViolations(err)
+	assert.Len(t, violations, 1)
+	assert.Equal(t, "job_id", violations[0].Field)
+}
+
+func TestBatchQueryJobStatusAll(t *testing.T) {
+	c := newBufconnClient(t, &fakeJobServer{})
+
+	jobs, err := c.BatchQueryJobStatusAll(context.Background(), []string{"a", "b", "c", "d", "e"}, 2)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 5)
+	assert.Equal(t, "e", jobs[4].JobId)
+}
+
+func TestPages(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2}, {3}}, Pages([]int{1, 2, 3}, 2))
+	assert.Empty(t, Pages([]int{}, 2))
+	assert.Len(t, Pages(make([]int, 101), 0), 2)
+}
+
+func TestHTTPClient(t *testing.T) {
+	var token string
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Header.Get("Token")
+		switch r.URL.Path {
+		case "/api/v1/domain/query":
+			attempts++
+			if attempts == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+			body, _ := io.ReadAll(r.Body)
+			req := &kusciaapi.QueryDomainRequest{}
+			assert.NoError(t, proto.Unmarshal(body, req))
+			content, _ := proto.Marshal(&kusciaapi.QueryDomainResponse{
+				Status: utils.BuildSuccessResponseStatus(),
+				Data:   &kusciaapi.QueryDomainResponseData{DomainId: req.DomainId},
+			})
+			w.Header().Set("Content-Type", "application/x-protobuf")
+			w.Write(content)
+		default:
+			// servers without content negotiation respond json
+			content, _ := protojson.Marshal(&kusciaapi.DeleteDomainResponse{
+				Status: &v1alpha1.Status{Code: int32(pberrorcode.ErrorCode_KusciaAPIErrDomainNotExists), Message: "not found"},
+			})
+			w.Write(content)
+		}
+	}))
+	defer server.Close()
+
+	c, err := New(&Config{Endpoint: server.URL, Protocol: common.NOTLS, Token: "secret"})
+	assert.NoError(t, err)
+	defer c.Close()
+
+	resp, err := c.QueryDomain(context.Background(), &kusciaapi.QueryDomainRequest{DomainId: "alice"})
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", resp.Data.DomainId)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, "secret", token)
+
+	_, err = c.DeleteDomain(context.Background(), &kusciaapi.DeleteDomainRequest{DomainId: "bob"})
+	assert.Equal(t, pberrorcode.ErrorCode_KusciaAPIErrDomainNotExists, Code(err))
+
+	_, err = c.WatchJob(context.Background(), &kusciaapi.WatchJobRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestHTTPStatusError(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"3"}}}
+	err := httpStatusError(resp, nil)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	delay, ok := RetryDelay(err)
+	assert.True(t, ok)
+	assert.Equal(t, float64(3), delay.Seconds())
+
+	resp = &http.Response{StatusCode: http.StatusUnauthorized}
+	assert.Equal(t, codes.Unauthenticated, status.Code(httpStatusError(resp, nil)))
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.Error(t, (&Config{}).validate())
+	assert.Error(t, (&Config{Endpoint: "localhost:8083"}).validate())
+	assert.Error(t, (&Config{Endpoint: "localhost:8083", Protocol: "tls"}).validate())
+	assert.NoError(t, (&Config{Endpoint: "localhost:8083", Protocol: "tls", CAFile: "ca.crt"}).validate())
+	assert.NoError(t, NewLocalConfig("/home/kuscia", "localhost:8083", common.MTLS).validate())
+}
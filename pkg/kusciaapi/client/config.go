@@ -0,0 +1,190 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	tlsutil "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+const (
+	defaultTimeout        = 30 * time.Second
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = 200 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+)
+
+// Config of the client, the protocol and cert files are the same as the kusciaAPI of kuscia.yaml.
+type Config struct {
+	// Endpoint is the host:port of the grpc server, or the url of the http server like https://localhost:8082.
+	Endpoint string          `yaml:"endpoint"`
+	Protocol common.Protocol `yaml:"protocol,omitempty"`
+	// Token is used in preference to TokenFile.
+	Token     string `yaml:"token,omitempty"`
+	TokenFile string `yaml:"tokenFile,omitempty"`
+	// CAFile verifies the server for TLS and MTLS, CertFile and KeyFile are only required by MTLS.
+	CAFile   string `yaml:"caFile,omitempty"`
+	CertFile string `yaml:"certFile,omitempty"`
+	KeyFile  string `yaml:"keyFile,omitempty"`
+	// ServerName overrides the host name used to verify the server certificate.
+	ServerName string `yaml:"serverName,omitempty"`
+	// TimeoutSeconds is the timeout of unary calls whose context has no deadline.
+	TimeoutSeconds int          `yaml:"timeoutSeconds,omitempty"`
+	Retry          *RetryConfig `yaml:"retry,omitempty"`
+}
+
+// RetryConfig controls the retries of calls failed with transient errors.
+type RetryConfig struct {
+	// MaxAttempts includes the first call, 1 disables retries.
+	MaxAttempts           int `yaml:"maxAttempts,omitempty"`
+	InitialBackoffSeconds int `yaml:"initialBackoffSeconds,omitempty"`
+	MaxBackoffSeconds     int `yaml:"maxBackoffSeconds,omitempty"`
+}
+
+// LoadConfig reads the config from a yaml file.
+func LoadConfig(path string) (*Config, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	conf := &Config{}
+	if err := yaml.Unmarshal(content, conf); err != nil {
+		return nil, fmt.Errorf("unmarshal client config %s failed, %v", path, err)
+	}
+	return conf, nil
+}
+
+// NewLocalConfig builds the config of operators running inside a kuscia node, it uses the certs and token
+// generated by kuscia in rootDir, e.g. /home/kuscia.
+func NewLocalConfig(rootDir, endpoint string, protocol common.Protocol) *Config {
+	certDir := filepath.Join(rootDir, common.CertPrefix)
+	return &Config{
+		Endpoint:  endpoint,
+		Protocol:  protocol,
+		TokenFile: filepath.Join(certDir, "token"),
+		CAFile:    filepath.Join(certDir, "ca.crt"),
+		CertFile:  filepath.Join(certDir, "kusciaapi-server.crt"),
+		KeyFile:   filepath.Join(certDir, "kusciaapi-server.key"),
+	}
+}
+
+func (c *Config) validate() error {
+	if c.Endpoint == "" {
+		return fmt.Errorf("endpoint can't be empty")
+	}
+	switch c.protocol() {
+	case common.NOTLS:
+	case common.TLS:
+		if c.CAFile == "" {
+			return fmt.Errorf("caFile is required by protocol %s", c.Protocol)
+		}
+	case common.MTLS:
+		if c.CAFile == "" || c.CertFile == "" || c.KeyFile == "" {
+			return fmt.Errorf("caFile, certFile and keyFile are required by protocol %s", c.Protocol)
+		}
+	default:
+		return fmt.Errorf("protocol %s is invalid, must be one of NOTLS, TLS and MTLS", c.Protocol)
+	}
+	if c.TimeoutSeconds < 0 {
+		return fmt.Errorf("timeoutSeconds can't be negative")
+	}
+	return nil
+}
+
+// protocol defaults to MTLS, the same as kuscia.
+func (c *Config) protocol() common.Protocol {
+	if c.Protocol == "" {
+		return common.MTLS
+	}
+	return common.Protocol(strings.ToUpper(string(c.Protocol)))
+}
+
+func (c *Config) isHTTP() bool {
+	return strings.HasPrefix(c.Endpoint, "http://") || strings.HasPrefix(c.Endpoint, "https://")
+}
+
+func (c *Config) timeout() time.Duration {
+	if c.TimeoutSeconds > 0 {
+		return time.Duration(c.TimeoutSeconds) * time.Second
+	}
+	return defaultTimeout
+}
+
+func (c *Config) token() (string, error) {
+	if c.Token != "" || c.TokenFile == "" {
+		return c.Token, nil
+	}
+	data, err := os.ReadFile(c.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("read token file %s failed, %v", c.TokenFile, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	var config *tls.Config
+	switch c.protocol() {
+	case common.NOTLS:
+		return nil, nil
+	case common.TLS:
+		caCert, err := tlsutil.LoadCertFile(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificate found in %s", c.CAFile)
+		}
+		config = &tls.Config{RootCAs: pool}
+	default:
+		var err error
+		if config, err = tlsutil.BuildClientTLSConfigViaPath(c.CAFile, c.CertFile, c.KeyFile); err != nil {
+			return nil, err
+		}
+	}
+	config.ServerName = c.ServerName
+	return config, nil
+}
+
+func (c *RetryConfig) maxAttempts() int {
+	if c == nil || c.MaxAttempts == 0 {
+		return defaultMaxAttempts
+	}
+	return c.MaxAttempts
+}
+
+func (c *RetryConfig) initialBackoff() time.Duration {
+	if c == nil || c.InitialBackoffSeconds <= 0 {
+		return defaultInitialBackoff
+	}
+	return time.Duration(c.InitialBackoffSeconds) * time.Second
+}
+
+func (c *RetryConfig) maxBackoff() time.Duration {
+	if c == nil || c.MaxBackoffSeconds <= 0 {
+		return defaultMaxBackoff
+	}
+	return time.Duration(c.MaxBackoffSeconds) * time.Second
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+)
+
+// StatusError is returned when KusciaAPI responds with a status whose code isn't success.
+type StatusError struct {
+	Status *v1alpha1.Status
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("kusciaapi error, code: %d(%s), message: %s", e.Status.Code, pberrorcode.ErrorCode(e.Status.Code), e.Status.Message)
+}
+
+// Code returns the error code of err, ErrorCode_SUCCESS if err isn't a StatusError.
+func Code(err error) pberrorcode.ErrorCode {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return pberrorcode.ErrorCode(statusErr.Status.Code)
+	}
+	return pberrorcode.ErrorCode_SUCCESS
+}
+
+// FieldViolations returns the invalid request fields reported by KusciaAPI.
+func FieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, detail := range errorDetails(err) {
+		badRequest := &errdetails.BadRequest{}
+		if detail.MessageIs(badRequest) && detail.UnmarshalTo(badRequest) == nil {
+			violations = append(violations, badRequest.FieldViolations...)
+		}
+	}
+	return violations
+}
+
+// RetryDelay returns the delay suggested by the server if err may succeed on retry.
+func RetryDelay(err error) (time.Duration, bool) {
+	for _, detail := range errorDetails(err) {
+		retryInfo := &errdetails.RetryInfo{}
+		if detail.MessageIs(retryInfo) && detail.UnmarshalTo(retryInfo) == nil {
+			return retryInfo.RetryDelay.AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+func errorDetails(err error) []*anypb.Any {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Status.Details
+	}
+	if st, ok := status.FromError(err); ok && st.Code() != codes.OK {
+		return st.Proto().Details
+	}
+	return nil
+}
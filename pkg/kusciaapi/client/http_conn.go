@@ -0,0 +1,224 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const contentTypeProtobuf = "application/x-protobuf"
+
+// httpRoutes maps the grpc methods to the routes of the http server. Streaming methods are only served
+// by grpc.
+var httpRoutes = map[string]string{
+	kusciaapi.JobService_CreateJob_FullMethodName:           "/api/v1/job/create",
+	kusciaapi.JobService_QueryJob_FullMethodName:            "/api/v1/job/query",
+	kusciaapi.JobService_BatchQueryJobStatus_FullMethodName: "/api/v1/job/status/batchQuery",
+	kusciaapi.JobService_StopJob_FullMethodName:             "/api/v1/job/stop",
+	kusciaapi.JobService_RestartJob_FullMethodName:          "/api/v1/job/restart",
+	kusciaapi.JobService_SuspendJob_FullMethodName:          "/api/v1/job/suspend",
+	kusciaapi.JobService_CancelJob_FullMethodName:           "/api/v1/job/cancel",
+	kusciaapi.JobService_DeleteJob_FullMethodName:           "/api/v1/job/delete",
+	kusciaapi.JobService_ApproveJob_FullMethodName:          "/api/v1/job/approve",
+	kusciaapi.JobService_BatchCancelJob_FullMethodName:      "/api/v1/job/batchCancel",
+	kusciaapi.JobService_BatchApproveJob_FullMethodName:     "/api/v1/job/batchApprove",
+	kusciaapi.JobService_ListEvents_FullMethodName:          "/api/v1/job/event/list",
+	kusciaapi.JobService_QueryJobUsage_FullMethodName:       "/api/v1/job/usage/query",
+	kusciaapi.JobService_ListJobUsage_FullMethodName:        "/api/v1/job/usage/list",
+	kusciaapi.JobService_QueryArchivedJob_FullMethodName:    "/api/v1/job/archive/query",
+	kusciaapi.JobService_ReportArchivedJob_FullMethodName:   "/api/v1/job/archive/report",
+
+	kusciaapi.DomainService_CreateDomain_FullMethodName:     "/api/v1/domain/create",
+	kusciaapi.DomainService_QueryDomain_FullMethodName:      "/api/v1/domain/query",
+	kusciaapi.DomainService_UpdateDomain_FullMethodName:     "/api/v1/domain/update",
+	kusciaapi.DomainService_DeleteDomain_FullMethodName:     "/api/v1/domain/delete",
+	kusciaapi.DomainService_BatchQueryDomain_FullMethodName: "/api/v1/domain/batchQuery",
+
+	kusciaapi.DomainRouteService_CreateDomainRoute_FullMethodName:           "/api/v1/route/create",
+	kusciaapi.DomainRouteService_DeleteDomainRoute_FullMethodName:           "/api/v1/route/delete",
+	kusciaapi.DomainRouteService_QueryDomainRoute_FullMethodName:            "/api/v1/route/query",
+	kusciaapi.DomainRouteService_BatchQueryDomainRouteStatus_FullMethodName: "/api/v1/route/status/batchQuery",
+	kusciaapi.DomainRouteService_QueryDomainRouteStatus_FullMethodName:      "/api/v1/route/status/query",
+
+	kusciaapi.DomainDataService_CreateDomainData_FullMethodName:     "/api/v1/domaindata/create",
+	kusciaapi.DomainDataService_UpdateDomainData_FullMethodName:     "/api/v1/domaindata/update",
+	kusciaapi.DomainDataService_DeleteDomainData_FullMethodName:     "/api/v1/domaindata/delete",
+	kusciaapi.DomainDataService_QueryDomainData_FullMethodName:      "/api/v1/domaindata/query",
+	kusciaapi.DomainDataService_BatchQueryDomainData_FullMethodName: "/api/v1/domaindata/batchQuery",
+	kusciaapi.DomainDataService_ListDomainData_FullMethodName:       "/api/v1/domaindata/list",
+
+	kusciaapi.DomainDataSourceService_CreateDomainDataSource_FullMethodName:     "/api/v1/domaindatasource/create",
+	kusciaapi.DomainDataSourceService_UpdateDomainDataSource_FullMethodName:     "/api/v1/domaindatasource/update",
+	kusciaapi.DomainDataSourceService_DeleteDomainDataSource_FullMethodName:     "/api/v1/domaindatasource/delete",
+	kusciaapi.DomainDataSourceService_QueryDomainDataSource_FullMethodName:      "/api/v1/domaindatasource/query",
+	kusciaapi.DomainDataSourceService_BatchQueryDomainDataSource_FullMethodName: "/api/v1/domaindatasource/batchQuery",
+	kusciaapi.DomainDataSourceService_ListDomainDataSource_FullMethodName:       "/api/v1/domaindatasource/list",
+
+	kusciaapi.DomainDataGrantService_CreateDomainDataGrant_FullMethodName:     "/api/v1/domaindatagrant/create",
+	kusciaapi.DomainDataGrantService_UpdateDomainDataGrant_FullMethodName:     "/api/v1/domaindatagrant/update",
+	kusciaapi.DomainDataGrantService_DeleteDomainDataGrant_FullMethodName:     "/api/v1/domaindatagrant/delete",
+	kusciaapi.DomainDataGrantService_QueryDomainDataGrant_FullMethodName:      "/api/v1/domaindatagrant/query",
+	kusciaapi.DomainDataGrantService_BatchQueryDomainDataGrant_FullMethodName: "/api/v1/domaindatagrant/batchQuery",
+	kusciaapi.DomainDataGrantService_ListDomainDataGrant_FullMethodName:       "/api/v1/domaindatagrant/list",
+
+	kusciaapi.ServingService_CreateServing_FullMethodName:           "/api/v1/serving/create",
+	kusciaapi.ServingService_QueryServing_FullMethodName:            "/api/v1/serving/query",
+	kusciaapi.ServingService_UpdateServing_FullMethodName:           "/api/v1/serving/update",
+	kusciaapi.ServingService_DeleteServing_FullMethodName:           "/api/v1/serving/delete",
+	kusciaapi.ServingService_BatchQueryServingStatus_FullMethodName: "/api/v1/serving/status/batchQuery",
+
+	kusciaapi.CertificateService_GenerateKeyCerts_FullMethodName: "/api/v1/certificate/generate",
+	kusciaapi.CertificateService_ListCertificates_FullMethodName: "/api/v1/certificate/list",
+
+	kusciaapi.ConfigService_CreateConfig_FullMethodName:     "/api/v1/config/create",
+	kusciaapi.ConfigService_QueryConfig_FullMethodName:      "/api/v1/config/query",
+	kusciaapi.ConfigService_UpdateConfig_FullMethodName:     "/api/v1/config/update",
+	kusciaapi.ConfigService_DeleteConfig_FullMethodName:     "/api/v1/config/delete",
+	kusciaapi.ConfigService_BatchQueryConfig_FullMethodName: "/api/v1/config/batchQuery",
+
+	kusciaapi.AppImageService_CreateAppImage_FullMethodName:     "/api/v1/appimage/create",
+	kusciaapi.AppImageService_QueryAppImage_FullMethodName:      "/api/v1/appimage/query",
+	kusciaapi.AppImageService_UpdateAppImage_FullMethodName:     "/api/v1/appimage/update",
+	kusciaapi.AppImageService_DeleteAppImage_FullMethodName:     "/api/v1/appimage/delete",
+	kusciaapi.AppImageService_BatchQueryAppImage_FullMethodName: "/api/v1/appimage/batchQuery",
+	kusciaapi.AppImageService_PrewarmImage_FullMethodName:       "/api/v1/appimage/prewarm",
+	kusciaapi.AppImageService_QueryPrewarmImage_FullMethodName:  "/api/v1/appimage/prewarm/query",
+
+	kusciaapi.LogService_QueryPodNode_FullMethodName:        "/api/v1/log/node/query",
+	kusciaapi.LogService_QueryTaskLog_FullMethodName:        "/api/v1/log/task/range",
+	kusciaapi.LogService_PushArchivedLog_FullMethodName:     "/api/v1/log/archive/push",
+	kusciaapi.LogService_ListArchivedLog_FullMethodName:     "/api/v1/log/archive/list",
+	kusciaapi.LogService_DownloadArchivedLog_FullMethodName: "/api/v1/log/archive/download",
+
+	kusciaapi.NodeService_CordonNode_FullMethodName:   "/api/v1/node/cordon",
+	kusciaapi.NodeService_UncordonNode_FullMethodName: "/api/v1/node/uncordon",
+	kusciaapi.NodeService_DrainNode_FullMethodName:    "/api/v1/node/drain",
+
+	kusciaapi.SearchService_Search_FullMethodName: "/api/v1/resource/search",
+
+	kusciaapi.HealthService_HealthZ_FullMethodName: constants.HealthAPI,
+}
+
+// httpConn calls the grpc methods through the http server of KusciaAPI, so that the generated grpc
+// clients can be used with both servers. The messages are encoded as protobuf to save the cost of json.
+type httpConn struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+var _ grpc.ClientConnInterface = &httpConn{}
+
+func (c *httpConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	route, ok := httpRoutes[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "method %s isn't served by the http server", method)
+	}
+	req, ok := args.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "request of %s isn't a proto message", method)
+	}
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return status.Errorf(codes.Internal, "marshal request of %s failed, %v", method, err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+route, bytes.NewReader(body))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	httpReq.Header.Set(constants.ContentTypeHeader, contentTypeProtobuf)
+	httpReq.Header.Set("Accept", contentTypeProtobuf)
+	if c.token != "" {
+		httpReq.Header.Set(constants.TokenHeader, c.token)
+	}
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if resp.StatusCode != http.StatusOK {
+		return httpStatusError(resp, respBody)
+	}
+	if err := unmarshalResponse(resp.Header.Get(constants.ContentTypeHeader), respBody, reply.(proto.Message)); err != nil {
+		return status.Errorf(codes.Internal, "unmarshal response of %s failed, %v", method, err)
+	}
+	return nil
+}
+
+// unmarshalResponse decodes the response by its content type, since the servers before content negotiation
+// always respond json.
+func unmarshalResponse(contentType string, body []byte, reply proto.Message) error {
+	if strings.Contains(contentType, "protobuf") {
+		return proto.Unmarshal(body, reply)
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, reply)
+}
+
+func (c *httpConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "streaming method %s is only supported by grpc", method)
+}
+
+// httpStatusError converts the http errors to grpc status, so the errors are handled the same for both servers.
+func httpStatusError(resp *http.Response, body []byte) error {
+	msg := fmt.Sprintf("http status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return status.Error(codes.Unauthenticated, msg)
+	case http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, msg)
+	case http.StatusNotFound:
+		return status.Error(codes.Unimplemented, msg)
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		code := codes.Unavailable
+		if resp.StatusCode == http.StatusTooManyRequests {
+			code = codes.ResourceExhausted
+		}
+		st := status.New(code, msg)
+		if seconds, err := strconv.Atoi(resp.Header.Get(constants.RetryAfterHeader)); err == nil {
+			retryInfo := &errdetails.RetryInfo{RetryDelay: durationpb.New(time.Duration(seconds) * time.Second)}
+			if detailed, err := st.WithDetails(retryInfo); err == nil {
+				st = detailed
+			}
+		}
+		return st.Err()
+	default:
+		return status.Error(codes.Unknown, msg)
+	}
+}
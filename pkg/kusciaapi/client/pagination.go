@@ -0,0 +1,106 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// DefaultPageSize is the number of items queried by a single batch request of the *All helpers.
+const DefaultPageSize = 100
+
+// Pages splits items into pages of at most pageSize items, DefaultPageSize is used if pageSize isn't positive.
+func Pages[T any](items []T, pageSize int) [][]T {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	var pages [][]T
+	for len(items) > 0 {
+		n := min(pageSize, len(items))
+		pages = append(pages, items[:n])
+		items = items[n:]
+	}
+	return pages
+}
+
+// batchQueryAll calls query for each page of keys and merges the results.
+func batchQueryAll[K, V any](keys []K, pageSize int, query func(page []K) ([]V, error)) ([]V, error) {
+	var results []V
+	for _, page := range Pages(keys, pageSize) {
+		items, err := query(page)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, items...)
+	}
+	return results, nil
+}
+
+// BatchQueryJobStatusAll queries the status of any number of jobs, pageSize jobs per request.
+func (c *Client) BatchQueryJobStatusAll(ctx context.Context, jobIDs []string, pageSize int) ([]*kusciaapi.JobStatus, error) {
+	return batchQueryAll(jobIDs, pageSize, func(page []string) ([]*kusciaapi.JobStatus, error) {
+		resp, err := c.BatchQueryJobStatus(ctx, &kusciaapi.BatchQueryJobStatusRequest{JobIds: page})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetData().GetJobs(), nil
+	})
+}
+
+// BatchQueryDomainAll queries any number of domains, pageSize domains per request.
+func (c *Client) BatchQueryDomainAll(ctx context.Context, domainIDs []string, pageSize int) ([]*kusciaapi.Domain, error) {
+	return batchQueryAll(domainIDs, pageSize, func(page []string) ([]*kusciaapi.Domain, error) {
+		resp, err := c.BatchQueryDomain(ctx, &kusciaapi.BatchQueryDomainRequest{DomainIds: page})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetData().GetDomains(), nil
+	})
+}
+
+// BatchQueryServingStatusAll queries the status of any number of servings, pageSize servings per request.
+func (c *Client) BatchQueryServingStatusAll(ctx context.Context, servingIDs []string, pageSize int) ([]*kusciaapi.ServingStatus, error) {
+	return batchQueryAll(servingIDs, pageSize, func(page []string) ([]*kusciaapi.ServingStatus, error) {
+		resp, err := c.BatchQueryServingStatus(ctx, &kusciaapi.BatchQueryServingStatusRequest{ServingIds: page})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetData().GetServings(), nil
+	})
+}
+
+// BatchQueryDomainDataAll queries any number of domain data, pageSize domain data per request.
+func (c *Client) BatchQueryDomainDataAll(ctx context.Context, keys []*kusciaapi.QueryDomainDataRequestData, pageSize int) ([]*kusciaapi.DomainData, error) {
+	return batchQueryAll(keys, pageSize, func(page []*kusciaapi.QueryDomainDataRequestData) ([]*kusciaapi.DomainData, error) {
+		resp, err := c.BatchQueryDomainData(ctx, &kusciaapi.BatchQueryDomainDataRequest{Data: page})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetData().GetDomaindataList(), nil
+	})
+}
+
+// BatchQueryDomainDataGrantAll queries any number of domain data grants, pageSize grants per request.
+func (c *Client) BatchQueryDomainDataGrantAll(ctx context.Context, keys []*kusciaapi.QueryDomainDataGrantRequestData, pageSize int) ([]*kusciaapi.DomainDataGrant, error) {
+	return batchQueryAll(keys, pageSize, func(page []*kusciaapi.QueryDomainDataGrantRequestData) ([]*kusciaapi.DomainDataGrant, error) {
+		resp, err := c.BatchQueryDomainDataGrant(ctx, &kusciaapi.BatchQueryDomainDataGrantRequest{Data: page})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetData(), nil
+	})
+}
@@ -20,13 +20,17 @@ import (
 
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/confmanager/driver"
 	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
 	informers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/bean"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/ha"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/utils"
 	"github.com/secretflow/kuscia/pkg/utils/meta"
+	"github.com/secretflow/kuscia/pkg/utils/quota"
 	"github.com/secretflow/kuscia/pkg/web/framework"
 	"github.com/secretflow/kuscia/pkg/web/framework/engine"
 )
@@ -44,11 +48,37 @@ func Run(ctx context.Context, kusciaAPIConfig *config.KusciaAPIConfig, kusciaCli
 
 	// create informer factory
 	kusciaInformerFactory := informers.NewSharedInformerFactoryWithOptions(kusciaClient, 0)
+	// the lite domain searches through the master api, it's not allowed to list the resources of the other domains
+	if kusciaAPIConfig.RunMode != common.RunModeLite {
+		kuscia := kusciaInformerFactory.Kuscia().V1alpha1()
+		kuscia.KusciaJobs().Informer()
+		kuscia.DomainDatas().Informer()
+		kuscia.DomainDataGrants().Informer()
+		kuscia.AppImages().Informer()
+		kusciaAPIConfig.KusciaInformerFactory = kusciaInformerFactory
+	}
 	kusciaInformerFactory.Start(ctx.Done())
 
 	// wait for all caches to sync
 	kusciaInformerFactory.WaitForCacheSync(ctx.Done())
 
+	// init quota checker
+	quotaChecker, err := quota.NewChecker(kusciaAPIConfig.Quota)
+	if err != nil {
+		return fmt.Errorf("init quota checker for kusciaapi failed, %s", err.Error())
+	}
+	kusciaAPIConfig.QuotaChecker = quotaChecker
+
+	// the standby kusciaapi of the masters forwards the write requests to the leader
+	if kusciaAPIConfig.HA != nil && kusciaAPIConfig.HA.Enabled && kusciaAPIConfig.RunMode != common.RunModeLite {
+		forwarder, err := newForwarder(kusciaAPIConfig)
+		if err != nil {
+			return fmt.Errorf("init ha forwarder for kusciaapi failed, %s", err.Error())
+		}
+		go forwarder.Run(ctx)
+		kusciaAPIConfig.Forwarder = forwarder
+	}
+
 	// init cm config service
 	configService, err := newCMConfigService(ctx, kusciaAPIConfig)
 	if err != nil {
@@ -74,12 +104,31 @@ func Run(ctx context.Context, kusciaAPIConfig *config.KusciaAPIConfig, kusciaCli
 	return appEngine.Run(ctx)
 }
 
+func newForwarder(kusciaAPIConfig *config.KusciaAPIConfig) (*ha.Forwarder, error) {
+	tlsConfig, err := ha.BuildClientTLSConfig(kusciaAPIConfig.TLS, kusciaAPIConfig.Protocol)
+	if err != nil {
+		return nil, err
+	}
+	authenticator, err := utils.NewTenantAuthenticator(kusciaAPIConfig)
+	if err != nil {
+		return nil, err
+	}
+	return ha.NewForwarder(kusciaAPIConfig.KubeClient, ha.Options{
+		AdvertiseAddress: kusciaAPIConfig.HA.AdvertiseAddress,
+		HTTPPort:         kusciaAPIConfig.HTTPPort,
+		GRPCPort:         kusciaAPIConfig.GRPCPort,
+		TLSConfig:        tlsConfig,
+		Authenticator:    authenticator,
+	})
+}
+
 func newCMConfigService(ctx context.Context, kusciaAPIConfig *config.KusciaAPIConfig) (cmservice.IConfigService, error) {
 	configService, err := cmservice.NewConfigService(ctx, &cmservice.ConfigServiceConfig{
-		DomainID:   kusciaAPIConfig.DomainID,
-		DomainKey:  kusciaAPIConfig.DomainKey,
-		Driver:     driver.CRDDriverType,
-		KubeClient: kusciaAPIConfig.KubeClient,
+		DomainID:      kusciaAPIConfig.DomainID,
+		DomainKey:     kusciaAPIConfig.DomainKey,
+		SecretBackend: kusciaAPIConfig.SecretBackend,
+		Driver:        driver.CRDDriverType,
+		KubeClient:    kusciaAPIConfig.KubeClient,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("init cm config service for kusciaapi failed, %s", err.Error())
@@ -23,42 +23,131 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	informers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	"github.com/secretflow/kuscia/pkg/jobarchive"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/grantpolicy"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/ha"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/quota"
 	"github.com/secretflow/kuscia/pkg/web/framework/config"
+	"github.com/secretflow/kuscia/pkg/web/ratelimit"
 )
 
 type KusciaAPIConfig struct {
-	HTTPPort         int32                     `yaml:"HTTPPort,omitempty"`
-	HTTPInternalPort int32                     `yaml:"HTTPInternalPort,omitempty"`
-	GRPCPort         int32                     `yaml:"GRPCPort,omitempty"`
-	Debug            bool                      `yaml:"debug,omitempty"`
-	ConnectTimeout   int                       `yaml:"connectTimeout,omitempty"`
-	ReadTimeout      int                       `yaml:"readTimeout,omitempty"`
-	IdleTimeout      int                       `yaml:"idleTimeout,omitempty"`
-	Initiator        string                    `yaml:"initiator,omitempty"`
-	Protocol         common.Protocol           `yaml:"protocol"`
-	Token            *TokenConfig              `yaml:"token"`
-	WriteTimeout     int                       `yaml:"-"`
-	TLS              *config.TLSServerConfig   `yaml:"-"`
-	DomainKey        *rsa.PrivateKey           `yaml:"-"`
-	RootCAKey        *rsa.PrivateKey           `yaml:"-"`
-	RootCA           *x509.Certificate         `yaml:"-"`
-	KusciaClient     kusciaclientset.Interface `yaml:"-"`
-	KubeClient       kubernetes.Interface      `yaml:"-"`
-	RunMode          common.RunModeType        `yaml:"-"`
-	DomainCertValue  *atomic.Value             `yaml:"-"`
-	ConfDir          string                    `yaml:"-"`
-	DomainID         string                    `yaml:"-"`
-	InterceptorLog   *nlog.NLog                `yaml:"-"`
-	StdoutPath       string                    `yaml:"-"`
-	NodeName         string                    `yaml:"-"`
+	HTTPPort          int32                     `yaml:"HTTPPort,omitempty"`
+	HTTPInternalPort  int32                     `yaml:"HTTPInternalPort,omitempty"`
+	GRPCPort          int32                     `yaml:"GRPCPort,omitempty"`
+	Debug             bool                      `yaml:"debug,omitempty"`
+	ConnectTimeout    int                       `yaml:"connectTimeout,omitempty"`
+	ReadTimeout       int                       `yaml:"readTimeout,omitempty"`
+	IdleTimeout       int                       `yaml:"idleTimeout,omitempty"`
+	Initiator         string                    `yaml:"initiator,omitempty"`
+	Protocol          common.Protocol           `yaml:"protocol"`
+	Token             *TokenConfig              `yaml:"token"`
+	RateLimit         *ratelimit.Config         `yaml:"rateLimit,omitempty"`
+	Quota             *quota.Config             `yaml:"quota,omitempty"`
+	OpenAPI           *OpenAPIConfig            `yaml:"openAPI,omitempty"`
+	DisableReflection bool                      `yaml:"disableReflection,omitempty"`
+	BatchJob          *BatchJobConfig           `yaml:"batchJob,omitempty"`
+	LogArchivePath    string                    `yaml:"logArchivePath,omitempty"`
+	Exec              *ExecConfig               `yaml:"exec,omitempty"`
+	DataSource        *DataSourceConfig         `yaml:"dataSource,omitempty"`
+	DomainData        *DomainDataConfig         `yaml:"domainData,omitempty"`
+	GrantPolicy       *grantpolicy.Config       `yaml:"grantPolicy,omitempty"`
+	Tenants           []TenantConfig            `yaml:"tenants,omitempty"`
+	HA                *HAConfig                 `yaml:"ha,omitempty"`
+	WriteTimeout      int                       `yaml:"-"`
+	TLS               *config.TLSServerConfig   `yaml:"-"`
+	DomainKey         *rsa.PrivateKey           `yaml:"-"`
+	SecretBackend     secretbackend.Backend     `yaml:"-"`
+	RootCAKey         *rsa.PrivateKey           `yaml:"-"`
+	RootCA            *x509.Certificate         `yaml:"-"`
+	KusciaClient      kusciaclientset.Interface `yaml:"-"`
+	KubeClient        kubernetes.Interface      `yaml:"-"`
+	RunMode           common.RunModeType        `yaml:"-"`
+	DomainCertValue   *atomic.Value             `yaml:"-"`
+	ConfDir           string                    `yaml:"-"`
+	DomainID          string                    `yaml:"-"`
+	InterceptorLog    *nlog.NLog                `yaml:"-"`
+	StdoutPath        string                    `yaml:"-"`
+	NodeName          string                    `yaml:"-"`
+	QuotaChecker      *quota.Checker            `yaml:"-"`
+	RateLimiters      *ratelimit.Group          `yaml:"-"`
+	AgentExecSocket   string                    `yaml:"-"`
+	ExecAuditLog      *nlog.NLog                `yaml:"-"`
+	Forwarder         *ha.Forwarder             `yaml:"-"`
+	GrantPolicies     *grantpolicy.Policy       `yaml:"-"`
+	// KusciaInformerFactory caches the resources searched by the search api, it's nil in lite mode.
+	KusciaInformerFactory informers.SharedInformerFactory `yaml:"-"`
+	// JobArchive serves the archived jobs, it's nil if the job archive is disabled or in lite mode.
+	JobArchive jobarchive.Store `yaml:"-"`
 }
 
 type TokenConfig struct {
 	TokenFile string
 }
 
+// OpenAPIConfig controls the openapi document served by the http server.
+type OpenAPIConfig struct {
+	// Disabled stops serving the document at /api/openapi.json.
+	Disabled bool `yaml:"disabled,omitempty"`
+	// SwaggerUI serves a swagger ui page of the document at /api/docs.
+	SwaggerUI bool `yaml:"swaggerUI,omitempty"`
+	// SwaggerUIAssetsURL overrides where the swagger ui scripts are loaded from.
+	SwaggerUIAssetsURL string `yaml:"swaggerUIAssetsURL,omitempty"`
+}
+
+// BatchJobConfig limits the batch operations of jobs, e.g. BatchCancelJob.
+type BatchJobConfig struct {
+	// MaxJobs is the max number of jobs selected by a batch operation, default 500.
+	MaxJobs int `yaml:"maxJobs,omitempty"`
+	// Workers is the max number of jobs updated concurrently by a batch operation, default 16.
+	Workers int `yaml:"workers,omitempty"`
+	// RateLimit is the token bucket of the jobs updated by all the batch operations, default 50 qps.
+	RateLimit *ratelimit.TokenBucketConfig `yaml:"rateLimit,omitempty"`
+}
+
+// ExecConfig controls the debug api which executes commands in the engine containers.
+type ExecConfig struct {
+	// Roles are granted the debug scope to exec, i.e. master and domain. The api is disabled if it's empty.
+	Roles []string `yaml:"roles,omitempty"`
+}
+
+// DataSourceConfig controls the domain data source api.
+type DataSourceConfig struct {
+	// RevealRoles are granted to query the credentials of the datasources in plaintext, i.e. master and domain.
+	// The credentials are always masked in the query responses if it's empty.
+	RevealRoles []string `yaml:"revealRoles,omitempty"`
+}
+
+// DomainDataConfig controls the domain data api.
+type DomainDataConfig struct {
+	// UploadMaxBytes is the max size of the files uploaded by UploadDomainData, default 100MiB.
+	UploadMaxBytes int64 `yaml:"uploadMaxBytes,omitempty"`
+}
+
+// TenantConfig binds the callers of the master's KusciaAPI to a set of domains, they can only operate the resources
+// of these domains.
+type TenantConfig struct {
+	Name string `yaml:"name"`
+	// TokenFile holds the token of the tenant, which is used instead of the token of the KusciaAPI.
+	TokenFile string `yaml:"tokenFile,omitempty"`
+	// CommonNames are the common names of the client certificates of the tenant, which take effect in mtls protocol.
+	CommonNames []string `yaml:"commonNames,omitempty"`
+	Domains     []string `yaml:"domains"`
+}
+
+// HAConfig runs the KusciaAPI of the masters sharing the same api server in active/standby, the standby serves
+// the read requests and forwards the others to the leader.
+type HAConfig struct {
+	Enabled bool `yaml:"enabled,omitempty"`
+	// AdvertiseAddress is the host the other masters reach this KusciaAPI with, e.g. the ip of the host. It must be
+	// unique among the masters.
+	AdvertiseAddress string `yaml:"advertiseAddress,omitempty"`
+}
+
 func NewDefaultKusciaAPIConfig(rootDir string) *KusciaAPIConfig {
 	return &KusciaAPIConfig{
 		HTTPPort:         8082,
@@ -75,6 +164,7 @@ func NewDefaultKusciaAPIConfig(rootDir string) *KusciaAPIConfig {
 		Token: &TokenConfig{
 			TokenFile: path.Join(rootDir, common.CertPrefix, "token"),
 		},
-		ConfDir: path.Join(rootDir, common.ConfPrefix),
+		ConfDir:        path.Join(rootDir, common.ConfPrefix),
+		LogArchivePath: path.Join(rootDir, common.LogArchivePrefix),
 	}
 }
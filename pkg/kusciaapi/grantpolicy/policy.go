@@ -0,0 +1,240 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grantpolicy validates the domain data grants against the enterprise policy with pluggable engines.
+package grantpolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/cel-go/cel"
+)
+
+const (
+	EngineCEL = "cel"
+	EngineOPA = "opa"
+
+	defaultOPATimeout = 5 * time.Second
+)
+
+// Config configures the engines, the grant is allowed only if all the engines allow it.
+type Config struct {
+	// Rules are CEL expressions on the variable grant, the grant is rejected if any of them is false.
+	Rules []Rule `yaml:"rules,omitempty"`
+	// OPA queries the decision of an Open Policy Agent.
+	OPA *OPAConfig `yaml:"opa,omitempty"`
+}
+
+type Rule struct {
+	Name       string `yaml:"name"`
+	Expression string `yaml:"expression"`
+	// Message is the reason of the rejection, the name of the rule by default.
+	Message string `yaml:"message,omitempty"`
+}
+
+type OPAConfig struct {
+	// URL of the decision, e.g. http://opa:8181/v1/data/kuscia/grant. The result of the decision is either a
+	// boolean or an object with the fields allow and reason.
+	URL string `yaml:"url"`
+	// TimeoutSeconds of the query, default 5.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+}
+
+// Decision is recorded in the annotations of the grant.
+type Decision struct {
+	Allowed bool   `json:"allowed"`
+	Engine  string `json:"engine,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Time    string `json:"time"`
+}
+
+// Engine decides whether the grant described by the input is allowed.
+type Engine interface {
+	Name() string
+	Evaluate(ctx context.Context, input map[string]interface{}) (allowed bool, reason string, err error)
+}
+
+// Policy evaluates the engines in order, the first engine rejecting the grant makes the decision.
+type Policy struct {
+	engines []Engine
+}
+
+// New returns nil if no engine is configured.
+func New(conf *Config) (*Policy, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	p := &Policy{}
+	if len(conf.Rules) > 0 {
+		engine, err := newCELEngine(conf.Rules)
+		if err != nil {
+			return nil, err
+		}
+		p.engines = append(p.engines, engine)
+	}
+	if conf.OPA != nil {
+		if conf.OPA.URL == "" {
+			return nil, fmt.Errorf("url of the opa grant policy can't be empty")
+		}
+		timeout := defaultOPATimeout
+		if conf.OPA.TimeoutSeconds > 0 {
+			timeout = time.Duration(conf.OPA.TimeoutSeconds) * time.Second
+		}
+		p.engines = append(p.engines, &opaEngine{url: conf.OPA.URL, client: &http.Client{Timeout: timeout}})
+	}
+	if len(p.engines) == 0 {
+		return nil, nil
+	}
+	return p, nil
+}
+
+// Evaluate returns the decision on the grant, a nil policy allows all the grants without a decision. The grant is
+// rejected if an engine fails to evaluate it.
+func (p *Policy) Evaluate(ctx context.Context, input map[string]interface{}) (*Decision, error) {
+	if p == nil {
+		return nil, nil
+	}
+	decision := &Decision{Allowed: true, Time: time.Now().UTC().Format(time.RFC3339)}
+	for _, engine := range p.engines {
+		allowed, reason, err := engine.Evaluate(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("evaluate the %s grant policy failed, %s", engine.Name(), err.Error())
+		}
+		if !allowed {
+			decision.Allowed = false
+			decision.Engine = engine.Name()
+			decision.Reason = reason
+			return decision, nil
+		}
+	}
+	return decision, nil
+}
+
+// Annotation is the json of the decision.
+func (d *Decision) Annotation() string {
+	bs, _ := json.Marshal(d)
+	return string(bs)
+}
+
+type celRule struct {
+	Rule
+	program cel.Program
+}
+
+type celEngine struct {
+	rules []celRule
+}
+
+func newCELEngine(rules []Rule) (*celEngine, error) {
+	env, err := cel.NewEnv(cel.Variable("grant", cel.MapType(cel.StringType, cel.DynType)))
+	if err != nil {
+		return nil, err
+	}
+	engine := &celEngine{}
+	for _, r := range rules {
+		ast, iss := env.Compile(r.Expression)
+		if iss.Err() != nil {
+			return nil, fmt.Errorf("compile grant policy rule %q failed, %s", r.Name, iss.Err().Error())
+		}
+		if ast.OutputType() != cel.BoolType {
+			return nil, fmt.Errorf("grant policy rule %q must be a boolean expression", r.Name)
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("build grant policy rule %q failed, %s", r.Name, err.Error())
+		}
+		engine.rules = append(engine.rules, celRule{Rule: r, program: program})
+	}
+	return engine, nil
+}
+
+func (e *celEngine) Name() string {
+	return EngineCEL
+}
+
+func (e *celEngine) Evaluate(ctx context.Context, input map[string]interface{}) (bool, string, error) {
+	for _, r := range e.rules {
+		out, _, err := r.program.ContextEval(ctx, map[string]interface{}{"grant": input})
+		if err != nil {
+			return false, "", fmt.Errorf("rule %q: %s", r.Name, err.Error())
+		}
+		if allowed, ok := out.Value().(bool); !ok || !allowed {
+			reason := r.Message
+			if reason == "" {
+				reason = fmt.Sprintf("violates rule %s", r.Name)
+			}
+			return false, reason, nil
+		}
+	}
+	return true, "", nil
+}
+
+type opaEngine struct {
+	url    string
+	client *http.Client
+}
+
+func (e *opaEngine) Name() string {
+	return EngineOPA
+}
+
+func (e *opaEngine) Evaluate(ctx context.Context, input map[string]interface{}) (bool, string, error) {
+	body, err := json.Marshal(map[string]interface{}{"input": input})
+	if err != nil {
+		return false, "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return false, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return false, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, "", fmt.Errorf("opa responds with status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, "", err
+	}
+	if len(result.Result) == 0 {
+		return false, "", fmt.Errorf("the decision of opa is undefined")
+	}
+	var allowed bool
+	if err = json.Unmarshal(result.Result, &allowed); err == nil {
+		return allowed, "rejected by opa", nil
+	}
+	var decision struct {
+		Allow  bool   `json:"allow"`
+		Reason string `json:"reason"`
+	}
+	if err = json.Unmarshal(result.Result, &decision); err != nil {
+		return false, "", fmt.Errorf("the decision of opa must be a boolean or an object with allow, %s", err.Error())
+	}
+	if decision.Reason == "" {
+		decision.Reason = "rejected by opa"
+	}
+	return decision.Allow, decision.Reason, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grantpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func grantInput(purpose string, categories []string) map[string]interface{} {
+	return map[string]interface{}{
+		"grant_domain": "bob",
+		"compliance": map[string]interface{}{
+			"purpose":         purpose,
+			"legal_basis":     "consent",
+			"data_categories": categories,
+			"retention_days":  int64(30),
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	p, err := New(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+	p, err = New(&Config{})
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+
+	decision, err := p.Evaluate(context.Background(), grantInput("", nil))
+	assert.NoError(t, err)
+	assert.Nil(t, decision)
+
+	_, err = New(&Config{Rules: []Rule{{Name: "bad", Expression: "grant.purpose =="}}})
+	assert.Error(t, err)
+	_, err = New(&Config{Rules: []Rule{{Name: "not-bool", Expression: "grant.grant_domain"}}})
+	assert.Error(t, err)
+	_, err = New(&Config{OPA: &OPAConfig{}})
+	assert.Error(t, err)
+}
+
+func TestCELPolicy(t *testing.T) {
+	p, err := New(&Config{Rules: []Rule{
+		{Name: "purpose", Expression: `grant.compliance.purpose != ""`, Message: "purpose is required"},
+		{Name: "no-health", Expression: `!("health" in grant.compliance.data_categories)`},
+	}})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	decision, err := p.Evaluate(ctx, grantInput("marketing", []string{"contact"}))
+	assert.NoError(t, err)
+	assert.True(t, decision.Allowed)
+
+	decision, err = p.Evaluate(ctx, grantInput("", []string{}))
+	assert.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, EngineCEL, decision.Engine)
+	assert.Equal(t, "purpose is required", decision.Reason)
+
+	decision, err = p.Evaluate(ctx, grantInput("research", []string{"health"}))
+	assert.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, "violates rule no-health", decision.Reason)
+
+	// a missing field fails the evaluation, and the grant is rejected
+	_, err = p.Evaluate(ctx, map[string]interface{}{})
+	assert.Error(t, err)
+}
+
+func TestOPAPolicy(t *testing.T) {
+	result := `true`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Input map[string]interface{} `json:"input"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "bob", body.Input["grant_domain"])
+		_, _ = w.Write([]byte(`{"result":` + result + `}`))
+	}))
+	defer server.Close()
+
+	p, err := New(&Config{OPA: &OPAConfig{URL: server.URL}})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	decision, err := p.Evaluate(ctx, grantInput("marketing", nil))
+	assert.NoError(t, err)
+	assert.True(t, decision.Allowed)
+
+	result = `false`
+	decision, err = p.Evaluate(ctx, grantInput("marketing", nil))
+	assert.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, EngineOPA, decision.Engine)
+
+	result = `{"allow":false,"reason":"legal basis is not allowed"}`
+	decision, err = p.Evaluate(ctx, grantInput("marketing", nil))
+	assert.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, "legal basis is not allowed", decision.Reason)
+
+	result = `"yes"`
+	_, err = p.Evaluate(ctx, grantInput("marketing", nil))
+	assert.Error(t, err)
+}
+
+func TestDecisionAnnotation(t *testing.T) {
+	d := &Decision{Allowed: true, Time: "2025-01-01T00:00:00Z"}
+	assert.Equal(t, `{"allowed":true,"time":"2025-01-01T00:00:00Z"}`, d.Annotation())
+}
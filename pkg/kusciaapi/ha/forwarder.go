@@ -0,0 +1,390 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ha runs the KusciaAPI of multiple masters in active/standby. The candidates elect a leader through a
+// lease, the standby serves the read requests itself and forwards the others to the leader.
+package ha
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/election"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	webconfig "github.com/secretflow/kuscia/pkg/web/framework/config"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+)
+
+const (
+	// electionName is the name of the lease the KusciaAPI candidates elect the leader with.
+	electionName = "kuscia-kusciaapi"
+	// serverName is the dns name in the certificate of the KusciaAPI server.
+	serverName = "kusciaapi"
+)
+
+// readOnlyPrefixes are the prefixes of the operations served by the standby, they don't write the api server.
+var readOnlyPrefixes = []string{"query", "batchquery", "list", "watch", "tail", "download", "export", "health", "search", "exec"}
+
+// Options of the forwarder.
+type Options struct {
+	// AdvertiseAddress is the host the other candidates reach this KusciaAPI with, it's the identity in the election.
+	AdvertiseAddress string
+	HTTPPort         int32
+	GRPCPort         int32
+	// TLSConfig is the client tls config to connect the leader with, nil means the leader serves in plain text.
+	TLSConfig *tls.Config
+	// Authenticator identifies the tenants of the callers, nil if the tenants are not configured.
+	Authenticator *tenant.Authenticator
+}
+
+// Forwarder forwards the requests received by the standby to the leader.
+type Forwarder struct {
+	options   Options
+	elector   election.Elector
+	transport *http.Transport
+
+	mutex sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+// NewForwarder returns a forwarder, it takes part in the election once it runs.
+func NewForwarder(kubeClient kubernetes.Interface, options Options) (*Forwarder, error) {
+	if options.AdvertiseAddress == "" {
+		return nil, errors.New("advertise address of the kusciaapi can not be empty when ha is enabled")
+	}
+	f := &Forwarder{
+		options: options,
+		conns:   map[string]*grpc.ClientConn{},
+	}
+	f.transport = http.DefaultTransport.(*http.Transport).Clone()
+	f.transport.TLSClientConfig = options.TLSConfig
+	f.elector = election.NewElector(kubeClient, electionName,
+		election.WithIdentity(options.AdvertiseAddress),
+		election.WithOnNewLeader(f.onNewLeader))
+	if f.elector == nil {
+		return nil, errors.New("failed to new leader elector")
+	}
+	return f, nil
+}
+
+// BuildClientTLSConfig builds the tls config to connect the leader with, the candidates share the server
+// certificate of the KusciaAPI.
+func BuildClientTLSConfig(tlsConfig *webconfig.TLSServerConfig, protocol common.Protocol) (*tls.Config, error) {
+	if tlsConfig == nil {
+		return nil, nil
+	}
+	var clientTLSConfig *tls.Config
+	var err error
+	if protocol == common.TLS {
+		clientTLSConfig, err = tlsutils.BuildClientSimpleTLSConfig(tlsConfig.RootCA)
+	} else {
+		clientTLSConfig, err = tlsutils.BuildClientTLSConfig(tlsConfig.RootCA, tlsConfig.ServerCert, tlsConfig.ServerKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	clientTLSConfig.ServerName = serverName
+	return clientTLSConfig, nil
+}
+
+// Run takes part in the election until the context is done. The standby keeps serving the read requests if the
+// leader is lost, the write requests fail until a new leader is elected.
+func (f *Forwarder) Run(ctx context.Context) {
+	nlog.Infof("KusciaAPI takes part in the election with identity %q", f.elector.MyIdentity())
+	f.elector.Run(ctx)
+}
+
+// IsLeader returns whether this KusciaAPI is the leader.
+func (f *Forwarder) IsLeader() bool {
+	return f.elector.IsLeader()
+}
+
+func (f *Forwarder) onNewLeader(identity string) {
+	if identity == f.elector.MyIdentity() {
+		nlog.Infof("KusciaAPI has been elected as the leader")
+		return
+	}
+	nlog.Infof("KusciaAPI leader has been elected: %s, the write requests are forwarded to it", identity)
+	f.closeConns(identity)
+}
+
+// IsReadOnly returns whether the operation, i.e. the last segment of the http path or the grpc method, only reads
+// the api server, so that it's served by the standby.
+func IsReadOnly(operation string) bool {
+	operation = strings.ToLower(path.Base(operation))
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// leader returns the leader to forward the request to. The callers whose tenants are identified by their client
+// certificates are not forwarded, since the leader sees the certificate of the standby instead.
+func (f *Forwarder) leader(t *tenant.Tenant, tokens []string) (string, error) {
+	leader := f.elector.GetLeader()
+	if leader == "" {
+		return "", errors.New("kusciaapi leader is not elected yet")
+	}
+	if f.options.Authenticator != nil {
+		forwarded, err := f.options.Authenticator.Authenticate(tokens, nil)
+		if err != nil || tenantName(forwarded) != tenantName(t) {
+			return "", fmt.Errorf("the caller identified by client certificate can't be forwarded, send the request to the leader %s", leader)
+		}
+	}
+	return leader, nil
+}
+
+func tenantName(t *tenant.Tenant) string {
+	if t == nil {
+		return ""
+	}
+	return t.Name
+}
+
+// HTTPInterceptor forwards the write requests to the leader if this KusciaAPI is the standby, it follows the
+// auth interceptors so that the requests are authenticated by the standby as well.
+func (f *Forwarder) HTTPInterceptor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if f.IsLeader() || c.Request.Method == http.MethodGet || IsReadOnly(c.Request.URL.Path) {
+			c.Next()
+			return
+		}
+		t, _ := c.Value(constants.AuthTenant).(*tenant.Tenant)
+		leader, err := f.leader(t, c.Request.Header.Values(constants.TokenHeader))
+		if err != nil {
+			_ = c.AbortWithError(http.StatusServiceUnavailable, err)
+			return
+		}
+
+		scheme := constants.SchemaHTTP
+		if f.options.TLSConfig != nil {
+			scheme = constants.SchemaHTTPS
+		}
+		target := &url.URL{Scheme: scheme, Host: net.JoinHostPort(leader, strconv.Itoa(int(f.options.HTTPPort)))}
+		proxy := &httputil.ReverseProxy{
+			Rewrite: func(r *httputil.ProxyRequest) {
+				r.SetURL(target)
+				r.SetXForwarded()
+			},
+			Transport: f.transport,
+		}
+		nlog.Debugf("Forward request %s to the leader %s", c.Request.URL.Path, leader)
+		proxy.ServeHTTP(c.Writer, c.Request)
+		c.Abort()
+	}
+}
+
+// GrpcServerInterceptor forwards the unary write requests to the leader if this KusciaAPI is the standby.
+func (f *Forwarder) GrpcServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if f.IsLeader() || IsReadOnly(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		leader, err := f.leader(tenant.FromContext(ctx), md.Get(strings.ToLower(constants.TokenHeader)))
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		resp, err := newResponse(info.FullMethod)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		conn, err := f.grpcConn(leader)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+
+		nlog.Debugf("Forward request %s to the leader %s", info.FullMethod, leader)
+		if err = conn.Invoke(metadata.NewOutgoingContext(ctx, forwardedMetadata(md)), info.FullMethod, req, resp); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
+
+// GrpcStreamServerInterceptor forwards the write streams, e.g. UploadDomainData, to the leader if this KusciaAPI is
+// the standby. The messages are relayed in both directions until the leader closes the stream.
+func (f *Forwarder) GrpcStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if f.IsLeader() || IsReadOnly(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		leader, err := f.leader(tenant.FromContext(ss.Context()), md.Get(strings.ToLower(constants.TokenHeader)))
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		method, err := findMethod(info.FullMethod)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		conn, err := f.grpcConn(leader)
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+
+		ctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+		desc := &grpc.StreamDesc{ServerStreams: info.IsServerStream, ClientStreams: info.IsClientStream}
+		cs, err := conn.NewStream(metadata.NewOutgoingContext(ctx, forwardedMetadata(md)), desc, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		nlog.Debugf("Forward stream %s to the leader %s", info.FullMethod, leader)
+
+		go func() {
+			for {
+				req, err := newMessage(method.Input())
+				if err == nil {
+					err = ss.RecvMsg(req)
+				}
+				if errors.Is(err, io.EOF) {
+					_ = cs.CloseSend()
+					return
+				}
+				if err != nil {
+					// the caller is gone, abort the stream to the leader
+					cancel()
+					return
+				}
+				if err = cs.SendMsg(req); err != nil {
+					// the leader has closed the stream, the status is returned by RecvMsg
+					return
+				}
+			}
+		}()
+
+		for first := true; ; first = false {
+			resp, err := newMessage(method.Output())
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			if err = cs.RecvMsg(resp); err != nil {
+				ss.SetTrailer(cs.Trailer())
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+			if first {
+				if header, err := cs.Header(); err == nil {
+					_ = ss.SendHeader(header)
+				}
+			}
+			if err = ss.SendMsg(resp); err != nil {
+				return err
+			}
+			if !info.IsServerStream {
+				ss.SetTrailer(cs.Trailer())
+				return nil
+			}
+		}
+	}
+}
+
+// forwardedMetadata returns the metadata sent to the leader, the transport headers of the standby are dropped.
+func forwardedMetadata(md metadata.MD) metadata.MD {
+	md = md.Copy()
+	md.Delete(":authority")
+	md.Delete("content-type")
+	return md
+}
+
+func (f *Forwarder) grpcConn(leader string) (*grpc.ClientConn, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if conn, ok := f.conns[leader]; ok {
+		return conn, nil
+	}
+	creds := insecure.NewCredentials()
+	if f.options.TLSConfig != nil {
+		creds = credentials.NewTLS(f.options.TLSConfig)
+	}
+	conn, err := grpc.Dial(net.JoinHostPort(leader, strconv.Itoa(int(f.options.GRPCPort))), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	f.conns[leader] = conn
+	return conn, nil
+}
+
+// closeConns closes the connections to the former leaders.
+func (f *Forwarder) closeConns(leader string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for identity, conn := range f.conns {
+		if identity != leader {
+			_ = conn.Close()
+			delete(f.conns, identity)
+		}
+	}
+}
+
+// newResponse returns an empty response of the grpc method, e.g. /kuscia.proto.api.v1alpha1.kusciaapi.JobService/CreateJob.
+func newResponse(fullMethod string) (proto.Message, error) {
+	method, err := findMethod(fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return newMessage(method.Output())
+}
+
+func findMethod(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown method %s, %v", fullMethod, err)
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a method", fullMethod)
+	}
+	return method, nil
+}
+
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err
+	}
+	return msgType.New().Interface(), nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type fakeElector struct {
+	leader   string
+	identity string
+}
+
+func (e *fakeElector) IsLeader() bool          { return e.leader == e.identity }
+func (e *fakeElector) GetLeader() string       { return e.leader }
+func (e *fakeElector) MyIdentity() string      { return e.identity }
+func (e *fakeElector) Run(ctx context.Context) {}
+
+func newTestForwarder(leader string, options Options) *Forwarder {
+	f := &Forwarder{
+		options:   options,
+		elector:   &fakeElector{leader: leader, identity: "10.0.0.2"},
+		transport: http.DefaultTransport.(*http.Transport).Clone(),
+		conns:     map[string]*grpc.ClientConn{},
+	}
+	return f
+}
+
+func TestIsReadOnly(t *testing.T) {
+	assert.True(t, IsReadOnly("/api/v1/job/query"))
+	assert.True(t, IsReadOnly("/api/v1/job/status/batchQuery"))
+	assert.True(t, IsReadOnly("/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/QueryDomainOnboarding"))
+	assert.True(t, IsReadOnly("/api/v1/resource/search"))
+	assert.True(t, IsReadOnly("/api/v1/job/usage/export"))
+	assert.True(t, IsReadOnly("/kuscia.proto.api.v1alpha1.kusciaapi.SearchService/Search"))
+	assert.True(t, IsReadOnly("/api/v1/certificate/list"))
+	assert.True(t, IsReadOnly("/kuscia.proto.api.v1alpha1.kusciaapi.CertificateService/ListCertificates"))
+	assert.False(t, IsReadOnly("/api/v1/job/create"))
+	assert.True(t, IsReadOnly("/kuscia.proto.api.v1alpha1.kusciaapi.DebugService/ExecContainer"))
+	assert.False(t, IsReadOnly("/kuscia.proto.api.v1alpha1.kusciaapi.JobService/CreateJob"))
+	assert.False(t, IsReadOnly("/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/UploadDomainData"))
+}
+
+func TestNewResponse(t *testing.T) {
+	resp, err := newResponse("/kuscia.proto.api.v1alpha1.kusciaapi.JobService/CreateJob")
+	assert.NoError(t, err)
+	assert.IsType(t, &kusciaapi.CreateJobResponse{}, resp)
+	_, err = newResponse("/kuscia.proto.api.v1alpha1.kusciaapi.JobService/NotExist")
+	assert.Error(t, err)
+}
+
+func TestHTTPInterceptor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	leader := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Token", r.Header.Get(constants.TokenHeader))
+		_, _ = w.Write([]byte("leader"))
+	}))
+	defer leader.Close()
+	leaderURL, _ := url.Parse(leader.URL)
+	host, port, _ := net.SplitHostPort(leaderURL.Host)
+	httpPort, _ := strconv.Atoi(port)
+
+	serve := func(f *Forwarder, path string) (int, string, string) {
+		engine := gin.New()
+		engine.Use(f.HTTPInterceptor())
+		engine.POST("/api/v1/job/:op", func(c *gin.Context) {
+			c.String(http.StatusOK, "local")
+		})
+		standby := httptest.NewServer(engine)
+		defer standby.Close()
+		req, _ := http.NewRequest(http.MethodPost, standby.URL+path, nil)
+		req.Header.Set(constants.TokenHeader, "token")
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body), resp.Header.Get("Token")
+	}
+
+	f := newTestForwarder(host, Options{HTTPPort: int32(httpPort)})
+	_, body, token := serve(f, "/api/v1/job/create")
+	assert.Equal(t, "leader", body)
+	assert.Equal(t, "token", token)
+	_, body, _ = serve(f, "/api/v1/job/query")
+	assert.Equal(t, "local", body)
+
+	// the leader serves the requests itself
+	f = newTestForwarder("10.0.0.2", Options{HTTPPort: int32(httpPort)})
+	_, body, _ = serve(f, "/api/v1/job/create")
+	assert.Equal(t, "local", body)
+
+	// no leader elected
+	f = newTestForwarder("", Options{HTTPPort: int32(httpPort)})
+	code, _, _ := serve(f, "/api/v1/job/create")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+type fakeNodeServer struct {
+	kusciaapi.UnimplementedNodeServiceServer
+}
+
+func (s fakeNodeServer) CordonNode(ctx context.Context, request *kusciaapi.CordonNodeRequest) (*kusciaapi.CordonNodeResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &kusciaapi.CordonNodeResponse{Status: &v1alpha1.Status{Message: md.Get("token")[0]}}, nil
+}
+
+func TestGrpcServerInterceptor(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer()
+	kusciaapi.RegisterNodeServiceServer(server, fakeNodeServer{})
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+	port := lis.Addr().(*net.TCPAddr).Port
+
+	f := newTestForwarder("127.0.0.1", Options{GRPCPort: int32(port)})
+	defer f.closeConns("")
+	interceptor := f.GrpcServerInterceptor()
+	local := func(ctx context.Context, req any) (any, error) {
+		return &kusciaapi.CordonNodeResponse{}, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("token", "token"))
+	resp, err := interceptor(ctx, &kusciaapi.CordonNodeRequest{NodeName: "node"},
+		&grpc.UnaryServerInfo{FullMethod: "/kuscia.proto.api.v1alpha1.kusciaapi.NodeService/CordonNode"}, local)
+	assert.NoError(t, err)
+	assert.Equal(t, "token", resp.(*kusciaapi.CordonNodeResponse).Status.Message)
+}
+
+type fakeDomainDataServer struct {
+	kusciaapi.UnimplementedDomainDataServiceServer
+	name string
+}
+
+func (s fakeDomainDataServer) UploadDomainData(stream kusciaapi.DomainDataService_UploadDomainDataServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	var content []byte
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		content = append(content, req.Content...)
+	}
+	return stream.SendAndClose(&kusciaapi.UploadDomainDataResponse{
+		Status: &v1alpha1.Status{Message: s.name + ":" + md.Get("token")[0]},
+		Data:   &kusciaapi.UploadDomainDataResponseData{Size: int64(len(content))},
+	})
+}
+
+func TestGrpcStreamServerInterceptor(t *testing.T) {
+	serve := func(opts ...grpc.ServerOption) (*grpc.Server, int) {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		server := grpc.NewServer(opts...)
+		name := "leader"
+		if len(opts) > 0 {
+			name = "standby"
+		}
+		kusciaapi.RegisterDomainDataServiceServer(server, fakeDomainDataServer{name: name})
+		go func() { _ = server.Serve(lis) }()
+		return server, lis.Addr().(*net.TCPAddr).Port
+	}
+	leader, leaderPort := serve()
+	defer leader.Stop()
+
+	upload := func(leaderHost string) (*kusciaapi.UploadDomainDataResponse, error) {
+		f := newTestForwarder(leaderHost, Options{GRPCPort: int32(leaderPort)})
+		defer f.closeConns("")
+		standby, standbyPort := serve(grpc.StreamInterceptor(f.GrpcStreamServerInterceptor()))
+		defer standby.Stop()
+
+		conn, err := grpc.Dial(net.JoinHostPort("127.0.0.1", strconv.Itoa(standbyPort)), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.NoError(t, err)
+		defer conn.Close()
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "token", "token")
+		stream, err := kusciaapi.NewDomainDataServiceClient(conn).UploadDomainData(ctx)
+		assert.NoError(t, err)
+		for _, chunk := range []string{"a,b\n", "1,2\n"} {
+			// the send fails with io.EOF once the stream is rejected, the status is returned by CloseAndRecv
+			if err = stream.Send(&kusciaapi.UploadDomainDataRequest{Content: []byte(chunk)}); err != nil {
+				break
+			}
+		}
+		return stream.CloseAndRecv()
+	}
+
+	resp, err := upload("127.0.0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "leader:token", resp.Status.Message)
+	assert.Equal(t, int64(8), resp.Data.Size)
+
+	// the leader serves the streams itself
+	resp, err = upload("10.0.0.2")
+	assert.NoError(t, err)
+	assert.Equal(t, "standby:token", resp.Status.Message)
+
+	// no leader elected
+	_, err = upload("")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestLeaderWithTenants(t *testing.T) {
+	bob := &tenant.Tenant{Name: "bob", Domains: []string{"bob"}, CommonNames: []string{"bob-client"}}
+	carol := &tenant.Tenant{Name: "carol", Domains: []string{"carol"}, Token: "carol-token"}
+	authenticator, err := tenant.NewAuthenticator("admin-token", []*tenant.Tenant{bob, carol})
+	assert.NoError(t, err)
+	f := newTestForwarder("10.0.0.1", Options{Authenticator: authenticator})
+
+	_, err = f.leader(nil, []string{"admin-token"})
+	assert.NoError(t, err)
+	_, err = f.leader(&tenant.Tenant{Name: "carol"}, []string{"carol-token"})
+	assert.NoError(t, err)
+	// the tenant identified by the client certificate
+	_, err = f.leader(bob, []string{"admin-token"})
+	assert.Error(t, err)
+}
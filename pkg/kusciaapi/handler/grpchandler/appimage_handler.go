@@ -52,3 +52,11 @@ func (h appImageHandler) DeleteAppImage(ctx context.Context, request *kusciaapi.
 func (h appImageHandler) BatchQueryAppImage(ctx context.Context, request *kusciaapi.BatchQueryAppImageRequest) (*kusciaapi.BatchQueryAppImageResponse, error) {
 	return h.appImageService.BatchQueryAppImage(ctx, request), nil
 }
+
+func (h appImageHandler) PrewarmImage(ctx context.Context, request *kusciaapi.PrewarmImageRequest) (*kusciaapi.PrewarmImageResponse, error) {
+	return h.appImageService.PrewarmImage(ctx, request), nil
+}
+
+func (h appImageHandler) QueryPrewarmImage(ctx context.Context, request *kusciaapi.QueryPrewarmImageRequest) (*kusciaapi.QueryPrewarmImageResponse, error) {
+	return h.appImageService.QueryPrewarmImage(ctx, request), nil
+}
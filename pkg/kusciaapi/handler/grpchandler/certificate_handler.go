@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 
 	"github.com/secretflow/kuscia/pkg/confmanager/service"
+	apiservice "github.com/secretflow/kuscia/pkg/kusciaapi/service"
 	"github.com/secretflow/kuscia/pkg/web/utils"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/confmanager"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
@@ -27,13 +28,15 @@ import (
 
 // certificateHandler GRPC Handler
 type certificateHandler struct {
-	certificateService service.ICertificateService
+	certificateService   service.ICertificateService
+	certInventoryService apiservice.ICertInventoryService
 	kusciaapi.UnimplementedCertificateServiceServer
 }
 
-func NewCertificateHandler(certService service.ICertificateService) kusciaapi.CertificateServiceServer {
+func NewCertificateHandler(certService service.ICertificateService, certInventoryService apiservice.ICertInventoryService) kusciaapi.CertificateServiceServer {
 	return &certificateHandler{
-		certificateService: certService,
+		certificateService:   certService,
+		certInventoryService: certInventoryService,
 	}
 }
 
@@ -42,18 +45,22 @@ func (h *certificateHandler) GenerateKeyCerts(ctx context.Context, request *kusc
 	kapiResp := &kusciaapi.GenerateKeyCertsResponse{}
 	if err := CopyValue(request, cmReq); err != nil {
 		return &kusciaapi.GenerateKeyCertsResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrForUnexpected, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrForUnexpected, err),
 		}, nil
 	}
 	cmResp := h.certificateService.GenerateKeyCerts(ctx, cmReq)
 	if err := CopyValue(cmResp, kapiResp); err != nil {
 		return &kusciaapi.GenerateKeyCertsResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrForUnexpected, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrForUnexpected, err),
 		}, nil
 	}
 	return kapiResp, nil
 }
 
+func (h *certificateHandler) ListCertificates(ctx context.Context, request *kusciaapi.ListCertificatesRequest) (*kusciaapi.ListCertificatesResponse, error) {
+	return h.certInventoryService.ListCertificates(ctx, request), nil
+}
+
 func CopyValue(src interface{}, dst interface{}) error {
 	jsonBytes, _ := json.Marshal(src)
 	return json.Unmarshal(jsonBytes, dst)
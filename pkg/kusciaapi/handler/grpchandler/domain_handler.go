@@ -52,3 +52,11 @@ func (h domainHandler) DeleteDomain(ctx context.Context, request *kusciaapi.Dele
 func (h domainHandler) BatchQueryDomain(ctx context.Context, request *kusciaapi.BatchQueryDomainRequest) (*kusciaapi.BatchQueryDomainResponse, error) {
 	return h.domainService.BatchQueryDomain(ctx, request), nil
 }
+
+func (h domainHandler) RegisterDomain(ctx context.Context, request *kusciaapi.RegisterDomainRequest) (*kusciaapi.RegisterDomainResponse, error) {
+	return h.domainService.RegisterDomain(ctx, request), nil
+}
+
+func (h domainHandler) QueryDomainOnboarding(ctx context.Context, request *kusciaapi.QueryDomainOnboardingRequest) (*kusciaapi.QueryDomainOnboardingResponse, error) {
+	return h.domainService.QueryDomainOnboarding(ctx, request), nil
+}
@@ -51,3 +51,7 @@ func (h domainRouteHandler) BatchQueryDomainRouteStatus(ctx context.Context, req
 // nolint:unused
 func (h domainRouteHandler) mustEmbedUnimplementedRouteServiceServer() {
 }
+
+func (h domainRouteHandler) QueryDomainRouteStatus(ctx context.Context, request *kusciaapi.QueryDomainRouteStatusRequest) (*kusciaapi.QueryDomainRouteStatusResponse, error) {
+	return h.domainRouteService.QueryDomainRouteStatus(ctx, request), nil
+}
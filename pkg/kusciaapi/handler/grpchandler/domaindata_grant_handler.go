@@ -52,3 +52,11 @@ func (h *domainDataGrantHandler) QueryDomainDataGrant(ctx context.Context, reque
 func (h *domainDataGrantHandler) BatchQueryDomainDataGrant(ctx context.Context, request *kusciaapi.BatchQueryDomainDataGrantRequest) (*kusciaapi.BatchQueryDomainDataGrantResponse, error) {
 	return h.domainDataGrantService.BatchQueryDomainDataGrant(ctx, request), nil
 }
+
+func (h *domainDataGrantHandler) ApproveDomainDataGrant(ctx context.Context, request *kusciaapi.ApproveDomainDataGrantRequest) (*kusciaapi.ApproveDomainDataGrantResponse, error) {
+	return h.domainDataGrantService.ApproveDomainDataGrant(ctx, request), nil
+}
+
+func (h *domainDataGrantHandler) RejectDomainDataGrant(ctx context.Context, request *kusciaapi.RejectDomainDataGrantRequest) (*kusciaapi.RejectDomainDataGrantResponse, error) {
+	return h.domainDataGrantService.RejectDomainDataGrant(ctx, request), nil
+}
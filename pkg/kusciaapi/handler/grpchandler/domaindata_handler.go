@@ -19,17 +19,23 @@ import (
 	"context"
 
 	"github.com/secretflow/kuscia/pkg/kusciaapi/service"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
 
 type domainDataHandler struct {
 	domainDataService service.IDomainDataService
+	downloadService   service.IDomainDataDownloadService
+	uploadService     service.IDomainDataUploadService
 	kusciaapi.UnimplementedDomainDataServiceServer
 }
 
-func NewDomainDataHandler(domainDataService service.IDomainDataService) kusciaapi.DomainDataServiceServer {
+func NewDomainDataHandler(domainDataService service.IDomainDataService, downloadService service.IDomainDataDownloadService,
+	uploadService service.IDomainDataUploadService) kusciaapi.DomainDataServiceServer {
 	return &domainDataHandler{
 		domainDataService: domainDataService,
+		downloadService:   downloadService,
+		uploadService:     uploadService,
 	}
 }
 
@@ -56,3 +62,51 @@ func (h *domainDataHandler) BatchQueryDomainData(ctx context.Context, request *k
 func (h *domainDataHandler) ListDomainData(ctx context.Context, request *kusciaapi.ListDomainDataRequest) (*kusciaapi.ListDomainDataResponse, error) {
 	return h.domainDataService.ListDomainData(ctx, request), nil
 }
+
+func (h *domainDataHandler) SetDomainDataMaskingPolicies(ctx context.Context, request *kusciaapi.SetDomainDataMaskingPoliciesRequest) (*kusciaapi.SetDomainDataMaskingPoliciesResponse, error) {
+	return h.domainDataService.SetDomainDataMaskingPolicies(ctx, request), nil
+}
+
+func (h *domainDataHandler) DownloadDomainData(request *kusciaapi.DownloadDomainDataRequest, srv kusciaapi.DomainDataService_DownloadDomainDataServer) error {
+	eventCh := make(chan *kusciaapi.DownloadDomainDataResponse, 1)
+	go func() {
+		defer close(eventCh)
+		h.downloadService.DownloadDomainData(srv.Context(), request, eventCh)
+	}()
+
+	for e := range eventCh {
+		if err := srv.Send(e); err != nil {
+			nlog.Errorf("Send domaindata download response error: %v", err)
+		}
+	}
+	return nil
+}
+
+func (h *domainDataHandler) UploadDomainData(srv kusciaapi.DomainDataService_UploadDomainDataServer) error {
+	first, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	content := &uploadStreamReader{srv: srv, buf: first.Content}
+	return srv.SendAndClose(h.uploadService.UploadDomainData(srv.Context(), first.Meta, content))
+}
+
+// uploadStreamReader reads the file content carried by the requests of the upload stream.
+type uploadStreamReader struct {
+	srv kusciaapi.DomainDataService_UploadDomainDataServer
+	buf []byte
+}
+
+func (r *uploadStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		request, err := r.srv.Recv()
+		if err != nil {
+			// io.EOF means the client has closed the stream
+			return 0, err
+		}
+		r.buf = request.Content
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
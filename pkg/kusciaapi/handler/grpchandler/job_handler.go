@@ -90,3 +90,31 @@ func (h jobHandler) WatchJob(request *kusciaapi.WatchJobRequest, stream kusciaap
 func (h jobHandler) ApproveJob(ctx context.Context, request *kusciaapi.ApproveJobRequest) (*kusciaapi.ApproveJobResponse, error) {
 	return h.jobService.ApproveJob(ctx, request), nil
 }
+
+func (h jobHandler) BatchCancelJob(ctx context.Context, request *kusciaapi.BatchCancelJobRequest) (*kusciaapi.BatchJobOperationResponse, error) {
+	return h.jobService.BatchCancelJob(ctx, request), nil
+}
+
+func (h jobHandler) BatchApproveJob(ctx context.Context, request *kusciaapi.BatchApproveJobRequest) (*kusciaapi.BatchJobOperationResponse, error) {
+	return h.jobService.BatchApproveJob(ctx, request), nil
+}
+
+func (h jobHandler) ListEvents(ctx context.Context, request *kusciaapi.ListEventsRequest) (*kusciaapi.ListEventsResponse, error) {
+	return h.jobService.ListEvents(ctx, request), nil
+}
+
+func (h jobHandler) QueryJobUsage(ctx context.Context, request *kusciaapi.QueryJobUsageRequest) (*kusciaapi.QueryJobUsageResponse, error) {
+	return h.jobService.QueryJobUsage(ctx, request), nil
+}
+
+func (h jobHandler) ListJobUsage(ctx context.Context, request *kusciaapi.ListJobUsageRequest) (*kusciaapi.ListJobUsageResponse, error) {
+	return h.jobService.ListJobUsage(ctx, request), nil
+}
+
+func (h jobHandler) QueryArchivedJob(ctx context.Context, request *kusciaapi.QueryArchivedJobRequest) (*kusciaapi.QueryArchivedJobResponse, error) {
+	return h.jobService.QueryArchivedJob(ctx, request), nil
+}
+
+func (h jobHandler) ReportArchivedJob(ctx context.Context, request *kusciaapi.ReportArchivedJobRequest) (*kusciaapi.ReportArchivedJobResponse, error) {
+	return h.jobService.ReportArchivedJob(ctx, request), nil
+}
@@ -39,7 +39,7 @@ func (h logHandler) QueryLog(request *kusciaapi.QueryLogRequest, srv kusciaapi.L
 	eventCh := make(chan *kusciaapi.QueryLogResponse, 10)
 	go func() {
 		defer close(eventCh)
-		h.logService.QueryTaskLog(srv.Context(), request, eventCh)
+		h.logService.QueryLog(srv.Context(), request, eventCh)
 	}()
 
 	for e := range eventCh {
@@ -54,3 +54,38 @@ func (h logHandler) QueryPodNode(ctx context.Context, request *kusciaapi.QueryPo
 	res := h.logService.QueryPodNode(ctx, request)
 	return res, nil
 }
+
+func (h logHandler) QueryTaskLog(ctx context.Context, request *kusciaapi.QueryTaskLogRequest) (*kusciaapi.QueryTaskLogResponse, error) {
+	res := h.logService.QueryTaskLog(ctx, request)
+	return res, nil
+}
+
+func (h logHandler) TailTaskLog(request *kusciaapi.TailTaskLogRequest, srv kusciaapi.LogService_TailTaskLogServer) error {
+	eventCh := make(chan *kusciaapi.TailTaskLogResponse, 10)
+	go func() {
+		defer close(eventCh)
+		h.logService.TailTaskLog(srv.Context(), request, eventCh)
+	}()
+
+	for e := range eventCh {
+		if err := srv.Send(e); err != nil {
+			nlog.Errorf("Send task log response error: %v", err)
+		}
+	}
+	return nil
+}
+
+func (h logHandler) PushArchivedLog(ctx context.Context, request *kusciaapi.PushArchivedLogRequest) (*kusciaapi.PushArchivedLogResponse, error) {
+	res := h.logService.PushArchivedLog(ctx, request)
+	return res, nil
+}
+
+func (h logHandler) ListArchivedLog(ctx context.Context, request *kusciaapi.ListArchivedLogRequest) (*kusciaapi.ListArchivedLogResponse, error) {
+	res := h.logService.ListArchivedLog(ctx, request)
+	return res, nil
+}
+
+func (h logHandler) DownloadArchivedLog(ctx context.Context, request *kusciaapi.DownloadArchivedLogRequest) (*kusciaapi.DownloadArchivedLogResponse, error) {
+	res := h.logService.DownloadArchivedLog(ctx, request)
+	return res, nil
+}
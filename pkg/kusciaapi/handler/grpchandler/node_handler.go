@@ -0,0 +1,50 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:dupl
+
+package grpchandler
+
+import (
+	"context"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/service"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type nodeHandler struct {
+	nodeService service.INodeService
+	kusciaapi.UnimplementedNodeServiceServer
+}
+
+func NewNodeHandler(nodeService service.INodeService) kusciaapi.NodeServiceServer {
+	return &nodeHandler{
+		nodeService: nodeService,
+	}
+}
+
+func (h nodeHandler) CordonNode(ctx context.Context, request *kusciaapi.CordonNodeRequest) (*kusciaapi.CordonNodeResponse, error) {
+	res := h.nodeService.CordonNode(ctx, request)
+	return res, nil
+}
+
+func (h nodeHandler) UncordonNode(ctx context.Context, request *kusciaapi.UncordonNodeRequest) (*kusciaapi.UncordonNodeResponse, error) {
+	res := h.nodeService.UncordonNode(ctx, request)
+	return res, nil
+}
+
+func (h nodeHandler) DrainNode(ctx context.Context, request *kusciaapi.DrainNodeRequest) (*kusciaapi.DrainNodeResponse, error) {
+	res := h.nodeService.DrainNode(ctx, request)
+	return res, nil
+}
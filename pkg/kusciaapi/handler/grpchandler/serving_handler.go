@@ -52,3 +52,19 @@ func (h servingHandler) DeleteServing(ctx context.Context, request *kusciaapi.De
 func (h servingHandler) BatchQueryServingStatus(ctx context.Context, request *kusciaapi.BatchQueryServingStatusRequest) (*kusciaapi.BatchQueryServingStatusResponse, error) {
 	return h.servingService.BatchQueryServingStatus(ctx, request), nil
 }
+
+func (h servingHandler) CreateServingCanary(ctx context.Context, request *kusciaapi.CreateServingCanaryRequest) (*kusciaapi.CreateServingCanaryResponse, error) {
+	return h.servingService.CreateServingCanary(ctx, request), nil
+}
+
+func (h servingHandler) UpdateServingTraffic(ctx context.Context, request *kusciaapi.UpdateServingTrafficRequest) (*kusciaapi.UpdateServingTrafficResponse, error) {
+	return h.servingService.UpdateServingTraffic(ctx, request), nil
+}
+
+func (h servingHandler) PromoteServing(ctx context.Context, request *kusciaapi.PromoteServingRequest) (*kusciaapi.PromoteServingResponse, error) {
+	return h.servingService.PromoteServing(ctx, request), nil
+}
+
+func (h servingHandler) RollbackServing(ctx context.Context, request *kusciaapi.RollbackServingRequest) (*kusciaapi.RollbackServingResponse, error) {
+	return h.servingService.RollbackServing(ctx, request), nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debug
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/service"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// ExecContainerHandler serves the exec session on a websocket, each message is a json encoded ExecContainerRequest
+// or ExecContainerResponse.
+type ExecContainerHandler struct {
+	debugService service.IDebugService
+}
+
+func NewExecContainerHandler(debugService service.IDebugService) *ExecContainerHandler {
+	return &ExecContainerHandler{
+		debugService: debugService,
+	}
+}
+
+func (h ExecContainerHandler) Handle(ginCtx *gin.Context) {
+	// the role is set in the gin context by the interceptors
+	ctx := ginCtx.Request.Context()
+	ctx = context.WithValue(ctx, constants.AuthRole, ginCtx.GetString(constants.AuthRole))
+	ctx = context.WithValue(ctx, constants.SourceDomainKey, ginCtx.GetString(constants.SourceDomainKey))
+	// only the sessions proxied by the other nodes carry it, the grpc api can't start a local session
+	ctx = context.WithValue(ctx, constants.NodeExecTokenHeader, ginCtx.GetHeader(constants.NodeExecTokenHeader))
+
+	websocket.Handler(func(ws *websocket.Conn) {
+		defer ws.Close()
+		if err := h.debugService.ExecContainer(ctx, &wsExecStream{ws: ws}); err != nil {
+			nlog.Warnf("Exec session ends with error: %v", err)
+		}
+	}).ServeHTTP(ginCtx.Writer, ginCtx.Request)
+}
+
+type wsExecStream struct {
+	ws *websocket.Conn
+}
+
+func (s *wsExecStream) Recv() (*kusciaapi.ExecContainerRequest, error) {
+	request := &kusciaapi.ExecContainerRequest{}
+	if err := websocket.JSON.Receive(s.ws, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *wsExecStream) Send(response *kusciaapi.ExecContainerResponse) error {
+	return websocket.JSON.Send(s.ws, response)
+}
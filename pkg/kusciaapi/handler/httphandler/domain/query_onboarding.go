@@ -0,0 +1,46 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"reflect"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/service"
+	"github.com/secretflow/kuscia/pkg/web/api"
+	"github.com/secretflow/kuscia/pkg/web/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type queryDomainOnboardingHandler struct {
+	domainService service.IDomainService
+}
+
+func NewQueryDomainOnboardingHandler(domainService service.IDomainService) api.ProtoHandler {
+	return &queryDomainOnboardingHandler{
+		domainService: domainService,
+	}
+}
+
+func (h queryDomainOnboardingHandler) Validate(context *api.BizContext, request api.ProtoRequest, errs *errorcode.Errs) {
+}
+
+func (h queryDomainOnboardingHandler) Handle(context *api.BizContext, request api.ProtoRequest) api.ProtoResponse {
+	onboardingRequest, _ := request.(*kusciaapi.QueryDomainOnboardingRequest)
+	return h.domainService.QueryDomainOnboarding(context.Context, onboardingRequest)
+}
+
+func (h queryDomainOnboardingHandler) GetType() (reqType, respType reflect.Type) {
+	return reflect.TypeOf(kusciaapi.QueryDomainOnboardingRequest{}), reflect.TypeOf(kusciaapi.QueryDomainOnboardingResponse{})
+}
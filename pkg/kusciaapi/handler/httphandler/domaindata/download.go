@@ -0,0 +1,149 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domaindata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/service"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// ContentSha256Trailer is the trailer of the download response, it's the sha256 hex digest of the response body.
+const ContentSha256Trailer = "X-Kuscia-Content-Sha256"
+
+// DownloadDomainDataHandler serves the domaindata file with the http range requests, so that the interrupted
+// downloads could be resumed by the standard http clients, e.g. curl -C -.
+type DownloadDomainDataHandler struct {
+	downloadService service.IDomainDataDownloadService
+}
+
+func NewDownloadDomainDataHandler(downloadService service.IDomainDataDownloadService) *DownloadDomainDataHandler {
+	return &DownloadDomainDataHandler{
+		downloadService: downloadService,
+	}
+}
+
+func (h DownloadDomainDataHandler) Handle(ginCtx *gin.Context) {
+	req := &kusciaapi.DownloadDomainDataRequest{
+		DomainId:     ginCtx.Query("domain_id"),
+		DomaindataId: ginCtx.Query("domaindata_id"),
+		GrantDomain:  ginCtx.Query("grant_domain"),
+	}
+	rangeHeader := ginCtx.GetHeader("Range")
+	if rangeHeader != "" {
+		offset, limitBytes, err := parseByteRange(rangeHeader)
+		if err != nil {
+			h.writeError(ginCtx, http.StatusRequestedRangeNotSatisfiable,
+				utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err))
+			return
+		}
+		req.Offset, req.LimitBytes = offset, limitBytes
+	}
+
+	ctx := requestContext(ginCtx)
+
+	reader, end, totalSize, status := h.downloadService.OpenDomainData(ctx, req)
+	if status != nil {
+		code := http.StatusInternalServerError
+		switch pberrorcode.ErrorCode(status.Code) {
+		case pberrorcode.ErrorCode_KusciaAPIErrRequestValidate:
+			code = http.StatusBadRequest
+			if rangeHeader != "" {
+				code = http.StatusRequestedRangeNotSatisfiable
+			}
+		case pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted:
+			code = http.StatusForbidden
+		case pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotExists:
+			code = http.StatusNotFound
+		}
+		h.writeError(ginCtx, code, status)
+		return
+	}
+	defer reader.Close()
+
+	header := ginCtx.Writer.Header()
+	header.Set("Content-Type", "application/octet-stream")
+	header.Set("Accept-Ranges", "bytes")
+	// the response is chunked without Content-Length, otherwise the trailer is dropped
+	header.Set("Trailer", ContentSha256Trailer)
+	code := http.StatusOK
+	if rangeHeader != "" {
+		code = http.StatusPartialContent
+		header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", req.Offset, end-1, totalSize))
+	}
+	ginCtx.Status(code)
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(ginCtx.Writer, hash), reader); err != nil {
+		// the client resumes from the bytes it has received
+		nlog.Warnf("Download domaindata %s/%s failed: %v", req.DomainId, req.DomaindataId, err)
+		return
+	}
+	header.Set(ContentSha256Trailer, hex.EncodeToString(hash.Sum(nil)))
+}
+
+func (h DownloadDomainDataHandler) writeError(ginCtx *gin.Context, code int, status *v1alpha1.Status) {
+	nlog.Warnf("Download domaindata failed with http code %d: %s", code, status.Message)
+	ginCtx.JSON(code, &kusciaapi.DownloadDomainDataResponse{Status: status})
+}
+
+// parseByteRange parses the single range of the Range header, e.g. bytes=100- or bytes=100-199.
+func parseByteRange(header string) (offset, limitBytes int64, err error) {
+	spec, ok := strings.CutPrefix(header, "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return 0, 0, fmt.Errorf("range %q is not supported, only a single bytes range is supported", header)
+	}
+	start, end, ok := strings.Cut(spec, "-")
+	if !ok || start == "" {
+		return 0, 0, fmt.Errorf("range %q is not supported, the start of the range is required", header)
+	}
+	if offset, err = strconv.ParseInt(start, 10, 64); err != nil || offset < 0 {
+		return 0, 0, fmt.Errorf("range %q has invalid start", header)
+	}
+	if end == "" {
+		return offset, 0, nil
+	}
+	last, err := strconv.ParseInt(end, 10, 64)
+	if err != nil || last < offset {
+		return 0, 0, fmt.Errorf("range %q has invalid end", header)
+	}
+	return offset, last - offset + 1, nil
+}
+
+// requestContext carries the role and the tenant, which are set in the gin context by the interceptors.
+func requestContext(ginCtx *gin.Context) context.Context {
+	ctx := ginCtx.Request.Context()
+	ctx = context.WithValue(ctx, constants.AuthRole, ginCtx.GetString(constants.AuthRole))
+	ctx = context.WithValue(ctx, constants.SourceDomainKey, ginCtx.GetString(constants.SourceDomainKey))
+	if t, ok := ginCtx.Get(constants.AuthTenant); ok {
+		ctx = context.WithValue(ctx, constants.AuthTenant, t)
+	}
+	return ctx
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domaindata
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/service"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/web/decorator/binder"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const (
+	// uploadMetaField is the form field of the UploadDomainDataMeta in json, it must precede the file field.
+	uploadMetaField = "meta"
+	uploadFileField = "file"
+)
+
+// UploadDomainDataHandler receives the multipart form with the meta and the file, the file is streamed into the
+// datasource without being buffered on the disk.
+type UploadDomainDataHandler struct {
+	uploadService service.IDomainDataUploadService
+}
+
+func NewUploadDomainDataHandler(uploadService service.IDomainDataUploadService) *UploadDomainDataHandler {
+	return &UploadDomainDataHandler{
+		uploadService: uploadService,
+	}
+}
+
+func (h UploadDomainDataHandler) Handle(ginCtx *gin.Context) {
+	reader, err := ginCtx.Request.MultipartReader()
+	if err != nil {
+		h.writeError(ginCtx, err)
+		return
+	}
+
+	meta := &kusciaapi.UploadDomainDataMeta{}
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			h.writeError(ginCtx, fmt.Errorf("form field %q is required", uploadFileField))
+			return
+		}
+		if err != nil {
+			h.writeError(ginCtx, err)
+			return
+		}
+
+		switch part.FormName() {
+		case uploadMetaField:
+			body, err := io.ReadAll(io.LimitReader(part, 1024*1024))
+			if err == nil {
+				err = binder.JSONProtoBinder{}.BindBody(body, meta)
+			}
+			if err != nil {
+				h.writeError(ginCtx, fmt.Errorf("parse form field %q failed, %v", uploadMetaField, err))
+				return
+			}
+		case uploadFileField:
+			ginCtx.JSON(http.StatusOK, h.uploadService.UploadDomainData(requestContext(ginCtx), meta, part))
+			return
+		}
+	}
+}
+
+func (h UploadDomainDataHandler) writeError(ginCtx *gin.Context, err error) {
+	nlog.Warnf("Upload domaindata handler parse request failed, error: %s", err.Error())
+	ginCtx.JSON(http.StatusOK, &kusciaapi.UploadDomainDataResponse{
+		Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/service"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+var usageCSVHeader = []string{
+	"month", "job_id", "initiator", "job_end_time", "task_id", "task_alias", "domain_id", "cpu_seconds",
+	"memory_gb_hours", "memory_peak_bytes", "network_receive_bytes", "network_transmit_bytes", "cross_domain_bytes",
+}
+
+// ExportJobUsageHandler exports the usage of the jobs completed in a month as csv, one row for each party of each
+// task, so that the usage could be billed to the parties.
+type ExportJobUsageHandler struct {
+	jobService service.IJobService
+}
+
+func NewExportJobUsageHandler(jobService service.IJobService) *ExportJobUsageHandler {
+	return &ExportJobUsageHandler{
+		jobService: jobService,
+	}
+}
+
+func (h ExportJobUsageHandler) Handle(ginCtx *gin.Context) {
+	req := &kusciaapi.ListJobUsageRequest{
+		Month:    ginCtx.Query("month"),
+		DomainId: ginCtx.Query("domain_id"),
+	}
+	resp := h.jobService.ListJobUsage(ginCtx, req)
+	if resp.Status.Code != int32(errorcode.ErrorCode_SUCCESS) {
+		// the failures are responded in json like the other apis
+		body, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(resp)
+		if err != nil {
+			_ = ginCtx.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		ginCtx.Data(http.StatusOK, "application/json; charset=utf-8", body)
+		return
+	}
+
+	filename := fmt.Sprintf("job-usage-%s.csv", req.Month)
+	if req.DomainId != "" {
+		filename = fmt.Sprintf("job-usage-%s-%s.csv", req.Month, req.DomainId)
+	}
+	ginCtx.Header("Content-Type", "text/csv; charset=utf-8")
+	ginCtx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	ginCtx.Status(http.StatusOK)
+	if err := WriteJobUsageCSV(csv.NewWriter(ginCtx.Writer), resp.Data); err != nil {
+		nlog.Warnf("Write job usage csv failed, error: %s", err.Error())
+	}
+}
+
+// WriteJobUsageCSV writes the usage of the tasks of each party as csv rows after the header.
+func WriteJobUsageCSV(w *csv.Writer, data *kusciaapi.ListJobUsageResponseData) error {
+	if err := w.Write(usageCSVHeader); err != nil {
+		return err
+	}
+	for _, job := range data.Jobs {
+		for _, task := range job.Tasks {
+			for _, party := range task.Parties {
+				if err := w.Write([]string{
+					data.Month,
+					job.JobId,
+					job.Initiator,
+					job.EndTime,
+					task.TaskId,
+					task.Alias,
+					party.DomainId,
+					strconv.FormatFloat(party.CpuSeconds, 'f', -1, 64),
+					strconv.FormatFloat(party.MemoryGbHours, 'f', -1, 64),
+					strconv.FormatInt(party.MemoryPeakBytes, 10),
+					strconv.FormatInt(party.NetworkReceiveBytes, 10),
+					strconv.FormatInt(party.NetworkTransmitBytes, 10),
+					strconv.FormatInt(party.CrossDomainBytes, 10),
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
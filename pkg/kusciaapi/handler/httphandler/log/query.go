@@ -48,7 +48,7 @@ func (h QueryHandler) Handle(ginCtx *gin.Context) {
 	eventCh := make(chan *kusciaapi.QueryLogResponse, 10)
 	go func() {
 		defer close(eventCh)
-		h.logService.QueryTaskLog(ginCtx, req, eventCh)
+		h.logService.QueryLog(ginCtx, req, eventCh)
 	}()
 	ginCtx.Header("Content-Type", "application/json; charset=utf-8")
 	ginCtx.Header("Transfer-Encoding", "chunked")
@@ -0,0 +1,84 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/service"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type TailTaskLogHandler struct {
+	logService service.ILogService
+}
+
+func NewTailTaskLogHandler(logService service.ILogService) *TailTaskLogHandler {
+	return &TailTaskLogHandler{
+		logService: logService,
+	}
+}
+
+func (h TailTaskLogHandler) Handle(ginCtx *gin.Context) {
+	req := &kusciaapi.TailTaskLogRequest{}
+	if err := ginCtx.ShouldBind(req); err != nil {
+		nlog.Errorf("Tail task log handler parse request failed, error: %s", err.Error())
+		_ = ginCtx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	nlog.Infof("Tail task log request: %+v", req)
+
+	// the following stops when the client disconnects
+	ctx := ginCtx.Request.Context()
+	eventCh := make(chan *kusciaapi.TailTaskLogResponse, 10)
+	go func() {
+		defer close(eventCh)
+		h.logService.TailTaskLog(ctx, req, eventCh)
+	}()
+	defer func() {
+		// drain the events so that the tailing goroutine is not blocked
+		for range eventCh {
+		}
+	}()
+	ginCtx.Header("Content-Type", "application/json; charset=utf-8")
+	ginCtx.Header("Transfer-Encoding", "chunked")
+	ginCtx.Header("Cache-Control", "no-cache")
+
+	ginCtx.Stream(func(w io.Writer) bool {
+		resp, ok := <-eventCh
+		if !ok {
+			nlog.Infof("TailTaskLog stream reach end, close connection")
+			return false
+		}
+		respBody, err := json.Marshal(resp)
+		if err != nil {
+			nlog.Errorf("Marshal response body failed, error: %s.", err.Error())
+			return false
+		}
+		if _, err := w.Write(respBody); err != nil {
+			nlog.Errorf("Write response body failed, error:%s.", err.Error())
+			return false
+		}
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+		return true
+	})
+}
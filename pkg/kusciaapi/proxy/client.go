@@ -36,10 +36,11 @@ const (
 	QueryDomainPath      = "/api/v1/domain/query"
 	BatchQueryDomainPath = "/api/v1/domain/batchQuery"
 	// Domain Route
-	CreateDomainRoutePath     = "/api/v1/route/create"
-	DeleteDomainRoutePath     = "/api/v1/route/delete"
-	QueryDomainRoutePath      = "/api/v1/route/query"
-	BatchQueryDomainRoutePath = "/api/v1/route/status/batchQuery"
+	CreateDomainRoutePath      = "/api/v1/route/create"
+	DeleteDomainRoutePath      = "/api/v1/route/delete"
+	QueryDomainRoutePath       = "/api/v1/route/query"
+	BatchQueryDomainRoutePath  = "/api/v1/route/status/batchQuery"
+	QueryDomainRouteStatusPath = "/api/v1/route/status/query"
 	// Domain Data
 	CreateDomainDataPath     = "/api/v1/domaindata/create"
 	UpdateDomainDataPath     = "/api/v1/domaindata/update"
@@ -48,25 +49,45 @@ const (
 	BatchQueryDomainDataPath = "/api/v1/domaindata/batchQuery"
 	ListDomainDataPath       = "/api/v1/domaindata/list"
 	// Kuscia Job
-	CreateJobPath     = "/api/v1/job/create"
-	DeleteJobPath     = "/api/v1/job/delete"
-	QueryJobPath      = "/api/v1/job/query"
-	StopJobPath       = "/api/v1/job/stop"
-	SuspendJobPath    = "/api/v1/job/suspend"
-	RestartJobPath    = "/api/v1/job/restart"
-	CancelJobPath     = "/api/v1/job/cancel"
-	ApproveJobPath    = "/api/v1/job/approve"
-	BatchQueryJobPath = "/api/v1/job/status/batchQuery"
-	WatchJobPath      = "/api/v1/job/watch"
+	CreateJobPath         = "/api/v1/job/create"
+	DeleteJobPath         = "/api/v1/job/delete"
+	QueryJobPath          = "/api/v1/job/query"
+	StopJobPath           = "/api/v1/job/stop"
+	SuspendJobPath        = "/api/v1/job/suspend"
+	RestartJobPath        = "/api/v1/job/restart"
+	CancelJobPath         = "/api/v1/job/cancel"
+	ApproveJobPath        = "/api/v1/job/approve"
+	BatchQueryJobPath     = "/api/v1/job/status/batchQuery"
+	WatchJobPath          = "/api/v1/job/watch"
+	BatchCancelJobPath    = "/api/v1/job/batchCancel"
+	BatchApproveJobPath   = "/api/v1/job/batchApprove"
+	ListJobEventsPath     = "/api/v1/job/event/list"
+	QueryJobUsagePath     = "/api/v1/job/usage/query"
+	ListJobUsagePath      = "/api/v1/job/usage/list"
+	QueryArchivedJobPath  = "/api/v1/job/archive/query"
+	ReportArchivedJobPath = "/api/v1/job/archive/report"
 	// Log
-	QueryPodNodePath = "/api/v1/log/node/query"
+	QueryPodNodePath        = "/api/v1/log/node/query"
+	PushArchivedLogPath     = "/api/v1/log/archive/push"
+	ListArchivedLogPath     = "/api/v1/log/archive/list"
+	DownloadArchivedLogPath = "/api/v1/log/archive/download"
+	// Node
+	CordonNodePath   = "/api/v1/node/cordon"
+	UncordonNodePath = "/api/v1/node/uncordon"
+	DrainNodePath    = "/api/v1/node/drain"
+	// Search
+	SearchPath = "/api/v1/resource/search"
 
 	// Kuscia Serving
-	CreateServingPath     = "/api/v1/serving/create"
-	UpdateServingPath     = "/api/v1/serving/update"
-	DeleteServingPath     = "/api/v1/serving/delete"
-	QueryServingPath      = "/api/v1/serving/query"
-	BatchQueryServingPath = "/api/v1/serving/status/batchQuery"
+	CreateServingPath        = "/api/v1/serving/create"
+	UpdateServingPath        = "/api/v1/serving/update"
+	DeleteServingPath        = "/api/v1/serving/delete"
+	QueryServingPath         = "/api/v1/serving/query"
+	BatchQueryServingPath    = "/api/v1/serving/status/batchQuery"
+	CreateServingCanaryPath  = "/api/v1/serving/canary/create"
+	UpdateServingTrafficPath = "/api/v1/serving/traffic/update"
+	PromoteServingPath       = "/api/v1/serving/promote"
+	RollbackServingPath      = "/api/v1/serving/rollback"
 	// AppImage
 	CreateAppImagePath = "/api/v1/appimage/create"
 	UpdateAppImagePath = "/api/v1/appimage/update"
@@ -93,6 +114,8 @@ type KusciaAPIClient interface {
 
 	BatchQueryDomainRoute(ctx context.Context, request *kusciaapi.BatchQueryDomainRouteStatusRequest) (response *kusciaapi.BatchQueryDomainRouteStatusResponse, err error)
 
+	QueryDomainRouteStatus(ctx context.Context, request *kusciaapi.QueryDomainRouteStatusRequest) (response *kusciaapi.QueryDomainRouteStatusResponse, err error)
+
 	CreateDomainData(ctx context.Context, request *kusciaapi.CreateDomainDataRequest) (response *kusciaapi.CreateDomainDataResponse, err error)
 
 	UpdateDomainData(ctx context.Context, request *kusciaapi.UpdateDomainDataRequest) (response *kusciaapi.UpdateDomainDataResponse, err error)
@@ -133,13 +156,49 @@ type KusciaAPIClient interface {
 
 	BatchQueryServing(ctx context.Context, request *kusciaapi.BatchQueryServingStatusRequest) (response *kusciaapi.BatchQueryServingStatusResponse, err error)
 
+	CreateServingCanary(ctx context.Context, request *kusciaapi.CreateServingCanaryRequest) (response *kusciaapi.CreateServingCanaryResponse, err error)
+
+	UpdateServingTraffic(ctx context.Context, request *kusciaapi.UpdateServingTrafficRequest) (response *kusciaapi.UpdateServingTrafficResponse, err error)
+
+	PromoteServing(ctx context.Context, request *kusciaapi.PromoteServingRequest) (response *kusciaapi.PromoteServingResponse, err error)
+
+	RollbackServing(ctx context.Context, request *kusciaapi.RollbackServingRequest) (response *kusciaapi.RollbackServingResponse, err error)
+
 	UpdateAppImage(ctx context.Context, request *kusciaapi.UpdateAppImageRequest) (response *kusciaapi.UpdateAppImageResponse, err error)
 
 	QueryAppImage(ctx context.Context, request *kusciaapi.QueryAppImageRequest) (response *kusciaapi.QueryAppImageResponse, err error)
 
 	ApproveJob(ctx context.Context, request *kusciaapi.ApproveJobRequest) (response *kusciaapi.ApproveJobResponse, err error)
 
+	BatchCancelJob(ctx context.Context, request *kusciaapi.BatchCancelJobRequest) (response *kusciaapi.BatchJobOperationResponse, err error)
+
+	BatchApproveJob(ctx context.Context, request *kusciaapi.BatchApproveJobRequest) (response *kusciaapi.BatchJobOperationResponse, err error)
+
+	ListJobEvents(ctx context.Context, request *kusciaapi.ListEventsRequest) (response *kusciaapi.ListEventsResponse, err error)
+
+	QueryJobUsage(ctx context.Context, request *kusciaapi.QueryJobUsageRequest) (response *kusciaapi.QueryJobUsageResponse, err error)
+
+	ListJobUsage(ctx context.Context, request *kusciaapi.ListJobUsageRequest) (response *kusciaapi.ListJobUsageResponse, err error)
+
+	QueryArchivedJob(ctx context.Context, request *kusciaapi.QueryArchivedJobRequest) (response *kusciaapi.QueryArchivedJobResponse, err error)
+
+	ReportArchivedJob(ctx context.Context, request *kusciaapi.ReportArchivedJobRequest) (response *kusciaapi.ReportArchivedJobResponse, err error)
+
 	QueryPodNode(ctx context.Context, request *kusciaapi.QueryPodNodeRequest) (response *kusciaapi.QueryPodNodeResponse, err error)
+
+	PushArchivedLog(ctx context.Context, request *kusciaapi.PushArchivedLogRequest) (response *kusciaapi.PushArchivedLogResponse, err error)
+
+	ListArchivedLog(ctx context.Context, request *kusciaapi.ListArchivedLogRequest) (response *kusciaapi.ListArchivedLogResponse, err error)
+
+	DownloadArchivedLog(ctx context.Context, request *kusciaapi.DownloadArchivedLogRequest) (response *kusciaapi.DownloadArchivedLogResponse, err error)
+
+	CordonNode(ctx context.Context, request *kusciaapi.CordonNodeRequest) (response *kusciaapi.CordonNodeResponse, err error)
+
+	UncordonNode(ctx context.Context, request *kusciaapi.UncordonNodeRequest) (response *kusciaapi.UncordonNodeResponse, err error)
+
+	DrainNode(ctx context.Context, request *kusciaapi.DrainNodeRequest) (response *kusciaapi.DrainNodeResponse, err error)
+
+	Search(ctx context.Context, request *kusciaapi.SearchRequest) (response *kusciaapi.SearchResponse, err error)
 }
 
 func NewKusciaAPIClient(endpoint string) KusciaAPIClient {
@@ -198,6 +257,12 @@ func (c *KusciaAPIHttpClient) BatchQueryDomainRoute(ctx context.Context, request
 	return
 }
 
+func (c *KusciaAPIHttpClient) QueryDomainRouteStatus(ctx context.Context, request *kusciaapi.QueryDomainRouteStatusRequest) (response *kusciaapi.QueryDomainRouteStatusResponse, err error) {
+	response = &kusciaapi.QueryDomainRouteStatusResponse{}
+	err = c.Send(ctx, request, response, QueryDomainRouteStatusPath)
+	return
+}
+
 func (c *KusciaAPIHttpClient) CreateDomainData(ctx context.Context, request *kusciaapi.CreateDomainDataRequest) (response *kusciaapi.CreateDomainDataResponse, err error) {
 	response = &kusciaapi.CreateDomainDataResponse{}
 	err = c.Send(ctx, request, response, CreateDomainDataPath)
@@ -282,6 +347,48 @@ func (c *KusciaAPIHttpClient) ApproveJob(ctx context.Context, request *kusciaapi
 	return
 }
 
+func (c *KusciaAPIHttpClient) BatchCancelJob(ctx context.Context, request *kusciaapi.BatchCancelJobRequest) (response *kusciaapi.BatchJobOperationResponse, err error) {
+	response = &kusciaapi.BatchJobOperationResponse{}
+	err = c.Send(ctx, request, response, BatchCancelJobPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) BatchApproveJob(ctx context.Context, request *kusciaapi.BatchApproveJobRequest) (response *kusciaapi.BatchJobOperationResponse, err error) {
+	response = &kusciaapi.BatchJobOperationResponse{}
+	err = c.Send(ctx, request, response, BatchApproveJobPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) ListJobEvents(ctx context.Context, request *kusciaapi.ListEventsRequest) (response *kusciaapi.ListEventsResponse, err error) {
+	response = &kusciaapi.ListEventsResponse{}
+	err = c.Send(ctx, request, response, ListJobEventsPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) QueryJobUsage(ctx context.Context, request *kusciaapi.QueryJobUsageRequest) (response *kusciaapi.QueryJobUsageResponse, err error) {
+	response = &kusciaapi.QueryJobUsageResponse{}
+	err = c.Send(ctx, request, response, QueryJobUsagePath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) ListJobUsage(ctx context.Context, request *kusciaapi.ListJobUsageRequest) (response *kusciaapi.ListJobUsageResponse, err error) {
+	response = &kusciaapi.ListJobUsageResponse{}
+	err = c.Send(ctx, request, response, ListJobUsagePath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) QueryArchivedJob(ctx context.Context, request *kusciaapi.QueryArchivedJobRequest) (response *kusciaapi.QueryArchivedJobResponse, err error) {
+	response = &kusciaapi.QueryArchivedJobResponse{}
+	err = c.Send(ctx, request, response, QueryArchivedJobPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) ReportArchivedJob(ctx context.Context, request *kusciaapi.ReportArchivedJobRequest) (response *kusciaapi.ReportArchivedJobResponse, err error) {
+	response = &kusciaapi.ReportArchivedJobResponse{}
+	err = c.Send(ctx, request, response, ReportArchivedJobPath)
+	return
+}
+
 func (c *KusciaAPIHttpClient) BatchQueryJob(ctx context.Context, request *kusciaapi.BatchQueryJobStatusRequest) (response *kusciaapi.BatchQueryJobStatusResponse, err error) {
 	response = &kusciaapi.BatchQueryJobStatusResponse{}
 	err = c.Send(ctx, request, response, BatchQueryJobPath)
@@ -374,6 +481,30 @@ func (c *KusciaAPIHttpClient) BatchQueryServing(ctx context.Context, request *ku
 	return
 }
 
+func (c *KusciaAPIHttpClient) CreateServingCanary(ctx context.Context, request *kusciaapi.CreateServingCanaryRequest) (response *kusciaapi.CreateServingCanaryResponse, err error) {
+	response = &kusciaapi.CreateServingCanaryResponse{}
+	err = c.Send(ctx, request, response, CreateServingCanaryPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) UpdateServingTraffic(ctx context.Context, request *kusciaapi.UpdateServingTrafficRequest) (response *kusciaapi.UpdateServingTrafficResponse, err error) {
+	response = &kusciaapi.UpdateServingTrafficResponse{}
+	err = c.Send(ctx, request, response, UpdateServingTrafficPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) PromoteServing(ctx context.Context, request *kusciaapi.PromoteServingRequest) (response *kusciaapi.PromoteServingResponse, err error) {
+	response = &kusciaapi.PromoteServingResponse{}
+	err = c.Send(ctx, request, response, PromoteServingPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) RollbackServing(ctx context.Context, request *kusciaapi.RollbackServingRequest) (response *kusciaapi.RollbackServingResponse, err error) {
+	response = &kusciaapi.RollbackServingResponse{}
+	err = c.Send(ctx, request, response, RollbackServingPath)
+	return
+}
+
 func (c *KusciaAPIHttpClient) UpdateAppImage(ctx context.Context, request *kusciaapi.UpdateAppImageRequest) (response *kusciaapi.UpdateAppImageResponse, err error) {
 	response = &kusciaapi.UpdateAppImageResponse{}
 	err = c.Send(ctx, request, response, UpdateAppImagePath)
@@ -392,6 +523,48 @@ func (c *KusciaAPIHttpClient) QueryPodNode(ctx context.Context, request *kusciaa
 	return
 }
 
+func (c *KusciaAPIHttpClient) PushArchivedLog(ctx context.Context, request *kusciaapi.PushArchivedLogRequest) (response *kusciaapi.PushArchivedLogResponse, err error) {
+	response = &kusciaapi.PushArchivedLogResponse{}
+	err = c.Send(ctx, request, response, PushArchivedLogPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) ListArchivedLog(ctx context.Context, request *kusciaapi.ListArchivedLogRequest) (response *kusciaapi.ListArchivedLogResponse, err error) {
+	response = &kusciaapi.ListArchivedLogResponse{}
+	err = c.Send(ctx, request, response, ListArchivedLogPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) DownloadArchivedLog(ctx context.Context, request *kusciaapi.DownloadArchivedLogRequest) (response *kusciaapi.DownloadArchivedLogResponse, err error) {
+	response = &kusciaapi.DownloadArchivedLogResponse{}
+	err = c.Send(ctx, request, response, DownloadArchivedLogPath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) CordonNode(ctx context.Context, request *kusciaapi.CordonNodeRequest) (response *kusciaapi.CordonNodeResponse, err error) {
+	response = &kusciaapi.CordonNodeResponse{}
+	err = c.Send(ctx, request, response, CordonNodePath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) UncordonNode(ctx context.Context, request *kusciaapi.UncordonNodeRequest) (response *kusciaapi.UncordonNodeResponse, err error) {
+	response = &kusciaapi.UncordonNodeResponse{}
+	err = c.Send(ctx, request, response, UncordonNodePath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) DrainNode(ctx context.Context, request *kusciaapi.DrainNodeRequest) (response *kusciaapi.DrainNodeResponse, err error) {
+	response = &kusciaapi.DrainNodeResponse{}
+	err = c.Send(ctx, request, response, DrainNodePath)
+	return
+}
+
+func (c *KusciaAPIHttpClient) Search(ctx context.Context, request *kusciaapi.SearchRequest) (response *kusciaapi.SearchResponse, err error) {
+	response = &kusciaapi.SearchResponse{}
+	err = c.Send(ctx, request, response, SearchPath)
+	return
+}
+
 func (c *KusciaAPIHttpClient) Send(ctx context.Context, request proto.Message, response proto.Message, path string) error {
 	byteReq, err := proto.Marshal(request)
 	if err != nil {
@@ -17,6 +17,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
 
 	v1 "k8s.io/api/core/v1"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
@@ -29,6 +32,7 @@ import (
 	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/errorcode"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/proxy"
+	apiutils "github.com/secretflow/kuscia/pkg/kusciaapi/utils"
 	"github.com/secretflow/kuscia/pkg/utils/resources"
 	"github.com/secretflow/kuscia/pkg/web/utils"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
@@ -41,6 +45,8 @@ type IAppImageService interface {
 	UpdateAppImage(ctx context.Context, request *kusciaapi.UpdateAppImageRequest) *kusciaapi.UpdateAppImageResponse
 	DeleteAppImage(ctx context.Context, request *kusciaapi.DeleteAppImageRequest) *kusciaapi.DeleteAppImageResponse
 	BatchQueryAppImage(ctx context.Context, request *kusciaapi.BatchQueryAppImageRequest) *kusciaapi.BatchQueryAppImageResponse
+	PrewarmImage(ctx context.Context, request *kusciaapi.PrewarmImageRequest) *kusciaapi.PrewarmImageResponse
+	QueryPrewarmImage(ctx context.Context, request *kusciaapi.QueryPrewarmImageRequest) *kusciaapi.QueryPrewarmImageResponse
 }
 
 type appImageService struct {
@@ -65,17 +71,17 @@ func NewAppImageService(config *config.KusciaAPIConfig) IAppImageService {
 func (s appImageService) CreateAppImage(ctx context.Context, request *kusciaapi.CreateAppImageRequest) *kusciaapi.CreateAppImageResponse {
 	// validate
 	if err := validateCreateAppImageRequest(request); err != nil {
-		return &kusciaapi.CreateAppImageResponse{Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error())}
+		return &kusciaapi.CreateAppImageResponse{Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err)}
 	}
 	// build resources
 	k8sAppImage, err := buildK8sAppImage(request.Name, request.Image, request.ConfigTemplates, request.DeployTemplates)
 	if err != nil {
-		return &kusciaapi.CreateAppImageResponse{Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrCreateAppImage, err.Error())}
+		return &kusciaapi.CreateAppImageResponse{Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrCreateAppImage, err)}
 	}
 
 	// create resources
 	if _, err := s.kusciaClient.KusciaV1alpha1().AppImages().Create(ctx, k8sAppImage, metav1.CreateOptions{}); err != nil {
-		return &kusciaapi.CreateAppImageResponse{Status: utils.BuildErrorResponseStatus(errorcode.GetAppImageErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateAppImage), err.Error())}
+		return &kusciaapi.CreateAppImageResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.GetAppImageErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateAppImage), err)}
 	}
 
 	return &kusciaapi.CreateAppImageResponse{Status: utils.BuildSuccessResponseStatus()}
@@ -93,7 +99,7 @@ func (s appImageService) QueryAppImage(ctx context.Context, request *kusciaapi.Q
 	k8sAppImage, err := s.kusciaClient.KusciaV1alpha1().AppImages().Get(ctx, appImageName, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.QueryAppImageResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetAppImageErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryAppImage), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetAppImageErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryAppImage), err),
 		}
 	}
 
@@ -138,23 +144,23 @@ func (s appImageService) BatchQueryAppImage(ctx context.Context, request *kuscia
 func (s appImageService) UpdateAppImage(ctx context.Context, request *kusciaapi.UpdateAppImageRequest) *kusciaapi.UpdateAppImageResponse {
 	// validate
 	if err := validateUpdateAppImageRequest(request); err != nil {
-		return &kusciaapi.UpdateAppImageResponse{Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error())}
+		return &kusciaapi.UpdateAppImageResponse{Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err)}
 	}
 
 	// get resource
 	k8sAppImage, err := s.kusciaClient.KusciaV1alpha1().AppImages().Get(ctx, request.Name, metav1.GetOptions{})
 	if err != nil {
-		return &kusciaapi.UpdateAppImageResponse{Status: utils.BuildErrorResponseStatus(errorcode.GetAppImageErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateAppImage), err.Error())}
+		return &kusciaapi.UpdateAppImageResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.GetAppImageErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateAppImage), err)}
 	}
 
 	// override resources
 	if err := overrideK8sAppImage(k8sAppImage, request.Image, request.ConfigTemplates, request.DeployTemplates); err != nil {
-		return &kusciaapi.UpdateAppImageResponse{Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrUpdateAppImage, err.Error())}
+		return &kusciaapi.UpdateAppImageResponse{Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrUpdateAppImage, err)}
 	}
 
 	// update resources
 	if _, err := s.kusciaClient.KusciaV1alpha1().AppImages().Update(ctx, k8sAppImage, metav1.UpdateOptions{}); err != nil {
-		return &kusciaapi.UpdateAppImageResponse{Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrUpdateAppImage, err.Error())}
+		return &kusciaapi.UpdateAppImageResponse{Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrUpdateAppImage, err)}
 	}
 
 	return &kusciaapi.UpdateAppImageResponse{Status: utils.BuildSuccessResponseStatus()}
@@ -172,7 +178,7 @@ func (s appImageService) DeleteAppImage(ctx context.Context, request *kusciaapi.
 	err := s.kusciaClient.KusciaV1alpha1().AppImages().Delete(ctx, appImageName, metav1.DeleteOptions{})
 	if err != nil {
 		return &kusciaapi.DeleteAppImageResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrDeleteAppImage, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDeleteAppImage, err),
 		}
 	}
 	return &kusciaapi.DeleteAppImageResponse{
@@ -180,30 +186,156 @@ func (s appImageService) DeleteAppImage(ctx context.Context, request *kusciaapi.
 	}
 }
 
+// PrewarmImage creates an image prewarm of the same name in each domain, the agents of the domains pull the image
+// of the app image in advance, so that the first task using the app image doesn't wait for pulling.
+func (s appImageService) PrewarmImage(ctx context.Context, request *kusciaapi.PrewarmImageRequest) *kusciaapi.PrewarmImageResponse {
+	// validate
+	if err := validatePrewarmImageRequest(request); err != nil {
+		return &kusciaapi.PrewarmImageResponse{Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err)}
+	}
+	if _, err := s.kusciaClient.KusciaV1alpha1().AppImages().Get(ctx, request.AppImage, metav1.GetOptions{}); err != nil {
+		return &kusciaapi.PrewarmImageResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetAppImageErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrPrewarmImage), err),
+		}
+	}
+	for _, domainID := range request.DomainIds {
+		if _, err := s.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, domainID, metav1.GetOptions{}); err != nil {
+			return &kusciaapi.PrewarmImageResponse{
+				Status: utils.BuildErrorResponseStatus(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrPrewarmImage), fmt.Sprintf("get domain %s failed, %v", domainID, err)),
+			}
+		}
+	}
+
+	prewarmID := request.PrewarmId
+	if prewarmID == "" {
+		prewarmID = fmt.Sprintf("prewarm-%s", uuid.NewString()[:8])
+	}
+	// create resources
+	for _, domainID := range request.DomainIds {
+		imagePrewarm := &v1alpha1.ImagePrewarm{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      prewarmID,
+				Namespace: domainID,
+			},
+			Spec: v1alpha1.ImagePrewarmSpec{
+				AppImage: request.AppImage,
+				Nodes:    request.Nodes,
+			},
+		}
+		if _, err := s.kusciaClient.KusciaV1alpha1().ImagePrewarms(domainID).Create(ctx, imagePrewarm, metav1.CreateOptions{}); err != nil {
+			return &kusciaapi.PrewarmImageResponse{
+				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrPrewarmImage, fmt.Sprintf("create image prewarm in domain %s failed, %v", domainID, err)),
+			}
+		}
+	}
+	return &kusciaapi.PrewarmImageResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   &kusciaapi.PrewarmImageResponseData{PrewarmId: prewarmID},
+	}
+}
+
+func (s appImageService) QueryPrewarmImage(ctx context.Context, request *kusciaapi.QueryPrewarmImageRequest) *kusciaapi.QueryPrewarmImageResponse {
+	// validate
+	if request.PrewarmId == "" {
+		return &kusciaapi.QueryPrewarmImageResponse{
+			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "prewarm id can not be empty"),
+		}
+	}
+	imagePrewarms, err := s.kusciaClient.KusciaV1alpha1().ImagePrewarms(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return &kusciaapi.QueryPrewarmImageResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrQueryPrewarmImage, err),
+		}
+	}
+
+	data := &kusciaapi.QueryPrewarmImageResponseData{PrewarmId: request.PrewarmId}
+	for i := range imagePrewarms.Items {
+		imagePrewarm := &imagePrewarms.Items[i]
+		if imagePrewarm.Name != request.PrewarmId {
+			continue
+		}
+		data.AppImage = imagePrewarm.Spec.AppImage
+		data.Domains = append(data.Domains, buildPrewarmImageDomainStatus(imagePrewarm))
+	}
+	if len(data.Domains) == 0 {
+		return &kusciaapi.QueryPrewarmImageResponse{
+			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrQueryPrewarmImage, fmt.Sprintf("image prewarm %s is not found", request.PrewarmId)),
+		}
+	}
+	sort.Slice(data.Domains, func(i, j int) bool {
+		return data.Domains[i].DomainId < data.Domains[j].DomainId
+	})
+	return &kusciaapi.QueryPrewarmImageResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   data,
+	}
+}
+
+func validatePrewarmImageRequest(request *kusciaapi.PrewarmImageRequest) error {
+	if request.AppImage == "" {
+		return utils.NewFieldViolation("app_image", "appimage name can not be empty")
+	}
+	if len(request.DomainIds) == 0 {
+		return utils.NewFieldViolation("domain_ids", "domain ids can not be empty")
+	}
+	for i, domainID := range request.DomainIds {
+		if err := resources.ValidateK8sName(domainID, "domain_id"); err != nil {
+			return utils.NewFieldViolation(fmt.Sprintf("domain_ids[%d]", i), "%s", err.Error())
+		}
+	}
+	if request.PrewarmId != "" {
+		if err := resources.ValidateK8sName(request.PrewarmId, "prewarm_id"); err != nil {
+			return utils.NewFieldViolation("prewarm_id", "%s", err.Error())
+		}
+	}
+	return nil
+}
+
+func buildPrewarmImageDomainStatus(imagePrewarm *v1alpha1.ImagePrewarm) *kusciaapi.PrewarmImageDomainStatus {
+	status := &kusciaapi.PrewarmImageDomainStatus{
+		DomainId:       imagePrewarm.Namespace,
+		Phase:          string(imagePrewarm.Status.Phase),
+		Message:        imagePrewarm.Status.Message,
+		Image:          imagePrewarm.Status.Image,
+		StartTime:      apiutils.TimeRfc3339String(imagePrewarm.Status.StartTime),
+		CompletionTime: apiutils.TimeRfc3339String(imagePrewarm.Status.CompletionTime),
+	}
+	for _, nodeStatus := range imagePrewarm.Status.NodeStatuses {
+		status.Nodes = append(status.Nodes, &kusciaapi.PrewarmImageNodeStatus{
+			NodeName:           nodeStatus.NodeName,
+			Phase:              string(nodeStatus.Phase),
+			Message:            nodeStatus.Message,
+			ImageRef:           nodeStatus.ImageRef,
+			LastTransitionTime: apiutils.TimeRfc3339String(nodeStatus.LastTransitionTime),
+		})
+	}
+	return status
+}
+
 func validateCreateAppImageRequest(request *kusciaapi.CreateAppImageRequest) error {
 	// do validate
 	if request.Name == "" {
-		return fmt.Errorf("appimage name can not be empty")
+		return utils.NewFieldViolation("name", "appimage name can not be empty")
 	}
 	if request.Image == nil || request.Image.Name == "" {
-		return fmt.Errorf("base image name can not be empty")
+		return utils.NewFieldViolation("image.name", "base image name can not be empty")
 	}
 	if err := validateDeployTemplates(request.DeployTemplates); err != nil {
 		return err
 	}
 	// do k8s validate
 	if err := resources.ValidateK8sName(request.Name, "appimage_id"); err != nil {
-		return err
+		return utils.NewFieldViolation("name", "%s", err.Error())
 	}
 	return nil
 }
 
 func validateUpdateAppImageRequest(request *kusciaapi.UpdateAppImageRequest) error {
 	if request.Name == "" {
-		return fmt.Errorf("appimage name can not be empty")
+		return utils.NewFieldViolation("name", "appimage name can not be empty")
 	}
 	if request.Image == nil || request.Image.Name == "" {
-		return fmt.Errorf("base image name can not be empty")
+		return utils.NewFieldViolation("image.name", "base image name can not be empty")
 	}
 	if request.DeployTemplates != nil {
 		if err := validateDeployTemplates(request.DeployTemplates); err != nil {
@@ -215,21 +347,21 @@ func validateUpdateAppImageRequest(request *kusciaapi.UpdateAppImageRequest) err
 
 func validateDeployTemplates(deployTemplates []*kusciaapi.DeployTemplate) error {
 	if len(deployTemplates) == 0 {
-		return fmt.Errorf("deploy templates can not be empty")
+		return utils.NewFieldViolation("deploy_templates", "deploy templates can not be empty")
 	}
-	for _, deployTemplate := range deployTemplates {
+	for i, deployTemplate := range deployTemplates {
 		if deployTemplate.Name == "" {
-			return fmt.Errorf("deploy template name can not be empty")
+			return utils.NewFieldViolation(fmt.Sprintf("deploy_templates[%d].name", i), "deploy template name can not be empty")
 		}
 		if len(deployTemplate.Containers) == 0 {
-			return fmt.Errorf("containers can not be empty")
+			return utils.NewFieldViolation(fmt.Sprintf("deploy_templates[%d].containers", i), "containers can not be empty")
 		}
 		if deployTemplate.Replicas < 0 {
-			return fmt.Errorf("replicas can not be less than 0")
+			return utils.NewFieldViolation(fmt.Sprintf("deploy_templates[%d].replicas", i), "replicas can not be less than 0")
 		}
-		for _, container := range deployTemplate.Containers {
+		for j, container := range deployTemplate.Containers {
 			if container.Name == "" {
-				return fmt.Errorf("container name can not be empty")
+				return utils.NewFieldViolation(fmt.Sprintf("deploy_templates[%d].containers[%d].name", i, j), "container name can not be empty")
 			}
 		}
 	}
@@ -240,10 +372,11 @@ func buildAppImage(k8sAppImage *v1alpha1.AppImage) *kusciaapi.QueryAppImageRespo
 	return &kusciaapi.QueryAppImageResponseData{
 		Name: k8sAppImage.GetName(),
 		Image: &kusciaapi.AppImageInfo{
-			Name: k8sAppImage.Spec.Image.Name,
-			Tag:  k8sAppImage.Spec.Image.Tag,
-			Id:   k8sAppImage.Spec.Image.ID,
-			Sign: k8sAppImage.Spec.Image.Sign,
+			Name:                  k8sAppImage.Spec.Image.Name,
+			Tag:                   k8sAppImage.Spec.Image.Tag,
+			Id:                    k8sAppImage.Spec.Image.ID,
+			Sign:                  k8sAppImage.Spec.Image.Sign,
+			RegistryCredentialKey: k8sAppImage.Spec.Image.RegistryCredentialKey,
 		},
 		ConfigTemplates: k8sAppImage.Spec.ConfigTemplates,
 		DeployTemplates: convertSlice(k8sAppImage.Spec.DeployTemplates, apiDeployTemplate),
@@ -261,10 +394,11 @@ func buildK8sAppImage(name string, imageInfo *kusciaapi.AppImageInfo, configTemp
 		},
 		Spec: v1alpha1.AppImageSpec{
 			Image: v1alpha1.AppImageInfo{
-				Name: imageInfo.Name,
-				Tag:  imageInfo.Tag,
-				ID:   imageInfo.Id,
-				Sign: imageInfo.Sign,
+				Name:                  imageInfo.Name,
+				Tag:                   imageInfo.Tag,
+				ID:                    imageInfo.Id,
+				Sign:                  imageInfo.Sign,
+				RegistryCredentialKey: imageInfo.RegistryCredentialKey,
 			},
 			ConfigTemplates: configTemplates,
 			DeployTemplates: k8sDeployTemplates,
@@ -274,10 +408,11 @@ func buildK8sAppImage(name string, imageInfo *kusciaapi.AppImageInfo, configTemp
 
 func overrideK8sAppImage(k8sAppImage *v1alpha1.AppImage, imageInfo *kusciaapi.AppImageInfo, configTemplates map[string]string, deployTemplates []*kusciaapi.DeployTemplate) error {
 	k8sAppImage.Spec.Image = v1alpha1.AppImageInfo{
-		Name: imageInfo.Name,
-		Tag:  imageInfo.Tag,
-		ID:   imageInfo.Id,
-		Sign: imageInfo.Sign,
+		Name:                  imageInfo.Name,
+		Tag:                   imageInfo.Tag,
+		ID:                    imageInfo.Id,
+		Sign:                  imageInfo.Sign,
+		RegistryCredentialKey: imageInfo.RegistryCredentialKey,
 	}
 	if configTemplates != nil {
 		k8sAppImage.Spec.ConfigTemplates = configTemplates
@@ -59,3 +59,17 @@ func (s appImageServiceLite) BatchQueryAppImage(ctx context.Context, request *ku
 		Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrLiteAPINotSupport, "kuscia lite api not support this interface now"),
 	}
 }
+
+func (s appImageServiceLite) PrewarmImage(ctx context.Context, request *kusciaapi.PrewarmImageRequest) *kusciaapi.PrewarmImageResponse {
+	// kuscia lite api not support this interface
+	return &kusciaapi.PrewarmImageResponse{
+		Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrLiteAPINotSupport, "kuscia lite api not support this interface now"),
+	}
+}
+
+func (s appImageServiceLite) QueryPrewarmImage(ctx context.Context, request *kusciaapi.QueryPrewarmImageRequest) *kusciaapi.QueryPrewarmImageResponse {
+	// kuscia lite api not support this interface
+	return &kusciaapi.QueryPrewarmImageResponse{
+		Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrLiteAPINotSupport, "kuscia lite api not support this interface now"),
+	}
+}
@@ -19,10 +19,14 @@ import (
 	"encoding/json"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	"github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
 	"github.com/secretflow/kuscia/pkg/web/utils"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 	"gotest.tools/v3/assert"
 )
@@ -215,3 +219,39 @@ func TestDeleteAppImage(t *testing.T) {
 	resp := s.DeleteAppImage(context.Background(), req)
 	assert.Equal(t, utils.IsSuccessCode(resp.Status.Code), true)
 }
+
+func TestPrewarmImage(t *testing.T) {
+	prewarmClient := fake.NewSimpleClientset(
+		&v1alpha1.AppImage{ObjectMeta: metav1.ObjectMeta{Name: "secretflow"}},
+		&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "alice"}},
+		&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "bob"}},
+	)
+	s := NewAppImageService(&config.KusciaAPIConfig{
+		KusciaClient: prewarmClient,
+		RunMode:      common.RunModeAutonomy,
+	})
+
+	resp := s.PrewarmImage(context.Background(), &kusciaapi.PrewarmImageRequest{AppImage: "secretflow", DomainIds: []string{"alice", "carol"}})
+	assert.Equal(t, resp.Status.Code, int32(pberrorcode.ErrorCode_KusciaAPIErrDomainNotExists))
+	resp = s.PrewarmImage(context.Background(), &kusciaapi.PrewarmImageRequest{AppImage: "secretflow", DomainIds: []string{"bob", "alice"}})
+	assert.Equal(t, utils.IsSuccessCode(resp.Status.Code), true)
+	prewarmID := resp.Data.PrewarmId
+
+	ip, err := prewarmClient.KusciaV1alpha1().ImagePrewarms("bob").Get(context.Background(), prewarmID, metav1.GetOptions{})
+	assert.NilError(t, err)
+	ip.Status.Phase = v1alpha1.ImagePrewarmPulling
+	ip.Status.NodeStatuses = []v1alpha1.ImagePrewarmNodeStatus{{NodeName: "bob-1", Phase: v1alpha1.ImagePrewarmSucceeded, ImageRef: "sha256:1234"}}
+	_, err = prewarmClient.KusciaV1alpha1().ImagePrewarms("bob").UpdateStatus(context.Background(), ip, metav1.UpdateOptions{})
+	assert.NilError(t, err)
+
+	queryResp := s.QueryPrewarmImage(context.Background(), &kusciaapi.QueryPrewarmImageRequest{PrewarmId: prewarmID})
+	assert.Equal(t, utils.IsSuccessCode(queryResp.Status.Code), true)
+	assert.Equal(t, queryResp.Data.AppImage, "secretflow")
+	assert.Equal(t, len(queryResp.Data.Domains), 2)
+	assert.Equal(t, queryResp.Data.Domains[0].DomainId, "alice")
+	assert.Equal(t, queryResp.Data.Domains[1].Phase, string(v1alpha1.ImagePrewarmPulling))
+	assert.Equal(t, queryResp.Data.Domains[1].Nodes[0].ImageRef, "sha256:1234")
+
+	queryResp = s.QueryPrewarmImage(context.Background(), &kusciaapi.QueryPrewarmImageRequest{PrewarmId: "prewarm-unknown"})
+	assert.Equal(t, queryResp.Status.Code, int32(pberrorcode.ErrorCode_KusciaAPIErrQueryPrewarmImage))
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/secretflow/kuscia/pkg/backup"
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type IBackupService interface {
+	CreateBackup(ctx context.Context, request *kusciaapi.CreateBackupRequest) *kusciaapi.CreateBackupResponse
+	RestoreBackup(ctx context.Context, request *kusciaapi.RestoreBackupRequest) *kusciaapi.RestoreBackupResponse
+}
+
+type backupService struct {
+	kusciaClient kusciaclientset.Interface
+	kubeClient   kubernetes.Interface
+}
+
+func NewBackupService(config *config.KusciaAPIConfig) IBackupService {
+	switch config.RunMode {
+	case common.RunModeLite:
+		return &backupServiceLite{}
+	default:
+		return &backupService{
+			kusciaClient: config.KusciaClient,
+			kubeClient:   config.KubeClient,
+		}
+	}
+}
+
+// CreateBackup takes a snapshot of the Kuscia resources in the cluster and returns it as an archive encrypted
+// with the passphrase of the request.
+func (s backupService) CreateBackup(ctx context.Context, request *kusciaapi.CreateBackupRequest) *kusciaapi.CreateBackupResponse {
+	if err := authHandlerBackup(ctx); err != nil {
+		return &kusciaapi.CreateBackupResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+	if request.Passphrase == "" {
+		return &kusciaapi.CreateBackupResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils.NewFieldViolation("passphrase", "passphrase can not be empty")),
+		}
+	}
+
+	snapshot, err := backup.Create(ctx, s.kusciaClient, s.kubeClient)
+	if err != nil {
+		return &kusciaapi.CreateBackupResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateBackup, err),
+		}
+	}
+	archive, err := backup.Seal(snapshot, []byte(request.Passphrase))
+	if err != nil {
+		return &kusciaapi.CreateBackupResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateBackup, err),
+		}
+	}
+	nlog.Infof("Created backup of %d bytes", len(archive))
+	return &kusciaapi.CreateBackupResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.CreateBackupResponseData{
+			Archive:   archive,
+			Resources: buildBackupResourceStats(snapshot.Stats()),
+		},
+	}
+}
+
+// RestoreBackup restores the resources in the archive into the cluster. The resources failing to restore are
+// reported in the error of the response, along with the stats of the restored ones.
+func (s backupService) RestoreBackup(ctx context.Context, request *kusciaapi.RestoreBackupRequest) *kusciaapi.RestoreBackupResponse {
+	if err := authHandlerBackup(ctx); err != nil {
+		return &kusciaapi.RestoreBackupResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+	if len(request.Archive) == 0 {
+		return &kusciaapi.RestoreBackupResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils.NewFieldViolation("archive", "archive can not be empty")),
+		}
+	}
+	if request.Passphrase == "" {
+		return &kusciaapi.RestoreBackupResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils.NewFieldViolation("passphrase", "passphrase can not be empty")),
+		}
+	}
+
+	snapshot, err := backup.Open(request.Archive, []byte(request.Passphrase))
+	if err != nil {
+		return &kusciaapi.RestoreBackupResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRestoreBackup, err),
+		}
+	}
+	stats, err := backup.Restore(ctx, s.kusciaClient, s.kubeClient, snapshot, backup.RestoreOptions{Overwrite: request.Overwrite})
+	resp := &kusciaapi.RestoreBackupResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.RestoreBackupResponseData{
+			Resources: buildBackupResourceStats(stats),
+		},
+	}
+	if err != nil {
+		nlog.Warnf("Restore backup failed, %v", err)
+		resp.Status = utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRestoreBackup, err)
+	}
+	return resp
+}
+
+// authHandlerBackup only allows the callers of the whole cluster, since the backup contains the resources of all domains.
+func authHandlerBackup(ctx context.Context) error {
+	role, _ := GetRoleAndDomainFromCtx(ctx)
+	if role == consts.AuthRoleDomain {
+		return errors.New("domain's KusciaAPI could not backup or restore the cluster")
+	}
+	if t := tenant.FromContext(ctx); t != nil {
+		return fmt.Errorf("tenant %s could not backup or restore the cluster", t.Name)
+	}
+	return nil
+}
+
+func buildBackupResourceStats(stats []backup.Stat) []*kusciaapi.BackupResourceStat {
+	resources := make([]*kusciaapi.BackupResourceStat, 0, len(stats))
+	for _, stat := range stats {
+		resources = append(resources, &kusciaapi.BackupResourceStat{
+			Kind:    stat.Kind,
+			Total:   int32(stat.Total),
+			Created: int32(stat.Created),
+			Updated: int32(stat.Updated),
+			Skipped: int32(stat.Skipped),
+		})
+	}
+	return resources
+}
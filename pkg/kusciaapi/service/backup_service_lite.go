@@ -0,0 +1,39 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type backupServiceLite struct{}
+
+func (s backupServiceLite) CreateBackup(ctx context.Context, request *kusciaapi.CreateBackupRequest) *kusciaapi.CreateBackupResponse {
+	// kuscia lite api not support this interface
+	return &kusciaapi.CreateBackupResponse{
+		Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrLiteAPINotSupport, "kuscia lite api not support this interface now"),
+	}
+}
+
+func (s backupServiceLite) RestoreBackup(ctx context.Context, request *kusciaapi.RestoreBackupRequest) *kusciaapi.RestoreBackupResponse {
+	// kuscia lite api not support this interface
+	return &kusciaapi.RestoreBackupResponse{
+		Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrLiteAPINotSupport, "kuscia lite api not support this interface now"),
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func TestCreateAndRestoreBackup(t *testing.T) {
+	ctx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleMaster)
+	src := &backupService{
+		kusciaClient: kusciafake.NewSimpleClientset(&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "alice"}}),
+		kubeClient:   kubefake.NewSimpleClientset(),
+	}
+	createResp := src.CreateBackup(ctx, &kusciaapi.CreateBackupRequest{Passphrase: "passphrase"})
+	assert.Equal(t, int32(0), createResp.Status.Code)
+	assert.NotEmpty(t, createResp.Data.Archive)
+
+	dst := &backupService{
+		kusciaClient: kusciafake.NewSimpleClientset(),
+		kubeClient:   kubefake.NewSimpleClientset(),
+	}
+	restoreResp := dst.RestoreBackup(ctx, &kusciaapi.RestoreBackupRequest{Archive: createResp.Data.Archive, Passphrase: "wrong"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrRestoreBackup), restoreResp.Status.Code)
+
+	restoreResp = dst.RestoreBackup(ctx, &kusciaapi.RestoreBackupRequest{Archive: createResp.Data.Archive, Passphrase: "passphrase"})
+	assert.Equal(t, int32(0), restoreResp.Status.Code)
+	assert.Equal(t, int32(1), restoreResp.Data.Resources[0].Created)
+	_, err := dst.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, "alice", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestBackupValidate(t *testing.T) {
+	s := &backupService{
+		kusciaClient: kusciafake.NewSimpleClientset(),
+		kubeClient:   kubefake.NewSimpleClientset(),
+	}
+	ctx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleMaster)
+	createResp := s.CreateBackup(ctx, &kusciaapi.CreateBackupRequest{})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate), createResp.Status.Code)
+	restoreResp := s.RestoreBackup(ctx, &kusciaapi.RestoreBackupRequest{Passphrase: "passphrase"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate), restoreResp.Status.Code)
+
+	domainCtx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleDomain)
+	createResp = s.CreateBackup(domainCtx, &kusciaapi.CreateBackupRequest{Passphrase: "passphrase"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrAuthFailed), createResp.Status.Code)
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/certinventory"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type ICertInventoryService interface {
+	ListCertificates(ctx context.Context, request *kusciaapi.ListCertificatesRequest) *kusciaapi.ListCertificatesResponse
+}
+
+// certInventoryService lists the certs of the kuscia instance serving the api, so the lite domains list their own
+// certs rather than forwarding to the master.
+type certInventoryService struct {
+	domainID  string
+	inventory *certinventory.Inventory
+	now       func() time.Time
+}
+
+func NewCertInventoryService(config *config.KusciaAPIConfig) ICertInventoryService {
+	return &certInventoryService{
+		domainID:  config.DomainID,
+		inventory: certinventory.Default,
+		now:       time.Now,
+	}
+}
+
+func (s *certInventoryService) ListCertificates(ctx context.Context, request *kusciaapi.ListCertificatesRequest) *kusciaapi.ListCertificatesResponse {
+	for _, kind := range request.Kinds {
+		if !slices.Contains(certinventory.Kinds, certinventory.Kind(kind)) {
+			return &kusciaapi.ListCertificatesResponse{
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+					utils.NewFieldViolation("kinds", fmt.Sprintf("unknown kind %q, should be one of %v", kind, certinventory.Kinds))),
+			}
+		}
+	}
+	if request.ExpiringWithinDays < 0 {
+		return &kusciaapi.ListCertificatesResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils.NewFieldViolation("expiring_within_days", "should not be negative")),
+		}
+	}
+
+	now := s.now()
+	t := tenant.FromContext(ctx)
+	data := &kusciaapi.ListCertificatesResponseData{}
+	for _, cert := range s.inventory.List(ctx) {
+		if len(request.Kinds) > 0 && !slices.Contains(request.Kinds, string(cert.Kind)) {
+			continue
+		}
+		if request.ExpiringWithinDays > 0 && cert.NotAfter.Sub(now) > time.Duration(request.ExpiringWithinDays)*24*time.Hour {
+			continue
+		}
+		// the tokens belong to the domainroutes of the namespace, the others to the domain of the instance
+		owner := s.domainID
+		if cert.Namespace != "" {
+			owner = cert.Namespace
+		}
+		if t != nil && !t.Allows(owner) {
+			continue
+		}
+		data.Certificates = append(data.Certificates, &kusciaapi.CertificateInfo{
+			Kind:             string(cert.Kind),
+			Name:             cert.Name,
+			Namespace:        cert.Namespace,
+			Subject:          cert.Subject,
+			Issuer:           cert.Issuer,
+			NotBefore:        formatCertTime(cert.NotBefore),
+			NotAfter:         formatCertTime(cert.NotAfter),
+			ExpiresInSeconds: int64(cert.NotAfter.Sub(now).Seconds()),
+			Expired:          !cert.NotAfter.After(now),
+		})
+	}
+	return &kusciaapi.ListCertificatesResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   data,
+	}
+}
+
+// formatCertTime leaves the unknown time empty, e.g. the issue time of the tokens.
+func formatCertTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
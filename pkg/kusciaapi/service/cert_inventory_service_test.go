@@ -0,0 +1,89 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/certinventory"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func newTestCertInventoryService(now time.Time) ICertInventoryService {
+	inventory := certinventory.NewInventory()
+	inventory.Register("test", func(ctx context.Context) ([]certinventory.Certificate, error) {
+		return []certinventory.Certificate{
+			{Kind: certinventory.KindDomainRouteToken, Name: "alice-bob", Namespace: "alice", NotAfter: now.Add(-time.Hour)},
+			{Kind: certinventory.KindKusciaAPIServerCert, Name: "kusciaapi-server", Subject: "CN=KusciaAPI",
+				NotBefore: now.Add(-24 * time.Hour), NotAfter: now.Add(10 * 24 * time.Hour)},
+			{Kind: certinventory.KindCACert, Name: "ca", NotAfter: now.Add(100 * 24 * time.Hour)},
+		}, nil
+	})
+	s := NewCertInventoryService(&config.KusciaAPIConfig{DomainID: "alice"}).(*certInventoryService)
+	s.inventory = inventory
+	s.now = func() time.Time { return now }
+	return s
+}
+
+func TestListCertificates(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := newTestCertInventoryService(now)
+
+	resp := s.ListCertificates(context.Background(), &kusciaapi.ListCertificatesRequest{})
+	assert.Equal(t, int32(0), resp.Status.Code)
+	assert.Len(t, resp.Data.Certificates, 3)
+	token := resp.Data.Certificates[0]
+	assert.Equal(t, "DomainRouteToken", token.Kind)
+	assert.Equal(t, "alice", token.Namespace)
+	assert.True(t, token.Expired)
+	assert.Equal(t, int64(-3600), token.ExpiresInSeconds)
+	assert.Empty(t, token.NotBefore)
+	server := resp.Data.Certificates[1]
+	assert.Equal(t, "CN=KusciaAPI", server.Subject)
+	assert.Equal(t, "2024-12-31T00:00:00Z", server.NotBefore)
+	assert.Equal(t, "2025-01-11T00:00:00Z", server.NotAfter)
+	assert.False(t, server.Expired)
+
+	resp = s.ListCertificates(context.Background(), &kusciaapi.ListCertificatesRequest{ExpiringWithinDays: 30})
+	assert.Len(t, resp.Data.Certificates, 2)
+
+	resp = s.ListCertificates(context.Background(), &kusciaapi.ListCertificatesRequest{Kinds: []string{"CACert"}})
+	assert.Len(t, resp.Data.Certificates, 1)
+	assert.Equal(t, "ca", resp.Data.Certificates[0].Name)
+}
+
+func TestListCertificates_Validate(t *testing.T) {
+	s := newTestCertInventoryService(time.Now())
+	resp := s.ListCertificates(context.Background(), &kusciaapi.ListCertificatesRequest{Kinds: []string{"SSHKey"}})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), resp.Status.Code)
+	resp = s.ListCertificates(context.Background(), &kusciaapi.ListCertificatesRequest{ExpiringWithinDays: -1})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), resp.Status.Code)
+}
+
+func TestListCertificates_Tenant(t *testing.T) {
+	s := newTestCertInventoryService(time.Now())
+	ctx := context.WithValue(context.Background(), consts.AuthTenant, &tenant.Tenant{Name: "tenant-c", Domains: []string{"carol"}})
+	resp := s.ListCertificates(ctx, &kusciaapi.ListCertificatesRequest{})
+	assert.Equal(t, int32(0), resp.Status.Code)
+	assert.Empty(t, resp.Data.Certificates)
+}
@@ -56,7 +56,7 @@ func (s *configService) CreateConfig(ctx context.Context, request *kusciaapi.Cre
 	cmReq, err := buildCMCreateConfigRequest(request)
 	if err != nil {
 		return &kusciaapi.CreateConfigResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	cmResp := s.cmConfigService.CreateConfig(ctx, cmReq)
@@ -0,0 +1,283 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	"github.com/secretflow/kuscia/pkg/agent/podexec"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const ExecContainerPath = "/api/v1/debug/exec"
+
+// ExecStream is the bidirectional stream of an exec session, implemented by the grpc stream and the websocket.
+type ExecStream interface {
+	Recv() (*kusciaapi.ExecContainerRequest, error)
+	Send(*kusciaapi.ExecContainerResponse) error
+}
+
+type IDebugService interface {
+	// ExecContainer serves the exec session until the command exits or the client disconnects.
+	ExecContainer(ctx context.Context, stream ExecStream) error
+}
+
+// taskPodLocator is implemented by the log services.
+type taskPodLocator interface {
+	locateTaskPod(ctx context.Context, taskID string, replicaIdx int32, container *string) (nodeIP string, local bool, status *pbv1alpha1.Status)
+}
+
+type debugService struct {
+	conf    *config.KusciaAPIConfig
+	locator taskPodLocator
+}
+
+func NewDebugService(config *config.KusciaAPIConfig) IDebugService {
+	return &debugService{
+		conf:    config,
+		locator: NewLogService(config).(taskPodLocator),
+	}
+}
+
+func (s *debugService) ExecContainer(ctx context.Context, stream ExecStream) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := request.Start
+	if err := validateExecContainerStart(start); err != nil {
+		return stream.Send(&kusciaapi.ExecContainerResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err)})
+	}
+	if s.conf.RunMode == common.RunModeMaster {
+		return stream.Send(&kusciaapi.ExecContainerResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrMasterAPINotSupport, "kuscia master api not support this interface now")})
+	}
+	role, sourceDomain := GetRoleAndDomainFromCtx(ctx)
+	if !s.hasDebugScope(ctx, role, start.Local) {
+		return stream.Send(&kusciaapi.ExecContainerResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrDebugScopeDenied, fmt.Sprintf("role %q is not granted the debug scope to exec", role))})
+	}
+
+	audit := &execAudit{log: s.conf.ExecAuditLog, session: string(uuid.NewUUID()), startTime: time.Now()}
+	audit.logf("start, role=%s, source=%s, task=%s, replica=%d, container=%s, command=%q, tty=%t, stdin=%t, local=%t, reason=%q",
+		role, sourceDomain, start.TaskId, start.ReplicaIdx, start.Container, start.Command, start.Tty, start.Stdin, start.Local, start.Reason)
+
+	backend, status := s.dialExec(ctx, start)
+	if status != nil {
+		audit.logf("end, error=%q", status.Message)
+		return stream.Send(&kusciaapi.ExecContainerResponse{Status: status})
+	}
+	defer backend.Close()
+	return relayExec(stream, backend, start, audit)
+}
+
+// hasDebugScope checks the role of the client. The sessions proxied from the other nodes of the domain skip locating
+// the pod, so they must carry the internal credential of the nodes rather than a role any client may have.
+func (s *debugService) hasDebugScope(ctx context.Context, role string, local bool) bool {
+	if s.conf.Exec == nil || len(s.conf.Exec.Roles) == 0 {
+		return false
+	}
+	if local {
+		token := nodeExecToken(s.conf)
+		proxied, _ := ctx.Value(constants.NodeExecTokenHeader).(string)
+		return token != "" && hmac.Equal([]byte(token), []byte(proxied))
+	}
+	return slices.Contains(s.conf.Exec.Roles, role)
+}
+
+// nodeExecToken is the internal credential of the exec sessions proxied between the nodes of the domain. It's derived
+// from the domain key shared by the nodes, which the clients of the kusciaapi don't hold.
+func nodeExecToken(conf *config.KusciaAPIConfig) string {
+	if conf.DomainKey == nil {
+		return ""
+	}
+	mac := hmac.New(sha256.New, x509.MarshalPKCS1PrivateKey(conf.DomainKey))
+	mac.Write([]byte("kuscia-node-exec"))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// dialExec connects to the agent if the pod runs on this node, or to the kusciaapi of the node running the pod.
+func (s *debugService) dialExec(ctx context.Context, start *kusciaapi.ExecContainerStart) (*websocket.Conn, *pbv1alpha1.Status) {
+	local := start.Local
+	var nodeIP string
+	if !local {
+		var status *pbv1alpha1.Status
+		if nodeIP, local, status = s.locator.locateTaskPod(ctx, start.TaskId, start.ReplicaIdx, &start.Container); status != nil {
+			return nil, status
+		}
+	}
+	var backend *websocket.Conn
+	var err error
+	if local {
+		backend, err = dialAgentExec(ctx, s.conf.AgentExecSocket)
+	} else {
+		backend, err = dialNodeExec(ctx, nodeIP, s.conf)
+	}
+	if err != nil {
+		return nil, utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrExecContainer, fmt.Sprintf("failed to connect to the node running the pod, err: %v", err))
+	}
+	return backend, nil
+}
+
+func dialAgentExec(ctx context.Context, socketPath string) (*websocket.Conn, error) {
+	wsConfig, err := websocket.NewConfig("ws://agent"+podexec.ExecPath, "http://localhost")
+	if err != nil {
+		return nil, err
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+	ws, err := websocket.NewClient(wsConfig, conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return ws, nil
+}
+
+func dialNodeExec(ctx context.Context, nodeIP string, kusciaAPIConfig *config.KusciaAPIConfig) (*websocket.Conn, error) {
+	clientTLSConfig, token, err := buildNodeClientConfig(kusciaAPIConfig)
+	if err != nil {
+		return nil, err
+	}
+	schema := "ws"
+	if clientTLSConfig != nil {
+		schema = "wss"
+	}
+	wsConfig, err := websocket.NewConfig(fmt.Sprintf("%s://%s:%d%s", schema, nodeIP, kusciaAPIConfig.HTTPPort, ExecContainerPath),
+		fmt.Sprintf("%s://%s", constants.SchemaHTTP, nodeIP))
+	if err != nil {
+		return nil, err
+	}
+	wsConfig.TlsConfig = clientTLSConfig
+	if token != "" {
+		wsConfig.Header.Set(constants.TokenHeader, token)
+	}
+	wsConfig.Header.Set(constants.NodeExecTokenHeader, nodeExecToken(kusciaAPIConfig))
+	return wsConfig.DialContext(ctx)
+}
+
+// relayExec starts the session on the backend, and relays the requests and the responses until the command exits or
+// either side disconnects.
+func relayExec(stream ExecStream, backend *websocket.Conn, start *kusciaapi.ExecContainerStart, audit *execAudit) error {
+	backendStart := &kusciaapi.ExecContainerStart{
+		TaskId:     start.TaskId,
+		ReplicaIdx: start.ReplicaIdx,
+		Container:  start.Container,
+		Command:    start.Command,
+		Tty:        start.Tty,
+		Stdin:      start.Stdin,
+		Reason:     start.Reason,
+		Local:      true,
+	}
+	if err := websocket.JSON.Send(backend, &kusciaapi.ExecContainerRequest{Start: backendStart}); err != nil {
+		audit.logf("end, error=%q", err.Error())
+		return stream.Send(&kusciaapi.ExecContainerResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrExecContainer, err)})
+	}
+
+	go func() {
+		// closing the backend stops the command if the client is gone
+		defer backend.Close()
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			if len(request.Stdin) > 0 {
+				audit.logf("stdin %q", request.Stdin)
+			}
+			if request.CloseStdin {
+				audit.logf("stdin closed")
+			}
+			forward := &kusciaapi.ExecContainerRequest{Stdin: request.Stdin, CloseStdin: request.CloseStdin, Resize: request.Resize}
+			if err := websocket.JSON.Send(backend, forward); err != nil {
+				return
+			}
+		}
+	}()
+
+	var outputBytes int
+	for {
+		response := &kusciaapi.ExecContainerResponse{}
+		if err := websocket.JSON.Receive(backend, response); err != nil {
+			audit.logf("end, output=%d bytes, error=%q", outputBytes, err.Error())
+			return stream.Send(&kusciaapi.ExecContainerResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrExecContainer, fmt.Sprintf("exec session is broken, err: %v", err))})
+		}
+		outputBytes += len(response.Stdout) + len(response.Stderr)
+		if err := stream.Send(response); err != nil {
+			audit.logf("end, output=%d bytes, client disconnected", outputBytes)
+			return err
+		}
+		if !utils.IsSuccessCode(response.GetStatus().GetCode()) {
+			audit.logf("end, output=%d bytes, error=%q", outputBytes, response.GetStatus().GetMessage())
+			return nil
+		}
+		if response.Exited {
+			audit.logf("end, output=%d bytes, exit_code=%d", outputBytes, response.ExitCode)
+			return nil
+		}
+	}
+}
+
+func validateExecContainerStart(start *kusciaapi.ExecContainerStart) error {
+	if start == nil {
+		return utils.NewFieldViolation("start", "the first request must start the exec session")
+	}
+	if start.TaskId == "" {
+		return utils.NewFieldViolation("start.task_id", "task id can not be empty")
+	}
+	if start.ReplicaIdx < 0 {
+		return utils.NewFieldViolation("start.replica_idx", "replica index can not be negative")
+	}
+	if len(start.Command) == 0 {
+		return utils.NewFieldViolation("start.command", "command can not be empty")
+	}
+	return nil
+}
+
+// execAudit records the exec session, including the stdin typed by the client. The output is not recorded since it
+// may contain the data of the engine.
+type execAudit struct {
+	log       *nlog.NLog
+	session   string
+	startTime time.Time
+}
+
+func (a *execAudit) logf(format string, args ...interface{}) {
+	msg := fmt.Sprintf("Exec session %s (%s): %s", a.session, time.Since(a.startTime).Round(time.Millisecond), fmt.Sprintf(format, args...))
+	if a.log != nil {
+		a.log.Info(msg)
+	} else {
+		nlog.Info(msg)
+	}
+}
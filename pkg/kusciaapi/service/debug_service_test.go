@@ -0,0 +1,145 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/secretflow/kuscia/pkg/agent/podexec"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type fakeExecStream struct {
+	requests  []*kusciaapi.ExecContainerRequest
+	responses []*kusciaapi.ExecContainerResponse
+}
+
+func (s *fakeExecStream) Recv() (*kusciaapi.ExecContainerRequest, error) {
+	if len(s.requests) == 0 {
+		// the client waits for the command to exit
+		time.Sleep(time.Second)
+		return nil, io.EOF
+	}
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+func (s *fakeExecStream) Send(response *kusciaapi.ExecContainerResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+type fakePodGetter struct{}
+
+func (fakePodGetter) GetPodByName(namespace, name string) (*v1.Pod, bool) {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "secretflow"}}},
+	}, true
+}
+
+type fakeExecProvider struct{}
+
+func (fakeExecProvider) ExecInContainer(ctx context.Context, pod *v1.Pod, container string, cmd []string, streams remotecommand.StreamOptions) error {
+	_, err := io.Copy(streams.Stdout, streams.Stdin)
+	return err
+}
+
+func TestExecContainer(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "exec.sock")
+	server := podexec.NewServer(&podexec.ServerConfig{
+		Namespace:    "alice",
+		SocketPath:   socketPath,
+		PodGetter:    fakePodGetter{},
+		ExecProvider: fakeExecProvider{},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go server.Run(ctx)
+	for i := 0; i < 100; i++ {
+		if conn, err := net.Dial("unix", socketPath); err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	domainKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+	conf := &config.KusciaAPIConfig{
+		DomainKey:       domainKey,
+		DomainID:        "alice",
+		RunMode:         common.RunModeAutonomy,
+		AgentExecSocket: socketPath,
+		Exec:            &config.ExecConfig{Roles: []string{consts.AuthRoleMaster}},
+	}
+	debugService := &debugService{conf: conf}
+	masterCtx := context.WithValue(ctx, consts.AuthRole, consts.AuthRoleMaster)
+	proxiedCtx := context.WithValue(masterCtx, consts.NodeExecTokenHeader, nodeExecToken(conf))
+	newStream := func() *fakeExecStream {
+		return &fakeExecStream{requests: []*kusciaapi.ExecContainerRequest{
+			{Start: &kusciaapi.ExecContainerStart{TaskId: "task1", Command: []string{"cat"}, Stdin: true, Local: true}},
+			{Stdin: []byte("hello")},
+			{CloseStdin: true},
+		}}
+	}
+
+	stream := newStream()
+	assert.NilError(t, debugService.ExecContainer(proxiedCtx, stream))
+	var stdout string
+	for _, response := range stream.responses {
+		assert.Equal(t, response.Status.Code, int32(0), response.Status.Message)
+		stdout += string(response.Stdout)
+	}
+	assert.Equal(t, stdout, "hello")
+	assert.Assert(t, stream.responses[len(stream.responses)-1].Exited)
+
+	// the clients can't start a local session without the credential of the nodes, whatever the role is
+	for _, token := range []string{"", "forged"} {
+		stream = newStream()
+		assert.NilError(t, debugService.ExecContainer(context.WithValue(masterCtx, consts.NodeExecTokenHeader, token), stream))
+		assert.Equal(t, stream.responses[0].Status.Code, int32(errorcode.ErrorCode_KusciaAPIErrDebugScopeDenied))
+	}
+
+	// the domain role is not granted the debug scope
+	stream = newStream()
+	domainCtx := context.WithValue(ctx, consts.AuthRole, consts.AuthRoleDomain)
+	stream.requests[0].Start.Local = false
+	assert.NilError(t, debugService.ExecContainer(domainCtx, stream))
+	assert.Equal(t, stream.responses[0].Status.Code, int32(errorcode.ErrorCode_KusciaAPIErrDebugScopeDenied))
+
+	// the api is disabled
+	conf.Exec = nil
+	stream = newStream()
+	assert.NilError(t, debugService.ExecContainer(proxiedCtx, stream))
+	assert.Equal(t, stream.responses[0].Status.Code, int32(errorcode.ErrorCode_KusciaAPIErrDebugScopeDenied))
+}
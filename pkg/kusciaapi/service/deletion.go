@@ -0,0 +1,92 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/utils"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
+)
+
+const (
+	defaultDeletionWaitTimeoutSeconds = 60
+	maxDeletionWaitTimeoutSeconds     = 600
+)
+
+var deletionPollInterval = time.Second
+
+type getObjectFunc func(ctx context.Context) (metav1.Object, error)
+
+// buildDeletionStatus returns nil if the object isn't being deleted.
+func buildDeletionStatus(obj metav1.Object) *pbv1alpha1.DeletionStatus {
+	if obj == nil || obj.GetDeletionTimestamp() == nil {
+		return nil
+	}
+	return &pbv1alpha1.DeletionStatus{
+		Terminating:       true,
+		BlockedBy:         obj.GetFinalizers(),
+		DeletionTimestamp: utils.TimeRfc3339String(obj.GetDeletionTimestamp()),
+	}
+}
+
+func validateDeletionWaitTimeout(timeoutSeconds int32) error {
+	if timeoutSeconds < 0 || timeoutSeconds > maxDeletionWaitTimeoutSeconds {
+		return fmt.Errorf("wait timeout seconds should be in [0, %d]", maxDeletionWaitTimeoutSeconds)
+	}
+	return nil
+}
+
+// checkDeletion reports the deletion status of the object that has been deleted, nil means the object is gone.
+// If waitForDeletion is true, it waits until the finalizers are removed, and returns the last status with an error
+// if the object is still there when the wait times out.
+func checkDeletion(ctx context.Context, get getObjectFunc, waitForDeletion bool, timeoutSeconds int32) (*pbv1alpha1.DeletionStatus, error) {
+	status, err := fetchDeletionStatus(ctx, get)
+	if err != nil || status == nil || !waitForDeletion {
+		return status, err
+	}
+
+	if timeoutSeconds == 0 {
+		timeoutSeconds = defaultDeletionWaitTimeoutSeconds
+	}
+	timeout := time.Duration(timeoutSeconds) * time.Second
+	err = wait.PollUntilContextTimeout(ctx, deletionPollInterval, timeout, false, func(ctx context.Context) (bool, error) {
+		if status, err = fetchDeletionStatus(ctx, get); err != nil {
+			return false, err
+		}
+		return status == nil, nil
+	})
+	if err != nil && status != nil {
+		return status, fmt.Errorf("still terminating after waiting for %s, blocked by finalizers %v", timeout, status.BlockedBy)
+	}
+	return status, err
+}
+
+func fetchDeletionStatus(ctx context.Context, get getObjectFunc) (*pbv1alpha1.DeletionStatus, error) {
+	obj, err := get(ctx)
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buildDeletionStatus(obj), nil
+}
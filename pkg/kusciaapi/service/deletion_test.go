@@ -0,0 +1,87 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func terminatingJob() *v1alpha1.KusciaJob {
+	now := metav1.Now()
+	return &v1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "job-1",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"example.com/cleanup"},
+		},
+	}
+}
+
+func TestBuildDeletionStatus(t *testing.T) {
+	assert.Nil(t, buildDeletionStatus(&v1alpha1.KusciaJob{}))
+
+	status := buildDeletionStatus(terminatingJob())
+	assert.True(t, status.Terminating)
+	assert.Equal(t, []string{"example.com/cleanup"}, status.BlockedBy)
+	assert.NotEmpty(t, status.DeletionTimestamp)
+}
+
+func TestValidateDeletionWaitTimeout(t *testing.T) {
+	assert.NoError(t, validateDeletionWaitTimeout(0))
+	assert.NoError(t, validateDeletionWaitTimeout(maxDeletionWaitTimeoutSeconds))
+	assert.Error(t, validateDeletionWaitTimeout(-1))
+	assert.Error(t, validateDeletionWaitTimeout(maxDeletionWaitTimeoutSeconds+1))
+}
+
+func TestCheckDeletion(t *testing.T) {
+	defer func(interval time.Duration) { deletionPollInterval = interval }(deletionPollInterval)
+	deletionPollInterval = 10 * time.Millisecond
+
+	notFound := k8serrors.NewNotFound(schema.GroupResource{Resource: "kusciajobs"}, "job-1")
+	gets := 0
+	get := func(ctx context.Context) (metav1.Object, error) {
+		gets++
+		if gets > 2 {
+			return nil, notFound
+		}
+		return terminatingJob(), nil
+	}
+
+	// without waiting, the finalizers blocking the deletion are reported
+	status, err := checkDeletion(context.Background(), get, false, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"example.com/cleanup"}, status.BlockedBy)
+
+	// waiting until the job is gone
+	status, err = checkDeletion(context.Background(), get, true, 1)
+	assert.NoError(t, err)
+	assert.Nil(t, status)
+
+	// the wait times out if the finalizers aren't removed
+	status, err = checkDeletion(context.Background(), func(ctx context.Context) (metav1.Object, error) {
+		return terminatingJob(), nil
+	}, true, 1)
+	assert.Error(t, err)
+	assert.True(t, status.Terminating)
+}
@@ -0,0 +1,322 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	agentconfig "github.com/secretflow/kuscia/pkg/agent/config"
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/errorcode"
+	apiutils "github.com/secretflow/kuscia/pkg/kusciaapi/utils"
+	utilscommon "github.com/secretflow/kuscia/pkg/utils/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/resources"
+	"github.com/secretflow/kuscia/pkg/utils/tls"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const (
+	onboardingKeySize   = 2048
+	onboardingTokenSize = 32
+
+	onboardingPhasePending     = "Pending"
+	onboardingPhaseTokenIssued = "TokenIssued"
+	onboardingPhaseRegistered  = "Registered"
+	onboardingPhaseReady       = "Ready"
+)
+
+var liteRuntimes = []string{agentconfig.ContainerRuntime, agentconfig.K8sRuntime, agentconfig.ProcessRuntime}
+
+// liteBootstrapConfig is the kuscia.yaml a lite domain starts with.
+type liteBootstrapConfig struct {
+	Mode                       string `yaml:"mode"`
+	DomainID                   string `yaml:"domainID"`
+	DomainKeyData              string `yaml:"domainKeyData"`
+	LogLevel                   string `yaml:"logLevel"`
+	LiteDeployToken            string `yaml:"liteDeployToken"`
+	MasterEndpoint             string `yaml:"masterEndpoint"`
+	Runtime                    string `yaml:"runtime"`
+	ImageRegistryCredentialKey string `yaml:"imageRegistryCredentialKey,omitempty"`
+}
+
+func (s domainService) RegisterDomain(ctx context.Context, request *kusciaapi.RegisterDomainRequest) *kusciaapi.RegisterDomainResponse {
+	if err := s.validateRegisterDomainRequest(request); err != nil {
+		return &kusciaapi.RegisterDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	// Auth Handler
+	if err := s.authHandler(ctx, request); err != nil {
+		return &kusciaapi.RegisterDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+
+	domainID := request.DomainId
+	runtime := request.Runtime
+	if runtime == "" {
+		runtime = agentconfig.ContainerRuntime
+	}
+
+	// generate the domain key and the deploy token before creating the domain, so that nothing is left behind on failure
+	domainKey, err := rsa.GenerateKey(rand.Reader, onboardingKeySize)
+	if err != nil {
+		return &kusciaapi.RegisterDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRegisterDomain, err),
+		}
+	}
+	deployToken := string(utilscommon.GenerateRandomBytes(onboardingTokenSize))
+	csr, err := generateDomainCsr(domainID, deployToken, domainKey)
+	if err != nil {
+		return &kusciaapi.RegisterDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRegisterDomain, err),
+		}
+	}
+	domainKeyData := base64.StdEncoding.EncodeToString(tls.EncodePKCS1PrivateKey(domainKey))
+
+	kusciaDomain := &v1alpha1.Domain{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: domainID,
+			Annotations: map[string]string{
+				common.DomainOnboardingAnnotationKey: time.Now().Format(time.RFC3339),
+			},
+		},
+		Spec: v1alpha1.DomainSpec{
+			AuthCenter: &v1alpha1.AuthCenter{
+				AuthenticationType: v1alpha1.DomainAuthenticationToken,
+				TokenGenMethod:     v1alpha1.TokenGenMethodRSA,
+			},
+			ImageRegistryCredentialKey: request.ImageRegistryCredentialKey,
+		},
+	}
+	if _, err = s.kusciaClient.KusciaV1alpha1().Domains().Create(ctx, kusciaDomain, metav1.CreateOptions{}); err != nil {
+		return &kusciaapi.RegisterDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrRegisterDomain), err),
+		}
+	}
+
+	if err = s.issueDeployToken(ctx, domainID, deployToken); err != nil {
+		nlog.Warnf("Issue deploy token for domain %s failed, %v", domainID, err)
+		return &kusciaapi.RegisterDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRegisterDomain, err),
+		}
+	}
+
+	liteConfig, err := yaml.Marshal(&liteBootstrapConfig{
+		Mode:                       common.RunModeLite,
+		DomainID:                   domainID,
+		DomainKeyData:              domainKeyData,
+		LogLevel:                   "INFO",
+		LiteDeployToken:            deployToken,
+		MasterEndpoint:             request.MasterEndpoint,
+		Runtime:                    runtime,
+		ImageRegistryCredentialKey: request.ImageRegistryCredentialKey,
+	})
+	if err != nil {
+		return &kusciaapi.RegisterDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRegisterDomain, err),
+		}
+	}
+
+	nlog.Infof("Domain %s is registered for onboarding", domainID)
+	return &kusciaapi.RegisterDomainResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.RegisterDomainResponseData{
+			DomainId:      domainID,
+			DeployToken:   deployToken,
+			DomainKeyData: domainKeyData,
+			Csr:           base64.StdEncoding.EncodeToString(csr),
+			LiteConfig:    string(liteConfig),
+		},
+	}
+}
+
+func (s domainService) QueryDomainOnboarding(ctx context.Context, request *kusciaapi.QueryDomainOnboardingRequest) *kusciaapi.QueryDomainOnboardingResponse {
+	// do validate
+	domainID := request.DomainId
+	if domainID == "" {
+		return &kusciaapi.QueryDomainOnboardingResponse{
+			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "domain id can not be empty"),
+		}
+	}
+	// Auth Handler
+	if err := s.authHandler(ctx, request); err != nil {
+		return &kusciaapi.QueryDomainOnboardingResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+
+	kusciaDomain, err := s.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, domainID, metav1.GetOptions{})
+	if err != nil {
+		return &kusciaapi.QueryDomainOnboardingResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomain), err),
+		}
+	}
+	if _, ok := kusciaDomain.Annotations[common.DomainOnboardingAnnotationKey]; !ok {
+		return &kusciaapi.QueryDomainOnboardingResponse{
+			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, fmt.Sprintf("domain %s is not registered by RegisterDomain", domainID)),
+		}
+	}
+
+	data := &kusciaapi.QueryDomainOnboardingResponseData{
+		DomainId:   domainID,
+		Phase:      onboardingPhasePending,
+		Conditions: make([]*kusciaapi.DomainCondition, 0),
+	}
+	if kusciaDomain.Status == nil {
+		return &kusciaapi.QueryDomainOnboardingResponse{
+			Status: utils.BuildSuccessResponseStatus(),
+			Data:   data,
+		}
+	}
+
+	conditionTrue := map[v1alpha1.DomainConditionType]bool{}
+	for _, cond := range kusciaDomain.Status.Conditions {
+		conditionTrue[cond.Type] = cond.Status == corev1.ConditionTrue
+		data.Conditions = append(data.Conditions, &kusciaapi.DomainCondition{
+			Type:               string(cond.Type),
+			Status:             string(cond.Status),
+			Reason:             cond.Reason,
+			Message:            cond.Message,
+			LastTransitionTime: apiutils.TimeRfc3339String(&cond.LastTransitionTime),
+		})
+	}
+	switch {
+	case conditionTrue[v1alpha1.DomainNodeReady]:
+		data.Phase = onboardingPhaseReady
+	case conditionTrue[v1alpha1.DomainCertRegistered]:
+		data.Phase = onboardingPhaseRegistered
+	case conditionTrue[v1alpha1.DomainDeployTokenIssued]:
+		data.Phase = onboardingPhaseTokenIssued
+	}
+
+	if !conditionTrue[v1alpha1.DomainCertRegistered] {
+		for _, tokenStatus := range kusciaDomain.Status.DeployTokenStatuses {
+			if tokenStatus.State == common.DeployTokenUnusedState {
+				data.DeployToken = tokenStatus.Token
+			}
+		}
+	}
+	return &kusciaapi.QueryDomainOnboardingResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   data,
+	}
+}
+
+func (s domainService) validateRegisterDomainRequest(request *kusciaapi.RegisterDomainRequest) error {
+	if s.conf.RunMode != common.RunModeMaster {
+		return fmt.Errorf("register domain is only supported in %s mode", common.RunModeMaster)
+	}
+	domainID := request.DomainId
+	if domainID == "" {
+		return fmt.Errorf("domain id can not be empty")
+	} else if domainID == common.UnSupportedDomainID {
+		return fmt.Errorf("domain id can not be 'master', please choose another name")
+	}
+	if err := resources.ValidateK8sName(domainID, "domain_id"); err != nil {
+		return err
+	}
+	if request.MasterEndpoint == "" {
+		return fmt.Errorf("master endpoint can not be empty")
+	}
+	if request.Runtime != "" && !slices.Contains(liteRuntimes, request.Runtime) {
+		return fmt.Errorf("runtime is invalid, must be one of %s", strings.Join(liteRuntimes, ", "))
+	}
+	return nil
+}
+
+// issueDeployToken replaces the unused deploy tokens of the domain with the given one.
+// The domain controller keeps an existing unused token, so the token stays valid until the domain registers.
+func (s domainService) issueDeployToken(ctx context.Context, domainID, deployToken string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		domain, err := s.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, domainID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if domain.Status == nil {
+			domain.Status = &v1alpha1.DomainStatus{}
+		}
+		tokenStatuses := make([]v1alpha1.DeployTokenStatus, 0, len(domain.Status.DeployTokenStatuses)+1)
+		for _, tokenStatus := range domain.Status.DeployTokenStatuses {
+			if tokenStatus.State != common.DeployTokenUnusedState {
+				tokenStatuses = append(tokenStatuses, tokenStatus)
+			}
+		}
+		domain.Status.DeployTokenStatuses = append(tokenStatuses, v1alpha1.DeployTokenStatus{
+			Token:              deployToken,
+			State:              common.DeployTokenUnusedState,
+			LastTransitionTime: metav1.Now(),
+		})
+		_, err = s.kusciaClient.KusciaV1alpha1().Domains().UpdateStatus(ctx, domain, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// generateDomainCsr generates the csr a lite domain registers with, the deploy token is carried in the extension.
+func generateDomainCsr(domainID, deployToken string, key *rsa.PrivateKey) ([]byte, error) {
+	var asn1ID asn1.ObjectIdentifier
+	for _, str := range strings.Split(common.DomainCsrExtensionID, ".") {
+		id, err := strconv.Atoi(str)
+		if err != nil {
+			return nil, err
+		}
+		asn1ID = append(asn1ID, id)
+	}
+
+	template := x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName: domainID,
+		},
+		SignatureAlgorithm: x509.SHA256WithRSA,
+		ExtraExtensions: []pkix.Extension{
+			{
+				Id:    asn1ID,
+				Value: []byte(deployToken),
+			},
+		},
+	}
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &template, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrBytes}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
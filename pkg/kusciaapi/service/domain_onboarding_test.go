@@ -0,0 +1,171 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func newOnboardingTestService(runMode string) domainService {
+	return domainService{
+		kusciaClient: kusciafake.NewSimpleClientset(),
+		conf:         &config.KusciaAPIConfig{RunMode: runMode},
+	}
+}
+
+func TestRegisterDomain(t *testing.T) {
+	ctx := context.Background()
+	s := newOnboardingTestService(common.RunModeMaster)
+
+	res := s.RegisterDomain(ctx, &kusciaapi.RegisterDomainRequest{
+		DomainId:       "alice",
+		MasterEndpoint: "https://kuscia-master:1080",
+	})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.NotEmpty(t, res.Data.DeployToken)
+
+	domain, err := s.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, "alice", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, domain.Annotations, common.DomainOnboardingAnnotationKey)
+	assert.Len(t, domain.Status.DeployTokenStatuses, 1)
+	assert.Equal(t, res.Data.DeployToken, domain.Status.DeployTokenStatuses[0].Token)
+	assert.Equal(t, common.DeployTokenUnusedState, domain.Status.DeployTokenStatuses[0].State)
+
+	csrPem, err := base64.StdEncoding.DecodeString(res.Data.Csr)
+	assert.NoError(t, err)
+	block, _ := pem.Decode(csrPem)
+	assert.NotNil(t, block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", csr.Subject.CommonName)
+	assert.Equal(t, res.Data.DeployToken, getCsrToken(csr))
+
+	liteConfig := &liteBootstrapConfig{}
+	assert.NoError(t, yaml.Unmarshal([]byte(res.Data.LiteConfig), liteConfig))
+	assert.Equal(t, common.RunModeLite, liteConfig.Mode)
+	assert.Equal(t, "alice", liteConfig.DomainID)
+	assert.Equal(t, res.Data.DomainKeyData, liteConfig.DomainKeyData)
+	assert.Equal(t, res.Data.DeployToken, liteConfig.LiteDeployToken)
+	assert.Equal(t, "https://kuscia-master:1080", liteConfig.MasterEndpoint)
+	assert.Equal(t, "runc", liteConfig.Runtime)
+
+	res = s.RegisterDomain(ctx, &kusciaapi.RegisterDomainRequest{
+		DomainId:       "alice",
+		MasterEndpoint: "https://kuscia-master:1080",
+	})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrDomainExists), res.Status.Code)
+}
+
+func TestRegisterDomain_ValidateError(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name    string
+		runMode string
+		request *kusciaapi.RegisterDomainRequest
+	}{
+		{
+			name:    "autonomy mode",
+			runMode: common.RunModeAutonomy,
+			request: &kusciaapi.RegisterDomainRequest{DomainId: "alice", MasterEndpoint: "https://kuscia-master:1080"},
+		},
+		{
+			name:    "empty domain id",
+			runMode: common.RunModeMaster,
+			request: &kusciaapi.RegisterDomainRequest{MasterEndpoint: "https://kuscia-master:1080"},
+		},
+		{
+			name:    "empty master endpoint",
+			runMode: common.RunModeMaster,
+			request: &kusciaapi.RegisterDomainRequest{DomainId: "alice"},
+		},
+		{
+			name:    "invalid runtime",
+			runMode: common.RunModeMaster,
+			request: &kusciaapi.RegisterDomainRequest{DomainId: "alice", MasterEndpoint: "https://kuscia-master:1080", Runtime: "docker"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := newOnboardingTestService(tc.runMode).RegisterDomain(context.Background(), tc.request)
+			assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+		})
+	}
+}
+
+func TestQueryDomainOnboarding(t *testing.T) {
+	ctx := context.Background()
+	s := newOnboardingTestService(common.RunModeMaster)
+
+	res := s.RegisterDomain(ctx, &kusciaapi.RegisterDomainRequest{
+		DomainId:       "alice",
+		MasterEndpoint: "https://kuscia-master:1080",
+	})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+
+	queryRes := s.QueryDomainOnboarding(ctx, &kusciaapi.QueryDomainOnboardingRequest{DomainId: "alice"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, queryRes.Status.Code)
+	assert.Equal(t, onboardingPhasePending, queryRes.Data.Phase)
+	assert.Equal(t, res.Data.DeployToken, queryRes.Data.DeployToken)
+
+	domain, err := s.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, "alice", metav1.GetOptions{})
+	assert.NoError(t, err)
+	domain.Status.Conditions = []v1alpha1.DomainCondition{
+		{Type: v1alpha1.DomainDeployTokenIssued, Status: corev1.ConditionTrue},
+		{Type: v1alpha1.DomainCertRegistered, Status: corev1.ConditionTrue},
+		{Type: v1alpha1.DomainNodeReady, Status: corev1.ConditionFalse},
+	}
+	_, err = s.kusciaClient.KusciaV1alpha1().Domains().UpdateStatus(ctx, domain, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	queryRes = s.QueryDomainOnboarding(ctx, &kusciaapi.QueryDomainOnboardingRequest{DomainId: "alice"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, queryRes.Status.Code)
+	assert.Equal(t, onboardingPhaseRegistered, queryRes.Data.Phase)
+	assert.Len(t, queryRes.Data.Conditions, 3)
+	assert.Empty(t, queryRes.Data.DeployToken)
+
+	_, err = s.kusciaClient.KusciaV1alpha1().Domains().Create(ctx, &v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "bob"}}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	queryRes = s.QueryDomainOnboarding(ctx, &kusciaapi.QueryDomainOnboardingRequest{DomainId: "bob"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate), queryRes.Status.Code)
+
+	queryRes = s.QueryDomainOnboarding(ctx, &kusciaapi.QueryDomainOnboardingRequest{DomainId: "carol"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrDomainNotExists), queryRes.Status.Code)
+}
+
+func getCsrToken(csr *x509.CertificateRequest) string {
+	for _, e := range csr.Extensions {
+		if e.Id.String() == common.DomainCsrExtensionID {
+			return string(e.Value)
+		}
+	}
+	return ""
+}
@@ -30,8 +30,10 @@ import (
 	"github.com/secretflow/kuscia/pkg/kusciaapi/constants"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/errorcode"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/proxy"
+	apiutils "github.com/secretflow/kuscia/pkg/kusciaapi/utils"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/pkg/web/utils"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
@@ -42,6 +44,7 @@ type IDomainRouteService interface {
 	DeleteDomainRoute(ctx context.Context, request *kusciaapi.DeleteDomainRouteRequest) *kusciaapi.DeleteDomainRouteResponse
 	QueryDomainRoute(ctx context.Context, request *kusciaapi.QueryDomainRouteRequest) *kusciaapi.QueryDomainRouteResponse
 	BatchQueryDomainRouteStatus(ctx context.Context, request *kusciaapi.BatchQueryDomainRouteStatusRequest) *kusciaapi.BatchQueryDomainRouteStatusResponse
+	QueryDomainRouteStatus(ctx context.Context, request *kusciaapi.QueryDomainRouteStatusRequest) *kusciaapi.QueryDomainRouteStatusResponse
 }
 
 type domainRouteService struct {
@@ -65,13 +68,13 @@ func (s domainRouteService) CreateDomainRoute(ctx context.Context, request *kusc
 	// do validate
 	if err := validateCreateDomainRouteRequest(request); err != nil {
 		return &kusciaapi.CreateDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// auth pre handler
 	if err := s.authHandlerViaDestination(ctx, request); err != nil {
 		return &kusciaapi.CreateDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 
@@ -99,7 +102,7 @@ func (s domainRouteService) CreateDomainRoute(ctx context.Context, request *kusc
 			drProtocol, isTLS, err := convert2DomainRouteProtocol(port.Protocol)
 			if err != nil {
 				return &kusciaapi.CreateDomainRouteResponse{
-					Status: utils.BuildErrorResponseStatus(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainRoute), err.Error()),
+					Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainRoute), err),
 				}
 			}
 			cdrEndpoint.Ports[i] = v1alpha1.DomainPort{
@@ -131,6 +134,7 @@ func (s domainRouteService) CreateDomainRoute(ctx context.Context, request *kusc
 			DestinationPublicKey: tokenConfig.DestinationPublicKey,
 			TokenGenMethod:       v1alpha1.TokenGenMethodType(tokenConfig.TokenGenMethod),
 			RollingUpdatePeriod:  int(tokenConfig.RollingUpdatePeriod),
+			RollingOverlapPeriod: int(tokenConfig.RollingOverlapPeriod),
 		}
 	case string(v1alpha1.DomainAuthenticationMTLS):
 		cdrAuthenticationType = v1alpha1.DomainAuthenticationMTLS
@@ -187,7 +191,7 @@ func (s domainRouteService) CreateDomainRoute(ctx context.Context, request *kusc
 	_, err := s.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Create(ctx, clusterDomainRoute, metav1.CreateOptions{})
 	if err != nil {
 		return &kusciaapi.CreateDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainRoute), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainRoute), err),
 		}
 	}
 	return &kusciaapi.CreateDomainRouteResponse{
@@ -199,13 +203,18 @@ func (s domainRouteService) DeleteDomainRoute(ctx context.Context, request *kusc
 	// do validate
 	if err := validateDomainRouteRequest(request); err != nil {
 		return &kusciaapi.DeleteDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	if err := validateDeletionWaitTimeout(request.WaitTimeoutSeconds); err != nil {
+		return &kusciaapi.DeleteDomainRouteResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// auth pre handler
 	if err := s.authHandlerViaDestination(ctx, request); err != nil {
 		return &kusciaapi.DeleteDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// delete cluster domain kusciaAPIDomainRoute
@@ -213,11 +222,21 @@ func (s domainRouteService) DeleteDomainRoute(ctx context.Context, request *kusc
 	err := s.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Delete(ctx, name, metav1.DeleteOptions{})
 	if err != nil {
 		return &kusciaapi.DeleteDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomainRoute), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomainRoute), err),
+		}
+	}
+	deletionStatus, err := checkDeletion(ctx, func(ctx context.Context) (metav1.Object, error) {
+		return s.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(ctx, name, metav1.GetOptions{})
+	}, request.WaitForDeletion, request.WaitTimeoutSeconds)
+	if err != nil {
+		return &kusciaapi.DeleteDomainRouteResponse{
+			Status:         utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDeleteDomainRoute, err),
+			DeletionStatus: deletionStatus,
 		}
 	}
 	return &kusciaapi.DeleteDomainRouteResponse{
-		Status: utils.BuildSuccessResponseStatus(),
+		Status:         utils.BuildSuccessResponseStatus(),
+		DeletionStatus: deletionStatus,
 	}
 }
 
@@ -225,13 +244,13 @@ func (s domainRouteService) QueryDomainRoute(ctx context.Context, request *kusci
 	// do validate
 	if err := validateDomainRouteRequest(request); err != nil {
 		return &kusciaapi.QueryDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// auth pre handler
 	if err := s.authHandlerViaDstAndSrc(ctx, request); err != nil {
 		return &kusciaapi.QueryDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// get cdr from k8s
@@ -239,7 +258,7 @@ func (s domainRouteService) QueryDomainRoute(ctx context.Context, request *kusci
 	cdr, err := s.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.QueryDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainRoute), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainRoute), err),
 		}
 	}
 	cdrSpec := cdr.Spec
@@ -268,6 +287,7 @@ func (s domainRouteService) QueryDomainRoute(ctx context.Context, request *kusci
 		routeTokenConfig = &kusciaapi.TokenConfig{
 			DestinationPublicKey: cdrTokenConfig.DestinationPublicKey,
 			RollingUpdatePeriod:  int64(cdrTokenConfig.RollingUpdatePeriod),
+			RollingOverlapPeriod: int64(cdrTokenConfig.RollingOverlapPeriod),
 			SourcePublicKey:      cdrTokenConfig.SourcePublicKey,
 			TokenGenMethod:       string(cdrTokenConfig.TokenGenMethod),
 		}
@@ -316,6 +336,7 @@ func (s domainRouteService) QueryDomainRoute(ctx context.Context, request *kusci
 			Status:             buildRouteStatus(cdr),
 			Transit:            apiTransit,
 			BodyEncryption:     bodyEncryption,
+			DeletionStatus:     buildDeletionStatus(cdr),
 		},
 	}
 }
@@ -332,13 +353,13 @@ func (s domainRouteService) BatchQueryDomainRouteStatus(ctx context.Context, req
 		if err := validateDomainRouteRequest(key); err != nil {
 			nlog.Errorf("Validate BatchQueryDomainRouteStatusRequest the index: %d of route key, failed: %s.", i, err.Error())
 			return &kusciaapi.BatchQueryDomainRouteStatusResponse{
-				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 			}
 		}
 		// auth handler
 		if err := s.authHandlerViaDstAndSrc(ctx, key); err != nil {
 			return &kusciaapi.BatchQueryDomainRouteStatusResponse{
-				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 			}
 		}
 	}
@@ -350,7 +371,7 @@ func (s domainRouteService) BatchQueryDomainRouteStatus(ctx context.Context, req
 		cdr, err := s.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			return &kusciaapi.BatchQueryDomainRouteStatusResponse{
-				Status: utils.BuildErrorResponseStatus(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainRouteStatus), err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainRouteStatus), err),
 			}
 		}
 		routeStatuses[i] = &kusciaapi.DomainRouteStatus{
@@ -368,6 +389,61 @@ func (s domainRouteService) BatchQueryDomainRouteStatus(ctx context.Context, req
 	}
 }
 
+func (s domainRouteService) QueryDomainRouteStatus(ctx context.Context, request *kusciaapi.QueryDomainRouteStatusRequest) *kusciaapi.QueryDomainRouteStatusResponse {
+	// do validate
+	if err := validateDomainRouteRequest(request); err != nil {
+		return &kusciaapi.QueryDomainRouteStatusResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	// auth pre handler
+	if err := s.authHandlerViaDstAndSrc(ctx, request); err != nil {
+		return &kusciaapi.QueryDomainRouteStatusResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+	name := buildRouteName(request.Source, request.Destination)
+	cdr, err := s.kusciaClient.KusciaV1alpha1().ClusterDomainRoutes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return &kusciaapi.QueryDomainRouteStatusResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainRouteErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainRouteStatus), err),
+		}
+	}
+	return &kusciaapi.QueryDomainRouteStatusResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.QueryDomainRouteStatusResponseData{
+			Name:        name,
+			Source:      request.Source,
+			Destination: request.Destination,
+			Status:      buildRouteStatus(cdr),
+			Probe:       buildRouteProbeStatus(cdr.Status.Probe),
+		},
+	}
+}
+
+func buildRouteProbeStatus(probe *v1alpha1.DomainRouteProbeStatus) *kusciaapi.RouteProbeStatus {
+	if probe == nil {
+		return nil
+	}
+	history := make([]*kusciaapi.RouteProbeRecord, len(probe.History))
+	for i := range probe.History {
+		history[i] = &kusciaapi.RouteProbeRecord{
+			Result:  string(probe.History[i].Result),
+			Message: probe.History[i].Message,
+			Time:    apiutils.TimeRfc3339String(&probe.History[i].Time),
+		}
+	}
+	return &kusciaapi.RouteProbeStatus{
+		Result:           string(probe.Result),
+		Message:          probe.Message,
+		LastProbeTime:    apiutils.TimeRfc3339String(&probe.LastProbeTime),
+		LatencyMillis:    probe.LatencyMillis,
+		AvgLatencyMillis: probe.AvgLatencyMillis,
+		LossPercent:      probe.LossPercent,
+		History:          history,
+	}
+}
+
 func buildRouteStatus(cdr *v1alpha1.ClusterDomainRoute) *kusciaapi.RouteStatus {
 	status := constants.RouteFailed
 	reason := ""
@@ -399,6 +475,9 @@ type RequestWithDstAndSrc interface {
 }
 
 func (s domainRouteService) authHandlerViaDestination(ctx context.Context, request RequestWithDstAndSrc) error {
+	if err := tenant.CheckDomains(ctx, request.GetDestination()); err != nil {
+		return err
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if role == consts.AuthRoleDomain && request.GetDestination() != domainID {
 		return fmt.Errorf("domain's kusciaAPI could only operate DomainRoute with destination is itself, request.Destination must be %s not %s", domainID, request.GetDestination())
@@ -407,6 +486,9 @@ func (s domainRouteService) authHandlerViaDestination(ctx context.Context, reque
 }
 
 func (s domainRouteService) authHandlerViaDstAndSrc(ctx context.Context, request RequestWithDstAndSrc) error {
+	if t := tenant.FromContext(ctx); t != nil && !t.Allows(request.GetDestination()) && !t.Allows(request.GetSource()) {
+		return fmt.Errorf("tenant %s could only query DomainRoute with its domains, destination:%s,source:%s", t.Name, request.GetDestination(), request.GetSource())
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if role == consts.AuthRoleDomain && request.GetDestination() != domainID && request.GetSource() != domainID {
 		return fmt.Errorf("domain's kusciaAPI could only query DomainRoute with itself, domain:%s ,destination:%s,source:%s", domainID, request.GetDestination(), request.GetSource())
@@ -428,32 +510,32 @@ func GetRoleAndDomainFromCtx(ctx context.Context) (role, domain string) {
 
 func validateCreateDomainRouteRequest(request *kusciaapi.CreateDomainRouteRequest) error {
 	if request.Source == "" {
-		return fmt.Errorf("source can not be empty")
+		return utils.NewFieldViolation("source", "source can not be empty")
 	}
 	if request.Destination == "" {
-		return fmt.Errorf("destination can not be empty")
+		return utils.NewFieldViolation("destination", "destination can not be empty")
 	}
 
 	if request.AuthenticationType == "" {
-		return fmt.Errorf("authentication type can not be empty")
+		return utils.NewFieldViolation("authentication_type", "authentication type can not be empty")
 	}
 
 	if request.Transit == nil {
 		if request.Endpoint == nil || len(request.Endpoint.Ports) == 0 {
-			return fmt.Errorf("endpoint can not be empty when transit is not set")
+			return utils.NewFieldViolation("endpoint", "endpoint can not be empty when transit is not set")
 		}
-		for _, port := range request.Endpoint.Ports {
+		for i, port := range request.Endpoint.Ports {
 			if port.Port > 65535 || port.Port <= 0 {
-				return fmt.Errorf("endpoint port should be positive and less than or equal to 65535 ")
+				return utils.NewFieldViolation(fmt.Sprintf("endpoint.ports[%d].port", i), "endpoint port should be positive and less than or equal to 65535 ")
 			}
 		}
 	} else {
 		if request.Transit.TransitMethod == "" {
-			return fmt.Errorf("tranist method is required when transit is not empty")
+			return utils.NewFieldViolation("transit.transit_method", "tranist method is required when transit is not empty")
 		}
 		if request.Transit.TransitMethod == string(v1alpha1.TransitMethodThirdDomain) {
 			if request.Transit.Domain == nil || request.Transit.Domain.DomainId == "" {
-				return fmt.Errorf("domain is required when transit method is third domain")
+				return utils.NewFieldViolation("transit.domain.domain_id", "domain is required when transit method is third domain")
 			}
 		}
 	}
@@ -463,10 +545,10 @@ func validateCreateDomainRouteRequest(request *kusciaapi.CreateDomainRouteReques
 
 func validateDomainRouteRequest(request RequestWithDstAndSrc) error {
 	if request.GetSource() == "" {
-		return fmt.Errorf("source can not be empty")
+		return utils.NewFieldViolation("source", "source can not be empty")
 	}
 	if request.GetDestination() == "" {
-		return fmt.Errorf("destination can not be empty")
+		return utils.NewFieldViolation("destination", "destination can not be empty")
 	}
 	return nil
 }
@@ -32,14 +32,14 @@ func (s domainRouteServiceLite) CreateDomainRoute(ctx context.Context, request *
 	// do validate
 	if err := validateCreateDomainRouteRequest(request); err != nil {
 		return &kusciaapi.CreateDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// request the master api
 	resp, err := s.kusciaAPIClient.CreateDomainRoute(ctx, request)
 	if err != nil {
 		return &kusciaapi.CreateDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -49,14 +49,14 @@ func (s domainRouteServiceLite) DeleteDomainRoute(ctx context.Context, request *
 	// do validate
 	if err := validateDomainRouteRequest(request); err != nil {
 		return &kusciaapi.DeleteDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// request the master api
 	resp, err := s.kusciaAPIClient.DeleteDomainRoute(ctx, request)
 	if err != nil {
 		return &kusciaapi.DeleteDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -66,14 +66,14 @@ func (s domainRouteServiceLite) QueryDomainRoute(ctx context.Context, request *k
 	// do validate
 	if err := validateDomainRouteRequest(request); err != nil {
 		return &kusciaapi.QueryDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// request the master api
 	resp, err := s.kusciaAPIClient.QueryDomainRoute(ctx, request)
 	if err != nil {
 		return &kusciaapi.QueryDomainRouteResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -91,7 +91,7 @@ func (s domainRouteServiceLite) BatchQueryDomainRouteStatus(ctx context.Context,
 		if err := validateDomainRouteRequest(key); err != nil {
 			nlog.Errorf("Validate BatchQueryDomainRouteStatusRequest the index: %d of route key, failed: %s.", i, err.Error())
 			return &kusciaapi.BatchQueryDomainRouteStatusResponse{
-				Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 			}
 		}
 	}
@@ -99,7 +99,24 @@ func (s domainRouteServiceLite) BatchQueryDomainRouteStatus(ctx context.Context,
 	resp, err := s.kusciaAPIClient.BatchQueryDomainRoute(ctx, request)
 	if err != nil {
 		return &kusciaapi.BatchQueryDomainRouteStatusResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (s domainRouteServiceLite) QueryDomainRouteStatus(ctx context.Context, request *kusciaapi.QueryDomainRouteStatusRequest) *kusciaapi.QueryDomainRouteStatusResponse {
+	// do validate
+	if err := validateDomainRouteRequest(request); err != nil {
+		return &kusciaapi.QueryDomainRouteStatusResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	// request the master api
+	resp, err := s.kusciaAPIClient.QueryDomainRouteStatus(ctx, request)
+	if err != nil {
+		return &kusciaapi.QueryDomainRouteStatusResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -21,8 +21,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
@@ -153,6 +156,34 @@ func TestBatchQueryRoutes(t *testing.T) {
 	assert.Equal(t, len(res.Data.Routes), 1)
 }
 
+func TestQueryRouteStatus(t *testing.T) {
+	res := kusciaAPIDR.QueryDomainRouteStatus(context.Background(), &kusciaapi.QueryDomainRouteStatusRequest{
+		Source:      kusciaAPIDR.source,
+		Destination: kusciaAPIDR.destination,
+	})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Equal(t, kusciaAPIDR.source, res.Data.Source)
+	assert.Nil(t, res.Data.Probe)
+}
+
+func TestBuildRouteProbeStatus(t *testing.T) {
+	now := metav1.Now()
+	probe := buildRouteProbeStatus(&v1alpha1.DomainRouteProbeStatus{
+		Result:        v1alpha1.DomainRouteProbeUnauthorized,
+		Message:       "token not found in destination",
+		LastProbeTime: now,
+		LossPercent:   10,
+		History: []v1alpha1.DomainRouteProbeRecord{
+			{Result: v1alpha1.DomainRouteProbeReachable, Time: now},
+			{Result: v1alpha1.DomainRouteProbeUnauthorized, Message: "token not found in destination", Time: now},
+		},
+	})
+	assert.Equal(t, "Unauthorized", probe.Result)
+	assert.Equal(t, int32(10), probe.LossPercent)
+	assert.Len(t, probe.History, 2)
+	assert.NotEmpty(t, probe.History[1].Time)
+}
+
 func TestDeleteRoute(t *testing.T) {
 	deleteRes := kusciaAPIDR.DeleteDomainRoute(context.Background(), &kusciaapi.DeleteDomainRouteRequest{
 		Source:      kusciaAPIDR.source,
@@ -166,6 +197,24 @@ func TestDeleteRoute(t *testing.T) {
 	assert.Equal(t, queryRes.Status.Code, int32(errorcode.ErrorCode_KusciaAPIErrDomainRouteNotExists))
 }
 
+func TestDomainRouteTenant(t *testing.T) {
+	t.Parallel()
+	s := &domainRouteService{}
+	ctx := context.WithValue(context.Background(), consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+
+	createRes := s.CreateDomainRoute(ctx, &kusciaapi.CreateDomainRouteRequest{
+		Source:             "carol",
+		Destination:        "alice",
+		AuthenticationType: string(v1alpha1.DomainAuthenticationNone),
+		Endpoint:           &kusciaapi.RouteEndpoint{Host: "localhost", Ports: []*kusciaapi.EndpointPort{{Name: "http", Port: 8080, Protocol: "HTTP"}}},
+	})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrAuthFailed), createRes.Status.Code)
+	queryRes := s.QueryDomainRoute(ctx, &kusciaapi.QueryDomainRouteRequest{Source: "bob", Destination: "alice"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrAuthFailed), queryRes.Status.Code)
+	deleteRes := s.DeleteDomainRoute(ctx, &kusciaapi.DeleteDomainRouteRequest{Source: "carol", Destination: "alice"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrAuthFailed), deleteRes.Status.Code)
+}
+
 func TestConvertDomainRouteProtocol(t *testing.T) {
 	p, isTLS, err := convert2DomainRouteProtocol("http")
 	assert.False(t, isTLS)
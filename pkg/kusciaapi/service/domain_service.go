@@ -38,6 +38,7 @@ import (
 	"github.com/secretflow/kuscia/pkg/utils/resources"
 	"github.com/secretflow/kuscia/pkg/utils/tls"
 	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/pkg/web/utils"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
@@ -49,6 +50,8 @@ type IDomainService interface {
 	UpdateDomain(ctx context.Context, request *kusciaapi.UpdateDomainRequest) *kusciaapi.UpdateDomainResponse
 	DeleteDomain(ctx context.Context, request *kusciaapi.DeleteDomainRequest) *kusciaapi.DeleteDomainResponse
 	BatchQueryDomain(ctx context.Context, request *kusciaapi.BatchQueryDomainRequest) *kusciaapi.BatchQueryDomainResponse
+	RegisterDomain(ctx context.Context, request *kusciaapi.RegisterDomainRequest) *kusciaapi.RegisterDomainResponse
+	QueryDomainOnboarding(ctx context.Context, request *kusciaapi.QueryDomainOnboardingRequest) *kusciaapi.QueryDomainOnboardingResponse
 }
 
 type domainService struct {
@@ -86,7 +89,7 @@ func (s domainService) CreateDomain(ctx context.Context, request *kusciaapi.Crea
 	// do k8s validate
 	if err := resources.ValidateK8sName(domainID, "domain_id"); err != nil {
 		return &kusciaapi.CreateDomainResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -96,6 +99,16 @@ func (s domainService) CreateDomain(ctx context.Context, request *kusciaapi.Crea
 			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, fmt.Sprintf("role is invalid, must be empty or %s", v1alpha1.Partner)),
 		}
 	}
+	if err := validateDataGrantApproval(request.DataGrantApproval); err != nil {
+		return &kusciaapi.CreateDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	if err := tenant.CheckDomains(ctx, domainID); err != nil {
+		return &kusciaapi.CreateDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
 	// 1. role is empty, domain to create is located in local party
 	// 2. role is partner, domain to create is located in remote party
 	if request.MasterDomainId != "" && request.MasterDomainId != domainID {
@@ -118,7 +131,7 @@ func (s domainService) CreateDomain(ctx context.Context, request *kusciaapi.Crea
 		var err error
 		if inputCert, err = s.getValidCert(inputCert); err != nil {
 			return &kusciaapi.CreateDomainResponse{
-				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 			}
 		}
 	}
@@ -132,10 +145,12 @@ func (s domainService) CreateDomain(ctx context.Context, request *kusciaapi.Crea
 			Name: domainID,
 		},
 		Spec: v1alpha1.DomainSpec{
-			Cert:         inputCert,
-			Role:         v1alpha1.DomainRole(request.Role),
-			AuthCenter:   authCenterConverter(request.AuthCenter),
-			MasterDomain: request.MasterDomainId,
+			Cert:                       inputCert,
+			Role:                       v1alpha1.DomainRole(request.Role),
+			AuthCenter:                 authCenterConverter(request.AuthCenter),
+			MasterDomain:               request.MasterDomainId,
+			ImageRegistryCredentialKey: request.ImageRegistryCredentialKey,
+			DataGrantApproval:          v1alpha1.DataGrantApprovalMode(request.DataGrantApproval),
 		},
 	}
 
@@ -150,7 +165,7 @@ func (s domainService) CreateDomain(ctx context.Context, request *kusciaapi.Crea
 	_, err := s.kusciaClient.KusciaV1alpha1().Domains().Create(ctx, kusciaDomain, metav1.CreateOptions{})
 	if err != nil {
 		return &kusciaapi.CreateDomainResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomain), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomain), err),
 		}
 	}
 	return &kusciaapi.CreateDomainResponse{
@@ -172,14 +187,14 @@ func (s domainService) QueryDomain(ctx context.Context, request *kusciaapi.Query
 	// Auth Handler
 	if err := s.authHandler(ctx, request); err != nil {
 		return &kusciaapi.QueryDomainResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// get kuscia domain
 	kusciaDomain, err := s.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, domainID, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.QueryDomainResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomain), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomain), err),
 		}
 	}
 	// build domain response
@@ -196,14 +211,17 @@ func (s domainService) QueryDomain(ctx context.Context, request *kusciaapi.Query
 	return &kusciaapi.QueryDomainResponse{
 		Status: utils.BuildSuccessResponseStatus(),
 		Data: &kusciaapi.QueryDomainResponseData{
-			DomainId:            domainID,
-			Cert:                kusciaDomain.Spec.Cert,
-			Role:                string(kusciaDomain.Spec.Role),
-			NodeStatuses:        domainStatus.NodeStatuses,
-			DeployTokenStatuses: deployToken,
-			Annotations:         kusciaDomain.Annotations,
-			AuthCenter:          authCenter,
-			MasterDomainId:      kusciaDomain.Spec.MasterDomain,
+			DomainId:                   domainID,
+			Cert:                       kusciaDomain.Spec.Cert,
+			Role:                       string(kusciaDomain.Spec.Role),
+			NodeStatuses:               domainStatus.NodeStatuses,
+			DeployTokenStatuses:        deployToken,
+			Annotations:                kusciaDomain.Annotations,
+			AuthCenter:                 authCenter,
+			MasterDomainId:             kusciaDomain.Spec.MasterDomain,
+			DeletionStatus:             buildDeletionStatus(kusciaDomain),
+			ImageRegistryCredentialKey: kusciaDomain.Spec.ImageRegistryCredentialKey,
+			DataGrantApproval:          string(kusciaDomain.Spec.DataGrantApproval),
 		},
 	}
 }
@@ -212,7 +230,7 @@ func (s domainService) queryKusciaMasterDomain() *kusciaapi.QueryDomainResponse
 	caCert, err := s.queryKusciaMasterCert()
 	if err != nil {
 		return &kusciaapi.QueryDomainResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrQueryDomain, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrQueryDomain, err),
 		}
 	}
 	return &kusciaapi.QueryDomainResponse{
@@ -249,10 +267,15 @@ func (s domainService) UpdateDomain(ctx context.Context, request *kusciaapi.Upda
 			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, fmt.Sprintf("role is invalid, must be empty or %s", v1alpha1.Partner)),
 		}
 	}
+	if err := validateDataGrantApproval(request.DataGrantApproval); err != nil {
+		return &kusciaapi.UpdateDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
 	// Auth Handler
 	if err := s.authHandler(ctx, request); err != nil {
 		return &kusciaapi.UpdateDomainResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// 1. role is empty, domain to create is located in local party
@@ -277,7 +300,7 @@ func (s domainService) UpdateDomain(ctx context.Context, request *kusciaapi.Upda
 		var err error
 		if inputCert, err = s.getValidCert(inputCert); err != nil {
 			return &kusciaapi.UpdateDomainResponse{
-				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 			}
 		}
 	}
@@ -286,7 +309,7 @@ func (s domainService) UpdateDomain(ctx context.Context, request *kusciaapi.Upda
 	latestDomain, err := s.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, domainID, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.UpdateDomainResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomain), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomain), err),
 		}
 	}
 
@@ -323,11 +346,21 @@ func (s domainService) UpdateDomain(ctx context.Context, request *kusciaapi.Upda
 		latestDomain.Spec.AuthCenter = authCenter
 	}
 
+	if request.ImageRegistryCredentialKey != latestDomain.Spec.ImageRegistryCredentialKey {
+		needUpdate = true
+		latestDomain.Spec.ImageRegistryCredentialKey = request.ImageRegistryCredentialKey
+	}
+
+	if v1alpha1.DataGrantApprovalMode(request.DataGrantApproval) != latestDomain.Spec.DataGrantApproval {
+		needUpdate = true
+		latestDomain.Spec.DataGrantApproval = v1alpha1.DataGrantApprovalMode(request.DataGrantApproval)
+	}
+
 	if needUpdate {
 		_, err = s.kusciaClient.KusciaV1alpha1().Domains().Update(ctx, latestDomain, metav1.UpdateOptions{})
 		if err != nil {
 			return &kusciaapi.UpdateDomainResponse{
-				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrUpdateDomain, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrUpdateDomain, err),
 			}
 		}
 	}
@@ -345,15 +378,35 @@ func (s domainService) DeleteDomain(ctx context.Context, request *kusciaapi.Dele
 			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "domain id can not be empty"),
 		}
 	}
+	if err := validateDeletionWaitTimeout(request.WaitTimeoutSeconds); err != nil {
+		return &kusciaapi.DeleteDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	if err := tenant.CheckDomains(ctx, domainID); err != nil {
+		return &kusciaapi.DeleteDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
 	// delete kuscia domain
 	err := s.kusciaClient.KusciaV1alpha1().Domains().Delete(ctx, domainID, metav1.DeleteOptions{})
 	if err != nil {
 		return &kusciaapi.DeleteDomainResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomain), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomain), err),
+		}
+	}
+	deletionStatus, err := checkDeletion(ctx, func(ctx context.Context) (metav1.Object, error) {
+		return s.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, domainID, metav1.GetOptions{})
+	}, request.WaitForDeletion, request.WaitTimeoutSeconds)
+	if err != nil {
+		return &kusciaapi.DeleteDomainResponse{
+			Status:         utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDeleteDomain, err),
+			DeletionStatus: deletionStatus,
 		}
 	}
 	return &kusciaapi.DeleteDomainResponse{
-		Status: utils.BuildSuccessResponseStatus(),
+		Status:         utils.BuildSuccessResponseStatus(),
+		DeletionStatus: deletionStatus,
 	}
 }
 
@@ -372,6 +425,11 @@ func (s domainService) BatchQueryDomain(ctx context.Context, request *kusciaapi.
 			}
 		}
 	}
+	if err := tenant.CheckDomains(ctx, domainIDs...); err != nil {
+		return &kusciaapi.BatchQueryDomainResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
 	// build domain statuses
 	domains := make([]*kusciaapi.Domain, len(domainIDs))
 	for i, domainID := range domainIDs {
@@ -379,7 +437,7 @@ func (s domainService) BatchQueryDomain(ctx context.Context, request *kusciaapi.
 			caCert, err := s.queryKusciaMasterCert()
 			if err != nil {
 				return &kusciaapi.BatchQueryDomainResponse{
-					Status: utils.BuildErrorResponseStatus(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainStatus), err.Error()),
+					Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainStatus), err),
 				}
 			}
 			domains[i] = &kusciaapi.Domain{
@@ -391,7 +449,7 @@ func (s domainService) BatchQueryDomain(ctx context.Context, request *kusciaapi.
 		kusciaDomain, err := s.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, domainID, metav1.GetOptions{})
 		if err != nil {
 			return &kusciaapi.BatchQueryDomainResponse{
-				Status: utils.BuildErrorResponseStatus(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainStatus), err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainStatus), err),
 			}
 		}
 		domains[i], _ = s.buildDomain(kusciaDomain)
@@ -450,6 +508,14 @@ func (s domainService) buildDomain(kusciaDomain *v1alpha1.Domain) (*kusciaapi.Do
 	return domain, tokenStatuses
 }
 
+func validateDataGrantApproval(approval string) error {
+	switch v1alpha1.DataGrantApprovalMode(approval) {
+	case "", v1alpha1.DataGrantApprovalAuto, v1alpha1.DataGrantApprovalManual:
+		return nil
+	}
+	return fmt.Errorf("data grant approval is invalid, must be empty, %s or %s", v1alpha1.DataGrantApprovalAuto, v1alpha1.DataGrantApprovalManual)
+}
+
 func authCenterConverter(authCenter *kusciaapi.AuthCenter) *v1alpha1.AuthCenter {
 	if authCenter != nil {
 		return &v1alpha1.AuthCenter{
@@ -465,6 +531,9 @@ type RequestWithDomainID interface {
 }
 
 func (s domainService) authHandler(ctx context.Context, request RequestWithDomainID) error {
+	if err := tenant.CheckDomains(ctx, request.GetDomainId()); err != nil {
+		return err
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if role == constants.AuthRoleDomain {
 		if request.GetDomainId() != domainID {
@@ -48,7 +48,7 @@ func (s domainServiceLite) QueryDomain(ctx context.Context, request *kusciaapi.Q
 	resp, err := s.kusciaAPIClient.QueryDomain(ctx, request)
 	if err != nil {
 		return &kusciaapi.QueryDomainResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -72,7 +72,7 @@ func (s domainServiceLite) UpdateDomain(ctx context.Context, request *kusciaapi.
 	resp, err := s.kusciaAPIClient.UpdateDomain(ctx, request)
 	if err != nil {
 		return &kusciaapi.UpdateDomainResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 
@@ -105,8 +105,22 @@ func (s domainServiceLite) BatchQueryDomain(ctx context.Context, request *kuscia
 	resp, err := s.kusciaAPIClient.BatchQueryDomain(ctx, request)
 	if err != nil {
 		return &kusciaapi.BatchQueryDomainResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
 }
+
+func (s domainServiceLite) RegisterDomain(ctx context.Context, request *kusciaapi.RegisterDomainRequest) *kusciaapi.RegisterDomainResponse {
+	// kuscia lite api not support this interface
+	return &kusciaapi.RegisterDomainResponse{
+		Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrLiteAPINotSupport, "kuscia lite api not support this interface now"),
+	}
+}
+
+func (s domainServiceLite) QueryDomainOnboarding(ctx context.Context, request *kusciaapi.QueryDomainOnboardingRequest) *kusciaapi.QueryDomainOnboardingResponse {
+	// kuscia lite api not support this interface
+	return &kusciaapi.QueryDomainOnboardingResponse{
+		Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrLiteAPINotSupport, "kuscia lite api not support this interface now"),
+	}
+}
@@ -23,6 +23,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 	"github.com/secretflow/kuscia/test/util"
@@ -51,6 +53,16 @@ func TestCreateDomain_NameError(t *testing.T) {
 	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
 }
 
+func TestCreateDomain_DataGrantApprovalError(t *testing.T) {
+	t.Parallel()
+	ds := &domainService{}
+	res := ds.CreateDomain(context.Background(), &kusciaapi.CreateDomainRequest{
+		DomainId:          "alice",
+		DataGrantApproval: "manually",
+	})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+}
+
 func TestCreateDomainWithCertError(t *testing.T) {
 	res := kusciaAPIDS.CreateDomain(context.Background(), &kusciaapi.CreateDomainRequest{
 		DomainId: kusciaAPIDS.domainID,
@@ -120,6 +132,23 @@ func TestDeleteDomain(t *testing.T) {
 	assert.Equal(t, queryRes.Status.Code, int32(errorcode.ErrorCode_KusciaAPIErrDomainNotExists))
 }
 
+func TestDomainTenant(t *testing.T) {
+	t.Parallel()
+	ds := &domainService{}
+	ctx := context.WithValue(context.Background(), consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+
+	createRes := ds.CreateDomain(ctx, &kusciaapi.CreateDomainRequest{DomainId: "alice"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrAuthFailed), createRes.Status.Code)
+	queryRes := ds.QueryDomain(ctx, &kusciaapi.QueryDomainRequest{DomainId: "alice"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrAuthFailed), queryRes.Status.Code)
+	updateRes := ds.UpdateDomain(ctx, &kusciaapi.UpdateDomainRequest{DomainId: "alice"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrAuthFailed), updateRes.Status.Code)
+	deleteRes := ds.DeleteDomain(ctx, &kusciaapi.DeleteDomainRequest{DomainId: "alice"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrAuthFailed), deleteRes.Status.Code)
+	batchRes := ds.BatchQueryDomain(ctx, &kusciaapi.BatchQueryDomainRequest{DomainIds: []string{"carol", "alice"}})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrAuthFailed), batchRes.Status.Code)
+}
+
 func TestGetValidCert(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,276 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
+	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/datamesh/dataserver/io/builtin"
+	dsutils "github.com/secretflow/kuscia/pkg/datamesh/dataserver/utils"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/quota"
+	"github.com/secretflow/kuscia/pkg/utils/resources"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// downloadChunkSize is the max content size of each response of the download stream.
+const downloadChunkSize = 1024 * 1024
+
+type IDomainDataDownloadService interface {
+	// OpenDomainData authorizes the request and opens the requested range of the domaindata, it returns the reader,
+	// the end offset of the range and the size of the whole domaindata file.
+	OpenDomainData(ctx context.Context, request *kusciaapi.DownloadDomainDataRequest) (reader io.ReadCloser, end int64, totalSize int64, status *pbv1alpha1.Status)
+	DownloadDomainData(ctx context.Context, request *kusciaapi.DownloadDomainDataRequest, eventCh chan<- *kusciaapi.DownloadDomainDataResponse)
+}
+
+type domainDataDownloadService struct {
+	conf              *config.KusciaAPIConfig
+	datasourceService domainDataSourceService
+}
+
+func NewDomainDataDownloadService(config *config.KusciaAPIConfig, configService cmservice.IConfigService) IDomainDataDownloadService {
+	return &domainDataDownloadService{
+		conf: config,
+		datasourceService: domainDataSourceService{
+			conf:          config,
+			configService: configService,
+			sealer:        secretbackend.NewSealer(config.DomainKey, config.SecretBackend),
+		},
+	}
+}
+
+func (s *domainDataDownloadService) DownloadDomainData(ctx context.Context, request *kusciaapi.DownloadDomainDataRequest, eventCh chan<- *kusciaapi.DownloadDomainDataResponse) {
+	reader, end, totalSize, status := s.OpenDomainData(ctx, request)
+	if status != nil {
+		eventCh <- &kusciaapi.DownloadDomainDataResponse{Status: status}
+		return
+	}
+	defer reader.Close()
+
+	offset := request.Offset
+	buf := make([]byte, downloadChunkSize)
+	for {
+		if ctx.Err() != nil {
+			nlog.Infof("Download of domaindata %s/%s is canceled at offset %d", request.DomainId, request.DomaindataId, offset)
+			return
+		}
+		n, err := io.ReadFull(reader, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			nlog.Warnf("Read domaindata %s/%s at offset %d failed: %v", request.DomainId, request.DomaindataId, offset, err)
+			eventCh <- &kusciaapi.DownloadDomainDataResponse{
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDownloadDomainData, err),
+				Offset: offset,
+			}
+			return
+		}
+		sum := sha256.Sum256(buf[:n])
+		eof := offset+int64(n) >= end || n < len(buf)
+		eventCh <- &kusciaapi.DownloadDomainDataResponse{
+			Status:    utils.BuildSuccessResponseStatus(),
+			Offset:    offset,
+			Content:   slices.Clone(buf[:n]),
+			Sha256:    hex.EncodeToString(sum[:]),
+			TotalSize: totalSize,
+			Eof:       eof,
+		}
+		offset += int64(n)
+		if eof {
+			return
+		}
+	}
+}
+
+func (s *domainDataDownloadService) OpenDomainData(ctx context.Context, request *kusciaapi.DownloadDomainDataRequest) (io.ReadCloser, int64, int64, *pbv1alpha1.Status) {
+	if err := validateDownloadDomainDataRequest(request); err != nil {
+		return nil, 0, 0, utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err)
+	}
+	if s.conf.RunMode == common.RunModeLite && request.DomainId != s.conf.Initiator {
+		return nil, 0, 0, utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+			fmt.Sprintf("kuscia lite api could only download it's own domaindata, the domainid of request must be %s, not %s", s.conf.Initiator, request.DomainId))
+	}
+
+	// the domain callers could only download by their own identities
+	requester := request.GrantDomain
+	if role, domainID := GetRoleAndDomainFromCtx(ctx); role == consts.AuthRoleDomain {
+		requester = domainID
+	}
+	tenantDomain := request.DomainId
+	if requester != "" {
+		tenantDomain = requester
+	}
+	if err := tenant.CheckDomains(ctx, tenantDomain); err != nil {
+		return nil, 0, 0, utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err)
+	}
+
+	domainData, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDatas(request.DomainId).Get(ctx, request.DomaindataId, metav1.GetOptions{})
+	if err != nil {
+		nlog.Warnf("Get domaindata %s/%s failed: %v", request.DomainId, request.DomaindataId, err)
+		if k8serrors.IsNotFound(err) {
+			return nil, 0, 0, utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotExists, err)
+		}
+		return nil, 0, 0, utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed, err)
+	}
+
+	var maxBytesRead int64
+	if requester != "" && requester != request.DomainId {
+		grant, err := s.findFileGrant(ctx, request.DomainId, request.DomaindataId, requester)
+		if err != nil {
+			return nil, 0, 0, utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted, err)
+		}
+		if limit := grant.Spec.Limit; limit != nil {
+			maxBytesRead = limit.MaxBytesRead
+			// the rows and columns can't be filtered in the raw file, the same as the raw reads of the datamesh
+			constraint := &dsutils.OutputConstraint{MaxRows: limit.MaxRowsRead, SamplePercent: limit.SamplePercent, AllowedColumns: limit.AllowedColumns}
+			if constraint.FiltersContent() {
+				return nil, 0, 0, utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted,
+					fmt.Sprintf("the output of domaindata %s/%s is limited by grant %s, it can't be downloaded as file", request.DomainId, request.DomaindataId, grant.Name))
+			}
+		}
+		// the columns can't be masked in the raw file
+		if policies := resources.MaskingPoliciesForDomain(&domainData.Spec, requester); len(policies) > 0 {
+			return nil, 0, 0, utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted,
+				fmt.Sprintf("domaindata %s/%s has masked columns for domain %s, it can't be downloaded as file", request.DomainId, request.DomaindataId, requester))
+		}
+	}
+
+	ds, err := s.datasourceService.getDataMeshDataSource(ctx, request.DomainId, domainData.Spec.DataSource)
+	if err != nil {
+		return nil, 0, 0, utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDownloadDomainData, err)
+	}
+	// the local files of the lite domains are not reachable from the master
+	if s.conf.RunMode == common.RunModeMaster && ds.Type == common.DomainDataSourceTypeLocalFS {
+		return nil, 0, 0, utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrMasterAPINotSupport,
+			"localfs domaindata could only be downloaded by the kuscia api of its own domain")
+	}
+
+	data := &datamesh.DomainData{DomaindataId: domainData.Name, RelativeUri: domainData.Spec.RelativeURI}
+	reader, totalSize, err := builtin.OpenDomainDataRange(ctx, data, ds, request.Offset, request.LimitBytes)
+	if err != nil {
+		nlog.Warnf("Open domaindata %s/%s failed: %v", request.DomainId, request.DomaindataId, err)
+		if errors.Is(err, builtin.ErrRangeNotSatisfiable) {
+			return nil, 0, 0, utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err)
+		}
+		return nil, 0, 0, utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDownloadDomainData, err)
+	}
+
+	end := totalSize
+	if request.LimitBytes > 0 && request.Offset+request.LimitBytes < totalSize {
+		end = request.Offset + request.LimitBytes
+	}
+	if maxBytesRead > 0 && end > maxBytesRead {
+		reader.Close()
+		return nil, 0, 0, utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted,
+			fmt.Sprintf("the grant of domain %s allows to read the first %d bytes only, but %d bytes are requested", requester, maxBytesRead, end))
+	}
+	// the bytes sent are counted against the transfer quota of the domain receiving them
+	if err := s.conf.QuotaChecker.Check(ctx, &quota.Request{DomainID: tenantDomain, Resource: quota.ResourceTransferBytes, Amount: end - request.Offset}); err != nil {
+		reader.Close()
+		return nil, 0, 0, utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrQuotaExceeded, err)
+	}
+	nlog.Infof("Domain %q downloads domaindata %s/%s from offset %d to %d", requester, request.DomainId, request.DomaindataId, request.Offset, end)
+	return reader, end, totalSize, nil
+}
+
+// findFileGrant returns the available grant which allows the grant domain to download the domaindata.
+func (s *domainDataDownloadService) findFileGrant(ctx context.Context, domainID, domainDataID, grantDomain string) (*v1alpha1.DomainDataGrant, error) {
+	grants, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(domainID).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range grants.Items {
+		grant := &grants.Items[i]
+		if grant.Spec.DomainDataID != domainDataID || grant.Spec.GrantDomain != grantDomain || grant.Status.Phase != v1alpha1.GrantReady {
+			continue
+		}
+		if grant.Spec.Limit == nil || !slices.Contains(grant.Spec.Limit.GrantMode, v1alpha1.GrantFile) {
+			continue
+		}
+		if t := grant.Spec.Limit.ExpirationTime; t != nil && t.Time.Before(time.Now()) {
+			continue
+		}
+		return grant, nil
+	}
+	return nil, fmt.Errorf("domaindata %s/%s is not granted to domain %s with %s mode", domainID, domainDataID, grantDomain, v1alpha1.GrantFile)
+}
+
+// getDataMeshDataSource returns the datasource with the decrypted info, which is used to read and write the
+// domaindata files by the datamesh builtin io.
+func (s domainDataSourceService) getDataMeshDataSource(ctx context.Context, domainID, datasourceID string) (*datamesh.DomainDataSource, error) {
+	ds, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDataSources(domainID).Get(ctx, datasourceID, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get datasource %s/%s failed, %w", domainID, datasourceID, err)
+	}
+	info, err := s.decryptDatasourceInfo(ctx, ds)
+	if err != nil {
+		return nil, err
+	}
+	dmInfo := &datamesh.DataSourceInfo{}
+	if info.Localfs != nil {
+		dmInfo.Localfs = &datamesh.LocalDataSourceInfo{Path: info.Localfs.Path}
+	}
+	if info.Oss != nil {
+		dmInfo.Oss = &datamesh.OssDataSourceInfo{
+			Endpoint:        info.Oss.Endpoint,
+			Bucket:          info.Oss.Bucket,
+			Prefix:          info.Oss.Prefix,
+			AccessKeyId:     info.Oss.AccessKeyId,
+			AccessKeySecret: info.Oss.AccessKeySecret,
+			Virtualhost:     info.Oss.Virtualhost,
+		}
+	}
+	return &datamesh.DomainDataSource{
+		DatasourceId: ds.Name,
+		Name:         ds.Spec.Name,
+		Type:         ds.Spec.Type,
+		Info:         dmInfo,
+	}, nil
+}
+
+func validateDownloadDomainDataRequest(request *kusciaapi.DownloadDomainDataRequest) error {
+	if request.DomainId == "" {
+		return utils.NewFieldViolation("domain_id", "domain id can not be empty")
+	}
+	if request.DomaindataId == "" {
+		return utils.NewFieldViolation("domaindata_id", "domaindata id can not be empty")
+	}
+	if request.Offset < 0 {
+		return utils.NewFieldViolation("offset", "offset can not be negative")
+	}
+	if request.LimitBytes < 0 {
+		return utils.NewFieldViolation("limit_bytes", "limit bytes can not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/quota"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func makeDownloadTestService(t *testing.T, content string) (*config.KusciaAPIConfig, IDomainDataDownloadService) {
+	conf := makeDomainDataSourceServiceConfig(t)
+	root := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "result.csv"), []byte(content), 0644))
+
+	dsService := makeDomainDataSourceService(t, conf)
+	res := dsService.CreateDomainDataSource(context.Background(), &kusciaapi.CreateDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: "ds-1",
+		Type:         common.DomainDataSourceTypeLocalFS,
+		Info:         &kusciaapi.DataSourceInfo{Localfs: &kusciaapi.LocalDataSourceInfo{Path: root}},
+	})
+	assert.Equal(t, int32(0), res.Status.Code)
+	_, err := conf.KusciaClient.KusciaV1alpha1().DomainDatas(mockDomainID).Create(context.Background(), &v1alpha1.DomainData{
+		ObjectMeta: metav1.ObjectMeta{Name: "result", Namespace: mockDomainID},
+		Spec:       v1alpha1.DomainDataSpec{RelativeURI: "result.csv", DataSource: "ds-1"},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	return conf, NewDomainDataDownloadService(conf, makeConfigService(t))
+}
+
+func downloadAll(ctx context.Context, s IDomainDataDownloadService, request *kusciaapi.DownloadDomainDataRequest) []*kusciaapi.DownloadDomainDataResponse {
+	eventCh := make(chan *kusciaapi.DownloadDomainDataResponse, 10)
+	go func() {
+		defer close(eventCh)
+		s.DownloadDomainData(ctx, request, eventCh)
+	}()
+	var responses []*kusciaapi.DownloadDomainDataResponse
+	for e := range eventCh {
+		responses = append(responses, e)
+	}
+	return responses
+}
+
+func TestDownloadDomainData(t *testing.T) {
+	_, s := makeDownloadTestService(t, "0123456789")
+
+	responses := downloadAll(context.Background(), s, &kusciaapi.DownloadDomainDataRequest{
+		DomainId:     mockDomainID,
+		DomaindataId: "result",
+		Offset:       2,
+		LimitBytes:   5,
+	})
+	assert.Len(t, responses, 1)
+	resp := responses[0]
+	assert.Equal(t, int32(0), resp.Status.Code)
+	assert.Equal(t, "23456", string(resp.Content))
+	assert.Equal(t, int64(2), resp.Offset)
+	assert.Equal(t, int64(10), resp.TotalSize)
+	assert.True(t, resp.Eof)
+	sum := sha256.Sum256([]byte("23456"))
+	assert.Equal(t, hex.EncodeToString(sum[:]), resp.Sha256)
+}
+
+func TestDownloadDomainData_Chunks(t *testing.T) {
+	content := make([]byte, downloadChunkSize+10)
+	for i := range content {
+		content[i] = byte('a' + i%26)
+	}
+	_, s := makeDownloadTestService(t, string(content))
+
+	responses := downloadAll(context.Background(), s, &kusciaapi.DownloadDomainDataRequest{
+		DomainId:     mockDomainID,
+		DomaindataId: "result",
+	})
+	assert.Len(t, responses, 2)
+	assert.False(t, responses[0].Eof)
+	assert.Equal(t, int64(downloadChunkSize), responses[1].Offset)
+	assert.True(t, responses[1].Eof)
+	assert.Equal(t, content, append(responses[0].Content, responses[1].Content...))
+}
+
+func TestDownloadDomainData_TransferQuota(t *testing.T) {
+	conf, s := makeDownloadTestService(t, "0123456789")
+	backend := &amountQuotaBackend{limits: map[quota.Resource]int64{quota.ResourceTransferBytes: 5}}
+	checker, err := quota.NewCheckerWithBackend(backend, &quota.Config{})
+	assert.NoError(t, err)
+	conf.QuotaChecker = checker
+
+	responses := downloadAll(context.Background(), s, &kusciaapi.DownloadDomainDataRequest{
+		DomainId:     mockDomainID,
+		DomaindataId: "result",
+	})
+	assert.Len(t, responses, 1)
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrQuotaExceeded), responses[0].Status.Code)
+	assert.Equal(t, []quota.Request{{DomainID: mockDomainID, Resource: quota.ResourceTransferBytes, Amount: 10}}, backend.requests)
+
+	responses = downloadAll(context.Background(), s, &kusciaapi.DownloadDomainDataRequest{
+		DomainId:     mockDomainID,
+		DomaindataId: "result",
+		Offset:       5,
+	})
+	assert.Len(t, responses, 1)
+	assert.Equal(t, int32(0), responses[0].Status.Code, responses[0].Status.Message)
+	assert.Equal(t, "56789", string(responses[0].Content))
+}
+
+func TestDownloadDomainData_Failed(t *testing.T) {
+	_, s := makeDownloadTestService(t, "0123456789")
+
+	tests := []struct {
+		name    string
+		request *kusciaapi.DownloadDomainDataRequest
+		code    pberrorcode.ErrorCode
+	}{
+		{
+			name:    "empty domaindata id",
+			request: &kusciaapi.DownloadDomainDataRequest{DomainId: mockDomainID},
+			code:    pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+		},
+		{
+			name:    "other domain in lite",
+			request: &kusciaapi.DownloadDomainDataRequest{DomainId: "bob", DomaindataId: "result"},
+			code:    pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+		},
+		{
+			name:    "not exist",
+			request: &kusciaapi.DownloadDomainDataRequest{DomainId: mockDomainID, DomaindataId: "missing"},
+			code:    pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotExists,
+		},
+		{
+			name:    "offset beyond end",
+			request: &kusciaapi.DownloadDomainDataRequest{DomainId: mockDomainID, DomaindataId: "result", Offset: 11},
+			code:    pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+		},
+		{
+			name:    "not granted",
+			request: &kusciaapi.DownloadDomainDataRequest{DomainId: mockDomainID, DomaindataId: "result", GrantDomain: "bob"},
+			code:    pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := downloadAll(context.Background(), s, tt.request)
+			assert.Len(t, responses, 1)
+			assert.Equal(t, int32(tt.code), responses[0].Status.Code)
+		})
+	}
+}
+
+func TestDownloadDomainData_Grant(t *testing.T) {
+	conf, s := makeDownloadTestService(t, "0123456789")
+	grant := &v1alpha1.DomainDataGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant-bob", Namespace: mockDomainID},
+		Spec: v1alpha1.DomainDataGrantSpec{
+			Author:       mockDomainID,
+			DomainDataID: "result",
+			GrantDomain:  "bob",
+			Limit: &v1alpha1.GrantLimit{
+				GrantMode:    []v1alpha1.GrantType{v1alpha1.GrantFile},
+				MaxBytesRead: 6,
+			},
+		},
+		Status: v1alpha1.DomainDataGrantStatus{Phase: v1alpha1.GrantReady},
+	}
+	_, err := conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(mockDomainID).Create(context.Background(), grant, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	// the domain callers download by their own identities
+	ctx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleDomain)
+	ctx = context.WithValue(ctx, consts.SourceDomainKey, "bob")
+	responses := downloadAll(ctx, s, &kusciaapi.DownloadDomainDataRequest{
+		DomainId:     mockDomainID,
+		DomaindataId: "result",
+		LimitBytes:   6,
+	})
+	assert.Len(t, responses, 1)
+	assert.Equal(t, int32(0), responses[0].Status.Code)
+	assert.Equal(t, "012345", string(responses[0].Content))
+
+	// beyond the max bytes read of the grant
+	responses = downloadAll(ctx, s, &kusciaapi.DownloadDomainDataRequest{
+		DomainId:     mockDomainID,
+		DomaindataId: "result",
+		Offset:       4,
+	})
+	assert.Len(t, responses, 1)
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted), responses[0].Status.Code)
+
+	// the grant filtering the rows
+	grant.Spec.Limit.MaxRowsRead = 1
+	_, err = conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(mockDomainID).Update(context.Background(), grant, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	responses = downloadAll(ctx, s, &kusciaapi.DownloadDomainDataRequest{
+		DomainId:     mockDomainID,
+		DomaindataId: "result",
+		LimitBytes:   6,
+	})
+	assert.Len(t, responses, 1)
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted), responses[0].Status.Code)
+	grant.Spec.Limit.MaxRowsRead = 0
+
+	// the grant without file mode
+	grant.Spec.Limit.GrantMode = []v1alpha1.GrantType{v1alpha1.GrantNormal}
+	_, err = conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(mockDomainID).Update(context.Background(), grant, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	responses = downloadAll(ctx, s, &kusciaapi.DownloadDomainDataRequest{
+		DomainId:     mockDomainID,
+		DomaindataId: "result",
+		LimitBytes:   6,
+	})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted), responses[0].Status.Code)
+}
@@ -31,7 +31,9 @@ import (
 	"github.com/secretflow/kuscia/pkg/kusciaapi/errorcode"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/resources"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/pkg/web/utils"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
@@ -43,6 +45,8 @@ type IDomainDataGrantService interface {
 	DeleteDomainDataGrant(ctx context.Context, request *kusciaapi.DeleteDomainDataGrantRequest) *kusciaapi.DeleteDomainDataGrantResponse
 	BatchQueryDomainDataGrant(ctx context.Context, request *kusciaapi.BatchQueryDomainDataGrantRequest) *kusciaapi.BatchQueryDomainDataGrantResponse
 	ListDomainDataGrant(ctx context.Context, request *kusciaapi.ListDomainDataGrantRequest) *kusciaapi.ListDomainDataGrantResponse
+	ApproveDomainDataGrant(ctx context.Context, request *kusciaapi.ApproveDomainDataGrantRequest) *kusciaapi.ApproveDomainDataGrantResponse
+	RejectDomainDataGrant(ctx context.Context, request *kusciaapi.RejectDomainDataGrantRequest) *kusciaapi.RejectDomainDataGrantResponse
 }
 
 type domainDataGrantService struct {
@@ -62,7 +66,13 @@ func (s *domainDataGrantService) CreateDomainDataGrant(ctx context.Context, requ
 	// do validate
 	if validateErr := validateCreateDomainDataGrantRequest(request); validateErr != nil {
 		return &kusciaapi.CreateDomainDataGrantResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, validateErr.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, validateErr),
+		}
+	}
+	// auth pre handler
+	if err := tenant.CheckDomains(ctx, request.DomainId); err != nil {
+		return &kusciaapi.CreateDomainDataGrantResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 
@@ -93,13 +103,19 @@ func (s *domainDataGrantService) CreateDomainDataGrant(ctx context.Context, requ
 		Description:       request.Description,
 		Signature:         request.Signature,
 		DomainId:          request.DomainId,
+		Compliance:        request.Compliance,
 	}, dg)
+	if status := s.checkGrantPolicy(ctx, dg); status != nil {
+		return &kusciaapi.CreateDomainDataGrantResponse{
+			Status: status,
+		}
+	}
 
 	dg, err = s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(request.DomainId).Create(ctx, dg, metav1.CreateOptions{})
 	if err != nil {
 		nlog.Errorf("CreateDomainDataGrant failed, error:%s", err.Error())
 		return &kusciaapi.CreateDomainDataGrantResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.CreateDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainDataGrant), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.CreateDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainDataGrant), err),
 		}
 	}
 	return &kusciaapi.CreateDomainDataGrantResponse{
@@ -111,17 +127,24 @@ func (s *domainDataGrantService) CreateDomainDataGrant(ctx context.Context, requ
 }
 
 func (s *domainDataGrantService) QueryDomainDataGrant(ctx context.Context, request *kusciaapi.QueryDomainDataGrantRequest) *kusciaapi.QueryDomainDataGrantResponse {
+	// auth pre handler
+	if err := tenant.CheckDomains(ctx, request.DomainId); err != nil {
+		return &kusciaapi.QueryDomainDataGrantResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
 
 	dg, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(request.DomainId).Get(ctx, request.DomaindatagrantId, metav1.GetOptions{})
 	if err != nil {
 		nlog.Errorf("Query DomainDataGrant failed, error:%s", err.Error())
 		return &kusciaapi.QueryDomainDataGrantResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainDataGrant), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainDataGrant), err),
 		}
 	}
 
 	grant := &kusciaapi.DomainDataGrant{}
 	s.convertSpec2Data(dg, grant)
+	s.fillMaskingPolicies(ctx, dg, grant.Data)
 	return &kusciaapi.QueryDomainDataGrantResponse{
 		Status: utils.BuildSuccessResponseStatus(),
 		Data:   grant,
@@ -193,6 +216,12 @@ func (s *domainDataGrantService) UpdateDomainDataGrant(ctx context.Context, requ
 			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "domaindataid can't be null"),
 		}
 	}
+	// auth pre handler
+	if err := tenant.CheckDomains(ctx, request.DomainId); err != nil {
+		return &kusciaapi.UpdateDomainDataGrantResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
 	dd, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDatas(request.DomainId).Get(ctx, request.DomaindataId, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.UpdateDomainDataGrantResponse{
@@ -209,12 +238,22 @@ func (s *domainDataGrantService) UpdateDomainDataGrant(ctx context.Context, requ
 			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "grantdomainid can't be null"),
 		}
 	}
+	if err := validateGrantLimit(request.Limit); err != nil {
+		return &kusciaapi.UpdateDomainDataGrantResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	if err := validateGrantCompliance(request.Compliance); err != nil {
+		return &kusciaapi.UpdateDomainDataGrantResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
 
 	dg, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(request.DomainId).Get(ctx, request.DomaindatagrantId, metav1.GetOptions{})
 	if err != nil {
 		nlog.Errorf("Get DomainDataGrant failed, error:%s", err.Error())
 		return &kusciaapi.UpdateDomainDataGrantResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainDataGrant), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainDataGrant), err),
 		}
 	}
 
@@ -234,7 +273,13 @@ func (s *domainDataGrantService) UpdateDomainDataGrant(ctx context.Context, requ
 		Description:       request.Description,
 		Signature:         request.Signature,
 		DomainId:          request.DomainId,
+		Compliance:        request.Compliance,
 	}, dg)
+	if status := s.checkGrantPolicy(ctx, dg); status != nil {
+		return &kusciaapi.UpdateDomainDataGrantResponse{
+			Status: status,
+		}
+	}
 
 	if dg.Labels == nil {
 		dg.Labels = map[string]string{}
@@ -245,7 +290,7 @@ func (s *domainDataGrantService) UpdateDomainDataGrant(ctx context.Context, requ
 	if err != nil {
 		nlog.Errorf("Update DomainDataGrant failed, error:%s", err.Error())
 		return &kusciaapi.UpdateDomainDataGrantResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomainDataGrant), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomainDataGrant), err),
 		}
 	}
 	return &kusciaapi.UpdateDomainDataGrantResponse{
@@ -254,12 +299,18 @@ func (s *domainDataGrantService) UpdateDomainDataGrant(ctx context.Context, requ
 }
 
 func (s *domainDataGrantService) DeleteDomainDataGrant(ctx context.Context, request *kusciaapi.DeleteDomainDataGrantRequest) *kusciaapi.DeleteDomainDataGrantResponse {
+	// auth pre handler
+	if err := tenant.CheckDomains(ctx, request.DomainId); err != nil {
+		return &kusciaapi.DeleteDomainDataGrantResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
 	nlog.Warnf("Delete domainDataGrantId %s", request.DomaindatagrantId)
 	err := s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(request.DomainId).Delete(ctx, request.DomaindatagrantId, metav1.DeleteOptions{})
 	if err != nil {
 		nlog.Errorf("Delete domainDataGrantId:%s failed, detail:%s", request.DomaindatagrantId, err.Error())
 		return &kusciaapi.DeleteDomainDataGrantResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomainDataGrant), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomainDataGrant), err),
 		}
 	}
 	return &kusciaapi.DeleteDomainDataGrantResponse{
@@ -274,6 +325,12 @@ func (s *domainDataGrantService) ListDomainDataGrant(ctx context.Context, reques
 			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "domain id can not be empty"),
 		}
 	}
+	// auth pre handler
+	if err := tenant.CheckDomains(ctx, request.Data.DomainId); err != nil {
+		return &kusciaapi.ListDomainDataGrantResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
 	// construct label selector
 	var (
 		selector    fields.Selector
@@ -311,13 +368,14 @@ func (s *domainDataGrantService) ListDomainDataGrant(ctx context.Context, reques
 	if err != nil {
 		nlog.Errorf("List DomainData failed, error:%s", err.Error())
 		return &kusciaapi.ListDomainDataGrantResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrListDomainDataFailed), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrListDomainDataFailed), err),
 		}
 	}
 	grantLists := make([]*kusciaapi.DomainDataGrant, len(dataList.Items))
 	for i, v := range dataList.Items {
 		grant := &kusciaapi.DomainDataGrant{}
 		s.convertSpec2Data(&v, grant)
+		s.fillMaskingPolicies(ctx, &v, grant.Data)
 		grantLists[i] = grant
 	}
 
@@ -330,17 +388,87 @@ func (s *domainDataGrantService) ListDomainDataGrant(ctx context.Context, reques
 	}
 }
 
+func (s *domainDataGrantService) ApproveDomainDataGrant(ctx context.Context, request *kusciaapi.ApproveDomainDataGrantRequest) *kusciaapi.ApproveDomainDataGrantResponse {
+	return &kusciaapi.ApproveDomainDataGrantResponse{
+		Status: s.decideDomainDataGrant(ctx, request.DomainId, request.DomaindatagrantId, &v1alpha1.GrantApproval{
+			Decision: v1alpha1.GrantApproved,
+			Operator: request.Operator,
+			Comment:  request.Comment,
+		}),
+	}
+}
+
+func (s *domainDataGrantService) RejectDomainDataGrant(ctx context.Context, request *kusciaapi.RejectDomainDataGrantRequest) *kusciaapi.RejectDomainDataGrantResponse {
+	return &kusciaapi.RejectDomainDataGrantResponse{
+		Status: s.decideDomainDataGrant(ctx, request.DomainId, request.DomaindatagrantId, &v1alpha1.GrantApproval{
+			Decision: v1alpha1.GrantApprovalRejected,
+			Operator: request.Operator,
+			Comment:  request.Comment,
+		}),
+	}
+}
+
+// decideDomainDataGrant records the decision on the current spec of the grant, the controller replicates
+// the approved grant to the grant domain, and removes the copies of the rejected one.
+func (s *domainDataGrantService) decideDomainDataGrant(ctx context.Context, domainID, grantID string, approval *v1alpha1.GrantApproval) *pbv1alpha1.Status {
+	if domainID == "" || grantID == "" {
+		return utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "domain id and domaindatagrant id can't be null")
+	}
+	// auth pre handler
+	if err := tenant.CheckDomains(ctx, domainID); err != nil {
+		return utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err)
+	}
+	domain, err := s.conf.KusciaClient.KusciaV1alpha1().Domains().Get(ctx, domainID, metav1.GetOptions{})
+	if err != nil {
+		return utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrQueryDomain, err)
+	}
+	if domain.Spec.DataGrantApproval != v1alpha1.DataGrantApprovalManual {
+		return utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+			fmt.Sprintf("domain %s approves the grants automatically", domainID))
+	}
+
+	dg, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(domainID).Get(ctx, grantID, metav1.GetOptions{})
+	if err != nil {
+		nlog.Errorf("Get DomainDataGrant failed, error:%s", err.Error())
+		return utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainDataGrant), err)
+	}
+	if dg.Spec.Author != domainID {
+		return utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+			fmt.Sprintf("domaindatagrant %s is authored by %s, only the author can approve or reject it", grantID, dg.Spec.Author))
+	}
+
+	dg = dg.DeepCopy()
+	approval.Generation = dg.Generation
+	approval.DecisionTime = metav1.Now()
+	dg.Status.Approval = approval
+	if _, err = s.conf.KusciaClient.KusciaV1alpha1().DomainDataGrants(domainID).UpdateStatus(ctx, dg, metav1.UpdateOptions{}); err != nil {
+		nlog.Errorf("Update DomainDataGrant %s/%s approval failed, error:%s", domainID, grantID, err.Error())
+		return utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataGrantErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomainDataGrant), err)
+	}
+	nlog.Infof("DomainDataGrant %s/%s is %s by %q", domainID, grantID, approval.Decision, approval.Operator)
+	return utils.BuildSuccessResponseStatus()
+}
+
 func (s *domainDataGrantService) convertData2Spec(data *kusciaapi.DomainDataGrantData, v *v1alpha1.DomainDataGrant) {
 	var limit *v1alpha1.GrantLimit
 	if data.Limit != nil {
-		grantMode := []v1alpha1.GrantType{"normal"}
 		limit = &v1alpha1.GrantLimit{
-			FlowID:      data.Limit.FlowId,
-			UseCount:    int(data.Limit.UseCount),
-			Initiator:   data.Limit.Initiator,
-			InputConfig: data.Limit.InputConfig,
-			Components:  data.Limit.Components,
-			GrantMode:   grantMode,
+			FlowID:         data.Limit.FlowId,
+			UseCount:       int(data.Limit.UseCount),
+			Initiator:      data.Limit.Initiator,
+			InputConfig:    data.Limit.InputConfig,
+			Components:     data.Limit.Components,
+			GrantMode:      resources.GrantModesFromStrings(data.Limit.GrantMode),
+			MaxBytesRead:   data.Limit.MaxBytesRead,
+			MaxRowsRead:    data.Limit.MaxRowsRead,
+			SamplePercent:  data.Limit.SamplePercent,
+			AllowedColumns: data.Limit.AllowedColumns,
+		}
+		for _, c := range data.Limit.AllowedComponents {
+			limit.AllowedComponents = append(limit.AllowedComponents, v1alpha1.AllowedComponent{
+				Name:     c.Name,
+				Versions: c.Versions,
+			})
 		}
 		if data.Limit.ExpirationTime > 0 {
 			mt := metav1.NewTime(time.Unix(data.Limit.ExpirationTime/int64(time.Second), data.Limit.ExpirationTime%int64(time.Second)))
@@ -366,7 +494,82 @@ func (s *domainDataGrantService) convertData2Spec(data *kusciaapi.DomainDataGran
 		GrantDomain:  data.GrantDomain,
 		Limit:        limit,
 		Description:  data.Description,
+		Compliance:   common.Convert2KubeGrantCompliance(data.Compliance),
+	}
+}
+
+// checkGrantPolicy evaluates the grant policy on the grant and records the decision in its annotations.
+func (s *domainDataGrantService) checkGrantPolicy(ctx context.Context, v *v1alpha1.DomainDataGrant) *pbv1alpha1.Status {
+	decision, err := s.conf.GrantPolicies.Evaluate(ctx, grantPolicyInput(v))
+	if err != nil {
+		nlog.Errorf("Evaluate grant policy on DomainDataGrant %s/%s failed, error:%s", v.Spec.Author, v.Name, err.Error())
+		return utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrGrantPolicyRejected, err)
 	}
+	if decision == nil {
+		return nil
+	}
+	if !decision.Allowed {
+		nlog.Infof("DomainDataGrant %s/%s is rejected by the %s grant policy: %s", v.Spec.Author, v.Name, decision.Engine, decision.Reason)
+		return utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrGrantPolicyRejected, fmt.Sprintf("rejected by the %s grant policy: %s", decision.Engine, decision.Reason))
+	}
+	if v.Annotations == nil {
+		v.Annotations = map[string]string{}
+	}
+	v.Annotations[common.GrantPolicyDecisionAnnotationKey] = decision.Annotation()
+	return nil
+}
+
+// grantPolicyInput describes the grant to the policy engines with the field names of the api.
+func grantPolicyInput(v *v1alpha1.DomainDataGrant) map[string]interface{} {
+	compliance := map[string]interface{}{
+		"purpose":         "",
+		"legal_basis":     "",
+		"data_categories": []string{},
+		"retention_days":  int64(0),
+	}
+	if c := v.Spec.Compliance; c != nil {
+		compliance["purpose"] = c.Purpose
+		compliance["legal_basis"] = c.LegalBasis
+		if c.DataCategories != nil {
+			compliance["data_categories"] = c.DataCategories
+		}
+		compliance["retention_days"] = int64(c.RetentionDays)
+	}
+	input := map[string]interface{}{
+		"domaindatagrant_id": v.Name,
+		"author":             v.Spec.Author,
+		"domaindata_id":      v.Spec.DomainDataID,
+		"grant_domain":       v.Spec.GrantDomain,
+		"description":        v.Spec.Description,
+		"compliance":         compliance,
+	}
+	if l := v.Spec.Limit; l != nil {
+		limit := map[string]interface{}{
+			"flow_id":         l.FlowID,
+			"use_count":       int64(l.UseCount),
+			"initiator":       l.Initiator,
+			"components":      l.Components,
+			"max_bytes_read":  l.MaxBytesRead,
+			"max_rows_read":   l.MaxRowsRead,
+			"sample_percent":  int64(l.SamplePercent),
+			"allowed_columns": l.AllowedColumns,
+		}
+		if l.ExpirationTime != nil {
+			limit["expiration_time"] = l.ExpirationTime.UnixNano()
+		}
+		input["limit"] = limit
+	}
+	return input
+}
+
+// fillMaskingPolicies shows the masking policies of the domaindata applied to the grant domain.
+func (s *domainDataGrantService) fillMaskingPolicies(ctx context.Context, v *v1alpha1.DomainDataGrant, data *kusciaapi.DomainDataGrantData) {
+	dd, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDatas(v.Namespace).Get(ctx, v.Spec.DomainDataID, metav1.GetOptions{})
+	if err != nil {
+		nlog.Warnf("Get DomainData %s/%s of DomainDataGrant %s failed, error:%s", v.Namespace, v.Spec.DomainDataID, v.Name, err.Error())
+		return
+	}
+	data.MaskingPolicies = common.Convert2PbMaskingPolicies(resources.MaskingPoliciesForDomain(&dd.Spec, v.Spec.GrantDomain))
 }
 
 func (s *domainDataGrantService) convertSpec2Data(v *v1alpha1.DomainDataGrant, grant *kusciaapi.DomainDataGrant) {
@@ -378,17 +581,34 @@ func (s *domainDataGrantService) convertSpec2Data(v *v1alpha1.DomainDataGrant, g
 	data.DomaindataId = v.Spec.DomainDataID
 	data.DomaindatagrantId = v.Name
 	data.GrantDomain = v.Spec.GrantDomain
+	data.Signature = v.Spec.Signature
+	data.Description = v.Spec.Description
+	data.DomainId = v.Namespace
+	data.Compliance = common.Convert2PbGrantCompliance(v.Spec.Compliance)
 
+	data.Limit = nil
 	if v.Spec.Limit != nil {
 		data.Limit = &kusciaapi.GrantLimit{
-			Components:  v.Spec.Limit.Components,
-			FlowId:      v.Spec.Limit.FlowID,
-			UseCount:    int32(v.Spec.Limit.UseCount),
-			InputConfig: v.Spec.Limit.InputConfig,
+			Components:     v.Spec.Limit.Components,
+			FlowId:         v.Spec.Limit.FlowID,
+			UseCount:       int32(v.Spec.Limit.UseCount),
+			Initiator:      v.Spec.Limit.Initiator,
+			InputConfig:    v.Spec.Limit.InputConfig,
+			GrantMode:      resources.GrantModesToStrings(v.Spec.Limit.GrantMode),
+			MaxBytesRead:   v.Spec.Limit.MaxBytesRead,
+			MaxRowsRead:    v.Spec.Limit.MaxRowsRead,
+			SamplePercent:  v.Spec.Limit.SamplePercent,
+			AllowedColumns: v.Spec.Limit.AllowedColumns,
 		}
 		if v.Spec.Limit.ExpirationTime != nil {
 			data.Limit.ExpirationTime = v.Spec.Limit.ExpirationTime.UnixNano()
 		}
+		for _, c := range v.Spec.Limit.AllowedComponents {
+			data.Limit.AllowedComponents = append(data.Limit.AllowedComponents, &kusciaapi.AllowedComponent{
+				Name:     c.Name,
+				Versions: c.Versions,
+			})
+		}
 	}
 
 	if grant.Status == nil {
@@ -396,6 +616,15 @@ func (s *domainDataGrantService) convertSpec2Data(v *v1alpha1.DomainDataGrant, g
 	}
 	grant.Status.Phase = string(v.Status.Phase)
 	grant.Status.Message = v.Status.Message
+	grant.Status.Approval = nil
+	if approval := v.Status.Approval; approval != nil {
+		grant.Status.Approval = &kusciaapi.GrantApproval{
+			Decision:     string(approval.Decision),
+			Operator:     approval.Operator,
+			Comment:      approval.Comment,
+			DecisionTime: approval.DecisionTime.UnixNano(),
+		}
+	}
 	grant.Status.Records = nil
 	for _, r := range v.Status.UseRecords {
 		grant.Status.Records = append(grant.Status.Records, &kusciaapi.UseRecord{
@@ -410,27 +639,76 @@ func (s *domainDataGrantService) convertSpec2Data(v *v1alpha1.DomainDataGrant, g
 func validateCreateDomainDataGrantRequest(request *kusciaapi.CreateDomainDataGrantRequest) error {
 
 	if request.GrantDomain == "" {
-		return fmt.Errorf("grantdomain can't be null")
+		return utils.NewFieldViolation("grant_domain", "grantdomain can't be null")
 	}
 
 	if request.GrantDomain == request.DomainId {
-		return fmt.Errorf("grantdomain can't be self")
+		return utils.NewFieldViolation("grant_domain", "grantdomain can't be self")
 	}
 
 	if request.DomaindataId == "" {
-		return fmt.Errorf("domaindata can't be null")
+		return utils.NewFieldViolation("domaindata_id", "domaindata can't be null")
 	}
 	// do k8s validate
 	if err := resources.ValidateK8sName(request.DomainId, "domain_id"); err != nil {
-		return err
+		return utils.NewFieldViolation("domain_id", "%s", err.Error())
 	}
 
 	if err := resources.ValidateK8sName(request.DomaindataId, "domaindata_id"); err != nil {
-		return err
+		return utils.NewFieldViolation("domaindata_id", "%s", err.Error())
 	}
 
 	if request.GetDomaindatagrantId() != "" {
-		return resources.ValidateK8sName(request.GetDomaindatagrantId(), "domaindatagrant_id")
+		if err := resources.ValidateK8sName(request.GetDomaindatagrantId(), "domaindatagrant_id"); err != nil {
+			return utils.NewFieldViolation("domaindatagrant_id", "%s", err.Error())
+		}
+	}
+	if err := validateGrantCompliance(request.Compliance); err != nil {
+		return err
+	}
+	return validateGrantLimit(request.Limit)
+}
+
+func validateGrantCompliance(compliance *pbv1alpha1.GrantCompliance) error {
+	if compliance == nil {
+		return nil
+	}
+	if compliance.RetentionDays < 0 {
+		return utils.NewFieldViolation("compliance.retention_days", "retention days can't be negative")
+	}
+	for i, c := range compliance.DataCategories {
+		if c == "" {
+			return utils.NewFieldViolation(fmt.Sprintf("compliance.data_categories[%d]", i), "data category can't be empty")
+		}
+	}
+	return nil
+}
+
+func validateGrantLimit(limit *kusciaapi.GrantLimit) error {
+	if limit == nil {
+		return nil
+	}
+	if err := resources.ValidateGrantModes(limit.GrantMode); err != nil {
+		return utils.NewFieldViolation("limit.grant_mode", "%s", err.Error())
+	}
+	if limit.MaxBytesRead < 0 {
+		return utils.NewFieldViolation("limit.max_bytes_read", "max bytes read can't be negative")
+	}
+	if limit.MaxRowsRead < 0 {
+		return utils.NewFieldViolation("limit.max_rows_read", "max rows read can't be negative")
+	}
+	if limit.SamplePercent < 0 || limit.SamplePercent > 100 {
+		return utils.NewFieldViolation("limit.sample_percent", "sample percent must be in [0, 100]")
+	}
+	for i, c := range limit.AllowedColumns {
+		if c == "" {
+			return utils.NewFieldViolation(fmt.Sprintf("limit.allowed_columns[%d]", i), "allowed column can't be empty")
+		}
+	}
+	for i, c := range limit.AllowedComponents {
+		if c.Name == "" {
+			return utils.NewFieldViolation(fmt.Sprintf("limit.allowed_components[%d].name", i), "allowed component name can't be empty")
+		}
 	}
 	return nil
 }
@@ -18,11 +18,20 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/grantpolicy"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
@@ -141,3 +150,158 @@ func TestDomainDataGrant(t *testing.T) {
 	})
 	assert.Equal(t, deleteRes.Status.Code, int32(0))
 }
+
+func TestDomainDataGrantTenant(t *testing.T) {
+	s := NewDomainDataGrantService(&config.KusciaAPIConfig{})
+	ctx := context.WithValue(context.Background(), consts.AuthTenant, &tenant.Tenant{Name: "bob", Domains: []string{"bob"}})
+
+	queryRes := s.QueryDomainDataGrant(ctx, &kusciaapi.QueryDomainDataGrantRequest{DomainId: "alice", DomaindatagrantId: "grant-1"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed), queryRes.Status.Code)
+	listRes := s.ListDomainDataGrant(ctx, &kusciaapi.ListDomainDataGrantRequest{Data: &kusciaapi.ListDomainDataGrantRequestData{DomainId: "alice"}})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed), listRes.Status.Code)
+	deleteRes := s.DeleteDomainDataGrant(ctx, &kusciaapi.DeleteDomainDataGrantRequest{DomainId: "alice", DomaindatagrantId: "grant-1"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed), deleteRes.Status.Code)
+}
+
+func TestApproveDomainDataGrant(t *testing.T) {
+	grant := &v1alpha1.DomainDataGrant{
+		ObjectMeta: v1.ObjectMeta{Name: "grant-1", Namespace: "alice", Generation: 2},
+		Spec:       v1alpha1.DomainDataGrantSpec{Author: "alice", DomainDataID: "data-1", GrantDomain: "bob"},
+	}
+	copied := grant.DeepCopy()
+	copied.Namespace = "bob"
+	kusciaClient := kusciafake.NewSimpleClientset(
+		&v1alpha1.Domain{ObjectMeta: v1.ObjectMeta{Name: "alice"}, Spec: v1alpha1.DomainSpec{DataGrantApproval: v1alpha1.DataGrantApprovalManual}},
+		&v1alpha1.Domain{ObjectMeta: v1.ObjectMeta{Name: "bob"}},
+		grant, copied)
+	s := NewDomainDataGrantService(&config.KusciaAPIConfig{KusciaClient: kusciaClient})
+	ctx := context.Background()
+
+	res := s.ApproveDomainDataGrant(ctx, &kusciaapi.ApproveDomainDataGrantRequest{DomainId: "alice", DomaindatagrantId: "grant-1", Operator: "governance"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	queryRes := s.QueryDomainDataGrant(ctx, &kusciaapi.QueryDomainDataGrantRequest{DomainId: "alice", DomaindatagrantId: "grant-1"})
+	assert.Equal(t, string(v1alpha1.GrantApproved), queryRes.Data.Status.Approval.Decision)
+	assert.Equal(t, "governance", queryRes.Data.Status.Approval.Operator)
+	dg, err := kusciaClient.KusciaV1alpha1().DomainDataGrants("alice").Get(ctx, "grant-1", v1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), dg.Status.Approval.Generation)
+
+	rejectRes := s.RejectDomainDataGrant(ctx, &kusciaapi.RejectDomainDataGrantRequest{DomainId: "alice", DomaindatagrantId: "grant-1", Comment: "no"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, rejectRes.Status.Code)
+	dg, err = kusciaClient.KusciaV1alpha1().DomainDataGrants("alice").Get(ctx, "grant-1", v1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.GrantApprovalRejected, dg.Status.Approval.Decision)
+
+	// the grant domain can't approve the copy of the grant
+	res = s.ApproveDomainDataGrant(ctx, &kusciaapi.ApproveDomainDataGrantRequest{DomainId: "bob", DomaindatagrantId: "grant-1"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+	res = s.ApproveDomainDataGrant(ctx, &kusciaapi.ApproveDomainDataGrantRequest{DomainId: "alice"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+}
+
+func TestCreateDomainDataGrant_GrantPolicy(t *testing.T) {
+	policy, err := grantpolicy.New(&grantpolicy.Config{Rules: []grantpolicy.Rule{{
+		Name:       "retention",
+		Expression: `grant.compliance.retention_days > 0 && grant.compliance.retention_days <= 90`,
+		Message:    "retention must be within 90 days",
+	}}})
+	assert.NoError(t, err)
+	kusciaClient := kusciafake.NewSimpleClientset(&v1alpha1.DomainData{ObjectMeta: v1.ObjectMeta{Name: "data-1", Namespace: "alice"}})
+	s := NewDomainDataGrantService(&config.KusciaAPIConfig{KusciaClient: kusciaClient, GrantPolicies: policy})
+	ctx := context.Background()
+
+	res := s.CreateDomainDataGrant(ctx, &kusciaapi.CreateDomainDataGrantRequest{
+		DomainId:          "alice",
+		DomaindataId:      "data-1",
+		DomaindatagrantId: "grant-1",
+		GrantDomain:       "bob",
+		Compliance:        &pbv1alpha1.GrantCompliance{Purpose: "marketing", RetentionDays: 365},
+	})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrGrantPolicyRejected), res.Status.Code)
+	assert.Contains(t, res.Status.Message, "retention must be within 90 days")
+
+	res = s.CreateDomainDataGrant(ctx, &kusciaapi.CreateDomainDataGrantRequest{
+		DomainId:          "alice",
+		DomaindataId:      "data-1",
+		DomaindatagrantId: "grant-1",
+		GrantDomain:       "bob",
+		Compliance:        &pbv1alpha1.GrantCompliance{Purpose: "marketing", DataCategories: []string{"contact"}, RetentionDays: 30},
+	})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	dg, err := kusciaClient.KusciaV1alpha1().DomainDataGrants("alice").Get(ctx, "grant-1", v1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, dg.Annotations[common.GrantPolicyDecisionAnnotationKey], `"allowed":true`)
+	assert.Equal(t, int32(30), dg.Spec.Compliance.RetentionDays)
+
+	updateRes := s.UpdateDomainDataGrant(ctx, &kusciaapi.UpdateDomainDataGrantRequest{
+		DomainId:          "alice",
+		DomaindataId:      "data-1",
+		DomaindatagrantId: "grant-1",
+		GrantDomain:       "bob",
+	})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrGrantPolicyRejected), updateRes.Status.Code)
+
+	res = s.CreateDomainDataGrant(ctx, &kusciaapi.CreateDomainDataGrantRequest{
+		DomainId:     "alice",
+		DomaindataId: "data-1",
+		GrantDomain:  "bob",
+		Compliance:   &pbv1alpha1.GrantCompliance{RetentionDays: -1},
+	})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+}
+
+func TestDomainDataGrantConvertRoundTrip(t *testing.T) {
+	s := &domainDataGrantService{}
+	data := &kusciaapi.DomainDataGrantData{
+		DomaindatagrantId: "grant-1",
+		Author:            "alice",
+		DomaindataId:      "data-1",
+		GrantDomain:       "bob",
+		Limit: &kusciaapi.GrantLimit{
+			ExpirationTime: time.Now().Add(time.Hour).UnixNano(),
+			UseCount:       3,
+			FlowId:         "flow-1",
+			Components:     []string{"psi"},
+			Initiator:      "alice",
+			InputConfig:    "{}",
+			GrantMode:      []string{string(v1alpha1.GrantMetadata), string(v1alpha1.GrantFile)},
+			MaxBytesRead:   1 << 20,
+			MaxRowsRead:    1000,
+			SamplePercent:  10,
+			AllowedColumns: []string{"id", "age"},
+			AllowedComponents: []*kusciaapi.AllowedComponent{
+				{Name: "psi", Versions: []string{"1.0.0", "1.1.0"}},
+				{Name: "mpc"},
+			},
+		},
+		Description: map[string]string{"usage": "test"},
+		Signature:   "sign",
+		DomainId:    "alice",
+		Compliance: &pbv1alpha1.GrantCompliance{
+			Purpose:        "marketing",
+			LegalBasis:     "consent",
+			DataCategories: []string{"contact"},
+			RetentionDays:  30,
+		},
+	}
+	dg := &v1alpha1.DomainDataGrant{ObjectMeta: v1.ObjectMeta{Namespace: "alice"}}
+	s.convertData2Spec(data, dg)
+	grant := &kusciaapi.DomainDataGrant{}
+	s.convertSpec2Data(dg, grant)
+	assert.True(t, proto.Equal(data, grant.Data), "got %v", grant.Data)
+
+	// grant mode defaults to normal
+	s.convertData2Spec(&kusciaapi.DomainDataGrantData{Limit: &kusciaapi.GrantLimit{}}, dg)
+	assert.Equal(t, []v1alpha1.GrantType{v1alpha1.GrantNormal}, dg.Spec.Limit.GrantMode)
+}
+
+func TestValidateGrantLimit(t *testing.T) {
+	assert.NoError(t, validateGrantLimit(nil))
+	assert.NoError(t, validateGrantLimit(&kusciaapi.GrantLimit{GrantMode: []string{"normal"}}))
+	assert.Error(t, validateGrantLimit(&kusciaapi.GrantLimit{GrantMode: []string{"all"}}))
+	assert.Error(t, validateGrantLimit(&kusciaapi.GrantLimit{MaxBytesRead: -1}))
+	assert.Error(t, validateGrantLimit(&kusciaapi.GrantLimit{MaxRowsRead: -1}))
+	assert.Error(t, validateGrantLimit(&kusciaapi.GrantLimit{SamplePercent: 101}))
+	assert.Error(t, validateGrantLimit(&kusciaapi.GrantLimit{AllowedColumns: []string{""}}))
+	assert.Error(t, validateGrantLimit(&kusciaapi.GrantLimit{AllowedComponents: []*kusciaapi.AllowedComponent{{Versions: []string{"1.0.0"}}}}))
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/resources"
 	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/pkg/web/utils"
 	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
@@ -48,6 +49,7 @@ type IDomainDataService interface {
 	QueryDomainData(ctx context.Context, request *kusciaapi.QueryDomainDataRequest) *kusciaapi.QueryDomainDataResponse
 	BatchQueryDomainData(ctx context.Context, request *kusciaapi.BatchQueryDomainDataRequest) *kusciaapi.BatchQueryDomainDataResponse
 	ListDomainData(ctx context.Context, request *kusciaapi.ListDomainDataRequest) *kusciaapi.ListDomainDataResponse
+	SetDomainDataMaskingPolicies(ctx context.Context, request *kusciaapi.SetDomainDataMaskingPoliciesRequest) *kusciaapi.SetDomainDataMaskingPoliciesResponse
 }
 
 type domainDataService struct {
@@ -69,7 +71,7 @@ func (s domainDataService) CreateDomainData(ctx context.Context, request *kuscia
 	}
 	if err := resources.ValidateK8sName(request.DomainId, "domain_id"); err != nil {
 		return &kusciaapi.CreateDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// validate data type
@@ -87,7 +89,7 @@ func (s domainDataService) CreateDomainData(ctx context.Context, request *kuscia
 	// validate lite request
 	if err := s.validateRequestWhenLite(request); err != nil {
 		return &kusciaapi.CreateDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -96,7 +98,7 @@ func (s domainDataService) CreateDomainData(ctx context.Context, request *kuscia
 		// do k8s validate
 		if err := resources.ValidateK8sName(request.DomaindataId, "domaindata_id"); err != nil {
 			return &kusciaapi.CreateDomainDataResponse{
-				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 			}
 		}
 		domainData, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDatas(request.DomainId).Get(ctx, request.DomaindataId, metav1.GetOptions{})
@@ -109,7 +111,7 @@ func (s domainDataService) CreateDomainData(ctx context.Context, request *kuscia
 	// auth pre handler
 	if err := s.authHandler(ctx, request); err != nil {
 		return &kusciaapi.CreateDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// normalization request
@@ -163,7 +165,7 @@ func (s domainDataService) CreateDomainData(ctx context.Context, request *kuscia
 	if err != nil {
 		nlog.Errorf("CreateDomainData failed, error: %s", err.Error())
 		return &kusciaapi.CreateDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.CreateDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainDataFailed), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.CreateDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainDataFailed), err),
 		}
 	}
 	return &kusciaapi.CreateDomainDataResponse{
@@ -184,13 +186,13 @@ func (s domainDataService) UpdateDomainData(ctx context.Context, request *kuscia
 
 	if err := s.validateRequestWhenLite(request); err != nil {
 		return &kusciaapi.UpdateDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// auth pre handler
 	if err := s.authHandler(ctx, request); err != nil {
 		return &kusciaapi.UpdateDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// get original domainData from k8s
@@ -198,7 +200,7 @@ func (s domainDataService) UpdateDomainData(ctx context.Context, request *kuscia
 	if err != nil {
 		nlog.Errorf("UpdateDomainData failed, error: %s", err.Error())
 		return &kusciaapi.UpdateDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed), err),
 		}
 	}
 
@@ -241,6 +243,8 @@ func (s domainDataService) UpdateDomainData(ctx context.Context, request *kuscia
 			Vendor:      customVendor,
 			Author:      request.DomainId,
 			FileFormat:  common.Convert2KubeFileFormat(request.FileFormat),
+			// the masking policies are only changed by SetDomainDataMaskingPolicies
+			MaskingPolicies: originalDomainData.Spec.MaskingPolicies,
 		},
 	}
 	// merge modifiedDomainData to originalDomainData
@@ -249,7 +253,7 @@ func (s domainDataService) UpdateDomainData(ctx context.Context, request *kuscia
 		nlog.Errorf("Merge DomainData failed, request: %+v,error: %s.",
 			request, err.Error())
 		return &kusciaapi.UpdateDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrMergeDomainDataFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrMergeDomainDataFailed, err),
 		}
 	}
 	nlog.Debugf("Update DomainData request: %+v, patchBytes: %s, originalDomainData: %s, modifiedDomainData: %s.",
@@ -260,7 +264,7 @@ func (s domainDataService) UpdateDomainData(ctx context.Context, request *kuscia
 		nlog.Debugf("Patch DomainData failed, request: %+v, patchBytes: %s, originalDomainData: %s, modifiedDomainData: %s, error: %s.",
 			request, patchBytes, originalBytes, modifiedBytes, err.Error())
 		return &kusciaapi.UpdateDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrPatchDomainDataFailed), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrPatchDomainDataFailed), err),
 		}
 	}
 	// construct the response
@@ -278,13 +282,13 @@ func (s domainDataService) DeleteDomainData(ctx context.Context, request *kuscia
 	}
 	if err := s.validateRequestWhenLite(request); err != nil {
 		return &kusciaapi.DeleteDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// auth pre handler
 	if err := s.authHandler(ctx, request); err != nil {
 		return &kusciaapi.DeleteDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// record the delete operation
@@ -294,7 +298,7 @@ func (s domainDataService) DeleteDomainData(ctx context.Context, request *kuscia
 	if err != nil {
 		nlog.Errorf("Delete domainData: %s failed, detail: %s", request.DomaindataId, err.Error())
 		return &kusciaapi.DeleteDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomainDataFailed), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomainDataFailed), err),
 		}
 	}
 	return &kusciaapi.DeleteDomainDataResponse{
@@ -311,13 +315,13 @@ func (s domainDataService) QueryDomainData(ctx context.Context, request *kusciaa
 	}
 	if err := s.validateRequestWhenLite(request.Data); err != nil {
 		return &kusciaapi.QueryDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// auth pre handler
 	if err := s.authHandler(ctx, request.Data); err != nil {
 		return &kusciaapi.QueryDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// get kuscia domain
@@ -325,26 +329,27 @@ func (s domainDataService) QueryDomainData(ctx context.Context, request *kusciaa
 	if err != nil {
 		nlog.Errorf("QueryDomainData failed, error: %s", err.Error())
 		return &kusciaapi.QueryDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed), err),
 		}
 	}
 	// build domain response
 	return &kusciaapi.QueryDomainDataResponse{
 		Status: utils.BuildSuccessResponseStatus(),
 		Data: &kusciaapi.DomainData{
-			DomaindataId: kusciaDomainData.Name,
-			DomainId:     kusciaDomainData.Namespace,
-			Name:         kusciaDomainData.Spec.Name,
-			Type:         kusciaDomainData.Spec.Type,
-			RelativeUri:  kusciaDomainData.Spec.RelativeURI,
-			DatasourceId: kusciaDomainData.Spec.DataSource,
-			Attributes:   kusciaDomainData.Spec.Attributes,
-			Partition:    common.Convert2PbPartition(kusciaDomainData.Spec.Partition),
-			Columns:      common.Convert2PbColumn(kusciaDomainData.Spec.Columns),
-			Vendor:       kusciaDomainData.Spec.Vendor,
-			Status:       constants.DomainDataStatusAvailable,
-			Author:       kusciaDomainData.Spec.Author,
-			FileFormat:   common.Convert2PbFileFormat(kusciaDomainData.Spec.FileFormat),
+			DomaindataId:    kusciaDomainData.Name,
+			DomainId:        kusciaDomainData.Namespace,
+			Name:            kusciaDomainData.Spec.Name,
+			Type:            kusciaDomainData.Spec.Type,
+			RelativeUri:     kusciaDomainData.Spec.RelativeURI,
+			DatasourceId:    kusciaDomainData.Spec.DataSource,
+			Attributes:      kusciaDomainData.Spec.Attributes,
+			Partition:       common.Convert2PbPartition(kusciaDomainData.Spec.Partition),
+			Columns:         common.Convert2PbColumn(kusciaDomainData.Spec.Columns),
+			Vendor:          kusciaDomainData.Spec.Vendor,
+			Status:          constants.DomainDataStatusAvailable,
+			Author:          kusciaDomainData.Spec.Author,
+			FileFormat:      common.Convert2PbFileFormat(kusciaDomainData.Spec.FileFormat),
+			MaskingPolicies: common.Convert2PbMaskingPolicies(kusciaDomainData.Spec.MaskingPolicies),
 		},
 	}
 }
@@ -360,13 +365,13 @@ func (s domainDataService) BatchQueryDomainData(ctx context.Context, request *ku
 		// check the request when this is kuscia lite api
 		if err := s.validateRequestWhenLite(v); err != nil {
 			return &kusciaapi.BatchQueryDomainDataResponse{
-				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 			}
 		}
 		// auth pre handler
 		if err := s.authHandler(ctx, v); err != nil {
 			return &kusciaapi.BatchQueryDomainDataResponse{
-				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 			}
 		}
 	}
@@ -380,23 +385,24 @@ func (s domainDataService) BatchQueryDomainData(ctx context.Context, request *ku
 			}
 			nlog.Errorf("QueryDomainData failed, error: %s", err.Error())
 			return &kusciaapi.BatchQueryDomainDataResponse{
-				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed, err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed, err),
 			}
 		}
 		domainData := kusciaapi.DomainData{
-			DomaindataId: kusciaDomainData.Name,
-			DomainId:     kusciaDomainData.Namespace,
-			Name:         kusciaDomainData.Spec.Name,
-			Type:         kusciaDomainData.Spec.Type,
-			RelativeUri:  kusciaDomainData.Spec.RelativeURI,
-			DatasourceId: kusciaDomainData.Spec.DataSource,
-			Attributes:   kusciaDomainData.Spec.Attributes,
-			Partition:    common.Convert2PbPartition(kusciaDomainData.Spec.Partition),
-			Columns:      common.Convert2PbColumn(kusciaDomainData.Spec.Columns),
-			Vendor:       kusciaDomainData.Spec.Vendor,
-			Status:       constants.DomainDataStatusAvailable,
-			Author:       kusciaDomainData.Spec.Author,
-			FileFormat:   common.Convert2PbFileFormat(kusciaDomainData.Spec.FileFormat),
+			DomaindataId:    kusciaDomainData.Name,
+			DomainId:        kusciaDomainData.Namespace,
+			Name:            kusciaDomainData.Spec.Name,
+			Type:            kusciaDomainData.Spec.Type,
+			RelativeUri:     kusciaDomainData.Spec.RelativeURI,
+			DatasourceId:    kusciaDomainData.Spec.DataSource,
+			Attributes:      kusciaDomainData.Spec.Attributes,
+			Partition:       common.Convert2PbPartition(kusciaDomainData.Spec.Partition),
+			Columns:         common.Convert2PbColumn(kusciaDomainData.Spec.Columns),
+			Vendor:          kusciaDomainData.Spec.Vendor,
+			Status:          constants.DomainDataStatusAvailable,
+			Author:          kusciaDomainData.Spec.Author,
+			FileFormat:      common.Convert2PbFileFormat(kusciaDomainData.Spec.FileFormat),
+			MaskingPolicies: common.Convert2PbMaskingPolicies(kusciaDomainData.Spec.MaskingPolicies),
 		}
 		respDatas = append(respDatas, &domainData)
 	}
@@ -418,13 +424,13 @@ func (s domainDataService) ListDomainData(ctx context.Context, request *kusciaap
 	}
 	if err := s.validateRequestWhenLite(request.Data); err != nil {
 		return &kusciaapi.ListDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// auth pre handler
 	if err := s.authHandler(ctx, request.Data); err != nil {
 		return &kusciaapi.ListDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// construct label selector
@@ -458,25 +464,26 @@ func (s domainDataService) ListDomainData(ctx context.Context, request *kusciaap
 	if err != nil {
 		nlog.Errorf("List DomainData failed, error: %s", err.Error())
 		return &kusciaapi.ListDomainDataResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrListDomainDataFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrListDomainDataFailed, err),
 		}
 	}
 	respDatas := make([]*kusciaapi.DomainData, len(dataList.Items))
 	for i, v := range dataList.Items {
 		domaindata := kusciaapi.DomainData{
-			DomaindataId: v.Name,
-			DomainId:     v.Namespace,
-			Name:         v.Spec.Name,
-			Type:         v.Spec.Type,
-			RelativeUri:  v.Spec.RelativeURI,
-			DatasourceId: v.Spec.DataSource,
-			Attributes:   v.Spec.Attributes,
-			Partition:    common.Convert2PbPartition(v.Spec.Partition),
-			Columns:      common.Convert2PbColumn(v.Spec.Columns),
-			Vendor:       v.Spec.Vendor,
-			Status:       constants.DomainDataStatusAvailable,
-			Author:       v.Spec.Author,
-			FileFormat:   common.Convert2PbFileFormat(v.Spec.FileFormat),
+			DomaindataId:    v.Name,
+			DomainId:        v.Namespace,
+			Name:            v.Spec.Name,
+			Type:            v.Spec.Type,
+			RelativeUri:     v.Spec.RelativeURI,
+			DatasourceId:    v.Spec.DataSource,
+			Attributes:      v.Spec.Attributes,
+			Partition:       common.Convert2PbPartition(v.Spec.Partition),
+			Columns:         common.Convert2PbColumn(v.Spec.Columns),
+			Vendor:          v.Spec.Vendor,
+			Status:          constants.DomainDataStatusAvailable,
+			Author:          v.Spec.Author,
+			FileFormat:      common.Convert2PbFileFormat(v.Spec.FileFormat),
+			MaskingPolicies: common.Convert2PbMaskingPolicies(v.Spec.MaskingPolicies),
 		}
 		respDatas[i] = &domaindata
 	}
@@ -489,6 +496,59 @@ func (s domainDataService) ListDomainData(ctx context.Context, request *kusciaap
 	}
 }
 
+func (s domainDataService) SetDomainDataMaskingPolicies(ctx context.Context, request *kusciaapi.SetDomainDataMaskingPoliciesRequest) *kusciaapi.SetDomainDataMaskingPoliciesResponse {
+	// do validate
+	if request.DomaindataId == "" || request.DomainId == "" {
+		return &kusciaapi.SetDomainDataMaskingPoliciesResponse{
+			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "domain id and domaindata id can not be empty"),
+		}
+	}
+	if err := s.validateRequestWhenLite(request); err != nil {
+		return &kusciaapi.SetDomainDataMaskingPoliciesResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	// auth pre handler
+	if err := s.authHandler(ctx, request); err != nil {
+		return &kusciaapi.SetDomainDataMaskingPoliciesResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+	originalDomainData, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDatas(request.DomainId).Get(ctx, request.DomaindataId, metav1.GetOptions{})
+	if err != nil {
+		nlog.Errorf("SetDomainDataMaskingPolicies failed, error: %s", err.Error())
+		return &kusciaapi.SetDomainDataMaskingPoliciesResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed), err),
+		}
+	}
+	// the copies of granted domaindata follow the author
+	if originalDomainData.Spec.Author != request.DomainId {
+		return &kusciaapi.SetDomainDataMaskingPoliciesResponse{
+			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				fmt.Sprintf("domaindata %s is authored by %s, only the author can set the masking policies", request.DomaindataId, originalDomainData.Spec.Author)),
+		}
+	}
+	policies := common.Convert2KubeMaskingPolicies(request.MaskingPolicies)
+	if err = resources.ValidateDataMaskingPolicies(policies, originalDomainData.Spec.Columns); err != nil {
+		return &kusciaapi.SetDomainDataMaskingPoliciesResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+
+	modifiedDomainData := originalDomainData.DeepCopy()
+	modifiedDomainData.Spec.MaskingPolicies = policies
+	if err = resources.PatchDomainData(ctx, s.conf.KusciaClient, originalDomainData, modifiedDomainData); err != nil {
+		nlog.Errorf("Patch DomainData %s/%s masking policies failed, error: %s", request.DomainId, request.DomaindataId, err.Error())
+		return &kusciaapi.SetDomainDataMaskingPoliciesResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrPatchDomainDataFailed), err),
+		}
+	}
+	nlog.Infof("Set DomainData %s/%s masking policies: %+v", request.DomainId, request.DomaindataId, policies)
+	return &kusciaapi.SetDomainDataMaskingPoliciesResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+	}
+}
+
 func convert2UpdateReq(createReq *kusciaapi.CreateDomainDataRequest) (updateReq *kusciaapi.UpdateDomainDataRequest) {
 	updateReq = &kusciaapi.UpdateDomainDataRequest{
 		Header:       createReq.Header,
@@ -575,6 +635,9 @@ func (s domainDataService) normalizationUpdateRequest(request *kusciaapi.UpdateD
 }
 
 func (s domainDataService) authHandler(ctx context.Context, request RequestWithDomainID) error {
+	if err := tenant.CheckDomains(ctx, request.GetDomainId()); err != nil {
+		return err
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if role == consts.AuthRoleDomain && request.GetDomainId() != domainID {
 		return fmt.Errorf("domain's kusciaAPI could only operate its own DomainData, request.DomainID must be %s not %s", domainID, request.GetDomainId())
@@ -251,6 +251,55 @@ func TestUpdateDomainDataWithVendor(t *testing.T) {
 
 }
 
+func TestSetDomainDataMaskingPolicies(t *testing.T) {
+	conf := makeDomainDataServiceConfig(t)
+	domainDataService := NewDomainDataService(conf)
+	mockCreateDomainDataSource(t, conf)
+
+	res := mockCreateDomainData(domainDataService)
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+
+	res1 := domainDataService.SetDomainDataMaskingPolicies(context.Background(), &kusciaapi.SetDomainDataMaskingPoliciesRequest{
+		DomainId:     domainID,
+		DomaindataId: res.Data.DomaindataId,
+		MaskingPolicies: []*v1alpha1.DataMaskingPolicy{
+			{Column: "unknown", Method: "hash"},
+		},
+	})
+	assert.NotEqual(t, kusciaAPISuccessStatusCode, res1.Status.Code)
+
+	res1 = domainDataService.SetDomainDataMaskingPolicies(context.Background(), &kusciaapi.SetDomainDataMaskingPoliciesRequest{
+		DomainId:     domainID,
+		DomaindataId: res.Data.DomaindataId,
+		MaskingPolicies: []*v1alpha1.DataMaskingPolicy{
+			{Column: "id", Method: "truncate", Length: 4, Domains: []string{"bob"}},
+		},
+	})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res1.Status.Code)
+
+	// the masking policies are kept when the domaindata is updated
+	res2 := domainDataService.UpdateDomainData(context.Background(), &kusciaapi.UpdateDomainDataRequest{
+		DomaindataId: res.Data.DomaindataId,
+		DomainId:     domainID,
+		Name:         "test",
+		Type:         "table",
+		RelativeUri:  "a/b.csv",
+		DatasourceId: dsID,
+	})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res2.Status.Code)
+
+	res3 := domainDataService.QueryDomainData(context.Background(), &kusciaapi.QueryDomainDataRequest{
+		Data: &kusciaapi.QueryDomainDataRequestData{
+			DomainId:     domainID,
+			DomaindataId: res.Data.DomaindataId,
+		},
+	})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res3.Status.Code)
+	assert.Len(t, res3.Data.MaskingPolicies, 1)
+	assert.Equal(t, int32(4), res3.Data.MaskingPolicies[0].Length)
+	assert.Equal(t, []string{"bob"}, res3.Data.MaskingPolicies[0].Domains)
+}
+
 func TestDeleteDomainData(t *testing.T) {
 	conf := makeDomainDataServiceConfig(t)
 	domainDataService := NewDomainDataService(conf)
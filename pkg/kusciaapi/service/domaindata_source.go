@@ -22,12 +22,15 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	"google.golang.org/protobuf/proto"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
 	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
@@ -35,8 +38,8 @@ import (
 	"github.com/secretflow/kuscia/pkg/kusciaapi/errorcode"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/resources"
-	"github.com/secretflow/kuscia/pkg/utils/tls"
 	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/pkg/web/utils"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/confmanager"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
@@ -54,6 +57,8 @@ var (
 
 const (
 	encryptedInfo = "encryptedInfo"
+	// maskedSecret replaces the credentials of the datasources in the query responses unless they're revealed.
+	maskedSecret = "******"
 )
 
 type IDomainDataSourceService interface {
@@ -68,28 +73,30 @@ type IDomainDataSourceService interface {
 type domainDataSourceService struct {
 	conf          *config.KusciaAPIConfig
 	configService cmservice.IConfigService
+	sealer        *secretbackend.Sealer
 }
 
 func NewDomainDataSourceService(config *config.KusciaAPIConfig, configService cmservice.IConfigService) IDomainDataSourceService {
 	return &domainDataSourceService{
 		conf:          config,
 		configService: configService,
+		sealer:        secretbackend.NewSealer(config.DomainKey, config.SecretBackend),
 	}
 }
 
 func (s domainDataSourceService) CreateDomainDataSource(ctx context.Context, request *kusciaapi.CreateDomainDataSourceRequest) *kusciaapi.CreateDomainDataSourceResponse {
 	var err error
-	if err = s.validateRequestIdentity(request.DomainId); err != nil {
+	if err = s.validateRequestIdentity(ctx, request.DomainId); err != nil {
 		nlog.Errorf(errCreateDomainDataSource, err.Error())
 		return &kusciaapi.CreateDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
 	if err = validateDataSourceType(request.Type); err != nil {
 		nlog.Errorf(errCreateDomainDataSource, err.Error())
 		return &kusciaapi.CreateDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -99,7 +106,7 @@ func (s domainDataSourceService) CreateDomainDataSource(ctx context.Context, req
 
 	if err = resources.ValidateK8sName(request.DatasourceId, "datasource_id"); err != nil {
 		return &kusciaapi.CreateDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -153,15 +160,15 @@ func (s domainDataSourceService) CreateDomainDataSource(ctx context.Context, req
 
 		if err = validateDataSourceInfo(request.Type, request.Info); err != nil {
 			return &kusciaapi.CreateDomainDataSourceResponse{
-				Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), err),
 			}
 		}
 
-		uri, encInfo, encryptErr := s.encryptInfo(request.Type, request.Info)
+		uri, encInfo, encryptErr := s.encryptInfo(ctx, request.Type, request.Info)
 		if encryptErr != nil {
 			nlog.Errorf(errCreateDomainDataSource, encryptErr.Error())
 			return &kusciaapi.CreateDomainDataSourceResponse{
-				Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataSourceErrorCode(encryptErr, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainDataSource), encryptErr.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataSourceErrorCode(encryptErr, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainDataSource), encryptErr),
 			}
 		}
 		dataSource.Spec.URI = uri
@@ -177,7 +184,7 @@ func (s domainDataSourceService) CreateDomainDataSource(ctx context.Context, req
 	if err != nil {
 		nlog.Errorf(errCreateDomainDataSource, err.Error())
 		return &kusciaapi.CreateDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.CreateDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainDataSource), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.CreateDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrCreateDomainDataSource), err),
 		}
 	}
 
@@ -191,10 +198,10 @@ func (s domainDataSourceService) CreateDomainDataSource(ctx context.Context, req
 
 func (s domainDataSourceService) UpdateDomainDataSource(ctx context.Context, request *kusciaapi.UpdateDomainDataSourceRequest) *kusciaapi.UpdateDomainDataSourceResponse {
 	var err error
-	if err = s.validateRequestIdentity(request.DomainId); err != nil {
+	if err = s.validateRequestIdentity(ctx, request.DomainId); err != nil {
 		nlog.Errorf(errUpdateDomainDataSource, err.Error())
 		return &kusciaapi.UpdateDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -214,17 +221,17 @@ func (s domainDataSourceService) UpdateDomainDataSource(ctx context.Context, req
 		}
 		nlog.Errorf(errUpdateDomainDataSource, err.Error())
 		return &kusciaapi.UpdateDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomainDataSource), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomainDataSource), err),
 		}
 	}
 
 	dataSource := curDataSource.DeepCopy()
 
-	updated, err := s.updateDataSource(dataSource, request)
+	updated, err := s.updateDataSource(ctx, dataSource, request)
 	if err != nil {
 		nlog.Errorf(errUpdateDomainDataSource, err.Error())
 		return &kusciaapi.UpdateDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomainDataSource), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomainDataSource), err),
 		}
 	}
 
@@ -234,7 +241,7 @@ func (s domainDataSourceService) UpdateDomainDataSource(ctx context.Context, req
 		if err != nil {
 			nlog.Errorf(errUpdateDomainDataSource, err.Error())
 			return &kusciaapi.UpdateDomainDataSourceResponse{
-				Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomainDataSource), err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrUpdateDomainDataSource), err),
 			}
 		}
 	}
@@ -244,7 +251,7 @@ func (s domainDataSourceService) UpdateDomainDataSource(ctx context.Context, req
 	}
 }
 
-func (s domainDataSourceService) updateDataSource(dataSource *v1alpha1.DomainDataSource, request *kusciaapi.UpdateDomainDataSourceRequest) (bool, error) {
+func (s domainDataSourceService) updateDataSource(ctx context.Context, dataSource *v1alpha1.DomainDataSource, request *kusciaapi.UpdateDomainDataSourceRequest) (bool, error) {
 	updated := false
 	if request.Name != nil && *request.Name != dataSource.Spec.Name {
 		dataSource.Spec.Name = *request.Name
@@ -279,7 +286,12 @@ func (s domainDataSourceService) updateDataSource(dataSource *v1alpha1.DomainDat
 			return false, err
 		}
 
-		uri, encInfo, err := s.encryptInfo(infoType, request.Info)
+		info, err := s.restoreMaskedSecrets(ctx, dataSource, request.Info)
+		if err != nil {
+			return false, err
+		}
+
+		uri, encInfo, err := s.encryptInfo(ctx, infoType, info)
 		if err != nil {
 			return false, err
 		}
@@ -301,10 +313,10 @@ func (s domainDataSourceService) updateDataSource(dataSource *v1alpha1.DomainDat
 
 func (s domainDataSourceService) DeleteDomainDataSource(ctx context.Context, request *kusciaapi.DeleteDomainDataSourceRequest) *kusciaapi.DeleteDomainDataSourceResponse {
 	var err error
-	if err = s.validateRequestIdentity(request.DomainId); err != nil {
+	if err = s.validateRequestIdentity(ctx, request.DomainId); err != nil {
 		nlog.Errorf(errDeleteDomainDataSource, err.Error())
 		return &kusciaapi.DeleteDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -323,7 +335,7 @@ func (s domainDataSourceService) DeleteDomainDataSource(ctx context.Context, req
 		}
 		nlog.Errorf(errDeleteDomainDataSource, err.Error())
 		return &kusciaapi.DeleteDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomainDataSource), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrDeleteDomainDataSource), err),
 		}
 	}
 
@@ -333,11 +345,17 @@ func (s domainDataSourceService) DeleteDomainDataSource(ctx context.Context, req
 }
 
 func (s domainDataSourceService) QueryDomainDataSource(ctx context.Context, request *kusciaapi.QueryDomainDataSourceRequest) *kusciaapi.QueryDomainDataSourceResponse {
-	dataSource, err := s.getDomainDataSource(ctx, request.DomainId, request.DatasourceId)
+	if err := s.validateRevealRequest(ctx, request.RevealInfo); err != nil {
+		nlog.Errorf(errQueryDomainDataSource, err.Error())
+		return &kusciaapi.QueryDomainDataSourceResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDomainDataSourceRevealDenied, err),
+		}
+	}
+	dataSource, err := s.getDomainDataSource(ctx, request.DomainId, request.DatasourceId, request.RevealInfo)
 	if err != nil {
 		nlog.Errorf(errQueryDomainDataSource, err.Error())
 		return &kusciaapi.QueryDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainDataSource), err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrQueryDomainDataSource), err),
 		}
 	}
 
@@ -354,13 +372,20 @@ func (s domainDataSourceService) BatchQueryDomainDataSource(ctx context.Context,
 		}
 	}
 
+	if err := s.validateRevealRequest(ctx, request.RevealInfo); err != nil {
+		nlog.Errorf(errBatchQueryDomainDataSource, err.Error())
+		return &kusciaapi.BatchQueryDomainDataSourceResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDomainDataSourceRevealDenied, err),
+		}
+	}
+
 	var data []*kusciaapi.DomainDataSource
 	for _, reqData := range request.Data {
-		dataSource, err := s.getDomainDataSource(ctx, reqData.DomainId, reqData.DatasourceId)
+		dataSource, err := s.getDomainDataSource(ctx, reqData.DomainId, reqData.DatasourceId, request.RevealInfo)
 		if err != nil {
 			nlog.Errorf(errBatchQueryDomainDataSource, err.Error())
 			return &kusciaapi.BatchQueryDomainDataSourceResponse{
-				Status: utils.BuildErrorResponseStatus(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrBatchQueryDomainDataSource), err.Error()),
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.GetDomainDataSourceErrorCode(err, pberrorcode.ErrorCode_KusciaAPIErrBatchQueryDomainDataSource), err),
 			}
 		}
 		data = append(data, dataSource)
@@ -378,10 +403,16 @@ func (s domainDataSourceService) ListDomainDataSource(ctx context.Context, reque
 			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "request domainID can't be empty"),
 		}
 	}
-	if err := s.validateRetrieveRequest(request.DomainId); err != nil {
+	if err := s.validateRetrieveRequest(ctx, request.DomainId); err != nil {
+		nlog.Errorf(errListDomainDataSource, err.Error())
+		return &kusciaapi.ListDomainDataSourceResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrListDomainDataSource, err),
+		}
+	}
+	if err := s.validateRevealRequest(ctx, request.RevealInfo); err != nil {
 		nlog.Errorf(errListDomainDataSource, err.Error())
 		return &kusciaapi.ListDomainDataSourceResponse{
-			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrListDomainDataSource, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDomainDataSourceRevealDenied, err),
 		}
 	}
 	var data []*kusciaapi.DomainDataSource
@@ -398,6 +429,9 @@ func (s domainDataSourceService) ListDomainDataSource(ctx context.Context, reque
 			if info, err = s.decryptDatasourceInfo(ctx, &ds); err != nil {
 				goto returnErr
 			}
+			if !request.RevealInfo {
+				maskDataSourceInfo(info)
+			}
 		}
 		ids := &kusciaapi.DomainDataSource{
 			DomainId:       ds.Namespace,
@@ -417,7 +451,7 @@ func (s domainDataSourceService) ListDomainDataSource(ctx context.Context, reque
 returnErr:
 	nlog.Error(err.Error())
 	return &kusciaapi.ListDomainDataSourceResponse{
-		Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrListDomainDataSource, err.Error()),
+		Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrListDomainDataSource, err),
 	}
 }
 
@@ -432,7 +466,7 @@ func (s domainDataSourceService) decryptDatasourceInfo(ctx context.Context, ds *
 		if !exist {
 			return nil, fmt.Errorf("missing datasource info for %s", ds.Spec.Name)
 		}
-		info, err = s.decryptInfo(encryptedInfo)
+		info, err = s.decryptInfo(ctx, encryptedInfo)
 		if err != nil {
 			return nil, err
 		}
@@ -440,8 +474,8 @@ func (s domainDataSourceService) decryptDatasourceInfo(ctx context.Context, ds *
 	return info, err
 }
 
-func (s domainDataSourceService) getDomainDataSource(ctx context.Context, domainID, dataSourceID string) (*kusciaapi.DomainDataSource, error) {
-	if err := s.validateRetrieveRequest(domainID); err != nil {
+func (s domainDataSourceService) getDomainDataSource(ctx context.Context, domainID, dataSourceID string, reveal bool) (*kusciaapi.DomainDataSource, error) {
+	if err := s.validateRetrieveRequest(ctx, domainID); err != nil {
 		return nil, err
 	}
 
@@ -460,6 +494,9 @@ func (s domainDataSourceService) getDomainDataSource(ctx context.Context, domain
 		if info, err = s.decryptDatasourceInfo(ctx, kusciaDataSource); err != nil {
 			return nil, err
 		}
+		if !reveal {
+			maskDataSourceInfo(info)
+		}
 	}
 
 	return &kusciaapi.DomainDataSource{
@@ -472,6 +509,84 @@ func (s domainDataSourceService) getDomainDataSource(ctx context.Context, domain
 		AccessDirectly: kusciaDataSource.Spec.AccessDirectly}, nil
 }
 
+// validateRevealRequest checks whether the role of the client is granted to reveal the credentials of the datasources.
+func (s domainDataSourceService) validateRevealRequest(ctx context.Context, reveal bool) error {
+	if !reveal {
+		return nil
+	}
+	role, _ := GetRoleAndDomainFromCtx(ctx)
+	if s.conf.DataSource == nil || !slices.Contains(s.conf.DataSource.RevealRoles, role) {
+		return fmt.Errorf("role %q is not granted to reveal the datasource info", role)
+	}
+	return nil
+}
+
+// maskDataSourceInfo replaces the credentials of the datasource, the other fields are kept for display.
+func maskDataSourceInfo(info *kusciaapi.DataSourceInfo) {
+	mask := func(secret *string) {
+		if *secret != "" {
+			*secret = maskedSecret
+		}
+	}
+	if info == nil {
+		return
+	}
+	if info.Oss != nil {
+		mask(&info.Oss.AccessKeySecret)
+	}
+	if info.Database != nil {
+		mask(&info.Database.Password)
+	}
+	if info.Odps != nil {
+		mask(&info.Odps.AccessKeySecret)
+	}
+}
+
+// restoreMaskedSecrets replaces the masked credentials sent back by a read-modify-write client with the stored ones,
+// so that the placeholder is never saved as the real secret.
+func (s domainDataSourceService) restoreMaskedSecrets(ctx context.Context, dataSource *v1alpha1.DomainDataSource, info *kusciaapi.DataSourceInfo) (*kusciaapi.DataSourceInfo, error) {
+	if info.GetOss().GetAccessKeySecret() != maskedSecret && info.GetDatabase().GetPassword() != maskedSecret &&
+		info.GetOdps().GetAccessKeySecret() != maskedSecret {
+		return info, nil
+	}
+
+	stored := &kusciaapi.DataSourceInfo{}
+	if dataSource.Spec.InfoKey == "" && dataSource.Spec.Data[encryptedInfo] != "" {
+		var err error
+		if stored, err = s.decryptInfo(ctx, dataSource.Spec.Data[encryptedInfo]); err != nil {
+			return nil, err
+		}
+	}
+
+	restore := func(secret *string, storedSecret string) error {
+		if *secret != maskedSecret {
+			return nil
+		}
+		if storedSecret == "" {
+			return fmt.Errorf("the masked secret %q can't be saved, please set the secret of the datasource again", maskedSecret)
+		}
+		*secret = storedSecret
+		return nil
+	}
+	info = proto.Clone(info).(*kusciaapi.DataSourceInfo)
+	if info.Oss != nil {
+		if err := restore(&info.Oss.AccessKeySecret, stored.GetOss().GetAccessKeySecret()); err != nil {
+			return nil, err
+		}
+	}
+	if info.Database != nil {
+		if err := restore(&info.Database.Password, stored.GetDatabase().GetPassword()); err != nil {
+			return nil, err
+		}
+	}
+	if info.Odps != nil {
+		if err := restore(&info.Odps.AccessKeySecret, stored.GetOdps().GetAccessKeySecret()); err != nil {
+			return nil, err
+		}
+	}
+	return info, nil
+}
+
 func CheckDomainDataSourceExists(kusciaClient kusciaclientset.Interface, domainID, domainDataSourceID string) (kusciaError pberrorcode.ErrorCode, errorMsg string) {
 
 	_, err := kusciaClient.KusciaV1alpha1().DomainDataSources(domainID).Get(context.Background(), domainDataSourceID, metav1.GetOptions{})
@@ -497,7 +612,10 @@ func validateDataSourceType(t string) error {
 	return nil
 }
 
-func (s domainDataSourceService) validateRequestIdentity(domainID string) error {
+func (s domainDataSourceService) validateRequestIdentity(ctx context.Context, domainID string) error {
+	if err := tenant.CheckDomains(ctx, domainID); err != nil {
+		return err
+	}
 	if s.conf.RunMode == common.RunModeMaster {
 		return errors.New("master's kuscia api can't operate domain data source")
 	}
@@ -514,7 +632,12 @@ func (s domainDataSourceService) validateRequestIdentity(domainID string) error
 	return nil
 }
 
-func (s domainDataSourceService) validateRetrieveRequest(domainID string) error {
+// validateRetrieveRequest limits the tenants to the datasources of their domains, the master retrieves the datasources
+// of any domain otherwise.
+func (s domainDataSourceService) validateRetrieveRequest(ctx context.Context, domainID string) error {
+	if err := tenant.CheckDomains(ctx, domainID); err != nil {
+		return err
+	}
 	if s.conf.RunMode == common.RunModeMaster {
 		return nil
 	}
@@ -550,7 +673,7 @@ func (s domainDataSourceService) getDsInfoByKey(ctx context.Context, sourceType
 }
 
 //nolint:dupl
-func (s domainDataSourceService) encryptInfo(dataSourceType string, info *kusciaapi.DataSourceInfo) (uri string, encInfo string, err error) {
+func (s domainDataSourceService) encryptInfo(ctx context.Context, dataSourceType string, info *kusciaapi.DataSourceInfo) (uri string, encInfo string, err error) {
 	uri, err = parseAndNormalizeDataSource(dataSourceType, info)
 	if err != nil {
 		return "", "", fmt.Errorf("parse data source failed, %v", err)
@@ -562,8 +685,8 @@ func (s domainDataSourceService) encryptInfo(dataSourceType string, info *kuscia
 		return "", "", err
 	}
 
-	// encrypt
-	encInfo, err = tls.EncryptOAEP(&s.conf.DomainKey.PublicKey, infoBytes)
+	// encrypt by the secret backend if it's configured, otherwise by the domain key
+	encInfo, err = s.sealer.Encrypt(ctx, infoBytes)
 	if err != nil {
 		return "", "", fmt.Errorf("encrypt plaintext failed, %v", err)
 	}
@@ -572,8 +695,8 @@ func (s domainDataSourceService) encryptInfo(dataSourceType string, info *kuscia
 }
 
 //nolint:dupl
-func (s domainDataSourceService) decryptInfo(cipherInfo string) (*kusciaapi.DataSourceInfo, error) {
-	plaintext, err := tls.DecryptOAEP(s.conf.DomainKey, cipherInfo)
+func (s domainDataSourceService) decryptInfo(ctx context.Context, cipherInfo string) (*kusciaapi.DataSourceInfo, error) {
+	plaintext, err := s.sealer.Decrypt(ctx, cipherInfo)
 	if err != nil {
 		return nil, fmt.Errorf("decrypt data source info failed, %v", err)
 	}
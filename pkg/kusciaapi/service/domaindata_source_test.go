@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,10 +27,13 @@ import (
 
 	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/confmanager/driver"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
 	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
@@ -155,10 +159,128 @@ func TestUpdateDomainDataSource(t *testing.T) {
 
 	assert.Equal(t, int32(0), queryRes.Status.Code)
 	assert.Equal(t, "root-2", queryRes.Data.Info.Database.User)
-	assert.Equal(t, "passwd-2", queryRes.Data.Info.Database.Password)
+	assert.Equal(t, maskedSecret, queryRes.Data.Info.Database.Password)
 	assert.Equal(t, "db-name-2", queryRes.Data.Info.Database.Database)
 }
 
+func TestUpdateDomainDataSource_MaskedSecret(t *testing.T) {
+	dataSourceID := "ds-1"
+	conf := makeDomainDataSourceServiceConfig(t)
+	dsService := makeDomainDataSourceService(t, conf)
+	createRes := dsService.CreateDomainDataSource(context.Background(), &kusciaapi.CreateDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: dataSourceID,
+		Type:         common.DomainDataSourceTypeMysql,
+		Info: &kusciaapi.DataSourceInfo{
+			Database: &kusciaapi.DatabaseDataSourceInfo{
+				Endpoint: "127.0.0.1:3306",
+				User:     "root",
+				Password: "passwd",
+				Database: "db-name",
+			},
+		},
+	})
+	assert.Equal(t, int32(0), createRes.Status.Code)
+
+	// read the datasource, edit it and send it back with the masked password
+	queryRes := dsService.QueryDomainDataSource(context.Background(), &kusciaapi.QueryDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: dataSourceID,
+	})
+	assert.Equal(t, int32(0), queryRes.Status.Code)
+	assert.Equal(t, maskedSecret, queryRes.Data.Info.Database.Password)
+	info := queryRes.Data.Info
+	info.Database.User = "root-2"
+	updateRes := dsService.UpdateDomainDataSource(context.Background(), &kusciaapi.UpdateDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: dataSourceID,
+		Type:         common.DomainDataSourceTypeMysql,
+		Info:         info,
+	})
+	assert.Equal(t, int32(0), updateRes.Status.Code)
+
+	ds, err := conf.KusciaClient.KusciaV1alpha1().DomainDataSources(mockDomainID).Get(context.Background(), dataSourceID, metav1.GetOptions{})
+	assert.NoError(t, err)
+	stored, err := dsService.(*domainDataSourceService).decryptInfo(context.Background(), ds.Spec.Data[encryptedInfo])
+	assert.NoError(t, err)
+	assert.Equal(t, "root-2", stored.Database.User)
+	assert.Equal(t, "passwd", stored.Database.Password)
+
+	// the masked secret of a credential that was never stored is rejected
+	updateRes = dsService.UpdateDomainDataSource(context.Background(), &kusciaapi.UpdateDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: dataSourceID,
+		Type:         common.DomainDataSourceTypeOSS,
+		Info: &kusciaapi.DataSourceInfo{
+			Oss: &kusciaapi.OssDataSourceInfo{
+				Endpoint:        "127.0.0.1:9000",
+				Bucket:          "bucket",
+				AccessKeyId:     "ak",
+				AccessKeySecret: maskedSecret,
+			},
+		},
+	})
+	assert.NotEqual(t, int32(0), updateRes.Status.Code)
+}
+
+func TestQueryDomainDataSource_RevealInfo(t *testing.T) {
+	dataSourceID := "ds-1"
+	conf := makeDomainDataSourceServiceConfig(t)
+	conf.SecretBackend = &fakeSecretBackend{}
+	conf.DataSource = &config.DataSourceConfig{RevealRoles: []string{consts.AuthRoleMaster}}
+	dsService := makeDomainDataSourceService(t, conf)
+
+	createRes := dsService.CreateDomainDataSource(context.Background(), &kusciaapi.CreateDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: dataSourceID,
+		Type:         common.DomainDataSourceTypeOSS,
+		Info: &kusciaapi.DataSourceInfo{
+			Oss: &kusciaapi.OssDataSourceInfo{
+				Endpoint:        "127.0.0.1:9000",
+				Bucket:          "bucket",
+				AccessKeyId:     "ak",
+				AccessKeySecret: "sk",
+			},
+		},
+	})
+	assert.Equal(t, int32(0), createRes.Status.Code)
+	ds, err := conf.KusciaClient.KusciaV1alpha1().DomainDataSources(mockDomainID).Get(context.Background(), dataSourceID, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, secretbackend.IsSealed(ds.Spec.Data[encryptedInfo]))
+
+	masterCtx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleMaster)
+	queryRes := dsService.QueryDomainDataSource(masterCtx, &kusciaapi.QueryDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: dataSourceID,
+		RevealInfo:   true,
+	})
+	assert.Equal(t, int32(0), queryRes.Status.Code)
+	assert.Equal(t, "sk", queryRes.Data.Info.Oss.AccessKeySecret)
+
+	domainCtx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleDomain)
+	queryRes = dsService.QueryDomainDataSource(domainCtx, &kusciaapi.QueryDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: dataSourceID,
+		RevealInfo:   true,
+	})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrDomainDataSourceRevealDenied), queryRes.Status.Code)
+
+	listRes := dsService.ListDomainDataSource(domainCtx, &kusciaapi.ListDomainDataSourceRequest{DomainId: mockDomainID})
+	assert.Equal(t, int32(0), listRes.Status.Code)
+	assert.Equal(t, "ak", listRes.Data.DatasourceList[0].Info.Oss.AccessKeyId)
+	assert.Equal(t, maskedSecret, listRes.Data.DatasourceList[0].Info.Oss.AccessKeySecret)
+}
+
+type fakeSecretBackend struct{}
+
+func (f *fakeSecretBackend) Seal(ctx context.Context, plaintext []byte) (string, error) {
+	return base64.StdEncoding.EncodeToString(plaintext), nil
+}
+
+func (f *fakeSecretBackend) Unseal(ctx context.Context, ciphertext string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(ciphertext)
+}
+
 func TestDeleteDomainDataSource(t *testing.T) {
 	dataSourceID := "ds-1"
 	conf := makeDomainDataSourceServiceConfig(t)
@@ -193,6 +315,28 @@ func TestDeleteDomainDataSource(t *testing.T) {
 	assert.NotEqual(t, int32(0), queryRes.Status.Code)
 }
 
+func TestDomainDataSourceTenant(t *testing.T) {
+	conf := makeDomainDataSourceServiceConfig(t)
+	dsService := makeDomainDataSourceService(t, conf)
+	ctx := context.WithValue(context.Background(), consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+
+	createRes := dsService.CreateDomainDataSource(ctx, &kusciaapi.CreateDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: "ds-1",
+		Type:         common.DomainDataSourceTypeMysql,
+		Info: &kusciaapi.DataSourceInfo{
+			Database: &kusciaapi.DatabaseDataSourceInfo{Endpoint: "127.0.0.1:3306", User: "root", Password: "passwd", Database: "db-name"},
+		},
+	})
+	assert.Contains(t, createRes.Status.Message, "tenant carol")
+	queryRes := dsService.QueryDomainDataSource(ctx, &kusciaapi.QueryDomainDataSourceRequest{DomainId: mockDomainID, DatasourceId: "ds-1"})
+	assert.Contains(t, queryRes.Status.Message, "tenant carol")
+	listRes := dsService.ListDomainDataSource(ctx, &kusciaapi.ListDomainDataSourceRequest{DomainId: mockDomainID})
+	assert.Contains(t, listRes.Status.Message, "tenant carol")
+	deleteRes := dsService.DeleteDomainDataSource(ctx, &kusciaapi.DeleteDomainDataSourceRequest{DomainId: mockDomainID, DatasourceId: "ds-1"})
+	assert.Contains(t, deleteRes.Status.Message, "tenant carol")
+}
+
 func TestBatchQueryDomainDataSource(t *testing.T) {
 	dataSourceID := "ds-1"
 	conf := makeDomainDataSourceServiceConfig(t)
@@ -0,0 +1,333 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
+	cmservice "github.com/secretflow/kuscia/pkg/confmanager/service"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/datamesh/dataserver/io/builtin"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/quota"
+	"github.com/secretflow/kuscia/pkg/utils/resources"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/datamesh"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const (
+	defaultUploadMaxBytes = 100 * 1024 * 1024
+	// schemaSampleBytes is the size of the head of the csv file which the columns are inferred from.
+	schemaSampleBytes = 64 * 1024
+	schemaSampleRows  = 100
+)
+
+type IDomainDataUploadService interface {
+	// UploadDomainData writes the content into the datasource and registers the domaindata.
+	UploadDomainData(ctx context.Context, meta *kusciaapi.UploadDomainDataMeta, content io.Reader) *kusciaapi.UploadDomainDataResponse
+}
+
+type domainDataUploadService struct {
+	conf              *config.KusciaAPIConfig
+	domainDataService domainDataService
+	datasourceService domainDataSourceService
+}
+
+func NewDomainDataUploadService(config *config.KusciaAPIConfig, configService cmservice.IConfigService) IDomainDataUploadService {
+	return &domainDataUploadService{
+		conf:              config,
+		domainDataService: domainDataService{conf: config},
+		datasourceService: domainDataSourceService{
+			conf:          config,
+			configService: configService,
+			sealer:        secretbackend.NewSealer(config.DomainKey, config.SecretBackend),
+		},
+	}
+}
+
+func (s *domainDataUploadService) UploadDomainData(ctx context.Context, meta *kusciaapi.UploadDomainDataMeta, content io.Reader) *kusciaapi.UploadDomainDataResponse {
+	if err := validateUploadDomainDataMeta(meta); err != nil {
+		return &kusciaapi.UploadDomainDataResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	request := &kusciaapi.CreateDomainDataRequest{
+		DomaindataId: meta.DomaindataId,
+		Name:         meta.Name,
+		Type:         meta.Type,
+		RelativeUri:  meta.RelativeUri,
+		DomainId:     meta.DomainId,
+		DatasourceId: meta.DatasourceId,
+		Attributes:   meta.Attributes,
+		Columns:      meta.Columns,
+		Vendor:       meta.Vendor,
+		FileFormat:   meta.FileFormat,
+	}
+	if err := s.domainDataService.validateRequestWhenLite(request); err != nil {
+		return &kusciaapi.UploadDomainDataResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	if err := s.domainDataService.authHandler(ctx, request); err != nil {
+		return &kusciaapi.UploadDomainDataResponse{
+			Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+	s.domainDataService.normalizationCreateRequest(request)
+
+	if !meta.Overwrite {
+		_, err := s.conf.KusciaClient.KusciaV1alpha1().DomainDatas(request.DomainId).Get(ctx, request.DomaindataId, metav1.GetOptions{})
+		if err == nil {
+			return &kusciaapi.UploadDomainDataResponse{
+				Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrDomainDataExists,
+					fmt.Sprintf("domaindata %s/%s exists, set overwrite to replace it", request.DomainId, request.DomaindataId)),
+			}
+		}
+		if !k8serrors.IsNotFound(err) {
+			return &kusciaapi.UploadDomainDataResponse{
+				Status: utils.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed, err),
+			}
+		}
+	}
+
+	ds, err := s.datasourceService.getDataMeshDataSource(ctx, request.DomainId, request.DatasourceId)
+	if err != nil {
+		code := pberrorcode.ErrorCode_KusciaAPIErrUploadDomainData
+		if k8serrors.IsNotFound(err) {
+			code = pberrorcode.ErrorCode_KusciaAPIErrDomainDataSourceNotExists
+		}
+		return &kusciaapi.UploadDomainDataResponse{Status: utils.BuildErrorResponseStatusFromError(code, err)}
+	}
+	// the local files of the lite domains are not reachable from the master
+	if s.conf.RunMode == common.RunModeMaster && ds.Type == common.DomainDataSourceTypeLocalFS {
+		return &kusciaapi.UploadDomainDataResponse{
+			Status: utils.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrMasterAPINotSupport,
+				"localfs domaindata could only be uploaded by the kuscia api of its own domain"),
+		}
+	}
+
+	size, sum, columns, err := s.writeFile(ctx, request, ds, meta, content)
+	if err != nil {
+		nlog.Warnf("Upload domaindata %s/%s failed: %v", request.DomainId, request.DomaindataId, err)
+		code := pberrorcode.ErrorCode_KusciaAPIErrUploadDomainData
+		var violation *utils.FieldViolationError
+		var quotaErr *storageQuotaError
+		switch {
+		case errors.Is(err, builtin.ErrDomainDataFileExists):
+			code = pberrorcode.ErrorCode_KusciaAPIErrDomainDataExists
+		case errors.As(err, &quotaErr):
+			code = pberrorcode.ErrorCode_KusciaAPIErrQuotaExceeded
+		case errors.As(err, &violation):
+			code = pberrorcode.ErrorCode_KusciaAPIErrRequestValidate
+		}
+		return &kusciaapi.UploadDomainDataResponse{Status: utils.BuildErrorResponseStatusFromError(code, err)}
+	}
+	request.Columns = columns
+
+	createResp := s.domainDataService.CreateDomainData(ctx, request)
+	if !utils.IsSuccessCode(createResp.Status.Code) {
+		nlog.Warnf("Register uploaded domaindata %s/%s failed: %s, the file is kept in datasource %s", request.DomainId,
+			request.DomaindataId, createResp.Status.Message, request.DatasourceId)
+		return &kusciaapi.UploadDomainDataResponse{Status: createResp.Status}
+	}
+	nlog.Infof("Domaindata %s/%s is uploaded to %s of datasource %s, size: %d", request.DomainId, request.DomaindataId,
+		request.RelativeUri, request.DatasourceId, size)
+	return &kusciaapi.UploadDomainDataResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.UploadDomainDataResponseData{
+			DomaindataId: request.DomaindataId,
+			Size:         size,
+			Sha256:       sum,
+			Columns:      columns,
+		},
+	}
+}
+
+// writeFile writes the content into the datasource, and infers the columns from the head of the file if required.
+func (s *domainDataUploadService) writeFile(ctx context.Context, request *kusciaapi.CreateDomainDataRequest, ds *datamesh.DomainDataSource,
+	meta *kusciaapi.UploadDomainDataMeta, content io.Reader) (int64, string, []*pbv1alpha1.DataColumn, error) {
+	writer, err := builtin.CreateDomainDataWriter(ctx, &datamesh.DomainData{RelativeUri: request.RelativeUri}, ds, meta.Overwrite)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	maxBytes := s.uploadMaxBytes()
+	hash := sha256.New()
+	sample := &headBuffer{max: schemaSampleBytes}
+	size, err := io.Copy(io.MultiWriter(writer, hash, sample), io.LimitReader(content, maxBytes+1))
+	if err != nil {
+		writer.Abort()
+		return 0, "", nil, err
+	}
+	if size > maxBytes {
+		writer.Abort()
+		return 0, "", nil, utils.NewFieldViolation("content", "the file exceeds the max size %d bytes", maxBytes)
+	}
+	// the size is only known after the content is read, the file is discarded if it exceeds the storage quota
+	if err := s.conf.QuotaChecker.Check(ctx, &quota.Request{DomainID: request.DomainId, Resource: quota.ResourceStorage, Amount: size}); err != nil {
+		writer.Abort()
+		return 0, "", nil, &storageQuotaError{err: err}
+	}
+
+	columns := request.Columns
+	if meta.InferSchema && len(columns) == 0 && request.Type == v1alpha1.DomainDataTableType {
+		if columns, err = inferCSVColumns(sample.Bytes(), size > int64(sample.Len())); err != nil {
+			writer.Abort()
+			return 0, "", nil, utils.NewFieldViolation("infer_schema", "infer the columns failed, %v", err)
+		}
+	}
+	if err := writer.Commit(ctx); err != nil {
+		return 0, "", nil, err
+	}
+	return size, hex.EncodeToString(hash.Sum(nil)), columns, nil
+}
+
+func (s *domainDataUploadService) uploadMaxBytes() int64 {
+	if s.conf.DomainData != nil && s.conf.DomainData.UploadMaxBytes > 0 {
+		return s.conf.DomainData.UploadMaxBytes
+	}
+	return defaultUploadMaxBytes
+}
+
+// storageQuotaError is returned by writeFile when the storage quota check of the uploaded file fails.
+type storageQuotaError struct {
+	err error
+}
+
+func (e *storageQuotaError) Error() string {
+	return e.err.Error()
+}
+
+func (e *storageQuotaError) Unwrap() error {
+	return e.err
+}
+
+// headBuffer keeps the first max bytes written to it.
+type headBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (b *headBuffer) Write(p []byte) (int, error) {
+	if remain := b.max - b.Len(); remain > 0 {
+		b.Buffer.Write(p[:min(remain, len(p))])
+	}
+	return len(p), nil
+}
+
+// inferCSVColumns infers the columns from the header and the first rows of the csv content, the column type is the
+// narrowest one of bool, int64, float64 and str which all the values fit in.
+func inferCSVColumns(head []byte, truncated bool) ([]*pbv1alpha1.DataColumn, error) {
+	if truncated {
+		// drop the incomplete last line
+		if i := bytes.LastIndexByte(head, '\n'); i >= 0 {
+			head = head[:i+1]
+		}
+	}
+	reader := csv.NewReader(bytes.NewReader(head))
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read the csv header failed, %v", err)
+	}
+
+	types := make([]string, len(header))
+	for rows := 0; rows < schemaSampleRows; rows++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read the csv rows failed, %v", err)
+		}
+		for i, value := range record {
+			types[i] = widenColumnType(types[i], strings.TrimSpace(value))
+		}
+	}
+
+	columns := make([]*pbv1alpha1.DataColumn, 0, len(header))
+	for i, name := range header {
+		colType := types[i]
+		if colType == "" {
+			colType = "str"
+		}
+		columns = append(columns, &pbv1alpha1.DataColumn{Name: strings.TrimSpace(name), Type: colType})
+	}
+	return columns, nil
+}
+
+func widenColumnType(current, value string) string {
+	if value == "" || current == "str" {
+		return current
+	}
+	valueType := "str"
+	if strings.EqualFold(value, "true") || strings.EqualFold(value, "false") {
+		valueType = "bool"
+	} else if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+		valueType = "int64"
+	} else if _, err := strconv.ParseFloat(value, 64); err == nil {
+		valueType = "float64"
+	}
+
+	switch {
+	case current == "" || current == valueType:
+		return valueType
+	case (current == "int64" && valueType == "float64") || (current == "float64" && valueType == "int64"):
+		return "float64"
+	default:
+		return "str"
+	}
+}
+
+func validateUploadDomainDataMeta(meta *kusciaapi.UploadDomainDataMeta) error {
+	if meta == nil {
+		return utils.NewFieldViolation("meta", "meta is required in the first request")
+	}
+	if meta.DomainId == "" {
+		return utils.NewFieldViolation("domain_id", "domain id can not be empty")
+	}
+	if err := resources.ValidateK8sName(meta.DomainId, "domain_id"); err != nil {
+		return err
+	}
+	if meta.DomaindataId != "" {
+		if err := resources.ValidateK8sName(meta.DomaindataId, "domaindata_id"); err != nil {
+			return err
+		}
+	}
+	if meta.Type == "" {
+		return utils.NewFieldViolation("type", "type can not be empty")
+	}
+	if meta.RelativeUri == "" {
+		return utils.NewFieldViolation("relative_uri", "relative uri can not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/quota"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func makeUploadTestService(t *testing.T) (*config.KusciaAPIConfig, string, IDomainDataUploadService) {
+	conf := makeDomainDataSourceServiceConfig(t)
+	root := t.TempDir()
+	dsService := makeDomainDataSourceService(t, conf)
+	res := dsService.CreateDomainDataSource(context.Background(), &kusciaapi.CreateDomainDataSourceRequest{
+		DomainId:     mockDomainID,
+		DatasourceId: "ds-1",
+		Type:         common.DomainDataSourceTypeLocalFS,
+		Info:         &kusciaapi.DataSourceInfo{Localfs: &kusciaapi.LocalDataSourceInfo{Path: root}},
+	})
+	assert.Equal(t, int32(0), res.Status.Code)
+	return conf, root, NewDomainDataUploadService(conf, makeConfigService(t))
+}
+
+func TestUploadDomainData(t *testing.T) {
+	conf, root, s := makeUploadTestService(t)
+	meta := &kusciaapi.UploadDomainDataMeta{
+		DomainId:     mockDomainID,
+		DomaindataId: "input",
+		Type:         "table",
+		RelativeUri:  "upload/input.csv",
+		DatasourceId: "ds-1",
+		InferSchema:  true,
+	}
+	content := "id,score,name,valid\n1,1.5,a,true\n2,3,b,false\n"
+	res := s.UploadDomainData(context.Background(), meta, strings.NewReader(content))
+	assert.Equal(t, int32(0), res.Status.Code, res.Status.Message)
+	assert.Equal(t, int64(len(content)), res.Data.Size)
+	assert.Len(t, res.Data.Sha256, 64)
+
+	saved, err := os.ReadFile(filepath.Join(root, "upload/input.csv"))
+	assert.NoError(t, err)
+	assert.Equal(t, content, string(saved))
+	dd, err := conf.KusciaClient.KusciaV1alpha1().DomainDatas(mockDomainID).Get(context.Background(), "input", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "upload/input.csv", dd.Spec.RelativeURI)
+	assert.Equal(t, "ds-1", dd.Spec.DataSource)
+	types := map[string]string{}
+	for _, col := range dd.Spec.Columns {
+		types[col.Name] = col.Type
+	}
+	assert.Equal(t, map[string]string{"id": "int64", "score": "float64", "name": "str", "valid": "bool"}, types)
+
+	// the existing domaindata is kept without overwrite
+	res = s.UploadDomainData(context.Background(), meta, strings.NewReader("id\n1\n"))
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrDomainDataExists), res.Status.Code)
+	meta.Overwrite = true
+	res = s.UploadDomainData(context.Background(), meta, strings.NewReader("id\n1\n"))
+	assert.Equal(t, int32(0), res.Status.Code, res.Status.Message)
+	saved, err = os.ReadFile(filepath.Join(root, "upload/input.csv"))
+	assert.NoError(t, err)
+	assert.Equal(t, "id\n1\n", string(saved))
+
+	// the file of another domaindata exists
+	meta.DomaindataId, meta.Overwrite = "input-2", false
+	res = s.UploadDomainData(context.Background(), meta, strings.NewReader("id\n1\n"))
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrDomainDataExists), res.Status.Code)
+}
+
+func TestUploadDomainData_Failed(t *testing.T) {
+	conf, root, s := makeUploadTestService(t)
+	conf.DomainData = &config.DomainDataConfig{UploadMaxBytes: 4}
+
+	res := s.UploadDomainData(context.Background(), nil, strings.NewReader(""))
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+
+	meta := &kusciaapi.UploadDomainDataMeta{
+		DomainId:     mockDomainID,
+		Type:         "model",
+		RelativeUri:  "model.bin",
+		DatasourceId: "ds-1",
+	}
+	res = s.UploadDomainData(context.Background(), meta, strings.NewReader("12345"))
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+	entries, err := os.ReadDir(root)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	meta.DatasourceId = "missing"
+	res = s.UploadDomainData(context.Background(), meta, strings.NewReader("1234"))
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrDomainDataSourceNotExists), res.Status.Code)
+
+	meta.DomainId = "bob"
+	res = s.UploadDomainData(context.Background(), meta, strings.NewReader("1234"))
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+}
+
+// amountQuotaBackend allows the requests whose amount doesn't exceed the limit of their resource.
+type amountQuotaBackend struct {
+	limits   map[quota.Resource]int64
+	requests []quota.Request
+}
+
+func (b *amountQuotaBackend) Check(ctx context.Context, req *quota.Request) (*quota.Decision, error) {
+	b.requests = append(b.requests, *req)
+	return &quota.Decision{Allowed: req.Amount <= b.limits[req.Resource]}, nil
+}
+
+func TestUploadDomainData_StorageQuota(t *testing.T) {
+	conf, root, s := makeUploadTestService(t)
+	backend := &amountQuotaBackend{limits: map[quota.Resource]int64{quota.ResourceStorage: 4}}
+	checker, err := quota.NewCheckerWithBackend(backend, &quota.Config{})
+	assert.NoError(t, err)
+	conf.QuotaChecker = checker
+
+	meta := &kusciaapi.UploadDomainDataMeta{
+		DomainId:     mockDomainID,
+		DomaindataId: "model",
+		Type:         "model",
+		RelativeUri:  "model.bin",
+		DatasourceId: "ds-1",
+	}
+	res := s.UploadDomainData(context.Background(), meta, strings.NewReader("12345"))
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrQuotaExceeded), res.Status.Code)
+	assert.Equal(t, []quota.Request{{DomainID: mockDomainID, Resource: quota.ResourceStorage, Amount: 5}}, backend.requests)
+	entries, err := os.ReadDir(root)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	res = s.UploadDomainData(context.Background(), meta, strings.NewReader("1234"))
+	assert.Equal(t, int32(0), res.Status.Code, res.Status.Message)
+}
+
+func TestInferCSVColumns(t *testing.T) {
+	t.Parallel()
+	columns, err := inferCSVColumns([]byte("a,b,c\n1,x,\n2.5,y,\n3,z,tr"), true)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pbv1alpha1.DataColumn{
+		{Name: "a", Type: "float64"},
+		{Name: "b", Type: "str"},
+		{Name: "c", Type: "str"},
+	}, columns)
+
+	_, err = inferCSVColumns([]byte(""), false)
+	assert.Error(t, err)
+}
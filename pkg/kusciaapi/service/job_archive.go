@@ -0,0 +1,259 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/jobarchive"
+	apiutils "github.com/secretflow/kuscia/pkg/kusciaapi/utils"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// QueryArchivedJob queries the job archived by the job archive controller. The tasks are restored from the
+// summaries archived with the job, so the endpoints of the parties are not available any more.
+func (h *jobService) QueryArchivedJob(ctx context.Context, request *kusciaapi.QueryArchivedJobRequest) *kusciaapi.QueryArchivedJobResponse {
+	if request.JobId == "" {
+		return &kusciaapi.QueryArchivedJobResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils.NewFieldViolation("job_id", "job id can not be empty")),
+		}
+	}
+	if h.archiveStore == nil {
+		return &kusciaapi.QueryArchivedJobResponse{
+			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryArchivedJob, "job archive is not enabled"),
+		}
+	}
+	archived, err := h.archiveStore.Get(ctx, request.JobId)
+	if err != nil {
+		if errors.Is(err, jobarchive.ErrNotFound) {
+			err = fmt.Errorf("job %s is not archived", request.JobId)
+		}
+		return &kusciaapi.QueryArchivedJobResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryArchivedJob, err),
+		}
+	}
+	if err = h.authHandlerJobRetrieve(ctx, archived.Job); err != nil {
+		return &kusciaapi.QueryArchivedJobResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+
+	job := archived.Job
+	return &kusciaapi.QueryArchivedJobResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.QueryArchivedJobResponseData{
+			JobId:          archived.JobID,
+			Initiator:      job.Spec.Initiator,
+			MaxParallelism: apiutils.Int32Value(job.Spec.MaxParallelism),
+			Tasks:          buildTaskConfigs(job.Spec.Tasks),
+			Status:         buildArchivedJobStatus(archived),
+			CustomFields:   buildJobCustomFields(job.Labels),
+			Events:         buildArchivedJobEvents(archived.Events),
+			ArchiveTime:    archived.ArchiveTime.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+// ReportArchivedJob counts the archived jobs by the partner and the input domain data, e.g. how many jobs with a
+// partner used a domain data last quarter. The domain and the tenant callers could only report their own jobs.
+func (h *jobService) ReportArchivedJob(ctx context.Context, request *kusciaapi.ReportArchivedJobRequest) *kusciaapi.ReportArchivedJobResponse {
+	query, err := validateReportArchivedJobRequest(request)
+	if err != nil {
+		return &kusciaapi.ReportArchivedJobResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	if err = authReportArchivedJob(ctx, request.DomainId); err != nil {
+		return &kusciaapi.ReportArchivedJobResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+	if h.archiveStore == nil {
+		return &kusciaapi.ReportArchivedJobResponse{
+			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrReportArchivedJob, "job archive is not enabled"),
+		}
+	}
+	reporter, ok := h.archiveStore.(jobarchive.Reporter)
+	if !ok {
+		return &kusciaapi.ReportArchivedJobResponse{
+			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrReportArchivedJob,
+				"the job archive store doesn't support the report, please archive the jobs to mysql"),
+		}
+	}
+	rows, err := reporter.Report(ctx, query)
+	if err != nil {
+		return &kusciaapi.ReportArchivedJobResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrReportArchivedJob, err),
+		}
+	}
+
+	data := &kusciaapi.ReportArchivedJobResponseData{Rows: make([]*kusciaapi.ArchivedJobReportRow, 0, len(rows))}
+	for _, row := range rows {
+		data.Rows = append(data.Rows, &kusciaapi.ArchivedJobReportRow{
+			Partner:           row.Partner,
+			DomaindataId:      row.DomainDataID,
+			JobCount:          row.JobCount,
+			SucceededJobCount: row.SucceededJobCount,
+			TaskCount:         row.TaskCount,
+			GrantIds:          row.GrantIDs,
+		})
+	}
+	return &kusciaapi.ReportArchivedJobResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   data,
+	}
+}
+
+func validateReportArchivedJobRequest(request *kusciaapi.ReportArchivedJobRequest) (*jobarchive.ReportQuery, error) {
+	query := &jobarchive.ReportQuery{
+		Domain:       request.DomainId,
+		Partner:      request.Partner,
+		DomainDataID: request.DomaindataId,
+	}
+	if request.State != "" {
+		phase, ok := archivedJobPhases[request.State]
+		if !ok {
+			return nil, utils.NewFieldViolation("state", "state %q is not a finished job state", request.State)
+		}
+		query.Phase = string(phase)
+	}
+	var err error
+	if request.StartTime != "" {
+		if query.Since, err = time.Parse(time.RFC3339, request.StartTime); err != nil {
+			return nil, utils.NewFieldViolation("start_time", "start time %q is not in RFC3339 format", request.StartTime)
+		}
+	}
+	if request.EndTime != "" {
+		if query.Until, err = time.Parse(time.RFC3339, request.EndTime); err != nil {
+			return nil, utils.NewFieldViolation("end_time", "end time %q is not in RFC3339 format", request.EndTime)
+		}
+	}
+	if !query.Since.IsZero() && !query.Until.IsZero() && !query.Since.Before(query.Until) {
+		return nil, utils.NewFieldViolation("end_time", "end time must be after the start time")
+	}
+	return query, nil
+}
+
+// archivedJobPhases maps the states of the finished jobs to the phases in the archive.
+var archivedJobPhases = map[string]v1alpha1.KusciaJobPhase{
+	kusciaapi.JobState_Succeeded.String():      v1alpha1.KusciaJobSucceeded,
+	kusciaapi.JobState_Failed.String():         v1alpha1.KusciaJobFailed,
+	kusciaapi.JobState_Cancelled.String():      v1alpha1.KusciaJobCancelled,
+	kusciaapi.JobState_ApprovalReject.String(): v1alpha1.KusciaJobApprovalReject,
+}
+
+// authReportArchivedJob requires the domain and the tenant callers to report the jobs of their own domains.
+func authReportArchivedJob(ctx context.Context, domainID string) error {
+	if t := tenant.FromContext(ctx); t != nil && domainID == "" {
+		return fmt.Errorf("tenant %s must report the archived jobs of its domain", t.Name)
+	}
+	if err := tenant.CheckDomains(ctx, domainID); err != nil {
+		return err
+	}
+	role, callerDomainID := GetRoleAndDomainFromCtx(ctx)
+	if role == consts.AuthRoleDomain && domainID != callerDomainID {
+		return fmt.Errorf("domain's KusciaAPI could only report the archived jobs of domain %s", callerDomainID)
+	}
+	return nil
+}
+
+func buildArchivedJobStatus(archived *jobarchive.ArchivedJob) *kusciaapi.JobStatusDetail {
+	job := archived.Job
+	statusDetail := &kusciaapi.JobStatusDetail{
+		State:             getJobState(job.Status.Phase),
+		ErrMsg:            job.Status.Message,
+		CreateTime:        apiutils.TimeRfc3339String(&job.CreationTimestamp),
+		StartTime:         apiutils.TimeRfc3339String(job.Status.StartTime),
+		EndTime:           apiutils.TimeRfc3339String(job.Status.CompletionTime),
+		Tasks:             make([]*kusciaapi.TaskStatus, 0, len(archived.Tasks)),
+		StageStatusList:   make([]*kusciaapi.PartyStageStatus, 0),
+		ApproveStatusList: make([]*kusciaapi.PartyApproveStatus, 0),
+	}
+	for k, v := range job.Status.StageStatus {
+		statusDetail.StageStatusList = append(statusDetail.StageStatusList, &kusciaapi.PartyStageStatus{
+			DomainId: k,
+			State:    string(v),
+		})
+	}
+	for k, v := range job.Status.ApproveStatus {
+		statusDetail.ApproveStatusList = append(statusDetail.ApproveStatusList, &kusciaapi.PartyApproveStatus{
+			DomainId: k,
+			State:    string(v),
+		})
+	}
+
+	for _, task := range archived.Tasks {
+		ts := &kusciaapi.TaskStatus{
+			TaskId:     task.TaskID,
+			Alias:      task.Alias,
+			State:      getTaskState(task.Phase),
+			ErrMsg:     task.Message,
+			CreateTime: archivedTimeString(&task.CreateTime),
+			StartTime:  archivedTimeString(task.StartTime),
+			EndTime:    archivedTimeString(task.CompletionTime),
+			Progress:   task.Progress,
+			Parties:    make([]*kusciaapi.PartyStatus, 0, len(task.Parties)),
+		}
+		for _, party := range task.Parties {
+			ts.Parties = append(ts.Parties, &kusciaapi.PartyStatus{
+				DomainId: party.DomainID,
+				State:    getTaskState(party.Phase),
+				ErrMsg:   party.Message,
+			})
+		}
+		statusDetail.Tasks = append(statusDetail.Tasks, ts)
+	}
+	return statusDetail
+}
+
+func buildArchivedJobEvents(events []jobarchive.Event) []*kusciaapi.JobEvent {
+	jobEvents := make([]*kusciaapi.JobEvent, 0, len(events))
+	for _, ev := range events {
+		jobEvent := &kusciaapi.JobEvent{
+			ObjectKind: ev.ObjectKind,
+			ObjectName: ev.ObjectName,
+			Type:       ev.Type,
+			Reason:     ev.Reason,
+			Message:    ev.Message,
+			Count:      ev.Count,
+			FirstTime:  ev.FirstTime.UTC().Format(time.RFC3339),
+			LastTime:   ev.LastTime.UTC().Format(time.RFC3339),
+			Source:     ev.Source,
+		}
+		if ev.ObjectKind == "KusciaTask" {
+			jobEvent.TaskId = ev.ObjectName
+		}
+		jobEvents = append(jobEvents, jobEvent)
+	}
+	return jobEvents
+}
+
+func archivedTimeString(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return apiutils.TimeRfc3339String(&metav1.Time{Time: *t})
+}
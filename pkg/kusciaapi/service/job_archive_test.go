@@ -0,0 +1,173 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/jobarchive"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type fakeArchiveStore map[string]*jobarchive.ArchivedJob
+
+func (s fakeArchiveStore) Put(ctx context.Context, job *jobarchive.ArchivedJob) error {
+	s[job.JobID] = job
+	return nil
+}
+
+func (s fakeArchiveStore) Get(ctx context.Context, jobID string) (*jobarchive.ArchivedJob, error) {
+	if job, ok := s[jobID]; ok {
+		return job, nil
+	}
+	return nil, jobarchive.ErrNotFound
+}
+
+// fakeReportStore is an archive store supporting the report, it records the last query.
+type fakeReportStore struct {
+	fakeArchiveStore
+	query *jobarchive.ReportQuery
+	rows  []jobarchive.ReportRow
+}
+
+func (s *fakeReportStore) Report(ctx context.Context, query *jobarchive.ReportQuery) ([]jobarchive.ReportRow, error) {
+	s.query = query
+	return s.rows, nil
+}
+
+func TestQueryArchivedJob(t *testing.T) {
+	completionTime := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	job := newTestUsageJob("job-1", completionTime, "task-1")
+	job.Labels = map[string]string{common.JobCustomFieldsLabelPrefix + "project": "p1"}
+	task := newTestUsageTask("task-1", nil)
+	task.Status.Phase = v1alpha1.TaskSucceeded
+	task.Status.CompletionTime = &metav1.Time{Time: completionTime}
+	event := corev1.Event{
+		InvolvedObject: corev1.ObjectReference{Kind: "KusciaTask", Name: "task-1"},
+		Type:           corev1.EventTypeNormal,
+		Reason:         "Succeeded",
+		Count:          1,
+		LastTimestamp:  metav1.Time{Time: completionTime},
+	}
+	store := fakeArchiveStore{}
+	archived := jobarchive.Build(job, []*v1alpha1.KusciaTask{task}, []corev1.Event{event}, completionTime.Add(time.Hour))
+	assert.NoError(t, store.Put(context.Background(), archived))
+
+	s := NewJobService(&config.KusciaAPIConfig{
+		KusciaClient: kusciafake.NewSimpleClientset(),
+		JobArchive:   store,
+	})
+	ctx := context.Background()
+
+	res := s.QueryArchivedJob(ctx, &kusciaapi.QueryArchivedJobRequest{JobId: "job-1"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Equal(t, "alice", res.Data.Initiator)
+	assert.Equal(t, "p1", res.Data.CustomFields["project"])
+	assert.Equal(t, "2025-01-10T01:00:00Z", res.Data.ArchiveTime)
+	assert.Equal(t, "Succeeded", res.Data.Status.State)
+	assert.Len(t, res.Data.Tasks, 1)
+	assert.Len(t, res.Data.Status.Tasks, 1)
+	assert.Equal(t, "task-1", res.Data.Status.Tasks[0].TaskId)
+	assert.Equal(t, "2025-01-10T00:00:00Z", res.Data.Status.Tasks[0].EndTime)
+	assert.Len(t, res.Data.Status.Tasks[0].Parties, 2)
+	assert.Len(t, res.Data.Events, 1)
+	assert.Equal(t, "task-1", res.Data.Events[0].TaskId)
+
+	res = s.QueryArchivedJob(ctx, &kusciaapi.QueryArchivedJobRequest{})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+	res = s.QueryArchivedJob(ctx, &kusciaapi.QueryArchivedJobRequest{JobId: "job-2"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrQueryArchivedJob), res.Status.Code)
+
+	// the domain can only query the archived jobs it participates in
+	carolCtx := context.WithValue(context.WithValue(ctx, consts.AuthRole, consts.AuthRoleDomain), consts.SourceDomainKey, "carol")
+	res = s.QueryArchivedJob(carolCtx, &kusciaapi.QueryArchivedJobRequest{JobId: "job-1"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed), res.Status.Code)
+}
+
+func TestQueryArchivedJob_Disabled(t *testing.T) {
+	s := NewJobService(&config.KusciaAPIConfig{KusciaClient: kusciafake.NewSimpleClientset()})
+	res := s.QueryArchivedJob(context.Background(), &kusciaapi.QueryArchivedJobRequest{JobId: "job-1"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrQueryArchivedJob), res.Status.Code)
+}
+
+func TestReportArchivedJob(t *testing.T) {
+	store := &fakeReportStore{fakeArchiveStore: fakeArchiveStore{}, rows: []jobarchive.ReportRow{{
+		Partner: "bob", DomainDataID: "alice-table", JobCount: 3, SucceededJobCount: 2, TaskCount: 5,
+		GrantIDs: []string{"grant-1"},
+	}}}
+	s := NewJobService(&config.KusciaAPIConfig{
+		KusciaClient: kusciafake.NewSimpleClientset(),
+		JobArchive:   store,
+	})
+	ctx := context.Background()
+
+	res := s.ReportArchivedJob(ctx, &kusciaapi.ReportArchivedJobRequest{
+		DomainId: "alice", Partner: "bob", State: "Succeeded",
+		StartTime: "2025-07-01T00:00:00Z", EndTime: "2025-10-01T00:00:00Z",
+	})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Len(t, res.Data.Rows, 1)
+	assert.Equal(t, int64(3), res.Data.Rows[0].JobCount)
+	assert.Equal(t, []string{"grant-1"}, res.Data.Rows[0].GrantIds)
+	assert.Equal(t, &jobarchive.ReportQuery{
+		Domain: "alice", Partner: "bob", Phase: "Succeeded",
+		Since: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), Until: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+	}, store.query)
+
+	for _, request := range []*kusciaapi.ReportArchivedJobRequest{
+		{State: "Running"},
+		{StartTime: "2025-07-01"},
+		{StartTime: "2025-10-01T00:00:00Z", EndTime: "2025-07-01T00:00:00Z"},
+	} {
+		res = s.ReportArchivedJob(ctx, request)
+		assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+	}
+
+	// the domain and the tenant callers can only report their own jobs
+	carolCtx := context.WithValue(context.WithValue(ctx, consts.AuthRole, consts.AuthRoleDomain), consts.SourceDomainKey, "carol")
+	res = s.ReportArchivedJob(carolCtx, &kusciaapi.ReportArchivedJobRequest{DomainId: "alice"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed), res.Status.Code)
+	res = s.ReportArchivedJob(carolCtx, &kusciaapi.ReportArchivedJobRequest{DomainId: "carol"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	tenantCtx := context.WithValue(ctx, consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+	res = s.ReportArchivedJob(tenantCtx, &kusciaapi.ReportArchivedJobRequest{})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed), res.Status.Code)
+}
+
+func TestReportArchivedJob_NotSupported(t *testing.T) {
+	s := NewJobService(&config.KusciaAPIConfig{
+		KusciaClient: kusciafake.NewSimpleClientset(),
+		JobArchive:   fakeArchiveStore{},
+	})
+	res := s.ReportArchivedJob(context.Background(), &kusciaapi.ReportArchivedJobRequest{})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrReportArchivedJob), res.Status.Code)
+
+	s = NewJobService(&config.KusciaAPIConfig{KusciaClient: kusciafake.NewSimpleClientset()})
+	res = s.ReportArchivedJob(context.Background(), &kusciaapi.ReportArchivedJobRequest{})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrReportArchivedJob), res.Status.Code)
+}
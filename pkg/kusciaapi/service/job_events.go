@@ -0,0 +1,265 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/resources"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const (
+	eventObjectKindJob               = "KusciaJob"
+	eventObjectKindTask              = "KusciaTask"
+	eventObjectKindTaskResourceGroup = "TaskResourceGroup"
+	eventObjectKindTaskResource      = "TaskResource"
+	eventObjectKindPod               = "Pod"
+)
+
+// eventObject is an object whose events are listed, the namespace is empty for the cluster scoped objects.
+type eventObject struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+type eventObjectInfo struct {
+	domainID string
+	taskID   string
+}
+
+// ListEvents aggregates the events of the job, its tasks, task resource groups, and the task resources and pods
+// in the namespaces of the parties, so that the users could find out why a task is pending without kubectl.
+func (h *jobService) ListEvents(ctx context.Context, request *kusciaapi.ListEventsRequest) *kusciaapi.ListEventsResponse {
+	if err := validateListEventsRequest(request); err != nil {
+		return &kusciaapi.ListEventsResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	kusciaJob, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, request.JobId, metav1.GetOptions{})
+	if err != nil {
+		return &kusciaapi.ListEventsResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryJob, err),
+		}
+	}
+	if err = h.authHandlerJobRetrieve(ctx, kusciaJob); err != nil {
+		return &kusciaapi.ListEventsResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+
+	objects := map[eventObject]eventObjectInfo{
+		{kind: eventObjectKindJob, namespace: common.KusciaCrossDomain, name: kusciaJob.Name}: {},
+	}
+	// the parties of the tasks, the task id is filled by the job controller
+	taskParties := map[string][]string{}
+	taskFound := false
+	for _, task := range kusciaJob.Spec.Tasks {
+		if task.TaskID == "" || (request.TaskId != "" && task.TaskID != request.TaskId) {
+			continue
+		}
+		taskFound = true
+		taskParties[task.TaskID] = nil
+		for _, p := range task.Parties {
+			if request.DomainId == "" || p.DomainID == request.DomainId {
+				taskParties[task.TaskID] = append(taskParties[task.TaskID], p.DomainID)
+			}
+		}
+	}
+	if request.TaskId != "" && !taskFound {
+		return &kusciaapi.ListEventsResponse{
+			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, fmt.Sprintf("task %s doesn't belong to job %s", request.TaskId, request.JobId)),
+		}
+	}
+
+	domainTasks := map[string][]string{}
+	for taskID, parties := range taskParties {
+		objects[eventObject{kind: eventObjectKindTask, namespace: common.KusciaCrossDomain, name: taskID}] = eventObjectInfo{taskID: taskID}
+		objects[eventObject{kind: eventObjectKindTaskResourceGroup, name: taskID}] = eventObjectInfo{taskID: taskID}
+		trg, err := h.kusciaClient.KusciaV1alpha1().TaskResourceGroups().Get(ctx, taskID, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			trg = nil
+		} else if err != nil {
+			return &kusciaapi.ListEventsResponse{
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrListJobEvents, err),
+			}
+		}
+		for _, domainID := range parties {
+			domainTasks[domainID] = append(domainTasks[domainID], taskID)
+			if trg == nil {
+				continue
+			}
+			for _, p := range trg.Spec.Parties {
+				if p.DomainID == domainID && p.TaskResourceName != "" {
+					objects[eventObject{kind: eventObjectKindTaskResource, namespace: domainID, name: p.TaskResourceName}] = eventObjectInfo{domainID: domainID, taskID: taskID}
+				}
+			}
+		}
+	}
+
+	// the pods of the tasks in the namespaces of the parties
+	for domainID, taskIDs := range domainTasks {
+		sort.Strings(taskIDs)
+		requirement, err := labels.NewRequirement(common.LabelTaskID, selection.In, taskIDs)
+		if err != nil {
+			return &kusciaapi.ListEventsResponse{
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrListJobEvents, err),
+			}
+		}
+		pods, err := h.kubeClient.CoreV1().Pods(domainID).List(ctx, metav1.ListOptions{LabelSelector: labels.NewSelector().Add(*requirement).String()})
+		if err != nil {
+			return &kusciaapi.ListEventsResponse{
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrListJobEvents, err),
+			}
+		}
+		for _, pod := range pods.Items {
+			objects[eventObject{kind: eventObjectKindPod, namespace: domainID, name: pod.Name}] = eventObjectInfo{domainID: domainID, taskID: pod.Labels[common.LabelTaskID]}
+		}
+	}
+
+	events, err := h.listObjectEvents(ctx, objects, request.Type)
+	if err != nil {
+		return &kusciaapi.ListEventsResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrListJobEvents, err),
+		}
+	}
+	return &kusciaapi.ListEventsResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   &kusciaapi.ListEventsResponseData{Events: events},
+	}
+}
+
+// listObjectEvents lists the events of each namespace once, and merges the duplicate events of the same object.
+func (h *jobService) listObjectEvents(ctx context.Context, objects map[eventObject]eventObjectInfo, eventType string) ([]*kusciaapi.JobEvent, error) {
+	namespaces := map[string]bool{}
+	for obj := range objects {
+		namespace := obj.namespace
+		if namespace == "" {
+			// the events of the cluster scoped objects are recorded in the default namespace
+			namespace = metav1.NamespaceDefault
+		}
+		namespaces[namespace] = true
+	}
+
+	type eventKey struct {
+		object                 eventObject
+		eventType, reason, msg string
+	}
+	type mergedEvent struct {
+		event     *kusciaapi.JobEvent
+		firstTime time.Time
+		lastTime  time.Time
+	}
+	merged := map[eventKey]*mergedEvent{}
+	for namespace := range namespaces {
+		list, err := h.kubeClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("list events of namespace %s failed, %s", namespace, err.Error())
+		}
+		for i := range list.Items {
+			ev := &list.Items[i]
+			obj := eventObject{kind: ev.InvolvedObject.Kind, namespace: ev.InvolvedObject.Namespace, name: ev.InvolvedObject.Name}
+			info, ok := objects[obj]
+			if !ok || (eventType != "" && ev.Type != eventType) {
+				continue
+			}
+			firstTime, lastTime, count := resources.EventTimes(ev)
+			key := eventKey{object: obj, eventType: ev.Type, reason: ev.Reason, msg: ev.Message}
+			if m, ok := merged[key]; ok {
+				m.event.Count += count
+				if firstTime.Before(m.firstTime) {
+					m.firstTime = firstTime
+				}
+				if lastTime.After(m.lastTime) {
+					m.lastTime = lastTime
+				}
+				continue
+			}
+			merged[key] = &mergedEvent{
+				event: &kusciaapi.JobEvent{
+					ObjectKind: obj.kind,
+					ObjectName: obj.name,
+					DomainId:   info.domainID,
+					TaskId:     info.taskID,
+					Type:       ev.Type,
+					Reason:     ev.Reason,
+					Message:    ev.Message,
+					Count:      count,
+					Source:     eventSource(ev),
+				},
+				firstTime: firstTime,
+				lastTime:  lastTime,
+			}
+		}
+	}
+
+	list := make([]*mergedEvent, 0, len(merged))
+	for _, m := range merged {
+		m.event.FirstTime = m.firstTime.UTC().Format(time.RFC3339)
+		m.event.LastTime = m.lastTime.UTC().Format(time.RFC3339)
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if !a.lastTime.Equal(b.lastTime) {
+			return a.lastTime.Before(b.lastTime)
+		}
+		if !a.firstTime.Equal(b.firstTime) {
+			return a.firstTime.Before(b.firstTime)
+		}
+		if a.event.ObjectKind != b.event.ObjectKind {
+			return a.event.ObjectKind < b.event.ObjectKind
+		}
+		if a.event.ObjectName != b.event.ObjectName {
+			return a.event.ObjectName < b.event.ObjectName
+		}
+		return a.event.Reason < b.event.Reason
+	})
+	events := make([]*kusciaapi.JobEvent, 0, len(list))
+	for _, m := range list {
+		events = append(events, m.event)
+	}
+	return events, nil
+}
+
+func eventSource(ev *corev1.Event) string {
+	if ev.Source.Component != "" {
+		return ev.Source.Component
+	}
+	return ev.ReportingController
+}
+
+func validateListEventsRequest(request *kusciaapi.ListEventsRequest) error {
+	if request.JobId == "" {
+		return utils.NewFieldViolation("job_id", "job id can not be empty")
+	}
+	if request.Type != "" && request.Type != corev1.EventTypeNormal && request.Type != corev1.EventTypeWarning {
+		return utils.NewFieldViolation("type", "type must be %s or %s", corev1.EventTypeNormal, corev1.EventTypeWarning)
+	}
+	return nil
+}
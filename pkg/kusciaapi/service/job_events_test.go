@@ -0,0 +1,115 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func newTestEvent(name, namespace, kind, objNamespace, objName, eventType, reason string, last time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Namespace: objNamespace, Name: objName},
+		Type:           eventType,
+		Reason:         reason,
+		Message:        reason + " message",
+		Count:          1,
+		FirstTimestamp: metav1.NewTime(last.Add(-time.Minute)),
+		LastTimestamp:  metav1.NewTime(last),
+		Source:         corev1.EventSource{Component: "kuscia"},
+	}
+}
+
+func TestListEvents(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	job := &v1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "job-1", Namespace: common.KusciaCrossDomain},
+		Spec: v1alpha1.KusciaJobSpec{Initiator: "alice", Tasks: []v1alpha1.KusciaTaskTemplate{
+			{TaskID: "task-1", Parties: []v1alpha1.Party{{DomainID: "alice"}, {DomainID: "bob"}}},
+		}},
+	}
+	trg := &v1alpha1.TaskResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "task-1"},
+		Spec: v1alpha1.TaskResourceGroupSpec{Parties: []v1alpha1.TaskResourceGroupParty{
+			{DomainID: "alice", TaskResourceName: "task-1-tr-alice"},
+			{DomainID: "bob", TaskResourceName: "task-1-tr-bob"},
+		}},
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "task-1-0", Namespace: "bob", Labels: map[string]string{common.LabelTaskID: "task-1"}}}
+	otherPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "task-2-0", Namespace: "bob", Labels: map[string]string{common.LabelTaskID: "task-2"}}}
+	kubeClient := kubefake.NewSimpleClientset(pod, otherPod,
+		newTestEvent("e1", common.KusciaCrossDomain, "KusciaJob", common.KusciaCrossDomain, "job-1", corev1.EventTypeNormal, "Created", now.Add(-5*time.Minute)),
+		newTestEvent("e2", common.KusciaCrossDomain, "KusciaTask", common.KusciaCrossDomain, "task-1", corev1.EventTypeNormal, "Pending", now.Add(-4*time.Minute)),
+		newTestEvent("e3", metav1.NamespaceDefault, "TaskResourceGroup", "", "task-1", corev1.EventTypeWarning, "Reserving", now.Add(-3*time.Minute)),
+		newTestEvent("e4", "bob", "Pod", "bob", "task-1-0", corev1.EventTypeWarning, "FailedScheduling", now.Add(-2*time.Minute)),
+		// duplicate of e4 recorded by another recorder
+		newTestEvent("e5", "bob", "Pod", "bob", "task-1-0", corev1.EventTypeWarning, "FailedScheduling", now.Add(-time.Minute)),
+		newTestEvent("e6", "alice", "TaskResource", "alice", "task-1-tr-alice", corev1.EventTypeNormal, "Reserved", now),
+		// events of the other objects
+		newTestEvent("e7", "bob", "Pod", "bob", "task-2-0", corev1.EventTypeWarning, "FailedScheduling", now),
+		newTestEvent("e8", common.KusciaCrossDomain, "KusciaJob", common.KusciaCrossDomain, "job-2", corev1.EventTypeNormal, "Created", now),
+	)
+	s := NewJobService(&config.KusciaAPIConfig{
+		KusciaClient: kusciafake.NewSimpleClientset(job, trg),
+		KubeClient:   kubeClient,
+	})
+	ctx := context.Background()
+
+	res := s.ListEvents(ctx, &kusciaapi.ListEventsRequest{JobId: "job-1"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	var reasons []string
+	for _, e := range res.Data.Events {
+		reasons = append(reasons, e.ObjectKind+"/"+e.Reason)
+	}
+	assert.Equal(t, []string{"KusciaJob/Created", "KusciaTask/Pending", "TaskResourceGroup/Reserving", "Pod/FailedScheduling", "TaskResource/Reserved"}, reasons)
+	podEvent := res.Data.Events[3]
+	assert.Equal(t, int32(2), podEvent.Count)
+	assert.Equal(t, "bob", podEvent.DomainId)
+	assert.Equal(t, "task-1", podEvent.TaskId)
+	assert.Equal(t, now.Add(-3*time.Minute).UTC().Format(time.RFC3339), podEvent.FirstTime)
+	assert.Equal(t, now.Add(-time.Minute).UTC().Format(time.RFC3339), podEvent.LastTime)
+	assert.Equal(t, "kuscia", podEvent.Source)
+
+	res = s.ListEvents(ctx, &kusciaapi.ListEventsRequest{JobId: "job-1", DomainId: "bob", Type: corev1.EventTypeWarning})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Len(t, res.Data.Events, 2)
+
+	res = s.ListEvents(ctx, &kusciaapi.ListEventsRequest{JobId: "job-1", TaskId: "task-2"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+	res = s.ListEvents(ctx, &kusciaapi.ListEventsRequest{JobId: "job-1", Type: "Error"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+	res = s.ListEvents(ctx, &kusciaapi.ListEventsRequest{JobId: "job-2"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrQueryJob), res.Status.Code)
+
+	carolCtx := context.WithValue(ctx, consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+	res = s.ListEvents(carolCtx, &kusciaapi.ListEventsRequest{JobId: "job-1"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed), res.Status.Code)
+}
@@ -21,26 +21,33 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
+	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/jobarchive"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/proxy"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/utils"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/quota"
 	"github.com/secretflow/kuscia/pkg/utils/resources"
 	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	utils2 "github.com/secretflow/kuscia/pkg/web/utils"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
@@ -56,11 +63,23 @@ type IJobService interface {
 	SuspendJob(ctx context.Context, request *kusciaapi.SuspendJobRequest) *kusciaapi.SuspendJobResponse
 	RestartJob(ctx context.Context, request *kusciaapi.RestartJobRequest) *kusciaapi.RestartJobResponse
 	CancelJob(ctx context.Context, request *kusciaapi.CancelJobRequest) *kusciaapi.CancelJobResponse
+	BatchCancelJob(ctx context.Context, request *kusciaapi.BatchCancelJobRequest) *kusciaapi.BatchJobOperationResponse
+	BatchApproveJob(ctx context.Context, request *kusciaapi.BatchApproveJobRequest) *kusciaapi.BatchJobOperationResponse
+	ListEvents(ctx context.Context, request *kusciaapi.ListEventsRequest) *kusciaapi.ListEventsResponse
+	QueryJobUsage(ctx context.Context, request *kusciaapi.QueryJobUsageRequest) *kusciaapi.QueryJobUsageResponse
+	ListJobUsage(ctx context.Context, request *kusciaapi.ListJobUsageRequest) *kusciaapi.ListJobUsageResponse
+	QueryArchivedJob(ctx context.Context, request *kusciaapi.QueryArchivedJobRequest) *kusciaapi.QueryArchivedJobResponse
+	ReportArchivedJob(ctx context.Context, request *kusciaapi.ReportArchivedJobRequest) *kusciaapi.ReportArchivedJobResponse
 }
 
 type jobService struct {
 	Initiator    string
 	kusciaClient kusciaclientset.Interface
+	kubeClient   kubernetes.Interface
+	quotaChecker *quota.Checker
+	batchConf    *config.BatchJobConfig
+	batchLimiter *rate.Limiter
+	archiveStore jobarchive.Store
 }
 
 func NewJobService(config *config.KusciaAPIConfig) IJobService {
@@ -74,6 +93,11 @@ func NewJobService(config *config.KusciaAPIConfig) IJobService {
 		return &jobService{
 			Initiator:    config.Initiator,
 			kusciaClient: config.KusciaClient,
+			kubeClient:   config.KubeClient,
+			quotaChecker: config.QuotaChecker,
+			batchConf:    config.BatchJob,
+			batchLimiter: newBatchLimiter(config.BatchJob),
+			archiveStore: config.JobArchive,
 		}
 	}
 }
@@ -82,13 +106,19 @@ func (h *jobService) CreateJob(ctx context.Context, request *kusciaapi.CreateJob
 	// do validate
 	if err := validateCreateJobRequest(request, h.Initiator); err != nil {
 		return &kusciaapi.CreateJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// auth handler
 	if err := h.authHandlerJobCreate(ctx, request); err != nil {
 		return &kusciaapi.CreateJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+	// quota check
+	if err := h.quotaChecker.Check(ctx, &quota.Request{DomainID: request.Initiator, Resource: quota.ResourceJob, Amount: 1}); err != nil {
+		return &kusciaapi.CreateJobResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrQuotaExceeded, err),
 		}
 	}
 	// convert createJobRequest to kuscia job
@@ -169,6 +199,17 @@ func (h *jobService) CreateJob(ctx context.Context, request *kusciaapi.CreateJob
 			kusciaTask.ScheduleConfig = buildScheduleConfigForKusciaTask(task.ScheduleConfig)
 		}
 
+		if task.SensitiveInputConfig != "" || len(task.EncryptedSensitiveInputConfigs) > 0 {
+			sensitiveConfigs, err := h.buildSensitiveInputConfigs(ctx, task)
+			if err != nil {
+				return &kusciaapi.CreateJobResponse{
+					Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+						utils2.NewFieldViolation(fmt.Sprintf("tasks[%d].sensitive_input_config", i), "%s", err.Error())),
+				}
+			}
+			kusciaTask.SensitiveInputConfigs = sensitiveConfigs
+		}
+
 		kusciaTasks[i] = kusciaTask
 	}
 
@@ -195,7 +236,7 @@ func (h *jobService) CreateJob(ctx context.Context, request *kusciaapi.CreateJob
 	_, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Create(ctx, kusciaJob, metav1.CreateOptions{})
 	if err != nil {
 		return &kusciaapi.CreateJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrCreateJob, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrCreateJob, err),
 		}
 	}
 	return &kusciaapi.CreateJobResponse{
@@ -242,20 +283,40 @@ func (h *jobService) QueryJob(ctx context.Context, request *kusciaapi.QueryJobRe
 	kusciaJob, jobStatus, err := h.buildJobStatusByID(ctx, jobID)
 	if err != nil {
 		return &kusciaapi.QueryJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrQueryJob, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrQueryJob, err),
 		}
 	}
-	// custom fields
+	kusciaJobSpec := kusciaJob.Spec
+
+	// build job response
+	jobResponse := &kusciaapi.QueryJobResponse{
+		Status: utils2.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.QueryJobResponseData{
+			JobId:          jobID,
+			Initiator:      kusciaJobSpec.Initiator,
+			MaxParallelism: utils.Int32Value(kusciaJobSpec.MaxParallelism),
+			Tasks:          buildTaskConfigs(kusciaJobSpec.Tasks),
+			Status:         jobStatus,
+			CustomFields:   buildJobCustomFields(kusciaJob.Labels),
+			DeletionStatus: buildDeletionStatus(kusciaJob),
+		},
+	}
+	return jobResponse
+}
+
+// buildJobCustomFields returns the custom fields of the job stored in the labels.
+func buildJobCustomFields(labels map[string]string) map[string]string {
 	prefixLen := len(common.JobCustomFieldsLabelPrefix)
 	customFields := map[string]string{}
-	for k, v := range kusciaJob.Labels {
+	for k, v := range labels {
 		if strings.HasPrefix(k, common.JobCustomFieldsLabelPrefix) {
 			customFields[k[prefixLen:]] = v
 		}
 	}
-	// build task config
-	kusciaJobSpec := kusciaJob.Spec
-	kusciaTasks := kusciaJobSpec.Tasks
+	return customFields
+}
+
+func buildTaskConfigs(kusciaTasks []v1alpha1.KusciaTaskTemplate) []*kusciaapi.TaskConfig {
 	taskConfigs := make([]*kusciaapi.TaskConfig, len(kusciaTasks))
 	for i, task := range kusciaTasks {
 		// build task parties
@@ -293,20 +354,7 @@ func (h *jobService) QueryJob(ctx context.Context, request *kusciaapi.QueryJobRe
 
 		taskConfigs[i] = taskConfig
 	}
-
-	// build job response
-	jobResponse := &kusciaapi.QueryJobResponse{
-		Status: utils2.BuildSuccessResponseStatus(),
-		Data: &kusciaapi.QueryJobResponseData{
-			JobId:          jobID,
-			Initiator:      kusciaJobSpec.Initiator,
-			MaxParallelism: utils.Int32Value(kusciaJobSpec.MaxParallelism),
-			Tasks:          taskConfigs,
-			Status:         jobStatus,
-			CustomFields:   customFields,
-		},
-	}
-	return jobResponse
+	return taskConfigs
 }
 
 func buildScheduleConfigForKusciaAPI(sc *v1alpha1.ScheduleConfig) *kusciaapi.ScheduleConfig {
@@ -341,24 +389,40 @@ func (h *jobService) DeleteJob(ctx context.Context, request *kusciaapi.DeleteJob
 			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "job id can not be empty"),
 		}
 	}
+	if err := validateDeletionWaitTimeout(request.WaitTimeoutSeconds); err != nil {
+		return &kusciaapi.DeleteJobResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
 	// auth handler
 	if err := h.authHandlerJobDelete(ctx, jobID); err != nil {
 		return &kusciaapi.DeleteJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 	// delete kuscia job
 	err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Delete(ctx, jobID, metav1.DeleteOptions{})
 	if err != nil {
 		return &kusciaapi.DeleteJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrDeleteJob, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDeleteJob, err),
+		}
+	}
+	deletionStatus, err := checkDeletion(ctx, func(ctx context.Context) (metav1.Object, error) {
+		return h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
+	}, request.WaitForDeletion, request.WaitTimeoutSeconds)
+	data := &kusciaapi.DeleteJobResponseData{
+		JobId:          jobID,
+		DeletionStatus: deletionStatus,
+	}
+	if err != nil {
+		return &kusciaapi.DeleteJobResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrDeleteJob, err),
+			Data:   data,
 		}
 	}
 	return &kusciaapi.DeleteJobResponse{
 		Status: utils2.BuildSuccessResponseStatus(),
-		Data: &kusciaapi.DeleteJobResponseData{
-			JobId: jobID,
-		},
+		Data:   data,
 	}
 }
 
@@ -381,14 +445,14 @@ func (h *jobService) StopJob(ctx context.Context, request *kusciaapi.StopJobRequ
 	job, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.StopJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrStopJob, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrStopJob, err),
 		}
 	}
 
 	// auth pre handler
 	if err = h.authHandlerJobRetrieve(ctx, job); err != nil {
 		return &kusciaapi.StopJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 
@@ -398,7 +462,7 @@ func (h *jobService) StopJob(ctx context.Context, request *kusciaapi.StopJobRequ
 		_, err = h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Update(ctx, job, metav1.UpdateOptions{})
 		if err != nil {
 			return &kusciaapi.StopJobResponse{
-				Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrStopJob, err.Error()),
+				Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrStopJob, err),
 			}
 		}
 	}
@@ -427,28 +491,43 @@ func (h *jobService) ApproveJob(ctx context.Context, request *kusciaapi.ApproveJ
 	}
 
 	// get domain from context
-	role, domainID := GetRoleAndDomainFromCtx(ctx)
-	var domainIDs []string
+	_, domainID := GetRoleAndDomainFromCtx(ctx)
 	if len(domainID) == 0 {
 		return &kusciaapi.ApproveJobResponse{
 			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "source domain header must be set"),
 		}
 	}
-	job, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
-	if err != nil {
+	if status := h.approveJob(ctx, jobID, request.Result, request.Reason, false); status != nil {
 		return &kusciaapi.ApproveJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrApproveJob, err.Error()),
+			Status: status,
 		}
 	}
+	return &kusciaapi.ApproveJobResponse{
+		Status: utils2.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.ApproveJobResponseData{
+			JobId: jobID,
+		},
+	}
+}
+
+// approveJob sets the approve status of the job for the parties of the caller, it returns nil on success.
+func (h *jobService) approveJob(ctx context.Context, jobID string, result kusciaapi.ApproveResult, reason string, dryRun bool) *pbv1alpha1.Status {
+	role, domainID := GetRoleAndDomainFromCtx(ctx)
+	var domainIDs []string
+	job, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
+	if err != nil {
+		return utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrApproveJob, err)
+	}
 	// auth handler
 	if authErr := h.authHandlerJob(ctx, job); authErr != nil {
-		return &kusciaapi.ApproveJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, authErr.Error()),
-		}
+		return utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, authErr)
 	}
-	nlog.Infof("approve job %s result %v reason %s", jobID, request.Result, request.Reason)
+	if dryRun {
+		return nil
+	}
+	nlog.Infof("approve job %s result %v reason %s", jobID, result, reason)
 	approveStatus := v1alpha1.JobRejected
-	if request.Result == kusciaapi.ApproveResult_APPROVE_RESULT_ACCEPT {
+	if result == kusciaapi.ApproveResult_APPROVE_RESULT_ACCEPT {
 		approveStatus = v1alpha1.JobAccepted
 	}
 	if role == consts.AuthRoleMaster {
@@ -456,6 +535,10 @@ func (h *jobService) ApproveJob(ctx context.Context, request *kusciaapi.ApproveJ
 		if selfParties, ok := job.Annotations[common.InterConnSelfPartyAnnotationKey]; ok {
 			domainIDs = annotationToDomainList(selfParties)
 		}
+		// the tenant just approval the parties of its domains
+		if t := tenant.FromContext(ctx); t != nil {
+			domainIDs = slices.DeleteFunc(domainIDs, func(id string) bool { return !t.Allows(id) })
+		}
 	} else { // role is domain
 		// domain just approval itself party not all self cluster parties
 		domainIDs = append(domainIDs, domainID)
@@ -469,16 +552,9 @@ func (h *jobService) ApproveJob(ctx context.Context, request *kusciaapi.ApproveJ
 	// update kuscia job approve status
 	_, err = h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).UpdateStatus(ctx, job, metav1.UpdateOptions{})
 	if err != nil {
-		return &kusciaapi.ApproveJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrApproveJob, err.Error()),
-		}
-	}
-	return &kusciaapi.ApproveJobResponse{
-		Status: utils2.BuildSuccessResponseStatus(),
-		Data: &kusciaapi.ApproveJobResponseData{
-			JobId: jobID,
-		},
+		return utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrApproveJob, err)
 	}
+	return nil
 }
 
 func (h *jobService) SuspendJob(ctx context.Context, request *kusciaapi.SuspendJobRequest) *kusciaapi.SuspendJobResponse {
@@ -499,13 +575,13 @@ func (h *jobService) SuspendJob(ctx context.Context, request *kusciaapi.SuspendJ
 	job, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.SuspendJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrSuspendJob, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrSuspendJob, err),
 		}
 	}
 	// auth handler
 	if authErr := h.authHandlerJob(ctx, job); authErr != nil {
 		return &kusciaapi.SuspendJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, authErr.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, authErr),
 		}
 	}
 	if job.Status.Phase != v1alpha1.KusciaJobRunning {
@@ -520,7 +596,7 @@ func (h *jobService) SuspendJob(ctx context.Context, request *kusciaapi.SuspendJ
 	_, err = h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Update(ctx, job, metav1.UpdateOptions{})
 	if err != nil {
 		return &kusciaapi.SuspendJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrSuspendJob, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrSuspendJob, err),
 		}
 	}
 	return &kusciaapi.SuspendJobResponse{
@@ -549,13 +625,13 @@ func (h *jobService) RestartJob(ctx context.Context, request *kusciaapi.RestartJ
 	job, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.RestartJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRestartJob, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRestartJob, err),
 		}
 	}
 	// auth handler
 	if authErr := h.authHandlerJob(ctx, job); authErr != nil {
 		return &kusciaapi.RestartJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, authErr.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, authErr),
 		}
 	}
 	if job.Status.Phase != v1alpha1.KusciaJobFailed && job.Status.Phase != v1alpha1.KusciaJobSuspended {
@@ -572,7 +648,7 @@ func (h *jobService) RestartJob(ctx context.Context, request *kusciaapi.RestartJ
 		_, err = h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Update(ctx, job, metav1.UpdateOptions{})
 		if err != nil {
 			return &kusciaapi.RestartJobResponse{
-				Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrSuspendJob, err.Error()),
+				Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrSuspendJob, err),
 			}
 		}
 	}
@@ -599,33 +675,40 @@ func (h *jobService) CancelJob(ctx context.Context, request *kusciaapi.CancelJob
 			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "source domain header must be set"),
 		}
 	}
-	job, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
-	if err != nil {
+	if status := h.cancelJob(ctx, jobID, request.Reason, false); status != nil {
 		return &kusciaapi.CancelJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrCancelJob, err.Error()),
+			Status: status,
 		}
 	}
+	return &kusciaapi.CancelJobResponse{
+		Status: utils2.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.CancelJobResponseData{
+			JobId: jobID,
+		},
+	}
+}
+
+// cancelJob moves the job to the cancel stage, it returns nil on success.
+func (h *jobService) cancelJob(ctx context.Context, jobID, reason string, dryRun bool) *pbv1alpha1.Status {
+	job, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
+	if err != nil {
+		return utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrCancelJob, err)
+	}
 	// auth handler
 	if authErr := h.authHandlerJob(ctx, job); authErr != nil {
-		return &kusciaapi.CancelJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, authErr.Error()),
-		}
+		return utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed, authErr)
 	}
-	nlog.Infof("Cancel job: %s, reason: %s", jobID, request.Reason)
+	if dryRun {
+		return nil
+	}
+	nlog.Infof("Cancel job: %s, reason: %s", jobID, reason)
 	// cancel kuscia job
 	h.setJobStage(job, v1alpha1.JobCancelStage, h.Initiator)
 	_, err = h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Update(ctx, job, metav1.UpdateOptions{})
 	if err != nil {
-		return &kusciaapi.CancelJobResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrCancelJob, err.Error()),
-		}
-	}
-	return &kusciaapi.CancelJobResponse{
-		Status: utils2.BuildSuccessResponseStatus(),
-		Data: &kusciaapi.CancelJobResponseData{
-			JobId: jobID,
-		},
+		return utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrCancelJob, err)
 	}
+	return nil
 }
 
 func (h *jobService) setJobStage(job *v1alpha1.KusciaJob, stage v1alpha1.JobStage, domain string) {
@@ -653,7 +736,7 @@ func (h *jobService) BatchQueryJobStatus(ctx context.Context, request *kusciaapi
 	jobIDs := request.JobIds
 	if err := validateBatchQueryJobStatusRequest(request); err != nil {
 		return &kusciaapi.BatchQueryJobStatusResponse{
-			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// build job status
@@ -662,7 +745,7 @@ func (h *jobService) BatchQueryJobStatus(ctx context.Context, request *kusciaapi
 		_, jobStatusDetail, err := h.buildJobStatusByID(ctx, jobID)
 		if err != nil {
 			return &kusciaapi.BatchQueryJobStatusResponse{
-				Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrQueryJobStatus, err.Error()),
+				Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrQueryJobStatus, err),
 			}
 		}
 		jobStatuses[i] = &kusciaapi.JobStatus{
@@ -934,7 +1017,27 @@ func (h *jobService) buildJobStatus(ctx context.Context, kusciaJob *v1alpha1.Kus
 	}, nil
 }
 
+// authTenantJob checks whether the tenant of the caller is bound to the initiator or any participant of the job.
+func authTenantJob(ctx context.Context, kusciaJob *v1alpha1.KusciaJob) error {
+	t := tenant.FromContext(ctx)
+	if t == nil || t.Allows(kusciaJob.Spec.Initiator) {
+		return nil
+	}
+	for _, task := range kusciaJob.Spec.Tasks {
+		for _, p := range task.Parties {
+			if t.Allows(p.DomainID) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("tenant %s could only operate the job that its domains as participants in the job", t.Name)
+}
+
 func (h *jobService) authHandlerJobCreate(ctx context.Context, request *kusciaapi.CreateJobRequest) error {
+	// the tenant could only create the jobs initiated by its domains
+	if err := tenant.CheckDomains(ctx, request.Initiator); err != nil {
+		return err
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if domainID == request.Initiator {
 		return nil
@@ -954,6 +1057,15 @@ func (h *jobService) authHandlerJobCreate(ctx context.Context, request *kusciaap
 
 func (h *jobService) authHandlerJobDelete(ctx context.Context, jobID string) error {
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
+	if tenant.FromContext(ctx) != nil {
+		kusciaJob, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := authTenantJob(ctx, kusciaJob); err != nil {
+			return err
+		}
+	}
 	if role == consts.AuthRoleDomain {
 		kusciaJob, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, jobID, metav1.GetOptions{})
 		if err != nil {
@@ -975,6 +1087,9 @@ func (h *jobService) authHandlerJobDelete(ctx context.Context, jobID string) err
 }
 
 func (h *jobService) authHandlerJobRetrieve(ctx context.Context, kusciaJob *v1alpha1.KusciaJob) error {
+	if err := authTenantJob(ctx, kusciaJob); err != nil {
+		return err
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if domainID == kusciaJob.Spec.Initiator {
 		return nil
@@ -993,6 +1108,9 @@ func (h *jobService) authHandlerJobRetrieve(ctx context.Context, kusciaJob *v1al
 }
 
 func (h *jobService) authHandlerJobWatch(ctx context.Context, kusciaJob *v1alpha1.KusciaJob) bool {
+	if authTenantJob(ctx, kusciaJob) != nil {
+		return false
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if domainID == kusciaJob.Spec.Initiator {
 		return true
@@ -1011,6 +1129,9 @@ func (h *jobService) authHandlerJobWatch(ctx context.Context, kusciaJob *v1alpha
 }
 
 func (h *jobService) authHandlerJob(ctx context.Context, kusciaJob *v1alpha1.KusciaJob) error {
+	if err := authTenantJob(ctx, kusciaJob); err != nil {
+		return err
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if domainID == kusciaJob.Spec.Initiator {
 		return nil
@@ -1032,15 +1153,15 @@ func validateCreateJobRequest(request *kusciaapi.CreateJobRequest, domainID stri
 	// jobID can not be empty
 	jobID := request.JobId
 	if jobID == "" {
-		return fmt.Errorf("job id can not be empty")
+		return utils2.NewFieldViolation("job_id", "job id can not be empty")
 	}
 	// do k8s validate
 	if err := resources.ValidateK8sName(request.JobId, "job_id"); err != nil {
-		return err
+		return utils2.NewFieldViolation("job_id", "%s", err.Error())
 	}
 	// tasks can not be empty
 	if len(request.Tasks) == 0 {
-		return fmt.Errorf("tasks can not be empty")
+		return utils2.NewFieldViolation("tasks", "tasks can not be empty")
 	}
 	// check initiator
 	if err := validateInitiator(domainID, request.Initiator, request.Tasks); err != nil {
@@ -1055,38 +1176,42 @@ func validateCreateJobRequest(request *kusciaapi.CreateJobRequest, domainID stri
 	tasks := request.Tasks
 	for i, task := range tasks {
 		if task.Alias == "" {
-			return fmt.Errorf("task alias can not be empty on tasks[%d]", i)
+			return utils2.NewFieldViolation(fmt.Sprintf("tasks[%d].alias", i), "task alias can not be empty on tasks[%d]", i)
 		}
 		parties := task.Parties
 		if len(parties) == 0 {
-			return fmt.Errorf("parties can not be empty on tasks[%d]", i)
+			return utils2.NewFieldViolation(fmt.Sprintf("tasks[%d].parties", i), "parties can not be empty on tasks[%d]", i)
 		}
-		for _, party := range parties {
+		for j, party := range parties {
 			if party.DomainId == "" {
-				return fmt.Errorf("party domain id can not be empty")
+				return utils2.NewFieldViolation(fmt.Sprintf("tasks[%d].parties[%d].domain_id", i, j), "party domain id can not be empty")
 			}
 		}
+		// the kusciaapi of master has no domain id, it's the centralized mode
+		if err := validateSensitiveInputConfig(i, task, domainID == ""); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func validateInitiator(domainID, initiator string, tasks []*kusciaapi.Task) error {
 	if initiator == "" {
-		return fmt.Errorf("initiator can not be empty")
+		return utils2.NewFieldViolation("initiator", "initiator can not be empty")
 	}
 	if domainID != "" && domainID != initiator {
-		return fmt.Errorf("initiator is %s, but initiator must be %s in P2P", initiator, domainID)
+		return utils2.NewFieldViolation("initiator", "initiator is %s, but initiator must be %s in P2P", initiator, domainID)
 	}
 	return nil
 }
 
 func validateBatchQueryJobStatusRequest(request *kusciaapi.BatchQueryJobStatusRequest) error {
 	if len(request.JobIds) == 0 {
-		return fmt.Errorf("job ids can not be empty")
+		return utils2.NewFieldViolation("job_ids", "job ids can not be empty")
 	}
-	for _, jobID := range request.JobIds {
+	for i, jobID := range request.JobIds {
 		if jobID == "" {
-			return fmt.Errorf("job id can not be empty")
+			return utils2.NewFieldViolation(fmt.Sprintf("job_ids[%d]", i), "job id can not be empty")
 		}
 	}
 	return nil
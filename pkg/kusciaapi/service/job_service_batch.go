@@ -0,0 +1,210 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/web/ratelimit"
+	utils2 "github.com/secretflow/kuscia/pkg/web/utils"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const (
+	defaultBatchMaxJobs = 500
+	defaultBatchWorkers = 16
+	defaultBatchQPS     = 50
+)
+
+// newBatchLimiter returns the token bucket shared by the batch operations, so that they can't flood the apiserver
+// with job updates however many requests are sent.
+func newBatchLimiter(conf *config.BatchJobConfig) *rate.Limiter {
+	bucket := &ratelimit.TokenBucketConfig{QPS: defaultBatchQPS}
+	if conf != nil && conf.RateLimit != nil {
+		bucket = conf.RateLimit
+	}
+	return ratelimit.NewTokenBucket(bucket)
+}
+
+func (h *jobService) batchMaxJobs() int {
+	if h.batchConf != nil && h.batchConf.MaxJobs > 0 {
+		return h.batchConf.MaxJobs
+	}
+	return defaultBatchMaxJobs
+}
+
+func (h *jobService) batchWorkers() int {
+	if h.batchConf != nil && h.batchConf.Workers > 0 {
+		return h.batchConf.Workers
+	}
+	return defaultBatchWorkers
+}
+
+func (h *jobService) BatchCancelJob(ctx context.Context, request *kusciaapi.BatchCancelJobRequest) *kusciaapi.BatchJobOperationResponse {
+	return h.batchOperateJobs(ctx, request.Selector, request.DryRun, pberrorcode.ErrorCode_KusciaAPIErrCancelJob,
+		func(jobID string) *pbv1alpha1.Status {
+			return h.cancelJob(ctx, jobID, request.Reason, request.DryRun)
+		})
+}
+
+func (h *jobService) BatchApproveJob(ctx context.Context, request *kusciaapi.BatchApproveJobRequest) *kusciaapi.BatchJobOperationResponse {
+	if request.Result != kusciaapi.ApproveResult_APPROVE_RESULT_ACCEPT &&
+		request.Result != kusciaapi.ApproveResult_APPROVE_RESULT_REJECT {
+		return &kusciaapi.BatchJobOperationResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils2.NewFieldViolation("result", "request result must be legal")),
+		}
+	}
+	return h.batchOperateJobs(ctx, request.Selector, request.DryRun, pberrorcode.ErrorCode_KusciaAPIErrApproveJob,
+		func(jobID string) *pbv1alpha1.Status {
+			return h.approveJob(ctx, jobID, request.Result, request.Reason, request.DryRun)
+		})
+}
+
+// batchOperateJobs applies operate to each selected job. The jobs are updated by a bounded number of workers,
+// and the failure of a job doesn't stop the others, its status is reported in the results instead.
+func (h *jobService) batchOperateJobs(ctx context.Context, selector *kusciaapi.JobSelector, dryRun bool,
+	errCode pberrorcode.ErrorCode, operate func(jobID string) *pbv1alpha1.Status) *kusciaapi.BatchJobOperationResponse {
+	// get domain from context
+	_, domainID := GetRoleAndDomainFromCtx(ctx)
+	if len(domainID) == 0 {
+		return &kusciaapi.BatchJobOperationResponse{
+			Status: utils2.BuildErrorResponseStatus(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate, "source domain header must be set"),
+		}
+	}
+	jobIDs, status := h.selectJobs(ctx, selector)
+	if status != nil {
+		return &kusciaapi.BatchJobOperationResponse{
+			Status: status,
+		}
+	}
+
+	statuses := make([]*pbv1alpha1.Status, len(jobIDs))
+	workers := h.batchWorkers()
+	if workers > len(jobIDs) {
+		workers = len(jobIDs)
+	}
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				if err := ctx.Err(); err != nil {
+					statuses[idx] = utils2.BuildErrorResponseStatusFromError(errCode, err)
+					continue
+				}
+				if h.batchLimiter != nil {
+					if err := h.batchLimiter.Wait(ctx); err != nil {
+						statuses[idx] = utils2.BuildErrorResponseStatusFromError(errCode, err)
+						continue
+					}
+				}
+				statuses[idx] = operate(jobIDs[idx])
+			}
+		}()
+	}
+	for idx := range jobIDs {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+
+	data := &kusciaapi.BatchJobOperationResponseData{
+		DryRun:  dryRun,
+		Results: make([]*kusciaapi.JobOperationResult, 0, len(jobIDs)),
+	}
+	for idx, jobID := range jobIDs {
+		itemStatus := statuses[idx]
+		if itemStatus == nil {
+			itemStatus = utils2.BuildSuccessResponseStatus()
+			data.Succeeded++
+		} else {
+			data.Failed++
+		}
+		data.Results = append(data.Results, &kusciaapi.JobOperationResult{JobId: jobID, Status: itemStatus})
+	}
+	nlog.Infof("Batch operate %d jobs, succeeded: %d, failed: %d, dry run: %v", len(jobIDs), data.Succeeded, data.Failed, dryRun)
+	return &kusciaapi.BatchJobOperationResponse{
+		Status: utils2.BuildSuccessResponseStatus(),
+		Data:   data,
+	}
+}
+
+// selectJobs returns the sorted job ids of the selector. The jobs listed by a label selector are filtered by
+// the permission of the caller, while the explicit job ids are kept, so their auth failures are reported.
+func (h *jobService) selectJobs(ctx context.Context, selector *kusciaapi.JobSelector) ([]string, *pbv1alpha1.Status) {
+	maxJobs := h.batchMaxJobs()
+	switch {
+	case selector == nil || (len(selector.JobIds) == 0 && selector.LabelSelector == ""):
+		return nil, utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+			utils2.NewFieldViolation("selector", "either job ids or label selector must be set"))
+	case len(selector.JobIds) > 0 && selector.LabelSelector != "":
+		return nil, utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+			utils2.NewFieldViolation("selector", "job ids and label selector can not be set at the same time"))
+	case len(selector.JobIds) > 0:
+		jobIDs := make([]string, 0, len(selector.JobIds))
+		seen := map[string]bool{}
+		for i, jobID := range selector.JobIds {
+			if jobID == "" {
+				return nil, utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+					utils2.NewFieldViolation("selector.job_ids", "job id can not be empty on job_ids[%d]", i))
+			}
+			if !seen[jobID] {
+				seen[jobID] = true
+				jobIDs = append(jobIDs, jobID)
+			}
+		}
+		if len(jobIDs) > maxJobs {
+			return nil, utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils2.NewFieldViolation("selector.job_ids", "at most %d jobs can be operated in a batch, got %d", maxJobs, len(jobIDs)))
+		}
+		sort.Strings(jobIDs)
+		return jobIDs, nil
+	}
+
+	if _, err := labels.Parse(selector.LabelSelector); err != nil {
+		return nil, utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+			utils2.NewFieldViolation("selector.label_selector", "invalid label selector: %v", err))
+	}
+	jobs, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).List(ctx, metav1.ListOptions{LabelSelector: selector.LabelSelector})
+	if err != nil {
+		return nil, utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrQueryJob, err)
+	}
+	jobIDs := make([]string, 0, len(jobs.Items))
+	for i := range jobs.Items {
+		if h.authHandlerJob(ctx, &jobs.Items[i]) == nil {
+			jobIDs = append(jobIDs, jobs.Items[i].Name)
+		}
+	}
+	if len(jobIDs) > maxJobs {
+		return nil, utils2.BuildErrorResponseStatusFromError(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate,
+			utils2.NewFieldViolation("selector.label_selector", "at most %d jobs can be operated in a batch, %d jobs are selected", maxJobs, len(jobIDs)))
+	}
+	sort.Strings(jobIDs)
+	return jobIDs, nil
+}
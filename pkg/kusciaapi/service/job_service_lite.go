@@ -33,14 +33,14 @@ func (h *jobServiceLite) CreateJob(ctx context.Context, request *kusciaapi.Creat
 	// do validate
 	if err := validateCreateJobRequest(request, h.Initiator); err != nil {
 		return &kusciaapi.CreateJobResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// request the master api
 	resp, err := h.kusciaAPIClient.CreateJob(ctx, request)
 	if err != nil {
 		return &kusciaapi.CreateJobResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -58,7 +58,7 @@ func (h *jobServiceLite) QueryJob(ctx context.Context, request *kusciaapi.QueryJ
 	resp, err := h.kusciaAPIClient.QueryJob(ctx, request)
 	if err != nil {
 		return &kusciaapi.QueryJobResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -76,7 +76,7 @@ func (h *jobServiceLite) DeleteJob(ctx context.Context, request *kusciaapi.Delet
 	resp, err := h.kusciaAPIClient.DeleteJob(ctx, request)
 	if err != nil {
 		return &kusciaapi.DeleteJobResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -94,7 +94,7 @@ func (h *jobServiceLite) StopJob(ctx context.Context, request *kusciaapi.StopJob
 	resp, err := h.kusciaAPIClient.StopJob(ctx, request)
 	if err != nil {
 		return &kusciaapi.StopJobResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -112,7 +112,7 @@ func (h *jobServiceLite) SuspendJob(ctx context.Context, request *kusciaapi.Susp
 	resp, err := h.kusciaAPIClient.SuspendJob(ctx, request)
 	if err != nil {
 		return &kusciaapi.SuspendJobResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -130,7 +130,7 @@ func (h *jobServiceLite) RestartJob(ctx context.Context, request *kusciaapi.Rest
 	resp, err := h.kusciaAPIClient.RestartJob(ctx, request)
 	if err != nil {
 		return &kusciaapi.RestartJobResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -148,7 +148,7 @@ func (h *jobServiceLite) CancelJob(ctx context.Context, request *kusciaapi.Cance
 	resp, err := h.kusciaAPIClient.CancelJob(ctx, request)
 	if err != nil {
 		return &kusciaapi.CancelJobResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -171,7 +171,136 @@ func (h *jobServiceLite) ApproveJob(ctx context.Context, request *kusciaapi.Appr
 	resp, err := h.kusciaAPIClient.ApproveJob(ctx, request)
 	if err != nil {
 		return &kusciaapi.ApproveJobResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (h *jobServiceLite) BatchCancelJob(ctx context.Context, request *kusciaapi.BatchCancelJobRequest) *kusciaapi.BatchJobOperationResponse {
+	// request the master api
+	resp, err := h.kusciaAPIClient.BatchCancelJob(ctx, request)
+	if err != nil {
+		return &kusciaapi.BatchJobOperationResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (h *jobServiceLite) BatchApproveJob(ctx context.Context, request *kusciaapi.BatchApproveJobRequest) *kusciaapi.BatchJobOperationResponse {
+	if request.Result == kusciaapi.ApproveResult_APPROVE_RESULT_UNKNOWN {
+		return &kusciaapi.BatchJobOperationResponse{
+			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, "approve result must be set"),
+		}
+	}
+	// request the master api
+	resp, err := h.kusciaAPIClient.BatchApproveJob(ctx, request)
+	if err != nil {
+		return &kusciaapi.BatchJobOperationResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (h *jobServiceLite) ListEvents(ctx context.Context, request *kusciaapi.ListEventsRequest) *kusciaapi.ListEventsResponse {
+	if err := validateListEventsRequest(request); err != nil {
+		return &kusciaapi.ListEventsResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	// request the master api
+	resp, err := h.kusciaAPIClient.ListJobEvents(ctx, request)
+	if err != nil {
+		return &kusciaapi.ListEventsResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (h *jobServiceLite) QueryJobUsage(ctx context.Context, request *kusciaapi.QueryJobUsageRequest) *kusciaapi.QueryJobUsageResponse {
+	if request.JobId == "" {
+		return &kusciaapi.QueryJobUsageResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils2.NewFieldViolation("job_id", "job id can not be empty")),
+		}
+	}
+	// request the master api
+	resp, err := h.kusciaAPIClient.QueryJobUsage(ctx, request)
+	if err != nil {
+		return &kusciaapi.QueryJobUsageResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (h *jobServiceLite) ListJobUsage(ctx context.Context, request *kusciaapi.ListJobUsageRequest) *kusciaapi.ListJobUsageResponse {
+	if _, err := validateListJobUsageRequest(request); err != nil {
+		return &kusciaapi.ListJobUsageResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	// the lite domain could only list its own usage
+	if request.DomainId == "" {
+		request.DomainId = h.Initiator
+	}
+	if request.DomainId != h.Initiator {
+		return &kusciaapi.ListJobUsageResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils2.NewFieldViolation("domain_id", "kuscia lite api could only list the usage of domain %s", h.Initiator)),
+		}
+	}
+	// request the master api
+	resp, err := h.kusciaAPIClient.ListJobUsage(ctx, request)
+	if err != nil {
+		return &kusciaapi.ListJobUsageResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (h *jobServiceLite) QueryArchivedJob(ctx context.Context, request *kusciaapi.QueryArchivedJobRequest) *kusciaapi.QueryArchivedJobResponse {
+	if request.JobId == "" {
+		return &kusciaapi.QueryArchivedJobResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils2.NewFieldViolation("job_id", "job id can not be empty")),
+		}
+	}
+	// request the master api
+	resp, err := h.kusciaAPIClient.QueryArchivedJob(ctx, request)
+	if err != nil {
+		return &kusciaapi.QueryArchivedJobResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (h *jobServiceLite) ReportArchivedJob(ctx context.Context, request *kusciaapi.ReportArchivedJobRequest) *kusciaapi.ReportArchivedJobResponse {
+	if _, err := validateReportArchivedJobRequest(request); err != nil {
+		return &kusciaapi.ReportArchivedJobResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	// the lite domain could only report its own jobs
+	if request.DomainId == "" {
+		request.DomainId = h.Initiator
+	}
+	if request.DomainId != h.Initiator {
+		return &kusciaapi.ReportArchivedJobResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils2.NewFieldViolation("domain_id", "kuscia lite api could only report the jobs of domain %s", h.Initiator)),
+		}
+	}
+	// request the master api
+	resp, err := h.kusciaAPIClient.ReportArchivedJob(ctx, request)
+	if err != nil {
+		return &kusciaapi.ReportArchivedJobResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -181,14 +310,14 @@ func (h *jobServiceLite) BatchQueryJobStatus(ctx context.Context, request *kusci
 	// do validate
 	if err := validateBatchQueryJobStatusRequest(request); err != nil {
 		return &kusciaapi.BatchQueryJobStatusResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 	// request the master api
 	resp, err := h.kusciaAPIClient.BatchQueryJob(ctx, request)
 	if err != nil {
 		return &kusciaapi.BatchQueryJobStatusResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
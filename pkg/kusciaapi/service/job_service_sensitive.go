@@ -0,0 +1,163 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+	utils2 "github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// validateSensitiveInputConfig checks the sensitive input config can be merged into the task input config by the
+// agent, that is both of them are json objects. The kusciaapi of master only accepts the configs encrypted by the
+// client, so that the master never sees the raw config.
+func validateSensitiveInputConfig(i int, task *kusciaapi.Task, centralized bool) error {
+	if task.SensitiveInputConfig == "" && len(task.EncryptedSensitiveInputConfigs) == 0 {
+		return nil
+	}
+	if task.SensitiveInputConfig != "" {
+		field := fmt.Sprintf("tasks[%d].sensitive_input_config", i)
+		if centralized {
+			return utils2.NewFieldViolation(field, "sensitive input config is not supported by the kusciaapi of master, "+
+				"encrypt it with the public keys of the parties and set encrypted_sensitive_input_configs instead")
+		}
+		if len(task.EncryptedSensitiveInputConfigs) > 0 {
+			return utils2.NewFieldViolation(field, "sensitive input config and encrypted sensitive input configs can not be both set")
+		}
+		if err := json.Unmarshal([]byte(task.SensitiveInputConfig), &map[string]interface{}{}); err != nil {
+			return utils2.NewFieldViolation(field, "sensitive input config must be a json object")
+		}
+	} else if err := validateEncryptedSensitiveInputConfigs(i, task); err != nil {
+		return err
+	}
+	if task.TaskInputConfig != "" {
+		if err := json.Unmarshal([]byte(task.TaskInputConfig), &map[string]interface{}{}); err != nil {
+			return utils2.NewFieldViolation(fmt.Sprintf("tasks[%d].task_input_config", i),
+				"task input config must be a json object if sensitive input config is set")
+		}
+	}
+	return nil
+}
+
+// validateEncryptedSensitiveInputConfigs checks each party of the task has exactly one encrypted config, the content
+// can only be checked by the agents of the parties.
+func validateEncryptedSensitiveInputConfigs(i int, task *kusciaapi.Task) error {
+	parties := map[string]bool{}
+	for _, party := range task.Parties {
+		parties[party.DomainId] = true
+	}
+	encrypted := map[string]bool{}
+	for j, config := range task.EncryptedSensitiveInputConfigs {
+		field := fmt.Sprintf("tasks[%d].encrypted_sensitive_input_configs[%d]", i, j)
+		if !parties[config.DomainId] {
+			return utils2.NewFieldViolation(field+".domain_id", "domain %q is not a party of the task", config.DomainId)
+		}
+		if encrypted[config.DomainId] {
+			return utils2.NewFieldViolation(field+".domain_id", "domain %q has more than one encrypted config", config.DomainId)
+		}
+		encrypted[config.DomainId] = true
+		if _, err := base64.StdEncoding.DecodeString(config.EncryptedConfig); err != nil || config.EncryptedConfig == "" {
+			return utils2.NewFieldViolation(field+".encrypted_config", "encrypted config must be base64 encoded ciphertext")
+		}
+	}
+	for domainID := range parties {
+		if !encrypted[domainID] {
+			return utils2.NewFieldViolation(fmt.Sprintf("tasks[%d].encrypted_sensitive_input_configs", i),
+				"party %s has no encrypted sensitive input config", domainID)
+		}
+	}
+	return nil
+}
+
+// buildSensitiveInputConfigs returns the sensitive input configs of the task parties, the raw config is encrypted
+// with the public key of each party, and the configs encrypted by the client are kept as they are.
+func (h *jobService) buildSensitiveInputConfigs(ctx context.Context, task *kusciaapi.Task) ([]v1alpha1.SensitiveInputConfig, error) {
+	if task.SensitiveInputConfig != "" {
+		return h.encryptSensitiveInputConfig(ctx, task)
+	}
+	var configs []v1alpha1.SensitiveInputConfig
+	for _, config := range task.EncryptedSensitiveInputConfigs {
+		configs = append(configs, v1alpha1.SensitiveInputConfig{
+			DomainID:        config.DomainId,
+			EncryptedConfig: config.EncryptedConfig,
+		})
+	}
+	return configs, nil
+}
+
+// encryptSensitiveInputConfig encrypts the sensitive input config with the public key of each party, so that the
+// raw config is neither stored nor synced to the other domains.
+func (h *jobService) encryptSensitiveInputConfig(ctx context.Context, task *kusciaapi.Task) ([]v1alpha1.SensitiveInputConfig, error) {
+	var configs []v1alpha1.SensitiveInputConfig
+	encrypted := map[string]bool{}
+	for _, party := range task.Parties {
+		if encrypted[party.DomainId] {
+			continue
+		}
+		encrypted[party.DomainId] = true
+
+		domain, err := h.kusciaClient.KusciaV1alpha1().Domains().Get(ctx, party.DomainId, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("get domain %s failed, %v", party.DomainId, err)
+		}
+		if domain.Spec.Cert == "" {
+			return nil, fmt.Errorf("domain %s has no cert to encrypt the sensitive input config", party.DomainId)
+		}
+		pubKey, err := getPublicKeyFromCert(domain.Spec.Cert)
+		if err != nil {
+			return nil, fmt.Errorf("parse cert of domain %s failed, %v", party.DomainId, err)
+		}
+		config, err := tlsutils.EncryptOAEP(pubKey, []byte(task.SensitiveInputConfig))
+		if err != nil {
+			return nil, fmt.Errorf("encrypt for domain %s failed, %v", party.DomainId, err)
+		}
+		configs = append(configs, v1alpha1.SensitiveInputConfig{
+			DomainID:        party.DomainId,
+			EncryptedConfig: config,
+		})
+	}
+	return configs, nil
+}
+
+func getPublicKeyFromCert(certString string) (*rsa.PublicKey, error) {
+	certPem, err := base64.StdEncoding.DecodeString(certString)
+	if err != nil {
+		return nil, err
+	}
+	certData, _ := pem.Decode(certPem)
+	if certData == nil {
+		return nil, fmt.Errorf("pem decode failed")
+	}
+	cert, err := x509.ParseCertificate(certData.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key of cert is not rsa")
+	}
+	return pubKey, nil
+}
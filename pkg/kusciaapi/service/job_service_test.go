@@ -17,13 +17,24 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
 	"testing"
+	"time"
 
+	"golang.org/x/time/rate"
 	"gotest.tools/v3/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
 	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
@@ -123,6 +134,24 @@ func TestBatchQueryJob(t *testing.T) {
 	assert.Equal(t, len(batchResponse.Data.Jobs), 1)
 }
 
+func TestAuthTenantJob(t *testing.T) {
+	job := &v1alpha1.KusciaJob{Spec: v1alpha1.KusciaJobSpec{
+		Initiator: "alice",
+		Tasks:     []v1alpha1.KusciaTaskTemplate{{Parties: []v1alpha1.Party{{DomainID: "alice"}, {DomainID: "bob"}}}},
+	}}
+	assert.NilError(t, authTenantJob(context.Background(), job))
+
+	bobCtx := context.WithValue(context.Background(), consts.AuthTenant, &tenant.Tenant{Name: "bob", Domains: []string{"bob"}})
+	assert.NilError(t, authTenantJob(bobCtx, job))
+	carolCtx := context.WithValue(context.Background(), consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+	assert.ErrorContains(t, authTenantJob(carolCtx, job), "carol")
+
+	// the tenant could only create the jobs initiated by its domains
+	h := &jobService{}
+	err := h.authHandlerJobCreate(bobCtx, &kusciaapi.CreateJobRequest{Initiator: "alice"})
+	assert.ErrorContains(t, err, "alice")
+}
+
 func TestDeleteJob(t *testing.T) {
 	deleteRes := kusciaAPIJS.DeleteJob(context.Background(), &kusciaapi.DeleteJobRequest{
 		JobId: kusciaAPIJS.jobID,
@@ -213,3 +242,200 @@ func TestBuildScheduleConfigForKusciaAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchCancelJob(t *testing.T) {
+	newJob := func(name, stage string) *v1alpha1.KusciaJob {
+		return &v1alpha1.KusciaJob{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: common.KusciaCrossDomain,
+			Labels:    map[string]string{common.LabelJobStage: stage},
+		}}
+	}
+	client := kusciafake.NewSimpleClientset(
+		newJob("job-a", string(v1alpha1.JobStartStage)),
+		newJob("job-b", string(v1alpha1.JobStartStage)),
+		newJob("job-c", string(v1alpha1.JobStopStage)))
+	js := &jobService{
+		kusciaClient: client,
+		batchConf:    &config.BatchJobConfig{MaxJobs: 2},
+	}
+	ctx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleMaster)
+	ctx = context.WithValue(ctx, consts.SourceDomainKey, "alice")
+
+	// dry run only reports the selected jobs
+	res := js.BatchCancelJob(ctx, &kusciaapi.BatchCancelJobRequest{
+		Selector: &kusciaapi.JobSelector{LabelSelector: common.LabelJobStage + "=" + string(v1alpha1.JobStartStage)},
+		DryRun:   true,
+	})
+	assert.Equal(t, res.Status.Code, int32(0))
+	assert.Equal(t, res.Data.Succeeded, int32(2))
+	assert.Equal(t, res.Data.Results[0].JobId, "job-a")
+	kj, err := client.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, "job-a", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, kj.Labels[common.LabelJobStage], string(v1alpha1.JobStartStage))
+
+	// a missing job doesn't stop the others
+	res = js.BatchCancelJob(ctx, &kusciaapi.BatchCancelJobRequest{
+		Selector: &kusciaapi.JobSelector{JobIds: []string{"job-b", "job-x", "job-b"}},
+	})
+	assert.Equal(t, res.Status.Code, int32(0))
+	assert.Equal(t, res.Data.Succeeded, int32(1))
+	assert.Equal(t, res.Data.Failed, int32(1))
+	assert.Equal(t, res.Data.Results[1].Status.Code, int32(errorcode.ErrorCode_KusciaAPIErrCancelJob))
+	kj, err = client.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, "job-b", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, kj.Labels[common.LabelJobStage], string(v1alpha1.JobCancelStage))
+
+	res = js.BatchCancelJob(ctx, &kusciaapi.BatchCancelJobRequest{
+		Selector: &kusciaapi.JobSelector{JobIds: []string{"job-a", "job-b", "job-c"}},
+	})
+	assert.Equal(t, res.Status.Code, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate))
+
+	res = js.BatchCancelJob(ctx, &kusciaapi.BatchCancelJobRequest{Selector: &kusciaapi.JobSelector{}})
+	assert.Equal(t, res.Status.Code, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate))
+}
+
+func TestBatchCancelJob_Workers(t *testing.T) {
+	var objects []runtime.Object
+	var jobIDs []string
+	for i := 0; i < 50; i++ {
+		jobID := fmt.Sprintf("job-%02d", i)
+		jobIDs = append(jobIDs, jobID)
+		objects = append(objects, &v1alpha1.KusciaJob{ObjectMeta: metav1.ObjectMeta{Name: jobID, Namespace: common.KusciaCrossDomain}})
+	}
+	js := &jobService{
+		kusciaClient: kusciafake.NewSimpleClientset(objects...),
+		batchConf:    &config.BatchJobConfig{Workers: 4},
+	}
+	ctx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleMaster)
+	ctx = context.WithValue(ctx, consts.SourceDomainKey, "alice")
+
+	res := js.BatchCancelJob(ctx, &kusciaapi.BatchCancelJobRequest{
+		Selector: &kusciaapi.JobSelector{JobIds: append([]string{"job-x"}, jobIDs...)},
+	})
+	assert.Equal(t, res.Status.Code, int32(0))
+	assert.Equal(t, res.Data.Succeeded, int32(50))
+	assert.Equal(t, res.Data.Failed, int32(1))
+	// the results are kept in the order of job ids
+	for i, jobID := range jobIDs {
+		assert.Equal(t, res.Data.Results[i].JobId, jobID)
+	}
+	assert.Equal(t, res.Data.Results[50].JobId, "job-x")
+}
+
+func TestBatchCancelJob_RateLimit(t *testing.T) {
+	client := kusciafake.NewSimpleClientset(
+		&v1alpha1.KusciaJob{ObjectMeta: metav1.ObjectMeta{Name: "job-a", Namespace: common.KusciaCrossDomain}},
+		&v1alpha1.KusciaJob{ObjectMeta: metav1.ObjectMeta{Name: "job-b", Namespace: common.KusciaCrossDomain}})
+	// the bucket is drained, so the jobs wait for the tokens until the request is canceled
+	js := &jobService{kusciaClient: client, batchLimiter: rate.NewLimiter(rate.Every(time.Hour), 1)}
+	assert.Assert(t, js.batchLimiter.Allow())
+	ctx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleMaster)
+	ctx = context.WithValue(ctx, consts.SourceDomainKey, "alice")
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	res := js.BatchCancelJob(ctx, &kusciaapi.BatchCancelJobRequest{
+		Selector: &kusciaapi.JobSelector{JobIds: []string{"job-a", "job-b"}},
+	})
+	assert.Equal(t, res.Status.Code, int32(0))
+	assert.Equal(t, res.Data.Failed, int32(2))
+	kj, err := client.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(context.Background(), "job-a", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, kj.Labels[common.LabelJobStage], "")
+}
+
+func TestBatchApproveJob(t *testing.T) {
+	client := kusciafake.NewSimpleClientset(&v1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "job-a", Namespace: common.KusciaCrossDomain},
+		Spec:       v1alpha1.KusciaJobSpec{Initiator: "alice"},
+	})
+	js := &jobService{kusciaClient: client}
+	ctx := context.WithValue(context.Background(), consts.AuthRole, consts.AuthRoleDomain)
+	ctx = context.WithValue(ctx, consts.SourceDomainKey, "alice")
+
+	res := js.BatchApproveJob(ctx, &kusciaapi.BatchApproveJobRequest{
+		Selector: &kusciaapi.JobSelector{JobIds: []string{"job-a"}},
+	})
+	assert.Equal(t, res.Status.Code, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate))
+
+	res = js.BatchApproveJob(ctx, &kusciaapi.BatchApproveJobRequest{
+		Selector: &kusciaapi.JobSelector{JobIds: []string{"job-a"}},
+		Result:   kusciaapi.ApproveResult_APPROVE_RESULT_ACCEPT,
+	})
+	assert.Equal(t, res.Data.Succeeded, int32(1))
+	kj, err := client.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, "job-a", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, kj.Status.ApproveStatus["alice"], v1alpha1.JobAccepted)
+}
+
+func TestEncryptSensitiveInputConfig(t *testing.T) {
+	key, certDER, err := tlsutils.CreateCA("alice")
+	assert.NilError(t, err)
+	cert := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}))
+	client := kusciafake.NewSimpleClientset(
+		&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "alice"}, Spec: v1alpha1.DomainSpec{Cert: cert}},
+		&v1alpha1.Domain{ObjectMeta: metav1.ObjectMeta{Name: "bob"}})
+	js := &jobService{kusciaClient: client}
+
+	task := &kusciaapi.Task{
+		TaskInputConfig:      `{"sf_storage_config":{"type":"s3"}}`,
+		SensitiveInputConfig: `{"sf_storage_config":{"password":"secret"}}`,
+		Parties:              []*kusciaapi.Party{{DomainId: "alice", Role: "server"}, {DomainId: "alice", Role: "client"}},
+	}
+	assert.NilError(t, validateSensitiveInputConfig(0, task, false))
+	configs, err := js.encryptSensitiveInputConfig(context.Background(), task)
+	assert.NilError(t, err)
+	assert.Equal(t, len(configs), 1)
+	assert.Equal(t, configs[0].DomainID, "alice")
+	plaintext, err := tlsutils.DecryptOAEP(key, configs[0].EncryptedConfig)
+	assert.NilError(t, err)
+	assert.Equal(t, string(plaintext), task.SensitiveInputConfig)
+
+	// the domain without cert can't receive sensitive config
+	task.Parties = append(task.Parties, &kusciaapi.Party{DomainId: "bob"})
+	_, err = js.encryptSensitiveInputConfig(context.Background(), task)
+	assert.ErrorContains(t, err, "domain bob has no cert")
+
+	task.TaskInputConfig = "meta://task-input-config"
+	assert.ErrorContains(t, validateSensitiveInputConfig(0, task, false), "task input config must be a json object")
+	task.SensitiveInputConfig = "secret"
+	assert.ErrorContains(t, validateSensitiveInputConfig(0, task, false), "sensitive input config must be a json object")
+}
+
+func TestBuildEncryptedSensitiveInputConfigs(t *testing.T) {
+	task := &kusciaapi.Task{
+		TaskInputConfig:      `{"sf_storage_config":{"type":"s3"}}`,
+		SensitiveInputConfig: `{"sf_storage_config":{"password":"secret"}}`,
+		Parties:              []*kusciaapi.Party{{DomainId: "alice"}, {DomainId: "bob"}},
+	}
+	// the master never sees the raw config
+	assert.ErrorContains(t, validateSensitiveInputConfig(0, task, true), "not supported by the kusciaapi of master")
+
+	ciphertext := base64.StdEncoding.EncodeToString([]byte("ciphertext"))
+	task.SensitiveInputConfig = ""
+	task.EncryptedSensitiveInputConfigs = []*kusciaapi.EncryptedSensitiveInputConfig{
+		{DomainId: "alice", EncryptedConfig: ciphertext},
+	}
+	assert.ErrorContains(t, validateSensitiveInputConfig(0, task, true), "party bob has no encrypted sensitive input config")
+	task.EncryptedSensitiveInputConfigs = append(task.EncryptedSensitiveInputConfigs,
+		&kusciaapi.EncryptedSensitiveInputConfig{DomainId: "carol", EncryptedConfig: ciphertext})
+	assert.ErrorContains(t, validateSensitiveInputConfig(0, task, true), `domain "carol" is not a party of the task`)
+	task.EncryptedSensitiveInputConfigs[1].DomainId = "bob"
+	task.EncryptedSensitiveInputConfigs[1].EncryptedConfig = "not base64"
+	assert.ErrorContains(t, validateSensitiveInputConfig(0, task, true), "must be base64 encoded ciphertext")
+	task.EncryptedSensitiveInputConfigs[1].EncryptedConfig = ciphertext
+	assert.NilError(t, validateSensitiveInputConfig(0, task, true))
+
+	task.SensitiveInputConfig = `{"sf_storage_config":{"password":"secret"}}`
+	assert.ErrorContains(t, validateSensitiveInputConfig(0, task, false), "can not be both set")
+	task.SensitiveInputConfig = ""
+
+	js := &jobService{}
+	configs, err := js.buildSensitiveInputConfigs(context.Background(), task)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, configs, []v1alpha1.SensitiveInputConfig{
+		{DomainID: "alice", EncryptedConfig: ciphertext},
+		{DomainID: "bob", EncryptedConfig: ciphertext},
+	})
+}
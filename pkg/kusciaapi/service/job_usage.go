@@ -0,0 +1,246 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	apiutils "github.com/secretflow/kuscia/pkg/kusciaapi/utils"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// JobUsageMonthLayout is the layout of the month of ListJobUsageRequest.
+const JobUsageMonthLayout = "2006-01"
+
+const (
+	mibPerGiB      = 1 << 10
+	secondsPerHour = 3600
+)
+
+// QueryJobUsage reports the resource usage of the job, the usage of a task is summarized by the task controller from
+// the samples of the agents when the task finishes, so the running tasks have no usage yet.
+func (h *jobService) QueryJobUsage(ctx context.Context, request *kusciaapi.QueryJobUsageRequest) *kusciaapi.QueryJobUsageResponse {
+	if request.JobId == "" {
+		return &kusciaapi.QueryJobUsageResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils.NewFieldViolation("job_id", "job id can not be empty")),
+		}
+	}
+	kusciaJob, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).Get(ctx, request.JobId, metav1.GetOptions{})
+	if err != nil {
+		return &kusciaapi.QueryJobUsageResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryJob, err),
+		}
+	}
+	if err = h.authHandlerJobRetrieve(ctx, kusciaJob); err != nil {
+		return &kusciaapi.QueryJobUsageResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+		}
+	}
+
+	tasks := map[string]*v1alpha1.KusciaTask{}
+	for taskID := range kusciaJob.Status.TaskStatus {
+		task, err := h.kusciaClient.KusciaV1alpha1().KusciaTasks(common.KusciaCrossDomain).Get(ctx, taskID, metav1.GetOptions{})
+		if err != nil {
+			nlog.Warnf("Failed to get task [%s], %v", taskID, err)
+			continue
+		}
+		tasks[taskID] = task
+	}
+	return &kusciaapi.QueryJobUsageResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   buildJobUsage(kusciaJob, tasks, ""),
+	}
+}
+
+// ListJobUsage reports the resource usage of the jobs completed in the month, for the monthly billing of the
+// parties.
+func (h *jobService) ListJobUsage(ctx context.Context, request *kusciaapi.ListJobUsageRequest) *kusciaapi.ListJobUsageResponse {
+	start, err := validateListJobUsageRequest(request)
+	if err != nil {
+		return &kusciaapi.ListJobUsageResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	end := start.AddDate(0, 1, 0)
+
+	jobs, err := h.kusciaClient.KusciaV1alpha1().KusciaJobs(common.KusciaCrossDomain).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return &kusciaapi.ListJobUsageResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrListJobUsage, err),
+		}
+	}
+	taskList, err := h.kusciaClient.KusciaV1alpha1().KusciaTasks(common.KusciaCrossDomain).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return &kusciaapi.ListJobUsageResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrListJobUsage, err),
+		}
+	}
+	tasks := make(map[string]*v1alpha1.KusciaTask, len(taskList.Items))
+	for i := range taskList.Items {
+		tasks[taskList.Items[i].Name] = &taskList.Items[i]
+	}
+
+	var completed []*v1alpha1.KusciaJob
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		completionTime := job.Status.CompletionTime
+		if completionTime == nil || completionTime.Time.Before(start) || !completionTime.Time.Before(end) {
+			continue
+		}
+		if request.DomainId != "" && !jobHasParty(job, request.DomainId) {
+			continue
+		}
+		if h.authHandlerJobRetrieve(ctx, job) != nil {
+			continue
+		}
+		completed = append(completed, job)
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		ti, tj := completed[i].Status.CompletionTime, completed[j].Status.CompletionTime
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return completed[i].Name < completed[j].Name
+	})
+
+	data := &kusciaapi.ListJobUsageResponseData{
+		Month: request.Month,
+		Jobs:  make([]*kusciaapi.JobUsage, 0, len(completed)),
+	}
+	for _, job := range completed {
+		data.Jobs = append(data.Jobs, buildJobUsage(job, tasks, request.DomainId))
+	}
+	return &kusciaapi.ListJobUsageResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   data,
+	}
+}
+
+func jobHasParty(kusciaJob *v1alpha1.KusciaJob, domainID string) bool {
+	if kusciaJob.Spec.Initiator == domainID {
+		return true
+	}
+	for _, task := range kusciaJob.Spec.Tasks {
+		for _, p := range task.Parties {
+			if p.DomainID == domainID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// buildJobUsage builds the usage of the tasks of the job, the usage is limited to the party if domainID isn't empty.
+func buildJobUsage(kusciaJob *v1alpha1.KusciaJob, tasks map[string]*v1alpha1.KusciaTask, domainID string) *kusciaapi.JobUsage {
+	usage := &kusciaapi.JobUsage{
+		JobId:     kusciaJob.Name,
+		Initiator: kusciaJob.Spec.Initiator,
+		State:     getJobState(kusciaJob.Status.Phase),
+		StartTime: apiutils.TimeRfc3339String(kusciaJob.Status.StartTime),
+		EndTime:   apiutils.TimeRfc3339String(kusciaJob.Status.CompletionTime),
+		Tasks:     make([]*kusciaapi.TaskUsage, 0, len(kusciaJob.Spec.Tasks)),
+	}
+	jobParties := map[string]*v1alpha1.ResourceUsage{}
+	for _, kt := range kusciaJob.Spec.Tasks {
+		taskUsage := &kusciaapi.TaskUsage{
+			TaskId:  kt.TaskID,
+			Alias:   kt.Alias,
+			State:   getTaskState(v1alpha1.TaskPending),
+			Parties: []*kusciaapi.PartyUsage{},
+		}
+		if phase, ok := kusciaJob.Status.TaskStatus[kt.TaskID]; ok {
+			taskUsage.State = getTaskState(phase)
+		}
+		if task, ok := tasks[kt.TaskID]; ok {
+			// the pods of the roles of a party run at the same time, so their usage is summed up
+			taskParties := map[string]*v1alpha1.ResourceUsage{}
+			for _, ps := range task.Status.PartyTaskStatus {
+				if ps.ResourceUsage == nil || (domainID != "" && ps.DomainID != domainID) {
+					continue
+				}
+				if taskParties[ps.DomainID] == nil {
+					taskParties[ps.DomainID] = &v1alpha1.ResourceUsage{}
+				}
+				addResourceUsage(taskParties[ps.DomainID], ps.ResourceUsage, false)
+			}
+			for partyID, ru := range taskParties {
+				if jobParties[partyID] == nil {
+					jobParties[partyID] = &v1alpha1.ResourceUsage{}
+				}
+				addResourceUsage(jobParties[partyID], ru, true)
+			}
+			taskUsage.Parties = buildPartyUsages(taskParties)
+		}
+		usage.Tasks = append(usage.Tasks, taskUsage)
+	}
+	usage.Parties = buildPartyUsages(jobParties)
+	return usage
+}
+
+// addResourceUsage adds the usage to the sum, the peak memory is the maximum rather than the sum of the peaks if
+// the usages are not sampled at the same time, e.g. the usage of the tasks of a job.
+func addResourceUsage(sum, usage *v1alpha1.ResourceUsage, maxPeak bool) {
+	sum.CPUMilliSeconds += usage.CPUMilliSeconds
+	sum.MemoryMiBSeconds += usage.MemoryMiBSeconds
+	sum.IOReadBytes += usage.IOReadBytes
+	sum.IOWriteBytes += usage.IOWriteBytes
+	sum.NetworkReceiveBytes += usage.NetworkReceiveBytes
+	sum.NetworkTransmitBytes += usage.NetworkTransmitBytes
+	if !maxPeak {
+		sum.MemoryPeakBytes += usage.MemoryPeakBytes
+	} else if usage.MemoryPeakBytes > sum.MemoryPeakBytes {
+		sum.MemoryPeakBytes = usage.MemoryPeakBytes
+	}
+}
+
+func buildPartyUsages(parties map[string]*v1alpha1.ResourceUsage) []*kusciaapi.PartyUsage {
+	usages := make([]*kusciaapi.PartyUsage, 0, len(parties))
+	for domainID, ru := range parties {
+		usages = append(usages, &kusciaapi.PartyUsage{
+			DomainId:             domainID,
+			CpuSeconds:           float64(ru.CPUMilliSeconds) / float64(time.Second/time.Millisecond),
+			MemoryGbHours:        float64(ru.MemoryMiBSeconds) / mibPerGiB / secondsPerHour,
+			MemoryPeakBytes:      ru.MemoryPeakBytes,
+			NetworkReceiveBytes:  ru.NetworkReceiveBytes,
+			NetworkTransmitBytes: ru.NetworkTransmitBytes,
+			CrossDomainBytes:     ru.NetworkReceiveBytes + ru.NetworkTransmitBytes,
+		})
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].DomainId < usages[j].DomainId
+	})
+	return usages
+}
+
+func validateListJobUsageRequest(request *kusciaapi.ListJobUsageRequest) (time.Time, error) {
+	if request.Month == "" {
+		return time.Time{}, utils.NewFieldViolation("month", "month can not be empty")
+	}
+	start, err := time.Parse(JobUsageMonthLayout, request.Month)
+	if err != nil {
+		return time.Time{}, utils.NewFieldViolation("month", "month %q is not in the format of %s", request.Month, JobUsageMonthLayout)
+	}
+	return start, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func newTestUsageJob(name string, completionTime time.Time, taskIDs ...string) *v1alpha1.KusciaJob {
+	job := &v1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.KusciaCrossDomain},
+		Spec:       v1alpha1.KusciaJobSpec{Initiator: "alice"},
+		Status: v1alpha1.KusciaJobStatus{
+			Phase:          v1alpha1.KusciaJobSucceeded,
+			CompletionTime: &metav1.Time{Time: completionTime},
+			TaskStatus:     map[string]v1alpha1.KusciaTaskPhase{},
+		},
+	}
+	for _, taskID := range taskIDs {
+		job.Spec.Tasks = append(job.Spec.Tasks, v1alpha1.KusciaTaskTemplate{
+			TaskID:  taskID,
+			Alias:   taskID,
+			Parties: []v1alpha1.Party{{DomainID: "alice"}, {DomainID: "bob"}},
+		})
+		job.Status.TaskStatus[taskID] = v1alpha1.TaskSucceeded
+	}
+	return job
+}
+
+func newTestUsageTask(name string, usages map[string]*v1alpha1.ResourceUsage) *v1alpha1.KusciaTask {
+	task := &v1alpha1.KusciaTask{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.KusciaCrossDomain}}
+	for _, domainID := range []string{"alice", "bob"} {
+		task.Status.PartyTaskStatus = append(task.Status.PartyTaskStatus, v1alpha1.PartyTaskStatus{
+			DomainID:      domainID,
+			ResourceUsage: usages[domainID],
+		})
+	}
+	return task
+}
+
+func TestQueryJobUsage(t *testing.T) {
+	job := newTestUsageJob("job-1", time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), "task-1", "task-2")
+	task1 := newTestUsageTask("task-1", map[string]*v1alpha1.ResourceUsage{
+		"alice": {CPUMilliSeconds: 1500, MemoryMiBSeconds: 1024 * 3600, MemoryPeakBytes: 100, NetworkReceiveBytes: 10, NetworkTransmitBytes: 20},
+		"bob":   {CPUMilliSeconds: 500, MemoryPeakBytes: 50},
+	})
+	// the role of a party is summed up within the task
+	task1.Status.PartyTaskStatus = append(task1.Status.PartyTaskStatus, v1alpha1.PartyTaskStatus{
+		DomainID:      "alice",
+		Role:          "server",
+		ResourceUsage: &v1alpha1.ResourceUsage{CPUMilliSeconds: 500, MemoryPeakBytes: 100},
+	})
+	task2 := newTestUsageTask("task-2", map[string]*v1alpha1.ResourceUsage{
+		"alice": {CPUMilliSeconds: 1000, MemoryMiBSeconds: 1024 * 1800, MemoryPeakBytes: 150},
+	})
+	s := NewJobService(&config.KusciaAPIConfig{
+		KusciaClient: kusciafake.NewSimpleClientset(job, task1, task2),
+	})
+	ctx := context.Background()
+
+	res := s.QueryJobUsage(ctx, &kusciaapi.QueryJobUsageRequest{JobId: "job-1"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Equal(t, "alice", res.Data.Initiator)
+	assert.Len(t, res.Data.Tasks, 2)
+	taskParties := res.Data.Tasks[0].Parties
+	assert.Len(t, taskParties, 2)
+	assert.Equal(t, "alice", taskParties[0].DomainId)
+	assert.Equal(t, 2.0, taskParties[0].CpuSeconds)
+	assert.Equal(t, 1.0, taskParties[0].MemoryGbHours)
+	assert.Equal(t, int64(200), taskParties[0].MemoryPeakBytes)
+	assert.Equal(t, int64(30), taskParties[0].CrossDomainBytes)
+
+	jobParties := res.Data.Parties
+	assert.Len(t, jobParties, 2)
+	assert.Equal(t, 3.0, jobParties[0].CpuSeconds)
+	assert.Equal(t, 1.5, jobParties[0].MemoryGbHours)
+	assert.Equal(t, int64(200), jobParties[0].MemoryPeakBytes)
+	assert.Equal(t, "bob", jobParties[1].DomainId)
+	assert.Equal(t, 0.5, jobParties[1].CpuSeconds)
+
+	res = s.QueryJobUsage(ctx, &kusciaapi.QueryJobUsageRequest{})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+	res = s.QueryJobUsage(ctx, &kusciaapi.QueryJobUsageRequest{JobId: "job-2"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrQueryJob), res.Status.Code)
+}
+
+func TestListJobUsage(t *testing.T) {
+	usage := map[string]*v1alpha1.ResourceUsage{
+		"alice": {CPUMilliSeconds: 1000},
+		"bob":   {CPUMilliSeconds: 2000},
+	}
+	running := newTestUsageJob("job-running", time.Time{}, "task-running")
+	running.Status.CompletionTime = nil
+	s := NewJobService(&config.KusciaAPIConfig{
+		KusciaClient: kusciafake.NewSimpleClientset(
+			newTestUsageJob("job-2", time.Date(2025, 1, 31, 23, 0, 0, 0, time.UTC), "task-2"),
+			newTestUsageJob("job-1", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "task-1"),
+			newTestUsageJob("job-feb", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), "task-feb"),
+			running,
+			newTestUsageTask("task-1", usage),
+			newTestUsageTask("task-2", usage),
+			newTestUsageTask("task-feb", usage),
+		),
+	})
+	ctx := context.Background()
+
+	res := s.ListJobUsage(ctx, &kusciaapi.ListJobUsageRequest{Month: "2025-01"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Equal(t, "2025-01", res.Data.Month)
+	assert.Len(t, res.Data.Jobs, 2)
+	assert.Equal(t, "job-1", res.Data.Jobs[0].JobId)
+	assert.Equal(t, "job-2", res.Data.Jobs[1].JobId)
+	assert.Len(t, res.Data.Jobs[0].Parties, 2)
+
+	res = s.ListJobUsage(ctx, &kusciaapi.ListJobUsageRequest{Month: "2025-01", DomainId: "bob"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Len(t, res.Data.Jobs, 2)
+	assert.Len(t, res.Data.Jobs[0].Parties, 1)
+	assert.Equal(t, 2.0, res.Data.Jobs[0].Tasks[0].Parties[0].CpuSeconds)
+
+	res = s.ListJobUsage(ctx, &kusciaapi.ListJobUsageRequest{Month: "2025-01", DomainId: "carol"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Empty(t, res.Data.Jobs)
+
+	carolCtx := context.WithValue(ctx, consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+	res = s.ListJobUsage(carolCtx, &kusciaapi.ListJobUsageRequest{Month: "2025-01"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Empty(t, res.Data.Jobs)
+
+	res = s.ListJobUsage(ctx, &kusciaapi.ListJobUsageRequest{Month: "2025-1-1"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code)
+}
@@ -0,0 +1,261 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/utils/resources"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// logArchive stores the logs shipped by the agents, the log file of each container restart is archived at
+// {root}/{domain}/{job}/{task}/{pod}/{container}/{restart}.log.
+type logArchive struct {
+	root string
+	mu   sync.Mutex
+}
+
+func newLogArchive(root string) *logArchive {
+	return &logArchive{root: root}
+}
+
+func (a *logArchive) path(domainID, jobID, taskID, podName, container string, restartCount int32) string {
+	return filepath.Join(a.root, domainID, jobID, taskID, podName, container, fmt.Sprintf("%d.log", restartCount))
+}
+
+// append writes the chunk at its offset of the archived file. The data already archived is skipped, so that the
+// chunks resent by the agents are idempotent.
+func (a *logArchive) append(chunk *kusciaapi.ArchivedLogChunk) error {
+	if err := validateArchivedLogNames(chunk.DomainId, chunk.JobId, chunk.TaskId, chunk.PodName, chunk.Container); err != nil {
+		return err
+	}
+	if chunk.Offset < 0 || chunk.RestartCount < 0 {
+		return fmt.Errorf("offset and restart count of pod %s can not be negative", chunk.PodName)
+	}
+	logPath := a.path(chunk.DomainId, chunk.JobId, chunk.TaskId, chunk.PodName, chunk.Container, chunk.RestartCount)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+	data := chunk.Data
+	if end := chunk.Offset + int64(len(data)); end <= size {
+		return nil
+	} else if chunk.Offset < size {
+		data = data[size-chunk.Offset:]
+	} else if chunk.Offset > size {
+		nlog.Warnf("Log chunk of %s starts at %d beyond the archived size %d, the log between them is lost", logPath, chunk.Offset, size)
+	}
+	_, err = f.WriteAt(data, size)
+	return err
+}
+
+// list returns the archived files of the job, or of the task if it's not empty.
+func (a *logArchive) list(domainID, jobID, taskID string) ([]*kusciaapi.ArchivedLogFile, error) {
+	root := filepath.Join(a.root, domainID, jobID)
+	if taskID != "" {
+		root = filepath.Join(root, taskID)
+	}
+	var files []*kusciaapi.ArchivedLogFile
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".log") {
+			return nil
+		}
+		rel, err := filepath.Rel(filepath.Join(a.root, domainID, jobID), path)
+		if err != nil {
+			return err
+		}
+		// task/pod/container/restart.log
+		parts := strings.Split(rel, string(filepath.Separator))
+		if len(parts) != 4 {
+			return nil
+		}
+		restartCount, err := strconv.Atoi(strings.TrimSuffix(parts[3], ".log"))
+		if err != nil {
+			return nil
+		}
+		files = append(files, &kusciaapi.ArchivedLogFile{
+			DomainId:     domainID,
+			JobId:        jobID,
+			TaskId:       parts[0],
+			PodName:      parts[1],
+			Container:    parts[2],
+			RestartCount: int32(restartCount),
+			Size:         info.Size(),
+			UpdateTime:   info.ModTime().Format(time.RFC3339),
+		})
+		return nil
+	})
+	return files, err
+}
+
+func validateArchivedLogNames(domainID, jobID, taskID, podName, container string) error {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"domain_id", domainID}, {"job_id", jobID}, {"task_id", taskID}, {"pod_name", podName}, {"container", container},
+	}
+	for _, field := range fields {
+		if err := resources.ValidateK8sName(field.value, field.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateArchiveDomain checks the domain of the archived logs, the domain's kusciaAPI could only access its own logs.
+func validateArchiveDomain(ctx context.Context, domainID *string) error {
+	role, ctxDomainID := GetRoleAndDomainFromCtx(ctx)
+	if role != consts.AuthRoleDomain {
+		if *domainID == "" {
+			return utils.NewFieldViolation("domain_id", "domain id can not be empty")
+		}
+		return tenant.CheckDomains(ctx, *domainID)
+	}
+	if *domainID == "" {
+		*domainID = ctxDomainID
+	}
+	if *domainID != ctxDomainID {
+		return fmt.Errorf("domain's kusciaAPI could only access the logs of itself, domain:%s, request domain:%s", ctxDomainID, *domainID)
+	}
+	return nil
+}
+
+func (s logService) PushArchivedLog(ctx context.Context, request *kusciaapi.PushArchivedLogRequest) *kusciaapi.PushArchivedLogResponse {
+	for _, chunk := range request.Chunks {
+		if err := validateArchiveDomain(ctx, &chunk.DomainId); err != nil {
+			return &kusciaapi.PushArchivedLogResponse{
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+			}
+		}
+	}
+	for _, chunk := range request.Chunks {
+		if err := s.archive.append(chunk); err != nil {
+			nlog.Warnf("Archive log of pod %s/%s from node %s failed, %v", chunk.DomainId, chunk.PodName, request.NodeName, err)
+			return &kusciaapi.PushArchivedLogResponse{
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrPushArchivedLog, err),
+			}
+		}
+	}
+	return &kusciaapi.PushArchivedLogResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+	}
+}
+
+func (s logService) ListArchivedLog(ctx context.Context, request *kusciaapi.ListArchivedLogRequest) *kusciaapi.ListArchivedLogResponse {
+	if err := validateListArchivedLogRequest(ctx, request); err != nil {
+		return &kusciaapi.ListArchivedLogResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	files, err := s.archive.list(request.DomainId, request.JobId, request.TaskId)
+	if err != nil {
+		return &kusciaapi.ListArchivedLogResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrListArchivedLog, err),
+		}
+	}
+	return &kusciaapi.ListArchivedLogResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.ListArchivedLogResponseData{
+			Files: files,
+		},
+	}
+}
+
+func (s logService) DownloadArchivedLog(ctx context.Context, request *kusciaapi.DownloadArchivedLogRequest) *kusciaapi.DownloadArchivedLogResponse {
+	if err := validateDownloadArchivedLogRequest(ctx, request); err != nil {
+		return &kusciaapi.DownloadArchivedLogResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	limitBytes := request.LimitBytes
+	if limitBytes == 0 {
+		limitBytes = defaultLogLimitBytes
+	}
+	logPath := s.archive.path(request.DomainId, request.JobId, request.TaskId, request.PodName, request.Container, request.RestartCount)
+	data, err := readLogRange(logPath, request.Offset, 0, limitBytes)
+	if err != nil {
+		return &kusciaapi.DownloadArchivedLogResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrDownloadArchivedLog, err),
+		}
+	}
+	return &kusciaapi.DownloadArchivedLogResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   data,
+	}
+}
+
+func validateListArchivedLogRequest(ctx context.Context, request *kusciaapi.ListArchivedLogRequest) error {
+	if err := validateArchiveDomain(ctx, &request.DomainId); err != nil {
+		return err
+	}
+	if request.JobId == "" {
+		return utils.NewFieldViolation("job_id", "job id can not be empty")
+	}
+	if err := resources.ValidateK8sName(request.DomainId, "domain_id"); err != nil {
+		return err
+	}
+	if err := resources.ValidateK8sName(request.JobId, "job_id"); err != nil {
+		return err
+	}
+	if request.TaskId != "" {
+		return resources.ValidateK8sName(request.TaskId, "task_id")
+	}
+	return nil
+}
+
+func validateDownloadArchivedLogRequest(ctx context.Context, request *kusciaapi.DownloadArchivedLogRequest) error {
+	if err := validateArchiveDomain(ctx, &request.DomainId); err != nil {
+		return err
+	}
+	if err := validateArchivedLogNames(request.DomainId, request.JobId, request.TaskId, request.PodName, request.Container); err != nil {
+		return err
+	}
+	if request.RestartCount < 0 {
+		return utils.NewFieldViolation("restart_count", "restart count can not be negative")
+	}
+	return validateTaskLogRange(request.Offset, 0, request.LimitBytes)
+}
@@ -42,25 +42,34 @@ import (
 	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
 	"github.com/secretflow/kuscia/pkg/web/constants"
 	"github.com/secretflow/kuscia/pkg/web/utils"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
 
 type ILogService interface {
-	QueryTaskLog(ctx context.Context, request *kusciaapi.QueryLogRequest, eventCh chan<- *kusciaapi.QueryLogResponse)
+	QueryLog(ctx context.Context, request *kusciaapi.QueryLogRequest, eventCh chan<- *kusciaapi.QueryLogResponse)
 	QueryPodNode(ctx context.Context, request *kusciaapi.QueryPodNodeRequest) *kusciaapi.QueryPodNodeResponse
+	QueryTaskLog(ctx context.Context, request *kusciaapi.QueryTaskLogRequest) *kusciaapi.QueryTaskLogResponse
+	TailTaskLog(ctx context.Context, request *kusciaapi.TailTaskLogRequest, eventCh chan<- *kusciaapi.TailTaskLogResponse)
+	PushArchivedLog(ctx context.Context, request *kusciaapi.PushArchivedLogRequest) *kusciaapi.PushArchivedLogResponse
+	ListArchivedLog(ctx context.Context, request *kusciaapi.ListArchivedLogRequest) *kusciaapi.ListArchivedLogResponse
+	DownloadArchivedLog(ctx context.Context, request *kusciaapi.DownloadArchivedLogRequest) *kusciaapi.DownloadArchivedLogResponse
 }
 
 const (
-	QueryLogPath  = "/api/v1/log/task/query"
-	OutputLineNum = 100
-	OutputPeriod  = 5 * time.Second
+	QueryLogPath     = "/api/v1/log/task/query"
+	QueryTaskLogPath = "/api/v1/log/task/range"
+	TailTaskLogPath  = "/api/v1/log/task/tail"
+	OutputLineNum    = 100
+	OutputPeriod     = 5 * time.Second
 )
 
 type logService struct {
 	kusciaClient kusciaclientset.Interface
 	kubeClient   kubernetes.Interface
 	conf         *config.KusciaAPIConfig
+	archive      *logArchive
 }
 
 func NewLogService(config *config.KusciaAPIConfig) ILogService {
@@ -75,6 +84,7 @@ func NewLogService(config *config.KusciaAPIConfig) ILogService {
 			kusciaClient: config.KusciaClient,
 			kubeClient:   config.KubeClient,
 			conf:         config,
+			archive:      newLogArchive(config.LogArchivePath),
 		}
 	}
 }
@@ -83,7 +93,7 @@ func (s logService) QueryPodNode(ctx context.Context, request *kusciaapi.QueryPo
 	task, err := s.kusciaClient.KusciaV1alpha1().KusciaTasks(common.KusciaCrossDomain).Get(ctx, request.TaskId, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.QueryPodNodeResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryPodNode, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryPodNode, err),
 		}
 	}
 
@@ -91,7 +101,7 @@ func (s logService) QueryPodNode(ctx context.Context, request *kusciaapi.QueryPo
 	nodeName, err := getPodNode(task, podName)
 	if err != nil {
 		return &kusciaapi.QueryPodNodeResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryPodNode, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryPodNode, err),
 		}
 	}
 
@@ -137,7 +147,7 @@ func getPodNode(task *v1alpha1.KusciaTask, podName string) (string, error) {
 	return podStatus.NodeName, nil
 }
 
-func (s logService) QueryTaskLog(ctx context.Context, request *kusciaapi.QueryLogRequest, eventCh chan<- *kusciaapi.QueryLogResponse) {
+func (s logService) QueryLog(ctx context.Context, request *kusciaapi.QueryLogRequest, eventCh chan<- *kusciaapi.QueryLogResponse) {
 	if s.conf.RunMode == common.RunModeMaster {
 		eventCh <- &kusciaapi.QueryLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrMasterAPINotSupport, "kuscia master api not support this interface now")}
 		return
@@ -156,21 +166,21 @@ func (s logService) QueryTaskLog(ctx context.Context, request *kusciaapi.QueryLo
 	nlog.Infof("Get kuscia task by task id")
 	task, err := s.kusciaClient.KusciaV1alpha1().KusciaTasks(common.KusciaCrossDomain).Get(ctx, request.TaskId, metav1.GetOptions{})
 	if err != nil {
-		eventCh <- &kusciaapi.QueryLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryLog, err.Error())}
+		eventCh <- &kusciaapi.QueryLogResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryLog, err)}
 		return
 	}
 
 	// validate request param
 	nlog.Infof("Validate query log request param")
-	if err = s.validateQueryRequest(ctx, request, task); err != nil {
-		eventCh <- &kusciaapi.QueryLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error())}
+	if err = s.validateQueryRequest(ctx, request.TaskId, request.ReplicaIdx, &request.Container, task); err != nil {
+		eventCh <- &kusciaapi.QueryLogResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err)}
 		return
 	}
 
 	// check task and pod status
 	nodeName, err := getPodNode(task, podName)
 	if err != nil {
-		eventCh <- &kusciaapi.QueryLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryLog, err.Error())}
+		eventCh <- &kusciaapi.QueryLogResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryLog, err)}
 		return
 	}
 	nlog.Infof("Query log get pod node %s, self node name: %s", nodeName, s.conf.NodeName)
@@ -194,7 +204,76 @@ func (s logService) QueryTaskLog(ctx context.Context, request *kusciaapi.QueryLo
 	}
 }
 
-func (s logService) validateQueryRequest(ctx context.Context, request *kusciaapi.QueryLogRequest, task *v1alpha1.KusciaTask) error {
+// locateTaskPod returns the ip of the node running the task pod of this domain, local is true if the pod runs on the
+// node of this api. The container is defaulted if it's empty.
+func (s logService) locateTaskPod(ctx context.Context, taskID string, replicaIdx int32, container *string) (nodeIP string, local bool, status *pbv1alpha1.Status) {
+	task, err := s.kusciaClient.KusciaV1alpha1().KusciaTasks(common.KusciaCrossDomain).Get(ctx, taskID, metav1.GetOptions{})
+	if err != nil {
+		return "", false, utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryLog, err)
+	}
+	if err = s.validateQueryRequest(ctx, taskID, replicaIdx, container, task); err != nil {
+		return "", false, utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err)
+	}
+	nodeName, err := getPodNode(task, buildPodName(s.conf.DomainID, taskID, replicaIdx))
+	if err != nil {
+		return "", false, utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryLog, err)
+	}
+	if nodeName == s.conf.NodeName {
+		return "", true, nil
+	}
+	nodeIP, err = s.getNodeIP(ctx, nodeName)
+	if err != nil {
+		return "", false, utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryLog, fmt.Sprintf("failed to get node ip for node %s, err: %v", nodeName, err))
+	}
+	return nodeIP, false, nil
+}
+
+func (s logService) QueryTaskLog(ctx context.Context, request *kusciaapi.QueryTaskLogRequest) *kusciaapi.QueryTaskLogResponse {
+	if s.conf.RunMode == common.RunModeMaster {
+		return &kusciaapi.QueryTaskLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrMasterAPINotSupport, "kuscia master api not support this interface now")}
+	}
+	if err := validateTaskLogRange(request.Offset, request.TailLines, request.LimitBytes); err != nil {
+		return &kusciaapi.QueryTaskLogResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err)}
+	}
+	if request.Local {
+		return localQueryTaskLog(request, s.conf.DomainID, s.conf.StdoutPath)
+	}
+	nodeIP, local, status := s.locateTaskPod(ctx, request.TaskId, request.ReplicaIdx, &request.Container)
+	if status != nil {
+		return &kusciaapi.QueryTaskLogResponse{Status: status}
+	}
+	if local {
+		return localQueryTaskLog(request, s.conf.DomainID, s.conf.StdoutPath)
+	}
+	return proxyQueryTaskLog(ctx, nodeIP, s.conf, request)
+}
+
+func (s logService) TailTaskLog(ctx context.Context, request *kusciaapi.TailTaskLogRequest, eventCh chan<- *kusciaapi.TailTaskLogResponse) {
+	if s.conf.RunMode == common.RunModeMaster {
+		eventCh <- &kusciaapi.TailTaskLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrMasterAPINotSupport, "kuscia master api not support this interface now")}
+		return
+	}
+	if err := validateTaskLogRange(request.Offset, request.TailLines, 0); err != nil {
+		eventCh <- &kusciaapi.TailTaskLogResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err)}
+		return
+	}
+	if request.Local {
+		localTailTaskLog(ctx, request, s.conf.DomainID, s.conf.StdoutPath, eventCh)
+		return
+	}
+	nodeIP, local, status := s.locateTaskPod(ctx, request.TaskId, request.ReplicaIdx, &request.Container)
+	if status != nil {
+		eventCh <- &kusciaapi.TailTaskLogResponse{Status: status}
+		return
+	}
+	if local {
+		localTailTaskLog(ctx, request, s.conf.DomainID, s.conf.StdoutPath, eventCh)
+		return
+	}
+	proxyTailTaskLog(ctx, nodeIP, s.conf, request, eventCh)
+}
+
+func (s logService) validateQueryRequest(ctx context.Context, taskID string, replicaIdx int32, container *string, task *v1alpha1.KusciaTask) error {
 	// find appimage
 	var appImageName string
 	var role string
@@ -211,7 +290,7 @@ func (s logService) validateQueryRequest(ctx context.Context, request *kusciaapi
 
 	appImage, err := s.kusciaClient.KusciaV1alpha1().AppImages().Get(ctx, appImageName, metav1.GetOptions{})
 	if err != nil {
-		return fmt.Errorf("can't find appimage %s for task id %s, err: %v", appImageName, request.TaskId, err)
+		return fmt.Errorf("can't find appimage %s for task id %s, err: %v", appImageName, taskID, err)
 	}
 
 	// find deploytemplate
@@ -228,110 +307,68 @@ func (s logService) validateQueryRequest(ctx context.Context, request *kusciaapi
 	}
 
 	// check replicate index param
-	if request.ReplicaIdx < 0 || request.ReplicaIdx >= *deployTemplate.Replicas {
+	if replicaIdx < 0 || replicaIdx >= *deployTemplate.Replicas {
 		return fmt.Errorf("replicate index invalid")
 	}
 
 	// check container param
-	if request.Container == "" {
+	if *container == "" {
 		if len(deployTemplate.Spec.Containers) == 1 {
-			request.Container = deployTemplate.Spec.Containers[0].Name
+			*container = deployTemplate.Spec.Containers[0].Name
 		} else {
 			return fmt.Errorf("task's deploy container larger than 1, please specify the container name")
 		}
 	} else {
 		found := false
-		for _, container := range deployTemplate.Spec.Containers {
-			if request.Container == container.Name {
+		for _, c := range deployTemplate.Spec.Containers {
+			if *container == c.Name {
 				found = true
 				break
 			}
 		}
 		if !found {
-			return fmt.Errorf("container name %s invalid", request.Container)
+			return fmt.Errorf("container name %s invalid", *container)
 		}
 	}
 	return nil
 }
 
 func localQueryLog(request *kusciaapi.QueryLogRequest, domain string, stdoutPath string, eventCh chan<- *kusciaapi.QueryLogResponse) error {
-	podPrefix := fmt.Sprintf("%s_%s-%d", domain, request.TaskId, request.ReplicaIdx)
+	logPath, err := findTaskLogPath(domain, stdoutPath, request.TaskId, request.ReplicaIdx, request.Container)
+	if err != nil {
+		return err
+	}
+	return tailFile(logPath, request.Follow, eventCh)
+}
+
+// findTaskLogPath returns the log file of the latest run of the container.
+func findTaskLogPath(domain, stdoutPath, taskID string, replicaIdx int32, container string) (string, error) {
+	podPrefix := fmt.Sprintf("%s_%s-%d", domain, taskID, replicaIdx)
 	podLogDir, err := findNewestDirWithPrefix(stdoutPath, podPrefix)
 	if err != nil || podLogDir == "" {
-		return fmt.Errorf("can't find pod log directory for %s, err: %v", podPrefix, err)
+		return "", fmt.Errorf("can't find pod log directory for %s, err: %v", podPrefix, err)
 	}
 	nlog.Infof("Newest pod log directory for %s is %s", podPrefix, podLogDir)
-	podLogDir = filepath.Join(podLogDir, request.Container)
+	podLogDir = filepath.Join(podLogDir, container)
 	logPath, err := findLargestRestartLogPath(podLogDir)
 	if err != nil || logPath == "" {
-		return fmt.Errorf("can't find pod log path for %s, err: %v", podLogDir, err)
+		return "", fmt.Errorf("can't find pod log path for %s, err: %v", podLogDir, err)
 	}
 	nlog.Infof("Largest pod log file in %s is %s", podLogDir, logPath)
-
-	return tailFile(logPath, request.Follow, eventCh)
+	return logPath, nil
 }
 
 func proxyQueryLog(ctx context.Context, nodeIP string, kusciaAPIConfig *config.KusciaAPIConfig, request *kusciaapi.QueryLogRequest, eventCh chan<- *kusciaapi.QueryLogResponse) error {
-	tlsConfig := kusciaAPIConfig.TLS
-	protocol := kusciaAPIConfig.Protocol
-	httpPort := kusciaAPIConfig.HTTPPort
-	tokenConfig := kusciaAPIConfig.Token
-	var clientTLSConfig *tls.Config
-	var err error
-	schema := constants.SchemaHTTP
-	// init client tls config
-	if tlsConfig != nil {
-		if protocol == common.TLS {
-			clientTLSConfig, err = tlsutils.BuildClientSimpleTLSConfig(tlsConfig.ServerCert)
-		} else {
-			clientTLSConfig, err = tlsutils.BuildClientTLSConfig(tlsConfig.RootCA, tlsConfig.ServerCert, tlsConfig.ServerKey)
-		}
-		if err != nil {
-			nlog.Errorf("local tls config error: %v", err)
-			return err
-		}
-		schema = constants.SchemaHTTPS
-	}
-
-	// token auth
-	var token string
-	var tokenAuth bool
-	if tokenConfig != nil {
-		token, err = apiutils.ReadToken(*tokenConfig)
-		if err != nil {
-			nlog.Error(err.Error())
-			return err
-		}
-		tokenAuth = true
-	}
-
-	httpClient := utils.BuildHTTPClient(clientTLSConfig)
-	httpURL := fmt.Sprintf("%s://%s:%d%s", schema, nodeIP, httpPort, QueryLogPath)
 	byteReq, err := json.Marshal(request)
 	if err != nil {
 		nlog.Errorf("Send request %+v ,marshal request failed: %s", request.String(), err.Error())
 		return err
 	}
-	req, err := http.NewRequest(http.MethodPost, httpURL, bytes.NewReader(byteReq))
+	resp, err := sendNodeRequest(ctx, nodeIP, kusciaAPIConfig, QueryLogPath, byteReq, constants.HTTPDefaultContentType)
 	if err != nil {
-		nlog.Errorf("invalid request error: %v", err)
-		return err
-	}
-	req.Header.Set(constants.ContentTypeHeader, constants.HTTPDefaultContentType)
-	if tokenAuth {
-		req.Header.Set(constants.TokenHeader, token)
-	}
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		nlog.Errorf("send health request error: %v", err)
 		return err
 	}
 	defer resp.Body.Close()
-	// check http status code
-	if resp.StatusCode != http.StatusOK {
-		nlog.Errorf("Send watch request %+v failed, http code: %d", request.String(), resp.StatusCode)
-		return fmt.Errorf("unexpected error, status_code: '%d'", resp.StatusCode)
-	}
 	// decode response
 	decoder := json.NewDecoder(resp.Body)
 	// Loop: parse the stream response
@@ -358,6 +395,67 @@ func proxyQueryLog(ctx context.Context, nodeIP string, kusciaAPIConfig *config.K
 	}
 }
 
+// sendNodeRequest sends the request to the kuscia api of another node of the domain.
+func sendNodeRequest(ctx context.Context, nodeIP string, kusciaAPIConfig *config.KusciaAPIConfig, path string, body []byte, contentType string) (*http.Response, error) {
+	clientTLSConfig, token, err := buildNodeClientConfig(kusciaAPIConfig)
+	if err != nil {
+		return nil, err
+	}
+	schema := constants.SchemaHTTP
+	if clientTLSConfig != nil {
+		schema = constants.SchemaHTTPS
+	}
+
+	httpClient := utils.BuildHTTPClient(clientTLSConfig)
+	httpURL := fmt.Sprintf("%s://%s:%d%s", schema, nodeIP, kusciaAPIConfig.HTTPPort, path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, httpURL, bytes.NewReader(body))
+	if err != nil {
+		nlog.Errorf("invalid request error: %v", err)
+		return nil, err
+	}
+	req.Header.Set(constants.ContentTypeHeader, contentType)
+	if token != "" {
+		req.Header.Set(constants.TokenHeader, token)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		nlog.Errorf("Send request to node %s error: %v", nodeIP, err)
+		return nil, err
+	}
+	// check http status code
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		nlog.Errorf("Send request to node %s failed, path: %s, http code: %d", nodeIP, path, resp.StatusCode)
+		return nil, fmt.Errorf("unexpected error, status_code: '%d'", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// buildNodeClientConfig returns the tls config and the token to access the kusciaapi of the other nodes, which share
+// the certificates and the token of the domain.
+func buildNodeClientConfig(kusciaAPIConfig *config.KusciaAPIConfig) (clientTLSConfig *tls.Config, token string, err error) {
+	tlsConfig := kusciaAPIConfig.TLS
+	if tlsConfig != nil {
+		if kusciaAPIConfig.Protocol == common.TLS {
+			clientTLSConfig, err = tlsutils.BuildClientSimpleTLSConfig(tlsConfig.ServerCert)
+		} else {
+			clientTLSConfig, err = tlsutils.BuildClientTLSConfig(tlsConfig.RootCA, tlsConfig.ServerCert, tlsConfig.ServerKey)
+		}
+		if err != nil {
+			nlog.Errorf("local tls config error: %v", err)
+			return nil, "", err
+		}
+	}
+	if kusciaAPIConfig.Token != nil {
+		token, err = apiutils.ReadToken(*kusciaAPIConfig.Token)
+		if err != nil {
+			nlog.Error(err.Error())
+			return nil, "", err
+		}
+	}
+	return clientTLSConfig, token, nil
+}
+
 func findNewestDirWithPrefix(rootDir string, prefix string) (string, error) {
 	var newestFolder string
 	var newestTime time.Time
@@ -22,6 +22,7 @@ import (
 	"github.com/secretflow/kuscia/pkg/kusciaapi/proxy"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/web/utils"
+	pbv1alpha1 "github.com/secretflow/kuscia/proto/api/v1alpha1"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
@@ -36,13 +37,13 @@ func (s logServiceLite) QueryPodNode(ctx context.Context, request *kusciaapi.Que
 	resp, err := s.kusciaAPIClient.QueryPodNode(ctx, request)
 	if err != nil {
 		return &kusciaapi.QueryPodNodeResponse{
-			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
 }
 
-func (s logServiceLite) QueryTaskLog(ctx context.Context, request *kusciaapi.QueryLogRequest, eventCh chan<- *kusciaapi.QueryLogResponse) {
+func (s logServiceLite) QueryLog(ctx context.Context, request *kusciaapi.QueryLogRequest, eventCh chan<- *kusciaapi.QueryLogResponse) {
 	// overrideRequestDomain(s.conf.RunMode, s.conf.DomainID, request)
 	domain := s.conf.DomainID
 	podName := fmt.Sprintf("%s/%s-%d", domain, request.TaskId, request.ReplicaIdx)
@@ -78,3 +79,98 @@ func (s logServiceLite) QueryTaskLog(ctx context.Context, request *kusciaapi.Que
 		}
 	}
 }
+
+// locateTaskPod asks the master for the node running the task pod of this domain, the container is checked by the node.
+func (s logServiceLite) locateTaskPod(ctx context.Context, taskID string, replicaIdx int32, _ *string) (nodeIP string, local bool, status *pbv1alpha1.Status) {
+	nodeResp := s.QueryPodNode(ctx, &kusciaapi.QueryPodNodeRequest{TaskId: taskID, Domain: s.conf.DomainID, ReplicaIdx: replicaIdx})
+	if !utils.IsSuccessCode(nodeResp.Status.Code) {
+		return "", false, utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryLog, fmt.Sprintf("failed to get pod node from master, err: %v", nodeResp.Status.Message))
+	}
+	return nodeResp.NodeIp, nodeResp.NodeName == s.conf.NodeName, nil
+}
+
+func (s logServiceLite) QueryTaskLog(ctx context.Context, request *kusciaapi.QueryTaskLogRequest) *kusciaapi.QueryTaskLogResponse {
+	if err := validateTaskLogRange(request.Offset, request.TailLines, request.LimitBytes); err != nil {
+		return &kusciaapi.QueryTaskLogResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err)}
+	}
+	if request.Local {
+		return localQueryTaskLog(request, s.conf.DomainID, s.conf.StdoutPath)
+	}
+	nodeIP, local, status := s.locateTaskPod(ctx, request.TaskId, request.ReplicaIdx, &request.Container)
+	if status != nil {
+		return &kusciaapi.QueryTaskLogResponse{Status: status}
+	}
+	if local {
+		return localQueryTaskLog(request, s.conf.DomainID, s.conf.StdoutPath)
+	}
+	return proxyQueryTaskLog(ctx, nodeIP, s.conf, request)
+}
+
+func (s logServiceLite) TailTaskLog(ctx context.Context, request *kusciaapi.TailTaskLogRequest, eventCh chan<- *kusciaapi.TailTaskLogResponse) {
+	if err := validateTaskLogRange(request.Offset, request.TailLines, 0); err != nil {
+		eventCh <- &kusciaapi.TailTaskLogResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err)}
+		return
+	}
+	if request.Local {
+		localTailTaskLog(ctx, request, s.conf.DomainID, s.conf.StdoutPath, eventCh)
+		return
+	}
+	nodeIP, local, status := s.locateTaskPod(ctx, request.TaskId, request.ReplicaIdx, &request.Container)
+	if status != nil {
+		eventCh <- &kusciaapi.TailTaskLogResponse{Status: status}
+		return
+	}
+	if local {
+		localTailTaskLog(ctx, request, s.conf.DomainID, s.conf.StdoutPath, eventCh)
+		return
+	}
+	proxyTailTaskLog(ctx, nodeIP, s.conf, request, eventCh)
+}
+
+func (s logServiceLite) PushArchivedLog(ctx context.Context, request *kusciaapi.PushArchivedLogRequest) *kusciaapi.PushArchivedLogResponse {
+	// the agents push the logs to the master directly
+	return &kusciaapi.PushArchivedLogResponse{
+		Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrLiteAPINotSupport, "kuscia lite api not support this interface now"),
+	}
+}
+
+func (s logServiceLite) ListArchivedLog(ctx context.Context, request *kusciaapi.ListArchivedLogRequest) *kusciaapi.ListArchivedLogResponse {
+	if err := s.validateArchiveDomain(&request.DomainId); err != nil {
+		return &kusciaapi.ListArchivedLogResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	resp, err := s.kusciaAPIClient.ListArchivedLog(ctx, request)
+	if err != nil {
+		return &kusciaapi.ListArchivedLogResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (s logServiceLite) DownloadArchivedLog(ctx context.Context, request *kusciaapi.DownloadArchivedLogRequest) *kusciaapi.DownloadArchivedLogResponse {
+	if err := s.validateArchiveDomain(&request.DomainId); err != nil {
+		return &kusciaapi.DownloadArchivedLogResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	resp, err := s.kusciaAPIClient.DownloadArchivedLog(ctx, request)
+	if err != nil {
+		return &kusciaapi.DownloadArchivedLogResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+// validateArchiveDomain fills the domain if it's empty, the lite api could only access the archived logs of its own domain.
+func (s logServiceLite) validateArchiveDomain(domainID *string) error {
+	if *domainID == "" {
+		*domainID = s.conf.DomainID
+	}
+	if *domainID != s.conf.DomainID {
+		return utils.NewFieldViolation("domain_id", "kuscia lite api could only access the logs of domain %s", s.conf.DomainID)
+	}
+	return nil
+}
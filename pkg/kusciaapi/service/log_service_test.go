@@ -17,8 +17,13 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,7 +31,10 @@ import (
 	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	"github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+	"google.golang.org/protobuf/proto"
 	"gotest.tools/v3/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -129,11 +137,131 @@ func TestQueryLog(t *testing.T) {
 	eventCh := make(chan *kusciaapi.QueryLogResponse, 1)
 	defer close(eventCh)
 
-	logService.QueryTaskLog(ctx, request, eventCh)
+	logService.QueryLog(ctx, request, eventCh)
 	log := <-eventCh
 	assert.Equal(t, log.Log, "hello world")
 }
 
+func TestQueryTaskLog(t *testing.T) {
+	ctx := context.Background()
+	logService := buildLogService(ctx, t).(*logService)
+
+	logDir := filepath.Join(logService.conf.StdoutPath, "alice_task1-0_xxxx/secretflow")
+	assert.NilError(t, os.MkdirAll(logDir, os.ModePerm))
+	assert.NilError(t, os.WriteFile(filepath.Join(logDir, "0.log"), []byte("line1\nline2\nline3\n"), 0644))
+
+	tests := []struct {
+		name    string
+		request *kusciaapi.QueryTaskLogRequest
+		log     string
+		offset  int64
+		next    int64
+	}{
+		{name: "whole file", request: &kusciaapi.QueryTaskLogRequest{TaskId: "task1"}, log: "line1\nline2\nline3\n", next: 18},
+		{name: "offset and limit", request: &kusciaapi.QueryTaskLogRequest{TaskId: "task1", Offset: 6, LimitBytes: 6}, log: "line2\n", offset: 6, next: 12},
+		{name: "tail lines", request: &kusciaapi.QueryTaskLogRequest{TaskId: "task1", TailLines: 2}, log: "line2\nline3\n", offset: 6, next: 18},
+		{name: "tail lines more than file", request: &kusciaapi.QueryTaskLogRequest{TaskId: "task1", TailLines: 10}, log: "line1\nline2\nline3\n", next: 18},
+		{name: "offset beyond file", request: &kusciaapi.QueryTaskLogRequest{TaskId: "task1", Offset: 100}, offset: 18, next: 18},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := logService.QueryTaskLog(ctx, tt.request)
+			assert.Equal(t, resp.Status.Code, int32(0), resp.Status.Message)
+			assert.Equal(t, resp.Data.Log, tt.log)
+			assert.Equal(t, resp.Data.Offset, tt.offset)
+			assert.Equal(t, resp.Data.NextOffset, tt.next)
+			assert.Equal(t, resp.Data.Size, int64(18))
+		})
+	}
+
+	resp := logService.QueryTaskLog(ctx, &kusciaapi.QueryTaskLogRequest{TaskId: "task1", Offset: -1})
+	assert.Assert(t, resp.Status.Code != 0)
+}
+
+func TestProxyQueryTaskLog(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.NilError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	assert.NilError(t, err)
+	conf := &config.KusciaAPIConfig{HTTPPort: int32(port)}
+
+	body, err = proto.Marshal(&kusciaapi.QueryTaskLogResponse{Data: &kusciaapi.QueryTaskLogResponseData{Log: "line1\n"}})
+	assert.NilError(t, err)
+	resp := proxyQueryTaskLog(context.Background(), serverURL.Hostname(), conf, &kusciaapi.QueryTaskLogRequest{TaskId: "task1"})
+	assert.Equal(t, resp.Data.Log, "line1\n")
+
+	// the node can't make the kusciaapi read an unbounded response
+	body = make([]byte, maxProxyLogResponseBytes+1)
+	resp = proxyQueryTaskLog(context.Background(), serverURL.Hostname(), conf, &kusciaapi.QueryTaskLogRequest{TaskId: "task1"})
+	assert.Assert(t, strings.Contains(resp.Status.Message, "exceeds"), resp.Status.Message)
+}
+
+func TestTailTaskLog(t *testing.T) {
+	ctx := context.Background()
+	logService := buildLogService(ctx, t).(*logService)
+
+	logDir := filepath.Join(logService.conf.StdoutPath, "alice_task1-0_xxxx/secretflow")
+	assert.NilError(t, os.MkdirAll(logDir, os.ModePerm))
+	assert.NilError(t, os.WriteFile(filepath.Join(logDir, "0.log"), []byte("line1\nline2\nline3\n"), 0644))
+
+	eventCh := make(chan *kusciaapi.TailTaskLogResponse, 10)
+	logService.TailTaskLog(ctx, &kusciaapi.TailTaskLogRequest{TaskId: "task1", TailLines: 2}, eventCh)
+	close(eventCh)
+	resp := <-eventCh
+	assert.Equal(t, resp.Status.Code, int32(0), resp.Status.Message)
+	assert.Equal(t, resp.Log, "line2\nline3")
+	assert.Equal(t, resp.NextOffset, int64(18))
+}
+
+func TestArchivedLog(t *testing.T) {
+	ctx := context.Background()
+	logService := buildLogService(ctx, t).(*logService)
+	logService.archive = newLogArchive(t.TempDir())
+
+	chunk := func(offset int64, data string) *kusciaapi.ArchivedLogChunk {
+		return &kusciaapi.ArchivedLogChunk{DomainId: "alice", JobId: "job1", TaskId: "task1", PodName: "task1-0",
+			Container: "secretflow", Offset: offset, Data: []byte(data)}
+	}
+	// the resent chunk is skipped
+	pushResp := logService.PushArchivedLog(ctx, &kusciaapi.PushArchivedLogRequest{
+		NodeName: "node",
+		Chunks:   []*kusciaapi.ArchivedLogChunk{chunk(0, "line1\n"), chunk(0, "line1\n"), chunk(3, "e1\nline2\n")},
+	})
+	assert.Equal(t, pushResp.Status.Code, int32(0), pushResp.Status.Message)
+
+	listResp := logService.ListArchivedLog(ctx, &kusciaapi.ListArchivedLogRequest{DomainId: "alice", JobId: "job1"})
+	assert.Equal(t, listResp.Status.Code, int32(0), listResp.Status.Message)
+	assert.Equal(t, len(listResp.Data.Files), 1)
+	assert.Equal(t, listResp.Data.Files[0].PodName, "task1-0")
+	assert.Equal(t, listResp.Data.Files[0].Size, int64(12))
+
+	downloadResp := logService.DownloadArchivedLog(ctx, &kusciaapi.DownloadArchivedLogRequest{DomainId: "alice", JobId: "job1",
+		TaskId: "task1", PodName: "task1-0", Container: "secretflow", Offset: 6})
+	assert.Equal(t, downloadResp.Status.Code, int32(0), downloadResp.Status.Message)
+	assert.Equal(t, downloadResp.Data.Log, "line2\n")
+
+	listResp = logService.ListArchivedLog(ctx, &kusciaapi.ListArchivedLogRequest{JobId: "job1"})
+	assert.Assert(t, listResp.Status.Code != 0)
+	downloadResp = logService.DownloadArchivedLog(ctx, &kusciaapi.DownloadArchivedLogRequest{DomainId: "alice", JobId: "job1",
+		TaskId: "task1", PodName: "../task1-0", Container: "secretflow"})
+	assert.Assert(t, downloadResp.Status.Code != 0)
+
+	// the tenant could only access the logs of its domains
+	tenantCtx := context.WithValue(ctx, consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+	listResp = logService.ListArchivedLog(tenantCtx, &kusciaapi.ListArchivedLogRequest{DomainId: "alice", JobId: "job1"})
+	assert.Assert(t, listResp.Status.Code != 0)
+	downloadResp = logService.DownloadArchivedLog(tenantCtx, &kusciaapi.DownloadArchivedLogRequest{DomainId: "alice", JobId: "job1",
+		TaskId: "task1", PodName: "task1-0", Container: "secretflow"})
+	assert.Assert(t, downloadResp.Status.Code != 0)
+	pushResp = logService.PushArchivedLog(tenantCtx, &kusciaapi.PushArchivedLogRequest{NodeName: "node", Chunks: []*kusciaapi.ArchivedLogChunk{chunk(12, "line3\n")}})
+	assert.Assert(t, pushResp.Status.Code != 0)
+}
+
 func TestQueryPodNode(t *testing.T) {
 	ctx := context.Background()
 	logService := buildLogService(ctx, t)
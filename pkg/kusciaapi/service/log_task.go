@@ -0,0 +1,274 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/nxadm/tail"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const (
+	defaultLogLimitBytes = 1 << 20
+	maxLogLimitBytes     = 8 << 20
+	// maxProxyLogResponseBytes bounds the response of the node, which holds at most maxLogLimitBytes of the log
+	// besides the status and offsets.
+	maxProxyLogResponseBytes = maxLogLimitBytes + 64<<10
+)
+
+func validateTaskLogRange(offset, tailLines, limitBytes int64) error {
+	if offset < 0 {
+		return utils.NewFieldViolation("offset", "offset can not be negative")
+	}
+	if tailLines < 0 {
+		return utils.NewFieldViolation("tail_lines", "tail lines can not be negative")
+	}
+	if limitBytes < 0 || limitBytes > maxLogLimitBytes {
+		return utils.NewFieldViolation("limit_bytes", "limit bytes should be in [0, %d]", maxLogLimitBytes)
+	}
+	return nil
+}
+
+func localQueryTaskLog(request *kusciaapi.QueryTaskLogRequest, domain, stdoutPath string) *kusciaapi.QueryTaskLogResponse {
+	logPath, err := findTaskLogPath(domain, stdoutPath, request.TaskId, request.ReplicaIdx, request.Container)
+	if err != nil {
+		return &kusciaapi.QueryTaskLogResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryLog, err)}
+	}
+	limitBytes := request.LimitBytes
+	if limitBytes == 0 {
+		limitBytes = defaultLogLimitBytes
+	}
+	data, err := readLogRange(logPath, request.Offset, request.TailLines, limitBytes)
+	if err != nil {
+		return &kusciaapi.QueryTaskLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryLog, fmt.Sprintf("failed to read log file, err: %v", err))}
+	}
+	return &kusciaapi.QueryTaskLogResponse{Status: utils.BuildSuccessResponseStatus(), Data: data}
+}
+
+// readLogRange reads at most limitBytes of the file from the offset, or from the last tailLines lines if it's positive.
+func readLogRange(logPath string, offset, tailLines, limitBytes int64) (*kusciaapi.QueryTaskLogResponseData, error) {
+	f, err := os.Open(logPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+
+	start := offset
+	if tailLines > 0 {
+		if start, err = tailLinesOffset(f, size, tailLines); err != nil {
+			return nil, err
+		}
+	}
+	start = min(start, size)
+	buf := make([]byte, min(limitBytes, size-start))
+	if _, err := f.ReadAt(buf, start); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return &kusciaapi.QueryTaskLogResponseData{
+		Log:        string(buf),
+		Offset:     start,
+		NextOffset: start + int64(len(buf)),
+		Size:       size,
+	}, nil
+}
+
+// tailLinesOffset returns the offset where the last n lines of the file start.
+func tailLinesOffset(f *os.File, size, n int64) (int64, error) {
+	buf := make([]byte, 4096)
+	var lines int64
+	for pos := size; pos > 0; {
+		readSize := min(int64(len(buf)), pos)
+		pos -= readSize
+		if _, err := f.ReadAt(buf[:readSize], pos); err != nil {
+			return 0, err
+		}
+		for i := readSize - 1; i >= 0; i-- {
+			// the newline ending the file doesn't start a line
+			if buf[i] != '\n' || pos+i == size-1 {
+				continue
+			}
+			lines++
+			if lines == n {
+				return pos + i + 1, nil
+			}
+		}
+	}
+	return 0, nil
+}
+
+func localTailTaskLog(ctx context.Context, request *kusciaapi.TailTaskLogRequest, domain, stdoutPath string, eventCh chan<- *kusciaapi.TailTaskLogResponse) {
+	logPath, err := findTaskLogPath(domain, stdoutPath, request.TaskId, request.ReplicaIdx, request.Container)
+	if err != nil {
+		eventCh <- &kusciaapi.TailTaskLogResponse{Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryLog, err)}
+		return
+	}
+	offset := request.Offset
+	if request.TailLines > 0 {
+		offset, err = func() (int64, error) {
+			f, err := os.Open(logPath)
+			if err != nil {
+				return 0, err
+			}
+			defer f.Close()
+			info, err := f.Stat()
+			if err != nil {
+				return 0, err
+			}
+			return tailLinesOffset(f, info.Size(), request.TailLines)
+		}()
+		if err != nil {
+			eventCh <- &kusciaapi.TailTaskLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryLog, fmt.Sprintf("failed to read log file, err: %v", err))}
+			return
+		}
+	}
+
+	err = tailFileFrom(ctx, logPath, request.Follow, offset, func(log string, nextOffset int64) {
+		eventCh <- &kusciaapi.TailTaskLogResponse{Status: utils.BuildSuccessResponseStatus(), Log: log, NextOffset: nextOffset}
+	})
+	if err != nil {
+		eventCh <- &kusciaapi.TailTaskLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryLog, fmt.Sprintf("failed to tail log file, err: %v", err))}
+	}
+}
+
+// tailFileFrom sends the lines of the file from the offset in batches, along with the offset following the batch.
+func tailFileFrom(ctx context.Context, fileName string, follow bool, offset int64, send func(log string, nextOffset int64)) error {
+	t, err := tail.TailFile(fileName, tail.Config{
+		Follow:   follow,
+		ReOpen:   follow,
+		Poll:     true,
+		Location: &tail.SeekInfo{Offset: offset, Whence: io.SeekStart},
+	})
+	if err != nil {
+		return err
+	}
+	defer t.Cleanup()
+	defer t.Stop()
+
+	var buffer []string
+	nextOffset := offset
+	flush := func() {
+		if len(buffer) != 0 {
+			send(strings.Join(buffer, "\n"), nextOffset)
+			buffer = buffer[:0]
+		}
+	}
+	ticker := time.NewTicker(OutputPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			flush()
+			return nil
+		case <-ticker.C:
+			flush()
+		case line, ok := <-t.Lines:
+			if !ok {
+				flush()
+				return nil
+			}
+			if line.Err != nil {
+				nlog.Errorf("Tail line error: %v", line.Err)
+				continue
+			}
+			buffer = append(buffer, line.Text)
+			nextOffset = line.SeekInfo.Offset
+			if len(buffer) >= OutputLineNum {
+				flush()
+				ticker.Reset(OutputPeriod)
+			}
+		}
+	}
+}
+
+func proxyQueryTaskLog(ctx context.Context, nodeIP string, kusciaAPIConfig *config.KusciaAPIConfig, request *kusciaapi.QueryTaskLogRequest) *kusciaapi.QueryTaskLogResponse {
+	// the pod has been located, the node reads its own log
+	proxyRequest := proto.Clone(request).(*kusciaapi.QueryTaskLogRequest)
+	proxyRequest.Local = true
+	response := &kusciaapi.QueryTaskLogResponse{}
+	err := func() error {
+		byteReq, err := proto.Marshal(proxyRequest)
+		if err != nil {
+			return err
+		}
+		resp, err := sendNodeRequest(ctx, nodeIP, kusciaAPIConfig, QueryTaskLogPath, byteReq, binding.MIMEPROTOBUF)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxProxyLogResponseBytes+1))
+		if err != nil {
+			return err
+		}
+		if len(body) > maxProxyLogResponseBytes {
+			return fmt.Errorf("response of node %s exceeds %d bytes", nodeIP, maxProxyLogResponseBytes)
+		}
+		return proto.Unmarshal(body, response)
+	}()
+	if err != nil {
+		return &kusciaapi.QueryTaskLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryLog, fmt.Sprintf("failed to proxy query, err: %v", err))}
+	}
+	return response
+}
+
+func proxyTailTaskLog(ctx context.Context, nodeIP string, kusciaAPIConfig *config.KusciaAPIConfig, request *kusciaapi.TailTaskLogRequest, eventCh chan<- *kusciaapi.TailTaskLogResponse) {
+	proxyRequest := proto.Clone(request).(*kusciaapi.TailTaskLogRequest)
+	proxyRequest.Local = true
+	err := func() error {
+		byteReq, err := json.Marshal(proxyRequest)
+		if err != nil {
+			return err
+		}
+		resp, err := sendNodeRequest(ctx, nodeIP, kusciaAPIConfig, TailTaskLogPath, byteReq, constants.HTTPDefaultContentType)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		decoder := json.NewDecoder(resp.Body)
+		for {
+			event := &kusciaapi.TailTaskLogResponse{}
+			if err := decoder.Decode(event); err != nil {
+				if errors.Is(err, io.EOF) || ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			eventCh <- event
+		}
+	}()
+	if err != nil {
+		eventCh <- &kusciaapi.TailTaskLogResponse{Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryLog, fmt.Sprintf("failed to proxy tail, err: %v", err))}
+	}
+}
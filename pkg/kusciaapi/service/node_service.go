@@ -0,0 +1,215 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/proxy"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+type INodeService interface {
+	CordonNode(ctx context.Context, request *kusciaapi.CordonNodeRequest) *kusciaapi.CordonNodeResponse
+	UncordonNode(ctx context.Context, request *kusciaapi.UncordonNodeRequest) *kusciaapi.UncordonNodeResponse
+	DrainNode(ctx context.Context, request *kusciaapi.DrainNodeRequest) *kusciaapi.DrainNodeResponse
+}
+
+type nodeService struct {
+	kubeClient kubernetes.Interface
+}
+
+func NewNodeService(config *config.KusciaAPIConfig) INodeService {
+	switch config.RunMode {
+	case common.RunModeLite:
+		return &nodeServiceLite{
+			domainID:        config.DomainID,
+			kusciaAPIClient: proxy.NewKusciaAPIClient(""),
+		}
+	default:
+		return &nodeService{
+			kubeClient: config.KubeClient,
+		}
+	}
+}
+
+// CordonNode marks the node unschedulable, the pods of the new tasks are not scheduled to it.
+func (s nodeService) CordonNode(ctx context.Context, request *kusciaapi.CordonNodeRequest) *kusciaapi.CordonNodeResponse {
+	if err := s.setUnschedulable(ctx, request.DomainId, request.NodeName, true); err != nil {
+		return &kusciaapi.CordonNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCordonNode, err),
+		}
+	}
+	return &kusciaapi.CordonNodeResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+	}
+}
+
+func (s nodeService) UncordonNode(ctx context.Context, request *kusciaapi.UncordonNodeRequest) *kusciaapi.UncordonNodeResponse {
+	if err := s.setUnschedulable(ctx, request.DomainId, request.NodeName, false); err != nil {
+		return &kusciaapi.UncordonNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUncordonNode, err),
+		}
+	}
+	return &kusciaapi.UncordonNodeResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+	}
+}
+
+// DrainNode cordons the node and evicts its running pods through the eviction api, so that the pods are terminated
+// gracefully. The pods tolerating the unschedulable node are kept.
+func (s nodeService) DrainNode(ctx context.Context, request *kusciaapi.DrainNodeRequest) *kusciaapi.DrainNodeResponse {
+	if request.GracePeriodSeconds < 0 {
+		return &kusciaapi.DrainNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils.NewFieldViolation("grace_period_seconds", "grace period seconds can not be negative")),
+		}
+	}
+	if err := s.setUnschedulable(ctx, request.DomainId, request.NodeName, true); err != nil {
+		return &kusciaapi.DrainNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrDrainNode, err),
+		}
+	}
+
+	pods, err := s.kubeClient.CoreV1().Pods(request.DomainId).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", request.NodeName).String(),
+	})
+	if err != nil {
+		return &kusciaapi.DrainNodeResponse{
+			Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrDrainNode, fmt.Sprintf("failed to list pods of node %s, %v", request.NodeName, err)),
+		}
+	}
+
+	deleteOptions := &metav1.DeleteOptions{}
+	if request.GracePeriodSeconds > 0 {
+		deleteOptions.GracePeriodSeconds = &request.GracePeriodSeconds
+	}
+	results := make([]*kusciaapi.DrainPodResult, 0, len(pods.Items))
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName != request.NodeName || pod.DeletionTimestamp != nil ||
+			pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		result := &kusciaapi.DrainPodResult{
+			Name:   pod.Name,
+			TaskId: pod.Annotations[common.TaskIDAnnotationKey],
+		}
+		results = append(results, result)
+
+		if toleratesUnschedulable(pod) {
+			result.Message = "the pod tolerates the unschedulable node"
+			continue
+		}
+		err := s.kubeClient.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{
+			ObjectMeta:    metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+			DeleteOptions: deleteOptions,
+		})
+		if err != nil {
+			nlog.Warnf("Evict pod %s/%s of node %s failed, %v", pod.Namespace, pod.Name, request.NodeName, err)
+			result.Message = err.Error()
+			continue
+		}
+		result.Evicted = true
+	}
+	nlog.Infof("Drained node %s of domain %s, %d pods are handled", request.NodeName, request.DomainId, len(results))
+
+	return &kusciaapi.DrainNodeResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data: &kusciaapi.DrainNodeResponseData{
+			Pods: results,
+		},
+	}
+}
+
+// setUnschedulable cordons or uncordons the node of the domain. The label marks the nodes cordoned by the api, the
+// agent keeps them unschedulable after restarting.
+func (s nodeService) setUnschedulable(ctx context.Context, domainID, nodeName string, unschedulable bool) error {
+	if err := validateNodeRequest(ctx, domainID, nodeName); err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := s.kubeClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if node.Labels[common.LabelNodeNamespace] != domainID {
+			return fmt.Errorf("node %s does not belong to domain %s", nodeName, domainID)
+		}
+		if node.Spec.Unschedulable == unschedulable && isNodeCordoned(node) == unschedulable {
+			return nil
+		}
+		node.Spec.Unschedulable = unschedulable
+		if unschedulable {
+			if node.Labels == nil {
+				node.Labels = map[string]string{}
+			}
+			node.Labels[common.LabelNodeCordoned] = common.True
+		} else {
+			delete(node.Labels, common.LabelNodeCordoned)
+		}
+		_, err = s.kubeClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		if err == nil {
+			nlog.Infof("Set node %s of domain %s unschedulable=%v", nodeName, domainID, unschedulable)
+		}
+		return err
+	})
+}
+
+func validateNodeRequest(ctx context.Context, domainID, nodeName string) error {
+	if domainID == "" {
+		return utils.NewFieldViolation("domain_id", "domain id can not be empty")
+	}
+	if nodeName == "" {
+		return utils.NewFieldViolation("node_name", "node name can not be empty")
+	}
+	if err := tenant.CheckDomains(ctx, domainID); err != nil {
+		return err
+	}
+	role, ctxDomainID := GetRoleAndDomainFromCtx(ctx)
+	if role == consts.AuthRoleDomain && domainID != ctxDomainID {
+		return fmt.Errorf("domain's kusciaAPI could only operate the nodes of itself, domain:%s, request domain:%s", ctxDomainID, domainID)
+	}
+	return nil
+}
+
+func isNodeCordoned(node *v1.Node) bool {
+	return node.Labels[common.LabelNodeCordoned] == common.True
+}
+
+func toleratesUnschedulable(pod *v1.Pod) bool {
+	taint := &v1.Taint{Key: v1.TaintNodeUnschedulable, Effect: v1.TaintEffectNoSchedule}
+	for i := range pod.Spec.Tolerations {
+		if pod.Spec.Tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
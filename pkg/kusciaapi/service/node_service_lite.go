@@ -0,0 +1,87 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/proxy"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// nodeServiceLite operates the nodes of the lite domain through the master api.
+type nodeServiceLite struct {
+	domainID        string
+	kusciaAPIClient proxy.KusciaAPIClient
+}
+
+func (s nodeServiceLite) CordonNode(ctx context.Context, request *kusciaapi.CordonNodeRequest) *kusciaapi.CordonNodeResponse {
+	if err := s.validateDomain(&request.DomainId); err != nil {
+		return &kusciaapi.CordonNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	resp, err := s.kusciaAPIClient.CordonNode(ctx, request)
+	if err != nil {
+		return &kusciaapi.CordonNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (s nodeServiceLite) UncordonNode(ctx context.Context, request *kusciaapi.UncordonNodeRequest) *kusciaapi.UncordonNodeResponse {
+	if err := s.validateDomain(&request.DomainId); err != nil {
+		return &kusciaapi.UncordonNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	resp, err := s.kusciaAPIClient.UncordonNode(ctx, request)
+	if err != nil {
+		return &kusciaapi.UncordonNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (s nodeServiceLite) DrainNode(ctx context.Context, request *kusciaapi.DrainNodeRequest) *kusciaapi.DrainNodeResponse {
+	if err := s.validateDomain(&request.DomainId); err != nil {
+		return &kusciaapi.DrainNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	resp, err := s.kusciaAPIClient.DrainNode(ctx, request)
+	if err != nil {
+		return &kusciaapi.DrainNodeResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+// validateDomain fills the domain of the lite api if it's empty, the lite api could only operate its own nodes.
+func (s nodeServiceLite) validateDomain(domainID *string) error {
+	if *domainID == "" {
+		*domainID = s.domainID
+	}
+	if *domainID != s.domainID {
+		return utils.NewFieldViolation("domain_id", fmt.Sprintf("kuscia lite api could only operate the nodes of domain %s", s.domainID))
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func newNodeTestPod(name, nodeName string, tolerations ...v1.Toleration) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "alice",
+			Annotations: map[string]string{common.TaskIDAnnotationKey: "task-" + name},
+		},
+		Spec:   v1.PodSpec{NodeName: nodeName, Tolerations: tolerations},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+func newNodeTestService() (*nodeService, *kubefake.Clientset) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "alice-node",
+			Labels: map[string]string{common.LabelNodeNamespace: "alice"},
+		},
+	}
+	kubeClient := kubefake.NewSimpleClientset(node,
+		newNodeTestPod("a", "alice-node"),
+		newNodeTestPod("b", "alice-node", v1.Toleration{Operator: v1.TolerationOpExists}),
+		newNodeTestPod("c", "other-node"),
+	)
+	return &nodeService{kubeClient: kubeClient}, kubeClient
+}
+
+func TestCordonNode(t *testing.T) {
+	s, kubeClient := newNodeTestService()
+	ctx := context.Background()
+
+	resp := s.CordonNode(ctx, &kusciaapi.CordonNodeRequest{DomainId: "alice", NodeName: "alice-node"})
+	assert.Equal(t, int32(0), resp.Status.Code)
+	node, _ := kubeClient.CoreV1().Nodes().Get(ctx, "alice-node", metav1.GetOptions{})
+	assert.True(t, node.Spec.Unschedulable)
+	assert.Equal(t, common.True, node.Labels[common.LabelNodeCordoned])
+
+	uncordonResp := s.UncordonNode(ctx, &kusciaapi.UncordonNodeRequest{DomainId: "alice", NodeName: "alice-node"})
+	assert.Equal(t, int32(0), uncordonResp.Status.Code)
+	node, _ = kubeClient.CoreV1().Nodes().Get(ctx, "alice-node", metav1.GetOptions{})
+	assert.False(t, node.Spec.Unschedulable)
+	assert.NotContains(t, node.Labels, common.LabelNodeCordoned)
+
+	// the node of other domain
+	resp = s.CordonNode(ctx, &kusciaapi.CordonNodeRequest{DomainId: "bob", NodeName: "alice-node"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrCordonNode), resp.Status.Code)
+	resp = s.CordonNode(ctx, &kusciaapi.CordonNodeRequest{DomainId: "alice"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrCordonNode), resp.Status.Code)
+}
+
+func TestDrainNode(t *testing.T) {
+	s, kubeClient := newNodeTestService()
+	ctx := context.Background()
+
+	resp := s.DrainNode(ctx, &kusciaapi.DrainNodeRequest{DomainId: "alice", NodeName: "alice-node", GracePeriodSeconds: 30})
+	assert.Equal(t, int32(0), resp.Status.Code)
+	node, _ := kubeClient.CoreV1().Nodes().Get(ctx, "alice-node", metav1.GetOptions{})
+	assert.True(t, node.Spec.Unschedulable)
+
+	assert.Len(t, resp.Data.Pods, 2)
+	results := map[string]*kusciaapi.DrainPodResult{}
+	for _, result := range resp.Data.Pods {
+		results[result.Name] = result
+	}
+	assert.True(t, results["a"].Evicted)
+	assert.Equal(t, "task-a", results["a"].TaskId)
+	assert.False(t, results["b"].Evicted)
+
+	resp = s.DrainNode(ctx, &kusciaapi.DrainNodeRequest{DomainId: "alice", NodeName: "alice-node", GracePeriodSeconds: -1})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate), resp.Status.Code)
+}
+
+func TestNodeTenant(t *testing.T) {
+	s, kubeClient := newNodeTestService()
+	ctx := context.WithValue(context.Background(), consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+
+	resp := s.CordonNode(ctx, &kusciaapi.CordonNodeRequest{DomainId: "alice", NodeName: "alice-node"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrCordonNode), resp.Status.Code)
+	node, _ := kubeClient.CoreV1().Nodes().Get(ctx, "alice-node", metav1.GetOptions{})
+	assert.False(t, node.Spec.Unschedulable)
+
+	drainResp := s.DrainNode(ctx, &kusciaapi.DrainNodeRequest{DomainId: "alice", NodeName: "alice-node"})
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrDrainNode), drainResp.Status.Code)
+}
@@ -0,0 +1,349 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kuscialistersv1alpha1 "github.com/secretflow/kuscia/pkg/crd/listers/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/proxy"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+const (
+	SearchTypeJob             = "job"
+	SearchTypeDomainData      = "domaindata"
+	SearchTypeDomainDataGrant = "domaindatagrant"
+	SearchTypeAppImage        = "appimage"
+
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// searchTypes are in the order of the results created at the same time.
+var searchTypes = []string{SearchTypeJob, SearchTypeDomainData, SearchTypeDomainDataGrant, SearchTypeAppImage}
+
+type ISearchService interface {
+	Search(ctx context.Context, request *kusciaapi.SearchRequest) *kusciaapi.SearchResponse
+}
+
+// searchService searches the resources in the informer caches, so that the search box of the ui doesn't
+// list the resources from the api server on each keystroke.
+type searchService struct {
+	jobLister             kuscialistersv1alpha1.KusciaJobLister
+	domainDataLister      kuscialistersv1alpha1.DomainDataLister
+	domainDataGrantLister kuscialistersv1alpha1.DomainDataGrantLister
+	appImageLister        kuscialistersv1alpha1.AppImageLister
+}
+
+func NewSearchService(config *config.KusciaAPIConfig) ISearchService {
+	switch config.RunMode {
+	case common.RunModeLite:
+		return &searchServiceLite{
+			domainID:        config.DomainID,
+			kusciaAPIClient: proxy.NewKusciaAPIClient(""),
+		}
+	default:
+		factory := config.KusciaInformerFactory.Kuscia().V1alpha1()
+		return &searchService{
+			jobLister:             factory.KusciaJobs().Lister(),
+			domainDataLister:      factory.DomainDatas().Lister(),
+			domainDataGrantLister: factory.DomainDataGrants().Lister(),
+			appImageLister:        factory.AppImages().Lister(),
+		}
+	}
+}
+
+type searchHit struct {
+	result     *kusciaapi.SearchResult
+	createTime time.Time
+	typeOrder  int
+}
+
+func (s *searchService) Search(ctx context.Context, request *kusciaapi.SearchRequest) *kusciaapi.SearchResponse {
+	types, offset, pageSize, err := validateSearchRequest(request)
+	if err != nil {
+		return &kusciaapi.SearchResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	if request.DomainId != "" {
+		if err = tenant.CheckDomains(ctx, request.DomainId); err != nil {
+			return &kusciaapi.SearchResponse{
+				Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
+			}
+		}
+	}
+
+	keyword := strings.ToLower(request.Keyword)
+	allows := func(domainIDs ...string) bool {
+		if request.DomainId != "" && !slices.Contains(domainIDs, request.DomainId) {
+			return false
+		}
+		t := tenant.FromContext(ctx)
+		if t == nil {
+			return true
+		}
+		for _, domainID := range domainIDs {
+			if t.Allows(domainID) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var hits []*searchHit
+	for i, searchType := range searchTypes {
+		if !types[searchType] {
+			continue
+		}
+		var typeHits []*searchHit
+		switch searchType {
+		case SearchTypeJob:
+			typeHits, err = s.searchJobs(keyword, allows)
+		case SearchTypeDomainData:
+			typeHits, err = s.searchDomainData(keyword, request.DomainId, allows)
+		case SearchTypeDomainDataGrant:
+			typeHits, err = s.searchDomainDataGrants(keyword, request.DomainId, allows)
+		case SearchTypeAppImage:
+			typeHits, err = s.searchAppImages(keyword)
+		}
+		if err != nil {
+			return &kusciaapi.SearchResponse{
+				Status: utils.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrSearch, fmt.Sprintf("search %s failed, %s", searchType, err.Error())),
+			}
+		}
+		for _, hit := range typeHits {
+			hit.typeOrder = i
+		}
+		hits = append(hits, typeHits...)
+	}
+
+	// the newest first, the order is stable so that the pages don't overlap if the resources don't change
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := hits[i], hits[j]
+		if !a.createTime.Equal(b.createTime) {
+			return a.createTime.After(b.createTime)
+		}
+		if a.typeOrder != b.typeOrder {
+			return a.typeOrder < b.typeOrder
+		}
+		if a.result.DomainId != b.result.DomainId {
+			return a.result.DomainId < b.result.DomainId
+		}
+		return a.result.Id < b.result.Id
+	})
+
+	data := &kusciaapi.SearchResponseData{Total: int32(len(hits))}
+	if offset < len(hits) {
+		end := min(offset+pageSize, len(hits))
+		for _, hit := range hits[offset:end] {
+			data.Results = append(data.Results, hit.result)
+		}
+		if end < len(hits) {
+			data.NextPageToken = strconv.Itoa(end)
+		}
+	}
+	return &kusciaapi.SearchResponse{
+		Status: utils.BuildSuccessResponseStatus(),
+		Data:   data,
+	}
+}
+
+func (s *searchService) searchJobs(keyword string, allows func(domainIDs ...string) bool) ([]*searchHit, error) {
+	jobs, err := s.jobLister.KusciaJobs(common.KusciaCrossDomain).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var hits []*searchHit
+	for _, job := range jobs {
+		domainIDs := []string{job.Spec.Initiator}
+		m := &searchMatcher{keyword: keyword}
+		m.match("id", job.Name)
+		m.match("initiator", job.Spec.Initiator)
+		for _, task := range job.Spec.Tasks {
+			m.match("tasks.alias", task.Alias)
+			for _, party := range task.Parties {
+				domainIDs = append(domainIDs, party.DomainID)
+			}
+		}
+		if len(m.fields) == 0 || !allows(domainIDs...) {
+			continue
+		}
+		hits = append(hits, newSearchHit(SearchTypeJob, job.Name, job.Spec.Initiator, job.Name, &job.ObjectMeta, m.fields))
+	}
+	return hits, nil
+}
+
+func (s *searchService) searchDomainData(keyword, domainID string, allows func(domainIDs ...string) bool) ([]*searchHit, error) {
+	var (
+		list []*v1alpha1.DomainData
+		err  error
+	)
+	if domainID != "" {
+		list, err = s.domainDataLister.DomainDatas(domainID).List(labels.Everything())
+	} else {
+		list, err = s.domainDataLister.List(labels.Everything())
+	}
+	if err != nil {
+		return nil, err
+	}
+	var hits []*searchHit
+	for _, dd := range list {
+		m := &searchMatcher{keyword: keyword}
+		m.match("id", dd.Name)
+		m.match("name", dd.Spec.Name)
+		m.matchMap("attributes", dd.Spec.Attributes)
+		if len(m.fields) == 0 || !allows(dd.Namespace) {
+			continue
+		}
+		hits = append(hits, newSearchHit(SearchTypeDomainData, dd.Name, dd.Namespace, dd.Spec.Name, &dd.ObjectMeta, m.fields))
+	}
+	return hits, nil
+}
+
+func (s *searchService) searchDomainDataGrants(keyword, domainID string, allows func(domainIDs ...string) bool) ([]*searchHit, error) {
+	var (
+		list []*v1alpha1.DomainDataGrant
+		err  error
+	)
+	if domainID != "" {
+		list, err = s.domainDataGrantLister.DomainDataGrants(domainID).List(labels.Everything())
+	} else {
+		list, err = s.domainDataGrantLister.List(labels.Everything())
+	}
+	if err != nil {
+		return nil, err
+	}
+	var hits []*searchHit
+	for _, dg := range list {
+		m := &searchMatcher{keyword: keyword}
+		m.match("id", dg.Name)
+		m.match("domaindata_id", dg.Spec.DomainDataID)
+		m.match("grant_domain", dg.Spec.GrantDomain)
+		m.matchMap("description", dg.Spec.Description)
+		if len(m.fields) == 0 || !allows(dg.Namespace) {
+			continue
+		}
+		hits = append(hits, newSearchHit(SearchTypeDomainDataGrant, dg.Name, dg.Namespace, dg.Spec.DomainDataID, &dg.ObjectMeta, m.fields))
+	}
+	return hits, nil
+}
+
+// searchAppImages searches all the appimages, they are shared by the domains.
+func (s *searchService) searchAppImages(keyword string) ([]*searchHit, error) {
+	list, err := s.appImageLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var hits []*searchHit
+	for _, image := range list {
+		m := &searchMatcher{keyword: keyword}
+		m.match("id", image.Name)
+		m.match("image.name", image.Spec.Image.Name)
+		m.match("image.tag", image.Spec.Image.Tag)
+		if len(m.fields) == 0 {
+			continue
+		}
+		name := fmt.Sprintf("%s:%s", image.Spec.Image.Name, image.Spec.Image.Tag)
+		hits = append(hits, newSearchHit(SearchTypeAppImage, image.Name, "", name, &image.ObjectMeta, m.fields))
+	}
+	return hits, nil
+}
+
+func newSearchHit(searchType, id, domainID, name string, meta *metav1.ObjectMeta, fields []string) *searchHit {
+	return &searchHit{
+		result: &kusciaapi.SearchResult{
+			Type:          searchType,
+			Id:            id,
+			DomainId:      domainID,
+			Name:          name,
+			MatchedFields: fields,
+			CreateTime:    meta.CreationTimestamp.UTC().Format(time.RFC3339),
+		},
+		createTime: meta.CreationTimestamp.Time,
+	}
+}
+
+// searchMatcher collects the fields containing the lower case keyword.
+type searchMatcher struct {
+	keyword string
+	fields  []string
+}
+
+func (m *searchMatcher) match(field, value string) {
+	if strings.Contains(strings.ToLower(value), m.keyword) && !slices.Contains(m.fields, field) {
+		m.fields = append(m.fields, field)
+	}
+}
+
+// matchMap matches both the keys and the values of the map, the matched field is named by the key.
+func (m *searchMatcher) matchMap(field string, values map[string]string) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		m.match(field+"."+k, k+"\x00"+values[k])
+	}
+}
+
+func validateSearchRequest(request *kusciaapi.SearchRequest) (types map[string]bool, offset, pageSize int, err error) {
+	if strings.TrimSpace(request.Keyword) == "" {
+		return nil, 0, 0, utils.NewFieldViolation("keyword", "keyword can't be empty")
+	}
+	types = map[string]bool{}
+	for i, t := range request.Types {
+		if !slices.Contains(searchTypes, t) {
+			return nil, 0, 0, utils.NewFieldViolation(fmt.Sprintf("types[%d]", i), "unsupported type %q, must be one of %v", t, searchTypes)
+		}
+		types[t] = true
+	}
+	if len(types) == 0 {
+		for _, t := range searchTypes {
+			types[t] = true
+		}
+	}
+	pageSize = int(request.PageSize)
+	if pageSize < 0 || pageSize > maxSearchPageSize {
+		return nil, 0, 0, utils.NewFieldViolation("page_size", "page size must be in [0, %d]", maxSearchPageSize)
+	}
+	if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	}
+	if request.PageToken != "" {
+		offset, err = strconv.Atoi(request.PageToken)
+		if err != nil || offset < 0 {
+			return nil, 0, 0, utils.NewFieldViolation("page_token", "invalid page token")
+		}
+	}
+	return types, offset, pageSize, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/secretflow/kuscia/pkg/kusciaapi/proxy"
+	"github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// searchServiceLite searches the resources of the lite domain through the master api.
+type searchServiceLite struct {
+	domainID        string
+	kusciaAPIClient proxy.KusciaAPIClient
+}
+
+func (s searchServiceLite) Search(ctx context.Context, request *kusciaapi.SearchRequest) *kusciaapi.SearchResponse {
+	if request.DomainId == "" {
+		request.DomainId = s.domainID
+	}
+	if request.DomainId != s.domainID {
+		return &kusciaapi.SearchResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate,
+				utils.NewFieldViolation("domain_id", fmt.Sprintf("kuscia lite api could only search the resources of domain %s", s.domainID))),
+		}
+	}
+	resp, err := s.kusciaAPIClient.Search(ctx, request)
+	if err != nil {
+		return &kusciaapi.SearchResponse{
+			Status: utils.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	informers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func newTestSearchService(t *testing.T) ISearchService {
+	now := time.Now()
+	meta := func(name, namespace string, age time.Duration) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: namespace, CreationTimestamp: metav1.NewTime(now.Add(-age))}
+	}
+	kusciaClient := kusciafake.NewSimpleClientset(
+		&v1alpha1.KusciaJob{
+			ObjectMeta: meta("psi-job", common.KusciaCrossDomain, time.Minute),
+			Spec: v1alpha1.KusciaJobSpec{Initiator: "alice", Tasks: []v1alpha1.KusciaTaskTemplate{
+				{Alias: "psi", Parties: []v1alpha1.Party{{DomainID: "alice"}, {DomainID: "bob"}}},
+			}},
+		},
+		&v1alpha1.DomainData{
+			ObjectMeta: meta("alice-table", "alice", 2*time.Minute),
+			Spec:       v1alpha1.DomainDataSpec{Name: "PSI input", Attributes: map[string]string{"owner": "risk"}},
+		},
+		&v1alpha1.DomainData{
+			ObjectMeta: meta("carol-table", "carol", 3*time.Minute),
+			Spec:       v1alpha1.DomainDataSpec{Name: "carol psi"},
+		},
+		&v1alpha1.DomainDataGrant{
+			ObjectMeta: meta("alice-grant", "alice", 4*time.Minute),
+			Spec:       v1alpha1.DomainDataGrantSpec{DomainDataID: "alice-table", GrantDomain: "bob", Description: map[string]string{"usage": "psi"}},
+		},
+		&v1alpha1.AppImage{
+			ObjectMeta: meta("secretflow-image", "", 5*time.Minute),
+			Spec:       v1alpha1.AppImageSpec{Image: v1alpha1.AppImageInfo{Name: "secretflow/psi", Tag: "1.0"}},
+		},
+	)
+	factory := informers.NewSharedInformerFactory(kusciaClient, 0)
+	s := NewSearchService(&config.KusciaAPIConfig{KusciaInformerFactory: factory})
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+	return s
+}
+
+func searchResultIDs(res *kusciaapi.SearchResponse) []string {
+	var ids []string
+	for _, r := range res.Data.Results {
+		ids = append(ids, r.Id)
+	}
+	return ids
+}
+
+func TestSearch(t *testing.T) {
+	s := newTestSearchService(t)
+	ctx := context.Background()
+
+	res := s.Search(ctx, &kusciaapi.SearchRequest{Keyword: "PSI"})
+	assert.Equal(t, kusciaAPISuccessStatusCode, res.Status.Code)
+	assert.Equal(t, []string{"psi-job", "alice-table", "carol-table", "alice-grant", "secretflow-image"}, searchResultIDs(res))
+	assert.Equal(t, int32(5), res.Data.Total)
+	assert.Equal(t, []string{"id", "tasks.alias"}, res.Data.Results[0].MatchedFields)
+	assert.Equal(t, "alice", res.Data.Results[0].DomainId)
+	assert.Equal(t, []string{"description.usage"}, res.Data.Results[3].MatchedFields)
+	assert.Equal(t, "secretflow/psi:1.0", res.Data.Results[4].Name)
+
+	res = s.Search(ctx, &kusciaapi.SearchRequest{Keyword: "owner", Types: []string{SearchTypeDomainData}})
+	assert.Equal(t, []string{"alice-table"}, searchResultIDs(res))
+	assert.Equal(t, []string{"attributes.owner"}, res.Data.Results[0].MatchedFields)
+
+	// the job is searched by the parties
+	res = s.Search(ctx, &kusciaapi.SearchRequest{Keyword: "psi", DomainId: "bob"})
+	assert.Equal(t, []string{"psi-job", "secretflow-image"}, searchResultIDs(res))
+
+	// pagination
+	res = s.Search(ctx, &kusciaapi.SearchRequest{Keyword: "psi", PageSize: 2})
+	assert.Equal(t, []string{"psi-job", "alice-table"}, searchResultIDs(res))
+	res = s.Search(ctx, &kusciaapi.SearchRequest{Keyword: "psi", PageSize: 2, PageToken: res.Data.NextPageToken})
+	assert.Equal(t, []string{"carol-table", "alice-grant"}, searchResultIDs(res))
+	res = s.Search(ctx, &kusciaapi.SearchRequest{Keyword: "psi", PageSize: 2, PageToken: res.Data.NextPageToken})
+	assert.Equal(t, []string{"secretflow-image"}, searchResultIDs(res))
+	assert.Empty(t, res.Data.NextPageToken)
+
+	for _, req := range []*kusciaapi.SearchRequest{
+		{},
+		{Keyword: "psi", Types: []string{"pod"}},
+		{Keyword: "psi", PageSize: 101},
+		{Keyword: "psi", PageToken: "x"},
+	} {
+		res = s.Search(ctx, req)
+		assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrRequestValidate), res.Status.Code, "%v", req)
+	}
+}
+
+func TestSearchTenant(t *testing.T) {
+	s := newTestSearchService(t)
+	ctx := context.WithValue(context.Background(), consts.AuthTenant, &tenant.Tenant{Name: "tenant-c", Domains: []string{"carol"}})
+
+	res := s.Search(ctx, &kusciaapi.SearchRequest{Keyword: "psi"})
+	assert.Equal(t, []string{"carol-table", "secretflow-image"}, searchResultIDs(res))
+	res = s.Search(ctx, &kusciaapi.SearchRequest{Keyword: "psi", DomainId: "alice"})
+	assert.Equal(t, int32(pberrorcode.ErrorCode_KusciaAPIErrAuthFailed), res.Status.Code)
+}
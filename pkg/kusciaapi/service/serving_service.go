@@ -37,6 +37,7 @@ import (
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/resources"
 	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	utils2 "github.com/secretflow/kuscia/pkg/web/utils"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
@@ -57,6 +58,10 @@ type IServingService interface {
 	BatchQueryServingStatus(ctx context.Context, request *kusciaapi.BatchQueryServingStatusRequest) *kusciaapi.BatchQueryServingStatusResponse
 	UpdateServing(ctx context.Context, request *kusciaapi.UpdateServingRequest) *kusciaapi.UpdateServingResponse
 	DeleteServing(ctx context.Context, request *kusciaapi.DeleteServingRequest) *kusciaapi.DeleteServingResponse
+	CreateServingCanary(ctx context.Context, request *kusciaapi.CreateServingCanaryRequest) *kusciaapi.CreateServingCanaryResponse
+	UpdateServingTraffic(ctx context.Context, request *kusciaapi.UpdateServingTrafficRequest) *kusciaapi.UpdateServingTrafficResponse
+	PromoteServing(ctx context.Context, request *kusciaapi.PromoteServingRequest) *kusciaapi.PromoteServingResponse
+	RollbackServing(ctx context.Context, request *kusciaapi.RollbackServingRequest) *kusciaapi.RollbackServingResponse
 }
 
 type servingService struct {
@@ -84,32 +89,32 @@ func NewServingService(config *config.KusciaAPIConfig) IServingService {
 func (s *servingService) CreateServing(ctx context.Context, request *kusciaapi.CreateServingRequest) *kusciaapi.CreateServingResponse {
 	if err := validateCreateServingRequest(s.Initiator, request); err != nil {
 		return &kusciaapi.CreateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
 	if err := authenticateServingRequest(ctx, request.Parties); err != nil {
 		return &kusciaapi.CreateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrAuthFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrAuthFailed, err),
 		}
 	}
 
 	if err := s.checkIfExist(ctx, common.KusciaCrossDomain, request); err != nil {
 		return &kusciaapi.CreateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrCreateServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateServing, err),
 		}
 	}
 
 	kd, err := s.buildKusciaDeployment(ctx, request)
 	if err != nil {
 		return &kusciaapi.CreateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrCreateServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateServing, err),
 		}
 	}
 
 	if _, err = s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Create(ctx, kd, metav1.CreateOptions{}); err != nil {
 		return &kusciaapi.CreateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrCreateServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateServing, err),
 		}
 	}
 
@@ -124,20 +129,20 @@ func validateCreateServingRequest(expectedInitiator string, request *kusciaapi.C
 	}
 
 	if err := resources.ValidateK8sName(request.ServingId, "serving_id"); err != nil {
-		return err
+		return utils2.NewFieldViolation("serving_id", "%s", err.Error())
 	}
 
 	initiator := request.Initiator
 	if initiator == "" {
-		return fmt.Errorf("initiator can not be empty")
+		return utils2.NewFieldViolation("initiator", "initiator can not be empty")
 	}
 
 	if expectedInitiator != "" && expectedInitiator != initiator {
-		return fmt.Errorf("initiator must be %s in P2P", expectedInitiator)
+		return utils2.NewFieldViolation("initiator", "initiator must be %s in P2P", expectedInitiator)
 	}
 
 	if len(request.Parties) == 0 {
-		return fmt.Errorf("parties can not be empty")
+		return utils2.NewFieldViolation("parties", "parties can not be empty")
 	}
 
 	foundInitiator := false
@@ -151,28 +156,28 @@ func validateCreateServingRequest(expectedInitiator string, request *kusciaapi.C
 		}
 	}
 	if !foundInitiator {
-		return fmt.Errorf("initiator %s should be one of the parties", initiator)
+		return utils2.NewFieldViolation("initiator", "initiator %s should be one of the parties", initiator)
 	}
 	return nil
 }
 
 func validateServingID(servingID string) error {
 	if servingID == "" {
-		return fmt.Errorf("serving id can not be empty")
+		return utils2.NewFieldViolation("serving_id", "serving id can not be empty")
 	}
 	return nil
 }
 
 func validateServingParty(party *kusciaapi.ServingParty, index int) error {
 	if party.AppImage == "" {
-		return fmt.Errorf("appimage can't be empty in parties[%d]", index)
+		return utils2.NewFieldViolation(fmt.Sprintf("parties[%d].app_image", index), "appimage can't be empty in parties[%d]", index)
 	}
 	if party.DomainId == "" {
-		return fmt.Errorf("domain id can't be empty in parties[%d]", index)
+		return utils2.NewFieldViolation(fmt.Sprintf("parties[%d].domain_id", index), "domain id can't be empty in parties[%d]", index)
 	}
 	if party.ServiceNamePrefix != "" {
 		if err := resources.ValidateServiceNamePrefix(party.ServiceNamePrefix, "service_name_prefix"); err != nil {
-			return fmt.Errorf("service name prefix is invalid in parties[%d], %s", index, err.Error())
+			return utils2.NewFieldViolation(fmt.Sprintf("parties[%d].service_name_prefix", index), "service name prefix is invalid in parties[%d], %s", index, err.Error())
 		}
 	}
 	return nil
@@ -532,14 +537,14 @@ func (s *servingService) QueryServing(ctx context.Context, request *kusciaapi.Qu
 	servingID := request.ServingId
 	if err := validateServingID(servingID); err != nil {
 		return &kusciaapi.QueryServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
 	kd, err := s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Get(ctx, servingID, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.QueryServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryServing, err),
 		}
 	}
 
@@ -552,14 +557,25 @@ func (s *servingService) QueryServing(ctx context.Context, request *kusciaapi.Qu
 	parties, err := s.buildServingParties(ctx, kd)
 	if err != nil {
 		return &kusciaapi.QueryServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryServing, err),
 		}
 	}
 
 	status, err := s.buildServingStatusDetail(ctx, kd)
 	if err != nil {
 		return &kusciaapi.QueryServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryServing, err),
+		}
+	}
+
+	var canary *kusciaapi.ServingCanary
+	if _, canaryKd, err := s.getServingRevisions(ctx, servingID); err != nil {
+		nlog.Warnf("Failed to get canary revision of serving %s, %v", servingID, err)
+	} else if canaryKd != nil {
+		if canary, err = s.buildServingCanary(ctx, canaryKd); err != nil {
+			return &kusciaapi.QueryServingResponse{
+				Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryServing, err),
+			}
 		}
 	}
 
@@ -570,11 +586,23 @@ func (s *servingService) QueryServing(ctx context.Context, request *kusciaapi.Qu
 			Initiator:          kd.Spec.Initiator,
 			Parties:            parties,
 			Status:             status,
+			DeletionStatus:     buildDeletionStatus(kd),
+			Canary:             canary,
 		},
 	}
 }
 
 func selfAsParticipant(ctx context.Context, kd *v1alpha1.KusciaDeployment) bool {
+	// the tenant could only access the servings that its domains as participants in
+	if t := tenant.FromContext(ctx); t != nil && !t.Allows(kd.Spec.Initiator) {
+		allowed := false
+		for _, party := range kd.Spec.Parties {
+			allowed = allowed || t.Allows(party.DomainID)
+		}
+		if !allowed {
+			return false
+		}
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if role == consts.AuthRoleDomain {
 		for _, party := range kd.Spec.Parties {
@@ -651,6 +679,14 @@ func (s *servingService) buildServingStatusDetail(ctx context.Context, kd *v1alp
 				}
 			}
 
+			var replicaSpread *kusciaapi.ServingReplicaSpread
+			if statusInfo.ReplicaSpread != nil {
+				replicaSpread = &kusciaapi.ServingReplicaSpread{
+					Zones: statusInfo.ReplicaSpread.Zones,
+					Nodes: statusInfo.ReplicaSpread.Nodes,
+				}
+			}
+
 			partyStatuses = append(partyStatuses, &kusciaapi.PartyServingStatus{
 				DomainId:            domainID,
 				Role:                statusInfo.Role,
@@ -661,6 +697,7 @@ func (s *servingService) buildServingStatusDetail(ctx context.Context, kd *v1alp
 				UpdatedReplicas:     statusInfo.UpdatedReplicas,
 				CreateTime:          utils.TimeRfc3339String(statusInfo.CreationTimestamp),
 				Endpoints:           endpoints,
+				ReplicaSpread:       replicaSpread,
 			})
 		}
 	}
@@ -679,7 +716,7 @@ func (s *servingService) buildServingStatusDetail(ctx context.Context, kd *v1alp
 func (s *servingService) BatchQueryServingStatus(ctx context.Context, request *kusciaapi.BatchQueryServingStatusRequest) *kusciaapi.BatchQueryServingStatusResponse {
 	if err := validateBatchQueryServingStatusRequest(request); err != nil {
 		return &kusciaapi.BatchQueryServingStatusResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -691,7 +728,7 @@ func (s *servingService) BatchQueryServingStatus(ctx context.Context, request *k
 				continue
 			}
 			return &kusciaapi.BatchQueryServingStatusResponse{
-				Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryServingStatus, err.Error()),
+				Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryServingStatus, err),
 			}
 		}
 
@@ -702,7 +739,7 @@ func (s *servingService) BatchQueryServingStatus(ctx context.Context, request *k
 		status, err := s.buildServingStatus(ctx, kd)
 		if err != nil {
 			return &kusciaapi.BatchQueryServingStatusResponse{
-				Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrQueryServingStatus, err.Error()),
+				Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrQueryServingStatus, err),
 			}
 		}
 		servingStatuses = append(servingStatuses, status)
@@ -719,7 +756,7 @@ func (s *servingService) BatchQueryServingStatus(ctx context.Context, request *k
 func validateBatchQueryServingStatusRequest(request *kusciaapi.BatchQueryServingStatusRequest) error {
 	servingIDs := request.ServingIds
 	if len(servingIDs) == 0 {
-		return fmt.Errorf("serving ids can not be empty")
+		return utils2.NewFieldViolation("serving_ids", "serving ids can not be empty")
 	}
 
 	for _, servingID := range servingIDs {
@@ -745,7 +782,7 @@ func (s *servingService) buildServingStatus(ctx context.Context, kd *v1alpha1.Ku
 func (s *servingService) UpdateServing(ctx context.Context, request *kusciaapi.UpdateServingRequest) *kusciaapi.UpdateServingResponse {
 	if err := validateServingID(request.ServingId); err != nil {
 		return &kusciaapi.UpdateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -758,7 +795,7 @@ func (s *servingService) UpdateServing(ctx context.Context, request *kusciaapi.U
 	kd, err := s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Get(ctx, request.ServingId, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.UpdateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err),
 		}
 	}
 
@@ -779,14 +816,14 @@ func (s *servingService) UpdateServing(ctx context.Context, request *kusciaapi.U
 	needUpdate, err := s.updateKusciaDeployment(ctx, kdCopy, inputConfig, request.Parties)
 	if err != nil {
 		return &kusciaapi.UpdateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err),
 		}
 	}
 	if needUpdate {
 		_, err = s.kusciaClient.KusciaV1alpha1().KusciaDeployments(kdCopy.Namespace).Update(ctx, kdCopy, metav1.UpdateOptions{})
 		if err != nil {
 			return &kusciaapi.UpdateServingResponse{
-				Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err.Error()),
+				Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err),
 			}
 		}
 	}
@@ -1004,11 +1041,16 @@ func (s *servingService) DeleteServing(ctx context.Context, request *kusciaapi.D
 			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, "serving id can not be empty"),
 		}
 	}
+	if err := validateDeletionWaitTimeout(request.WaitTimeoutSeconds); err != nil {
+		return &kusciaapi.DeleteServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
 
 	kd, err := s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Get(ctx, request.ServingId, metav1.GetOptions{})
 	if err != nil {
 		return &kusciaapi.DeleteServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrDeleteServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrDeleteServing, err),
 		}
 	}
 
@@ -1020,16 +1062,42 @@ func (s *servingService) DeleteServing(ctx context.Context, request *kusciaapi.D
 
 	if err = s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Delete(ctx, request.ServingId, metav1.DeleteOptions{}); err != nil {
 		return &kusciaapi.DeleteServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrDeleteServing, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrDeleteServing, err),
 		}
 	}
 
+	// the canary revision is deleted together with the serving
+	if err = s.deleteServingCanary(ctx, request.ServingId); err != nil {
+		return &kusciaapi.DeleteServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrDeleteServing, err),
+		}
+	}
+
+	deletionStatus, err := checkDeletion(ctx, func(ctx context.Context) (metav1.Object, error) {
+		return s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Get(ctx, request.ServingId, metav1.GetOptions{})
+	}, request.WaitForDeletion, request.WaitTimeoutSeconds)
+	if err != nil {
+		return &kusciaapi.DeleteServingResponse{
+			Status:         utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrDeleteServing, err),
+			DeletionStatus: deletionStatus,
+		}
+	}
 	return &kusciaapi.DeleteServingResponse{
-		Status: utils2.BuildSuccessResponseStatus(),
+		Status:         utils2.BuildSuccessResponseStatus(),
+		DeletionStatus: deletionStatus,
 	}
 }
 
 func authenticateServingRequest(ctx context.Context, parties []*kusciaapi.ServingParty) error {
+	if t := tenant.FromContext(ctx); t != nil {
+		allowed := false
+		for _, party := range parties {
+			allowed = allowed || t.Allows(party.DomainId)
+		}
+		if !allowed {
+			return fmt.Errorf("tenant %s could only operate the serving that its domains as participants in", t.Name)
+		}
+	}
 	role, domainID := GetRoleAndDomainFromCtx(ctx)
 	if role == consts.AuthRoleDomain {
 		for _, party := range parties {
@@ -0,0 +1,293 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	utils2 "github.com/secretflow/kuscia/pkg/web/utils"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+// servingCanarySuffix is the suffix of the kuscia deployment name of the canary revision of a serving.
+const servingCanarySuffix = "-canary"
+
+func canaryServingName(servingID string) string {
+	return servingID + servingCanarySuffix
+}
+
+func validateServingTrafficWeight(weight int32) error {
+	if weight < 0 || weight > 100 {
+		return utils2.NewFieldViolation("weight", "weight %d should be between 0 and 100", weight)
+	}
+	return nil
+}
+
+// getServingRevisions returns the kuscia deployments of the current and the canary revisions of the serving, the
+// canary one is nil if not found.
+func (s *servingService) getServingRevisions(ctx context.Context, servingID string) (*v1alpha1.KusciaDeployment, *v1alpha1.KusciaDeployment, error) {
+	kd, err := s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Get(ctx, servingID, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if asParticipant := selfAsParticipant(ctx, kd); !asParticipant {
+		return nil, nil, fmt.Errorf("serving not found")
+	}
+
+	canary, err := s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Get(ctx, canaryServingName(servingID), metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return kd, nil, nil
+		}
+		return nil, nil, err
+	}
+	if canary.Spec.Traffic == nil || canary.Spec.Traffic.StableDeployment != servingID {
+		return kd, nil, nil
+	}
+	return kd, canary, nil
+}
+
+func (s *servingService) CreateServingCanary(ctx context.Context, request *kusciaapi.CreateServingCanaryRequest) *kusciaapi.CreateServingCanaryResponse {
+	if err := validateServingID(request.ServingId); err != nil {
+		return &kusciaapi.CreateServingCanaryResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	if err := validateServingTrafficWeight(request.Weight); err != nil {
+		return &kusciaapi.CreateServingCanaryResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+
+	kd, canary, err := s.getServingRevisions(ctx, request.ServingId)
+	if err != nil {
+		return &kusciaapi.CreateServingCanaryResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateServing, err),
+		}
+	}
+	if canary != nil {
+		return &kusciaapi.CreateServingCanaryResponse{
+			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrCreateServing,
+				fmt.Sprintf("serving %s already has a canary revision, promote or rollback it first", request.ServingId)),
+		}
+	}
+
+	canary, err = s.buildCanaryKusciaDeployment(ctx, kd, request)
+	if err != nil {
+		return &kusciaapi.CreateServingCanaryResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateServing, err),
+		}
+	}
+
+	if _, err = s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Create(ctx, canary, metav1.CreateOptions{}); err != nil {
+		return &kusciaapi.CreateServingCanaryResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateServing, err),
+		}
+	}
+	nlog.Infof("Serving %s canary revision %s is created with weight %d", kd.Name, canary.Name, request.Weight)
+
+	return &kusciaapi.CreateServingCanaryResponse{
+		Status: utils2.BuildSuccessResponseStatus(),
+	}
+}
+
+// buildCanaryKusciaDeployment builds the canary revision from the current one with the changes of the request.
+func (s *servingService) buildCanaryKusciaDeployment(ctx context.Context, kd *v1alpha1.KusciaDeployment,
+	request *kusciaapi.CreateServingCanaryRequest) (*v1alpha1.KusciaDeployment, error) {
+	canary := &v1alpha1.KusciaDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: common.KusciaCrossDomain,
+			Name:      canaryServingName(kd.Name),
+			Labels: map[string]string{
+				common.LabelKusciaDeploymentAppType: string(common.ServingApp),
+			},
+		},
+		Spec: *kd.Spec.DeepCopy(),
+	}
+	// the services of the canary revision are named after itself, the traffic is split from the current ones
+	for i := range canary.Spec.Parties {
+		canary.Spec.Parties[i].ServiceNamePrefix = ""
+	}
+	canary.Spec.Traffic = &v1alpha1.KusciaDeploymentTraffic{
+		StableDeployment: kd.Name,
+		Weight:           request.Weight,
+	}
+
+	var inputConfig *string
+	if request.ServingInputConfig != "" {
+		inputConfig = &request.ServingInputConfig
+	}
+	if _, err := s.updateKusciaDeployment(ctx, canary, inputConfig, request.Parties); err != nil {
+		return nil, err
+	}
+	return canary, nil
+}
+
+func (s *servingService) UpdateServingTraffic(ctx context.Context, request *kusciaapi.UpdateServingTrafficRequest) *kusciaapi.UpdateServingTrafficResponse {
+	if err := validateServingID(request.ServingId); err != nil {
+		return &kusciaapi.UpdateServingTrafficResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+	if err := validateServingTrafficWeight(request.Weight); err != nil {
+		return &kusciaapi.UpdateServingTrafficResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+
+	_, canary, err := s.getServingRevisions(ctx, request.ServingId)
+	if err == nil && canary == nil {
+		err = fmt.Errorf("serving %s has no canary revision", request.ServingId)
+	}
+	if err != nil {
+		return &kusciaapi.UpdateServingTrafficResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err),
+		}
+	}
+
+	if canary.Spec.Traffic.Weight != request.Weight {
+		nlog.Infof("Serving %s canary revision weight updated from %d to %d", request.ServingId, canary.Spec.Traffic.Weight, request.Weight)
+		canaryCopy := canary.DeepCopy()
+		canaryCopy.Spec.Traffic.Weight = request.Weight
+		if _, err = s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Update(ctx, canaryCopy, metav1.UpdateOptions{}); err != nil {
+			return &kusciaapi.UpdateServingTrafficResponse{
+				Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err),
+			}
+		}
+	}
+
+	return &kusciaapi.UpdateServingTrafficResponse{
+		Status: utils2.BuildSuccessResponseStatus(),
+	}
+}
+
+// PromoteServing applies the spec of the canary revision to the current revision, then removes the canary revision.
+// The current revision is rolled out according to its update strategy.
+func (s *servingService) PromoteServing(ctx context.Context, request *kusciaapi.PromoteServingRequest) *kusciaapi.PromoteServingResponse {
+	if err := validateServingID(request.ServingId); err != nil {
+		return &kusciaapi.PromoteServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+
+	kd, canary, err := s.getServingRevisions(ctx, request.ServingId)
+	if err == nil && canary == nil {
+		err = fmt.Errorf("serving %s has no canary revision", request.ServingId)
+	}
+	if err != nil {
+		return &kusciaapi.PromoteServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err),
+		}
+	}
+
+	kdCopy := kd.DeepCopy()
+	kdCopy.Spec.InputConfig = canary.Spec.InputConfig
+	for i, party := range kdCopy.Spec.Parties {
+		for _, canaryParty := range canary.Spec.Parties {
+			if canaryParty.DomainID == party.DomainID && canaryParty.Role == party.Role {
+				kdCopy.Spec.Parties[i].AppImageRef = canaryParty.AppImageRef
+				kdCopy.Spec.Parties[i].Template = *canaryParty.Template.DeepCopy()
+			}
+		}
+	}
+	if _, err = s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Update(ctx, kdCopy, metav1.UpdateOptions{}); err != nil {
+		return &kusciaapi.PromoteServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err),
+		}
+	}
+
+	if err = s.deleteServingCanary(ctx, request.ServingId); err != nil {
+		return &kusciaapi.PromoteServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err),
+		}
+	}
+	nlog.Infof("Serving %s canary revision is promoted", request.ServingId)
+
+	return &kusciaapi.PromoteServingResponse{
+		Status: utils2.BuildSuccessResponseStatus(),
+	}
+}
+
+func (s *servingService) RollbackServing(ctx context.Context, request *kusciaapi.RollbackServingRequest) *kusciaapi.RollbackServingResponse {
+	if err := validateServingID(request.ServingId); err != nil {
+		return &kusciaapi.RollbackServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+
+	_, canary, err := s.getServingRevisions(ctx, request.ServingId)
+	if err == nil && canary == nil {
+		err = fmt.Errorf("serving %s has no canary revision", request.ServingId)
+	}
+	if err == nil {
+		err = s.deleteServingCanary(ctx, request.ServingId)
+	}
+	if err != nil {
+		return &kusciaapi.RollbackServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrUpdateServing, err),
+		}
+	}
+	nlog.Infof("Serving %s canary revision is rolled back", request.ServingId)
+
+	return &kusciaapi.RollbackServingResponse{
+		Status: utils2.BuildSuccessResponseStatus(),
+	}
+}
+
+// deleteServingCanary deletes the canary revision of the serving if exists.
+func (s *servingService) deleteServingCanary(ctx context.Context, servingID string) error {
+	name := canaryServingName(servingID)
+	canary, err := s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if canary.Spec.Traffic == nil || canary.Spec.Traffic.StableDeployment != servingID {
+		return nil
+	}
+
+	err = s.kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *servingService) buildServingCanary(ctx context.Context, canary *v1alpha1.KusciaDeployment) (*kusciaapi.ServingCanary, error) {
+	parties, err := s.buildServingParties(ctx, canary)
+	if err != nil {
+		return nil, err
+	}
+	status, err := s.buildServingStatusDetail(ctx, canary)
+	if err != nil {
+		return nil, err
+	}
+	return &kusciaapi.ServingCanary{
+		ServingInputConfig: canary.Spec.InputConfig,
+		Parties:            parties,
+		Weight:             canary.Spec.Traffic.Weight,
+		Status:             status,
+	}, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	consts "github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func TestServingCanary(t *testing.T) {
+	ctx := context.Background()
+	replicas := int32(1)
+	kd := &v1alpha1.KusciaDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "serving-1", Namespace: common.KusciaCrossDomain},
+		Spec: v1alpha1.KusciaDeploymentSpec{
+			Initiator:   "alice",
+			InputConfig: "v1",
+			Parties: []v1alpha1.KusciaDeploymentParty{
+				{DomainID: "alice", AppImageRef: "serving-image", ServiceNamePrefix: "alice-serving", Template: v1alpha1.KusciaDeploymentPartyTemplate{Replicas: &replicas}},
+				{DomainID: "bob", AppImageRef: "serving-image", Template: v1alpha1.KusciaDeploymentPartyTemplate{Replicas: &replicas}},
+			},
+		},
+	}
+	kusciaClient := kusciafake.NewSimpleClientset(kd)
+	s := &servingService{kusciaClient: kusciaClient}
+	kds := kusciaClient.KusciaV1alpha1().KusciaDeployments(common.KusciaCrossDomain)
+
+	// the tenant whose domains aren't participants
+	tenantCtx := context.WithValue(ctx, consts.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}})
+	assert.NotEqual(t, int32(0), s.CreateServingCanary(tenantCtx, &kusciaapi.CreateServingCanaryRequest{ServingId: "serving-1", Weight: 10}).Status.Code)
+	_, err := kds.Get(ctx, "serving-1-canary", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	// no canary revision yet
+	assert.NotEqual(t, int32(0), s.UpdateServingTraffic(ctx, &kusciaapi.UpdateServingTrafficRequest{ServingId: "serving-1", Weight: 10}).Status.Code)
+	assert.NotEqual(t, int32(0), s.CreateServingCanary(ctx, &kusciaapi.CreateServingCanaryRequest{ServingId: "serving-1", Weight: 101}).Status.Code)
+
+	canaryReplicas := int32(2)
+	resp := s.CreateServingCanary(ctx, &kusciaapi.CreateServingCanaryRequest{
+		ServingId:          "serving-1",
+		ServingInputConfig: "v2",
+		Parties:            []*kusciaapi.ServingParty{{DomainId: "bob", Replicas: &canaryReplicas}},
+		Weight:             10,
+	})
+	assert.Equal(t, int32(0), resp.Status.Code, resp.Status.Message)
+	canary, err := kds.Get(ctx, "serving-1-canary", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, &v1alpha1.KusciaDeploymentTraffic{StableDeployment: "serving-1", Weight: 10}, canary.Spec.Traffic)
+	assert.Equal(t, "v2", canary.Spec.InputConfig)
+	assert.Equal(t, "", canary.Spec.Parties[0].ServiceNamePrefix)
+	assert.Equal(t, int32(2), *canary.Spec.Parties[1].Template.Replicas)
+	assert.NotEqual(t, int32(0), s.CreateServingCanary(ctx, &kusciaapi.CreateServingCanaryRequest{ServingId: "serving-1"}).Status.Code)
+
+	assert.Equal(t, int32(0), s.UpdateServingTraffic(ctx, &kusciaapi.UpdateServingTrafficRequest{ServingId: "serving-1", Weight: 50}).Status.Code)
+	canary, err = kds.Get(ctx, "serving-1-canary", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(50), canary.Spec.Traffic.Weight)
+
+	// the current revision takes the spec of the canary one, and keeps its service names
+	assert.Equal(t, int32(0), s.PromoteServing(ctx, &kusciaapi.PromoteServingRequest{ServingId: "serving-1"}).Status.Code)
+	promoted, err := kds.Get(ctx, "serving-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", promoted.Spec.InputConfig)
+	assert.Equal(t, "alice-serving", promoted.Spec.Parties[0].ServiceNamePrefix)
+	assert.Equal(t, int32(2), *promoted.Spec.Parties[1].Template.Replicas)
+	assert.Nil(t, promoted.Spec.Traffic)
+	_, err = kds.Get(ctx, "serving-1-canary", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	assert.Equal(t, int32(0), s.CreateServingCanary(ctx, &kusciaapi.CreateServingCanaryRequest{ServingId: "serving-1"}).Status.Code)
+	assert.Equal(t, int32(0), s.RollbackServing(ctx, &kusciaapi.RollbackServingRequest{ServingId: "serving-1"}).Status.Code)
+	_, err = kds.Get(ctx, "serving-1-canary", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	assert.NotEqual(t, int32(0), s.RollbackServing(ctx, &kusciaapi.RollbackServingRequest{ServingId: "serving-1"}).Status.Code)
+}
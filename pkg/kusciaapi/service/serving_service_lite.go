@@ -32,7 +32,7 @@ func (s *servingServiceLite) CreateServing(ctx context.Context, request *kusciaa
 	// do validate
 	if err := validateCreateServingRequest(s.Initiator, request); err != nil {
 		return &kusciaapi.CreateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -40,7 +40,7 @@ func (s *servingServiceLite) CreateServing(ctx context.Context, request *kusciaa
 	resp, err := s.kusciaAPIClient.CreateServing(ctx, request)
 	if err != nil {
 		return &kusciaapi.CreateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -59,7 +59,7 @@ func (s *servingServiceLite) QueryServing(ctx context.Context, request *kusciaap
 	resp, err := s.kusciaAPIClient.QueryServing(ctx, request)
 	if err != nil {
 		return &kusciaapi.QueryServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -70,7 +70,7 @@ func (s *servingServiceLite) BatchQueryServingStatus(ctx context.Context,
 	// do validate
 	if err := validateBatchQueryServingStatusRequest(request); err != nil {
 		return &kusciaapi.BatchQueryServingStatusResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
 		}
 	}
 
@@ -78,7 +78,7 @@ func (s *servingServiceLite) BatchQueryServingStatus(ctx context.Context,
 	resp, err := s.kusciaAPIClient.BatchQueryServing(ctx, request)
 	if err != nil {
 		return &kusciaapi.BatchQueryServingStatusResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -101,7 +101,7 @@ func (s *servingServiceLite) UpdateServing(ctx context.Context, request *kusciaa
 	resp, err := s.kusciaAPIClient.UpdateServing(ctx, request)
 	if err != nil {
 		return &kusciaapi.UpdateServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
@@ -120,7 +120,89 @@ func (s *servingServiceLite) DeleteServing(ctx context.Context, request *kusciaa
 	resp, err := s.kusciaAPIClient.DeleteServing(ctx, request)
 	if err != nil {
 		return &kusciaapi.DeleteServingResponse{
-			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err.Error()),
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (s *servingServiceLite) CreateServingCanary(ctx context.Context, request *kusciaapi.CreateServingCanaryRequest) *kusciaapi.CreateServingCanaryResponse {
+	// do validate
+	if request.ServingId == "" {
+		return &kusciaapi.CreateServingCanaryResponse{
+			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, "serving id can not be empty"),
+		}
+	}
+	if err := validateServingTrafficWeight(request.Weight); err != nil {
+		return &kusciaapi.CreateServingCanaryResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+
+	// request the master api
+	resp, err := s.kusciaAPIClient.CreateServingCanary(ctx, request)
+	if err != nil {
+		return &kusciaapi.CreateServingCanaryResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (s *servingServiceLite) UpdateServingTraffic(ctx context.Context, request *kusciaapi.UpdateServingTrafficRequest) *kusciaapi.UpdateServingTrafficResponse {
+	// do validate
+	if request.ServingId == "" {
+		return &kusciaapi.UpdateServingTrafficResponse{
+			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, "serving id can not be empty"),
+		}
+	}
+	if err := validateServingTrafficWeight(request.Weight); err != nil {
+		return &kusciaapi.UpdateServingTrafficResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err),
+		}
+	}
+
+	// request the master api
+	resp, err := s.kusciaAPIClient.UpdateServingTraffic(ctx, request)
+	if err != nil {
+		return &kusciaapi.UpdateServingTrafficResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (s *servingServiceLite) PromoteServing(ctx context.Context, request *kusciaapi.PromoteServingRequest) *kusciaapi.PromoteServingResponse {
+	// do validate
+	if request.ServingId == "" {
+		return &kusciaapi.PromoteServingResponse{
+			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, "serving id can not be empty"),
+		}
+	}
+
+	// request the master api
+	resp, err := s.kusciaAPIClient.PromoteServing(ctx, request)
+	if err != nil {
+		return &kusciaapi.PromoteServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
+		}
+	}
+	return resp
+}
+
+func (s *servingServiceLite) RollbackServing(ctx context.Context, request *kusciaapi.RollbackServingRequest) *kusciaapi.RollbackServingResponse {
+	// do validate
+	if request.ServingId == "" {
+		return &kusciaapi.RollbackServingResponse{
+			Status: utils2.BuildErrorResponseStatus(errorcode.ErrorCode_KusciaAPIErrRequestValidate, "serving id can not be empty"),
+		}
+	}
+
+	// request the master api
+	resp, err := s.kusciaAPIClient.RollbackServing(ctx, request)
+	if err != nil {
+		return &kusciaapi.RollbackServingResponse{
+			Status: utils2.BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed, err),
 		}
 	}
 	return resp
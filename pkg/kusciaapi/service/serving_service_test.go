@@ -29,6 +29,7 @@ import (
 	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
 	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
 )
 
@@ -228,6 +229,18 @@ func TestAuthenticateServingRequest(t *testing.T) {
 			}},
 			wantErr: false,
 		},
+		{
+			name:    "request from tenant and its domains don't exist in party",
+			ctx:     context.WithValue(context.Background(), constants.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}}),
+			parties: []*kusciaapi.ServingParty{{DomainId: "alice"}, {DomainId: "bob"}},
+			wantErr: true,
+		},
+		{
+			name:    "request from tenant and its domain exists in party",
+			ctx:     context.WithValue(context.Background(), constants.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}}),
+			parties: []*kusciaapi.ServingParty{{DomainId: "alice"}, {DomainId: "carol"}},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -276,6 +289,17 @@ func TestSelfAsParticipant(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "request from tenant and its domains don't exist in party",
+			ctx:  context.WithValue(context.Background(), constants.AuthTenant, &tenant.Tenant{Name: "carol", Domains: []string{"carol"}}),
+			kd: &v1alpha1.KusciaDeployment{
+				Spec: v1alpha1.KusciaDeploymentSpec{
+					Initiator: "alice",
+					Parties:   []v1alpha1.KusciaDeploymentParty{{DomainID: "alice"}, {DomainID: "bob"}},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -17,8 +17,11 @@ package utils
 import (
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/secretflow/kuscia/pkg/common"
 	"github.com/secretflow/kuscia/pkg/kusciaapi/config"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
 )
 
 func ReadToken(tokenConfig config.TokenConfig) (string, error) {
@@ -29,3 +32,33 @@ func ReadToken(tokenConfig config.TokenConfig) (string, error) {
 	}
 	return string(data), nil
 }
+
+// NewTenantAuthenticator returns the authenticator of the tenants of the master's KusciaAPI, nil if the tenants are
+// not configured.
+func NewTenantAuthenticator(conf *config.KusciaAPIConfig) (*tenant.Authenticator, error) {
+	if conf.RunMode != common.RunModeMaster || len(conf.Tenants) == 0 {
+		return nil, nil
+	}
+	var token string
+	if conf.Token != nil {
+		var err error
+		if token, err = ReadToken(*conf.Token); err != nil {
+			return nil, err
+		}
+	}
+	var tenants []*tenant.Tenant
+	for _, tc := range conf.Tenants {
+		t := &tenant.Tenant{Name: tc.Name, Domains: tc.Domains, CommonNames: tc.CommonNames}
+		if tc.TokenFile != "" {
+			tenantToken, err := ReadToken(config.TokenConfig{TokenFile: tc.TokenFile})
+			if err != nil {
+				return nil, fmt.Errorf("read token of tenant %s failed, %v", tc.Name, err)
+			}
+			if t.Token = strings.TrimSpace(tenantToken); t.Token == "" {
+				return nil, fmt.Errorf("token of tenant %s is empty", tc.Name)
+			}
+		}
+		tenants = append(tenants, t)
+	}
+	return tenant.NewAuthenticator(token, tenants)
+}
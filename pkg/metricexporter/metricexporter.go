@@ -92,6 +92,28 @@ func getMetrics(fullURL string) ([]byte, error) {
 	}
 	return responseBody, nil
 }
+
+// GatherMetrics fetches the metrics of the sources concurrently, the metrics are indexed by the names of the sources
+// and the failed sources are skipped.
+func GatherMetrics(metricURLs map[string]string) map[string][]byte {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string][]byte, len(metricURLs))
+	for name, fullURL := range metricURLs {
+		wg.Add(1)
+		go func(name, fullURL string) {
+			defer wg.Done()
+			if metrics, err := getMetrics(fullURL); err == nil {
+				mu.Lock()
+				results[name] = metrics
+				mu.Unlock()
+			}
+		}(name, fullURL)
+	}
+	wg.Wait()
+	return results
+}
+
 func metricHandler(metricURLs map[string]string, w http.ResponseWriter) {
 	metricsChan := make(chan []byte, len(metricURLs))
 	var wg sync.WaitGroup
@@ -131,7 +153,9 @@ func combine(map1, map2 map[string]string) map[string]string {
 	return map1
 }
 
-func MetricExporter(ctx context.Context, metricURLs map[string]string, port int) {
+// MetricExporter serves the metrics of the sources on /metrics, the handlers are served by the same server along with
+// /metrics, which are usually the metric sources living in the exporter itself.
+func MetricExporter(ctx context.Context, metricURLs map[string]string, port int, handlers map[string]http.Handler) {
 	nlog.Infof("Start to export metrics on port %d...", port)
 
 	if podManager != nil {
@@ -149,6 +173,9 @@ func MetricExporter(ctx context.Context, metricURLs map[string]string, port int)
 	metricServer.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
 		metricHandler(metricURLs, w)
 	})
+	for path, handler := range handlers {
+		metricServer.Handle(path, handler)
+	}
 
 	go func() {
 		nlog.Infof("Starting metric server on port %d", port)
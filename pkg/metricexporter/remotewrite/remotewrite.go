@@ -0,0 +1,324 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotewrite
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+const (
+	// DomainLabel is added to the series to tell the domains apart in the central TSDB.
+	DomainLabel = "domain"
+	// JobLabel is the name of the source of the series, like the job label added by the prometheus scraping.
+	JobLabel = "job"
+
+	nameLabel = "__name__"
+	// maxSeriesPerRequest keeps the requests small enough for the default limits of the TSDBs.
+	maxSeriesPerRequest = 2000
+	maxRetries          = 3
+	retryBackoff        = time.Second
+)
+
+// Gatherer returns the metrics of each source in the prometheus text format, indexed by the names of the sources.
+type Gatherer func() map[string][]byte
+
+type label struct {
+	name  string
+	value string
+}
+
+type timeSeries struct {
+	labels    []label
+	value     float64
+	timestamp int64
+}
+
+// Writer pushes the gathered metrics to the remote write endpoint periodically.
+type Writer struct {
+	config   *kusciaconfig.MetricRemoteWriteConfig
+	domainID string
+	gather   Gatherer
+	client   *http.Client
+	now      func() time.Time
+}
+
+func NewWriter(config *kusciaconfig.MetricRemoteWriteConfig, domainID string, gather Gatherer) (*Writer, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.TLS != nil {
+		tlsConfig, err := buildTLSConfig(config.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	return &Writer{
+		config:   config,
+		domainID: domainID,
+		gather:   gather,
+		client:   &http.Client{Transport: transport, Timeout: config.Timeout()},
+		now:      time.Now,
+	}, nil
+}
+
+func buildTLSConfig(config *kusciaconfig.TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+	if config.CAFile != "" {
+		caCert, err := tlsutils.LoadCertFile(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificate found in %s", config.CAFile)
+		}
+	}
+	if config.CertFile != "" {
+		certs, err := tlsutils.BuildTLSCertificateViaPath(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = certs
+	}
+	return tlsConfig, nil
+}
+
+// Run pushes the metrics every interval until the context is done.
+func (w *Writer) Run(ctx context.Context) {
+	nlog.Infof("Start to push metrics to %s every %v", w.config.Endpoint, w.config.Interval())
+	ticker := time.NewTicker(w.config.Interval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.push(ctx); err != nil {
+				nlog.Warnf("Push metrics to %s failed, %v", w.config.Endpoint, err)
+			}
+		}
+	}
+}
+
+func (w *Writer) push(ctx context.Context) error {
+	timestamp := w.now().UnixMilli()
+	var series []timeSeries
+	for source, content := range w.gather() {
+		var parser expfmt.TextParser
+		families, err := parser.TextToMetricFamilies(bytes.NewReader(content))
+		if err != nil {
+			nlog.Warnf("Parse metrics of %s failed, %v", source, err)
+			continue
+		}
+		series = append(series, w.convert(source, families, timestamp)...)
+	}
+	for start := 0; start < len(series); start += maxSeriesPerRequest {
+		end := min(start+maxSeriesPerRequest, len(series))
+		if err := w.send(ctx, encodeWriteRequest(series[start:end])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convert flattens the metric families to the series as the prometheus scraping does, e.g. a histogram is
+// flattened to the _bucket, _sum and _count series.
+func (w *Writer) convert(source string, families map[string]*dto.MetricFamily, timestamp int64) []timeSeries {
+	var series []timeSeries
+	for name, family := range families {
+		for _, m := range family.GetMetric() {
+			ts := timestamp
+			if m.TimestampMs != nil {
+				ts = m.GetTimestampMs()
+			}
+			add := func(suffix string, value float64, extra ...label) {
+				series = append(series, timeSeries{
+					labels:    w.buildLabels(source, name+suffix, m.GetLabel(), extra),
+					value:     value,
+					timestamp: ts,
+				})
+			}
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				add("", m.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				add("", m.GetGauge().GetValue())
+			case dto.MetricType_SUMMARY:
+				summary := m.GetSummary()
+				for _, q := range summary.GetQuantile() {
+					add("", q.GetValue(), label{name: "quantile", value: formatFloat(q.GetQuantile())})
+				}
+				add("_sum", summary.GetSampleSum())
+				add("_count", float64(summary.GetSampleCount()))
+			case dto.MetricType_HISTOGRAM:
+				histogram := m.GetHistogram()
+				infSeen := false
+				for _, b := range histogram.GetBucket() {
+					if math.IsInf(b.GetUpperBound(), 1) {
+						infSeen = true
+					}
+					add("_bucket", float64(b.GetCumulativeCount()), label{name: "le", value: formatFloat(b.GetUpperBound())})
+				}
+				if !infSeen {
+					add("_bucket", float64(histogram.GetSampleCount()), label{name: "le", value: "+Inf"})
+				}
+				add("_sum", histogram.GetSampleSum())
+				add("_count", float64(histogram.GetSampleCount()))
+			default:
+				add("", m.GetUntyped().GetValue())
+			}
+		}
+	}
+	return series
+}
+
+// buildLabels returns the labels sorted by name as required by the remote write protocol. The labels of the metric
+// take precedence over the job label, the external labels and the domain label.
+func (w *Writer) buildLabels(source, name string, pairs []*dto.LabelPair, extra []label) []label {
+	labels := map[string]string{}
+	labels[DomainLabel] = w.domainID
+	for k, v := range w.config.ExternalLabels {
+		labels[k] = v
+	}
+	labels[JobLabel] = source
+	for _, p := range pairs {
+		labels[p.GetName()] = p.GetValue()
+	}
+	for _, l := range extra {
+		labels[l.name] = l.value
+	}
+	labels[nameLabel] = name
+
+	result := make([]label, 0, len(labels))
+	for k, v := range labels {
+		if v != "" {
+			result = append(result, label{name: k, value: v})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return result
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// send posts the snappy compressed WriteRequest, the failures of the server side are retried.
+func (w *Writer) send(ctx context.Context, body []byte) error {
+	compressed := snappy.Encode(nil, body)
+	var err error
+	for i := 0; i < maxRetries; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryBackoff * time.Duration(i)):
+			}
+		}
+		var retryable bool
+		if retryable, err = w.sendOnce(ctx, compressed); err == nil || !retryable {
+			return err
+		}
+	}
+	return err
+}
+
+func (w *Writer) sendOnce(ctx context.Context, compressed []byte) (retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.Endpoint, bytes.NewReader(compressed))
+	if err != nil {
+		return false, err
+	}
+	for k, v := range w.config.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("User-Agent", "kuscia-metric-exporter")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	if w.config.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+w.config.BearerToken)
+	} else if w.config.Username != "" || w.config.Password != "" {
+		req.SetBasicAuth(w.config.Username, w.config.Password)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return false, nil
+	}
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("remote write responds %s: %s", resp.Status, bytes.TrimSpace(message))
+	return resp.StatusCode/100 == 5 || resp.StatusCode == http.StatusTooManyRequests, err
+}
+
+// encodeWriteRequest encodes the series as the prometheus.WriteRequest protobuf message:
+//
+//	message WriteRequest { repeated TimeSeries timeseries = 1; }
+//	message TimeSeries { repeated Label labels = 1; repeated Sample samples = 2; }
+//	message Label { string name = 1; string value = 2; }
+//	message Sample { double value = 1; int64 timestamp = 2; }
+func encodeWriteRequest(series []timeSeries) []byte {
+	var buf []byte
+	for _, s := range series {
+		var tsBuf []byte
+		for _, l := range s.labels {
+			var labelBuf []byte
+			labelBuf = protowire.AppendTag(labelBuf, 1, protowire.BytesType)
+			labelBuf = protowire.AppendString(labelBuf, l.name)
+			labelBuf = protowire.AppendTag(labelBuf, 2, protowire.BytesType)
+			labelBuf = protowire.AppendString(labelBuf, l.value)
+			tsBuf = protowire.AppendTag(tsBuf, 1, protowire.BytesType)
+			tsBuf = protowire.AppendBytes(tsBuf, labelBuf)
+		}
+		var sampleBuf []byte
+		sampleBuf = protowire.AppendTag(sampleBuf, 1, protowire.Fixed64Type)
+		sampleBuf = protowire.AppendFixed64(sampleBuf, math.Float64bits(s.value))
+		sampleBuf = protowire.AppendTag(sampleBuf, 2, protowire.VarintType)
+		sampleBuf = protowire.AppendVarint(sampleBuf, uint64(s.timestamp))
+		tsBuf = protowire.AppendTag(tsBuf, 2, protowire.BytesType)
+		tsBuf = protowire.AppendBytes(tsBuf, sampleBuf)
+
+		buf = protowire.AppendTag(buf, 1, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, tsBuf)
+	}
+	return buf
+}
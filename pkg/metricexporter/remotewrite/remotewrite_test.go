@@ -0,0 +1,137 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotewrite
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/secretflow/kuscia/pkg/utils/kusciaconfig"
+)
+
+const testMetrics = `# TYPE requests_total counter
+requests_total{code="200"} 3
+# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.1"} 1
+latency_seconds_bucket{le="+Inf"} 2
+latency_seconds_sum 0.3
+latency_seconds_count 2
+`
+
+// decodeWriteRequest decodes the series of a WriteRequest, the labels of a series are joined as name=value.
+func decodeWriteRequest(t *testing.T, buf []byte) map[string]float64 {
+	result := map[string]float64{}
+	for len(buf) > 0 {
+		_, _, n := protowire.ConsumeTag(buf)
+		tsBuf, m := protowire.ConsumeBytes(buf[n:])
+		assert.True(t, m > 0)
+		buf = buf[n+m:]
+
+		key := ""
+		var value float64
+		for len(tsBuf) > 0 {
+			num, _, n := protowire.ConsumeTag(tsBuf)
+			fieldBuf, m := protowire.ConsumeBytes(tsBuf[n:])
+			tsBuf = tsBuf[n+m:]
+			if num == 1 {
+				_, _, n := protowire.ConsumeTag(fieldBuf)
+				name, m := protowire.ConsumeString(fieldBuf[n:])
+				fieldBuf = fieldBuf[n+m:]
+				_, _, n = protowire.ConsumeTag(fieldBuf)
+				v, _ := protowire.ConsumeString(fieldBuf[n:])
+				key += name + "=" + v + ","
+			} else {
+				_, _, n := protowire.ConsumeTag(fieldBuf)
+				bits, _ := protowire.ConsumeFixed64(fieldBuf[n:])
+				value = math.Float64frombits(bits)
+			}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+func TestPush(t *testing.T) {
+	var got map[string]float64
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ := io.ReadAll(r.Body)
+		decoded, err := snappy.Decode(nil, body)
+		assert.NoError(t, err)
+		got = decodeWriteRequest(t, decoded)
+	}))
+	defer server.Close()
+
+	config := &kusciaconfig.MetricRemoteWriteConfig{
+		Enable:         true,
+		Endpoint:       server.URL,
+		BearerToken:    "token",
+		Headers:        map[string]string{"X-Scope-OrgID": "kuscia"},
+		ExternalLabels: map[string]string{"region": "east", "code": "none"},
+	}
+	w, err := NewWriter(config, "alice", func() map[string][]byte {
+		return map[string][]byte{"ss": []byte(testMetrics), "broken": []byte("{")}
+	})
+	assert.NoError(t, err)
+	w.now = func() time.Time { return time.UnixMilli(1000) }
+
+	assert.NoError(t, w.push(context.Background()))
+	assert.Equal(t, "Bearer token", header.Get("Authorization"))
+	assert.Equal(t, "snappy", header.Get("Content-Encoding"))
+	assert.Equal(t, "kuscia", header.Get("X-Scope-OrgID"))
+	// the labels of the metrics take precedence over the external labels
+	assert.Equal(t, map[string]float64{
+		"__name__=requests_total,code=200,domain=alice,job=ss,region=east,":                  3,
+		"__name__=latency_seconds_bucket,code=none,domain=alice,job=ss,le=0.1,region=east,":  1,
+		"__name__=latency_seconds_bucket,code=none,domain=alice,job=ss,le=+Inf,region=east,": 2,
+		"__name__=latency_seconds_sum,code=none,domain=alice,job=ss,region=east,":            0.3,
+		"__name__=latency_seconds_count,code=none,domain=alice,job=ss,region=east,":          2,
+	}, got)
+}
+
+func TestPushRetry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		username, password, _ := r.BasicAuth()
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "pass", password)
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	config := &kusciaconfig.MetricRemoteWriteConfig{Enable: true, Endpoint: server.URL, Username: "user", Password: "pass"}
+	w, err := NewWriter(config, "alice", func() map[string][]byte {
+		return map[string][]byte{"ss": []byte(testMetrics)}
+	})
+	assert.NoError(t, err)
+	// the server error is retried and the client error is not
+	assert.Error(t, w.push(context.Background()))
+	assert.Equal(t, 2, requests)
+}
@@ -0,0 +1,220 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trafficexporter breaks down the traffic of the gateway by the domain pairs and the jobs. Envoy writes a
+// line to the traffic log for each request crossing the gateway, and the exporter counts the lines in prometheus.
+package trafficexporter
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nxadm/tail"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	// MetricPath is the path the traffic metrics are served on by the metric exporter.
+	MetricPath = "/trafficmetrics"
+
+	// the listener which writes the line, see etc/conf/domainroute/listeners
+	listenerInternal = "internal"
+	listenerExternal = "external"
+	// envoy writes - for the missing headers
+	missingField  = "-"
+	unknownDomain = "unknown"
+
+	// the series of the finished jobs are dropped after being idle for seriesTTL
+	seriesTTL        = 24 * time.Hour
+	expirePeriod     = 10 * time.Minute
+	trafficLogFields = 6
+)
+
+type seriesKey struct {
+	src string
+	dst string
+	job string
+}
+
+// Exporter follows the traffic log of envoy, the format of the lines is
+// `<listener> <Kuscia-Source> <Kuscia-Host or :authority> <Kuscia-Job-Id> <bytes received> <bytes sent>`.
+type Exporter struct {
+	domainID string
+	logPath  string
+	registry *prometheus.Registry
+	bytes    *prometheus.CounterVec
+	requests *prometheus.CounterVec
+
+	mu sync.Mutex
+	// the last time the series are updated, indexed by the label values
+	requestsSeen map[seriesKey]time.Time
+	bytesSeen    map[seriesKey]time.Time
+}
+
+func NewExporter(rootDir, domainID string) *Exporter {
+	e := &Exporter{
+		domainID: domainID,
+		logPath:  filepath.Join(rootDir, common.LogPrefix, "envoy", "traffic.log"),
+		registry: prometheus.NewRegistry(),
+		bytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "kuscia_route_bytes_total",
+			Help: "The body bytes transferred from the src domain to the dst domain through the gateway.",
+		}, []string{"src", "dst", "job"}),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "kuscia_route_requests_total",
+			Help: "The requests sent from the src domain to the dst domain through the gateway.",
+		}, []string{"src", "dst", "job"}),
+		requestsSeen: map[seriesKey]time.Time{},
+		bytesSeen:    map[seriesKey]time.Time{},
+	}
+	e.registry.MustRegister(e.bytes, e.requests)
+	return e
+}
+
+// Handler serves the traffic metrics in the prometheus text format.
+func (e *Exporter) Handler() http.Handler {
+	return promhttp.HandlerFor(e.registry, promhttp.HandlerOpts{})
+}
+
+// Run follows the traffic log until the context is done. The log is followed from its end, the counters start
+// from zero every time kuscia restarts as the other prometheus counters do.
+func (e *Exporter) Run(ctx context.Context) {
+	t, err := tail.TailFile(e.logPath, tail.Config{
+		Follow:   true,
+		ReOpen:   true,
+		Poll:     true,
+		Location: &tail.SeekInfo{Offset: 0, Whence: io.SeekEnd},
+		Logger:   tail.DiscardingLogger,
+	})
+	if err != nil {
+		nlog.Warnf("Failed to follow the traffic log %s, err: %v", e.logPath, err)
+		return
+	}
+	defer t.Cleanup()
+	defer t.Stop()
+
+	nlog.Infof("Start to export the traffic metrics of %s", e.logPath)
+	ticker := time.NewTicker(expirePeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			e.expire(now)
+		case line, ok := <-t.Lines:
+			if !ok {
+				return
+			}
+			if line.Err != nil {
+				nlog.Warnf("Failed to read the traffic log, err: %v", line.Err)
+				continue
+			}
+			e.observe(line.Text, time.Now())
+		}
+	}
+}
+
+// observe counts a line of the traffic log. The request bytes flow from the source to the destination, and the
+// response bytes flow back, so they are counted on the reversed pair. The requests between the local services
+// don't consume the WAN, they are skipped.
+func (e *Exporter) observe(line string, now time.Time) {
+	fields := strings.Fields(line)
+	if len(fields) != trafficLogFields {
+		nlog.Debugf("Skip the malformed traffic log line: %s", line)
+		return
+	}
+	received, err1 := strconv.ParseUint(fields[4], 10, 64)
+	sent, err2 := strconv.ParseUint(fields[5], 10, 64)
+	if err1 != nil || err2 != nil {
+		nlog.Debugf("Skip the malformed traffic log line: %s", line)
+		return
+	}
+
+	src, dst := fields[1], domainOfHost(fields[2])
+	switch fields[0] {
+	case listenerInternal:
+		// the local apps may not set the source
+		if src == missingField {
+			src = e.domainID
+		}
+	case listenerExternal:
+		// the requests received by the external listener are destined for the local domain unless transited
+		if dst == unknownDomain {
+			dst = e.domainID
+		}
+	default:
+		nlog.Debugf("Skip the traffic log line of unknown listener: %s", line)
+		return
+	}
+	if src == missingField {
+		src = unknownDomain
+	}
+	if src == dst {
+		return
+	}
+	job := fields[3]
+	if job == missingField {
+		job = ""
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.requests.WithLabelValues(src, dst, job).Inc()
+	e.bytes.WithLabelValues(src, dst, job).Add(float64(received))
+	e.bytes.WithLabelValues(dst, src, job).Add(float64(sent))
+	e.requestsSeen[seriesKey{src: src, dst: dst, job: job}] = now
+	e.bytesSeen[seriesKey{src: src, dst: dst, job: job}] = now
+	e.bytesSeen[seriesKey{src: dst, dst: src, job: job}] = now
+}
+
+// expire drops the series idle for seriesTTL, otherwise the series of the finished jobs pile up.
+func (e *Exporter) expire(now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	expireSeries(e.requests, e.requestsSeen, now)
+	expireSeries(e.bytes, e.bytesSeen, now)
+}
+
+func expireSeries(vec *prometheus.CounterVec, seen map[seriesKey]time.Time, now time.Time) {
+	for key, t := range seen {
+		if now.Sub(t) >= seriesTTL {
+			vec.DeleteLabelValues(key.src, key.dst, key.job)
+			delete(seen, key)
+		}
+	}
+}
+
+// domainOfHost extracts the domain from the service hosts like <service>.<domain>.svc[:port].
+func domainOfHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	labels := strings.Split(strings.TrimSuffix(host, "."), ".")
+	if len(labels) < 3 || labels[len(labels)-1] != "svc" {
+		return unknownDomain
+	}
+	return labels[len(labels)-2]
+}
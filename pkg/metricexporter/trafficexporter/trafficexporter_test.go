@@ -0,0 +1,100 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trafficexporter
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainOfHost(t *testing.T) {
+	assert.Equal(t, "bob", domainOfHost("secretflow-task-psi-0-spu.bob.svc"))
+	assert.Equal(t, "bob", domainOfHost("secretflow-task-psi-0-spu.bob.svc:1054"))
+	assert.Equal(t, unknownDomain, domainOfHost("10.0.0.1:1080"))
+	assert.Equal(t, unknownDomain, domainOfHost("-"))
+}
+
+func TestObserve(t *testing.T) {
+	e := NewExporter(t.TempDir(), "alice")
+	now := time.Now()
+
+	// alice sends a request of 100 bytes to bob and receives 20 bytes
+	e.observe("internal alice task-0-spu.bob.svc job-1 100 20", now)
+	// the source is missing
+	e.observe("internal - task-0-spu.bob.svc job-1 10 0", now)
+	// bob sends a request to alice without the job id
+	e.observe("external bob task-0-spu.alice.svc - 7 3", now)
+	// the local requests are skipped
+	e.observe("internal alice kuscia-handshake.alice.svc job-1 1000 1000", now)
+	// the malformed lines are skipped
+	e.observe("internal alice task-0-spu.bob.svc job-1 abc 20", now)
+	e.observe("internal alice task-0-spu.bob.svc", now)
+	e.observe("unknown alice task-0-spu.bob.svc job-1 1 1", now)
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(e.requests.WithLabelValues("alice", "bob", "job-1")))
+	assert.Equal(t, float64(110), testutil.ToFloat64(e.bytes.WithLabelValues("alice", "bob", "job-1")))
+	assert.Equal(t, float64(20), testutil.ToFloat64(e.bytes.WithLabelValues("bob", "alice", "job-1")))
+	assert.Equal(t, float64(7), testutil.ToFloat64(e.bytes.WithLabelValues("bob", "alice", "")))
+	assert.Equal(t, float64(3), testutil.ToFloat64(e.bytes.WithLabelValues("alice", "bob", "")))
+	assert.Equal(t, 2, testutil.CollectAndCount(e.requests))
+	assert.Equal(t, 4, testutil.CollectAndCount(e.bytes))
+}
+
+func TestExpire(t *testing.T) {
+	e := NewExporter(t.TempDir(), "alice")
+	now := time.Now()
+	e.observe("internal alice task-0-spu.bob.svc job-1 100 20", now.Add(-seriesTTL))
+	e.observe("external bob task-0-spu.alice.svc job-2 7 3", now)
+
+	e.expire(now)
+	assert.Equal(t, 1, testutil.CollectAndCount(e.requests))
+	assert.Equal(t, 2, testutil.CollectAndCount(e.bytes))
+	assert.Equal(t, float64(7), testutil.ToFloat64(e.bytes.WithLabelValues("bob", "alice", "job-2")))
+}
+
+func TestRun(t *testing.T) {
+	rootDir := t.TempDir()
+	e := NewExporter(rootDir, "alice")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(e.logPath), 0755))
+	// the lines written before running are not counted
+	assert.NoError(t, os.WriteFile(e.logPath, []byte("internal alice task-0-spu.bob.svc job-0 1 1\n"), 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go e.Run(ctx)
+
+	assert.Eventually(t, func() bool {
+		f, err := os.OpenFile(e.logPath, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return false
+		}
+		defer f.Close()
+		_, _ = f.WriteString("internal alice task-0-spu.bob.svc job-1 100 20\n")
+		return testutil.ToFloat64(e.requests.WithLabelValues("alice", "bob", "job-1")) > 0
+	}, 10*time.Second, 500*time.Millisecond)
+	assert.Equal(t, float64(0), testutil.ToFloat64(e.requests.WithLabelValues("alice", "bob", "job-0")))
+
+	recorder := httptest.NewRecorder()
+	e.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", MetricPath, nil))
+	assert.True(t, strings.Contains(recorder.Body.String(), `kuscia_route_bytes_total{dst="bob",job="job-1",src="alice"}`))
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migration migrates the stored Kuscia custom resources into the schema of the running kuscia version,
+// so that the objects created by an older version keep working with the new controllers after an upgrade.
+package migration
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+// Migration rewrites the fields of the objects of a resource that the current controllers can't handle.
+// A renamed field must stay in the crd schema until the migration is removed, otherwise the api server
+// prunes it before the migration could read it.
+type Migration struct {
+	// Name identifies the migration in the summary.
+	Name string
+	// Resource is the plural name of the crd, e.g. kusciajobs.
+	Resource string
+	// Migrate mutates the object in place and reports whether it's changed. It must be idempotent,
+	// as the migrations run again on every upgrade.
+	Migrate func(obj *unstructured.Unstructured) (bool, error)
+}
+
+// migrations are applied in order, append the new ones at the end.
+var migrations = []Migration{
+	{
+		Name:     "kusciajob-default-max-parallelism",
+		Resource: "kusciajobs",
+		Migrate:  defaultKusciaJobMaxParallelism,
+	},
+	{
+		Name:     "domain-base64-cert",
+		Resource: "domains",
+		Migrate:  encodeDomainCert,
+	},
+}
+
+// migrationsFor returns the migrations of the resource.
+func migrationsFor(resource string) []Migration {
+	var result []Migration
+	for _, m := range migrations {
+		if m.Resource == resource {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// defaultKusciaJobMaxParallelism persists the default max parallelism of the jobs created before the field existed.
+func defaultKusciaJobMaxParallelism(obj *unstructured.Unstructured) (bool, error) {
+	_, found, err := unstructured.NestedFieldNoCopy(obj.Object, "spec", "maxParallelism")
+	if err != nil || found {
+		return false, err
+	}
+	if err = unstructured.SetNestedField(obj.Object, int64(1), "spec", "maxParallelism"); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// encodeDomainCert encodes the raw pem cert of the domain with base64, which is the format the gateway expects.
+func encodeDomainCert(obj *unstructured.Unstructured) (bool, error) {
+	cert, found, err := unstructured.NestedString(obj.Object, "spec", "cert")
+	if err != nil || !found {
+		return false, err
+	}
+	cert = strings.TrimSpace(cert)
+	if !strings.HasPrefix(cert, "-----BEGIN CERTIFICATE-----") || !tls.VerifyCert([]byte(cert)) {
+		return false, nil
+	}
+	if err = unstructured.SetNestedField(obj.Object, base64.StdEncoding.EncodeToString([]byte(cert)), "spec", "cert"); err != nil {
+		return false, err
+	}
+	return true, nil
+}
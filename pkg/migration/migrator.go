@@ -0,0 +1,269 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/meta"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	listPageSize = 500
+	// maxErrors is the max number of errors kept in the summary of a resource.
+	maxErrors = 10
+)
+
+// ResourceSummary is the migration result of a resource.
+type ResourceSummary struct {
+	Resource       string `json:"resource"`
+	StorageVersion string `json:"storageVersion"`
+	// StoredVersions are the versions the objects were stored in before the migration.
+	StoredVersions []string `json:"storedVersions,omitempty"`
+	Total          int      `json:"total"`
+	// Migrated is the number of objects changed by the migrations.
+	Migrated int `json:"migrated"`
+	// Rewritten is the number of objects rewritten in the storage version without changes.
+	Rewritten int `json:"rewritten"`
+	Failed    int `json:"failed"`
+	// Migrations is the number of objects changed by each migration.
+	Migrations map[string]int `json:"migrations,omitempty"`
+	Errors     []string       `json:"errors,omitempty"`
+}
+
+func (r *ResourceSummary) addError(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	nlog.Warnf("Migrate %s: %s", r.Resource, msg)
+	if len(r.Errors) < maxErrors {
+		r.Errors = append(r.Errors, msg)
+	}
+}
+
+// Summary is the result of a migration run.
+type Summary struct {
+	// Version is the kuscia version the resources are migrated for.
+	Version        string            `json:"version"`
+	DryRun         bool              `json:"dryRun"`
+	StartTime      metav1.Time       `json:"startTime"`
+	CompletionTime metav1.Time       `json:"completionTime"`
+	Resources      []ResourceSummary `json:"resources"`
+}
+
+// Succeeded reports whether all the objects are migrated.
+func (s *Summary) Succeeded() bool {
+	for _, r := range s.Resources {
+		if r.Failed > 0 || len(r.Errors) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Options are the options of the migrator.
+type Options struct {
+	// DryRun only reports the objects to migrate without updating them.
+	DryRun bool
+}
+
+// Migrator walks all the Kuscia custom resources, applies the migrations to them and rewrites them in the storage
+// version of their crds. Once all the objects of a crd are rewritten, the storage version is the only stored version
+// left in the crd status, so that the old versions could be removed from the crd safely.
+type Migrator struct {
+	dynamicClient   dynamic.Interface
+	extensionClient apiextensionsclientset.Interface
+	options         Options
+}
+
+func NewMigrator(dynamicClient dynamic.Interface, extensionClient apiextensionsclientset.Interface, options Options) *Migrator {
+	return &Migrator{
+		dynamicClient:   dynamicClient,
+		extensionClient: extensionClient,
+		options:         options,
+	}
+}
+
+// Run migrates the resources of all the Kuscia crds. The failed objects are reported in the summary instead of
+// stopping the run, an error is returned only if the crds couldn't be listed.
+func (m *Migrator) Run(ctx context.Context) (*Summary, error) {
+	summary := &Summary{
+		Version:   meta.KusciaVersionString(),
+		DryRun:    m.options.DryRun,
+		StartTime: metav1.Now(),
+	}
+	crds, err := m.extensionClient.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list crds failed, %v", err)
+	}
+	sort.Slice(crds.Items, func(i, j int) bool { return crds.Items[i].Name < crds.Items[j].Name })
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if crd.Spec.Group != v1alpha1.SchemeGroupVersion.Group {
+			continue
+		}
+		summary.Resources = append(summary.Resources, m.migrateResource(ctx, crd))
+	}
+	summary.CompletionTime = metav1.Now()
+	return summary, nil
+}
+
+func (m *Migrator) migrateResource(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) ResourceSummary {
+	result := ResourceSummary{
+		Resource:       crd.Spec.Names.Plural,
+		StoredVersions: crd.Status.StoredVersions,
+	}
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			result.StorageVersion = version.Name
+		}
+	}
+	if result.StorageVersion == "" {
+		result.addError("crd %s has no storage version", crd.Name)
+		return result
+	}
+
+	// the objects are rewritten only if some of them may be stored in another version
+	rewrite := len(crd.Status.StoredVersions) != 1 || crd.Status.StoredVersions[0] != result.StorageVersion
+	resourceMigrations := migrationsFor(result.Resource)
+	client := m.dynamicClient.Resource(schema.GroupVersionResource{
+		Group:    crd.Spec.Group,
+		Version:  result.StorageVersion,
+		Resource: result.Resource,
+	})
+
+	continueToken := ""
+	for {
+		list, err := client.List(ctx, metav1.ListOptions{Limit: listPageSize, Continue: continueToken})
+		if err != nil {
+			result.addError("list failed, %v", err)
+			return result
+		}
+		for i := range list.Items {
+			m.migrateObject(ctx, client, &list.Items[i], resourceMigrations, rewrite, &result)
+		}
+		if continueToken = list.GetContinue(); continueToken == "" {
+			break
+		}
+	}
+
+	if rewrite && result.Failed == 0 && !m.options.DryRun {
+		if err := m.updateStoredVersions(ctx, crd.Name, result.StorageVersion); err != nil {
+			result.addError("update stored versions of crd %s failed, %v", crd.Name, err)
+		}
+	}
+	return result
+}
+
+func (m *Migrator) migrateObject(ctx context.Context, client dynamic.NamespaceableResourceInterface, obj *unstructured.Unstructured,
+	resourceMigrations []Migration, rewrite bool, result *ResourceSummary) {
+	result.Total++
+	key := obj.GetName()
+	if obj.GetNamespace() != "" {
+		key = obj.GetNamespace() + "/" + key
+	}
+
+	applied, err := applyMigrations(obj, resourceMigrations)
+	if err != nil {
+		result.Failed++
+		result.addError("migrate %s failed, %v", key, err)
+		return
+	}
+	if len(applied) == 0 && !rewrite {
+		return
+	}
+
+	if !m.options.DryRun {
+		current := obj
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			resourceClient := client.Namespace(obj.GetNamespace())
+			if current == nil {
+				latest, err := resourceClient.Get(ctx, obj.GetName(), metav1.GetOptions{})
+				if err != nil {
+					return err
+				}
+				if applied, err = applyMigrations(latest, resourceMigrations); err != nil {
+					return err
+				}
+				current = latest
+			}
+			_, err := resourceClient.Update(ctx, current, metav1.UpdateOptions{})
+			if err != nil {
+				current = nil
+			}
+			return err
+		})
+		if k8serrors.IsNotFound(err) {
+			return
+		}
+		if err != nil {
+			result.Failed++
+			result.addError("update %s failed, %v", key, err)
+			return
+		}
+	}
+
+	if len(applied) == 0 {
+		result.Rewritten++
+		return
+	}
+	nlog.Infof("Migrate %s %s with %v", result.Resource, key, applied)
+	result.Migrated++
+	if result.Migrations == nil {
+		result.Migrations = map[string]int{}
+	}
+	for _, name := range applied {
+		result.Migrations[name]++
+	}
+}
+
+func (m *Migrator) updateStoredVersions(ctx context.Context, name, storageVersion string) error {
+	crdClient := m.extensionClient.ApiextensionsV1().CustomResourceDefinitions()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		crd, err := crdClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		crd.Status.StoredVersions = []string{storageVersion}
+		_, err = crdClient.UpdateStatus(ctx, crd, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// applyMigrations applies the migrations to the object and returns the names of the ones changing it.
+func applyMigrations(obj *unstructured.Unstructured, resourceMigrations []Migration) ([]string, error) {
+	var applied []string
+	for _, m := range resourceMigrations {
+		changed, err := m.Migrate(obj)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", m.Name, err)
+		}
+		if changed {
+			applied = append(applied, m.Name)
+		}
+	}
+	return applied, nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+var (
+	kusciaJobGVR = schema.GroupVersionResource{Group: "kuscia.secretflow", Version: "v1alpha1", Resource: "kusciajobs"}
+	domainGVR    = schema.GroupVersionResource{Group: "kuscia.secretflow", Version: "v1alpha1", Resource: "domains"}
+)
+
+func newCRD(plural, kind string, scope apiextensionsv1.ResourceScope, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: plural + ".kuscia.secretflow"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "kuscia.secretflow",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: plural, Kind: kind},
+			Scope: scope,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+	}
+}
+
+func newObject(kind, namespace, name string, spec map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kuscia.secretflow/v1alpha1",
+		"kind":       kind,
+		"spec":       spec,
+	}}
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func newPEMCert(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "alice"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestDefaultKusciaJobMaxParallelism(t *testing.T) {
+	obj := newObject("KusciaJob", "cross-domain", "job-1", map[string]interface{}{})
+	changed, err := defaultKusciaJobMaxParallelism(obj)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	value, _, _ := unstructured.NestedInt64(obj.Object, "spec", "maxParallelism")
+	assert.Equal(t, int64(1), value)
+
+	changed, err = defaultKusciaJobMaxParallelism(obj)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestEncodeDomainCert(t *testing.T) {
+	cert := newPEMCert(t)
+	obj := newObject("Domain", "", "alice", map[string]interface{}{"cert": cert})
+	changed, err := encodeDomainCert(obj)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	value, _, _ := unstructured.NestedString(obj.Object, "spec", "cert")
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(strings.TrimSpace(cert))), value)
+
+	changed, err = encodeDomainCert(obj)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	changed, err = encodeDomainCert(newObject("Domain", "", "bob", map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestMigratorRun(t *testing.T) {
+	ctx := context.Background()
+	extensionClient := apiextensionsfake.NewSimpleClientset(
+		newCRD("kusciajobs", "KusciaJob", apiextensionsv1.NamespaceScoped, "v1alpha1"),
+		newCRD("domains", "Domain", apiextensionsv1.ClusterScoped, "v1alpha0", "v1alpha1"),
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "others.example.com"},
+			Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "example.com"},
+		},
+	)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{kusciaJobGVR: "KusciaJobList", domainGVR: "DomainList"},
+		newObject("KusciaJob", "cross-domain", "job-1", map[string]interface{}{}),
+		newObject("KusciaJob", "cross-domain", "job-2", map[string]interface{}{"maxParallelism": int64(2)}),
+		newObject("Domain", "", "alice", map[string]interface{}{"cert": newPEMCert(t)}),
+		newObject("Domain", "", "bob", map[string]interface{}{}),
+	)
+
+	t.Run("dry run", func(t *testing.T) {
+		summary, err := NewMigrator(dynamicClient, extensionClient, Options{DryRun: true}).Run(ctx)
+		require.NoError(t, err)
+		assert.True(t, summary.Succeeded())
+		require.Len(t, summary.Resources, 2)
+		assert.Equal(t, ResourceSummary{
+			Resource: "domains", StorageVersion: "v1alpha1", StoredVersions: []string{"v1alpha0", "v1alpha1"},
+			Total: 2, Migrated: 1, Rewritten: 1, Migrations: map[string]int{"domain-base64-cert": 1},
+		}, summary.Resources[0])
+
+		job, err := dynamicClient.Resource(kusciaJobGVR).Namespace("cross-domain").Get(ctx, "job-1", metav1.GetOptions{})
+		require.NoError(t, err)
+		_, found, _ := unstructured.NestedFieldNoCopy(job.Object, "spec", "maxParallelism")
+		assert.False(t, found)
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		summary, err := NewMigrator(dynamicClient, extensionClient, Options{}).Run(ctx)
+		require.NoError(t, err)
+		assert.True(t, summary.Succeeded())
+		require.Len(t, summary.Resources, 2)
+		assert.Equal(t, ResourceSummary{
+			Resource: "kusciajobs", StorageVersion: "v1alpha1", StoredVersions: []string{"v1alpha1"},
+			Total: 2, Migrated: 1, Migrations: map[string]int{"kusciajob-default-max-parallelism": 1},
+		}, summary.Resources[1])
+
+		job, err := dynamicClient.Resource(kusciaJobGVR).Namespace("cross-domain").Get(ctx, "job-1", metav1.GetOptions{})
+		require.NoError(t, err)
+		value, _, _ := unstructured.NestedInt64(job.Object, "spec", "maxParallelism")
+		assert.Equal(t, int64(1), value)
+
+		crd, err := extensionClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, "domains.kuscia.secretflow", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"v1alpha1"}, crd.Status.StoredVersions)
+	})
+
+	t.Run("migrate again", func(t *testing.T) {
+		summary, err := NewMigrator(dynamicClient, extensionClient, Options{}).Run(ctx)
+		require.NoError(t, err)
+		for _, r := range summary.Resources {
+			assert.Equal(t, 2, r.Total)
+			assert.Equal(t, 0, r.Migrated+r.Rewritten, r.Resource)
+		}
+	})
+}
@@ -63,10 +63,11 @@ func Run(ctx context.Context, reporterConfig *config.ReporterConfig) error {
 
 func newCMConfigService(ctx context.Context, reporterConfig *config.ReporterConfig) (cmservice.IConfigService, error) {
 	configService, err := cmservice.NewConfigService(ctx, &cmservice.ConfigServiceConfig{
-		DomainID:   reporterConfig.DomainID,
-		DomainKey:  reporterConfig.DomainKey,
-		Driver:     driver.CRDDriverType,
-		KubeClient: reporterConfig.KubeClient,
+		DomainID:      reporterConfig.DomainID,
+		DomainKey:     reporterConfig.DomainKey,
+		SecretBackend: reporterConfig.SecretBackend,
+		Driver:        driver.CRDDriverType,
+		KubeClient:    reporterConfig.KubeClient,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("init cm config service for reporter failed, %s", err.Error())
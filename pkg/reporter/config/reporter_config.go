@@ -21,6 +21,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/secretflow/kuscia/pkg/common"
+	"github.com/secretflow/kuscia/pkg/confmanager/secretbackend"
 	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
 )
 
@@ -32,6 +33,7 @@ type ReporterConfig struct {
 	IdleTimeout    int                       `yaml:"idleTimeout,omitempty"`
 	WriteTimeout   int                       `yaml:"-"`
 	DomainKey      *rsa.PrivateKey           `yaml:"-"`
+	SecretBackend  secretbackend.Backend     `yaml:"-"`
 	KusciaClient   kusciaclientset.Interface `yaml:"-"`
 	KubeClient     kubernetes.Interface      `yaml:"-"`
 	RunMode        common.RunModeType        `yaml:"-"`
@@ -0,0 +1,39 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	PlacementDecisions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kuscia_scheduler_placement_decisions_total",
+			Help: "Counts number of pods placed by kuscia scheduler with each placement strategy",
+		},
+		[]string{"strategy"},
+	)
+
+	PlacementNodeUtilization = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kuscia_scheduler_placement_node_utilization",
+			Help:    "Requested resource ratio of the nodes after the pods are placed on them",
+			Buckets: prometheus.LinearBuckets(0.1, 0.1, 10),
+		},
+		[]string{"strategy"},
+	)
+)
@@ -0,0 +1,186 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/api/v1/resource"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/scheduler/placement/metrics"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+// Name is the name of the plugin used in the plugin registry and configurations.
+const Name = "KusciaPlacement"
+
+// KusciaPlacement is a plugin that scores the nodes by the placement strategy of the pod.
+// The binpack strategy prefers the most allocated nodes to reduce fragmentation, and the
+// spread strategy prefers the least allocated nodes for reliability.
+type KusciaPlacement struct {
+	handle          framework.Handle
+	defaultStrategy kusciaapisv1alpha1.PlacementStrategy
+}
+
+var _ framework.ScorePlugin = &KusciaPlacement{}
+var _ framework.PostBindPlugin = &KusciaPlacement{}
+
+// New initializes and returns a new KusciaPlacement plugin.
+func New(obj runtime.Object, handle framework.Handle) (framework.Plugin, error) {
+	args, err := parseArgs(obj)
+	if err != nil {
+		nlog.Warnf("Can't parse placement args, %v", err)
+		return nil, err
+	}
+
+	plugin := &KusciaPlacement{handle: handle}
+	if args != nil {
+		if args.PlacementStrategy != "" && !isValidStrategy(args.PlacementStrategy) {
+			return nil, fmt.Errorf("unsupported placement strategy %q", args.PlacementStrategy)
+		}
+		plugin.defaultStrategy = args.PlacementStrategy
+	}
+
+	nlog.Infof("%v plugin args: PlacementStrategy=%s", Name, plugin.defaultStrategy)
+	return plugin, nil
+}
+
+// parseArgs parses plugin arguments.
+func parseArgs(obj runtime.Object) (*kusciaapisv1alpha1.SchedulerPluginArgs, error) {
+	if obj == nil {
+		return nil, nil
+	}
+
+	ob, ok := obj.(*runtime.Unknown)
+	if !ok {
+		return nil, fmt.Errorf("obj type is not runtime.Unknown")
+	}
+
+	if ob.ContentType != "application/json" {
+		return nil, fmt.Errorf("obj content type is not application/json")
+	}
+
+	var args kusciaapisv1alpha1.SchedulerPluginArgs
+	if err := json.Unmarshal(ob.Raw, &args); err != nil {
+		return nil, err
+	}
+	return &args, nil
+}
+
+// Name returns name of the plugin.
+func (p *KusciaPlacement) Name() string {
+	return Name
+}
+
+// Score scores the node by its requested resource ratio after the pod is placed on it.
+// All the nodes get the same score if the pod has no placement strategy.
+func (p *KusciaPlacement) Score(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
+	strategy := p.strategyOf(pod)
+	if strategy == "" {
+		return framework.MinNodeScore, nil
+	}
+
+	nodeInfo, err := p.handle.SnapshotSharedLister().NodeInfos().Get(nodeName)
+	if err != nil {
+		return 0, framework.AsStatus(fmt.Errorf("getting node %q from snapshot: %w", nodeName, err))
+	}
+	return scoreOf(strategy, nodeUtilization(nodeInfo, pod)), nil
+}
+
+// ScoreExtensions returns nil because the scores are already in the range of node score.
+func (p *KusciaPlacement) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
+
+// PostBind records the placement decision of the pod.
+func (p *KusciaPlacement) PostBind(ctx context.Context, state *framework.CycleState, pod *v1.Pod, nodeName string) {
+	strategy := p.strategyOf(pod)
+	if strategy == "" {
+		return
+	}
+
+	metrics.PlacementDecisions.WithLabelValues(string(strategy)).Inc()
+	nodeInfo, err := p.handle.SnapshotSharedLister().NodeInfos().Get(nodeName)
+	if err != nil {
+		nlog.Debugf("Get node %v from snapshot failed, %v", nodeName, err)
+		return
+	}
+	metrics.PlacementNodeUtilization.WithLabelValues(string(strategy)).Observe(nodeUtilization(nodeInfo, pod))
+}
+
+// strategyOf returns the placement strategy of the pod, or the default strategy of the plugin if the pod doesn't specify one.
+func (p *KusciaPlacement) strategyOf(pod *v1.Pod) kusciaapisv1alpha1.PlacementStrategy {
+	strategy := kusciaapisv1alpha1.PlacementStrategy(pod.Annotations[common.PlacementStrategyAnnotationKey])
+	if isValidStrategy(strategy) {
+		return strategy
+	}
+	if strategy != "" {
+		nlog.Warnf("Pod %v/%v has unsupported placement strategy %q, use the default one", pod.Namespace, pod.Name, strategy)
+	}
+	return p.defaultStrategy
+}
+
+func isValidStrategy(strategy kusciaapisv1alpha1.PlacementStrategy) bool {
+	return strategy == kusciaapisv1alpha1.PlacementStrategyBinpack || strategy == kusciaapisv1alpha1.PlacementStrategySpread
+}
+
+// nodeUtilization returns the average requested ratio of cpu and memory on the node with the pod placed on it.
+func nodeUtilization(nodeInfo *framework.NodeInfo, pod *v1.Pod) float64 {
+	requests := resource.PodRequests(pod, resource.PodResourcesOptions{})
+	ratios := []float64{
+		requestedRatio(nodeInfo.Requested.MilliCPU+requests.Cpu().MilliValue(), nodeInfo.Allocatable.MilliCPU),
+		requestedRatio(nodeInfo.Requested.Memory+requests.Memory().Value(), nodeInfo.Allocatable.Memory),
+	}
+
+	var sum float64
+	var count int
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			continue
+		}
+		sum += ratio
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return sum / float64(count)
+}
+
+// requestedRatio returns the ratio of requested to allocatable in [0, 1], or -1 if nothing is allocatable.
+func requestedRatio(requested, allocatable int64) float64 {
+	if allocatable <= 0 {
+		return -1
+	}
+	if requested >= allocatable {
+		return 1
+	}
+	return float64(requested) / float64(allocatable)
+}
+
+func scoreOf(strategy kusciaapisv1alpha1.PlacementStrategy, utilization float64) int64 {
+	if strategy == kusciaapisv1alpha1.PlacementStrategySpread {
+		utilization = 1 - utilization
+	}
+	return int64(math.Round(utilization * float64(framework.MaxNodeScore)))
+}
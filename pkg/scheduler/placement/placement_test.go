@@ -0,0 +1,115 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	st "k8s.io/kubernetes/pkg/scheduler/testing"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func makeNodeInfo(milliCPU, memory string, pods ...*v1.Pod) *framework.NodeInfo {
+	node := st.MakeNode().Name("node1").Capacity(map[v1.ResourceName]string{
+		v1.ResourceCPU:    milliCPU,
+		v1.ResourceMemory: memory,
+	}).Obj()
+	nodeInfo := framework.NewNodeInfo(pods...)
+	nodeInfo.SetNode(node)
+	return nodeInfo
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	p, err := New(&runtime.Unknown{ContentType: "application/json", Raw: []byte(`{"placementStrategy":"spread"}`)}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, kusciaapisv1alpha1.PlacementStrategySpread, p.(*KusciaPlacement).defaultStrategy)
+
+	_, err = New(&runtime.Unknown{ContentType: "application/json", Raw: []byte(`{"placementStrategy":"random"}`)}, nil)
+	assert.Error(t, err)
+
+	p, err = New(nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, p.(*KusciaPlacement).defaultStrategy)
+}
+
+func TestStrategyOf(t *testing.T) {
+	t.Parallel()
+	p := &KusciaPlacement{defaultStrategy: kusciaapisv1alpha1.PlacementStrategySpread}
+	tests := []struct {
+		name string
+		pod  *v1.Pod
+		want kusciaapisv1alpha1.PlacementStrategy
+	}{
+		{
+			name: "pod without strategy",
+			pod:  st.MakePod().Name("pod").Obj(),
+			want: kusciaapisv1alpha1.PlacementStrategySpread,
+		},
+		{
+			name: "pod with binpack strategy",
+			pod:  st.MakePod().Name("pod").Annotation(common.PlacementStrategyAnnotationKey, "binpack").Obj(),
+			want: kusciaapisv1alpha1.PlacementStrategyBinpack,
+		},
+		{
+			name: "pod with unsupported strategy",
+			pod:  st.MakePod().Name("pod").Annotation(common.PlacementStrategyAnnotationKey, "random").Obj(),
+			want: kusciaapisv1alpha1.PlacementStrategySpread,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, p.strategyOf(tt.pod))
+		})
+	}
+}
+
+func TestNodeUtilization(t *testing.T) {
+	t.Parallel()
+	existing := st.MakePod().Name("existing").Req(map[v1.ResourceName]string{
+		v1.ResourceCPU:    "1",
+		v1.ResourceMemory: "1Gi",
+	}).Obj()
+	pod := st.MakePod().Name("pod").Req(map[v1.ResourceName]string{
+		v1.ResourceCPU:    "1",
+		v1.ResourceMemory: "3Gi",
+	}).Obj()
+
+	assert.InDelta(t, 0.5, nodeUtilization(makeNodeInfo("4", "8Gi", existing), pod), 1e-9)
+	assert.InDelta(t, 1, nodeUtilization(makeNodeInfo("1", "1Gi", existing), pod), 1e-9)
+	assert.InDelta(t, 0, nodeUtilization(framework.NewNodeInfo(), pod), 1e-9)
+}
+
+func TestScoreOf(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, int64(80), scoreOf(kusciaapisv1alpha1.PlacementStrategyBinpack, 0.8))
+	assert.Equal(t, int64(20), scoreOf(kusciaapisv1alpha1.PlacementStrategySpread, 0.8))
+
+	// binpack prefers the more allocated node and spread prefers the less allocated one.
+	busy := nodeUtilization(makeNodeInfo("4", "8Gi", st.MakePod().Name("existing").Req(map[v1.ResourceName]string{
+		v1.ResourceCPU:    "3",
+		v1.ResourceMemory: "6Gi",
+	}).Obj()), st.MakePod().Name("pod").Obj())
+	idle := nodeUtilization(makeNodeInfo("4", "8Gi"), st.MakePod().Name("pod").Obj())
+	assert.Greater(t, scoreOf(kusciaapisv1alpha1.PlacementStrategyBinpack, busy), scoreOf(kusciaapisv1alpha1.PlacementStrategyBinpack, idle))
+	assert.Less(t, scoreOf(kusciaapisv1alpha1.PlacementStrategySpread, busy), scoreOf(kusciaapisv1alpha1.PlacementStrategySpread, idle))
+}
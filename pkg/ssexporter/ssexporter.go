@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -32,9 +33,20 @@ import (
 
 var (
 	ReadyChan = make(chan struct{})
+	// exportPeriod is the export period in seconds, it can be changed by SetExportPeriod while running.
+	exportPeriod atomic.Uint32
 )
 
-func SsExporter(ctx context.Context, runMode pkgcom.RunModeType, domainID string, exportPeriod uint, port int) error {
+// SetExportPeriod changes the export period of the running exporter, it takes effect after the current period.
+func SetExportPeriod(period uint) {
+	if period == 0 {
+		return
+	}
+	exportPeriod.Store(uint32(period))
+}
+
+func SsExporter(ctx context.Context, runMode pkgcom.RunModeType, domainID string, period uint, port int) error {
+	SetExportPeriod(period)
 	// read the config
 	_, AggregationMetrics := parse.LoadMetricConfig()
 	clusterAddresses, _ := parse.GetClusterAddress(domainID)
@@ -42,16 +54,21 @@ func SsExporter(ctx context.Context, runMode pkgcom.RunModeType, domainID string
 	var MetricTypes = promexporter.NewMetricTypes()
 
 	reg := promexporter.ProduceRegister()
-	lastClusterMetricValues, err := ssmetrics.GetSsMetricResults(runMode, localDomainName, clusterAddresses, AggregationMetrics, exportPeriod)
+	lastClusterMetricValues, err := ssmetrics.GetSsMetricResults(runMode, localDomainName, clusterAddresses, AggregationMetrics, period)
 	if err != nil {
 		nlog.Warnf("Fail to get ss metric results, err: %v", err)
 		return err
 	}
 	// export the cluster metrics
-	ticker := time.NewTicker(time.Duration(exportPeriod) * time.Second)
+	ticker := time.NewTicker(time.Duration(period) * time.Second)
 	defer ticker.Stop()
 	go func(runMode pkgcom.RunModeType, reg *prometheus.Registry, MetricTypes map[string]string, exportPeriods uint, lastClusterMetricValues map[string]float64) {
 		for range ticker.C {
+			if current := uint(exportPeriod.Load()); current != exportPeriods {
+				nlog.Infof("Metric export period changed from %ds to %ds", exportPeriods, current)
+				exportPeriods = current
+				ticker.Reset(time.Duration(exportPeriods) * time.Second)
+			}
 			// get clusterName and clusterAddress
 			clusterAddresses, _ = parse.GetClusterAddress(domainID)
 			// get cluster metrics
@@ -64,7 +81,7 @@ func SsExporter(ctx context.Context, runMode pkgcom.RunModeType, domainID string
 			// update cluster metrics in prometheus
 			promexporter.UpdateMetrics(reg, currentClusterMetricValues, MetricTypes)
 		}
-	}(runMode, reg, MetricTypes, exportPeriod, lastClusterMetricValues)
+	}(runMode, reg, MetricTypes, period, lastClusterMetricValues)
 	// export to the prometheus
 	ssServer := http.NewServeMux()
 	ssServer.Handle("/ssmetrics", promhttp.HandlerFor(
@@ -0,0 +1,173 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+const (
+	DomainAlice = "alice"
+	DomainBob   = "bob"
+
+	DefaultAppImage = "test-image"
+)
+
+// MakeNamespace returns the namespace of a domain.
+func MakeNamespace(name string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+}
+
+// MakeDomain returns a domain with an empty spec.
+func MakeDomain(name string) *kusciaapisv1alpha1.Domain {
+	return &kusciaapisv1alpha1.Domain{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: kusciaapisv1alpha1.DomainSpec{},
+	}
+}
+
+// MakeDomains returns the namespaces and domains of names, they can be passed to Harness.AddObjects directly.
+func MakeDomains(names ...string) []runtime.Object {
+	var objs []runtime.Object
+	for _, name := range names {
+		objs = append(objs, MakeNamespace(name), MakeDomain(name))
+	}
+	return objs
+}
+
+// MakeAliceBobDomains returns the namespaces and domains of alice and bob.
+func MakeAliceBobDomains() []runtime.Object {
+	return MakeDomains(DomainAlice, DomainBob)
+}
+
+// MakeDomainRoute returns a token-authenticated domain route from source to destination, placed in the
+// source namespace and named as "source-destination".
+func MakeDomainRoute(source, destination string) *kusciaapisv1alpha1.DomainRoute {
+	return &kusciaapisv1alpha1.DomainRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", source, destination),
+			Namespace: source,
+		},
+		Spec: kusciaapisv1alpha1.DomainRouteSpec{
+			Source:             source,
+			Destination:        destination,
+			AuthenticationType: kusciaapisv1alpha1.DomainAuthenticationToken,
+			Endpoint: kusciaapisv1alpha1.DomainEndpoint{
+				Host: destination,
+				Ports: []kusciaapisv1alpha1.DomainPort{
+					{Name: "http", Port: 1080, Protocol: kusciaapisv1alpha1.DomainRouteProtocolHTTP},
+				},
+			},
+		},
+	}
+}
+
+// MakeClusterDomainRoute returns a token-authenticated cluster domain route from source to destination.
+func MakeClusterDomainRoute(source, destination string) *kusciaapisv1alpha1.ClusterDomainRoute {
+	dr := MakeDomainRoute(source, destination)
+	return &kusciaapisv1alpha1.ClusterDomainRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: dr.Name,
+		},
+		Spec: kusciaapisv1alpha1.ClusterDomainRouteSpec{
+			DomainRouteSpec: dr.Spec,
+		},
+	}
+}
+
+// MakeAppImage returns an app image with a single client deploy template.
+func MakeAppImage(name string) *kusciaapisv1alpha1.AppImage {
+	replicas := int32(1)
+	return &kusciaapisv1alpha1.AppImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: kusciaapisv1alpha1.AppImageSpec{
+			Image: kusciaapisv1alpha1.AppImageInfo{
+				Name: "secretflow/secretflow-lite-anolis8",
+				Tag:  "latest",
+			},
+			DeployTemplates: []kusciaapisv1alpha1.DeployTemplate{
+				{
+					Name:     "secretflow",
+					Role:     "client",
+					Replicas: &replicas,
+					Spec: kusciaapisv1alpha1.PodSpec{
+						Containers: []kusciaapisv1alpha1.Container{
+							{
+								Name:    "secretflow",
+								Command: []string{"sh"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// MakeKusciaJob returns a job initiated by the first party, with one task per alias where each task
+// depends on the previous one and is run by all parties.
+func MakeKusciaJob(name string, parties []string, taskAliases ...string) *kusciaapisv1alpha1.KusciaJob {
+	var jobParties []kusciaapisv1alpha1.Party
+	for _, party := range parties {
+		jobParties = append(jobParties, kusciaapisv1alpha1.Party{Role: "client", DomainID: party})
+	}
+	maxParallelism := 2
+	job := &kusciaapisv1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: common.KusciaCrossDomain,
+			UID:       types.UID(name),
+		},
+		Spec: kusciaapisv1alpha1.KusciaJobSpec{
+			ScheduleMode:   kusciaapisv1alpha1.KusciaJobScheduleModeBestEffort,
+			MaxParallelism: &maxParallelism,
+		},
+	}
+	if len(parties) > 0 {
+		job.Spec.Initiator = parties[0]
+	}
+	for i, alias := range taskAliases {
+		task := kusciaapisv1alpha1.KusciaTaskTemplate{
+			Alias:           alias,
+			TaskID:          alias,
+			Priority:        100,
+			TaskInputConfig: "meta://secretflow-1/task-input-config",
+			AppImage:        DefaultAppImage,
+			Parties:         jobParties,
+			Dependencies:    []string{},
+		}
+		if i > 0 {
+			task.Dependencies = []string{taskAliases[i-1]}
+		}
+		job.Spec.Tasks = append(job.Spec.Tasks, task)
+	}
+	return job
+}
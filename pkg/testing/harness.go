@@ -0,0 +1,154 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testing provides a harness for testing kuscia controllers and extensions. It wires fake
+// kube/kuscia clientsets with informer factories, so objects can be seeded into both the client and
+// the listers with one call.
+package testing
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	kubescheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+	kusciascheme "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/scheme"
+	kusciainformers "github.com/secretflow/kuscia/pkg/crd/informers/externalversions"
+)
+
+const (
+	defaultResyncPeriod = 5 * time.Minute
+	defaultRecorderSize = 100
+)
+
+// Harness holds the fake clients and informer factories shared by a test.
+type Harness struct {
+	KubeClient            *kubefake.Clientset
+	KusciaClient          *kusciafake.Clientset
+	KubeInformerFactory   informers.SharedInformerFactory
+	KusciaInformerFactory kusciainformers.SharedInformerFactory
+	Recorder              *record.FakeRecorder
+}
+
+// NewHarness returns a Harness whose clients are seeded with objs. The objects are only added to
+// the clients, use AddObjects to make them visible to listers too.
+func NewHarness(objs ...runtime.Object) *Harness {
+	var kubeObjs, kusciaObjs []runtime.Object
+	for _, obj := range objs {
+		if isKusciaObject(obj) {
+			kusciaObjs = append(kusciaObjs, obj)
+		} else {
+			kubeObjs = append(kubeObjs, obj)
+		}
+	}
+	kubeClient := kubefake.NewSimpleClientset(kubeObjs...)
+	kusciaClient := kusciafake.NewSimpleClientset(kusciaObjs...)
+	return &Harness{
+		KubeClient:            kubeClient,
+		KusciaClient:          kusciaClient,
+		KubeInformerFactory:   informers.NewSharedInformerFactory(kubeClient, defaultResyncPeriod),
+		KusciaInformerFactory: kusciainformers.NewSharedInformerFactory(kusciaClient, defaultResyncPeriod),
+		Recorder:              record.NewFakeRecorder(defaultRecorderSize),
+	}
+}
+
+// AddObjects adds objs to both the fake clients and the informer stores, so that they can be got
+// from the clients and the listers without starting the informers.
+func (h *Harness) AddObjects(objs ...runtime.Object) error {
+	for _, obj := range objs {
+		tracker := h.KubeClient.Tracker()
+		if isKusciaObject(obj) {
+			tracker = h.KusciaClient.Tracker()
+		}
+		if err := tracker.Add(obj); err != nil {
+			return err
+		}
+	}
+	return h.AddToInformers(objs...)
+}
+
+// AddToInformers adds objs to the informer stores only, it's useful to simulate a stale cache.
+func (h *Harness) AddToInformers(objs ...runtime.Object) error {
+	for _, obj := range objs {
+		informer, err := h.informerFor(obj)
+		if err != nil {
+			return err
+		}
+		if err := informer.GetStore().Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start starts all informers requested so far and waits for their caches to sync.
+func (h *Harness) Start(stopCh <-chan struct{}) {
+	h.KubeInformerFactory.Start(stopCh)
+	h.KusciaInformerFactory.Start(stopCh)
+	h.KubeInformerFactory.WaitForCacheSync(stopCh)
+	h.KusciaInformerFactory.WaitForCacheSync(stopCh)
+}
+
+// Events drains and returns the events recorded so far.
+func (h *Harness) Events() []string {
+	var events []string
+	for {
+		select {
+		case event := <-h.Recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func (h *Harness) informerFor(obj runtime.Object) (cache.SharedIndexInformer, error) {
+	scheme := kubescheme.Scheme
+	if isKusciaObject(obj) {
+		scheme = kusciascheme.Scheme
+	}
+	gvks, _, err := scheme.ObjectKinds(obj)
+	if err != nil {
+		return nil, err
+	}
+	gvr, _ := meta.UnsafeGuessKindToResource(gvks[0])
+	if isKusciaObject(obj) {
+		informer, err := h.KusciaInformerFactory.ForResource(gvr)
+		if err != nil {
+			return nil, fmt.Errorf("no kuscia informer for %s: %v", gvr, err)
+		}
+		return informer.Informer(), nil
+	}
+	informer, err := h.KubeInformerFactory.ForResource(gvr)
+	if err != nil {
+		return nil, fmt.Errorf("no kube informer for %s: %v", gvr, err)
+	}
+	return informer.Informer(), nil
+}
+
+func isKusciaObject(obj runtime.Object) bool {
+	gvks, _, err := kusciascheme.Scheme.ObjectKinds(obj)
+	if err != nil {
+		return false
+	}
+	return gvks[0].Group == kusciaapisv1alpha1.SchemeGroupVersion.Group
+}
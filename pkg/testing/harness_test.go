@@ -0,0 +1,93 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func TestHarnessAddObjects(t *testing.T) {
+	h := NewHarness()
+	nsLister := h.KubeInformerFactory.Core().V1().Namespaces().Lister()
+	domainLister := h.KusciaInformerFactory.Kuscia().V1alpha1().Domains().Lister()
+	routeLister := h.KusciaInformerFactory.Kuscia().V1alpha1().DomainRoutes().Lister()
+
+	assert.NoError(t, h.AddObjects(MakeAliceBobDomains()...))
+	assert.NoError(t, h.AddObjects(MakeDomainRoute(DomainAlice, DomainBob), MakeAppImage(DefaultAppImage)))
+
+	nss, err := nsLister.List(labels.Everything())
+	assert.NoError(t, err)
+	assert.Len(t, nss, 2)
+	domains, err := domainLister.List(labels.Everything())
+	assert.NoError(t, err)
+	assert.Len(t, domains, 2)
+	route, err := routeLister.DomainRoutes(DomainAlice).Get("alice-bob")
+	assert.NoError(t, err)
+	assert.Equal(t, DomainBob, route.Spec.Destination)
+
+	_, err = h.KusciaClient.KusciaV1alpha1().AppImages().Get(context.Background(), DefaultAppImage, metav1.GetOptions{})
+	assert.NoError(t, err)
+	_, err = h.KubeClient.CoreV1().Namespaces().Get(context.Background(), DomainBob, metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestNewHarnessWithObjects(t *testing.T) {
+	h := NewHarness(MakeNamespace(DomainAlice), MakeKusciaJob("job-1", []string{DomainAlice, DomainBob}, "a", "b"))
+	job, err := h.KusciaClient.KusciaV1alpha1().KusciaJobs("cross-domain").Get(context.Background(), "job-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, DomainAlice, job.Spec.Initiator)
+	assert.Equal(t, []string{"a"}, job.Spec.Tasks[1].Dependencies)
+	_, err = h.KubeClient.CoreV1().Namespaces().Get(context.Background(), DomainAlice, metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	h.Recorder.Event(job, "Normal", "Test", "test event")
+	assert.Equal(t, []string{"Normal Test test event"}, h.Events())
+	assert.Empty(t, h.Events())
+}
+
+func TestDrivePhases(t *testing.T) {
+	job := MakeKusciaJob("job-1", []string{DomainAlice})
+	next := map[kusciaapisv1alpha1.KusciaJobPhase]kusciaapisv1alpha1.KusciaJobPhase{
+		"":                                      kusciaapisv1alpha1.KusciaJobInitialized,
+		kusciaapisv1alpha1.KusciaJobInitialized: kusciaapisv1alpha1.KusciaJobPending,
+		kusciaapisv1alpha1.KusciaJobPending:     kusciaapisv1alpha1.KusciaJobRunning,
+	}
+	phaseOf := func(j *kusciaapisv1alpha1.KusciaJob) kusciaapisv1alpha1.KusciaJobPhase { return j.Status.Phase }
+	handlerFor := func(phase kusciaapisv1alpha1.KusciaJobPhase) PhaseHandlerFunc[*kusciaapisv1alpha1.KusciaJob] {
+		return func(j *kusciaapisv1alpha1.KusciaJob) (bool, error) {
+			if n, ok := next[phase]; ok {
+				j.Status.Phase = n
+				return true, nil
+			}
+			return false, nil
+		}
+	}
+
+	phases, err := DrivePhases(job, phaseOf, handlerFor, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []kusciaapisv1alpha1.KusciaJobPhase{"", kusciaapisv1alpha1.KusciaJobInitialized,
+		kusciaapisv1alpha1.KusciaJobPending, kusciaapisv1alpha1.KusciaJobRunning}, phases)
+
+	job.Status.Phase = ""
+	_, err = DrivePhases(job, phaseOf, handlerFor, 2)
+	assert.Error(t, err)
+}
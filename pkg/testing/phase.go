@@ -0,0 +1,51 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"fmt"
+)
+
+// PhaseHandlerFunc handles obj in its current phase and reports whether obj needs to be updated,
+// e.g. KusciaJobPhaseHandler.HandlePhase or KusciaTaskPhaseHandler.Handle.
+type PhaseHandlerFunc[T any] func(obj T) (needUpdate bool, err error)
+
+// DrivePhases runs the phase state machine of obj in memory: it looks up the handler of the current
+// phase by handlerFor and calls it, until there is no handler for the phase, the handler reports no
+// update without changing the phase, or maxSteps handlers have been called. It returns the phases
+// visited in order, starting with the initial phase.
+func DrivePhases[T any, P comparable](obj T, phaseOf func(T) P, handlerFor func(P) PhaseHandlerFunc[T], maxSteps int) ([]P, error) {
+	phases := []P{phaseOf(obj)}
+	for step := 0; step < maxSteps; step++ {
+		phase := phaseOf(obj)
+		handler := handlerFor(phase)
+		if handler == nil {
+			return phases, nil
+		}
+		needUpdate, err := handler(obj)
+		if err != nil {
+			return phases, fmt.Errorf("handle phase %v failed: %v", phase, err)
+		}
+		next := phaseOf(obj)
+		if next != phase {
+			phases = append(phases, next)
+			continue
+		}
+		if !needUpdate {
+			return phases, nil
+		}
+	}
+	return phases, fmt.Errorf("phases do not converge after %d steps, visited %v", maxSteps, phases)
+}
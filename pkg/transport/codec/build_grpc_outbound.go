@@ -19,6 +19,24 @@ import (
 	"github.com/secretflow/kuscia/pkg/transport/transerr"
 )
 
+// metadata of the messages on the transport stream
+const (
+	// PtpStreamOp is the operation of the inbound, or the kind of the outbound on the transport stream.
+	PtpStreamOp = "x-ptp-stream-op"
+	// PtpStreamCredits is the number of messages the subscriber is able to receive more, unlimited if it's
+	// not set when subscribing.
+	PtpStreamCredits = "x-ptp-stream-credits"
+)
+
+const (
+	StreamOpPush      = "push"
+	StreamOpSubscribe = "subscribe"
+	StreamOpCredit    = "credit"
+	StreamOpAck       = "ack"
+	// StreamOpMessage is the kind of the outbound which carries a message of the subscribed topic.
+	StreamOpMessage = "message"
+)
+
 func BuildInvokeOutboundByErr(err *transerr.TransError) *pb.Outbound {
 	if err == nil {
 		return BuildInvokeOutboundByPayload(nil)
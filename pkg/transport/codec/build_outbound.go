@@ -26,8 +26,18 @@ const (
 	PtpSourceNodeID = "x-ptp-source-node-id"
 	PtpTraceID      = "x-ptp-trace-id"
 	PtpTopicID      = "x-ptp-topic"
+
+	// PtpMessageID is set by the producer to deduplicate the retried pushes, and is returned with the popped
+	// message to acknowledge it.
+	PtpMessageID = "x-ptp-message-id"
+	// PtpAckMode set to AckModeManual makes the popped message wait for the acknowledgement.
+	PtpAckMode = "x-ptp-ack-mode"
+	// PtpDeliveryCount is how many times the popped message has been delivered, greater than 1 means redelivery.
+	PtpDeliveryCount = "x-ptp-delivery-count"
 )
 
+const AckModeManual = "manual"
+
 type Outbound ptp.TransportOutbound
 
 func BuildOutboundByErr(err *transerr.TransError) *Outbound {
@@ -19,7 +19,7 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/secretflow/kuscia/pkg/transport/server/http"
+	"github.com/secretflow/kuscia/pkg/transport/server"
 	"github.com/secretflow/kuscia/pkg/utils/meta"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/utils/nlog/zlogwriter"
@@ -38,7 +38,7 @@ func NewCommand(opts *Opts) *cobra.Command {
 			}
 			nlog.Setup(nlog.SetWriter(zLogWriter))
 
-			err = http.Run(context.Background(), opts.configFile)
+			err = server.Run(context.Background(), opts.configFile)
 			if err != nil {
 				nlog.Fatalf("Failed to start transport, %v", err)
 			}
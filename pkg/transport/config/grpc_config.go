@@ -34,6 +34,8 @@ const (
 )
 
 type GrpcConfig struct {
+	// Enabled starts the grpc server of transport besides the http server, it's disabled by default.
+	Enabled              bool   `yaml:"enabled,omitempty"`
 	Port                 int    `yaml:"port,omitempty"`
 	MaxConns             int    `yaml:"maxConns,omitempty"`
 	MaxConcurrentStreams uint32 `yaml:"maxConcurrentStreams,omitempty"`
@@ -25,6 +25,7 @@ import (
 type TransConfig struct {
 	MsqConfig  *msq.Config   `yaml:"msqConfig,omitempty"`
 	HTTPConfig *ServerConfig `yaml:"httpConfig,omitempty"`
+	GrpcConfig *GrpcConfig   `yaml:"grpcConfig,omitempty"`
 }
 
 func LoadTransConfig(configPath string) (*TransConfig, error) {
@@ -35,6 +36,7 @@ func DefaultTransConfig() *TransConfig {
 	return &TransConfig{
 		MsqConfig:  msq.DefaultMsgConfig(),
 		HTTPConfig: DefaultServerConfig(),
+		GrpcConfig: DefaultGrpcConfig(),
 	}
 }
 
@@ -0,0 +1,57 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	QueueDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kuscia_transport_queue_depth",
+			Help: "Number of messages buffered in the transport session queues",
+		},
+	)
+
+	QueueByteSize = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kuscia_transport_queue_bytes",
+			Help: "Total size of messages buffered in the transport session queues",
+		},
+	)
+
+	OldestMessageAge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kuscia_transport_oldest_message_age_seconds",
+			Help: "Age of the oldest message buffered in the transport session queues",
+		},
+	)
+
+	RedeliveredMessages = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "kuscia_transport_redelivered_messages_total",
+			Help: "Counts number of messages redelivered because their acknowledgements timed out",
+		},
+	)
+
+	PersistedByteSize = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kuscia_transport_persisted_bytes",
+			Help: "Total size of messages persisted in the transport message store",
+		},
+	)
+)
@@ -14,7 +14,10 @@
 
 package msq
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 const (
 	cleanStep            = 100
@@ -34,6 +37,17 @@ const (
 
 	minCleanIntervalSeconds = 30
 	maxCleanIntervalSeconds = 600
+
+	minAckTimeoutSeconds     = 5
+	maxAckTimeoutSeconds     = 600
+	defaultAckTimeoutSeconds = 60
+
+	minDedupWindowSeconds     = 60
+	maxDedupWindowSeconds     = 3600
+	defaultDedupWindowSeconds = 600
+
+	minPersistMessageTTLSeconds     = 60
+	defaultPersistMessageTTLSeconds = 3600
 )
 
 type Config struct {
@@ -46,6 +60,29 @@ type Config struct {
 	NormalizeActiveSeconds     int64 `yaml:"normalizeActiveSeconds,omitempty"`
 
 	CleanIntervalSeconds int64 `yaml:"cleanIntervalSeconds,omitempty"`
+
+	// AckTimeoutSeconds is how long a message popped in manual ack mode waits for the ack before it's redelivered.
+	AckTimeoutSeconds int64 `yaml:"ackTimeoutSeconds,omitempty"`
+	// DedupWindowSeconds is how long the id of a pushed message is remembered to drop the duplicated pushes.
+	DedupWindowSeconds int64 `yaml:"dedupWindowSeconds,omitempty"`
+
+	// Persistence enables the disk-backed buffer, messages are kept in memory only if it's not set.
+	Persistence *PersistenceConfig `yaml:"persistence,omitempty"`
+}
+
+// PersistenceConfig is the config of the disk-backed message buffer, buffered messages survive the
+// restart of transport and are redelivered after it.
+type PersistenceConfig struct {
+	// Path is the file path of the message database.
+	Path string `yaml:"path,omitempty"`
+	// MaxByteSize limits the total size of the persisted messages, defaults to TotalByteSizeLimit.
+	MaxByteSize uint64 `yaml:"maxByteSize,omitempty"`
+	// MessageTTLSeconds is how long a persisted message is kept, expired messages are not redelivered.
+	MessageTTLSeconds int64 `yaml:"messageTTLSeconds,omitempty"`
+}
+
+func (c *Config) persistenceEnabled() bool {
+	return c.Persistence != nil && c.Persistence.Path != ""
 }
 
 func DefaultMsgConfig() *Config {
@@ -57,6 +94,8 @@ func DefaultMsgConfig() *Config {
 		SessionExpireSeconds:       600,
 		NormalizeActiveSeconds:     4,
 		CleanIntervalSeconds:       120,
+		AckTimeoutSeconds:          defaultAckTimeoutSeconds,
+		DedupWindowSeconds:         defaultDedupWindowSeconds,
 	}
 }
 
@@ -80,9 +119,40 @@ func (c *Config) Check() error {
 	adjustInt64(&c.SessionExpireSeconds, minSessionExpireSeconds, maxSessionExpireSeconds)
 	adjustInt64(&c.NormalizeActiveSeconds, minNormalizeActiveSeconds, maxNormalizeActiveSeconds)
 	adjustInt64(&c.CleanIntervalSeconds, minCleanIntervalSeconds, maxCleanIntervalSeconds)
+	adjustInt64(&c.AckTimeoutSeconds, minAckTimeoutSeconds, maxAckTimeoutSeconds)
+	adjustInt64(&c.DedupWindowSeconds, minDedupWindowSeconds, maxDedupWindowSeconds)
+	return c.checkPersistence()
+}
+
+func (c *Config) checkPersistence() error {
+	if !c.persistenceEnabled() {
+		return nil
+	}
+
+	if c.Persistence.MaxByteSize == 0 {
+		c.Persistence.MaxByteSize = c.TotalByteSizeLimit
+	}
+	if c.Persistence.MaxByteSize < c.PerSessionByteSizeLimit {
+		return fmt.Errorf("Persistence.MaxByteSize(%d) of msq should greater than PerSessionByteSizeLimit(%d)",
+			c.Persistence.MaxByteSize, c.PerSessionByteSizeLimit)
+	}
+
+	if c.Persistence.MessageTTLSeconds == 0 {
+		c.Persistence.MessageTTLSeconds = defaultPersistMessageTTLSeconds
+	}
+	if c.Persistence.MessageTTLSeconds < minPersistMessageTTLSeconds {
+		c.Persistence.MessageTTLSeconds = minPersistMessageTTLSeconds
+	}
 	return nil
 }
 
+func secondsOrDefault(seconds, defaultSeconds int64) time.Duration {
+	if seconds <= 0 {
+		seconds = defaultSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
 func adjustInt64(v *int64, min, max int64) {
 	if *v < min {
 		*v = min
@@ -88,6 +88,17 @@ func (mc *MemControl) Prefetch(byteSize uint64, timeout time.Duration) (bool, ti
 	return true, leftTimeout
 }
 
+// TryAcquire takes the buffer without waiting, returns false if the buffer can't fit it.
+func (mc *MemControl) TryAcquire(byteSize uint64) bool {
+	mc.Lock()
+	defer mc.Unlock()
+	if !mc.availableToPush(byteSize) {
+		return false
+	}
+	mc.totalByteSize += byteSize
+	return true
+}
+
 func (mc *MemControl) Release(byteSize uint64) {
 	mc.Lock()
 	mc.totalByteSize -= byteSize
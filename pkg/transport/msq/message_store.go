@@ -0,0 +1,298 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msq
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+var messageBucket = []byte("messages")
+
+const (
+	storeOpenTimeout = 5 * time.Second
+	// the value of a persisted message is the enqueue time in unix nano, the length-prefixed message id
+	// and the content
+	storeValueTimeSize = 8
+)
+
+// MessageStore is the disk-backed buffer of messages, the keys are ordered by session, topic and
+// sequence, so the messages of a topic are redelivered in the order they were pushed.
+type MessageStore struct {
+	db  *bolt.DB
+	ttl time.Duration
+
+	mtx           sync.Mutex
+	byteSize      uint64
+	byteSizeLimit uint64
+}
+
+func OpenMessageStore(config *PersistenceConfig) (*MessageStore, error) {
+	if err := os.MkdirAll(filepath.Dir(config.Path), 0755); err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(config.Path, 0600, &bolt.Options{Timeout: storeOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("open message store %s failed, %v", config.Path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(messageBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &MessageStore{
+		db:            db,
+		ttl:           time.Duration(config.MessageTTLSeconds) * time.Second,
+		byteSizeLimit: config.MaxByteSize,
+	}, nil
+}
+
+// Load removes the expired messages and calls fn with every live message in key order.
+func (ms *MessageStore) Load(fn func(sid, topic string, message *Message)) error {
+	now := time.Now()
+	var byteSize uint64
+	var expired [][]byte
+
+	err := ms.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(messageBucket).ForEach(func(k, v []byte) error {
+			sid, topic, ok := decodeMessageKey(k)
+			message, valueOK := decodeMessageValue(v)
+			if !ok || !valueOK {
+				nlog.Warnf("Drop malformed persisted message, key=%x", k)
+				expired = append(expired, bytes.Clone(k))
+				return nil
+			}
+
+			if now.Sub(message.enqueueTime) > ms.ttl {
+				expired = append(expired, bytes.Clone(k))
+				return nil
+			}
+
+			// the value is only valid in the transaction
+			message.Content = bytes.Clone(message.Content)
+			message.storeKey = bytes.Clone(k)
+			byteSize += message.ByteSize()
+			fn(sid, topic, message)
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	ms.mtx.Lock()
+	ms.byteSize = byteSize
+	ms.mtx.Unlock()
+
+	if len(expired) > 0 {
+		nlog.Infof("Drop %d expired persisted messages", len(expired))
+		return ms.deleteKeys(expired)
+	}
+	return nil
+}
+
+// Save persists the message and records its key on it.
+func (ms *MessageStore) Save(sid, topic string, message *Message) error {
+	if !ms.reserve(message.ByteSize()) {
+		return fmt.Errorf("message store is full, limit=%d", ms.byteSizeLimit)
+	}
+
+	var key []byte
+	err := ms.db.Batch(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(messageBucket)
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		key = encodeMessageKey(sid, topic, seq)
+		return bucket.Put(key, encodeMessageValue(message))
+	})
+	if err != nil {
+		ms.release(message.ByteSize())
+		return err
+	}
+
+	message.storeKey = key
+	return nil
+}
+
+// Delete removes the message from the store, it's a no-op if the message is not persisted.
+func (ms *MessageStore) Delete(message *Message) {
+	if message.storeKey == nil {
+		return
+	}
+
+	if err := ms.deleteKeys([][]byte{message.storeKey}); err != nil {
+		nlog.Warnf("Delete persisted message failed, %v", err)
+		return
+	}
+	ms.release(message.ByteSize())
+}
+
+// DeleteTopic removes all messages of the topic.
+func (ms *MessageStore) DeleteTopic(sid, topic string) {
+	ms.deletePrefix(encodeTopicPrefix(sid, topic))
+}
+
+// DeleteSession removes all messages of the session.
+func (ms *MessageStore) DeleteSession(sid string) {
+	ms.deletePrefix(encodeSessionPrefix(sid))
+}
+
+// ByteSize returns the total size of the persisted messages.
+func (ms *MessageStore) ByteSize() uint64 {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	return ms.byteSize
+}
+
+func (ms *MessageStore) Close() error {
+	return ms.db.Close()
+}
+
+func (ms *MessageStore) deletePrefix(prefix []byte) {
+	var byteSize uint64
+	err := ms.db.Update(func(tx *bolt.Tx) error {
+		// collect the keys first, deleting while iterating makes the cursor skip keys
+		var keys [][]byte
+		c := tx.Bucket(messageBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			keys = append(keys, bytes.Clone(k))
+			if message, ok := decodeMessageValue(v); ok {
+				byteSize += message.ByteSize()
+			}
+		}
+
+		bucket := tx.Bucket(messageBucket)
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		nlog.Warnf("Delete persisted messages failed, %v", err)
+		return
+	}
+	ms.release(byteSize)
+}
+
+func (ms *MessageStore) deleteKeys(keys [][]byte) error {
+	return ms.db.Batch(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(messageBucket)
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (ms *MessageStore) reserve(byteSize uint64) bool {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	if ms.byteSize+byteSize > ms.byteSizeLimit {
+		return false
+	}
+	ms.byteSize += byteSize
+	return true
+}
+
+func (ms *MessageStore) release(byteSize uint64) {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	if byteSize > ms.byteSize {
+		byteSize = ms.byteSize
+	}
+	ms.byteSize -= byteSize
+}
+
+// encodeSessionPrefix length-prefixes the session id, so a session id never matches the prefix of another one.
+func encodeSessionPrefix(sid string) []byte {
+	buf := binary.AppendUvarint(nil, uint64(len(sid)))
+	return append(buf, sid...)
+}
+
+func encodeTopicPrefix(sid, topic string) []byte {
+	buf := binary.AppendUvarint(encodeSessionPrefix(sid), uint64(len(topic)))
+	return append(buf, topic...)
+}
+
+func encodeMessageKey(sid, topic string, seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(encodeTopicPrefix(sid, topic), seq)
+}
+
+func decodeMessageKey(key []byte) (sid, topic string, ok bool) {
+	readString := func() (string, bool) {
+		l, n := binary.Uvarint(key)
+		if n <= 0 || uint64(len(key)-n) < l {
+			return "", false
+		}
+		s := string(key[n : n+int(l)])
+		key = key[n+int(l):]
+		return s, true
+	}
+
+	if sid, ok = readString(); !ok {
+		return "", "", false
+	}
+	if topic, ok = readString(); !ok {
+		return "", "", false
+	}
+	return sid, topic, len(key) == 8
+}
+
+func encodeMessageValue(message *Message) []byte {
+	value := make([]byte, storeValueTimeSize, storeValueTimeSize+binary.MaxVarintLen64+len(message.ID)+len(message.Content))
+	binary.BigEndian.PutUint64(value, uint64(message.enqueueTime.UnixNano()))
+	value = binary.AppendUvarint(value, uint64(len(message.ID)))
+	value = append(value, message.ID...)
+	return append(value, message.Content...)
+}
+
+// decodeMessageValue decodes the message from the value, the content refers to the value.
+func decodeMessageValue(value []byte) (*Message, bool) {
+	if len(value) < storeValueTimeSize {
+		return nil, false
+	}
+	enqueueTime := time.Unix(0, int64(binary.BigEndian.Uint64(value)))
+	value = value[storeValueTimeSize:]
+
+	l, n := binary.Uvarint(value)
+	if n <= 0 || uint64(len(value)-n) < l {
+		return nil, false
+	}
+	message := NewMessageWithID(value[n+int(l):], string(value[n:n+int(l)]))
+	message.enqueueTime = enqueueTime
+	return message, true
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msq
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPersistConfig(t *testing.T) *Config {
+	config := NewTestSessionManager().config
+	config.Persistence = &PersistenceConfig{
+		Path: filepath.Join(t.TempDir(), "msq.db"),
+	}
+	return config
+}
+
+func TestMessageKeyCodec(t *testing.T) {
+	t.Parallel()
+	key := encodeMessageKey("session1", "topic", 12)
+	sid, topic, ok := decodeMessageKey(key)
+	assert.True(t, ok)
+	assert.Equal(t, "session1", sid)
+	assert.Equal(t, "topic", topic)
+
+	// a session id must not match the prefix of a longer one
+	assert.False(t, bytes.HasPrefix(encodeMessageKey("session10", "topic", 12), encodeSessionPrefix("session1")))
+
+	_, _, ok = decodeMessageKey([]byte{0x10, 'a'})
+	assert.False(t, ok)
+}
+
+func TestSessionManagerRestore(t *testing.T) {
+	t.Parallel()
+	config := newTestPersistConfig(t)
+
+	sm := NewSessionManager(config)
+	assert.NoError(t, sm.Restore())
+	assert.Nil(t, sm.Push("session1", "topic1", NewMessageByStr("m1"), time.Millisecond*100))
+	assert.Nil(t, sm.Push("session1", "topic1", NewMessageWithID([]byte("m2"), "id2"), time.Millisecond*100))
+	assert.Nil(t, sm.Push("session1", "topic2", NewMessageByStr("m3"), time.Millisecond*100))
+	assert.Nil(t, sm.Push("session2", "topic1", NewMessageByStr("m4"), time.Millisecond*100))
+
+	msg, err := sm.Pop("session1", "topic1", time.Millisecond*100)
+	assert.Nil(t, err)
+	assert.Equal(t, "m1", string(msg.Content))
+	sm.ReleaseSession("session2")
+	assert.Equal(t, uint64(4), sm.store.ByteSize())
+	assert.NoError(t, sm.Close())
+
+	// restart, the messages not consumed are redelivered in order
+	sm = NewSessionManager(config)
+	assert.NoError(t, sm.Restore())
+	defer sm.Close()
+	assert.Equal(t, uint64(4), sm.memControl.totalByteSize)
+
+	// the producer's retry of a message pushed before the restart is dropped
+	assert.Nil(t, sm.Push("session1", "topic1", NewMessageWithID([]byte("m2"), "id2"), time.Millisecond*100))
+	assert.Equal(t, uint64(4), sm.memControl.totalByteSize)
+
+	msg, err = sm.Pop("session1", "topic1", time.Millisecond*100)
+	assert.Nil(t, err)
+	assert.Equal(t, "m2", string(msg.Content))
+	assert.Equal(t, "id2", msg.ID)
+	msg, err = sm.Peek("session1", "topic2")
+	assert.Nil(t, err)
+	assert.Equal(t, "m3", string(msg.Content))
+	msg, err = sm.Peek("session2", "topic1")
+	assert.Nil(t, err)
+	assert.Nil(t, msg)
+	assert.Equal(t, uint64(0), sm.store.ByteSize())
+}
+
+func TestSessionManagerRestoreExpired(t *testing.T) {
+	t.Parallel()
+	config := newTestPersistConfig(t)
+
+	sm := NewSessionManager(config)
+	assert.NoError(t, sm.Restore())
+	assert.Nil(t, sm.Push("session1", "topic", NewMessageByStr("m1"), time.Millisecond*100))
+	assert.NoError(t, sm.Close())
+
+	// the message is older than ttl when transport restarts
+	sm = NewSessionManager(config)
+	store, err := OpenMessageStore(config.Persistence)
+	assert.NoError(t, err)
+	store.ttl = 0
+	assert.NoError(t, store.Load(func(sid, topic string, message *Message) {
+		assert.Fail(t, "expired message should not be loaded")
+	}))
+	assert.Equal(t, uint64(0), store.ByteSize())
+	assert.NoError(t, store.Close())
+
+	assert.NoError(t, sm.Restore())
+	defer sm.Close()
+	msg, transErr := sm.Peek("session1", "topic")
+	assert.Nil(t, transErr)
+	assert.Nil(t, msg)
+}
+
+func TestSessionManagerPersistLimit(t *testing.T) {
+	t.Parallel()
+	config := newTestPersistConfig(t)
+	config.Persistence.MaxByteSize = 600
+
+	sm := NewSessionManager(config)
+	assert.NoError(t, sm.Restore())
+	defer sm.Close()
+
+	assert.Nil(t, sm.Push("session1", "topic", NewMessageByRandomStr(500), time.Millisecond*100))
+	// the store is full though the memory buffer still has room
+	assert.NotNil(t, sm.Push("session2", "topic", NewMessageByRandomStr(200), time.Millisecond*100))
+	assert.Equal(t, uint64(500), sm.memControl.totalByteSize)
+
+	sm.ReleaseTopic("session1", "topic")
+	assert.Equal(t, uint64(0), sm.store.ByteSize())
+	assert.Nil(t, sm.Push("session2", "topic", NewMessageByRandomStr(200), time.Millisecond*100))
+}
@@ -16,11 +16,14 @@ package msq
 
 import (
 	"container/heap"
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	"github.com/secretflow/kuscia/pkg/transport/metrics"
 	"github.com/secretflow/kuscia/pkg/transport/transerr"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 )
@@ -38,8 +41,12 @@ type SessionManager struct {
 	deadSessionIDs   *DeadSessionID
 	activeSessionIDs SessionIDPQ
 	memControl       *MemControl
+	// store is the disk-backed buffer, nil if persistence is not enabled
+	store *MessageStore
 }
 
+const monitorInterval = 10 * time.Second
+
 func NewSessionManager(config *Config) *SessionManager {
 	return &SessionManager{
 		RWMutex:        sync.RWMutex{},
@@ -65,21 +72,106 @@ func (s *SessionManager) StartCleanLoop(stopCh <-chan struct{}) {
 	go wait.Until(cleanFn, cleanInterval, stopCh)
 }
 
+// Restore opens the persistent store if it's configured and redelivers the persisted messages to
+// the session queues, it should be called before the server starts.
+func (s *SessionManager) Restore() error {
+	if !s.config.persistenceEnabled() {
+		return nil
+	}
+	if err := s.config.checkPersistence(); err != nil {
+		return err
+	}
+
+	store, err := OpenMessageStore(s.config.Persistence)
+	if err != nil {
+		return err
+	}
+
+	restored := 0
+	var dropped []*Message
+	err = store.Load(func(sid, topic string, message *Message) {
+		if s.restoreMessage(sid, topic, message) {
+			restored++
+		} else {
+			dropped = append(dropped, message)
+		}
+	})
+	if err != nil {
+		store.Close()
+		return fmt.Errorf("restore persisted messages failed, %v", err)
+	}
+
+	for _, message := range dropped {
+		store.Delete(message)
+	}
+	if len(dropped) > 0 {
+		nlog.Warnf("Drop %d persisted messages which can't fit the session queues", len(dropped))
+	}
+	nlog.Infof("Restored %d persisted messages from %s", restored, s.config.Persistence.Path)
+
+	s.store = store
+	return nil
+}
+
+// Close closes the persistent store, the messages left in it are redelivered on next Restore.
+func (s *SessionManager) Close() error {
+	if s.store == nil {
+		return nil
+	}
+	return s.store.Close()
+}
+
+// StartMonitorLoop redelivers the messages whose ack timed out, and reports the queue depth and the age
+// of the oldest buffered message periodically.
+func (s *SessionManager) StartMonitorLoop(stopCh <-chan struct{}) {
+	go wait.Until(func() {
+		s.redeliverExpired()
+		s.updateMetrics()
+	}, monitorInterval, stopCh)
+}
+
 func (s *SessionManager) Push(sid, topic string, message *Message, timeout time.Duration) *transerr.TransError {
 	sq, err := s.GetOrCreateSession(sid, false)
 	if err != nil {
 		return err
 	}
 
+	producerID := message.ID != ""
+	if !producerID {
+		message.ID = uuid.NewString()
+	} else if !sq.RecordMessageID(topic, message.ID) {
+		nlog.Infof("Drop duplicated message(%s) of session(%s) topic(%s)", message.ID, sid, topic)
+		return nil
+	}
+	// the producer retries the push with the same id if it fails, so it must not be taken as a duplicate
+	pushFailed := func() {
+		if producerID {
+			sq.ForgetMessageID(topic, message.ID)
+		}
+	}
+
 	ok, leftTime := s.memControl.Prefetch(message.ByteSize(), timeout)
 	if !ok {
 		nlog.Warnf("All session queue total buffer(len=%d) size can't fit message(len=%d)", s.memControl.totalByteSizeLimit, message.ByteSize())
+		pushFailed()
 		return transerr.NewTransError(transerr.BufferOverflow)
 	}
 
+	message.enqueueTime = time.Now()
+	if s.store != nil {
+		if storeErr := s.store.Save(sid, topic, message); storeErr != nil {
+			nlog.Warnf("Persist message(len=%d) of session(%s) topic(%s) failed, %v", message.ByteSize(), sid, topic, storeErr)
+			s.memControl.Release(message.ByteSize())
+			pushFailed()
+			return transerr.NewTransError(transerr.BufferOverflow)
+		}
+	}
+
 	err = sq.Push(topic, message, leftTime)
 	if err != nil {
 		s.memControl.Release(message.ByteSize())
+		s.deleteStoredMessage(message)
+		pushFailed()
 	}
 	return err
 }
@@ -93,6 +185,7 @@ func (s *SessionManager) Pop(sid, topic string, timeout time.Duration) (*Message
 	message, err := sq.Pop(topic, timeout)
 	if message != nil {
 		s.memControl.Release(message.ByteSize())
+		s.deleteStoredMessage(message)
 	}
 	return message, err
 }
@@ -106,10 +199,51 @@ func (s *SessionManager) Peek(sid, topic string) (*Message, *transerr.TransError
 	message, err := sq.Peek(topic)
 	if message != nil {
 		s.memControl.Release(message.ByteSize())
+		s.deleteStoredMessage(message)
 	}
 	return message, err
 }
 
+// PopForAck pops the message in manual ack mode, the message keeps its buffer until it's acknowledged by Ack,
+// and it's redelivered if the ack doesn't arrive in AckTimeoutSeconds.
+func (s *SessionManager) PopForAck(sid, topic string, timeout time.Duration) (*Message, *transerr.TransError) {
+	sq, err := s.GetOrCreateSession(sid, true)
+	if err != nil {
+		return nil, err
+	}
+	return sq.PopForAck(topic, timeout)
+}
+
+// PeekForAck is the non-blocking version of PopForAck.
+func (s *SessionManager) PeekForAck(sid, topic string) (*Message, *transerr.TransError) {
+	sq, err := s.GetOrCreateSession(sid, true)
+	if err != nil {
+		return nil, err
+	}
+	return sq.PeekForAck(topic)
+}
+
+// Ack acknowledges the message popped in manual ack mode and releases its buffer.
+func (s *SessionManager) Ack(sid, topic, id string) *transerr.TransError {
+	sq, err := s.GetSession(sid, true)
+	if err != nil {
+		return err
+	}
+	if sq == nil {
+		return transerr.NewTransError(transerr.NotFound)
+	}
+
+	message := sq.Ack(topic, id)
+	if message == nil {
+		nlog.Warnf("Ack unknown message(%s) of session(%s) topic(%s)", id, sid, topic)
+		return transerr.NewTransError(transerr.NotFound)
+	}
+
+	s.memControl.Release(message.ByteSize())
+	s.deleteStoredMessage(message)
+	return nil
+}
+
 func (s *SessionManager) ReleaseTopic(sid, topic string) {
 	sq, _ := s.GetSession(sid, false)
 	if sq == nil {
@@ -119,6 +253,9 @@ func (s *SessionManager) ReleaseTopic(sid, topic string) {
 	if topicByteSize := sq.ReleaseTopic(topic); topicByteSize > 0 {
 		s.memControl.Release(topicByteSize)
 	}
+	if s.store != nil {
+		s.store.DeleteTopic(sid, topic)
+	}
 }
 
 func (s *SessionManager) ReleaseSession(sid string) {
@@ -133,6 +270,9 @@ func (s *SessionManager) ReleaseSession(sid string) {
 	if sessionByteSize := sq.ReleaseSession(); sessionByteSize > 0 {
 		s.memControl.Release(sessionByteSize)
 	}
+	if s.store != nil {
+		s.store.DeleteSession(sid)
+	}
 }
 
 func (s *SessionManager) GetSession(sid string, refresh bool) (*SessionQueue, *transerr.TransError) {
@@ -164,7 +304,7 @@ func (s *SessionManager) GetOrCreateSession(sid string, refresh bool) (*SessionQ
 func (s *SessionManager) cleanInactiveSession() {
 	currentTimestamp := s.normalizedNowTimestamp()
 	expireDuration := s.config.SessionExpireSeconds / s.config.NormalizeActiveSeconds
-	inactiveQueues := make([]*SessionQueue, 0)
+	inactiveQueues := make(map[string]*SessionQueue)
 
 	s.Lock()
 	count := min(s.activeSessionIDs.Len(), cleanStep)
@@ -179,16 +319,92 @@ func (s *SessionManager) cleanInactiveSession() {
 		// if a session has been released before, the session here maybe nil
 		if session != nil {
 			s.deadSessionIDs.Push(item.sid)
-			inactiveQueues = append(inactiveQueues, session.Queue)
+			inactiveQueues[item.sid] = session.Queue
 		}
 		delete(s.sessions, item.sid)
 	}
 	s.Unlock()
 
-	for _, sq := range inactiveQueues {
+	for sid, sq := range inactiveQueues {
 		if sessionByteSize := sq.ReleaseSession(); sessionByteSize > 0 {
 			s.memControl.Release(sessionByteSize)
 		}
+		if s.store != nil {
+			s.store.DeleteSession(sid)
+		}
+	}
+}
+
+// restoreMessage puts a persisted message back to its session queue without waiting, returns false
+// if the buffer can't fit it.
+func (s *SessionManager) restoreMessage(sid, topic string, message *Message) bool {
+	sq, err := s.GetOrCreateSession(sid, false)
+	if err != nil {
+		return false
+	}
+
+	// remember the id, so the producer's retry of a message pushed before the restart is still dropped
+	sq.RecordMessageID(topic, message.ID)
+	if !s.memControl.TryAcquire(message.ByteSize()) {
+		return false
+	}
+	if err = sq.Push(topic, message, 0); err != nil {
+		s.memControl.Release(message.ByteSize())
+		return false
+	}
+	return true
+}
+
+func (s *SessionManager) deleteStoredMessage(message *Message) {
+	if s.store != nil {
+		s.store.Delete(message)
+	}
+}
+
+func (s *SessionManager) redeliverExpired() {
+	count := 0
+	for _, sq := range s.sessionQueues() {
+		count += sq.RedeliverExpired()
+	}
+	if count > 0 {
+		metrics.RedeliveredMessages.Add(float64(count))
+	}
+}
+
+func (s *SessionManager) sessionQueues() []*SessionQueue {
+	s.RLock()
+	defer s.RUnlock()
+	queues := make([]*SessionQueue, 0, len(s.sessions))
+	for _, session := range s.sessions {
+		queues = append(queues, session.Queue)
+	}
+	return queues
+}
+
+func (s *SessionManager) updateMetrics() {
+	queues := s.sessionQueues()
+
+	depth := 0
+	var oldest time.Time
+	for _, sq := range queues {
+		count, queueOldest := sq.Stats()
+		depth += count
+		if !queueOldest.IsZero() && (oldest.IsZero() || queueOldest.Before(oldest)) {
+			oldest = queueOldest
+		}
+	}
+
+	metrics.QueueDepth.Set(float64(depth))
+	s.memControl.Lock()
+	metrics.QueueByteSize.Set(float64(s.memControl.totalByteSize))
+	s.memControl.Unlock()
+	if oldest.IsZero() {
+		metrics.OldestMessageAge.Set(0)
+	} else {
+		metrics.OldestMessageAge.Set(time.Since(oldest).Seconds())
+	}
+	if s.store != nil {
+		metrics.PersistedByteSize.Set(float64(s.store.ByteSize()))
 	}
 }
 
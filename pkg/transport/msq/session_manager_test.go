@@ -293,3 +293,36 @@ func TestSessionReleasedDuringWait(t *testing.T) {
 	assert.True(t, processTime > time.Second && processTime < time.Second*2)
 	assert.NotNil(t, err)
 }
+
+func TestSessionManagerDedupAndAck(t *testing.T) {
+	t.Parallel()
+	sm := NewTestSessionManager()
+
+	assert.Nil(t, sm.Push("session1", "topic", NewMessageWithID([]byte("m1"), "id1"), time.Millisecond*100))
+	// the retried push is dropped
+	assert.Nil(t, sm.Push("session1", "topic", NewMessageWithID([]byte("m1"), "id1"), time.Millisecond*100))
+	assert.Equal(t, uint64(2), sm.memControl.totalByteSize)
+
+	// a failed push doesn't stop the retry
+	assert.NotNil(t, sm.Push("session1", "topic", NewMessageWithID(NewMessageByRandomStr(520).Content, "id2"), time.Millisecond*100))
+	assert.Nil(t, sm.Push("session1", "topic", NewMessageWithID([]byte("m2"), "id2"), time.Millisecond*100))
+
+	// the id is generated if the producer doesn't set it
+	msg := NewMessageByStr("m3")
+	assert.Nil(t, sm.Push("session1", "topic2", msg, time.Millisecond*100))
+	assert.NotEmpty(t, msg.ID)
+
+	msg, err := sm.PopForAck("session1", "topic", time.Millisecond*100)
+	assert.Nil(t, err)
+	assert.Equal(t, "id1", msg.ID)
+	assert.Equal(t, uint64(6), sm.memControl.totalByteSize)
+
+	assert.Nil(t, sm.Ack("session1", "topic", "id1"))
+	assert.Equal(t, uint64(4), sm.memControl.totalByteSize)
+	assert.NotNil(t, sm.Ack("session1", "topic", "id1"))
+	assert.NotNil(t, sm.Ack("session2", "topic", "id1"))
+
+	msg, err = sm.Pop("session1", "topic", time.Millisecond*100)
+	assert.Nil(t, err)
+	assert.Equal(t, "id2", msg.ID)
+}
@@ -15,6 +15,7 @@
 package msq
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -36,6 +37,25 @@ type SessionQueue struct {
 	notEmpty *condchan.CondChan
 
 	topics map[string]*Topic
+
+	ackTimeout time.Duration
+	// inflight holds the messages popped in manual ack mode until they're acknowledged or redelivered
+	inflight map[string]*inflightMessage
+
+	dedupWindow time.Duration
+	seenIDs     map[string]time.Time
+	// seenOrder is ordered by expire time since the dedup window is the same for all ids
+	seenOrder []string
+}
+
+type inflightMessage struct {
+	topic    string
+	message  *Message
+	deadline time.Time
+}
+
+func messageKey(topic, id string) string {
+	return topic + "\x00" + id
 }
 
 func NewSessionQueue(config *Config) *SessionQueue {
@@ -46,6 +66,10 @@ func NewSessionQueue(config *Config) *SessionQueue {
 		released:           false,
 		mtx:                sync.Mutex{},
 		topics:             make(map[string]*Topic),
+		ackTimeout:         secondsOrDefault(config.AckTimeoutSeconds, defaultAckTimeoutSeconds),
+		inflight:           make(map[string]*inflightMessage),
+		dedupWindow:        secondsOrDefault(config.DedupWindowSeconds, defaultDedupWindowSeconds),
+		seenIDs:            make(map[string]time.Time),
 	}
 
 	sq.notEmpty = condchan.New(&sq.mtx)
@@ -63,7 +87,16 @@ func (s *SessionQueue) Push(topic string, message *Message, timeout time.Duratio
 }
 
 func (s *SessionQueue) Pop(topic string, timeout time.Duration) (*Message, *transerr.TransError) {
-	message, err := s.tryPop(topic, timeout)
+	return s.pop(topic, timeout, false)
+}
+
+// PopForAck pops the message in manual ack mode, the message is redelivered if it's not acknowledged in time.
+func (s *SessionQueue) PopForAck(topic string, timeout time.Duration) (*Message, *transerr.TransError) {
+	return s.pop(topic, timeout, true)
+}
+
+func (s *SessionQueue) pop(topic string, timeout time.Duration, manualAck bool) (*Message, *transerr.TransError) {
+	message, err := s.tryPop(topic, timeout, manualAck)
 	if err != nil || message == nil {
 		return message, err
 	}
@@ -73,6 +106,15 @@ func (s *SessionQueue) Pop(topic string, timeout time.Duration) (*Message, *tran
 }
 
 func (s *SessionQueue) Peek(topic string) (*Message, *transerr.TransError) {
+	return s.peek(topic, false)
+}
+
+// PeekForAck peeks the message in manual ack mode, the message is redelivered if it's not acknowledged in time.
+func (s *SessionQueue) PeekForAck(topic string) (*Message, *transerr.TransError) {
+	return s.peek(topic, true)
+}
+
+func (s *SessionQueue) peek(topic string, manualAck bool) (*Message, *transerr.TransError) {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
@@ -81,7 +123,7 @@ func (s *SessionQueue) Peek(topic string) (*Message, *transerr.TransError) {
 	}
 
 	if s.availableToPop(topic) {
-		message := s.innerPop(topic)
+		message := s.deliver(topic, manualAck)
 		if message != nil {
 			s.notFull.Signal()
 			return message, nil
@@ -102,11 +144,18 @@ func (s *SessionQueue) ReleaseTopic(topic string) uint64 {
 		return 0
 	}
 
-	s.ByteSize -= topicQueue.ByteSize
+	byteSize := topicQueue.ByteSize
+	for key, inflight := range s.inflight {
+		if inflight.topic == topic {
+			byteSize += inflight.message.ByteSize()
+			delete(s.inflight, key)
+		}
+	}
+	s.ByteSize -= byteSize
 	delete(s.topics, topic)
 
 	s.notFull.Signal()
-	return topicQueue.ByteSize
+	return byteSize
 }
 
 func (s *SessionQueue) ReleaseSession() uint64 {
@@ -115,6 +164,7 @@ func (s *SessionQueue) ReleaseSession() uint64 {
 
 	s.released = true
 	s.topics = nil
+	s.inflight = nil
 	byteSize := s.ByteSize
 	s.ByteSize = 0
 
@@ -123,6 +173,93 @@ func (s *SessionQueue) ReleaseSession() uint64 {
 	return byteSize
 }
 
+// Ack acknowledges the message popped in manual ack mode, returns nil if the message is not found.
+func (s *SessionQueue) Ack(topic, id string) *Message {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.released {
+		return nil
+	}
+
+	var message *Message
+	key := messageKey(topic, id)
+	if inflight, ok := s.inflight[key]; ok {
+		delete(s.inflight, key)
+		message = inflight.message
+		s.ByteSize -= message.ByteSize()
+	} else if topicQueue, ok := s.topics[topic]; ok {
+		// the ack arrives after the message has been put back for redelivery
+		if message = topicQueue.Remove(id); message != nil {
+			s.ByteSize -= message.ByteSize()
+		}
+	}
+
+	if message != nil {
+		s.notFull.Signal()
+	}
+	return message
+}
+
+// RedeliverExpired puts the unacknowledged messages whose ack deadline passed back to the head of their topics.
+func (s *SessionQueue) RedeliverExpired() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	count := s.redeliverExpired("")
+	if count > 0 {
+		s.notEmpty.Broadcast()
+	}
+	return count
+}
+
+// RecordMessageID remembers the id in the dedup window, returns false if the id is already there.
+func (s *SessionQueue) RecordMessageID(topic, id string) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := time.Now()
+	for len(s.seenOrder) > 0 {
+		key := s.seenOrder[0]
+		if expire, ok := s.seenIDs[key]; ok && expire.After(now) {
+			break
+		}
+		delete(s.seenIDs, key)
+		s.seenOrder = s.seenOrder[1:]
+	}
+
+	key := messageKey(topic, id)
+	if _, ok := s.seenIDs[key]; ok {
+		return false
+	}
+	s.seenIDs[key] = now.Add(s.dedupWindow)
+	s.seenOrder = append(s.seenOrder, key)
+	return true
+}
+
+// ForgetMessageID removes the id from the dedup window, so the producer is able to retry a failed push.
+func (s *SessionQueue) ForgetMessageID(topic, id string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// the key left in seenOrder is skipped when it's cleaned
+	delete(s.seenIDs, messageKey(topic, id))
+}
+
+// Stats returns the number of buffered messages and the enqueue time of the oldest one.
+func (s *SessionQueue) Stats() (count int, oldest time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, topicQueue := range s.topics {
+		count += topicQueue.Len()
+		if front := topicQueue.Front(); front != nil && (oldest.IsZero() || front.enqueueTime.Before(oldest)) {
+			oldest = front.enqueueTime
+		}
+	}
+	return count, oldest
+}
+
 // getTopic not thread safe
 func (s *SessionQueue) getTopic(topic string) *Topic {
 	topicQueue, exists := s.topics[topic]
@@ -199,8 +336,9 @@ func (s *SessionQueue) tryPush(topic string, message *Message, timeout time.Dura
 	return nil
 }
 
-func (s *SessionQueue) tryPop(topic string, timeout time.Duration) (*Message, *transerr.TransError) {
+func (s *SessionQueue) tryPop(topic string, timeout time.Duration, manualAck bool) (*Message, *transerr.TransError) {
 	checkFn := func() bool {
+		s.redeliverExpired(topic)
 		return s.availableToPop(topic)
 	}
 
@@ -211,7 +349,7 @@ func (s *SessionQueue) tryPop(topic string, timeout time.Duration) (*Message, *t
 		return nil, err
 	}
 
-	return s.innerPop(topic), nil
+	return s.deliver(topic, manualAck), nil
 }
 
 func (s *SessionQueue) availableToPush(message *Message) bool {
@@ -229,6 +367,51 @@ func (s *SessionQueue) innerPush(topic string, message *Message) {
 	s.ByteSize += message.ByteSize()
 }
 
+// deliver pops the message and holds it in inflight until it's acknowledged if manualAck is set, the
+// message still takes the buffer of the session while it's in flight. not thread safe
+func (s *SessionQueue) deliver(topic string, manualAck bool) *Message {
+	message := s.innerPop(topic)
+	if message == nil {
+		return nil
+	}
+
+	message.deliveryCount++
+	if manualAck {
+		s.inflight[messageKey(topic, message.ID)] = &inflightMessage{
+			topic:    topic,
+			message:  message,
+			deadline: time.Now().Add(s.ackTimeout),
+		}
+		s.ByteSize += message.ByteSize()
+	}
+	return message
+}
+
+// redeliverExpired puts the expired inflight messages of the topic back, all topics if topic is empty.
+// not thread safe
+func (s *SessionQueue) redeliverExpired(topic string) int {
+	now := time.Now()
+	var expired []*inflightMessage
+	for key, inflight := range s.inflight {
+		if (topic != "" && inflight.topic != topic) || inflight.deadline.After(now) {
+			continue
+		}
+		delete(s.inflight, key)
+		expired = append(expired, inflight)
+	}
+
+	// push the latest delivered message first, so the messages keep the order they were delivered in
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].deadline.After(expired[j].deadline)
+	})
+	for _, inflight := range expired {
+		// the message is still counted in the byte size of the session, only the topic needs to count it
+		s.getTopic(inflight.topic).PushFront(inflight.message)
+		nlog.Infof("Redeliver unacknowledged message(%s) of topic(%s)", inflight.message.ID, inflight.topic)
+	}
+	return len(expired)
+}
+
 func (s *SessionQueue) innerPop(topic string) *Message {
 	topicQueue := s.getTopic(topic)
 	message := topicQueue.Pop()
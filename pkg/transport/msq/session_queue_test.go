@@ -236,3 +236,99 @@ func TestSessionQueueReleaseTopic(t *testing.T) {
 	msg, _ := sq.Peek("topic1")
 	assert.Nil(t, msg)
 }
+
+func TestSessionQueueAckAndRedeliver(t *testing.T) {
+	t.Parallel()
+	sq := NewTestSessionQueue()
+	sq.ackTimeout = time.Millisecond * 100
+
+	assert.Nil(t, sq.Push("topic", NewMessageWithID([]byte("m1"), "id1"), time.Second))
+	assert.Nil(t, sq.Push("topic", NewMessageWithID([]byte("m2"), "id2"), time.Second))
+
+	msg, err := sq.PopForAck("topic", time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, "id1", msg.ID)
+	assert.Equal(t, 1, msg.DeliveryCount())
+	msg, err = sq.PeekForAck("topic")
+	assert.Nil(t, err)
+	assert.Equal(t, "id2", msg.ID)
+	// the inflight messages still take the buffer
+	assert.Equal(t, uint64(4), sq.ByteSize)
+
+	assert.NotNil(t, sq.Ack("topic", "id2"))
+	assert.Nil(t, sq.Ack("topic", "id2"))
+	assert.Equal(t, uint64(2), sq.ByteSize)
+
+	// id1 is not acknowledged in time, so it's delivered again
+	time.Sleep(time.Millisecond * 150)
+	msg, err = sq.PopForAck("topic", time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, "id1", msg.ID)
+	assert.Equal(t, 2, msg.DeliveryCount())
+	assert.NotNil(t, sq.Ack("topic", "id1"))
+	assert.Equal(t, uint64(0), sq.ByteSize)
+}
+
+func TestSessionQueueRedeliverOrder(t *testing.T) {
+	t.Parallel()
+	sq := NewTestSessionQueue()
+	sq.ackTimeout = time.Millisecond * 10
+
+	for _, id := range []string{"id1", "id2", "id3"} {
+		assert.Nil(t, sq.Push("topic", NewMessageWithID([]byte(id), id), time.Second))
+		_, err := sq.PeekForAck("topic")
+		assert.Nil(t, err)
+		time.Sleep(time.Millisecond)
+	}
+
+	time.Sleep(time.Millisecond * 20)
+	assert.Equal(t, 3, sq.RedeliverExpired())
+	for _, id := range []string{"id1", "id2", "id3"} {
+		msg, err := sq.Pop("topic", time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, id, msg.ID)
+	}
+
+	// the ack arrives after the message has been put back
+	assert.Nil(t, sq.Push("topic", NewMessageWithID([]byte("m4"), "id4"), time.Second))
+	_, err := sq.PeekForAck("topic")
+	assert.Nil(t, err)
+	time.Sleep(time.Millisecond * 20)
+	assert.Equal(t, 1, sq.RedeliverExpired())
+	assert.NotNil(t, sq.Ack("topic", "id4"))
+	msg, err := sq.Peek("topic")
+	assert.Nil(t, err)
+	assert.Nil(t, msg)
+}
+
+func TestSessionQueueReleaseInflightTopic(t *testing.T) {
+	t.Parallel()
+	sq := NewTestSessionQueue()
+
+	assert.Nil(t, sq.Push("topic", NewMessageWithID([]byte("m1"), "id1"), time.Second))
+	assert.Nil(t, sq.Push("topic", NewMessageWithID([]byte("m2"), "id2"), time.Second))
+	_, err := sq.PeekForAck("topic")
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint64(4), sq.ReleaseTopic("topic"))
+	assert.Equal(t, uint64(0), sq.ByteSize)
+	assert.Nil(t, sq.Ack("topic", "id1"))
+}
+
+func TestSessionQueueMessageIDDedup(t *testing.T) {
+	t.Parallel()
+	sq := NewTestSessionQueue()
+	sq.dedupWindow = time.Millisecond * 50
+
+	assert.True(t, sq.RecordMessageID("topic", "id1"))
+	assert.False(t, sq.RecordMessageID("topic", "id1"))
+	assert.True(t, sq.RecordMessageID("topic2", "id1"))
+
+	sq.ForgetMessageID("topic", "id1")
+	assert.True(t, sq.RecordMessageID("topic", "id1"))
+
+	time.Sleep(time.Millisecond * 60)
+	assert.True(t, sq.RecordMessageID("topic", "id2"))
+	assert.Equal(t, 1, len(sq.seenIDs))
+	assert.True(t, sq.RecordMessageID("topic", "id1"))
+}
@@ -14,8 +14,19 @@
 
 package msq
 
+import "time"
+
 type Message struct {
 	Content []byte
+	// ID identifies the message in its topic, a push whose id is in the dedup window is dropped.
+	ID string
+
+	// deliveryCount is how many times the message has been handed out to consumers.
+	deliveryCount int
+	// enqueueTime is when the message entered the buffer, it's kept across restarts for persisted messages.
+	enqueueTime time.Time
+	// storeKey is the key of the message in the persistent store, nil if it's not persisted.
+	storeKey []byte
 }
 
 type Topic struct {
@@ -29,6 +40,13 @@ func NewMessage(msg []byte) *Message {
 	}
 }
 
+func NewMessageWithID(msg []byte, id string) *Message {
+	return &Message{
+		Content: msg,
+		ID:      id,
+	}
+}
+
 func NewTopicQueue(topic string, topicQueueCapacity int) *Topic {
 	return &Topic{
 		ByteSize: 0,
@@ -54,10 +72,41 @@ func (t *Topic) Pop() *Message {
 	return message
 }
 
+// PushFront puts the message back to the head of the topic, it's used to redeliver unacknowledged messages.
+func (t *Topic) PushFront(message *Message) {
+	t.ByteSize += message.ByteSize()
+	t.queue = append([]*Message{message}, t.queue...)
+}
+
+// Remove removes the message with the id from the topic.
+func (t *Topic) Remove(id string) *Message {
+	for i, message := range t.queue {
+		if message.ID == id {
+			t.queue = append(t.queue[:i], t.queue[i+1:]...)
+			t.ByteSize -= message.ByteSize()
+			return message
+		}
+	}
+	return nil
+}
+
+// Front returns the oldest message of the topic without removing it.
+func (t *Topic) Front() *Message {
+	if len(t.queue) == 0 {
+		return nil
+	}
+	return t.queue[0]
+}
+
 func (t *Topic) Len() int {
 	return len(t.queue)
 }
 
+// DeliveryCount returns how many times the message has been handed out, it's greater than 1 for redelivered messages.
+func (m *Message) DeliveryCount() int {
+	return m.deliveryCount
+}
+
 func (m *Message) ByteSize() uint64 {
 	return uint64(len(m.Content))
 }
@@ -79,6 +79,11 @@ func (s *Server) Start(ctx context.Context) error {
 
 	pb.RegisterPrivateTransferProtocolServer(gs, s)
 	pb.RegisterPrivateTransferTransportServer(gs, s)
+
+	go func() {
+		<-ctx.Done()
+		gs.Stop()
+	}()
 	err = gs.Serve(limitedListener)
 	if err != nil {
 		return err
@@ -91,7 +96,7 @@ func (s *Server) Invoke(ctx context.Context, inbound *pb.Inbound) (*pb.Outbound,
 	if err != nil {
 		return codec.BuildInvokeOutboundByErr(err), nil
 	}
-	message, err := s.readMessage(inbound)
+	message, err := s.readMessage(ctx, inbound)
 	if err != nil {
 		return codec.BuildInvokeOutboundByErr(err), nil
 	}
@@ -105,12 +110,17 @@ func (s *Server) Pop(ctx context.Context, inbound *pb.PopInbound) (*pb.Transport
 		return codec.BuildTransportOutboundByErr(err), nil
 	}
 
-	msg, err := s.sm.Pop(params.sid, params.topic, getTimeout(ctx, inbound))
+	var msg *msq.Message
+	if isManualAck(ctx) {
+		msg, err = s.sm.PopForAck(params.sid, params.topic, getTimeout(ctx, inbound))
+	} else {
+		msg, err = s.sm.Pop(params.sid, params.topic, getTimeout(ctx, inbound))
+	}
 	if err != nil || msg == nil {
 		return codec.BuildTransportOutboundByErr(err), nil
 	}
 
-	return codec.BuildTransportOutboundByPayload(msg.Content), nil
+	return buildMessageOutbound(msg), nil
 }
 
 func (s *Server) Peek(ctx context.Context, inbound *pb.PeekInbound) (*pb.TransportOutbound, error) {
@@ -119,14 +129,21 @@ func (s *Server) Peek(ctx context.Context, inbound *pb.PeekInbound) (*pb.Transpo
 		return codec.BuildTransportOutboundByErr(err), nil
 	}
 
-	msg, err := s.sm.Peek(params.sid, params.topic)
+	var msg *msq.Message
+	if isManualAck(ctx) {
+		msg, err = s.sm.PeekForAck(params.sid, params.topic)
+	} else {
+		msg, err = s.sm.Peek(params.sid, params.topic)
+	}
 	if err != nil || msg == nil {
 		return codec.BuildTransportOutboundByErr(err), nil
 	}
 
-	return codec.BuildTransportOutboundByPayload(msg.Content), nil
+	return buildMessageOutbound(msg), nil
 }
 
+// Release acknowledges the message if x-ptp-message-id is set, otherwise releases the topic or the session.
+
 func (s *Server) Release(ctx context.Context, inbound *pb.ReleaseInbound) (*pb.TransportOutbound, error) {
 	sid, ok := getParamFromCtx(ctx, codec.PtpSessionID)
 	if !ok || len(sid) == 0 {
@@ -144,6 +161,9 @@ func (s *Server) Release(ctx context.Context, inbound *pb.ReleaseInbound) (*pb.T
 		}
 
 		fullTopic := fmt.Sprintf("%s-%s", topicPrefix, topic)
+		if id, _ := getParamFromCtx(ctx, codec.PtpMessageID); len(id) != 0 {
+			return codec.BuildTransportOutboundByErr(s.sm.Ack(sid, fullTopic, id)), nil
+		}
 		s.sm.ReleaseTopic(sid, fullTopic)
 		return codec.BuildTransportOutboundByErr(nil), nil
 	}
@@ -151,12 +171,31 @@ func (s *Server) Release(ctx context.Context, inbound *pb.ReleaseInbound) (*pb.T
 	return codec.BuildTransportOutboundByErr(nil), nil
 }
 
-func (s *Server) readMessage(inbound *pb.Inbound) (*msq.Message, *transerr.TransError) {
+func (s *Server) readMessage(ctx context.Context, inbound *pb.Inbound) (*msq.Message, *transerr.TransError) {
 	if int64(len(inbound.GetPayload())) == 0 {
 		nlog.Warnf("Empty request body")
 		return nil, transerr.NewTransError(transerr.InvalidRequest)
 	}
-	return msq.NewMessage(inbound.GetPayload()), nil
+
+	id := inbound.GetMetadata()[codec.PtpMessageID]
+	if len(id) == 0 {
+		id, _ = getParamFromCtx(ctx, codec.PtpMessageID)
+	}
+	return msq.NewMessageWithID(inbound.GetPayload(), id), nil
+}
+
+func isManualAck(ctx context.Context) bool {
+	mode, _ := getParamFromCtx(ctx, codec.PtpAckMode)
+	return mode == codec.AckModeManual
+}
+
+func buildMessageOutbound(msg *msq.Message) *pb.TransportOutbound {
+	outbound := codec.BuildTransportOutboundByPayload(msg.Content)
+	outbound.Metadata = map[string]string{
+		codec.PtpMessageID:     msg.ID,
+		codec.PtpDeliveryCount: strconv.Itoa(msg.DeliveryCount()),
+	}
+	return outbound
 }
 
 func getTimeout(ctx context.Context, inbound interface{}) time.Duration {
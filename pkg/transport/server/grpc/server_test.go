@@ -503,3 +503,154 @@ func TestLoadOverrideGrpcTransConfig(t *testing.T) {
 
 	assert.Equal(t, string(popOut.Payload), "123456789")
 }
+
+func TestPopForAck(t *testing.T) {
+	md := metadata.New(map[string]string{
+		codec.PtpSessionID:    "session10",
+		codec.PtpSourceNodeID: "node0",
+		codec.PtpTopicID:      "topic1",
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	invokeInbound := &pb.Inbound{
+		Metadata: map[string]string{codec.PtpMessageID: "msg1"},
+		Payload:  NewStr("123456789"),
+	}
+	verifyInvokeResponse(t, ctx, invokeInbound, transerr.Success)
+	// the retried push is dropped
+	verifyInvokeResponse(t, ctx, invokeInbound, transerr.Success)
+
+	popMd := metadata.New(map[string]string{
+		codec.PtpSessionID:    "session10",
+		codec.PtpTargetNodeID: "node0",
+		codec.PtpAckMode:      codec.AckModeManual,
+	})
+	popCtx := metadata.NewOutgoingContext(context.Background(), popMd)
+	outbound := verifyPopResponse(t, popCtx, &pb.PopInbound{Topic: "topic1"}, transerr.Success)
+	assert.Equal(t, "123456789", string(outbound.GetPayload()))
+	assert.Equal(t, "msg1", outbound.GetMetadata()[codec.PtpMessageID])
+	assert.Equal(t, "1", outbound.GetMetadata()[codec.PtpDeliveryCount])
+
+	ackMd := metadata.New(map[string]string{
+		codec.PtpSessionID:    "session10",
+		codec.PtpTargetNodeID: "node0",
+		codec.PtpMessageID:    "msg1",
+	})
+	ackCtx := metadata.NewOutgoingContext(context.Background(), ackMd)
+	verifyReleaseResponse(t, ackCtx, &pb.ReleaseInbound{Topic: "topic1"}, transerr.Success)
+	verifyReleaseResponse(t, ackCtx, &pb.ReleaseInbound{Topic: "topic1"}, transerr.NotFound)
+
+	outbound = verifyPeekResponse(t, popCtx, &pb.PeekInbound{Topic: "topic1"}, transerr.Success)
+	assert.Empty(t, outbound.GetPayload())
+}
+
+func TestTransportStream(t *testing.T) {
+	dial, err := grpc.Dial(testServer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer dial.Close()
+
+	md := metadata.New(map[string]string{
+		codec.PtpSessionID:    "session12",
+		codec.PtpSourceNodeID: "node0",
+		codec.PtpTargetNodeID: "node0",
+	})
+	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(context.Background(), md))
+	defer cancel()
+	stream, err := pb.NewPrivateTransferProtocolClient(dial).Transport(ctx)
+	assert.NoError(t, err)
+
+	// subscribe with one credit, the messages are pushed on arrival
+	assert.NoError(t, stream.Send(&pb.Inbound{Metadata: map[string]string{
+		codec.PtpStreamOp:      codec.StreamOpSubscribe,
+		codec.PtpTopicID:       "topic1",
+		codec.PtpAckMode:       codec.AckModeManual,
+		codec.PtpStreamCredits: "1",
+	}}))
+	for _, id := range []string{"msg1", "msg2"} {
+		assert.NoError(t, stream.Send(&pb.Inbound{
+			Metadata: map[string]string{
+				codec.PtpStreamOp:  codec.StreamOpPush,
+				codec.PtpTopicID:   "topic1",
+				codec.PtpMessageID: id,
+			},
+			Payload: NewStr(id),
+		}))
+	}
+
+	var messages []*pb.Outbound
+	for i := 0; i < 3; i++ {
+		out, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, string(transerr.Success), out.Code)
+		if out.Metadata[codec.PtpStreamOp] == codec.StreamOpMessage {
+			messages = append(messages, out)
+		}
+	}
+	assert.Equal(t, 1, len(messages))
+	assert.Equal(t, "msg1", string(messages[0].Payload))
+	assert.Equal(t, "msg1", messages[0].Metadata[codec.PtpMessageID])
+	assert.Equal(t, "topic1", messages[0].Metadata[codec.PtpTopicID])
+
+	// msg2 waits for the credit
+	time.Sleep(time.Millisecond * 100)
+	assert.NoError(t, stream.Send(&pb.Inbound{Metadata: map[string]string{
+		codec.PtpStreamOp:  codec.StreamOpAck,
+		codec.PtpTopicID:   "topic1",
+		codec.PtpMessageID: "msg1",
+	}}))
+	out, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, codec.StreamOpAck, out.Metadata[codec.PtpStreamOp])
+	assert.Equal(t, string(transerr.Success), out.Code)
+
+	assert.NoError(t, stream.Send(&pb.Inbound{Metadata: map[string]string{
+		codec.PtpStreamOp:      codec.StreamOpCredit,
+		codec.PtpTopicID:       "topic1",
+		codec.PtpStreamCredits: "1",
+	}}))
+	out, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, codec.StreamOpMessage, out.Metadata[codec.PtpStreamOp])
+	assert.Equal(t, "msg2", string(out.Payload))
+
+	// invalid op
+	assert.NoError(t, stream.Send(&pb.Inbound{Metadata: map[string]string{codec.PtpStreamOp: "unknown"}}))
+	out, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, string(transerr.InvalidRequest), out.Code)
+
+	assert.NoError(t, stream.CloseSend())
+}
+
+func TestTransportStreamCompatibleWithPolling(t *testing.T) {
+	dial, err := grpc.Dial(testServer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer dial.Close()
+
+	md := metadata.New(map[string]string{
+		codec.PtpSessionID:    "session13",
+		codec.PtpSourceNodeID: "node0",
+	})
+	stream, err := pb.NewPrivateTransferProtocolClient(dial).Transport(metadata.NewOutgoingContext(context.Background(), md))
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.Inbound{
+		Metadata: map[string]string{
+			codec.PtpStreamOp: codec.StreamOpPush,
+			codec.PtpTopicID:  "topic1",
+		},
+		Payload: NewStr("123456789"),
+	}))
+	out, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, string(transerr.Success), out.Code)
+	assert.NotEmpty(t, out.Metadata[codec.PtpStreamOp])
+	assert.NoError(t, stream.CloseSend())
+
+	// the message pushed by the stream is popped by the polling client
+	popMd := metadata.New(map[string]string{
+		codec.PtpSessionID:    "session13",
+		codec.PtpTargetNodeID: "node0",
+	})
+	popCtx := metadata.NewOutgoingContext(context.Background(), popMd)
+	outbound := verifyPopResponse(t, popCtx, &pb.PopInbound{Topic: "topic1"}, transerr.Success)
+	assert.Equal(t, "123456789", string(outbound.GetPayload()))
+}
@@ -0,0 +1,345 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/secretflow/kuscia/pkg/transport/codec"
+	"github.com/secretflow/kuscia/pkg/transport/msq"
+	pb "github.com/secretflow/kuscia/pkg/transport/proto/mesh"
+	"github.com/secretflow/kuscia/pkg/transport/transerr"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+// streamPopTimeout bounds how long a subscription waits for a message before it checks the stream again.
+const streamPopTimeout = time.Second
+
+// streamSubscription delivers the messages of a topic to the stream as soon as they arrive, the credits
+// granted by the subscriber limit how many messages are sent ahead of its processing.
+type streamSubscription struct {
+	topic     string
+	fullTopic string
+	manualAck bool
+
+	mtx       sync.Mutex
+	unlimited bool
+	credits   int64
+	notify    chan struct{}
+}
+
+func newStreamSubscription(topic, fullTopic string, manualAck bool) *streamSubscription {
+	return &streamSubscription{
+		topic:     topic,
+		fullTopic: fullTopic,
+		manualAck: manualAck,
+		unlimited: true,
+		notify:    make(chan struct{}, 1),
+	}
+}
+
+func (sub *streamSubscription) setCredits(credits int64) {
+	sub.mtx.Lock()
+	sub.unlimited = false
+	sub.credits = credits
+	sub.mtx.Unlock()
+}
+
+func (sub *streamSubscription) addCredits(credits int64) {
+	sub.mtx.Lock()
+	sub.unlimited = false
+	sub.credits += credits
+	sub.mtx.Unlock()
+
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
+
+// acquire waits until the subscriber has credit, returns false if the stream is closed.
+func (sub *streamSubscription) acquire(ctx context.Context) bool {
+	for {
+		sub.mtx.Lock()
+		if sub.unlimited || sub.credits > 0 {
+			if !sub.unlimited {
+				sub.credits--
+			}
+			sub.mtx.Unlock()
+			return true
+		}
+		sub.mtx.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-sub.notify:
+		}
+	}
+}
+
+// release gives back the credit acquired when no message was sent.
+func (sub *streamSubscription) release() {
+	sub.mtx.Lock()
+	if !sub.unlimited {
+		sub.credits++
+	}
+	sub.mtx.Unlock()
+}
+
+type transportStream struct {
+	ctx    context.Context
+	sid    string
+	sm     *msq.SessionManager
+	stream pb.PrivateTransferProtocol_TransportServer
+
+	sendMtx       sync.Mutex
+	subscriptions map[string]*streamSubscription
+	wg            sync.WaitGroup
+}
+
+// Transport serves the bidirectional stream of a session, it's the streaming counterpart of invoke/pop/release:
+// the inbound with x-ptp-stream-op push/subscribe/credit/ack pushes a message, subscribes a topic, grants
+// credits to a subscription and acknowledges a message, and the messages of the subscribed topics are sent
+// to the stream as soon as they arrive.
+func (s *Server) Transport(stream pb.PrivateTransferProtocol_TransportServer) error {
+	sid, _ := getParamFromCtx(stream.Context(), codec.PtpSessionID)
+	if len(sid) == 0 {
+		nlog.Warnf("Empty session-id of transport stream")
+		return stream.Send(codec.BuildInvokeOutboundByErr(transerr.NewTransError(transerr.InvalidRequest)))
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	ts := &transportStream{
+		ctx:           ctx,
+		sid:           sid,
+		sm:            s.sm,
+		stream:        stream,
+		subscriptions: make(map[string]*streamSubscription),
+	}
+	defer func() {
+		cancel()
+		ts.wg.Wait()
+	}()
+
+	for {
+		inbound, err := stream.Recv()
+		if err == io.EOF {
+			// the client may half-close the stream and keep receiving the messages of its subscriptions
+			if len(ts.subscriptions) > 0 {
+				<-ctx.Done()
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		ts.handle(inbound)
+	}
+}
+
+func (ts *transportStream) handle(inbound *pb.Inbound) {
+	op := inbound.GetMetadata()[codec.PtpStreamOp]
+	var err *transerr.TransError
+	switch op {
+	case codec.StreamOpPush:
+		err = ts.handlePush(inbound)
+		// push is always answered, so the producer knows the message is buffered
+		ts.reply(op, inbound, err)
+		return
+	case codec.StreamOpAck:
+		err = ts.handleAck(inbound)
+		ts.reply(op, inbound, err)
+		return
+	case codec.StreamOpSubscribe:
+		err = ts.handleSubscribe(inbound)
+	case codec.StreamOpCredit:
+		err = ts.handleCredit(inbound)
+	default:
+		nlog.Warnf("Unknown stream op(%s) of session(%s)", op, ts.sid)
+		err = transerr.NewTransError(transerr.InvalidRequest)
+	}
+
+	if err != nil {
+		ts.reply(op, inbound, err)
+	}
+}
+
+func (ts *transportStream) handlePush(inbound *pb.Inbound) *transerr.TransError {
+	fullTopic, err := ts.getFullTopic(inbound, codec.PtpSourceNodeID)
+	if err != nil {
+		return err
+	}
+	if len(inbound.GetPayload()) == 0 {
+		nlog.Warnf("Empty payload of stream push")
+		return transerr.NewTransError(transerr.InvalidRequest)
+	}
+
+	message := msq.NewMessageWithID(inbound.GetPayload(), inbound.GetMetadata()[codec.PtpMessageID])
+	// pushing blocks the stream until the buffer has room, so a fast producer is slowed down
+	return ts.sm.Push(ts.sid, fullTopic, message, getTimeout(ts.ctx, nil))
+}
+
+func (ts *transportStream) handleAck(inbound *pb.Inbound) *transerr.TransError {
+	fullTopic, err := ts.getFullTopic(inbound, codec.PtpTargetNodeID)
+	if err != nil {
+		return err
+	}
+
+	id := inbound.GetMetadata()[codec.PtpMessageID]
+	if len(id) == 0 {
+		nlog.Warnf("Empty %s of stream ack", codec.PtpMessageID)
+		return transerr.NewTransError(transerr.InvalidRequest)
+	}
+	return ts.sm.Ack(ts.sid, fullTopic, id)
+}
+
+func (ts *transportStream) handleSubscribe(inbound *pb.Inbound) *transerr.TransError {
+	fullTopic, err := ts.getFullTopic(inbound, codec.PtpTargetNodeID)
+	if err != nil {
+		return err
+	}
+	if _, ok := ts.subscriptions[fullTopic]; ok {
+		nlog.Warnf("Topic(%s) of session(%s) is already subscribed by the stream", fullTopic, ts.sid)
+		return transerr.NewTransError(transerr.InvalidRequest)
+	}
+
+	metadata := inbound.GetMetadata()
+	sub := newStreamSubscription(metadata[codec.PtpTopicID], fullTopic, metadata[codec.PtpAckMode] == codec.AckModeManual)
+	if val, ok := metadata[codec.PtpStreamCredits]; ok {
+		credits, err := parseCredits(val)
+		if err != nil {
+			return err
+		}
+		sub.setCredits(credits)
+	}
+
+	ts.subscriptions[fullTopic] = sub
+	ts.wg.Add(1)
+	go ts.deliver(sub)
+	return nil
+}
+
+func (ts *transportStream) handleCredit(inbound *pb.Inbound) *transerr.TransError {
+	fullTopic, err := ts.getFullTopic(inbound, codec.PtpTargetNodeID)
+	if err != nil {
+		return err
+	}
+	sub, ok := ts.subscriptions[fullTopic]
+	if !ok {
+		return transerr.NewTransError(transerr.NotFound)
+	}
+
+	credits, err := parseCredits(inbound.GetMetadata()[codec.PtpStreamCredits])
+	if err != nil {
+		return err
+	}
+	sub.addCredits(credits)
+	return nil
+}
+
+func (ts *transportStream) deliver(sub *streamSubscription) {
+	defer ts.wg.Done()
+
+	for {
+		if !sub.acquire(ts.ctx) {
+			return
+		}
+
+		var msg *msq.Message
+		var err *transerr.TransError
+		if sub.manualAck {
+			msg, err = ts.sm.PopForAck(ts.sid, sub.fullTopic, streamPopTimeout)
+		} else {
+			msg, err = ts.sm.Pop(ts.sid, sub.fullTopic, streamPopTimeout)
+		}
+		if err != nil {
+			ts.send(buildStreamOutbound(codec.StreamOpMessage, sub.topic, "", err))
+			return
+		}
+		if msg == nil {
+			sub.release()
+			if ts.ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		outbound := buildStreamOutbound(codec.StreamOpMessage, sub.topic, msg.ID, nil)
+		outbound.Payload = msg.Content
+		outbound.Metadata[codec.PtpDeliveryCount] = strconv.Itoa(msg.DeliveryCount())
+		if sendErr := ts.send(outbound); sendErr != nil {
+			nlog.Warnf("Send message(%s) of topic(%s) to stream failed, %v", msg.ID, sub.fullTopic, sendErr)
+			return
+		}
+	}
+}
+
+func (ts *transportStream) reply(op string, inbound *pb.Inbound, err *transerr.TransError) {
+	metadata := inbound.GetMetadata()
+	if sendErr := ts.send(buildStreamOutbound(op, metadata[codec.PtpTopicID], metadata[codec.PtpMessageID], err)); sendErr != nil {
+		nlog.Warnf("Reply stream op(%s) of session(%s) failed, %v", op, ts.sid, sendErr)
+	}
+}
+
+// send serializes the outbounds, the stream is not safe to be sent by multiple goroutines.
+func (ts *transportStream) send(outbound *pb.Outbound) error {
+	ts.sendMtx.Lock()
+	defer ts.sendMtx.Unlock()
+	return ts.stream.Send(outbound)
+}
+
+// getFullTopic reads the topic and node id from the inbound metadata, and falls back to the stream metadata.
+func (ts *transportStream) getFullTopic(inbound *pb.Inbound, nodeIDKey string) (string, *transerr.TransError) {
+	metadata := inbound.GetMetadata()
+	topic := metadata[codec.PtpTopicID]
+	nodeID, ok := metadata[nodeIDKey]
+	if !ok {
+		nodeID, _ = getParamFromCtx(ts.ctx, nodeIDKey)
+	}
+
+	if len(topic) == 0 || len(nodeID) == 0 {
+		nlog.Warnf("Empty topic or %s of stream inbound", nodeIDKey)
+		return "", transerr.NewTransError(transerr.InvalidRequest)
+	}
+	return fmt.Sprintf("%s-%s", nodeID, topic), nil
+}
+
+func parseCredits(val string) (int64, *transerr.TransError) {
+	credits, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || credits < 0 {
+		nlog.Warnf("Invalid %s: %s", codec.PtpStreamCredits, val)
+		return 0, transerr.NewTransError(transerr.InvalidRequest)
+	}
+	return credits, nil
+}
+
+func buildStreamOutbound(op, topic, id string, err *transerr.TransError) *pb.Outbound {
+	outbound := codec.BuildInvokeOutboundByErr(err)
+	outbound.Metadata = map[string]string{
+		codec.PtpStreamOp: op,
+		codec.PtpTopicID:  topic,
+	}
+	if len(id) != 0 {
+		outbound.Metadata[codec.PtpMessageID] = id
+	}
+	return outbound
+}
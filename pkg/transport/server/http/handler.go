@@ -35,7 +35,7 @@ type ReqParams struct {
 
 func (s *Server) generateHandler(method Method) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		outbound := s.factory[method](r)
+		outbound := s.factory[method](r, w.Header())
 		body, err := s.codec.Marshal(outbound)
 		if err != nil {
 			nlog.Warnf("Marshal outbound fail :%v", outbound)
@@ -61,10 +61,11 @@ func (s *Server) registerInnerHandler() {
 		pop:     s.handlePop,
 		peek:    s.handlePeek,
 		release: s.handleRelease,
+		ack:     s.handleAck,
 	}
 }
 
-func (s *Server) handleInvoke(r *http.Request) *codec.Outbound {
+func (s *Server) handleInvoke(r *http.Request, _ http.Header) *codec.Outbound {
 	params, err := getReqParams(r, true)
 	if err != nil {
 		return codec.BuildOutboundByErr(err)
@@ -79,35 +80,62 @@ func (s *Server) handleInvoke(r *http.Request) *codec.Outbound {
 	return codec.BuildOutboundByErr(err)
 }
 
-func (s *Server) handlePop(r *http.Request) *codec.Outbound {
+func (s *Server) handlePop(r *http.Request, header http.Header) *codec.Outbound {
 	params, err := getReqParams(r, false)
 	if err != nil {
 		return codec.BuildOutboundByErr(err)
 	}
 
-	msg, err := s.sm.Pop(params.sid, params.topic, getTimeout(r))
+	var msg *msq.Message
+	if isManualAck(r) {
+		msg, err = s.sm.PopForAck(params.sid, params.topic, getTimeout(r))
+	} else {
+		msg, err = s.sm.Pop(params.sid, params.topic, getTimeout(r))
+	}
 	if err != nil || msg == nil {
 		return codec.BuildOutboundByErr(err)
 	}
 
+	setMessageHeader(header, msg)
 	return codec.BuildOutboundByPayload(msg.Content)
 }
 
-func (s *Server) handlePeek(r *http.Request) *codec.Outbound {
+func (s *Server) handlePeek(r *http.Request, header http.Header) *codec.Outbound {
 	params, err := getReqParams(r, false)
 	if err != nil {
 		return codec.BuildOutboundByErr(err)
 	}
 
-	msg, err := s.sm.Peek(params.sid, params.topic)
+	var msg *msq.Message
+	if isManualAck(r) {
+		msg, err = s.sm.PeekForAck(params.sid, params.topic)
+	} else {
+		msg, err = s.sm.Peek(params.sid, params.topic)
+	}
 	if err != nil || msg == nil {
 		return codec.BuildOutboundByErr(err)
 	}
 
+	setMessageHeader(header, msg)
 	return codec.BuildOutboundByPayload(msg.Content)
 }
 
-func (s *Server) handleRelease(r *http.Request) *codec.Outbound {
+func (s *Server) handleAck(r *http.Request, _ http.Header) *codec.Outbound {
+	params, err := getReqParams(r, false)
+	if err != nil {
+		return codec.BuildOutboundByErr(err)
+	}
+
+	id := r.Header.Get(codec.PtpMessageID)
+	if len(id) == 0 {
+		nlog.Warnf("Empty %s", codec.PtpMessageID)
+		return codec.BuildOutboundByErr(transerr.NewTransError(transerr.InvalidRequest))
+	}
+
+	return codec.BuildOutboundByErr(s.sm.Ack(params.sid, params.topic, id))
+}
+
+func (s *Server) handleRelease(r *http.Request, _ http.Header) *codec.Outbound {
 	sid := r.Header.Get(codec.PtpSessionID)
 	if len(sid) == 0 {
 		nlog.Warnf("Empty session-id")
@@ -147,7 +175,16 @@ func (s *Server) readMessage(r *http.Request) (*msq.Message, *transerr.TransErro
 		return nil, transerr.NewTransError(transerr.InvalidRequest)
 	}
 
-	return msq.NewMessage(body), nil
+	return msq.NewMessageWithID(body, r.Header.Get(codec.PtpMessageID)), nil
+}
+
+func isManualAck(r *http.Request) bool {
+	return r.Header.Get(codec.PtpAckMode) == codec.AckModeManual
+}
+
+func setMessageHeader(header http.Header, msg *msq.Message) {
+	header.Set(codec.PtpMessageID, msg.ID)
+	header.Set(codec.PtpDeliveryCount, strconv.Itoa(msg.DeliveryCount()))
 }
 
 func getReqParams(r *http.Request, isPush bool) (*ReqParams, *transerr.TransError) {
@@ -33,9 +33,11 @@ const (
 	pop     Method = "pop"
 	peek    Method = "peek"
 	release Method = "release"
+	ack     Method = "ack"
 )
 
-type TransHandler func(r *http.Request) *codec.Outbound
+// TransHandler handles the request, the header is the header of the response.
+type TransHandler func(r *http.Request, header http.Header) *codec.Outbound
 
 type Server struct {
 	svrConfig *config.ServerConfig
@@ -62,6 +64,7 @@ func (s *Server) Start(ctx context.Context) error {
 	mux.HandleFunc("/v1/interconn/chan/pop", s.generateHandler(pop))
 	mux.HandleFunc("/v1/interconn/chan/peek", s.generateHandler(peek))
 	mux.HandleFunc("/v1/interconn/chan/release", s.generateHandler(release))
+	mux.HandleFunc("/v1/interconn/chan/ack", s.generateHandler(ack))
 
 	sr := &http.Server{
 		Addr:           fmt.Sprintf("127.0.0.1:%d", s.svrConfig.Port),
@@ -89,14 +92,3 @@ func (s *Server) Start(ctx context.Context) error {
 		return fmt.Errorf("transport server has been canceled")
 	}
 }
-
-func Run(ctx context.Context, configFile string) error {
-	transConfig, err := config.LoadTransConfig(configFile)
-	if err != nil {
-		return err
-	}
-
-	sessionManager := msq.NewSessionManager(transConfig.MsqConfig)
-	server := NewServer(transConfig.HTTPConfig, sessionManager)
-	return server.Start(ctx)
-}
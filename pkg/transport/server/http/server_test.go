@@ -375,3 +375,52 @@ func TestPerformance(t *testing.T) {
 	nlog.Infof("popMsgCount=%d popFailCount=%d leftCount=%d totalRecvCount=%d",
 		popMsgCount, popFailCount, leftCount, leftCount+popMsgCount)
 }
+
+func TestPopForAck(t *testing.T) {
+	pushReq, _ := http.NewRequest("POST", generatePath(invoke), bytes.NewBuffer(NewStr("123456789")))
+	pushReq.Header.Set(codec.PtpTopicID, "topic1")
+	pushReq.Header.Set(codec.PtpSessionID, "session10")
+	pushReq.Header.Set(codec.PtpSourceNodeID, "node0")
+	pushReq.Header.Set(codec.PtpMessageID, "msg1")
+	verifyResponse(t, pushReq, transerr.Success)
+	// the retried push is dropped
+	pushReq, _ = http.NewRequest("POST", generatePath(invoke), bytes.NewBuffer(NewStr("123456789")))
+	pushReq.Header.Set(codec.PtpTopicID, "topic1")
+	pushReq.Header.Set(codec.PtpSessionID, "session10")
+	pushReq.Header.Set(codec.PtpSourceNodeID, "node0")
+	pushReq.Header.Set(codec.PtpMessageID, "msg1")
+	verifyResponse(t, pushReq, transerr.Success)
+
+	popReq, _ := http.NewRequest("POST", generatePath(pop), bytes.NewBuffer(nil))
+	popReq.Header.Set(codec.PtpTopicID, "topic1")
+	popReq.Header.Set(codec.PtpSessionID, "session10")
+	popReq.Header.Set(codec.PtpTargetNodeID, "node0")
+	popReq.Header.Set(codec.PtpAckMode, codec.AckModeManual)
+	resp, err := http.DefaultClient.Do(popReq)
+	assert.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	outbound, err := server.codec.UnMarshal(body)
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789", string(outbound.Payload))
+	assert.Equal(t, "msg1", resp.Header.Get(codec.PtpMessageID))
+	assert.Equal(t, "1", resp.Header.Get(codec.PtpDeliveryCount))
+
+	ackReq, _ := http.NewRequest("POST", generatePath(ack), bytes.NewBuffer(nil))
+	ackReq.Header.Set(codec.PtpTopicID, "topic1")
+	ackReq.Header.Set(codec.PtpSessionID, "session10")
+	ackReq.Header.Set(codec.PtpTargetNodeID, "node0")
+	ackReq.Header.Set(codec.PtpMessageID, "msg1")
+	verifyResponse(t, ackReq, transerr.Success)
+
+	ackReq, _ = http.NewRequest("POST", generatePath(ack), bytes.NewBuffer(nil))
+	ackReq.Header.Set(codec.PtpTopicID, "topic1")
+	ackReq.Header.Set(codec.PtpSessionID, "session10")
+	ackReq.Header.Set(codec.PtpTargetNodeID, "node0")
+	ackReq.Header.Set(codec.PtpMessageID, "msg1")
+	verifyResponse(t, ackReq, transerr.NotFound)
+
+	msg, transErr := server.sm.Peek("session10", "node0-topic1")
+	assert.Nil(t, transErr)
+	assert.Nil(t, msg)
+}
@@ -0,0 +1,53 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/secretflow/kuscia/pkg/transport/config"
+	"github.com/secretflow/kuscia/pkg/transport/msq"
+	"github.com/secretflow/kuscia/pkg/transport/server/grpc"
+	"github.com/secretflow/kuscia/pkg/transport/server/http"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+// Run starts the http server of transport, and the grpc server if it's enabled, both servers share the
+// same session queues, so the messages pushed by one are able to be popped or streamed by the other.
+func Run(ctx context.Context, configFile string) error {
+	transConfig, err := config.LoadTransConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	sessionManager := msq.NewSessionManager(transConfig.MsqConfig)
+	if err = sessionManager.Restore(); err != nil {
+		return err
+	}
+	defer sessionManager.Close()
+	sessionManager.StartMonitorLoop(ctx.Done())
+
+	if transConfig.GrpcConfig != nil && transConfig.GrpcConfig.Enabled {
+		grpcServer := grpc.NewServer(transConfig.GrpcConfig, sessionManager)
+		go func() {
+			if err := grpcServer.Start(ctx); err != nil {
+				nlog.Errorf("Transport grpc server exit with error: %v", err)
+			}
+		}()
+	}
+
+	server := http.NewServer(transConfig.HTTPConfig, sessionManager)
+	return server.Start(ctx)
+}
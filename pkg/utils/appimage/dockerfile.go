@@ -0,0 +1,297 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appimage
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	oci "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// Dockerfile is the result of statically parsing a dockerfile. Only the final build stage is kept.
+// Config is the config set by the final stage alone, use ImageConfig to apply it on top of the config
+// inherited from the base image.
+type Dockerfile struct {
+	BaseImage string
+	Config    oci.ImageConfig
+
+	// args are the ARG values visible to the final stage, instructions are the ones of the final stage.
+	args         map[string]string
+	instructions []string
+}
+
+// ParseDockerfileFile parses the dockerfile at filePath.
+func ParseDockerfileFile(filePath string) (*Dockerfile, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseDockerfile(f)
+}
+
+// ParseDockerfile extracts the base image, exposed ports, entrypoint, cmd, env and working dir of
+// the final stage. Variables defined by ARG and ENV are expanded.
+func ParseDockerfile(r io.Reader) (*Dockerfile, error) {
+	lines, err := logicalLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	df := &Dockerfile{}
+	// the ARGs declared before the first FROM are visible to all the stages
+	globalArgs := map[string]string{}
+	for _, line := range lines {
+		instruction, rest, _ := strings.Cut(line, " ")
+		rest = strings.TrimSpace(rest)
+		switch upper := strings.ToUpper(instruction); {
+		case upper == "FROM":
+			words := strings.Fields(rest)
+			for len(words) > 0 && strings.HasPrefix(words[0], "--") {
+				words = words[1:]
+			}
+			if len(words) == 0 {
+				return nil, fmt.Errorf("invalid FROM instruction: %q", line)
+			}
+			// a new stage doesn't inherit anything from the previous one except global ARGs
+			df.BaseImage = os.Expand(words[0], func(key string) string { return globalArgs[key] })
+			df.args = maps.Clone(globalArgs)
+			df.instructions = nil
+		case df.BaseImage == "":
+			if upper == "ARG" {
+				name, value, _ := strings.Cut(rest, "=")
+				if _, ok := globalArgs[name]; !ok || value != "" {
+					globalArgs[name] = os.Expand(unquote(value), func(key string) string { return globalArgs[key] })
+				}
+			}
+		default:
+			df.instructions = append(df.instructions, line)
+		}
+	}
+	if df.BaseImage == "" {
+		return nil, fmt.Errorf("no FROM instruction found in dockerfile")
+	}
+
+	conf, err := df.ImageConfig(nil)
+	if err != nil {
+		return nil, err
+	}
+	df.Config = *conf
+	return df, nil
+}
+
+// ImageConfig applies the instructions of the final stage on top of the config of the base image, the same
+// way the image would be built: ENV and EXPOSE are merged, ENTRYPOINT resets the inherited CMD, and the
+// others override the inherited values. A nil base is treated as an empty config.
+func (df *Dockerfile) ImageConfig(base *oci.ImageConfig) (*oci.ImageConfig, error) {
+	conf := &oci.ImageConfig{}
+	if base != nil {
+		conf.User = base.User
+		conf.ExposedPorts = maps.Clone(base.ExposedPorts)
+		conf.Env = slices.Clone(base.Env)
+		conf.Entrypoint = slices.Clone(base.Entrypoint)
+		conf.Cmd = slices.Clone(base.Cmd)
+		conf.WorkingDir = base.WorkingDir
+	}
+
+	vars := maps.Clone(df.args)
+	if vars == nil {
+		vars = map[string]string{}
+	}
+	for _, kv := range conf.Env {
+		key, value, _ := strings.Cut(kv, "=")
+		vars[key] = value
+	}
+	expand := func(s string) string {
+		return os.Expand(s, func(key string) string { return vars[key] })
+	}
+	for _, line := range df.instructions {
+		instruction, rest, _ := strings.Cut(line, " ")
+		rest = strings.TrimSpace(rest)
+		switch strings.ToUpper(instruction) {
+		case "ARG":
+			name, value, _ := strings.Cut(rest, "=")
+			if _, ok := vars[name]; !ok || value != "" {
+				vars[name] = expand(unquote(value))
+			}
+		case "ENV":
+			pairs, err := parseEnv(rest)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ENV instruction %q: %v", line, err)
+			}
+			for _, kv := range pairs {
+				kv[1] = expand(kv[1])
+				vars[kv[0]] = kv[1]
+				conf.Env = setEnv(conf.Env, kv[0], kv[1])
+			}
+		case "EXPOSE":
+			if conf.ExposedPorts == nil {
+				conf.ExposedPorts = map[string]struct{}{}
+			}
+			for _, port := range strings.Fields(expand(rest)) {
+				if !strings.Contains(port, "/") {
+					port += "/tcp"
+				}
+				conf.ExposedPorts[strings.ToLower(port)] = struct{}{}
+			}
+		case "ENTRYPOINT":
+			conf.Entrypoint = parseCommand(rest)
+			// setting ENTRYPOINT resets the CMD inherited so far
+			conf.Cmd = nil
+		case "CMD":
+			conf.Cmd = parseCommand(rest)
+		case "WORKDIR":
+			dir := expand(unquote(rest))
+			if !path.IsAbs(dir) {
+				dir = path.Join("/", conf.WorkingDir, dir)
+			}
+			conf.WorkingDir = dir
+		case "USER":
+			conf.User = expand(rest)
+		}
+	}
+	return conf, nil
+}
+
+// logicalLines joins continuation lines and drops comments and blank lines.
+func logicalLines(r io.Reader) ([]string, error) {
+	var lines []string
+	var current strings.Builder
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") || (line == "" && current.Len() == 0) {
+			continue
+		}
+		if strings.HasSuffix(line, "\\") {
+			current.WriteString(strings.TrimSuffix(line, "\\"))
+			current.WriteString(" ")
+			continue
+		}
+		current.WriteString(line)
+		if s := strings.TrimSpace(current.String()); s != "" {
+			lines = append(lines, s)
+		}
+		current.Reset()
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if s := strings.TrimSpace(current.String()); s != "" {
+		lines = append(lines, s)
+	}
+	return lines, nil
+}
+
+// parseCommand parses both the exec form `["a", "b"]` and the shell form `a b` of ENTRYPOINT and CMD.
+func parseCommand(s string) []string {
+	if strings.HasPrefix(s, "[") {
+		var args []string
+		if err := json.Unmarshal([]byte(s), &args); err == nil {
+			return args
+		}
+	}
+	if s == "" {
+		return nil
+	}
+	return []string{"/bin/sh", "-c", s}
+}
+
+// parseEnv parses both `ENV k v` and `ENV k1=v1 k2="v 2"`.
+func parseEnv(s string) ([][2]string, error) {
+	words, err := splitWords(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("missing key")
+	}
+	if !strings.Contains(words[0], "=") {
+		key, value, _ := strings.Cut(s, " ")
+		return [][2]string{{key, unquote(strings.TrimSpace(value))}}, nil
+	}
+	var pairs [][2]string
+	for _, word := range words {
+		key, value, ok := strings.Cut(word, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%q is not in key=value format", word)
+		}
+		pairs = append(pairs, [2]string{key, value})
+	}
+	return pairs, nil
+}
+
+// splitWords splits s by spaces, the quotes are removed and the spaces inside quotes are kept.
+func splitWords(s string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	var quote rune
+	inWord := false
+	for _, c := range s {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				word.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			inWord = true
+		case c == ' ' || c == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in %q", s)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+func setEnv(env []string, key, value string) []string {
+	for i, kv := range env {
+		if strings.HasPrefix(kv, key+"=") {
+			env[i] = key + "=" + value
+			return env
+		}
+	}
+	return append(env, key+"="+value)
+}
@@ -0,0 +1,320 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package appimage scaffolds AppImage definitions from the config of a container image.
+package appimage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	oci "github.com/opencontainers/image-spec/specs-go/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	"github.com/secretflow/kuscia/pkg/agent/local/store/kii"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+// DefaultTemplate renders an AppImage with one deploy template, whose container runs the image
+// entrypoint and mounts the kuscia task config. Custom templates get the same TemplateData.
+const DefaultTemplate = `apiVersion: kuscia.secretflow/v1alpha1
+kind: AppImage
+metadata:
+  name: {{ .Name }}
+spec:
+  configTemplates:
+    task-config.conf: |
+      {
+        "task_id": "{{ "{{.TASK_ID}}" }}",
+        "task_input_config": "{{ "{{.TASK_INPUT_CONFIG}}" }}",
+        "task_cluster_def": "{{ "{{.TASK_CLUSTER_DEFINE}}" }}",
+        "allocated_ports": "{{ "{{.ALLOCATED_PORTS}}" }}"
+      }
+  deployTemplates:
+    - name: {{ .Name }}
+{{- if .Role }}
+      role: {{ .Role }}
+{{- end }}
+      replicas: {{ .Replicas }}
+      spec:
+        restartPolicy: Never
+        containers:
+          - name: {{ .Name }}
+{{- if .Command }}
+            command: {{ toJSON .Command }}
+{{- end }}
+{{- if .Args }}
+            args: {{ toJSON .Args }}
+{{- end }}
+            workingDir: {{ toJSON .WorkingDir }}
+{{- if .Env }}
+            env: {{ toJSON .Env }}
+{{- end }}
+            configVolumeMounts:
+              - mountPath: {{ .ConfigMountPath }}
+                subPath: task-config.conf
+            ports: {{ toJSON .Ports }}
+  image:
+    name: {{ .Image }}
+    tag: {{ .Tag }}
+{{- if .ID }}
+    id: {{ .ID }}
+{{- end }}
+`
+
+const (
+	defaultWorkingDir  = "/work"
+	configMountSubPath = "kuscia/task-config.conf"
+)
+
+// Options customizes the scaffolded AppImage.
+type Options struct {
+	// Name of the AppImage, default is the last path element of the image repository.
+	Name string
+	// Role of the deploy template, empty matches all roles.
+	Role     string
+	Replicas int32
+	// PortProtocol is the protocol of all exposed ports, default is HTTP.
+	PortProtocol v1alpha1.PortProtocol
+	// PortScope is the scope of all exposed ports, default is Cluster.
+	PortScope v1alpha1.PortScope
+	// PortNames maps the port number to its name, default name is "port-<number>".
+	PortNames map[int32]string
+	// Template is a text/template rendering the AppImage yaml, default is DefaultTemplate.
+	Template string
+}
+
+// TemplateData is the input of the AppImage template.
+type TemplateData struct {
+	Name            string
+	Image           string
+	Tag             string
+	ID              string
+	Role            string
+	Replicas        int32
+	Command         []string
+	Args            []string
+	WorkingDir      string
+	Env             []corev1.EnvVar
+	Ports           []v1alpha1.ContainerPort
+	ConfigMountPath string
+}
+
+// NewTemplateData builds the template data from the image name, id and config.
+func NewTemplateData(image *kii.ImageName, imageID string, conf *oci.ImageConfig, opts *Options) (*TemplateData, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	data := &TemplateData{
+		Name:       opts.Name,
+		Image:      imageRepo(image),
+		Tag:        image.Tag,
+		ID:         imageID,
+		Role:       opts.Role,
+		Replicas:   opts.Replicas,
+		WorkingDir: conf.WorkingDir,
+	}
+	if data.Name == "" {
+		data.Name = defaultName(image.Repo)
+	}
+	if errs := validation.IsDNS1123Subdomain(data.Name); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid app image name %q: %s", data.Name, strings.Join(errs, ", "))
+	}
+	if data.Replicas <= 0 {
+		data.Replicas = 1
+	}
+	if data.WorkingDir == "" {
+		data.WorkingDir = defaultWorkingDir
+	}
+	data.ConfigMountPath = strings.TrimSuffix(data.WorkingDir, "/") + "/" + configMountSubPath
+
+	// the same rules as docker: entrypoint becomes the command and cmd becomes its args
+	if len(conf.Entrypoint) > 0 {
+		data.Command = conf.Entrypoint
+		data.Args = conf.Cmd
+	} else {
+		data.Command = conf.Cmd
+	}
+	if len(data.Command) == 0 {
+		nlog.Warnf("Image %s has neither entrypoint nor cmd, please fill the container command manually", image.Image)
+	}
+
+	for _, kv := range conf.Env {
+		key, value, _ := strings.Cut(kv, "=")
+		// PATH and the like are already in the image, repeating them only makes the template noisy
+		if key == "PATH" || key == "HOME" || key == "HOSTNAME" {
+			continue
+		}
+		data.Env = append(data.Env, corev1.EnvVar{Name: key, Value: value})
+	}
+
+	ports, err := buildPorts(conf.ExposedPorts, opts)
+	if err != nil {
+		return nil, err
+	}
+	data.Ports = ports
+	return data, nil
+}
+
+// Scaffold renders and validates the AppImage of the image.
+func Scaffold(image *kii.ImageName, imageID string, conf *oci.ImageConfig, opts *Options) (*v1alpha1.AppImage, error) {
+	data, err := NewTemplateData(image, imageID, conf, opts)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := DefaultTemplate
+	if opts != nil && opts.Template != "" {
+		tmpl = opts.Template
+	}
+	return Render(tmpl, data)
+}
+
+// Render executes tmpl with data, the result must be a valid AppImage.
+func Render(tmpl string, data *TemplateData) (*v1alpha1.AppImage, error) {
+	t, err := template.New("appimage").Funcs(template.FuncMap{"toJSON": toJSON}).Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("parse app image template failed: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("render app image template failed: %v", err)
+	}
+
+	appImage := &v1alpha1.AppImage{}
+	if err := yaml.UnmarshalStrict(buf.Bytes(), appImage); err != nil {
+		return nil, fmt.Errorf("rendered app image is invalid: %v\n%s", err, buf.String())
+	}
+	if err := Validate(appImage); err != nil {
+		return nil, err
+	}
+	return appImage, nil
+}
+
+// Validate checks the fields that kuscia requires but the crd schema can't express.
+func Validate(appImage *v1alpha1.AppImage) error {
+	if appImage.Kind != "" && appImage.Kind != "AppImage" {
+		return fmt.Errorf("kind should be AppImage, but got %q", appImage.Kind)
+	}
+	if appImage.Name == "" {
+		return fmt.Errorf("app image name can not be empty")
+	}
+	if appImage.Spec.Image.Name == "" || appImage.Spec.Image.Tag == "" {
+		return fmt.Errorf("image name and tag of app image %q can not be empty", appImage.Name)
+	}
+	if len(appImage.Spec.DeployTemplates) == 0 {
+		return fmt.Errorf("app image %q should have at least one deploy template", appImage.Name)
+	}
+	for _, dt := range appImage.Spec.DeployTemplates {
+		if len(dt.Spec.Containers) == 0 {
+			return fmt.Errorf("deploy template %q should have at least one container", dt.Name)
+		}
+		portNames := map[string]bool{}
+		for _, c := range dt.Spec.Containers {
+			if c.Name == "" {
+				return fmt.Errorf("container name of deploy template %q can not be empty", dt.Name)
+			}
+			for _, port := range c.Ports {
+				if errs := validation.IsDNS1035Label(port.Name); len(errs) > 0 {
+					return fmt.Errorf("invalid port name %q of container %q: %s", port.Name, c.Name, strings.Join(errs, ", "))
+				}
+				if portNames[port.Name] {
+					return fmt.Errorf("duplicate port name %q in deploy template %q", port.Name, dt.Name)
+				}
+				portNames[port.Name] = true
+				switch port.Protocol {
+				case "", v1alpha1.ProtocolHTTP, v1alpha1.ProtocolGRPC:
+				default:
+					return fmt.Errorf("port %q has unsupported protocol %q", port.Name, port.Protocol)
+				}
+				switch port.Scope {
+				case "", v1alpha1.ScopeCluster, v1alpha1.ScopeDomain, v1alpha1.ScopeLocal:
+				default:
+					return fmt.Errorf("port %q has unsupported scope %q", port.Name, port.Scope)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func buildPorts(exposedPorts map[string]struct{}, opts *Options) ([]v1alpha1.ContainerPort, error) {
+	protocol := opts.PortProtocol
+	if protocol == "" {
+		protocol = v1alpha1.ProtocolHTTP
+	}
+	scope := opts.PortScope
+	if scope == "" {
+		scope = v1alpha1.ScopeCluster
+	}
+
+	var numbers []int
+	for exposed := range exposedPorts {
+		number, proto, _ := strings.Cut(exposed, "/")
+		if proto != "" && proto != "tcp" {
+			nlog.Warnf("Exposed port %s is skipped, only tcp ports are supported", exposed)
+			continue
+		}
+		n, err := strconv.ParseInt(number, 10, 32)
+		if err != nil || n <= 0 || n > 65535 {
+			return nil, fmt.Errorf("invalid exposed port %q", exposed)
+		}
+		numbers = append(numbers, int(n))
+	}
+	sort.Ints(numbers)
+
+	ports := []v1alpha1.ContainerPort{}
+	for _, n := range numbers {
+		name := opts.PortNames[int32(n)]
+		if name == "" {
+			name = fmt.Sprintf("port-%d", n)
+		}
+		ports = append(ports, v1alpha1.ContainerPort{
+			Name:     name,
+			Port:     int32(n),
+			Protocol: protocol,
+			Scope:    scope,
+		})
+	}
+	return ports, nil
+}
+
+// imageRepo keeps the repository as the user wrote it, kii.ImageName.Repo is normalized to the docker.io form.
+func imageRepo(image *kii.ImageName) string {
+	if repo, ok := strings.CutSuffix(image.Image, ":"+image.Tag); ok {
+		return repo
+	}
+	return image.Repo
+}
+
+func defaultName(repo string) string {
+	name := repo[strings.LastIndex(repo, "/")+1:]
+	name = strings.ToLower(strings.NewReplacer("_", "-", ".", "-").Replace(name))
+	return strings.Trim(name, "-")
+}
+
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appimage
+
+import (
+	"strings"
+	"testing"
+
+	oci "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/secretflow/kuscia/pkg/agent/local/store/kii"
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+const testDockerfile = `
+# build stage
+FROM golang:1.22 AS builder
+EXPOSE 9999
+ENTRYPOINT ["/bin/false"]
+
+FROM --platform=linux/amd64 openanolis/anolisos:8.8
+ARG APP_PORT=8080
+ENV APP_HOME=/home/app \
+    LOG_LEVEL="info"
+WORKDIR ${APP_HOME}
+WORKDIR bin
+EXPOSE ${APP_PORT} 9090/tcp 5353/udp
+CMD ["--legacy"]
+ENTRYPOINT ["./engine", "--log-level", "info"]
+CMD ["--config", "./kuscia/task-config.conf"]
+`
+
+func TestParseDockerfile(t *testing.T) {
+	df, err := ParseDockerfile(strings.NewReader(testDockerfile))
+	assert.NoError(t, err)
+	assert.Equal(t, "openanolis/anolisos:8.8", df.BaseImage)
+	assert.Equal(t, "/home/app/bin", df.Config.WorkingDir)
+	assert.Equal(t, []string{"APP_HOME=/home/app", "LOG_LEVEL=info"}, df.Config.Env)
+	assert.Equal(t, map[string]struct{}{"8080/tcp": {}, "9090/tcp": {}, "5353/udp": {}}, df.Config.ExposedPorts)
+	assert.Equal(t, []string{"./engine", "--log-level", "info"}, df.Config.Entrypoint)
+	assert.Equal(t, []string{"--config", "./kuscia/task-config.conf"}, df.Config.Cmd)
+
+	df, err = ParseDockerfile(strings.NewReader("FROM alpine\nENV FOO bar baz\nCMD python -m app\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"FOO=bar baz"}, df.Config.Env)
+	assert.Equal(t, []string{"/bin/sh", "-c", "python -m app"}, df.Config.Cmd)
+
+	_, err = ParseDockerfile(strings.NewReader("EXPOSE 80\n"))
+	assert.Error(t, err)
+}
+
+func TestDockerfileImageConfig(t *testing.T) {
+	base := &oci.ImageConfig{
+		Env:          []string{"PATH=/usr/bin", "ENGINE_HOME=/opt/engine", "LOG_LEVEL=debug"},
+		ExposedPorts: map[string]struct{}{"8080/tcp": {}},
+		Entrypoint:   []string{"/opt/engine/bin/start.sh"},
+		Cmd:          []string{"--serve"},
+		WorkingDir:   "/opt/engine",
+	}
+
+	// the dockerfile only adds to the base image, ENTRYPOINT, ENV and EXPOSE are inherited
+	df, err := ParseDockerfile(strings.NewReader("FROM secretflow/engine-base:1.0\nENV LOG_LEVEL=info CONF=${ENGINE_HOME}/conf\nEXPOSE 9090\nWORKDIR data\n"))
+	assert.NoError(t, err)
+	assert.Empty(t, df.Config.Entrypoint)
+	conf, err := df.ImageConfig(base)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PATH=/usr/bin", "ENGINE_HOME=/opt/engine", "LOG_LEVEL=info", "CONF=/opt/engine/conf"}, conf.Env)
+	assert.Equal(t, map[string]struct{}{"8080/tcp": {}, "9090/tcp": {}}, conf.ExposedPorts)
+	assert.Equal(t, []string{"/opt/engine/bin/start.sh"}, conf.Entrypoint)
+	assert.Equal(t, []string{"--serve"}, conf.Cmd)
+	assert.Equal(t, "/opt/engine/data", conf.WorkingDir)
+	// the base config is not modified
+	assert.Equal(t, []string{"PATH=/usr/bin", "ENGINE_HOME=/opt/engine", "LOG_LEVEL=debug"}, base.Env)
+
+	image, err := kii.NewImageName("secretflow/my-engine:0.1.0")
+	assert.NoError(t, err)
+	appImage, err := Scaffold(image, "", conf, nil)
+	assert.NoError(t, err)
+	c := appImage.Spec.DeployTemplates[0].Spec.Containers[0]
+	assert.Equal(t, []string{"/opt/engine/bin/start.sh"}, c.Command)
+	assert.Equal(t, []string{"--serve"}, c.Args)
+	assert.Len(t, c.Ports, 2)
+
+	// ENTRYPOINT of the dockerfile resets the CMD of the base image
+	df, err = ParseDockerfile(strings.NewReader("FROM secretflow/engine-base:1.0\nENTRYPOINT [\"./engine\"]\n"))
+	assert.NoError(t, err)
+	conf, err = df.ImageConfig(base)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"./engine"}, conf.Entrypoint)
+	assert.Empty(t, conf.Cmd)
+}
+
+func TestScaffold(t *testing.T) {
+	df, err := ParseDockerfile(strings.NewReader(testDockerfile))
+	assert.NoError(t, err)
+	image, err := kii.NewImageName("secretflow/my_engine:0.1.0")
+	assert.NoError(t, err)
+
+	appImage, err := Scaffold(image, "", &df.Config, &Options{PortNames: map[int32]string{9090: "grpc"}, PortProtocol: v1alpha1.ProtocolGRPC})
+	assert.NoError(t, err)
+	assert.Equal(t, "my-engine", appImage.Name)
+	assert.Equal(t, "0.1.0", appImage.Spec.Image.Tag)
+	assert.Contains(t, appImage.Spec.ConfigTemplates["task-config.conf"], "{{.TASK_ID}}")
+
+	dt := appImage.Spec.DeployTemplates[0]
+	assert.Equal(t, int32(1), *dt.Replicas)
+	c := dt.Spec.Containers[0]
+	assert.Equal(t, []string{"./engine", "--log-level", "info"}, c.Command)
+	assert.Equal(t, []string{"--config", "./kuscia/task-config.conf"}, c.Args)
+	assert.Equal(t, "/home/app/bin", c.WorkingDir)
+	assert.Equal(t, "/home/app/bin/kuscia/task-config.conf", c.ConfigVolumeMounts[0].MountPath)
+	assert.Equal(t, []corev1.EnvVar{{Name: "APP_HOME", Value: "/home/app"}, {Name: "LOG_LEVEL", Value: "info"}}, c.Env)
+	assert.Equal(t, []v1alpha1.ContainerPort{
+		{Name: "port-8080", Port: 8080, Protocol: v1alpha1.ProtocolGRPC, Scope: v1alpha1.ScopeCluster},
+		{Name: "grpc", Port: 9090, Protocol: v1alpha1.ProtocolGRPC, Scope: v1alpha1.ScopeCluster},
+	}, c.Ports)
+}
+
+func TestScaffoldCustomTemplate(t *testing.T) {
+	image, err := kii.NewImageName("secretflow/engine:0.1.0")
+	assert.NoError(t, err)
+
+	tmpl := `apiVersion: kuscia.secretflow/v1alpha1
+kind: AppImage
+metadata:
+  name: {{ .Name }}
+spec:
+  image: {name: {{ .Image }}, tag: {{ .Tag }}}
+  deployTemplates:
+    - name: server
+      role: server
+      spec:
+        containers:
+          - name: server
+            command: {{ toJSON .Command }}
+            workingDir: /
+            ports: {{ toJSON .Ports }}
+`
+	appImage, err := Scaffold(image, "", &oci.ImageConfig{Cmd: []string{"serve"}}, &Options{Name: "server-image", Template: tmpl})
+	assert.NoError(t, err)
+	assert.Equal(t, "server-image", appImage.Name)
+	assert.Equal(t, []string{"serve"}, appImage.Spec.DeployTemplates[0].Spec.Containers[0].Command)
+
+	_, err = Scaffold(image, "", &oci.ImageConfig{}, &Options{Template: "kind: AppImage\nmetadata: {name: x}\nspec: {unknown: 1}\n"})
+	assert.Error(t, err)
+	_, err = Scaffold(image, "", &oci.ImageConfig{}, &Options{Template: "{{ .Unknown }}"})
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	image, err := kii.NewImageName("secretflow/engine:0.1.0")
+	assert.NoError(t, err)
+	conf := oci.ImageConfig{Cmd: []string{"serve"}, ExposedPorts: map[string]struct{}{"8080/tcp": {}}}
+
+	_, err = Scaffold(image, "", &conf, &Options{PortNames: map[int32]string{8080: "Invalid_Name"}})
+	assert.Error(t, err)
+	_, err = Scaffold(image, "", &conf, &Options{PortScope: "Global"})
+	assert.Error(t, err)
+	_, err = Scaffold(image, "", &conf, &Options{Name: "Upper"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package certinventory tracks the expiration of the certs and the tokens of the domain. The modules register the
+// sources of the certs they hold, and the inventory lists them for the metrics, the api and the alerts.
+package certinventory
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+type Kind string
+
+const (
+	KindCACert = Kind("CACert")
+	// KindDomainCert is the cert of the domain key.
+	KindDomainCert          = Kind("DomainCert")
+	KindKusciaAPIServerCert = Kind("KusciaAPIServerCert")
+	// KindEnvoyListenerCert is the downstream cert of the gateway listeners.
+	KindEnvoyListenerCert = Kind("EnvoyListenerCert")
+	// KindDomainRouteToken is the newest token of the DomainRoutes, which is rotated by the gateways.
+	KindDomainRouteToken = Kind("DomainRouteToken")
+)
+
+// Kinds are all the kinds of the inventory.
+var Kinds = []Kind{KindCACert, KindDomainCert, KindKusciaAPIServerCert, KindEnvoyListenerCert, KindDomainRouteToken}
+
+type Certificate struct {
+	Kind Kind
+	// Name identifies the cert of the kind, e.g. the domain id, the listener name or the DomainRoute name.
+	Name string
+	// Namespace is the namespace of the DomainRoute of the tokens, empty for the certs.
+	Namespace string
+	Subject   string
+	Issuer    string
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// Source lists the certs of a module, the certs are loaded on each call so that the renewed ones are listed.
+type Source func(ctx context.Context) ([]Certificate, error)
+
+type Inventory struct {
+	mu      sync.RWMutex
+	sources map[string]Source
+}
+
+// Default is the inventory of the kuscia process, the modules register their sources to it.
+var Default = NewInventory()
+
+func NewInventory() *Inventory {
+	return &Inventory{sources: map[string]Source{}}
+}
+
+// Register adds the source named name, the source of the same name is replaced.
+func (i *Inventory) Register(name string, source Source) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.sources[name] = source
+}
+
+// List lists the certs of all the sources ordered by the expiration, the failed sources are skipped.
+func (i *Inventory) List(ctx context.Context) []Certificate {
+	i.mu.RLock()
+	names := make([]string, 0, len(i.sources))
+	for name := range i.sources {
+		names = append(names, name)
+	}
+	sources := make([]Source, 0, len(names))
+	sort.Strings(names)
+	for _, name := range names {
+		sources = append(sources, i.sources[name])
+	}
+	i.mu.RUnlock()
+
+	var certs []Certificate
+	for idx, source := range sources {
+		list, err := source(ctx)
+		if err != nil {
+			nlog.Warnf("List certs of %s failed, %v", names[idx], err)
+			continue
+		}
+		certs = append(certs, list...)
+	}
+	sort.SliceStable(certs, func(a, b int) bool {
+		return certs[a].NotAfter.Before(certs[b].NotAfter)
+	})
+	return certs
+}
+
+func FromX509(kind Kind, name string, cert *x509.Certificate) Certificate {
+	return Certificate{
+		Kind:      kind,
+		Name:      name,
+		Subject:   cert.Subject.String(),
+		Issuer:    cert.Issuer.String(),
+		NotBefore: cert.NotBefore,
+		NotAfter:  cert.NotAfter,
+	}
+}
+
+// X509Source lists the cert returned by load, nothing is listed if it's nil.
+func X509Source(kind Kind, name string, load func() *x509.Certificate) Source {
+	return func(ctx context.Context) ([]Certificate, error) {
+		cert := load()
+		if cert == nil {
+			return nil, nil
+		}
+		return []Certificate{FromX509(kind, name, cert)}, nil
+	}
+}
+
+// ValueSource lists the cert stored in the value, e.g. the domain cert issued by the master.
+func ValueSource(kind Kind, name string, value *atomic.Value) Source {
+	return X509Source(kind, name, func() *x509.Certificate {
+		cert, _ := value.Load().(*x509.Certificate)
+		return cert
+	})
+}
+
+// FileSource lists the PEM encoded cert of the file, nothing is listed if the file doesn't exist.
+func FileSource(kind Kind, name, file string) Source {
+	return func(ctx context.Context) ([]Certificate, error) {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		cert, err := tlsutils.ParseCertData(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse cert %s failed, %v", file, err)
+		}
+		return []Certificate{FromX509(kind, name, cert)}, nil
+	}
+}
+
+// DomainRouteTokenSource lists the newest ready token of the DomainRoutes in the namespace. The tokens without
+// expiration, e.g. the tokens of the routes without rolling update, aren't listed.
+func DomainRouteTokenSource(kusciaClient kusciaclientset.Interface, namespace string) Source {
+	return func(ctx context.Context) ([]Certificate, error) {
+		routes, err := kusciaClient.KusciaV1alpha1().DomainRoutes(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		var certs []Certificate
+		for i := range routes.Items {
+			route := &routes.Items[i]
+			var newest *Certificate
+			for _, token := range route.Status.TokenStatus.Tokens {
+				if !token.IsReady || token.ExpirationTime.IsZero() {
+					continue
+				}
+				if newest == nil || token.ExpirationTime.After(newest.NotAfter) {
+					newest = &Certificate{
+						Kind:      KindDomainRouteToken,
+						Name:      route.Name,
+						Namespace: route.Namespace,
+						Subject:   fmt.Sprintf("%s-%s revision %d", route.Spec.Source, route.Spec.Destination, token.Revision),
+						NotBefore: token.RevisionTime.Time,
+						NotAfter:  token.ExpirationTime.Time,
+					}
+				}
+			}
+			if newest != nil {
+				certs = append(certs, *newest)
+			}
+		}
+		return certs, nil
+	}
+}
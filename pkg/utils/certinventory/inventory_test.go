@@ -0,0 +1,133 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certinventory
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+)
+
+func makeX509Cert(t *testing.T, commonName string, notAfter time.Time) *x509.Certificate {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert
+}
+
+func TestInventoryList(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	inventory := NewInventory()
+	inventory.Register("ca", X509Source(KindCACert, "ca", func() *x509.Certificate {
+		return makeX509Cert(t, "ca", now.Add(365*24*time.Hour))
+	}))
+	var value atomic.Value
+	inventory.Register("domain", ValueSource(KindDomainCert, "alice", &value))
+	inventory.Register("broken", func(ctx context.Context) ([]Certificate, error) {
+		return nil, errors.New("broken")
+	})
+
+	// the domain cert isn't issued yet and the broken source is skipped
+	certs := inventory.List(context.Background())
+	assert.Len(t, certs, 1)
+	assert.Equal(t, KindCACert, certs[0].Kind)
+	assert.Equal(t, "CN=ca", certs[0].Subject)
+
+	value.Store(makeX509Cert(t, "alice", now.Add(30*24*time.Hour)))
+	certs = inventory.List(context.Background())
+	assert.Len(t, certs, 2)
+	assert.Equal(t, KindDomainCert, certs[0].Kind)
+	assert.Equal(t, "alice", certs[0].Name)
+	assert.True(t, now.Add(30*24*time.Hour).Equal(certs[0].NotAfter))
+}
+
+func TestFileSource(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "server.crt")
+	source := FileSource(KindKusciaAPIServerCert, "kusciaapi-server", file)
+
+	certs, err := source(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, certs)
+
+	assert.NoError(t, os.WriteFile(file, []byte("invalid"), 0644))
+	_, err = source(context.Background())
+	assert.Error(t, err)
+
+	cert := makeX509Cert(t, "KusciaAPI", time.Now().Add(time.Hour))
+	assert.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0644))
+	certs, err = source(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, certs, 1)
+	assert.Equal(t, "CN=KusciaAPI", certs[0].Subject)
+}
+
+func TestDomainRouteTokenSource(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	token := func(revision int64, ready bool, expiration time.Time) v1alpha1.DomainRouteToken {
+		return v1alpha1.DomainRouteToken{IsReady: ready, Revision: revision, ExpirationTime: metav1.NewTime(expiration)}
+	}
+	kusciaClient := kusciafake.NewSimpleClientset(
+		&v1alpha1.DomainRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice-bob", Namespace: "alice"},
+			Spec:       v1alpha1.DomainRouteSpec{Source: "alice", Destination: "bob"},
+			Status: v1alpha1.DomainRouteStatus{TokenStatus: v1alpha1.DomainRouteTokenStatus{Tokens: []v1alpha1.DomainRouteToken{
+				token(1, true, now.Add(time.Hour)),
+				token(2, true, now.Add(2*time.Hour)),
+				token(3, false, now.Add(3*time.Hour)),
+			}}},
+		},
+		&v1alpha1.DomainRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice-carol", Namespace: "alice"},
+			Spec:       v1alpha1.DomainRouteSpec{Source: "alice", Destination: "carol"},
+			Status: v1alpha1.DomainRouteStatus{TokenStatus: v1alpha1.DomainRouteTokenStatus{Tokens: []v1alpha1.DomainRouteToken{
+				{IsReady: true, Revision: 1},
+			}}},
+		},
+	)
+
+	certs, err := DomainRouteTokenSource(kusciaClient, "alice")(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, certs, 1)
+	assert.Equal(t, KindDomainRouteToken, certs[0].Kind)
+	assert.Equal(t, "alice-bob", certs[0].Name)
+	assert.Equal(t, "alice", certs[0].Namespace)
+	assert.Equal(t, "alice-bob revision 2", certs[0].Subject)
+	assert.True(t, now.Add(2*time.Hour).Equal(certs[0].NotAfter))
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certinventory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	// MetricPath is the path the cert metrics are served on by the metric exporter.
+	MetricPath = "/certmetrics"
+
+	// DefaultWarnBefore is shorter than the default renewal ahead of expiration, so the renewed certs aren't warned,
+	// and leaves time to replace the certs by hand.
+	DefaultWarnBefore    = 14 * 24 * time.Hour
+	DefaultCheckInterval = time.Hour
+
+	CertExpiringReason = "CertificateExpiring"
+	// TokenExpiredReason means the newest token of the route expired, i.e. the token isn't rotated in time.
+	TokenExpiredReason = "DomainRouteTokenExpired"
+
+	// warnInterval is the interval of warning the same cert
+	warnInterval = 24 * time.Hour
+	apiVersion   = "kuscia.secretflow/v1alpha1"
+)
+
+// Monitor exports the expiration of the certs as metrics, and records warning events before the certs expire.
+// The events of the certs are recorded on the Domain, and the events of the tokens are recorded on the DomainRoutes.
+type Monitor struct {
+	inventory  *Inventory
+	domainID   string
+	recorder   record.EventRecorder
+	warnBefore time.Duration
+	registry   *prometheus.Registry
+	expiry     *prometheus.GaugeVec
+	now        func() time.Time
+	// warned is the last time the certs are warned, indexed by the kind, name and expiration.
+	warned map[string]time.Time
+}
+
+// NewMonitor creates a monitor of the inventory, the recorder may be nil if no event is recorded.
+func NewMonitor(inventory *Inventory, domainID string, recorder record.EventRecorder) *Monitor {
+	m := &Monitor{
+		inventory:  inventory,
+		domainID:   domainID,
+		recorder:   recorder,
+		warnBefore: DefaultWarnBefore,
+		registry:   prometheus.NewRegistry(),
+		expiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "kuscia_cert_expiry_timestamp_seconds",
+			Help: "The unix time the certs and the tokens of the domain expire.",
+		}, []string{"kind", "name"}),
+		now:    time.Now,
+		warned: map[string]time.Time{},
+	}
+	m.registry.MustRegister(m.expiry)
+	return m
+}
+
+// Handler serves the cert metrics in the prometheus text format.
+func (m *Monitor) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Monitor) Run(ctx context.Context, interval time.Duration) {
+	nlog.Infof("Start monitoring the expiration of the certs every %v", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		m.check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Monitor) check(ctx context.Context) {
+	now := m.now()
+	certs := m.inventory.List(ctx)
+	m.expiry.Reset()
+	for _, cert := range certs {
+		m.expiry.WithLabelValues(string(cert.Kind), cert.Name).Set(float64(cert.NotAfter.Unix()))
+		if m.shouldWarn(&cert, now) {
+			m.warn(&cert, now)
+		}
+	}
+	for key, t := range m.warned {
+		if now.Sub(t) >= warnInterval {
+			delete(m.warned, key)
+		}
+	}
+}
+
+// shouldWarn warns the certs expiring in warnBefore, and the tokens expired. The tokens are rotated well before
+// the expiration, so only the expired ones need attention.
+func (m *Monitor) shouldWarn(cert *Certificate, now time.Time) bool {
+	if cert.Kind == KindDomainRouteToken {
+		return !cert.NotAfter.After(now)
+	}
+	return cert.NotAfter.Sub(now) < m.warnBefore
+}
+
+func (m *Monitor) warn(cert *Certificate, now time.Time) {
+	key := fmt.Sprintf("%s/%s/%s/%d", cert.Kind, cert.Namespace, cert.Name, cert.NotAfter.Unix())
+	if t, ok := m.warned[key]; ok && now.Sub(t) < warnInterval {
+		return
+	}
+	m.warned[key] = now
+
+	message := ExpirationMessage(cert, now)
+	nlog.Warn(message)
+	if m.recorder == nil {
+		return
+	}
+	if cert.Kind == KindDomainRouteToken {
+		ref := &corev1.ObjectReference{APIVersion: apiVersion, Kind: "DomainRoute", Namespace: cert.Namespace, Name: cert.Name}
+		m.recorder.Event(ref, corev1.EventTypeWarning, TokenExpiredReason, message)
+		return
+	}
+	ref := &corev1.ObjectReference{APIVersion: apiVersion, Kind: "Domain", Name: m.domainID}
+	m.recorder.Event(ref, corev1.EventTypeWarning, CertExpiringReason, message)
+}
+
+// ExpirationMessage describes when the cert expires, e.g. "KusciaAPIServerCert kusciaapi-server expires at ..., in 10 days".
+func ExpirationMessage(cert *Certificate, now time.Time) string {
+	if !cert.NotAfter.After(now) {
+		return fmt.Sprintf("%s %s expired at %s", cert.Kind, cert.Name, cert.NotAfter.Format(time.RFC3339))
+	}
+	days := int(math.Ceil(cert.NotAfter.Sub(now).Hours() / 24))
+	return fmt.Sprintf("%s %s expires at %s, in %d days", cert.Kind, cert.Name, cert.NotAfter.Format(time.RFC3339), days)
+}
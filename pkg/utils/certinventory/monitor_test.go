@@ -0,0 +1,69 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certinventory
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestMonitorCheck(t *testing.T) {
+	start := time.Now()
+	now := start
+	inventory := NewInventory()
+	inventory.Register("test", func(ctx context.Context) ([]Certificate, error) {
+		return []Certificate{
+			{Kind: KindKusciaAPIServerCert, Name: "kusciaapi-server", NotAfter: start.Add(10 * 24 * time.Hour)},
+			{Kind: KindEnvoyListenerCert, Name: "external-listener", NotAfter: start.Add(100 * 24 * time.Hour)},
+			{Kind: KindDomainRouteToken, Name: "alice-bob", Namespace: "alice", NotAfter: start.Add(time.Hour)},
+			{Kind: KindDomainRouteToken, Name: "alice-carol", Namespace: "alice", NotAfter: start.Add(-time.Hour)},
+		}, nil
+	})
+	recorder := record.NewFakeRecorder(10)
+	m := NewMonitor(inventory, "alice", recorder)
+	m.now = func() time.Time { return now }
+
+	m.check(context.Background())
+	assert.Len(t, recorder.Events, 2)
+	// ordered by the expiration
+	assert.Contains(t, <-recorder.Events, "Warning DomainRouteTokenExpired DomainRouteToken alice-carol expired at")
+	assert.Contains(t, <-recorder.Events, "Warning CertificateExpiring KusciaAPIServerCert kusciaapi-server expires at")
+
+	// the certs are warned once a day, the token alice-bob expired meanwhile
+	m.check(context.Background())
+	assert.Len(t, recorder.Events, 0)
+	now = now.Add(warnInterval)
+	m.check(context.Background())
+	assert.Len(t, recorder.Events, 3)
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest("GET", MetricPath, nil))
+	body := w.Body.String()
+	assert.Contains(t, body, `kuscia_cert_expiry_timestamp_seconds{kind="KusciaAPIServerCert",name="kusciaapi-server"}`)
+	assert.Contains(t, body, `kuscia_cert_expiry_timestamp_seconds{kind="DomainRouteToken",name="alice-carol"}`)
+}
+
+func TestExpirationMessage(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := &Certificate{Kind: KindCACert, Name: "ca", NotAfter: now.Add(36 * time.Hour)}
+	assert.Equal(t, "CACert ca expires at 2025-01-02T12:00:00Z, in 2 days", ExpirationMessage(cert, now))
+	cert.NotAfter = now.Add(-time.Hour)
+	assert.Equal(t, "CACert ca expired at 2024-12-31T23:00:00Z", ExpirationMessage(cert, now))
+}
@@ -301,3 +301,104 @@ func parseCgroup1CPUQuotaAndPeriod(group string) (quota int64, period int64, err
 
 	return quota, period, nil
 }
+
+// GetStats gets the resource usage of the group, e.g. /kuscia.apps/{containerID}.
+func GetStats(group string) (*Stats, error) {
+	mode := cgroups.Mode()
+	switch mode {
+	case cgroups.Unified, cgroups.Hybrid:
+		return getCgroup2Stats(group)
+	case cgroups.Legacy:
+		return getCgroup1Stats(group)
+	default:
+		return nil, fmt.Errorf("unsupported cgroup version: %v", mode)
+	}
+}
+
+func getCgroup2Stats(group string) (*Stats, error) {
+	m, err := cgroup2.Load(group)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := m.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{
+		CPUUsageNanoSeconds:   metrics.GetCPU().GetUsageUsec() * 1000,
+		MemoryWorkingSetBytes: workingSet(metrics.GetMemory().GetUsage(), metrics.GetMemory().GetInactiveFile()),
+	}
+	for _, entry := range metrics.GetIo().GetUsage() {
+		stats.IOReadBytes += entry.GetRbytes()
+		stats.IOWriteBytes += entry.GetWbytes()
+	}
+	return stats, nil
+}
+
+func getCgroup1Stats(group string) (*Stats, error) {
+	cg, err := cgroup1.Load(cgroup1.StaticPath(group))
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := cg.Stat(cgroup1.IgnoreNotExist)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{
+		CPUUsageNanoSeconds:   metrics.GetCPU().GetUsage().GetTotal(),
+		MemoryWorkingSetBytes: workingSet(metrics.GetMemory().GetUsage().GetUsage(), metrics.GetMemory().GetTotalInactiveFile()),
+	}
+	for _, entry := range metrics.GetBlkio().GetIoServiceBytesRecursive() {
+		switch strings.ToLower(entry.GetOp()) {
+		case "read":
+			stats.IOReadBytes += entry.GetValue()
+		case "write":
+			stats.IOWriteBytes += entry.GetValue()
+		}
+	}
+	return stats, nil
+}
+
+func workingSet(usage, inactiveFile uint64) uint64 {
+	if usage < inactiveFile {
+		return 0
+	}
+	return usage - inactiveFile
+}
+
+// GetPids gets the pids of the processes in the group.
+func GetPids(group string) ([]int, error) {
+	var pids []int
+	mode := cgroups.Mode()
+	switch mode {
+	case cgroups.Unified, cgroups.Hybrid:
+		m, err := cgroup2.Load(group)
+		if err != nil {
+			return nil, err
+		}
+		procs, err := m.Procs(false)
+		if err != nil {
+			return nil, err
+		}
+		for _, pid := range procs {
+			pids = append(pids, int(pid))
+		}
+	case cgroups.Legacy:
+		cg, err := cgroup1.Load(cgroup1.StaticPath(group))
+		if err != nil {
+			return nil, err
+		}
+		procs, err := cg.Processes(cgroup1.Cpu, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, proc := range procs {
+			pids = append(pids, proc.Pid)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported cgroup version: %v", mode)
+	}
+	return pids, nil
+}
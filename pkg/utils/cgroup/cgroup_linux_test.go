@@ -217,3 +217,10 @@ func TestParseCgroup2CPUQuotaAndPeriod(t *testing.T) {
 func TestParseCgroup1CPUQuotaAndPeriod(t *testing.T) {
 	parseCgroup1CPUQuotaAndPeriod(DefaultMountPoint)
 }
+
+func TestGetStats(t *testing.T) {
+	_, err := GetStats("/kuscia.test.not.exist")
+	assert.Error(t, err)
+	assert.Equal(t, uint64(0), workingSet(100, 200))
+	assert.Equal(t, uint64(60), workingSet(100, 40))
+}
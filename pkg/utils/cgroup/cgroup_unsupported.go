@@ -57,3 +57,11 @@ func GetMemoryLimit(group string) (int64, error) {
 func GetCPUQuotaAndPeriod(group string) (quota int64, period int64, err error) {
 	return 0, 0, fmt.Errorf("cgroup is not implemented in non LinuxOS")
 }
+
+func GetStats(group string) (*Stats, error) {
+	return nil, fmt.Errorf("cgroup is not implemented in non LinuxOS")
+}
+
+func GetPids(group string) ([]int, error) {
+	return nil, fmt.Errorf("cgroup is not implemented in non LinuxOS")
+}
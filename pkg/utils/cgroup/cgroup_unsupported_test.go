@@ -65,3 +65,8 @@ func TestGetMemoryLimit(t *testing.T) {
 	_, got := GetMemoryLimit("test")
 	assert.NotNil(t, got)
 }
+
+func TestGetStats(t *testing.T) {
+	_, got := GetStats("test")
+	assert.NotNil(t, got)
+}
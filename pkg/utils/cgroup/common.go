@@ -40,3 +40,14 @@ type Manager interface {
 	UpdateCgroup() error
 	DeleteCgroup() error
 }
+
+// Stats is the resource usage of a cgroup.
+type Stats struct {
+	// CPUUsageNanoSeconds is the cumulative cpu time consumed.
+	CPUUsageNanoSeconds uint64
+	// MemoryWorkingSetBytes is the memory usage excluding the inactive file cache.
+	MemoryWorkingSetBytes uint64
+	// IOReadBytes and IOWriteBytes are the cumulative bytes read from and written to the block devices.
+	IOReadBytes  uint64
+	IOWriteBytes uint64
+}
@@ -0,0 +1,234 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	// rootKey is the prefix of the keys stored by k3s, the keys out of it belong to kine itself.
+	rootKey      = "/"
+	migratePage  = 500
+	kineTable    = "kine"
+	kineTableDDL = "CREATE TABLE IF NOT EXISTS `kine` (" +
+		"`id` int(11) NOT NULL AUTO_INCREMENT," +
+		"`name` varchar(630) CHARACTER SET ascii DEFAULT NULL," +
+		"`created` int(11) DEFAULT NULL," +
+		"`deleted` int(11) DEFAULT NULL," +
+		"`create_revision` int(11) DEFAULT NULL," +
+		"`prev_revision` int(11) DEFAULT NULL," +
+		"`lease` int(11) DEFAULT NULL," +
+		"`value` mediumblob," +
+		"`old_value` mediumblob," +
+		"PRIMARY KEY (`id`)," +
+		"UNIQUE KEY `kine_name_prev_revision_uindex` (`name`,`prev_revision`)," +
+		"KEY `kine_name_index` (`name`)," +
+		"KEY `kine_name_id_index` (`name`,`id`)," +
+		"KEY `kine_id_deleted_index` (`id`,`deleted`)," +
+		"KEY `kine_prev_revision_index` (`prev_revision`)" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"
+)
+
+// KeyValue is the latest revision of a key in the datastore.
+type KeyValue struct {
+	Key   string
+	Value []byte
+	// Lease is the id of the lease attached to the key, the key is removed when the lease expires.
+	Lease int64
+}
+
+// Reader lists the keys of the source datastore.
+type Reader interface {
+	Range(ctx context.Context, fn func(kv *KeyValue) error) error
+	Close() error
+}
+
+// Writer creates the keys in the target datastore.
+type Writer interface {
+	// Create creates the key, it returns false if the key already exists.
+	Create(ctx context.Context, kv *KeyValue) (bool, error)
+	Close() error
+}
+
+// MigrateResult is the number of keys migrated.
+type MigrateResult struct {
+	Total   int
+	Created int
+	// Skipped counts the keys existing in the target and the keys attached to a lease, e.g. the events.
+	Skipped int
+}
+
+// Migrate copies the keys of the source datastore into the target datastore. The keys are read at the revision of
+// the first page, the keys written after it are not migrated. No k3s server may run on the target datastore.
+func Migrate(ctx context.Context, reader Reader, writer Writer) (MigrateResult, error) {
+	result := MigrateResult{}
+	err := reader.Range(ctx, func(kv *KeyValue) error {
+		result.Total++
+		if kv.Lease != 0 {
+			nlog.Debugf("Skip key %s attached to lease %d", kv.Key, kv.Lease)
+			result.Skipped++
+			return nil
+		}
+		created, err := writer.Create(ctx, kv)
+		if err != nil {
+			return fmt.Errorf("create key %s failed, %v", kv.Key, err)
+		}
+		if created {
+			result.Created++
+		} else {
+			nlog.Debugf("Skip key %s existing in the target datastore", kv.Key)
+			result.Skipped++
+		}
+		return nil
+	})
+	return result, err
+}
+
+// NewReader connects to the etcd api of the source datastore. Besides etcd, it's the kine socket
+// of the k3s server, e.g. unix:///home/kuscia/var/k3s/server/kine.sock, which serves sqlite and mysql.
+func NewReader(endpoint string, tlsConfig *TLSConfig) (Reader, error) {
+	cli, err := newEtcdClient(endpoint, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &etcdReader{cli: cli}, nil
+}
+
+// NewWriter connects to the target datastore, the endpoint is in the format of datastoreEndpoint.
+func NewWriter(endpoint string, tlsConfig *TLSConfig) (Writer, error) {
+	driver, dsn, err := ParseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	switch driver {
+	case DriverMySQL:
+		c, err := parseMySQLDSN(dsn, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		db, err := sql.Open("mysql", c.FormatDSN())
+		if err != nil {
+			return nil, err
+		}
+		return &kineWriter{db: db}, nil
+	default:
+		cli, err := newEtcdClient(dsn, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		return &etcdWriter{cli: cli}, nil
+	}
+}
+
+type etcdReader struct {
+	cli *clientv3.Client
+}
+
+func (r *etcdReader) Range(ctx context.Context, fn func(kv *KeyValue) error) error {
+	key, end := rootKey, clientv3.GetPrefixRangeEnd(rootKey)
+	var rev int64
+	for {
+		opts := []clientv3.OpOption{clientv3.WithRange(end), clientv3.WithLimit(migratePage)}
+		// pin the following pages to the revision of the first one, so the keys are a consistent snapshot
+		if rev != 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		resp, err := r.cli.Get(ctx, key, opts...)
+		if err != nil {
+			return err
+		}
+		rev = resp.Header.Revision
+		for _, kv := range resp.Kvs {
+			if err = fn(&KeyValue{Key: string(kv.Key), Value: kv.Value, Lease: kv.Lease}); err != nil {
+				return err
+			}
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return nil
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+func (r *etcdReader) Close() error {
+	return r.cli.Close()
+}
+
+type etcdWriter struct {
+	cli *clientv3.Client
+}
+
+func (w *etcdWriter) Create(ctx context.Context, kv *KeyValue) (bool, error) {
+	resp, err := w.cli.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(kv.Key), "=", 0)).
+		Then(clientv3.OpPut(kv.Key, string(kv.Value))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+func (w *etcdWriter) Close() error {
+	return w.cli.Close()
+}
+
+// kineWriter writes the rows of kine directly, as there is no kine serving the target database until k3s starts.
+// A created key is a row with created=1, its revision is the id of the row.
+type kineWriter struct {
+	db          *sql.DB
+	tableExists bool
+}
+
+func (w *kineWriter) Create(ctx context.Context, kv *KeyValue) (bool, error) {
+	if !w.tableExists {
+		if _, err := w.db.ExecContext(ctx, kineTableDDL); err != nil {
+			return false, fmt.Errorf("create table %s failed, %v", kineTable, err)
+		}
+		w.tableExists = true
+	}
+
+	var id, deleted int64
+	err := w.db.QueryRowContext(ctx, "SELECT id, deleted FROM kine WHERE name = ? ORDER BY id DESC LIMIT 1", kv.Key).Scan(&id, &deleted)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		id = 0
+	case err != nil:
+		return false, err
+	case deleted == 0:
+		return false, nil
+	}
+
+	// the row recreating a deleted key refers to the deletion row as its previous revision
+	_, err = w.db.ExecContext(ctx,
+		"INSERT INTO kine(name, created, deleted, create_revision, prev_revision, lease, value, old_value) VALUES(?, 1, 0, 0, ?, 0, ?, NULL)",
+		kv.Key, id, kv.Value)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (w *kineWriter) Close() error {
+	return w.db.Close()
+}
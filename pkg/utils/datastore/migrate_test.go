@@ -0,0 +1,119 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeReader struct {
+	kvs []*KeyValue
+}
+
+func (r *fakeReader) Range(ctx context.Context, fn func(kv *KeyValue) error) error {
+	for _, kv := range r.kvs {
+		if err := fn(kv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeReader) Close() error {
+	return nil
+}
+
+type fakeWriter struct {
+	kvs map[string][]byte
+	err error
+}
+
+func (w *fakeWriter) Create(ctx context.Context, kv *KeyValue) (bool, error) {
+	if w.err != nil {
+		return false, w.err
+	}
+	if _, ok := w.kvs[kv.Key]; ok {
+		return false, nil
+	}
+	w.kvs[kv.Key] = kv.Value
+	return true, nil
+}
+
+func (w *fakeWriter) Close() error {
+	return nil
+}
+
+func TestMigrate(t *testing.T) {
+	reader := &fakeReader{kvs: []*KeyValue{
+		{Key: "/registry/domains/alice", Value: []byte("alice")},
+		{Key: "/registry/domains/bob", Value: []byte("bob")},
+		{Key: "/registry/events/alice/e1", Value: []byte("event"), Lease: 1},
+	}}
+	writer := &fakeWriter{kvs: map[string][]byte{"/registry/domains/bob": []byte("old")}}
+
+	result, err := Migrate(context.Background(), reader, writer)
+	assert.NoError(t, err)
+	assert.Equal(t, MigrateResult{Total: 3, Created: 1, Skipped: 2}, result)
+	assert.Equal(t, []byte("alice"), writer.kvs["/registry/domains/alice"])
+	assert.Equal(t, []byte("old"), writer.kvs["/registry/domains/bob"])
+	assert.NotContains(t, writer.kvs, "/registry/events/alice/e1")
+
+	writer.err = errors.New("connection refused")
+	_, err = Migrate(context.Background(), reader, writer)
+	assert.ErrorContains(t, err, "/registry/domains/alice")
+}
+
+func TestKineWriter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	const (
+		selectSQL = "SELECT id, deleted FROM kine WHERE name = \\? ORDER BY id DESC LIMIT 1"
+		insertSQL = "INSERT INTO kine"
+	)
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS `kine`").WillReturnResult(sqlmock.NewResult(0, 0))
+	// new key
+	mock.ExpectQuery(selectSQL).WithArgs("/registry/a").WillReturnRows(sqlmock.NewRows([]string{"id", "deleted"}))
+	mock.ExpectExec(insertSQL).WithArgs("/registry/a", int64(0), []byte("a")).WillReturnResult(sqlmock.NewResult(1, 1))
+	// existing key
+	mock.ExpectQuery(selectSQL).WithArgs("/registry/b").WillReturnRows(sqlmock.NewRows([]string{"id", "deleted"}).AddRow(2, 0))
+	// deleted key
+	mock.ExpectQuery(selectSQL).WithArgs("/registry/c").WillReturnRows(sqlmock.NewRows([]string{"id", "deleted"}).AddRow(3, 1))
+	mock.ExpectExec(insertSQL).WithArgs("/registry/c", int64(3), []byte("c")).WillReturnResult(sqlmock.NewResult(4, 1))
+
+	writer := &kineWriter{db: db}
+	ctx := context.Background()
+	created, err := writer.Create(ctx, &KeyValue{Key: "/registry/a", Value: []byte("a")})
+	assert.NoError(t, err)
+	assert.True(t, created)
+	created, err = writer.Create(ctx, &KeyValue{Key: "/registry/b", Value: []byte("b")})
+	assert.NoError(t, err)
+	assert.False(t, created)
+	created, err = writer.Create(ctx, &KeyValue{Key: "/registry/c", Value: []byte("c")})
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNewWriterInvalidEndpoint(t *testing.T) {
+	_, err := NewWriter("sqlite://test.db", nil)
+	assert.Error(t, err)
+}
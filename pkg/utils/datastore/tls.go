@@ -0,0 +1,87 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// mysqlTLSConfigName is the name the tls config of the datastore is registered with in the mysql driver.
+const mysqlTLSConfigName = "kuscia-datastore"
+
+// TLSConfig is the client certificates used to connect to the external datastore,
+// they are passed to k3s as --datastore-cafile, --datastore-certfile and --datastore-keyfile.
+type TLSConfig struct {
+	CAFile   string `yaml:"caFile,omitempty"`
+	CertFile string `yaml:"certFile,omitempty"`
+	KeyFile  string `yaml:"keyFile,omitempty"`
+}
+
+// IsEmpty reports whether no certificate is configured.
+func (c *TLSConfig) IsEmpty() bool {
+	return c == nil || (c.CAFile == "" && c.CertFile == "" && c.KeyFile == "")
+}
+
+// ClientConfig builds the client tls config, it's nil if no certificate is configured.
+func (c *TLSConfig) ClientConfig() (*tls.Config, error) {
+	if c.IsEmpty() {
+		return nil, nil
+	}
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return nil, fmt.Errorf("datastore certFile and keyFile must be configured together")
+	}
+
+	tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.CAFile != "" {
+		ca, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read datastore caFile failed, %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("datastore caFile %s contains no pem certificate", c.CAFile)
+		}
+		tlsConf.RootCAs = pool
+	}
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load datastore client certificate failed, %v", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConf, nil
+}
+
+// K3sArgs returns the k3s server args of the certificates.
+func (c *TLSConfig) K3sArgs() []string {
+	if c == nil {
+		return nil
+	}
+	var args []string
+	if c.CAFile != "" {
+		args = append(args, "--datastore-cafile="+c.CAFile)
+	}
+	if c.CertFile != "" {
+		args = append(args, "--datastore-certfile="+c.CertFile)
+	}
+	if c.KeyFile != "" {
+		args = append(args, "--datastore-keyfile="+c.KeyFile)
+	}
+	return args
+}
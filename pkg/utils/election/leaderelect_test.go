@@ -58,3 +58,19 @@ func Test_k8sElector_Run(t *testing.T) {
 	assert.Equal(t, startedLeadingCh, 1)
 	assert.Equal(t, stoppedLeadingCh, 1)
 }
+
+func Test_k8sElector_WithIdentity(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	stopCh := make(chan struct{})
+	ctx := signals.NewKusciaContextWithStopCh(stopCh)
+	elector := NewElector(kubeClient, "test", WithIdentity("10.0.0.1"))
+	assert.Equal(t, "10.0.0.1", elector.MyIdentity())
+
+	go func() {
+		time.Sleep(1 * time.Second)
+		assert.True(t, elector.IsLeader())
+		assert.Equal(t, "10.0.0.1", elector.GetLeader())
+		close(stopCh)
+	}()
+	elector.Run(ctx)
+}
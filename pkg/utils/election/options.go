@@ -123,3 +123,11 @@ func WithNamespace(namespace string) Option {
 		o.Namespace = namespace
 	}
 }
+
+// WithIdentity sets the identity of the candidate, it must be unique among the candidates. The identity of the
+// leader is visible to the others, so it could carry the address that the others reach the leader with.
+func WithIdentity(identity string) Option {
+	return func(o *Options) {
+		o.Identity = identity
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -31,6 +32,7 @@ type KubeClients struct {
 	KubeClient       kubernetes.Interface
 	KusciaClient     kusciaclientset.Interface
 	ExtensionsClient apiextensionsclientset.Interface
+	DynamicClient    dynamic.Interface
 	Kubeconfig       *restclient.Config
 }
 
@@ -48,10 +50,15 @@ func CreateClientSets(config *restclient.Config) (*KubeClients, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error building apiextensions kubernetes client set, detail-> %v", err)
 	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error building dynamic client, detail-> %v", err)
+	}
 	return &KubeClients{
 		KubeClient:       kubeClient,
 		KusciaClient:     kusciaClient,
 		ExtensionsClient: extensionClient,
+		DynamicClient:    dynamicClient,
 		Kubeconfig:       config,
 	}, nil
 }
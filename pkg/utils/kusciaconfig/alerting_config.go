@@ -0,0 +1,238 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	// AlertRuleJobFailureRate fires if the rate of the failed jobs finished in the window reaches the threshold.
+	AlertRuleJobFailureRate = "jobFailureRate"
+	// AlertRuleRouteDown fires for each ClusterDomainRoute which is not ready or whose destination is unreachable.
+	AlertRuleRouteDown = "routeDown"
+	// AlertRuleCertExpiring fires for each domain whose cert expires in threshold days.
+	AlertRuleCertExpiring = "certExpiring"
+	// AlertRuleDiskPressure fires for each node under disk pressure.
+	AlertRuleDiskPressure = "diskPressure"
+
+	AlertNotifierWebhook  = "webhook"
+	AlertNotifierEmail    = "email"
+	AlertNotifierDingTalk = "dingtalk"
+	AlertNotifierWeCom    = "wecom"
+
+	AlertSeverityWarning  = "warning"
+	AlertSeverityCritical = "critical"
+
+	defaultAlertingInterval       = time.Minute
+	defaultAlertingRepeatInterval = 4 * time.Hour
+	defaultAlertRuleWindow        = time.Hour
+	defaultAlertNotifierTimeout   = 10 * time.Second
+)
+
+// AlertingConfig evaluates the alert rules over the jobs, routes, domains and nodes in the master, and sends the
+// firing and resolved alerts to the notifiers.
+type AlertingConfig struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// IntervalSeconds is the interval of evaluating the rules, default 60.
+	IntervalSeconds int `yaml:"intervalSeconds,omitempty"`
+	// RepeatIntervalSeconds is the interval of notifying the alerts which are still firing, default 14400.
+	RepeatIntervalSeconds int                   `yaml:"repeatIntervalSeconds,omitempty"`
+	Rules                 []AlertRuleConfig     `yaml:"rules,omitempty"`
+	Notifiers             []AlertNotifierConfig `yaml:"notifiers,omitempty"`
+}
+
+type AlertRuleConfig struct {
+	Name string `yaml:"name"`
+	// Type is jobFailureRate, routeDown, certExpiring or diskPressure.
+	Type string `yaml:"type"`
+	// Severity is warning or critical, default warning.
+	Severity string `yaml:"severity,omitempty"`
+	// Threshold is the failure rate in (0, 1] of jobFailureRate, or the days before expiration of certExpiring.
+	Threshold float64 `yaml:"threshold,omitempty"`
+	// WindowSeconds is the window of the finished jobs of jobFailureRate, default 3600.
+	WindowSeconds int `yaml:"windowSeconds,omitempty"`
+	// MinJobs is the least finished jobs in the window for jobFailureRate to fire, default 1.
+	MinJobs int `yaml:"minJobs,omitempty"`
+	// ForSeconds is how long the condition lasts before the alert fires, default 0.
+	ForSeconds int `yaml:"forSeconds,omitempty"`
+	// Notifiers are the names of the notifiers of the rule, default all the notifiers.
+	Notifiers []string `yaml:"notifiers,omitempty"`
+}
+
+type AlertNotifierConfig struct {
+	Name string `yaml:"name"`
+	// Type is webhook, email, dingtalk or wecom.
+	Type string `yaml:"type"`
+	// URL is the endpoint of webhook, or the robot webhook url of dingtalk and wecom.
+	URL string `yaml:"url,omitempty"`
+	// Token is sent as a bearer token by webhook if it's not empty.
+	Token string `yaml:"token,omitempty"`
+	// Secret signs the requests of the dingtalk robot whose security setting is signature.
+	Secret string `yaml:"secret,omitempty"`
+	// SMTP is the mail server of email.
+	SMTP           *AlertSMTPConfig `yaml:"smtp,omitempty"`
+	TimeoutSeconds int              `yaml:"timeoutSeconds,omitempty"`
+}
+
+type AlertSMTPConfig struct {
+	Host string `yaml:"host"`
+	// Port is the port of the mail server, default 25. STARTTLS is used if the server supports it.
+	Port     int      `yaml:"port,omitempty"`
+	Username string   `yaml:"username,omitempty"`
+	Password string   `yaml:"password,omitempty"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+}
+
+func CheckAlertingConfig(config *AlertingConfig) error {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	if config.IntervalSeconds < 0 {
+		return fmt.Errorf("alerting intervalSeconds can not be negative")
+	}
+	if config.RepeatIntervalSeconds < 0 {
+		return fmt.Errorf("alerting repeatIntervalSeconds can not be negative")
+	}
+
+	notifiers := map[string]bool{}
+	for i := range config.Notifiers {
+		notifier := &config.Notifiers[i]
+		if notifier.Name == "" {
+			return fmt.Errorf("alerting notifiers[%d] name can not be empty", i)
+		}
+		if notifiers[notifier.Name] {
+			return fmt.Errorf("alerting notifier %q is duplicated", notifier.Name)
+		}
+		notifiers[notifier.Name] = true
+		if err := checkAlertNotifierConfig(notifier); err != nil {
+			return err
+		}
+	}
+
+	rules := map[string]bool{}
+	for i := range config.Rules {
+		rule := &config.Rules[i]
+		if rule.Name == "" {
+			return fmt.Errorf("alerting rules[%d] name can not be empty", i)
+		}
+		if rules[rule.Name] {
+			return fmt.Errorf("alerting rule %q is duplicated", rule.Name)
+		}
+		rules[rule.Name] = true
+		if err := checkAlertRuleConfig(rule); err != nil {
+			return err
+		}
+		for _, name := range rule.Notifiers {
+			if !notifiers[name] {
+				return fmt.Errorf("alerting rule %q refers to unknown notifier %q", rule.Name, name)
+			}
+		}
+	}
+	return nil
+}
+
+func checkAlertRuleConfig(rule *AlertRuleConfig) error {
+	switch rule.Type {
+	case AlertRuleJobFailureRate:
+		if rule.Threshold <= 0 || rule.Threshold > 1 {
+			return fmt.Errorf("alerting rule %q threshold should be a failure rate in (0, 1]", rule.Name)
+		}
+	case AlertRuleCertExpiring:
+		if rule.Threshold <= 0 {
+			return fmt.Errorf("alerting rule %q threshold should be the days before the cert expires", rule.Name)
+		}
+	case AlertRuleRouteDown, AlertRuleDiskPressure:
+	default:
+		return fmt.Errorf("alerting rule %q type %q should be one of %s, %s, %s, %s", rule.Name, rule.Type,
+			AlertRuleJobFailureRate, AlertRuleRouteDown, AlertRuleCertExpiring, AlertRuleDiskPressure)
+	}
+	switch rule.Severity {
+	case "", AlertSeverityWarning, AlertSeverityCritical:
+	default:
+		return fmt.Errorf("alerting rule %q severity %q should be %s or %s", rule.Name, rule.Severity, AlertSeverityWarning, AlertSeverityCritical)
+	}
+	if rule.WindowSeconds < 0 || rule.MinJobs < 0 || rule.ForSeconds < 0 {
+		return fmt.Errorf("alerting rule %q windowSeconds, minJobs and forSeconds can not be negative", rule.Name)
+	}
+	return nil
+}
+
+func checkAlertNotifierConfig(notifier *AlertNotifierConfig) error {
+	switch notifier.Type {
+	case AlertNotifierWebhook, AlertNotifierDingTalk, AlertNotifierWeCom:
+		u, err := url.Parse(notifier.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("alerting notifier %q url %q should be a http or https url", notifier.Name, notifier.URL)
+		}
+	case AlertNotifierEmail:
+		smtp := notifier.SMTP
+		if smtp == nil || smtp.Host == "" || smtp.From == "" || len(smtp.To) == 0 {
+			return fmt.Errorf("alerting notifier %q smtp host, from and to can not be empty", notifier.Name)
+		}
+		if smtp.Port < 0 || smtp.Port > 65535 {
+			return fmt.Errorf("alerting notifier %q smtp port %d is invalid", notifier.Name, smtp.Port)
+		}
+	default:
+		return fmt.Errorf("alerting notifier %q type %q should be one of %s, %s, %s, %s", notifier.Name, notifier.Type,
+			AlertNotifierWebhook, AlertNotifierEmail, AlertNotifierDingTalk, AlertNotifierWeCom)
+	}
+	if notifier.TimeoutSeconds < 0 {
+		return fmt.Errorf("alerting notifier %q timeoutSeconds can not be negative", notifier.Name)
+	}
+	return nil
+}
+
+func (c *AlertingConfig) Interval() time.Duration {
+	if c.IntervalSeconds > 0 {
+		return time.Duration(c.IntervalSeconds) * time.Second
+	}
+	return defaultAlertingInterval
+}
+
+func (c *AlertingConfig) RepeatInterval() time.Duration {
+	if c.RepeatIntervalSeconds > 0 {
+		return time.Duration(c.RepeatIntervalSeconds) * time.Second
+	}
+	return defaultAlertingRepeatInterval
+}
+
+func (r *AlertRuleConfig) Window() time.Duration {
+	if r.WindowSeconds > 0 {
+		return time.Duration(r.WindowSeconds) * time.Second
+	}
+	return defaultAlertRuleWindow
+}
+
+func (r *AlertRuleConfig) For() time.Duration {
+	return time.Duration(r.ForSeconds) * time.Second
+}
+
+func (r *AlertRuleConfig) GetSeverity() string {
+	if r.Severity != "" {
+		return r.Severity
+	}
+	return AlertSeverityWarning
+}
+
+func (n *AlertNotifierConfig) Timeout() time.Duration {
+	if n.TimeoutSeconds > 0 {
+		return time.Duration(n.TimeoutSeconds) * time.Second
+	}
+	return defaultAlertNotifierTimeout
+}
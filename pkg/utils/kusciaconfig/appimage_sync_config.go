@@ -0,0 +1,96 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// AppImageSyncOCIScheme is the scheme of the oci artifact sources, e.g. oci://registry.example.com/kuscia/appimages:v1.
+	AppImageSyncOCIScheme = "oci://"
+
+	defaultAppImageSyncInterval = 5 * time.Minute
+	defaultAppImageSyncTimeout  = 30 * time.Second
+)
+
+// AppImageSyncConfig syncs the app images from a central registry. The registry publishes a bundle of AppImage
+// yaml documents signed by the registry key, the bundle is applied only if the signature is verified.
+type AppImageSyncConfig struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// Source is where the bundle is published, a http or https url, or an oci artifact prefixed with oci://.
+	Source string `yaml:"source,omitempty"`
+	// SignatureURL is the url of the base64 encoded signature of a http source, default the source url suffixed
+	// with .sig. The signature of an oci artifact is in the manifest annotation kuscia.secretflow/signature.
+	SignatureURL string `yaml:"signatureURL,omitempty"`
+	// PublicKeyFile is the PEM encoded RSA public key of the registry verifying the signature.
+	PublicKeyFile string `yaml:"publicKeyFile,omitempty"`
+	// Token is sent as a bearer token to the http source if it's not empty.
+	Token string `yaml:"token,omitempty"`
+	// Username and Password authenticate to the oci registry, the registry is accessed anonymously if empty.
+	Username string `yaml:"username,omitempty"`
+	Password string `yaml:"password,omitempty"`
+	// IntervalSeconds is the interval of syncing, default 300.
+	IntervalSeconds int `yaml:"intervalSeconds,omitempty"`
+	// TimeoutSeconds is the timeout of fetching the bundle, default 30.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+	// Prune deletes the synced app images which are removed from the bundle.
+	Prune bool `yaml:"prune,omitempty"`
+}
+
+func CheckAppImageSyncConfig(config *AppImageSyncConfig) error {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	if config.IsOCI() {
+		if strings.TrimPrefix(config.Source, AppImageSyncOCIScheme) == "" {
+			return fmt.Errorf("appImageSync source %q should be an oci artifact", config.Source)
+		}
+	} else if u, err := url.Parse(config.Source); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("appImageSync source %q should be a http or https url, or an oci artifact prefixed with %s", config.Source, AppImageSyncOCIScheme)
+	}
+	if config.PublicKeyFile == "" {
+		return fmt.Errorf("appImageSync publicKeyFile must be set to verify the app images")
+	}
+	if config.IntervalSeconds < 0 {
+		return fmt.Errorf("appImageSync intervalSeconds can not be negative")
+	}
+	if config.TimeoutSeconds < 0 {
+		return fmt.Errorf("appImageSync timeoutSeconds can not be negative")
+	}
+	return nil
+}
+
+// IsOCI reports whether the bundle is published as an oci artifact.
+func (c *AppImageSyncConfig) IsOCI() bool {
+	return strings.HasPrefix(c.Source, AppImageSyncOCIScheme)
+}
+
+func (c *AppImageSyncConfig) Interval() time.Duration {
+	if c.IntervalSeconds > 0 {
+		return time.Duration(c.IntervalSeconds) * time.Second
+	}
+	return defaultAppImageSyncInterval
+}
+
+func (c *AppImageSyncConfig) Timeout() time.Duration {
+	if c.TimeoutSeconds > 0 {
+		return time.Duration(c.TimeoutSeconds) * time.Second
+	}
+	return defaultAppImageSyncTimeout
+}
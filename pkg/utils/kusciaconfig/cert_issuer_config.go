@@ -0,0 +1,106 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	// CertIssuerSelfSigned signs the certs with the local domain key.
+	CertIssuerSelfSigned = "self-signed"
+	// CertIssuerWebhook asks an external CA to sign the certs by a json webhook.
+	CertIssuerWebhook = "webhook"
+	// CertIssuerCertManager requests the certs by the kubernetes CertificateSigningRequest API, which is signed by
+	// the issuers of cert-manager.
+	CertIssuerCertManager = "cert-manager"
+	// CertIssuerEST enrolls the certs from an EST(RFC 7030) server.
+	CertIssuerEST = "est"
+)
+
+// CertIssuerConfig selects the CA issuing the domain cert and the gateway external listener cert.
+type CertIssuerConfig struct {
+	// Type is the name of the issuer, default self-signed.
+	Type        string                   `yaml:"type,omitempty"`
+	Webhook     *WebhookConfig           `yaml:"webhook,omitempty"`
+	CertManager *CertManagerIssuerConfig `yaml:"certManager,omitempty"`
+	EST         *ESTIssuerConfig         `yaml:"est,omitempty"`
+}
+
+type CertManagerIssuerConfig struct {
+	// KubeconfigFile is the kubeconfig of the cluster running cert-manager, empty means the in-cluster config.
+	KubeconfigFile string `yaml:"kubeconfigFile,omitempty"`
+	// SignerName is the signer of the issuer, e.g. issuers.cert-manager.io/<namespace>.<name> or
+	// clusterissuers.cert-manager.io/<name>.
+	SignerName string `yaml:"signerName,omitempty"`
+	// TimeoutSeconds is how long to wait for the request to be approved and signed, default 300.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+}
+
+type ESTIssuerConfig struct {
+	// Endpoint is the base url of the EST server, e.g. https://est.example.com/.well-known/est.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	Username string `yaml:"username,omitempty"`
+	Password string `yaml:"password,omitempty"`
+	// CAFile is the CA verifying the EST server, empty means the system CAs.
+	CAFile         string `yaml:"caFile,omitempty"`
+	TimeoutSeconds int    `yaml:"timeoutSeconds,omitempty"`
+}
+
+func CheckCertIssuerConfig(config *CertIssuerConfig) error {
+	if config == nil {
+		return nil
+	}
+	switch config.TypeName() {
+	case CertIssuerSelfSigned:
+	case CertIssuerWebhook:
+		if config.Webhook == nil {
+			return fmt.Errorf("certIssuer webhook must be set for the webhook issuer")
+		}
+		return CheckWebhookConfig(config.Webhook, "certIssuer")
+	case CertIssuerCertManager:
+		if config.CertManager == nil || config.CertManager.SignerName == "" {
+			return fmt.Errorf("certIssuer certManager signerName must be set for the cert-manager issuer")
+		}
+		if config.CertManager.TimeoutSeconds < 0 {
+			return fmt.Errorf("certIssuer certManager timeoutSeconds can not be negative")
+		}
+	case CertIssuerEST:
+		if config.EST == nil {
+			return fmt.Errorf("certIssuer est must be set for the est issuer")
+		}
+		u, err := url.Parse(config.EST.Endpoint)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("certIssuer est endpoint %q should be a https url", config.EST.Endpoint)
+		}
+		if config.EST.TimeoutSeconds < 0 {
+			return fmt.Errorf("certIssuer est timeoutSeconds can not be negative")
+		}
+	}
+	return nil
+}
+
+func (c *CertIssuerConfig) TypeName() string {
+	if c != nil && c.Type != "" {
+		return c.Type
+	}
+	return CertIssuerSelfSigned
+}
+
+// IsExternal reports whether the certs are issued by an external CA instead of the local domain key.
+func (c *CertIssuerConfig) IsExternal() bool {
+	return c.TypeName() != CertIssuerSelfSigned
+}
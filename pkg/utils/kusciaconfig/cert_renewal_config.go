@@ -0,0 +1,63 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultCertCheckInterval = time.Hour
+	defaultCertRenewBefore   = 30 * 24 * time.Hour
+)
+
+// CertRenewalConfig defines when the domain certs, the mTLS certs of routes and the gateway listener certs are
+// renewed ahead of expiration. The renewed certs are signed by the issuer of CertIssuerConfig.
+type CertRenewalConfig struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// CheckIntervalSeconds is the interval of checking the expiration of the certs, default 1 hour.
+	CheckIntervalSeconds int `yaml:"checkIntervalSeconds,omitempty"`
+	// RenewBeforeSeconds is how long before the expiration the certs are renewed, default 30 days. At most a
+	// third of the validity period is used for the short-lived certs.
+	RenewBeforeSeconds int `yaml:"renewBeforeSeconds,omitempty"`
+}
+
+func CheckCertRenewalConfig(config *CertRenewalConfig) error {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	if config.CheckIntervalSeconds < 0 {
+		return fmt.Errorf("certRenewal checkIntervalSeconds can not be negative")
+	}
+	if config.RenewBeforeSeconds < 0 {
+		return fmt.Errorf("certRenewal renewBeforeSeconds can not be negative")
+	}
+	return nil
+}
+
+func (c *CertRenewalConfig) CheckInterval() time.Duration {
+	if c.CheckIntervalSeconds > 0 {
+		return time.Duration(c.CheckIntervalSeconds) * time.Second
+	}
+	return defaultCertCheckInterval
+}
+
+func (c *CertRenewalConfig) RenewBefore() time.Duration {
+	if c.RenewBeforeSeconds > 0 {
+		return time.Duration(c.RenewBeforeSeconds) * time.Second
+	}
+	return defaultCertRenewBefore
+}
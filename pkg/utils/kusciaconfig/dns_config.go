@@ -0,0 +1,97 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// DNSConfig customizes the names resolved by the embedded coredns of the domain, so that the engines resolve the
+// services of partners and the internal hostnames of the enterprise without editing the images.
+type DNSConfig struct {
+	// Upstreams are the nameservers resolving the names out of the domain, an IP or IP:port, default the
+	// nameservers of the host.
+	Upstreams []string `yaml:"upstreams,omitempty"`
+	// StubDomains forwards the names of the zones to their own nameservers, e.g. corp.example.com: [10.0.0.53].
+	StubDomains map[string][]string `yaml:"stubDomains,omitempty"`
+	// Hosts overrides the addresses of the hostnames, they take precedence over the upstreams.
+	Hosts []DNSHostEntry `yaml:"hosts,omitempty"`
+	// Searches are appended to the search domains of the engine pods, only runc and runk support it.
+	Searches []string `yaml:"searches,omitempty"`
+}
+
+// DNSHostEntry resolves the hostnames to the IP.
+type DNSHostEntry struct {
+	IP        string   `yaml:"ip"`
+	Hostnames []string `yaml:"hostnames"`
+}
+
+func CheckDNSConfig(config *DNSConfig) error {
+	if config == nil {
+		return nil
+	}
+	for _, upstream := range config.Upstreams {
+		if !isNameserver(upstream) {
+			return fmt.Errorf("dns upstream %q should be an IP or IP:port", upstream)
+		}
+	}
+	for zone, servers := range config.StubDomains {
+		if errs := validation.IsDNS1123Subdomain(zone); len(errs) > 0 {
+			return fmt.Errorf("dns stubDomain %q is invalid, %s", zone, strings.Join(errs, ", "))
+		}
+		if len(servers) == 0 {
+			return fmt.Errorf("dns stubDomain %q has no nameserver", zone)
+		}
+		for _, server := range servers {
+			if !isNameserver(server) {
+				return fmt.Errorf("dns nameserver %q of stubDomain %q should be an IP or IP:port", server, zone)
+			}
+		}
+	}
+	for _, host := range config.Hosts {
+		if net.ParseIP(host.IP) == nil {
+			return fmt.Errorf("dns hosts ip %q is invalid", host.IP)
+		}
+		if len(host.Hostnames) == 0 {
+			return fmt.Errorf("dns hosts ip %q has no hostname", host.IP)
+		}
+		for _, hostname := range host.Hostnames {
+			if errs := validation.IsDNS1123Subdomain(hostname); len(errs) > 0 {
+				return fmt.Errorf("dns hosts hostname %q is invalid, %s", hostname, strings.Join(errs, ", "))
+			}
+		}
+	}
+	for _, search := range config.Searches {
+		if errs := validation.IsDNS1123Subdomain(search); len(errs) > 0 {
+			return fmt.Errorf("dns search %q is invalid, %s", search, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+func isNameserver(server string) bool {
+	if net.ParseIP(server) != nil {
+		return true
+	}
+	host, port, err := net.SplitHostPort(server)
+	if err != nil || port == "" {
+		return false
+	}
+	return net.ParseIP(host) != nil
+}
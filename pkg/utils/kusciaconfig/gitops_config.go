@@ -0,0 +1,124 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// GitOpsOCIScheme is the scheme of the oci artifact sources, e.g. oci://registry.example.com/kuscia/fleet:v1.
+	GitOpsOCIScheme = "oci://"
+
+	// GitOpsDriftPolicyCorrect overwrites the changes made to the reconciled resources out of the source.
+	GitOpsDriftPolicyCorrect = "correct"
+	// GitOpsDriftPolicyReport only reports the changes made to the reconciled resources out of the source.
+	GitOpsDriftPolicyReport = "report"
+
+	defaultGitOpsInterval = 5 * time.Minute
+	defaultGitOpsTimeout  = 2 * time.Minute
+)
+
+// GitOpsConfig reconciles the Domains, DomainRoutes, AppImages and DomainDataSources declared in a git repository
+// or an oci artifact, so that the domains of a fleet are managed by pull requests.
+type GitOpsConfig struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// Source is the git repository, a http, https or ssh url, or an oci artifact prefixed with oci://.
+	Source string `yaml:"source,omitempty"`
+	// Revision is the branch, tag or commit of the git repository, default the HEAD of the remote.
+	Revision string `yaml:"revision,omitempty"`
+	// Path is the directory of the resource manifests in the git repository, default the root.
+	Path string `yaml:"path,omitempty"`
+	// Username and Password authenticate to the http git repository or the oci registry.
+	Username string `yaml:"username,omitempty"`
+	Password string `yaml:"password,omitempty"`
+	// SSHKeyFile is the private key authenticating to the ssh git repository.
+	SSHKeyFile string `yaml:"sshKeyFile,omitempty"`
+	// IntervalSeconds is the interval of reconciling, default 300.
+	IntervalSeconds int `yaml:"intervalSeconds,omitempty"`
+	// TimeoutSeconds is the timeout of fetching the source, default 120.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+	// Prune deletes the reconciled resources which are removed from the source.
+	Prune bool `yaml:"prune,omitempty"`
+	// DriftPolicy is correct or report, default correct.
+	DriftPolicy string `yaml:"driftPolicy,omitempty"`
+}
+
+func CheckGitOpsConfig(config *GitOpsConfig) error {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	if config.IsOCI() {
+		if strings.TrimPrefix(config.Source, GitOpsOCIScheme) == "" {
+			return fmt.Errorf("gitOps source %q should be an oci artifact", config.Source)
+		}
+	} else if !isGitURL(config.Source) {
+		return fmt.Errorf("gitOps source %q should be a http, https or ssh git url, or an oci artifact prefixed with %s", config.Source, GitOpsOCIScheme)
+	}
+	if strings.HasPrefix(config.Revision, "-") {
+		return fmt.Errorf("gitOps revision %q is invalid", config.Revision)
+	}
+	if config.IntervalSeconds < 0 {
+		return fmt.Errorf("gitOps intervalSeconds can not be negative")
+	}
+	if config.TimeoutSeconds < 0 {
+		return fmt.Errorf("gitOps timeoutSeconds can not be negative")
+	}
+	switch config.DriftPolicy {
+	case "", GitOpsDriftPolicyCorrect, GitOpsDriftPolicyReport:
+	default:
+		return fmt.Errorf("gitOps driftPolicy %q should be %s or %s", config.DriftPolicy, GitOpsDriftPolicyCorrect, GitOpsDriftPolicyReport)
+	}
+	return nil
+}
+
+func isGitURL(source string) bool {
+	// scp-like syntax of ssh, e.g. git@github.com:org/fleet.git
+	if strings.HasPrefix(source, "git@") && strings.Contains(source, ":") {
+		return true
+	}
+	u, err := url.Parse(source)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return u.Scheme == "http" || u.Scheme == "https" || u.Scheme == "ssh"
+}
+
+// IsOCI reports whether the resources are published as an oci artifact.
+func (c *GitOpsConfig) IsOCI() bool {
+	return strings.HasPrefix(c.Source, GitOpsOCIScheme)
+}
+
+// CorrectDrift reports whether the drifted resources are overwritten by the source.
+func (c *GitOpsConfig) CorrectDrift() bool {
+	return c.DriftPolicy != GitOpsDriftPolicyReport
+}
+
+func (c *GitOpsConfig) Interval() time.Duration {
+	if c.IntervalSeconds > 0 {
+		return time.Duration(c.IntervalSeconds) * time.Second
+	}
+	return defaultGitOpsInterval
+}
+
+func (c *GitOpsConfig) Timeout() time.Duration {
+	if c.TimeoutSeconds > 0 {
+		return time.Duration(c.TimeoutSeconds) * time.Second
+	}
+	return defaultGitOpsTimeout
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+const (
+	JobArchiveStoreS3    = "s3"
+	JobArchiveStoreOSS   = "oss"
+	JobArchiveStoreMySQL = "mysql"
+
+	defaultJobArchiveRetention = 7 * 24 * time.Hour
+	defaultJobArchiveInterval  = 10 * time.Minute
+	defaultJobArchiveTable     = "kuscia_archived_job"
+)
+
+var tableNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// JobArchiveConfig archives the finished KusciaJobs to an external store after the retention, and deletes them from
+// the cluster. The KusciaJob garbage collection is disabled if the archive is enabled.
+type JobArchiveConfig struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// RetentionSeconds is how long the finished jobs are kept in the cluster before archived, default 604800.
+	RetentionSeconds int `yaml:"retentionSeconds,omitempty"`
+	// IntervalSeconds is the interval of archiving the jobs, default 600.
+	IntervalSeconds int                   `yaml:"intervalSeconds,omitempty"`
+	Store           JobArchiveStoreConfig `yaml:"store"`
+}
+
+type JobArchiveStoreConfig struct {
+	// Type is s3, oss or mysql. The oss store talks to the s3 compatible api of the oss.
+	Type string `yaml:"type"`
+	// Endpoint is the endpoint of s3 and oss, e.g. https://oss-cn-hangzhou.aliyuncs.com.
+	Endpoint        string `yaml:"endpoint,omitempty"`
+	Bucket          string `yaml:"bucket,omitempty"`
+	Prefix          string `yaml:"prefix,omitempty"`
+	Region          string `yaml:"region,omitempty"`
+	AccessKeyID     string `yaml:"accessKeyID,omitempty"`
+	AccessKeySecret string `yaml:"accessKeySecret,omitempty"`
+	// Virtualhost uses the virtual hosted style urls, which the oss requires.
+	Virtualhost bool `yaml:"virtualhost,omitempty"`
+	// MaxObjectBytes is the max size of an archived job object read from s3 and oss, default 64MiB.
+	MaxObjectBytes int64 `yaml:"maxObjectBytes,omitempty"`
+	// DSN is the data source name of mysql, e.g. user:password@tcp(127.0.0.1:3306)/kuscia.
+	DSN string `yaml:"dsn,omitempty"`
+	// Table is the mysql table of the archived jobs, default kuscia_archived_job.
+	Table string `yaml:"table,omitempty"`
+}
+
+func CheckJobArchiveConfig(config *JobArchiveConfig) error {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	if config.RetentionSeconds < 0 || config.IntervalSeconds < 0 {
+		return fmt.Errorf("jobArchive retentionSeconds and intervalSeconds can not be negative")
+	}
+	store := &config.Store
+	switch store.Type {
+	case JobArchiveStoreS3, JobArchiveStoreOSS:
+		if store.Endpoint == "" || store.Bucket == "" {
+			return fmt.Errorf("jobArchive store endpoint and bucket can not be empty")
+		}
+	case JobArchiveStoreMySQL:
+		if store.DSN == "" {
+			return fmt.Errorf("jobArchive store dsn can not be empty")
+		}
+		if store.Table != "" && !tableNameRegexp.MatchString(store.Table) {
+			return fmt.Errorf("jobArchive store table %q should only contain letters, digits and underscores", store.Table)
+		}
+	default:
+		return fmt.Errorf("jobArchive store type %q should be one of %s, %s, %s", store.Type,
+			JobArchiveStoreS3, JobArchiveStoreOSS, JobArchiveStoreMySQL)
+	}
+	return nil
+}
+
+func (c *JobArchiveConfig) Retention() time.Duration {
+	if c.RetentionSeconds > 0 {
+		return time.Duration(c.RetentionSeconds) * time.Second
+	}
+	return defaultJobArchiveRetention
+}
+
+func (c *JobArchiveConfig) Interval() time.Duration {
+	if c.IntervalSeconds > 0 {
+		return time.Duration(c.IntervalSeconds) * time.Second
+	}
+	return defaultJobArchiveInterval
+}
+
+func (c *JobArchiveStoreConfig) GetTable() string {
+	if c.Table != "" {
+		return c.Table
+	}
+	return defaultJobArchiveTable
+}
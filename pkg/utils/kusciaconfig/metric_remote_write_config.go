@@ -0,0 +1,86 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultMetricRemoteWriteInterval = 15 * time.Second
+	defaultMetricRemoteWriteTimeout  = 10 * time.Second
+)
+
+// MetricRemoteWriteConfig pushes the metrics of the domain to a central TSDB, e.g. Prometheus or VictoriaMetrics,
+// with the prometheus remote write protocol, so that the domains behind NAT are monitored without being scraped.
+type MetricRemoteWriteConfig struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// Endpoint is the remote write url, e.g. http://prometheus:9090/api/v1/write.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// Username and Password authenticate with basic auth.
+	Username string `yaml:"username,omitempty"`
+	Password string `yaml:"password,omitempty"`
+	// BearerToken is sent in the Authorization header if it's not empty.
+	BearerToken string `yaml:"bearerToken,omitempty"`
+	// Headers are sent with each request, e.g. X-Scope-OrgID of the multi-tenant TSDBs.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// TLS verifies the endpoint with the caFile and authenticates with the certFile and keyFile if they're set.
+	TLS *TLSConfig `yaml:"tls,omitempty"`
+	// ExternalLabels are added to the series unless the series have the labels, the domain label is always added.
+	ExternalLabels map[string]string `yaml:"externalLabels,omitempty"`
+	// IntervalSeconds is the interval of pushing, default 15.
+	IntervalSeconds int `yaml:"intervalSeconds,omitempty"`
+	// TimeoutSeconds is the timeout of a push, default 10.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+}
+
+func CheckMetricRemoteWriteConfig(config *MetricRemoteWriteConfig) error {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	u, err := url.Parse(config.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("metricRemoteWrite endpoint %q should be a http or https url", config.Endpoint)
+	}
+	if config.BearerToken != "" && (config.Username != "" || config.Password != "") {
+		return fmt.Errorf("metricRemoteWrite could only authenticate with either basic auth or bearer token")
+	}
+	if config.IntervalSeconds < 0 {
+		return fmt.Errorf("metricRemoteWrite intervalSeconds can not be negative")
+	}
+	if config.TimeoutSeconds < 0 {
+		return fmt.Errorf("metricRemoteWrite timeoutSeconds can not be negative")
+	}
+	if config.TLS != nil && (config.TLS.CertFile == "") != (config.TLS.KeyFile == "") {
+		return fmt.Errorf("metricRemoteWrite tls certFile and keyFile should be set together")
+	}
+	return nil
+}
+
+func (c *MetricRemoteWriteConfig) Interval() time.Duration {
+	if c.IntervalSeconds > 0 {
+		return time.Duration(c.IntervalSeconds) * time.Second
+	}
+	return defaultMetricRemoteWriteInterval
+}
+
+func (c *MetricRemoteWriteConfig) Timeout() time.Duration {
+	if c.TimeoutSeconds > 0 {
+		return time.Duration(c.TimeoutSeconds) * time.Second
+	}
+	return defaultMetricRemoteWriteTimeout
+}
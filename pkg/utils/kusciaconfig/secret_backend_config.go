@@ -0,0 +1,132 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	// SecretBackendLocal seals the secrets with the local domain key.
+	SecretBackendLocal = "local"
+	// SecretBackendVault seals the secrets by the transit secrets engine of HashiCorp Vault.
+	SecretBackendVault = "vault"
+	// SecretBackendKMS seals the secrets by a cloud KMS through its encrypt/decrypt json API.
+	SecretBackendKMS = "kms"
+)
+
+// SecretBackendConfig selects the secret manager sealing the domain private key and the confs of ConfManager,
+// e.g. the datasource credentials.
+type SecretBackendConfig struct {
+	// Type is the name of the backend, default local.
+	Type string `yaml:"type,omitempty"`
+	// SealedDomainKey means domainKeyData of kuscia.yaml is sealed by the backend and is unsealed on startup.
+	SealedDomainKey bool                `yaml:"sealedDomainKey,omitempty"`
+	Vault           *VaultBackendConfig `yaml:"vault,omitempty"`
+	KMS             *KMSBackendConfig   `yaml:"kms,omitempty"`
+}
+
+type VaultBackendConfig struct {
+	// Address is the url of the vault server, e.g. https://vault.example.com:8200.
+	Address string `yaml:"address,omitempty"`
+	// Token is the vault token, TokenFile is read instead if it's empty.
+	Token     string `yaml:"token,omitempty"`
+	TokenFile string `yaml:"tokenFile,omitempty"`
+	// Namespace is the vault enterprise namespace.
+	Namespace string `yaml:"namespace,omitempty"`
+	// Mount is the mount path of the transit secrets engine, default transit.
+	Mount string `yaml:"mount,omitempty"`
+	// KeyName is the name of the transit encryption key.
+	KeyName string `yaml:"keyName,omitempty"`
+	// CAFile is the CA verifying the vault server, empty means the system CAs.
+	CAFile         string `yaml:"caFile,omitempty"`
+	TimeoutSeconds int    `yaml:"timeoutSeconds,omitempty"`
+}
+
+type KMSBackendConfig struct {
+	// Endpoint is the base url of the KMS API, the secrets are sealed by POST {endpoint}/encrypt and unsealed by
+	// POST {endpoint}/decrypt.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// KeyID is the id of the master key in the KMS.
+	KeyID string `yaml:"keyID,omitempty"`
+	// Token is sent as a bearer token in the Authorization header if it's not empty.
+	Token string `yaml:"token,omitempty"`
+	// CAFile is the CA verifying the KMS, empty means the system CAs.
+	CAFile         string `yaml:"caFile,omitempty"`
+	TimeoutSeconds int    `yaml:"timeoutSeconds,omitempty"`
+}
+
+func CheckSecretBackendConfig(config *SecretBackendConfig) error {
+	if config == nil {
+		return nil
+	}
+	switch config.TypeName() {
+	case SecretBackendLocal:
+		if config.SealedDomainKey {
+			return fmt.Errorf("secretBackend sealedDomainKey is not supported by the local backend")
+		}
+	case SecretBackendVault:
+		if config.Vault == nil {
+			return fmt.Errorf("secretBackend vault must be set for the vault backend")
+		}
+		if err := checkHTTPEndpoint(config.Vault.Address, "secretBackend vault address"); err != nil {
+			return err
+		}
+		if config.Vault.KeyName == "" {
+			return fmt.Errorf("secretBackend vault keyName must be set")
+		}
+		if config.Vault.Token == "" && config.Vault.TokenFile == "" {
+			return fmt.Errorf("secretBackend vault token or tokenFile must be set")
+		}
+		if config.Vault.TimeoutSeconds < 0 {
+			return fmt.Errorf("secretBackend vault timeoutSeconds can not be negative")
+		}
+	case SecretBackendKMS:
+		if config.KMS == nil {
+			return fmt.Errorf("secretBackend kms must be set for the kms backend")
+		}
+		if err := checkHTTPEndpoint(config.KMS.Endpoint, "secretBackend kms endpoint"); err != nil {
+			return err
+		}
+		if config.KMS.KeyID == "" {
+			return fmt.Errorf("secretBackend kms keyID must be set")
+		}
+		if config.KMS.TimeoutSeconds < 0 {
+			return fmt.Errorf("secretBackend kms timeoutSeconds can not be negative")
+		}
+	}
+	return nil
+}
+
+func checkHTTPEndpoint(endpoint, name string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s %q should be a http or https url", name, endpoint)
+	}
+	return nil
+}
+
+func (c *SecretBackendConfig) TypeName() string {
+	if c != nil && c.Type != "" {
+		return c.Type
+	}
+	return SecretBackendLocal
+}
+
+// IsExternal reports whether the secrets are sealed by an external secret manager instead of the local domain key.
+func (c *SecretBackendConfig) IsExternal() bool {
+	return c.TypeName() != SecretBackendLocal
+}
@@ -17,6 +17,8 @@ package kusciaconfig
 import (
 	"crypto/rsa"
 	"fmt"
+
+	"github.com/secretflow/kuscia/pkg/utils/datastore"
 )
 
 type ServiceConfig struct {
@@ -31,14 +33,15 @@ type APIServerConfig struct {
 
 type MasterConfig struct {
 	ServiceConfig     `yaml:",inline"`
-	DatastoreEndpoint string           `yaml:"datastoreEndpoint,omitempty"`
-	ClusterToken      string           `yaml:"clusterToken"`
-	APIServer         *APIServerConfig `yaml:"apiserver,omitempty"`
-	KusciaStorage     *ServiceConfig   `yaml:"kusciaStorage,omitempty"`
-	KusciaAPI         *ServiceConfig   `yaml:"kusciaAPI,omitempty"`
-	Reporter          *ServiceConfig   `yaml:"reporter,omitempty"`
-	APIWhitelist      []string         `yaml:"apiWhitelist,omitempty"`
-	MasterPubkey      *rsa.PublicKey   `yaml:"masterPubkey,omitempty"` // for rsa token gen in first handshake to master
+	DatastoreEndpoint string               `yaml:"datastoreEndpoint,omitempty"`
+	DatastoreTLS      *datastore.TLSConfig `yaml:"datastoreTLS,omitempty"`
+	ClusterToken      string               `yaml:"clusterToken"`
+	APIServer         *APIServerConfig     `yaml:"apiserver,omitempty"`
+	KusciaStorage     *ServiceConfig       `yaml:"kusciaStorage,omitempty"`
+	KusciaAPI         *ServiceConfig       `yaml:"kusciaAPI,omitempty"`
+	Reporter          *ServiceConfig       `yaml:"reporter,omitempty"`
+	APIWhitelist      []string             `yaml:"apiWhitelist,omitempty"`
+	MasterPubkey      *rsa.PublicKey       `yaml:"masterPubkey,omitempty"` // for rsa token gen in first handshake to master
 }
 
 func (m *MasterConfig) IsMaster() bool {
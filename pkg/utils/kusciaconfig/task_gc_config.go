@@ -0,0 +1,78 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"time"
+)
+
+const defaultTaskGCInterval = 5 * time.Minute
+
+// TaskGCConfig deletes the pods, services, configmaps and TaskResources of the finished KusciaTasks after the ttls
+// of the phase the tasks finished in. The pods still running are always deleted when the tasks finish. If it's
+// disabled, the resources of the succeeded tasks are deleted when the tasks finish, and the failed pods, configmaps
+// and TaskResources of the failed tasks are kept until the tasks are deleted.
+type TaskGCConfig struct {
+	Enable bool `yaml:"enable,omitempty"`
+	// IntervalSeconds is the interval of collecting the expired resources, default 300.
+	IntervalSeconds int          `yaml:"intervalSeconds,omitempty"`
+	Succeeded       TaskGCPolicy `yaml:"succeeded,omitempty"`
+	Failed          TaskGCPolicy `yaml:"failed,omitempty"`
+}
+
+// TaskGCPolicy is the ttls counted from the completion time of the tasks. A zero ttl deletes the resources when the
+// tasks finish, and a negative ttl keeps them until the tasks are deleted.
+type TaskGCPolicy struct {
+	// PodTTLSeconds is the ttl of the finished pods and their services.
+	PodTTLSeconds int `yaml:"podTTLSeconds,omitempty"`
+	// ConfigMapTTLSeconds is the ttl of the configmaps generated for the tasks.
+	ConfigMapTTLSeconds int `yaml:"configMapTTLSeconds,omitempty"`
+	// TaskResourceTTLSeconds is the ttl of the TaskResourceGroups and the TaskResources.
+	TaskResourceTTLSeconds int `yaml:"taskResourceTTLSeconds,omitempty"`
+}
+
+func CheckTaskGCConfig(config *TaskGCConfig) error {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	if config.IntervalSeconds < 0 {
+		return fmt.Errorf("taskGC intervalSeconds can not be negative")
+	}
+	return nil
+}
+
+func (c *TaskGCConfig) Interval() time.Duration {
+	if c.IntervalSeconds > 0 {
+		return time.Duration(c.IntervalSeconds) * time.Second
+	}
+	return defaultTaskGCInterval
+}
+
+func ttlDuration(seconds int) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
+
+func (p *TaskGCPolicy) PodTTL() time.Duration {
+	return ttlDuration(p.PodTTLSeconds)
+}
+
+func (p *TaskGCPolicy) ConfigMapTTL() time.Duration {
+	return ttlDuration(p.ConfigMapTTLSeconds)
+}
+
+func (p *TaskGCPolicy) TaskResourceTTL() time.Duration {
+	return ttlDuration(p.TaskResourceTTLSeconds)
+}
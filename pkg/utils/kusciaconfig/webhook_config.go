@@ -0,0 +1,41 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kusciaconfig
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type WebhookConfig struct {
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// Token is sent as a bearer token in the Authorization header if it's not empty.
+	Token          string `yaml:"token,omitempty"`
+	TimeoutSeconds int    `yaml:"timeoutSeconds,omitempty"`
+}
+
+func CheckWebhookConfig(config *WebhookConfig, name string) error {
+	if config == nil {
+		return nil
+	}
+	u, err := url.Parse(config.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("webhook(%s) endpoint %q should be a http or https url", name, config.Endpoint)
+	}
+	if config.TimeoutSeconds < 0 {
+		return fmt.Errorf("webhook(%s) timeoutSeconds can not be negative", name)
+	}
+	return nil
+}
@@ -19,7 +19,8 @@ import (
 	"net"
 )
 
-// GetHostIP gets IPv4 address of network interface eth0.
+// GetHostIP gets IPv4 address of network interface eth0. The global IPv6 address is returned if eth0 has no
+// IPv4 address, e.g. in the IPv6 only network.
 func GetHostIP() (string, error) {
 	ifaces, err := net.Interfaces()
 	if err != nil {
@@ -44,7 +45,7 @@ func GetHostIP() (string, error) {
 		return "", err
 	}
 
-	var address string
+	var ipv6Address string
 	for _, addr := range addrs {
 		var ip net.IP
 		switch v := addr.(type) {
@@ -57,16 +58,18 @@ func GetHostIP() (string, error) {
 		if ip == nil {
 			continue
 		}
-
-		address = ip.String()
 		if ip.To4() != nil {
-			break
+			return ip.String(), nil
+		}
+		// the link-local addresses are only reachable on the same link, and they need the zone to be used
+		if ipv6Address == "" && !ip.IsLinkLocalUnicast() && !ip.IsLoopback() {
+			ipv6Address = ip.String()
 		}
 	}
 
-	if address == "" {
-		return address, errors.New("host IP unknown")
+	if ipv6Address == "" {
+		return "", errors.New("host IP unknown")
 	}
 
-	return address, nil
+	return ipv6Address, nil
 }
@@ -372,3 +372,30 @@ func Move(oldPath, newPath string) error {
 
 	return os.Rename(oldPath, newPath)
 }
+
+// DirSize returns the total size of the regular files under the directory, symlinks are not followed. Files removed
+// during the walk are skipped, and 0 is returned if the directory doesn't exist.
+func DirSize(dir string) (uint64, error) {
+	var size uint64
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		size += uint64(info.Size())
+		return nil
+	})
+	return size, err
+}
@@ -117,3 +117,19 @@ func TestMove(t *testing.T) {
 	newPath := filepath.Join(rootDir, "dst.txt")
 	assert.NoError(t, Move(oldPath, newPath))
 }
+
+func TestDirSize(t *testing.T) {
+	rootDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(rootDir, "a.txt"), []byte("hello"), 0644))
+	assert.NoError(t, os.MkdirAll(filepath.Join(rootDir, "sub"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(rootDir, "sub", "b.txt"), []byte("world!"), 0644))
+	assert.NoError(t, os.Symlink(filepath.Join(rootDir, "a.txt"), filepath.Join(rootDir, "link")))
+
+	size, err := DirSize(rootDir)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(11), size)
+
+	size, err = DirSize(filepath.Join(rootDir, "not-exist"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), size)
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxResponseBytes bounds the response of the backend, a decision is far smaller than it.
+const maxResponseBytes = 1 << 20
+
+// httpBackend posts the Request as json to the endpoint and expects a Decision as json response.
+type httpBackend struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+func NewHTTPBackend(conf *Config) (Backend, error) {
+	if conf.Endpoint == "" {
+		return nil, fmt.Errorf("quota http backend endpoint can not be empty")
+	}
+	return &httpBackend{
+		endpoint: conf.Endpoint,
+		token:    conf.Token,
+		client:   &http.Client{},
+	}, nil
+}
+
+func (b *httpBackend) Check(ctx context.Context, req *Request) (*Decision, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, b.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if b.token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+b.token)
+	}
+	resp, err := b.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(respBody) > maxResponseBytes {
+		return nil, fmt.Errorf("quota backend response exceeds %d bytes", maxResponseBytes)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("quota backend returns unexpected status code %d, body: %s", resp.StatusCode, respBody)
+	}
+	decision := &Decision{}
+	if err := json.Unmarshal(respBody, decision); err != nil {
+		return nil, fmt.Errorf("unmarshal quota decision failed: %v", err)
+	}
+	return decision, nil
+}
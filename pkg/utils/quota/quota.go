@@ -0,0 +1,242 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+type Resource string
+
+const (
+	ResourceJob           Resource = "job"
+	ResourceStorage       Resource = "storage"
+	ResourceTransferBytes Resource = "transferBytes"
+)
+
+type FailurePolicy string
+
+const (
+	// FailOpen allows the request when the quota backend is unavailable.
+	FailOpen FailurePolicy = "open"
+	// FailClosed rejects the request when the quota backend is unavailable.
+	FailClosed FailurePolicy = "closed"
+)
+
+const (
+	HTTPBackendType = "http"
+
+	defaultCacheTTL = 30 * time.Second
+	defaultTimeout  = 3 * time.Second
+)
+
+// ErrQuotaExceeded is returned when the backend denies the request.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// Request is a quota check of the amount of resource used by the domain.
+type Request struct {
+	DomainID string   `json:"domainId"`
+	Resource Resource `json:"resource"`
+	Amount   int64    `json:"amount"`
+}
+
+// Decision is the result of a quota check.
+type Decision struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+	// Remaining is the amount the domain can still use after this request. An allowed decision is only cached
+	// with a positive remaining amount, and the later requests served from the cache are counted against it.
+	Remaining int64 `json:"remaining,omitempty"`
+	// TTLSeconds overrides the cache ttl of this decision, 0 means using the configured ttl.
+	TTLSeconds int64 `json:"ttlSeconds,omitempty"`
+}
+
+// Backend makes quota decisions, e.g. by asking an external billing or entitlement service.
+type Backend interface {
+	Check(ctx context.Context, req *Request) (*Decision, error)
+}
+
+type Config struct {
+	// Backend is the backend type, only "http" is supported now.
+	Backend  string `yaml:"backend"`
+	Endpoint string `yaml:"endpoint,omitempty"`
+	Token    string `yaml:"token,omitempty"`
+	// TimeoutSeconds is the timeout of each backend call, default is 3s.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+	// CacheTTLSeconds is how long a decision is cached locally, default is 30s, negative disables the cache.
+	CacheTTLSeconds int `yaml:"cacheTTLSeconds,omitempty"`
+	// FailurePolicy is used when the backend is unavailable, default is open.
+	FailurePolicy FailurePolicy `yaml:"failurePolicy,omitempty"`
+}
+
+func NewBackend(conf *Config) (Backend, error) {
+	switch conf.Backend {
+	case HTTPBackendType:
+		return NewHTTPBackend(conf)
+	default:
+		return nil, fmt.Errorf("quota doesn't support backend: %q", conf.Backend)
+	}
+}
+
+type cacheKey struct {
+	domainID string
+	resource Resource
+}
+
+type cacheEntry struct {
+	decision *Decision
+	// remaining is the amount left of an allowed decision, each request served from the cache consumes it.
+	remaining int64
+	// denied is the amount of the request a denial was made for, it also denies the larger requests.
+	denied   int64
+	expireAt time.Time
+}
+
+// Checker checks quota with a backend, caching its decisions locally.
+type Checker struct {
+	backend       Backend
+	cacheTTL      time.Duration
+	timeout       time.Duration
+	failurePolicy FailurePolicy
+
+	mu    sync.Mutex
+	cache map[cacheKey]*cacheEntry
+
+	now func() time.Time
+}
+
+// NewChecker returns a Checker with the backend of conf, or nil if conf is nil.
+func NewChecker(conf *Config) (*Checker, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	backend, err := NewBackend(conf)
+	if err != nil {
+		return nil, err
+	}
+	return NewCheckerWithBackend(backend, conf)
+}
+
+// NewCheckerWithBackend returns a Checker with a custom backend, conf.Backend is ignored.
+func NewCheckerWithBackend(backend Backend, conf *Config) (*Checker, error) {
+	c := &Checker{
+		backend:       backend,
+		cacheTTL:      defaultCacheTTL,
+		timeout:       defaultTimeout,
+		failurePolicy: FailOpen,
+		cache:         map[cacheKey]*cacheEntry{},
+		now:           time.Now,
+	}
+	if conf.CacheTTLSeconds != 0 {
+		c.cacheTTL = time.Duration(conf.CacheTTLSeconds) * time.Second
+	}
+	if conf.TimeoutSeconds > 0 {
+		c.timeout = time.Duration(conf.TimeoutSeconds) * time.Second
+	}
+	switch conf.FailurePolicy {
+	case "":
+	case FailOpen, FailClosed:
+		c.failurePolicy = conf.FailurePolicy
+	default:
+		return nil, fmt.Errorf("invalid quota failure policy %q, must be one of [%s, %s]", conf.FailurePolicy, FailOpen, FailClosed)
+	}
+	return c, nil
+}
+
+// Check returns nil if the request is allowed, an error wrapping ErrQuotaExceeded if it's denied,
+// or the backend error when the backend is unavailable and the failure policy is closed.
+func (c *Checker) Check(ctx context.Context, req *Request) error {
+	if c == nil {
+		return nil
+	}
+	decision, ok := c.getCache(req)
+	if !ok {
+		var err error
+		checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		decision, err = c.backend.Check(checkCtx, req)
+		cancel()
+		if err != nil {
+			if c.failurePolicy == FailClosed {
+				return fmt.Errorf("check %s quota of domain %q failed: %v", req.Resource, req.DomainID, err)
+			}
+			nlog.Warnf("Check %s quota of domain %q failed, allow it since failure policy is %s: %v", req.Resource, req.DomainID, c.failurePolicy, err)
+			return nil
+		}
+		c.setCache(req, decision)
+	}
+	if !decision.Allowed {
+		if decision.Reason != "" {
+			return fmt.Errorf("%w: %s quota of domain %q, %s", ErrQuotaExceeded, req.Resource, req.DomainID, decision.Reason)
+		}
+		return fmt.Errorf("%w: %s quota of domain %q", ErrQuotaExceeded, req.Resource, req.DomainID)
+	}
+	return nil
+}
+
+// getCache returns the cached decision of the request. A cached allowed decision is only used if its remaining
+// amount covers the request, which is then consumed, so that a burst of requests can't exceed the quota. A cached
+// denial is only used for the requests not smaller than the denied one.
+func (c *Checker) getCache(req *Request) (*Decision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := cacheKey{domainID: req.DomainID, resource: req.Resource}
+	entry, ok := c.cache[key]
+	if !ok {
+		return nil, false
+	}
+	if c.now().After(entry.expireAt) {
+		delete(c.cache, key)
+		return nil, false
+	}
+	if !entry.decision.Allowed {
+		return entry.decision, req.Amount >= entry.denied
+	}
+	if entry.remaining < req.Amount {
+		delete(c.cache, key)
+		return nil, false
+	}
+	entry.remaining -= req.Amount
+	return entry.decision, true
+}
+
+func (c *Checker) setCache(req *Request, decision *Decision) {
+	ttl := c.cacheTTL
+	if decision.TTLSeconds > 0 {
+		ttl = time.Duration(decision.TTLSeconds) * time.Second
+	}
+	if ttl <= 0 || (decision.Allowed && decision.Remaining <= 0) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for key, entry := range c.cache {
+		if now.After(entry.expireAt) {
+			delete(c.cache, key)
+		}
+	}
+	c.cache[cacheKey{domainID: req.DomainID, resource: req.Resource}] = &cacheEntry{
+		decision:  decision,
+		remaining: decision.Remaining,
+		denied:    req.Amount,
+		expireAt:  now.Add(ttl),
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeBackend struct {
+	calls    int
+	decision *Decision
+	err      error
+}
+
+func (b *fakeBackend) Check(ctx context.Context, req *Request) (*Decision, error) {
+	b.calls++
+	return b.decision, b.err
+}
+
+func TestCheckerCache(t *testing.T) {
+	backend := &fakeBackend{decision: &Decision{Allowed: true, Remaining: 2}}
+	c, err := NewCheckerWithBackend(backend, &Config{CacheTTLSeconds: 10})
+	assert.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	c.now = func() time.Time { return now }
+
+	req := &Request{DomainID: "alice", Resource: ResourceJob, Amount: 1}
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, c.Check(context.Background(), req))
+	}
+	assert.Equal(t, 1, backend.calls)
+
+	// the remaining amount is used up, the backend is asked again
+	assert.NoError(t, c.Check(context.Background(), req))
+	assert.Equal(t, 2, backend.calls)
+
+	now = now.Add(11 * time.Second)
+	backend.decision = &Decision{Allowed: false, Reason: "balance is not enough"}
+	err = c.Check(context.Background(), req)
+	assert.True(t, errors.Is(err, ErrQuotaExceeded))
+	assert.Contains(t, err.Error(), "balance is not enough")
+	assert.Equal(t, 3, backend.calls)
+	err = c.Check(context.Background(), req)
+	assert.True(t, errors.Is(err, ErrQuotaExceeded))
+	assert.Equal(t, 3, backend.calls)
+}
+
+func TestCheckerCacheWithoutRemaining(t *testing.T) {
+	backend := &fakeBackend{decision: &Decision{Allowed: true}}
+	c, err := NewCheckerWithBackend(backend, &Config{CacheTTLSeconds: 10})
+	assert.NoError(t, err)
+
+	req := &Request{DomainID: "alice", Resource: ResourceStorage, Amount: 1024}
+	assert.NoError(t, c.Check(context.Background(), req))
+	assert.NoError(t, c.Check(context.Background(), req))
+	assert.Equal(t, 2, backend.calls)
+}
+
+func TestCheckerFailurePolicy(t *testing.T) {
+	req := &Request{DomainID: "alice", Resource: ResourceTransferBytes, Amount: 1024}
+
+	backend := &fakeBackend{err: errors.New("connection refused")}
+	c, err := NewCheckerWithBackend(backend, &Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, c.Check(context.Background(), req))
+
+	c, err = NewCheckerWithBackend(backend, &Config{FailurePolicy: FailClosed})
+	assert.NoError(t, err)
+	err = c.Check(context.Background(), req)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrQuotaExceeded))
+
+	_, err = NewCheckerWithBackend(backend, &Config{FailurePolicy: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestNilChecker(t *testing.T) {
+	c, err := NewChecker(nil)
+	assert.NoError(t, err)
+	assert.NoError(t, c.Check(context.Background(), &Request{DomainID: "alice", Resource: ResourceJob, Amount: 1}))
+}
+
+func TestHTTPBackend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		req := &Request{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		_ = json.NewEncoder(w).Encode(&Decision{Allowed: req.Amount <= 100})
+	}))
+	defer server.Close()
+
+	c, err := NewChecker(&Config{Backend: HTTPBackendType, Endpoint: server.URL, Token: "secret", CacheTTLSeconds: -1})
+	assert.NoError(t, err)
+	assert.NoError(t, c.Check(context.Background(), &Request{DomainID: "alice", Resource: ResourceStorage, Amount: 100}))
+	err = c.Check(context.Background(), &Request{DomainID: "alice", Resource: ResourceStorage, Amount: 101})
+	assert.True(t, errors.Is(err, ErrQuotaExceeded))
+
+	_, err = NewChecker(&Config{Backend: HTTPBackendType})
+	assert.Error(t, err)
+	_, err = NewChecker(&Config{Backend: "grpc"})
+	assert.Error(t, err)
+}
+
+func TestHTTPBackendResponseTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(bytes.Repeat([]byte(" "), maxResponseBytes+1))
+	}))
+	defer server.Close()
+
+	b, err := NewHTTPBackend(&Config{Endpoint: server.URL})
+	assert.NoError(t, err)
+	_, err = b.Check(context.Background(), &Request{DomainID: "alice", Resource: ResourceJob, Amount: 1})
+	assert.ErrorContains(t, err, "exceeds")
+}
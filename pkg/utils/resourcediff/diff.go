@@ -0,0 +1,362 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcediff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciaclientset "github.com/secretflow/kuscia/pkg/crd/clientset/versioned"
+)
+
+type DiffType string
+
+const (
+	// DiffAdded means the field is set by the manifest but not by the live object.
+	DiffAdded DiffType = "Added"
+	// DiffRemoved means the field of the live object isn't set by the manifest.
+	DiffRemoved DiffType = "Removed"
+	// DiffChanged means the field is set to different values.
+	DiffChanged DiffType = "Changed"
+)
+
+// FieldDiff is a difference of a field. The elements of the lists keyed by alias or name are referred as
+// [alias=xxx] or [name=xxx] in the path, and the others by the whole list.
+type FieldDiff struct {
+	Path    string      `json:"path"`
+	Type    DiffType    `json:"type"`
+	Live    interface{} `json:"live,omitempty"`
+	Desired interface{} `json:"desired,omitempty"`
+}
+
+// Result is the differences between a manifest and its live object.
+type Result struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// NotFound means the live object doesn't exist, and will be created by applying the manifest.
+	NotFound bool        `json:"notFound,omitempty"`
+	Diffs    []FieldDiff `json:"diffs,omitempty"`
+}
+
+// HasDiff reports whether applying the manifest will change the cluster.
+func (r *Result) HasDiff() bool {
+	return r.NotFound || len(r.Diffs) > 0
+}
+
+// Differ compares the manifests with the live objects in the cluster.
+type Differ struct {
+	kusciaClient kusciaclientset.Interface
+}
+
+func NewDiffer(kusciaClient kusciaclientset.Interface) *Differ {
+	return &Differ{kusciaClient: kusciaClient}
+}
+
+// Diff compares the manifest obj with its live object.
+func (d *Differ) Diff(ctx context.Context, obj runtime.Object) (*Result, error) {
+	result, live, err := d.getLive(ctx, obj)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			result.NotFound = true
+			return result, nil
+		}
+		return nil, fmt.Errorf("get %s %s failed, %v", result.Kind, result.Name, err)
+	}
+	result.Diffs, err = Compare(live, obj)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *Differ) getLive(ctx context.Context, obj runtime.Object) (*Result, runtime.Object, error) {
+	client := d.kusciaClient.KusciaV1alpha1()
+	switch o := obj.(type) {
+	case *kusciaapisv1alpha1.AppImage:
+		result := &Result{Kind: "AppImage", Name: o.Name}
+		live, err := client.AppImages().Get(ctx, o.Name, metav1.GetOptions{})
+		return result, live, err
+	case *kusciaapisv1alpha1.KusciaJob:
+		result := &Result{Kind: "KusciaJob", Namespace: o.Namespace, Name: o.Name}
+		live, err := client.KusciaJobs(o.Namespace).Get(ctx, o.Name, metav1.GetOptions{})
+		return result, live, err
+	case *kusciaapisv1alpha1.DomainRoute:
+		result := &Result{Kind: "DomainRoute", Namespace: o.Namespace, Name: o.Name}
+		live, err := client.DomainRoutes(o.Namespace).Get(ctx, o.Name, metav1.GetOptions{})
+		return result, live, err
+	case *kusciaapisv1alpha1.ClusterDomainRoute:
+		result := &Result{Kind: "ClusterDomainRoute", Name: o.Name}
+		live, err := client.ClusterDomainRoutes().Get(ctx, o.Name, metav1.GetOptions{})
+		return result, live, err
+	default:
+		return &Result{}, nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+}
+
+// Compare returns the field differences between the live and desired objects. The defaults of the crd
+// schemas are applied to both before comparing, and the fields filled by the controllers are ignored if the
+// desired object doesn't set them.
+func Compare(live, desired runtime.Object) ([]FieldDiff, error) {
+	liveContent, err := normalize(live)
+	if err != nil {
+		return nil, err
+	}
+	desiredContent, err := normalize(desired)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []FieldDiff
+	compareValue("", "", liveContent, desiredContent, &diffs)
+	return diffs, nil
+}
+
+func compareValue(path, pattern string, live, desired interface{}, diffs *[]FieldDiff) {
+	switch {
+	case isEmpty(live) && isEmpty(desired):
+		return
+	case isEmpty(desired):
+		if live = pruneServerManaged(pattern, live); !isEmpty(live) {
+			*diffs = append(*diffs, FieldDiff{Path: path, Type: DiffRemoved, Live: live})
+		}
+		return
+	case isEmpty(live):
+		*diffs = append(*diffs, FieldDiff{Path: path, Type: DiffAdded, Desired: desired})
+		return
+	}
+
+	liveMap, liveIsMap := live.(map[string]interface{})
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	if liveIsMap && desiredIsMap {
+		keys := make([]string, 0, len(liveMap)+len(desiredMap))
+		for k := range liveMap {
+			keys = append(keys, k)
+		}
+		for k := range desiredMap {
+			if _, ok := liveMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			compareValue(joinPath(path, k), joinPath(pattern, k), liveMap[k], desiredMap[k], diffs)
+		}
+		return
+	}
+
+	liveList, liveIsList := live.([]interface{})
+	desiredList, desiredIsList := desired.([]interface{})
+	if liveIsList && desiredIsList {
+		if key := listKey(liveList, desiredList); key != "" {
+			compareKeyedList(path, pattern, key, liveList, desiredList, diffs)
+			return
+		}
+	}
+
+	if !semanticEqual(live, desired) {
+		*diffs = append(*diffs, FieldDiff{Path: path, Type: DiffChanged, Live: live, Desired: desired})
+	}
+}
+
+// compareKeyedList compares the elements with the same key, in the order of the desired list followed by
+// the elements only in the live list.
+func compareKeyedList(path, pattern, key string, live, desired []interface{}, diffs *[]FieldDiff) {
+	liveByKey := map[string]interface{}{}
+	for _, item := range live {
+		liveByKey[item.(map[string]interface{})[key].(string)] = item
+	}
+	seen := map[string]bool{}
+	for _, item := range desired {
+		value := item.(map[string]interface{})[key].(string)
+		seen[value] = true
+		compareValue(fmt.Sprintf("%s[%s=%s]", path, key, value), pattern+"[*]", liveByKey[value], item, diffs)
+	}
+	for _, item := range live {
+		value := item.(map[string]interface{})[key].(string)
+		if !seen[value] {
+			compareValue(fmt.Sprintf("%s[%s=%s]", path, key, value), pattern+"[*]", item, nil, diffs)
+		}
+	}
+}
+
+// listKey returns the field the elements of both lists are identified by, or empty if the lists should be
+// compared as a whole.
+func listKey(lists ...[]interface{}) string {
+	for _, key := range []string{"alias", "name"} {
+		if isKeyedBy(key, lists...) {
+			return key
+		}
+	}
+	return ""
+}
+
+func isKeyedBy(key string, lists ...[]interface{}) bool {
+	for _, list := range lists {
+		seen := map[string]bool{}
+		for _, item := range list {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			value, ok := m[key].(string)
+			if !ok || value == "" || seen[value] {
+				return false
+			}
+			seen[value] = true
+		}
+	}
+	return true
+}
+
+// semanticEqual compares the json strings, such as the task input config, by their decoded values.
+func semanticEqual(live, desired interface{}) bool {
+	if reflect.DeepEqual(live, desired) {
+		return true
+	}
+	liveStr, ok1 := live.(string)
+	desiredStr, ok2 := desired.(string)
+	if !ok1 || !ok2 || !looksLikeJSON(liveStr) || !looksLikeJSON(desiredStr) {
+		return false
+	}
+	var liveValue, desiredValue interface{}
+	if json.Unmarshal([]byte(liveStr), &liveValue) != nil || json.Unmarshal([]byte(desiredStr), &desiredValue) != nil {
+		return false
+	}
+	return reflect.DeepEqual(liveValue, desiredValue)
+}
+
+func looksLikeJSON(s string) bool {
+	s = strings.TrimSpace(s)
+	return strings.HasPrefix(s, "{") || strings.HasPrefix(s, "[")
+}
+
+func isEmpty(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(value) == 0
+	case []interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+// pruneServerManaged removes the server managed fields from the live value which isn't set by the manifest.
+func pruneServerManaged(pattern string, value interface{}) interface{} {
+	if isServerManaged(pattern) {
+		return nil
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		pruned := map[string]interface{}{}
+		for k, child := range v {
+			if child = pruneServerManaged(joinPath(pattern, k), child); !isEmpty(child) {
+				pruned[k] = child
+			}
+		}
+		return pruned
+	case []interface{}:
+		pruned := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			pruned = append(pruned, pruneServerManaged(pattern+"[*]", item))
+		}
+		return pruned
+	}
+	return value
+}
+
+// isServerManaged matches the pattern with serverManagedFields, the keys of labels and annotations may contain
+// dots, so the fields ending with .* match all the fields under the prefix.
+func isServerManaged(pattern string) bool {
+	for field := range serverManagedFields {
+		if field == pattern || (strings.HasSuffix(field, ".*") && strings.HasPrefix(pattern, strings.TrimSuffix(field, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// WriteText writes the results in a human readable format, '+' for the added fields, '-' for the removed
+// fields and '~' for the changed fields.
+func WriteText(w io.Writer, results []*Result) error {
+	for _, r := range results {
+		name := r.Name
+		if r.Namespace != "" {
+			name = r.Namespace + "/" + r.Name
+		}
+		var err error
+		switch {
+		case r.NotFound:
+			_, err = fmt.Fprintf(w, "%s %s: not found, will be created\n", r.Kind, name)
+		case len(r.Diffs) == 0:
+			_, err = fmt.Fprintf(w, "%s %s: no differences\n", r.Kind, name)
+		default:
+			_, err = fmt.Fprintf(w, "%s %s:\n", r.Kind, name)
+			for _, d := range r.Diffs {
+				if err != nil {
+					break
+				}
+				switch d.Type {
+				case DiffAdded:
+					_, err = fmt.Fprintf(w, "  + %s: %s\n", d.Path, formatValue(d.Desired))
+				case DiffRemoved:
+					_, err = fmt.Fprintf(w, "  - %s: %s\n", d.Path, formatValue(d.Live))
+				default:
+					_, err = fmt.Fprintf(w, "  ~ %s: %s -> %s\n", d.Path, formatValue(d.Live), formatValue(d.Desired))
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the results as a json array, for the CI systems to parse.
+func WriteJSON(w io.Writer, results []*Result) error {
+	if results == nil {
+		results = []*Result{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
+
+func formatValue(v interface{}) string {
+	content, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(content)
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcediff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	kusciafake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
+)
+
+const testManifests = `
+# the job to run
+apiVersion: kuscia.secretflow/v1alpha1
+kind: KusciaJob
+metadata:
+  name: job-1
+spec:
+  initiator: alice
+  maxParallelism: 2
+  tasks:
+    - alias: train
+      appImage: secretflow-image
+      taskInputConfig: '{"b": 1, "a": [1, 2]}'
+      parties:
+        - domainID: alice
+        - domainID: bob
+    - alias: predict
+      appImage: secretflow-image
+      taskInputConfig: '{}'
+      dependencies: [train]
+      parties:
+        - domainID: alice
+---
+---
+apiVersion: kuscia.secretflow/v1alpha1
+kind: ClusterDomainRoute
+metadata:
+  name: alice-bob
+spec:
+  source: alice
+  destination: bob
+  authenticationType: Token
+`
+
+func newLiveJob() *kusciaapisv1alpha1.KusciaJob {
+	maxParallelism := 1
+	tolerable := false
+	return &kusciaapisv1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "job-1",
+			Namespace: common.KusciaCrossDomain,
+			Labels:    map[string]string{common.LabelInterConnProtocolType: "kuscia"},
+		},
+		Spec: kusciaapisv1alpha1.KusciaJobSpec{
+			Initiator:      "alice",
+			ScheduleMode:   kusciaapisv1alpha1.KusciaJobScheduleModeStrict,
+			MaxParallelism: &maxParallelism,
+			Tasks: []kusciaapisv1alpha1.KusciaTaskTemplate{
+				{
+					Alias:           "train",
+					TaskID:          "job-1-train",
+					Tolerable:       &tolerable,
+					AppImage:        "secretflow-image",
+					TaskInputConfig: `{"a":[1,2],"b":1}`,
+					Parties:         []kusciaapisv1alpha1.Party{{DomainID: "alice"}, {DomainID: "bob"}},
+				},
+				{
+					Alias:           "evaluate",
+					TaskID:          "job-1-evaluate",
+					Tolerable:       &tolerable,
+					AppImage:        "secretflow-image",
+					TaskInputConfig: `{}`,
+					Parties:         []kusciaapisv1alpha1.Party{{DomainID: "alice"}},
+				},
+			},
+		},
+	}
+}
+
+func TestParseManifests(t *testing.T) {
+	objs, err := ParseManifests([]byte(testManifests))
+	assert.NoError(t, err)
+	assert.Len(t, objs, 2)
+	job, ok := objs[0].(*kusciaapisv1alpha1.KusciaJob)
+	assert.True(t, ok)
+	assert.Equal(t, common.KusciaCrossDomain, job.Namespace)
+	_, ok = objs[1].(*kusciaapisv1alpha1.ClusterDomainRoute)
+	assert.True(t, ok)
+
+	_, err = ParseManifests([]byte("apiVersion: kuscia.secretflow/v1alpha1\nkind: Domain\nmetadata:\n  name: alice\n"))
+	assert.ErrorContains(t, err, "unsupported kind Domain")
+
+	_, err = ParseManifests([]byte("apiVersion: kuscia.secretflow/v1alpha1\nkind: DomainRoute\nmetadata:\n  name: alice-bob\n"))
+	assert.ErrorContains(t, err, "namespace of DomainRoute alice-bob must be set")
+}
+
+func TestCompare(t *testing.T) {
+	objs, err := ParseManifests([]byte(testManifests))
+	assert.NoError(t, err)
+
+	diffs, err := Compare(newLiveJob(), objs[0])
+	assert.NoError(t, err)
+	// the defaulted schedule mode and tolerable, the generated task ids, the labels added by the controller
+	// and the reformatted task input config aren't differences
+	assert.Equal(t, []FieldDiff{
+		{Path: "spec.maxParallelism", Type: DiffChanged, Live: int64(1), Desired: int64(2)},
+		{Path: "spec.tasks[alias=predict]", Type: DiffAdded, Desired: map[string]interface{}{
+			"alias":           "predict",
+			"appImage":        "secretflow-image",
+			"taskInputConfig": "{}",
+			"dependencies":    []interface{}{"train"},
+			"tolerable":       false,
+			"parties":         []interface{}{map[string]interface{}{"domainID": "alice"}},
+		}},
+		{Path: "spec.tasks[alias=evaluate]", Type: DiffRemoved, Live: map[string]interface{}{
+			"alias":           "evaluate",
+			"appImage":        "secretflow-image",
+			"taskInputConfig": "{}",
+			"tolerable":       false,
+			"parties":         []interface{}{map[string]interface{}{"domainID": "alice"}},
+		}},
+	}, diffs)
+
+	live := &kusciaapisv1alpha1.AppImage{Spec: kusciaapisv1alpha1.AppImageSpec{
+		DeployTemplates: []kusciaapisv1alpha1.DeployTemplate{{Name: "secretflow", Spec: kusciaapisv1alpha1.PodSpec{
+			Containers: []kusciaapisv1alpha1.Container{{Name: "secretflow", Ports: []kusciaapisv1alpha1.ContainerPort{
+				{Name: "spu", Port: 20000, Protocol: kusciaapisv1alpha1.ProtocolGRPC, Scope: kusciaapisv1alpha1.ScopeLocal},
+			}}},
+		}}},
+	}}
+	desired := live.DeepCopy()
+	desired.Spec.DeployTemplates[0].Spec.Containers[0].Ports[0].Scope = ""
+	desired.Spec.DeployTemplates[0].Spec.Containers[0].Ports[0].Protocol = ""
+	diffs, err = Compare(live, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, []FieldDiff{{
+		Path:    "spec.deployTemplates[name=secretflow].spec.containers[name=secretflow].ports[name=spu].protocol",
+		Type:    DiffChanged,
+		Live:    "GRPC",
+		Desired: "HTTP",
+	}}, diffs)
+}
+
+func TestDiffer(t *testing.T) {
+	objs, err := ParseManifests([]byte(testManifests))
+	assert.NoError(t, err)
+	differ := NewDiffer(kusciafake.NewSimpleClientset(newLiveJob()))
+
+	var results []*Result
+	for _, obj := range objs {
+		result, err := differ.Diff(context.Background(), obj)
+		assert.NoError(t, err)
+		assert.True(t, result.HasDiff())
+		results = append(results, result)
+	}
+	assert.Len(t, results[0].Diffs, 3)
+	assert.True(t, results[1].NotFound)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, WriteText(buf, results))
+	assert.Contains(t, buf.String(), "KusciaJob cross-domain/job-1:\n  ~ spec.maxParallelism: 1 -> 2\n")
+	assert.Contains(t, buf.String(), "ClusterDomainRoute alice-bob: not found, will be created\n")
+
+	buf.Reset()
+	assert.NoError(t, WriteJSON(buf, results))
+	var decoded []*Result
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "spec.maxParallelism", decoded[0].Diffs[0].Path)
+}
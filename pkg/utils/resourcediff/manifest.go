@@ -0,0 +1,94 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcediff
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/secretflow/kuscia/pkg/common"
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/crd/clientset/versioned/scheme"
+)
+
+// ParseManifests decodes the yaml or json documents of data into kuscia objects. Only the kinds which can
+// be diffed are accepted.
+func ParseManifests(data []byte) ([]runtime.Object, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	decoder := scheme.Codecs.UniversalDeserializer()
+	var objs []runtime.Object
+	for i := 0; ; i++ {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read document %d failed, %v", i, err)
+		}
+		// skip the documents of only comments or separators
+		content := map[string]interface{}{}
+		if err := yaml.Unmarshal(doc, &content); err != nil {
+			return nil, fmt.Errorf("parse document %d failed, %v", i, err)
+		}
+		if len(content) == 0 {
+			continue
+		}
+
+		obj, gvk, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("decode document %d failed, %v", i, err)
+		}
+		if !isSupported(obj) {
+			return nil, fmt.Errorf("document %d: unsupported kind %s, only AppImage, KusciaJob, DomainRoute and ClusterDomainRoute can be diffed", i, gvk.Kind)
+		}
+		if err := defaultNamespace(obj); err != nil {
+			return nil, fmt.Errorf("document %d: %v", i, err)
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+func isSupported(obj runtime.Object) bool {
+	switch obj.(type) {
+	case *kusciaapisv1alpha1.AppImage, *kusciaapisv1alpha1.KusciaJob,
+		*kusciaapisv1alpha1.DomainRoute, *kusciaapisv1alpha1.ClusterDomainRoute:
+		return true
+	}
+	return false
+}
+
+// defaultNamespace fills the namespace the objects are created in, KusciaJob is always in the cross domain
+// namespace while the namespace of DomainRoute must be given.
+func defaultNamespace(obj runtime.Object) error {
+	switch o := obj.(type) {
+	case *kusciaapisv1alpha1.KusciaJob:
+		if o.Namespace == "" {
+			o.Namespace = common.KusciaCrossDomain
+		}
+	case *kusciaapisv1alpha1.DomainRoute:
+		if o.Namespace == "" {
+			return fmt.Errorf("namespace of DomainRoute %s must be set", o.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcediff
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+// serverManagedFields are filled by the controllers, so they are only compared if the manifest sets them.
+// The list elements are matched by [*].
+var serverManagedFields = map[string]bool{
+	"metadata.labels.*":                     true,
+	"metadata.annotations.*":                true,
+	"spec.tasks[*].taskID":                  true,
+	"spec.tasks[*].sensitiveInputConfigs":   true,
+	"spec.tokenConfig.sourcePublicKey":      true,
+	"spec.tokenConfig.destinationPublicKey": true,
+}
+
+// normalize applies the defaults of the crd schemas to obj, and returns the fields which are compared: the
+// labels, annotations and spec.
+func normalize(obj runtime.Object) (map[string]interface{}, error) {
+	obj = obj.DeepCopyObject()
+	switch o := obj.(type) {
+	case *kusciaapisv1alpha1.KusciaJob:
+		defaultKusciaJob(o)
+	case *kusciaapisv1alpha1.AppImage:
+		defaultAppImage(o)
+	case *kusciaapisv1alpha1.DomainRoute, *kusciaapisv1alpha1.ClusterDomainRoute:
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	if spec, ok := content["spec"]; ok {
+		result["spec"] = spec
+	}
+	metadata := map[string]interface{}{}
+	if meta, ok := content["metadata"].(map[string]interface{}); ok {
+		for _, key := range []string{"labels", "annotations"} {
+			if v, ok := meta[key]; ok {
+				metadata[key] = v
+			}
+		}
+	}
+	if len(metadata) > 0 {
+		result["metadata"] = metadata
+	}
+	return result, nil
+}
+
+func defaultKusciaJob(job *kusciaapisv1alpha1.KusciaJob) {
+	if job.Spec.ScheduleMode == "" {
+		job.Spec.ScheduleMode = kusciaapisv1alpha1.KusciaJobScheduleModeStrict
+	}
+	if job.Spec.MaxParallelism == nil {
+		maxParallelism := 1
+		job.Spec.MaxParallelism = &maxParallelism
+	}
+	for i := range job.Spec.Tasks {
+		if job.Spec.Tasks[i].Tolerable == nil {
+			tolerable := false
+			job.Spec.Tasks[i].Tolerable = &tolerable
+		}
+	}
+}
+
+func defaultAppImage(image *kusciaapisv1alpha1.AppImage) {
+	for i := range image.Spec.DeployTemplates {
+		containers := image.Spec.DeployTemplates[i].Spec.Containers
+		for j := range containers {
+			for k := range containers[j].Ports {
+				port := &containers[j].Ports[k]
+				if port.Protocol == "" {
+					port.Protocol = kusciaapisv1alpha1.ProtocolHTTP
+				}
+				if port.Scope == "" {
+					port.Scope = kusciaapisv1alpha1.ScopeLocal
+				}
+			}
+		}
+	}
+}
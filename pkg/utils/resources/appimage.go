@@ -57,3 +57,15 @@ func SelectDeployTemplate(templates []kusciaapisv1alpha1.DeployTemplate, role st
 
 	return nil, fmt.Errorf("not found deploy template for role %q", role)
 }
+
+// GetImageRegistryCredentialKey returns the key of the registry credential to pull the image of the app image in the
+// domain, the credential of the app image takes precedence over the default one of the domain.
+func GetImageRegistryCredentialKey(appImage *kusciaapisv1alpha1.AppImage, domain *kusciaapisv1alpha1.Domain) string {
+	if appImage != nil && appImage.Spec.Image.RegistryCredentialKey != "" {
+		return appImage.Spec.Image.RegistryCredentialKey
+	}
+	if domain != nil {
+		return domain.Spec.ImageRegistryCredentialKey
+	}
+	return ""
+}
@@ -135,3 +135,13 @@ func Test_selectDeployTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetImageRegistryCredentialKey(t *testing.T) {
+	appImage := &kusciaapisv1alpha1.AppImage{}
+	domain := &kusciaapisv1alpha1.Domain{Spec: kusciaapisv1alpha1.DomainSpec{ImageRegistryCredentialKey: "domain-registry"}}
+	assert.Equal(t, "", GetImageRegistryCredentialKey(appImage, nil))
+	assert.Equal(t, "domain-registry", GetImageRegistryCredentialKey(appImage, domain))
+
+	appImage.Spec.Image.RegistryCredentialKey = "harbor-robot"
+	assert.Equal(t, "harbor-robot", GetImageRegistryCredentialKey(appImage, domain))
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -128,3 +129,40 @@ func ExtractDomainDataSpec(p *kusciaapisv1alpha1.DomainData) *kusciaapisv1alpha1
 	pp.Spec = p.Spec
 	return pp
 }
+
+// MaskingPoliciesForDomain returns the masking policies of the domaindata applied when the domain reads it.
+func MaskingPoliciesForDomain(spec *kusciaapisv1alpha1.DomainDataSpec, domain string) []kusciaapisv1alpha1.DataMaskingPolicy {
+	if domain == spec.Author {
+		return nil
+	}
+	var policies []kusciaapisv1alpha1.DataMaskingPolicy
+	for _, p := range spec.MaskingPolicies {
+		if len(p.Domains) == 0 || slices.Contains(p.Domains, domain) {
+			policies = append(policies, p)
+		}
+	}
+	return policies
+}
+
+// ValidateDataMaskingPolicies checks the masking policies, the columns are checked if the domaindata has columns.
+func ValidateDataMaskingPolicies(policies []kusciaapisv1alpha1.DataMaskingPolicy, columns []kusciaapisv1alpha1.DataColumn) error {
+	for i, p := range policies {
+		if p.Column == "" {
+			return fmt.Errorf("masking policy[%d] column can't be empty", i)
+		}
+		if len(columns) > 0 && !slices.ContainsFunc(columns, func(c kusciaapisv1alpha1.DataColumn) bool { return c.Name == p.Column }) {
+			return fmt.Errorf("masking policy[%d] column %q is not a column of the domaindata", i, p.Column)
+		}
+		switch p.Method {
+		case kusciaapisv1alpha1.DataMaskingHash, kusciaapisv1alpha1.DataMaskingNullify:
+		case kusciaapisv1alpha1.DataMaskingTruncate:
+			if p.Length <= 0 {
+				return fmt.Errorf("masking policy[%d] length must be positive for the truncate method", i)
+			}
+		default:
+			return fmt.Errorf("masking policy[%d] method %q is invalid, must be one of %s, %s, %s", i, p.Method,
+				kusciaapisv1alpha1.DataMaskingHash, kusciaapisv1alpha1.DataMaskingTruncate, kusciaapisv1alpha1.DataMaskingNullify)
+		}
+	}
+	return nil
+}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	kusciaapisv1alpha1 "github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
 	kusciaclientsetfake "github.com/secretflow/kuscia/pkg/crd/clientset/versioned/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -120,3 +122,40 @@ func TestPatchDomainDataStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestMaskingPoliciesForDomain(t *testing.T) {
+	spec := &kusciaapisv1alpha1.DomainDataSpec{
+		Author: "alice",
+		MaskingPolicies: []kusciaapisv1alpha1.DataMaskingPolicy{
+			{Column: "id", Method: kusciaapisv1alpha1.DataMaskingHash},
+			{Column: "name", Method: kusciaapisv1alpha1.DataMaskingNullify, Domains: []string{"bob"}},
+		},
+	}
+	assert.Nil(t, MaskingPoliciesForDomain(spec, "alice"))
+	assert.Len(t, MaskingPoliciesForDomain(spec, "bob"), 2)
+	assert.Equal(t, spec.MaskingPolicies[:1], MaskingPoliciesForDomain(spec, "carol"))
+}
+
+func TestValidateDataMaskingPolicies(t *testing.T) {
+	columns := []kusciaapisv1alpha1.DataColumn{{Name: "id"}, {Name: "name"}}
+	tests := []struct {
+		name    string
+		policy  kusciaapisv1alpha1.DataMaskingPolicy
+		columns []kusciaapisv1alpha1.DataColumn
+		wantErr bool
+	}{
+		{"hash", kusciaapisv1alpha1.DataMaskingPolicy{Column: "id", Method: kusciaapisv1alpha1.DataMaskingHash}, columns, false},
+		{"truncate", kusciaapisv1alpha1.DataMaskingPolicy{Column: "name", Method: kusciaapisv1alpha1.DataMaskingTruncate, Length: 3}, columns, false},
+		{"without columns", kusciaapisv1alpha1.DataMaskingPolicy{Column: "any", Method: kusciaapisv1alpha1.DataMaskingNullify}, nil, false},
+		{"empty column", kusciaapisv1alpha1.DataMaskingPolicy{Method: kusciaapisv1alpha1.DataMaskingHash}, columns, true},
+		{"unknown column", kusciaapisv1alpha1.DataMaskingPolicy{Column: "age", Method: kusciaapisv1alpha1.DataMaskingHash}, columns, true},
+		{"invalid method", kusciaapisv1alpha1.DataMaskingPolicy{Column: "id", Method: "encrypt"}, columns, true},
+		{"truncate without length", kusciaapisv1alpha1.DataMaskingPolicy{Column: "id", Method: kusciaapisv1alpha1.DataMaskingTruncate}, columns, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDataMaskingPolicies([]kusciaapisv1alpha1.DataMaskingPolicy{tt.policy}, tt.columns)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
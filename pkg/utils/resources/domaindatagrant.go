@@ -138,3 +138,40 @@ func ExtractDomainDataGrantStatus(p *kusciaapisv1alpha1.DomainDataGrant) *kuscia
 	pp.Status = p.Status
 	return pp
 }
+
+// GrantModesFromStrings converts the grant modes of api requests, the mode defaults to normal.
+func GrantModesFromStrings(modes []string) []kusciaapisv1alpha1.GrantType {
+	if len(modes) == 0 {
+		return []kusciaapisv1alpha1.GrantType{kusciaapisv1alpha1.GrantNormal}
+	}
+	grantModes := make([]kusciaapisv1alpha1.GrantType, 0, len(modes))
+	for _, mode := range modes {
+		grantModes = append(grantModes, kusciaapisv1alpha1.GrantType(mode))
+	}
+	return grantModes
+}
+
+// GrantModesToStrings converts the grant modes of domaindatagrant spec to api responses.
+func GrantModesToStrings(modes []kusciaapisv1alpha1.GrantType) []string {
+	if len(modes) == 0 {
+		return nil
+	}
+	grantModes := make([]string, 0, len(modes))
+	for _, mode := range modes {
+		grantModes = append(grantModes, string(mode))
+	}
+	return grantModes
+}
+
+// ValidateGrantModes checks the grant modes are known, empty modes are valid and default to normal.
+func ValidateGrantModes(modes []string) error {
+	for _, mode := range modes {
+		switch kusciaapisv1alpha1.GrantType(mode) {
+		case kusciaapisv1alpha1.GrantNormal, kusciaapisv1alpha1.GrantMetadata, kusciaapisv1alpha1.GrantFile:
+		default:
+			return fmt.Errorf("grant mode %q is invalid, must be one of %s, %s, %s", mode,
+				kusciaapisv1alpha1.GrantNormal, kusciaapisv1alpha1.GrantMetadata, kusciaapisv1alpha1.GrantFile)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// EventTimes returns the first and last time and the count of the event, which are recorded in different fields by
+// the core and the events.k8s.io recorders.
+func EventTimes(ev *corev1.Event) (firstTime, lastTime time.Time, count int32) {
+	firstTime, lastTime, count = ev.FirstTimestamp.Time, ev.LastTimestamp.Time, ev.Count
+	if firstTime.IsZero() {
+		firstTime = ev.EventTime.Time
+	}
+	if ev.Series != nil {
+		if lastTime.IsZero() {
+			lastTime = ev.Series.LastObservedTime.Time
+		}
+		if count == 0 {
+			count = ev.Series.Count
+		}
+	}
+	if lastTime.IsZero() {
+		lastTime = firstTime
+	}
+	if firstTime.IsZero() {
+		firstTime = ev.CreationTimestamp.Time
+		lastTime = firstTime
+	}
+	if count == 0 {
+		count = 1
+	}
+	return firstTime, lastTime, count
+}
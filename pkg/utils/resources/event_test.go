@@ -0,0 +1,53 @@
+// Copyright 2023 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventTimes(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	// recorded by the core recorder
+	firstTime, lastTime, count := EventTimes(&corev1.Event{
+		FirstTimestamp: metav1.NewTime(now),
+		LastTimestamp:  metav1.NewTime(now.Add(time.Minute)),
+		Count:          3,
+	})
+	assert.Equal(t, now, firstTime)
+	assert.Equal(t, now.Add(time.Minute), lastTime)
+	assert.Equal(t, int32(3), count)
+
+	// recorded by the events.k8s.io recorder
+	firstTime, lastTime, count = EventTimes(&corev1.Event{
+		EventTime: metav1.NewMicroTime(now),
+		Series:    &corev1.EventSeries{Count: 2, LastObservedTime: metav1.NewMicroTime(now.Add(time.Minute))},
+	})
+	assert.Equal(t, now, firstTime)
+	assert.Equal(t, now.Add(time.Minute), lastTime)
+	assert.Equal(t, int32(2), count)
+
+	// neither of the times
+	firstTime, lastTime, count = EventTimes(&corev1.Event{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now)}})
+	assert.Equal(t, now, firstTime)
+	assert.Equal(t, now, lastTime)
+	assert.Equal(t, int32(1), count)
+}
@@ -16,6 +16,7 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 
@@ -29,6 +30,23 @@ import (
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 )
 
+// TaskInputIDsKey is the key of the input domain data ids in the task input config of SecretFlow.
+const TaskInputIDsKey = "sf_input_ids"
+
+// TaskInputDomainDataIDs returns the input domain data ids of the SecretFlow task input config, it returns nil if the
+// config isn't of SecretFlow or has no inputs.
+func TaskInputDomainDataIDs(taskInputConfig string) []string {
+	inputs := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(taskInputConfig), &inputs); err != nil {
+		return nil
+	}
+	var dataIDs []string
+	if raw, ok := inputs[TaskInputIDsKey]; !ok || json.Unmarshal(raw, &dataIDs) != nil {
+		return nil
+	}
+	return dataIDs
+}
+
 // GetKusciaTaskCondition gets kuscia task condition.
 func GetKusciaTaskCondition(status *kusciaapisv1alpha1.KusciaTaskStatus, condType kusciaapisv1alpha1.KusciaTaskConditionType, generateCond bool) (*kusciaapisv1alpha1.KusciaTaskCondition, bool) {
 	for i, condition := range status.Conditions {
@@ -0,0 +1,28 @@
+// Copyright 2023 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskInputDomainDataIDs(t *testing.T) {
+	assert.Equal(t, []string{"alice-table", "bob-table"}, TaskInputDomainDataIDs(`{"sf_input_ids":["alice-table","bob-table"],"sf_output_ids":["out"]}`))
+	assert.Nil(t, TaskInputDomainDataIDs(`{"sf_output_ids":["out"]}`))
+	assert.Nil(t, TaskInputDomainDataIDs(`{"sf_input_ids":"alice-table"}`))
+	assert.Nil(t, TaskInputDomainDataIDs("not json"))
+}
@@ -58,3 +58,31 @@ func TestGenerateAndLoadX509KeyPair(t *testing.T) {
 	_, _, err = LoadX509KeyPair(certFile, keyFile)
 	assert.NoError(t, err)
 }
+
+func TestNeedRenew(t *testing.T) {
+	now := time.Now()
+	cert := &x509.Certificate{NotBefore: now.AddDate(0, 0, -300), NotAfter: now.AddDate(0, 0, 65)}
+	assert.False(t, NeedRenew(cert, 30*24*time.Hour, now))
+	assert.True(t, NeedRenew(cert, 90*24*time.Hour, now))
+
+	// a third of the validity period is used for the short-lived certs
+	cert = &x509.Certificate{NotBefore: now.Add(-time.Hour), NotAfter: now.Add(23 * time.Hour)}
+	assert.False(t, NeedRenew(cert, 30*24*time.Hour, now))
+	assert.True(t, NeedRenew(cert, 30*24*time.Hour, now.Add(16*time.Hour)))
+}
+
+func TestRenewalTemplate(t *testing.T) {
+	now := time.Now()
+	cert := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "Test"},
+		NotBefore:   now.AddDate(0, 0, -10),
+		NotAfter:    now.AddDate(0, 0, 1),
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+	}
+	template := RenewalTemplate(cert, now)
+	assert.Equal(t, "Test", template.Subject.CommonName)
+	assert.Equal(t, now, template.NotBefore)
+	assert.True(t, now.AddDate(0, 0, 11).Equal(template.NotAfter))
+	assert.Equal(t, cert.ExtKeyUsage, template.ExtKeyUsage)
+}
@@ -369,13 +369,9 @@ func ParseCert(certData []byte, certFile string) (cert *x509.Certificate, err er
 	return nil, fmt.Errorf("can't parse cert")
 }
 
-func ParseCertWithGenerated(privateKey *rsa.PrivateKey, subject string, certData []byte, certFile string) (cert *x509.Certificate, err error) {
-	if len(certData) != 0 || (certFile != "" && paths.CheckFileExist(certFile)) {
-		return ParseCert(certData, certFile)
-	}
-
-	nlog.Infof("Generate cert with key, subject[%s]", subject)
-	template := &x509.Certificate{
+// DomainCertTemplate returns the template of the domain cert, which signs the mTLS client certs of routes.
+func DomainCertTemplate(subject string) *x509.Certificate {
+	return &x509.Certificate{
 		SerialNumber:          big.NewInt(1),
 		Subject:               pkix.Name{CommonName: subject},
 		PublicKeyAlgorithm:    x509.RSA,
@@ -387,6 +383,15 @@ func ParseCertWithGenerated(privateKey *rsa.PrivateKey, subject string, certData
 		IsCA:                  true,
 		BasicConstraintsValid: true,
 	}
+}
+
+func ParseCertWithGenerated(privateKey *rsa.PrivateKey, subject string, certData []byte, certFile string) (cert *x509.Certificate, err error) {
+	if len(certData) != 0 || (certFile != "" && paths.CheckFileExist(certFile)) {
+		return ParseCert(certData, certFile)
+	}
+
+	nlog.Infof("Generate cert with key, subject[%s]", subject)
+	template := DomainCertTemplate(subject)
 	crtRaw, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
 	if err != nil {
 		nlog.Errorf("Create certificate error: %v", err)
@@ -411,24 +416,23 @@ func ParseCertWithGenerated(privateKey *rsa.PrivateKey, subject string, certData
 	return x509.ParseCertificate(crtRaw)
 }
 
-func GenerateKeyCertPairData(rootCAKey *rsa.PrivateKey, rootCACert *x509.Certificate, commonName string) (string, string, error) {
-	var certBuf, keyBuf bytes.Buffer
-
-	var netIPs []net.IP
-	netIPs = append(netIPs, net.IPv4(127, 0, 0, 1))
-
-	cert := &x509.Certificate{
+// ServerCertTemplate returns the template of the server certs, e.g. the gateway listener certs.
+func ServerCertTemplate(commonName string) *x509.Certificate {
+	return &x509.Certificate{
 		SerialNumber: big.NewInt(int64(uuid.New().ID())),
 		Subject:      pkix.Name{CommonName: commonName},
-		IPAddresses:  netIPs,
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
 		NotBefore:    time.Now(),
 		NotAfter:     time.Now().AddDate(10, 0, 0),
 		SubjectKeyId: []byte{1, 2, 3, 4, 6},
 		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 		KeyUsage:     x509.KeyUsageDigitalSignature,
 	}
+}
 
-	err := GenerateX509KeyPair(rootCACert, rootCAKey, cert, &certBuf, &keyBuf)
+func GenerateKeyCertPairData(rootCAKey *rsa.PrivateKey, rootCACert *x509.Certificate, commonName string) (string, string, error) {
+	var certBuf, keyBuf bytes.Buffer
+	err := GenerateX509KeyPair(rootCACert, rootCAKey, ServerCertTemplate(commonName), &certBuf, &keyBuf)
 	if err != nil {
 		return "", "", err
 	}
@@ -501,3 +505,13 @@ func SignWithRSA(key *rsa.PrivateKey, data string) (string, error) {
 	}
 	return base64.StdEncoding.EncodeToString(sigBytes), nil
 }
+
+// VerifyWithRSA verifies the base64 encoded signature of SignWithRSA.
+func VerifyWithRSA(key *rsa.PublicKey, data []byte, signature string) error {
+	sigBytes, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("decode signature failed, %v", err)
+	}
+	digest := sha256.Sum256(data)
+	return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sigBytes)
+}
@@ -118,3 +118,14 @@ func TestParsePKCS8PrivateKey(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, token, string(plaintext))
 }
+
+func TestVerifyWithRSA(t *testing.T) {
+	priKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	signature, err := SignWithRSA(priKey, "kuscia")
+	assert.NoError(t, err)
+	assert.NoError(t, VerifyWithRSA(&priKey.PublicKey, []byte("kuscia"), signature))
+	assert.Error(t, VerifyWithRSA(&priKey.PublicKey, []byte("kuscia2"), signature))
+	assert.Error(t, VerifyWithRSA(&priKey.PublicKey, []byte("kuscia"), "not base64"))
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"crypto/x509"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NeedRenew reports whether cert expires within renewBefore. At most a third of the validity period is used,
+// so the short-lived certs aren't renewed right after they are issued.
+func NeedRenew(cert *x509.Certificate, renewBefore time.Duration, now time.Time) bool {
+	if validity := cert.NotAfter.Sub(cert.NotBefore); renewBefore > validity/3 {
+		renewBefore = validity / 3
+	}
+	return !now.Before(cert.NotAfter.Add(-renewBefore))
+}
+
+// RenewalTemplate returns the template to reissue cert from now, with the same subject, names, usages and
+// validity period.
+func RenewalTemplate(cert *x509.Certificate, now time.Time) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber:          big.NewInt(int64(uuid.New().ID())),
+		Subject:               cert.Subject,
+		DNSNames:              cert.DNSNames,
+		IPAddresses:           cert.IPAddresses,
+		EmailAddresses:        cert.EmailAddresses,
+		URIs:                  cert.URIs,
+		NotBefore:             now,
+		NotAfter:              now.Add(cert.NotAfter.Sub(cert.NotBefore)),
+		KeyUsage:              cert.KeyUsage,
+		ExtKeyUsage:           cert.ExtKeyUsage,
+		IsCA:                  cert.IsCA,
+		BasicConstraintsValid: cert.BasicConstraintsValid,
+		SubjectKeyId:          cert.SubjectKeyId,
+	}
+}
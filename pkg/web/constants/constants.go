@@ -24,8 +24,12 @@ const (
 	ContentTypeHeader      = "Content-Type"
 	HTTPDefaultContentType = "application/json"
 	SourceDomainHeader     = "Kuscia-Source"
+	RetryAfterHeader       = "Retry-After"
 	SourceDomainKey        = "source-domain"
 	AuthRole               = "AuthRole"
 	AuthRoleMaster         = "master"
 	AuthRoleDomain         = "domain"
+	AuthTenant             = "AuthTenant"
+	// NodeExecTokenHeader carries the internal credential of the exec sessions proxied between the nodes of a domain.
+	NodeExecTokenHeader = "Kuscia-Node-Exec-Token"
 )
@@ -38,6 +38,9 @@ import (
 const REQUEST = "REQUEST"
 const RESPONSE = "RESPONSE"
 
+// mimeProtobuf is the registered media type of protobuf, binding.MIMEPROTOBUF is the legacy one used by most clients.
+const mimeProtobuf = "application/protobuf"
+
 type BizFlow struct {
 	handler    api.ProtoHandler
 	ReqType    *reflect.Type
@@ -74,7 +77,8 @@ type ProtoDecoratorOptions struct {
 
 // ProtoDecorator is the decorator of protocol request processing logic.
 // During request processing, ProtoDecorator uses ShouldBindBodyWith of ginContext to read requests with contentType
-// of "text/plain", "application/json" and "", so that other middleware can use ShouldBindBodyWith to read the request body again.
+// of "text/plain", "application/json", "" and protobuf, so that other middleware can use ShouldBindBodyWith to read the request body again.
+// The response is encoded as json or protobuf according to the Accept header, see acceptsProtobuf.
 func ProtoDecorator(e framework.ConfBeanRegistry, handler api.ProtoHandler, options *ProtoDecoratorOptions) gin.HandlerFunc {
 	reqType, respType := handler.GetType()
 	if options.MarshalOptions == nil {
@@ -157,6 +161,10 @@ func getProtoRequest(flow *BizFlow) (api.ProtoRequest, error) {
 		if err := bizContext.Context.ShouldBindBodyWith(request, binder.JSONProtoBinder{}); err != nil {
 			return nil, err
 		}
+	} else if IsProtobufMIME(bizContext.Context.ContentType()) {
+		if err := bizContext.Context.ShouldBindBodyWith(request, binding.ProtoBuf); err != nil {
+			return nil, err
+		}
 	} else if err := bizContext.Context.ShouldBind(request); err != nil {
 		return nil, err
 	}
@@ -201,10 +209,10 @@ func renderResponse(ctx *api.BizContext, response api.ProtoResponse, postProcess
 	}
 
 	var render bizrender.Render
-	switch ctx.Context.ContentType() {
-	case binding.MIMEPROTOBUF:
+	ctx.Context.Header("Vary", "Accept")
+	if acceptsProtobuf(ctx.Context) {
 		render = &bizrender.ProtoRender{Data: response}
-	default:
+	} else {
 		render = &bizrender.JSONRender{Data: response, MarshalOptions: jsonMarshalOptions}
 	}
 
@@ -215,6 +223,26 @@ func renderResponse(ctx *api.BizContext, response api.ProtoResponse, postProcess
 	ctx.Context.Render(http.StatusOK, render)
 }
 
+// IsProtobufMIME reports whether the mime type is a binary encoded protobuf message.
+func IsProtobufMIME(mime string) bool {
+	return mime == binding.MIMEPROTOBUF || mime == mimeProtobuf
+}
+
+// acceptsProtobuf reports whether the response should be encoded as protobuf. The first json or protobuf type
+// listed in the Accept header wins, and the response follows the content type of the request if neither is listed.
+func acceptsProtobuf(c *gin.Context) bool {
+	for _, accept := range strings.Split(c.GetHeader("Accept"), ",") {
+		mime := strings.TrimSpace(strings.Split(accept, ";")[0])
+		switch {
+		case IsProtobufMIME(mime):
+			return true
+		case mime == binding.MIMEJSON:
+			return false
+		}
+	}
+	return IsProtobufMIME(c.ContentType())
+}
+
 // DefaultProtoDecoratorMaker returns default ProtoDecorator.
 func DefaultProtoDecoratorMaker(validateFailedCode int32, unexpectedECode int32) func(framework.ConfBeanRegistry, api.ProtoHandler) gin.HandlerFunc {
 	return func(e framework.ConfBeanRegistry, handler api.ProtoHandler) gin.HandlerFunc {
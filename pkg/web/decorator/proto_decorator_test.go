@@ -24,9 +24,11 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/secretflow/kuscia/pkg/web/api"
 	"github.com/secretflow/kuscia/pkg/web/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
 )
 
 type testHandler struct{}
@@ -106,3 +108,60 @@ func TestRenderResponse(t *testing.T) {
 		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
 	})
 }
+
+type echoStatusHandler struct{}
+
+func (h *echoStatusHandler) Validate(ctx *api.BizContext, req api.ProtoRequest, errs *errorcode.Errs) {
+}
+
+func (h *echoStatusHandler) Handle(ctx *api.BizContext, req api.ProtoRequest) api.ProtoResponse {
+	return req
+}
+
+func (h *echoStatusHandler) GetType() (req, resp reflect.Type) {
+	return reflect.TypeOf(v1alpha1.Status{}), reflect.TypeOf(v1alpha1.Status{})
+}
+
+func TestProtoDecoratorContentNegotiation(t *testing.T) {
+	engine := gin.New()
+	engine.POST("/echo", ProtoDecorator(nil, &echoStatusHandler{}, &ProtoDecoratorOptions{RenderJSONUseProtoNames: true}))
+	status := &v1alpha1.Status{Code: 11100, Message: "hello"}
+	protoBody, err := proto.Marshal(status)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		contentType string
+		accept      string
+		body        []byte
+		wantProto   bool
+	}{
+		{name: "json", contentType: "application/json", body: []byte(`{"code":11100,"message":"hello"}`)},
+		{name: "protobuf", contentType: "application/x-protobuf", body: protoBody, wantProto: true},
+		{name: "protobuf request, json response", contentType: "application/x-protobuf", accept: "application/json", body: protoBody},
+		{name: "json request, protobuf response", contentType: "application/json", accept: "application/protobuf, application/json",
+			body: []byte(`{"code":11100,"message":"hello"}`), wantProto: true},
+		{name: "wildcard accept", contentType: "application/protobuf", accept: "*/*", body: protoBody, wantProto: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/echo", bytes.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			engine.ServeHTTP(w, req)
+
+			got := &v1alpha1.Status{}
+			if tt.wantProto {
+				assert.Equal(t, "application/x-protobuf", w.Header().Get("Content-Type"))
+				assert.NoError(t, proto.Unmarshal(w.Body.Bytes(), got))
+			} else {
+				assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+				assert.NoError(t, protojson.Unmarshal(w.Body.Bytes(), got))
+			}
+			assert.True(t, proto.Equal(status, got))
+		})
+	}
+}
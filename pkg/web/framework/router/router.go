@@ -14,7 +14,11 @@
 
 package router
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/secretflow/kuscia/pkg/web/api"
+)
 
 type GroupsRouters []*GroupRouters
 
@@ -30,4 +34,7 @@ type Router struct {
 	HTTPMethod   string
 	RelativePath string
 	Handlers     []gin.HandlerFunc
+	// ProtoHandler is optional, servers supporting it decorate it as the last handler and document the
+	// route with its request and response types.
+	ProtoHandler api.ProtoHandler
 }
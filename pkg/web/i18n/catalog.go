@@ -0,0 +1,120 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package i18n
+
+import (
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+)
+
+// catalog is the localized summaries of the error codes, keep it in sync with docs/reference/apis/error_code_cn.md.
+var catalog = map[errorcode.ErrorCode]map[string]string{
+	errorcode.ErrorCode_SUCCESS:                                      {LocaleEN: "success", LocaleZH: "成功"},
+	errorcode.ErrorCode_KusciaAPIErrRequestValidate:                  {LocaleEN: "Request validation failed", LocaleZH: "请求入参校验错误"},
+	errorcode.ErrorCode_KusciaAPIErrForUnexpected:                    {LocaleEN: "Unexpected error", LocaleZH: "未知异常"},
+	errorcode.ErrorCode_KusciaAPIErrAuthFailed:                       {LocaleEN: "Authentication failed", LocaleZH: "权限校验异常"},
+	errorcode.ErrorCode_KusciaAPIErrRequestMasterFailed:              {LocaleEN: "Request to master failed", LocaleZH: "请求 Master 失败"},
+	errorcode.ErrorCode_KusciaAPIErrLiteAPINotSupport:                {LocaleEN: "API not supported by lite domain", LocaleZH: "Lite 节点不支持的 API"},
+	errorcode.ErrorCode_KusciaAPIErrMasterAPINotSupport:              {LocaleEN: "API not supported by master domain", LocaleZH: "Master 节点不支持的 API"},
+	errorcode.ErrorCode_KusciaAPIErrQuotaExceeded:                    {LocaleEN: "Quota exceeded", LocaleZH: "配额不足"},
+	errorcode.ErrorCode_KusciaAPIErrCreateJob:                        {LocaleEN: "Create job failed", LocaleZH: "创建任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryJob:                         {LocaleEN: "Query job failed", LocaleZH: "查询任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryJobStatus:                   {LocaleEN: "Query job status failed", LocaleZH: "查询任务状态失败"},
+	errorcode.ErrorCode_KusciaAPIErrDeleteJob:                        {LocaleEN: "Delete job failed", LocaleZH: "删除任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrStopJob:                          {LocaleEN: "Stop job failed", LocaleZH: "停止任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrApproveJob:                       {LocaleEN: "Approve job failed", LocaleZH: "审批任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrSuspendJob:                       {LocaleEN: "Suspend job failed", LocaleZH: "暂停任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrRestartJob:                       {LocaleEN: "Restart job failed", LocaleZH: "重跑任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrCancelJob:                        {LocaleEN: "Cancel job failed", LocaleZH: "取消任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrSuspendNotRunningJob:             {LocaleEN: "Suspend job failed, only running jobs can be suspended", LocaleZH: "暂停任务失败，无法暂停非 Running 状态的任务"},
+	errorcode.ErrorCode_KusciaAPIErrRestartNotSuspendedOrFailedJob:   {LocaleEN: "Restart job failed, only failed or suspended jobs can be restarted", LocaleZH: "重跑任务失败，无法重跑非 Failed 或 Suspended 状态的任务"},
+	errorcode.ErrorCode_KusciaAPIErrListJobEvents:                    {LocaleEN: "List job events failed", LocaleZH: "查询任务事件失败"},
+	errorcode.ErrorCode_KusciaAPIErrListJobUsage:                     {LocaleEN: "List job usage failed", LocaleZH: "查询任务资源用量失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryArchivedJob:                 {LocaleEN: "Query archived job failed", LocaleZH: "查询归档任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrReportArchivedJob:                {LocaleEN: "Report archived job failed", LocaleZH: "统计归档任务失败"},
+	errorcode.ErrorCode_KusciaAPIErrCreateDomain:                     {LocaleEN: "Create domain failed", LocaleZH: "创建节点失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryDomain:                      {LocaleEN: "Query domain failed", LocaleZH: "查询节点失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryDomainStatus:                {LocaleEN: "Query domain status failed", LocaleZH: "查询节点状态失败"},
+	errorcode.ErrorCode_KusciaAPIErrUpdateDomain:                     {LocaleEN: "Update domain failed", LocaleZH: "更新节点失败"},
+	errorcode.ErrorCode_KusciaAPIErrDeleteDomain:                     {LocaleEN: "Delete domain failed", LocaleZH: "删除节点失败"},
+	errorcode.ErrorCode_KusciaAPIErrDomainNotExists:                  {LocaleEN: "Domain does not exist", LocaleZH: "节点不存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrDomainExists:                     {LocaleEN: "Domain already exists", LocaleZH: "节点已存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrRegisterDomain:                   {LocaleEN: "Register domain failed", LocaleZH: "注册节点失败"},
+	errorcode.ErrorCode_KusciaAPIErrCreateDomainRoute:                {LocaleEN: "Create domain route failed", LocaleZH: "创建节点路由失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryDomainRoute:                 {LocaleEN: "Query domain route failed", LocaleZH: "查询节点路由失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryDomainRouteStatus:           {LocaleEN: "Query domain route status failed", LocaleZH: "查询节点路由状态失败"},
+	errorcode.ErrorCode_KusciaAPIErrDeleteDomainRoute:                {LocaleEN: "Delete domain route failed", LocaleZH: "删除节点路由失败"},
+	errorcode.ErrorCode_KusciaAPIErrDomainRouteNotExists:             {LocaleEN: "Domain route does not exist", LocaleZH: "节点路由不存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrDomainRouteExists:                {LocaleEN: "Domain route already exists", LocaleZH: "节点路由已存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrCreateDomainDataFailed:           {LocaleEN: "Create domain data failed", LocaleZH: "创建节点数据失败"},
+	errorcode.ErrorCode_KusciaAPIErrDeleteDomainDataFailed:           {LocaleEN: "Delete domain data failed", LocaleZH: "删除节点数据失败"},
+	errorcode.ErrorCode_KusciaAPIErrGetDomainDataFailed:              {LocaleEN: "Get domain data failed", LocaleZH: "获取节点数据失败"},
+	errorcode.ErrorCode_KusciaAPIErrListDomainDataFailed:             {LocaleEN: "List domain data failed", LocaleZH: "获取节点数据列表失败"},
+	errorcode.ErrorCode_KusciaAPIErrMergeDomainDataFailed:            {LocaleEN: "Merge domain data failed", LocaleZH: "更新合并数据失败"},
+	errorcode.ErrorCode_KusciaAPIErrPatchDomainDataFailed:            {LocaleEN: "Patch domain data failed", LocaleZH: "更新补充数据失败"},
+	errorcode.ErrorCode_KusciaAPIErrDomainDataNotExists:              {LocaleEN: "Domain data does not exist", LocaleZH: "节点数据不存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrDomainDataExists:                 {LocaleEN: "Domain data already exists", LocaleZH: "节点数据已存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrDownloadDomainData:               {LocaleEN: "Download domain data failed", LocaleZH: "下载节点数据失败"},
+	errorcode.ErrorCode_KusciaAPIErrDomainDataNotGranted:             {LocaleEN: "Domain data is not granted for downloading", LocaleZH: "节点数据未授权下载"},
+	errorcode.ErrorCode_KusciaAPIErrUploadDomainData:                 {LocaleEN: "Upload domain data failed", LocaleZH: "上传节点数据失败"},
+	errorcode.ErrorCode_KusciaAPIErrCreateServing:                    {LocaleEN: "Create serving failed", LocaleZH: "创建 Serving 失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryServing:                     {LocaleEN: "Query serving failed", LocaleZH: "查询 Serving 失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryServingStatus:               {LocaleEN: "Query serving status failed", LocaleZH: "查询 Serving 状态失败"},
+	errorcode.ErrorCode_KusciaAPIErrUpdateServing:                    {LocaleEN: "Update serving failed", LocaleZH: "更新 Serving 失败"},
+	errorcode.ErrorCode_KusciaAPIErrDeleteServing:                    {LocaleEN: "Delete serving failed", LocaleZH: "删除 Serving 失败"},
+	errorcode.ErrorCode_KusciaAPIErrCreateDomainDataGrant:            {LocaleEN: "Create domain data grant failed", LocaleZH: "创建数据授权失败"},
+	errorcode.ErrorCode_KusciaAPIErrUpdateDomainDataGrant:            {LocaleEN: "Update domain data grant failed", LocaleZH: "更新数据授权失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryDomainDataGrant:             {LocaleEN: "Query domain data grant failed", LocaleZH: "查询数据授权失败"},
+	errorcode.ErrorCode_KusciaAPIErrDeleteDomainDataGrant:            {LocaleEN: "Delete domain data grant failed", LocaleZH: "删除数据授权失败"},
+	errorcode.ErrorCode_KusciaAPIErrDomainDataGrantExists:            {LocaleEN: "Domain data grant already exists", LocaleZH: "数据授权已存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrDomainDataGrantNotExists:         {LocaleEN: "Domain data grant does not exist", LocaleZH: "数据授权不存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrGrantPolicyRejected:              {LocaleEN: "Domain data grant is rejected by the grant policy", LocaleZH: "数据授权不符合授权策略"},
+	errorcode.ErrorCode_KusciaAPIErrCreateDomainDataSource:           {LocaleEN: "Create domain data source failed", LocaleZH: "创建数据源失败"},
+	errorcode.ErrorCode_KusciaAPIErrUpdateDomainDataSource:           {LocaleEN: "Update domain data source failed", LocaleZH: "更新数据源失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryDomainDataSource:            {LocaleEN: "Query domain data source failed", LocaleZH: "查询数据源失败"},
+	errorcode.ErrorCode_KusciaAPIErrBatchQueryDomainDataSource:       {LocaleEN: "Batch query domain data source failed", LocaleZH: "批量查询数据源失败"},
+	errorcode.ErrorCode_KusciaAPIErrDeleteDomainDataSource:           {LocaleEN: "Delete domain data source failed", LocaleZH: "删除数据源失败"},
+	errorcode.ErrorCode_KusciaAPIErrDomainDataSourceExists:           {LocaleEN: "Domain data source already exists", LocaleZH: "数据源已存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrDomainDataSourceNotExists:        {LocaleEN: "Domain data source does not exist", LocaleZH: "数据源不存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrDomainDataSourceInfoEncodeFailed: {LocaleEN: "Encode domain data source info failed", LocaleZH: "数据源信息转码异常"},
+	errorcode.ErrorCode_KusciaAPIErrListDomainDataSource:             {LocaleEN: "List domain data source failed", LocaleZH: "列出数据源失败"},
+	errorcode.ErrorCode_KusciaAPIErrDomainDataSourceRevealDenied:     {LocaleEN: "Revealing domain data source info is not granted", LocaleZH: "无权获取数据源凭证"},
+	errorcode.ErrorCode_KusciaAPIErrCreateConfig:                     {LocaleEN: "Create config failed", LocaleZH: "创建配置失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryConfig:                      {LocaleEN: "Query config failed", LocaleZH: "查询配置失败"},
+	errorcode.ErrorCode_KusciaAPIErrUpdateConfig:                     {LocaleEN: "Update config failed", LocaleZH: "更新配置失败"},
+	errorcode.ErrorCode_KusciaAPIErrDeleteConfig:                     {LocaleEN: "Delete config failed", LocaleZH: "删除配置失败"},
+	errorcode.ErrorCode_KusciaAPIErrBatchQueryConfig:                 {LocaleEN: "Batch query config failed", LocaleZH: "批量查询配置失败"},
+	errorcode.ErrorCode_KusciaAPIErrCreateAppImage:                   {LocaleEN: "Create app image failed", LocaleZH: "创建应用镜像失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryAppImage:                    {LocaleEN: "Query app image failed", LocaleZH: "查询应用镜像失败"},
+	errorcode.ErrorCode_KusciaAPIErrUpdateAppImage:                   {LocaleEN: "Update app image failed", LocaleZH: "更新应用镜像失败"},
+	errorcode.ErrorCode_KusciaAPIErrDeleteAppImage:                   {LocaleEN: "Delete app image failed", LocaleZH: "删除应用镜像失败"},
+	errorcode.ErrorCode_KusciaAPIErrBatchQueryAppImage:               {LocaleEN: "Batch query app image failed", LocaleZH: "批量查询应用镜像失败"},
+	errorcode.ErrorCode_KusciaAPIErrAppImageNotExists:                {LocaleEN: "App image does not exist", LocaleZH: "应用镜像不存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrAppImageExists:                   {LocaleEN: "App image already exists", LocaleZH: "应用镜像已存在异常"},
+	errorcode.ErrorCode_KusciaAPIErrPrewarmImage:                     {LocaleEN: "Prewarm image failed", LocaleZH: "预热镜像失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryPrewarmImage:                {LocaleEN: "Query image prewarm failed", LocaleZH: "查询镜像预热失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryLog:                         {LocaleEN: "Query log failed", LocaleZH: "查询日志失败"},
+	errorcode.ErrorCode_KusciaAPIErrQueryPodNode:                     {LocaleEN: "Query pod node failed", LocaleZH: "查询实例节点失败"},
+	errorcode.ErrorCode_KusciaAPIErrPushArchivedLog:                  {LocaleEN: "Push archived log failed", LocaleZH: "归档日志失败"},
+	errorcode.ErrorCode_KusciaAPIErrListArchivedLog:                  {LocaleEN: "List archived log failed", LocaleZH: "查询归档日志列表失败"},
+	errorcode.ErrorCode_KusciaAPIErrDownloadArchivedLog:              {LocaleEN: "Download archived log failed", LocaleZH: "下载归档日志失败"},
+	errorcode.ErrorCode_KusciaAPIErrCordonNode:                       {LocaleEN: "Cordon node failed", LocaleZH: "封锁节点失败"},
+	errorcode.ErrorCode_KusciaAPIErrUncordonNode:                     {LocaleEN: "Uncordon node failed", LocaleZH: "解除节点封锁失败"},
+	errorcode.ErrorCode_KusciaAPIErrDrainNode:                        {LocaleEN: "Drain node failed", LocaleZH: "驱逐节点实例失败"},
+	errorcode.ErrorCode_KusciaAPIErrExecContainer:                    {LocaleEN: "Exec in container failed", LocaleZH: "容器内执行命令失败"},
+	errorcode.ErrorCode_KusciaAPIErrDebugScopeDenied:                 {LocaleEN: "Debug scope is not granted", LocaleZH: "未授予调试权限"},
+	errorcode.ErrorCode_KusciaAPIErrCreateBackup:                     {LocaleEN: "Create backup failed", LocaleZH: "创建备份失败"},
+	errorcode.ErrorCode_KusciaAPIErrRestoreBackup:                    {LocaleEN: "Restore backup failed", LocaleZH: "恢复备份失败"},
+	errorcode.ErrorCode_KusciaAPIErrSearch:                           {LocaleEN: "Search resources failed", LocaleZH: "搜索资源失败"},
+}
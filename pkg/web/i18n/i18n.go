@@ -0,0 +1,105 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package i18n localizes the human-readable messages of the api responses by the Accept-Language of the request.
+package i18n
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+)
+
+const (
+	LocaleEN = "en"
+	LocaleZH = "zh"
+
+	// AcceptLanguageHeader is also the grpc metadata key, the keys of grpc metadata are lower case.
+	AcceptLanguageHeader  = "accept-language"
+	ContentLanguageHeader = "content-language"
+)
+
+var separators = map[string]string{
+	LocaleEN: ": ",
+	LocaleZH: "：",
+}
+
+// ParseAcceptLanguage returns the supported locale preferred by the Accept-Language header, e.g.
+// "zh-CN,zh;q=0.9,en;q=0.8", and empty if none of the languages is supported.
+func ParseAcceptLanguage(header string) string {
+	locale, weight := "", 0.0
+	for _, item := range strings.Split(header, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ";")
+		lang := strings.ToLower(strings.SplitN(strings.TrimSpace(parts[0]), "-", 2)[0])
+		if _, ok := separators[lang]; !ok {
+			continue
+		}
+		q := 1.0
+		for _, param := range parts[1:] {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		// the first one wins if the weights are equal
+		if q > weight {
+			locale, weight = lang, q
+		}
+	}
+	return locale
+}
+
+// Message returns the summary of the error code in the locale.
+func Message(code errorcode.ErrorCode, locale string) (string, bool) {
+	msg, ok := catalog[code][locale]
+	return msg, ok
+}
+
+// LocalizeStatus replaces the message of the status by the summary of its code in the locale, the original
+// message is appended as the detail of the errors. The code is kept, so the clients should always check it
+// rather than the message. It returns false if the status isn't localized.
+func LocalizeStatus(status *v1alpha1.Status, locale string) bool {
+	if status == nil {
+		return false
+	}
+	summary, ok := Message(errorcode.ErrorCode(status.Code), locale)
+	if !ok {
+		return false
+	}
+	if status.Code == int32(errorcode.ErrorCode_SUCCESS) || status.Message == "" || status.Message == summary {
+		status.Message = summary
+	} else {
+		status.Message = summary + separators[locale] + status.Message
+	}
+	return true
+}
+
+type statusResponse interface {
+	GetStatus() *v1alpha1.Status
+}
+
+// LocalizeResponse localizes the status of the response if it has one.
+func LocalizeResponse(resp interface{}, locale string) bool {
+	if locale == "" {
+		return false
+	}
+	r, ok := resp.(statusResponse)
+	if !ok {
+		return false
+	}
+	return LocalizeStatus(r.GetStatus(), locale)
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package i18n
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t, "", ParseAcceptLanguage(""))
+	assert.Equal(t, "", ParseAcceptLanguage("fr-FR,de;q=0.5"))
+	assert.Equal(t, LocaleZH, ParseAcceptLanguage("zh-CN,zh;q=0.9,en;q=0.8"))
+	assert.Equal(t, LocaleEN, ParseAcceptLanguage("fr;q=1.0, zh;q=0.5, EN-us;q=0.7"))
+	assert.Equal(t, LocaleEN, ParseAcceptLanguage("en,zh"))
+	assert.Equal(t, "", ParseAcceptLanguage("zh;q=0"))
+}
+
+func TestCatalogCoversKusciaAPIErrorCodes(t *testing.T) {
+	for code, name := range errorcode.ErrorCode_name {
+		if !strings.HasPrefix(name, "KusciaAPIErr") {
+			continue
+		}
+		for _, locale := range []string{LocaleEN, LocaleZH} {
+			_, ok := Message(errorcode.ErrorCode(code), locale)
+			assert.True(t, ok, "%s has no %s message", name, locale)
+		}
+	}
+}
+
+func TestLocalizeStatus(t *testing.T) {
+	status := &v1alpha1.Status{Code: int32(errorcode.ErrorCode_KusciaAPIErrDomainNotExists), Message: `domains "bob" not found`}
+	assert.True(t, LocalizeStatus(status, LocaleZH))
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrDomainNotExists), status.Code)
+	assert.Equal(t, `节点不存在异常：domains "bob" not found`, status.Message)
+
+	status = &v1alpha1.Status{Code: int32(errorcode.ErrorCode_SUCCESS), Message: "success"}
+	assert.True(t, LocalizeStatus(status, LocaleZH))
+	assert.Equal(t, "成功", status.Message)
+
+	status = &v1alpha1.Status{Code: int32(errorcode.ErrorCode_ReporterErrForUnexptected), Message: "unexpected"}
+	assert.False(t, LocalizeStatus(status, LocaleZH))
+	assert.Equal(t, "unexpected", status.Message)
+
+	resp := &kusciaapi.QueryDomainResponse{Status: &v1alpha1.Status{Code: int32(errorcode.ErrorCode_KusciaAPIErrQueryDomain), Message: "timeout"}}
+	assert.False(t, LocalizeResponse(resp, ""))
+	assert.True(t, LocalizeResponse(resp, LocaleEN))
+	assert.Equal(t, "Query domain failed: timeout", resp.Status.Message)
+	assert.False(t, LocalizeResponse(&kusciaapi.QueryDomainResponse{}, LocaleEN))
+}
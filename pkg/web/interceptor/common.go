@@ -24,7 +24,11 @@ import (
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 )
 
-var sensitiveFields = []string{"password", "access_key_id", "access_key_secret"}
+var sensitiveFields = []string{"password", "access_key_id", "access_key_secret",
+	// the sensitive input config of the tasks is in plaintext if it's not encrypted by the client
+	"sensitive_input_config", "sensitiveInputConfig",
+	// the credentials of the domain returned by the onboarding apis
+	"deploy_token", "deployToken", "domain_key_data", "domainKeyData"}
 
 var sensitiveHTTPPathPrefix = map[string]struct{}{
 	"/api/v1/configmanager/config": {},
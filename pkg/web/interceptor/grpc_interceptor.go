@@ -30,6 +30,7 @@ import (
 
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/i18n"
 	"github.com/secretflow/kuscia/pkg/web/utils"
 	"github.com/secretflow/kuscia/proto/api/v1alpha1"
 	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
@@ -180,7 +181,34 @@ func GrpcStreamServerMasterRoleInterceptor() grpc.StreamServerInterceptor {
 		ctx := ss.Context()
 		ctx = context.WithValue(ctx, constants.AuthRole, constants.AuthRoleMaster)
 		ctx = context.WithValue(ctx, constants.SourceDomainKey, constants.AuthRoleMaster)
-		return handler(srv, ss)
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextServerStream overrides the context of the stream, so that the values set by the interceptors are seen by the
+// handlers.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// GrpcServerLocaleInterceptor localizes the status message of the responses by the accept-language metadata.
+func GrpcServerLocaleInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		resp, err = handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		languages := metadata.ValueFromIncomingContext(ctx, i18n.AcceptLanguageHeader)
+		locale := i18n.ParseAcceptLanguage(strings.Join(languages, ","))
+		if i18n.LocalizeResponse(resp, locale) {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(i18n.ContentLanguageHeader, locale))
+		}
+		return resp, nil
 	}
 }
 
@@ -191,6 +219,14 @@ func GrpcClientTokenInterceptor(tokenData string) grpc.UnaryClientInterceptor {
 	}
 }
 
+// GrpcClientStreamTokenInterceptor attaches the token to the outgoing context of streaming calls.
+func GrpcClientStreamTokenInterceptor(tokenData string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx = metadata.AppendToOutgoingContext(ctx, strings.ToLower(constants.TokenHeader), tokenData)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
 // UnaryRecoverInterceptor returns a new unary server interceptors that recovers from panics.
 func UnaryRecoverInterceptor(errorCode pberrorcode.ErrorCode) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
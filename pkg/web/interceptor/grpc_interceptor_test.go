@@ -0,0 +1,47 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func TestAdjustGRPCRequestAndResponseMasksSensitiveInputConfig(t *testing.T) {
+	ctx := &loggerContext{}
+	req := &kusciaapi.CreateJobRequest{
+		JobId: "job-1",
+		Tasks: []*kusciaapi.Task{{Alias: "task-1", SensitiveInputConfig: `{"password":"secret"}`}},
+	}
+	assert.Empty(t, adjustGRPCRequestAndResponse(ctx, req, nil))
+	assert.Contains(t, string(ctx.RequestBody), "task-1")
+	assert.NotContains(t, string(ctx.RequestBody), "secret")
+}
+
+func TestAdjustGRPCRequestAndResponseMasksDomainCredentials(t *testing.T) {
+	ctx := &loggerContext{}
+	resp := &kusciaapi.RegisterDomainResponse{Data: &kusciaapi.RegisterDomainResponseData{
+		DomainId:      "alice",
+		DeployToken:   "deploy-token-secret",
+		DomainKeyData: "domain-key-secret",
+	}}
+	assert.Empty(t, adjustGRPCRequestAndResponse(ctx, &kusciaapi.RegisterDomainRequest{DomainId: "alice"}, resp))
+	assert.Contains(t, string(ctx.ResponseBody), "alice")
+	assert.NotContains(t, string(ctx.ResponseBody), "deploy-token-secret")
+	assert.NotContains(t, string(ctx.ResponseBody), "domain-key-secret")
+}
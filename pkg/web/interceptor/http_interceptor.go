@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -102,7 +103,8 @@ func HTTPServerLoggingInterceptor(logger nlog.NLog) func(c *gin.Context) {
 				ResponseBody:  emptyBody,
 			}
 
-			if !hasSensitiveHTTPPathPrefix(context.RequestPath) {
+			// the binary protobuf bodies are not logged
+			if !hasSensitiveHTTPPathPrefix(context.RequestPath) && !hasProtobufBody(r, c.Writer) {
 				errors = adjustHTTPRequestAndResponse(logger, context, requestBody, withBodyWriter)
 			}
 			printfLoggerContext(logger, context)
@@ -112,6 +114,15 @@ func HTTPServerLoggingInterceptor(logger nlog.NLog) func(c *gin.Context) {
 	}
 }
 
+func hasProtobufBody(r *http.Request, w gin.ResponseWriter) bool {
+	for _, contentType := range []string{r.Header.Get(constants.ContentTypeHeader), w.Header().Get(constants.ContentTypeHeader)} {
+		if strings.Contains(contentType, "protobuf") {
+			return true
+		}
+	}
+	return false
+}
+
 func adjustHTTPRequestAndResponse(logger nlog.NLog, ctx *loggerContext, reqBody []byte, respBodyWriter *responseWithBodyWriter) (errors []error) {
 	var structInterface any
 	// request body
@@ -22,6 +22,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/secretflow/kuscia/pkg/utils/nlog"
 	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/ratelimit"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,6 +46,36 @@ func TestHTTPServerLoggingInterceptor(t *testing.T) {
 	})
 }
 
+func TestAdjustHTTPRequestAndResponseMasksSensitiveInputConfig(t *testing.T) {
+	for _, body := range []string{
+		`{"job_id":"job-1","tasks":[{"alias":"task-1","sensitive_input_config":"{\"password\":\"secret\"}"}]}`,
+		`{"jobId":"job-1","tasks":[{"alias":"task-1","sensitiveInputConfig":"{\"password\":\"secret\"}"}]}`,
+	} {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		writer := newResponseWithBodyWriter(c.Writer)
+		_, _ = writer.Write([]byte(`{}`))
+		ctx := &loggerContext{}
+		assert.Empty(t, adjustHTTPRequestAndResponse(*nlog.DefaultLogger(), ctx, []byte(body), writer))
+		assert.Contains(t, string(ctx.RequestBody), "task-1")
+		assert.NotContains(t, string(ctx.RequestBody), "secret")
+	}
+}
+
+func TestAdjustHTTPRequestAndResponseMasksDomainCredentials(t *testing.T) {
+	for _, body := range []string{
+		`{"data":{"domain_id":"alice","deploy_token":"deploy-token-secret","domain_key_data":"domain-key-secret"}}`,
+		`{"data":{"domainId":"alice","deployToken":"deploy-token-secret","domainKeyData":"domain-key-secret"}}`,
+	} {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		writer := newResponseWithBodyWriter(c.Writer)
+		_, _ = writer.Write([]byte(body))
+		ctx := &loggerContext{}
+		assert.Empty(t, adjustHTTPRequestAndResponse(*nlog.DefaultLogger(), ctx, nil, writer))
+		assert.Contains(t, string(ctx.ResponseBody), "alice")
+		assert.NotContains(t, string(ctx.ResponseBody), "secret")
+	}
+}
+
 func TestHTTPTokenAuthInterceptor(t *testing.T) {
 	validToken := "test-token-123"
 
@@ -133,3 +164,48 @@ func TestHTTPSetMasterRoleInterceptor(t *testing.T) {
 	engine.ServeHTTP(w, req)
 	assert.Equal(t, 200, w.Code)
 }
+
+func TestHTTPRateLimitInterceptorIgnoresSourceHeader(t *testing.T) {
+	engine := gin.New()
+	engine.Use(HTTPRateLimitInterceptor(ratelimit.NewLimiter(&ratelimit.Config{
+		PerCaller: &ratelimit.TokenBucketConfig{QPS: 1, Burst: 1},
+	})))
+	engine.GET("/limited", func(c *gin.Context) {
+		c.String(http.StatusOK, "OK")
+	})
+
+	codes := make([]int, 0, 2)
+	for _, source := range []string{"alice", "bob"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/limited", nil)
+		req.RemoteAddr = "10.0.0.1:12345"
+		req.Header.Set(constants.SourceDomainHeader, source)
+		engine.ServeHTTP(w, req)
+		codes = append(codes, w.Code)
+	}
+	assert.Equal(t, []int{http.StatusOK, http.StatusTooManyRequests}, codes)
+}
+
+func TestHTTPRateLimitInterceptorKeysByRoute(t *testing.T) {
+	limiter := ratelimit.NewLimiter(&ratelimit.Config{
+		Default: &ratelimit.TokenBucketConfig{QPS: 1, Burst: 1},
+	})
+	engine := gin.New()
+	engine.Use(HTTPRateLimitInterceptor(limiter))
+	engine.GET("/job/:id", func(c *gin.Context) {
+		c.String(http.StatusOK, "OK")
+	})
+
+	serve := func(path string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		engine.ServeHTTP(w, req)
+		return w.Code
+	}
+	// the paths of the same route share a bucket
+	assert.Equal(t, http.StatusOK, serve("/job/a"))
+	assert.Equal(t, http.StatusTooManyRequests, serve("/job/b"))
+	// the unmatched paths share a bucket as well
+	assert.Equal(t, http.StatusNotFound, serve("/unknown/a"))
+	assert.Equal(t, http.StatusTooManyRequests, serve("/unknown/b"))
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/ratelimit"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+)
+
+// GrpcServerRateLimitInterceptor rejects unary requests exceeding the rate or in-flight limits with ResourceExhausted.
+func GrpcServerRateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if ok, retryAfter := limiter.Allow(info.FullMethod, grpcCaller(ctx)); !ok {
+			return nil, grpcRateLimitError(ctx, info.FullMethod, "rate limit exceeded", retryAfter)
+		}
+		release, ok, retryAfter := limiter.Acquire()
+		if !ok {
+			return nil, grpcRateLimitError(ctx, info.FullMethod, "too many in-flight requests", retryAfter)
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
+
+// GrpcStreamServerRateLimitInterceptor rejects stream requests exceeding the rate limits. Streams are long-lived,
+// so they are not counted as in-flight requests.
+func GrpcStreamServerRateLimitInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		if ok, retryAfter := limiter.Allow(info.FullMethod, grpcCaller(ctx)); !ok {
+			return grpcRateLimitError(ctx, info.FullMethod, "rate limit exceeded", retryAfter)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// unmatchedRoute is the method key shared by the requests not matching any route, so that a caller can't create a
+// bucket per request path.
+const unmatchedRoute = "<unmatched>"
+
+// HTTPRateLimitInterceptor rejects requests exceeding the rate or in-flight limits with http status 429.
+// The method buckets are keyed by the matched route rather than the request path.
+func HTTPRateLimitInterceptor(limiter *ratelimit.Limiter) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		path := c.FullPath()
+		if path == "" {
+			path = unmatchedRoute
+		}
+		if ok, retryAfter := limiter.Allow(path, httpCaller(c)); !ok {
+			abortWithRateLimitError(c, path, "rate limit exceeded", retryAfter)
+			return
+		}
+		release, ok, retryAfter := limiter.Acquire()
+		if !ok {
+			abortWithRateLimitError(c, path, "too many in-flight requests", retryAfter)
+			return
+		}
+		defer release()
+		c.Next()
+	}
+}
+
+func grpcRateLimitError(ctx context.Context, method, reason string, retryAfter time.Duration) error {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(strings.ToLower(constants.RetryAfterHeader), retryAfterSeconds(retryAfter)))
+	st := status.Newf(codes.ResourceExhausted, "%s: %s, retry after %s", method, reason, retryAfter)
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func abortWithRateLimitError(c *gin.Context, path, reason string, retryAfter time.Duration) {
+	c.Header(constants.RetryAfterHeader, retryAfterSeconds(retryAfter))
+	err := status.Errorf(codes.ResourceExhausted, "%s: %s, retry after %s", path, reason, retryAfter)
+	_ = c.AbortWithError(http.StatusTooManyRequests, err)
+}
+
+// retryAfterSeconds formats the duration as the delay-seconds of Retry-After, rounded up to at least 1.
+func retryAfterSeconds(d time.Duration) string {
+	seconds := int64(math.Ceil(d.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return strconv.FormatInt(seconds, 10)
+}
+
+// grpcCaller identifies the caller by the authenticated identity: the tenant, then the common name of the verified
+// client certificate, falling back to the peer ip. Headers set by the client are not trusted, otherwise a caller could
+// rotate them to escape its bucket.
+func grpcCaller(ctx context.Context) string {
+	if t := tenant.FromContext(ctx); t != nil {
+		return "tenant/" + t.Name
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+		if cn := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName; cn != "" {
+			return "cn/" + cn
+		}
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
+
+// httpCaller identifies the caller by the authenticated identity: the tenant, then the common name of the verified
+// client certificate, falling back to the remote ip of the connection.
+func httpCaller(c *gin.Context) string {
+	if t, ok := c.Get(constants.AuthTenant); ok {
+		if t, ok := t.(*tenant.Tenant); ok && t != nil {
+			return "tenant/" + t.Name
+		}
+	}
+	if c.Request.TLS != nil && len(c.Request.TLS.VerifiedChains) > 0 {
+		if cn := c.Request.TLS.VerifiedChains[0][0].Subject.CommonName; cn != "" {
+			return "cn/" + cn
+		}
+	}
+	return c.RemoteIP()
+}
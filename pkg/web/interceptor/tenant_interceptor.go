@@ -0,0 +1,93 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/secretflow/kuscia/pkg/web/constants"
+	"github.com/secretflow/kuscia/pkg/web/tenant"
+)
+
+// GrpcServerTenantInterceptor authenticates the callers and binds them to their tenants, it replaces the token
+// interceptor if the tenants are configured.
+func GrpcServerTenantInterceptor(authenticator *tenant.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		ctx, err = grpcAuthenticateTenant(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func GrpcStreamServerTenantInterceptor(authenticator *tenant.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := grpcAuthenticateTenant(ss.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func grpcAuthenticateTenant(ctx context.Context, authenticator *tenant.Authenticator) (context.Context, error) {
+	tokens := metadata.ValueFromIncomingContext(ctx, strings.ToLower(constants.TokenHeader))
+	var certs []*x509.Certificate
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			certs = tlsInfo.State.PeerCertificates
+		}
+	}
+	t, err := authenticator.Authenticate(tokens, certs)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	if t != nil {
+		ctx = context.WithValue(ctx, constants.AuthTenant, t)
+	}
+	return ctx, nil
+}
+
+// HTTPTenantInterceptor authenticates the callers and binds them to their tenants, it replaces the token
+// interceptor if the tenants are configured.
+func HTTPTenantInterceptor(authenticator *tenant.Authenticator) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		var certs []*x509.Certificate
+		if c.Request.TLS != nil {
+			certs = c.Request.TLS.PeerCertificates
+		}
+		t, err := authenticator.Authenticate(c.Request.Header.Values(constants.TokenHeader), certs)
+		if err != nil {
+			_ = c.AbortWithError(http.StatusUnauthorized, err)
+			return
+		}
+		if t != nil {
+			c.Set(constants.AuthTenant, t)
+		}
+		c.Next()
+	}
+}
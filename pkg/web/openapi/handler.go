@@ -0,0 +1,92 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	SpecPath      = "api/openapi.json"
+	SwaggerUIPath = "api/docs"
+
+	// DefaultSwaggerUIAssetsURL is where the swagger ui scripts are loaded from, set a mirror in
+	// environments that can't reach the internet.
+	DefaultSwaggerUIAssetsURL = "https://unpkg.com/swagger-ui-dist@5"
+)
+
+var swaggerUITemplate = template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <title>{{ .Title }}</title>
+  <link rel="stylesheet" href="{{ .AssetsURL }}/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="{{ .AssetsURL }}/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: "{{ .SpecURL }}", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`))
+
+// SpecHandler serves the json of the document. The document is marshaled on the first request, so
+// the operations added after the handler is registered are included as well.
+func SpecHandler(b *Builder) gin.HandlerFunc {
+	var once sync.Once
+	var content []byte
+	return func(c *gin.Context) {
+		once.Do(func() {
+			var err error
+			if content, err = b.JSON(); err != nil {
+				nlog.Errorf("Marshal openapi document failed: %v", err)
+			}
+		})
+		if content == nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", content)
+	}
+}
+
+// SwaggerUIHandler serves a swagger ui page rendering the document at specURL.
+func SwaggerUIHandler(title, specURL, assetsURL string) gin.HandlerFunc {
+	if assetsURL == "" {
+		assetsURL = DefaultSwaggerUIAssetsURL
+	}
+	var page bytes.Buffer
+	if err := swaggerUITemplate.Execute(&page, map[string]string{
+		"Title":     title,
+		"SpecURL":   specURL,
+		"AssetsURL": assetsURL,
+	}); err != nil {
+		nlog.Errorf("Render swagger ui page failed: %v", err)
+	}
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", page.Bytes())
+	}
+}
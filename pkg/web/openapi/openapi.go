@@ -0,0 +1,300 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package openapi builds OpenAPI v3 documents for proto based http apis. The schemas are derived from
+// the proto descriptors compiled into the binary, so the document always matches the served api.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const Version = "3.0.3"
+
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       Info                  `json:"info"`
+	Paths      map[string]*PathItem  `json:"paths"`
+	Components Components            `json:"components"`
+	Security   []map[string][]string `json:"security,omitempty"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type PathItem struct {
+	Get  *Operation `json:"get,omitempty"`
+	Post *Operation `json:"post,omitempty"`
+}
+
+type Operation struct {
+	Tags        []string             `json:"tags,omitempty"`
+	OperationID string               `json:"operationId"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type string `json:"type"`
+	In   string `json:"in"`
+	Name string `json:"name"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	// EnumNames names the values of an integer enum, it's understood by most code generators.
+	EnumNames []string `json:"x-enum-varnames,omitempty"`
+}
+
+const (
+	contentTypeJSON     = "application/json"
+	contentTypeProtobuf = "application/x-protobuf"
+)
+
+// Builder adds proto based operations to a document.
+type Builder struct {
+	doc *Document
+	// useProtoNames should be consistent with the protojson.MarshalOptions of the api.
+	useProtoNames bool
+}
+
+func NewBuilder(info Info, useProtoNames bool) *Builder {
+	return &Builder{
+		doc: &Document{
+			OpenAPI: Version,
+			Info:    info,
+			Paths:   map[string]*PathItem{},
+			Components: Components{
+				Schemas: map[string]*Schema{},
+			},
+		},
+		useProtoNames: useProtoNames,
+	}
+}
+
+// AddHeaderAuth requires the header on all operations.
+func (b *Builder) AddHeaderAuth(name, header string) {
+	if b.doc.Components.SecuritySchemes == nil {
+		b.doc.Components.SecuritySchemes = map[string]*SecurityScheme{}
+	}
+	b.doc.Components.SecuritySchemes[name] = &SecurityScheme{Type: "apiKey", In: "header", Name: header}
+	b.doc.Security = append(b.doc.Security, map[string][]string{name: {}})
+}
+
+// messageContent describes the json and the binary protobuf encoding of the message.
+func (b *Builder) messageContent(md protoreflect.MessageDescriptor) map[string]*MediaType {
+	return map[string]*MediaType{
+		contentTypeJSON: {Schema: b.messageRef(md)},
+		contentTypeProtobuf: {Schema: &Schema{
+			Type:        "string",
+			Format:      "binary",
+			Description: fmt.Sprintf("binary encoded %s", md.FullName()),
+		}},
+	}
+}
+
+// AddOperation documents the api at path, whose body is req and response is resp.
+func (b *Builder) AddOperation(method, path, tag string, req, resp proto.Message) error {
+	item, ok := b.doc.Paths[path]
+	if !ok {
+		item = &PathItem{}
+		b.doc.Paths[path] = item
+	}
+	op := &Operation{
+		OperationID: operationID(path),
+		Responses: map[string]*Response{
+			"200": {
+				Description: "OK",
+				Content:     b.messageContent(resp.ProtoReflect().Descriptor()),
+			},
+		},
+	}
+	if tag != "" {
+		op.Tags = []string{tag}
+	}
+	if req != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  b.messageContent(req.ProtoReflect().Descriptor()),
+		}
+	}
+	switch method {
+	case http.MethodGet:
+		item.Get = op
+	case http.MethodPost:
+		item.Post = op
+	default:
+		return fmt.Errorf("openapi doesn't support http method %s", method)
+	}
+	return nil
+}
+
+// AddIntEnum adds an integer schema whose values are the numbers of the enum, e.g. error codes that
+// are returned as int32 in responses.
+func (b *Builder) AddIntEnum(name, description string, enum protoreflect.EnumDescriptor) {
+	values := enum.Values()
+	schema := &Schema{Type: "integer", Format: "int32", Description: description}
+	for i := 0; i < values.Len(); i++ {
+		schema.Enum = append(schema.Enum, int32(values.Get(i).Number()))
+		schema.EnumNames = append(schema.EnumNames, string(values.Get(i).Name()))
+	}
+	b.doc.Components.Schemas[name] = schema
+}
+
+func (b *Builder) Document() *Document {
+	return b.doc
+}
+
+func (b *Builder) JSON() ([]byte, error) {
+	return json.MarshalIndent(b.doc, "", "  ")
+}
+
+func (b *Builder) messageRef(md protoreflect.MessageDescriptor) *Schema {
+	if schema, ok := wellKnownSchema(md.FullName()); ok {
+		return schema
+	}
+	name := string(md.FullName())
+	if _, ok := b.doc.Components.Schemas[name]; !ok {
+		schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+		// register before walking the fields, so that recursive messages terminate
+		b.doc.Components.Schemas[name] = schema
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			jsonName := fd.JSONName()
+			if b.useProtoNames {
+				jsonName = string(fd.Name())
+			}
+			schema.Properties[jsonName] = b.fieldSchema(fd)
+		}
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func (b *Builder) fieldSchema(fd protoreflect.FieldDescriptor) *Schema {
+	if fd.IsMap() {
+		return &Schema{Type: "object", AdditionalProperties: b.singularSchema(fd.MapValue())}
+	}
+	if fd.IsList() {
+		return &Schema{Type: "array", Items: b.singularSchema(fd)}
+	}
+	return b.singularSchema(fd)
+}
+
+func (b *Builder) singularSchema(fd protoreflect.FieldDescriptor) *Schema {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &Schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &Schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &Schema{Type: "integer", Format: "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson encodes 64-bit integers as strings
+		return &Schema{Type: "string", Format: "int64"}
+	case protoreflect.FloatKind:
+		return &Schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &Schema{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		return &Schema{Type: "string"}
+	case protoreflect.BytesKind:
+		return &Schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		return b.enumRef(fd.Enum())
+	default:
+		return b.messageRef(fd.Message())
+	}
+}
+
+func (b *Builder) enumRef(ed protoreflect.EnumDescriptor) *Schema {
+	name := string(ed.FullName())
+	if _, ok := b.doc.Components.Schemas[name]; !ok {
+		schema := &Schema{Type: "string"}
+		values := ed.Values()
+		for i := 0; i < values.Len(); i++ {
+			schema.Enum = append(schema.Enum, string(values.Get(i).Name()))
+		}
+		b.doc.Components.Schemas[name] = schema
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func wellKnownSchema(name protoreflect.FullName) (*Schema, bool) {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return &Schema{Type: "string", Format: "date-time"}, true
+	case "google.protobuf.Duration":
+		return &Schema{Type: "string"}, true
+	case "google.protobuf.Struct", "google.protobuf.Any", "google.protobuf.Empty":
+		return &Schema{Type: "object"}, true
+	case "google.protobuf.Value":
+		return &Schema{}, true
+	case "google.protobuf.ListValue":
+		return &Schema{Type: "array", Items: &Schema{}}, true
+	case "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		return &Schema{Type: "string"}, true
+	case "google.protobuf.BoolValue":
+		return &Schema{Type: "boolean"}, true
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return &Schema{Type: "integer"}, true
+	case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		return &Schema{Type: "string", Format: "int64"}, true
+	case "google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+		return &Schema{Type: "number"}, true
+	}
+	return nil, false
+}
+
+// operationID turns "/api/v1/job/status/batchQuery" into "job_status_batchQuery".
+func operationID(path string) string {
+	path = strings.TrimPrefix(strings.Trim(path, "/"), "api/v1/")
+	return strings.ReplaceAll(path, "/", "_")
+}
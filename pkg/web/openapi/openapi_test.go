@@ -0,0 +1,77 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	pberrorcode "github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/kusciaapi"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder(Info{Title: "test", Version: "v1"}, true)
+	b.AddHeaderAuth("token", "Token")
+	b.AddIntEnum("ErrorCode", "", pberrorcode.ErrorCode(0).Descriptor())
+	assert.NoError(t, b.AddOperation(http.MethodPost, "/api/v1/job/create", "job", &kusciaapi.CreateJobRequest{}, &kusciaapi.CreateJobResponse{}))
+	assert.Error(t, b.AddOperation(http.MethodPut, "/api/v1/job/update", "job", &kusciaapi.CreateJobRequest{}, &kusciaapi.CreateJobResponse{}))
+
+	doc := b.Document()
+	op := doc.Paths["/api/v1/job/create"].Post
+	assert.Equal(t, "job_create", op.OperationID)
+	assert.Equal(t, "#/components/schemas/kuscia.proto.api.v1alpha1.kusciaapi.CreateJobRequest", op.RequestBody.Content[contentTypeJSON].Schema.Ref)
+	assert.Equal(t, "binary", op.RequestBody.Content[contentTypeProtobuf].Schema.Format)
+
+	req := doc.Components.Schemas["kuscia.proto.api.v1alpha1.kusciaapi.CreateJobRequest"]
+	assert.Equal(t, &Schema{Type: "integer", Format: "int32"}, req.Properties["max_parallelism"])
+	assert.Equal(t, "array", req.Properties["tasks"].Type)
+	assert.Equal(t, &Schema{Type: "string"}, req.Properties["custom_fields"].AdditionalProperties)
+	assert.Contains(t, doc.Components.Schemas, "kuscia.proto.api.v1alpha1.kusciaapi.Task")
+	assert.Contains(t, doc.Components.Schemas["ErrorCode"].EnumNames, "KusciaAPIErrRequestValidate")
+
+	content, err := b.JSON()
+	assert.NoError(t, err)
+	assert.True(t, json.Valid(content))
+}
+
+func TestHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	b := NewBuilder(Info{Title: "test", Version: "v1"}, false)
+	engine := gin.New()
+	engine.GET("/"+SpecPath, SpecHandler(b))
+	engine.GET("/"+SwaggerUIPath, SwaggerUIHandler("test", "/"+SpecPath, ""))
+	// operations added after the handler is registered are served too
+	assert.NoError(t, b.AddOperation(http.MethodPost, "/api/v1/job/create", "job", &kusciaapi.CreateJobRequest{}, &kusciaapi.CreateJobResponse{}))
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/"+SpecPath, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	doc := &Document{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), doc))
+	assert.Contains(t, doc.Paths, "/api/v1/job/create")
+	assert.Contains(t, doc.Components.Schemas["kuscia.proto.api.v1alpha1.kusciaapi.CreateJobRequest"].Properties, "maxParallelism")
+
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/"+SwaggerUIPath, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), DefaultSwaggerUIAssetsURL+"/swagger-ui-bundle.js")
+	assert.Contains(t, w.Body.String(), `url: "\/api\/openapi.json"`)
+}
@@ -0,0 +1,262 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"container/list"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxCallerLimiters bounds the number of per-caller buckets kept in memory.
+	maxCallerLimiters = 4096
+	// callerLimiterIdleTTL is how long an unused per-caller bucket is kept.
+	callerLimiterIdleTTL = 10 * time.Minute
+	// defaultRetryAfter is the retry hint returned when the wait time is unknown.
+	defaultRetryAfter = time.Second
+)
+
+// Config is the rate limit config of an api server.
+type Config struct {
+	// MaxInFlight is the max number of unary requests served at the same time, 0 means unlimited.
+	MaxInFlight int `yaml:"maxInFlight,omitempty"`
+	// Default is the token bucket applied to each method without a specific config.
+	Default *TokenBucketConfig `yaml:"default,omitempty"`
+	// Methods overrides the token bucket by method. The key is the grpc full method
+	// (e.g. /kuscia.proto.api.v1alpha1.kusciaapi.JobService/CreateJob) or the http route (e.g. /api/v1/job/create).
+	Methods map[string]*TokenBucketConfig `yaml:"methods,omitempty"`
+	// PerCaller is the token bucket applied to each caller across all methods.
+	PerCaller *TokenBucketConfig `yaml:"perCaller,omitempty"`
+}
+
+// TokenBucketConfig is the config of a token bucket, QPS <= 0 means unlimited.
+type TokenBucketConfig struct {
+	QPS   float64 `yaml:"qps"`
+	Burst int     `yaml:"burst"`
+}
+
+func (c *TokenBucketConfig) newLimiter() *rate.Limiter {
+	if c == nil || c.QPS <= 0 {
+		return nil
+	}
+	burst := c.Burst
+	if burst <= 0 {
+		burst = int(math.Ceil(c.QPS))
+	}
+	return rate.NewLimiter(rate.Limit(c.QPS), burst)
+}
+
+// NewTokenBucket returns the token bucket of the config, or nil if it's unlimited.
+func NewTokenBucket(c *TokenBucketConfig) *rate.Limiter {
+	return c.newLimiter()
+}
+
+type callerLimiter struct {
+	caller   string
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter checks requests against the method, caller and in-flight limits.
+type Limiter struct {
+	conf     *Config
+	inFlight chan struct{}
+
+	mu             sync.Mutex
+	methodLimiters map[string]*rate.Limiter
+	// callerLimiters indexes the elements of callerLRU, whose front is the most recently used caller.
+	callerLimiters map[string]*list.Element
+	callerLRU      *list.List
+
+	now func() time.Time
+}
+
+// NewLimiter returns a Limiter, or nil if conf is nil.
+func NewLimiter(conf *Config) *Limiter {
+	if conf == nil {
+		return nil
+	}
+	l := &Limiter{
+		conf:           conf,
+		methodLimiters: map[string]*rate.Limiter{},
+		callerLimiters: map[string]*list.Element{},
+		callerLRU:      list.New(),
+		now:            time.Now,
+	}
+	if conf.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, conf.MaxInFlight)
+	}
+	return l
+}
+
+// Reload replaces the config of the limiter. The buckets restart full, and the requests in flight
+// keep their slots until they finish.
+func (l *Limiter) Reload(conf *Config) {
+	if l == nil || conf == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if conf.MaxInFlight != l.conf.MaxInFlight {
+		l.inFlight = nil
+		if conf.MaxInFlight > 0 {
+			l.inFlight = make(chan struct{}, conf.MaxInFlight)
+		}
+	}
+	l.conf = conf
+	l.methodLimiters = map[string]*rate.Limiter{}
+	l.callerLimiters = map[string]*list.Element{}
+	l.callerLRU = list.New()
+}
+
+// Group keeps the limiters built from the same config, so that they can be reloaded together.
+type Group struct {
+	mu       sync.Mutex
+	limiters []*Limiter
+}
+
+// NewLimiter returns a Limiter tracked by the group. A nil group returns an untracked Limiter.
+func (g *Group) NewLimiter(conf *Config) *Limiter {
+	l := NewLimiter(conf)
+	if g == nil || l == nil {
+		return l
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.limiters = append(g.limiters, l)
+	return l
+}
+
+// Reload replaces the config of all the limiters in the group.
+func (g *Group) Reload(conf *Config) {
+	if g == nil {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, l := range g.limiters {
+		l.Reload(conf)
+	}
+}
+
+// Allow consumes a token of the method bucket and the caller bucket. If the request is rejected,
+// it returns false and the duration the caller should wait before retrying.
+func (l *Limiter) Allow(method, caller string) (bool, time.Duration) {
+	if l == nil {
+		return true, 0
+	}
+	now := l.now()
+	methodLimiter := l.methodLimiter(method)
+	callerLimiter := l.callerLimiter(caller, now)
+
+	var reservations []*rate.Reservation
+	cancel := func() {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+	for _, limiter := range []*rate.Limiter{methodLimiter, callerLimiter} {
+		if limiter == nil {
+			continue
+		}
+		r := limiter.ReserveN(now, 1)
+		if !r.OK() {
+			cancel()
+			return false, defaultRetryAfter
+		}
+		reservations = append(reservations, r)
+		if delay := r.DelayFrom(now); delay > 0 {
+			cancel()
+			return false, delay
+		}
+	}
+	return true, 0
+}
+
+// Acquire takes an in-flight slot without blocking. The returned release func must be called
+// when the request finishes.
+func (l *Limiter) Acquire() (release func(), ok bool, retryAfter time.Duration) {
+	if l == nil {
+		return func() {}, true, 0
+	}
+	l.mu.Lock()
+	inFlight := l.inFlight
+	l.mu.Unlock()
+	if inFlight == nil {
+		return func() {}, true, 0
+	}
+	select {
+	case inFlight <- struct{}{}:
+		return func() { <-inFlight }, true, 0
+	default:
+		return nil, false, defaultRetryAfter
+	}
+}
+
+func (l *Limiter) methodLimiter(method string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if limiter, ok := l.methodLimiters[method]; ok {
+		return limiter
+	}
+	conf := l.conf.Default
+	if c, ok := l.conf.Methods[method]; ok {
+		conf = c
+	}
+	limiter := conf.newLimiter()
+	l.methodLimiters[method] = limiter
+	return limiter
+}
+
+func (l *Limiter) callerLimiter(caller string, now time.Time) *rate.Limiter {
+	if caller == "" {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conf.PerCaller == nil {
+		return nil
+	}
+	if e, ok := l.callerLimiters[caller]; ok {
+		cl := e.Value.(*callerLimiter)
+		cl.lastSeen = now
+		l.callerLRU.MoveToFront(e)
+		return cl.limiter
+	}
+	limiter := l.conf.PerCaller.newLimiter()
+	if limiter == nil {
+		return nil
+	}
+	l.evictCallerLimiters(now)
+	l.callerLimiters[caller] = l.callerLRU.PushFront(&callerLimiter{caller: caller, limiter: limiter, lastSeen: now})
+	return limiter
+}
+
+// evictCallerLimiters drops the buckets idle for longer than callerLimiterIdleTTL, and then the least recently used
+// ones until there is room for a new bucket.
+func (l *Limiter) evictCallerLimiters(now time.Time) {
+	for e := l.callerLRU.Back(); e != nil; e = l.callerLRU.Back() {
+		cl := e.Value.(*callerLimiter)
+		if len(l.callerLimiters) < maxCallerLimiters && now.Sub(cl.lastSeen) <= callerLimiterIdleTTL {
+			return
+		}
+		l.callerLRU.Remove(e)
+		delete(l.callerLimiters, cl.caller)
+	}
+}
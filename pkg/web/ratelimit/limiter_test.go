@@ -0,0 +1,161 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiterAllow(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewLimiter(&Config{
+		Default: &TokenBucketConfig{QPS: 1, Burst: 2},
+		Methods: map[string]*TokenBucketConfig{
+			"/api/v1/job/create": {QPS: 1, Burst: 1},
+			"/api/v1/healthZ":    {QPS: 0},
+		},
+	})
+	l.now = func() time.Time { return now }
+
+	ok, _ := l.Allow("/api/v1/job/create", "alice")
+	assert.True(t, ok)
+	ok, retryAfter := l.Allow("/api/v1/job/create", "alice")
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, retryAfter)
+
+	// default bucket is per method
+	for i := 0; i < 2; i++ {
+		ok, _ = l.Allow("/api/v1/job/query", "alice")
+		assert.True(t, ok)
+	}
+	ok, _ = l.Allow("/api/v1/job/query", "alice")
+	assert.False(t, ok)
+
+	// qps 0 means unlimited
+	for i := 0; i < 10; i++ {
+		ok, _ = l.Allow("/api/v1/healthZ", "alice")
+		assert.True(t, ok)
+	}
+
+	now = now.Add(time.Second)
+	ok, _ = l.Allow("/api/v1/job/create", "alice")
+	assert.True(t, ok)
+}
+
+func TestLimiterAllowPerCaller(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewLimiter(&Config{
+		Default:   &TokenBucketConfig{QPS: 100, Burst: 100},
+		PerCaller: &TokenBucketConfig{QPS: 1, Burst: 1},
+	})
+	l.now = func() time.Time { return now }
+
+	ok, _ := l.Allow("/api/v1/job/create", "alice")
+	assert.True(t, ok)
+	ok, _ = l.Allow("/api/v1/job/query", "alice")
+	assert.False(t, ok)
+	ok, _ = l.Allow("/api/v1/job/query", "bob")
+	assert.True(t, ok)
+
+	// a rejected caller must not consume tokens of the method bucket
+	l = NewLimiter(&Config{
+		Default:   &TokenBucketConfig{QPS: 1, Burst: 1},
+		PerCaller: &TokenBucketConfig{QPS: 1, Burst: 1},
+	})
+	l.now = func() time.Time { return now }
+	ok, _ = l.Allow("/a", "alice")
+	assert.True(t, ok)
+	ok, _ = l.Allow("/b", "alice")
+	assert.False(t, ok)
+	ok, _ = l.Allow("/b", "bob")
+	assert.True(t, ok)
+}
+
+func TestLimiterCallerEviction(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewLimiter(&Config{PerCaller: &TokenBucketConfig{QPS: 1, Burst: 1}})
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < maxCallerLimiters+10; i++ {
+		ok, _ := l.Allow("/a", fmt.Sprintf("caller-%d", i))
+		assert.True(t, ok)
+	}
+	assert.Len(t, l.callerLimiters, maxCallerLimiters)
+	assert.Equal(t, maxCallerLimiters, l.callerLRU.Len())
+
+	// the least recently used callers are evicted, the recent ones keep their buckets
+	_, ok := l.callerLimiters["caller-0"]
+	assert.False(t, ok)
+	ok, _ = l.Allow("/a", fmt.Sprintf("caller-%d", maxCallerLimiters+9))
+	assert.False(t, ok)
+
+	// idle buckets are dropped first
+	now = now.Add(callerLimiterIdleTTL + time.Second)
+	ok, _ = l.Allow("/a", "bob")
+	assert.True(t, ok)
+	assert.Len(t, l.callerLimiters, 1)
+}
+
+func TestLimiterAcquire(t *testing.T) {
+	l := NewLimiter(&Config{MaxInFlight: 1})
+	release, ok, _ := l.Acquire()
+	assert.True(t, ok)
+	_, ok, retryAfter := l.Acquire()
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, retryAfter)
+	release()
+	release, ok, _ = l.Acquire()
+	assert.True(t, ok)
+	release()
+}
+
+func TestNilLimiter(t *testing.T) {
+	var l *Limiter
+	ok, _ := l.Allow("/a", "alice")
+	assert.True(t, ok)
+	release, ok, _ := l.Acquire()
+	assert.True(t, ok)
+	release()
+}
+
+func TestGroupReload(t *testing.T) {
+	g := &Group{}
+	l := g.NewLimiter(&Config{MaxInFlight: 1, Default: &TokenBucketConfig{QPS: 1, Burst: 1}})
+	assert.Nil(t, g.NewLimiter(nil))
+	release, ok, _ := l.Acquire()
+	assert.True(t, ok)
+	_, ok, _ = l.Acquire()
+	assert.False(t, ok)
+	ok, _ = l.Allow("/a", "")
+	assert.True(t, ok)
+	ok, _ = l.Allow("/a", "")
+	assert.False(t, ok)
+
+	g.Reload(&Config{MaxInFlight: 2, Default: &TokenBucketConfig{QPS: 100, Burst: 2}})
+	// the request taking the old slot releases it to the old channel
+	release()
+	for i := 0; i < 2; i++ {
+		_, ok, _ = l.Acquire()
+		assert.True(t, ok)
+		ok, _ = l.Allow("/a", "")
+		assert.True(t, ok)
+	}
+	_, ok, _ = l.Acquire()
+	assert.False(t, ok)
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+	"fmt"
+	"slices"
+
+	"github.com/secretflow/kuscia/pkg/web/constants"
+)
+
+// Tenant is a caller of the KusciaAPI bound to a set of domains.
+type Tenant struct {
+	Name    string
+	Domains []string
+	// Token authenticates the tenant, it's optional if the tenant is authenticated by the client certificates.
+	Token string
+	// CommonNames are the common names of the client certificates of the tenant.
+	CommonNames []string
+}
+
+// Allows reports whether the tenant is bound to the domain.
+func (t *Tenant) Allows(domainID string) bool {
+	return slices.Contains(t.Domains, domainID)
+}
+
+// FromContext returns the tenant of the caller, nil means the caller isn't bound to any tenant.
+func FromContext(ctx context.Context) *Tenant {
+	t, _ := ctx.Value(constants.AuthTenant).(*Tenant)
+	return t
+}
+
+// CheckDomains returns an error if the caller is bound to a tenant which doesn't allow any of the domains.
+func CheckDomains(ctx context.Context, domainIDs ...string) error {
+	t := FromContext(ctx)
+	if t == nil {
+		return nil
+	}
+	for _, domainID := range domainIDs {
+		if !t.Allows(domainID) {
+			return fmt.Errorf("tenant %s is not allowed to operate the resources of domain %q", t.Name, domainID)
+		}
+	}
+	return nil
+}
+
+// Authenticator identifies the tenant of the callers by the tokens and client certificates.
+type Authenticator struct {
+	// token is the token of the administrator, who isn't bound to any tenant.
+	token       string
+	tenants     []*Tenant
+	commonNames map[string]*Tenant
+}
+
+// NewAuthenticator returns an Authenticator, the callers with the token are administrators unless their client
+// certificates belong to a tenant. An empty token means the administrators are not authenticated by the token.
+func NewAuthenticator(token string, tenants []*Tenant) (*Authenticator, error) {
+	a := &Authenticator{token: token, tenants: tenants, commonNames: map[string]*Tenant{}}
+	for _, t := range tenants {
+		if len(t.Domains) == 0 {
+			return nil, fmt.Errorf("tenant %s is not bound to any domain", t.Name)
+		}
+		if t.Token == "" && len(t.CommonNames) == 0 {
+			return nil, fmt.Errorf("tenant %s has neither token nor common names", t.Name)
+		}
+		for _, cn := range t.CommonNames {
+			if other, ok := a.commonNames[cn]; ok {
+				return nil, fmt.Errorf("common name %q is bound to both tenant %s and %s", cn, other.Name, t.Name)
+			}
+			a.commonNames[cn] = t
+		}
+	}
+	return a, nil
+}
+
+// Authenticate returns the tenant of the caller, or nil if the caller is an administrator.
+func (a *Authenticator) Authenticate(tokens []string, certs []*x509.Certificate) (*Tenant, error) {
+	for _, token := range tokens {
+		for _, t := range a.tenants {
+			if t.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(t.Token)) == 1 {
+				return t, nil
+			}
+		}
+	}
+
+	var certTenant *Tenant
+	if len(certs) > 0 {
+		certTenant = a.commonNames[certs[0].Subject.CommonName]
+	}
+	if a.token == "" {
+		return certTenant, nil
+	}
+	for _, token := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) == 1 {
+			return certTenant, nil
+		}
+	}
+	if certTenant != nil && certTenant.Token == "" {
+		return certTenant, nil
+	}
+	return nil, fmt.Errorf("unauthorized")
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/secretflow/kuscia/pkg/web/constants"
+)
+
+func TestAuthenticate(t *testing.T) {
+	alice := &Tenant{Name: "alice", Domains: []string{"alice"}, Token: "alice-token"}
+	bob := &Tenant{Name: "bob", Domains: []string{"bob", "carol"}, CommonNames: []string{"bob-client"}}
+	authenticator, err := NewAuthenticator("admin-token", []*Tenant{alice, bob})
+	assert.NoError(t, err)
+
+	bobCert := &x509.Certificate{Subject: pkix.Name{CommonName: "bob-client"}}
+	otherCert := &x509.Certificate{Subject: pkix.Name{CommonName: "other"}}
+	tests := []struct {
+		name    string
+		tokens  []string
+		certs   []*x509.Certificate
+		want    *Tenant
+		wantErr bool
+	}{
+		{name: "admin", tokens: []string{"admin-token"}},
+		{name: "admin with unknown certificate", tokens: []string{"admin-token"}, certs: []*x509.Certificate{otherCert}},
+		{name: "tenant token", tokens: []string{"alice-token"}, want: alice},
+		{name: "admin token with tenant certificate", tokens: []string{"admin-token"}, certs: []*x509.Certificate{bobCert}, want: bob},
+		{name: "tenant certificate without token", certs: []*x509.Certificate{bobCert}, want: bob},
+		{name: "wrong token", tokens: []string{"wrong"}, wantErr: true},
+		{name: "no token", certs: []*x509.Certificate{otherCert}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := authenticator.Authenticate(tt.tokens, tt.certs)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err = NewAuthenticator("", []*Tenant{{Name: "empty", Token: "token"}})
+	assert.Error(t, err)
+	_, err = NewAuthenticator("", []*Tenant{{Name: "bob", Domains: []string{"bob"}, CommonNames: []string{"cn"}}, {Name: "carol", Domains: []string{"carol"}, CommonNames: []string{"cn"}}})
+	assert.Error(t, err)
+}
+
+func TestCheckDomains(t *testing.T) {
+	assert.NoError(t, CheckDomains(context.Background(), "alice"))
+
+	ctx := context.WithValue(context.Background(), constants.AuthTenant, &Tenant{Name: "bob", Domains: []string{"bob", "carol"}})
+	assert.NoError(t, CheckDomains(ctx, "bob", "carol"))
+	assert.Error(t, CheckDomains(ctx, "bob", "alice"))
+}
@@ -148,8 +148,16 @@ func filterMapDesensitizeKey(mapData map[string]interface{}, nameFunc fieldTypeN
 			}
 			mapData[key] = newSubSlice
 		default:
-			if reflect.ValueOf(val).Kind() == reflect.Struct {
+			rv := reflect.ValueOf(val)
+			if rv.Kind() == reflect.Struct {
 				mapData[key] = structToMap(val, nameFunc, sensitiveFields...)
+			} else if rv.Kind() == reflect.Slice && isStructType(rv.Type().Elem()) {
+				// the repeated messages of the protobuf, e.g. []*Task
+				newSubSlice := make([]map[string]interface{}, 0, rv.Len())
+				for i := 0; i < rv.Len(); i++ {
+					newSubSlice = append(newSubSlice, structToMap(rv.Index(i).Interface(), nameFunc, sensitiveFields...))
+				}
+				mapData[key] = newSubSlice
 			} else {
 				mapData[key] = desensitizeFieldOrKeyValue(key, value, sensitiveFields)
 			}
@@ -158,6 +166,13 @@ func filterMapDesensitizeKey(mapData map[string]interface{}, nameFunc fieldTypeN
 	return mapData
 }
 
+func isStructType(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
 func desensitizeFieldOrKeyValue(fieldOrKeyName string, v any, sensitiveFields []string) any {
 	fieldInterface := v
 	for _, sensitiveField := range sensitiveFields {
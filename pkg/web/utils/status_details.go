@@ -0,0 +1,93 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1"
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+)
+
+// DefaultRetryDelay is suggested to clients when a retryable error doesn't carry a delay itself.
+const DefaultRetryDelay = time.Second
+
+// FieldViolationError is a validation error of a single request field. The field is a path like
+// "tasks[0].parties[1].domain_id", following the proto field names.
+type FieldViolationError struct {
+	Field       string
+	Description string
+}
+
+func NewFieldViolation(field, format string, args ...interface{}) error {
+	return &FieldViolationError{Field: field, Description: fmt.Sprintf(format, args...)}
+}
+
+func (e *FieldViolationError) Error() string {
+	return e.Description
+}
+
+// BuildErrorResponseStatusWithDetails builds an error status carrying the typed details, e.g. the
+// messages of google.golang.org/genproto/googleapis/rpc/errdetails.
+func BuildErrorResponseStatusWithDetails(errCode errorcode.ErrorCode, msg string, details ...proto.Message) *v1alpha1.Status {
+	status := BuildErrorResponseStatus(errCode, msg)
+	for _, detail := range details {
+		anyDetail, err := anypb.New(detail)
+		if err != nil {
+			nlog.Warnf("Marshal status detail %T failed: %v", detail, err)
+			continue
+		}
+		status.Details = append(status.Details, anyDetail)
+	}
+	return status
+}
+
+// BuildErrorResponseStatusFromError builds an error status with the message of err. A BadRequest detail
+// is attached for field violations, and a RetryInfo detail for errors that may succeed on retry.
+func BuildErrorResponseStatusFromError(errCode errorcode.ErrorCode, err error) *v1alpha1.Status {
+	var details []proto.Message
+	var violation *FieldViolationError
+	if errors.As(err, &violation) {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: violation.Field, Description: violation.Description}},
+		})
+	}
+	if delay, ok := RetryDelay(err); ok {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	}
+	return BuildErrorResponseStatusWithDetails(errCode, err.Error(), details...)
+}
+
+// RetryDelay reports whether err is a transient error of the kubernetes api, and the delay suggested
+// before retrying.
+func RetryDelay(err error) (time.Duration, bool) {
+	if seconds, ok := k8serrors.SuggestsClientDelay(err); ok && seconds > 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if k8serrors.IsConflict(err) || k8serrors.IsServerTimeout(err) || k8serrors.IsTimeout(err) ||
+		k8serrors.IsTooManyRequests(err) || k8serrors.IsServiceUnavailable(err) {
+		return DefaultRetryDelay, true
+	}
+	return 0, false
+}
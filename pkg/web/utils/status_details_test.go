@@ -0,0 +1,65 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/encoding/protojson"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/secretflow/kuscia/proto/api/v1alpha1/errorcode"
+)
+
+func TestBuildErrorResponseStatusFromError(t *testing.T) {
+	err := fmt.Errorf("validate failed: %w", NewFieldViolation("tasks[0].alias", "task alias can not be empty on tasks[%d]", 0))
+	status := BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrRequestValidate, err)
+	assert.Equal(t, int32(errorcode.ErrorCode_KusciaAPIErrRequestValidate), status.Code)
+	assert.Equal(t, "validate failed: task alias can not be empty on tasks[0]", status.Message)
+	assert.Len(t, status.Details, 1)
+	badRequest := &errdetails.BadRequest{}
+	assert.NoError(t, status.Details[0].UnmarshalTo(badRequest))
+	assert.Equal(t, "tasks[0].alias", badRequest.FieldViolations[0].Field)
+
+	conflict := k8serrors.NewConflict(schema.GroupResource{Resource: "kusciajobs"}, "job-1", errors.New("modified"))
+	status = BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateJob, conflict)
+	assert.Len(t, status.Details, 1)
+	retryInfo := &errdetails.RetryInfo{}
+	assert.NoError(t, status.Details[0].UnmarshalTo(retryInfo))
+	assert.Equal(t, DefaultRetryDelay, retryInfo.RetryDelay.AsDuration())
+
+	// the details can be rendered by the http api
+	content, err := protojson.Marshal(status)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "type.googleapis.com/google.rpc.RetryInfo")
+
+	status = BuildErrorResponseStatusFromError(errorcode.ErrorCode_KusciaAPIErrCreateJob, errors.New("unknown"))
+	assert.Empty(t, status.Details)
+}
+
+func TestRetryDelay(t *testing.T) {
+	delay, ok := RetryDelay(k8serrors.NewTooManyRequests("slow down", 3))
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	_, ok = RetryDelay(k8serrors.NewNotFound(schema.GroupResource{Resource: "kusciajobs"}, "job-1"))
+	assert.False(t, ok)
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook serves the validating and defaulting admission webhooks of the Kuscia custom resources,
+// so that the resources written by kubectl or other controllers are checked with the rules of KusciaAPI.
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+)
+
+const (
+	validatePathPrefix = "/validate/"
+	mutatePathPrefix   = "/mutate/"
+
+	// maxRequestBytes is the limit of the admission review, the api server limits the objects to 3MB.
+	maxRequestBytes = 4 << 20
+)
+
+// patchOperation is an operation of the json patch returned by the defaulting webhooks.
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type admitFunc func(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse
+
+// object is the custom resource with the spec checked by the webhooks.
+type object[T any] interface {
+	*T
+	metav1.Object
+}
+
+// validating admits the object if it passes the validation. An update keeping the spec unchanged is always admitted,
+// so that the objects created before the webhook keep working, e.g. the controllers could still update their labels.
+func validating[T any, PT object[T]](validate func(obj PT) field.ErrorList, spec func(obj PT) interface{}) admitFunc {
+	return func(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+		obj := PT(new(T))
+		if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+			return errorResponse(http.StatusBadRequest, fmt.Errorf("decode object failed, %v", err))
+		}
+		if req.Operation == admissionv1.Update {
+			old := PT(new(T))
+			if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+				return errorResponse(http.StatusBadRequest, fmt.Errorf("decode old object failed, %v", err))
+			}
+			if equality.Semantic.DeepEqual(spec(old), spec(obj)) {
+				return allowedResponse()
+			}
+		}
+		if errs := validate(obj); len(errs) > 0 {
+			return &admissionv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Status:  metav1.StatusFailure,
+					Code:    http.StatusUnprocessableEntity,
+					Reason:  metav1.StatusReasonInvalid,
+					Message: fmt.Sprintf("%s %q is invalid: %v", req.Kind.Kind, obj.GetName(), errs.ToAggregate()),
+				},
+			}
+		}
+		return allowedResponse()
+	}
+}
+
+// defaulting admits the object with the json patch setting the defaults.
+func defaulting[T any, PT object[T]](defaults func(obj PT) []patchOperation) admitFunc {
+	return func(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+		obj := PT(new(T))
+		if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+			return errorResponse(http.StatusBadRequest, fmt.Errorf("decode object failed, %v", err))
+		}
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(req.Namespace)
+		}
+		patches := defaults(obj)
+		if len(patches) == 0 {
+			return allowedResponse()
+		}
+		patch, err := json.Marshal(patches)
+		if err != nil {
+			return errorResponse(http.StatusInternalServerError, err)
+		}
+		patchType := admissionv1.PatchTypeJSONPatch
+		return &admissionv1.AdmissionResponse{Allowed: true, Patch: patch, PatchType: &patchType}
+	}
+}
+
+func allowedResponse() *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func errorResponse(code int32, err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    code,
+			Message: err.Error(),
+		},
+	}
+}
+
+// serveAdmission decodes the admission review, admits the request and writes the review with the response back.
+func serveAdmission(admit admitFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBytes))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		review := &admissionv1.AdmissionReview{}
+		if err = json.Unmarshal(body, review); err != nil || review.Request == nil {
+			http.Error(w, fmt.Sprintf("invalid admission review, %v", err), http.StatusBadRequest)
+			return
+		}
+
+		response := admit(review.Request)
+		response.UID = review.Request.UID
+		if !response.Allowed {
+			nlog.Infof("Reject %s %s/%s by %s: %s", review.Request.Operation, review.Request.Namespace, review.Request.Name,
+				review.Request.UserInfo.Username, response.Result.Message)
+		}
+		result, err := json.Marshal(&admissionv1.AdmissionReview{
+			TypeMeta: metav1.TypeMeta{APIVersion: admissionv1.SchemeGroupVersion.String(), Kind: "AdmissionReview"},
+			Response: response,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(result)
+	}
+}
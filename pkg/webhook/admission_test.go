@@ -0,0 +1,146 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+func review(t *testing.T, handler http.Handler, op admissionv1.Operation, obj, old interface{}) *admissionv1.AdmissionResponse {
+	req := &admissionv1.AdmissionRequest{
+		UID:       types.UID("uid-1"),
+		Kind:      metav1.GroupVersionKind{Group: "kuscia.secretflow", Version: "v1alpha1", Kind: "KusciaJob"},
+		Namespace: "alice",
+		Operation: op,
+	}
+	raw, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	req.Object = runtime.RawExtension{Raw: raw}
+	if old != nil {
+		raw, err = json.Marshal(old)
+		assert.NoError(t, err)
+		req.OldObject = runtime.RawExtension{Raw: raw}
+	}
+	body, err := json.Marshal(&admissionv1.AdmissionReview{Request: req})
+	assert.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	result := &admissionv1.AdmissionReview{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), result))
+	assert.Equal(t, req.UID, result.Response.UID)
+	return result.Response
+}
+
+func TestServeAdmission_Validating(t *testing.T) {
+	t.Parallel()
+	handler := serveAdmission(validating(validateKusciaJob, func(job *v1alpha1.KusciaJob) interface{} { return job.Spec }))
+	job := &v1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "job-1"},
+		Spec: v1alpha1.KusciaJobSpec{
+			Initiator: "alice",
+			Tasks:     []v1alpha1.KusciaTaskTemplate{{Alias: "task-1", Parties: []v1alpha1.Party{{DomainID: "alice"}}}},
+		},
+	}
+	assert.True(t, review(t, handler, admissionv1.Create, job, nil).Allowed)
+
+	invalid := job.DeepCopy()
+	invalid.Spec.Initiator = ""
+	resp := review(t, handler, admissionv1.Create, invalid, nil)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, int32(http.StatusUnprocessableEntity), resp.Result.Code)
+	assert.Contains(t, resp.Result.Message, "spec.initiator")
+
+	// the objects created before the webhook could still be updated without changing the spec
+	labeled := invalid.DeepCopy()
+	labeled.Labels = map[string]string{"foo": "bar"}
+	assert.True(t, review(t, handler, admissionv1.Update, labeled, invalid).Allowed)
+	labeled.Spec.Tasks[0].Alias = ""
+	assert.False(t, review(t, handler, admissionv1.Update, labeled, invalid).Allowed)
+}
+
+func TestServeAdmission_Defaulting(t *testing.T) {
+	t.Parallel()
+	handler := serveAdmission(defaulting(defaultDomainDataGrant))
+	resp := review(t, handler, admissionv1.Create, &v1alpha1.DomainDataGrant{ObjectMeta: metav1.ObjectMeta{Name: "grant-1"}}, nil)
+	assert.True(t, resp.Allowed)
+	assert.Equal(t, admissionv1.PatchTypeJSONPatch, *resp.PatchType)
+	assert.JSONEq(t, `[{"op":"add","path":"/spec/author","value":"alice"}]`, string(resp.Patch))
+
+	resp = review(t, handler, admissionv1.Create, &v1alpha1.DomainDataGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant-1"},
+		Spec:       v1alpha1.DomainDataGrantSpec{Author: "alice"},
+	}, nil)
+	assert.True(t, resp.Allowed)
+	assert.Empty(t, resp.Patch)
+}
+
+func TestServeAdmission_BadRequest(t *testing.T) {
+	t.Parallel()
+	handler := serveAdmission(defaulting(defaultKusciaJob))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("{}"))))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestServerRegister(t *testing.T) {
+	t.Parallel()
+	caKey, caCertData, err := tlsutils.CreateCA("kuscia-test")
+	assert.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caCertData)
+	assert.NoError(t, err)
+
+	kubeClient := fake.NewSimpleClientset()
+	server, err := NewServer(&Config{CACert: caCert, CAKey: caKey, KubeClient: kubeClient})
+	assert.NoError(t, err)
+	assert.False(t, server.Ready())
+
+	ctx := context.Background()
+	// registering twice updates the existing configurations
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, server.register(ctx))
+	}
+
+	validatingConf, err := kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, ConfigurationName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, validatingConf.Webhooks, 4)
+	assert.Equal(t, "kusciajobs.validating.kuscia.secretflow", validatingConf.Webhooks[0].Name)
+	assert.Equal(t, "https://127.0.0.1:8096/validate/kusciajobs", *validatingConf.Webhooks[0].ClientConfig.URL)
+
+	mutatingConf, err := kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, ConfigurationName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, mutatingConf.Webhooks, 3)
+}
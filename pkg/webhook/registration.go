@@ -0,0 +1,134 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+// ConfigurationName is the name of both the validating and the mutating webhook configurations.
+const ConfigurationName = "kuscia-admission-webhook"
+
+const webhookTimeoutSeconds = 5
+
+// register creates or updates the webhook configurations pointing to the server, the url and ca bundle are
+// refreshed on every start because the serving cert is regenerated.
+func (s *Server) register(ctx context.Context) error {
+	caBundle, err := tlsutils.EncodeCert(s.caCert)
+	if err != nil {
+		return err
+	}
+
+	validating := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigurationName},
+	}
+	mutating := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigurationName},
+	}
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	failurePolicy := admissionregistrationv1.Fail
+	timeout := int32(webhookTimeoutSeconds)
+	for _, w := range webhooks() {
+		rules := []admissionregistrationv1.RuleWithOperations{{
+			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{v1alpha1.SchemeGroupVersion.Group},
+				APIVersions: []string{v1alpha1.SchemeGroupVersion.Version},
+				Resources:   []string{w.resource},
+			},
+		}}
+		validating.Webhooks = append(validating.Webhooks, admissionregistrationv1.ValidatingWebhook{
+			Name:                    fmt.Sprintf("%s.validating.%s", w.resource, v1alpha1.SchemeGroupVersion.Group),
+			ClientConfig:            s.clientConfig(validatePathPrefix+w.resource, caBundle),
+			Rules:                   rules,
+			FailurePolicy:           &failurePolicy,
+			SideEffects:             &sideEffects,
+			TimeoutSeconds:          &timeout,
+			AdmissionReviewVersions: []string{"v1"},
+		})
+		if w.mutate == nil {
+			continue
+		}
+		mutating.Webhooks = append(mutating.Webhooks, admissionregistrationv1.MutatingWebhook{
+			Name:                    fmt.Sprintf("%s.mutating.%s", w.resource, v1alpha1.SchemeGroupVersion.Group),
+			ClientConfig:            s.clientConfig(mutatePathPrefix+w.resource, caBundle),
+			Rules:                   rules,
+			FailurePolicy:           &failurePolicy,
+			SideEffects:             &sideEffects,
+			TimeoutSeconds:          &timeout,
+			AdmissionReviewVersions: []string{"v1"},
+		})
+	}
+
+	if err = s.registerValidating(ctx, validating); err != nil {
+		return fmt.Errorf("register validating webhook configuration failed, %v", err)
+	}
+	if err = s.registerMutating(ctx, mutating); err != nil {
+		return fmt.Errorf("register mutating webhook configuration failed, %v", err)
+	}
+	nlog.Infof("Registered admission webhook configurations %q", ConfigurationName)
+	return nil
+}
+
+func (s *Server) clientConfig(path, caBundle string) admissionregistrationv1.WebhookClientConfig {
+	url := fmt.Sprintf("https://%s:%d%s", listenAddress, s.port, path)
+	return admissionregistrationv1.WebhookClientConfig{URL: &url, CABundle: []byte(caBundle)}
+}
+
+func (s *Server) registerValidating(ctx context.Context, conf *admissionregistrationv1.ValidatingWebhookConfiguration) error {
+	client := s.kubeClient.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := client.Get(ctx, conf.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			_, err = client.Create(ctx, conf, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		existing = existing.DeepCopy()
+		existing.Webhooks = conf.Webhooks
+		_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (s *Server) registerMutating(ctx context.Context, conf *admissionregistrationv1.MutatingWebhookConfiguration) error {
+	client := s.kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := client.Get(ctx, conf.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			_, err = client.Create(ctx, conf, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		existing = existing.DeepCopy()
+		existing.Webhooks = conf.Webhooks
+		_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+		return err
+	})
+}
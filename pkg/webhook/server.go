@@ -0,0 +1,179 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/nlog"
+	tlsutils "github.com/secretflow/kuscia/pkg/utils/tls"
+)
+
+const (
+	// DefaultPort is the port of the webhook server, which only listens on the loopback address
+	// because the webhooks are called by the api server of the same node.
+	DefaultPort = 8096
+
+	listenAddress = "127.0.0.1"
+)
+
+// Config is the config of the webhook server.
+type Config struct {
+	Port       int
+	CACert     *x509.Certificate
+	CAKey      *rsa.PrivateKey
+	KubeClient kubernetes.Interface
+}
+
+// Server serves the admission webhooks and registers them to the api server.
+type Server struct {
+	port       int
+	caCert     *x509.Certificate
+	kubeClient kubernetes.Interface
+	tlsConfig  *tls.Config
+	handler    http.Handler
+	ready      atomic.Bool
+}
+
+// webhook is an admission webhook of a kuscia resource.
+type webhook struct {
+	resource string
+	validate admitFunc
+	mutate   admitFunc
+}
+
+func webhooks() []webhook {
+	return []webhook{
+		{
+			resource: "kusciajobs",
+			validate: validating(validateKusciaJob, func(job *v1alpha1.KusciaJob) interface{} { return job.Spec }),
+			mutate:   defaulting(defaultKusciaJob),
+		},
+		{
+			resource: "domaindatagrants",
+			validate: validating(validateDomainDataGrant, func(grant *v1alpha1.DomainDataGrant) interface{} { return grant.Spec }),
+			mutate:   defaulting(defaultDomainDataGrant),
+		},
+		{
+			resource: "domainroutes",
+			validate: validating(validateDomainRoute, func(dr *v1alpha1.DomainRoute) interface{} { return dr.Spec }),
+			mutate:   defaulting(defaultDomainRoute),
+		},
+		{
+			resource: "appimages",
+			validate: validating(validateAppImage, func(appImage *v1alpha1.AppImage) interface{} { return appImage.Spec }),
+		},
+	}
+}
+
+// NewServer creates the webhook server with a serving certificate signed by the kuscia ca.
+func NewServer(conf *Config) (*Server, error) {
+	if conf.CACert == nil || conf.CAKey == nil {
+		return nil, errors.New("ca cert and key of the webhook server can not be empty")
+	}
+	port := conf.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "kuscia-admission-webhook"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(10, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP(listenAddress)},
+		DNSNames:     []string{"localhost"},
+	}
+	key, cert, err := tlsutils.GenerateX509KeyPairStruct(conf.CACert, conf.CAKey, tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("generate webhook server cert failed, %v", err)
+	}
+
+	mux := http.NewServeMux()
+	for _, w := range webhooks() {
+		mux.Handle(validatePathPrefix+w.resource, serveAdmission(w.validate))
+		if w.mutate != nil {
+			mux.Handle(mutatePathPrefix+w.resource, serveAdmission(w.mutate))
+		}
+	}
+
+	return &Server{
+		port:       port,
+		caCert:     conf.CACert,
+		kubeClient: conf.KubeClient,
+		tlsConfig: &tls.Config{
+			Certificates: tlsutils.BuildTLSCertificate(cert, key),
+			MinVersion:   tls.VersionTLS12,
+		},
+		handler: mux,
+	}, nil
+}
+
+// Run listens on the port, registers the webhook configurations and serves until the ctx is done.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", listenAddress, s.port))
+	if err != nil {
+		return fmt.Errorf("webhook server listen failed, %v", err)
+	}
+	server := &http.Server{
+		Handler:           s.handler,
+		TLSConfig:         s.tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.ServeTLS(listener, "", "")
+	}()
+
+	if err = s.register(ctx); err != nil {
+		_ = server.Close()
+		return err
+	}
+	s.ready.Store(true)
+	nlog.Infof("Admission webhook server is serving on %s", listener.Addr())
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return server.Shutdown(shutdownCtx)
+	case err = <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+// Ready returns whether the webhooks are serving and registered.
+func (s *Server) Ready() bool {
+	return s.ready.Load()
+}
@@ -0,0 +1,200 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+	"github.com/secretflow/kuscia/pkg/utils/resources"
+)
+
+// The validations follow the ones of the create requests of KusciaAPI, with the fields named by the crds.
+
+// validateKusciaJob follows validateCreateJobRequest of KusciaAPI.
+func validateKusciaJob(job *v1alpha1.KusciaJob) field.ErrorList {
+	var errs field.ErrorList
+	if err := resources.ValidateK8sName(job.Name, "name"); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), job.Name, err.Error()))
+	}
+	specPath := field.NewPath("spec")
+	if job.Spec.Initiator == "" {
+		errs = append(errs, field.Required(specPath.Child("initiator"), "initiator can not be empty"))
+	}
+	if len(job.Spec.Tasks) == 0 {
+		errs = append(errs, field.Required(specPath.Child("tasks"), "tasks can not be empty"))
+	}
+	for i, task := range job.Spec.Tasks {
+		taskPath := specPath.Child("tasks").Index(i)
+		if task.Alias == "" {
+			errs = append(errs, field.Required(taskPath.Child("alias"), "task alias can not be empty"))
+		}
+		if len(task.Parties) == 0 {
+			errs = append(errs, field.Required(taskPath.Child("parties"), "parties can not be empty"))
+		}
+		for j, party := range task.Parties {
+			if party.DomainID == "" {
+				errs = append(errs, field.Required(taskPath.Child("parties").Index(j).Child("domainID"), "party domain id can not be empty"))
+			}
+		}
+	}
+	return errs
+}
+
+// validateDomainDataGrant follows validateCreateDomainDataGrantRequest of KusciaAPI, the author is the domain id.
+func validateDomainDataGrant(grant *v1alpha1.DomainDataGrant) field.ErrorList {
+	var errs field.ErrorList
+	if err := resources.ValidateK8sName(grant.Name, "name"); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), grant.Name, err.Error()))
+	}
+	specPath := field.NewPath("spec")
+	if grant.Spec.GrantDomain == "" {
+		errs = append(errs, field.Required(specPath.Child("grantDomain"), "grantdomain can't be null"))
+	} else if grant.Spec.GrantDomain == grant.Spec.Author {
+		errs = append(errs, field.Invalid(specPath.Child("grantDomain"), grant.Spec.GrantDomain, "grantdomain can't be self"))
+	}
+	if grant.Spec.DomainDataID == "" {
+		errs = append(errs, field.Required(specPath.Child("domainDataID"), "domaindata can't be null"))
+	} else if err := resources.ValidateK8sName(grant.Spec.DomainDataID, "domainDataID"); err != nil {
+		errs = append(errs, field.Invalid(specPath.Child("domainDataID"), grant.Spec.DomainDataID, err.Error()))
+	}
+
+	// follows validateGrantLimit of KusciaAPI
+	if limit := grant.Spec.Limit; limit != nil {
+		limitPath := specPath.Child("limit")
+		modes := make([]string, 0, len(limit.GrantMode))
+		for _, mode := range limit.GrantMode {
+			modes = append(modes, string(mode))
+		}
+		if err := resources.ValidateGrantModes(modes); err != nil {
+			errs = append(errs, field.Invalid(limitPath.Child("grantMode"), modes, err.Error()))
+		}
+		if limit.MaxBytesRead < 0 {
+			errs = append(errs, field.Invalid(limitPath.Child("maxBytesRead"), limit.MaxBytesRead, "max bytes read can't be negative"))
+		}
+		if limit.MaxRowsRead < 0 {
+			errs = append(errs, field.Invalid(limitPath.Child("maxRowsRead"), limit.MaxRowsRead, "max rows read can't be negative"))
+		}
+		if limit.SamplePercent < 0 || limit.SamplePercent > 100 {
+			errs = append(errs, field.Invalid(limitPath.Child("samplePercent"), limit.SamplePercent, "sample percent must be in [0, 100]"))
+		}
+		for i, c := range limit.AllowedColumns {
+			if c == "" {
+				errs = append(errs, field.Required(limitPath.Child("allowedColumns").Index(i), "allowed column can't be empty"))
+			}
+		}
+		for i, c := range limit.AllowedComponents {
+			if c.Name == "" {
+				errs = append(errs, field.Required(limitPath.Child("allowedComponents").Index(i).Child("name"), "allowed component name can't be empty"))
+			}
+		}
+	}
+	return errs
+}
+
+// validateDomainRoute follows validateCreateDomainRouteRequest of KusciaAPI, except that the endpoint could be empty
+// because the routes from the lite domains to the master are created by the domain controller without endpoint.
+func validateDomainRoute(dr *v1alpha1.DomainRoute) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+	if dr.Spec.Source == "" {
+		errs = append(errs, field.Required(specPath.Child("source"), "source can not be empty"))
+	}
+	if dr.Spec.Destination == "" {
+		errs = append(errs, field.Required(specPath.Child("destination"), "destination can not be empty"))
+	}
+	if dr.Spec.AuthenticationType == "" {
+		errs = append(errs, field.Required(specPath.Child("authenticationType"), "authentication type can not be empty"))
+	}
+
+	if dr.Spec.Transit == nil {
+		portsPath := specPath.Child("endpoint", "ports")
+		for i, port := range dr.Spec.Endpoint.Ports {
+			if port.Port > 65535 || port.Port <= 0 {
+				errs = append(errs, field.Invalid(portsPath.Index(i).Child("port"), port.Port, "endpoint port should be positive and less than or equal to 65535"))
+			}
+		}
+	} else {
+		transitPath := specPath.Child("transit")
+		switch dr.Spec.Transit.TransitMethod {
+		case "":
+			errs = append(errs, field.Required(transitPath.Child("transitMethod"), "transit method is required when transit is not empty"))
+		case v1alpha1.TransitMethodThirdDomain:
+			if dr.Spec.Transit.Domain == nil || dr.Spec.Transit.Domain.DomainID == "" {
+				errs = append(errs, field.Required(transitPath.Child("domain", "domainID"), "domain is required when transit method is third domain"))
+			}
+		}
+	}
+	return errs
+}
+
+// validateAppImage follows validateCreateAppImageRequest of KusciaAPI.
+func validateAppImage(appImage *v1alpha1.AppImage) field.ErrorList {
+	var errs field.ErrorList
+	if err := resources.ValidateK8sName(appImage.Name, "name"); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), appImage.Name, err.Error()))
+	}
+	specPath := field.NewPath("spec")
+	if appImage.Spec.Image.Name == "" {
+		errs = append(errs, field.Required(specPath.Child("image", "name"), "base image name can not be empty"))
+	}
+	templatesPath := specPath.Child("deployTemplates")
+	if len(appImage.Spec.DeployTemplates) == 0 {
+		errs = append(errs, field.Required(templatesPath, "deploy templates can not be empty"))
+	}
+	for i, template := range appImage.Spec.DeployTemplates {
+		templatePath := templatesPath.Index(i)
+		if template.Name == "" {
+			errs = append(errs, field.Required(templatePath.Child("name"), "deploy template name can not be empty"))
+		}
+		if template.Replicas != nil && *template.Replicas < 0 {
+			errs = append(errs, field.Invalid(templatePath.Child("replicas"), *template.Replicas, "replicas can not be less than 0"))
+		}
+		if len(template.Spec.Containers) == 0 {
+			errs = append(errs, field.Required(templatePath.Child("spec", "containers"), "containers can not be empty"))
+		}
+		for j, container := range template.Spec.Containers {
+			if container.Name == "" {
+				errs = append(errs, field.Required(templatePath.Child("spec", "containers").Index(j).Child("name"), "container name can not be empty"))
+			}
+		}
+	}
+	return errs
+}
+
+// defaultKusciaJob defaults the max parallelism to 1 as KusciaAPI does.
+func defaultKusciaJob(job *v1alpha1.KusciaJob) []patchOperation {
+	if job.Spec.MaxParallelism != nil {
+		return nil
+	}
+	return []patchOperation{{Op: "add", Path: "/spec/maxParallelism", Value: 1}}
+}
+
+// defaultDomainDataGrant defaults the author to the domain of the grant as KusciaAPI does.
+func defaultDomainDataGrant(grant *v1alpha1.DomainDataGrant) []patchOperation {
+	if grant.Spec.Author != "" || grant.Namespace == "" {
+		return nil
+	}
+	grant.Spec.Author = grant.Namespace
+	return []patchOperation{{Op: "add", Path: "/spec/author", Value: grant.Namespace}}
+}
+
+// defaultDomainRoute defaults the transit method to THIRD-DOMAIN as documented by the crd.
+func defaultDomainRoute(dr *v1alpha1.DomainRoute) []patchOperation {
+	if dr.Spec.Transit == nil || dr.Spec.Transit.TransitMethod != "" {
+		return nil
+	}
+	return []patchOperation{{Op: "add", Path: "/spec/transit/transitMethod", Value: v1alpha1.TransitMethodThirdDomain}}
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/secretflow/kuscia/pkg/crd/apis/kuscia/v1alpha1"
+)
+
+func TestValidateKusciaJob(t *testing.T) {
+	t.Parallel()
+	job := &v1alpha1.KusciaJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "job-1"},
+		Spec: v1alpha1.KusciaJobSpec{
+			Initiator: "alice",
+			Tasks: []v1alpha1.KusciaTaskTemplate{{
+				Alias:   "task-1",
+				Parties: []v1alpha1.Party{{DomainID: "alice"}, {DomainID: "bob"}},
+			}},
+		},
+	}
+	assert.Empty(t, validateKusciaJob(job))
+
+	invalid := job.DeepCopy()
+	invalid.Name = "Job_1"
+	invalid.Spec.Initiator = ""
+	invalid.Spec.Tasks[0].Alias = ""
+	invalid.Spec.Tasks[0].Parties[1].DomainID = ""
+	errs := validateKusciaJob(invalid)
+	assert.Len(t, errs, 4)
+	assert.Equal(t, "spec.tasks[0].parties[1].domainID", errs[3].Field)
+
+	invalid.Spec.Tasks = nil
+	assert.Equal(t, "spec.tasks", validateKusciaJob(invalid)[2].Field)
+}
+
+func TestValidateDomainDataGrant(t *testing.T) {
+	t.Parallel()
+	grant := &v1alpha1.DomainDataGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant-1", Namespace: "alice"},
+		Spec: v1alpha1.DomainDataGrantSpec{
+			Author:       "alice",
+			GrantDomain:  "bob",
+			DomainDataID: "data-1",
+			Limit: &v1alpha1.GrantLimit{
+				GrantMode:         []v1alpha1.GrantType{v1alpha1.GrantNormal},
+				AllowedComponents: []v1alpha1.AllowedComponent{{Name: "psi"}},
+			},
+		},
+	}
+	assert.Empty(t, validateDomainDataGrant(grant))
+
+	self := grant.DeepCopy()
+	self.Spec.GrantDomain = "alice"
+	errs := validateDomainDataGrant(self)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "spec.grantDomain", errs[0].Field)
+
+	invalid := grant.DeepCopy()
+	invalid.Spec.DomainDataID = "Data_1"
+	invalid.Spec.Limit.MaxBytesRead = -1
+	invalid.Spec.Limit.AllowedComponents[0].Name = ""
+	invalid.Spec.Limit.SamplePercent = 200
+	errs = validateDomainDataGrant(invalid)
+	assert.Len(t, errs, 4)
+	assert.Equal(t, "spec.domainDataID", errs[0].Field)
+	assert.Equal(t, "spec.limit.maxBytesRead", errs[1].Field)
+	assert.Equal(t, "spec.limit.samplePercent", errs[2].Field)
+	assert.Equal(t, "spec.limit.allowedComponents[0].name", errs[3].Field)
+}
+
+func TestValidateDomainRoute(t *testing.T) {
+	t.Parallel()
+	dr := &v1alpha1.DomainRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice-bob", Namespace: "alice"},
+		Spec: v1alpha1.DomainRouteSpec{
+			Source:             "alice",
+			Destination:        "bob",
+			AuthenticationType: v1alpha1.DomainAuthenticationToken,
+			Endpoint: v1alpha1.DomainEndpoint{
+				Host:  "bob.example.com",
+				Ports: []v1alpha1.DomainPort{{Name: "http", Port: 1080}},
+			},
+		},
+	}
+	assert.Empty(t, validateDomainRoute(dr))
+
+	// the route to the master created by the domain controller has no endpoint
+	toMaster := dr.DeepCopy()
+	toMaster.Spec.Endpoint = v1alpha1.DomainEndpoint{}
+	assert.Empty(t, validateDomainRoute(toMaster))
+
+	invalidPort := dr.DeepCopy()
+	invalidPort.Spec.Endpoint.Ports[0].Port = 65536
+	errs := validateDomainRoute(invalidPort)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "spec.endpoint.ports[0].port", errs[0].Field)
+
+	transit := dr.DeepCopy()
+	transit.Spec.AuthenticationType = ""
+	transit.Spec.Transit = &v1alpha1.Transit{TransitMethod: v1alpha1.TransitMethodThirdDomain}
+	errs = validateDomainRoute(transit)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "spec.authenticationType", errs[0].Field)
+	assert.Equal(t, "spec.transit.domain.domainID", errs[1].Field)
+}
+
+func TestValidateAppImage(t *testing.T) {
+	t.Parallel()
+	appImage := &v1alpha1.AppImage{
+		ObjectMeta: metav1.ObjectMeta{Name: "secretflow-image"},
+		Spec: v1alpha1.AppImageSpec{
+			Image: v1alpha1.AppImageInfo{Name: "secretflow/secretflow-lite-anolis8", Tag: "latest"},
+			DeployTemplates: []v1alpha1.DeployTemplate{{
+				Name: "secretflow",
+				Spec: v1alpha1.PodSpec{Containers: []v1alpha1.Container{{Name: "secretflow"}}},
+			}},
+		},
+	}
+	assert.Empty(t, validateAppImage(appImage))
+
+	invalid := appImage.DeepCopy()
+	invalid.Spec.Image.Name = ""
+	replicas := int32(-1)
+	invalid.Spec.DeployTemplates[0].Replicas = &replicas
+	invalid.Spec.DeployTemplates[0].Spec.Containers[0].Name = ""
+	errs := validateAppImage(invalid)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, "spec.image.name", errs[0].Field)
+	assert.Equal(t, "spec.deployTemplates[0].replicas", errs[1].Field)
+	assert.Equal(t, "spec.deployTemplates[0].spec.containers[0].name", errs[2].Field)
+
+	invalid.Spec.DeployTemplates = nil
+	assert.Equal(t, "spec.deployTemplates", validateAppImage(invalid)[1].Field)
+}
+
+func TestDefaults(t *testing.T) {
+	t.Parallel()
+	job := &v1alpha1.KusciaJob{}
+	assert.Equal(t, []patchOperation{{Op: "add", Path: "/spec/maxParallelism", Value: 1}}, defaultKusciaJob(job))
+	maxParallelism := 2
+	job.Spec.MaxParallelism = &maxParallelism
+	assert.Empty(t, defaultKusciaJob(job))
+
+	grant := &v1alpha1.DomainDataGrant{ObjectMeta: metav1.ObjectMeta{Namespace: "alice"}}
+	assert.Equal(t, []patchOperation{{Op: "add", Path: "/spec/author", Value: "alice"}}, defaultDomainDataGrant(grant))
+	assert.Empty(t, defaultDomainDataGrant(grant))
+
+	dr := &v1alpha1.DomainRoute{}
+	assert.Empty(t, defaultDomainRoute(dr))
+	dr.Spec.Transit = &v1alpha1.Transit{}
+	assert.Equal(t, []patchOperation{{Op: "add", Path: "/spec/transit/transitMethod", Value: v1alpha1.TransitMethodThirdDomain}},
+		defaultDomainRoute(dr))
+}
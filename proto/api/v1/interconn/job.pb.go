@@ -178,6 +178,81 @@ func (x *CreateJobRequest) GetConfig() *Config {
 	return nil
 }
 
+// JobStatusCallbackRequest defines the request pushed to the callback url of the partners once the job status changes.
+type JobStatusCallbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// status of the job, e.g. RUNNING, SUCCESS.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// task_status maps the task ids to their status.
+	TaskStatus map[string]string `protobuf:"bytes,3,rep,name=task_status,json=taskStatus,proto3" json:"task_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// event_id identifies the status, the retries of the same status carry the same id.
+	EventId string `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+}
+
+func (x *JobStatusCallbackRequest) Reset() {
+	*x = JobStatusCallbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobStatusCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStatusCallbackRequest) ProtoMessage() {}
+
+func (x *JobStatusCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStatusCallbackRequest.ProtoReflect.Descriptor instead.
+func (*JobStatusCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JobStatusCallbackRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobStatusCallbackRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobStatusCallbackRequest) GetTaskStatus() map[string]string {
+	if x != nil {
+		return x.TaskStatus
+	}
+	return nil
+}
+
+func (x *JobStatusCallbackRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
 // StartJobRequest defines the request for starting job.
 type StartJobRequest struct {
 	state         protoimpl.MessageState
@@ -190,7 +265,7 @@ type StartJobRequest struct {
 func (x *StartJobRequest) Reset() {
 	*x = StartJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[2]
+		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -203,7 +278,7 @@ func (x *StartJobRequest) String() string {
 func (*StartJobRequest) ProtoMessage() {}
 
 func (x *StartJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[2]
+	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -216,7 +291,7 @@ func (x *StartJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartJobRequest.ProtoReflect.Descriptor instead.
 func (*StartJobRequest) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{2}
+	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StartJobRequest) GetJobId() string {
@@ -238,7 +313,7 @@ type StopJobAllRequest struct {
 func (x *StopJobAllRequest) Reset() {
 	*x = StopJobAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[3]
+		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -251,7 +326,7 @@ func (x *StopJobAllRequest) String() string {
 func (*StopJobAllRequest) ProtoMessage() {}
 
 func (x *StopJobAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[3]
+	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +339,7 @@ func (x *StopJobAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopJobAllRequest.ProtoReflect.Descriptor instead.
 func (*StopJobAllRequest) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{3}
+	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *StopJobAllRequest) GetJobId() string {
@@ -286,7 +361,7 @@ type StopJobRequest struct {
 func (x *StopJobRequest) Reset() {
 	*x = StopJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[4]
+		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -299,7 +374,7 @@ func (x *StopJobRequest) String() string {
 func (*StopJobRequest) ProtoMessage() {}
 
 func (x *StopJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[4]
+	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +387,7 @@ func (x *StopJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopJobRequest.ProtoReflect.Descriptor instead.
 func (*StopJobRequest) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{4}
+	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *StopJobRequest) GetJobId() string {
@@ -334,7 +409,7 @@ type QueryJobStatusAllRequest struct {
 func (x *QueryJobStatusAllRequest) Reset() {
 	*x = QueryJobStatusAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[5]
+		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -347,7 +422,7 @@ func (x *QueryJobStatusAllRequest) String() string {
 func (*QueryJobStatusAllRequest) ProtoMessage() {}
 
 func (x *QueryJobStatusAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[5]
+	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +435,7 @@ func (x *QueryJobStatusAllRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryJobStatusAllRequest.ProtoReflect.Descriptor instead.
 func (*QueryJobStatusAllRequest) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{5}
+	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *QueryJobStatusAllRequest) GetJobId() string {
@@ -383,7 +458,7 @@ type DAG struct {
 func (x *DAG) Reset() {
 	*x = DAG{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[6]
+		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -396,7 +471,7 @@ func (x *DAG) String() string {
 func (*DAG) ProtoMessage() {}
 
 func (x *DAG) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[6]
+	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +484,7 @@ func (x *DAG) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DAG.ProtoReflect.Descriptor instead.
 func (*DAG) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{6}
+	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DAG) GetVersion() string {
@@ -443,7 +518,7 @@ type Component struct {
 func (x *Component) Reset() {
 	*x = Component{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[7]
+		mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -456,7 +531,7 @@ func (x *Component) String() string {
 func (*Component) ProtoMessage() {}
 
 func (x *Component) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[7]
+	mi := &file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +544,7 @@ func (x *Component) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Component.ProtoReflect.Descriptor instead.
 func (*Component) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{7}
+	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Component) GetCode() string {
@@ -547,7 +622,24 @@ var file_kuscia_proto_api_v1_interconn_job_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b,
 	0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x76, 0x31, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x28, 0x0a, 0x0f, 0x53,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x8d, 0x02, 0x0a, 0x18,
+	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x68, 0x0a, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x6e, 0x2e, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x1a, 0x3d, 0x0a, 0x0f,
+	0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x28, 0x0a, 0x0f, 0x53,
 	0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
 	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
 	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x4a, 0x6f, 0x62,
@@ -599,32 +691,35 @@ func file_kuscia_proto_api_v1_interconn_job_proto_rawDescGZIP() []byte {
 	return file_kuscia_proto_api_v1_interconn_job_proto_rawDescData
 }
 
-var file_kuscia_proto_api_v1_interconn_job_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_kuscia_proto_api_v1_interconn_job_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_kuscia_proto_api_v1_interconn_job_proto_goTypes = []interface{}{
 	(*CreateJobAllRequest)(nil),      // 0: kuscia.proto.api.v1.interconn.CreateJobAllRequest
 	(*CreateJobRequest)(nil),         // 1: kuscia.proto.api.v1.interconn.CreateJobRequest
-	(*StartJobRequest)(nil),          // 2: kuscia.proto.api.v1.interconn.StartJobRequest
-	(*StopJobAllRequest)(nil),        // 3: kuscia.proto.api.v1.interconn.StopJobAllRequest
-	(*StopJobRequest)(nil),           // 4: kuscia.proto.api.v1.interconn.StopJobRequest
-	(*QueryJobStatusAllRequest)(nil), // 5: kuscia.proto.api.v1.interconn.QueryJobStatusAllRequest
-	(*DAG)(nil),                      // 6: kuscia.proto.api.v1.interconn.DAG
-	(*Component)(nil),                // 7: kuscia.proto.api.v1.interconn.Component
-	(*Config)(nil),                   // 8: kuscia.proto.api.v1.interconn.Config
-	(*ComponentIO)(nil),              // 9: kuscia.proto.api.v1.interconn.ComponentIO
+	(*JobStatusCallbackRequest)(nil), // 2: kuscia.proto.api.v1.interconn.JobStatusCallbackRequest
+	(*StartJobRequest)(nil),          // 3: kuscia.proto.api.v1.interconn.StartJobRequest
+	(*StopJobAllRequest)(nil),        // 4: kuscia.proto.api.v1.interconn.StopJobAllRequest
+	(*StopJobRequest)(nil),           // 5: kuscia.proto.api.v1.interconn.StopJobRequest
+	(*QueryJobStatusAllRequest)(nil), // 6: kuscia.proto.api.v1.interconn.QueryJobStatusAllRequest
+	(*DAG)(nil),                      // 7: kuscia.proto.api.v1.interconn.DAG
+	(*Component)(nil),                // 8: kuscia.proto.api.v1.interconn.Component
+	nil,                              // 9: kuscia.proto.api.v1.interconn.JobStatusCallbackRequest.TaskStatusEntry
+	(*Config)(nil),                   // 10: kuscia.proto.api.v1.interconn.Config
+	(*ComponentIO)(nil),              // 11: kuscia.proto.api.v1.interconn.ComponentIO
 }
 var file_kuscia_proto_api_v1_interconn_job_proto_depIdxs = []int32{
-	6, // 0: kuscia.proto.api.v1.interconn.CreateJobAllRequest.dag:type_name -> kuscia.proto.api.v1.interconn.DAG
-	8, // 1: kuscia.proto.api.v1.interconn.CreateJobAllRequest.config:type_name -> kuscia.proto.api.v1.interconn.Config
-	6, // 2: kuscia.proto.api.v1.interconn.CreateJobRequest.dag:type_name -> kuscia.proto.api.v1.interconn.DAG
-	8, // 3: kuscia.proto.api.v1.interconn.CreateJobRequest.config:type_name -> kuscia.proto.api.v1.interconn.Config
-	7, // 4: kuscia.proto.api.v1.interconn.DAG.components:type_name -> kuscia.proto.api.v1.interconn.Component
-	9, // 5: kuscia.proto.api.v1.interconn.Component.input:type_name -> kuscia.proto.api.v1.interconn.ComponentIO
-	9, // 6: kuscia.proto.api.v1.interconn.Component.output:type_name -> kuscia.proto.api.v1.interconn.ComponentIO
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7,  // 0: kuscia.proto.api.v1.interconn.CreateJobAllRequest.dag:type_name -> kuscia.proto.api.v1.interconn.DAG
+	10, // 1: kuscia.proto.api.v1.interconn.CreateJobAllRequest.config:type_name -> kuscia.proto.api.v1.interconn.Config
+	7,  // 2: kuscia.proto.api.v1.interconn.CreateJobRequest.dag:type_name -> kuscia.proto.api.v1.interconn.DAG
+	10, // 3: kuscia.proto.api.v1.interconn.CreateJobRequest.config:type_name -> kuscia.proto.api.v1.interconn.Config
+	9,  // 4: kuscia.proto.api.v1.interconn.JobStatusCallbackRequest.task_status:type_name -> kuscia.proto.api.v1.interconn.JobStatusCallbackRequest.TaskStatusEntry
+	8,  // 5: kuscia.proto.api.v1.interconn.DAG.components:type_name -> kuscia.proto.api.v1.interconn.Component
+	11, // 6: kuscia.proto.api.v1.interconn.Component.input:type_name -> kuscia.proto.api.v1.interconn.ComponentIO
+	11, // 7: kuscia.proto.api.v1.interconn.Component.output:type_name -> kuscia.proto.api.v1.interconn.ComponentIO
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_kuscia_proto_api_v1_interconn_job_proto_init() }
@@ -659,7 +754,7 @@ func file_kuscia_proto_api_v1_interconn_job_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartJobRequest); i {
+			switch v := v.(*JobStatusCallbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -671,7 +766,7 @@ func file_kuscia_proto_api_v1_interconn_job_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopJobAllRequest); i {
+			switch v := v.(*StartJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -683,7 +778,7 @@ func file_kuscia_proto_api_v1_interconn_job_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopJobRequest); i {
+			switch v := v.(*StopJobAllRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -695,7 +790,7 @@ func file_kuscia_proto_api_v1_interconn_job_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryJobStatusAllRequest); i {
+			switch v := v.(*StopJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -707,7 +802,7 @@ func file_kuscia_proto_api_v1_interconn_job_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DAG); i {
+			switch v := v.(*QueryJobStatusAllRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -719,6 +814,18 @@ func file_kuscia_proto_api_v1_interconn_job_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DAG); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuscia_proto_api_v1_interconn_job_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Component); i {
 			case 0:
 				return &v.state
@@ -737,7 +844,7 @@ func file_kuscia_proto_api_v1_interconn_job_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kuscia_proto_api_v1_interconn_job_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
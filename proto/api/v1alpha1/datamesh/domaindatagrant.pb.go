@@ -46,6 +46,18 @@ type GrantLimit struct {
 	Components     []string `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
 	Initiator      string   `protobuf:"bytes,5,opt,name=initiator,proto3" json:"initiator,omitempty"`
 	InputConfig    string   `protobuf:"bytes,6,opt,name=input_config,json=inputConfig,proto3" json:"input_config,omitempty"`
+	// grant modes, one or more of normal, metadata and file. Default: [normal].
+	GrantMode []string `protobuf:"bytes,7,rep,name=grant_mode,json=grantMode,proto3" json:"grant_mode,omitempty"`
+	// max bytes the grantee can read from the domain data, 0 means unlimited.
+	MaxBytesRead int64 `protobuf:"varint,8,opt,name=max_bytes_read,json=maxBytesRead,proto3" json:"max_bytes_read,omitempty"`
+	// components and their versions allowed to use the domain data.
+	AllowedComponents []*AllowedComponent `protobuf:"bytes,9,rep,name=allowed_components,json=allowedComponents,proto3" json:"allowed_components,omitempty"`
+	// max rows the grantee can read from the table domain data, 0 means unlimited.
+	MaxRowsRead int64 `protobuf:"varint,10,opt,name=max_rows_read,json=maxRowsRead,proto3" json:"max_rows_read,omitempty"`
+	// percent of rows randomly sampled for the grantee, in [0, 100], 0 means no sampling.
+	SamplePercent int32 `protobuf:"varint,11,opt,name=sample_percent,json=samplePercent,proto3" json:"sample_percent,omitempty"`
+	// columns of the table domain data visible to the grantee, empty means all columns.
+	AllowedColumns []string `protobuf:"bytes,12,rep,name=allowed_columns,json=allowedColumns,proto3" json:"allowed_columns,omitempty"`
 }
 
 func (x *GrantLimit) Reset() {
@@ -122,6 +134,104 @@ func (x *GrantLimit) GetInputConfig() string {
 	return ""
 }
 
+func (x *GrantLimit) GetGrantMode() []string {
+	if x != nil {
+		return x.GrantMode
+	}
+	return nil
+}
+
+func (x *GrantLimit) GetMaxBytesRead() int64 {
+	if x != nil {
+		return x.MaxBytesRead
+	}
+	return 0
+}
+
+func (x *GrantLimit) GetAllowedComponents() []*AllowedComponent {
+	if x != nil {
+		return x.AllowedComponents
+	}
+	return nil
+}
+
+func (x *GrantLimit) GetMaxRowsRead() int64 {
+	if x != nil {
+		return x.MaxRowsRead
+	}
+	return 0
+}
+
+func (x *GrantLimit) GetSamplePercent() int32 {
+	if x != nil {
+		return x.SamplePercent
+	}
+	return 0
+}
+
+func (x *GrantLimit) GetAllowedColumns() []string {
+	if x != nil {
+		return x.AllowedColumns
+	}
+	return nil
+}
+
+type AllowedComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// empty means all versions.
+	Versions []string `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *AllowedComponent) Reset() {
+	*x = AllowedComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowedComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedComponent) ProtoMessage() {}
+
+func (x *AllowedComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowedComponent.ProtoReflect.Descriptor instead.
+func (*AllowedComponent) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AllowedComponent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AllowedComponent) GetVersions() []string {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
 type DomainDataGrantData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -134,12 +244,14 @@ type DomainDataGrantData struct {
 	Limit             *GrantLimit       `protobuf:"bytes,5,opt,name=limit,proto3" json:"limit,omitempty"`
 	Description       map[string]string `protobuf:"bytes,6,rep,name=description,proto3" json:"description,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Signature         string            `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// the masking policies of the domaindata applied to the grant domain, it's ignored in the requests
+	MaskingPolicies []*v1alpha1.DataMaskingPolicy `protobuf:"bytes,8,rep,name=masking_policies,json=maskingPolicies,proto3" json:"masking_policies,omitempty"`
 }
 
 func (x *DomainDataGrantData) Reset() {
 	*x = DomainDataGrantData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[1]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -152,7 +264,7 @@ func (x *DomainDataGrantData) String() string {
 func (*DomainDataGrantData) ProtoMessage() {}
 
 func (x *DomainDataGrantData) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[1]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +277,7 @@ func (x *DomainDataGrantData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DomainDataGrantData.ProtoReflect.Descriptor instead.
 func (*DomainDataGrantData) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{1}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DomainDataGrantData) GetDomaindatagrantId() string {
@@ -217,6 +329,13 @@ func (x *DomainDataGrantData) GetSignature() string {
 	return ""
 }
 
+func (x *DomainDataGrantData) GetMaskingPolicies() []*v1alpha1.DataMaskingPolicy {
+	if x != nil {
+		return x.MaskingPolicies
+	}
+	return nil
+}
+
 type CreateDomainDataGrantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -233,7 +352,7 @@ type CreateDomainDataGrantRequest struct {
 func (x *CreateDomainDataGrantRequest) Reset() {
 	*x = CreateDomainDataGrantRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[2]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -246,7 +365,7 @@ func (x *CreateDomainDataGrantRequest) String() string {
 func (*CreateDomainDataGrantRequest) ProtoMessage() {}
 
 func (x *CreateDomainDataGrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[2]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,7 +378,7 @@ func (x *CreateDomainDataGrantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDomainDataGrantRequest.ProtoReflect.Descriptor instead.
 func (*CreateDomainDataGrantRequest) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{2}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateDomainDataGrantRequest) GetHeader() *v1alpha1.RequestHeader {
@@ -316,7 +435,7 @@ type CreateDomainDataGrantResponse struct {
 func (x *CreateDomainDataGrantResponse) Reset() {
 	*x = CreateDomainDataGrantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[3]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -329,7 +448,7 @@ func (x *CreateDomainDataGrantResponse) String() string {
 func (*CreateDomainDataGrantResponse) ProtoMessage() {}
 
 func (x *CreateDomainDataGrantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[3]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +461,7 @@ func (x *CreateDomainDataGrantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDomainDataGrantResponse.ProtoReflect.Descriptor instead.
 func (*CreateDomainDataGrantResponse) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{3}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateDomainDataGrantResponse) GetStatus() *v1alpha1.Status {
@@ -371,7 +490,7 @@ type CreateDomainDataGrantResponseData struct {
 func (x *CreateDomainDataGrantResponseData) Reset() {
 	*x = CreateDomainDataGrantResponseData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[4]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -384,7 +503,7 @@ func (x *CreateDomainDataGrantResponseData) String() string {
 func (*CreateDomainDataGrantResponseData) ProtoMessage() {}
 
 func (x *CreateDomainDataGrantResponseData) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[4]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +516,7 @@ func (x *CreateDomainDataGrantResponseData) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateDomainDataGrantResponseData.ProtoReflect.Descriptor instead.
 func (*CreateDomainDataGrantResponseData) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{4}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateDomainDataGrantResponseData) GetDomaindatagrantId() string {
@@ -423,7 +542,7 @@ type UpdateDomainDataGrantRequest struct {
 func (x *UpdateDomainDataGrantRequest) Reset() {
 	*x = UpdateDomainDataGrantRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[5]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -436,7 +555,7 @@ func (x *UpdateDomainDataGrantRequest) String() string {
 func (*UpdateDomainDataGrantRequest) ProtoMessage() {}
 
 func (x *UpdateDomainDataGrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[5]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +568,7 @@ func (x *UpdateDomainDataGrantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDomainDataGrantRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDomainDataGrantRequest) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{5}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateDomainDataGrantRequest) GetHeader() *v1alpha1.RequestHeader {
@@ -505,7 +624,7 @@ type UpdateDomainDataGrantResponse struct {
 func (x *UpdateDomainDataGrantResponse) Reset() {
 	*x = UpdateDomainDataGrantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[6]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -518,7 +637,7 @@ func (x *UpdateDomainDataGrantResponse) String() string {
 func (*UpdateDomainDataGrantResponse) ProtoMessage() {}
 
 func (x *UpdateDomainDataGrantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[6]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +650,7 @@ func (x *UpdateDomainDataGrantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDomainDataGrantResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDomainDataGrantResponse) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{6}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateDomainDataGrantResponse) GetStatus() *v1alpha1.Status {
@@ -553,7 +672,7 @@ type DeleteDomainDataGrantRequest struct {
 func (x *DeleteDomainDataGrantRequest) Reset() {
 	*x = DeleteDomainDataGrantRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[7]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +685,7 @@ func (x *DeleteDomainDataGrantRequest) String() string {
 func (*DeleteDomainDataGrantRequest) ProtoMessage() {}
 
 func (x *DeleteDomainDataGrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[7]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +698,7 @@ func (x *DeleteDomainDataGrantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDomainDataGrantRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDomainDataGrantRequest) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{7}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteDomainDataGrantRequest) GetHeader() *v1alpha1.RequestHeader {
@@ -607,7 +726,7 @@ type DeleteDomainDataGrantResponse struct {
 func (x *DeleteDomainDataGrantResponse) Reset() {
 	*x = DeleteDomainDataGrantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[8]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -620,7 +739,7 @@ func (x *DeleteDomainDataGrantResponse) String() string {
 func (*DeleteDomainDataGrantResponse) ProtoMessage() {}
 
 func (x *DeleteDomainDataGrantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[8]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +752,7 @@ func (x *DeleteDomainDataGrantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDomainDataGrantResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDomainDataGrantResponse) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{8}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteDomainDataGrantResponse) GetStatus() *v1alpha1.Status {
@@ -655,7 +774,7 @@ type QueryDomainDataGrantRequest struct {
 func (x *QueryDomainDataGrantRequest) Reset() {
 	*x = QueryDomainDataGrantRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[9]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -668,7 +787,7 @@ func (x *QueryDomainDataGrantRequest) String() string {
 func (*QueryDomainDataGrantRequest) ProtoMessage() {}
 
 func (x *QueryDomainDataGrantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[9]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +800,7 @@ func (x *QueryDomainDataGrantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDomainDataGrantRequest.ProtoReflect.Descriptor instead.
 func (*QueryDomainDataGrantRequest) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{9}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryDomainDataGrantRequest) GetHeader() *v1alpha1.RequestHeader {
@@ -710,7 +829,7 @@ type QueryDomainDataGrantResponse struct {
 func (x *QueryDomainDataGrantResponse) Reset() {
 	*x = QueryDomainDataGrantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[10]
+		mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -723,7 +842,7 @@ func (x *QueryDomainDataGrantResponse) String() string {
 func (*QueryDomainDataGrantResponse) ProtoMessage() {}
 
 func (x *QueryDomainDataGrantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[10]
+	mi := &file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +855,7 @@ func (x *QueryDomainDataGrantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDomainDataGrantResponse.ProtoReflect.Descriptor instead.
 func (*QueryDomainDataGrantResponse) Descriptor() ([]byte, []int) {
-	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{10}
+	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryDomainDataGrantResponse) GetStatus() *v1alpha1.Status {
@@ -764,7 +883,7 @@ var file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDesc = []by
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x1a, 0x26,
 	0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69,
 	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x01, 0x0a, 0x0a, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x03, 0x0a, 0x0a, 0x47, 0x72, 0x61, 0x6e, 0x74,
 	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
 	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b,
@@ -777,197 +896,225 @@ var file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDesc = []by
 	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
 	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xb4, 0x03, 0x0a, 0x13, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a,
-	0x12, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61,
-	0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x44, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75,
-	0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x6a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x3e, 0x0a, 0x10,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd2, 0x03, 0x0a,
-	0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
-	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x63, 0x0a, 0x12, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8d, 0x04, 0x0a, 0x13, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
 	0x2d, 0x0a, 0x12, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74,
-	0x61, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x44, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x73, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x51, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xb5, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6b,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x44,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x6a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x6b, 0x75, 0x73, 0x63,
+	0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x57,
+	0x0a, 0x10, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd2, 0x03, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61,
+	0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x12, 0x44, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x73, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x51, 0x2e, 0x6b,
 	0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73,
 	0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x21, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2d,
-	0x0a, 0x12, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xd2, 0x03,
-	0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
-	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x2d, 0x0a, 0x12, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61,
-	0x74, 0x61, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e,
-	0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x44, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x73, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x51, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x5a, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8f,
-	0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x40, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67,
-	0x72, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x5a, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8e, 0x01, 0x0a,
-	0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d,
-	0x0a, 0x12, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xa6, 0x01,
-	0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb5, 0x01, 0x0a,
+	0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
 	0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4b, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x91, 0x05, 0x0a, 0x16, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x9c, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x2e, 0x6b, 0x75,
-	0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e,
+	0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74,
+	0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xd2, 0x03, 0x0a, 0x1c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x73, 0x63,
+	0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64,
+	0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x12, 0x44, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x73, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x51, 0x2e,
 	0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x99, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x3f, 0x2e, 0x6b, 0x75, 0x73, 0x63,
-	0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x6b, 0x75, 0x73,
+	0x73, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a,
+	0x10, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a,
+	0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x1c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x73,
 	0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47,
-	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9c, 0x01, 0x0a,
-	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x1d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74,
+	0x61, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x75, 0x73, 0x63,
+	0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x4b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x32, 0x91, 0x05, 0x0a, 0x16, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x9c, 0x01, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
 	0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e,
 	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69,
 	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x15,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x14,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x12, 0x3f, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x12, 0x40, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
 	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x5c, 0x0a, 0x20, 0x6f, 0x72,
-	0x67, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x5a, 0x38,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
-	0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x40, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x41, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x5c, 0x0a, 0x20, 0x6f, 0x72, 0x67, 0x2e, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x6d, 0x65, 0x73, 0x68, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77,
+	0x2f, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x6d,
+	0x65, 0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -982,55 +1129,59 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescGZIP()
 	return file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDescData
 }
 
-var file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_goTypes = []interface{}{
 	(*GrantLimit)(nil),                        // 0: kuscia.proto.api.v1alpha1.datamesh.GrantLimit
-	(*DomainDataGrantData)(nil),               // 1: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData
-	(*CreateDomainDataGrantRequest)(nil),      // 2: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest
-	(*CreateDomainDataGrantResponse)(nil),     // 3: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponse
-	(*CreateDomainDataGrantResponseData)(nil), // 4: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponseData
-	(*UpdateDomainDataGrantRequest)(nil),      // 5: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest
-	(*UpdateDomainDataGrantResponse)(nil),     // 6: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantResponse
-	(*DeleteDomainDataGrantRequest)(nil),      // 7: kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantRequest
-	(*DeleteDomainDataGrantResponse)(nil),     // 8: kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantResponse
-	(*QueryDomainDataGrantRequest)(nil),       // 9: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantRequest
-	(*QueryDomainDataGrantResponse)(nil),      // 10: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantResponse
-	nil,                                       // 11: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData.DescriptionEntry
-	nil,                                       // 12: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.DescriptionEntry
-	nil,                                       // 13: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.DescriptionEntry
-	(*v1alpha1.RequestHeader)(nil),            // 14: kuscia.proto.api.v1alpha1.RequestHeader
-	(*v1alpha1.Status)(nil),                   // 15: kuscia.proto.api.v1alpha1.Status
+	(*AllowedComponent)(nil),                  // 1: kuscia.proto.api.v1alpha1.datamesh.AllowedComponent
+	(*DomainDataGrantData)(nil),               // 2: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData
+	(*CreateDomainDataGrantRequest)(nil),      // 3: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest
+	(*CreateDomainDataGrantResponse)(nil),     // 4: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponse
+	(*CreateDomainDataGrantResponseData)(nil), // 5: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponseData
+	(*UpdateDomainDataGrantRequest)(nil),      // 6: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest
+	(*UpdateDomainDataGrantResponse)(nil),     // 7: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantResponse
+	(*DeleteDomainDataGrantRequest)(nil),      // 8: kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantRequest
+	(*DeleteDomainDataGrantResponse)(nil),     // 9: kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantResponse
+	(*QueryDomainDataGrantRequest)(nil),       // 10: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantRequest
+	(*QueryDomainDataGrantResponse)(nil),      // 11: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantResponse
+	nil,                                       // 12: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData.DescriptionEntry
+	nil,                                       // 13: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.DescriptionEntry
+	nil,                                       // 14: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.DescriptionEntry
+	(*v1alpha1.DataMaskingPolicy)(nil),        // 15: kuscia.proto.api.v1alpha1.DataMaskingPolicy
+	(*v1alpha1.RequestHeader)(nil),            // 16: kuscia.proto.api.v1alpha1.RequestHeader
+	(*v1alpha1.Status)(nil),                   // 17: kuscia.proto.api.v1alpha1.Status
 }
 var file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_depIdxs = []int32{
-	0,  // 0: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData.limit:type_name -> kuscia.proto.api.v1alpha1.datamesh.GrantLimit
-	11, // 1: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData.description:type_name -> kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData.DescriptionEntry
-	14, // 2: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
-	0,  // 3: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.limit:type_name -> kuscia.proto.api.v1alpha1.datamesh.GrantLimit
-	12, // 4: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.description:type_name -> kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.DescriptionEntry
-	15, // 5: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
-	4,  // 6: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponse.data:type_name -> kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponseData
-	14, // 7: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
-	0,  // 8: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.limit:type_name -> kuscia.proto.api.v1alpha1.datamesh.GrantLimit
-	13, // 9: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.description:type_name -> kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.DescriptionEntry
-	15, // 10: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
-	14, // 11: kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
-	15, // 12: kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
-	14, // 13: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
-	15, // 14: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
-	1,  // 15: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantResponse.data:type_name -> kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData
-	2,  // 16: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.CreateDomainDataGrant:input_type -> kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest
-	9,  // 17: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.QueryDomainDataGrant:input_type -> kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantRequest
-	5,  // 18: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.UpdateDomainDataGrant:input_type -> kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest
-	7,  // 19: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.DeleteDomainDataGrant:input_type -> kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantRequest
-	3,  // 20: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.CreateDomainDataGrant:output_type -> kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponse
-	10, // 21: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.QueryDomainDataGrant:output_type -> kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantResponse
-	6,  // 22: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.UpdateDomainDataGrant:output_type -> kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantResponse
-	8,  // 23: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.DeleteDomainDataGrant:output_type -> kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantResponse
-	20, // [20:24] is the sub-list for method output_type
-	16, // [16:20] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	1,  // 0: kuscia.proto.api.v1alpha1.datamesh.GrantLimit.allowed_components:type_name -> kuscia.proto.api.v1alpha1.datamesh.AllowedComponent
+	0,  // 1: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData.limit:type_name -> kuscia.proto.api.v1alpha1.datamesh.GrantLimit
+	12, // 2: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData.description:type_name -> kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData.DescriptionEntry
+	15, // 3: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData.masking_policies:type_name -> kuscia.proto.api.v1alpha1.DataMaskingPolicy
+	16, // 4: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	0,  // 5: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.limit:type_name -> kuscia.proto.api.v1alpha1.datamesh.GrantLimit
+	13, // 6: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.description:type_name -> kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest.DescriptionEntry
+	17, // 7: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	5,  // 8: kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponse.data:type_name -> kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponseData
+	16, // 9: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	0,  // 10: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.limit:type_name -> kuscia.proto.api.v1alpha1.datamesh.GrantLimit
+	14, // 11: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.description:type_name -> kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest.DescriptionEntry
+	17, // 12: kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	16, // 13: kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	17, // 14: kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	16, // 15: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	17, // 16: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	2,  // 17: kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantResponse.data:type_name -> kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantData
+	3,  // 18: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.CreateDomainDataGrant:input_type -> kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantRequest
+	10, // 19: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.QueryDomainDataGrant:input_type -> kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantRequest
+	6,  // 20: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.UpdateDomainDataGrant:input_type -> kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantRequest
+	8,  // 21: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.DeleteDomainDataGrant:input_type -> kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantRequest
+	4,  // 22: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.CreateDomainDataGrant:output_type -> kuscia.proto.api.v1alpha1.datamesh.CreateDomainDataGrantResponse
+	11, // 23: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.QueryDomainDataGrant:output_type -> kuscia.proto.api.v1alpha1.datamesh.QueryDomainDataGrantResponse
+	7,  // 24: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.UpdateDomainDataGrant:output_type -> kuscia.proto.api.v1alpha1.datamesh.UpdateDomainDataGrantResponse
+	9,  // 25: kuscia.proto.api.v1alpha1.datamesh.DomainDataGrantService.DeleteDomainDataGrant:output_type -> kuscia.proto.api.v1alpha1.datamesh.DeleteDomainDataGrantResponse
+	22, // [22:26] is the sub-list for method output_type
+	18, // [18:22] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() }
@@ -1052,7 +1203,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DomainDataGrantData); i {
+			switch v := v.(*AllowedComponent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1064,7 +1215,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDomainDataGrantRequest); i {
+			switch v := v.(*DomainDataGrantData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1076,7 +1227,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDomainDataGrantResponse); i {
+			switch v := v.(*CreateDomainDataGrantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1088,7 +1239,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDomainDataGrantResponseData); i {
+			switch v := v.(*CreateDomainDataGrantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1100,7 +1251,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDomainDataGrantRequest); i {
+			switch v := v.(*CreateDomainDataGrantResponseData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1112,7 +1263,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDomainDataGrantResponse); i {
+			switch v := v.(*UpdateDomainDataGrantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1124,7 +1275,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDomainDataGrantRequest); i {
+			switch v := v.(*UpdateDomainDataGrantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1136,7 +1287,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDomainDataGrantResponse); i {
+			switch v := v.(*DeleteDomainDataGrantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1148,7 +1299,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDomainDataGrantRequest); i {
+			switch v := v.(*DeleteDomainDataGrantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1160,6 +1311,18 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			}
 		}
 		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDomainDataGrantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryDomainDataGrantResponse); i {
 			case 0:
 				return &v.state
@@ -1178,7 +1341,7 @@ func file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kuscia_proto_api_v1alpha1_datamesh_domaindatagrant_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -45,6 +45,7 @@ const (
 	ErrorCode_KusciaAPIErrRequestMasterFailed              ErrorCode = 11103
 	ErrorCode_KusciaAPIErrLiteAPINotSupport                ErrorCode = 11104
 	ErrorCode_KusciaAPIErrMasterAPINotSupport              ErrorCode = 11105
+	ErrorCode_KusciaAPIErrQuotaExceeded                    ErrorCode = 11106
 	ErrorCode_KusciaAPIErrCreateJob                        ErrorCode = 11201
 	ErrorCode_KusciaAPIErrQueryJob                         ErrorCode = 11202
 	ErrorCode_KusciaAPIErrQueryJobStatus                   ErrorCode = 11203
@@ -56,6 +57,10 @@ const (
 	ErrorCode_KusciaAPIErrCancelJob                        ErrorCode = 11209
 	ErrorCode_KusciaAPIErrSuspendNotRunningJob             ErrorCode = 11210
 	ErrorCode_KusciaAPIErrRestartNotSuspendedOrFailedJob   ErrorCode = 11211
+	ErrorCode_KusciaAPIErrListJobEvents                    ErrorCode = 11212
+	ErrorCode_KusciaAPIErrListJobUsage                     ErrorCode = 11213
+	ErrorCode_KusciaAPIErrQueryArchivedJob                 ErrorCode = 11214
+	ErrorCode_KusciaAPIErrReportArchivedJob                ErrorCode = 11215
 	ErrorCode_KusciaAPIErrCreateDomain                     ErrorCode = 11300
 	ErrorCode_KusciaAPIErrQueryDomain                      ErrorCode = 11301
 	ErrorCode_KusciaAPIErrQueryDomainStatus                ErrorCode = 11302
@@ -63,6 +68,7 @@ const (
 	ErrorCode_KusciaAPIErrDeleteDomain                     ErrorCode = 11304
 	ErrorCode_KusciaAPIErrDomainNotExists                  ErrorCode = 11305
 	ErrorCode_KusciaAPIErrDomainExists                     ErrorCode = 11306
+	ErrorCode_KusciaAPIErrRegisterDomain                   ErrorCode = 11307
 	ErrorCode_KusciaAPIErrCreateDomainRoute                ErrorCode = 11400
 	ErrorCode_KusciaAPIErrQueryDomainRoute                 ErrorCode = 11401
 	ErrorCode_KusciaAPIErrQueryDomainRouteStatus           ErrorCode = 11402
@@ -77,6 +83,9 @@ const (
 	ErrorCode_KusciaAPIErrPatchDomainDataFailed            ErrorCode = 11505
 	ErrorCode_KusciaAPIErrDomainDataNotExists              ErrorCode = 11506
 	ErrorCode_KusciaAPIErrDomainDataExists                 ErrorCode = 11507
+	ErrorCode_KusciaAPIErrDownloadDomainData               ErrorCode = 11508
+	ErrorCode_KusciaAPIErrDomainDataNotGranted             ErrorCode = 11509
+	ErrorCode_KusciaAPIErrUploadDomainData                 ErrorCode = 11510
 	ErrorCode_KusciaAPIErrCreateServing                    ErrorCode = 11600
 	ErrorCode_KusciaAPIErrQueryServing                     ErrorCode = 11601
 	ErrorCode_KusciaAPIErrQueryServingStatus               ErrorCode = 11602
@@ -88,6 +97,7 @@ const (
 	ErrorCode_KusciaAPIErrDeleteDomainDataGrant            ErrorCode = 11703
 	ErrorCode_KusciaAPIErrDomainDataGrantExists            ErrorCode = 11704
 	ErrorCode_KusciaAPIErrDomainDataGrantNotExists         ErrorCode = 11705
+	ErrorCode_KusciaAPIErrGrantPolicyRejected              ErrorCode = 11706
 	ErrorCode_KusciaAPIErrCreateDomainDataSource           ErrorCode = 11800
 	ErrorCode_KusciaAPIErrUpdateDomainDataSource           ErrorCode = 11801
 	ErrorCode_KusciaAPIErrQueryDomainDataSource            ErrorCode = 11802
@@ -97,6 +107,7 @@ const (
 	ErrorCode_KusciaAPIErrDomainDataSourceNotExists        ErrorCode = 11806
 	ErrorCode_KusciaAPIErrDomainDataSourceInfoEncodeFailed ErrorCode = 11807
 	ErrorCode_KusciaAPIErrListDomainDataSource             ErrorCode = 11808
+	ErrorCode_KusciaAPIErrDomainDataSourceRevealDenied     ErrorCode = 11809
 	ErrorCode_KusciaAPIErrCreateConfig                     ErrorCode = 11900
 	ErrorCode_KusciaAPIErrQueryConfig                      ErrorCode = 11901
 	ErrorCode_KusciaAPIErrUpdateConfig                     ErrorCode = 11902
@@ -109,8 +120,21 @@ const (
 	ErrorCode_KusciaAPIErrBatchQueryAppImage               ErrorCode = 13104
 	ErrorCode_KusciaAPIErrAppImageNotExists                ErrorCode = 13105
 	ErrorCode_KusciaAPIErrAppImageExists                   ErrorCode = 13106
+	ErrorCode_KusciaAPIErrPrewarmImage                     ErrorCode = 13107
+	ErrorCode_KusciaAPIErrQueryPrewarmImage                ErrorCode = 13108
 	ErrorCode_KusciaAPIErrQueryLog                         ErrorCode = 13200
 	ErrorCode_KusciaAPIErrQueryPodNode                     ErrorCode = 13201
+	ErrorCode_KusciaAPIErrPushArchivedLog                  ErrorCode = 13202
+	ErrorCode_KusciaAPIErrListArchivedLog                  ErrorCode = 13203
+	ErrorCode_KusciaAPIErrDownloadArchivedLog              ErrorCode = 13204
+	ErrorCode_KusciaAPIErrCordonNode                       ErrorCode = 13300
+	ErrorCode_KusciaAPIErrUncordonNode                     ErrorCode = 13301
+	ErrorCode_KusciaAPIErrDrainNode                        ErrorCode = 13302
+	ErrorCode_KusciaAPIErrExecContainer                    ErrorCode = 13400
+	ErrorCode_KusciaAPIErrDebugScopeDenied                 ErrorCode = 13401
+	ErrorCode_KusciaAPIErrCreateBackup                     ErrorCode = 13500
+	ErrorCode_KusciaAPIErrRestoreBackup                    ErrorCode = 13501
+	ErrorCode_KusciaAPIErrSearch                           ErrorCode = 13600
 	// data mesh
 	ErrorCode_DataMeshErrRequestInvalidate                 ErrorCode = 12100
 	ErrorCode_DataMeshErrForUnexpected                     ErrorCode = 12101
@@ -161,6 +185,7 @@ var (
 		11103: "KusciaAPIErrRequestMasterFailed",
 		11104: "KusciaAPIErrLiteAPINotSupport",
 		11105: "KusciaAPIErrMasterAPINotSupport",
+		11106: "KusciaAPIErrQuotaExceeded",
 		11201: "KusciaAPIErrCreateJob",
 		11202: "KusciaAPIErrQueryJob",
 		11203: "KusciaAPIErrQueryJobStatus",
@@ -172,6 +197,10 @@ var (
 		11209: "KusciaAPIErrCancelJob",
 		11210: "KusciaAPIErrSuspendNotRunningJob",
 		11211: "KusciaAPIErrRestartNotSuspendedOrFailedJob",
+		11212: "KusciaAPIErrListJobEvents",
+		11213: "KusciaAPIErrListJobUsage",
+		11214: "KusciaAPIErrQueryArchivedJob",
+		11215: "KusciaAPIErrReportArchivedJob",
 		11300: "KusciaAPIErrCreateDomain",
 		11301: "KusciaAPIErrQueryDomain",
 		11302: "KusciaAPIErrQueryDomainStatus",
@@ -179,6 +208,7 @@ var (
 		11304: "KusciaAPIErrDeleteDomain",
 		11305: "KusciaAPIErrDomainNotExists",
 		11306: "KusciaAPIErrDomainExists",
+		11307: "KusciaAPIErrRegisterDomain",
 		11400: "KusciaAPIErrCreateDomainRoute",
 		11401: "KusciaAPIErrQueryDomainRoute",
 		11402: "KusciaAPIErrQueryDomainRouteStatus",
@@ -193,6 +223,9 @@ var (
 		11505: "KusciaAPIErrPatchDomainDataFailed",
 		11506: "KusciaAPIErrDomainDataNotExists",
 		11507: "KusciaAPIErrDomainDataExists",
+		11508: "KusciaAPIErrDownloadDomainData",
+		11509: "KusciaAPIErrDomainDataNotGranted",
+		11510: "KusciaAPIErrUploadDomainData",
 		11600: "KusciaAPIErrCreateServing",
 		11601: "KusciaAPIErrQueryServing",
 		11602: "KusciaAPIErrQueryServingStatus",
@@ -204,6 +237,7 @@ var (
 		11703: "KusciaAPIErrDeleteDomainDataGrant",
 		11704: "KusciaAPIErrDomainDataGrantExists",
 		11705: "KusciaAPIErrDomainDataGrantNotExists",
+		11706: "KusciaAPIErrGrantPolicyRejected",
 		11800: "KusciaAPIErrCreateDomainDataSource",
 		11801: "KusciaAPIErrUpdateDomainDataSource",
 		11802: "KusciaAPIErrQueryDomainDataSource",
@@ -213,6 +247,7 @@ var (
 		11806: "KusciaAPIErrDomainDataSourceNotExists",
 		11807: "KusciaAPIErrDomainDataSourceInfoEncodeFailed",
 		11808: "KusciaAPIErrListDomainDataSource",
+		11809: "KusciaAPIErrDomainDataSourceRevealDenied",
 		11900: "KusciaAPIErrCreateConfig",
 		11901: "KusciaAPIErrQueryConfig",
 		11902: "KusciaAPIErrUpdateConfig",
@@ -225,8 +260,21 @@ var (
 		13104: "KusciaAPIErrBatchQueryAppImage",
 		13105: "KusciaAPIErrAppImageNotExists",
 		13106: "KusciaAPIErrAppImageExists",
+		13107: "KusciaAPIErrPrewarmImage",
+		13108: "KusciaAPIErrQueryPrewarmImage",
 		13200: "KusciaAPIErrQueryLog",
 		13201: "KusciaAPIErrQueryPodNode",
+		13202: "KusciaAPIErrPushArchivedLog",
+		13203: "KusciaAPIErrListArchivedLog",
+		13204: "KusciaAPIErrDownloadArchivedLog",
+		13300: "KusciaAPIErrCordonNode",
+		13301: "KusciaAPIErrUncordonNode",
+		13302: "KusciaAPIErrDrainNode",
+		13400: "KusciaAPIErrExecContainer",
+		13401: "KusciaAPIErrDebugScopeDenied",
+		13500: "KusciaAPIErrCreateBackup",
+		13501: "KusciaAPIErrRestoreBackup",
+		13600: "KusciaAPIErrSearch",
 		12100: "DataMeshErrRequestInvalidate",
 		12101: "DataMeshErrForUnexpected",
 		12200: "DataMeshErrCreateDomainData",
@@ -271,6 +319,7 @@ var (
 		"KusciaAPIErrRequestMasterFailed":              11103,
 		"KusciaAPIErrLiteAPINotSupport":                11104,
 		"KusciaAPIErrMasterAPINotSupport":              11105,
+		"KusciaAPIErrQuotaExceeded":                    11106,
 		"KusciaAPIErrCreateJob":                        11201,
 		"KusciaAPIErrQueryJob":                         11202,
 		"KusciaAPIErrQueryJobStatus":                   11203,
@@ -282,6 +331,10 @@ var (
 		"KusciaAPIErrCancelJob":                        11209,
 		"KusciaAPIErrSuspendNotRunningJob":             11210,
 		"KusciaAPIErrRestartNotSuspendedOrFailedJob":   11211,
+		"KusciaAPIErrListJobEvents":                    11212,
+		"KusciaAPIErrListJobUsage":                     11213,
+		"KusciaAPIErrQueryArchivedJob":                 11214,
+		"KusciaAPIErrReportArchivedJob":                11215,
 		"KusciaAPIErrCreateDomain":                     11300,
 		"KusciaAPIErrQueryDomain":                      11301,
 		"KusciaAPIErrQueryDomainStatus":                11302,
@@ -289,6 +342,7 @@ var (
 		"KusciaAPIErrDeleteDomain":                     11304,
 		"KusciaAPIErrDomainNotExists":                  11305,
 		"KusciaAPIErrDomainExists":                     11306,
+		"KusciaAPIErrRegisterDomain":                   11307,
 		"KusciaAPIErrCreateDomainRoute":                11400,
 		"KusciaAPIErrQueryDomainRoute":                 11401,
 		"KusciaAPIErrQueryDomainRouteStatus":           11402,
@@ -303,6 +357,9 @@ var (
 		"KusciaAPIErrPatchDomainDataFailed":            11505,
 		"KusciaAPIErrDomainDataNotExists":              11506,
 		"KusciaAPIErrDomainDataExists":                 11507,
+		"KusciaAPIErrDownloadDomainData":               11508,
+		"KusciaAPIErrDomainDataNotGranted":             11509,
+		"KusciaAPIErrUploadDomainData":                 11510,
 		"KusciaAPIErrCreateServing":                    11600,
 		"KusciaAPIErrQueryServing":                     11601,
 		"KusciaAPIErrQueryServingStatus":               11602,
@@ -314,6 +371,7 @@ var (
 		"KusciaAPIErrDeleteDomainDataGrant":            11703,
 		"KusciaAPIErrDomainDataGrantExists":            11704,
 		"KusciaAPIErrDomainDataGrantNotExists":         11705,
+		"KusciaAPIErrGrantPolicyRejected":              11706,
 		"KusciaAPIErrCreateDomainDataSource":           11800,
 		"KusciaAPIErrUpdateDomainDataSource":           11801,
 		"KusciaAPIErrQueryDomainDataSource":            11802,
@@ -323,6 +381,7 @@ var (
 		"KusciaAPIErrDomainDataSourceNotExists":        11806,
 		"KusciaAPIErrDomainDataSourceInfoEncodeFailed": 11807,
 		"KusciaAPIErrListDomainDataSource":             11808,
+		"KusciaAPIErrDomainDataSourceRevealDenied":     11809,
 		"KusciaAPIErrCreateConfig":                     11900,
 		"KusciaAPIErrQueryConfig":                      11901,
 		"KusciaAPIErrUpdateConfig":                     11902,
@@ -335,8 +394,21 @@ var (
 		"KusciaAPIErrBatchQueryAppImage":               13104,
 		"KusciaAPIErrAppImageNotExists":                13105,
 		"KusciaAPIErrAppImageExists":                   13106,
+		"KusciaAPIErrPrewarmImage":                     13107,
+		"KusciaAPIErrQueryPrewarmImage":                13108,
 		"KusciaAPIErrQueryLog":                         13200,
 		"KusciaAPIErrQueryPodNode":                     13201,
+		"KusciaAPIErrPushArchivedLog":                  13202,
+		"KusciaAPIErrListArchivedLog":                  13203,
+		"KusciaAPIErrDownloadArchivedLog":              13204,
+		"KusciaAPIErrCordonNode":                       13300,
+		"KusciaAPIErrUncordonNode":                     13301,
+		"KusciaAPIErrDrainNode":                        13302,
+		"KusciaAPIErrExecContainer":                    13400,
+		"KusciaAPIErrDebugScopeDenied":                 13401,
+		"KusciaAPIErrCreateBackup":                     13500,
+		"KusciaAPIErrRestoreBackup":                    13501,
+		"KusciaAPIErrSearch":                           13600,
 		"DataMeshErrRequestInvalidate":                 12100,
 		"DataMeshErrForUnexpected":                     12101,
 		"DataMeshErrCreateDomainData":                  12200,
@@ -410,7 +482,7 @@ var file_kuscia_proto_api_v1alpha1_errorcode_error_code_proto_rawDesc = []byte{
 	0x72, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2a, 0xeb, 0x1e, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x31, 0x2a, 0x96, 0x25, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
 	0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1b,
 	0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x10, 0xdc, 0x56, 0x12, 0x1e,
@@ -424,7 +496,9 @@ var file_kuscia_proto_api_v1alpha1_errorcode_error_code_proto_rawDesc = []byte{
 	0x72, 0x4c, 0x69, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4e, 0x6f, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f,
 	0x72, 0x74, 0x10, 0xe0, 0x56, 0x12, 0x24, 0x0a, 0x1f, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
 	0x50, 0x49, 0x45, 0x72, 0x72, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x50, 0x49, 0x4e, 0x6f,
-	0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x10, 0xe1, 0x56, 0x12, 0x1a, 0x0a, 0x15, 0x4b,
+	0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x10, 0xe1, 0x56, 0x12, 0x1e, 0x0a, 0x19, 0x4b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0xe2, 0x56, 0x12, 0x1a, 0x0a, 0x15, 0x4b,
 	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x4a, 0x6f, 0x62, 0x10, 0xc1, 0x57, 0x12, 0x19, 0x0a, 0x14, 0x4b, 0x75, 0x73, 0x63, 0x69,
 	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x10,
@@ -446,224 +520,273 @@ var file_kuscia_proto_api_v1alpha1_errorcode_error_code_proto_rawDesc = []byte{
 	0x10, 0xca, 0x57, 0x12, 0x2f, 0x0a, 0x2a, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49,
 	0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x6f, 0x74, 0x53, 0x75, 0x73,
 	0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4f, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4a, 0x6f,
-	0x62, 0x10, 0xcb, 0x57, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50,
-	0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x10, 0xa4, 0x58, 0x12, 0x1c, 0x0a, 0x17, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49,
-	0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x10, 0xa5,
-	0x58, 0x12, 0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72,
-	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x10, 0xa6, 0x58, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
-	0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x10, 0xa7, 0x58, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50,
-	0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x10, 0xa8, 0x58, 0x12, 0x20, 0x0a, 0x1b, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49,
-	0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x10, 0xa9, 0x58, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
-	0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x10, 0xaa, 0x58, 0x12, 0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50,
-	0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x10, 0x88, 0x59, 0x12, 0x21, 0x0a, 0x1c, 0x4b, 0x75, 0x73, 0x63,
-	0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x10, 0x89, 0x59, 0x12, 0x27, 0x0a, 0x22, 0x4b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x10, 0x8a, 0x59, 0x12, 0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50,
-	0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x10, 0x8b, 0x59, 0x12, 0x25, 0x0a, 0x20, 0x4b, 0x75, 0x73, 0x63,
-	0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x8c, 0x59, 0x12,
-	0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x10, 0x8d, 0x59, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49,
-	0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xec, 0x59, 0x12, 0x27, 0x0a, 0x22,
-	0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x10, 0xed, 0x59, 0x12, 0x24, 0x0a, 0x1f, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
-	0x50, 0x49, 0x45, 0x72, 0x72, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xee, 0x59, 0x12, 0x25, 0x0a, 0x20, 0x4b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
-	0xef, 0x59, 0x12, 0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45,
-	0x72, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf0, 0x59, 0x12, 0x26, 0x0a, 0x21, 0x4b, 0x75,
-	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
-	0xf1, 0x59, 0x12, 0x24, 0x0a, 0x1f, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45,
-	0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x4e, 0x6f, 0x74, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xf2, 0x59, 0x12, 0x21, 0x0a, 0x1c, 0x4b, 0x75, 0x73, 0x63,
-	0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xf3, 0x59, 0x12, 0x1e, 0x0a, 0x19, 0x4b,
+	0x62, 0x10, 0xcb, 0x57, 0x12, 0x1e, 0x0a, 0x19, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50,
+	0x49, 0x45, 0x72, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x10, 0xcc, 0x57, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50,
+	0x49, 0x45, 0x72, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x10, 0xcd, 0x57, 0x12, 0x21, 0x0a, 0x1c, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49,
+	0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x4a, 0x6f, 0x62, 0x10, 0xce, 0x57, 0x12, 0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x10, 0xcf, 0x57, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x10, 0xa4, 0x58, 0x12, 0x1c, 0x0a, 0x17, 0x4b, 0x75, 0x73,
+	0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x10, 0xa5, 0x58, 0x12, 0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0xa6, 0x58, 0x12, 0x1d, 0x0a, 0x18, 0x4b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x10, 0xa7, 0x58, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x10, 0xa8, 0x58, 0x12, 0x20, 0x0a, 0x1b, 0x4b, 0x75, 0x73,
+	0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4e,
+	0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xa9, 0x58, 0x12, 0x1d, 0x0a, 0x18, 0x4b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xaa, 0x58, 0x12, 0x1f, 0x0a, 0x1a, 0x4b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x10, 0xab, 0x58, 0x12, 0x22, 0x0a, 0x1d, 0x4b,
 	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x10, 0xd0, 0x5a, 0x12, 0x1d, 0x0a, 0x18, 0x4b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x10, 0xd1, 0x5a, 0x12, 0x23, 0x0a, 0x1e, 0x4b, 0x75,
-	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0xd2, 0x5a, 0x12,
-	0x1e, 0x0a, 0x19, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x10, 0xd3, 0x5a, 0x12,
-	0x1e, 0x0a, 0x19, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x10, 0xd4, 0x5a, 0x12,
-	0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47,
-	0x72, 0x61, 0x6e, 0x74, 0x10, 0xb4, 0x5b, 0x12, 0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69,
-	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10, 0xb5, 0x5b, 0x12,
-	0x25, 0x0a, 0x20, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72,
-	0x61, 0x6e, 0x74, 0x10, 0xb6, 0x5b, 0x12, 0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
-	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10, 0xb7, 0x5b, 0x12, 0x26,
-	0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x10, 0xb8, 0x5b, 0x12, 0x29, 0x0a, 0x24, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x10, 0x88, 0x59, 0x12,
+	0x21, 0x0a, 0x1c, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x10,
+	0x89, 0x59, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45,
+	0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x8a, 0x59, 0x12, 0x22, 0x0a, 0x1d, 0x4b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x10, 0x8b, 0x59, 0x12,
+	0x25, 0x0a, 0x20, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x10, 0x8c, 0x59, 0x12, 0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x8d, 0x59, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x10, 0xec, 0x59, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49,
+	0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xed, 0x59, 0x12, 0x24, 0x0a, 0x1f,
+	0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x47, 0x65, 0x74, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
+	0xee, 0x59, 0x12, 0x25, 0x0a, 0x20, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45,
+	0x72, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xef, 0x59, 0x12, 0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73,
+	0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf0,
+	0x59, 0x12, 0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72,
+	0x72, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xf1, 0x59, 0x12, 0x24, 0x0a, 0x1f, 0x4b, 0x75, 0x73,
+	0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xf2, 0x59, 0x12,
+	0x21, 0x0a, 0x1c, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10,
+	0xf3, 0x59, 0x12, 0x23, 0x0a, 0x1e, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45,
+	0x72, 0x72, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x10, 0xf4, 0x59, 0x12, 0x25, 0x0a, 0x20, 0x4b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x4e, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x10, 0xf5, 0x59, 0x12, 0x21,
+	0x0a, 0x1c, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x10, 0xf6,
+	0x59, 0x12, 0x1e, 0x0a, 0x19, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x10, 0xd0,
+	0x5a, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72,
+	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x10, 0xd1, 0x5a,
+	0x12, 0x23, 0x0a, 0x1e, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x10, 0xd2, 0x5a, 0x12, 0x1e, 0x0a, 0x19, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x6e, 0x67, 0x10, 0xd3, 0x5a, 0x12, 0x1e, 0x0a, 0x19, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x6e, 0x67, 0x10, 0xd4, 0x5a, 0x12, 0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10, 0xb4, 0x5b, 0x12, 0x26, 0x0a,
+	0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x10, 0xb5, 0x5b, 0x12, 0x25, 0x0a, 0x20, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10, 0xb6, 0x5b, 0x12, 0x26, 0x0a, 0x21,
+	0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x10, 0xb7, 0x5b, 0x12, 0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50,
+	0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xb8, 0x5b, 0x12, 0x29, 0x0a, 0x24,
+	0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x10, 0xb9, 0x5b, 0x12, 0x24, 0x0a, 0x1f, 0x4b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0xba, 0x5b, 0x12, 0x27, 0x0a,
+	0x22, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x10, 0x98, 0x5c, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x99, 0x5c, 0x12,
+	0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x10, 0x9a, 0x5c, 0x12, 0x2b, 0x0a, 0x26, 0x4b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x10, 0x9b, 0x5c, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50,
+	0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x9c, 0x5c, 0x12, 0x27, 0x0a,
+	0x22, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x10, 0x9d, 0x5c, 0x12, 0x2a, 0x0a, 0x25, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
 	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x47, 0x72, 0x61, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xb9,
-	0x5b, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72,
-	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x98, 0x5c, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10,
+	0x9e, 0x5c, 0x12, 0x31, 0x0a, 0x2c, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45,
+	0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x10, 0x9f, 0x5c, 0x12, 0x25, 0x0a, 0x20, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0xa0, 0x5c, 0x12, 0x2d, 0x0a, 0x28,
+	0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x10, 0xa1, 0x5c, 0x12, 0x1d, 0x0a, 0x18, 0x4b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xfc, 0x5c, 0x12, 0x1c, 0x0a, 0x17, 0x4b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xfd, 0x5c, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63,
+	0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x10, 0xfe, 0x5c, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x10, 0xff, 0x5c, 0x12, 0x21, 0x0a, 0x1c, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0x80, 0x5d, 0x12, 0x1f, 0x0a, 0x1a, 0x4b, 0x75, 0x73,
+	0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xac, 0x66, 0x12, 0x1e, 0x0a, 0x19, 0x4b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xad, 0x66, 0x12, 0x1f, 0x0a, 0x1a, 0x4b, 0x75,
 	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x10, 0x99, 0x5c, 0x12, 0x26, 0x0a, 0x21, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49,
+	0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xae, 0x66, 0x12, 0x1f, 0x0a, 0x1a, 0x4b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xaf, 0x66, 0x12, 0x23, 0x0a, 0x1e,
+	0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xb0,
+	0x66, 0x12, 0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72,
+	0x72, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x10, 0xb1, 0x66, 0x12, 0x1f, 0x0a, 0x1a, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x10, 0xb2, 0x66, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x50, 0x72, 0x65, 0x77, 0x61, 0x72, 0x6d, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x10, 0xb3, 0x66, 0x12, 0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xb4, 0x66, 0x12, 0x19, 0x0a, 0x14, 0x4b, 0x75, 0x73,
+	0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f,
+	0x67, 0x10, 0x90, 0x67, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50,
+	0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x64, 0x4e, 0x6f, 0x64, 0x65,
+	0x10, 0x91, 0x67, 0x12, 0x20, 0x0a, 0x1b, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49,
+	0x45, 0x72, 0x72, 0x50, 0x75, 0x73, 0x68, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c,
+	0x6f, 0x67, 0x10, 0x92, 0x67, 0x12, 0x20, 0x0a, 0x1b, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x4c, 0x6f, 0x67, 0x10, 0x93, 0x67, 0x12, 0x24, 0x0a, 0x1f, 0x4b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x10, 0x94, 0x67, 0x12, 0x1b, 0x0a,
+	0x16, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x72,
+	0x64, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x10, 0xf4, 0x67, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x10, 0xf5, 0x67, 0x12, 0x1a, 0x0a, 0x15, 0x4b, 0x75, 0x73,
+	0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x6f,
+	0x64, 0x65, 0x10, 0xf6, 0x67, 0x12, 0x1e, 0x0a, 0x19, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x10, 0xd8, 0x68, 0x12, 0x21, 0x0a, 0x1c, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41,
+	0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x44,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x10, 0xd9, 0x68, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63,
+	0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x10, 0xbc, 0x69, 0x12, 0x1e, 0x0a, 0x19, 0x4b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x10, 0xbd, 0x69, 0x12, 0x17, 0x0a, 0x12, 0x4b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x10, 0xa0, 0x6a,
+	0x12, 0x21, 0x0a, 0x1c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x10, 0xc4, 0x5e, 0x12, 0x1d, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45,
+	0x72, 0x72, 0x46, 0x6f, 0x72, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10,
+	0xc5, 0x5e, 0x12, 0x20, 0x0a, 0x1b, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x10, 0xa8, 0x5f, 0x12, 0x1f, 0x0a, 0x1a, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68,
 	0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x9a, 0x5c, 0x12, 0x2b, 0x0a, 0x26, 0x4b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x9b, 0x5c, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75, 0x73, 0x63,
-	0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x9c,
-	0x5c, 0x12, 0x27, 0x0a, 0x22, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72,
-	0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x9d, 0x5c, 0x12, 0x2a, 0x0a, 0x25, 0x4b, 0x75,
-	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x10, 0x9e, 0x5c, 0x12, 0x31, 0x0a, 0x2c, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
-	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x9f, 0x5c, 0x12, 0x25, 0x0a, 0x20, 0x4b, 0x75, 0x73,
-	0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0xa0, 0x5c,
-	0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xfc, 0x5c, 0x12,
-	0x1c, 0x0a, 0x17, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xfd, 0x5c, 0x12, 0x1d, 0x0a,
-	0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xfe, 0x5c, 0x12, 0x1d, 0x0a, 0x18,
-	0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xff, 0x5c, 0x12, 0x21, 0x0a, 0x1c, 0x4b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0x80, 0x5d, 0x12, 0x1f,
-	0x0a, 0x1a, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xac, 0x66, 0x12,
-	0x1e, 0x0a, 0x19, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xad, 0x66, 0x12,
-	0x1f, 0x0a, 0x1a, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xae, 0x66,
-	0x12, 0x1f, 0x0a, 0x1a, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0xaf,
-	0x66, 0x12, 0x23, 0x0a, 0x1e, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72,
-	0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x70, 0x70, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x10, 0xb0, 0x66, 0x12, 0x22, 0x0a, 0x1d, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
-	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x6f,
-	0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xb1, 0x66, 0x12, 0x1f, 0x0a, 0x1a, 0x4b, 0x75,
-	0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xb2, 0x66, 0x12, 0x19, 0x0a, 0x14, 0x4b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4c, 0x6f, 0x67, 0x10, 0x90, 0x67, 0x12, 0x1d, 0x0a, 0x18, 0x4b, 0x75, 0x73, 0x63, 0x69, 0x61,
-	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x64, 0x4e, 0x6f,
-	0x64, 0x65, 0x10, 0x91, 0x67, 0x12, 0x21, 0x0a, 0x1c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73,
-	0x68, 0x45, 0x72, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x10, 0xc4, 0x5e, 0x12, 0x1d, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61,
-	0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x46, 0x6f, 0x72, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x10, 0xc5, 0x5e, 0x12, 0x20, 0x0a, 0x1b, 0x44, 0x61, 0x74, 0x61, 0x4d,
-	0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x10, 0xa8, 0x5f, 0x12, 0x1f, 0x0a, 0x1a, 0x44, 0x61, 0x74,
-	0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x10, 0xa9, 0x5f, 0x12, 0x2b, 0x0a, 0x26, 0x44, 0x61,
-	0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x72, 0x6f, 0x6d, 0x4b, 0x75, 0x62, 0x65, 0x46, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x10, 0xaa, 0x5f, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x4d,
-	0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xab, 0x5f, 0x12, 0x25,
-	0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x50, 0x61, 0x74,
-	0x63, 0x68, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x10, 0xac, 0x5f, 0x12, 0x26, 0x0a, 0x21, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73,
-	0x68, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xad, 0x5f, 0x12, 0x26, 0x0a,
-	0x21, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x10, 0x8c, 0x60, 0x12, 0x2b, 0x0a, 0x26, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73,
-	0x68, 0x45, 0x72, 0x72, 0x50, 0x61, 0x72, 0x73, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
+	0x74, 0x61, 0x10, 0xa9, 0x5f, 0x12, 0x2b, 0x0a, 0x26, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73,
+	0x68, 0x45, 0x72, 0x72, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x46, 0x72, 0x6f, 0x6d, 0x4b, 0x75, 0x62, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
+	0xaa, 0x5f, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72,
+	0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xab, 0x5f, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xac, 0x5f,
+	0x12, 0x26, 0x0a, 0x21, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xad, 0x5f, 0x12, 0x26, 0x0a, 0x21, 0x44, 0x61, 0x74, 0x61,
+	0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x8c, 0x60,
+	0x12, 0x2b, 0x0a, 0x26, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x50,
+	0x61, 0x72, 0x73, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x8d, 0x60, 0x12, 0x25, 0x0a,
+	0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x10, 0x8e, 0x60, 0x12, 0x2c, 0x0a, 0x27, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68,
+	0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
 	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
-	0x8d, 0x60, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72,
-	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x8e, 0x60, 0x12, 0x2c, 0x0a, 0x27, 0x44, 0x61, 0x74,
-	0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x10, 0x8f, 0x60, 0x12, 0x26, 0x0a, 0x21, 0x44, 0x61, 0x74, 0x61, 0x4d,
-	0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x90, 0x60, 0x12,
-	0x29, 0x0a, 0x24, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f,
-	0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x91, 0x60, 0x12, 0x31, 0x0a, 0x2c, 0x44, 0x61,
-	0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x4b, 0x75, 0x62, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x92, 0x60, 0x12, 0x30, 0x0a,
-	0x2b, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x93, 0x60, 0x12,
-	0x30, 0x0a, 0x2b, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x94,
-	0x60, 0x12, 0x2b, 0x0a, 0x26, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72,
-	0x4d, 0x65, 0x72, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x95, 0x60, 0x12, 0x2b,
-	0x0a, 0x26, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x50, 0x61, 0x74,
-	0x63, 0x68, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x96, 0x60, 0x12, 0x25, 0x0a, 0x20, 0x44,
-	0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10,
-	0xf0, 0x60, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10, 0xf1, 0x60, 0x12, 0x24, 0x0a, 0x1f, 0x44, 0x61, 0x74,
-	0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10, 0xf2, 0x60, 0x12,
-	0x25, 0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72,
-	0x61, 0x6e, 0x74, 0x10, 0xf3, 0x60, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65,
-	0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47,
-	0x72, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xf4, 0x60, 0x12, 0x28, 0x0a,
-	0x23, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x10, 0xf5, 0x60, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x6f, 0x6e, 0x66, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x10, 0xd0, 0x0f, 0x12, 0x20, 0x0a,
-	0x1b, 0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72, 0x72, 0x46,
-	0x6f, 0x72, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0xd1, 0x0f, 0x12,
-	0x1f, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72,
-	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xb6, 0x10,
-	0x12, 0x1e, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45,
-	0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xb7, 0x10,
-	0x12, 0x1f, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45,
-	0x72, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xb8,
-	0x10, 0x12, 0x1f, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10,
-	0xb9, 0x10, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x45, 0x72, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x10, 0xba, 0x10, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x6f, 0x6e, 0x66, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x43, 0x65, 0x72, 0x74, 0x73, 0x10, 0x98, 0x11, 0x12, 0x21, 0x0a, 0x1c,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x10, 0xb8, 0x17, 0x12,
-	0x1e, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x46, 0x6f,
-	0x72, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0xb9, 0x17, 0x42,
-	0x5e, 0x0a, 0x21, 0x6f, 0x72, 0x67, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x66, 0x6c, 0x6f,
-	0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x63, 0x6f, 0x64, 0x65, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x6b, 0x75, 0x73, 0x63,
-	0x69, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x8f, 0x60, 0x12, 0x26, 0x0a, 0x21, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72,
+	0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x90, 0x60, 0x12, 0x29, 0x0a, 0x24, 0x44, 0x61,
+	0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x10, 0x91, 0x60, 0x12, 0x31, 0x0a, 0x2c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73,
+	0x68, 0x45, 0x72, 0x72, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4b, 0x75, 0x62, 0x65, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x92, 0x60, 0x12, 0x30, 0x0a, 0x2b, 0x44, 0x61, 0x74, 0x61,
+	0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x93, 0x60, 0x12, 0x30, 0x0a, 0x2b, 0x44, 0x61,
+	0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x94, 0x60, 0x12, 0x2b, 0x0a, 0x26,
+	0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x95, 0x60, 0x12, 0x2b, 0x0a, 0x26, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x50, 0x61, 0x74, 0x63, 0x68, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x96, 0x60, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65,
+	0x73, 0x68, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10, 0xf0, 0x60, 0x12, 0x25, 0x0a,
+	0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x10, 0xf1, 0x60, 0x12, 0x24, 0x0a, 0x1f, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68,
+	0x45, 0x72, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10, 0xf2, 0x60, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x61,
+	0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x10, 0xf3,
+	0x60, 0x12, 0x25, 0x0a, 0x20, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xf4, 0x60, 0x12, 0x28, 0x0a, 0x23, 0x44, 0x61, 0x74, 0x61,
+	0x4d, 0x65, 0x73, 0x68, 0x45, 0x72, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10,
+	0xf5, 0x60, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x45, 0x72, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x10, 0xd0, 0x0f, 0x12, 0x20, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x66,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72, 0x72, 0x46, 0x6f, 0x72, 0x55, 0x6e, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0xd1, 0x0f, 0x12, 0x1f, 0x0a, 0x1a, 0x43, 0x6f,
+	0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xb6, 0x10, 0x12, 0x1e, 0x0a, 0x19, 0x43,
+	0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72, 0x72, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xb7, 0x10, 0x12, 0x1f, 0x0a, 0x1a, 0x43,
+	0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xb8, 0x10, 0x12, 0x1f, 0x0a, 0x1a,
+	0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xb9, 0x10, 0x12, 0x23, 0x0a,
+	0x1e, 0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x72, 0x72, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10,
+	0xba, 0x10, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x45, 0x72, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x43,
+	0x65, 0x72, 0x74, 0x73, 0x10, 0x98, 0x11, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x10, 0xb8, 0x17, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x46, 0x6f, 0x72, 0x55, 0x6e, 0x65, 0x78,
+	0x70, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0xb9, 0x17, 0x42, 0x5e, 0x0a, 0x21, 0x6f, 0x72,
+	0x67, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x5a,
+	0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
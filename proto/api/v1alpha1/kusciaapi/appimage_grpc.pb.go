@@ -38,6 +38,8 @@ const (
 	AppImageService_UpdateAppImage_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.AppImageService/UpdateAppImage"
 	AppImageService_DeleteAppImage_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.AppImageService/DeleteAppImage"
 	AppImageService_BatchQueryAppImage_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.AppImageService/BatchQueryAppImage"
+	AppImageService_PrewarmImage_FullMethodName       = "/kuscia.proto.api.v1alpha1.kusciaapi.AppImageService/PrewarmImage"
+	AppImageService_QueryPrewarmImage_FullMethodName  = "/kuscia.proto.api.v1alpha1.kusciaapi.AppImageService/QueryPrewarmImage"
 )
 
 // AppImageServiceClient is the client API for AppImageService service.
@@ -49,6 +51,8 @@ type AppImageServiceClient interface {
 	UpdateAppImage(ctx context.Context, in *UpdateAppImageRequest, opts ...grpc.CallOption) (*UpdateAppImageResponse, error)
 	DeleteAppImage(ctx context.Context, in *DeleteAppImageRequest, opts ...grpc.CallOption) (*DeleteAppImageResponse, error)
 	BatchQueryAppImage(ctx context.Context, in *BatchQueryAppImageRequest, opts ...grpc.CallOption) (*BatchQueryAppImageResponse, error)
+	PrewarmImage(ctx context.Context, in *PrewarmImageRequest, opts ...grpc.CallOption) (*PrewarmImageResponse, error)
+	QueryPrewarmImage(ctx context.Context, in *QueryPrewarmImageRequest, opts ...grpc.CallOption) (*QueryPrewarmImageResponse, error)
 }
 
 type appImageServiceClient struct {
@@ -104,6 +108,24 @@ func (c *appImageServiceClient) BatchQueryAppImage(ctx context.Context, in *Batc
 	return out, nil
 }
 
+func (c *appImageServiceClient) PrewarmImage(ctx context.Context, in *PrewarmImageRequest, opts ...grpc.CallOption) (*PrewarmImageResponse, error) {
+	out := new(PrewarmImageResponse)
+	err := c.cc.Invoke(ctx, AppImageService_PrewarmImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appImageServiceClient) QueryPrewarmImage(ctx context.Context, in *QueryPrewarmImageRequest, opts ...grpc.CallOption) (*QueryPrewarmImageResponse, error) {
+	out := new(QueryPrewarmImageResponse)
+	err := c.cc.Invoke(ctx, AppImageService_QueryPrewarmImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppImageServiceServer is the server API for AppImageService service.
 // All implementations must embed UnimplementedAppImageServiceServer
 // for forward compatibility
@@ -113,6 +135,8 @@ type AppImageServiceServer interface {
 	UpdateAppImage(context.Context, *UpdateAppImageRequest) (*UpdateAppImageResponse, error)
 	DeleteAppImage(context.Context, *DeleteAppImageRequest) (*DeleteAppImageResponse, error)
 	BatchQueryAppImage(context.Context, *BatchQueryAppImageRequest) (*BatchQueryAppImageResponse, error)
+	PrewarmImage(context.Context, *PrewarmImageRequest) (*PrewarmImageResponse, error)
+	QueryPrewarmImage(context.Context, *QueryPrewarmImageRequest) (*QueryPrewarmImageResponse, error)
 	mustEmbedUnimplementedAppImageServiceServer()
 }
 
@@ -135,6 +159,12 @@ func (UnimplementedAppImageServiceServer) DeleteAppImage(context.Context, *Delet
 func (UnimplementedAppImageServiceServer) BatchQueryAppImage(context.Context, *BatchQueryAppImageRequest) (*BatchQueryAppImageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchQueryAppImage not implemented")
 }
+func (UnimplementedAppImageServiceServer) PrewarmImage(context.Context, *PrewarmImageRequest) (*PrewarmImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrewarmImage not implemented")
+}
+func (UnimplementedAppImageServiceServer) QueryPrewarmImage(context.Context, *QueryPrewarmImageRequest) (*QueryPrewarmImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPrewarmImage not implemented")
+}
 func (UnimplementedAppImageServiceServer) mustEmbedUnimplementedAppImageServiceServer() {}
 
 // UnsafeAppImageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -238,6 +268,42 @@ func _AppImageService_BatchQueryAppImage_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppImageService_PrewarmImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrewarmImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppImageServiceServer).PrewarmImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppImageService_PrewarmImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppImageServiceServer).PrewarmImage(ctx, req.(*PrewarmImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppImageService_QueryPrewarmImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrewarmImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppImageServiceServer).QueryPrewarmImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppImageService_QueryPrewarmImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppImageServiceServer).QueryPrewarmImage(ctx, req.(*QueryPrewarmImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AppImageService_ServiceDesc is the grpc.ServiceDesc for AppImageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -265,6 +331,14 @@ var AppImageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchQueryAppImage",
 			Handler:    _AppImageService_BatchQueryAppImage_Handler,
 		},
+		{
+			MethodName: "PrewarmImage",
+			Handler:    _AppImageService_PrewarmImage_Handler,
+		},
+		{
+			MethodName: "QueryPrewarmImage",
+			Handler:    _AppImageService_QueryPrewarmImage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kuscia/proto/api/v1alpha1/kusciaapi/appimage.proto",
@@ -34,6 +34,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	CertificateService_GenerateKeyCerts_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.CertificateService/GenerateKeyCerts"
+	CertificateService_ListCertificates_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.CertificateService/ListCertificates"
 )
 
 // CertificateServiceClient is the client API for CertificateService service.
@@ -41,6 +42,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CertificateServiceClient interface {
 	GenerateKeyCerts(ctx context.Context, in *GenerateKeyCertsRequest, opts ...grpc.CallOption) (*GenerateKeyCertsResponse, error)
+	// ListCertificates lists the certs and the domainroute tokens of the kuscia instance ordered by the expiration.
+	ListCertificates(ctx context.Context, in *ListCertificatesRequest, opts ...grpc.CallOption) (*ListCertificatesResponse, error)
 }
 
 type certificateServiceClient struct {
@@ -60,11 +63,22 @@ func (c *certificateServiceClient) GenerateKeyCerts(ctx context.Context, in *Gen
 	return out, nil
 }
 
+func (c *certificateServiceClient) ListCertificates(ctx context.Context, in *ListCertificatesRequest, opts ...grpc.CallOption) (*ListCertificatesResponse, error) {
+	out := new(ListCertificatesResponse)
+	err := c.cc.Invoke(ctx, CertificateService_ListCertificates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CertificateServiceServer is the server API for CertificateService service.
 // All implementations must embed UnimplementedCertificateServiceServer
 // for forward compatibility
 type CertificateServiceServer interface {
 	GenerateKeyCerts(context.Context, *GenerateKeyCertsRequest) (*GenerateKeyCertsResponse, error)
+	// ListCertificates lists the certs and the domainroute tokens of the kuscia instance ordered by the expiration.
+	ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error)
 	mustEmbedUnimplementedCertificateServiceServer()
 }
 
@@ -75,6 +89,9 @@ type UnimplementedCertificateServiceServer struct {
 func (UnimplementedCertificateServiceServer) GenerateKeyCerts(context.Context, *GenerateKeyCertsRequest) (*GenerateKeyCertsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateKeyCerts not implemented")
 }
+func (UnimplementedCertificateServiceServer) ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCertificates not implemented")
+}
 func (UnimplementedCertificateServiceServer) mustEmbedUnimplementedCertificateServiceServer() {}
 
 // UnsafeCertificateServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -106,6 +123,24 @@ func _CertificateService_GenerateKeyCerts_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CertificateService_ListCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).ListCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateService_ListCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).ListCertificates(ctx, req.(*ListCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CertificateService_ServiceDesc is the grpc.ServiceDesc for CertificateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,6 +152,10 @@ var CertificateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateKeyCerts",
 			Handler:    _CertificateService_GenerateKeyCerts_Handler,
 		},
+		{
+			MethodName: "ListCertificates",
+			Handler:    _CertificateService_ListCertificates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kuscia/proto/api/v1alpha1/kusciaapi/certificate.proto",
@@ -0,0 +1,159 @@
+// Copyright 2025 Ant Group Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.8
+// source: kuscia/proto/api/v1alpha1/kusciaapi/debug.proto
+
+package kusciaapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DebugService_ExecContainer_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.DebugService/ExecContainer"
+)
+
+// DebugServiceClient is the client API for DebugService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DebugServiceClient interface {
+	// ExecContainer runs a command in a running container of the task pod through the agent of its node. The first
+	// request must carry the start of the session, the following ones carry the stdin and the terminal size.
+	ExecContainer(ctx context.Context, opts ...grpc.CallOption) (DebugService_ExecContainerClient, error)
+}
+
+type debugServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDebugServiceClient(cc grpc.ClientConnInterface) DebugServiceClient {
+	return &debugServiceClient{cc}
+}
+
+func (c *debugServiceClient) ExecContainer(ctx context.Context, opts ...grpc.CallOption) (DebugService_ExecContainerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DebugService_ServiceDesc.Streams[0], DebugService_ExecContainer_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &debugServiceExecContainerClient{stream}
+	return x, nil
+}
+
+type DebugService_ExecContainerClient interface {
+	Send(*ExecContainerRequest) error
+	Recv() (*ExecContainerResponse, error)
+	grpc.ClientStream
+}
+
+type debugServiceExecContainerClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugServiceExecContainerClient) Send(m *ExecContainerRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *debugServiceExecContainerClient) Recv() (*ExecContainerResponse, error) {
+	m := new(ExecContainerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DebugServiceServer is the server API for DebugService service.
+// All implementations must embed UnimplementedDebugServiceServer
+// for forward compatibility
+type DebugServiceServer interface {
+	// ExecContainer runs a command in a running container of the task pod through the agent of its node. The first
+	// request must carry the start of the session, the following ones carry the stdin and the terminal size.
+	ExecContainer(DebugService_ExecContainerServer) error
+	mustEmbedUnimplementedDebugServiceServer()
+}
+
+// UnimplementedDebugServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDebugServiceServer struct {
+}
+
+func (UnimplementedDebugServiceServer) ExecContainer(DebugService_ExecContainerServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecContainer not implemented")
+}
+func (UnimplementedDebugServiceServer) mustEmbedUnimplementedDebugServiceServer() {}
+
+// UnsafeDebugServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DebugServiceServer will
+// result in compilation errors.
+type UnsafeDebugServiceServer interface {
+	mustEmbedUnimplementedDebugServiceServer()
+}
+
+func RegisterDebugServiceServer(s grpc.ServiceRegistrar, srv DebugServiceServer) {
+	s.RegisterService(&DebugService_ServiceDesc, srv)
+}
+
+func _DebugService_ExecContainer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DebugServiceServer).ExecContainer(&debugServiceExecContainerServer{stream})
+}
+
+type DebugService_ExecContainerServer interface {
+	Send(*ExecContainerResponse) error
+	Recv() (*ExecContainerRequest, error)
+	grpc.ServerStream
+}
+
+type debugServiceExecContainerServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugServiceExecContainerServer) Send(m *ExecContainerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *debugServiceExecContainerServer) Recv() (*ExecContainerRequest, error) {
+	m := new(ExecContainerRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DebugService_ServiceDesc is the grpc.ServiceDesc for DebugService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DebugService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kuscia.proto.api.v1alpha1.kusciaapi.DebugService",
+	HandlerType: (*DebugServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExecContainer",
+			Handler:       _DebugService_ExecContainer_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "kuscia/proto/api/v1alpha1/kusciaapi/debug.proto",
+}
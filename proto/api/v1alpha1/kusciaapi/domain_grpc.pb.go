@@ -33,11 +33,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DomainService_CreateDomain_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/CreateDomain"
-	DomainService_QueryDomain_FullMethodName      = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/QueryDomain"
-	DomainService_UpdateDomain_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/UpdateDomain"
-	DomainService_DeleteDomain_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/DeleteDomain"
-	DomainService_BatchQueryDomain_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/BatchQueryDomain"
+	DomainService_CreateDomain_FullMethodName          = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/CreateDomain"
+	DomainService_QueryDomain_FullMethodName           = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/QueryDomain"
+	DomainService_UpdateDomain_FullMethodName          = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/UpdateDomain"
+	DomainService_DeleteDomain_FullMethodName          = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/DeleteDomain"
+	DomainService_BatchQueryDomain_FullMethodName      = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/BatchQueryDomain"
+	DomainService_RegisterDomain_FullMethodName        = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/RegisterDomain"
+	DomainService_QueryDomainOnboarding_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainService/QueryDomainOnboarding"
 )
 
 // DomainServiceClient is the client API for DomainService service.
@@ -49,6 +51,8 @@ type DomainServiceClient interface {
 	UpdateDomain(ctx context.Context, in *UpdateDomainRequest, opts ...grpc.CallOption) (*UpdateDomainResponse, error)
 	DeleteDomain(ctx context.Context, in *DeleteDomainRequest, opts ...grpc.CallOption) (*DeleteDomainResponse, error)
 	BatchQueryDomain(ctx context.Context, in *BatchQueryDomainRequest, opts ...grpc.CallOption) (*BatchQueryDomainResponse, error)
+	RegisterDomain(ctx context.Context, in *RegisterDomainRequest, opts ...grpc.CallOption) (*RegisterDomainResponse, error)
+	QueryDomainOnboarding(ctx context.Context, in *QueryDomainOnboardingRequest, opts ...grpc.CallOption) (*QueryDomainOnboardingResponse, error)
 }
 
 type domainServiceClient struct {
@@ -104,6 +108,24 @@ func (c *domainServiceClient) BatchQueryDomain(ctx context.Context, in *BatchQue
 	return out, nil
 }
 
+func (c *domainServiceClient) RegisterDomain(ctx context.Context, in *RegisterDomainRequest, opts ...grpc.CallOption) (*RegisterDomainResponse, error) {
+	out := new(RegisterDomainResponse)
+	err := c.cc.Invoke(ctx, DomainService_RegisterDomain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainServiceClient) QueryDomainOnboarding(ctx context.Context, in *QueryDomainOnboardingRequest, opts ...grpc.CallOption) (*QueryDomainOnboardingResponse, error) {
+	out := new(QueryDomainOnboardingResponse)
+	err := c.cc.Invoke(ctx, DomainService_QueryDomainOnboarding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DomainServiceServer is the server API for DomainService service.
 // All implementations must embed UnimplementedDomainServiceServer
 // for forward compatibility
@@ -113,6 +135,8 @@ type DomainServiceServer interface {
 	UpdateDomain(context.Context, *UpdateDomainRequest) (*UpdateDomainResponse, error)
 	DeleteDomain(context.Context, *DeleteDomainRequest) (*DeleteDomainResponse, error)
 	BatchQueryDomain(context.Context, *BatchQueryDomainRequest) (*BatchQueryDomainResponse, error)
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	QueryDomainOnboarding(context.Context, *QueryDomainOnboardingRequest) (*QueryDomainOnboardingResponse, error)
 	mustEmbedUnimplementedDomainServiceServer()
 }
 
@@ -135,6 +159,12 @@ func (UnimplementedDomainServiceServer) DeleteDomain(context.Context, *DeleteDom
 func (UnimplementedDomainServiceServer) BatchQueryDomain(context.Context, *BatchQueryDomainRequest) (*BatchQueryDomainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchQueryDomain not implemented")
 }
+func (UnimplementedDomainServiceServer) RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDomain not implemented")
+}
+func (UnimplementedDomainServiceServer) QueryDomainOnboarding(context.Context, *QueryDomainOnboardingRequest) (*QueryDomainOnboardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDomainOnboarding not implemented")
+}
 func (UnimplementedDomainServiceServer) mustEmbedUnimplementedDomainServiceServer() {}
 
 // UnsafeDomainServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -238,6 +268,42 @@ func _DomainService_BatchQueryDomain_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DomainService_RegisterDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).RegisterDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainService_RegisterDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).RegisterDomain(ctx, req.(*RegisterDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainService_QueryDomainOnboarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainOnboardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).QueryDomainOnboarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainService_QueryDomainOnboarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).QueryDomainOnboarding(ctx, req.(*QueryDomainOnboardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DomainService_ServiceDesc is the grpc.ServiceDesc for DomainService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -265,6 +331,14 @@ var DomainService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchQueryDomain",
 			Handler:    _DomainService_BatchQueryDomain_Handler,
 		},
+		{
+			MethodName: "RegisterDomain",
+			Handler:    _DomainService_RegisterDomain_Handler,
+		},
+		{
+			MethodName: "QueryDomainOnboarding",
+			Handler:    _DomainService_QueryDomainOnboarding_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kuscia/proto/api/v1alpha1/kusciaapi/domain.proto",
@@ -37,6 +37,7 @@ const (
 	DomainRouteService_DeleteDomainRoute_FullMethodName           = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainRouteService/DeleteDomainRoute"
 	DomainRouteService_QueryDomainRoute_FullMethodName            = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainRouteService/QueryDomainRoute"
 	DomainRouteService_BatchQueryDomainRouteStatus_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainRouteService/BatchQueryDomainRouteStatus"
+	DomainRouteService_QueryDomainRouteStatus_FullMethodName      = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainRouteService/QueryDomainRouteStatus"
 )
 
 // DomainRouteServiceClient is the client API for DomainRouteService service.
@@ -47,6 +48,7 @@ type DomainRouteServiceClient interface {
 	DeleteDomainRoute(ctx context.Context, in *DeleteDomainRouteRequest, opts ...grpc.CallOption) (*DeleteDomainRouteResponse, error)
 	QueryDomainRoute(ctx context.Context, in *QueryDomainRouteRequest, opts ...grpc.CallOption) (*QueryDomainRouteResponse, error)
 	BatchQueryDomainRouteStatus(ctx context.Context, in *BatchQueryDomainRouteStatusRequest, opts ...grpc.CallOption) (*BatchQueryDomainRouteStatusResponse, error)
+	QueryDomainRouteStatus(ctx context.Context, in *QueryDomainRouteStatusRequest, opts ...grpc.CallOption) (*QueryDomainRouteStatusResponse, error)
 }
 
 type domainRouteServiceClient struct {
@@ -93,6 +95,15 @@ func (c *domainRouteServiceClient) BatchQueryDomainRouteStatus(ctx context.Conte
 	return out, nil
 }
 
+func (c *domainRouteServiceClient) QueryDomainRouteStatus(ctx context.Context, in *QueryDomainRouteStatusRequest, opts ...grpc.CallOption) (*QueryDomainRouteStatusResponse, error) {
+	out := new(QueryDomainRouteStatusResponse)
+	err := c.cc.Invoke(ctx, DomainRouteService_QueryDomainRouteStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DomainRouteServiceServer is the server API for DomainRouteService service.
 // All implementations must embed UnimplementedDomainRouteServiceServer
 // for forward compatibility
@@ -101,6 +112,7 @@ type DomainRouteServiceServer interface {
 	DeleteDomainRoute(context.Context, *DeleteDomainRouteRequest) (*DeleteDomainRouteResponse, error)
 	QueryDomainRoute(context.Context, *QueryDomainRouteRequest) (*QueryDomainRouteResponse, error)
 	BatchQueryDomainRouteStatus(context.Context, *BatchQueryDomainRouteStatusRequest) (*BatchQueryDomainRouteStatusResponse, error)
+	QueryDomainRouteStatus(context.Context, *QueryDomainRouteStatusRequest) (*QueryDomainRouteStatusResponse, error)
 	mustEmbedUnimplementedDomainRouteServiceServer()
 }
 
@@ -120,6 +132,9 @@ func (UnimplementedDomainRouteServiceServer) QueryDomainRoute(context.Context, *
 func (UnimplementedDomainRouteServiceServer) BatchQueryDomainRouteStatus(context.Context, *BatchQueryDomainRouteStatusRequest) (*BatchQueryDomainRouteStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchQueryDomainRouteStatus not implemented")
 }
+func (UnimplementedDomainRouteServiceServer) QueryDomainRouteStatus(context.Context, *QueryDomainRouteStatusRequest) (*QueryDomainRouteStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDomainRouteStatus not implemented")
+}
 func (UnimplementedDomainRouteServiceServer) mustEmbedUnimplementedDomainRouteServiceServer() {}
 
 // UnsafeDomainRouteServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -205,6 +220,24 @@ func _DomainRouteService_BatchQueryDomainRouteStatus_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DomainRouteService_QueryDomainRouteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomainRouteStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainRouteServiceServer).QueryDomainRouteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainRouteService_QueryDomainRouteStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainRouteServiceServer).QueryDomainRouteStatus(ctx, req.(*QueryDomainRouteStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DomainRouteService_ServiceDesc is the grpc.ServiceDesc for DomainRouteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -228,6 +261,10 @@ var DomainRouteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchQueryDomainRouteStatus",
 			Handler:    _DomainRouteService_BatchQueryDomainRouteStatus_Handler,
 		},
+		{
+			MethodName: "QueryDomainRouteStatus",
+			Handler:    _DomainRouteService_QueryDomainRouteStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kuscia/proto/api/v1alpha1/kusciaapi/domain_route.proto",
@@ -50,9 +50,8 @@ type CreateDomainDataRequest struct {
 	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
 	// The relative_uri is relative to the datasource URI, The datasourceURI appends relative_uri is the domaindataURI.
 	// e.g. the relative_uri is "train/table.csv"
-	//
-	//	the URI of datasource is "/home/data"
-	//	the URI of domaindata is "/home/data/train/table.csv"
+	//      the URI of datasource is "/home/data"
+	//      the URI of domaindata is "/home/data/train/table.csv"
 	RelativeUri string `protobuf:"bytes,5,opt,name=relative_uri,json=relativeUri,proto3" json:"relative_uri,omitempty"`
 	// Domain_id is the unique identity of the domain. the domaindata is belong to this domain.
 	DomainId string `protobuf:"bytes,6,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -309,9 +308,8 @@ type UpdateDomainDataRequest struct {
 	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
 	// The relative_uri is relative to the datasource URI, The datasourceURI appends relative_uri is the domaindataURI.
 	// e.g. the relative_uri is "train/table.csv"
-	//
-	//	the URI of datasource is "/home/data"
-	//	the URI of domaindata is "/home/data/train/table.csv"
+	//      the URI of datasource is "/home/data"
+	//      the URI of domaindata is "/home/data/train/table.csv"
 	RelativeUri string `protobuf:"bytes,5,opt,name=relative_uri,json=relativeUri,proto3" json:"relative_uri,omitempty"`
 	// Mandatory, The domain_id indicate which domain's domaindata would be updated.
 	DomainId string `protobuf:"bytes,6,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -1116,9 +1114,8 @@ type DomainData struct {
 	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 	// The relative_uri is relative to the datasource URI, The datasourceURI appends relative_uri is the domaindataURI.
 	// e.g. the relative_uri is "train/table.csv"
-	//
-	//	the URI of datasource is "/home/data"
-	//	the URI of domaindata is "/home/data/train/table.csv"
+	//      the URI of datasource is "/home/data"
+	//      the URI of domaindata is "/home/data/train/table.csv"
 	RelativeUri string `protobuf:"bytes,4,opt,name=relative_uri,json=relativeUri,proto3" json:"relative_uri,omitempty"`
 	// domain_id the unique identity of the domain. the domaindata is belong to this domain.
 	DomainId string `protobuf:"bytes,5,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -1140,6 +1137,8 @@ type DomainData struct {
 	Author string `protobuf:"bytes,12,opt,name=author,proto3" json:"author,omitempty"`
 	// file-format only takes effect when the data source type is  localfs or oss. default value is csv
 	FileFormat v1alpha1.FileFormat `protobuf:"varint,13,opt,name=file_format,json=fileFormat,proto3,enum=kuscia.proto.api.v1alpha1.FileFormat" json:"file_format,omitempty"`
+	// the masking policies applied when other domains read the domaindata
+	MaskingPolicies []*v1alpha1.DataMaskingPolicy `protobuf:"bytes,14,rep,name=masking_policies,json=maskingPolicies,proto3" json:"masking_policies,omitempty"`
 }
 
 func (x *DomainData) Reset() {
@@ -1265,6 +1264,650 @@ func (x *DomainData) GetFileFormat() v1alpha1.FileFormat {
 	return v1alpha1.FileFormat(0)
 }
 
+func (x *DomainData) GetMaskingPolicies() []*v1alpha1.DataMaskingPolicy {
+	if x != nil {
+		return x.MaskingPolicies
+	}
+	return nil
+}
+
+type DownloadDomainDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *v1alpha1.RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// the domain which the domaindata belongs to
+	DomainId     string `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	DomaindataId string `protobuf:"bytes,3,opt,name=domaindata_id,json=domaindataId,proto3" json:"domaindata_id,omitempty"`
+	// offset is where the download starts in the domaindata file
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit_bytes is the max bytes to download, 0 means to the end of the file
+	LimitBytes int64 `protobuf:"varint,5,opt,name=limit_bytes,json=limitBytes,proto3" json:"limit_bytes,omitempty"`
+	// Optional, the domain which downloads the domaindata by the grant, it's only used when the request is not sent by
+	// a domain, the domain of the request is used otherwise.
+	GrantDomain string `protobuf:"bytes,6,opt,name=grant_domain,json=grantDomain,proto3" json:"grant_domain,omitempty"`
+}
+
+func (x *DownloadDomainDataRequest) Reset() {
+	*x = DownloadDomainDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadDomainDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDomainDataRequest) ProtoMessage() {}
+
+func (x *DownloadDomainDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDomainDataRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDomainDataRequest) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DownloadDomainDataRequest) GetHeader() *v1alpha1.RequestHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *DownloadDomainDataRequest) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *DownloadDomainDataRequest) GetDomaindataId() string {
+	if x != nil {
+		return x.DomaindataId
+	}
+	return ""
+}
+
+func (x *DownloadDomainDataRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadDomainDataRequest) GetLimitBytes() int64 {
+	if x != nil {
+		return x.LimitBytes
+	}
+	return 0
+}
+
+func (x *DownloadDomainDataRequest) GetGrantDomain() string {
+	if x != nil {
+		return x.GrantDomain
+	}
+	return ""
+}
+
+type DownloadDomainDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *v1alpha1.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// offset is where the content starts in the domaindata file
+	Offset  int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// the sha256 hex digest of the content
+	Sha256 string `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// the size of the domaindata file
+	TotalSize int64 `protobuf:"varint,5,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// eof is true in the last chunk of the download
+	Eof bool `protobuf:"varint,6,opt,name=eof,proto3" json:"eof,omitempty"`
+}
+
+func (x *DownloadDomainDataResponse) Reset() {
+	*x = DownloadDomainDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadDomainDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDomainDataResponse) ProtoMessage() {}
+
+func (x *DownloadDomainDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDomainDataResponse.ProtoReflect.Descriptor instead.
+func (*DownloadDomainDataResponse) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DownloadDomainDataResponse) GetStatus() *v1alpha1.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *DownloadDomainDataResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadDomainDataResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DownloadDomainDataResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *DownloadDomainDataResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *DownloadDomainDataResponse) GetEof() bool {
+	if x != nil {
+		return x.Eof
+	}
+	return false
+}
+
+type UploadDomainDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *v1alpha1.RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// meta is required in the first request of the stream, it's ignored in the following requests.
+	Meta    *UploadDomainDataMeta `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Content []byte                `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *UploadDomainDataRequest) Reset() {
+	*x = UploadDomainDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDomainDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDomainDataRequest) ProtoMessage() {}
+
+func (x *UploadDomainDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDomainDataRequest.ProtoReflect.Descriptor instead.
+func (*UploadDomainDataRequest) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UploadDomainDataRequest) GetHeader() *v1alpha1.RequestHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *UploadDomainDataRequest) GetMeta() *UploadDomainDataMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UploadDomainDataRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadDomainDataMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DomainId string `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	// Optional, the domaindata_id would be generated by server if the domaindata_id is empty.
+	DomaindataId string `protobuf:"bytes,2,opt,name=domaindata_id,json=domaindataId,proto3" json:"domaindata_id,omitempty"`
+	// Optional, the file name of the relative_uri is used if the name is empty.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Enum: table,model,rule,report,unknown
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// The path relative to the datasource, where the file is written.
+	RelativeUri string `protobuf:"bytes,5,opt,name=relative_uri,json=relativeUri,proto3" json:"relative_uri,omitempty"`
+	// Optional, server would use default datasource if datasource_id is empty.
+	DatasourceId string                 `protobuf:"bytes,6,opt,name=datasource_id,json=datasourceId,proto3" json:"datasource_id,omitempty"`
+	Attributes   map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Columns      []*v1alpha1.DataColumn `protobuf:"bytes,8,rep,name=columns,proto3" json:"columns,omitempty"`
+	Vendor       string                 `protobuf:"bytes,9,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	FileFormat   v1alpha1.FileFormat    `protobuf:"varint,10,opt,name=file_format,json=fileFormat,proto3,enum=kuscia.proto.api.v1alpha1.FileFormat" json:"file_format,omitempty"`
+	// infer_schema infers the columns from the header and the first rows of the csv file if the columns are empty,
+	// it only takes effect when the type is table.
+	InferSchema bool `protobuf:"varint,11,opt,name=infer_schema,json=inferSchema,proto3" json:"infer_schema,omitempty"`
+	// overwrite replaces the existing file and domaindata, the upload fails if either of them exists otherwise.
+	Overwrite bool `protobuf:"varint,12,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+}
+
+func (x *UploadDomainDataMeta) Reset() {
+	*x = UploadDomainDataMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDomainDataMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDomainDataMeta) ProtoMessage() {}
+
+func (x *UploadDomainDataMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDomainDataMeta.ProtoReflect.Descriptor instead.
+func (*UploadDomainDataMeta) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UploadDomainDataMeta) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *UploadDomainDataMeta) GetDomaindataId() string {
+	if x != nil {
+		return x.DomaindataId
+	}
+	return ""
+}
+
+func (x *UploadDomainDataMeta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadDomainDataMeta) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UploadDomainDataMeta) GetRelativeUri() string {
+	if x != nil {
+		return x.RelativeUri
+	}
+	return ""
+}
+
+func (x *UploadDomainDataMeta) GetDatasourceId() string {
+	if x != nil {
+		return x.DatasourceId
+	}
+	return ""
+}
+
+func (x *UploadDomainDataMeta) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UploadDomainDataMeta) GetColumns() []*v1alpha1.DataColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *UploadDomainDataMeta) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *UploadDomainDataMeta) GetFileFormat() v1alpha1.FileFormat {
+	if x != nil {
+		return x.FileFormat
+	}
+	return v1alpha1.FileFormat(0)
+}
+
+func (x *UploadDomainDataMeta) GetInferSchema() bool {
+	if x != nil {
+		return x.InferSchema
+	}
+	return false
+}
+
+func (x *UploadDomainDataMeta) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type UploadDomainDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *v1alpha1.Status              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data   *UploadDomainDataResponseData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UploadDomainDataResponse) Reset() {
+	*x = UploadDomainDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDomainDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDomainDataResponse) ProtoMessage() {}
+
+func (x *UploadDomainDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDomainDataResponse.ProtoReflect.Descriptor instead.
+func (*UploadDomainDataResponse) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UploadDomainDataResponse) GetStatus() *v1alpha1.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UploadDomainDataResponse) GetData() *UploadDomainDataResponseData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadDomainDataResponseData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DomaindataId string `protobuf:"bytes,1,opt,name=domaindata_id,json=domaindataId,proto3" json:"domaindata_id,omitempty"`
+	// the size of the uploaded file
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// the sha256 hex digest of the uploaded file
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// the columns of the domaindata, including the inferred ones
+	Columns []*v1alpha1.DataColumn `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *UploadDomainDataResponseData) Reset() {
+	*x = UploadDomainDataResponseData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDomainDataResponseData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDomainDataResponseData) ProtoMessage() {}
+
+func (x *UploadDomainDataResponseData) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDomainDataResponseData.ProtoReflect.Descriptor instead.
+func (*UploadDomainDataResponseData) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadDomainDataResponseData) GetDomaindataId() string {
+	if x != nil {
+		return x.DomaindataId
+	}
+	return ""
+}
+
+func (x *UploadDomainDataResponseData) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadDomainDataResponseData) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *UploadDomainDataResponseData) GetColumns() []*v1alpha1.DataColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type SetDomainDataMaskingPoliciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header          *v1alpha1.RequestHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	DomainId        string                        `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	DomaindataId    string                        `protobuf:"bytes,3,opt,name=domaindata_id,json=domaindataId,proto3" json:"domaindata_id,omitempty"`
+	MaskingPolicies []*v1alpha1.DataMaskingPolicy `protobuf:"bytes,4,rep,name=masking_policies,json=maskingPolicies,proto3" json:"masking_policies,omitempty"`
+}
+
+func (x *SetDomainDataMaskingPoliciesRequest) Reset() {
+	*x = SetDomainDataMaskingPoliciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDomainDataMaskingPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDomainDataMaskingPoliciesRequest) ProtoMessage() {}
+
+func (x *SetDomainDataMaskingPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDomainDataMaskingPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*SetDomainDataMaskingPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetDomainDataMaskingPoliciesRequest) GetHeader() *v1alpha1.RequestHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *SetDomainDataMaskingPoliciesRequest) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *SetDomainDataMaskingPoliciesRequest) GetDomaindataId() string {
+	if x != nil {
+		return x.DomaindataId
+	}
+	return ""
+}
+
+func (x *SetDomainDataMaskingPoliciesRequest) GetMaskingPolicies() []*v1alpha1.DataMaskingPolicy {
+	if x != nil {
+		return x.MaskingPolicies
+	}
+	return nil
+}
+
+type SetDomainDataMaskingPoliciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *v1alpha1.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetDomainDataMaskingPoliciesResponse) Reset() {
+	*x = SetDomainDataMaskingPoliciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDomainDataMaskingPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDomainDataMaskingPoliciesResponse) ProtoMessage() {}
+
+func (x *SetDomainDataMaskingPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDomainDataMaskingPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*SetDomainDataMaskingPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetDomainDataMaskingPoliciesResponse) GetStatus() *v1alpha1.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
 var File_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto protoreflect.FileDescriptor
 
 var file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDesc = []byte{
@@ -1482,7 +2125,7 @@ var file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDesc = []byte{
 	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69,
 	0x61, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
 	0x0e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22,
-	0xf3, 0x04, 0x0a, 0x0a, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23,
+	0xcc, 0x05, 0x0a, 0x0a, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23,
 	0x0a, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74,
 	0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -1517,74 +2160,233 @@ var file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
 	0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x82, 0x07, 0x0a, 0x11, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x10,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x3c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73,
-	0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x57, 0x0a, 0x10, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f,
+	0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xfb,
+	0x01, 0x0a, 0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xd2, 0x01, 0x0a,
+	0x1a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x6f,
+	0x66, 0x22, 0xc4, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x4d, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e,
+	0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xd4, 0x04, 0x0a, 0x14, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x74,
+	0x61, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74,
+	0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x55, 0x72, 0x69, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x69, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65,
+	0x74, 0x61, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3f,
+	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xac, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x55, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb0,
+	0x01, 0x0a, 0x1c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61,
+	0x74, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x22, 0x82, 0x02, 0x0a, 0x23, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x73, 0x63,
+	0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x57, 0x0a,
+	0x10, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x24, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69,
-	0x61, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01,
-	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x3c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x3d, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73,
-	0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x8f, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xe6, 0x0a, 0x0a, 0x11, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x8f, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
 	0x44, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
 	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x8c, 0x01, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b,
-	0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x9b, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x2e, 0x6b, 0x75, 0x73, 0x63,
+	0x65, 0x12, 0x8f, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x2e, 0x6b, 0x75, 0x73, 0x63,
 	0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x6b, 0x75,
-	0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70,
-	0x69, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x89,
-	0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x3a, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9b, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x2e,
+	0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x41, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63,
+	0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3b, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75,
 	0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e,
+	0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x97,
+	0x01, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x91, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x2e,
 	0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
-	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x5e, 0x0a, 0x21, 0x6f, 0x72,
-	0x67, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x5a,
-	0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0xb3, 0x01, 0x0a,
+	0x1c, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x61,
+	0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x48, 0x2e,
+	0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x73, 0x6b, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x5e, 0x0a, 0x21, 0x6f, 0x72, 0x67, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x6b, 0x75,
+	0x73, 0x63, 0x69, 0x61, 0x61, 0x70, 0x69, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x6b,
+	0x75, 0x73, 0x63, 0x69, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6b, 0x75, 0x73, 0x63, 0x69, 0x61, 0x61,
+	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1599,84 +2401,114 @@ func file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescGZIP() []b
 	return file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDescData
 }
 
-var file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_goTypes = []interface{}{
-	(*CreateDomainDataRequest)(nil),      // 0: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest
-	(*CreateDomainDataResponse)(nil),     // 1: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponse
-	(*CreateDomainDataResponseData)(nil), // 2: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponseData
-	(*UpdateDomainDataRequest)(nil),      // 3: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest
-	(*UpdateDomainDataResponse)(nil),     // 4: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataResponse
-	(*DeleteDomainDataRequest)(nil),      // 5: kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataRequest
-	(*DeleteDomainDataResponse)(nil),     // 6: kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataResponse
-	(*QueryDomainDataRequest)(nil),       // 7: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequest
-	(*QueryDomainDataResponse)(nil),      // 8: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataResponse
-	(*QueryDomainDataRequestData)(nil),   // 9: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequestData
-	(*BatchQueryDomainDataRequest)(nil),  // 10: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataRequest
-	(*BatchQueryDomainDataResponse)(nil), // 11: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataResponse
-	(*ListDomainDataRequest)(nil),        // 12: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequest
-	(*ListDomainDataResponse)(nil),       // 13: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataResponse
-	(*ListDomainDataRequestData)(nil),    // 14: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequestData
-	(*DomainDataList)(nil),               // 15: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataList
-	(*DomainData)(nil),                   // 16: kuscia.proto.api.v1alpha1.kusciaapi.DomainData
-	nil,                                  // 17: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.AttributesEntry
-	nil,                                  // 18: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.AttributesEntry
-	nil,                                  // 19: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.AttributesEntry
-	(*v1alpha1.RequestHeader)(nil),       // 20: kuscia.proto.api.v1alpha1.RequestHeader
-	(*v1alpha1.Partition)(nil),           // 21: kuscia.proto.api.v1alpha1.Partition
-	(*v1alpha1.DataColumn)(nil),          // 22: kuscia.proto.api.v1alpha1.DataColumn
-	(v1alpha1.FileFormat)(0),             // 23: kuscia.proto.api.v1alpha1.FileFormat
-	(*v1alpha1.Status)(nil),              // 24: kuscia.proto.api.v1alpha1.Status
+	(*CreateDomainDataRequest)(nil),              // 0: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest
+	(*CreateDomainDataResponse)(nil),             // 1: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponse
+	(*CreateDomainDataResponseData)(nil),         // 2: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponseData
+	(*UpdateDomainDataRequest)(nil),              // 3: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest
+	(*UpdateDomainDataResponse)(nil),             // 4: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataResponse
+	(*DeleteDomainDataRequest)(nil),              // 5: kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataRequest
+	(*DeleteDomainDataResponse)(nil),             // 6: kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataResponse
+	(*QueryDomainDataRequest)(nil),               // 7: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequest
+	(*QueryDomainDataResponse)(nil),              // 8: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataResponse
+	(*QueryDomainDataRequestData)(nil),           // 9: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequestData
+	(*BatchQueryDomainDataRequest)(nil),          // 10: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataRequest
+	(*BatchQueryDomainDataResponse)(nil),         // 11: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataResponse
+	(*ListDomainDataRequest)(nil),                // 12: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequest
+	(*ListDomainDataResponse)(nil),               // 13: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataResponse
+	(*ListDomainDataRequestData)(nil),            // 14: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequestData
+	(*DomainDataList)(nil),                       // 15: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataList
+	(*DomainData)(nil),                           // 16: kuscia.proto.api.v1alpha1.kusciaapi.DomainData
+	(*DownloadDomainDataRequest)(nil),            // 17: kuscia.proto.api.v1alpha1.kusciaapi.DownloadDomainDataRequest
+	(*DownloadDomainDataResponse)(nil),           // 18: kuscia.proto.api.v1alpha1.kusciaapi.DownloadDomainDataResponse
+	(*UploadDomainDataRequest)(nil),              // 19: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataRequest
+	(*UploadDomainDataMeta)(nil),                 // 20: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataMeta
+	(*UploadDomainDataResponse)(nil),             // 21: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataResponse
+	(*UploadDomainDataResponseData)(nil),         // 22: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataResponseData
+	(*SetDomainDataMaskingPoliciesRequest)(nil),  // 23: kuscia.proto.api.v1alpha1.kusciaapi.SetDomainDataMaskingPoliciesRequest
+	(*SetDomainDataMaskingPoliciesResponse)(nil), // 24: kuscia.proto.api.v1alpha1.kusciaapi.SetDomainDataMaskingPoliciesResponse
+	nil,                                // 25: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.AttributesEntry
+	nil,                                // 26: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.AttributesEntry
+	nil,                                // 27: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.AttributesEntry
+	nil,                                // 28: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataMeta.AttributesEntry
+	(*v1alpha1.RequestHeader)(nil),     // 29: kuscia.proto.api.v1alpha1.RequestHeader
+	(*v1alpha1.Partition)(nil),         // 30: kuscia.proto.api.v1alpha1.Partition
+	(*v1alpha1.DataColumn)(nil),        // 31: kuscia.proto.api.v1alpha1.DataColumn
+	(v1alpha1.FileFormat)(0),           // 32: kuscia.proto.api.v1alpha1.FileFormat
+	(*v1alpha1.Status)(nil),            // 33: kuscia.proto.api.v1alpha1.Status
+	(*v1alpha1.DataMaskingPolicy)(nil), // 34: kuscia.proto.api.v1alpha1.DataMaskingPolicy
 }
 var file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_depIdxs = []int32{
-	20, // 0: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
-	17, // 1: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.attributes:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.AttributesEntry
-	21, // 2: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.partition:type_name -> kuscia.proto.api.v1alpha1.Partition
-	22, // 3: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.columns:type_name -> kuscia.proto.api.v1alpha1.DataColumn
-	23, // 4: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.file_format:type_name -> kuscia.proto.api.v1alpha1.FileFormat
-	24, // 5: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	29, // 0: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	25, // 1: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.attributes:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.AttributesEntry
+	30, // 2: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.partition:type_name -> kuscia.proto.api.v1alpha1.Partition
+	31, // 3: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.columns:type_name -> kuscia.proto.api.v1alpha1.DataColumn
+	32, // 4: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest.file_format:type_name -> kuscia.proto.api.v1alpha1.FileFormat
+	33, // 5: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
 	2,  // 6: kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponse.data:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponseData
-	20, // 7: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
-	18, // 8: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.attributes:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.AttributesEntry
-	21, // 9: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.partition:type_name -> kuscia.proto.api.v1alpha1.Partition
-	22, // 10: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.columns:type_name -> kuscia.proto.api.v1alpha1.DataColumn
-	23, // 11: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.file_format:type_name -> kuscia.proto.api.v1alpha1.FileFormat
-	24, // 12: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
-	20, // 13: kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
-	24, // 14: kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
-	20, // 15: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	29, // 7: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	26, // 8: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.attributes:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.AttributesEntry
+	30, // 9: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.partition:type_name -> kuscia.proto.api.v1alpha1.Partition
+	31, // 10: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.columns:type_name -> kuscia.proto.api.v1alpha1.DataColumn
+	32, // 11: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest.file_format:type_name -> kuscia.proto.api.v1alpha1.FileFormat
+	33, // 12: kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	29, // 13: kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	33, // 14: kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	29, // 15: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
 	9,  // 16: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequest.data:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequestData
-	24, // 17: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	33, // 17: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
 	16, // 18: kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataResponse.data:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.DomainData
-	20, // 19: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	29, // 19: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
 	9,  // 20: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataRequest.data:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequestData
-	24, // 21: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	33, // 21: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
 	15, // 22: kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataResponse.data:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.DomainDataList
-	20, // 23: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	29, // 23: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
 	14, // 24: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequest.data:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequestData
-	24, // 25: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	33, // 25: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
 	15, // 26: kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataResponse.data:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.DomainDataList
 	16, // 27: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataList.domaindata_list:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.DomainData
-	19, // 28: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.attributes:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.DomainData.AttributesEntry
-	21, // 29: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.partition:type_name -> kuscia.proto.api.v1alpha1.Partition
-	22, // 30: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.columns:type_name -> kuscia.proto.api.v1alpha1.DataColumn
-	23, // 31: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.file_format:type_name -> kuscia.proto.api.v1alpha1.FileFormat
-	0,  // 32: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.CreateDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest
-	3,  // 33: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.UpdateDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest
-	5,  // 34: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.DeleteDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataRequest
-	7,  // 35: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.QueryDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequest
-	10, // 36: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.BatchQueryDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataRequest
-	12, // 37: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.ListDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequest
-	1,  // 38: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.CreateDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponse
-	4,  // 39: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.UpdateDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataResponse
-	6,  // 40: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.DeleteDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataResponse
-	8,  // 41: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.QueryDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataResponse
-	11, // 42: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.BatchQueryDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataResponse
-	13, // 43: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.ListDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataResponse
-	38, // [38:44] is the sub-list for method output_type
-	32, // [32:38] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	27, // 28: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.attributes:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.DomainData.AttributesEntry
+	30, // 29: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.partition:type_name -> kuscia.proto.api.v1alpha1.Partition
+	31, // 30: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.columns:type_name -> kuscia.proto.api.v1alpha1.DataColumn
+	32, // 31: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.file_format:type_name -> kuscia.proto.api.v1alpha1.FileFormat
+	34, // 32: kuscia.proto.api.v1alpha1.kusciaapi.DomainData.masking_policies:type_name -> kuscia.proto.api.v1alpha1.DataMaskingPolicy
+	29, // 33: kuscia.proto.api.v1alpha1.kusciaapi.DownloadDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	33, // 34: kuscia.proto.api.v1alpha1.kusciaapi.DownloadDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	29, // 35: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	20, // 36: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataRequest.meta:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataMeta
+	28, // 37: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataMeta.attributes:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataMeta.AttributesEntry
+	31, // 38: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataMeta.columns:type_name -> kuscia.proto.api.v1alpha1.DataColumn
+	32, // 39: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataMeta.file_format:type_name -> kuscia.proto.api.v1alpha1.FileFormat
+	33, // 40: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	22, // 41: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataResponse.data:type_name -> kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataResponseData
+	31, // 42: kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataResponseData.columns:type_name -> kuscia.proto.api.v1alpha1.DataColumn
+	29, // 43: kuscia.proto.api.v1alpha1.kusciaapi.SetDomainDataMaskingPoliciesRequest.header:type_name -> kuscia.proto.api.v1alpha1.RequestHeader
+	34, // 44: kuscia.proto.api.v1alpha1.kusciaapi.SetDomainDataMaskingPoliciesRequest.masking_policies:type_name -> kuscia.proto.api.v1alpha1.DataMaskingPolicy
+	33, // 45: kuscia.proto.api.v1alpha1.kusciaapi.SetDomainDataMaskingPoliciesResponse.status:type_name -> kuscia.proto.api.v1alpha1.Status
+	0,  // 46: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.CreateDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataRequest
+	3,  // 47: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.UpdateDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataRequest
+	5,  // 48: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.DeleteDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataRequest
+	7,  // 49: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.QueryDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataRequest
+	10, // 50: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.BatchQueryDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataRequest
+	12, // 51: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.ListDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataRequest
+	17, // 52: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.DownloadDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.DownloadDomainDataRequest
+	19, // 53: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.UploadDomainData:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataRequest
+	23, // 54: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.SetDomainDataMaskingPolicies:input_type -> kuscia.proto.api.v1alpha1.kusciaapi.SetDomainDataMaskingPoliciesRequest
+	1,  // 55: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.CreateDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.CreateDomainDataResponse
+	4,  // 56: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.UpdateDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.UpdateDomainDataResponse
+	6,  // 57: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.DeleteDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.DeleteDomainDataResponse
+	8,  // 58: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.QueryDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.QueryDomainDataResponse
+	11, // 59: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.BatchQueryDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.BatchQueryDomainDataResponse
+	13, // 60: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.ListDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.ListDomainDataResponse
+	18, // 61: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.DownloadDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.DownloadDomainDataResponse
+	21, // 62: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.UploadDomainData:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.UploadDomainDataResponse
+	24, // 63: kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService.SetDomainDataMaskingPolicies:output_type -> kuscia.proto.api.v1alpha1.kusciaapi.SetDomainDataMaskingPoliciesResponse
+	55, // [55:64] is the sub-list for method output_type
+	46, // [46:55] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_init() }
@@ -1889,6 +2721,102 @@ func file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_init() {
 				return nil
 			}
 		}
+		file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadDomainDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadDomainDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDomainDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDomainDataMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDomainDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDomainDataResponseData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDomainDataMaskingPoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDomainDataMaskingPoliciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1896,7 +2824,7 @@ func file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kuscia_proto_api_v1alpha1_kusciaapi_domaindata_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
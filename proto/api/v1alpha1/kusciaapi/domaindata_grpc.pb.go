@@ -33,12 +33,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DomainDataService_CreateDomainData_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/CreateDomainData"
-	DomainDataService_UpdateDomainData_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/UpdateDomainData"
-	DomainDataService_DeleteDomainData_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/DeleteDomainData"
-	DomainDataService_QueryDomainData_FullMethodName      = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/QueryDomainData"
-	DomainDataService_BatchQueryDomainData_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/BatchQueryDomainData"
-	DomainDataService_ListDomainData_FullMethodName       = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/ListDomainData"
+	DomainDataService_CreateDomainData_FullMethodName             = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/CreateDomainData"
+	DomainDataService_UpdateDomainData_FullMethodName             = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/UpdateDomainData"
+	DomainDataService_DeleteDomainData_FullMethodName             = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/DeleteDomainData"
+	DomainDataService_QueryDomainData_FullMethodName              = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/QueryDomainData"
+	DomainDataService_BatchQueryDomainData_FullMethodName         = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/BatchQueryDomainData"
+	DomainDataService_ListDomainData_FullMethodName               = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/ListDomainData"
+	DomainDataService_DownloadDomainData_FullMethodName           = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/DownloadDomainData"
+	DomainDataService_UploadDomainData_FullMethodName             = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/UploadDomainData"
+	DomainDataService_SetDomainDataMaskingPolicies_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataService/SetDomainDataMaskingPolicies"
 )
 
 // DomainDataServiceClient is the client API for DomainDataService service.
@@ -51,6 +54,13 @@ type DomainDataServiceClient interface {
 	QueryDomainData(ctx context.Context, in *QueryDomainDataRequest, opts ...grpc.CallOption) (*QueryDomainDataResponse, error)
 	BatchQueryDomainData(ctx context.Context, in *BatchQueryDomainDataRequest, opts ...grpc.CallOption) (*BatchQueryDomainDataResponse, error)
 	ListDomainData(ctx context.Context, in *ListDomainDataRequest, opts ...grpc.CallOption) (*ListDomainDataResponse, error)
+	// DownloadDomainData streams the content of the localfs or oss domaindata in chunks from the offset,
+	// the interrupted download could be resumed from the offset of the last received chunk.
+	DownloadDomainData(ctx context.Context, in *DownloadDomainDataRequest, opts ...grpc.CallOption) (DomainDataService_DownloadDomainDataClient, error)
+	// UploadDomainData writes the streamed file into the localfs or oss datasource and registers the domaindata.
+	UploadDomainData(ctx context.Context, opts ...grpc.CallOption) (DomainDataService_UploadDomainDataClient, error)
+	// SetDomainDataMaskingPolicies replaces the masking policies of the domaindata, empty policies remove the masking.
+	SetDomainDataMaskingPolicies(ctx context.Context, in *SetDomainDataMaskingPoliciesRequest, opts ...grpc.CallOption) (*SetDomainDataMaskingPoliciesResponse, error)
 }
 
 type domainDataServiceClient struct {
@@ -115,6 +125,81 @@ func (c *domainDataServiceClient) ListDomainData(ctx context.Context, in *ListDo
 	return out, nil
 }
 
+func (c *domainDataServiceClient) DownloadDomainData(ctx context.Context, in *DownloadDomainDataRequest, opts ...grpc.CallOption) (DomainDataService_DownloadDomainDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DomainDataService_ServiceDesc.Streams[0], DomainDataService_DownloadDomainData_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &domainDataServiceDownloadDomainDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DomainDataService_DownloadDomainDataClient interface {
+	Recv() (*DownloadDomainDataResponse, error)
+	grpc.ClientStream
+}
+
+type domainDataServiceDownloadDomainDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *domainDataServiceDownloadDomainDataClient) Recv() (*DownloadDomainDataResponse, error) {
+	m := new(DownloadDomainDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *domainDataServiceClient) UploadDomainData(ctx context.Context, opts ...grpc.CallOption) (DomainDataService_UploadDomainDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DomainDataService_ServiceDesc.Streams[1], DomainDataService_UploadDomainData_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &domainDataServiceUploadDomainDataClient{stream}
+	return x, nil
+}
+
+type DomainDataService_UploadDomainDataClient interface {
+	Send(*UploadDomainDataRequest) error
+	CloseAndRecv() (*UploadDomainDataResponse, error)
+	grpc.ClientStream
+}
+
+type domainDataServiceUploadDomainDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *domainDataServiceUploadDomainDataClient) Send(m *UploadDomainDataRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *domainDataServiceUploadDomainDataClient) CloseAndRecv() (*UploadDomainDataResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadDomainDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *domainDataServiceClient) SetDomainDataMaskingPolicies(ctx context.Context, in *SetDomainDataMaskingPoliciesRequest, opts ...grpc.CallOption) (*SetDomainDataMaskingPoliciesResponse, error) {
+	out := new(SetDomainDataMaskingPoliciesResponse)
+	err := c.cc.Invoke(ctx, DomainDataService_SetDomainDataMaskingPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DomainDataServiceServer is the server API for DomainDataService service.
 // All implementations must embed UnimplementedDomainDataServiceServer
 // for forward compatibility
@@ -125,6 +210,13 @@ type DomainDataServiceServer interface {
 	QueryDomainData(context.Context, *QueryDomainDataRequest) (*QueryDomainDataResponse, error)
 	BatchQueryDomainData(context.Context, *BatchQueryDomainDataRequest) (*BatchQueryDomainDataResponse, error)
 	ListDomainData(context.Context, *ListDomainDataRequest) (*ListDomainDataResponse, error)
+	// DownloadDomainData streams the content of the localfs or oss domaindata in chunks from the offset,
+	// the interrupted download could be resumed from the offset of the last received chunk.
+	DownloadDomainData(*DownloadDomainDataRequest, DomainDataService_DownloadDomainDataServer) error
+	// UploadDomainData writes the streamed file into the localfs or oss datasource and registers the domaindata.
+	UploadDomainData(DomainDataService_UploadDomainDataServer) error
+	// SetDomainDataMaskingPolicies replaces the masking policies of the domaindata, empty policies remove the masking.
+	SetDomainDataMaskingPolicies(context.Context, *SetDomainDataMaskingPoliciesRequest) (*SetDomainDataMaskingPoliciesResponse, error)
 	mustEmbedUnimplementedDomainDataServiceServer()
 }
 
@@ -150,6 +242,15 @@ func (UnimplementedDomainDataServiceServer) BatchQueryDomainData(context.Context
 func (UnimplementedDomainDataServiceServer) ListDomainData(context.Context, *ListDomainDataRequest) (*ListDomainDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDomainData not implemented")
 }
+func (UnimplementedDomainDataServiceServer) DownloadDomainData(*DownloadDomainDataRequest, DomainDataService_DownloadDomainDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDomainData not implemented")
+}
+func (UnimplementedDomainDataServiceServer) UploadDomainData(DomainDataService_UploadDomainDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadDomainData not implemented")
+}
+func (UnimplementedDomainDataServiceServer) SetDomainDataMaskingPolicies(context.Context, *SetDomainDataMaskingPoliciesRequest) (*SetDomainDataMaskingPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDomainDataMaskingPolicies not implemented")
+}
 func (UnimplementedDomainDataServiceServer) mustEmbedUnimplementedDomainDataServiceServer() {}
 
 // UnsafeDomainDataServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -271,6 +372,71 @@ func _DomainDataService_ListDomainData_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DomainDataService_DownloadDomainData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDomainDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DomainDataServiceServer).DownloadDomainData(m, &domainDataServiceDownloadDomainDataServer{stream})
+}
+
+type DomainDataService_DownloadDomainDataServer interface {
+	Send(*DownloadDomainDataResponse) error
+	grpc.ServerStream
+}
+
+type domainDataServiceDownloadDomainDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *domainDataServiceDownloadDomainDataServer) Send(m *DownloadDomainDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DomainDataService_UploadDomainData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DomainDataServiceServer).UploadDomainData(&domainDataServiceUploadDomainDataServer{stream})
+}
+
+type DomainDataService_UploadDomainDataServer interface {
+	SendAndClose(*UploadDomainDataResponse) error
+	Recv() (*UploadDomainDataRequest, error)
+	grpc.ServerStream
+}
+
+type domainDataServiceUploadDomainDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *domainDataServiceUploadDomainDataServer) SendAndClose(m *UploadDomainDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *domainDataServiceUploadDomainDataServer) Recv() (*UploadDomainDataRequest, error) {
+	m := new(UploadDomainDataRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DomainDataService_SetDomainDataMaskingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDomainDataMaskingPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainDataServiceServer).SetDomainDataMaskingPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainDataService_SetDomainDataMaskingPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainDataServiceServer).SetDomainDataMaskingPolicies(ctx, req.(*SetDomainDataMaskingPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DomainDataService_ServiceDesc is the grpc.ServiceDesc for DomainDataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -302,7 +468,22 @@ var DomainDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDomainData",
 			Handler:    _DomainDataService_ListDomainData_Handler,
 		},
+		{
+			MethodName: "SetDomainDataMaskingPolicies",
+			Handler:    _DomainDataService_SetDomainDataMaskingPolicies_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadDomainData",
+			Handler:       _DomainDataService_DownloadDomainData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadDomainData",
+			Handler:       _DomainDataService_UploadDomainData_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "kuscia/proto/api/v1alpha1/kusciaapi/domaindata.proto",
 }
@@ -36,6 +36,8 @@ const (
 	DomainDataGrantService_QueryDomainDataGrant_FullMethodName      = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataGrantService/QueryDomainDataGrant"
 	DomainDataGrantService_BatchQueryDomainDataGrant_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataGrantService/BatchQueryDomainDataGrant"
 	DomainDataGrantService_ListDomainDataGrant_FullMethodName       = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataGrantService/ListDomainDataGrant"
+	DomainDataGrantService_ApproveDomainDataGrant_FullMethodName    = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataGrantService/ApproveDomainDataGrant"
+	DomainDataGrantService_RejectDomainDataGrant_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.DomainDataGrantService/RejectDomainDataGrant"
 )
 
 // DomainDataGrantServiceClient is the client API for DomainDataGrantService service.
@@ -48,6 +50,8 @@ type DomainDataGrantServiceClient interface {
 	QueryDomainDataGrant(ctx context.Context, in *QueryDomainDataGrantRequest, opts ...grpc.CallOption) (*QueryDomainDataGrantResponse, error)
 	BatchQueryDomainDataGrant(ctx context.Context, in *BatchQueryDomainDataGrantRequest, opts ...grpc.CallOption) (*BatchQueryDomainDataGrantResponse, error)
 	ListDomainDataGrant(ctx context.Context, in *ListDomainDataGrantRequest, opts ...grpc.CallOption) (*ListDomainDataGrantResponse, error)
+	ApproveDomainDataGrant(ctx context.Context, in *ApproveDomainDataGrantRequest, opts ...grpc.CallOption) (*ApproveDomainDataGrantResponse, error)
+	RejectDomainDataGrant(ctx context.Context, in *RejectDomainDataGrantRequest, opts ...grpc.CallOption) (*RejectDomainDataGrantResponse, error)
 }
 
 type domainDataGrantServiceClient struct {
@@ -112,6 +116,24 @@ func (c *domainDataGrantServiceClient) ListDomainDataGrant(ctx context.Context,
 	return out, nil
 }
 
+func (c *domainDataGrantServiceClient) ApproveDomainDataGrant(ctx context.Context, in *ApproveDomainDataGrantRequest, opts ...grpc.CallOption) (*ApproveDomainDataGrantResponse, error) {
+	out := new(ApproveDomainDataGrantResponse)
+	err := c.cc.Invoke(ctx, DomainDataGrantService_ApproveDomainDataGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainDataGrantServiceClient) RejectDomainDataGrant(ctx context.Context, in *RejectDomainDataGrantRequest, opts ...grpc.CallOption) (*RejectDomainDataGrantResponse, error) {
+	out := new(RejectDomainDataGrantResponse)
+	err := c.cc.Invoke(ctx, DomainDataGrantService_RejectDomainDataGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DomainDataGrantServiceServer is the server API for DomainDataGrantService service.
 // All implementations must embed UnimplementedDomainDataGrantServiceServer
 // for forward compatibility
@@ -122,6 +144,8 @@ type DomainDataGrantServiceServer interface {
 	QueryDomainDataGrant(context.Context, *QueryDomainDataGrantRequest) (*QueryDomainDataGrantResponse, error)
 	BatchQueryDomainDataGrant(context.Context, *BatchQueryDomainDataGrantRequest) (*BatchQueryDomainDataGrantResponse, error)
 	ListDomainDataGrant(context.Context, *ListDomainDataGrantRequest) (*ListDomainDataGrantResponse, error)
+	ApproveDomainDataGrant(context.Context, *ApproveDomainDataGrantRequest) (*ApproveDomainDataGrantResponse, error)
+	RejectDomainDataGrant(context.Context, *RejectDomainDataGrantRequest) (*RejectDomainDataGrantResponse, error)
 	mustEmbedUnimplementedDomainDataGrantServiceServer()
 }
 
@@ -147,6 +171,12 @@ func (UnimplementedDomainDataGrantServiceServer) BatchQueryDomainDataGrant(conte
 func (UnimplementedDomainDataGrantServiceServer) ListDomainDataGrant(context.Context, *ListDomainDataGrantRequest) (*ListDomainDataGrantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDomainDataGrant not implemented")
 }
+func (UnimplementedDomainDataGrantServiceServer) ApproveDomainDataGrant(context.Context, *ApproveDomainDataGrantRequest) (*ApproveDomainDataGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDomainDataGrant not implemented")
+}
+func (UnimplementedDomainDataGrantServiceServer) RejectDomainDataGrant(context.Context, *RejectDomainDataGrantRequest) (*RejectDomainDataGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectDomainDataGrant not implemented")
+}
 func (UnimplementedDomainDataGrantServiceServer) mustEmbedUnimplementedDomainDataGrantServiceServer() {
 }
 
@@ -269,6 +299,42 @@ func _DomainDataGrantService_ListDomainDataGrant_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DomainDataGrantService_ApproveDomainDataGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDomainDataGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainDataGrantServiceServer).ApproveDomainDataGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainDataGrantService_ApproveDomainDataGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainDataGrantServiceServer).ApproveDomainDataGrant(ctx, req.(*ApproveDomainDataGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainDataGrantService_RejectDomainDataGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectDomainDataGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainDataGrantServiceServer).RejectDomainDataGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainDataGrantService_RejectDomainDataGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainDataGrantServiceServer).RejectDomainDataGrant(ctx, req.(*RejectDomainDataGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DomainDataGrantService_ServiceDesc is the grpc.ServiceDesc for DomainDataGrantService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -300,6 +366,14 @@ var DomainDataGrantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDomainDataGrant",
 			Handler:    _DomainDataGrantService_ListDomainDataGrant_Handler,
 		},
+		{
+			MethodName: "ApproveDomainDataGrant",
+			Handler:    _DomainDataGrantService_ApproveDomainDataGrant_Handler,
+		},
+		{
+			MethodName: "RejectDomainDataGrant",
+			Handler:    _DomainDataGrantService_RejectDomainDataGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kuscia/proto/api/v1alpha1/kusciaapi/domaindatagrant.proto",
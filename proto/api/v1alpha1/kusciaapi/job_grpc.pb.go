@@ -43,6 +43,13 @@ const (
 	JobService_DeleteJob_FullMethodName           = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/DeleteJob"
 	JobService_WatchJob_FullMethodName            = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/WatchJob"
 	JobService_ApproveJob_FullMethodName          = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/ApproveJob"
+	JobService_BatchCancelJob_FullMethodName      = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/BatchCancelJob"
+	JobService_BatchApproveJob_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/BatchApproveJob"
+	JobService_ListEvents_FullMethodName          = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/ListEvents"
+	JobService_QueryJobUsage_FullMethodName       = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/QueryJobUsage"
+	JobService_ListJobUsage_FullMethodName        = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/ListJobUsage"
+	JobService_QueryArchivedJob_FullMethodName    = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/QueryArchivedJob"
+	JobService_ReportArchivedJob_FullMethodName   = "/kuscia.proto.api.v1alpha1.kusciaapi.JobService/ReportArchivedJob"
 )
 
 // JobServiceClient is the client API for JobService service.
@@ -59,6 +66,19 @@ type JobServiceClient interface {
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
 	WatchJob(ctx context.Context, in *WatchJobRequest, opts ...grpc.CallOption) (JobService_WatchJobClient, error)
 	ApproveJob(ctx context.Context, in *ApproveJobRequest, opts ...grpc.CallOption) (*ApproveJobResponse, error)
+	BatchCancelJob(ctx context.Context, in *BatchCancelJobRequest, opts ...grpc.CallOption) (*BatchJobOperationResponse, error)
+	BatchApproveJob(ctx context.Context, in *BatchApproveJobRequest, opts ...grpc.CallOption) (*BatchJobOperationResponse, error)
+	// ListEvents lists the kubernetes events of the job, its tasks, task resources and pods.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// QueryJobUsage reports the resource usage of the finished tasks of the job.
+	QueryJobUsage(ctx context.Context, in *QueryJobUsageRequest, opts ...grpc.CallOption) (*QueryJobUsageResponse, error)
+	// ListJobUsage reports the resource usage of the jobs completed in a month.
+	ListJobUsage(ctx context.Context, in *ListJobUsageRequest, opts ...grpc.CallOption) (*ListJobUsageResponse, error)
+	// QueryArchivedJob queries the finished job archived and deleted from the cluster.
+	QueryArchivedJob(ctx context.Context, in *QueryArchivedJobRequest, opts ...grpc.CallOption) (*QueryArchivedJobResponse, error)
+	// ReportArchivedJob counts the archived jobs by the partner and the input domain data, it requires the mysql
+	// archive store.
+	ReportArchivedJob(ctx context.Context, in *ReportArchivedJobRequest, opts ...grpc.CallOption) (*ReportArchivedJobResponse, error)
 }
 
 type jobServiceClient struct {
@@ -182,6 +202,69 @@ func (c *jobServiceClient) ApproveJob(ctx context.Context, in *ApproveJobRequest
 	return out, nil
 }
 
+func (c *jobServiceClient) BatchCancelJob(ctx context.Context, in *BatchCancelJobRequest, opts ...grpc.CallOption) (*BatchJobOperationResponse, error) {
+	out := new(BatchJobOperationResponse)
+	err := c.cc.Invoke(ctx, JobService_BatchCancelJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) BatchApproveJob(ctx context.Context, in *BatchApproveJobRequest, opts ...grpc.CallOption) (*BatchJobOperationResponse, error) {
+	out := new(BatchJobOperationResponse)
+	err := c.cc.Invoke(ctx, JobService_BatchApproveJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, JobService_ListEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) QueryJobUsage(ctx context.Context, in *QueryJobUsageRequest, opts ...grpc.CallOption) (*QueryJobUsageResponse, error) {
+	out := new(QueryJobUsageResponse)
+	err := c.cc.Invoke(ctx, JobService_QueryJobUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ListJobUsage(ctx context.Context, in *ListJobUsageRequest, opts ...grpc.CallOption) (*ListJobUsageResponse, error) {
+	out := new(ListJobUsageResponse)
+	err := c.cc.Invoke(ctx, JobService_ListJobUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) QueryArchivedJob(ctx context.Context, in *QueryArchivedJobRequest, opts ...grpc.CallOption) (*QueryArchivedJobResponse, error) {
+	out := new(QueryArchivedJobResponse)
+	err := c.cc.Invoke(ctx, JobService_QueryArchivedJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ReportArchivedJob(ctx context.Context, in *ReportArchivedJobRequest, opts ...grpc.CallOption) (*ReportArchivedJobResponse, error) {
+	out := new(ReportArchivedJobResponse)
+	err := c.cc.Invoke(ctx, JobService_ReportArchivedJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobServiceServer is the server API for JobService service.
 // All implementations must embed UnimplementedJobServiceServer
 // for forward compatibility
@@ -196,6 +279,19 @@ type JobServiceServer interface {
 	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
 	WatchJob(*WatchJobRequest, JobService_WatchJobServer) error
 	ApproveJob(context.Context, *ApproveJobRequest) (*ApproveJobResponse, error)
+	BatchCancelJob(context.Context, *BatchCancelJobRequest) (*BatchJobOperationResponse, error)
+	BatchApproveJob(context.Context, *BatchApproveJobRequest) (*BatchJobOperationResponse, error)
+	// ListEvents lists the kubernetes events of the job, its tasks, task resources and pods.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// QueryJobUsage reports the resource usage of the finished tasks of the job.
+	QueryJobUsage(context.Context, *QueryJobUsageRequest) (*QueryJobUsageResponse, error)
+	// ListJobUsage reports the resource usage of the jobs completed in a month.
+	ListJobUsage(context.Context, *ListJobUsageRequest) (*ListJobUsageResponse, error)
+	// QueryArchivedJob queries the finished job archived and deleted from the cluster.
+	QueryArchivedJob(context.Context, *QueryArchivedJobRequest) (*QueryArchivedJobResponse, error)
+	// ReportArchivedJob counts the archived jobs by the partner and the input domain data, it requires the mysql
+	// archive store.
+	ReportArchivedJob(context.Context, *ReportArchivedJobRequest) (*ReportArchivedJobResponse, error)
 	mustEmbedUnimplementedJobServiceServer()
 }
 
@@ -233,6 +329,27 @@ func (UnimplementedJobServiceServer) WatchJob(*WatchJobRequest, JobService_Watch
 func (UnimplementedJobServiceServer) ApproveJob(context.Context, *ApproveJobRequest) (*ApproveJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveJob not implemented")
 }
+func (UnimplementedJobServiceServer) BatchCancelJob(context.Context, *BatchCancelJobRequest) (*BatchJobOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelJob not implemented")
+}
+func (UnimplementedJobServiceServer) BatchApproveJob(context.Context, *BatchApproveJobRequest) (*BatchJobOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchApproveJob not implemented")
+}
+func (UnimplementedJobServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedJobServiceServer) QueryJobUsage(context.Context, *QueryJobUsageRequest) (*QueryJobUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryJobUsage not implemented")
+}
+func (UnimplementedJobServiceServer) ListJobUsage(context.Context, *ListJobUsageRequest) (*ListJobUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobUsage not implemented")
+}
+func (UnimplementedJobServiceServer) QueryArchivedJob(context.Context, *QueryArchivedJobRequest) (*QueryArchivedJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryArchivedJob not implemented")
+}
+func (UnimplementedJobServiceServer) ReportArchivedJob(context.Context, *ReportArchivedJobRequest) (*ReportArchivedJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportArchivedJob not implemented")
+}
 func (UnimplementedJobServiceServer) mustEmbedUnimplementedJobServiceServer() {}
 
 // UnsafeJobServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -429,6 +546,132 @@ func _JobService_ApproveJob_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_BatchCancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).BatchCancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_BatchCancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).BatchCancelJob(ctx, req.(*BatchCancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_BatchApproveJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchApproveJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).BatchApproveJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_BatchApproveJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).BatchApproveJob(ctx, req.(*BatchApproveJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_QueryJobUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJobUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).QueryJobUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_QueryJobUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).QueryJobUsage(ctx, req.(*QueryJobUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ListJobUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListJobUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListJobUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListJobUsage(ctx, req.(*ListJobUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_QueryArchivedJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).QueryArchivedJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_QueryArchivedJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).QueryArchivedJob(ctx, req.(*QueryArchivedJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ReportArchivedJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportArchivedJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ReportArchivedJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ReportArchivedJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ReportArchivedJob(ctx, req.(*ReportArchivedJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -472,6 +715,34 @@ var JobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApproveJob",
 			Handler:    _JobService_ApproveJob_Handler,
 		},
+		{
+			MethodName: "BatchCancelJob",
+			Handler:    _JobService_BatchCancelJob_Handler,
+		},
+		{
+			MethodName: "BatchApproveJob",
+			Handler:    _JobService_BatchApproveJob_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _JobService_ListEvents_Handler,
+		},
+		{
+			MethodName: "QueryJobUsage",
+			Handler:    _JobService_QueryJobUsage_Handler,
+		},
+		{
+			MethodName: "ListJobUsage",
+			Handler:    _JobService_ListJobUsage_Handler,
+		},
+		{
+			MethodName: "QueryArchivedJob",
+			Handler:    _JobService_QueryArchivedJob_Handler,
+		},
+		{
+			MethodName: "ReportArchivedJob",
+			Handler:    _JobService_ReportArchivedJob_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -33,8 +33,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	LogService_QueryLog_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.LogService/QueryLog"
-	LogService_QueryPodNode_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.LogService/QueryPodNode"
+	LogService_QueryLog_FullMethodName            = "/kuscia.proto.api.v1alpha1.kusciaapi.LogService/QueryLog"
+	LogService_QueryPodNode_FullMethodName        = "/kuscia.proto.api.v1alpha1.kusciaapi.LogService/QueryPodNode"
+	LogService_QueryTaskLog_FullMethodName        = "/kuscia.proto.api.v1alpha1.kusciaapi.LogService/QueryTaskLog"
+	LogService_TailTaskLog_FullMethodName         = "/kuscia.proto.api.v1alpha1.kusciaapi.LogService/TailTaskLog"
+	LogService_PushArchivedLog_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.LogService/PushArchivedLog"
+	LogService_ListArchivedLog_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.LogService/ListArchivedLog"
+	LogService_DownloadArchivedLog_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.LogService/DownloadArchivedLog"
 )
 
 // LogServiceClient is the client API for LogService service.
@@ -43,6 +48,16 @@ const (
 type LogServiceClient interface {
 	QueryLog(ctx context.Context, in *QueryLogRequest, opts ...grpc.CallOption) (LogService_QueryLogClient, error)
 	QueryPodNode(ctx context.Context, in *QueryPodNodeRequest, opts ...grpc.CallOption) (*QueryPodNodeResponse, error)
+	// QueryTaskLog reads a range of the stdout and stderr log of the task pod.
+	QueryTaskLog(ctx context.Context, in *QueryTaskLogRequest, opts ...grpc.CallOption) (*QueryTaskLogResponse, error)
+	// TailTaskLog streams the log of the task pod from the offset or the last lines, and follows it if required.
+	TailTaskLog(ctx context.Context, in *TailTaskLogRequest, opts ...grpc.CallOption) (LogService_TailTaskLogClient, error)
+	// PushArchivedLog appends the log chunks shipped by the agents to the archive, it's called by the agents.
+	PushArchivedLog(ctx context.Context, in *PushArchivedLogRequest, opts ...grpc.CallOption) (*PushArchivedLogResponse, error)
+	// ListArchivedLog lists the archived log files of the job or the task.
+	ListArchivedLog(ctx context.Context, in *ListArchivedLogRequest, opts ...grpc.CallOption) (*ListArchivedLogResponse, error)
+	// DownloadArchivedLog reads a range of the archived log file, which is kept after the pod is garbage collected.
+	DownloadArchivedLog(ctx context.Context, in *DownloadArchivedLogRequest, opts ...grpc.CallOption) (*DownloadArchivedLogResponse, error)
 }
 
 type logServiceClient struct {
@@ -94,12 +109,90 @@ func (c *logServiceClient) QueryPodNode(ctx context.Context, in *QueryPodNodeReq
 	return out, nil
 }
 
+func (c *logServiceClient) QueryTaskLog(ctx context.Context, in *QueryTaskLogRequest, opts ...grpc.CallOption) (*QueryTaskLogResponse, error) {
+	out := new(QueryTaskLogResponse)
+	err := c.cc.Invoke(ctx, LogService_QueryTaskLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) TailTaskLog(ctx context.Context, in *TailTaskLogRequest, opts ...grpc.CallOption) (LogService_TailTaskLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogService_ServiceDesc.Streams[1], LogService_TailTaskLog_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logServiceTailTaskLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogService_TailTaskLogClient interface {
+	Recv() (*TailTaskLogResponse, error)
+	grpc.ClientStream
+}
+
+type logServiceTailTaskLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *logServiceTailTaskLogClient) Recv() (*TailTaskLogResponse, error) {
+	m := new(TailTaskLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *logServiceClient) PushArchivedLog(ctx context.Context, in *PushArchivedLogRequest, opts ...grpc.CallOption) (*PushArchivedLogResponse, error) {
+	out := new(PushArchivedLogResponse)
+	err := c.cc.Invoke(ctx, LogService_PushArchivedLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) ListArchivedLog(ctx context.Context, in *ListArchivedLogRequest, opts ...grpc.CallOption) (*ListArchivedLogResponse, error) {
+	out := new(ListArchivedLogResponse)
+	err := c.cc.Invoke(ctx, LogService_ListArchivedLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) DownloadArchivedLog(ctx context.Context, in *DownloadArchivedLogRequest, opts ...grpc.CallOption) (*DownloadArchivedLogResponse, error) {
+	out := new(DownloadArchivedLogResponse)
+	err := c.cc.Invoke(ctx, LogService_DownloadArchivedLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogServiceServer is the server API for LogService service.
 // All implementations must embed UnimplementedLogServiceServer
 // for forward compatibility
 type LogServiceServer interface {
 	QueryLog(*QueryLogRequest, LogService_QueryLogServer) error
 	QueryPodNode(context.Context, *QueryPodNodeRequest) (*QueryPodNodeResponse, error)
+	// QueryTaskLog reads a range of the stdout and stderr log of the task pod.
+	QueryTaskLog(context.Context, *QueryTaskLogRequest) (*QueryTaskLogResponse, error)
+	// TailTaskLog streams the log of the task pod from the offset or the last lines, and follows it if required.
+	TailTaskLog(*TailTaskLogRequest, LogService_TailTaskLogServer) error
+	// PushArchivedLog appends the log chunks shipped by the agents to the archive, it's called by the agents.
+	PushArchivedLog(context.Context, *PushArchivedLogRequest) (*PushArchivedLogResponse, error)
+	// ListArchivedLog lists the archived log files of the job or the task.
+	ListArchivedLog(context.Context, *ListArchivedLogRequest) (*ListArchivedLogResponse, error)
+	// DownloadArchivedLog reads a range of the archived log file, which is kept after the pod is garbage collected.
+	DownloadArchivedLog(context.Context, *DownloadArchivedLogRequest) (*DownloadArchivedLogResponse, error)
 	mustEmbedUnimplementedLogServiceServer()
 }
 
@@ -113,6 +206,21 @@ func (UnimplementedLogServiceServer) QueryLog(*QueryLogRequest, LogService_Query
 func (UnimplementedLogServiceServer) QueryPodNode(context.Context, *QueryPodNodeRequest) (*QueryPodNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryPodNode not implemented")
 }
+func (UnimplementedLogServiceServer) QueryTaskLog(context.Context, *QueryTaskLogRequest) (*QueryTaskLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTaskLog not implemented")
+}
+func (UnimplementedLogServiceServer) TailTaskLog(*TailTaskLogRequest, LogService_TailTaskLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailTaskLog not implemented")
+}
+func (UnimplementedLogServiceServer) PushArchivedLog(context.Context, *PushArchivedLogRequest) (*PushArchivedLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushArchivedLog not implemented")
+}
+func (UnimplementedLogServiceServer) ListArchivedLog(context.Context, *ListArchivedLogRequest) (*ListArchivedLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedLog not implemented")
+}
+func (UnimplementedLogServiceServer) DownloadArchivedLog(context.Context, *DownloadArchivedLogRequest) (*DownloadArchivedLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadArchivedLog not implemented")
+}
 func (UnimplementedLogServiceServer) mustEmbedUnimplementedLogServiceServer() {}
 
 // UnsafeLogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -165,6 +273,99 @@ func _LogService_QueryPodNode_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogService_QueryTaskLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).QueryTaskLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_QueryTaskLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).QueryTaskLog(ctx, req.(*QueryTaskLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_TailTaskLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailTaskLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogServiceServer).TailTaskLog(m, &logServiceTailTaskLogServer{stream})
+}
+
+type LogService_TailTaskLogServer interface {
+	Send(*TailTaskLogResponse) error
+	grpc.ServerStream
+}
+
+type logServiceTailTaskLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *logServiceTailTaskLogServer) Send(m *TailTaskLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LogService_PushArchivedLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushArchivedLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).PushArchivedLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_PushArchivedLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).PushArchivedLog(ctx, req.(*PushArchivedLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_ListArchivedLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).ListArchivedLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_ListArchivedLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).ListArchivedLog(ctx, req.(*ListArchivedLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_DownloadArchivedLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadArchivedLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).DownloadArchivedLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_DownloadArchivedLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).DownloadArchivedLog(ctx, req.(*DownloadArchivedLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LogService_ServiceDesc is the grpc.ServiceDesc for LogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -176,6 +377,22 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryPodNode",
 			Handler:    _LogService_QueryPodNode_Handler,
 		},
+		{
+			MethodName: "QueryTaskLog",
+			Handler:    _LogService_QueryTaskLog_Handler,
+		},
+		{
+			MethodName: "PushArchivedLog",
+			Handler:    _LogService_PushArchivedLog_Handler,
+		},
+		{
+			MethodName: "ListArchivedLog",
+			Handler:    _LogService_ListArchivedLog_Handler,
+		},
+		{
+			MethodName: "DownloadArchivedLog",
+			Handler:    _LogService_DownloadArchivedLog_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -183,6 +400,11 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _LogService_QueryLog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TailTaskLog",
+			Handler:       _LogService_TailTaskLog_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "kuscia/proto/api/v1alpha1/kusciaapi/log.proto",
 }
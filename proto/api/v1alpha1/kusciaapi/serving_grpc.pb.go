@@ -38,6 +38,10 @@ const (
 	ServingService_UpdateServing_FullMethodName           = "/kuscia.proto.api.v1alpha1.kusciaapi.ServingService/UpdateServing"
 	ServingService_DeleteServing_FullMethodName           = "/kuscia.proto.api.v1alpha1.kusciaapi.ServingService/DeleteServing"
 	ServingService_BatchQueryServingStatus_FullMethodName = "/kuscia.proto.api.v1alpha1.kusciaapi.ServingService/BatchQueryServingStatus"
+	ServingService_CreateServingCanary_FullMethodName     = "/kuscia.proto.api.v1alpha1.kusciaapi.ServingService/CreateServingCanary"
+	ServingService_UpdateServingTraffic_FullMethodName    = "/kuscia.proto.api.v1alpha1.kusciaapi.ServingService/UpdateServingTraffic"
+	ServingService_PromoteServing_FullMethodName          = "/kuscia.proto.api.v1alpha1.kusciaapi.ServingService/PromoteServing"
+	ServingService_RollbackServing_FullMethodName         = "/kuscia.proto.api.v1alpha1.kusciaapi.ServingService/RollbackServing"
 )
 
 // ServingServiceClient is the client API for ServingService service.
@@ -49,6 +53,14 @@ type ServingServiceClient interface {
 	UpdateServing(ctx context.Context, in *UpdateServingRequest, opts ...grpc.CallOption) (*UpdateServingResponse, error)
 	DeleteServing(ctx context.Context, in *DeleteServingRequest, opts ...grpc.CallOption) (*DeleteServingResponse, error)
 	BatchQueryServingStatus(ctx context.Context, in *BatchQueryServingStatusRequest, opts ...grpc.CallOption) (*BatchQueryServingStatusResponse, error)
+	// Deploys a canary revision of the serving alongside the current one, and routes part of the traffic to it.
+	CreateServingCanary(ctx context.Context, in *CreateServingCanaryRequest, opts ...grpc.CallOption) (*CreateServingCanaryResponse, error)
+	// Adjusts the percentage of the traffic routed to the canary revision.
+	UpdateServingTraffic(ctx context.Context, in *UpdateServingTrafficRequest, opts ...grpc.CallOption) (*UpdateServingTrafficResponse, error)
+	// Replaces the current revision with the canary revision.
+	PromoteServing(ctx context.Context, in *PromoteServingRequest, opts ...grpc.CallOption) (*PromoteServingResponse, error)
+	// Removes the canary revision, all the traffic goes back to the current revision.
+	RollbackServing(ctx context.Context, in *RollbackServingRequest, opts ...grpc.CallOption) (*RollbackServingResponse, error)
 }
 
 type servingServiceClient struct {
@@ -104,6 +116,42 @@ func (c *servingServiceClient) BatchQueryServingStatus(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *servingServiceClient) CreateServingCanary(ctx context.Context, in *CreateServingCanaryRequest, opts ...grpc.CallOption) (*CreateServingCanaryResponse, error) {
+	out := new(CreateServingCanaryResponse)
+	err := c.cc.Invoke(ctx, ServingService_CreateServingCanary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *servingServiceClient) UpdateServingTraffic(ctx context.Context, in *UpdateServingTrafficRequest, opts ...grpc.CallOption) (*UpdateServingTrafficResponse, error) {
+	out := new(UpdateServingTrafficResponse)
+	err := c.cc.Invoke(ctx, ServingService_UpdateServingTraffic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *servingServiceClient) PromoteServing(ctx context.Context, in *PromoteServingRequest, opts ...grpc.CallOption) (*PromoteServingResponse, error) {
+	out := new(PromoteServingResponse)
+	err := c.cc.Invoke(ctx, ServingService_PromoteServing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *servingServiceClient) RollbackServing(ctx context.Context, in *RollbackServingRequest, opts ...grpc.CallOption) (*RollbackServingResponse, error) {
+	out := new(RollbackServingResponse)
+	err := c.cc.Invoke(ctx, ServingService_RollbackServing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServingServiceServer is the server API for ServingService service.
 // All implementations must embed UnimplementedServingServiceServer
 // for forward compatibility
@@ -113,6 +161,14 @@ type ServingServiceServer interface {
 	UpdateServing(context.Context, *UpdateServingRequest) (*UpdateServingResponse, error)
 	DeleteServing(context.Context, *DeleteServingRequest) (*DeleteServingResponse, error)
 	BatchQueryServingStatus(context.Context, *BatchQueryServingStatusRequest) (*BatchQueryServingStatusResponse, error)
+	// Deploys a canary revision of the serving alongside the current one, and routes part of the traffic to it.
+	CreateServingCanary(context.Context, *CreateServingCanaryRequest) (*CreateServingCanaryResponse, error)
+	// Adjusts the percentage of the traffic routed to the canary revision.
+	UpdateServingTraffic(context.Context, *UpdateServingTrafficRequest) (*UpdateServingTrafficResponse, error)
+	// Replaces the current revision with the canary revision.
+	PromoteServing(context.Context, *PromoteServingRequest) (*PromoteServingResponse, error)
+	// Removes the canary revision, all the traffic goes back to the current revision.
+	RollbackServing(context.Context, *RollbackServingRequest) (*RollbackServingResponse, error)
 	mustEmbedUnimplementedServingServiceServer()
 }
 
@@ -135,6 +191,18 @@ func (UnimplementedServingServiceServer) DeleteServing(context.Context, *DeleteS
 func (UnimplementedServingServiceServer) BatchQueryServingStatus(context.Context, *BatchQueryServingStatusRequest) (*BatchQueryServingStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchQueryServingStatus not implemented")
 }
+func (UnimplementedServingServiceServer) CreateServingCanary(context.Context, *CreateServingCanaryRequest) (*CreateServingCanaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServingCanary not implemented")
+}
+func (UnimplementedServingServiceServer) UpdateServingTraffic(context.Context, *UpdateServingTrafficRequest) (*UpdateServingTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServingTraffic not implemented")
+}
+func (UnimplementedServingServiceServer) PromoteServing(context.Context, *PromoteServingRequest) (*PromoteServingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteServing not implemented")
+}
+func (UnimplementedServingServiceServer) RollbackServing(context.Context, *RollbackServingRequest) (*RollbackServingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackServing not implemented")
+}
 func (UnimplementedServingServiceServer) mustEmbedUnimplementedServingServiceServer() {}
 
 // UnsafeServingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -238,6 +306,78 @@ func _ServingService_BatchQueryServingStatus_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServingService_CreateServingCanary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServingCanaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServingServiceServer).CreateServingCanary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServingService_CreateServingCanary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServingServiceServer).CreateServingCanary(ctx, req.(*CreateServingCanaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServingService_UpdateServingTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServingTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServingServiceServer).UpdateServingTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServingService_UpdateServingTraffic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServingServiceServer).UpdateServingTraffic(ctx, req.(*UpdateServingTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServingService_PromoteServing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteServingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServingServiceServer).PromoteServing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServingService_PromoteServing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServingServiceServer).PromoteServing(ctx, req.(*PromoteServingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServingService_RollbackServing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackServingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServingServiceServer).RollbackServing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServingService_RollbackServing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServingServiceServer).RollbackServing(ctx, req.(*RollbackServingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ServingService_ServiceDesc is the grpc.ServiceDesc for ServingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -265,6 +405,22 @@ var ServingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchQueryServingStatus",
 			Handler:    _ServingService_BatchQueryServingStatus_Handler,
 		},
+		{
+			MethodName: "CreateServingCanary",
+			Handler:    _ServingService_CreateServingCanary_Handler,
+		},
+		{
+			MethodName: "UpdateServingTraffic",
+			Handler:    _ServingService_UpdateServingTraffic_Handler,
+		},
+		{
+			MethodName: "PromoteServing",
+			Handler:    _ServingService_PromoteServing_Handler,
+		},
+		{
+			MethodName: "RollbackServing",
+			Handler:    _ServingService_RollbackServing_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kuscia/proto/api/v1alpha1/kusciaapi/serving.proto",